@@ -19,20 +19,28 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"text/tabwriter"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/cleaner"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+	"code.superseriousbusiness.org/gotosocial/internal/email"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	userprocessor "code.superseriousbusiness.org/gotosocial/internal/processing/user"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/uris"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"code.superseriousbusiness.org/gotosocial/internal/validate"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -46,6 +54,11 @@
 	_ action.GTSAction = Enable
 	_ action.GTSAction = Disable
 	_ action.GTSAction = Password
+	_ action.GTSAction = ResetPassword
+	_ action.GTSAction = Disable2FA
+	_ action.GTSAction = Search
+	_ action.GTSAction = Tokens
+	_ action.GTSAction = Recount
 )
 
 func initState(ctx context.Context) (*state.State, error) {
@@ -137,11 +150,60 @@ func List(ctx context.Context) error {
 		return err
 	}
 
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
 	users, err := state.DB.GetAllUsers(ctx)
 	if err != nil {
 		return err
 	}
 
+	return printUsers(users)
+}
+
+// Search returns existing local accounts
+// whose username or email address contains
+// the given query text (case-insensitive).
+func Search(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	query := strings.ToLower(config.GetAdminAccountQuery())
+	if query == "" {
+		return errors.New("query must not be empty")
+	}
+
+	users, err := state.DB.GetAllUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Drop any users not matching query.
+	users = slices.DeleteFunc(users, func(u *gtsmodel.User) bool {
+		return !strings.Contains(strings.ToLower(u.Account.Username), query) &&
+			!strings.Contains(strings.ToLower(u.Email), query) &&
+			!strings.Contains(strings.ToLower(u.UnconfirmedEmail), query)
+	})
+
+	return printUsers(users)
+}
+
+// printUsers prints the given users
+// (+ their accounts) as a table to stdout.
+func printUsers(users []*gtsmodel.User) error {
 	fmtBool := func(b *bool) string {
 		if b == nil {
 			return "unknown"
@@ -210,8 +272,19 @@ func Confirm(ctx context.Context) error {
 	)
 }
 
-// Promote sets admin + moderator flags on a user to true.
+// Promote sets admin + moderator flags on a user to true,
+// or only the moderator flag if promoting to moderator.
 func Promote(ctx context.Context) error {
+	var admin bool
+	switch role := config.GetAdminAccountRole(); role {
+	case "", "admin":
+		admin = true
+	case "moderator":
+		admin = false
+	default:
+		return fmt.Errorf("role %s not recognized, must be admin or moderator", role)
+	}
+
 	state, err := initState(ctx)
 	if err != nil {
 		return err
@@ -239,7 +312,7 @@ func Promote(ctx context.Context) error {
 		return err
 	}
 
-	user.Admin = func() *bool { a := true; return &a }()
+	user.Admin = &admin
 	user.Moderator = func() *bool { a := true; return &a }()
 	return state.DB.UpdateUser(
 		ctx, user,
@@ -440,3 +513,206 @@ func Disable2FA(ctx context.Context) error {
 	fmt.Printf("2fa disabled\n")
 	return nil
 }
+
+// ResetPassword emails target account a link to
+// reset its password, and revokes all of its access
+// tokens, forcing it to sign in again everywhere.
+func ResetPassword(ctx context.Context) error {
+	if config.GetSMTPHost() == "" {
+		return errors.New("smtp-host not set, cannot send password reset email")
+	}
+
+	emailSender, err := email.NewSender()
+	if err != nil {
+		return fmt.Errorf("error creating email sender: %w", err)
+	}
+
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	if user.Email == "" {
+		return fmt.Errorf("user %s has no confirmed email address", username)
+	}
+
+	instance, err := state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		return fmt.Errorf("error getting instance: %w", err)
+	}
+
+	// We need a token and a link for the
+	// user to click on. We'll use a uuid
+	// as our token since it's secure enough
+	// for this purpose.
+	var (
+		resetToken = uuid.NewString()
+		resetLink  = uris.GenerateURIForPasswordReset(resetToken)
+	)
+
+	// Store the token before sending the email,
+	// so the link works by the time it's received.
+	now := time.Now()
+	user.ResetPasswordToken = resetToken
+	user.ResetPasswordSentAt = now
+	user.LastEmailedAt = now
+
+	if err := state.DB.UpdateUser(
+		ctx, user,
+		"reset_password_token",
+		"reset_password_sent_at",
+		"last_emailed_at",
+	); err != nil {
+		return err
+	}
+
+	if err := emailSender.SendResetEmail(
+		user.Email,
+		email.ResetData{
+			Username:     account.Username,
+			InstanceURL:  instance.URI,
+			InstanceName: instance.Title,
+			ResetLink:    resetLink,
+		},
+	); err != nil {
+		return fmt.Errorf("error sending password reset email: %w", err)
+	}
+
+	// Revoke all existing access tokens of user.
+	tokens, err := state.DB.GetAccessTokens(ctx,
+		user.ID,
+		nil, // i.e. all
+	)
+	if err != nil {
+		return fmt.Errorf("error getting access tokens: %w", err)
+	}
+
+	for _, token := range tokens {
+		if err := state.DB.DeleteTokenByID(ctx, token.ID); err != nil {
+			return fmt.Errorf("error deleting access token: %w", err)
+		}
+	}
+
+	fmt.Printf("revoked %d access token(s)\n", len(tokens))
+	fmt.Printf("password reset email sent to %s\n", user.Email)
+	return nil
+}
+
+// Tokens lists the access tokens of target account,
+// ie., the apps + sessions it is currently signed in to.
+func Tokens(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := state.DB.GetAccessTokens(ctx,
+		user.ID,
+		nil, // i.e. all
+	)
+	if err != nil {
+		return fmt.Errorf("error getting access tokens: %w", err)
+	}
+
+	fmtDate := func(t time.Time) string {
+		if t.IsZero() {
+			return "never"
+		}
+		return util.FormatISO8601(t)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "token\tapplication\tscope\tcreated\tlast used\texpires")
+	for _, token := range tokens {
+		appName := "unknown"
+		app, err := state.DB.GetApplicationByClientID(ctx, token.ClientID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("error getting application: %w", err)
+		}
+		if app != nil {
+			appName = app.Name
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", token.ID, appName, token.Scope, fmtDate(token.AccessCreateAt), fmtDate(token.LastUsed), fmtDate(token.AccessExpiresAt))
+	}
+	return w.Flush()
+}
+
+// Recount recomputes the stats (follower, following,
+// status counts etc.) of all accounts from scratch,
+// and prints how many had drifted from the truth.
+func Recount(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	res, err := cleaner.New(state).AccountStats().Recount(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("checked %d account(s), %d had drifted\n", res.Checked, res.Drifted)
+	if res.Failed > 0 {
+		fmt.Printf("%d account(s) could not be recounted, see logs for details\n", res.Failed)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "count\ttotal drift")
+	fmt.Fprintf(w, "followers\t%d\n", res.Followers)
+	fmt.Fprintf(w, "following\t%d\n", res.Following)
+	fmt.Fprintf(w, "follow requests\t%d\n", res.FollowRequests)
+	fmt.Fprintf(w, "statuses\t%d\n", res.Statuses)
+	fmt.Fprintf(w, "pinned statuses\t%d\n", res.StatusesPinned)
+	return w.Flush()
+}
@@ -0,0 +1,251 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/media/ffmpeg"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	gtsstorage "code.superseriousbusiness.org/gotosocial/internal/storage"
+	"codeberg.org/gruf/go-iotools"
+)
+
+// check function conformance.
+var _ action.GTSAction = ReprocessEmojis
+
+// ReprocessEmojis pushes all cached local and / or remote emojis
+// back through the emoji processing pipeline, from their stored
+// original images, regenerating static images and enforcing the
+// currently configured emoji max sizes.
+func ReprocessEmojis(ctx context.Context) error {
+	r, err := setupReprocess(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure reprocessor gets shutdown on exit.
+		if err := r.shutdown(); err != nil {
+			log.Errorf(ctx, "error shutting down: %v", err)
+		}
+	}()
+
+	return r.reprocessEmojis(ctx)
+}
+
+type reprocess struct {
+	state      *state.State
+	manager    *media.Manager
+	localOnly  bool
+	remoteOnly bool
+}
+
+func (r *reprocess) reprocessEmojis(ctx context.Context) error {
+	// Page reused for iterative
+	// emoji queries, with
+	// predefined limit.
+	var page paging.Page
+	page.Limit = 500
+
+	var total, failed, skipped int
+
+	for {
+		// Get the next page of emoji media up to max ID.
+		emojis, err := r.state.DB.GetEmojis(ctx, &page)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("failed to fetch emojis from database: %w", err)
+		}
+
+		// Get current max ID.
+		maxID := page.Max.Value
+
+		// If no emojis or the same group is returned, we reached end.
+		if len(emojis) == 0 || maxID == emojis[len(emojis)-1].ID {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = emojis[len(emojis)-1].ID
+		page.Max.Value = maxID
+
+		for _, emoji := range emojis {
+			switch {
+			case r.localOnly && !emoji.IsLocal():
+				continue
+			case r.remoteOnly && emoji.IsLocal():
+				continue
+			}
+
+			total++
+
+			if !emoji.Cached() {
+				// Nothing stored to reprocess.
+				fmt.Printf("%s: skipped: not cached\n", emoji.ShortcodeDomain())
+				skipped++
+				continue
+			}
+
+			emoji, err := r.reprocess(ctx, emoji)
+			switch {
+			case errors.Is(err, errTooLarge):
+				fmt.Printf("%s: skipped: %v\n", emoji.ShortcodeDomain(), err)
+				skipped++
+			case err != nil:
+				fmt.Printf("%s: failed: %s (%v)\n", emoji.ShortcodeDomain(), emoji.Error, err)
+				failed++
+			case emoji.Error != 0:
+				fmt.Printf("%s: failed: %s\n", emoji.ShortcodeDomain(), emoji.Error)
+				failed++
+			default:
+				fmt.Printf("%s: ok\n", emoji.ShortcodeDomain())
+			}
+		}
+	}
+
+	fmt.Printf("reprocessed %d of %d emojis (%d skipped)\n", total-failed-skipped, total, skipped)
+	return nil
+}
+
+// errTooLarge is returned when a local emoji exceeds
+// the configured max size, as reprocessing it would
+// otherwise drop the emoji image from storage entirely.
+var errTooLarge = errors.New("exceeds emoji-local-max-size")
+
+// reprocess pushes the given cached emoji through the emoji
+// processing pipeline, reading from its stored original image.
+// Remote emojis are limited to emoji-remote-max-size as if just
+// fetched, local emojis exceeding their limit are left untouched.
+func (r *reprocess) reprocess(
+	ctx context.Context,
+	emoji *gtsmodel.Emoji,
+) (*gtsmodel.Emoji, error) {
+	var maxsz int64
+	if emoji.IsLocal() {
+		maxsz = int64(config.GetMediaEmojiLocalMaxSize()) // #nosec G115 -- Already validated.
+		if int64(emoji.ImageFileSize) > maxsz {
+			return emoji, errTooLarge
+		}
+	} else {
+		maxsz = int64(config.GetMediaEmojiRemoteMaxSize()) // #nosec G115 -- Already validated.
+	}
+
+	// Prepare data function to read stored original image.
+	data := func(ctx context.Context) (io.ReadCloser, error) {
+		rc, err := r.state.Storage.GetStream(ctx, emoji.ImagePath)
+		if err != nil {
+			return nil, err
+		}
+		rc, _, _ = iotools.UpdateReadCloserLimit(rc, maxsz)
+		return rc, nil
+	}
+
+	// Prepare to update the emoji, this
+	// stores new images under new paths.
+	processing, err := r.manager.UpdateEmoji(ctx,
+		emoji,
+		data,
+		media.AdditionalEmojiInfo{},
+	)
+	if err != nil {
+		return emoji, err
+	}
+
+	// Load emoji through the processing
+	// pipeline, this will also update
+	// the emoji in the database.
+	loaded, err := processing.Load(ctx)
+	if loaded != nil {
+		emoji = loaded
+	}
+
+	return emoji, err
+}
+
+func setupReprocess(ctx context.Context) (*reprocess, error) {
+	var (
+		localOnly  = config.GetAdminMediaListLocalOnly()
+		remoteOnly = config.GetAdminMediaListRemoteOnly()
+		state      state.State
+	)
+
+	// Validate flags.
+	if localOnly && remoteOnly {
+		return nil, errors.New(
+			"local-only and remote-only flags cannot be true at the same time; " +
+				"choose one or the other, or set neither to reprocess all emojis",
+		)
+	}
+
+	// Initialize caches.
+	state.Caches.Init()
+
+	// Ensure background cache tasks are running.
+	if err := state.Caches.Start(); err != nil {
+		return nil, fmt.Errorf("error starting caches: %w", err)
+	}
+
+	// Set state DB connection.
+	// Don't need Actions or Workers for this.
+	dbService, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dbservice: %w", err)
+	}
+	state.DB = dbService
+
+	//nolint:contextcheck
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error creating storage backend: %w", err)
+	}
+	state.Storage = storage
+
+	// Media processing requires ffmpeg
+	// and ffprobe, only one of each is
+	// needed as we process sequentially.
+	if err := ffmpeg.InitFfprobe(ctx, 1); err != nil {
+		return nil, fmt.Errorf("error compiling ffprobe: %w", err)
+	}
+	if err := ffmpeg.InitFfmpeg(ctx, 1); err != nil {
+		return nil, fmt.Errorf("error compiling ffmpeg: %w", err)
+	}
+
+	return &reprocess{
+		state:      &state,
+		manager:    media.NewManager(&state),
+		localOnly:  localOnly,
+		remoteOnly: remoteOnly,
+	}, nil
+}
+
+func (r *reprocess) shutdown() error {
+	err := r.state.DB.Close()
+	r.state.Caches.Stop()
+	return err
+}
@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// check function conformance.
+var _ action.GTSAction = Inspect
+
+// Inspect prints the stored metadata, storage
+// paths and any error details of one attachment.
+func Inspect(ctx context.Context) error {
+	var state state.State
+
+	// Initialize caches.
+	state.Caches.Init()
+
+	// Ensure background cache tasks are running.
+	if err := state.Caches.Start(); err != nil {
+		return fmt.Errorf("error starting caches: %w", err)
+	}
+	defer state.Caches.Stop()
+
+	var err error
+
+	// Only set state DB connection.
+	// Don't need Actions or Workers for this.
+	state.DB, err = bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %w", err)
+	}
+	defer func() {
+		if err := state.DB.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "error closing dbservice: %v\n", err)
+		}
+	}()
+
+	id := config.GetAdminMediaID()
+	attachment, err := state.DB.GetAttachmentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("no media attachment found with id %s", id)
+		}
+		return fmt.Errorf("error getting media attachment %s: %w", id, err)
+	}
+
+	printAttachment(attachment)
+	return nil
+}
+
+// printAttachment prints details of the given
+// attachment as a table of field names to values.
+func printAttachment(attachment *gtsmodel.MediaAttachment) {
+	// Storage base path, used
+	// for path building when
+	// using local disk storage.
+	var basePath string
+	if config.GetStorageBackend() == "local" {
+		basePath = config.GetStorageLocalBasePath()
+	}
+
+	// storagePath returns the full path
+	// of a stored file, or a placeholder
+	// if the file isn't currently stored.
+	storagePath := func(path string) string {
+		switch {
+		case path == "":
+			return "(not stored)"
+		case basePath != "":
+			return pb.Join(basePath, path)
+		default:
+			return path
+		}
+	}
+
+	// orNone returns given string,
+	// or a placeholder if empty.
+	orNone := func(s string) string {
+		if s == "" {
+			return "(none)"
+		}
+		return s
+	}
+
+	original := attachment.FileMeta.Original
+	small := attachment.FileMeta.Small
+	mediaErr := attachment.Error
+
+	w := tabwriter.NewWriter(os.Stdout, 1, 1, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\t%s\n", attachment.ID)
+	fmt.Fprintf(w, "Created\t%s\n", util.FormatISO8601(attachment.CreatedAt))
+	fmt.Fprintf(w, "Account ID\t%s\n", attachment.AccountID)
+	fmt.Fprintf(w, "Status ID\t%s\n", orNone(attachment.StatusID))
+	fmt.Fprintf(w, "Scheduled status ID\t%s\n", orNone(attachment.ScheduledStatusID))
+	fmt.Fprintf(w, "Avatar\t%t\n", util.PtrOrZero(attachment.Avatar))
+	fmt.Fprintf(w, "Header\t%t\n", util.PtrOrZero(attachment.Header))
+	fmt.Fprintf(w, "Type\t%s\n", attachment.Type)
+	fmt.Fprintf(w, "Description\t%s\n", orNone(attachment.Description))
+	fmt.Fprintf(w, "Blurhash\t%s\n", orNone(attachment.Blurhash))
+	fmt.Fprintf(w, "URL\t%s\n", orNone(attachment.URL))
+	fmt.Fprintf(w, "Remote URL\t%s\n", orNone(attachment.RemoteURL))
+	fmt.Fprintf(w, "Cached\t%t\n", attachment.Cached())
+	fmt.Fprintf(w, "Storage backend\t%s\n", config.GetStorageBackend())
+	fmt.Fprintf(w, "File path\t%s\n", storagePath(attachment.File.Path))
+	fmt.Fprintf(w, "File content type\t%s\n", orNone(attachment.File.ContentType))
+	fmt.Fprintf(w, "File size\t%d bytes\n", attachment.File.FileSize)
+	fmt.Fprintf(w, "Original dimensions\t%dx%d\n", original.Width, original.Height)
+	if original.Duration != nil {
+		fmt.Fprintf(w, "Original duration\t%ss\n", strconv.FormatFloat(float64(*original.Duration), 'f', -1, 32))
+	}
+	fmt.Fprintf(w, "Thumbnail path\t%s\n", storagePath(attachment.Thumbnail.Path))
+	fmt.Fprintf(w, "Thumbnail content type\t%s\n", orNone(attachment.Thumbnail.ContentType))
+	fmt.Fprintf(w, "Thumbnail size\t%d bytes\n", attachment.Thumbnail.FileSize)
+	fmt.Fprintf(w, "Thumbnail dimensions\t%dx%d\n", small.Width, small.Height)
+	fmt.Fprintf(w, "Thumbnail URL\t%s\n", orNone(attachment.Thumbnail.URL))
+	fmt.Fprintf(w, "Thumbnail remote URL\t%s\n", orNone(attachment.Thumbnail.RemoteURL))
+	fmt.Fprintf(w, "Error\t%s\n", mediaErr.String())
+	fmt.Fprintf(w, "Error type / details\t%d / %d\n", mediaErr.Type(), mediaErr.Details())
+	fmt.Fprintf(w, "Error supports retry\t%t\n", mediaErr.SupportsRetry())
+	w.Flush()
+}
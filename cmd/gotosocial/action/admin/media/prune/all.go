@@ -52,8 +52,8 @@ func All(ctx context.Context) error {
 	days := config.GetMediaRemoteCacheDays()
 
 	// Perform the actual pruning with logging.
-	prune.cleaner.Media().AllAndFix(ctx, days)
-	prune.cleaner.Emoji().AllAndFix(ctx, days)
+	_ = prune.cleaner.Media().AllAndFix(ctx, days)
+	_ = prune.cleaner.Emoji().AllAndFix(ctx, days)
 
 	return nil
 }
@@ -50,7 +50,7 @@ func Orphaned(ctx context.Context) error {
 	}
 
 	// Perform the actual pruning with logging.
-	prune.cleaner.Media().LogPruneOrphaned(ctx)
+	_ = prune.cleaner.Media().LogPruneOrphaned(ctx)
 
 	// Perform a cleanup of storage (for removed local dirs).
 	if err := prune.storage.Storage.Clean(ctx); err != nil {
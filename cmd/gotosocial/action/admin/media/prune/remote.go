@@ -53,8 +53,8 @@ func Remote(ctx context.Context) error {
 	t := time.Now().Add(-24 * time.Hour * time.Duration(config.GetMediaRemoteCacheDays()))
 
 	// Perform the actual pruning with logging.
-	prune.cleaner.Media().LogPruneUnused(ctx)
-	prune.cleaner.Media().LogUncacheRemote(ctx, t)
+	_ = prune.cleaner.Media().LogPruneUnused(ctx)
+	_ = prune.cleaner.Media().LogUncacheRemote(ctx, t)
 
 	// Perform a cleanup of storage (for removed local dirs).
 	if err := prune.storage.Storage.Clean(ctx); err != nil {
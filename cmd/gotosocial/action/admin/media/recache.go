@@ -0,0 +1,326 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"net/url"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/cmd/gotosocial/action"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+	"code.superseriousbusiness.org/gotosocial/internal/federation/federatingdb"
+	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
+	"code.superseriousbusiness.org/gotosocial/internal/filter/spam"
+	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/media/ffmpeg"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	gtsstorage "code.superseriousbusiness.org/gotosocial/internal/storage"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// check function conformance.
+var _ action.GTSAction = Recache
+
+// Recache forces a re-download and re-processing of either
+// the remote attachment with given ID, or all attachments
+// of accounts on the given domain, regardless of whether
+// they're currently cached or previously failed to cache.
+func Recache(ctx context.Context) error {
+	var (
+		id     = config.GetAdminMediaID()
+		domain = config.GetAdminMediaDomain()
+	)
+
+	// Validate flags.
+	switch {
+	case id == "" && domain == "":
+		return errors.New("one of id or domain must be set")
+	case id != "" && domain != "":
+		return errors.New(
+			"id and domain flags cannot be set at the same time; " +
+				"choose one or the other",
+		)
+	}
+
+	if domain != "" {
+		var err error
+
+		// Normalize the given domain
+		// to match stored account domains.
+		domain, err = util.Punify(domain)
+		if err != nil {
+			return fmt.Errorf("invalid domain %s: %w", domain, err)
+		}
+	}
+
+	r, err := setupRecache(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure recacher gets shutdown on exit.
+		if err := r.shutdown(); err != nil {
+			log.Errorf(ctx, "error shutting down: %v", err)
+		}
+	}()
+
+	if id != "" {
+		attachment, err := r.state.DB.GetAttachmentByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				return fmt.Errorf("no media attachment found with id %s", id)
+			}
+			return fmt.Errorf("error getting media attachment %s: %w", id, err)
+		}
+
+		if attachment.IsLocal() {
+			return fmt.Errorf("media attachment %s is local and cannot be recached", id)
+		}
+
+		attachment, err = r.recache(ctx, attachment)
+		printAttachment(attachment)
+		return err
+	}
+
+	return r.recacheDomain(ctx, domain)
+}
+
+type recache struct {
+	state     *state.State
+	manager   *media.Manager
+	transport transport.Transport
+}
+
+// recache pushes the given remote attachment through the media
+// processing pipeline, fetching it from its remote URL. Any previous
+// error is reset first, so that even media which failed with an
+// unretryable error is re-attempted. The returned attachment reflects
+// the updated state, including any new error, even on error.
+func (r *recache) recache(
+	ctx context.Context,
+	attachment *gtsmodel.MediaAttachment,
+) (*gtsmodel.MediaAttachment, error) {
+	url, err := url.Parse(attachment.RemoteURL)
+	if err != nil {
+		err := gtserror.Newf("invalid media remote url %s: %w", attachment.RemoteURL, err)
+		return attachment, err
+	}
+
+	// Get maximum supported remote media size.
+	maxsz := int64(config.GetMediaRemoteMaxSize()) // #nosec G115 -- Already validated.
+
+	// Prepare data function to dereference remote media.
+	data := func(ctx context.Context) (io.ReadCloser, error) {
+		return r.transport.DereferenceMedia(ctx, url, maxsz)
+	}
+
+	// Unset any previous
+	// error to force recache.
+	attachment.Error = 0
+
+	// Load media through the processing
+	// pipeline, this will also update
+	// the media in the database.
+	processing := r.manager.CacheMedia(attachment,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	loaded, err := processing.Load(ctx)
+	if loaded != nil {
+		attachment = loaded
+	}
+
+	return attachment, err
+}
+
+// recacheDomain recaches all remote attachments
+// owned by accounts on the given domain, printing
+// the result for each as it goes.
+func (r *recache) recacheDomain(ctx context.Context, domain string) error {
+	// Page reused for iterative
+	// attachment queries, with
+	// predefined limit.
+	var page paging.Page
+	page.Limit = 500
+
+	var total, failed int
+
+	for {
+		// Fetch the next batch of remote media attachments
+		// owned by accounts on the domain, up to max ID.
+		attachments, err := r.state.DB.GetRemoteAttachmentsByDomain(ctx, domain, &page)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("failed to fetch media from database: %w", err)
+		}
+
+		// Get current max ID.
+		maxID := page.Max.Value
+
+		// If no media or the same group is returned, we reached end.
+		if len(attachments) == 0 || maxID == attachments[len(attachments)-1].ID {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = attachments[len(attachments)-1].ID
+		page.Max.Value = maxID
+
+		for _, attachment := range attachments {
+			total++
+
+			attachment, err := r.recache(ctx, attachment)
+			if err != nil {
+				failed++
+				fmt.Printf("%s: failed: %s (%v)\n", attachment.ID, attachment.Error, err)
+				continue
+			}
+
+			fmt.Printf("%s: ok\n", attachment.ID)
+		}
+	}
+
+	fmt.Printf("recached %d of %d media attachments from %s\n", total-failed, total, domain)
+	return nil
+}
+
+func setupRecache(ctx context.Context) (*recache, error) {
+	var state state.State
+
+	// Initialize caches.
+	state.Caches.Init()
+
+	// Ensure background cache tasks are running.
+	if err := state.Caches.Start(); err != nil {
+		return nil, fmt.Errorf("error starting caches: %w", err)
+	}
+
+	// Set state DB connection.
+	// Don't need Actions or Workers for this.
+	dbService, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dbservice: %w", err)
+	}
+	state.DB = dbService
+
+	//nolint:contextcheck
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error creating storage backend: %w", err)
+	}
+	state.Storage = storage
+
+	// Media processing requires ffmpeg
+	// and ffprobe, only one of each is
+	// needed as we process sequentially.
+	if err := ffmpeg.InitFfprobe(ctx, 1); err != nil {
+		return nil, fmt.Errorf("error compiling ffprobe: %w", err)
+	}
+	if err := ffmpeg.InitFfmpeg(ctx, 1); err != nil {
+		return nil, fmt.Errorf("error compiling ffmpeg: %w", err)
+	}
+
+	// Parse http client allow
+	// and block range exceptions.
+	allowRanges, err := parseRanges(
+		config.GetHTTPClientAllowIPs(),
+		config.HTTPClientAllowIPsFlag,
+	)
+	if err != nil {
+		return nil, err
+	}
+	blockRanges, err := parseRanges(
+		config.GetHTTPClientBlockIPs(),
+		config.HTTPClientBlockIPsFlag,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare wrapped httpclient with config.
+	client := httpclient.New(httpclient.Config{
+		AllowRanges:           allowRanges,
+		BlockRanges:           blockRanges,
+		Timeout:               config.GetHTTPClientTimeout(),
+		MaxIdleConnsPerHost:   config.GetHTTPClientMaxIdleConnsPerHost(),
+		IdleConnTimeout:       config.GetHTTPClientIdleConnTimeout(),
+		DisableHTTP2:          config.GetHTTPClientDisableHTTP2(),
+		DNSCacheTTL:           config.GetHTTPClientDNSCacheTTL(),
+		DNSCacheNegativeTTL:   config.GetHTTPClientDNSCacheNegativeTTL(),
+		PreferIPFamily:        config.GetHTTPClientIPFamilyPreference(),
+		FallbackDelay:         config.GetHTTPClientHappyEyeballsDelay(),
+		IPv4OnlyDomains:       config.GetHTTPClientIPv4OnlyDomains(),
+		TLSInsecureSkipVerify: config.GetHTTPClientTLSInsecureSkipVerify(),
+	})
+
+	//nolint:contextcheck
+	federatingDB := federatingdb.New(&state,
+		typeutils.NewConverter(&state),
+		visibility.NewFilter(&state),
+		interaction.NewFilter(&state),
+		spam.NewFilter(&state),
+	)
+
+	// Dereference media
+	// as the instance account.
+	transportController := transport.NewController(&state, federatingDB, client)
+	transport, err := transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error creating transport: %w", err)
+	}
+
+	return &recache{
+		state:     &state,
+		manager:   media.NewManager(&state),
+		transport: transport,
+	}, nil
+}
+
+func (r *recache) shutdown() error {
+	err := r.state.DB.Close()
+	r.state.Caches.Stop()
+	return err
+}
+
+// parseRanges parses the given
+// IP range strings of given flag.
+func parseRanges(ips []string, flag string) ([]netip.Prefix, error) {
+	ranges := make([]netip.Prefix, len(ips))
+	for i, ip := range ips {
+		p, err := netip.ParsePrefix(ip)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s value %s: %w", flag, ip, err)
+		}
+		ranges[i] = p
+	}
+	return ranges, nil
+}
@@ -38,7 +38,9 @@
 	"code.superseriousbusiness.org/gotosocial/internal/cleaner"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db/bundb"
+	"code.superseriousbusiness.org/gotosocial/internal/describe"
 	"code.superseriousbusiness.org/gotosocial/internal/email"
+	"code.superseriousbusiness.org/gotosocial/internal/eventbus"
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
 	"code.superseriousbusiness.org/gotosocial/internal/federation/federatingdb"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
@@ -56,12 +58,15 @@
 	"code.superseriousbusiness.org/gotosocial/internal/oauth/handlers"
 	"code.superseriousbusiness.org/gotosocial/internal/observability"
 	"code.superseriousbusiness.org/gotosocial/internal/oidc"
+	"code.superseriousbusiness.org/gotosocial/internal/peers"
 	"code.superseriousbusiness.org/gotosocial/internal/processing"
 	"code.superseriousbusiness.org/gotosocial/internal/router"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	gtsstorage "code.superseriousbusiness.org/gotosocial/internal/storage"
 	"code.superseriousbusiness.org/gotosocial/internal/subscriptions"
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
 	"code.superseriousbusiness.org/gotosocial/internal/transport"
+	"code.superseriousbusiness.org/gotosocial/internal/transport/delivery"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/web"
 	"code.superseriousbusiness.org/gotosocial/internal/webpush"
@@ -143,6 +148,12 @@ func Start(ctx context.Context) error {
 		// Noop on unstarted workers.
 		state.Workers.Stop()
 
+		// Publish any events still queued
+		// now workers can't queue more.
+		//
+		// Noop if no event bus.
+		state.EventBus.Stop()
+
 		if process != nil {
 			const timeout = time.Minute
 
@@ -161,6 +172,20 @@ func Start(ctx context.Context) error {
 		}
 
 		if state.DB != nil {
+			const timeout = 30 * time.Second
+
+			// Use a new timeout context, as
+			// the main ctx is likely canceled.
+			ctx := context.WithoutCancel(ctx)
+			ctx, cncl := context.WithTimeout(ctx, timeout)
+			defer cncl()
+
+			// Write any federation stats
+			// counted since the last flush.
+			if err := state.FedStats.Flush(ctx, state.DB); err != nil {
+				log.Errorf(ctx, "error flushing federation stats: %v", err)
+			}
+
 			// Lastly, if database service was started,
 			// ensure it gets closed now all else stopped.
 			if err := state.DB.Close(); err != nil {
@@ -249,6 +274,14 @@ func Start(ctx context.Context) error {
 		AllowRanges:           ranges.allow,
 		BlockRanges:           ranges.block,
 		Timeout:               config.GetHTTPClientTimeout(),
+		MaxIdleConnsPerHost:   config.GetHTTPClientMaxIdleConnsPerHost(),
+		IdleConnTimeout:       config.GetHTTPClientIdleConnTimeout(),
+		DisableHTTP2:          config.GetHTTPClientDisableHTTP2(),
+		DNSCacheTTL:           config.GetHTTPClientDNSCacheTTL(),
+		DNSCacheNegativeTTL:   config.GetHTTPClientDNSCacheNegativeTTL(),
+		PreferIPFamily:        config.GetHTTPClientIPFamilyPreference(),
+		FallbackDelay:         config.GetHTTPClientHappyEyeballsDelay(),
+		IPv4OnlyDomains:       config.GetHTTPClientIPv4OnlyDomains(),
 		TLSInsecureSkipVerify: config.GetHTTPClientTLSInsecureSkipVerify(),
 	})
 
@@ -316,6 +349,19 @@ func Start(ctx context.Context) error {
 	// Create a Web Push notification sender.
 	webPushSender := webpush.NewSender(client, state, typeConverter)
 
+	// Create a status translator, nil if
+	// no translation provider is configured.
+	translator := translate.New(client)
+
+	// Create a media describer, nil if no
+	// describe provider is configured.
+	describer := describe.New(client)
+
+	// Create and start the lifecycle event bus,
+	// nil if no event bus provider is configured.
+	state.EventBus = eventbus.New()
+	state.EventBus.Start()
+
 	// Start the job scheduler
 	// (this is required for cleaner).
 	state.Workers.StartScheduler()
@@ -327,13 +373,28 @@ func Start(ctx context.Context) error {
 		"@cachesweep", // id
 		time.Time{},   // start
 		time.Minute,   // freq
-		func(context.Context, time.Time) {
+		func(context.Context, time.Time) error {
 			state.Caches.Sweep(60)
+			return nil
 		},
 	) {
 		return fmt.Errorf("error scheduling cache sweep: %w", err)
 	}
 
+	// Add a task to the scheduler to flush
+	// federation stats to the database.
+	// Frequency = 1 * minute
+	if !state.Workers.Scheduler.AddRecurring(
+		"@fedstatsflush", // id
+		time.Time{},      // start
+		time.Minute,      // freq
+		func(ctx context.Context, _ time.Time) error {
+			return state.FedStats.Flush(ctx, state.DB)
+		},
+	) {
+		return errors.New("error scheduling federation stats flush")
+	}
+
 	// Create background cleaner.
 	cleaner := cleaner.New(state)
 
@@ -344,6 +405,12 @@ func(context.Context, time.Time) {
 		typeConverter,
 	)
 
+	// Create instance peers crawler.
+	peers := peers.New(
+		state,
+		transportController,
+	)
+
 	// Create the processor using all the
 	// other services we've created so far.
 	process = processing.NewProcessor(
@@ -356,6 +423,8 @@ func(context.Context, time.Time) {
 		state,
 		emailSender,
 		webPushSender,
+		translator,
+		describer,
 		visFilter,
 		muteFilter,
 		intFilter,
@@ -372,10 +441,29 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error scheduling subscriptions jobs: %w", err)
 	}
 
+	// Schedule background peers crawling.
+	if err := peers.ScheduleJobs(); err != nil {
+		return fmt.Errorf("error scheduling peers jobs: %w", err)
+	}
+
+	// Schedule background sitemap generation.
+	if err := process.SitemapScheduleGeneration(); err != nil {
+		return fmt.Errorf("error scheduling sitemap generation: %w", err)
+	}
+
+	// Schedule background blocklist subscriptions refresh.
+	if err := process.Account().BlocklistSubscriptionsScheduleRefresh(); err != nil {
+		return fmt.Errorf("error scheduling blocklist subscriptions refresh: %w", err)
+	}
+
 	// Initialize the specialized workers pools.
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
 	state.Workers.Delivery.Init(client)
+	state.Workers.Delivery.Timeout = config.GetHTTPClientDeliveryTimeout()
+	state.Workers.Delivery.Hold = func(ctx context.Context, dlv *delivery.Delivery) (bool, error) {
+		return transport.HoldPaused(ctx, state, dlv)
+	}
 	state.Workers.Client.Process = process.Workers().ProcessFromClientAPI
 	state.Workers.Federator.Process = process.Workers().ProcessFromFediAPI
 
@@ -392,6 +480,16 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error scheduling status publications: %w", err)
 	}
 
+	// Schedule deletion tasks for all expiring statuses.
+	if err := process.Status().ExpiringStatusesScheduleAll(ctx); err != nil {
+		return fmt.Errorf("error scheduling status expiries: %w", err)
+	}
+
+	// Resume status cleanups interrupted by shutdown.
+	if err := process.Status().CleanupsResumeAll(ctx); err != nil {
+		return fmt.Errorf("error resuming status cleanups: %w", err)
+	}
+
 	// Initialize metrics.
 	if err := observability.InitializeMetrics(ctx, state); err != nil {
 		return fmt.Errorf("error initializing metrics: %w", err)
@@ -438,6 +536,7 @@ func(context.Context, time.Time) {
 		// note: hooks adding ctx fields must be ABOVE
 		// the logger, otherwise won't be accessible.
 		middleware.Logger(config.GetLogClientIP()),
+		middleware.Drain(state),
 		middleware.HeaderFilter(state),
 		middleware.UserAgent(),
 		middleware.CORS(),
@@ -576,11 +675,29 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error filling worker queues: %w", err)
 	}
 
-	// catch shutdown signals from the operating system
+	// Resume any deliveries still held back by federation pauses
+	// that were lifted, eg., if we stopped part way through resuming.
+	if err := process.Admin().ResumePausedDeliveries(ctx); err != nil {
+		return fmt.Errorf("error resuming paused deliveries: %w", err)
+	}
+
+	// catch shutdown signals from the operating system,
+	// or a drain request from the admin API; either way,
+	// we drain on return by stopping the router (letting
+	// in-flight requests finish) and persisting queues.
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-	sig := <-sigs // block until signal received
-	log.Infof(ctx, "received signal %s, shutting down", sig)
+	select {
+	case sig := <-sigs:
+		log.Infof(ctx, "received signal %s, shutting down", sig)
+	case <-state.Drain.Done():
+		log.Info(ctx, "drain requested, shutting down")
+	}
+
+	// Reject any new requests
+	// that make it to the router
+	// while it's being stopped.
+	state.Drain.Start()
 
 	return nil
 }
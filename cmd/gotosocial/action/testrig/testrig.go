@@ -26,6 +26,7 @@
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	"code.superseriousbusiness.org/gopkg/log"
@@ -136,6 +137,9 @@ func Start(ctx context.Context) error {
 	// Populate database tables + data if required.
 	if !config.GetTestrigSkipDBSetup() {
 		testrig.StandardDBSetup(state.DB, nil)
+		if config.GetTestrigClientFixtures() {
+			testrig.ClientFixturesDBSetup(state.DB)
+		}
 	}
 
 	// Get the instance account (we'll need this later).
@@ -154,6 +158,9 @@ func Start(ctx context.Context) error {
 		state.Storage = testrig.NewInMemoryStorage()
 	}
 	testrig.StandardStorageSetup(state.Storage, "./testrig/media")
+	if config.GetTestrigClientFixtures() {
+		testrig.ClientFixturesStorageSetup(state.Storage, "./testrig/media")
+	}
 
 	// build backend handlers
 	httpClient := testrig.NewMockHTTPClient(nil, "./testrig/media")
@@ -199,6 +206,7 @@ func Start(ctx context.Context) error {
 
 	middlewares = append(middlewares, []gin.HandlerFunc{
 		middleware.Logger(config.GetLogClientIP()),
+		middleware.Drain(state),
 		middleware.HeaderFilter(state),
 		middleware.UserAgent(),
 		middleware.CORS(),
@@ -283,6 +291,11 @@ func Start(ctx context.Context) error {
 	activityPubModule.RoutePublicKey(route)
 	webModule.Route(route)
 
+	// Attach the testrig reset endpoint,
+	// so client developers can get back to
+	// a known state between integration tests.
+	route.AttachHandler(http.MethodPost, resetPath, resetHandler(state))
+
 	// Create background cleaner.
 	cleaner := cleaner.New(state)
 
@@ -311,8 +324,56 @@ func Start(ctx context.Context) error {
 	// catch shutdown signals from the operating system
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
-	sig := <-sigs
-	log.Infof(ctx, "received signal %s, shutting down", sig)
+	select {
+	case sig := <-sigs:
+		log.Infof(ctx, "received signal %s, shutting down", sig)
+	case <-state.Drain.Done():
+		log.Info(ctx, "drain requested, shutting down")
+	}
+	state.Drain.Start()
 
 	return nil
 }
+
+// resetPath is the path of the testrig-only endpoint
+// which resets the database and storage back to the
+// same state they were in when the testrig started.
+const resetPath = "/testrig/reset"
+
+// resetHandler returns a handler which drops and repopulates
+// the testrig database and storage, then clears all caches.
+//
+// Workers are left running, so any side effects still queued
+// from before the reset may fail harmlessly once it's done.
+func resetHandler(state *state.State) gin.HandlerFunc {
+	var mu sync.Mutex
+	return func(c *gin.Context) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		ctx := c.Request.Context()
+		log.Info(ctx, "resetting testrig database and storage")
+
+		testrig.DropTestTables(state.DB)
+		testrig.StandardDBSetup(state.DB, nil)
+		if config.GetTestrigClientFixtures() {
+			testrig.ClientFixturesDBSetup(state.DB)
+		}
+
+		testrig.StandardStorageTeardown(state.Storage)
+		testrig.StandardStorageSetup(state.Storage, "./testrig/media")
+		if config.GetTestrigClientFixtures() {
+			testrig.ClientFixturesStorageSetup(state.Storage, "./testrig/media")
+		}
+
+		// Clear every cache so nothing
+		// from before the reset lingers.
+		for _, name := range state.Caches.ClearNames() {
+			if err := state.Caches.ClearNamed(name); err != nil {
+				log.Errorf(ctx, "error clearing cache %s: %v", name, err)
+			}
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -67,6 +67,19 @@ func adminCommands() *cobra.Command {
 	}
 	adminAccountCmd.AddCommand(adminAccountListCmd)
 
+	adminAccountSearchCmd := &cobra.Command{
+		Use:   "search",
+		Short: "list existing local accounts with username or email address containing the given query",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Search)
+		},
+	}
+	config.AddAdminAccountSearch(adminAccountSearchCmd)
+	adminAccountCmd.AddCommand(adminAccountSearchCmd)
+
 	adminAccountConfirmCmd := &cobra.Command{
 		Use:   "confirm",
 		Short: "confirm an existing local account manually, thereby skipping email confirmation",
@@ -82,7 +95,7 @@ func adminCommands() *cobra.Command {
 
 	adminAccountPromoteCmd := &cobra.Command{
 		Use:   "promote",
-		Short: "promote a local account to admin",
+		Short: "promote a local account to admin or moderator",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return preRun(preRunArgs{cmd: cmd})
 		},
@@ -90,12 +103,12 @@ func adminCommands() *cobra.Command {
 			return run(cmd.Context(), account.Promote)
 		},
 	}
-	config.AddAdminAccount(adminAccountPromoteCmd)
+	config.AddAdminAccountPromote(adminAccountPromoteCmd)
 	adminAccountCmd.AddCommand(adminAccountPromoteCmd)
 
 	adminAccountDemoteCmd := &cobra.Command{
 		Use:   "demote",
-		Short: "demote a local account from admin to normal user",
+		Short: "demote a local account from admin or moderator to normal user",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return preRun(preRunArgs{cmd: cmd})
 		},
@@ -159,6 +172,44 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccount(adminAccountDisable2FACmd)
 	adminAccountCmd.AddCommand(adminAccountDisable2FACmd)
 
+	adminAccountResetPasswordCmd := &cobra.Command{
+		Use:   "reset-password",
+		Short: "send a password reset email to the given local account, and revoke all its access tokens",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.ResetPassword)
+		},
+	}
+	config.AddAdminAccount(adminAccountResetPasswordCmd)
+	adminAccountCmd.AddCommand(adminAccountResetPasswordCmd)
+
+	adminAccountTokensCmd := &cobra.Command{
+		Use:   "tokens",
+		Short: "list the access tokens (ie., signed in apps and sessions) of the given local account",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Tokens)
+		},
+	}
+	config.AddAdminAccount(adminAccountTokensCmd)
+	adminAccountCmd.AddCommand(adminAccountTokensCmd)
+
+	adminAccountRecountCmd := &cobra.Command{
+		Use:   "recount",
+		Short: "recompute the follower, following, and status counts of all accounts from scratch, correcting any drift",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Recount)
+		},
+	}
+	adminAccountCmd.AddCommand(adminAccountRecountCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
@@ -230,6 +281,49 @@ func adminCommands() *cobra.Command {
 	config.AddAdminMediaList(adminMediaListEmojisLocalCmd)
 	adminMediaCmd.AddCommand(adminMediaListEmojisLocalCmd)
 
+	/*
+		ADMIN MEDIA INSPECT / RECACHE COMMANDS
+	*/
+
+	adminMediaInspectCmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "print stored metadata, storage paths and error details of a media attachment",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), media.Inspect)
+		},
+	}
+	config.AddAdminMediaInspect(adminMediaInspectCmd)
+	adminMediaCmd.AddCommand(adminMediaInspectCmd)
+
+	adminMediaRecacheCmd := &cobra.Command{
+		Use:   "recache",
+		Short: "force re-download and re-processing of a remote media attachment, or of all media attachments from a domain",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), media.Recache)
+		},
+	}
+	config.AddAdminMediaRecache(adminMediaRecacheCmd)
+	adminMediaCmd.AddCommand(adminMediaRecacheCmd)
+
+	adminMediaReprocessEmojisCmd := &cobra.Command{
+		Use:   "reprocess-emojis",
+		Short: "re-process all stored local, remote, or all emojis from their original images, regenerating static images and applying current emoji max sizes",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), media.ReprocessEmojis)
+		},
+	}
+	config.AddAdminMediaList(adminMediaReprocessEmojisCmd)
+	adminMediaCmd.AddCommand(adminMediaReprocessEmojisCmd)
+
 	/*
 		ADMIN MEDIA PRUNE COMMANDS
 	*/
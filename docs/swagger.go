@@ -26,18 +26,35 @@
 //   - admin: grants admin access to everything
 //   - admin:read: grants admin read access to everything
 //   - admin:read:accounts: grants admin read access to accounts
+//   - admin:read:announcements: grants admin read access to announcements
 //   - admin:read:custom_emojis: grants admin read access to custom emojis
+//   - admin:read:debug: grants admin read access to debug endpoints
 //   - admin:read:domain_allows: grants admin read access to domain allows
 //   - admin:read:domain_blocks: grants admin read access to domain blocks
 //   - admin:read:domain_limits: grants admin read access to domain limits
+//   - admin:read:domain_permissions: grants admin read access to domain permission drafts, excludes and subscriptions
+//   - admin:read:federation: grants admin read access to federation management (relays, failed activities, domain keys)
+//   - admin:read:header_filters: grants admin read access to HTTP header filters
+//   - admin:read:instance: grants admin read access to instance settings and rules
+//   - admin:read:invites: grants admin read access to invites
 //   - admin:read:reports: grants admin read access to reports
+//   - admin:read:tags: grants admin read access to tag management
 //   - admin:write: grants admin write access to everything
-//   - admin:write:accounts: grants write read access to accounts
+//   - admin:write:accounts: grants admin write access to accounts
+//   - admin:write:announcements: grants admin write access to announcements
 //   - admin:write:custom_emojis: grants admin write access to custom emojis
+//   - admin:write:debug: grants admin write access to debug endpoints
 //   - admin:write:domain_allows: grants admin write access to domain allows
 //   - admin:write:domain_blocks: grants admin write access to domain blocks
 //   - admin:write:domain_limits: grants admin write access to domain limits
+//   - admin:write:domain_permissions: grants admin write access to domain permission drafts, excludes and subscriptions
+//   - admin:write:federation: grants admin write access to federation management (relays, failed activities, domain keys)
+//   - admin:write:header_filters: grants admin write access to HTTP header filters
+//   - admin:write:instance: grants admin write access to instance settings and rules
+//   - admin:write:invites: grants admin write access to invites
+//   - admin:write:media: grants admin write access to media management
 //   - admin:write:reports: grants admin write access to reports
+//   - admin:write:tags: grants admin write access to tag management
 //   - profile: grants read access to verify_credentials
 //   - push: grants read/write access to push
 //   - read: grants read access to everything
@@ -89,18 +106,35 @@
 //	      admin: grants admin access to everything
 //	      admin:read: grants admin read access to everything
 //	      admin:read:accounts: grants admin read access to accounts
+//	      admin:read:announcements: grants admin read access to announcements
 //	      admin:read:custom_emojis: grants admin read access to custom emojis
+//	      admin:read:debug: grants admin read access to debug endpoints
 //	      admin:read:domain_allows: grants admin read access to domain allows
 //	      admin:read:domain_blocks: grants admin read access to domain blocks
 //	      admin:read:domain_limits: grants admin read access to domain limits
+//	      admin:read:domain_permissions: grants admin read access to domain permission drafts, excludes and subscriptions
+//	      admin:read:federation: grants admin read access to federation management (relays, failed activities, domain keys)
+//	      admin:read:header_filters: grants admin read access to HTTP header filters
+//	      admin:read:instance: grants admin read access to instance settings and rules
+//	      admin:read:invites: grants admin read access to invites
 //	      admin:read:reports: grants admin read access to reports
+//	      admin:read:tags: grants admin read access to tag management
 //	      admin:write: grants admin write access to everything
-//	      admin:write:accounts: grants write read access to accounts
+//	      admin:write:accounts: grants admin write access to accounts
+//	      admin:write:announcements: grants admin write access to announcements
 //	      admin:write:custom_emojis: grants admin write access to custom emojis
+//	      admin:write:debug: grants admin write access to debug endpoints
 //	      admin:write:domain_allows: grants admin write access to domain allows
 //	      admin:write:domain_blocks: grants admin write access to domain blocks
 //	      admin:write:domain_limits: grants admin write access to domain limits
+//	      admin:write:domain_permissions: grants admin write access to domain permission drafts, excludes and subscriptions
+//	      admin:write:federation: grants admin write access to federation management (relays, failed activities, domain keys)
+//	      admin:write:header_filters: grants admin write access to HTTP header filters
+//	      admin:write:instance: grants admin write access to instance settings and rules
+//	      admin:write:invites: grants admin write access to invites
+//	      admin:write:media: grants admin write access to media management
 //	      admin:write:reports: grants admin write access to reports
+//	      admin:write:tags: grants admin write access to tag management
 //	      profile: grants read access to verify_credentials
 //	      push: grants read/write access to push
 //	      read: grants read access to everything
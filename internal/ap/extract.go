@@ -856,7 +856,8 @@ func ExtractAttachment(i Attachmentable) (*gtsmodel.MediaAttachment, error) {
 		Description: ExtractDescription(i),
 		Blurhash:    ExtractBlurhash(i),
 		FileMeta: gtsmodel.FileMeta{
-			Focus: ExtractFocus(i),
+			Original: ExtractDimensions(i),
+			Focus:    ExtractFocus(i),
 		},
 	}, nil
 }
@@ -928,6 +929,47 @@ func ExtractFocus(attachmentable Attachmentable) gtsmodel.Focus {
 	return focus
 }
 
+// ExtractDimensions parses the width and height in pixels of
+// the given Attachmentable into a gtsmodel.Original, if set.
+// ActivityStreams only defines width + height for Image and
+// Link, but implementations (e.g. Mastodon) also set them on
+// Document, in which case they're taken from unknown props.
+// Otherwise, returns a zero gtsmodel.Original.
+func ExtractDimensions(attachmentable Attachmentable) gtsmodel.Original {
+	var width, height int
+
+	if withDimensions, ok := attachmentable.(WithDimensions); ok {
+		if prop := withDimensions.GetActivityStreamsWidth(); prop != nil &&
+			prop.IsXMLSchemaNonNegativeInteger() {
+			width = prop.Get()
+		}
+		if prop := withDimensions.GetActivityStreamsHeight(); prop != nil &&
+			prop.IsXMLSchemaNonNegativeInteger() {
+			height = prop.Get()
+		}
+	} else if withUnknown, ok := attachmentable.(WithUnknownProperties); ok {
+		unknown := withUnknown.GetUnknownProperties()
+
+		// Numbers from JSON come in as float64.
+		if w, ok := unknown["width"].(float64); ok && w > 0 {
+			width = int(w)
+		}
+		if h, ok := unknown["height"].(float64); ok && h > 0 {
+			height = int(h)
+		}
+	}
+
+	if width <= 0 || height <= 0 {
+		return gtsmodel.Original{}
+	}
+
+	return gtsmodel.Original{
+		Width:  width,
+		Height: height,
+		Aspect: float32(width) / float32(height),
+	}
+}
+
 // ExtractHashtags extracts a slice of minimal gtsmodel.Tags
 // from a WithTag. If an entry in the WithTag is not a hashtag,
 // or has a name that cannot be normalized, it will be ignored.
@@ -977,9 +1019,9 @@ func ExtractHashtags(i WithTag) ([]*gtsmodel.Tag, error) {
 			continue
 		}
 
-		// We store tag names lowercased, might
-		// as well change case here already.
-		tag.Name = strings.ToLower(normalized)
+		// We store tag names case-folded, might
+		// as well fold the name here already.
+		tag.Name = text.FoldHashtag(normalized)
 
 		// Only append this tag if we haven't
 		// seen it already, to avoid duplicates
@@ -1248,6 +1290,45 @@ func ExtractObjectURIs(withObject WithObject) ([]*url.URL, error) {
 	return urls, nil
 }
 
+// ExtractGroupAnnounceObjectURIs extracts the URIs of objects
+// Announced by a group actor, (eg., a Lemmy or kbin community).
+//
+// As per FEP-1b12, groups Announce the *activities* of their
+// members, rather than just the objects. So for each wrapped
+// Create activity, this returns the URIs of the created objects.
+// Other wrapped activities (eg., Update, Like, Delete) are skipped,
+// as forwards of these are not supported. Any plain object or IRI
+// is returned as-is, as some groups Announce objects directly.
+func ExtractGroupAnnounceObjectURIs(announce WithObject) []*url.URL {
+	objectProp := announce.GetActivityStreamsObject()
+	if objectProp == nil {
+		return nil
+	}
+
+	urls := make([]*url.URL, 0, objectProp.Len())
+	for iter := objectProp.Begin(); iter != objectProp.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil || !IsActivityable(t.GetTypeName()) {
+			// Plain object or IRI.
+			if id, err := pub.ToId(iter); err == nil {
+				urls = append(urls, id)
+			}
+			continue
+		}
+
+		if t.GetTypeName() != ActivityCreate {
+			// Unsupported wrapped activity.
+			continue
+		}
+
+		if create, ok := t.(WithObject); ok {
+			urls = append(urls, GetObjectIRIs(create)...)
+		}
+	}
+
+	return urls
+}
+
 // ExtractVisibility extracts the gtsmodel.Visibility
 // of a given addressable with a To and CC property.
 //
@@ -1329,6 +1410,7 @@ func ExtractInteractionPolicy(
 		CanLike:     extractCanLike(policy.GetGoToSocialCanLike(), owner),
 		CanReply:    extractCanReply(policy.GetGoToSocialCanReply(), owner),
 		CanAnnounce: extractCanAnnounce(policy.GetGoToSocialCanAnnounce(), owner),
+		CanQuote:    extractCanQuote(policy.GetGoToSocialCanQuote(), owner),
 	}
 }
 
@@ -1404,6 +1486,30 @@ func extractCanAnnounce(
 	return extractPolicyRules(withRules, owner)
 }
 
+// Returns either a parsed CanQuote sub-policy, or nil
+// if canQuote is not set, ie., if this post is from an
+// instance that doesn't know / care about canQuote.
+func extractCanQuote(
+	prop vocab.GoToSocialCanQuoteProperty,
+	owner *gtsmodel.Account,
+) *gtsmodel.PolicyRules {
+	if prop == nil || prop.Len() != 1 {
+		return nil
+	}
+
+	propIter := prop.At(0)
+	if !propIter.IsGoToSocialCanQuote() {
+		return nil
+	}
+
+	withRules := propIter.Get()
+	if withRules == nil {
+		return nil
+	}
+
+	return extractPolicyRules(withRules, owner)
+}
+
 func extractPolicyRules(
 	withRules WithPolicyRules,
 	owner *gtsmodel.Account,
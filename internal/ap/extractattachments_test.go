@@ -76,6 +76,46 @@ func (suite *ExtractAttachmentsTestSuite) TestExtractDescription() {
 	suite.Equal("A very large panel that is entirely twist switches", attachment.Description)
 }
 
+func (suite *ExtractAttachmentsTestSuite) TestExtractDimensions() {
+	// Note: normally a single attachment on a Note or
+	// similar wouldn't have the `@context` field set,
+	// but we set it here because we're parsing it as
+	// a discrete/standalone AP Object for this test.
+	attachmentableJSON := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "mediaType": "image/jpeg",
+  "type": "Document",
+  "url": "https://example.org/d/XzKw4M2Sc1pBxj3hY4.jpg",
+  "blurhash": "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH",
+  "width": 1200,
+  "height": 800
+}`
+
+	raw := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(attachmentableJSON), &raw); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(suite.T().Context(), raw)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	attachmentable, ok := t.(ap.Attachmentable)
+	if !ok {
+		suite.FailNow("type was not Attachmentable")
+	}
+
+	attachment, err := ap.ExtractAttachment(attachmentable)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(1200, attachment.FileMeta.Original.Width)
+	suite.Equal(800, attachment.FileMeta.Original.Height)
+	suite.InDelta(1.5, attachment.FileMeta.Original.Aspect, 0.001)
+}
+
 func TestExtractAttachmentsTestSuite(t *testing.T) {
 	suite.Run(t, &ExtractAttachmentsTestSuite{})
 }
@@ -66,6 +66,11 @@ func (suite *ExtractPolicyTestSuite) TestExtractPolicy() {
       "manualApproval": [
         "https://www.w3.org/ns/activitystreams#Public"
       ]
+    },
+    "canQuote": {
+      "automaticApproval": [
+        "http://localhost:8080/users/the_mighty_zork/followers"
+      ]
     }
   },
   "tag": [
@@ -126,6 +131,12 @@ func (suite *ExtractPolicyTestSuite) TestExtractPolicy() {
 				gtsmodel.PolicyValuePublic,
 			},
 		},
+		CanQuote: &gtsmodel.PolicyRules{
+			AutomaticApproval: gtsmodel.PolicyValues{
+				gtsmodel.PolicyValueFollowers,
+			},
+			ManualApproval: gtsmodel.PolicyValues{},
+		},
 	}
 	suite.EqualValues(expectedPolicy, policy)
 }
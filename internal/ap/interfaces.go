@@ -258,6 +258,9 @@ type Accountable interface {
 	WithFeatured
 	WithMovedTo
 	WithAlsoKnownAs
+	WithAttributedTo
+	WithLocation
+	WithUnknownProperties
 	WithManuallyApprovesFollowers
 	WithHidesToPublicFromUnauthedWeb
 	WithHidesCcPublicFromUnauthedWeb
@@ -625,6 +628,12 @@ type WithAttributedTo interface {
 	SetActivityStreamsAttributedTo(vocab.ActivityStreamsAttributedToProperty)
 }
 
+// WithLocation represents an object with ActivityStreamsLocationProperty
+type WithLocation interface {
+	GetActivityStreamsLocation() vocab.ActivityStreamsLocationProperty
+	SetActivityStreamsLocation(vocab.ActivityStreamsLocationProperty)
+}
+
 // WithAttachment represents an activity with ActivityStreamsAttachmentProperty
 type WithAttachment interface {
 	GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty
@@ -703,6 +712,19 @@ type WithFocalPoint interface {
 	SetTootFocalPoint(vocab.TootFocalPointProperty)
 }
 
+// WithDimensions represents an object with
+// ActivityStreamsWidthProperty and ActivityStreamsHeightProperty.
+type WithDimensions interface {
+	GetActivityStreamsWidth() vocab.ActivityStreamsWidthProperty
+	GetActivityStreamsHeight() vocab.ActivityStreamsHeightProperty
+}
+
+// WithUnknownProperties represents an object
+// that keeps properties not defined for its type.
+type WithUnknownProperties interface {
+	GetUnknownProperties() map[string]interface{}
+}
+
 // WithHref represents an activity with ActivityStreamsHrefProperty
 type WithHref interface {
 	GetActivityStreamsHref() vocab.ActivityStreamsHrefProperty
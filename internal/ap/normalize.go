@@ -742,6 +742,9 @@ func NormalizeOutgoingObjectProp(item WithObject, rawJSON map[string]interface{}
 		switch tn := objectType.GetTypeName(); {
 		case IsAccountable(tn):
 			objectSer, err = serializeAccountable(objectType, false)
+			if err == nil {
+				NormalizeOutgoingPronounsContext(rawJSON, objectSer)
+			}
 
 		case IsStatusable(tn):
 			// IsStatusable includes Pollable as well.
@@ -887,6 +890,49 @@ func NormalizeOutgoingOneOrAnyOfProp(item interface {
 	coercePropertyToArray(rawJSON, "anyOf")
 }
 
+// NormalizeOutgoingPronounsContext adds json-ld `@context`
+// definitions of the pronouns term to rawJSON, if the pronouns
+// term is used by objectJSON. Since pronouns are set as unknown
+// properties, go-fed doesn't know to define them by itself.
+//
+// objectJSON should be rawJSON itself for top-level accountables,
+// or the serialized object of rawJSON for wrapping activities.
+//
+// Noop if rawJSON has no `@context` or pronouns aren't set.
+func NormalizeOutgoingPronounsContext(rawJSON map[string]interface{}, objectJSON map[string]interface{}) {
+	if _, ok := objectJSON[PropPronouns]; !ok {
+		// Nothing to do.
+		return
+	}
+
+	terms := map[string]interface{}{
+		"schema":     "http://schema.org#",
+		PropPronouns: SchemaPronouns,
+	}
+
+	switch jsonCtx := rawJSON["@context"].(type) {
+	case string:
+		rawJSON["@context"] = []interface{}{jsonCtx, terms}
+
+	case map[string]interface{}:
+		for k, v := range terms {
+			jsonCtx[k] = v
+		}
+
+	case []interface{}:
+		for _, entry := range jsonCtx {
+			if defs, ok := entry.(map[string]interface{}); ok {
+				for k, v := range terms {
+					defs[k] = v
+				}
+				return
+			}
+		}
+
+		rawJSON["@context"] = append(jsonCtx, terms)
+	}
+}
+
 // coercePropertyToArray coerces the property contained at key in map
 // to an array if it isn't already, i.e. if not an []interface{} type.
 func coercePropertyToArray(data map[string]interface{}, key string) {
@@ -478,6 +478,57 @@ func SetAlsoKnownAs(with WithAlsoKnownAs, alsoKnownAs []*url.URL) {
 	}, alsoKnownAs...)
 }
 
+// GetLocationName returns the name of the first
+// Place contained in the location property of 'with'.
+func GetLocationName(with WithLocation) string {
+	locationProp := with.GetActivityStreamsLocation()
+	if locationProp == nil {
+		return ""
+	}
+	for iter := locationProp.Begin(); iter != locationProp.End(); iter = iter.Next() {
+		if !iter.IsActivityStreamsPlace() {
+			continue
+		}
+		if name := ExtractName(iter.GetActivityStreamsPlace()); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// SetLocationName sets a Place with the given
+// name on the location property of 'with'.
+func SetLocationName(with WithLocation, name string) {
+	place := streams.NewActivityStreamsPlace()
+	AppendName(place, name)
+	locationProp := streams.NewActivityStreamsLocationProperty()
+	locationProp.AppendActivityStreamsPlace(place)
+	with.SetActivityStreamsLocation(locationProp)
+}
+
+const (
+	// PropPronouns is the json-ld term used for an
+	// actor's pronouns, defined as schema:pronouns.
+	PropPronouns = "pronouns"
+
+	// SchemaPronouns is the expanded iri of PropPronouns.
+	SchemaPronouns = "schema:pronouns"
+)
+
+// GetPronouns returns the pronouns property of 'with', if set.
+//
+// Pronouns are not part of any vocabulary known to go-fed,
+// so they're kept among the unknown properties of 'with'.
+func GetPronouns(with WithUnknownProperties) string {
+	pronouns, _ := with.GetUnknownProperties()[PropPronouns].(string)
+	return pronouns
+}
+
+// SetPronouns sets the given pronouns on the pronouns property of 'with'.
+func SetPronouns(with WithUnknownProperties, pronouns string) {
+	with.GetUnknownProperties()[PropPronouns] = pronouns
+}
+
 // GetPublished returns the time contained in the Published property of 'with'.
 func GetPublished(with WithPublished) time.Time {
 	publishProp := with.GetActivityStreamsPublished()
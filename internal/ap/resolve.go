@@ -58,14 +58,16 @@ func ResolveIncomingActivity(r *http.Request) (pub.Activity, bool, gtserror.With
 		}
 
 		const text = "body json not resolvable as ActivityStreams type"
-		return nil, false, gtserror.NewErrorBadRequest(errors.New(text), text)
+		errWithCode := gtserror.NewErrorBadRequest(errors.New(text), text)
+		return nil, false, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeActivityMalformed)
 	}
 
 	// Ensure this is an Activity type.
 	activity, ok := t.(pub.Activity)
 	if !ok {
 		text := fmt.Sprintf("cannot resolve vocab type %T as pub.Activity", t)
-		return nil, false, gtserror.NewErrorBadRequest(errors.New(text), text)
+		errWithCode := gtserror.NewErrorBadRequest(errors.New(text), text)
+		return nil, false, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeActivityMalformed)
 	}
 
 	if activity.GetJSONLDId() == nil {
@@ -36,7 +36,7 @@
 //
 //   - OrderedCollection:       'orderedItems' property will always be made into an array.
 //   - OrderedCollectionPage:   'orderedItems' property will always be made into an array.
-//   - Any Accountable type:    'attachment' property will always be made into an array.
+//   - Any Accountable type:    'attachment' property will always be made into an array; 'pronouns' will be defined in '@context'.
 //   - Any Statusable type:     'attachment' property will always be made into an array; 'content', 'contentMap', and 'interactionPolicy' will be normalized.
 //   - Any Activityable type:   any 'object's set on an activity will be custom serialized as above.
 func Serialize(t vocab.Type) (map[string]interface{}, error) {
@@ -94,6 +94,10 @@ func serializeAccountable(t vocab.Type, includeContext bool) (map[string]interfa
 	NormalizeOutgoingAttachmentProp(accountable, data)
 	NormalizeOutgoingAlsoKnownAsProp(accountable, data)
 
+	if includeContext {
+		NormalizeOutgoingPronounsContext(data, data)
+	}
+
 	return data, nil
 }
 
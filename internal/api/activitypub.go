@@ -18,6 +18,8 @@
 package api
 
 import (
+	"net/http"
+
 	"code.superseriousbusiness.org/gotosocial/internal/api/activitypub/emoji"
 	"code.superseriousbusiness.org/gotosocial/internal/api/activitypub/publickey"
 	"code.superseriousbusiness.org/gotosocial/internal/api/activitypub/users"
@@ -33,6 +35,7 @@ type ActivityPub struct {
 	users                    *users.Module
 	publicKey                *publickey.Module
 	signatureCheckMiddleware gin.HandlerFunc
+	tokenCheckMiddleware     gin.HandlerFunc
 }
 
 func (a *ActivityPub) Route(r *router.Router, m ...gin.HandlerFunc) {
@@ -51,6 +54,16 @@ func (a *ActivityPub) Route(r *router.Router, m ...gin.HandlerFunc) {
 
 	a.emoji.Route(emojiGroup.Handle)
 	a.users.Route(usersGroup.Handle)
+
+	// Outbox POST is the only users endpoint that
+	// accepts OAuth bearer tokens, for client-to-server
+	// requests, so only attach the token check there.
+	usersGroup.Handle(
+		http.MethodPost,
+		users.OutboxPath,
+		a.tokenCheckMiddleware,
+		a.users.OutboxPOSTHandler,
+	)
 }
 
 // Public key endpoint requires different middleware + cache policies from other AP endpoints.
@@ -75,5 +88,6 @@ func NewActivityPub(db db.DB, p *processing.Processor) *ActivityPub {
 		users:                    users.New(p),
 		publicKey:                publickey.New(p),
 		signatureCheckMiddleware: middleware.SignatureCheck(db.IsURIBlocked),
+		tokenCheckMiddleware:     middleware.TokenCheck(db, p.OAuthValidateBearerToken),
 	}
 }
@@ -0,0 +1,144 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package users_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type BlocklistGetTestSuite struct {
+	UserStandardTestSuite
+}
+
+// getBlocklist performs a GET of the admin account's
+// blocklist collection with given query, signed by
+// remote_account_1, returning the response code and
+// the indented JSON response body.
+func (suite *BlocklistGetTestSuite) getBlocklist(query string) (int, string) {
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	targetAccount := suite.testAccounts["admin_account"]
+	target := testrig.URLMustParse("http://localhost:8080/users/admin/collections/blocklist" + query)
+	sig, _, date := testrig.GetSignatureForDereference(requestingAccount.PublicKeyURI, requestingAccount.PrivateKey, target)
+
+	// setup request
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, target.String(), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/activity+json")
+	ctx.Request.Header.Set("Signature", sig)
+	ctx.Request.Header.Set("Date", date)
+
+	// we need to pass the context through signature check first to set appropriate values on it
+	suite.signatureCheck(ctx)
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   apiutil.UsernameKey,
+			Value: targetAccount.Username,
+		},
+	}
+
+	// trigger the function being tested
+	suite.userModule.BlocklistGETHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	if recorder.Code != http.StatusOK {
+		return recorder.Code, string(b)
+	}
+
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	return recorder.Code, dst.String()
+}
+
+func (suite *BlocklistGetTestSuite) TestGetBlocklist() {
+	ctx := suite.T().Context()
+	admin := suite.testAccounts["admin_account"]
+	turtle := suite.testAccounts["local_account_2"]
+
+	// Blocklist isn't published by default.
+	code, _ := suite.getBlocklist("")
+	suite.Equal(http.StatusNotFound, code)
+
+	// Have admin block turtle, and publish their blocks.
+	if err := suite.db.PutBlock(ctx, &gtsmodel.Block{
+		ID:              "01JR5B6QNKB2VFR5ZQZX5D4CJD",
+		URI:             "http://localhost:8080/users/admin/blocks/01JR5B6QNKB2VFR5ZQZX5D4CJD",
+		AccountID:       admin.ID,
+		TargetAccountID: turtle.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	settings, err := suite.db.GetAccountSettings(ctx, admin.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.PublishBlocks = util.Ptr(true)
+	if err := suite.db.UpdateAccountSettings(ctx, settings, "publish_blocks"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	code, body := suite.getBlocklist("")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "first": "http://localhost:8080/users/admin/collections/blocklist?limit=40",
+  "id": "http://localhost:8080/users/admin/collections/blocklist",
+  "totalItems": 1,
+  "type": "OrderedCollection"
+}`, body)
+
+	code, body = suite.getBlocklist("?limit=40")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://localhost:8080/users/admin/collections/blocklist?limit=40",
+  "next": "http://localhost:8080/users/admin/collections/blocklist?limit=40&max_id=01JR5B6QNKB2VFR5ZQZX5D4CJD",
+  "orderedItems": [
+    "http://localhost:8080/users/1happyturtle"
+  ],
+  "partOf": "http://localhost:8080/users/admin/collections/blocklist",
+  "prev": "http://localhost:8080/users/admin/collections/blocklist?limit=40&min_id=01JR5B6QNKB2VFR5ZQZX5D4CJD",
+  "totalItems": 1,
+  "type": "OrderedCollectionPage"
+}`, body)
+}
+
+func TestBlocklistGetTestSuite(t *testing.T) {
+	suite.Run(t, new(BlocklistGetTestSuite))
+}
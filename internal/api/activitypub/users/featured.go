@@ -21,6 +21,7 @@
 	"net/http"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"github.com/gin-gonic/gin"
 )
 
@@ -30,6 +31,10 @@
 //
 // The response will contain an ordered collection of Note URIs in the `items` property.
 //
+// If the user has many pinned posts, the collection will instead link to a first page
+// in its `first` property. If `page` is `true`, a single `CollectionPage` of Note URIs
+// will be returned without the wrapping `Collection`.
+//
 // It is up to the caller to dereference the provided Note URIs (or not, if they already have them cached).
 //
 // HTTP signature is required on the request.
@@ -48,6 +53,22 @@
 //		description: Account name of the user
 //		in: path
 //		required: true
+//	-
+//		name: page
+//		type: boolean
+//		description: Return response as a CollectionPage.
+//		in: query
+//		default: false
+//	-
+//		name: min_id
+//		type: string
+//		description: Minimum ID of the next pinned status, used for paging.
+//		in: query
+//	-
+//		name: max_id
+//		type: string
+//		description: Maximum ID of the next pinned status, used for paging.
+//		in: query
 //
 //	responses:
 //		'200':
@@ -83,7 +104,17 @@ func (m *Module) FeaturedCollectionGETHandler(c *gin.Context) {
 		return
 	}
 
-	resp, errWithCode := m.processor.Fedi().FeaturedCollectionGet(c.Request.Context(), username)
+	page, errWithCode := paging.ParseIDPage(c,
+		1,  // min limit
+		80, // max limit
+		0,  // default = disabled
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	resp, errWithCode := m.processor.Fedi().FeaturedCollectionGet(c.Request.Context(), username, page)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
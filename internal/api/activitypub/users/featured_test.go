@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package users_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeaturedGetTestSuite struct {
+	UserStandardTestSuite
+}
+
+// getFeatured performs a signed GET of the admin account's
+// featured collection with given dereference request and
+// query, returning the indented JSON response body.
+func (suite *FeaturedGetTestSuite) getFeatured(derefRequest string, query string) string {
+	derefRequests := testrig.NewTestDereferenceRequests(suite.testAccounts)
+	signedRequest := derefRequests[derefRequest]
+	targetAccount := suite.testAccounts["admin_account"]
+
+	// setup request
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, targetAccount.FeaturedCollectionURI+query, nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/activity+json")
+	ctx.Request.Header.Set("Signature", signedRequest.SignatureHeader)
+	ctx.Request.Header.Set("Date", signedRequest.DateHeader)
+
+	// we need to pass the context through signature check first to set appropriate values on it
+	suite.signatureCheck(ctx)
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   apiutil.UsernameKey,
+			Value: targetAccount.Username,
+		},
+	}
+
+	// trigger the function being tested
+	suite.userModule.FeaturedCollectionGETHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	return dst.String()
+}
+
+func (suite *FeaturedGetTestSuite) TestGetFeatured() {
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://localhost:8080/users/admin/collections/featured",
+  "orderedItems": [
+    "http://localhost:8080/users/admin/statuses/01F8MHAAY43M6RJ473VQFCVH37",
+    "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R"
+  ],
+  "totalItems": 2,
+  "type": "OrderedCollection"
+}`, suite.getFeatured("foss_satan_dereference_admin_featured", ""))
+}
+
+func (suite *FeaturedGetTestSuite) TestGetFeaturedFirstPage() {
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://localhost:8080/users/admin/collections/featured?limit=1",
+  "next": "http://localhost:8080/users/admin/collections/featured?limit=1&max_id=01F8MHAAY43M6RJ473VQFCVH37",
+  "orderedItems": [
+    "http://localhost:8080/users/admin/statuses/01F8MHAAY43M6RJ473VQFCVH37"
+  ],
+  "partOf": "http://localhost:8080/users/admin/collections/featured",
+  "prev": "http://localhost:8080/users/admin/collections/featured?limit=1&min_id=01F8MHAAY43M6RJ473VQFCVH37",
+  "totalItems": 2,
+  "type": "OrderedCollectionPage"
+}`, suite.getFeatured("foss_satan_dereference_admin_featured_first", "?limit=1"))
+}
+
+func (suite *FeaturedGetTestSuite) TestGetFeaturedNextPage() {
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://localhost:8080/users/admin/collections/featured?limit=1&max_id=01F8MHAAY43M6RJ473VQFCVH37",
+  "next": "http://localhost:8080/users/admin/collections/featured?limit=1&max_id=01F8MH75CBF9JFX4ZAD54N0W0R",
+  "orderedItems": [
+    "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R"
+  ],
+  "partOf": "http://localhost:8080/users/admin/collections/featured",
+  "prev": "http://localhost:8080/users/admin/collections/featured?limit=1&min_id=01F8MH75CBF9JFX4ZAD54N0W0R",
+  "totalItems": 2,
+  "type": "OrderedCollectionPage"
+}`, suite.getFeatured("foss_satan_dereference_admin_featured_next", "?limit=1&max_id=01F8MHAAY43M6RJ473VQFCVH37"))
+}
+
+func TestFeaturedGetTestSuite(t *testing.T) {
+	suite.Run(t, new(FeaturedGetTestSuite))
+}
@@ -30,6 +30,9 @@
 
 // InboxPOSTHandler deals with incoming POST requests to an actor's inbox.
 // Eg., POST to https://example.org/users/whatever/inbox.
+//
+// Rejected activities are responded to with an application/problem+json
+// body, including a machine-readable reason for the rejection.
 func (m *Module) InboxPOSTHandler(c *gin.Context) {
 	_, err := m.processor.Fedi().InboxPost(c.Request.Context(), c.Writer, c.Request)
 	if err != nil {
@@ -43,8 +46,9 @@ func (m *Module) InboxPOSTHandler(c *gin.Context) {
 			errWithCode = gtserror.NewErrorBadRequest(err)
 		}
 
-		// Pass along confirmed error with code to the main error handler
-		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		// Pass along confirmed error with code to the problem
+		// handler, so the remote gets a machine-readable reason.
+		apiutil.ProblemErrorHandler(c, errWithCode)
 		return
 	}
 
@@ -24,6 +24,7 @@
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -471,6 +472,77 @@ func (suite *InboxPostTestSuite) TestPostEmptyCreate() {
 	)
 }
 
+// TestPostCreateToTwoInboxes verifies that an activity
+// delivered to one account's inbox, and dropped there as
+// not relevant to that account, is still accepted when
+// the same activity is delivered to another account.
+func (suite *InboxPostTestSuite) TestPostCreateToTwoInboxes() {
+	var (
+		ctx               = suite.T().Context()
+		requestingAccount = suite.testAccounts["remote_account_1"]
+		otherAccount      = suite.testAccounts["admin_account"]
+		targetAccount     = suite.testAccounts["local_account_1"]
+		statusURI         = requestingAccount.URI + "/statuses/01JAQ5ZK6G9T2N4E2CE3BDTFWP"
+	)
+
+	// Note mentioning only target account.
+	mention := streams.NewActivityStreamsMention()
+	hrefProp := streams.NewActivityStreamsHrefProperty()
+	hrefProp.SetIRI(testrig.URLMustParse(targetAccount.URI))
+	mention.SetActivityStreamsHref(hrefProp)
+	nameProp := streams.NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString("@" + targetAccount.Username + "@localhost:8080")
+	mention.SetActivityStreamsName(nameProp)
+
+	note := testrig.NewAPNote(&testrig.NewAPNoteParams{
+		ID:           testrig.URLMustParse(statusURI),
+		URL:          testrig.URLMustParse(statusURI),
+		CreatedAt:    time.Now(),
+		Content:      "hey zork",
+		AttributedTo: testrig.URLMustParse(requestingAccount.URI),
+		To:           []*url.URL{testrig.URLMustParse(targetAccount.URI)},
+		Mentions:     []vocab.ActivityStreamsMention{mention},
+	})
+
+	create := testrig.WrapAPNoteInCreate(
+		testrig.URLMustParse(statusURI+"/activity"),
+		testrig.URLMustParse(requestingAccount.URI),
+		time.Now(),
+		note,
+	)
+
+	// Deliver to the other account first. The
+	// note isn't relevant to it, so is dropped.
+	suite.inboxPost(
+		create,
+		requestingAccount,
+		otherAccount,
+		http.StatusAccepted,
+		`{"status":"Accepted"}`,
+		suite.signatureCheck,
+	)
+
+	// Deliver the same activity to the target account.
+	suite.inboxPost(
+		create,
+		requestingAccount,
+		targetAccount,
+		http.StatusAccepted,
+		`{"status":"Accepted"}`,
+		suite.signatureCheck,
+	)
+
+	// The status should now be created,
+	// rather than the second delivery
+	// having been dropped as a duplicate.
+	if !testrig.WaitFor(func() bool {
+		status, err := suite.db.GetStatusByURI(ctx, statusURI)
+		return err == nil && status != nil
+	}) {
+		suite.FailNow("timed out waiting for status to be created")
+	}
+}
+
 func (suite *InboxPostTestSuite) TestPostCreateMalformedBlock() {
 	var (
 		blockingAcc = suite.testAccounts["remote_account_1"]
@@ -506,7 +578,7 @@ func (suite *InboxPostTestSuite) TestPostCreateMalformedBlock() {
 		blockingAcc,
 		blockedAcc,
 		http.StatusBadRequest,
-		`{"error":"Bad Request: malformed incoming activity"}`,
+		`{"type":"about:blank","title":"Bad Request","status":400,"detail":"Bad Request: malformed incoming activity","reason":"activity_malformed"}`,
 		suite.signatureCheck,
 	)
 }
@@ -534,7 +606,47 @@ func (suite *InboxPostTestSuite) TestPostFromBlockedAccount() {
 		requestingAccount,
 		targetAccount,
 		http.StatusForbidden,
-		`{"error":"Forbidden: blocked"}`,
+		`{"type":"about:blank","title":"Forbidden","status":403,"detail":"Forbidden: blocked","reason":"actor_blocked"}`,
+		suite.signatureCheck,
+	)
+}
+
+func (suite *InboxPostTestSuite) TestPostFromPausedDomain() {
+	var (
+		ctx               = suite.T().Context()
+		requestingAccount = suite.testAccounts["remote_account_1"]
+		targetAccount     = suite.testAccounts["local_account_1"]
+	)
+
+	// Pause federation with foss satan's instance.
+	limit, err := suite.state.DB.GetDomainLimitByDomain(ctx, requestingAccount.Domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	limit.FederationPolicy = gtsmodel.FederationPolicyPause
+	if err := suite.state.DB.UpdateDomainLimit(ctx, limit, "federation_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Create an update from the account.
+	accountable, err := suite.tc.AccountToAS(ctx, requestingAccount)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	update, err := suite.tc.WrapAccountableInUpdate(accountable)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Post an update from foss satan to
+	// zork, which should be rejected for
+	// now, asking foss satan to retry.
+	suite.inboxPost(
+		update,
+		requestingAccount,
+		targetAccount,
+		http.StatusServiceUnavailable,
+		`{"type":"about:blank","title":"Service Unavailable","status":503,"detail":"Service Unavailable: federation paused","reason":"federation_paused"}`,
 		suite.signatureCheck,
 	)
 }
@@ -578,7 +690,7 @@ func (suite *InboxPostTestSuite) TestPostUnauthorized() {
 		requestingAccount,
 		targetAccount,
 		http.StatusUnauthorized,
-		`{"error":"Unauthorized: http request wasn't signed or http signature was invalid: (verifier)"}`,
+		`{"type":"about:blank","title":"Unauthorized","status":401,"detail":"Unauthorized: http request wasn't signed or http signature was invalid: (verifier)","reason":"signature_missing"}`,
 		// Omit signature check middleware.
 	)
 }
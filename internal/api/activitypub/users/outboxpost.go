@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package users
+
+import (
+	"errors"
+	"net/http"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// OutboxPOSTHandler swagger:operation POST /users/{username}/outbox c2sOutboxPost
+//
+// Post an activity to the outbox of an actor, using ActivityPub client-to-server.
+//
+// EXPERIMENTAL: only available if `instance-experimental-c2s` is enabled in the instance config.
+//
+// Supported are `Create` activities wrapping a `Note`, bare `Note`s, and `Delete` activities
+// targeting one of the actor's own statuses. Notes are converted to plain text statuses.
+//
+// On success, the `Location` header of the response is set to the URI of the created (or deleted) status.
+//
+//	---
+//	tags:
+//	- c2s
+//
+//	consumes:
+//	- application/activity+json
+//	- application/ld+json
+//
+//	parameters:
+//	-
+//		name: username
+//		type: string
+//		description: Username of the account.
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:statuses
+//
+//	responses:
+//		'201':
+//			description: created
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) OutboxPOSTHandler(c *gin.Context) {
+	if !config.GetInstanceExperimentalC2S() {
+		const text = "client-to-server is not enabled on this instance"
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotFound(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeWriteStatuses,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Only the outbox owner may post to it.
+	username := c.Param(apiutil.UsernameKey)
+	if username != authed.Account.Username {
+		const text = "cannot post to the outbox of another account"
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	t, err := ap.DecodeType(c.Request.Context(), c.Request.Body)
+	if err != nil {
+		text := "could not decode activity: " + err.Error()
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, text), m.processor.InstanceGetV1)
+		return
+	}
+
+	uri, errWithCode := m.processor.Status().OutboxPost(
+		c.Request.Context(),
+		authed.Account,
+		authed.Application,
+		t,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header("Location", uri)
+	c.Status(http.StatusCreated)
+}
@@ -0,0 +1,185 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package users_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxPostTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *OutboxPostTestSuite) postOutbox(
+	targetAccount *gtsmodel.Account,
+	body string,
+) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodPost, targetAccount.OutboxURI, strings.NewReader(body))
+	ctx.Request.Header.Set("Content-Type", "application/activity+json")
+
+	// Authorize as local_account_1.
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   apiutil.UsernameKey,
+			Value: targetAccount.Username,
+		},
+	}
+
+	suite.userModule.OutboxPOSTHandler(ctx)
+
+	// Bodyless responses are only
+	// written out once the handler
+	// chain completes, so force it.
+	ctx.Writer.WriteHeaderNow()
+	return recorder
+}
+
+func (suite *OutboxPostTestSuite) TestPostCreateNote() {
+	config.SetInstanceExperimentalC2S(true)
+	account := suite.testAccounts["local_account_1"]
+
+	recorder := suite.postOutbox(account, `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Create",
+  "object": {
+    "type": "Note",
+    "summary": "a greeting",
+    "content": "<p>hello <em>world</em>!</p>",
+    "contentMap": {"en": "<p>hello <em>world</em>!</p>"},
+    "to": "https://www.w3.org/ns/activitystreams#Public",
+    "cc": "`+account.FollowersURI+`"
+  }
+}`)
+	suite.Equal(http.StatusCreated, recorder.Code)
+
+	location := recorder.Header().Get("Location")
+	suite.NotEmpty(location)
+
+	status, err := suite.db.GetStatusByURI(suite.T().Context(), location)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(account.ID, status.AccountID)
+	suite.Equal("hello world!", status.Text)
+	suite.Equal("a greeting", status.ContentWarning)
+	suite.Equal("en", status.Language)
+	suite.Equal(gtsmodel.VisibilityPublic, status.Visibility)
+}
+
+func (suite *OutboxPostTestSuite) TestPostBareNoteFollowersOnly() {
+	config.SetInstanceExperimentalC2S(true)
+	account := suite.testAccounts["local_account_1"]
+
+	recorder := suite.postOutbox(account, `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "content": "just for my followers",
+  "to": "`+account.FollowersURI+`"
+}`)
+	suite.Equal(http.StatusCreated, recorder.Code)
+
+	status, err := suite.db.GetStatusByURI(suite.T().Context(), recorder.Header().Get("Location"))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("just for my followers", status.Text)
+	suite.Equal(gtsmodel.VisibilityFollowersOnly, status.Visibility)
+}
+
+func (suite *OutboxPostTestSuite) TestPostDelete() {
+	config.SetInstanceExperimentalC2S(true)
+	account := suite.testAccounts["local_account_1"]
+	target := suite.testStatuses["local_account_1_status_1"]
+
+	recorder := suite.postOutbox(account, `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Delete",
+  "object": "`+target.URI+`"
+}`)
+	suite.Equal(http.StatusCreated, recorder.Code)
+	suite.Equal(target.URI, recorder.Header().Get("Location"))
+}
+
+func (suite *OutboxPostTestSuite) TestPostDeleteNotOwn() {
+	config.SetInstanceExperimentalC2S(true)
+	account := suite.testAccounts["local_account_1"]
+	target := suite.testStatuses["admin_account_status_1"]
+
+	recorder := suite.postOutbox(account, `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Delete",
+  "object": "`+target.URI+`"
+}`)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *OutboxPostTestSuite) TestPostUnsupportedType() {
+	config.SetInstanceExperimentalC2S(true)
+	account := suite.testAccounts["local_account_1"]
+
+	recorder := suite.postOutbox(account, `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Like",
+  "object": "`+suite.testStatuses["admin_account_status_1"].URI+`"
+}`)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *OutboxPostTestSuite) TestPostOtherOutbox() {
+	config.SetInstanceExperimentalC2S(true)
+
+	recorder := suite.postOutbox(suite.testAccounts["admin_account"], `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "content": "hello"
+}`)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *OutboxPostTestSuite) TestPostDisabled() {
+	recorder := suite.postOutbox(suite.testAccounts["local_account_1"], `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "content": "hello"
+}`)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestOutboxPostTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxPostTestSuite))
+}
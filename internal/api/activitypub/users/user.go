@@ -34,6 +34,7 @@
 	FollowersPath          = BasePath + "/" + uris.FollowersPath
 	FollowingPath          = BasePath + "/" + uris.FollowingPath
 	FeaturedCollectionPath = BasePath + "/" + uris.CollectionsPath + "/" + uris.FeaturedPath
+	BlocklistPath          = BasePath + "/" + uris.CollectionsPath + "/" + uris.BlocklistPath
 	StatusPath             = BasePath + "/" + uris.StatusesPath + "/:" + apiutil.IDKey
 	StatusRepliesPath      = StatusPath + "/replies"
 	AcceptPath             = BasePath + "/" + uris.AcceptsPath + "/:" + apiutil.IDKey
@@ -59,6 +60,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, FollowersPath, m.FollowersGETHandler)
 	attachHandler(http.MethodGet, FollowingPath, m.FollowingGETHandler)
 	attachHandler(http.MethodGet, FeaturedCollectionPath, m.FeaturedCollectionGETHandler)
+	attachHandler(http.MethodGet, BlocklistPath, m.BlocklistGETHandler)
 	attachHandler(http.MethodGet, StatusPath, m.StatusGETHandler)
 	attachHandler(http.MethodGet, StatusRepliesPath, m.StatusRepliesGETHandler)
 	attachHandler(http.MethodGet, OutboxPath, m.OutboxGETHandler)
@@ -31,12 +31,13 @@
 		paths prefixed with 'auth'
 	*/
 
-	AuthSignInPath          = "/sign_in"
-	Auth2FAPath             = "/2fa"
-	AuthCheckYourEmailPath  = "/check_your_email"
-	AuthWaitForApprovalPath = "/wait_for_approval"
-	AuthAccountDisabledPath = "/account_disabled"
-	AuthCallbackPath        = "/callback"
+	AuthSignInPath           = "/sign_in"
+	Auth2FAPath              = "/2fa"
+	AuthCheckYourEmailPath   = "/check_your_email"
+	AuthWaitForApprovalPath  = "/wait_for_approval"
+	AuthAccountDisabledPath  = "/account_disabled"
+	AuthAccountSuspendedPath = "/account_suspended"
+	AuthCallbackPath         = "/callback"
 
 	/*
 		paths prefixed with 'oauth'
@@ -95,6 +96,8 @@ func (m *Module) RouteAuth(attachHandler func(method string, path string, f ...g
 	attachHandler(http.MethodPost, AuthSignInPath, m.SignInPOSTHandler)
 	attachHandler(http.MethodGet, Auth2FAPath, m.TwoFactorCodeGETHandler)
 	attachHandler(http.MethodPost, Auth2FAPath, m.TwoFactorCodePOSTHandler)
+	attachHandler(http.MethodGet, AuthAccountSuspendedPath, m.AccountSuspendedGETHandler)
+	attachHandler(http.MethodPost, AuthAccountSuspendedPath, m.AccountSuspendedPOSTHandler)
 	attachHandler(http.MethodGet, AuthCallbackPath, m.CallbackGETHandler)
 }
 
@@ -268,7 +268,8 @@ func (m *Module) redirectAuthFormToSignIn(c *gin.Context) {
 //
 //  1. Has a confirmed email address.
 //  2. Has been approved.
-//  3. Is not disabled or suspended.
+//  3. Is not disabled.
+//  4. Is not suspended.
 //
 // If all looks OK, returns true. Otherwise,
 // redirects to a help page and returns false.
@@ -289,12 +290,19 @@ func (m *Module) validateUser(
 		c.Redirect(http.StatusSeeOther, redirectTo)
 		return false
 
-	case *user.Disabled || !user.Account.SuspendedAt.IsZero():
-		// User disabled or suspended.
+	case *user.Disabled:
+		// User disabled.
 		const redirectTo = "/auth" + AuthAccountDisabledPath
 		c.Redirect(http.StatusSeeOther, redirectTo)
 		return false
 
+	case user.Account.IsSuspended():
+		// User suspended, but still within the
+		// grace period, so they can appeal it.
+		const redirectTo = "/auth" + AuthAccountSuspendedPath
+		c.Redirect(http.StatusSeeOther, redirectTo)
+		return false
+
 	default:
 		// All good.
 		return true
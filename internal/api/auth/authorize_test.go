@@ -68,7 +68,7 @@ func (suite *AuthAuthorizeTestSuite) TestAccountAuthorizeHandler() {
 				return []string{"confirmed_at", "email", "approved", "disabled"}
 			},
 			expectedStatusCode:     http.StatusSeeOther,
-			expectedLocationHeader: "/auth" + auth.AuthAccountDisabledPath,
+			expectedLocationHeader: "/auth" + auth.AuthAccountSuspendedPath,
 		},
 	}
 
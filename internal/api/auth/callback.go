@@ -178,6 +178,7 @@ func (m *Module) CallbackGETHandler(c *gin.Context) {
 		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
 		return
 	}
+	m.recordLogin(c, user, true)
 	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
 }
 
@@ -261,6 +262,7 @@ func (m *Module) FinalizePOSTHandler(c *gin.Context) {
 		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
 		return
 	}
+	m.recordLogin(c, user, true)
 	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
 }
 
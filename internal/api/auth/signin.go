@@ -18,7 +18,6 @@
 package auth
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -110,7 +109,7 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 	}
 
 	user, errWithCode := m.validatePassword(
-		c.Request.Context(),
+		c,
 		form.Email,
 		form.Password,
 	)
@@ -139,6 +138,7 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 	// If the user doesn't have 2fa enabled,
 	// redirect straight to the OAuth authorize page.
 	s.Set(sessionUserID, user.ID)
+	m.recordLogin(c, user, true)
 	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
 }
 
@@ -147,12 +147,16 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 // that email address stored in the database.
 //
 // If OK, it returns the user, so that it can be used in
-// further OAuth flows to generate a token etc.
+// further OAuth flows to generate a token etc. If the user
+// exists but the password is wrong, the failed sign-in
+// attempt is recorded in the user's sign-in history.
 func (m *Module) validatePassword(
-	ctx context.Context,
+	c *gin.Context,
 	email string,
 	password string,
 ) (*gtsmodel.User, gtserror.WithCode) {
+	ctx := c.Request.Context()
+
 	if email == "" || password == "" {
 		err := errors.New("email or password was not provided")
 		return incorrectPassword(err)
@@ -173,6 +177,7 @@ func (m *Module) validatePassword(
 		byteutil.S2B(user.EncryptedPassword),
 		byteutil.S2B(password),
 	); err != nil {
+		m.recordLogin(c, user, false)
 		err := fmt.Errorf("password hash didn't match for user %s during sign in attempt: %s", user.Email, err)
 		return incorrectPassword(err)
 	}
@@ -249,6 +254,8 @@ func (m *Module) TwoFactorCodePOSTHandler(c *gin.Context) {
 	}
 
 	if !valid {
+		m.recordLogin(c, user, false)
+
 		// Don't clear session here yet, so the user
 		// can just press back and try again if they
 		// accidentally gave the wrong code, without
@@ -264,6 +271,7 @@ func (m *Module) TwoFactorCodePOSTHandler(c *gin.Context) {
 	// to the OAuth authorize page.
 	s.Set(sessionUserID, user.ID)
 	m.mustSaveSession(s)
+	m.recordLogin(c, user, true)
 	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
 }
 
@@ -0,0 +1,128 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+// AccountSuspendedGETHandler should be served at
+// GET https://example.org/auth/account_suspended.
+//
+// Suspended users are redirected here when signing
+// in during the suspension grace period. The page shows
+// why the account was suspended, and either a form to
+// appeal the suspension, or the state of the appeal.
+func (m *Module) AccountSuspendedGETHandler(c *gin.Context) {
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.HTMLAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	s := sessions.Default(c)
+
+	user := m.mustUserFromSession(c, s)
+	if user == nil {
+		// Error already
+		// written.
+		return
+	}
+
+	if !user.Account.IsSuspended() {
+		// Suspension has been reversed
+		// meanwhile, so carry on with
+		// the normal sign in flow.
+		c.Redirect(http.StatusSeeOther, "/oauth"+OauthAuthorizePath)
+		return
+	}
+
+	warning, errWithCode := m.processor.Account().SuspensionWarningGet(
+		c.Request.Context(),
+		user.Account,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.TemplateWebPage(c, apiutil.WebPage{
+		Template: "account-suspended.tmpl",
+		Instance: instance,
+		Extra: map[string]any{
+			"user":    user.Account.Username,
+			"warning": warning,
+		},
+	})
+}
+
+// AccountSuspendedPOSTHandler should be served at
+// POST https://example.org/auth/account_suspended.
+//
+// The handler submits an appeal against the suspension
+// of the signed in user's account, then redirects back
+// to the account suspended page to show the appeal.
+func (m *Module) AccountSuspendedPOSTHandler(c *gin.Context) {
+	s := sessions.Default(c)
+
+	user := m.mustUserFromSession(c, s)
+	if user == nil {
+		// Error already
+		// written.
+		return
+	}
+
+	// Parse appeal text.
+	form := &apimodel.AppealRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		m.clearSessionWithBadRequest(c, s, err, oauth.HelpfulAdvice)
+		return
+	}
+
+	warning, errWithCode := m.processor.Account().SuspensionWarningGet(
+		c.Request.Context(),
+		user.Account,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := m.processor.Account().WarningAppeal(
+		c.Request.Context(),
+		user.Account,
+		warning.ID,
+		form,
+	); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/auth"+AuthAccountSuspendedPath)
+}
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/auth"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"github.com/gin-contrib/sessions"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthSuspendedTestSuite struct {
+	AuthStandardTestSuite
+}
+
+func (suite *AuthSuspendedTestSuite) TestAppealSuspension() {
+	var (
+		ctx     = suite.T().Context()
+		user    = suite.testUsers["local_account_1"]
+		account = new(gtsmodel.Account)
+	)
+
+	*account = *suite.testAccounts["local_account_1"]
+
+	// Suspend the account, as though
+	// during the suspension grace period.
+	account.SuspendedAt = time.Now()
+	if err := suite.db.UpdateAccount(ctx, account, "suspended_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	warning := &gtsmodel.AccountWarning{
+		ID:            id.NewULID(),
+		AccountID:     account.ID,
+		ByAccountID:   suite.testAccounts["admin_account"].ID,
+		AdminActionID: id.NewULID(),
+		Action:        gtsmodel.AccountWarningActionSuspend,
+		Text:          "stinky",
+	}
+	if err := suite.db.PutAccountWarning(ctx, warning); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Show the suspended page.
+	c, recorder := suite.newContext(http.MethodGet, "auth"+auth.AuthAccountSuspendedPath, nil, "")
+	testSession := sessions.Default(c)
+	testSession.Set(sessionUserID, user.ID)
+	if err := testSession.Save(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.authModule.AccountSuspendedGETHandler(c)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Contains(recorder.Body.String(), "stinky")
+	suite.Contains(recorder.Body.String(), `action="/auth/account_suspended"`)
+
+	// Submit an appeal.
+	body := url.Values{"text": {"i'm not stinky"}}.Encode()
+	c, recorder = suite.newContext(http.MethodPost, "auth"+auth.AuthAccountSuspendedPath, []byte(body), "application/x-www-form-urlencoded")
+	testSession = sessions.Default(c)
+	testSession.Set(sessionUserID, user.ID)
+	if err := testSession.Save(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.authModule.AccountSuspendedPOSTHandler(c)
+	suite.Equal(http.StatusSeeOther, recorder.Code)
+	suite.Equal("/auth"+auth.AuthAccountSuspendedPath, recorder.Header().Get("Location"))
+
+	// Ensure appeal stored.
+	appeal, err := suite.db.GetAppealByAccountWarningID(ctx, warning.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(account.ID, appeal.AccountID)
+	suite.Equal("i'm not stinky", appeal.Text)
+	suite.True(appeal.IsPending())
+}
+
+func TestAuthSuspendedTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthSuspendedTestSuite))
+}
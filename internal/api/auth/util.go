@@ -19,6 +19,7 @@
 
 import (
 	"errors"
+	"net"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
@@ -39,6 +40,18 @@ func (m *Module) mustSaveSession(s sessions.Session) {
 	}
 }
 
+// recordLogin records a sign-in attempt to the
+// given user's account, made by the client of c.
+func (m *Module) recordLogin(c *gin.Context, user *gtsmodel.User, successful bool) {
+	m.processor.User().LoginRecord(
+		c.Request.Context(),
+		user,
+		net.ParseIP(c.ClientIP()),
+		c.Request.UserAgent(),
+		successful,
+	)
+}
+
 // mustUserFromSession returns a *gtsmodel.User by checking the
 // session for a user id and fetching the user from the database.
 //
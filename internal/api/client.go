@@ -21,6 +21,7 @@
 	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/accounts"
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/accountwarnings"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/admin"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/announcements"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/apps"
@@ -40,6 +41,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/instance"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/interactionpolicies"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/interactionrequests"
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/invites"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/lists"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/markers"
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/media"
@@ -72,6 +74,7 @@ type Client struct {
 	db        db.DB
 
 	accounts            *accounts.Module            // api/v1/accounts, api/v1/profile
+	accountWarnings     *accountwarnings.Module     // api/v1/account_warnings
 	admin               *admin.Module               // api/v1/admin
 	announcements       *announcements.Module       // api/v1/announcements
 	apps                *apps.Module                // api/v1/apps
@@ -91,6 +94,7 @@ type Client struct {
 	instance            *instance.Module            // api/v1/instance
 	interactionPolicies *interactionpolicies.Module // api/v1/interaction_policies
 	interactionRequests *interactionrequests.Module // api/v1/interaction_requests
+	invites             *invites.Module             // api/v1/invites
 	lists               *lists.Module               // api/v1/lists
 	markers             *markers.Module             // api/v1/markers
 	media               *media.Module               // api/v1/media, api/v2/media
@@ -120,6 +124,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	apiGroup.Use(m...)
 	apiGroup.Use(
 		middleware.TokenCheck(c.db, c.processor.OAuthValidateBearerToken),
+		middleware.IdempotencyKey(c.processor.InstanceGetV1),
 		middleware.CacheControl(middleware.CacheControlConfig{
 			// Never cache client api responses.
 			Directives: []string{"no-store"},
@@ -130,6 +135,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	// so that the module can attach its routes to this group
 	h := apiGroup.Handle
 	c.accounts.Route(h)
+	c.accountWarnings.Route(h)
 	c.admin.Route(h)
 	c.announcements.Route(h)
 	c.apps.Route(h)
@@ -149,6 +155,7 @@ func (c *Client) Route(r *router.Router, m ...gin.HandlerFunc) {
 	c.instance.Route(h)
 	c.interactionPolicies.Route(h)
 	c.interactionRequests.Route(h)
+	c.invites.Route(h)
 	c.lists.Route(h)
 	c.markers.Route(h)
 	c.media.Route(h)
@@ -176,6 +183,7 @@ func NewClient(state *state.State, p *processing.Processor) *Client {
 		db:        state.DB,
 
 		accounts:            accounts.New(p),
+		accountWarnings:     accountwarnings.New(p),
 		admin:               admin.New(state, p),
 		announcements:       announcements.New(p),
 		apps:                apps.New(p),
@@ -195,6 +203,7 @@ func NewClient(state *state.State, p *processing.Processor) *Client {
 		instance:            instance.New(p),
 		interactionPolicies: interactionpolicies.New(p),
 		interactionRequests: interactionrequests.New(p),
+		invites:             invites.New(p),
 		lists:               lists.New(p),
 		markers:             markers.New(p),
 		media:               media.New(p),
@@ -106,7 +106,11 @@ func (m *Module) AccountCreatePOSTHandler(c *gin.Context) {
 	}
 
 	if err := validate.CreateAccount(form); err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		errWithCode := gtserror.WithErrCode(
+			gtserror.NewErrorBadRequest(err, err.Error()),
+			gtserror.ErrCodeValidationFailed,
+		)
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
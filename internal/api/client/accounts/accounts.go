@@ -38,6 +38,7 @@
 	IDKey          = "id"
 	BasePathWithID = BasePath + "/:" + IDKey
 
+	ActivityPath      = BasePathWithID + "/activity"
 	BlockPath         = BasePathWithID + "/block"
 	DeletePath        = BasePath + "/delete"
 	FeaturedTagsPath  = BasePathWithID + "/featured_tags"
@@ -59,11 +60,25 @@
 	MovePath          = BasePath + "/move"
 	AliasPath         = BasePath + "/alias"
 	ThemesPath        = BasePath + "/themes"
+	UsagePath         = BasePath + "/usage"
+
+	// CustomDomainPath for managing a vanity domain of the account.
+	CustomDomainPath       = BasePath + "/custom_domain"
+	CustomDomainVerifyPath = CustomDomainPath + "/verify"
+
+	// SoftMigrationPath for soft migration from an alias, an alternative to a full Move.
+	SoftMigrationPath          = BasePath + "/soft_migration"
+	SoftMigrationNoticePath    = SoftMigrationPath + "/notice"
+	SoftMigrationFollowingPath = SoftMigrationPath + "/following"
 
 	// ProfileBasePath for the profile API, an extension of the account update API with a different path.
 	ProfileBasePath = "/v1/profile"
 	AvatarPath      = ProfileBasePath + "/avatar"
 	HeaderPath      = ProfileBasePath + "/header"
+
+	// AvatarRollbackPath and HeaderRollbackPath for restoring previous profile media.
+	AvatarRollbackPath = AvatarPath + "/rollback"
+	HeaderRollbackPath = HeaderPath + "/rollback"
 )
 
 type Module struct {
@@ -95,6 +110,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// modify account profile media
 	attachHandler(http.MethodDelete, AvatarPath, m.AccountAvatarDELETEHandler)
 	attachHandler(http.MethodDelete, HeaderPath, m.AccountHeaderDELETEHandler)
+	attachHandler(http.MethodPost, AvatarRollbackPath, m.AccountAvatarRollbackPOSTHandler)
+	attachHandler(http.MethodPost, HeaderRollbackPath, m.AccountHeaderRollbackPOSTHandler)
 
 	// get account's statuses
 	attachHandler(http.MethodGet, StatusesPath, m.AccountStatusesGETHandler)
@@ -102,6 +119,9 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// get account's featured tags
 	attachHandler(http.MethodGet, FeaturedTagsPath, m.AccountFeaturedTagsGETHandler)
 
+	// get account activity heatmap
+	attachHandler(http.MethodGet, ActivityPath, m.AccountActivityGETHandler)
+
 	// get following or followers
 	attachHandler(http.MethodGet, FollowersPath, m.AccountFollowersGETHandler)
 	attachHandler(http.MethodGet, FollowingPath, m.AccountFollowingGETHandler)
@@ -134,7 +154,19 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// migration handlers
 	attachHandler(http.MethodPost, AliasPath, m.AccountAliasPOSTHandler)
 	attachHandler(http.MethodPost, MovePath, m.AccountMovePOSTHandler)
+	attachHandler(http.MethodGet, SoftMigrationPath, m.AccountSoftMigrationGETHandler)
+	attachHandler(http.MethodPost, SoftMigrationNoticePath, m.AccountSoftMigrationNoticePOSTHandler)
+	attachHandler(http.MethodPost, SoftMigrationFollowingPath, m.AccountSoftMigrationFollowingPOSTHandler)
 
 	// account themes
 	attachHandler(http.MethodGet, ThemesPath, m.AccountThemesGETHandler)
+
+	// account storage usage
+	attachHandler(http.MethodGet, UsagePath, m.AccountUsageGETHandler)
+
+	// account custom domain
+	attachHandler(http.MethodGet, CustomDomainPath, m.AccountCustomDomainGETHandler)
+	attachHandler(http.MethodPost, CustomDomainPath, m.AccountCustomDomainPOSTHandler)
+	attachHandler(http.MethodDelete, CustomDomainPath, m.AccountCustomDomainDELETEHandler)
+	attachHandler(http.MethodPost, CustomDomainVerifyPath, m.AccountCustomDomainVerifyPOSTHandler)
 }
@@ -0,0 +1,320 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package accounts
+
+import (
+	"errors"
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// AccountSoftMigrationGETHandler swagger:operation GET /api/v1/accounts/soft_migration accountSoftMigrationGet
+//
+// Get the accounts that your account is aliased to, and which steps of a soft migration are possible for each.
+//
+// A soft migration is a lighter alternative to a full Move, for when you want to
+// start using a new account without moving your old account: from the new account,
+// you can post a notice to let people know where you've moved to, and follow the
+// same accounts that your old account follows. Your old account is left as it is.
+//
+// To start, set the alsoKnownAs of your new account to your old account using `/api/v1/accounts/alias`.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:accounts
+//
+//	responses:
+//		'200':
+//			description: Aliased accounts.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/softMigrationAlias"
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: Unprocessable. Check the response body for more details.
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountSoftMigrationGETHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeReadAccounts,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	aliases, errWithCode := m.processor.SoftMigration().AliasesGet(c.Request.Context(), authed.Account)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, aliases)
+}
+
+// AccountSoftMigrationNoticePOSTHandler swagger:operation POST /api/v1/accounts/soft_migration/notice accountSoftMigrationNotice
+//
+// Post a public status from your account letting people know that you've moved here from the given alias.
+//
+// The status mentions the aliased account, so that it shows up in the old account's
+// notifications and can be boosted from there to reach the old account's followers.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: also_known_as_uri
+//		in: formData
+//		description: ActivityPub URI of the aliased (old) account.
+//		type: string
+//		required: true
+//	-
+//		name: status
+//		in: formData
+//		description: >-
+//			Text of the notice. If not set, a default notice is used.
+//			The aliased account will be mentioned at the end, if not already.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:statuses
+//
+//	responses:
+//		'200':
+//			description: The newly posted notice.
+//			schema:
+//				"$ref": "#/definitions/status"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: Unprocessable. Check the response body for more details.
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountSoftMigrationNoticePOSTHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeWriteStatuses,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.SoftMigrationNoticeRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.AlsoKnownAsURI == "" {
+		const text = "also_known_as_uri must be set"
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	apiStatus, errWithCode := m.processor.SoftMigration().NoticeCreate(
+		c.Request.Context(),
+		authed.Account,
+		authed.Application,
+		form,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, apiStatus)
+}
+
+// AccountSoftMigrationFollowingPOSTHandler swagger:operation POST /api/v1/accounts/soft_migration/following accountSoftMigrationFollowing
+//
+// Follow, from your account, the accounts followed by the given alias.
+//
+// If the alias is a local account which has your account as an alias in turn, the accounts it
+// follows are fetched directly. Otherwise, upload a following CSV exported from the alias.
+//
+// Existing follows are kept. Follows are created asynchronously, and not all may succeed
+// depending on domain blocks, user-level blocks, network availability of accounts, etc.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	consumes:
+//	- multipart/form-data
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: also_known_as_uri
+//		in: formData
+//		description: ActivityPub URI of the aliased (old) account.
+//		type: string
+//		required: true
+//	-
+//		name: data
+//		in: formData
+//		description: >-
+//			Following CSV data exported from the aliased account.
+//			Required if the alias's follows can't be fetched directly.
+//		type: file
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:follows
+//
+//	responses:
+//		'202':
+//			description: Import accepted.
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: Unprocessable. Check the response body for more details.
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountSoftMigrationFollowingPOSTHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeWriteFollows,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.SoftMigrationFollowingRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.AlsoKnownAsURI == "" {
+		const text = "also_known_as_uri must be set"
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := m.processor.SoftMigration().FollowingImport(
+		c.Request.Context(),
+		authed.Account,
+		form,
+	); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusAccepted, gin.H{"status": "accepted"})
+}
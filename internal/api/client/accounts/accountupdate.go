@@ -65,6 +65,11 @@
 //		description: Account is flagged as a bot.
 //		type: boolean
 //	-
+//		name: operator_uri
+//		in: formData
+//		description: URI or profile URL of the account that operates this bot account. Only allowed for bot accounts. Must be another account known to this instance. Empty string clears.
+//		type: string
+//	-
 //		name: display_name
 //		in: formData
 //		description: The display name to use for the account.
@@ -77,6 +82,18 @@
 //		type: string
 //		allowEmptyValue: true
 //	-
+//		name: pronouns
+//		in: formData
+//		description: Pronouns to show on this account's profile, eg., `they/them`. Max 40 characters. Empty string unsets.
+//		type: string
+//		allowEmptyValue: true
+//	-
+//		name: location
+//		in: formData
+//		description: Location to show on this account's profile, eg., `Berlin`. Max 100 characters. Empty string unsets.
+//		type: string
+//		allowEmptyValue: true
+//	-
 //		name: avatar
 //		in: formData
 //		description: Avatar of the user.
@@ -114,16 +131,45 @@
 //		description: Mark authored statuses as sensitive by default.
 //		type: boolean
 //	-
+//		name: source[preview_links]
+//		in: formData
+//		description: Generate link preview cards for links in authored statuses by default.
+//		type: boolean
+//	-
+//		name: source[media_description_policy]
+//		in: formData
+//		description: >-
+//			Policy for descriptions of media attached to authored statuses: "off", "remind", or "require".
+//			The instance policy applies instead if it's stricter. Use an empty value to just follow the instance policy.
+//		type: string
+//	-
 //		name: source[language]
 //		in: formData
 //		description: Default language to use for authored statuses (ISO 6391).
 //		type: string
 //	-
+//		name: source[chosen_languages]
+//		in: formData
+//		description: >-
+//			Languages of statuses to show on timelines (ISO 6391).
+//			Statuses in other languages are dropped from timelines and streams.
+//			Provide a single empty value to show statuses in all languages.
+//		type: array
+//		items:
+//			type: string
+//	-
 //		name: source[status_content_type]
 //		in: formData
 //		description: Default content type to use for authored statuses (text/plain or text/markdown).
 //		type: string
 //	-
+//		name: source[status_expires_in]
+//		in: formData
+//		description: >-
+//			Default number of seconds after which authored statuses are automatically deleted.
+//			Use 0 to not expire statuses by default.
+//		type: integer
+//	-
 //		name: theme
 //		in: formData
 //		description: >-
@@ -144,11 +190,23 @@
 //		description: Enable RSS feed for this account's Public posts at `/[username]/feed.rss`
 //		type: boolean
 //	-
+//		name: rss_full_content
+//		in: formData
+//		description: Embed all media attachments in the content of the items in this account's RSS feed.
+//		type: boolean
+//	-
 //		name: hide_collections
 //		in: formData
 //		description: Hide the account's following/followers collections.
 //		type: boolean
 //	-
+//		name: publish_blocks
+//		in: formData
+//		description: >-
+//			Publish the accounts blocked by this account as an ActivityPub collection at
+//			`/users/{username}/collections/blocklist`, which others can import blocks from.
+//		type: boolean
+//	-
 //		name: web_visibility
 //		in: formData
 //		description: |-
@@ -171,6 +229,13 @@
 //		description: Include boosts created by the account on the web view of the account.
 //		type: boolean
 //	-
+//		name: web_activity_heatmap
+//		in: formData
+//		description: >-
+//			Show a heatmap of public posting activity on the web view of the account,
+//			and allow it to be fetched via the account activity API.
+//		type: boolean
+//	-
 //		name: fields_attributes[0][name]
 //		in: formData
 //		description: Name of 1st profile field to be added to this account's profile.
@@ -364,8 +429,11 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 		(form.Discoverable == nil &&
 			form.Indexable == nil &&
 			form.Bot == nil &&
+			form.OperatorURI == nil &&
 			form.DisplayName == nil &&
 			form.Note == nil &&
+			form.Pronouns == nil &&
+			form.Location == nil &&
 			form.Avatar == nil &&
 			form.AvatarDescription == nil &&
 			form.Header == nil &&
@@ -373,16 +441,23 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 			form.Locked == nil &&
 			form.Source.Privacy == nil &&
 			form.Source.Sensitive == nil &&
+			form.Source.PreviewLinks == nil &&
+			form.Source.MediaDescriptionPolicy == nil &&
 			form.Source.Language == nil &&
+			form.Source.ChosenLanguages == nil &&
 			form.Source.StatusContentType == nil &&
+			form.Source.StatusExpiresIn == nil &&
 			form.FieldsAttributes == nil &&
 			form.Theme == nil &&
 			form.CustomCSS == nil &&
 			form.EnableRSS == nil &&
+			form.RSSFullContent == nil &&
 			form.HideCollections == nil &&
+			form.PublishBlocks == nil &&
 			form.WebVisibility == nil &&
 			form.WebLayout == nil &&
-			form.WebIncludeBoosts == nil) {
+			form.WebIncludeBoosts == nil &&
+			form.WebActivityHeatmap == nil) {
 		return nil, errors.New("empty form submitted")
 	}
 
@@ -442,7 +442,7 @@ func (suite *AccountUpdateTestSuite) TestUpdateAccountWithImageFormData() {
 func (suite *AccountUpdateTestSuite) TestUpdateAccountEmptyForm() {
 	data := make(map[string][]string)
 
-	_, err := suite.updateAccountFromForm(data, http.StatusBadRequest, `{"error":"Bad Request: empty form submitted"}`)
+	_, err := suite.updateAccountFromForm(data, http.StatusBadRequest, `{"error":"Bad Request: empty form submitted","error_code":"bad_request"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -451,7 +451,7 @@ func (suite *AccountUpdateTestSuite) TestUpdateAccountEmptyForm() {
 func (suite *AccountUpdateTestSuite) TestUpdateAccountEmptyFormData() {
 	data := make(map[string][]string)
 
-	_, err := suite.updateAccountFromFormData(data, http.StatusBadRequest, `{"error":"Bad Request: empty form submitted"}`)
+	_, err := suite.updateAccountFromFormData(data, http.StatusBadRequest, `{"error":"Bad Request: empty form submitted","error_code":"bad_request"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -543,7 +543,7 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateCredentialsPATCHHandlerUpd
 		"source[status_content_type]": {"peepeepoopoo"},
 	}
 
-	_, err := suite.updateAccountFromFormData(data, http.StatusBadRequest, `{"error":"Bad Request: status content type 'peepeepoopoo' was not recognized, valid options are 'text/plain', 'text/markdown'"}`)
+	_, err := suite.updateAccountFromFormData(data, http.StatusBadRequest, `{"error":"Bad Request: status content type 'peepeepoopoo' was not recognized, valid options are 'text/plain', 'text/markdown'","error_code":"validation_failed"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -154,7 +154,7 @@ func (suite *MuteTestSuite) TestPostMuteMinimal() {
 
 func (suite *MuteTestSuite) TestPostMuteSelf() {
 	accountID := suite.testAccounts["local_account_1"].ID
-	_, err := suite.postMute(accountID, nil, nil, nil, http.StatusNotAcceptable, `{"error":"Not Acceptable: getMuteTarget: account 01F8MH1H7YV1Z7D2C8K2730QBF cannot mute or unmute itself"}`)
+	_, err := suite.postMute(accountID, nil, nil, nil, http.StatusNotAcceptable, `{"error":"Not Acceptable: getMuteTarget: account 01F8MH1H7YV1Z7D2C8K2730QBF cannot mute or unmute itself","error_code":"not_acceptable"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -162,7 +162,7 @@ func (suite *MuteTestSuite) TestPostMuteSelf() {
 
 func (suite *MuteTestSuite) TestPostMuteNonexistentAccount() {
 	accountID := "not_even_a_real_ULID"
-	_, err := suite.postMute(accountID, nil, nil, nil, http.StatusNotFound, `{"error":"Not Found: getMuteTarget: target account not_even_a_real_ULID not found in the db"}`)
+	_, err := suite.postMute(accountID, nil, nil, nil, http.StatusNotFound, `{"error":"Not Found: getMuteTarget: target account not_even_a_real_ULID not found in the db","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
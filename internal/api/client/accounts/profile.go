@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -118,6 +119,126 @@ func (m *Module) AccountHeaderDELETEHandler(c *gin.Context) {
 	m.accountDeleteProfileAttachment(c, m.processor.Media().DeleteHeader)
 }
 
+// AccountAvatarRollbackPOSTHandler swagger:operation POST /api/v1/profile/avatar/rollback accountAvatarRollback
+//
+// Restore one of the authenticated account's previous avatars.
+//
+// The avatar is restored from stored media, without needing to be uploaded again.
+// Previous avatars are listed in the `source.avatar_history` field of the account.
+// The account's current avatar, if any, is added to the history in its place.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		in: formData
+//		description: ID of the previous avatar media attachment to restore.
+//		type: string
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'200':
+//			description: The updated account, including profile source information.
+//			schema:
+//				"$ref": "#/definitions/account"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountAvatarRollbackPOSTHandler(c *gin.Context) {
+	m.accountRollbackProfileAttachment(c, m.processor.Media().RollbackAvatar)
+}
+
+// AccountHeaderRollbackPOSTHandler swagger:operation POST /api/v1/profile/header/rollback accountHeaderRollback
+//
+// Restore one of the authenticated account's previous headers.
+//
+// The header is restored from stored media, without needing to be uploaded again.
+// Previous headers are listed in the `source.header_history` field of the account.
+// The account's current header, if any, is added to the history in its place.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		in: formData
+//		description: ID of the previous header media attachment to restore.
+//		type: string
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'200':
+//			description: The updated account, including profile source information.
+//			schema:
+//				"$ref": "#/definitions/account"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountHeaderRollbackPOSTHandler(c *gin.Context) {
+	m.accountRollbackProfileAttachment(c, m.processor.Media().RollbackHeader)
+}
+
 // accountDeleteProfileAttachment checks that an authenticated account is present and allowed to alter itself,
 // runs an attachment deletion processor method, and returns the updated account.
 func (m *Module) accountDeleteProfileAttachment(c *gin.Context, processDelete func(context.Context, *gtsmodel.Account) (*apimodel.Account, gtserror.WithCode)) {
@@ -143,3 +264,41 @@ func (m *Module) accountDeleteProfileAttachment(c *gin.Context, processDelete fu
 
 	apiutil.JSON(c, http.StatusOK, acctSensitive)
 }
+
+// accountRollbackProfileAttachment checks that an authenticated account is present and allowed to alter itself,
+// runs an attachment rollback processor method with the ID from the request form, and returns the updated account.
+func (m *Module) accountRollbackProfileAttachment(c *gin.Context, processRollback func(context.Context, *gtsmodel.Account, string) (*apimodel.Account, gtserror.WithCode)) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeWriteAccounts,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.ProfileMediaRollbackRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.ID == "" {
+		const text = "no id provided"
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(errors.New(text), text), m.processor.InstanceGetV1)
+		return
+	}
+
+	acctSensitive, errWithCode := processRollback(c, authed.Account, form.ID)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, acctSensitive)
+}
@@ -21,7 +21,6 @@
 	"errors"
 	"net/http"
 
-	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"github.com/gin-gonic/gin"
@@ -108,15 +107,10 @@ func (m *Module) AccountRelationshipsGETHandler(c *gin.Context) {
 		targetAccountIDs = append(targetAccountIDs, id)
 	}
 
-	relationships := []apimodel.Relationship{}
-
-	for _, targetAccountID := range targetAccountIDs {
-		r, errWithCode := m.processor.Account().RelationshipGet(c.Request.Context(), authed.Account, targetAccountID)
-		if errWithCode != nil {
-			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
-			return
-		}
-		relationships = append(relationships, *r)
+	relationships, errWithCode := m.processor.Account().RelationshipsGet(c.Request.Context(), authed.Account, targetAccountIDs)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
 	}
 
 	apiutil.JSON(c, http.StatusOK, relationships)
@@ -121,7 +121,7 @@ func (suite *MuteTestSuite) TestPostWithPreviousMute() {
 
 func (suite *MuteTestSuite) TestPostUnmuteSelf() {
 	accountID := suite.testAccounts["local_account_1"].ID
-	_, err := suite.postUnmute(accountID, http.StatusNotAcceptable, `{"error":"Not Acceptable: getMuteTarget: account 01F8MH1H7YV1Z7D2C8K2730QBF cannot mute or unmute itself"}`)
+	_, err := suite.postUnmute(accountID, http.StatusNotAcceptable, `{"error":"Not Acceptable: getMuteTarget: account 01F8MH1H7YV1Z7D2C8K2730QBF cannot mute or unmute itself","error_code":"not_acceptable"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -129,7 +129,7 @@ func (suite *MuteTestSuite) TestPostUnmuteSelf() {
 
 func (suite *MuteTestSuite) TestPostUnmuteNonexistentAccount() {
 	accountID := "not_even_a_real_ULID"
-	_, err := suite.postUnmute(accountID, http.StatusNotFound, `{"error":"Not Found: getMuteTarget: target account not_even_a_real_ULID not found in the db"}`)
+	_, err := suite.postUnmute(accountID, http.StatusNotFound, `{"error":"Not Found: getMuteTarget: target account not_even_a_real_ULID not found in the db","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
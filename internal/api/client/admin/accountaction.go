@@ -19,7 +19,6 @@
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -52,13 +51,44 @@
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken, currently only supports `suspend`.
+//		description: Type of action to be taken, one of `suspend`, `silence`, or `warn`.
 //		type: string
 //		required: true
 //	-
 //		name: text
 //		in: formData
-//		description: Optional text describing why this action was taken.
+//		description: >-
+//			Optional text describing why this action was taken.
+//			For `warn` actions, and `suspend` or `silence` actions
+//			against local accounts, this text is shown to the account.
+//		type: string
+//	-
+//		name: status_ids[]
+//		in: formData
+//		description: IDs of statuses by the account to cite in a warning. Only used for `warn` actions.
+//		type: array
+//		items:
+//			type: string
+//	-
+//		name: statuses_action
+//		in: formData
+//		description: >-
+//			Action to take against statuses cited in a warning, one of
+//			`none`, `delete_statuses`, or `mark_statuses_as_sensitive`.
+//			Only used for `warn` actions.
+//		type: string
+//		default: none
+//	-
+//		name: rule_ids[]
+//		in: formData
+//		description: IDs of instance rules to cite in a warning. Only used for `warn` actions.
+//		type: array
+//		items:
+//			type: string
+//	-
+//		name: report_id
+//		in: formData
+//		description: ID of a report targeting the account which led to a warning. Only used for `warn` actions.
 //		type: string
 //
 //	security:
@@ -109,9 +139,8 @@ func (m *Module) AccountActionPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageUsers); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -0,0 +1,218 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// AccountMediaQuotaPOSTHandler swagger:operation POST /api/v1/admin/accounts/{id}/media_quota adminAccountMediaQuotaSet
+//
+// Override the media storage quota of one local account.
+//
+// The instance default quota is set by `media-local-quota`.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//	- application/json
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		required: true
+//		in: path
+//		description: ID of the account.
+//		type: string
+//	-
+//		name: quota
+//		required: true
+//		in: formData
+//		description: Max total bytes of media that may be stored for the account. 0 means no quota.
+//		type: integer
+//		minimum: 0
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:accounts
+//
+//	responses:
+//		'200':
+//			description: Storage usage of the account, including the new quota.
+//			schema:
+//				"$ref": "#/definitions/accountUsage"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unprocessable entity
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountMediaQuotaPOSTHandler(c *gin.Context) {
+	m.accountMediaQuota(c, false)
+}
+
+// AccountMediaQuotaDELETEHandler swagger:operation DELETE /api/v1/admin/accounts/{id}/media_quota adminAccountMediaQuotaReset
+//
+// Remove any media storage quota override of one local account,
+// such that the instance default quota applies to it again.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		required: true
+//		in: path
+//		description: ID of the account.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:accounts
+//
+//	responses:
+//		'200':
+//			description: Storage usage of the account, including the default quota.
+//			schema:
+//				"$ref": "#/definitions/accountUsage"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unprocessable entity
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AccountMediaQuotaDELETEHandler(c *gin.Context) {
+	m.accountMediaQuota(c, true)
+}
+
+// accountMediaQuota contains the shared logic of the media quota
+// handlers, either setting the quota from form, or resetting it.
+func (m *Module) accountMediaQuota(c *gin.Context, reset bool) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeAdminWriteAccounts,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageUsers); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	targetAcctID, errWithCode := apiutil.ParseID(c.Param(apiutil.IDKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.AdminMediaQuotaRequest{}
+	if !reset {
+		if err := c.ShouldBind(form); err != nil {
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+
+		if form.Quota == nil {
+			err := errors.New("no quota specified")
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+	}
+
+	usage, errWithCode := m.processor.Admin().AccountMediaQuotaSet(
+		c.Request.Context(),
+		authed.Account,
+		targetAcctID,
+		form.Quota,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, usage)
+}
@@ -18,7 +18,6 @@
 package admin
 
 import (
-	"fmt"
 	"net/http"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -110,9 +109,8 @@ func (m *Module) AccountRejectPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageUsers); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -110,6 +110,21 @@
 //		type: string
 //		description: Lookup users with this IP address.
 //	-
+//		name: email_domain
+//		in: query
+//		type: string
+//		description: Filter for users with a (confirmed or unconfirmed) email address at this domain, eg., `example.org`.
+//	-
+//		name: created_after
+//		in: query
+//		type: string
+//		description: Filter for accounts created after this time. RFC3339 timestamp or `YYYY-MM-DD` date.
+//	-
+//		name: created_before
+//		in: query
+//		type: string
+//		description: Filter for accounts created before this time. RFC3339 timestamp or `YYYY-MM-DD` date.
+//	-
 //		name: staff
 //		in: query
 //		type: boolean
@@ -207,9 +222,8 @@ func (m *Module) AccountsGETV1Handler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageUsers); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -340,17 +354,20 @@ func (m *Module) AccountsGETV1Handler(c *gin.Context) {
 
 	// Parse out all optional params from the query.
 	params := &apimodel.AdminGetAccountsRequest{
-		Origin:      origin,
-		Status:      status,
-		Permissions: permissions,
-		RoleIDs:     nil, // Can't do in V1.
-		InvitedBy:   "",  // Can't do in V1.
-		Username:    c.Query(apiutil.UsernameKey),
-		DisplayName: c.Query(apiutil.AdminDisplayNameKey),
-		ByDomain:    c.Query(apiutil.AdminByDomainKey),
-		Email:       c.Query(apiutil.AdminEmailKey),
-		IP:          c.Query(apiutil.AdminIPKey),
-		APIVersion:  1,
+		Origin:        origin,
+		Status:        status,
+		Permissions:   permissions,
+		RoleIDs:       nil, // Can't do in V1.
+		InvitedBy:     "",  // Can't do in V1.
+		Username:      c.Query(apiutil.UsernameKey),
+		DisplayName:   c.Query(apiutil.AdminDisplayNameKey),
+		ByDomain:      c.Query(apiutil.AdminByDomainKey),
+		Email:         c.Query(apiutil.AdminEmailKey),
+		IP:            c.Query(apiutil.AdminIPKey),
+		EmailDomain:   c.Query(apiutil.AdminEmailDomainKey),
+		CreatedAfter:  c.Query(apiutil.AdminCreatedAfterKey),
+		CreatedBefore: c.Query(apiutil.AdminCreatedBeforeKey),
+		APIVersion:    1,
 	}
 
 	resp, errWithCode := m.processor.Admin().AccountsGet(
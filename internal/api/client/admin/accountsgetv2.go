@@ -89,6 +89,21 @@
 //		type: string
 //		description: Lookup users with this IP address.
 //	-
+//		name: email_domain
+//		in: query
+//		type: string
+//		description: Filter for users with a (confirmed or unconfirmed) email address at this domain, eg., `example.org`.
+//	-
+//		name: created_after
+//		in: query
+//		type: string
+//		description: Filter for accounts created after this time. RFC3339 timestamp or `YYYY-MM-DD` date.
+//	-
+//		name: created_before
+//		in: query
+//		type: string
+//		description: Filter for accounts created before this time. RFC3339 timestamp or `YYYY-MM-DD` date.
+//	-
 //		name: max_id
 //		in: query
 //		type: string
@@ -160,12 +175,10 @@
 package admin
 
 import (
-	"fmt"
 	"net/http"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
-	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"github.com/gin-gonic/gin"
 )
@@ -180,9 +193,8 @@ func (m *Module) AccountsGETV2Handler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageUsers); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -204,17 +216,20 @@ func (m *Module) AccountsGETV2Handler(c *gin.Context) {
 
 	// Parse out all optional params from the query.
 	params := &apimodel.AdminGetAccountsRequest{
-		Origin:      c.Query(apiutil.AdminOriginKey),
-		Status:      c.Query(apiutil.AdminStatusKey),
-		Permissions: c.Query(apiutil.AdminPermissionsKey),
-		RoleIDs:     c.QueryArray(apiutil.AdminRoleIDsKey),
-		InvitedBy:   c.Query(apiutil.AdminInvitedByKey),
-		Username:    c.Query(apiutil.UsernameKey),
-		DisplayName: c.Query(apiutil.AdminDisplayNameKey),
-		ByDomain:    c.Query(apiutil.AdminByDomainKey),
-		Email:       c.Query(apiutil.AdminEmailKey),
-		IP:          c.Query(apiutil.AdminIPKey),
-		APIVersion:  2,
+		Origin:        c.Query(apiutil.AdminOriginKey),
+		Status:        c.Query(apiutil.AdminStatusKey),
+		Permissions:   c.Query(apiutil.AdminPermissionsKey),
+		RoleIDs:       c.QueryArray(apiutil.AdminRoleIDsKey),
+		InvitedBy:     c.Query(apiutil.AdminInvitedByKey),
+		Username:      c.Query(apiutil.UsernameKey),
+		DisplayName:   c.Query(apiutil.AdminDisplayNameKey),
+		ByDomain:      c.Query(apiutil.AdminByDomainKey),
+		Email:         c.Query(apiutil.AdminEmailKey),
+		IP:            c.Query(apiutil.AdminIPKey),
+		EmailDomain:   c.Query(apiutil.AdminEmailDomainKey),
+		CreatedAfter:  c.Query(apiutil.AdminCreatedAfterKey),
+		CreatedBefore: c.Query(apiutil.AdminCreatedBeforeKey),
+		APIVersion:    2,
 	}
 
 	resp, errWithCode := m.processor.Admin().AccountsGet(
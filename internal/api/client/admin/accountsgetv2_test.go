@@ -26,6 +26,7 @@
 	"testing"
 
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/admin"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
 )
@@ -135,7 +136,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "557041",
       "highlighted": true
     },
     "confirmed": true,
@@ -701,6 +702,39 @@ func (suite *AccountsGetTestSuite) TestAccountsMinID() {
 ]`, dst.String())
 }
 
+func (suite *AccountsGetTestSuite) TestAccountsEmailDomainCreatedAfter() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.AccountsV2Path + "?email_domain=example.org&created_after=2025-01-01"
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+
+	suite.adminModule.AccountsGETV2Handler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	var accounts []*apimodel.AdminAccountInfo
+	if err := json.NewDecoder(recorder.Body).Decode(&accounts); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(accounts, 1)
+	suite.Equal("media_mogul", accounts[0].Username)
+
+	// Filters should be carried over to paging links.
+	link := recorder.Header().Get("Link")
+	suite.Equal(`<http://localhost:8080/api/v2/admin/accounts?created_after=2025-01-01&email_domain=example.org&limit=50&max_id=%2F%40media_mogul>; rel="next", <http://localhost:8080/api/v2/admin/accounts?created_after=2025-01-01&email_domain=example.org&limit=50&min_id=%2F%40media_mogul>; rel="prev"`, link)
+}
+
+func (suite *AccountsGetTestSuite) TestAccountsBadCreatedBefore() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.AccountsV2Path + "?created_before=last+tuesday"
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+
+	suite.adminModule.AccountsGETV2Handler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Equal(`{"error":"Bad Request: invalid created_before provided: last tuesday; must be RFC3339 timestamp or YYYY-MM-DD date","error_code":"bad_request"}`, recorder.Body.String())
+}
+
 func TestAccountsGetTestSuite(t *testing.T) {
 	suite.Run(t, &AccountsGetTestSuite{})
 }
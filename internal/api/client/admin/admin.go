@@ -28,6 +28,10 @@
 
 const (
 	BasePath                                 = "/v1/admin"
+	AnnouncementsPath                        = BasePath + "/announcements"
+	AnnouncementsPathWithID                  = AnnouncementsPath + "/:" + apiutil.IDKey
+	InvitesPath                              = BasePath + "/invites"
+	InvitesPathWithID                        = InvitesPath + "/:" + apiutil.IDKey
 	EmojiPath                                = BasePath + "/custom_emojis"
 	EmojiPathWithID                          = EmojiPath + "/:" + apiutil.IDKey
 	EmojiCategoriesPath                      = EmojiPath + "/categories"
@@ -49,6 +53,12 @@
 	DomainPermissionSubscriptionRemovePath   = DomainPermissionSubscriptionsPathWithID + "/remove"
 	DomainPermissionSubscriptionTestPath     = DomainPermissionSubscriptionsPathWithID + "/test"
 	DomainKeysExpirePath                     = BasePath + "/domain_keys_expire"
+	DrainPath                                = BasePath + "/drain"
+	FailedActivitiesPath                     = BasePath + "/failed_activities"
+	FailedActivitiesCountPath                = FailedActivitiesPath + "/counts"
+	FailedActivitiesPathWithID               = FailedActivitiesPath + "/:" + apiutil.IDKey
+	FailedActivityRetryPath                  = FailedActivitiesPathWithID + "/retry"
+	FederationStatsPath                      = BasePath + "/federation/stats"
 	HeaderAllowsPath                         = BasePath + "/header_allows"
 	HeaderAllowsPathWithID                   = HeaderAllowsPath + "/:" + apiutil.IDKey
 	HeaderBlocksPath                         = BasePath + "/header_blocks"
@@ -56,19 +66,43 @@
 	AccountsV1Path                           = BasePath + "/accounts"
 	AccountsV2Path                           = "/v2/admin/accounts"
 	AccountsPathWithID                       = AccountsV1Path + "/:" + apiutil.IDKey
+	AccountsBulkActionPath                   = AccountsV1Path + "/bulk_action"
 	AccountsActionPath                       = AccountsPathWithID + "/action"
 	AccountsApprovePath                      = AccountsPathWithID + "/approve"
 	AccountsRejectPath                       = AccountsPathWithID + "/reject"
+	AccountsUnsuspendPath                    = AccountsPathWithID + "/unsuspend"
+	AccountsUnsilencePath                    = AccountsPathWithID + "/unsilence"
+	AccountsUsagePath                        = AccountsPathWithID + "/usage"
+	AccountsMediaQuotaPath                   = AccountsPathWithID + "/media_quota"
+	AppealsPath                              = BasePath + "/appeals"
+	AppealsPathWithID                        = AppealsPath + "/:" + apiutil.IDKey
+	AppealsApprovePath                       = AppealsPathWithID + "/approve"
+	AppealsRejectPath                        = AppealsPathWithID + "/reject"
+	CustomDomainsPath                        = BasePath + "/custom_domains"
+	CustomDomainsPathWithID                  = CustomDomainsPath + "/:" + apiutil.IDKey
+	CustomDomainsApprovePath                 = CustomDomainsPathWithID + "/approve"
+	CustomDomainsRejectPath                  = CustomDomainsPathWithID + "/reject"
+	InstancesPath                            = BasePath + "/instances"
+	JobsPath                                 = BasePath + "/jobs"
+	JobsPathWithID                           = JobsPath + "/:" + apiutil.IDKey
+	JobTriggerPath                           = JobsPathWithID + "/trigger"
+	JobPausePath                             = JobsPathWithID + "/pause"
+	JobResumePath                            = JobsPathWithID + "/resume"
 	MediaCleanupPath                         = BasePath + "/media_cleanup"
 	MediaPurgePath                           = BasePath + "/media_purge"
 	MediaRefetchPath                         = BasePath + "/media_refetch"
+	RelaysPath                               = BasePath + "/relays"
+	RelaysPathWithID                         = RelaysPath + "/:" + apiutil.IDKey
 	ReportsPath                              = BasePath + "/reports"
 	ReportsPathWithID                        = ReportsPath + "/:" + apiutil.IDKey
 	ReportsResolvePath                       = ReportsPathWithID + "/resolve"
+	TagAliasesPath                           = BasePath + "/tag_aliases"
+	TagAliasesPathWithName                   = TagAliasesPath + "/:" + apiutil.TagNameKey
 	EmailPath                                = BasePath + "/email"
 	EmailTestPath                            = EmailPath + "/test"
 	InstanceRulesPath                        = BasePath + "/instance/rules"
 	InstanceRulesPathWithID                  = InstanceRulesPath + "/:" + apiutil.IDKey
+	WorkersPath                              = BasePath + "/workers"
 
 	FilterQueryKey        = "filter"
 	MaxShortcodeDomainKey = "max_shortcode_domain"
@@ -139,6 +173,16 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, DomainPermissionSubscriptionRemovePath, m.DomainPermissionSubscriptionRemovePOSTHandler)
 	attachHandler(http.MethodPost, DomainPermissionSubscriptionTestPath, m.DomainPermissionSubscriptionTestPOSTHandler)
 
+	// failed activity stuff
+	attachHandler(http.MethodGet, FailedActivitiesPath, m.FailedActivitiesGETHandler)
+	attachHandler(http.MethodGet, FailedActivitiesCountPath, m.FailedActivitiesCountGETHandler)
+	attachHandler(http.MethodGet, FailedActivitiesPathWithID, m.FailedActivityGETHandler)
+	attachHandler(http.MethodDelete, FailedActivitiesPathWithID, m.FailedActivityDELETEHandler)
+	attachHandler(http.MethodPost, FailedActivityRetryPath, m.FailedActivityRetryPOSTHandler)
+
+	// federation stats stuff
+	attachHandler(http.MethodGet, FederationStatsPath, m.FederationStatsGETHandler)
+
 	// header filtering administration routes
 	attachHandler(http.MethodGet, HeaderAllowsPathWithID, m.HeaderFilterAllowGET)
 	attachHandler(http.MethodGet, HeaderBlocksPathWithID, m.HeaderFilterBlockGET)
@@ -152,19 +196,60 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// domain maintenance stuff
 	attachHandler(http.MethodPost, DomainKeysExpirePath, m.DomainKeysExpirePOSTHandler)
 
+	// server maintenance stuff
+	attachHandler(http.MethodPost, DrainPath, m.DrainPOSTHandler)
+
+	// background jobs + workers stuff
+	attachHandler(http.MethodGet, JobsPath, m.JobsGETHandler)
+	attachHandler(http.MethodPost, JobTriggerPath, m.JobTriggerPOSTHandler)
+	attachHandler(http.MethodPost, JobPausePath, m.JobPausePOSTHandler)
+	attachHandler(http.MethodPost, JobResumePath, m.JobResumePOSTHandler)
+	attachHandler(http.MethodGet, WorkersPath, m.WorkerPoolsGETHandler)
+
 	// accounts stuff
 	attachHandler(http.MethodGet, AccountsV1Path, m.AccountsGETV1Handler)
 	attachHandler(http.MethodGet, AccountsV2Path, m.AccountsGETV2Handler)
 	attachHandler(http.MethodGet, AccountsPathWithID, m.AccountGETHandler)
+	attachHandler(http.MethodPost, AccountsBulkActionPath, m.AccountsBulkActionPOSTHandler)
 	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
 	attachHandler(http.MethodPost, AccountsApprovePath, m.AccountApprovePOSTHandler)
 	attachHandler(http.MethodPost, AccountsRejectPath, m.AccountRejectPOSTHandler)
+	attachHandler(http.MethodPost, AccountsUnsuspendPath, m.AccountUnsuspendPOSTHandler)
+	attachHandler(http.MethodPost, AccountsUnsilencePath, m.AccountUnsilencePOSTHandler)
+	attachHandler(http.MethodGet, AccountsUsagePath, m.AccountUsageGETHandler)
+	attachHandler(http.MethodPost, AccountsMediaQuotaPath, m.AccountMediaQuotaPOSTHandler)
+	attachHandler(http.MethodDelete, AccountsMediaQuotaPath, m.AccountMediaQuotaDELETEHandler)
+
+	// appeals stuff
+	attachHandler(http.MethodGet, AppealsPath, m.AppealsGETHandler)
+	attachHandler(http.MethodGet, AppealsPathWithID, m.AppealGETHandler)
+	attachHandler(http.MethodPost, AppealsApprovePath, m.AppealApprovePOSTHandler)
+	attachHandler(http.MethodPost, AppealsRejectPath, m.AppealRejectPOSTHandler)
+
+	// custom domains stuff
+	attachHandler(http.MethodGet, CustomDomainsPath, m.CustomDomainsGETHandler)
+	attachHandler(http.MethodPost, CustomDomainsApprovePath, m.CustomDomainApprovePOSTHandler)
+	attachHandler(http.MethodPost, CustomDomainsRejectPath, m.CustomDomainRejectPOSTHandler)
+
+	// instances stuff
+	attachHandler(http.MethodGet, InstancesPath, m.InstancesGETHandler)
 
 	// media stuff
 	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
 	attachHandler(http.MethodPost, MediaPurgePath, m.MediaPurgePOSTHandler)
 	attachHandler(http.MethodPost, MediaRefetchPath, m.MediaRefetchPOSTHandler)
 
+	// relays stuff
+	attachHandler(http.MethodGet, RelaysPath, m.RelaysGETHandler)
+	attachHandler(http.MethodPost, RelaysPath, m.RelayPOSTHandler)
+	attachHandler(http.MethodGet, RelaysPathWithID, m.RelayGETHandler)
+	attachHandler(http.MethodDelete, RelaysPathWithID, m.RelayDELETEHandler)
+
+	// tag alias stuff
+	attachHandler(http.MethodGet, TagAliasesPath, m.TagAliasesGETHandler)
+	attachHandler(http.MethodPost, TagAliasesPath, m.TagAliasPOSTHandler)
+	attachHandler(http.MethodDelete, TagAliasesPathWithName, m.TagAliasDELETEHandler)
+
 	// reports stuff
 	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
 	attachHandler(http.MethodGet, ReportsPathWithID, m.ReportGETHandler)
@@ -173,6 +258,17 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// email stuff
 	attachHandler(http.MethodPost, EmailTestPath, m.EmailTestPOSTHandler)
 
+	// announcements stuff
+	attachHandler(http.MethodGet, AnnouncementsPath, m.AnnouncementsGETHandler)
+	attachHandler(http.MethodPost, AnnouncementsPath, m.AnnouncementPOSTHandler)
+	attachHandler(http.MethodGet, AnnouncementsPathWithID, m.AnnouncementGETHandler)
+	attachHandler(http.MethodPatch, AnnouncementsPathWithID, m.AnnouncementPATCHHandler)
+	attachHandler(http.MethodDelete, AnnouncementsPathWithID, m.AnnouncementDELETEHandler)
+
+	// invites stuff
+	attachHandler(http.MethodGet, InvitesPath, m.InvitesGETHandler)
+	attachHandler(http.MethodDelete, InvitesPathWithID, m.InviteDELETEHandler)
+
 	// instance rules stuff
 	attachHandler(http.MethodGet, InstanceRulesPath, m.RulesGETHandler)
 	attachHandler(http.MethodGet, InstanceRulesPathWithID, m.RuleGETHandler)
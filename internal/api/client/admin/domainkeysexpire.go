@@ -19,7 +19,6 @@
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
 	"strings"
 
@@ -67,7 +66,7 @@
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin:write
+//		- admin:write:federation
 //
 //	responses:
 //		'202':
@@ -110,16 +109,15 @@
 func (m *Module) DomainKeysExpirePOSTHandler(c *gin.Context) {
 	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
-		apiutil.ScopeAdminWrite,
+		apiutil.ScopeAdminWriteFederation,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageFederation); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -19,7 +19,6 @@
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -59,11 +58,13 @@
 //			No action = default (not limited).
 //			Mark sensitive = mark all media from the limited domain as sensitive.
 //			Reject = do not download media from the limited domain. Serve a link to the media instead.
+//			Sensitive blurhash only = do not download media marked sensitive from the limited domain. Serve its blurhash and a link to the media instead.
 //		type: string
 //		enum:
 //			- no_action
 //			- mark_sensitive
 //			- reject
+//			- sensitive_blurhash_only
 //		default: no_action
 //	-
 //		name: follows_policy
@@ -108,6 +109,18 @@
 //			- mute
 //		default: no_action
 //	-
+//		name: federation_policy
+//		in: formData
+//		description: |-
+//			Policy to apply to federation with the limited domain.
+//			No action = default (not limited).
+//			Pause = temporarily pause federation with the limited domain: outgoing deliveries are held back until federation is resumed, and incoming activities are rejected with 503 Service Unavailable and a Retry-After header.
+//		type: string
+//		enum:
+//			- no_action
+//			- pause
+//		default: no_action
+//	-
 //		name: content_warning
 //		in: formData
 //		description: Content warning to prepend to posts from accounts on this instance.
@@ -160,9 +173,8 @@ func (m *Module) DomainLimitsPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageFederation); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -197,6 +209,7 @@ func (m *Module) DomainLimitsPOSTHandler(c *gin.Context) {
 		util.PtrOrValue(form.FollowsPolicy, apimodel.FollowsPolicyNoAction),
 		util.PtrOrValue(form.StatusesPolicy, apimodel.StatusesPolicyNoAction),
 		util.PtrOrValue(form.AccountsPolicy, apimodel.AccountsPolicyNoAction),
+		util.PtrOrValue(form.FederationPolicy, apimodel.FederationPolicyNoAction),
 		util.PtrOrZero(form.ContentWarning),
 		util.PtrOrZero(form.PublicComment),
 		util.PtrOrZero(form.PrivateComment),
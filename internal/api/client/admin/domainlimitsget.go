@@ -18,11 +18,10 @@
 package admin
 
 import (
-	"fmt"
 	"net/http"
 
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
-	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"github.com/gin-gonic/gin"
 )
@@ -45,6 +44,18 @@
 //
 // If paging, items will be returned in descending chronological order (newest first), with sequential IDs (bigger = newer).
 //
+// Alternatively, to page alphabetically by domain, use the `limit`, `max_cursor` and/or `min_cursor` params.
+// To fetch the first page, pass an empty `max_cursor` (e.g. `?limit=20&max_cursor=`). Cursors are opaque tokens,
+// and subsequent cursors should be taken from the returned Link header rather than constructed by hand.
+//
+// Example:
+//
+// ```
+// <https://example.org/api/v1/admin/domain_limits?limit=20&max_cursor=WyJleGFtcGxlLm9yZyIsIjAxRkMwU0tBNDhITlNWUjZZS1pDUUdTMlY4Il0>; rel="next", <https://example.org/api/v1/admin/domain_limits?limit=20&min_cursor=WyJhbm90aGVyLm9yZyIsIjAxRkMwU0tXNUpLMlE0RVZBVjJCNDYyWVkwIl0>; rel="prev"
+// ````
+//
+// Cursor paging cannot be combined with ID paging params.
+//
 //	---
 //	tags:
 //	- admin
@@ -75,6 +86,18 @@
 //			The item with the specified ID will not be included in the response.
 //		in: query
 //	-
+//		name: max_cursor
+//		type: string
+//		description: >-
+//			Return only items sorting alphabetically *AFTER* the given cursor (for paging downwards).
+//		in: query
+//	-
+//		name: min_cursor
+//		type: string
+//		description: >-
+//			Return only items sorting alphabetically immediately *BEFORE* the given cursor (for paging upwards).
+//		in: query
+//	-
 //		name: limit
 //		type: integer
 //		description: Number of items to return. Use 0 to return all (no paging).
@@ -118,9 +141,8 @@ func (m *Module) DomainLimitsGETHandler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageFederation); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -131,7 +153,15 @@ func (m *Module) DomainLimitsGETHandler(c *gin.Context) {
 
 	// Allow paging but don't
 	// enforce or use it by default.
-	page, errWithCode := paging.ParseIDPage(c,
+	parsePage := paging.ParseIDPage
+	_, haveMinCursor := c.GetQuery(paging.MinCursorKey)
+	_, haveMaxCursor := c.GetQuery(paging.MaxCursorKey)
+	if haveMinCursor || haveMaxCursor {
+		// Cursor paging requested.
+		parsePage = paging.ParseCursorPage
+	}
+
+	page, errWithCode := parsePage(c,
 		0,   // min items
 		100, // max items
 		0,   // default (no paging)
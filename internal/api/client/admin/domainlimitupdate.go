@@ -19,7 +19,6 @@
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -58,12 +57,14 @@
 //			No action = default (not limited).
 //			Mark sensitive = mark all media from the limited domain as sensitive.
 //			Reject = do not download media from the limited domain. Serve a link to the media instead.
+//			Sensitive blurhash only = do not download media marked sensitive from the limited domain. Serve its blurhash and a link to the media instead.
 //			Omit to keep current value.
 //		type: string
 //		enum:
 //			- no_action
 //			- mark_sensitive
 //			- reject
+//			- sensitive_blurhash_only
 //	-
 //		name: follows_policy
 //		in: formData
@@ -108,6 +109,19 @@
 //			- mute
 //		default: no_action
 //	-
+//		name: federation_policy
+//		in: formData
+//		description: |-
+//			Policy to apply to federation with the limited domain.
+//			No action = default (not limited).
+//			Pause = temporarily pause federation with the limited domain: outgoing deliveries are held back until federation is resumed, and incoming activities are rejected with 503 Service Unavailable and a Retry-After header.
+//			Omit to keep current value.
+//		type: string
+//		enum:
+//			- no_action
+//			- pause
+//		default: no_action
+//	-
 //		name: content_warning
 //		in: formData
 //		description: Content warning to prepend to posts from accounts on this instance. Omit to keep current value.
@@ -160,9 +174,8 @@ func (m *Module) DomainLimitPUTHandler(c *gin.Context) {
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageFederation); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -193,10 +206,11 @@ func (m *Module) DomainLimitPUTHandler(c *gin.Context) {
 		form.FollowsPolicy == nil &&
 		form.StatusesPolicy == nil &&
 		form.AccountsPolicy == nil &&
+		form.FederationPolicy == nil &&
 		form.ContentWarning == nil &&
 		form.PublicComment == nil &&
 		form.PrivateComment == nil {
-		const text = "nothing to update; at least one of media_policy, follows_policy, statuses_policy, accounts_policy, content_warning, public_comment, or private_comment must be set"
+		const text = "nothing to update; at least one of media_policy, follows_policy, statuses_policy, accounts_policy, federation_policy, content_warning, public_comment, or private_comment must be set"
 		errWithCode := gtserror.NewErrorBadRequest(errors.New(text), text)
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -209,6 +223,7 @@ func (m *Module) DomainLimitPUTHandler(c *gin.Context) {
 		form.FollowsPolicy,
 		form.StatusesPolicy,
 		form.AccountsPolicy,
+		form.FederationPolicy,
 		form.ContentWarning,
 		form.PublicComment,
 		form.PrivateComment,
@@ -280,7 +280,7 @@ func (suite *EmojiCreateTestSuite) TestEmojiCreateAlreadyExists() {
 	suite.NoError(err)
 	suite.NotEmpty(b)
 
-	suite.Equal(`{"error":"Conflict: emoji with shortcode already exists"}`, string(b))
+	suite.Equal(`{"error":"Conflict: emoji with shortcode already exists","error_code":"conflict"}`, string(b))
 }
 
 func TestEmojiCreateTestSuite(t *testing.T) {
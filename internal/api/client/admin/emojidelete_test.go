@@ -87,7 +87,7 @@ func (suite *EmojiDeleteTestSuite) TestEmojiDelete2() {
 	suite.NoError(err)
 	suite.NotNil(b)
 
-	suite.Equal(`{"error":"Bad Request: emoji with id 01GD5KP5CQEE1R3X43Y1EHS2CW was not a local emoji, will not delete"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: emoji with id 01GD5KP5CQEE1R3X43Y1EHS2CW was not a local emoji, will not delete","error_code":"bad_request"}`, string(b))
 
 	// emoji should still be in the db
 	dbEmoji, err := suite.db.GetEmojiByID(suite.T().Context(), testEmoji.ID)
@@ -108,7 +108,7 @@ func (suite *EmojiDeleteTestSuite) TestEmojiDeleteNotFound() {
 	b, err := io.ReadAll(recorder.Body)
 	suite.NoError(err)
 	suite.NotNil(b)
-	suite.Equal(`{"error":"Not Found"}`, string(b))
+	suite.Equal(`{"error":"Not Found","error_code":"not_found"}`, string(b))
 }
 
 func TestEmojiDeleteTestSuite(t *testing.T) {
@@ -111,7 +111,7 @@ func (suite *EmojiGetTestSuite) TestEmojiGetNotFound() {
 	b, err := io.ReadAll(recorder.Body)
 	suite.NoError(err)
 	suite.NotNil(b)
-	suite.Equal(`{"error":"Not Found"}`, string(b))
+	suite.Equal(`{"error":"Not Found","error_code":"not_found"}`, string(b))
 }
 
 func TestEmojiGetTestSuite(t *testing.T) {
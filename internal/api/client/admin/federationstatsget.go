@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"github.com/gin-gonic/gin"
+)
+
+// FederationStatsGETHandler swagger:operation GET /api/v1/admin/federation/stats federationStatsGet
+//
+// View per-domain federation traffic counters: activities received and sent,
+// bytes of remote media cached, and when each domain was last seen.
+//
+// Counters are accumulated in memory and written to the database
+// about once a minute, so the most recent traffic may not be included yet.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: sort
+//		type: string
+//		description: >-
+//			Column to sort by. One of domain, activities_received,
+//			activities_sent, media_bytes, or last_seen_at.
+//		default: activities_received
+//		in: query
+//	-
+//		name: order
+//		type: string
+//		description: Sort order, either asc or desc.
+//		default: desc
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of domains to return.
+//		default: 40
+//		minimum: 1
+//		maximum: 200
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read:federation
+//
+//	responses:
+//		'200':
+//			description: An array of per-domain federation stats.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminFederationStat"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) FederationStatsGETHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeAdminReadFederation,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageFederation); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	limit, errWithCode := apiutil.ParseLimit(c.Query(apiutil.LimitKey), 40, 200, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	sort := c.DefaultQuery(apiutil.FederationStatsSortKey, "activities_received")
+	order := c.DefaultQuery(apiutil.FederationStatsOrderKey, "desc")
+
+	stats, errWithCode := m.processor.Admin().FederationStatsGet(
+		c.Request.Context(),
+		sort,
+		order,
+		limit,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, stats)
+}
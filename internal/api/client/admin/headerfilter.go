@@ -19,7 +19,6 @@
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -33,16 +32,14 @@
 func (m *Module) getHeaderFilter(c *gin.Context, get func(context.Context, string) (*apimodel.HeaderFilter, gtserror.WithCode)) {
 	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
-		apiutil.ScopeAdminRead,
+		apiutil.ScopeAdminReadHeaderFilters,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	if !*authed.User.Admin {
-		const text = "user not an admin"
-		errWithCode := gtserror.NewErrorForbidden(errors.New(text), text)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageBlocks); errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
@@ -71,16 +68,14 @@ func (m *Module) getHeaderFilter(c *gin.Context, get func(context.Context, strin
 func (m *Module) getHeaderFilters(c *gin.Context, get func(context.Context) ([]*apimodel.HeaderFilter, gtserror.WithCode)) {
 	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
-		apiutil.ScopeAdminRead,
+		apiutil.ScopeAdminReadHeaderFilters,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	if !*authed.User.Admin {
-		const text = "user not an admin"
-		errWithCode := gtserror.NewErrorForbidden(errors.New(text), text)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageBlocks); errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
@@ -103,16 +98,14 @@ func (m *Module) getHeaderFilters(c *gin.Context, get func(context.Context) ([]*
 func (m *Module) createHeaderFilter(c *gin.Context, create func(context.Context, *gtsmodel.Account, *apimodel.HeaderFilterRequest) (*apimodel.HeaderFilter, gtserror.WithCode)) {
 	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
-		apiutil.ScopeAdminWrite,
+		apiutil.ScopeAdminWriteHeaderFilters,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	if !*authed.User.Admin {
-		const text = "user not an admin"
-		errWithCode := gtserror.NewErrorForbidden(errors.New(text), text)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageBlocks); errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
@@ -152,16 +145,14 @@ func (m *Module) createHeaderFilter(c *gin.Context, create func(context.Context,
 func (m *Module) deleteHeaderFilter(c *gin.Context, delete func(context.Context, string) gtserror.WithCode) {
 	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
-		apiutil.ScopeAdminWrite,
+		apiutil.ScopeAdminWriteHeaderFilters,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	if !*authed.User.Admin {
-		const text = "user not an admin"
-		errWithCode := gtserror.NewErrorForbidden(errors.New(text), text)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsManageBlocks); errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
@@ -42,7 +42,7 @@
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin:write
+//		- admin:write:header_filters
 //
 //	responses:
 //		'200':
@@ -90,7 +90,7 @@ func (m *Module) HeaderFilterAllowPOST(c *gin.Context) {
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin:write
+//		- admin:write:header_filters
 //
 //	responses:
 //		'200':
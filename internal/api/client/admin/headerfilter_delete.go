@@ -39,7 +39,7 @@
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin:write
+//		- admin:write:header_filters
 //
 //	responses:
 //		'202':
@@ -86,7 +86,7 @@ func (m *Module) HeaderFilterAllowDELETE(c *gin.Context) {
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin:write
+//		- admin:write:header_filters
 //
 //	responses:
 //		'202':
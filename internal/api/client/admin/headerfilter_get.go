@@ -37,7 +37,7 @@
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin:read
+//		- admin:read:header_filters
 //
 //	responses:
 //		'200':
@@ -86,7 +86,7 @@ func (m *Module) HeaderFilterAllowGET(c *gin.Context) {
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin:read
+//		- admin:read:header_filters
 //
 //	responses:
 //		'200':
@@ -127,7 +127,7 @@ func (m *Module) HeaderFilterBlockGET(c *gin.Context) {
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:read:header_filters
 //
 //	responses:
 //		'200':
@@ -170,7 +170,7 @@ func (m *Module) HeaderFilterAllowsGET(c *gin.Context) {
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:read:header_filters
 //
 //	responses:
 //		'200':
@@ -271,7 +271,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "id": "admin",
         "name": "admin",
         "color": "",
-        "permissions": "546033",
+        "permissions": "557041",
         "highlighted": true
       },
       "confirmed": true,
@@ -329,7 +329,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "id": "admin",
         "name": "admin",
         "color": "",
-        "permissions": "546033",
+        "permissions": "557041",
         "highlighted": true
       },
       "confirmed": true,
@@ -1175,11 +1175,26 @@ func (suite *ReportsGetTestSuite) TestReportsGetNotAdmin() {
 	testToken := suite.testTokens["local_account_1"]
 	testUser := suite.testUsers["local_account_1"]
 
-	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: token has insufficient scope permission"}`, nil, "", "", "", "", "", 20)
+	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: token has insufficient scope permission","error_code":"scope_insufficient"}`, nil, "", "", "", "", "", 20)
 	suite.NoError(err)
 	suite.Empty(reports)
 }
 
+func (suite *ReportsGetTestSuite) TestReportsGetModerator() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+
+	// Demote admin to moderator; moderators can handle reports.
+	testUser := new(gtsmodel.User)
+	*testUser = *suite.testUsers["admin_account"]
+	testUser.Admin = util.Ptr(false)
+	testUser.Moderator = util.Ptr(true)
+
+	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusOK, "", nil, "", "", "", "", "", 20)
+	suite.NoError(err)
+	suite.NotEmpty(reports)
+}
+
 func (suite *ReportsGetTestSuite) TestReportsGetZeroLimit() {
 	testAccount := suite.testAccounts["admin_account"]
 	testToken := suite.testTokens["admin_account"]
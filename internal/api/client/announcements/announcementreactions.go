@@ -0,0 +1,192 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"net/http"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"github.com/gin-gonic/gin"
+)
+
+// AnnouncementReactionPUTHandler swagger:operation PUT /api/v1/announcements/{id}/reactions/{name} announcementReactionAdd
+//
+// React to an active announcement with an emoji.
+//
+// Idempotent: if you've already reacted with the given emoji, this call will still succeed.
+//
+//	---
+//	tags:
+//	- announcements
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the announcement.
+//		in: path
+//		required: true
+//	-
+//		name: name
+//		type: string
+//		description: >-
+//			Unicode emoji, or the shortcode of
+//			a local custom emoji (without colons).
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:favourites
+//
+//	responses:
+//		'200':
+//			description: Reaction added.
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unprocessable content
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AnnouncementReactionPUTHandler(c *gin.Context) {
+	m.announcementReaction(c, true)
+}
+
+// AnnouncementReactionDELETEHandler swagger:operation DELETE /api/v1/announcements/{id}/reactions/{name} announcementReactionRemove
+//
+// Remove your reaction with the given emoji from an active announcement.
+//
+// Idempotent: if you haven't reacted with the given emoji, this call will still succeed.
+//
+//	---
+//	tags:
+//	- announcements
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the announcement.
+//		in: path
+//		required: true
+//	-
+//		name: name
+//		type: string
+//		description: >-
+//			Unicode emoji, or the shortcode of
+//			a local custom emoji (without colons).
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:favourites
+//
+//	responses:
+//		'200':
+//			description: Reaction removed.
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) AnnouncementReactionDELETEHandler(c *gin.Context) {
+	m.announcementReaction(c, false)
+}
+
+// announcementReaction adds
+// or removes a reaction.
+func (m *Module) announcementReaction(c *gin.Context, add bool) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeWriteFavourites,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	id, errWithCode := apiutil.ParseID(c.Param(apiutil.IDKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	name, errWithCode := apiutil.ParseAnnouncementReactionName(c.Param(apiutil.AnnouncementReactionNameKey))
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if add {
+		errWithCode = m.processor.Announcements().ReactionAdd(c.Request.Context(), authed.Account, id, name)
+	} else {
+		errWithCode = m.processor.Announcements().ReactionRemove(c.Request.Context(), authed.Account, id, name)
+	}
+
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, apiutil.EmptyJSONObject)
+}
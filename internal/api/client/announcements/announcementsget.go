@@ -28,7 +28,9 @@
 //
 // Get an array of currently active announcements.
 //
-// THIS ENDPOINT IS CURRENTLY NOT FULLY IMPLEMENTED: it will always return an empty array.
+// Announcements are returned oldest first. By default,
+// announcements dismissed by the requester are included,
+// with their `read` field set to true.
 //
 //	---
 //	tags:
@@ -37,16 +39,25 @@
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: with_dismissed
+//		type: boolean
+//		description: Include announcements dismissed by the requester.
+//		default: true
+//		in: query
+//
 //	security:
-//	- OAuth2 Bearer: []
+//	- OAuth2 Bearer:
+//		- read
 //
 //	responses:
 //		'200':
+//			description: Array of active announcements.
 //			schema:
 //				type: array
 //				items:
-//					type: object
-//				maxItems: 0
+//					"$ref": "#/definitions/announcement"
 //		'400':
 //			schema:
 //				"$ref": "#/definitions/error"
@@ -64,8 +75,9 @@
 //				"$ref": "#/definitions/error"
 //			description: internal server error
 func (m *Module) AnnouncementsGETHandler(c *gin.Context) {
-	_, errWithCode := apiutil.TokenAuth(c,
+	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
+		apiutil.ScopeRead,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -77,5 +89,24 @@ func (m *Module) AnnouncementsGETHandler(c *gin.Context) {
 		return
 	}
 
-	apiutil.JSON(c, http.StatusOK, apiutil.EmptyJSONArray)
+	withDismissed, errWithCode := apiutil.ParseAnnouncementWithDismissed(
+		c.Query(apiutil.AnnouncementWithDismissedKey),
+		true,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	announcements, errWithCode := m.processor.Announcements().Get(
+		c.Request.Context(),
+		authed.Account,
+		withDismissed,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, announcements)
 }
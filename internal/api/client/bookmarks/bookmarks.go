@@ -20,13 +20,17 @@
 import (
 	"net/http"
 
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/processing"
 	"github.com/gin-gonic/gin"
 )
 
 const (
 	// BasePath is the base path for serving the bookmarks API, minus the 'api' prefix
-	BasePath = "/v1/bookmarks"
+	BasePath           = "/v1/bookmarks"
+	FoldersPath        = BasePath + "/folders"
+	FolderPathWithID   = FoldersPath + "/:" + apiutil.IDKey
+	FolderStatusesPath = FolderPathWithID + "/statuses"
 )
 
 type Module struct {
@@ -41,4 +45,12 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, BasePath, m.BookmarksGETHandler)
+
+	// bookmark folder stuff
+	attachHandler(http.MethodGet, FoldersPath, m.BookmarkFoldersGETHandler)
+	attachHandler(http.MethodPost, FoldersPath, m.BookmarkFolderCreatePOSTHandler)
+	attachHandler(http.MethodGet, FolderPathWithID, m.BookmarkFolderGETHandler)
+	attachHandler(http.MethodPut, FolderPathWithID, m.BookmarkFolderUpdatePUTHandler)
+	attachHandler(http.MethodDelete, FolderPathWithID, m.BookmarkFolderDELETEHandler)
+	attachHandler(http.MethodGet, FolderStatusesPath, m.BookmarkFolderStatusesGETHandler)
 }
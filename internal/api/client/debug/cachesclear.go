@@ -18,9 +18,11 @@
 package debug
 
 import (
-	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"github.com/gin-gonic/gin"
@@ -28,18 +30,42 @@
 
 // ClearCachesPOSTHandler swagger:operation POST /api/v1/debug/caches/clear clearCaches
 //
-// Sweep/clear all in-memory caches.
+// Sweep/clear in-memory caches.
+//
+// If no caches are named, all caches are cleared. This includes
+// cached webfinger results, so accounts that previously failed
+// to resolve will be webfingered again.
+//
+// Clearing all caches at once causes every subsequent request to
+// reload from the database, so prefer clearing only the caches
+// you need to, by name. Keyed timeline caches also accept a key
+// to clear a single timeline, e.g. `timelines.home:<account-id>`.
 //
 //	---
 //	tags:
 //	- debug
 //
+//	consumes:
+//	- application/x-www-form-urlencoded
+//
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: caches[]
+//		type: array
+//		items:
+//			type: string
+//		description: >-
+//			Names of caches to clear, e.g. `domain-block`, `visibility`,
+//			or `timelines.home:<account-id>`. May also be given as a
+//			single comma-separated value. If not set, all caches are cleared.
+//		in: formData
+//
 //	security:
 //	- OAuth2 Bearer:
-//		- admin:write
+//		- admin:write:debug
 //
 //	responses:
 //		'200':
@@ -67,16 +93,15 @@
 func (m *Module) ClearCachesPOSTHandler(c *gin.Context) {
 	authed, errWithCode := apiutil.TokenAuth(c,
 		true, true, true, true,
-		apiutil.ScopeAdminWrite,
+		apiutil.ScopeAdminWriteDebug,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	if !*authed.User.Admin {
-		err := fmt.Errorf("user %s not an admin", authed.User.ID)
-		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsAdministrator); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -85,9 +110,56 @@ func (m *Module) ClearCachesPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	// Sweep all caches
-	// down to 0 (empty).
-	m.state.Caches.Sweep(0)
+	names := parseCacheNames(c)
+	if len(names) == 0 {
+		// Sweep all caches
+		// down to 0 (empty).
+		m.state.Caches.Sweep(0)
+		c.JSON(http.StatusOK, gin.H{"status": "OK"})
+		return
+	}
+
+	// Check all names before
+	// clearing any of them.
+	valid := m.state.Caches.ClearNames()
+	for _, name := range names {
+		base, _, _ := strings.Cut(name, ":")
+		if !slices.Contains(valid, base) {
+			const text = "unknown cache name"
+			errWithCode := gtserror.NewErrorBadRequest(
+				gtserror.Newf("%s: %s", text, name),
+				text+" "+name+", valid names are: "+strings.Join(valid, ", "),
+			)
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+	}
+
+	for _, name := range names {
+		if err := m.state.Caches.ClearNamed(name); err != nil {
+			errWithCode := gtserror.NewErrorBadRequest(err, err.Error())
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+	}
 
-	c.JSON(http.StatusOK, gin.H{"status": "OK"})
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "cleared": names})
+}
+
+// parseCacheNames gathers cache names from the
+// caches parameter, given as form data or query
+// parameters, singly or comma-separated.
+func parseCacheNames(c *gin.Context) []string {
+	var names []string
+	for _, key := range []string{"caches", "caches[]"} {
+		values := append(c.QueryArray(key), c.PostFormArray(key)...)
+		for _, value := range values {
+			for name := range strings.SplitSeq(value, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	return names
 }
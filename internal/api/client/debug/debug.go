@@ -26,16 +26,24 @@
 )
 
 const (
-	BasePath             = "/v1/debug"
-	APUrlPath            = BasePath + "/apurl"
-	ClearCachesPath      = BasePath + "/caches/clear"
-	StatusVisibilityPath = BasePath + "/status/visibility"
+	BasePath                            = "/v1/debug"
+	APUrlPath                           = BasePath + "/apurl"
+	ClearCachesPath                     = BasePath + "/caches/clear"
+	StatusVisibilityPath                = BasePath + "/status/visibility"
+	StatusVisibilityCachePath           = StatusVisibilityPath + "/cache"
+	StatusVisibilityCacheInvalidatePath = StatusVisibilityCachePath + "/invalidate"
+	SignaturePath                       = BasePath + "/signature"
 
 	// endpoint clones to maintain
 	// backwards compatibility with
 	// previous gotosocial versions
 	_CompatAPUrlPath       = "/v1/admin/debug/apurl"
 	_CompatClearCachesPath = "/v1/admin/debug/caches/clear"
+
+	// admin-namespaced clone of
+	// the signature endpoint, to
+	// match the other debug ones.
+	_AdminSignaturePath = "/v1/admin/debug/signature"
 )
 
 type Module struct {
@@ -53,12 +61,16 @@ func New(state *state.State, processor *processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	// activitypub debug endpoints.
 	attachHandler(http.MethodGet, APUrlPath, m.APUrlGETHandler)
+	attachHandler(http.MethodGet, SignaturePath, m.SignatureGETHandler)
+	attachHandler(http.MethodGet, _AdminSignaturePath, m.SignatureGETHandler)
 
 	// cache debug endpoints.
 	attachHandler(http.MethodPost, ClearCachesPath, m.ClearCachesPOSTHandler)
 
 	// status debug endpoints.
 	attachHandler(http.MethodGet, StatusVisibilityPath, m.StatusVisibilityGETHandler)
+	attachHandler(http.MethodGet, StatusVisibilityCachePath, m.StatusVisibilityCacheGETHandler)
+	attachHandler(http.MethodPost, StatusVisibilityCacheInvalidatePath, m.StatusVisibilityCacheInvalidatePOSTHandler)
 
 	// backwards compatibility endpoints
 	attachHandler(http.MethodGet, _CompatAPUrlPath, m.APUrlGETHandler)
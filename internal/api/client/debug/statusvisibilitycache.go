@@ -0,0 +1,187 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package debug
+
+import (
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"github.com/gin-gonic/gin"
+)
+
+// StatusVisibilityCacheGETHandler swagger:operation GET /api/v1/debug/status/visibility/cache statusVisibilityCache
+//
+// View the visibility, mute and filter cache entries currently stored for a status and account pair.
+//
+// This does not perform any lookups, so entries that are not yet cached will not be shown.
+//
+//	---
+//	tags:
+//	- debug
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: uri
+//		type: string
+//		description: Target status URL or URI.
+//		in: query
+//		required: true
+//	-
+//		name: account_id
+//		type: string
+//		description: >-
+//			ID of the requesting account that cache entries are keyed by.
+//			Defaults to the account of the authorized user.
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read:debug
+//
+//	responses:
+//		'200':
+//			description: The cache entries.
+//			schema:
+//				"$ref": "#/definitions/statusVisibilityCacheDebugResponse"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) StatusVisibilityCacheGETHandler(c *gin.Context) {
+	m.statusVisibilityCache(c, apiutil.ScopeAdminReadDebug, false)
+}
+
+// StatusVisibilityCacheInvalidatePOSTHandler swagger:operation POST /api/v1/debug/status/visibility/cache/invalidate statusVisibilityCacheInvalidate
+//
+// Invalidate only the visibility, mute and filter cache entries stored for a status and account pair.
+//
+// The returned entries are those that were cached prior to invalidation.
+//
+//	---
+//	tags:
+//	- debug
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: uri
+//		type: string
+//		description: Target status URL or URI.
+//		in: query
+//		required: true
+//	-
+//		name: account_id
+//		type: string
+//		description: >-
+//			ID of the requesting account that cache entries are keyed by.
+//			Defaults to the account of the authorized user.
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:debug
+//
+//	responses:
+//		'200':
+//			description: The invalidated cache entries.
+//			schema:
+//				"$ref": "#/definitions/statusVisibilityCacheDebugResponse"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'403':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: forbidden
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) StatusVisibilityCacheInvalidatePOSTHandler(c *gin.Context) {
+	m.statusVisibilityCache(c, apiutil.ScopeAdminWriteDebug, true)
+}
+
+func (m *Module) statusVisibilityCache(c *gin.Context, scope apiutil.Scope, invalidate bool) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		scope,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := apiutil.RequirePermissions(authed, apimodel.AccountRolePermissionsAdministrator); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	accountID := c.Query(apiutil.AccountIDKey)
+	if accountID == "" {
+		accountID = authed.Account.ID
+	}
+
+	entries, errWithCode := m.processor.Status().DebugVisibilityCacheGet(
+		c.Request.Context(),
+		accountID,
+		c.Query("uri"),
+		invalidate,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, entries)
+}
@@ -81,3 +81,65 @@ func (m *Module) ExportBlocksGETHandler(c *gin.Context) {
 
 	apiutil.EncodeCSVResponse(c.Writer, c.Request, http.StatusOK, records)
 }
+
+// ExportBlocksJSONGETHandler swagger:operation GET /api/v1/exports/blocks.json exportBlocksJSON
+//
+// Export a JSON array of accounts that you block.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:blocks
+//
+//	responses:
+//		'200':
+//			name: accounts
+//			description: Array of accounts that you block.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/blockExport"
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) ExportBlocksJSONGETHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeReadBlocks,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	entries, errWithCode := m.processor.Account().ExportBlocksJSON(
+		c.Request.Context(),
+		authed.Account,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, entries)
+}
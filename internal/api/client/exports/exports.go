@@ -25,13 +25,17 @@
 )
 
 const (
-	BasePath      = "/v1/exports"
-	StatsPath     = BasePath + "/stats"
-	FollowingPath = BasePath + "/following.csv"
-	FollowersPath = BasePath + "/followers.csv"
-	ListsPath     = BasePath + "/lists.csv"
-	BlocksPath    = BasePath + "/blocks.csv"
-	MutesPath     = BasePath + "/mutes.csv"
+	BasePath       = "/v1/exports"
+	StatsPath      = BasePath + "/stats"
+	FollowingPath  = BasePath + "/following.csv"
+	FollowersPath  = BasePath + "/followers.csv"
+	ListsPath      = BasePath + "/lists.csv"
+	BlocksPath     = BasePath + "/blocks.csv"
+	MutesPath      = BasePath + "/mutes.csv"
+	BlocksJSONPath = BasePath + "/blocks.json"
+	MutesJSONPath  = BasePath + "/mutes.json"
+	FavouritesPath = BasePath + "/favourites.csv"
+	NotesPath      = BasePath + "/notes.csv"
 )
 
 type Module struct {
@@ -51,4 +55,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, ListsPath, m.ExportListsGETHandler)
 	attachHandler(http.MethodGet, BlocksPath, m.ExportBlocksGETHandler)
 	attachHandler(http.MethodGet, MutesPath, m.ExportMutesGETHandler)
+	attachHandler(http.MethodGet, BlocksJSONPath, m.ExportBlocksJSONGETHandler)
+	attachHandler(http.MethodGet, MutesJSONPath, m.ExportMutesJSONGETHandler)
+	attachHandler(http.MethodGet, FavouritesPath, m.ExportFavouritesGETHandler)
+	attachHandler(http.MethodGet, NotesPath, m.ExportNotesGETHandler)
 }
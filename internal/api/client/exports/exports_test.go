@@ -205,6 +205,60 @@ type testCase struct {
 			user:        suite.testUsers["local_account_2"],
 			account:     suite.testAccounts["local_account_2"],
 			expect: `foss_satan@fossbros-anonymous.io
+`,
+		},
+		// Export Mutes as JSON.
+		{
+			handler:     suite.exportsModule.ExportMutesJSONGETHandler,
+			path:        exports.MutesJSONPath,
+			contentType: apiutil.AppJSON,
+			application: suite.testApplications["application_1"],
+			token:       suite.testTokens["local_account_1"],
+			user:        suite.testUsers["local_account_1"],
+			account:     suite.testAccounts["local_account_1"],
+			expect:      `[]`,
+		},
+		// Export Blocks as JSON.
+		{
+			handler:     suite.exportsModule.ExportBlocksJSONGETHandler,
+			path:        exports.BlocksJSONPath,
+			contentType: apiutil.AppJSON,
+			application: suite.testApplications["application_1"],
+			token:       suite.testTokens["local_account_2"],
+			user:        suite.testUsers["local_account_2"],
+			account:     suite.testAccounts["local_account_2"],
+			expect: `[
+  {
+    "account": "foss_satan@fossbros-anonymous.io"
+  }
+]`,
+		},
+		// Export Favourites.
+		{
+			handler:     suite.exportsModule.ExportFavouritesGETHandler,
+			path:        exports.FavouritesPath,
+			contentType: apiutil.TextCSV,
+			application: suite.testApplications["application_1"],
+			token:       suite.testTokens["local_account_1"],
+			user:        suite.testUsers["local_account_1"],
+			account:     suite.testAccounts["local_account_1"],
+			expect: `http://localhost:8080/users/1happyturtle/statuses/01F8MHCP5P2NWYQ416SBA0XSEV
+http://localhost:8080/users/1happyturtle/statuses/01F8MHBQCBTDKN6X5VHGMMN4MA
+http://localhost:8080/users/admin/statuses/01F8MHAAY43M6RJ473VQFCVH37
+http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R
+`,
+		},
+		// Export Notes.
+		{
+			handler:     suite.exportsModule.ExportNotesGETHandler,
+			path:        exports.NotesPath,
+			contentType: apiutil.TextCSV,
+			application: suite.testApplications["application_1"],
+			token:       suite.testTokens["local_account_2"],
+			user:        suite.testUsers["local_account_2"],
+			account:     suite.testAccounts["local_account_2"],
+			expect: `Account address,Note
+the_mighty_zork@localhost:8080,extremely average poster
 `,
 		},
 		// Export Stats.
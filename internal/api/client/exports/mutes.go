@@ -81,3 +81,65 @@ func (m *Module) ExportMutesGETHandler(c *gin.Context) {
 
 	apiutil.EncodeCSVResponse(c.Writer, c.Request, http.StatusOK, records)
 }
+
+// ExportMutesJSONGETHandler swagger:operation GET /api/v1/exports/mutes.json exportMutesJSON
+//
+// Export a JSON array of accounts that you mute.
+//
+//	---
+//	tags:
+//	- import-export
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:mutes
+//
+//	responses:
+//		'200':
+//			name: accounts
+//			description: Array of accounts that you mute.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/muteExport"
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+func (m *Module) ExportMutesJSONGETHandler(c *gin.Context) {
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeReadMutes,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	entries, errWithCode := m.processor.Account().ExportMutesJSON(
+		c.Request.Context(),
+		authed.Account,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, entries)
+}
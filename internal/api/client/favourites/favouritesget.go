@@ -18,12 +18,10 @@
 package favourites
 
 import (
-	"fmt"
 	"net/http"
-	"strconv"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
-	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"github.com/gin-gonic/gin"
 )
 
@@ -51,6 +49,8 @@
 //		type: integer
 //		description: Number of statuses to return.
 //		default: 20
+//		minimum: 1
+//		maximum: 80
 //		in: query
 //	-
 //		name: max_id
@@ -66,6 +66,14 @@
 //			Return only favourited statuses *NEWER* than the given favourite ID.
 //			The status with the corresponding fave ID will not be included in the response.
 //		in: query
+//	-
+//		name: since_id
+//		type: string
+//		description: >-
+//			Return only favourited statuses *NEWER* than the given favourite ID.
+//			The status with the corresponding fave ID will not be included in the response.
+//			Unlike min_id, this returns the newest faves first rather than those immediately newer.
+//		in: query
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -116,31 +124,17 @@ func (m *Module) FavouritesGETHandler(c *gin.Context) {
 		return
 	}
 
-	maxID := ""
-	maxIDString := c.Query(MaxIDKey)
-	if maxIDString != "" {
-		maxID = maxIDString
-	}
-
-	minID := ""
-	minIDString := c.Query(MinIDKey)
-	if minIDString != "" {
-		minID = minIDString
-	}
-
-	limit := 20
-	limitString := c.Query(LimitKey)
-	if limitString != "" {
-		i, err := strconv.ParseInt(limitString, 10, 32)
-		if err != nil {
-			err := fmt.Errorf("error parsing %s: %s", LimitKey, err)
-			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
-			return
-		}
-		limit = int(i)
+	page, errWithCode := paging.ParseIDPage(c,
+		1,  // min limit
+		80, // max limit
+		20, // default limit
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
 	}
 
-	resp, errWithCode := m.processor.Timeline().FavedTimelineGet(c.Request.Context(), authed, maxID, minID, limit)
+	resp, errWithCode := m.processor.Timeline().FavedTimelineGet(c.Request.Context(), authed, page)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -104,7 +104,7 @@ func (suite *FiltersTestSuite) TestDeleteFilter() {
 func (suite *FiltersTestSuite) TestDeleteAnotherAccountsFilter() {
 	id := suite.testFilterKeywords["local_account_2_filter_1_keyword_1"].ID
 
-	err := suite.deleteFilter(id, http.StatusNotFound, `{"error":"Not Found: filter not found"}`)
+	err := suite.deleteFilter(id, http.StatusNotFound, `{"error":"Not Found: filter not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -113,7 +113,7 @@ func (suite *FiltersTestSuite) TestDeleteAnotherAccountsFilter() {
 func (suite *FiltersTestSuite) TestDeleteNonexistentFilter() {
 	id := "not_even_a_real_ULID"
 
-	err := suite.deleteFilter(id, http.StatusNotFound, `{"error":"Not Found: filter keyword not found"}`)
+	err := suite.deleteFilter(id, http.StatusNotFound, `{"error":"Not Found: filter keyword not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -106,7 +106,7 @@ func (suite *FiltersTestSuite) TestGetFilterKeyword() {
 func (suite *FiltersTestSuite) TestGetAnotherAccountsFilterKeyword() {
 	id := suite.testFilterKeywords["local_account_2_filter_1_keyword_1"].ID
 
-	_, err := suite.getFilterKeyword(id, http.StatusNotFound, `{"error":"Not Found: filter not found"}`)
+	_, err := suite.getFilterKeyword(id, http.StatusNotFound, `{"error":"Not Found: filter not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -115,7 +115,7 @@ func (suite *FiltersTestSuite) TestGetAnotherAccountsFilterKeyword() {
 func (suite *FiltersTestSuite) TestGetNonexistentFilterKeyword() {
 	id := "not_even_a_real_ULID"
 
-	_, err := suite.getFilterKeyword(id, http.StatusNotFound, `{"error":"Not Found: filter keyword not found"}`)
+	_, err := suite.getFilterKeyword(id, http.StatusNotFound, `{"error":"Not Found: filter keyword not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -162,7 +162,7 @@ func (suite *FiltersTestSuite) TestPostFilterKeywordMinimal() {
 func (suite *FiltersTestSuite) TestPostFilterKeywordEmptyKeyword() {
 	filterID := suite.testFilters["local_account_1_filter_1"].ID
 	keyword := ""
-	_, err := suite.postFilterKeyword(filterID, &keyword, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: filter keyword must be provided, and must be no more than 40 chars"}`)
+	_, err := suite.postFilterKeyword(filterID, &keyword, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: filter keyword must be provided, and must be no more than 40 chars","error_code":"unprocessable_entity"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -170,7 +170,7 @@ func (suite *FiltersTestSuite) TestPostFilterKeywordEmptyKeyword() {
 
 func (suite *FiltersTestSuite) TestPostFilterKeywordMissingKeyword() {
 	filterID := suite.testFilters["local_account_1_filter_1"].ID
-	_, err := suite.postFilterKeyword(filterID, nil, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: filter keyword must be provided, and must be no more than 40 chars"}`)
+	_, err := suite.postFilterKeyword(filterID, nil, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: filter keyword must be provided, and must be no more than 40 chars","error_code":"unprocessable_entity"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -180,7 +180,7 @@ func (suite *FiltersTestSuite) TestPostFilterKeywordMissingKeyword() {
 func (suite *FiltersTestSuite) TestPostFilterKeywordKeywordConflict() {
 	filterID := suite.testFilters["local_account_1_filter_1"].ID
 	keyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"].Keyword
-	_, err := suite.postFilterKeyword(filterID, &keyword, nil, nil, http.StatusConflict, `{"error":"Conflict: duplicate keyword"}`)
+	_, err := suite.postFilterKeyword(filterID, &keyword, nil, nil, http.StatusConflict, `{"error":"Conflict: duplicate keyword","error_code":"conflict"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -189,7 +189,7 @@ func (suite *FiltersTestSuite) TestPostFilterKeywordKeywordConflict() {
 func (suite *FiltersTestSuite) TestPostFilterKeywordAnotherAccountsFilter() {
 	filterID := suite.testFilters["local_account_2_filter_1"].ID
 	keyword := "fnords"
-	_, err := suite.postFilterKeyword(filterID, &keyword, nil, nil, http.StatusNotFound, `{"error":"Not Found: filter not found"}`)
+	_, err := suite.postFilterKeyword(filterID, &keyword, nil, nil, http.StatusNotFound, `{"error":"Not Found: filter not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -198,7 +198,7 @@ func (suite *FiltersTestSuite) TestPostFilterKeywordAnotherAccountsFilter() {
 func (suite *FiltersTestSuite) TestPostFilterKeywordNonexistentFilter() {
 	filterID := "not_even_a_real_ULID"
 	keyword := "fnords"
-	_, err := suite.postFilterKeyword(filterID, &keyword, nil, nil, http.StatusNotFound, `{"error":"Not Found: filter not found"}`)
+	_, err := suite.postFilterKeyword(filterID, &keyword, nil, nil, http.StatusNotFound, `{"error":"Not Found: filter not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -162,7 +162,7 @@ func (suite *FiltersTestSuite) TestPutFilterKeywordMinimal() {
 func (suite *FiltersTestSuite) TestPutFilterKeywordEmptyKeyword() {
 	filterKeywordID := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"].ID
 	keyword := ""
-	_, err := suite.putFilterKeyword(filterKeywordID, &keyword, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: filter keyword must be provided, and must be no more than 40 chars"}`)
+	_, err := suite.putFilterKeyword(filterKeywordID, &keyword, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: filter keyword must be provided, and must be no more than 40 chars","error_code":"unprocessable_entity"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -170,7 +170,7 @@ func (suite *FiltersTestSuite) TestPutFilterKeywordEmptyKeyword() {
 
 func (suite *FiltersTestSuite) TestPutFilterKeywordMissingKeyword() {
 	filterKeywordID := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"].ID
-	_, err := suite.putFilterKeyword(filterKeywordID, nil, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: filter keyword must be provided, and must be no more than 40 chars"}`)
+	_, err := suite.putFilterKeyword(filterKeywordID, nil, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: filter keyword must be provided, and must be no more than 40 chars","error_code":"unprocessable_entity"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -180,7 +180,7 @@ func (suite *FiltersTestSuite) TestPutFilterKeywordMissingKeyword() {
 func (suite *FiltersTestSuite) TestPutFilterKeywordKeywordConflict() {
 	filterKeywordID := suite.testFilterKeywords["local_account_1_filter_2_keyword_1"].ID
 	conflictingKeyword := suite.testFilterKeywords["local_account_1_filter_2_keyword_2"].Keyword
-	_, err := suite.putFilterKeyword(filterKeywordID, &conflictingKeyword, nil, nil, http.StatusConflict, `{"error":"Conflict: duplicate keyword"}`)
+	_, err := suite.putFilterKeyword(filterKeywordID, &conflictingKeyword, nil, nil, http.StatusConflict, `{"error":"Conflict: duplicate keyword","error_code":"conflict"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -189,7 +189,7 @@ func (suite *FiltersTestSuite) TestPutFilterKeywordKeywordConflict() {
 func (suite *FiltersTestSuite) TestPutFilterKeywordAnotherAccountsFilterKeyword() {
 	filterKeywordID := suite.testFilterKeywords["local_account_2_filter_1_keyword_1"].ID
 	keyword := "fnord"
-	_, err := suite.putFilterKeyword(filterKeywordID, &keyword, nil, nil, http.StatusNotFound, `{"error":"Not Found: filter not found"}`)
+	_, err := suite.putFilterKeyword(filterKeywordID, &keyword, nil, nil, http.StatusNotFound, `{"error":"Not Found: filter not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -198,7 +198,7 @@ func (suite *FiltersTestSuite) TestPutFilterKeywordAnotherAccountsFilterKeyword(
 func (suite *FiltersTestSuite) TestPutFilterKeywordNonexistentFilterKeyword() {
 	filterKeywordID := "not_even_a_real_ULID"
 	keyword := "fnord"
-	_, err := suite.putFilterKeyword(filterKeywordID, &keyword, nil, nil, http.StatusNotFound, `{"error":"Not Found: filter keyword not found"}`)
+	_, err := suite.putFilterKeyword(filterKeywordID, &keyword, nil, nil, http.StatusNotFound, `{"error":"Not Found: filter keyword not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
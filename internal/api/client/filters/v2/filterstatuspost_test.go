@@ -137,7 +137,7 @@ func (suite *FiltersTestSuite) TestPostFilterStatusJSON() {
 func (suite *FiltersTestSuite) TestPostFilterStatusEmptyStatusID() {
 	filterID := suite.testFilters["local_account_1_filter_1"].ID
 	statusID := ""
-	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: status_id must be provided"}`)
+	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: status_id must be provided","error_code":"unprocessable_entity"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -146,7 +146,7 @@ func (suite *FiltersTestSuite) TestPostFilterStatusEmptyStatusID() {
 func (suite *FiltersTestSuite) TestPostFilterStatusInvalidStatusID() {
 	filterID := suite.testFilters["local_account_1_filter_1"].ID
 	statusID := "112401162517176488" // ma'am, that's clearly a Mastodon ID, this is a Wendy's
-	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: status_id didn't match the expected ULID format for an ID (26 characters from the set 0123456789ABCDEFGHJKMNPQRSTVWXYZ)"}`)
+	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: status_id didn't match the expected ULID format for an ID (26 characters from the set 0123456789ABCDEFGHJKMNPQRSTVWXYZ)","error_code":"unprocessable_entity"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -154,7 +154,7 @@ func (suite *FiltersTestSuite) TestPostFilterStatusInvalidStatusID() {
 
 func (suite *FiltersTestSuite) TestPostFilterStatusMissingStatusID() {
 	filterID := suite.testFilters["local_account_1_filter_1"].ID
-	_, err := suite.postFilterStatus(filterID, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: status_id must be provided"}`)
+	_, err := suite.postFilterStatus(filterID, nil, nil, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: status_id must be provided","error_code":"unprocessable_entity"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -164,7 +164,7 @@ func (suite *FiltersTestSuite) TestPostFilterStatusMissingStatusID() {
 func (suite *FiltersTestSuite) TestPostFilterStatusStatusIDConflict() {
 	filterID := suite.testFilters["local_account_1_filter_3"].ID
 	statusID := suite.testFilterStatuses["local_account_1_filter_3_status_1"].StatusID
-	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusConflict, `{"error":"Conflict: duplicate status"}`)
+	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusConflict, `{"error":"Conflict: duplicate status","error_code":"conflict"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -173,7 +173,7 @@ func (suite *FiltersTestSuite) TestPostFilterStatusStatusIDConflict() {
 func (suite *FiltersTestSuite) TestPostFilterStatusAnotherAccountsFilter() {
 	filterID := suite.testFilters["local_account_2_filter_1"].ID
 	statusID := suite.testStatuses["admin_account_status_1"].ID
-	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusNotFound, `{"error":"Not Found: filter not found"}`)
+	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusNotFound, `{"error":"Not Found: filter not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
@@ -182,7 +182,7 @@ func (suite *FiltersTestSuite) TestPostFilterStatusAnotherAccountsFilter() {
 func (suite *FiltersTestSuite) TestPostFilterStatusNonexistentFilter() {
 	filterID := "not_even_a_real_ULID"
 	statusID := suite.testStatuses["admin_account_status_1"].ID
-	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusNotFound, `{"error":"Not Found: filter not found"}`)
+	_, err := suite.postFilterStatus(filterID, &statusID, nil, http.StatusNotFound, `{"error":"Not Found: filter not found","error_code":"not_found"}`)
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
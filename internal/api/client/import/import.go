@@ -33,7 +33,9 @@
 )
 
 const (
-	BasePath = "/v1/import"
+	BasePath                        = "/v1/import"
+	BlocklistSubscriptionsPath      = BasePath + "/blocklist_subscriptions"
+	BlocklistSubscriptionPathWithID = BlocklistSubscriptionsPath + "/:" + apiutil.IDKey
 )
 
 var types = []string{
@@ -59,6 +61,8 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodPost, BasePath, m.ImportPOSTHandler)
+	attachHandler(http.MethodGet, BlocklistSubscriptionsPath, m.BlocklistSubscriptionsGETHandler)
+	attachHandler(http.MethodDelete, BlocklistSubscriptionPathWithID, m.BlocklistSubscriptionDELETEHandler)
 }
 
 // ImportPOSTHandler swagger:operation POST /api/v1/import importData
@@ -66,6 +70,13 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 // Upload some CSV-formatted data to your account.
 //
 // This can be used to migrate data from a Mastodon-compatible CSV file to a GoToSocial account.
+// Blocks and mutes may also be uploaded as JSON, in the format of their JSON exports.
+//
+// Instead of a data file, you may subscribe to the URL of a blocklist that another account has published
+// as an ActivityPub collection, eg., `https://example.org/users/someone/collections/blocklist`. The blocklist
+// is refetched once a day: accounts added to it are blocked, and accounts removed from it are unblocked, if
+// they were blocked because of the subscription. Subscriptions can be listed and removed at
+// `/api/v1/import/blocklist_subscriptions`. Blocks from a URL are always merged, mode `overwrite` is not supported.
 //
 // Uploaded data will be processed asynchronously, and not all entries may be processed depending
 // on domain blocks, user-level blocks, network availability of referenced accounts and statuses, etc.
@@ -84,9 +95,13 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 //	-
 //		name: data
 //		in: formData
-//		description: The CSV data file to upload.
+//		description: The CSV (or JSON) data file to upload. Required, unless url is provided.
 //		type: file
-//		required: true
+//	-
+//		name: url
+//		in: formData
+//		description: URL of a published blocklist to subscribe to, instead of a data file. Only valid with type `blocks`.
+//		type: string
 //	-
 //		name: type
 //		in: formData
@@ -106,7 +121,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 //			Mode to use when creating entries from the data file:
 //
 //			- `merge` to merge entries in file with existing entries.
-//			- `overwrite` to replace existing entries with entries in file.
+//			- `overwrite` to replace existing entries with entries in file. Not supported with url.
 //		type: string
 //		default: merge
 //
@@ -159,8 +174,8 @@ func (m *Module) ImportPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	if form.Data == nil {
-		const text = "no data file provided"
+	if form.Data == nil && form.URL == "" {
+		const text = "no data file or url provided"
 		err := errors.New(text)
 		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, text), m.processor.InstanceGetV1)
 		return
@@ -192,6 +207,38 @@ func (m *Module) ImportPOSTHandler(c *gin.Context) {
 	}
 	overwrite := form.Mode == "overwrite"
 
+	if form.URL != "" {
+		if form.Data != nil || form.Type != "blocks" {
+			const text = "url can only be provided instead of a data file, with type blocks"
+			err := errors.New(text)
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, text), m.processor.InstanceGetV1)
+			return
+		}
+
+		if overwrite {
+			// A blocklist may only partially dereference,
+			// so overwriting could silently unblock accounts.
+			const text = "mode overwrite is not supported when importing from a url"
+			err := errors.New(text)
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, text), m.processor.InstanceGetV1)
+			return
+		}
+
+		// Subscribe to the blocklist.
+		errWithCode = m.processor.Account().BlocklistSubscribe(
+			c.Request.Context(),
+			authed.Account,
+			form.URL,
+		)
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+
+		apiutil.JSON(c, http.StatusAccepted, gin.H{"status": "accepted"})
+		return
+	}
+
 	// Trigger the import.
 	errWithCode = m.processor.Account().ImportData(
 		c.Request.Context(),
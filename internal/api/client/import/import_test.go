@@ -94,6 +94,15 @@ func (suite *ImportTestSuite) TriggerHandler(
 	importData string,
 	importType string,
 	importMode string,
+) {
+	suite.TriggerHandlerFile("data.csv", importData, importType, importMode)
+}
+
+func (suite *ImportTestSuite) TriggerHandlerFile(
+	filename string,
+	importData string,
+	importType string,
+	importMode string,
 ) {
 	// Set up request.
 	recorder := httptest.NewRecorder()
@@ -108,7 +117,7 @@ func (suite *ImportTestSuite) TriggerHandler(
 
 	// Create test request.
 	b, w, err := testrig.CreateMultipartFormData(
-		testrig.StringToDataF("data", "data.csv", importData),
+		testrig.StringToDataF("data", filename, importData),
 		map[string][]string{
 			"type": {importType},
 			"mode": {importMode},
@@ -287,6 +296,79 @@ func (suite *ImportTestSuite) TestImportMutes() {
 
 }
 
+func (suite *ImportTestSuite) TestImportBlocksJSON() {
+	var (
+		ctx         = suite.T().Context()
+		testAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Have zork block turtle and remote fossbro,
+	// using the format of the blocks JSON export.
+	data := `[
+  {"account": "1happyturtle@localhost:8080"},
+  {"account": "unknown@localhost:8080"},
+  {"account": "foss_satan@fossbros-anonymous.io"}
+]`
+
+	// Trigger the import handler.
+	suite.TriggerHandlerFile("blocks.json", data, "blocks", "merge")
+
+	// Wait for blocks to be applied.
+	if !testrig.WaitFor(func() bool {
+		blocks, err := suite.state.DB.GetAccountBlocking(ctx, testAccount.ID, nil)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		for _, b := range blocks {
+			switch b.TargetAccount.ID {
+			case suite.testAccounts["remote_account_1"].ID,
+				suite.testAccounts["local_account_2"].ID:
+			default:
+				suite.FailNow("unexpected blocked account", b.TargetAccount)
+			}
+		}
+		return len(blocks) == 2
+	}) {
+		suite.FailNow("timed out waiting for blocks to apply")
+	}
+}
+
+func (suite *ImportTestSuite) TestImportBlocklistURLOverwrite() {
+	// Set up request.
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// Try to overwrite blocks
+	// from a published blocklist.
+	b, w, err := testrig.CreateMultipartFormData(
+		nil,
+		map[string][]string{
+			"url":  {"http://localhost:8080/users/1happyturtle/collections/blocklist"},
+			"type": {"blocks"},
+			"mode": {"overwrite"},
+		},
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	target := "http://localhost:8080/api/v1/import"
+	ctx.Request = httptest.NewRequest(http.MethodPost, target, bytes.NewReader(b.Bytes()))
+	ctx.Request.Header.Set("Accept", "application/json")
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+
+	suite.importModule.ImportPOSTHandler(ctx)
+
+	// This should be refused.
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Contains(recorder.Body.String(), "mode overwrite is not supported when importing from a url")
+}
+
 func TestImportTestSuite(t *testing.T) {
 	suite.Run(t, new(ImportTestSuite))
 }
@@ -28,6 +28,7 @@
 	InstanceInformationPathV1 = "/v1/instance"
 	InstanceInformationPathV2 = "/v2/instance"
 	InstancePeersPath         = InstanceInformationPathV1 + "/peers"
+	InstanceActivityPath      = InstanceInformationPathV1 + "/activity"
 	InstanceRulesPath         = InstanceInformationPathV1 + "/rules"
 	InstanceBlocklistPath     = InstanceInformationPathV1 + "/domain_blocks"
 	InstanceAllowlistPath     = InstanceInformationPathV1 + "/domain_allows"
@@ -50,6 +51,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, InstanceInformationPathV2, m.InstanceInformationGETHandlerV2)
 	attachHandler(http.MethodPatch, InstanceInformationPathV1, m.InstanceUpdatePATCHHandler)
 	attachHandler(http.MethodGet, InstancePeersPath, m.InstancePeersGETHandler)
+	attachHandler(http.MethodGet, InstanceActivityPath, m.InstanceActivityGETHandler)
 	attachHandler(http.MethodGet, InstanceRulesPath, m.InstanceRulesGETHandler)
 	attachHandler(http.MethodGet, InstanceBlocklistPath, m.InstanceDomainBlocksGETHandler)
 	attachHandler(http.MethodGet, InstanceAllowlistPath, m.InstanceDomainAllowsGETHandler)
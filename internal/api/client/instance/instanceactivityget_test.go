@@ -0,0 +1,147 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package instance_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/instance"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type InstanceActivityGetTestSuite struct {
+	InstanceStandardTestSuite
+}
+
+// putRecentActivity puts one status and one
+// sign-in from local_account_1 at the current time.
+func (suite *InstanceActivityGetTestSuite) putRecentActivity() {
+	ctx := context.Background()
+
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.ID = id.NewULID()
+	status.URI = status.URI + "/recent"
+	status.CreatedAt = time.Now()
+	if err := suite.db.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.PutUserLogin(ctx, &gtsmodel.UserLogin{
+		ID:         id.NewULID(),
+		CreatedAt:  time.Now(),
+		UserID:     suite.testUsers["local_account_1"].ID,
+		Successful: util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *InstanceActivityGetTestSuite) getActivity() (int, []byte) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, "api"+instance.InstanceActivityPath, nil, "", false)
+
+	suite.instanceModule.InstanceActivityGETHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, b
+}
+
+func (suite *InstanceActivityGetTestSuite) TestInstanceActivityGet() {
+	suite.putRecentActivity()
+
+	code, b := suite.getActivity()
+	suite.Equal(http.StatusOK, code)
+
+	var activity []*apimodel.InstanceActivity
+	if err := json.Unmarshal(b, &activity); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(activity, 12)
+
+	// Current week should be first,
+	// starting on a Monday, and contain
+	// the status + sign-in from above.
+	week, err := strconv.ParseInt(activity[0].Week, 10, 64)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	start := time.Unix(week, 0).UTC()
+	suite.Equal(time.Monday, start.Weekday())
+	suite.WithinDuration(time.Now(), start, 7*24*time.Hour)
+	suite.Equal("1", activity[0].Statuses)
+	suite.Equal("1", activity[0].Logins)
+	suite.Equal("0", activity[0].Registrations)
+
+	// Weeks should go back in time.
+	prev, err := strconv.ParseInt(activity[1].Week, 10, 64)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(week-7*24*60*60, prev)
+}
+
+func (suite *InstanceActivityGetTestSuite) TestInstanceActivityGetAnonymized() {
+	config.SetInstanceActivityMode(config.InstanceActivityModeAnonymize)
+	suite.putRecentActivity()
+
+	code, b := suite.getActivity()
+	suite.Equal(http.StatusOK, code)
+
+	var activity []*apimodel.InstanceActivity
+	if err := json.Unmarshal(b, &activity); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(activity, 12)
+
+	// Single status + sign-in
+	// should be rounded away.
+	suite.Equal("0", activity[0].Statuses)
+	suite.Equal("0", activity[0].Logins)
+}
+
+func (suite *InstanceActivityGetTestSuite) TestInstanceActivityGetDisabled() {
+	config.SetInstanceActivityMode(config.InstanceActivityModeDisabled)
+
+	code, b := suite.getActivity()
+	suite.Equal(http.StatusNotFound, code)
+	suite.Equal(`{"error":"Not Found: instance activity is not available on this instance","error_code":"not_found"}`, string(b))
+}
+
+func TestInstanceActivityGetTestSuite(t *testing.T) {
+	suite.Run(t, &InstanceActivityGetTestSuite{})
+}
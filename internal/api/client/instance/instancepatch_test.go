@@ -26,6 +26,7 @@
 	"testing"
 
 	"code.superseriousbusiness.org/gotosocial/internal/api/client/instance"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/middleware"
 	"code.superseriousbusiness.org/gotosocial/internal/oauth"
 	"code.superseriousbusiness.org/gotosocial/testrig"
@@ -105,7 +106,9 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
-      ]
+      ],
+      "min_expiration": 300,
+      "max_expiration": 31536000
     },
     "media_attachments": {
       "supported_mime_types": [
@@ -251,7 +254,9 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
-      ]
+      ],
+      "min_expiration": 300,
+      "max_expiration": 31536000
     },
     "media_attachments": {
       "supported_mime_types": [
@@ -397,7 +402,9 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
-      ]
+      ],
+      "min_expiration": 300,
+      "max_expiration": 31536000
     },
     "media_attachments": {
       "supported_mime_types": [
@@ -518,7 +525,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch4() {
 		suite.FailNow(err.Error())
 	}
 
-	suite.Equal(`{"error":"Bad Request: empty form submitted"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: empty form submitted","error_code":"bad_request"}`, string(b))
 }
 
 func (suite *InstancePatchTestSuite) TestInstancePatch5() {
@@ -552,7 +559,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch5() {
 	b, err := io.ReadAll(result.Body)
 	suite.NoError(err)
 
-	suite.Equal(`{"error":"Forbidden: token has insufficient scope permission"}`, string(b))
+	suite.Equal(`{"error":"Forbidden: token has insufficient scope permission","error_code":"scope_insufficient"}`, string(b))
 }
 
 func (suite *InstancePatchTestSuite) TestInstancePatch6() {
@@ -594,7 +601,9 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
-      ]
+      ],
+      "min_expiration": 300,
+      "max_expiration": 31536000
     },
     "media_attachments": {
       "supported_mime_types": [
@@ -715,7 +724,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch7() {
 		suite.FailNow(err.Error())
 	}
 
-	suite.Equal(`{"error":"Bad Request: mail: missing '@' or angle-addr"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: mail: missing '@' or angle-addr","error_code":"bad_request"}`, string(b))
 }
 
 func (suite *InstancePatchTestSuite) TestInstancePatch8() {
@@ -762,7 +771,9 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
-      ]
+      ],
+      "min_expiration": 300,
+      "max_expiration": 31536000
     },
     "media_attachments": {
       "supported_mime_types": [
@@ -818,9 +829,9 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
     "status_count": 23,
     "user_count": 5
   },
-  "thumbnail": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/attachment/original/`+instanceAccount.AvatarMediaAttachment.ID+`.gif",`+`
+  "thumbnail": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/attachment/original/0ff83244422e4ff8/`+instanceAccount.AvatarMediaAttachment.ID+`.gif",`+`
   "thumbnail_type": "image/gif",
-  "thumbnail_static": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/attachment/small/`+instanceAccount.AvatarMediaAttachment.ID+`.webp",`+`
+  "thumbnail_static": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/attachment/small/f0ed75f8b28c01ec/`+instanceAccount.AvatarMediaAttachment.ID+`.webp",`+`
   "thumbnail_static_type": "image/webp",
   "thumbnail_description": "A bouncing little green peglin.",
   "contact_account": {
@@ -884,9 +895,9 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
 	}
 
 	suite.Equal(`{
-  "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/attachment/original/`+instanceAccount.AvatarMediaAttachment.ID+`.gif",`+`
+  "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/attachment/original/0ff83244422e4ff8/`+instanceAccount.AvatarMediaAttachment.ID+`.gif",`+`
   "thumbnail_type": "image/gif",
-  "static_url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/attachment/small/`+instanceAccount.AvatarMediaAttachment.ID+`.webp",`+`
+  "static_url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/attachment/small/f0ed75f8b28c01ec/`+instanceAccount.AvatarMediaAttachment.ID+`.webp",`+`
   "thumbnail_static_type": "image/webp",
   "thumbnail_description": "A bouncing little green peglin.",
   "blurhash": "LF9Hm*Rl4Yt5.4RlRSt5IXkBxsj["
@@ -949,7 +960,9 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
-      ]
+      ],
+      "min_expiration": 300,
+      "max_expiration": 31536000
     },
     "media_attachments": {
       "supported_mime_types": [
@@ -1056,6 +1069,29 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
 }`, dst.String())
 }
 
+func (suite *InstancePatchTestSuite) TestInstancePatchThumbnailMediaQuota() {
+	// Set a default media quota far smaller than the
+	// thumbnail; this should not apply to the instance
+	// account, which has no settings to override it.
+	config.SetMediaLocalQuota(1)
+
+	code, b := suite.instancePatch("thumbnail", "../../../../testrig/media/peglin.gif", map[string][]string{
+		"thumbnail_description": {"A bouncing little green peglin."},
+	})
+
+	if expectedCode := http.StatusOK; code != expectedCode {
+		suite.FailNowf("wrong status code", "expected %d but got %d: %s", expectedCode, code, string(b))
+	}
+
+	i := make(map[string]interface{})
+	if err := json.Unmarshal(b, &i); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.EqualValues("image/gif", i["thumbnail_type"])
+	suite.EqualValues("A bouncing little green peglin.", i["thumbnail_description"])
+}
+
 func TestInstancePatchTestSuite(t *testing.T) {
 	suite.Run(t, &InstancePatchTestSuite{})
 }
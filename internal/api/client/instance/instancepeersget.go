@@ -64,6 +64,8 @@
 //			If filter is `open,allowed`, then allowed domains and known domains not on the blocklist will be shown.
 //
 //			If filter is an empty string or not set, then `open` will be assumed as the default.
+//
+//			Depending on instance configuration, `open` may leave out single-user instances, or return 404 if known domains aren't served at all.
 //		in: query
 //		required: false
 //		default: flat
@@ -188,6 +190,13 @@ func (m *Module) InstancePeersGETHandler(c *gin.Context) {
 		return
 	}
 
+	if includeOpen && config.GetInstancePeersMode() == config.InstancePeersModeDisabled {
+		const errText = "peers open query is not available on this instance"
+		errWithCode := gtserror.NewErrorNotFound(errors.New(errText), errText)
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	if includeOpen && isUnauthenticated && !config.GetInstanceExposePeers() {
 		const errText = "peers open query requires an authenticated account/user"
 		errWithCode := gtserror.NewErrorUnauthorized(errors.New(errText), errText)
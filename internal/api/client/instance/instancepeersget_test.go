@@ -19,6 +19,7 @@
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -84,7 +85,7 @@ func (suite *InstancePeersGetTestSuite) TestInstancePeersGetNoParamsUnauthorized
 	b, err := io.ReadAll(result.Body)
 	suite.NoError(err)
 
-	suite.Equal(`{"error":"Unauthorized: peers open query requires an authenticated account/user"}`, string(b))
+	suite.Equal(`{"error":"Unauthorized: peers open query requires an authenticated account/user","error_code":"unauthorized"}`, string(b))
 }
 
 func (suite *InstancePeersGetTestSuite) TestInstancePeersGetNoParamsAuthorized() {
@@ -113,6 +114,61 @@ func (suite *InstancePeersGetTestSuite) TestInstancePeersGetNoParamsAuthorized()
 ]`, dst.String())
 }
 
+func (suite *InstancePeersGetTestSuite) TestInstancePeersGetAnonymized() {
+	config.SetInstancePeersMode(config.InstancePeersModeAnonymize)
+
+	// Give one of the peers enough users
+	// to not be considered single-user.
+	peer, err := suite.db.GetInstance(context.Background(), "example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	peer.UsersTotal = 20
+	if err := suite.db.UpdateInstance(context.Background(), peer, "users_total"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	baseURI := fmt.Sprintf("%s://%s", config.GetProtocol(), config.GetHost())
+	requestURI := fmt.Sprintf("%s/%s", baseURI, instance.InstancePeersPath)
+	ctx := suite.newContext(recorder, http.MethodGet, requestURI, nil, "", false)
+
+	suite.instanceModule.InstancePeersGETHandler(ctx)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	// fossbros-anonymous.io has
+	// no known users, so left out.
+	suite.Equal(`["example.org"]`, string(b))
+}
+
+func (suite *InstancePeersGetTestSuite) TestInstancePeersGetDisabled() {
+	config.SetInstancePeersMode(config.InstancePeersModeDisabled)
+
+	recorder := httptest.NewRecorder()
+	baseURI := fmt.Sprintf("%s://%s", config.GetProtocol(), config.GetHost())
+	requestURI := fmt.Sprintf("%s/%s", baseURI, instance.InstancePeersPath)
+	ctx := suite.newContext(recorder, http.MethodGet, requestURI, nil, "", true)
+
+	suite.instanceModule.InstancePeersGETHandler(ctx)
+
+	suite.Equal(http.StatusNotFound, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	suite.Equal(`{"error":"Not Found: peers open query is not available on this instance","error_code":"not_found"}`, string(b))
+}
+
 func (suite *InstancePeersGetTestSuite) TestInstancePeersGetOnlySuspended() {
 	recorder := httptest.NewRecorder()
 	baseURI := fmt.Sprintf("%s://%s", config.GetProtocol(), config.GetHost())
@@ -159,7 +215,7 @@ func (suite *InstancePeersGetTestSuite) TestInstancePeersGetOnlySuspendedUnautho
 	b, err := io.ReadAll(result.Body)
 	suite.NoError(err)
 
-	suite.Equal(`{"error":"Unauthorized: peers blocked query requires an authenticated account/user"}`, string(b))
+	suite.Equal(`{"error":"Unauthorized: peers blocked query requires an authenticated account/user","error_code":"unauthorized"}`, string(b))
 }
 
 func (suite *InstancePeersGetTestSuite) TestInstancePeersGetOnlySuspendedAuthorized() {
@@ -192,6 +248,35 @@ func (suite *InstancePeersGetTestSuite) TestInstancePeersGetOnlySuspendedAuthori
 ]`, dst.String())
 }
 
+func (suite *InstancePeersGetTestSuite) TestInstancePeersGetOnlySuspendedNoComments() {
+	config.SetInstanceExposeBlocklistComments(false)
+
+	recorder := httptest.NewRecorder()
+	baseURI := fmt.Sprintf("%s://%s", config.GetProtocol(), config.GetHost())
+	requestURI := fmt.Sprintf("%s/%s?filter=suspended", baseURI, instance.InstancePeersPath)
+	ctx := suite.newContext(recorder, http.MethodGet, requestURI, nil, "", false)
+
+	suite.instanceModule.InstancePeersGETHandler(ctx)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`[
+  {
+    "domain": "replyguys.com",
+    "suspended_at": "2020-05-13T13:29:12.000Z",
+    "severity": "suspend"
+  }
+]`, dst.String())
+}
+
 func (suite *InstancePeersGetTestSuite) TestInstancePeersGetAll() {
 	recorder := httptest.NewRecorder()
 	baseURI := fmt.Sprintf("%s://%s", config.GetProtocol(), config.GetHost())
@@ -351,7 +436,7 @@ func (suite *InstancePeersGetTestSuite) TestInstancePeersGetFunkyParams() {
 	b, err := io.ReadAll(result.Body)
 	suite.NoError(err)
 
-	suite.Equal(`{"error":"Bad Request: filter aaaaaaaaaaaaaaaaa not recognized; accepted values are 'open', 'blocked', 'allowed', and 'suspended' (deprecated)"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: filter aaaaaaaaaaaaaaaaa not recognized; accepted values are 'open', 'blocked', 'allowed', and 'suspended' (deprecated)","error_code":"bad_request"}`, string(b))
 }
 
 func TestInstancePeersGetTestSuite(t *testing.T) {
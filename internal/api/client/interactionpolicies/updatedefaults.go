@@ -51,6 +51,10 @@
 //
 // Ie., in the example above, "public" would be updated, but "unlisted", "private", and "direct" would be reset to defaults.
 //
+// If `preset` is set, visibility levels left unspecified will be set to that preset instead of the defaults.
+// Currently the only supported preset is `bot`, which is the same as the defaults, except that replies
+// to public and unlisted posts are only automatically approved from followers and mentioned accounts.
+//
 // The server will perform some normalization on submitted policies so that you can't submit totally invalid policies.
 //
 //	---
@@ -67,6 +71,11 @@
 //
 //	parameters:
 //	-
+//		name: preset
+//		in: formData
+//		description: Preset to use for visibility levels left unspecified, instead of the defaults. Currently only `bot` is supported.
+//		type: string
+//	-
 //		name: public[can_favourite][automatic_approval][0]
 //		in: formData
 //		description: Nth entry for public.can_favourite.automatic_approval.
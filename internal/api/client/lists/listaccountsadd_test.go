@@ -97,7 +97,7 @@ func (suite *ListAccountsAddTestSuite) TestPostListAccountNotFollowed() {
 
 	resp, err := suite.postListAccounts(http.StatusNotFound, listID, accountIDs)
 	suite.NoError(err)
-	suite.Equal(`{"error":"Not Found: account 01F8MH5ZK5VRH73AKHQM6Y9VNX not currently followed"}`, string(resp))
+	suite.Equal(`{"error":"Not Found: account 01F8MH5ZK5VRH73AKHQM6Y9VNX not currently followed","error_code":"not_found"}`, string(resp))
 }
 
 func (suite *ListAccountsAddTestSuite) TestPostListAccountOK() {
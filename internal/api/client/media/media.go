@@ -29,6 +29,7 @@
 	IDKey            = "id"                                    // IDKey is the key for media attachment IDs
 	BasePath         = "/:" + apiutil.APIVersionKey + "/media" // BasePath is the base API path for making media requests through v1 or v2 of the api (for mastodon API compatibility)
 	AttachmentWithID = BasePath + "/:" + IDKey                 // BasePathWithID corresponds to a media attachment with the given ID
+	DescribePath     = AttachmentWithID + "/describe"          // DescribePath is for requesting a suggested description of a media attachment
 )
 
 type Module struct {
@@ -45,4 +46,5 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, BasePath, m.MediaCreatePOSTHandler)
 	attachHandler(http.MethodGet, AttachmentWithID, m.MediaGETHandler)
 	attachHandler(http.MethodPut, AttachmentWithID, m.MediaPUTHandler)
+	attachHandler(http.MethodPost, DescribePath, m.MediaDescribePOSTHandler)
 }
@@ -68,11 +68,28 @@
 //		type: string
 //		default: "0,0"
 //	-
+//		name: preserve_color_profile
+//		in: formData
+//		description: >-
+//			Keep the ICC color profile of an uploaded image, and apply any EXIF
+//			orientation directly to the image pixels, so that all other EXIF data
+//			(including orientation) can still be stripped. If not set, the
+//			instance's `media-preserve-color-profile` setting is used.
+//		type: boolean
+//	-
 //		name: file
 //		in: formData
 //		description: The media attachment to upload.
 //		type: file
 //		required: true
+//	-
+//		name: Idempotency-Key
+//		in: header
+//		description: >-
+//			Unique key for this upload. If an upload with the same key was
+//			made by the same account within the last hour, the attachment
+//			created by that upload is returned, instead of creating another.
+//		type: string
 //
 //	security:
 //	- OAuth2 Bearer:
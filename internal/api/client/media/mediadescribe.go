@@ -0,0 +1,140 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"errors"
+	"net/http"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// MediaDescribePOSTHandler swagger:operation POST /api/v1/media/{id}/describe mediaDescribe
+//
+// Get a suggested description (alt-text) for an image attachment.
+//
+// The suggestion is generated by the OCR or captioning backend configured on this instance,
+// and is only returned, not stored: the client should present it to the user to review and
+// edit, and then set it as the attachment description using PUT /api/v1/media/{id}.
+//
+// You must own the media attachment. Only images can be described.
+//
+// Check `configuration.media_attachments.description_suggestions` of /api/v2/instance
+// to see whether description suggestions are enabled on this instance.
+//
+//	---
+//	tags:
+//	- media
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		description: id of the attachment to describe
+//		type: string
+//		in: path
+//		required: true
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:media
+//
+//	responses:
+//		'200':
+//			description: The suggested description.
+//			schema:
+//				"$ref": "#/definitions/attachmentDescriptionSuggestion"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
+//		'401':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: unauthorized
+//		'404':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not found
+//		'406':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: not acceptable
+//		'422':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: attachment is not an image, or no description could be suggested
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
+//		'501':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: description suggestions are not enabled on this instance
+//		'503':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: description provider unavailable
+func (m *Module) MediaDescribePOSTHandler(c *gin.Context) {
+	if _, errWithCode := apiutil.ParseAPIVersion(
+		c.Param(apiutil.APIVersionKey),
+		[]string{apiutil.APIv1}...,
+	); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	authed, errWithCode := apiutil.TokenAuth(c,
+		true, true, true, true,
+		apiutil.ScopeWriteMedia,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	attachmentID := c.Param(IDKey)
+	if attachmentID == "" {
+		err := errors.New("no attachment id specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	suggestion, errWithCode := m.processor.Media().Describe(c.Request.Context(), authed.Account, attachmentID)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, suggestion)
+}
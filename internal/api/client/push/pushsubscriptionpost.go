@@ -147,6 +147,12 @@
 //		default: false
 //		description: Receive a push notification when a boost is pending?
 //	-
+//		name: data[alerts][thread_reply]
+//		in: formData
+//		type: boolean
+//		default: false
+//		description: Receive a push notification when someone replies in a subscribed thread?
+//	-
 //		name: data[policy]
 //		in: formData
 //		type: string
@@ -123,6 +123,12 @@
 //		default: false
 //		description: Receive a push notification when a boost is pending?
 //	-
+//		name: data[alerts][thread_reply]
+//		in: formData
+//		type: boolean
+//		default: false
+//		description: Receive a push notification when someone replies in a subscribed thread?
+//	-
 //		name: data[policy]
 //		in: formData
 //		type: string
@@ -254,6 +260,9 @@ func validateNormalizeUpdate(request *apimodel.WebPushSubscriptionUpdateRequest)
 	if request.DataAlertsPendingReblog != nil {
 		request.Data.Alerts.Reblog = *request.DataAlertsPendingReblog
 	}
+	if request.DataAlertsThreadReply != nil {
+		request.Data.Alerts.ThreadReply = *request.DataAlertsThreadReply
+	}
 
 	if request.DataPolicy != nil {
 		request.Data.Policy = request.DataPolicy
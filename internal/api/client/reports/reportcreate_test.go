@@ -138,7 +138,7 @@ func (suite *ReportCreateTestSuite) TestCreateReport2() {
 func (suite *ReportCreateTestSuite) TestCreateReport3() {
 	form := &apimodel.ReportCreateRequest{}
 
-	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: account_id must be set"}`, form)
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: account_id must be set","error_code":"bad_request"}`, form)
 	suite.NoError(err)
 	suite.Nil(report)
 }
@@ -151,7 +151,7 @@ func (suite *ReportCreateTestSuite) TestCreateReport4() {
 		Forward:   true,
 	}
 
-	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: account_id was not valid"}`, form)
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: account_id was not valid","error_code":"bad_request"}`, form)
 	suite.NoError(err)
 	suite.Nil(report)
 }
@@ -162,7 +162,7 @@ func (suite *ReportCreateTestSuite) TestCreateReport5() {
 		AccountID: testAccount.ID,
 	}
 
-	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: cannot report your own account"}`, form)
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: cannot report your own account","error_code":"bad_request"}`, form)
 	suite.NoError(err)
 	suite.Nil(report)
 }
@@ -175,7 +175,7 @@ func (suite *ReportCreateTestSuite) TestCreateReport6() {
 		Comment:   "netus et malesuada fames ac turpis egestas sed tempus urna et pharetra pharetra massa massa ultricies mi quis hendrerit dolor magna eget est lorem ipsum dolor sit amet consectetur adipiscing elit pellentesque habitant morbi tristique senectus et netus et malesuada fames ac turpis egestas integer eget aliquet nibh praesent tristique magna sit amet purus gravida quis blandit turpis cursus in hac habitasse platea dictumst quisque sagittis purus sit amet volutpat consequat mauris nunc congue nisi vitae suscipit tellus mauris a diam maecenas sed enim ut sem viverra aliquet eget sit amet tellus cras adipiscing enim eu turpis egestas pretium aenean pharetra magna ac placerat vestibulum lectus mauris ultrices eros in cursus turpis massa tincidunt dui ut ornare lectus sit amet est placerat in egestas erat imperdiet sed euismod nisi porta lorem mollis aliquam ut porttitor leo a diam sollicitudin tempor id eu nisl nunc mi ipsum faucibus vitae aliquet nec ullamcorper sit amet risus nullam eget felis eget nunc lobortis mattis aliquam faucibus purus in massa tempor nec feugiat nisl pretium fusce id velit ut tortor pretium viverra suspendisse potenti nullam ac tortor vitae purus faucibus ornare suspendisse sed nisi lacus sed viverra tellus in hac habitasse platea dictumst vestibulum rhoncus est pellentesque elit ullamcorper dignissim cras tincidunt lobortis feugiat vivamus at augue eget arcu dictum varius duis at consectetur lorem donec massa sapien faucibus et molestie ac feugiat sed lectus vestibulum mattis ullamcorper velit sed ullamcorper morbi tincidunt ornare massa eget ",
 	}
 
-	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: comment length must be no more than 1000 chars, provided comment was 1588 chars"}`, form)
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: comment length must be no more than 1000 chars, provided comment was 1588 chars","error_code":"bad_request"}`, form)
 	suite.NoError(err)
 	suite.Nil(report)
 }
@@ -185,7 +185,7 @@ func (suite *ReportCreateTestSuite) TestCreateReport7() {
 		AccountID: "01GPGH5ENXWE5K65YNNXYWAJA4",
 	}
 
-	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: account with ID 01GPGH5ENXWE5K65YNNXYWAJA4 does not exist"}`, form)
+	report, err := suite.createReport(http.StatusBadRequest, `{"error":"Bad Request: account with ID 01GPGH5ENXWE5K65YNNXYWAJA4 does not exist","error_code":"bad_request"}`, form)
 	suite.NoError(err)
 	suite.Nil(report)
 }
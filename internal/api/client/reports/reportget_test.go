@@ -143,19 +143,19 @@ func (suite *ReportGetTestSuite) TestGetReport1() {
 
 func (suite *ReportGetTestSuite) TestGetReport2() {
 	targetReport := suite.testReports["remote_account_1_report_local_account_2"]
-	report, err := suite.getReport(http.StatusNotFound, `{"error":"Not Found"}`, targetReport.ID)
+	report, err := suite.getReport(http.StatusNotFound, `{"error":"Not Found","error_code":"not_found"}`, targetReport.ID)
 	suite.NoError(err)
 	suite.Nil(report)
 }
 
 func (suite *ReportGetTestSuite) TestGetReport3() {
-	report, err := suite.getReport(http.StatusBadRequest, `{"error":"Bad Request: required key id was not set or had empty value"}`, "")
+	report, err := suite.getReport(http.StatusBadRequest, `{"error":"Bad Request: required key id was not set or had empty value","error_code":"param_required"}`, "")
 	suite.NoError(err)
 	suite.Nil(report)
 }
 
 func (suite *ReportGetTestSuite) TestGetReport4() {
-	report, err := suite.getReport(http.StatusNotFound, `{"error":"Not Found"}`, "01GPJWHQS1BG0SF0WZ1SABC4RZ")
+	report, err := suite.getReport(http.StatusNotFound, `{"error":"Not Found","error_code":"not_found"}`, "01GPJWHQS1BG0SF0WZ1SABC4RZ")
 	suite.NoError(err)
 	suite.Nil(report)
 }
@@ -132,6 +132,14 @@
 //		default: false
 //		in: query
 //	-
+//		name: notes
+//		type: boolean
+//		description: >-
+//			If search type includes accounts, and search query is an arbitrary string, also show accounts
+//			on which the requesting account has left a private note containing the query string.
+//		default: false
+//		in: query
+//	-
 //		name: exclude_unreviewed
 //		type: boolean
 //		description: >-
@@ -242,6 +250,12 @@ func (m *Module) SearchGETHandler(c *gin.Context) {
 		return
 	}
 
+	notes, errWithCode := apiutil.ParseSearchNotes(c.Query(apiutil.SearchNotesKey), false)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	excludeUnreviewed, errWithCode := apiutil.ParseSearchExcludeUnreviewed(c.Query(apiutil.SearchExcludeUnreviewedKey), false)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -257,6 +271,7 @@ func (m *Module) SearchGETHandler(c *gin.Context) {
 		QueryType:         c.Query(apiutil.SearchTypeKey),
 		Resolve:           resolve,
 		Following:         following,
+		Notes:             notes,
 		ExcludeUnreviewed: excludeUnreviewed,
 		AccountID:         c.Query(apiutil.AccountIDKey),
 		APIv1:             apiVersion == apiutil.APIv1,
@@ -1466,7 +1466,7 @@ func (suite *SearchGetTestSuite) TestSearchBadQueryType() {
 		following          *bool   = nil
 		fromAccountID      *string = nil
 		expectedHTTPStatus         = http.StatusBadRequest
-		expectedBody               = `{"error":"Bad Request: search query type aaaaaaaaaaa was not recognized, valid options are ['', 'accounts', 'statuses', 'hashtags']"}`
+		expectedBody               = `{"error":"Bad Request: search query type aaaaaaaaaaa was not recognized, valid options are ['', 'accounts', 'statuses', 'hashtags']","error_code":"bad_request"}`
 	)
 
 	_, err := suite.getSearch(
@@ -1505,7 +1505,7 @@ func (suite *SearchGetTestSuite) TestSearchEmptyQuery() {
 		following          *bool   = nil
 		fromAccountID      *string = nil
 		expectedHTTPStatus         = http.StatusBadRequest
-		expectedBody               = `{"error":"Bad Request: required key q was not set or had empty value"}`
+		expectedBody               = `{"error":"Bad Request: required key q was not set or had empty value","error_code":"param_required"}`
 	)
 
 	_, err := suite.getSearch(
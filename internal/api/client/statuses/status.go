@@ -27,6 +27,8 @@
 const (
 	// IDKey is for status UUIDs
 	IDKey = "id"
+	// MentionKey is for mentions to be previewed
+	MentionKey = "mention"
 	// BasePath is the base path for serving the statuses API, minus the 'api' prefix
 	BasePath = "/v1/statuses"
 	// BasePathWithID is just the base path with the ID key in it.
@@ -57,6 +59,11 @@
 	// UnmutePath is for undoing an existing mute
 	UnmutePath = BasePathWithID + "/unmute"
 
+	// SubscribePath is for subscribing to a given status thread so that notifications will be received about all replies in it.
+	SubscribePath = BasePathWithID + "/subscribe"
+	// UnsubscribePath is for undoing an existing subscription
+	UnsubscribePath = BasePathWithID + "/unsubscribe"
+
 	// PinPath is for pinning a status to an account profile so that it's the first thing people see
 	PinPath = BasePathWithID + "/pin"
 	// UnpinPath is for undoing a pin and returning a status to the ever-swirling drain of time and entropy
@@ -70,6 +77,20 @@
 
 	// SourcePath is used for fetching source of a post.
 	SourcePath = BasePathWithID + "/source"
+
+	// VisibilityPath is used for changing the visibility of a post after publication.
+	VisibilityPath = BasePathWithID + "/visibility"
+
+	// TranslatePath is used for translating a post into another language.
+	TranslatePath = BasePathWithID + "/translate"
+
+	// MentionPreviewPath is used for previewing mentions while composing a post.
+	MentionPreviewPath = BasePath + "/mention_preview"
+
+	// CleanupPath is used for starting and listing cleanups of own posts.
+	CleanupPath = BasePath + "/cleanup"
+	// CleanupPathWithID is used for checking progress of / cancelling a cleanup.
+	CleanupPathWithID = CleanupPath + "/:" + IDKey
 )
 
 type Module struct {
@@ -102,6 +123,10 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, MutePath, m.StatusMutePOSTHandler)
 	attachHandler(http.MethodPost, UnmutePath, m.StatusUnmutePOSTHandler)
 
+	// subscribe stuff
+	attachHandler(http.MethodPost, SubscribePath, m.StatusSubscribePOSTHandler)
+	attachHandler(http.MethodPost, UnsubscribePath, m.StatusUnsubscribePOSTHandler)
+
 	// reblog stuff
 	attachHandler(http.MethodPost, ReblogPath, m.StatusBoostPOSTHandler)
 	attachHandler(http.MethodPost, UnreblogPath, m.StatusUnboostPOSTHandler)
@@ -115,4 +140,17 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// history/edit stuff
 	attachHandler(http.MethodGet, HistoryPath, m.StatusHistoryGETHandler)
 	attachHandler(http.MethodGet, SourcePath, m.StatusSourceGETHandler)
+	attachHandler(http.MethodPut, VisibilityPath, m.StatusVisibilityPUTHandler)
+
+	// translation
+	attachHandler(http.MethodPost, TranslatePath, m.StatusTranslatePOSTHandler)
+
+	// compose helpers
+	attachHandler(http.MethodGet, MentionPreviewPath, m.StatusMentionPreviewGETHandler)
+
+	// cleanup of old statuses
+	attachHandler(http.MethodPost, CleanupPath, m.StatusCleanupPOSTHandler)
+	attachHandler(http.MethodGet, CleanupPath, m.StatusCleanupsGETHandler)
+	attachHandler(http.MethodGet, CleanupPathWithID, m.StatusCleanupGETHandler)
+	attachHandler(http.MethodDelete, CleanupPathWithID, m.StatusCleanupDELETEHandler)
 }
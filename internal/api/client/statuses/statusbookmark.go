@@ -21,6 +21,7 @@
 	"errors"
 	"net/http"
 
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"github.com/gin-gonic/gin"
@@ -30,10 +31,19 @@
 //
 // Bookmark status with the given ID.
 //
+// If folder_id is set, the bookmark is filed in the bookmark folder with that ID,
+// moving it there if the status was already bookmarked. Otherwise, the bookmark
+// is filed in the default folder.
+//
 //	---
 //	tags:
 //	- statuses
 //
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
 //	produces:
 //	- application/json
 //
@@ -44,6 +54,11 @@
 //		description: Target status ID.
 //		in: path
 //		required: true
+//	-
+//		name: folder_id
+//		type: string
+//		description: ID of the bookmark folder to file the bookmark in.
+//		in: formData
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -106,7 +121,18 @@ func (m *Module) StatusBookmarkPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	apiStatus, errWithCode := m.processor.Status().BookmarkCreate(c.Request.Context(), authed.Account, targetStatusID)
+	form := &apimodel.StatusBookmarkRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	apiStatus, errWithCode := m.processor.Status().BookmarkCreate(
+		c.Request.Context(),
+		authed.Account,
+		targetStatusID,
+		form.FolderID,
+	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -422,7 +422,8 @@ func (suite *StatusBoostTestSuite) TestPostUnboostable() {
 
 	// We should have a helpful message.
 	suite.Equal(`{
-  "error": "Forbidden: you do not have permission to boost this status"
+  "error": "Forbidden: you do not have permission to boost this status",
+  "error_code": "interaction_rejected"
 }`, out)
 }
 
@@ -461,7 +462,8 @@ func (suite *StatusBoostTestSuite) TestPostNotVisible() {
 
 	// We should have a helpful message.
 	suite.Equal(`{
-  "error": "Not Found: target status not found"
+  "error": "Not Found: target status not found",
+  "error_code": "status_not_found"
 }`, out)
 }
 
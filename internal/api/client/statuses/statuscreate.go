@@ -137,6 +137,14 @@
 //		type: boolean
 //		in: formData
 //	-
+//		name: preview_links
+//		x-go-name: PreviewLinks
+//		description: >-
+//			Generate a link preview card for the first link in the status.
+//			If not set, the account's default setting is used.
+//		type: boolean
+//		in: formData
+//	-
 //		name: spoiler_text
 //		x-go-name: SpoilerText
 //		description: |-
@@ -189,6 +197,28 @@
 //		format: date-time
 //		in: formData
 //	-
+//		name: expires_in
+//		x-go-name: ExpiresIn
+//		description: |-
+//			Duration after which the status should be automatically deleted, in seconds.
+//
+//			Must be within the min_expiration and max_expiration bounds given in the
+//			instance configuration. Cannot be used together with expires_at or scheduled_at.
+//		type: integer
+//		format: int64
+//		in: formData
+//	-
+//		name: expires_at
+//		x-go-name: ExpiresAt
+//		description: |-
+//			ISO 8601 Datetime at which the status should be automatically deleted.
+//
+//			Must be within the min_expiration and max_expiration bounds given in the
+//			instance configuration. Cannot be used together with expires_in or scheduled_at.
+//		type: string
+//		format: date-time
+//		in: formData
+//	-
 //		name: language
 //		x-go-name: Language
 //		description: ISO 639 language code for this status.
@@ -233,6 +263,15 @@
 //		in: formData
 //		description: Nth entry for interaction_policy.can_reblog.manual_approval.
 //		type: string
+//	-
+//		name: Idempotency-Key
+//		in: header
+//		description: >-
+//			Unique key for this status. If a status with the same key was
+//			posted by the same account within the last hour, that status is
+//			returned, instead of creating another. Prevents duplicate posts
+//			when a client retries a request that timed out.
+//		type: string
 //
 //	produces:
 //	- application/json
@@ -429,28 +468,63 @@ func parseStatusCreateForm(c *gin.Context) (*apimodel.StatusCreateRequest, gtser
 
 	// Parse scheduled_at if given.
 	if form.ScheduledAtRaw != "" {
-		// Try RFC3339 initially, which
-		// is a stricter UTC subset of ISO8601.
-		scheduledAt, err := time.Parse(
-			time.RFC3339, form.ScheduledAtRaw,
+		scheduledAt, errWithCode := parseISO8601(form.ScheduledAtRaw, "scheduled_at")
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		form.ScheduledAt = &scheduledAt
+	}
+
+	// Normalize status expiry duration if given.
+	if form.ExpiresInI != nil {
+
+		// If we parsed this as JSON, expires_in
+		// may be either a float64 or a string.
+		expiresIn, err := apiutil.ParseDuration(
+			form.ExpiresInI,
+			"expires_in",
 		)
 		if err != nil {
-			// Try ISO8601 with offset
-			// (many clients use this).
-			scheduledAt, err = time.Parse(
-				util.ISO8601Offset, form.ScheduledAtRaw,
-			)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
 		}
+		form.ExpiresIn = util.PtrOrZero(expiresIn)
+	}
 
-		// If we still have an error
-		// we can't use this time.
-		if err != nil {
-			text := "could not parse scheduled_at value " + form.ScheduledAtRaw + " as ISO8601 time"
+	// Parse expires_at if given.
+	if form.ExpiresAtRaw != "" {
+		if form.ExpiresIn != 0 {
+			const text = "expires_in and expires_at cannot both be set"
 			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
-		form.ScheduledAt = &scheduledAt
+		expiresAt, errWithCode := parseISO8601(form.ExpiresAtRaw, "expires_at")
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		form.ExpiresAt = &expiresAt
 	}
 
 	return form, nil
 }
+
+// parseISO8601 parses the given raw
+// value of form field as an ISO8601 time.
+func parseISO8601(raw string, field string) (time.Time, gtserror.WithCode) {
+	// Try RFC3339 initially, which
+	// is a stricter UTC subset of ISO8601.
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		// Try ISO8601 with offset
+		// (many clients use this).
+		t, err = time.Parse(util.ISO8601Offset, raw)
+	}
+
+	// If we still have an error
+	// we can't use this time.
+	if err != nil {
+		text := "could not parse " + field + " value " + raw + " as ISO8601 time"
+		return time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return t, nil
+}
@@ -404,7 +404,8 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusMessedUpIntPolicy() {
 	// We should have a helpful error
 	// message telling us how we screwed up.
 	suite.Equal(`{
-  "error": "Bad Request: error converting private.can_reply.automatic_approval: policyURI public is not feasible for visibility private"
+  "error": "Bad Request: error converting private.can_reply.automatic_approval: policyURI public is not feasible for visibility private",
+  "error_code": "bad_request"
 }`, out)
 }
 
@@ -484,7 +485,8 @@ func (suite *StatusCreateTestSuite) TestPostNewScheduledStatusNonsenseTime() {
 	// We should have a helpful error
 	// message telling us how we screwed up.
 	suite.Equal(`{
-  "error": "Bad Request: could not parse scheduled_at value pee pee poo poo as ISO8601 time"
+  "error": "Bad Request: could not parse scheduled_at value pee pee poo poo as ISO8601 time",
+  "error_code": "bad_request"
 }`, out)
 }
 
@@ -919,7 +921,8 @@ func (suite *StatusCreateTestSuite) TestReplyToNonexistentStatus() {
 	// our call to the function.
 	suite.Equal(http.StatusNotFound, recorder.Code)
 	suite.Equal(`{
-  "error": "Not Found: target status not found"
+  "error": "Not Found: target status not found",
+  "error_code": "status_not_found"
 }`, out)
 }
 
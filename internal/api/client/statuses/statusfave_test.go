@@ -180,7 +180,8 @@ func (suite *StatusFaveTestSuite) TestPostUnfaveable() {
 
 	// We should get a helpful error.
 	suite.Equal(`{
-  "error": "Forbidden: you do not have permission to fave this status"
+  "error": "Forbidden: you do not have permission to fave this status",
+  "error_code": "interaction_rejected"
 }`, out)
 }
 
@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/statuses"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusMentionPreviewTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusMentionPreviewTestSuite) getMentionPreview(mention string) (*apimodel.MentionPreview, int) {
+	var (
+		testApplication = suite.testApplications["application_1"]
+		testAccount     = suite.testAccounts["local_account_1"]
+		testUser        = suite.testUsers["local_account_1"]
+		testToken       = oauth.DBTokenToToken(suite.testTokens["local_account_1"])
+		target          = "http://localhost:8080/api" + statuses.MentionPreviewPath + "?" + statuses.MentionKey + "=" + url.QueryEscape(mention)
+	)
+
+	// Setup request.
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, target, nil)
+	request.Header.Set("accept", "application/json")
+	ctx, _ := testrig.CreateGinTestContext(recorder, request)
+
+	// Set auth.
+	ctx.Set(oauth.SessionAuthorizedApplication, testApplication)
+	ctx.Set(oauth.SessionAuthorizedToken, testToken)
+	ctx.Set(oauth.SessionAuthorizedUser, testUser)
+	ctx.Set(oauth.SessionAuthorizedAccount, testAccount)
+
+	// Call the handler.
+	suite.statusModule.StatusMentionPreviewGETHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	if result.StatusCode != http.StatusOK {
+		return nil, result.StatusCode
+	}
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	preview := new(apimodel.MentionPreview)
+	if err := json.Unmarshal(b, preview); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return preview, result.StatusCode
+}
+
+func (suite *StatusMentionPreviewTestSuite) TestPreviewLocal() {
+	// Mixed case + trailing
+	// punctuation should be fine.
+	preview, code := suite.getMentionPreview("@1HappyTurtle,")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("@1HappyTurtle", preview.Mention)
+	suite.Equal(suite.testAccounts["local_account_2"].ID, preview.Account.ID)
+}
+
+func (suite *StatusMentionPreviewTestSuite) TestPreviewRemoteNoAt() {
+	preview, code := suite.getMentionPreview("foss_satan@FOSSBROS-ANONYMOUS.IO.")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("@foss_satan@FOSSBROS-ANONYMOUS.IO", preview.Mention)
+	suite.Equal(suite.testAccounts["remote_account_1"].ID, preview.Account.ID)
+}
+
+func (suite *StatusMentionPreviewTestSuite) TestPreviewNotFound() {
+	_, code := suite.getMentionPreview("@nobody_at_all")
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *StatusMentionPreviewTestSuite) TestPreviewBadMention() {
+	_, code := suite.getMentionPreview("@...")
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func TestStatusMentionPreviewTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusMentionPreviewTestSuite))
+}
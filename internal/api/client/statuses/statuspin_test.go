@@ -140,7 +140,7 @@ func (suite *StatusPinTestSuite) TestPinStatusTwiceError() {
 
 	if _, err := suite.createPin(
 		http.StatusUnprocessableEntity,
-		`{"error":"Unprocessable Entity: status already pinned"}`,
+		`{"error":"Unprocessable Entity: status already pinned","error_code":"unprocessable_entity"}`,
 		targetStatus.ID,
 		testAccount,
 	); err != nil {
@@ -156,7 +156,7 @@ func (suite *StatusPinTestSuite) TestPinStatusOtherAccountError() {
 
 	if _, err := suite.createPin(
 		http.StatusUnprocessableEntity,
-		`{"error":"Unprocessable Entity: status 01F8MH75CBF9JFX4ZAD54N0W0R does not belong to account 01F8MH1H7YV1Z7D2C8K2730QBF"}`,
+		`{"error":"Unprocessable Entity: status 01F8MH75CBF9JFX4ZAD54N0W0R does not belong to account 01F8MH1H7YV1Z7D2C8K2730QBF","error_code":"unprocessable_entity"}`,
 		targetStatus.ID,
 		testAccount,
 	); err != nil {
@@ -198,7 +198,7 @@ func (suite *StatusPinTestSuite) TestPinStatusTooManyPins() {
 	targetStatus := suite.testStatuses["local_account_1_status_1"]
 	if _, err := suite.createPin(
 		http.StatusUnprocessableEntity,
-		`{"error":"Unprocessable Entity: status pin limit exceeded, you've already pinned 10 status(es) out of 10"}`,
+		`{"error":"Unprocessable Entity: status pin limit exceeded, you've already pinned 10 status(es) out of 10","error_code":"unprocessable_entity"}`,
 		targetStatus.ID,
 		testAccount,
 	); err != nil {
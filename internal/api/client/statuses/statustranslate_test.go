@@ -0,0 +1,160 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/statuses"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusTranslateTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusTranslateTestSuite) translate(statusID string, lang string) (*apimodel.Translation, int) {
+	var (
+		testApplication = suite.testApplications["application_1"]
+		testAccount     = suite.testAccounts["local_account_1"]
+		testUser        = suite.testUsers["local_account_1"]
+		testToken       = oauth.DBTokenToToken(suite.testTokens["local_account_1"])
+		target          = "http://localhost:8080/api" + strings.ReplaceAll(statuses.TranslatePath, ":id", statusID)
+	)
+
+	// Setup request.
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, target, strings.NewReader(url.Values{"lang": {lang}}.Encode()))
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx, _ := testrig.CreateGinTestContext(recorder, request)
+	ctx.AddParam(statuses.IDKey, statusID)
+
+	// Set auth.
+	ctx.Set(oauth.SessionAuthorizedApplication, testApplication)
+	ctx.Set(oauth.SessionAuthorizedToken, testToken)
+	ctx.Set(oauth.SessionAuthorizedUser, testUser)
+	ctx.Set(oauth.SessionAuthorizedAccount, testAccount)
+
+	// Call the handler.
+	suite.statusModule.StatusTranslatePOSTHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	if result.StatusCode != http.StatusOK {
+		return nil, result.StatusCode
+	}
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	translation := new(apimodel.Translation)
+	if err := json.Unmarshal(b, translation); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return translation, result.StatusCode
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateWithContentWarning() {
+	status := suite.testStatuses["admin_account_status_2"]
+
+	translation, code := suite.translate(status.ID, "de")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("[de] "+status.Content, translation.Content)
+	suite.Equal("[de] "+status.ContentWarning, translation.SpoilerText)
+	suite.Nil(translation.Poll)
+	suite.Empty(translation.MediaAttachments)
+	suite.Equal("en", translation.DetectedSourceLanguage)
+	suite.Equal("Mock", translation.Provider)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateWithAttachment() {
+	status := suite.testStatuses["admin_account_status_1"]
+	attachment, err := suite.db.GetAttachmentByID(suite.T().Context(), status.AttachmentIDs[0])
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	translation, code := suite.translate(status.ID, "fr")
+	suite.Equal(http.StatusOK, code)
+	suite.Empty(translation.SpoilerText)
+	suite.Len(translation.MediaAttachments, 1)
+	suite.Equal(attachment.ID, translation.MediaAttachments[0].ID)
+	suite.Equal("[fr] "+attachment.Description, translation.MediaAttachments[0].Description)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateWithPoll() {
+	status := suite.testStatuses["local_account_2_status_8"]
+	poll := testrig.NewTestPolls()["local_account_2_status_8_poll"]
+
+	translation, code := suite.translate(status.ID, "de")
+	suite.Equal(http.StatusOK, code)
+	if suite.NotNil(translation.Poll) {
+		suite.Equal(poll.ID, translation.Poll.ID)
+		suite.Len(translation.Poll.Options, len(poll.Options))
+		for i, option := range poll.Options {
+			suite.Equal("[de] "+option, translation.Poll.Options[i].Title)
+		}
+	}
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateSanitized() {
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["admin_account_status_1"]
+	status.Content = `<p>hello</p><script>alert("pwned")</script>`
+	if err := suite.db.UpdateStatus(suite.T().Context(), status, "content"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	translation, code := suite.translate(status.ID, "de")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("[de] <p>hello</p>", translation.Content)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateSameLanguage() {
+	_, code := suite.translate(suite.testStatuses["admin_account_status_1"].ID, "en-GB")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateBadLanguage() {
+	_, code := suite.translate(suite.testStatuses["admin_account_status_1"].ID, "not a language!")
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateFollowersOnly() {
+	_, code := suite.translate(suite.testStatuses["local_account_2_status_7"].ID, "de")
+	suite.Equal(http.StatusForbidden, code)
+}
+
+func TestStatusTranslateTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusTranslateTestSuite))
+}
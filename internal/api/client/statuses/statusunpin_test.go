@@ -107,7 +107,7 @@ func (suite *StatusUnpinTestSuite) TestUnpinStatusNotFound() {
 	// Unpin a pinned followers-only status owned by another account.
 	targetStatus := suite.testStatuses["local_account_2_status_7"]
 
-	if _, err := suite.createUnpin(http.StatusNotFound, `{"error":"Not Found: target status not found"}`, targetStatus.ID); err != nil {
+	if _, err := suite.createUnpin(http.StatusNotFound, `{"error":"Not Found: target status not found","error_code":"status_not_found"}`, targetStatus.ID); err != nil {
 		suite.FailNow(err.Error())
 	}
 }
@@ -118,7 +118,7 @@ func (suite *StatusUnpinTestSuite) TestUnpinStatusUnprocessable() {
 
 	if _, err := suite.createUnpin(
 		http.StatusUnprocessableEntity,
-		`{"error":"Unprocessable Entity: status 01F8MHAMCHF6Y650WCRSCP4WMY does not belong to account 01F8MH17FWEB39HZJ76B6VXSKF"}`,
+		`{"error":"Unprocessable Entity: status 01F8MHAMCHF6Y650WCRSCP4WMY does not belong to account 01F8MH17FWEB39HZJ76B6VXSKF","error_code":"unprocessable_entity"}`,
 		targetStatus.ID,
 	); err != nil {
 		suite.FailNow(err.Error())
@@ -0,0 +1,116 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/statuses"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/oauth"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusVisibilityTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusVisibilityTestSuite) changeVisibility(statusID string, body string) (*apimodel.Status, int) {
+	var (
+		testApplication = suite.testApplications["application_1"]
+		testAccount     = suite.testAccounts["local_account_1"]
+		testUser        = suite.testUsers["local_account_1"]
+		testToken       = oauth.DBTokenToToken(suite.testTokens["local_account_1"])
+		target          = "http://localhost:8080/api" + strings.ReplaceAll(statuses.VisibilityPath, ":id", statusID)
+	)
+
+	// Setup request.
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, target, strings.NewReader(body))
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", "application/json")
+	ctx, _ := testrig.CreateGinTestContext(recorder, request)
+	ctx.AddParam(statuses.IDKey, statusID)
+
+	// Set auth.
+	ctx.Set(oauth.SessionAuthorizedApplication, testApplication)
+	ctx.Set(oauth.SessionAuthorizedToken, testToken)
+	ctx.Set(oauth.SessionAuthorizedUser, testUser)
+	ctx.Set(oauth.SessionAuthorizedAccount, testAccount)
+
+	// Call the handler.
+	suite.statusModule.StatusVisibilityPUTHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	if result.StatusCode != http.StatusOK {
+		return nil, result.StatusCode
+	}
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiStatus := new(apimodel.Status)
+	if err := json.Unmarshal(b, apiStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiStatus, result.StatusCode
+}
+
+func (suite *StatusVisibilityTestSuite) TestDelist() {
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	apiStatus, code := suite.changeVisibility(status.ID, `{"visibility":"unlisted"}`)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(apimodel.VisibilityUnlisted, apiStatus.Visibility)
+
+	dbStatus, err := suite.db.GetStatusByID(suite.T().Context(), status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.VisibilityUnlocked, dbStatus.Visibility)
+}
+
+func (suite *StatusVisibilityTestSuite) TestNoVisibility() {
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	_, code := suite.changeVisibility(status.ID, `{}`)
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func (suite *StatusVisibilityTestSuite) TestToDirect() {
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	_, code := suite.changeVisibility(status.ID, `{"visibility":"direct"}`)
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func TestStatusVisibilityTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusVisibilityTestSuite))
+}
@@ -140,12 +140,18 @@
 //							`notification`: a new notification has been received.
 //							`delete`: a status has been deleted.
 //							`filters_changed`: filters (including keywords and statuses) have changed.
+//							`status.update`: a status has been edited.
+//							`conversation`: a direct conversation has been updated.
+//							`list.remove`: an account has been removed from a list.
 //						type: string
 //						enum:
 //						- update
 //						- notification
 //						- delete
 //						- filters_changed
+//						- status.update
+//						- conversation
+//						- list.remove
 //					payload:
 //						description: |-
 //							The payload of the streamed message.
@@ -157,6 +163,9 @@
 //							If `event` = `notification`, then the payload will be a JSON string of a notification.
 //							If `event` = `delete`, then the payload will be a status ID.
 //							If `event` = `filters_changed`, then there is no payload.
+//							If `event` = `status.update`, then the payload will be a JSON string of a status.
+//							If `event` = `conversation`, then the payload will be a JSON string of a conversation.
+//							If `event` = `list.remove`, then the payload will be the ID of the removed account.
 //						type: string
 //						example: "{\"id\":\"01FC3TZ5CFG6H65GCKCJRKA669\",\"created_at\":\"2021-08-02T16:25:52Z\",\"sensitive\":false,\"spoiler_text\":\"\",\"visibility\":\"public\",\"language\":\"en\",\"uri\":\"https://gts.superseriousbusiness.org/users/dumpsterqueer/statuses/01FC3TZ5CFG6H65GCKCJRKA669\",\"url\":\"https://gts.superseriousbusiness.org/@dumpsterqueer/statuses/01FC3TZ5CFG6H65GCKCJRKA669\",\"replies_count\":0,\"reblogs_count\":0,\"favourites_count\":0,\"favourited\":false,\"reblogged\":false,\"muted\":false,\"bookmarked\":fals…//gts.superseriousbusiness.org/fileserver/01JNN207W98SGG3CBJ76R5MVDN/header/original/019036W043D8FXPJKSKCX7G965.png\",\"header_static\":\"https://gts.superseriousbusiness.org/fileserver/01JNN207W98SGG3CBJ76R5MVDN/header/small/019036W043D8FXPJKSKCX7G965.png\",\"followers_count\":33,\"following_count\":28,\"statuses_count\":126,\"last_status_at\":\"2021-08-02T16:25:52Z\",\"emojis\":[],\"fields\":[]},\"media_attachments\":[],\"mentions\":[],\"tags\":[],\"emojis\":[],\"card\":null,\"poll\":null,\"text\":\"a\"}"
 //		'401':
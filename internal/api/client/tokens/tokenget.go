@@ -96,6 +96,7 @@ func (m *Module) TokenInfoGETHandler(c *gin.Context) {
 	tokenInfo, errWithCode := m.processor.Account().TokenGet(
 		c.Request.Context(),
 		authed.User.ID,
+		authed.Token.GetAccess(),
 		tokenID,
 	)
 	if errWithCode != nil {
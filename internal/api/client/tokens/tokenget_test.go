@@ -46,6 +46,7 @@ func (suite *TokenGetTestSuite) TestTokenGet() {
 	suite.Equal(`{
   "id": "01F8MGTQW4DKTDF8SW5CT9HYGA",
   "created_at": "2021-06-20T10:53:00.164Z",
+  "current": true,
   "scope": "read write push",
   "application": {
     "name": "really cool gts application",
@@ -69,7 +70,8 @@ func (suite *TokenGetTestSuite) TestTokenGetNotOurs() {
 
 	suite.Equal(http.StatusNotFound, code)
 	suite.Equal(`{
-  "error": "Not Found"
+  "error": "Not Found",
+  "error_code": "not_found"
 }`, out)
 }
 
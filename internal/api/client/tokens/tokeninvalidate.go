@@ -100,6 +100,7 @@ func (m *Module) TokenInvalidatePOSTHandler(c *gin.Context) {
 	tokenInfo, errWithCode := m.processor.Account().TokenInvalidate(
 		c.Request.Context(),
 		authed.User.ID,
+		authed.Token.GetAccess(),
 		tokenID,
 	)
 	if errWithCode != nil {
@@ -47,6 +47,7 @@ func (suite *TokenInvalidateTestSuite) TestTokenInvalidate() {
 	suite.Equal(`{
   "id": "01F8MGTQW4DKTDF8SW5CT9HYGA",
   "created_at": "2021-06-20T10:53:00.164Z",
+  "current": true,
   "scope": "read write push",
   "application": {
     "name": "really cool gts application",
@@ -77,7 +78,8 @@ func (suite *TokenInvalidateTestSuite) TestTokenInvalidateNotOurs() {
 
 	suite.Equal(http.StatusNotFound, code)
 	suite.Equal(`{
-  "error": "Not Found"
+  "error": "Not Found",
+  "error_code": "not_found"
 }`, out)
 }
 
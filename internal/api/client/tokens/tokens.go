@@ -26,9 +26,10 @@
 )
 
 const (
-	BasePath            = "/v1/tokens"
-	BasePathWithID      = BasePath + "/:" + apiutil.IDKey
-	InvalidateTokenPath = BasePathWithID + "/invalidate"
+	BasePath             = "/v1/tokens"
+	BasePathWithID       = BasePath + "/:" + apiutil.IDKey
+	InvalidateTokenPath  = BasePathWithID + "/invalidate"
+	InvalidateOthersPath = BasePath + "/invalidate_others"
 )
 
 type Module struct {
@@ -45,4 +46,5 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.TokensInfoGETHandler)
 	attachHandler(http.MethodGet, BasePathWithID, m.TokenInfoGETHandler)
 	attachHandler(http.MethodPost, InvalidateTokenPath, m.TokenInvalidatePOSTHandler)
+	attachHandler(http.MethodPost, InvalidateOthersPath, m.TokensInvalidateOthersPOSTHandler)
 }
@@ -132,6 +132,7 @@ func (m *Module) TokensInfoGETHandler(c *gin.Context) {
 	resp, errWithCode := m.processor.Account().TokensGet(
 		c.Request.Context(),
 		authed.User.ID,
+		authed.Token.GetAccess(),
 		page,
 	)
 	if errWithCode != nil {
@@ -46,6 +46,7 @@ func (suite *TokensGetTestSuite) TestTokensGet() {
   {
     "id": "01JN0X2D9GJTZQ5KYPYFWN16QW",
     "created_at": "2025-02-26T10:33:04.560Z",
+    "current": false,
     "scope": "push",
     "application": {
       "name": "really cool gts application",
@@ -55,6 +56,7 @@ func (suite *TokensGetTestSuite) TestTokensGet() {
   {
     "id": "01F8MGTQW4DKTDF8SW5CT9HYGA",
     "created_at": "2021-06-20T10:53:00.164Z",
+    "current": true,
     "scope": "read write push",
     "application": {
       "name": "really cool gts application",
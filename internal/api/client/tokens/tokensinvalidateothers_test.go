@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tokens_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/api/client/tokens"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"github.com/stretchr/testify/suite"
+)
+
+type TokensInvalidateOthersTestSuite struct {
+	TokensStandardTestSuite
+}
+
+func (suite *TokensInvalidateOthersTestSuite) TestTokensInvalidateOthers() {
+	var (
+		currentToken = suite.testTokens["local_account_1"]
+		otherToken   = suite.testTokens["local_account_1_push_only"]
+		testPath     = "/api" + tokens.InvalidateOthersPath
+	)
+
+	out, code := suite.req(
+		http.MethodPost,
+		testPath,
+		suite.tokens.TokensInvalidateOthersPOSTHandler,
+		nil,
+	)
+
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`[
+  {
+    "id": "01JN0X2D9GJTZQ5KYPYFWN16QW",
+    "created_at": "2025-02-26T10:33:04.560Z",
+    "current": false,
+    "scope": "push",
+    "application": {
+      "name": "really cool gts application",
+      "website": "https://reallycool.app"
+    }
+  }
+]`, out)
+
+	// Other token should be gone.
+	_, err := suite.testStructs.State.DB.GetTokenByID(
+		suite.T().Context(), otherToken.ID,
+	)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Current token should remain.
+	_, err = suite.testStructs.State.DB.GetTokenByID(
+		suite.T().Context(), currentToken.ID,
+	)
+	suite.NoError(err)
+}
+
+func TestTokensInvalidateOthersTestSuite(t *testing.T) {
+	suite.Run(t, new(TokensInvalidateOthersTestSuite))
+}
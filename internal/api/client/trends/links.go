@@ -28,7 +28,10 @@
 //
 // Links that have been shared more than others.
 //
-// THIS ENDPOINT IS CURRENTLY NOT FULLY IMPLEMENTED: it will always return an empty array.
+// Links are ranked by the number of accounts that shared them
+// in public statuses over the past week, using the link preview
+// card of each link. Each link includes its daily usage history
+// for the past week, newest day first.
 //
 //	---
 //	tags:
@@ -37,22 +40,52 @@
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: limit
+//		type: integer
+//		description: Maximum number of links to return.
+//		default: 10
+//		maximum: 20
+//		minimum: 1
+//		in: query
+//
 //	responses:
 //		'200':
+//			description: Trending links.
 //			schema:
 //				type: array
 //				items:
-//					type: object
-//				maxItems: 0
+//					"$ref": "#/definitions/trendsLink"
+//		'400':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: bad request
 //		'406':
 //			schema:
 //				"$ref": "#/definitions/error"
 //			description: not acceptable
+//		'500':
+//			schema:
+//				"$ref": "#/definitions/error"
+//			description: internal server error
 func (m *Module) LinksGETHandler(c *gin.Context) {
 	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
-	apiutil.JSON(c, http.StatusOK, apiutil.EmptyJSONArray)
+	limit, errWithCode := apiutil.ParseLimit(c.Query(apiutil.LimitKey), 10, 20, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	links, errWithCode := m.processor.Trends().LinksGet(c.Request.Context(), limit)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, links)
 }
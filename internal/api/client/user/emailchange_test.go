@@ -101,7 +101,7 @@ func (suite *EmailChangeTestSuite) TestEmailChangePOSTAddressInUse() {
 		suite.FailNow(err.Error())
 	}
 
-	suite.Equal(`{"error":"Conflict: new email address is already in use on this instance"}`, string(b))
+	suite.Equal(`{"error":"Conflict: new email address is already in use on this instance","error_code":"conflict"}`, string(b))
 }
 
 func (suite *EmailChangeTestSuite) TestEmailChangePOSTSameEmail() {
@@ -118,7 +118,7 @@ func (suite *EmailChangeTestSuite) TestEmailChangePOSTSameEmail() {
 		suite.FailNow(err.Error())
 	}
 
-	suite.Equal(`{"error":"Bad Request: new email address cannot be the same as current email address"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: new email address cannot be the same as current email address","error_code":"bad_request"}`, string(b))
 }
 
 func (suite *EmailChangeTestSuite) TestEmailChangePOSTBadPassword() {
@@ -135,7 +135,7 @@ func (suite *EmailChangeTestSuite) TestEmailChangePOSTBadPassword() {
 		suite.FailNow(err.Error())
 	}
 
-	suite.Equal(`{"error":"Unauthorized: password was incorrect"}`, string(b))
+	suite.Equal(`{"error":"Unauthorized: password was incorrect","error_code":"unauthorized"}`, string(b))
 }
 
 func TestEmailChangeTestSuite(t *testing.T) {
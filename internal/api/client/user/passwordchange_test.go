@@ -76,7 +76,7 @@ func (suite *PasswordChangeTestSuite) TestPasswordMissingOldPassword() {
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
-	suite.Equal(`{"error":"Bad Request: password change request missing field old_password"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: password change request missing field old_password","error_code":"bad_request"}`, string(b))
 }
 
 func (suite *PasswordChangeTestSuite) TestPasswordIncorrectOldPassword() {
@@ -92,7 +92,7 @@ func (suite *PasswordChangeTestSuite) TestPasswordIncorrectOldPassword() {
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
-	suite.Equal(`{"error":"Unauthorized: old password was incorrect"}`, string(b))
+	suite.Equal(`{"error":"Unauthorized: old password was incorrect","error_code":"unauthorized"}`, string(b))
 }
 
 func (suite *PasswordChangeTestSuite) TestPasswordWeakNewPassword() {
@@ -108,7 +108,7 @@ func (suite *PasswordChangeTestSuite) TestPasswordWeakNewPassword() {
 	if err != nil {
 		suite.FailNow(err.Error())
 	}
-	suite.Equal(`{"error":"Bad Request: password is only 94% strength, try including more special characters, using uppercase letters, using numbers or using a longer password"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: password is only 94% strength, try including more special characters, using uppercase letters, using numbers or using a longer password","error_code":"validation_failed"}`, string(b))
 }
 
 func TestPasswordChangeTestSuite(t *testing.T) {
@@ -36,8 +36,10 @@ func useFSCacheMiddleware(grp *router.RouterGroup) {
 	// If we're using local storage or proxying s3 (ie., serving
 	// from here) we can set a long max-age + immutable on file
 	// requests to reflect that we never host different files at
-	// the same URL (since ULIDs are generated per piece of media),
-	// so we can prevent clients having to fetch files repeatedly.
+	// the same URL (since ULIDs are generated per piece of media,
+	// and attachment URLs are versioned by file contents in case
+	// media is re-processed), so we can prevent clients having
+	// to fetch files repeatedly.
 	//
 	// If we *are* using non-proxying s3, however (ie., not serving
 	// from here) the max age must be set dynamically within the
@@ -55,9 +57,18 @@ func useFSCacheMiddleware(grp *router.RouterGroup) {
 		return
 	}
 
+	// Cache partial ranges separately.
+	vary := []string{"Range"}
+
+	// If image variants are enabled, the file served
+	// depends on which image types the client accepts.
+	if len(config.GetMediaVariantFormats()) > 0 {
+		vary = append(vary, "Accept")
+	}
+
 	grp.Use(middleware.CacheControl(middleware.CacheControlConfig{
 		Directives: []string{"private", "max-age=604800", "immutable"},
-		Vary:       []string{"Range"}, // Cache partial ranges separately.
+		Vary:       vary,
 	}))
 }
 
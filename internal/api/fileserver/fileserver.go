@@ -31,10 +31,14 @@
 	MediaTypeKey = "media_type"
 	// MediaSizeKey is the url key for the desired media size--original/small/static
 	MediaSizeKey = "media_size"
+	// MediaVersionKey is the url key for the optional media version, a hash of the file contents
+	MediaVersionKey = "media_version"
 	// FileNameKey is the actual filename being sought. Will usually be a UUID then something like .jpeg
 	FileNameKey = "file_name"
 	// FileServePath is the fileserve path minus the 'fileserver/:account_id/:media_type' prefix.
 	FileServePath = "/:" + MediaSizeKey + "/:" + FileNameKey
+	// VersionedFileServePath is the FileServePath with a media version before the file name.
+	VersionedFileServePath = "/:" + MediaSizeKey + "/:" + MediaVersionKey + "/:" + FileNameKey
 )
 
 type Module struct {
@@ -50,4 +54,6 @@ func New(processor *processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, FileServePath, m.ServeFile)
 	attachHandler(http.MethodHead, FileServePath, m.ServeFile)
+	attachHandler(http.MethodGet, VersionedFileServePath, m.ServeFile)
+	attachHandler(http.MethodHead, VersionedFileServePath, m.ServeFile)
 }
@@ -28,7 +28,9 @@
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/regexes"
 	"codeberg.org/gruf/go-fastcopy"
 	"github.com/gin-gonic/gin"
 )
@@ -46,7 +48,9 @@ func (m *Module) ServeFile(c *gin.Context) {
 
 	// We use request params to check what to pull out of the database/storage so check everything. A request URL should be formatted as follows:
 	// "https://example.org/fileserver/[ACCOUNT_ID]/[MEDIA_TYPE]/[MEDIA_SIZE]/[FILE_NAME]"
+	// "https://example.org/fileserver/[ACCOUNT_ID]/[MEDIA_TYPE]/[MEDIA_SIZE]/[MEDIA_VERSION]/[FILE_NAME]"
 	// "FILE_NAME" consists of two parts, the attachment's database id, a period, and the file extension.
+	// "MEDIA_VERSION" is only there to bust caches when media is re-processed, it doesn't affect what's served.
 	accountID := c.Param(AccountIDKey)
 	if accountID == "" {
 		err := fmt.Errorf("missing %s from request", AccountIDKey)
@@ -68,6 +72,13 @@ func (m *Module) ServeFile(c *gin.Context) {
 		return
 	}
 
+	if mediaVersion := c.Param(MediaVersionKey); mediaVersion != "" &&
+		!regexes.MediaVersion.MatchString(mediaVersion) {
+		err := fmt.Errorf("invalid %s in request", MediaVersionKey)
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotFound(err), m.processor.InstanceGetV1)
+		return
+	}
+
 	fileName := c.Param(FileNameKey)
 	if fileName == "" {
 		err := fmt.Errorf("missing %s from request", FileNameKey)
@@ -78,11 +89,20 @@ func (m *Module) ServeFile(c *gin.Context) {
 	// Acquire context from gin request.
 	ctx := c.Request.Context()
 
+	// If image variants are enabled, pass on which
+	// image types the client accepts, so we can serve
+	// a converted variant (see useFSCacheMiddleware()).
+	var accept []string
+	if len(config.GetMediaVariantFormats()) > 0 {
+		accept = acceptedImageTypes(c.GetHeader("Accept"))
+	}
+
 	content, errWithCode := m.processor.Media().GetFile(ctx, authed.Account, &apimodel.GetContentRequestForm{
 		AccountID: accountID,
 		MediaType: mediaType,
 		MediaSize: mediaSize,
 		FileName:  fileName,
+		Accept:    accept,
 	})
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -141,6 +161,35 @@ func (m *Module) ServeFile(c *gin.Context) {
 	)
 }
 
+// acceptedImageTypes returns the image content types
+// explicitly listed in given Accept header value, i.e.
+// not matched only by a wildcard, and not with q=0.
+func acceptedImageTypes(header string) []string {
+	var types []string
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, _ := strings.Cut(part, ";")
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+		if !strings.HasPrefix(mediaType, "image/") || mediaType == "image/*" {
+			continue
+		}
+
+		// Check for q=0, meaning "not acceptable".
+		for _, param := range strings.Split(params, ";") {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if key == "q" {
+				if q, err := strconv.ParseFloat(value, 64); err == nil && q == 0 {
+					mediaType = ""
+				}
+			}
+		}
+
+		if mediaType != "" {
+			types = append(types, mediaType)
+		}
+	}
+	return types
+}
+
 // serveFileRange serves the range of a file from a given source reader, without the
 // need for implementation of io.Seeker. Instead we read the first 'start' many bytes
 // into a discard reader. Code is adapted from https://codeberg.org/gruf/simplehttp.
@@ -24,6 +24,7 @@
 	"testing"
 
 	"code.superseriousbusiness.org/gotosocial/internal/api/fileserver"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/middleware"
@@ -43,15 +44,43 @@ func (suite *ServeFileTestSuite) GetFile(
 	mediaType media.Type,
 	mediaSize media.Size,
 	filename string,
+) (code int, headers http.Header, body []byte) {
+	return suite.GetVersionedFile(accountID, mediaType, mediaSize, "", filename)
+}
+
+// GetVersionedFile is like GetFile, but for
+// versioned URLs, with given media version.
+func (suite *ServeFileTestSuite) GetVersionedFile(
+	accountID string,
+	mediaType media.Type,
+	mediaSize media.Size,
+	version string,
+	filename string,
+) (code int, headers http.Header, body []byte) {
+	return suite.GetFileAccepting("*/*", accountID, mediaType, mediaSize, version, filename)
+}
+
+// GetFileAccepting is like GetVersionedFile,
+// but with given Accept header value.
+func (suite *ServeFileTestSuite) GetFileAccepting(
+	accept string,
+	accountID string,
+	mediaType media.Type,
+	mediaSize media.Size,
+	version string,
+	filename string,
 ) (code int, headers http.Header, body []byte) {
 	recorder := httptest.NewRecorder()
 
 	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
 	ctx.Request = httptest.NewRequest(http.MethodGet, "http://localhost:8080/whatever", nil)
-	ctx.Request.Header.Set("accept", "*/*")
+	ctx.Request.Header.Set("accept", accept)
 	ctx.AddParam(fileserver.AccountIDKey, accountID)
 	ctx.AddParam(fileserver.MediaTypeKey, string(mediaType))
 	ctx.AddParam(fileserver.MediaSizeKey, string(mediaSize))
+	if version != "" {
+		ctx.AddParam(fileserver.MediaVersionKey, version)
+	}
 	ctx.AddParam(fileserver.FileNameKey, filename)
 
 	logger := middleware.Logger(false)
@@ -133,6 +162,86 @@ func (suite *ServeFileTestSuite) TestServeSmallLocalFileOK() {
 	suite.Equal(fileInStorage, body)
 }
 
+func (suite *ServeFileTestSuite) TestServeVersionedLocalFileOK() {
+	targetAttachment := &gtsmodel.MediaAttachment{}
+	*targetAttachment = *suite.testAttachments["admin_account_status_1_attachment_1"]
+	fileInStorage, err := suite.storage.Get(suite.T().Context(), targetAttachment.File.Path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Any well-formed version is served
+	// the same, current, file contents.
+	code, headers, body := suite.GetVersionedFile(
+		targetAttachment.AccountID,
+		media.TypeAttachment,
+		media.SizeOriginal,
+		"56fa04afda513ea1",
+		targetAttachment.ID+".jpg",
+	)
+
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("image/jpeg", headers.Get("content-type"))
+	suite.Equal(fileInStorage, body)
+}
+
+func (suite *ServeFileTestSuite) TestServeVersionedFileBadVersion() {
+	targetAttachment := suite.testAttachments["admin_account_status_1_attachment_1"]
+
+	code, _, _ := suite.GetVersionedFile(
+		targetAttachment.AccountID,
+		media.TypeAttachment,
+		media.SizeOriginal,
+		"../../something",
+		targetAttachment.ID+".jpg",
+	)
+
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *ServeFileTestSuite) TestServeWebpVariant() {
+	config.SetMediaVariantFormats([]string{"webp"})
+	defer config.SetMediaVariantFormats(nil)
+
+	targetAttachment := &gtsmodel.MediaAttachment{}
+	*targetAttachment = *suite.testAttachments["admin_account_status_1_attachment_1"]
+	fileInStorage, err := suite.storage.Get(suite.T().Context(), targetAttachment.File.Path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Browser-like Accept header gets
+	// a smaller webp of the original.
+	code, headers, body := suite.GetFileAccepting(
+		"image/avif,image/webp,image/apng,image/svg+xml,image/*,*/*;q=0.8",
+		targetAttachment.AccountID,
+		media.TypeAttachment,
+		media.SizeOriginal,
+		"",
+		targetAttachment.ID+".jpg",
+	)
+
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("image/webp", headers.Get("content-type"))
+	suite.Less(len(body), len(fileInStorage))
+	suite.Equal("RIFF", string(body[:4]))
+
+	// Webp explicitly not accepted
+	// gets the original jpeg.
+	code, headers, body = suite.GetFileAccepting(
+		"image/webp;q=0,image/*",
+		targetAttachment.AccountID,
+		media.TypeAttachment,
+		media.SizeOriginal,
+		"",
+		targetAttachment.ID+".jpg",
+	)
+
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("image/jpeg", headers.Get("content-type"))
+	suite.Equal(fileInStorage, body)
+}
+
 func (suite *ServeFileTestSuite) TestServeOriginalRemoteFileOK() {
 	targetAttachment := &gtsmodel.MediaAttachment{}
 	*targetAttachment = *suite.testAttachments["remote_account_1_status_1_attachment_1"]
@@ -106,6 +106,14 @@ type Account struct {
 	// Additional metadata attached to this account's profile.
 	// Empty for blocked accounts.
 	Fields []Field `json:"fields"`
+	// Pronouns set by this account on their profile.
+	// Key/value omitted if not set, and for blocked accounts.
+	// example: they/them
+	Pronouns string `json:"pronouns,omitempty"`
+	// Location set by this account on their profile.
+	// Key/value omitted if not set, and for blocked accounts.
+	// example: Berlin
+	Location string `json:"location,omitempty"`
 	// Account has been suspended by our instance.
 	Suspended bool `json:"suspended,omitempty"`
 	// Extra profile information. Shown only if the requester owns the account being requested.
@@ -120,6 +128,10 @@ type Account struct {
 	// Account has opted to hide their followers/following collections.
 	// Key/value omitted if false.
 	HideCollections bool `json:"hide_collections,omitempty"`
+	// Account has opted to publish the accounts they block,
+	// as an ActivityPub collection that others can import.
+	// Key/value omitted if false.
+	PublishBlocks bool `json:"publish_blocks,omitempty"`
 	// Role of the account on this instance.
 	// Only available through the `verify_credentials` API method.
 	// Key/value omitted for remote accounts.
@@ -131,8 +143,14 @@ type Account struct {
 	// If set, indicates that this account is currently inactive, and has migrated to the given account.
 	// Key/value omitted for accounts that haven't moved, and for suspended accounts.
 	Moved *Account `json:"moved,omitempty"`
+	// If set, indicates that this bot account is operated by the given account.
+	// Key/value omitted for accounts that aren't bots, or have no operator set.
+	Operator *Account `json:"operator,omitempty"`
 	// Account identifies as a Group actor.
 	Group bool `json:"group"`
+	// Message sent by this account along with its pending follow request.
+	// Only set when listing follow requests, key/value omitted if no message was sent.
+	FollowRequestMessage string `json:"follow_request_message,omitempty"`
 }
 
 // WebAccount is like Account, but with
@@ -157,6 +175,9 @@ type WebAccount struct {
 
 	// Layout for this account (microblog, gallery).
 	WebLayout string `json:"-"`
+
+	// Show activity heatmap for this account.
+	WebActivityHeatmap bool `json:"-"`
 }
 
 // MutedAccount extends Account with a field used only by the muted user list.
@@ -196,10 +217,20 @@ type AccountCreateRequest struct {
 	// swagger:parameters
 	// required: true
 	Agreement bool `form:"agreement"  json:"agreement" xml:"agreement" binding:"required"`
+	// IDs of the instance rules that the user acknowledges (optional).
+	// If provided, this must include the IDs of all current instance rules.
+	// If not provided, agreement is taken to acknowledge all current instance rules.
+	// swagger:parameters
+	RuleIDs []string `form:"rule_ids[]" json:"rule_ids" xml:"rule_ids"`
 	// The preferred language of the account user (optional).
 	// swagger:parameters
 	// example: en
 	Locale string `form:"locale" json:"locale" xml:"locale"`
+	// Code of an invite created by a local account (optional).
+	// If provided, the account can be created even when
+	// registration is not open on this instance.
+	// swagger:parameters
+	InviteCode string `form:"invite_code" json:"invite_code" xml:"invite_code"`
 	// The IP of the sign up request, will not be parsed from the form.
 	// swagger:parameters
 	// swagger:ignore
@@ -216,10 +247,16 @@ type UpdateCredentialsRequest struct {
 	Indexable *bool `form:"indexable" json:"indexable"`
 	// Account is flagged as a bot.
 	Bot *bool `form:"bot" json:"bot"`
+	// URI or profile URL of the account that operates this bot account. Empty string clears.
+	OperatorURI *string `form:"operator_uri" json:"operator_uri"`
 	// The display name to use for the account.
 	DisplayName *string `form:"display_name" json:"display_name"`
 	// Bio/description of this account.
 	Note *string `form:"note" json:"note"`
+	// Pronouns to show on this account's profile. Empty string clears.
+	Pronouns *string `form:"pronouns" json:"pronouns"`
+	// Location to show on this account's profile. Empty string clears.
+	Location *string `form:"location" json:"location"`
 	// Avatar image encoded using multipart/form-data.
 	Avatar *multipart.FileHeader `form:"avatar" json:"-"`
 	// Description of the avatar image, for alt-text.
@@ -244,8 +281,14 @@ type UpdateCredentialsRequest struct {
 	CustomCSS *string `form:"custom_css" json:"custom_css"`
 	// Enable RSS feed of public toots for this account at /@[username]/feed.rss
 	EnableRSS *bool `form:"enable_rss" json:"enable_rss"`
+	// Embed all media attachments in the content
+	// of the items in this account's RSS feed.
+	RSSFullContent *bool `form:"rss_full_content" json:"rss_full_content"`
 	// Hide this account's following/followers collections.
 	HideCollections *bool `form:"hide_collections" json:"hide_collections"`
+	// Publish accounts blocked by this account as an
+	// ActivityPub collection, for others to import.
+	PublishBlocks *bool `form:"publish_blocks" json:"publish_blocks"`
 	// Visibility of statuses to show via the web view.
 	// "none", "public" (default), or "unlisted" (which includes public as well).
 	WebVisibility *string `form:"web_visibility" json:"web_visibility"`
@@ -255,6 +298,8 @@ type UpdateCredentialsRequest struct {
 	WebLayout *string `form:"web_layout" json:"web_layout"`
 	// Include boosts created by the account on the web view of the account.
 	WebIncludeBoosts *bool `form:"web_include_boosts" json:"web_include_boosts"`
+	// Show a heatmap of public posting activity on the web view of the account.
+	WebActivityHeatmap *bool `form:"web_activity_heatmap" json:"web_activity_heatmap"`
 }
 
 // UpdateSource is to be used specifically in an UpdateCredentialsRequest.
@@ -265,10 +310,22 @@ type UpdateSource struct {
 	Privacy *string `form:"privacy" json:"privacy"`
 	// Mark authored statuses as sensitive by default.
 	Sensitive *bool `form:"sensitive" json:"sensitive"`
+	// Generate link preview cards for authored statuses by default.
+	PreviewLinks *bool `form:"preview_links" json:"preview_links"`
+	// Policy for descriptions of media attached to authored
+	// statuses: "off", "remind", or "require". Use an empty
+	// value to just follow the instance policy.
+	MediaDescriptionPolicy *string `form:"media_description_policy" json:"media_description_policy"`
 	// Default language to use for authored statuses. (ISO 6391)
 	Language *string `form:"language" json:"language"`
+	// Languages of statuses to show on timelines. (ISO 6391)
+	// Use a single empty value to show all languages.
+	ChosenLanguages *[]string `form:"chosen_languages" json:"chosen_languages"`
 	// Default format for authored statuses (text/plain or text/markdown).
 	StatusContentType *string `form:"status_content_type" json:"status_content_type"`
+	// Default number of seconds after which authored statuses
+	// are automatically deleted. Use 0 to not expire statuses.
+	StatusExpiresIn *int `form:"status_expires_in" json:"status_expires_in"`
 }
 
 // UpdateField is to be used specifically in an UpdateCredentialsRequest.
@@ -322,6 +379,15 @@ type AccountAliasRequest struct {
 	AlsoKnownAsURIs []string `form:"also_known_as_uris" json:"also_known_as_uris" xml:"also_known_as_uris"`
 }
 
+// ProfileMediaRollbackRequest models a request to restore
+// one of an account's previous avatars or headers.
+//
+// swagger:ignore
+type ProfileMediaRollbackRequest struct {
+	// ID of the previous avatar or header media attachment to restore.
+	ID string `form:"id" json:"id" xml:"id"`
+}
+
 // AccountDisplayRole models a public, displayable role of an account.
 // This is a subset of AccountRole.
 //
@@ -366,7 +432,7 @@ type AccountRole struct {
 )
 
 // AccountRolePermissions is a bitmap representing a set of user permissions.
-// It's used for Mastodon API compatibility: internally, GotoSocial only tracks admins and moderators.
+// Internally, GotoSocial only tracks admins and moderators, and assigns a fixed set of permissions to each.
 //
 // swagger:type string
 type AccountRolePermissions int
@@ -418,17 +484,17 @@ func (a *AccountRolePermissions) UnmarshalJSON(b []byte) error {
 	AccountRolePermissionsManageSettings
 	// AccountRolePermissionsManageBlocks indicates that the user can manage non-federation blocks, currently including HTTP header blocks.
 	AccountRolePermissionsManageBlocks
-	// AccountRolePermissionsManageTaxonomies is not used by GotoSocial.
+	// AccountRolePermissionsManageTaxonomies indicates that the user can manage hashtag aliases.
 	AccountRolePermissionsManageTaxonomies
-	// AccountRolePermissionsManageAppeals is not used by GotoSocial.
+	// AccountRolePermissionsManageAppeals indicates that the user can view and resolve appeals.
 	AccountRolePermissionsManageAppeals
 	// AccountRolePermissionsManageUsers indicates that the user can view user details and perform user moderation actions.
 	AccountRolePermissionsManageUsers
-	// AccountRolePermissionsManageInvites is not used by GotoSocial.
+	// AccountRolePermissionsManageInvites indicates that the user can view and revoke invites created by any user.
 	AccountRolePermissionsManageInvites
 	// AccountRolePermissionsManageRules indicates that the user can edit instance rules.
 	AccountRolePermissionsManageRules
-	// AccountRolePermissionsManageAnnouncements is not used by GotoSocial.
+	// AccountRolePermissionsManageAnnouncements indicates that the user can edit instance announcements.
 	AccountRolePermissionsManageAnnouncements
 	// AccountRolePermissionsManageCustomEmojis indicates that the user can edit custom emoji.
 	AccountRolePermissionsManageCustomEmojis
@@ -452,14 +518,21 @@ func (a *AccountRolePermissions) UnmarshalJSON(b []byte) error {
 		AccountRolePermissionsManageFederation |
 		AccountRolePermissionsManageSettings |
 		AccountRolePermissionsManageBlocks |
+		AccountRolePermissionsManageTaxonomies |
+		AccountRolePermissionsManageAppeals |
 		AccountRolePermissionsManageUsers |
+		AccountRolePermissionsManageInvites |
 		AccountRolePermissionsManageRules |
+		AccountRolePermissionsManageAnnouncements |
 		AccountRolePermissionsManageCustomEmojis |
 		AccountRolePermissionsDeleteUserData
 
 	// AccountRolePermissionsForModeratorRole includes all of the permissions assigned to GotoSocial's built-in moderator role.
-	// (Currently, there aren't any.)
-	AccountRolePermissionsForModeratorRole = AccountRolePermissionsNone
+	// Moderators can handle reports, appeals, account actions, and invites, but not instance settings or federation.
+	AccountRolePermissionsForModeratorRole = AccountRolePermissionsManageReports |
+		AccountRolePermissionsManageAppeals |
+		AccountRolePermissionsManageUsers |
+		AccountRolePermissionsManageInvites
 )
 
 // AccountNoteRequest models a request to update the private note for an account.
@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AccountCustomDomain models a vanity domain requested by a local
+// account, which redirects to the account's profile once verified
+// and approved, and at which the account can be looked up.
+//
+// swagger:model accountCustomDomain
+type AccountCustomDomain struct {
+	// The ID of the custom domain.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// When the custom domain was requested (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The custom domain.
+	// example: example.com
+	Domain string `json:"domain"`
+	// Name of the DNS TXT record that can be
+	// used to verify control of the domain.
+	// example: _gotosocial.example.com
+	VerificationTXTName string `json:"verification_txt_name"`
+	// Value of the DNS TXT record that can be
+	// used to verify control of the domain.
+	// example: gotosocial-account=https://example.org/users/someone
+	VerificationTXTValue string `json:"verification_txt_value"`
+	// Link that can be added with rel="me" to the
+	// homepage of the domain (https://{domain}/),
+	// as an alternative to verifying via DNS.
+	// example: https://example.org/@someone
+	VerificationRelMe string `json:"verification_rel_me"`
+	// When control of the domain was verified (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// nullable: true
+	VerifiedAt *string `json:"verified_at"`
+	// When the domain was approved by an admin (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// nullable: true
+	ApprovedAt *string `json:"approved_at"`
+	// Whether the domain is both verified and
+	// approved, and so is currently in use.
+	// example: true
+	Active bool `json:"active"`
+	// The account that requested the domain.
+	// Only included in admin views of custom domains.
+	Account *Account `json:"account,omitempty"`
+}
+
+// AccountCustomDomainRequest models a request
+// to set the custom domain of an account.
+//
+// swagger:ignore
+type AccountCustomDomainRequest struct {
+	// The vanity domain to use, eg., "example.com".
+	Domain string `form:"domain" json:"domain"`
+}
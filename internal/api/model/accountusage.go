@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AccountUsage models the storage footprint of an account on this instance.
+//
+// swagger:model accountUsage
+type AccountUsage struct {
+	// The ID of the account.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	AccountID string `json:"account_id"`
+	// Locally stored media of the account, by category.
+	Media AccountMediaUsage `json:"media"`
+	// Max total bytes of media that may be stored for the account.
+	// Null if the account has no media quota, or is not local.
+	MediaQuota *int64 `json:"media_quota"`
+	// Number of statuses created by the account.
+	StatusesCount int `json:"statuses_count"`
+	// Number of accounts following the account.
+	FollowersCount int `json:"followers_count"`
+	// Number of accounts followed by the account.
+	FollowingCount int `json:"following_count"`
+	// When this usage report was calculated (ISO 8601 Datetime).
+	// Reports are cached for a short while, so may be slightly stale.
+	// example: 2021-07-30T09:20:25+00:00
+	CalculatedAt string `json:"calculated_at"`
+}
+
+// AdminMediaQuotaRequest models a request
+// to override an account's media quota.
+//
+// swagger:ignore
+type AdminMediaQuotaRequest struct {
+	// Max total bytes of media that may be stored
+	// for the account. 0 means no quota.
+	Quota *int64 `form:"quota" json:"quota"`
+}
+
+// AccountMediaUsage models the locally stored media of an account, by category.
+//
+// swagger:model accountMediaUsage
+type AccountMediaUsage struct {
+	// Media attached to statuses.
+	Attachments MediaUsage `json:"attachments"`
+	// Media not attached to any status, eg., pending or scheduled.
+	Unattached MediaUsage `json:"unattached"`
+	// Current and previous avatars.
+	Avatar MediaUsage `json:"avatar"`
+	// Current and previous headers.
+	Header MediaUsage `json:"header"`
+	// Sum of all the above categories.
+	Total MediaUsage `json:"total"`
+}
+
+// MediaUsage models the number and total stored size of a category of media.
+//
+// swagger:model mediaUsage
+type MediaUsage struct {
+	// Number of media attachments.
+	Count int `json:"count"`
+	// Total size in bytes of stored files, including thumbnails.
+	Bytes int64 `json:"bytes"`
+}
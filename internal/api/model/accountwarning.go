@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AccountWarning models a moderation warning (aka strike) issued to a local account.
+//
+// swagger:model accountWarning
+type AccountWarning struct {
+	// ID of the warning.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// The date when this warning was issued (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Additional action taken against the warned account or its statuses.
+	// One of none, delete_statuses, mark_statuses_as_sensitive, suspend, silence.
+	// example: delete_statuses
+	Action string `json:"action"`
+	// Text explaining why this warning was issued.
+	// example: Please don't post hurtful things.
+	Text string `json:"text"`
+	// Array of IDs of statuses that were cited by this warning.
+	// example: ["01GPBN5YDY6JKBWE44H7YQBDCQ","01GPBN65PDWSBPWVDD0SQCFFY3"]
+	StatusIDs []string `json:"status_ids"`
+	// Instance rules that were cited by this warning.
+	Rules []*InstanceRule `json:"rules"`
+	// Account that received the warning.
+	TargetAccount *Account `json:"target_account"`
+	// Appeal submitted against this warning, if any.
+	Appeal *Appeal `json:"appeal"`
+}
+
+// Appeal models an appeal submitted against a moderation decision.
+//
+// swagger:model appeal
+type Appeal struct {
+	// Text submitted with the appeal.
+	// example: I didn't mean it, honest!
+	Text string `json:"text"`
+	// State of the appeal.
+	// One of pending, approved, rejected.
+	// example: pending
+	State string `json:"state"`
+	// Response from the moderator who approved or
+	// rejected the appeal. Empty if no response given.
+	// example: Fair enough, we've removed the warning.
+	ResponseText string `json:"response_text,omitempty"`
+}
+
+// AppealRequest models a request to appeal a moderation decision.
+//
+// swagger:ignore
+type AppealRequest struct {
+	// Text explaining the reasons for the appeal.
+	Text string `form:"text" json:"text" xml:"text"`
+}
@@ -72,6 +72,9 @@ type AdminAccountInfo struct {
 	CreatedByApplicationID string `json:"created_by_application_id,omitempty"`
 	// The ID of the account that invited this user
 	InvitedByAccountID string `json:"invited_by_account_id,omitempty"`
+	// Moderation warnings (strikes) issued to this account, newest first.
+	// Only set for local accounts when viewing a single account.
+	Warnings []*AccountWarning `json:"warnings,omitempty"`
 }
 
 // AdminReport models the admin view of a report.
@@ -134,6 +137,255 @@ type AdminReportResolveRequest struct {
 	ActionTakenComment *string `form:"action_taken_comment" json:"action_taken_comment" xml:"action_taken_comment"`
 }
 
+// AdminAppeal models the admin view of an appeal.
+//
+// swagger:model adminAppeal
+type AdminAppeal struct {
+	// ID of the appeal.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// The date when this appeal was submitted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time of last action on this appeal (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// State of the appeal.
+	// One of pending, approved, rejected.
+	// example: pending
+	State string `json:"state"`
+	// Text submitted with the appeal.
+	// example: I didn't mean it, honest!
+	Text string `json:"text"`
+	// The account that submitted the appeal.
+	Account *AdminAccountInfo `json:"account"`
+	// The moderation warning being appealed.
+	Warning *AccountWarning `json:"warning"`
+	// Whether approving this appeal undoes the action taken by the warning.
+	// False for warnings that deleted statuses, as deleted statuses cannot
+	// be restored; approving such an appeal only removes the warning.
+	Reversible bool `json:"reversible"`
+	// If the appeal was approved or rejected, at what time was this done? (ISO 8601 Datetime)
+	// Will be null if appeal is still pending.
+	// example: 2021-07-30T09:20:25+00:00
+	ActionTakenAt *string `json:"action_taken_at"`
+	// Account that approved or rejected the appeal.
+	// Null if appeal is still pending.
+	ActionTakenByAccount *AdminAccountInfo `json:"action_taken_by_account"`
+	// Response to the appeal shown to the appealing account.
+	// Will be null if not set / appeal still pending.
+	// example: Fair enough, we've removed the warning.
+	ResponseText *string `json:"response_text"`
+}
+
+// AdminAppealResolveRequest can be submitted along with a POST to
+// /api/v1/admin/appeals/{id}/approve or /api/v1/admin/appeals/{id}/reject
+//
+// swagger:ignore
+type AdminAppealResolveRequest struct {
+	// Response to show to the appealing account.
+	ResponseText *string `form:"response_text" json:"response_text" xml:"response_text"`
+}
+
+// AdminInstance models the admin view of a known peer instance,
+// including metadata crawled from the instance's nodeinfo.
+//
+// swagger:model adminInstance
+type AdminInstance struct {
+	// ID of the instance.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Domain of the instance.
+	// example: example.org
+	Domain string `json:"domain"`
+	// The date when this instance was first seen (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which this instance was suspended, if at all (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	SuspendedAt *string `json:"suspended_at"`
+	// Name of the software running on the instance, as reported by nodeinfo.
+	// Null if not (yet) known.
+	// example: gotosocial
+	SoftwareName *string `json:"software_name"`
+	// Version of the software running on the instance, as reported by nodeinfo.
+	// Null if not (yet) known.
+	// example: 0.20.0
+	SoftwareVersion *string `json:"software_version"`
+	// Total number of users on the instance, as reported by nodeinfo.
+	// example: 42
+	UsersTotal int `json:"users_total"`
+	// Number of users active on the instance in the past month, as reported by nodeinfo.
+	// example: 12
+	UsersActiveMonth int `json:"users_active_month"`
+	// Whether the instance has open registrations, as reported by nodeinfo.
+	// Null if not (yet) known.
+	OpenRegistrations *bool `json:"open_registrations"`
+	// Time at which nodeinfo was last fetched for this instance (ISO 8601 Datetime).
+	// Null if never fetched.
+	// example: 2021-07-30T09:20:25+00:00
+	InfoFetchedAt *string `json:"info_fetched_at"`
+}
+
+// AdminRelay models the admin view of a
+// subscription to a remote ActivityPub relay.
+//
+// swagger:model adminRelay
+type AdminRelay struct {
+	// ID of the relay subscription.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// The date when this relay was added (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// ActivityPub URI of the relay actor.
+	// example: https://relay.example.org/actor
+	ActorURI string `json:"actor_uri"`
+	// ActivityPub inbox URI of the relay actor.
+	// example: https://relay.example.org/inbox
+	InboxURI string `json:"inbox_uri"`
+	// State of the subscription to the relay.
+	// One of pending, accepted, rejected.
+	// example: accepted
+	State string `json:"state"`
+	// The account that added this relay.
+	CreatedBy *AdminAccountInfo `json:"created_by,omitempty"`
+}
+
+// AdminRelayCreateRequest models a request to
+// subscribe to the relay with the given actor URI.
+//
+// swagger:ignore
+type AdminRelayCreateRequest struct {
+	// ActivityPub URI of the relay actor.
+	ActorURI string `form:"actor_uri" json:"actor_uri" xml:"actor_uri"`
+}
+
+// AdminFailedActivity models the admin view of an inbound
+// ActivityPub activity that failed asynchronous processing.
+//
+// swagger:model adminFailedActivity
+type AdminFailedActivity struct {
+	// ID of the failed activity.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// The date when processing of this activity failed (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// ActivityStreams type of the activity.
+	// example: Create
+	ActivityType string `json:"activity_type"`
+	// ActivityStreams type of the activity object.
+	// example: Note
+	ObjectType string `json:"object_type"`
+	// ActivityPub IRI of the activity or its object, if known.
+	// example: https://example.org/users/someone/statuses/123
+	IRI string `json:"iri,omitempty"`
+	// ActivityPub URI of the remote account that posted the activity.
+	// example: https://example.org/users/someone
+	RequestingAccountURI string `json:"requesting_account_uri,omitempty"`
+	// Username of the local account whose inbox received the activity.
+	// example: some_user
+	ReceivingAccountUsername string `json:"receiving_account_username,omitempty"`
+	// Classified reason for the failure. One of
+	// other, malformed, unretrievable, not_found,
+	// timeout, unhandled.
+	// example: unretrievable
+	Reason string `json:"reason"`
+	// Text of the error that occurred during processing.
+	// example: error dereferencing status: 410 Gone
+	Error string `json:"error,omitempty"`
+	// Raw JSON of the ActivityStreams object
+	// of the activity, if it was provided.
+	Object map[string]interface{} `json:"object,omitempty"`
+	// The raw JSON of the activity was too
+	// large to be stored, so object is not set,
+	// and the activity cannot be retried.
+	Truncated bool `json:"truncated"`
+}
+
+// AdminFailedActivityCount models the number of stored
+// failed activities with the given activity type,
+// object type, and failure reason.
+//
+// swagger:model adminFailedActivityCount
+type AdminFailedActivityCount struct {
+	// ActivityStreams type of the activities.
+	// example: Create
+	ActivityType string `json:"activity_type"`
+	// ActivityStreams type of the activity objects.
+	// example: Note
+	ObjectType string `json:"object_type"`
+	// Classified reason for the failures.
+	// example: unretrievable
+	Reason string `json:"reason"`
+	// Number of stored failed activities.
+	// example: 3
+	Count int `json:"count"`
+}
+
+// AdminFederationStat models running totals
+// of federation traffic with one remote domain.
+//
+// swagger:model adminFederationStat
+type AdminFederationStat struct {
+	// Remote domain these stats are for. This is the
+	// host the remote server is reached at, which may
+	// differ from its accounts' domain.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Number of activities received in
+	// this instance's inboxes from the domain.
+	// example: 1024
+	ActivitiesReceived int64 `json:"activities_received"`
+	// Number of activities this instance
+	// queued for delivery to the domain.
+	// example: 512
+	ActivitiesSent int64 `json:"activities_sent"`
+	// Number of bytes of remote media from
+	// the domain stored in this instance's cache.
+	// example: 1048576
+	MediaBytes int64 `json:"media_bytes"`
+	// Time an activity was last received from
+	// the domain (ISO 8601 Datetime), if ever.
+	// example: 2021-07-30T09:20:25+00:00
+	LastSeenAt string `json:"last_seen_at,omitempty"`
+	// Time these stats were last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// AdminTagAlias models an admin-managed alias of
+// one hashtag to another. Statuses using the aliased
+// hashtag are shown in the timeline of the hashtag
+// it's an alias of, and follows of the aliased
+// hashtag are treated as follows of that hashtag.
+//
+// swagger:model adminTagAlias
+type AdminTagAlias struct {
+	// Name of the aliased hashtag, without leading '#'.
+	// example: gts
+	Name string `json:"name"`
+	// Name of the hashtag that this hashtag
+	// is an alias of, without leading '#'.
+	// example: gotosocial
+	AliasOf string `json:"alias_of"`
+	// The date when this alias was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+}
+
+// AdminTagAliasCreateRequest models a request
+// to alias one hashtag to another hashtag.
+//
+// swagger:ignore
+type AdminTagAliasCreateRequest struct {
+	// Name of the hashtag to alias.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Name of the hashtag to alias it to.
+	AliasOf string `form:"alias_of" json:"alias_of" xml:"alias_of"`
+}
+
 // AdminEmoji models the admin view of a custom emoji.
 //
 // swagger:model adminEmoji
@@ -170,10 +422,20 @@ type AdminEmoji struct {
 type AdminActionRequest struct {
 	// Category of the target entity.
 	Category string `form:"-" json:"-" xml:"-"`
-	// Type of admin action to take. One of disable, silence, suspend.
+	// Type of admin action to take. One of disable, silence, suspend, warn.
 	Type string `form:"type" json:"type" xml:"type"`
 	// Text describing why an action was taken.
 	Text string `form:"text" json:"text" xml:"text"`
+	// IDs of statuses cited by a warning.
+	StatusIDs []string `form:"status_ids[]" json:"status_ids" xml:"status_ids"`
+	// Action to take against cited statuses when
+	// warning. One of none, delete_statuses,
+	// mark_statuses_as_sensitive.
+	StatusesAction string `form:"statuses_action" json:"statuses_action" xml:"statuses_action"`
+	// IDs of instance rules cited by a warning.
+	RuleIDs []string `form:"rule_ids[]" json:"rule_ids" xml:"rule_ids"`
+	// ID of a report that led to a warning.
+	ReportID string `form:"report_id" json:"report_id" xml:"report_id"`
 	// ID of the target entity.
 	TargetID string `form:"-" json:"-" xml:"-"`
 }
@@ -240,6 +502,58 @@ type DebugAPUrlResponse struct {
 	ResponseBody string `json:"response_body"`
 }
 
+// DebugSignatureResponse provides detailed information about
+// the steps involved in verifying HTTP signatures made by the
+// actor of a remote AP object, as performed by this instance.
+//
+// swagger:model debugSignatureResponse
+type DebugSignatureResponse struct {
+	// Remote AP URI that was requested.
+	RequestURI string `json:"request_uri"`
+	// HTTP response code returned from the remote
+	// instance for our signed request to the URI.
+	ResponseCode int `json:"response_code"`
+	// URI of the actor that signs requests on
+	// behalf of the requested object, if found.
+	ActorURI string `json:"actor_uri,omitempty"`
+	// ID of the public key of the actor, if found.
+	KeyID string `json:"key_id,omitempty"`
+	// URL the public key was fetched from, if fetched.
+	KeyFetchedFrom string `json:"key_fetched_from,omitempty"`
+	// URI of the owner of the public key, if fetched.
+	KeyOwner string `json:"key_owner,omitempty"`
+	// Algorithm of the public key, eg., "RSA-2048", if fetched.
+	KeyAlgorithm string `json:"key_algorithm,omitempty"`
+	// Signing algorithms this instance will try
+	// when verifying signatures with the public key.
+	SignatureAlgorithms []string `json:"signature_algorithms"`
+	// Result of checking the Digest header of the remote
+	// response against its body. One of "ok", "mismatch",
+	// "unsupported" or "absent".
+	DigestCheck string `json:"digest_check"`
+	// Difference between the Date header returned by the
+	// remote instance and our own clock, in seconds. Positive
+	// values mean the remote clock is ahead of ours. Omitted
+	// if the remote didn't return a (valid) Date header.
+	ClockSkew *float64 `json:"clock_skew,omitempty"`
+	// Detailed results of each verification step, in order.
+	Steps []DebugSignatureStep `json:"steps"`
+}
+
+// DebugSignatureStep is the result of
+// one step of signature verification.
+//
+// swagger:model debugSignatureStep
+type DebugSignatureStep struct {
+	// Short name of the step.
+	// example: fetch_key
+	Name string `json:"name"`
+	// Whether the step succeeded.
+	OK bool `json:"ok"`
+	// Verbose details of the step result.
+	Detail string `json:"detail"`
+}
+
 // AdminGetAccountsRequest models a request
 // to get an admin view of one or more
 // accounts using given parameters.
@@ -266,13 +580,63 @@ type AdminGetAccountsRequest struct {
 	ByDomain string
 	// Lookup a user with this email.
 	Email string
+	// Filter for users with an email
+	// address at the given domain.
+	EmailDomain string
 	// Lookup users with this IP address.
 	IP string
+	// Filter for accounts created after the given
+	// time (RFC3339 timestamp or YYYY-MM-DD date).
+	CreatedAfter string
+	// Filter for accounts created before the given
+	// time (RFC3339 timestamp or YYYY-MM-DD date).
+	CreatedBefore string
 	// API version to use for this request (1 or 2).
 	// Set internally, not by callers.
 	APIVersion int
 }
 
+// AdminAccountsBulkActionRequest models a request
+// to take one action against several accounts at once.
+//
+// swagger:ignore
+type AdminAccountsBulkActionRequest struct {
+	// IDs of the accounts to take action against.
+	AccountIDs []string `form:"account_ids[]" json:"account_ids"`
+	// Type of action to take. One of approve, reject, suspend.
+	Type string `form:"type" json:"type"`
+	// Text describing why the action was taken.
+	// For reject, this is the private comment.
+	Text string `form:"text" json:"text"`
+	// For reject only: message to include in email
+	// to applicants. Used only if send_email is true.
+	Message string `form:"message" json:"message"`
+	// For reject only: send an email to applicants
+	// informing them their sign-up has been rejected.
+	SendEmail bool `form:"send_email" json:"send_email"`
+}
+
+// AdminAccountsBulkActionResult models the
+// outcome of a bulk action for one account.
+//
+// swagger:model adminAccountsBulkActionResult
+type AdminAccountsBulkActionResult struct {
+	// ID of the targeted account.
+	//
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	AccountID string `json:"account_id"`
+	// Action was taken (or started) successfully.
+	Success bool `json:"success"`
+	// Internal ID of the admin action, if
+	// the action runs in the background.
+	//
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	ActionID string `json:"action_id,omitempty"`
+	// Error message if the action failed
+	// for this account, else omitted.
+	Error string `json:"error,omitempty"`
+}
+
 // AdminAccountRejectRequest models a
 // request to deny a new account sign-up.
 //
@@ -288,3 +652,61 @@ type AdminAccountRejectRequest struct {
 	// them that their sign-up has been rejected.
 	SendEmail bool `form:"send_email" json:"send_email"`
 }
+
+// AdminJob models the state of a
+// recurring background job.
+//
+// swagger:model adminJob
+type AdminJob struct {
+	// Unique ID of the job.
+	// example: @mediacleanup
+	ID string `json:"id"`
+	// Number of seconds between scheduled runs of the job.
+	// example: 86400
+	Every int64 `json:"every"`
+	// Time of the next scheduled run (ISO 8601 Datetime).
+	// The job will skip this run if it's paused.
+	// example: 2021-07-30T09:20:25+00:00
+	NextRunAt string `json:"next_run_at"`
+	// Time the last finished run of the job started (ISO 8601 Datetime).
+	// Omitted if the job hasn't finished a run since the server started.
+	// example: 2021-07-29T09:20:25+00:00
+	LastRunAt string `json:"last_run_at,omitempty"`
+	// Number of milliseconds the last finished run took.
+	// example: 1500
+	LastRunDuration int64 `json:"last_run_duration"`
+	// Number of runs finished since the server started.
+	// example: 3
+	Runs int `json:"runs"`
+	// Whether the job is running right now.
+	// example: false
+	Running bool `json:"running"`
+	// Whether scheduled runs of the job are paused.
+	// example: false
+	Paused bool `json:"paused"`
+	// Error returned by the last failed run of the job.
+	// Omitted if no run has failed since the server started.
+	// example: error pruning seen activities: context deadline exceeded
+	LastError string `json:"last_error,omitempty"`
+	// Time the last failed run of the job started (ISO 8601 Datetime).
+	// Omitted if no run has failed since the server started.
+	// example: 2021-07-28T09:20:25+00:00
+	LastErrorAt string `json:"last_error_at,omitempty"`
+}
+
+// AdminWorkerPool models the state of
+// a pool of background workers.
+//
+// swagger:model adminWorkerPool
+type AdminWorkerPool struct {
+	// Name of the worker pool.
+	// example: delivery
+	Name string `json:"name"`
+	// Number of workers in the pool.
+	// example: 8
+	Workers int `json:"workers"`
+	// Number of tasks queued for
+	// the pool, waiting for a worker.
+	// example: 12
+	Queued int `json:"queued"`
+}
@@ -19,7 +19,7 @@
 
 // Announcement models an admin announcement for the instance.
 //
-// TODO: swagger:model announcement once announcement API is supported
+// swagger:model announcement
 type Announcement struct {
 	// The ID of the announcement.
 	// example: 01FC30T7X4TNCZK0TH90QYF3M4
@@ -29,13 +29,13 @@ type Announcement struct {
 	// example: <p>This is an announcement. No malarky.</p>
 	Content string `json:"content"`
 	// When the announcement should begin to be displayed (ISO 8601 Datetime).
-	// If the announcement has no start time, this will be omitted or empty.
+	// If the announcement has no start time, this will be null.
 	// example: 2021-07-30T09:20:25+00:00
-	StartsAt string `json:"starts_at"`
+	StartsAt *string `json:"starts_at"`
 	// When the announcement should stop being displayed (ISO 8601 Datetime).
-	// If the announcement has no end time, this will be omitted or empty.
+	// If the announcement has no end time, this will be null.
 	// example: 2021-07-30T09:20:25+00:00
-	EndsAt string `json:"ends_at"`
+	EndsAt *string `json:"ends_at"`
 	// Announcement doesn't have begin time and end time, but begin day and end day.
 	AllDay bool `json:"all_day"`
 	// When the announcement was first published (ISO 8601 Datetime).
@@ -60,3 +60,50 @@ type Announcement struct {
 	// Reactions to this announcement.
 	Reactions []AnnouncementReaction `json:"reactions"`
 }
+
+// AdminAnnouncement models an admin view of an announcement.
+//
+// swagger:model adminAnnouncement
+type AdminAnnouncement struct {
+	Announcement
+	// Markdown text of the announcement, as
+	// originally written, for editing purposes.
+	// example: This is an announcement. No malarky.
+	Text string `json:"text"`
+}
+
+// AnnouncementCreateRequest models a request
+// to create an announcement, via the admin API.
+//
+// swagger:ignore
+type AnnouncementCreateRequest struct {
+	// Markdown text of the announcement.
+	Text string `form:"text" json:"text"`
+	// Start of the period the announcement refers to (ISO 8601 Datetime).
+	StartsAt string `form:"starts_at" json:"starts_at"`
+	// End of the period the announcement refers to (ISO 8601 Datetime).
+	EndsAt string `form:"ends_at" json:"ends_at"`
+	// Only the dates of starts_at and ends_at are relevant, not the times.
+	AllDay bool `form:"all_day" json:"all_day"`
+	// Publish the announcement immediately.
+	Published bool `form:"published" json:"published"`
+}
+
+// AnnouncementUpdateRequest models a request
+// to update an announcement, via the admin API.
+// Unset fields are left as they are, empty
+// starts_at / ends_at fields unset the time.
+//
+// swagger:ignore
+type AnnouncementUpdateRequest struct {
+	// Markdown text of the announcement.
+	Text *string `form:"text" json:"text"`
+	// Start of the period the announcement refers to (ISO 8601 Datetime).
+	StartsAt *string `form:"starts_at" json:"starts_at"`
+	// End of the period the announcement refers to (ISO 8601 Datetime).
+	EndsAt *string `form:"ends_at" json:"ends_at"`
+	// Only the dates of starts_at and ends_at are relevant, not the times.
+	AllDay *bool `form:"all_day" json:"all_day"`
+	// Publish (or unpublish) the announcement.
+	Published *bool `form:"published" json:"published"`
+}
@@ -19,7 +19,7 @@
 
 // AnnouncementReaction models a user reaction to an announcement.
 //
-// TODO: swagger:model announcementReaction once announcement API is supported
+// swagger:model announcementReaction
 type AnnouncementReaction struct {
 	// The emoji used for the reaction. Either a unicode emoji, or a custom emoji's shortcode.
 	// example: blobcat_uwu
@@ -38,3 +38,17 @@ type AnnouncementReaction struct {
 	// example: https://example.org/custom_emojis/statuc/blobcat_uwu.png
 	StaticURL string `json:"static_url,omitempty"`
 }
+
+// AnnouncementReactionEvent is the payload of a streamed
+// 'announcement.reaction' event, sent when the count
+// of a reaction to an announcement has changed.
+//
+// swagger:ignore
+type AnnouncementReactionEvent struct {
+	// The emoji used for the reaction. Either a unicode emoji, or a custom emoji's shortcode.
+	Name string `json:"name"`
+	// The total number of users who have added this reaction.
+	Count int `json:"count"`
+	// The ID of the announcement reacted to.
+	AnnouncementID string `json:"announcement_id"`
+}
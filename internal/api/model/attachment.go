@@ -36,6 +36,11 @@ type AttachmentRequest struct {
 	// If present, it should be in the form of two comma-separated floats between -1 and 1.
 	// example: -0.5,0.565
 	Focus string `form:"focus"`
+
+	// Keep the ICC color profile of the image, applying
+	// any EXIF orientation directly to image pixels.
+	// Optional, defaults to instance configuration.
+	PreserveColorProfile *bool `form:"preserve_color_profile"`
 }
 
 // AttachmentUpdateRequest models an update request for an attachment.
@@ -213,3 +218,18 @@ type MediaDimensions struct {
 	// example: 1.777777778
 	Aspect float32 `json:"aspect,omitempty"`
 }
+
+// AttachmentDescriptionSuggestion represents a description
+// of an image attachment suggested by an OCR or captioning
+// backend, for the poster to review and use as alt-text.
+//
+// swagger:model attachmentDescriptionSuggestion
+type AttachmentDescriptionSuggestion struct {
+	// The suggested description, as plain text.
+	// example: SALE 50% off everything this weekend only
+	Description string `json:"description"`
+
+	// Name of the backend that suggested the description.
+	// example: Tesseract
+	Provider string `json:"provider"`
+}
@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// BookmarkFolder represents a user-created folder
+// that the user can file their bookmarks into.
+//
+// swagger:model bookmarkFolder
+type BookmarkFolder struct {
+	// The ID of the bookmark folder.
+	ID string `json:"id"`
+	// The user-defined title of the bookmark folder.
+	Title string `json:"title"`
+}
+
+// BookmarkFolderRequest models bookmark
+// folder creation and update parameters.
+//
+// swagger:ignore
+type BookmarkFolderRequest struct {
+	// Title of this bookmark folder.
+	// Sample: Recipes
+	// in: formData
+	// required: true
+	Title string `form:"title" json:"title" xml:"title"`
+}
+
+// StatusBookmarkRequest models
+// status bookmark parameters.
+//
+// swagger:ignore
+type StatusBookmarkRequest struct {
+	// ID of the bookmark folder to file the bookmark in.
+	// If not set, the bookmark is filed in the default folder.
+	// in: formData
+	FolderID string `form:"folder_id" json:"folder_id" xml:"folder_id"`
+}
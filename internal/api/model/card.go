@@ -64,3 +64,13 @@ type Card struct {
 	// A hash computed by the BlurHash algorithm, for generating colorful preview thumbnails when media has not been downloaded yet.
 	Blurhash string `json:"blurhash"`
 }
+
+// TrendsLink represents a link preview card
+// that has been shared more than others.
+//
+// swagger:model trendsLink
+type TrendsLink struct {
+	Card
+	// Daily usage history of the link, newest day first.
+	History []History `json:"history"`
+}
@@ -45,4 +45,7 @@ type GetContentRequestForm struct {
 	MediaSize string
 	// Filename of the content
 	FileName string
+	// Accept contains image content types explicitly accepted
+	// by the caller, used to pick a converted variant to serve.
+	Accept []string
 }
@@ -115,6 +115,12 @@ type DomainLimit struct {
 	//	- mute
 	AccountsPolicy AccountsPolicy `json:"accounts_policy"`
 
+	// Policy to apply to federation with the limited domain.
+	// Enum:
+	//	- no_action
+	//	- pause
+	FederationPolicy FederationPolicy `json:"federation_policy"`
+
 	// Content warning to prepend to statuses originating from the limited domain.
 	// Omitted if not set.
 	// example: maybe nsfw
@@ -144,9 +150,10 @@ type DomainLimit struct {
 type MediaPolicy string
 
 const (
-	MediaPolicyNoAction      MediaPolicy = "no_action"
-	MediaPolicyMarkSensitive MediaPolicy = "mark_sensitive"
-	MediaPolicyReject        MediaPolicy = "reject"
+	MediaPolicyNoAction              MediaPolicy = "no_action"
+	MediaPolicyMarkSensitive         MediaPolicy = "mark_sensitive"
+	MediaPolicyReject                MediaPolicy = "reject"
+	MediaPolicySensitiveBlurhashOnly MediaPolicy = "sensitive_blurhash_only"
 )
 
 // Policy to apply to follow (requests)
@@ -179,6 +186,15 @@ type DomainLimit struct {
 	AccountsPolicyMute     AccountsPolicy = "mute"
 )
 
+// Policy to apply to federation
+// with the limited domain.
+type FederationPolicy string
+
+const (
+	FederationPolicyNoAction FederationPolicy = "no_action"
+	FederationPolicyPause    FederationPolicy = "pause"
+)
+
 // DomainLimitRequest is the form submitted as a POST
 // or PUT to create or update a domain limit entry.
 //
@@ -203,6 +219,10 @@ type DomainLimitRequest struct {
 	// accounts on the limited domain.
 	AccountsPolicy *AccountsPolicy `json:"accounts_policy" form:"accounts_policy"`
 
+	// Policy to apply to federation
+	// with the limited domain.
+	FederationPolicy *FederationPolicy `json:"federation_policy" form:"federation_policy"`
+
 	// Content warning to prepend to statuses
 	// originating from the limited domain.
 	ContentWarning *string `json:"content_warning" form:"content_warning"`
@@ -29,4 +29,51 @@ type Error struct {
 
 	// ErrorDescription is only used for OAuth errors, and is a human-readable description of the error.
 	ErrorDescription string `json:"error_description,omitempty"`
+
+	// ErrorCode is a stable, machine-readable code identifying the type of error,
+	// eg., "status_not_found", which clients can use to branch on different errors.
+	// Errors without a more specific code get a generic one derived from the http
+	// status code, eg., "not_found" or "unprocessable_entity".
+	//
+	// Not used for OAuth errors.
+	ErrorCode string `json:"error_code,omitempty"`
+
+	// RequestID is the ID of the request that caused this error, which can be
+	// included in bug reports to help admins find the request in server logs.
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Problem is an RFC 7807 "problem details" error, served
+// as application/problem+json in response to rejected
+// federation (ActivityPub) requests, so that remote
+// admins can diagnose interop problems.
+//
+// See https://www.rfc-editor.org/rfc/rfc7807.
+//
+// swagger:model problem
+type Problem struct {
+	// Type is a URI identifying the problem type. Always
+	// "about:blank", the reason code is given in Reason.
+	Type string `json:"type"`
+
+	// Title is a short, human-readable
+	// summary of the problem type, ie.,
+	// the text of the http status code.
+	Title string `json:"title"`
+
+	// Status is the http status code of the response.
+	Status int `json:"status"`
+
+	// Detail is a human-readable
+	// explanation of the problem.
+	Detail string `json:"detail,omitempty"`
+
+	// Reason is a stable, machine-readable
+	// code identifying the problem, eg.,
+	// "signature_invalid" or "domain_blocked".
+	Reason string `json:"reason"`
+
+	// RequestID is the ID of the request that caused this problem, which
+	// can be given to admins to help them find the request in server logs.
+	RequestID string `json:"request_id,omitempty"`
 }
@@ -61,12 +61,45 @@ type AccountExportStats struct {
 	MutesCount int `json:"mutes_count"`
 }
 
+// BlockExport models one account blocked by
+// the requester, in a JSON export of blocks.
+//
+// swagger:model blockExport
+type BlockExport struct {
+	// Address of the blocked account, without leading '@'.
+	//
+	// example: someone@example.org
+	Account string `json:"account"`
+}
+
+// MuteExport models one account muted by
+// the requester, in a JSON export of mutes.
+//
+// swagger:model muteExport
+type MuteExport struct {
+	// Address of the muted account, without leading '@'.
+	//
+	// example: someone@example.org
+	Account string `json:"account"`
+
+	// Notifications from the muted account are hidden.
+	// When importing, may be omitted to hide notifications.
+	//
+	// example: true
+	HideNotifications *bool `json:"hide_notifications,omitempty"`
+}
+
 // AttachmentRequest models media attachment creation parameters.
 //
 // swagger: ignore
 type ImportRequest struct {
-	// The CSV data to upload.
-	Data *multipart.FileHeader `form:"data" binding:"required"`
+	// The CSV data to upload. Blocks and
+	// mutes may also be uploaded as JSON,
+	// in the format of their JSON exports.
+	Data *multipart.FileHeader `form:"data"`
+	// URL of a blocklist published by another account,
+	// to subscribe to instead of uploading a data file.
+	URL string `form:"url"`
 	// Type of entries contained in the data file.
 	//
 	//	- `following` - accounts to follow.
@@ -80,3 +113,43 @@ type ImportRequest struct {
 	//	- `overwrite` to replace existing entries with entries in file.
 	Mode string `form:"mode"`
 }
+
+// BlocklistSubscription models a subscription to a
+// blocklist published by another account, which is
+// refetched periodically to keep blocks up to date.
+//
+// swagger:model blocklistSubscription
+type BlocklistSubscription struct {
+	// The ID of the subscription.
+	//
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+
+	// URL of the subscribed blocklist.
+	//
+	// example: https://example.org/users/someone/collections/blocklist
+	URL string `json:"url"`
+
+	// Time the subscription was created (ISO 8601 Datetime).
+	//
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+
+	// Time the blocklist was last fetched (ISO 8601 Datetime),
+	// if it's been fetched yet.
+	//
+	// example: 2021-07-30T09:20:25+00:00
+	FetchedAt *string `json:"fetched_at"`
+
+	// Error encountered when the blocklist was
+	// last fetched, if fetching it failed.
+	//
+	// example: unexpected status code 404
+	Error *string `json:"error"`
+
+	// Number of accounts currently blocked because of this subscription.
+	// Listed accounts that were already blocked beforehand are not counted.
+	//
+	// example: 15
+	BlocksCount int `json:"blocks_count"`
+}
@@ -17,12 +17,12 @@
 
 package model
 
-// History represents daily usage history of a hashtag.
+// History represents daily usage history of a hashtag or link.
 type History struct {
 	// UNIX timestamp on midnight of the given day (string cast from integer).
 	Day string `json:"day"`
-	// The counted usage of the tag within that day (string cast from integer).
+	// The counted usage of the tag or link within that day (string cast from integer).
 	Uses string `json:"uses"`
-	// The total of accounts using the tag within that day (string cast from integer).
+	// The total of accounts using the tag or link within that day (string cast from integer).
 	Accounts string `json:"accounts"`
 }
@@ -83,6 +83,15 @@ type InstanceConfigurationStatuses struct {
 	//
 	// example: ["text/plain","text/markdown"]
 	SupportedMimeTypes []string `json:"supported_mime_types,omitempty"`
+	// Minimum time after creation at which a status can be set to expire, in seconds.
+	//
+	// example: 300
+	MinExpiration int `json:"min_expiration"`
+	// Maximum time after creation at which a status can be set to expire, in seconds.
+	// 0 means no limit.
+	//
+	// example: 31536000
+	MaxExpiration int `json:"max_expiration"`
 }
 
 // InstanceConfigurationMediaAttachments models instance media attachment config parameters.
@@ -130,6 +139,20 @@ type InstanceConfigurationMediaAttachments struct {
 	//
 	// example: 200
 	DescriptionMinimum int `json:"description_minimum,omitempty"`
+	// Policy for descriptions of media attached to new statuses,
+	// "remind" or "require". Accounts may choose a stricter policy
+	// for themselves, see the media_description_policy of their source.
+	// Omitted if descriptions are optional.
+	// Omitted for /api/v1/instance response.
+	//
+	// example: remind
+	DescriptionPolicy string `json:"description_policy,omitempty"`
+	// True if suggested descriptions can be requested
+	// from POST /api/v1/media/{id}/describe, else omitted.
+	// Omitted for /api/v1/instance response.
+	//
+	// example: true
+	DescriptionSuggestions bool `json:"description_suggestions,omitempty"`
 }
 
 // InstanceConfigurationPolls models instance poll config parameters.
@@ -164,8 +187,36 @@ type InstanceConfigurationEmojis struct {
 
 // swagger:ignore
 type RandomStats struct {
-	Statuses           int64
-	TotalUsers         int64
-	MonthlyActiveUsers int64
-	Generated          time.Time
+	Statuses            int64
+	TotalUsers          int64
+	MonthlyActiveUsers  int64
+	HalfYearActiveUsers int64
+	Generated           time.Time
+}
+
+// swagger:ignore
+type ActivityStats struct {
+	MonthlyActiveUsers  int
+	HalfYearActiveUsers int
+	Generated           time.Time
+}
+
+// InstanceActivity models the local activity of
+// this instance over one week. Counts are given
+// as strings, for compatibility with Mastodon.
+//
+// swagger:model instanceActivity
+type InstanceActivity struct {
+	// Unix timestamp of midnight (UTC) on the Monday starting this week.
+	// example: 1736121600
+	Week string `json:"week"`
+	// Number of statuses posted by local accounts during this week.
+	// example: 120
+	Statuses string `json:"statuses"`
+	// Number of local users who signed in during this week.
+	// example: 10
+	Logins string `json:"logins"`
+	// Number of new sign-ups during this week.
+	// example: 2
+	Registrations string `json:"registrations"`
 }
@@ -64,6 +64,12 @@ type InteractionPolicy struct {
 	CanReply PolicyRules `form:"can_reply" json:"can_reply"`
 	// Rules for who can reblog this status.
 	CanReblog PolicyRules `form:"can_reblog" json:"can_reblog"`
+	// Rules for who can quote this status.
+	//
+	// GoToSocial doesn't support quote posts yet, so these
+	// rules aren't enforced, but are kept so that policies
+	// set by other software aren't dropped. Omitted if unset.
+	CanQuote *PolicyRules `form:"can_quote" json:"can_quote,omitempty"`
 }
 
 // Default interaction policies to use for new statuses by requesting account.
@@ -108,4 +114,10 @@ type UpdateInteractionPoliciesRequest struct {
 	// in: formData
 	// nullable: true
 	Public *InteractionPolicy `form:"public" json:"public"`
+	// Preset to use for any visibility level left
+	// unspecified, instead of the original default.
+	// Currently only "bot" is supported.
+	//
+	// in: formData
+	Preset string `form:"preset" json:"preset"`
 }
@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package model
+
+// Invite models an invite link created by a local
+// account, which allows others to sign up to this
+// instance, even when registration is closed.
+//
+// swagger:model invite
+type Invite struct {
+	// The ID of the invite.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// When the invite was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Code to provide as invite_code when signing up.
+	// example: 9A8X4V6Q
+	Code string `json:"code"`
+	// Link to the sign-up page with this invite's code prefilled.
+	// example: https://example.org/signup?invite=9A8X4V6Q
+	URL string `json:"url"`
+	// Maximum number of sign-ups allowed with this invite. 0 = unlimited.
+	// example: 5
+	MaxUses int `json:"max_uses"`
+	// Number of sign-ups made with this invite so far.
+	// example: 2
+	Uses int `json:"uses"`
+	// When the invite expires (ISO 8601 Datetime).
+	// example: 2021-08-30T09:20:25+00:00
+	// nullable: true
+	ExpiresAt *string `json:"expires_at"`
+	// When the invite was revoked (ISO 8601 Datetime).
+	// example: 2021-08-01T09:20:25+00:00
+	// nullable: true
+	RevokedAt *string `json:"revoked_at"`
+	// Whether the invite can currently be used to sign up.
+	// example: true
+	Usable bool `json:"usable"`
+	// The account that created the invite.
+	// Only included in admin views of invites.
+	Account *Account `json:"account,omitempty"`
+}
+
+// InviteCreateRequest models a request
+// to create a new invite for sign-up.
+//
+// swagger:ignore
+type InviteCreateRequest struct {
+	// Maximum number of sign-ups allowed with this invite. 0 = unlimited.
+	MaxUses int `form:"max_uses" json:"max_uses"`
+
+	// Number of seconds from now until the invite expires. 0 = never.
+	ExpiresIn int `form:"expires_in" json:"expires_in"`
+}
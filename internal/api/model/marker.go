@@ -35,6 +35,17 @@ type TimelineMarker struct {
 	UpdatedAt string `json:"updated_at"`
 	// Used for locking to prevent write conflicts.
 	Version int `json:"version"`
+	// Number of entries in the timeline newer than the marker.
+	// This is a GoToSocial extension, and is only set on GET.
+	// For the home timeline this is an approximation computed
+	// from the cache of the most recent entries, which does not
+	// account for mutes or filters, and is 0 if not yet cached.
+	UnreadCount *int `json:"unread_count,omitempty"`
+	// Whether UnreadCount could be counted exactly from the home
+	// timeline cache, ie., the cache covers the whole range since
+	// the marker. This is a GoToSocial extension, and is only set
+	// when UnreadCount is set.
+	UnreadCountExact *bool `json:"unread_count_exact,omitempty"`
 }
 
 // MarkerName is the name of one of the timelines we can store markers for.
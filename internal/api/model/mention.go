@@ -33,3 +33,16 @@ type Mention struct {
 	// example: some_user@example.org
 	Acct string `json:"acct"`
 }
+
+// MentionPreview shows which account a mention
+// in a status being composed will resolve to.
+//
+// swagger:model mentionPreview
+type MentionPreview struct {
+	// The mention as it will be parsed from status
+	// text, ie., without any trailing punctuation.
+	// example: @some_user@example.org
+	Mention string `json:"mention"`
+	// The account that the mention resolves to.
+	Account *Account `json:"account"`
+}
@@ -34,6 +34,10 @@ type Notification struct {
 	// 	poll = A poll you have voted in or created has ended. `status` will be set. `account` will be set.
 	// 	status = Someone you enabled notifications for has posted a status. `status` will be set. `account` will be set.
 	// 	admin.sign_up = Someone has signed up for a new account on the instance. `account` will be set.
+	// 	moderation_warning = A moderator has issued a warning to you. `moderation_warning` will be set.
+	// 	appeal.approved = A moderator has approved your appeal against a warning. `moderation_warning` will be set.
+	// 	appeal.rejected = A moderator has rejected your appeal against a warning. `moderation_warning` will be set.
+	// 	thread_reply = Someone has replied in a thread you subscribed to. `status` will be set. `account` will be set.
 	Type string `json:"type"`
 	// The timestamp of the notification (ISO 8601 Datetime)
 	CreatedAt string `json:"created_at"`
@@ -44,6 +48,9 @@ type Notification struct {
 
 	// Status that was the object of the notification, e.g. in mentions, reblogs, favourites, or polls.
 	Status *Status `json:"status,omitempty"`
+
+	// Moderation warning that was the object of the notification.
+	ModerationWarning *AccountWarning `json:"moderation_warning,omitempty"`
 }
 
 /*
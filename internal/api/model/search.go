@@ -27,6 +27,7 @@ type SearchRequest struct {
 	QueryType         string
 	Resolve           bool
 	Following         bool
+	Notes             bool
 	ExcludeUnreviewed bool
 	AccountID         string
 	APIv1             bool // Set to 'true' if using version 1 of the search API.
@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+import "encoding/xml"
+
+// Sitemap represents a sitemap urlset document.
+// See: https://www.sitemaps.org/protocol.html
+//
+// swagger:ignore
+type Sitemap struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	URLs    []SitemapURL `xml:"url"`
+}
+
+// SitemapURL represents one url entry in a sitemap,
+// or one sitemap entry in a sitemap index.
+//
+// swagger:ignore
+type SitemapURL struct {
+	// Location of the page or sitemap.
+	Loc string `xml:"loc"`
+	// Last modification time of the page or sitemap, in W3C Datetime format.
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// SitemapIndex represents a sitemap index document,
+// used to link to several sitemaps when there are too
+// many urls to fit into one.
+// See: https://www.sitemaps.org/protocol.html#index
+//
+// swagger:ignore
+type SitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	XMLNS    string       `xml:"xmlns,attr"`
+	Sitemaps []SitemapURL `xml:"sitemap"`
+}
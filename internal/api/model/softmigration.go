@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+import "mime/multipart"
+
+// SoftMigrationAlias models an account that the requesting
+// account is aliased to (via alsoKnownAs), along with what
+// parts of a soft migration from that account are possible.
+//
+// swagger:model softMigrationAlias
+type SoftMigrationAlias struct {
+	// The aliased (old) account.
+	Account *Account `json:"account"`
+	// Whether the aliased account also lists the requesting
+	// account in its alsoKnownAs, confirming that both
+	// accounts are controlled by the same person.
+	Verified bool `json:"verified"`
+	// Whether accounts followed by the aliased account can be
+	// fetched directly by this instance. If false, a following
+	// CSV exported from the aliased account must be uploaded.
+	CanFetchFollowing bool `json:"can_fetch_following"`
+}
+
+// SoftMigrationNoticeRequest models a request to post
+// a notice that the requester has moved from an alias.
+//
+// swagger:ignore
+type SoftMigrationNoticeRequest struct {
+	// ActivityPub URI of the aliased (old) account.
+	AlsoKnownAsURI string `form:"also_known_as_uri" json:"also_known_as_uri"`
+	// Text of the notice, if not the default.
+	Status string `form:"status" json:"status"`
+}
+
+// SoftMigrationFollowingRequest models a request to follow,
+// from the requester, the accounts followed by an alias.
+//
+// swagger:ignore
+type SoftMigrationFollowingRequest struct {
+	// ActivityPub URI of the aliased (old) account.
+	AlsoKnownAsURI string `form:"also_known_as_uri" json:"also_known_as_uri"`
+	// Following CSV data exported from the aliased
+	// account, required if it can't be fetched directly.
+	Data *multipart.FileHeader `form:"data" json:"-"`
+}
@@ -37,12 +37,40 @@ type Source struct {
 	WebLayout string `json:"web_layout"`
 	// Include boosts created by the account on the web view of the account.
 	WebIncludeBoosts bool `json:"web_include_boosts"`
+	// Show a heatmap of public posting activity on the web
+	// view of the account, and allow others to fetch it via
+	// the API.
+	WebActivityHeatmap bool `json:"web_activity_heatmap"`
+	// Embed all media attachments in the content
+	// of the items in this account's RSS feed.
+	RSSFullContent bool `json:"rss_full_content"`
 	// Whether new statuses should be marked sensitive by default.
 	Sensitive bool `json:"sensitive"`
+	// Whether link preview cards should be
+	// generated for new statuses by default.
+	PreviewLinks bool `json:"preview_links"`
+	// Policy for descriptions of media attached to new statuses
+	// by this account. This is the stricter of the account's own
+	// choice and the instance policy, so clients can use it to
+	// decide whether to remind the account to add descriptions.
+	//    "off": descriptions are optional.
+	//    "remind": descriptions are optional, but the account should be reminded to add them.
+	//    "require": descriptions are required, statuses with undescribed media are rejected.
+	MediaDescriptionPolicy string `json:"media_description_policy"`
 	// The default posting language for new statuses.
 	Language string `json:"language"`
+	// Languages of statuses to show on timelines.
+	// If empty, statuses in all languages are shown.
+	//
+	// Omitted from json if empty / not set.
+	ChosenLanguages []string `json:"chosen_languages,omitempty"`
 	// The default posting content type for new statuses.
 	StatusContentType string `json:"status_content_type"`
+	// Default number of seconds after which new statuses
+	// are automatically deleted, if any.
+	//
+	// Omitted from json if not set.
+	StatusExpiresIn int `json:"status_expires_in,omitempty"`
 	// Profile bio.
 	Note string `json:"note"`
 	// Metadata about the account.
@@ -54,4 +82,14 @@ type Source struct {
 	//
 	// Omitted from json if empty / not set.
 	AlsoKnownAsURIs []string `json:"also_known_as_uris,omitempty"`
+	// Previous avatars of this account, newest first. Any of
+	// these can be restored using `/api/v1/profile/avatar/rollback`.
+	//
+	// Omitted from json if empty / not set.
+	AvatarHistory []Attachment `json:"avatar_history,omitempty"`
+	// Previous headers of this account, newest first. Any of
+	// these can be restored using `/api/v1/profile/header/rollback`.
+	//
+	// Omitted from json if empty / not set.
+	HeaderHistory []Attachment `json:"header_history,omitempty"`
 }
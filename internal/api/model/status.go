@@ -37,6 +37,10 @@ type Status struct {
 	// example: 2021-07-30T09:20:25+00:00
 	// nullable: true
 	EditedAt *string `json:"edited_at"`
+	// Timestamp of when the status is scheduled to be
+	// automatically deleted (ISO 8601 Datetime), if set.
+	// example: 2021-07-31T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at,omitempty"`
 	// ID of the status being replied to.
 	// example: 01FBVD42CQ3ZEEVMW180SBX03B
 	// nullable: true
@@ -60,6 +64,11 @@ type Status struct {
 	// Will be null if language is not known.
 	// example: en
 	Language *string `json:"language"`
+	// BCP47 language tags of all languages the status content is available in,
+	// if more than one. Content and language are then those of the variant best matching
+	// the requesting account's language preferences. GoToSocial extension.
+	// example: ["de","en"]
+	Languages []string `json:"languages,omitempty"`
 	// ActivityPub URI of the status. Equivalent to the status's activitypub ID.
 	// example: https://example.org/users/some_user/statuses/01FBVD42CQ3ZEEVMW180SBX03B
 	URI string `json:"uri"`
@@ -78,6 +87,8 @@ type Status struct {
 	Reblogged bool `json:"reblogged"`
 	// Replies to this status have been muted by the account viewing it.
 	Muted bool `json:"muted"`
+	// New replies anywhere in the thread of this status will be notified to the account viewing it.
+	Subscribed bool `json:"subscribed,omitempty"`
 	// This status has been bookmarked by the account viewing it.
 	Bookmarked bool `json:"bookmarked"`
 	// This status has been pinned by the account viewing it (only relevant for your own statuses).
@@ -85,6 +96,14 @@ type Status struct {
 	// The content of this status. Should be HTML, but might also be plaintext in some cases.
 	// example: <p>Hey this is a status!</p>
 	Content string `json:"content"`
+	// Set to "true" if status is a long-form article (eg., from a blogging platform); omitted from response otherwise.
+	// For articles, content contains the full article.
+	Article bool `json:"article,omitempty"`
+	// Summary-only HTML form of content for long-form articles, containing
+	// the article's title, summary, and a link to it; omitted for non-articles.
+	// Clients may want to show this as a preview instead of the full content.
+	// example: <p><strong>My article</strong></p><p>A summary of my article.</p>
+	ArticleSummary string `json:"article_summary,omitempty"`
 	// The status that this status reblogs/boosts.
 	// nullable: true
 	Reblog *StatusReblogged `json:"reblog"`
@@ -218,6 +237,60 @@ type StatusFilterResult struct {
 	Expires *string `json:"expires,omitempty"`
 }
 
+// StatusVisibilityCacheDebugResponse shows the visibility,
+// mute and filter cache entries currently stored for a single
+// status and requesting account pair. It is used to diagnose
+// stale cached results without needing to clear all caches.
+//
+// swagger:model statusVisibilityCacheDebugResponse
+type StatusVisibilityCacheDebugResponse struct {
+	// URI is the URI of the status in question.
+	URI string `json:"uri"`
+
+	// StatusID is the ID of the status in question.
+	StatusID string `json:"status_id"`
+
+	// AccountID is the ID of the requesting
+	// account that cache entries are keyed by.
+	AccountID string `json:"account_id"`
+
+	// Visibility contains cached visibility lookup
+	// results for the status, and for its author.
+	Visibility []StatusVisibilityCacheEntry `json:"visibility"`
+
+	// MuteCached indicates whether a mute lookup
+	// result is cached for this status and account.
+	MuteCached bool `json:"mute_cached"`
+
+	// Mute contains any cached mute acting on status.
+	Mute *StatusMuteResult `json:"mute,omitempty"`
+
+	// MuteNotifications contains any cached
+	// notification mute acting on status.
+	MuteNotifications *StatusMuteResult `json:"mute_notifications,omitempty"`
+
+	// FiltersCached indicates whether filter lookup
+	// results are cached for this status and account.
+	FiltersCached bool `json:"filters_cached"`
+
+	// Filters contains any cached filter
+	// results matching on status.
+	Filters *StatusFiltersResult `json:"filters,omitempty"`
+}
+
+type StatusVisibilityCacheEntry struct {
+	// Type is the visibility lookup type,
+	// one of: status, home, public, account.
+	Type string `json:"type"`
+
+	// ItemID is the ID of the looked-up
+	// item, either a status or an account.
+	ItemID string `json:"item_id"`
+
+	// Visible is the cached visibility value.
+	Visible bool `json:"visible"`
+}
+
 // StatusReblogged represents a reblogged status.
 //
 // swagger:model statusReblogged
@@ -236,6 +309,10 @@ type WebStatus struct {
 	// (ie., not converted to plaintext).
 	SpoilerContent string `json:"-"`
 
+	// Title of this status,
+	// if it's a long-form article.
+	ArticleTitle string `json:"-"`
+
 	// Override API account with web account.
 	Account *WebAccount `json:"account"`
 
@@ -341,6 +418,10 @@ type StatusCreateRequest struct {
 	// Status and attached media should be marked as sensitive.
 	Sensitive bool `form:"sensitive" json:"sensitive"`
 
+	// Generate a link preview card for the first link in the status.
+	// If not set, the account's default (source[preview_links]) is used.
+	PreviewLinks *bool `form:"preview_links" json:"preview_links"`
+
 	// Text to be shown as a warning or subject before the actual content.
 	// Statuses are generally collapsed behind this field.
 	SpoilerText string `form:"spoiler_text" json:"spoiler_text"`
@@ -368,6 +449,22 @@ type StatusCreateRequest struct {
 	// of scheduled_at, if scheduled_at was set.
 	ScheduledAt *time.Time `form:"-" json:"-"`
 
+	// Duration after which the status should be automatically
+	// deleted, in seconds. Cannot be used with expires_at.
+	ExpiresIn int `form:"expires_in" xml:"expires_in"`
+
+	// Duration after which the status should be automatically
+	// deleted, in seconds. Cannot be used with expires_at.
+	ExpiresInI interface{} `json:"expires_in"`
+
+	// ISO8601 datetime string at which the status should be
+	// automatically deleted. Cannot be used with expires_in.
+	ExpiresAtRaw string `form:"expires_at" json:"expires_at"`
+
+	// ExpiresAt is the parsed version
+	// of expires_at, if expires_at was set.
+	ExpiresAt *time.Time `form:"-" json:"-"`
+
 	// ISO 639 language code for this status.
 	Language string `form:"language" json:"language"`
 
@@ -487,6 +584,17 @@ type StatusEdit struct {
 	Emojis []Emoji `json:"emojis"`
 }
 
+// StatusVisibilityRequest models a request
+// to change the visibility of a status.
+//
+// swagger:ignore
+type StatusVisibilityRequest struct {
+
+	// New visibility of the status,
+	// one of public or unlisted.
+	Visibility Visibility `form:"visibility" json:"visibility"`
+}
+
 // StatusEditRequest models status edit parameters.
 //
 // swagger:ignore
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// StatusCleanup models a background job which
+// deletes or unlists the requesting account's own
+// statuses older than a chosen age.
+//
+// swagger:model statusCleanup
+type StatusCleanup struct {
+	// The ID of the cleanup.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// When the cleanup was started (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// What the cleanup does to each status.
+	// enum:
+	//	- delete
+	//	- unlist
+	// example: delete
+	Action string `json:"action"`
+	// Statuses created before this time are cleaned up (ISO 8601 Datetime).
+	// example: 2021-06-30T09:20:25+00:00
+	OlderThan string `json:"older_than"`
+	// State of the cleanup.
+	// enum:
+	//	- running
+	//	- completed
+	//	- cancelled
+	// example: running
+	State string `json:"state"`
+	// Number of statuses to be cleaned up, counted when the cleanup was started.
+	// example: 420
+	Total int `json:"total"`
+	// Number of statuses cleaned up (or skipped, if pinned) so far.
+	// example: 69
+	Processed int `json:"processed"`
+	// When the cleanup completed or was cancelled (ISO 8601 Datetime).
+	// example: 2021-07-30T12:20:25+00:00
+	// nullable: true
+	CompletedAt *string `json:"completed_at"`
+}
+
+// StatusCleanupRequest models a request to
+// start a cleanup of the requester's statuses.
+//
+// swagger:ignore
+type StatusCleanupRequest struct {
+	// What to do to each status: delete or unlist.
+	Action string `form:"action" json:"action"`
+
+	// Minimum age in seconds of statuses to clean up.
+	MinAge int `form:"min_age" json:"min_age"`
+}
@@ -49,6 +49,16 @@ type TokenInfo struct {
 	// Omitted if token has never been used, or it is not known when it was last used (eg., it was last used before tracking "last_used" became a thing).
 	// example: 2021-07-30T09:20:25+00:00
 	LastUsed string `json:"last_used,omitempty"`
+	// IP address of the client that most recently used this token, as of "last_used".
+	// Omitted if not known (eg., it was last used before tracking "last_used_ip" became a thing).
+	// example: 192.0.2.1
+	LastUsedIP string `json:"last_used_ip,omitempty"`
+	// User-agent of the client that most recently used this token, as of "last_used".
+	// Omitted if not known, or the client did not send a user-agent.
+	// example: Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0
+	LastUsedUserAgent string `json:"last_used_user_agent,omitempty"`
+	// Whether this is the token used to make the current request.
+	Current bool `json:"current"`
 	// OAuth scopes granted by the token, space-separated.
 	// example: read write admin
 	Scope string `json:"scope"`
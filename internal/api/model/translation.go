@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Translation represents the translation of a status
+// into another language by a translation provider.
+//
+// swagger:model translation
+type Translation struct {
+	// The translated status content, as HTML.
+	// example: <p>Hallo Welt!</p>
+	Content string `json:"content"`
+
+	// The translated content warning of the status.
+	// example: Achtung
+	SpoilerText string `json:"spoiler_text"`
+
+	// The translated poll of the status, if any.
+	Poll *TranslationPoll `json:"poll,omitempty"`
+
+	// The translated media descriptions of the status.
+	MediaAttachments []TranslationAttachment `json:"media_attachments"`
+
+	// Language of the original status, as given or as
+	// detected by the translation provider (ISO 639 Part 1).
+	// example: en
+	DetectedSourceLanguage string `json:"detected_source_language"`
+
+	// Name of the provider that translated the status.
+	// example: DeepL.com
+	Provider string `json:"provider"`
+}
+
+// TranslationPoll represents the translated options of a poll.
+//
+// swagger:model translationPoll
+type TranslationPoll struct {
+	// The ID of the poll in the database.
+	// example: 01FBYKMD1KBMJ0W6JF1YZ3VY5D
+	ID string `json:"id"`
+
+	// The translated poll options.
+	Options []TranslationPollOption `json:"options"`
+}
+
+// TranslationPollOption represents a translated poll option.
+//
+// swagger:model translationPollOption
+type TranslationPollOption struct {
+	// The translated title of the poll option.
+	Title string `json:"title"`
+}
+
+// TranslationAttachment represents the translated
+// description of a media attachment.
+//
+// swagger:model translationAttachment
+type TranslationAttachment struct {
+	// The ID of the attachment in the database.
+	// example: 01FC31DZT1AYWDZ8XTCRWRBYRK
+	ID string `json:"id"`
+
+	// The translated description of the attachment.
+	Description string `json:"description"`
+}
+
+// TranslationRequest models a status translation request.
+//
+// swagger:ignore
+type TranslationRequest struct {
+	// ISO 639 language code to translate the status into.
+	// Defaults to the requester's default posting language.
+	Lang string `form:"lang" json:"lang"`
+}
@@ -98,3 +98,24 @@ type EmailChangeRequest struct {
 	// required: true
 	NewEmail string `form:"new_email" json:"new_email" xml:"new_email" validation:"required"`
 }
+
+// UserLogin represents one attempt to sign
+// in to a user account via the sign-in page.
+//
+// swagger:model userLogin
+type UserLogin struct {
+	// Database ID of this sign-in attempt.
+	// example: 01JMW7QBAZYZ8T8H73PCEX12XG
+	ID string `json:"id"`
+	// When the sign-in attempt was made (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// IP address the sign-in attempt originated from.
+	// example: 192.0.2.1
+	IP string `json:"ip,omitempty"`
+	// User-agent of the client that made the sign-in attempt, if known.
+	// example: Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0
+	UserAgent string `json:"user_agent,omitempty"`
+	// Whether the sign-in attempt succeeded.
+	Successful bool `json:"successful"`
+}
@@ -83,6 +83,9 @@ type WebPushSubscriptionAlerts struct {
 
 	// Receive a push notification when a boost is pending?
 	PendingReblog bool `json:"pending.reblog"`
+
+	// Receive a push notification when someone replies in a subscribed thread?
+	ThreadReply bool `json:"thread_reply"`
 }
 
 // WebPushSubscriptionCreateRequest captures params for creating or replacing a Web Push subscription.
@@ -138,6 +141,7 @@ type WebPushSubscriptionUpdateRequest struct {
 	DataAlertsPendingFavourite *bool `form:"data[alerts][pending.favourite]" json:"-"`
 	DataAlertsPendingReply     *bool `form:"data[alerts][pending.reply]" json:"-"`
 	DataAlertsPendingReblog    *bool `form:"data[alerts][pending.reblog]" json:"-"`
+	DataAlertsThreadReply      *bool `form:"data[alerts][thread_reply]" json:"-"`
 
 	DataPolicy *WebPushNotificationPolicy `form:"data[policy]" json:"-"`
 }
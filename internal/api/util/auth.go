@@ -107,22 +107,34 @@ func TokenAuth(
 
 	if requireToken && a.Token == nil {
 		const errText = "token not supplied"
-		return nil, gtserror.NewErrorUnauthorized(errors.New(errText), errText)
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorUnauthorized(errors.New(errText), errText),
+			gtserror.ErrCodeAuthRequired,
+		)
 	}
 
 	if requireApp && a.Application == nil {
 		const errText = "application not supplied"
-		return nil, gtserror.NewErrorUnauthorized(errors.New(errText), errText)
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorUnauthorized(errors.New(errText), errText),
+			gtserror.ErrCodeAuthRequired,
+		)
 	}
 
 	if requireUser && a.User == nil {
 		const errText = "user not supplied or not authorized"
-		return nil, gtserror.NewErrorUnauthorized(errors.New(errText), errText)
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorUnauthorized(errors.New(errText), errText),
+			gtserror.ErrCodeAuthRequired,
+		)
 	}
 
 	if requireAccount && a.Account == nil {
 		const errText = "account not supplied or not authorized"
-		return nil, gtserror.NewErrorUnauthorized(errors.New(errText), errText)
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorUnauthorized(errors.New(errText), errText),
+			gtserror.ErrCodeAuthRequired,
+		)
 	}
 
 	if len(requireScope) != 0 {
@@ -144,7 +156,10 @@ func(hasScope string) bool {
 
 		if !scopeOK {
 			const errText = "token has insufficient scope permission"
-			return nil, gtserror.NewErrorForbidden(errors.New(errText), errText)
+			return nil, gtserror.WithErrCode(
+				gtserror.NewErrorForbidden(errors.New(errText), errText),
+				gtserror.ErrCodeScopeInsufficient,
+			)
 		}
 	}
 
@@ -56,7 +56,9 @@ func NotFoundHandler(c *gin.Context, instanceGet func(ctx context.Context) (*api
 		)
 	default:
 		JSON(c, http.StatusNotFound, apimodel.Error{
-			Error: errWithCode.Safe(),
+			Error:     errWithCode.Safe(),
+			ErrorCode: errWithCode.ErrCode(),
+			RequestID: gtscontext.RequestID(c.Request.Context()),
 		})
 	}
 }
@@ -81,7 +83,9 @@ func genericErrorHandler(c *gin.Context, instanceGet func(ctx context.Context) (
 		)
 	default:
 		JSON(c, errWithCode.Code(), apimodel.Error{
-			Error: errWithCode.Safe(),
+			Error:     errWithCode.Safe(),
+			ErrorCode: errWithCode.ErrCode(),
+			RequestID: gtscontext.RequestID(c.Request.Context()),
 		})
 	}
 }
@@ -108,6 +112,34 @@ func ErrorHandler(
 	instanceGet func(ctx context.Context) (*apimodel.InstanceV1, gtserror.WithCode),
 	offers ...string,
 ) {
+	if abortOnContextErr(c, errWithCode) {
+		return
+	}
+
+	// Set the error on the gin context so that it can be logged
+	// in the gin logger middleware (internal/middleware/logger.go).
+	c.Error(errWithCode) //nolint:errcheck
+
+	// Discover if we're allowed to serve a nice html error page,
+	// or if we should just use a json. Normally we would want to
+	// check for a returned error, but if an error occurs here we
+	// can just fall back to default behavior (serve json error).
+	// Prefer provided offers, fall back to JSON or HTML.
+	accept, _ := NegotiateAccept(c, append(offers, JSONOrHTMLAcceptHeaders...)...)
+
+	if errWithCode.Code() == http.StatusNotFound {
+		// Use our special not found handler with useful status text.
+		NotFoundHandler(c, instanceGet, accept, errWithCode)
+	} else {
+		genericErrorHandler(c, instanceGet, accept, errWithCode)
+	}
+}
+
+// abortOnContextErr checks whether the request context has
+// been cancelled, either by the client hanging up or by the
+// server timing out a slow request. If so, it aborts the
+// request with an appropriate 408 / 499 code, returning true.
+func abortOnContextErr(c *gin.Context, errWithCode gtserror.WithCode) bool {
 	if ctxErr := c.Request.Context().Err(); ctxErr != nil {
 		// Context error means either client has left already,
 		// or server has timed out a very slow request.
@@ -130,26 +162,10 @@ func ErrorHandler(
 		}
 
 		c.AbortWithStatus(errWithCode.Code())
-		return
+		return true
 	}
 
-	// Set the error on the gin context so that it can be logged
-	// in the gin logger middleware (internal/middleware/logger.go).
-	c.Error(errWithCode) //nolint:errcheck
-
-	// Discover if we're allowed to serve a nice html error page,
-	// or if we should just use a json. Normally we would want to
-	// check for a returned error, but if an error occurs here we
-	// can just fall back to default behavior (serve json error).
-	// Prefer provided offers, fall back to JSON or HTML.
-	accept, _ := NegotiateAccept(c, append(offers, JSONOrHTMLAcceptHeaders...)...)
-
-	if errWithCode.Code() == http.StatusNotFound {
-		// Use our special not found handler with useful status text.
-		NotFoundHandler(c, instanceGet, accept, errWithCode)
-	} else {
-		genericErrorHandler(c, instanceGet, accept, errWithCode)
-	}
+	return false
 }
 
 // WebErrorHandler is like ErrorHandler, but will display HTML over JSON by default.
@@ -182,6 +198,7 @@ func OAuthErrorHandler(c *gin.Context, errWithCode gtserror.WithCode) {
 	JSON(c, statusCode, apimodel.Error{
 		Error:            errWithCode.Error(),
 		ErrorDescription: errWithCode.Safe(),
+		RequestID:        gtscontext.RequestID(c.Request.Context()),
 	})
 }
 
@@ -190,7 +207,8 @@ func OAuthErrorHandler(c *gin.Context, errWithCode gtserror.WithCode) {
 func NotFoundAfterMove(c *gin.Context) {
 	const errMsg = "your account has Moved or is currently Moving; you cannot use this endpoint"
 	JSON(c, http.StatusForbidden, apimodel.Error{
-		Error: errMsg,
+		Error:     errMsg,
+		ErrorCode: gtserror.ErrCodeAccountMoved,
 	})
 }
 
@@ -199,6 +217,35 @@ func NotFoundAfterMove(c *gin.Context) {
 func ForbiddenAfterMove(c *gin.Context) {
 	const errMsg = "your account has Moved or is currently Moving; you cannot take create or update type actions"
 	JSON(c, http.StatusForbidden, apimodel.Error{
-		Error: errMsg,
+		Error:     errMsg,
+		ErrorCode: gtserror.ErrCodeAccountMoved,
+	})
+}
+
+// ProblemErrorHandler is like ErrorHandler, but serves errWithCode
+// to the caller as an RFC 7807 application/problem+json body, see:
+// https://www.rfc-editor.org/rfc/rfc7807.
+//
+// It's intended for federation (ActivityPub) endpoints, where the
+// caller is a remote instance, to give remote admins a chance at
+// diagnosing interop problems. The problem reason is taken from
+// errWithCode.ErrCode().
+func ProblemErrorHandler(c *gin.Context, errWithCode gtserror.WithCode) {
+	if abortOnContextErr(c, errWithCode) {
+		return
+	}
+
+	// Set the error on the gin context so that it can be logged
+	// in the gin logger middleware (internal/middleware/logger.go).
+	c.Error(errWithCode) //nolint:errcheck
+
+	code := errWithCode.Code()
+	JSONType(c, code, AppProblemJSON, apimodel.Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(code),
+		Status:    code,
+		Detail:    errWithCode.Safe(),
+		Reason:    errWithCode.ErrCode(),
+		RequestID: gtscontext.RequestID(c.Request.Context()),
 	})
 }
@@ -36,9 +36,9 @@ func ParseFocus(focus string) (focusx, focusy float32, errWithCode gtserror.With
 	spl := strings.Split(focus, ",")
 	if len(spl) != 2 {
 		const text = "missing comma separator"
-		errWithCode = gtserror.NewErrorBadRequest(
-			errors.New(text),
-			text,
+		errWithCode = gtserror.WithErrCode(
+			gtserror.NewErrorBadRequest(errors.New(text), text),
+			gtserror.ErrCodeParamInvalid,
 		)
 		return
 	}
@@ -47,18 +47,18 @@ func ParseFocus(focus string) (focusx, focusy float32, errWithCode gtserror.With
 	fx, err := strconv.ParseFloat(xStr, 32)
 	if err != nil || fx > 1 || fx < -1 {
 		text := fmt.Sprintf("invalid x focus: %s", xStr)
-		errWithCode = gtserror.NewErrorBadRequest(
-			errors.New(text),
-			text,
+		errWithCode = gtserror.WithErrCode(
+			gtserror.NewErrorBadRequest(errors.New(text), text),
+			gtserror.ErrCodeParamInvalid,
 		)
 		return
 	}
 	fy, err := strconv.ParseFloat(yStr, 32)
 	if err != nil || fy > 1 || fy < -1 {
 		text := fmt.Sprintf("invalid y focus: %s", xStr)
-		errWithCode = gtserror.NewErrorBadRequest(
-			errors.New(text),
-			text,
+		errWithCode = gtserror.WithErrCode(
+			gtserror.NewErrorBadRequest(errors.New(text), text),
+			gtserror.ErrCodeParamInvalid,
 		)
 		return
 	}
@@ -19,6 +19,7 @@
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -44,6 +45,7 @@
 	AccountIDKey       = "account_id"
 	TargetAccountIDKey = "target_account_id"
 	ResolvedKey        = "resolved"
+	ApprovedKey        = "approved"
 
 	/* AP endpoint keys */
 
@@ -54,6 +56,7 @@
 	SearchExcludeUnreviewedKey = "exclude_unreviewed"
 	SearchFollowingKey         = "following"
 	SearchLookupKey            = "acct"
+	SearchNotesKey             = "notes"
 	SearchOffsetKey            = "offset"
 	SearchQueryKey             = "q"
 	SearchResolveKey           = "resolve"
@@ -63,6 +66,11 @@
 
 	TagNameKey = "tag_name"
 
+	/* Announcement keys */
+
+	AnnouncementWithDismissedKey = "with_dismissed"
+	AnnouncementReactionNameKey  = "name"
+
 	/* Domain permission keys */
 
 	DomainPermissionExportKey         = "export"
@@ -73,23 +81,38 @@
 
 	/* Admin query keys */
 
-	AdminRemoteKey      = "remote"
-	AdminActiveKey      = "active"
-	AdminPendingKey     = "pending"
-	AdminDisabledKey    = "disabled"
-	AdminSilencedKey    = "silenced"
-	AdminSuspendedKey   = "suspended"
-	AdminSensitizedKey  = "sensitized"
-	AdminDisplayNameKey = "display_name"
-	AdminByDomainKey    = "by_domain"
-	AdminEmailKey       = "email"
-	AdminIPKey          = "ip"
-	AdminStaffKey       = "staff"
-	AdminOriginKey      = "origin"
-	AdminStatusKey      = "status"
-	AdminPermissionsKey = "permissions"
-	AdminRoleIDsKey     = "role_ids[]"
-	AdminInvitedByKey   = "invited_by"
+	AdminRemoteKey        = "remote"
+	AdminActiveKey        = "active"
+	AdminPendingKey       = "pending"
+	AdminDisabledKey      = "disabled"
+	AdminSilencedKey      = "silenced"
+	AdminSuspendedKey     = "suspended"
+	AdminSensitizedKey    = "sensitized"
+	AdminDisplayNameKey   = "display_name"
+	AdminByDomainKey      = "by_domain"
+	AdminEmailKey         = "email"
+	AdminIPKey            = "ip"
+	AdminEmailDomainKey   = "email_domain"
+	AdminCreatedAfterKey  = "created_after"
+	AdminCreatedBeforeKey = "created_before"
+	AdminStaffKey         = "staff"
+	AdminOriginKey        = "origin"
+	AdminStatusKey        = "status"
+	AdminPermissionsKey   = "permissions"
+	AdminRoleIDsKey       = "role_ids[]"
+	AdminInvitedByKey     = "invited_by"
+	AdminSoftwareKey      = "software"
+	AdminVersionKey       = "version"
+
+	/* Failed activity keys */
+
+	FailedActivityTypeKey   = "activity_type"
+	FailedActivityReasonKey = "reason"
+
+	/* Federation stats keys */
+
+	FederationStatsSortKey  = "sort"
+	FederationStatsOrderKey = "order"
 
 	/* Interaction policy + request keys */
 
@@ -101,6 +124,7 @@
 	/* Web view keys */
 
 	WebIncludeBoostsKey = "include_boosts"
+	WebSitemapPageKey   = "page"
 )
 
 /*
@@ -148,6 +172,10 @@ func ParseResolved(value string, defaultValue *bool) (*bool, gtserror.WithCode)
 	return parseBoolPtr(value, defaultValue, ResolvedKey)
 }
 
+func ParseApproved(value string, defaultValue *bool) (*bool, gtserror.WithCode) {
+	return parseBoolPtr(value, defaultValue, ApprovedKey)
+}
+
 func ParseSearchExcludeUnreviewed(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, SearchExcludeUnreviewedKey)
 }
@@ -156,6 +184,10 @@ func ParseSearchFollowing(value string, defaultValue bool) (bool, gtserror.WithC
 	return parseBool(value, defaultValue, SearchFollowingKey)
 }
 
+func ParseSearchNotes(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, SearchNotesKey)
+}
+
 func ParseSearchOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
 	return parseInt(value, defaultValue, max, min, SearchOffsetKey)
 }
@@ -220,6 +252,14 @@ func ParseWebIncludeBoosts(value string, defaultValue *bool) (*bool, gtserror.Wi
 	return parseBoolPtr(value, defaultValue, WebIncludeBoostsKey)
 }
 
+func ParseWebSitemapPage(value string) (int, gtserror.WithCode) {
+	return parseInt(value, 0, math.MaxInt, 0, WebSitemapPageKey)
+}
+
+func ParseAnnouncementWithDismissed(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, AnnouncementWithDismissedKey)
+}
+
 /*
 	Parse functions for *REQUIRED* parameters.
 */
@@ -241,7 +281,10 @@ func ParseAPIVersion(value string, availableVersion ...string) (string, gtserror
 		"invalid API version, valid versions for this path are [%s]",
 		strings.Join(availableVersion, ", "),
 	)
-	return "", gtserror.NewErrorBadRequest(err, err.Error())
+	return "", gtserror.WithErrCode(
+		gtserror.NewErrorBadRequest(err, err.Error()),
+		gtserror.ErrCodeParamInvalid,
+	)
 }
 
 func ParseID(value string) (string, gtserror.WithCode) {
@@ -287,6 +330,16 @@ func ParseTagName(value string) (string, gtserror.WithCode) {
 	return value, nil
 }
 
+func ParseAnnouncementReactionName(value string) (string, gtserror.WithCode) {
+	key := AnnouncementReactionNameKey
+
+	if value == "" {
+		return "", requiredError(key)
+	}
+
+	return value, nil
+}
+
 func ParseUsername(value string) (string, gtserror.WithCode) {
 	key := UsernameKey
 
@@ -355,12 +408,18 @@ func parseInt(value string, defaultValue int, max int, min int, key string) (int
 // to the caller that a key was set to a value that could not be parsed.
 func parseError(key string, value, defaultValue any, err error) gtserror.WithCode {
 	err = fmt.Errorf("error parsing key %s with value %s as %T: %w", key, value, defaultValue, err)
-	return gtserror.NewErrorBadRequest(err, err.Error())
+	return gtserror.WithErrCode(
+		gtserror.NewErrorBadRequest(err, err.Error()),
+		gtserror.ErrCodeParamInvalid,
+	)
 }
 
 // requiredError returns gtserror.WithCode set to 400 Bad Request, to indicate
 // to the caller a required key value was not provided, or was empty.
 func requiredError(key string) gtserror.WithCode {
 	err := fmt.Errorf("required key %s was not set or had empty value", key)
-	return gtserror.NewErrorBadRequest(err, err.Error())
+	return gtserror.WithErrCode(
+		gtserror.NewErrorBadRequest(err, err.Error()),
+		gtserror.ErrCodeParamRequired,
+	)
 }
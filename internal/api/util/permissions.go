@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"errors"
+	"fmt"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// UserPermissions returns the set of permissions
+// granted to the given user by their role. A nil
+// user is treated as having no permissions.
+func UserPermissions(user *gtsmodel.User) apimodel.AccountRolePermissions {
+	switch {
+	case user == nil:
+		return apimodel.AccountRolePermissionsNone
+	case *user.Admin:
+		return apimodel.AccountRolePermissionsForAdminRole
+	case *user.Moderator:
+		return apimodel.AccountRolePermissionsForModeratorRole
+	default:
+		return apimodel.AccountRolePermissionsNone
+	}
+}
+
+// HasPermissions returns whether the given user has
+// all of the given permissions. Admins, having the
+// Administrator permission, always have permission.
+func HasPermissions(user *gtsmodel.User, perms apimodel.AccountRolePermissions) bool {
+	granted := UserPermissions(user)
+	if granted&apimodel.AccountRolePermissionsAdministrator != 0 {
+		return true
+	}
+	return granted&perms == perms
+}
+
+// RequirePermissions returns a 403 Forbidden error if the
+// authed user does not have all of the given permissions.
+// This should be called after TokenAuth with requireUser.
+func RequirePermissions(authed *Auth, perms apimodel.AccountRolePermissions) gtserror.WithCode {
+	if authed == nil || authed.User == nil {
+		const text = "no authorized user"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if !HasPermissions(authed.User, perms) {
+		err := fmt.Errorf("user %s does not have permission to perform this action", authed.User.ID)
+		return gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util_test
+
+import (
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	gtsutil "code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+func TestHasPermissions(t *testing.T) {
+	var (
+		admin = &gtsmodel.User{
+			Admin:     gtsutil.Ptr(true),
+			Moderator: gtsutil.Ptr(false),
+		}
+		moderator = &gtsmodel.User{
+			Admin:     gtsutil.Ptr(false),
+			Moderator: gtsutil.Ptr(true),
+		}
+		user = &gtsmodel.User{
+			Admin:     gtsutil.Ptr(false),
+			Moderator: gtsutil.Ptr(false),
+		}
+	)
+
+	for _, test := range []struct {
+		Name   string
+		User   *gtsmodel.User
+		Perms  apimodel.AccountRolePermissions
+		Expect bool
+	}{
+		{"admin settings", admin, apimodel.AccountRolePermissionsManageSettings, true},
+		{"admin administrator", admin, apimodel.AccountRolePermissionsAdministrator, true},
+		{"moderator reports", moderator, apimodel.AccountRolePermissionsManageReports, true},
+		{"moderator users", moderator, apimodel.AccountRolePermissionsManageUsers, true},
+		{"moderator appeals", moderator, apimodel.AccountRolePermissionsManageAppeals, true},
+		{"moderator reports and users", moderator, apimodel.AccountRolePermissionsManageReports | apimodel.AccountRolePermissionsManageUsers, true},
+		{"moderator settings", moderator, apimodel.AccountRolePermissionsManageSettings, false},
+		{"moderator federation", moderator, apimodel.AccountRolePermissionsManageFederation, false},
+		{"moderator reports and federation", moderator, apimodel.AccountRolePermissionsManageReports | apimodel.AccountRolePermissionsManageFederation, false},
+		{"moderator administrator", moderator, apimodel.AccountRolePermissionsAdministrator, false},
+		{"user reports", user, apimodel.AccountRolePermissionsManageReports, false},
+		{"nil user reports", nil, apimodel.AccountRolePermissionsManageReports, false},
+	} {
+		if got := util.HasPermissions(test.User, test.Perms); got != test.Expect {
+			t.Errorf("%s: expected %t, got %t", test.Name, test.Expect, got)
+		}
+	}
+}
@@ -27,6 +27,7 @@
 	"sync"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"codeberg.org/gruf/go-byteutil"
 	"codeberg.org/gruf/go-fastcopy"
 	"github.com/gin-gonic/gin"
@@ -47,10 +48,15 @@
 		"status": http.StatusText(http.StatusInternalServerError),
 	})
 	ErrorCapacityExceeded = mustJSON(map[string]string{
-		"error": "server capacity exceeded",
+		"error":      "server capacity exceeded",
+		"error_code": gtserror.ErrCodeCapacityExceeded,
 	})
 	ErrorRateLimited = mustJSON(map[string]string{
-		"error": "rate limit reached",
+		"error":      "rate limit reached",
+		"error_code": gtserror.ErrCodeRateLimited,
+	})
+	ErrorDraining = mustJSON(map[string]string{
+		"error": "server is shutting down",
 	})
 	EmptyJSONObject = json.RawMessage(`{}`)
 	EmptyJSONArray  = json.RawMessage(`[]`)
@@ -26,25 +26,33 @@
 const (
 	/* Sub-scopes / scope components */
 
-	scopeAccounts      = "accounts"
-	scopeApplications  = "applications"
-	scopeBlocks        = "blocks"
-	scopeBookmarks     = "bookmarks"
-	scopeConversations = "conversations"
-	scopeCustomEmojis  = "custom_emojis"
-	scopeDomainAllows  = "domain_allows"
-	scopeDomainBlocks  = "domain_blocks"
-	scopeDomainLimits  = "domain_limits"
-	scopeFavourites    = "favourites"
-	scopeFilters       = "filters"
-	scopeFollows       = "follows"
-	scopeLists         = "lists"
-	scopeMedia         = "media"
-	scopeMutes         = "mutes"
-	scopeNotifications = "notifications"
-	scopeReports       = "reports"
-	scopeSearch        = "search"
-	scopeStatuses      = "statuses"
+	scopeAccounts          = "accounts"
+	scopeAnnouncements     = "announcements"
+	scopeApplications      = "applications"
+	scopeBlocks            = "blocks"
+	scopeBookmarks         = "bookmarks"
+	scopeConversations     = "conversations"
+	scopeCustomEmojis      = "custom_emojis"
+	scopeDebug             = "debug"
+	scopeDomainAllows      = "domain_allows"
+	scopeDomainBlocks      = "domain_blocks"
+	scopeDomainLimits      = "domain_limits"
+	scopeDomainPermissions = "domain_permissions"
+	scopeFavourites        = "favourites"
+	scopeFederation        = "federation"
+	scopeFilters           = "filters"
+	scopeFollows           = "follows"
+	scopeHeaderFilters     = "header_filters"
+	scopeInstance          = "instance"
+	scopeInvites           = "invites"
+	scopeLists             = "lists"
+	scopeMedia             = "media"
+	scopeMutes             = "mutes"
+	scopeNotifications     = "notifications"
+	scopeReports           = "reports"
+	scopeSearch            = "search"
+	scopeStatuses          = "statuses"
+	scopeTags              = "tags"
 
 	/* Top-level scopes */
 
@@ -58,45 +66,62 @@
 
 	/* Granular scopes */
 
-	ScopeReadAccounts           Scope = ScopeRead + ":" + scopeAccounts
-	ScopeWriteAccounts          Scope = ScopeWrite + ":" + scopeAccounts
-	ScopeReadApplications       Scope = ScopeRead + ":" + scopeApplications
-	ScopeWriteApplications      Scope = ScopeWrite + ":" + scopeApplications
-	ScopeReadBlocks             Scope = ScopeRead + ":" + scopeBlocks
-	ScopeWriteBlocks            Scope = ScopeWrite + ":" + scopeBlocks
-	ScopeReadBookmarks          Scope = ScopeRead + ":" + scopeBookmarks
-	ScopeWriteBookmarks         Scope = ScopeWrite + ":" + scopeBookmarks
-	ScopeWriteConversations     Scope = ScopeWrite + ":" + scopeConversations
-	ScopeReadCustomEmojis       Scope = ScopeRead + ":" + scopeCustomEmojis
-	ScopeReadFavourites         Scope = ScopeRead + ":" + scopeFavourites
-	ScopeWriteFavourites        Scope = ScopeWrite + ":" + scopeFavourites
-	ScopeReadFilters            Scope = ScopeRead + ":" + scopeFilters
-	ScopeWriteFilters           Scope = ScopeWrite + ":" + scopeFilters
-	ScopeReadFollows            Scope = ScopeRead + ":" + scopeFollows
-	ScopeWriteFollows           Scope = ScopeWrite + ":" + scopeFollows
-	ScopeReadLists              Scope = ScopeRead + ":" + scopeLists
-	ScopeWriteLists             Scope = ScopeWrite + ":" + scopeLists
-	ScopeWriteMedia             Scope = ScopeWrite + ":" + scopeMedia
-	ScopeReadMutes              Scope = ScopeRead + ":" + scopeMutes
-	ScopeWriteMutes             Scope = ScopeWrite + ":" + scopeMutes
-	ScopeReadNotifications      Scope = ScopeRead + ":" + scopeNotifications
-	ScopeWriteNotifications     Scope = ScopeWrite + ":" + scopeNotifications
-	ScopeWriteReports           Scope = ScopeWrite + ":" + scopeReports
-	ScopeReadSearch             Scope = ScopeRead + ":" + scopeSearch
-	ScopeReadStatuses           Scope = ScopeRead + ":" + scopeStatuses
-	ScopeWriteStatuses          Scope = ScopeWrite + ":" + scopeStatuses
-	ScopeAdminReadAccounts      Scope = ScopeAdminRead + ":" + scopeAccounts
-	ScopeAdminWriteAccounts     Scope = ScopeAdminWrite + ":" + scopeAccounts
-	ScopeAdminReadCustomEmojis  Scope = ScopeAdminRead + ":" + scopeCustomEmojis
-	ScopeAdminWriteCustomEmojis Scope = ScopeAdminWrite + ":" + scopeCustomEmojis
-	ScopeAdminReadDomainAllows  Scope = ScopeAdminRead + ":" + scopeDomainAllows
-	ScopeAdminWriteDomainAllows Scope = ScopeAdminWrite + ":" + scopeDomainAllows
-	ScopeAdminReadDomainBlocks  Scope = ScopeAdminRead + ":" + scopeDomainBlocks
-	ScopeAdminWriteDomainBlocks Scope = ScopeAdminWrite + ":" + scopeDomainBlocks
-	ScopeAdminReadDomainLimits  Scope = ScopeAdminRead + ":" + scopeDomainLimits
-	ScopeAdminWriteDomainLimits Scope = ScopeAdminWrite + ":" + scopeDomainLimits
-	ScopeAdminReadReports       Scope = ScopeAdminRead + ":" + scopeReports
-	ScopeAdminWriteReports      Scope = ScopeAdminWrite + ":" + scopeReports
+	ScopeReadAccounts                Scope = ScopeRead + ":" + scopeAccounts
+	ScopeWriteAccounts               Scope = ScopeWrite + ":" + scopeAccounts
+	ScopeReadApplications            Scope = ScopeRead + ":" + scopeApplications
+	ScopeWriteApplications           Scope = ScopeWrite + ":" + scopeApplications
+	ScopeReadBlocks                  Scope = ScopeRead + ":" + scopeBlocks
+	ScopeWriteBlocks                 Scope = ScopeWrite + ":" + scopeBlocks
+	ScopeReadBookmarks               Scope = ScopeRead + ":" + scopeBookmarks
+	ScopeWriteBookmarks              Scope = ScopeWrite + ":" + scopeBookmarks
+	ScopeWriteConversations          Scope = ScopeWrite + ":" + scopeConversations
+	ScopeReadCustomEmojis            Scope = ScopeRead + ":" + scopeCustomEmojis
+	ScopeReadFavourites              Scope = ScopeRead + ":" + scopeFavourites
+	ScopeWriteFavourites             Scope = ScopeWrite + ":" + scopeFavourites
+	ScopeReadFilters                 Scope = ScopeRead + ":" + scopeFilters
+	ScopeWriteFilters                Scope = ScopeWrite + ":" + scopeFilters
+	ScopeReadFollows                 Scope = ScopeRead + ":" + scopeFollows
+	ScopeWriteFollows                Scope = ScopeWrite + ":" + scopeFollows
+	ScopeReadLists                   Scope = ScopeRead + ":" + scopeLists
+	ScopeWriteLists                  Scope = ScopeWrite + ":" + scopeLists
+	ScopeWriteMedia                  Scope = ScopeWrite + ":" + scopeMedia
+	ScopeReadMutes                   Scope = ScopeRead + ":" + scopeMutes
+	ScopeWriteMutes                  Scope = ScopeWrite + ":" + scopeMutes
+	ScopeReadNotifications           Scope = ScopeRead + ":" + scopeNotifications
+	ScopeWriteNotifications          Scope = ScopeWrite + ":" + scopeNotifications
+	ScopeWriteReports                Scope = ScopeWrite + ":" + scopeReports
+	ScopeReadSearch                  Scope = ScopeRead + ":" + scopeSearch
+	ScopeReadStatuses                Scope = ScopeRead + ":" + scopeStatuses
+	ScopeWriteStatuses               Scope = ScopeWrite + ":" + scopeStatuses
+	ScopeAdminReadAccounts           Scope = ScopeAdminRead + ":" + scopeAccounts
+	ScopeAdminWriteAccounts          Scope = ScopeAdminWrite + ":" + scopeAccounts
+	ScopeAdminReadAnnouncements      Scope = ScopeAdminRead + ":" + scopeAnnouncements
+	ScopeAdminWriteAnnouncements     Scope = ScopeAdminWrite + ":" + scopeAnnouncements
+	ScopeAdminReadCustomEmojis       Scope = ScopeAdminRead + ":" + scopeCustomEmojis
+	ScopeAdminWriteCustomEmojis      Scope = ScopeAdminWrite + ":" + scopeCustomEmojis
+	ScopeAdminReadDebug              Scope = ScopeAdminRead + ":" + scopeDebug
+	ScopeAdminWriteDebug             Scope = ScopeAdminWrite + ":" + scopeDebug
+	ScopeAdminReadDomainAllows       Scope = ScopeAdminRead + ":" + scopeDomainAllows
+	ScopeAdminWriteDomainAllows      Scope = ScopeAdminWrite + ":" + scopeDomainAllows
+	ScopeAdminReadDomainBlocks       Scope = ScopeAdminRead + ":" + scopeDomainBlocks
+	ScopeAdminWriteDomainBlocks      Scope = ScopeAdminWrite + ":" + scopeDomainBlocks
+	ScopeAdminReadDomainLimits       Scope = ScopeAdminRead + ":" + scopeDomainLimits
+	ScopeAdminWriteDomainLimits      Scope = ScopeAdminWrite + ":" + scopeDomainLimits
+	ScopeAdminReadDomainPermissions  Scope = ScopeAdminRead + ":" + scopeDomainPermissions
+	ScopeAdminWriteDomainPermissions Scope = ScopeAdminWrite + ":" + scopeDomainPermissions
+	ScopeAdminReadFederation         Scope = ScopeAdminRead + ":" + scopeFederation
+	ScopeAdminWriteFederation        Scope = ScopeAdminWrite + ":" + scopeFederation
+	ScopeAdminReadHeaderFilters      Scope = ScopeAdminRead + ":" + scopeHeaderFilters
+	ScopeAdminWriteHeaderFilters     Scope = ScopeAdminWrite + ":" + scopeHeaderFilters
+	ScopeAdminReadInstance           Scope = ScopeAdminRead + ":" + scopeInstance
+	ScopeAdminWriteInstance          Scope = ScopeAdminWrite + ":" + scopeInstance
+	ScopeAdminReadInvites            Scope = ScopeAdminRead + ":" + scopeInvites
+	ScopeAdminWriteInvites           Scope = ScopeAdminWrite + ":" + scopeInvites
+	ScopeAdminWriteMedia             Scope = ScopeAdminWrite + ":" + scopeMedia
+	ScopeAdminReadReports            Scope = ScopeAdminRead + ":" + scopeReports
+	ScopeAdminWriteReports           Scope = ScopeAdminWrite + ":" + scopeReports
+	ScopeAdminReadTags               Scope = ScopeAdminRead + ":" + scopeTags
+	ScopeAdminWriteTags              Scope = ScopeAdminWrite + ":" + scopeTags
 )
 
 // Permits returns true if the
@@ -94,6 +94,31 @@ func TestScopes(t *testing.T) {
 			WantsScope: util.ScopePush,
 			Expect:     false,
 		},
+		{
+			HasScope:   util.ScopeAdminRead,
+			WantsScope: util.ScopeAdminReadInstance,
+			Expect:     true,
+		},
+		{
+			HasScope:   util.ScopeAdminRead,
+			WantsScope: util.ScopeAdminWriteInstance,
+			Expect:     false,
+		},
+		{
+			HasScope:   util.ScopeAdminReadInstance,
+			WantsScope: util.ScopeAdminWriteInstance,
+			Expect:     false,
+		},
+		{
+			HasScope:   util.ScopeAdminWriteFederation,
+			WantsScope: util.ScopeAdminWriteDomainBlocks,
+			Expect:     false,
+		},
+		{
+			HasScope:   util.ScopeAdminReadAnnouncements,
+			WantsScope: util.ScopeAdminRead,
+			Expect:     false,
+		},
 		{
 			HasScope:   util.Scope("p"),
 			WantsScope: util.ScopePush,
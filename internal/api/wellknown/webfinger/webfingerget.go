@@ -23,7 +23,6 @@
 	"net/http"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
-	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/gin-gonic/gin"
@@ -74,13 +73,7 @@ func (m *Module) WebfingerGETRequest(c *gin.Context) {
 		return
 	}
 
-	if requestedHost != config.GetHost() && requestedHost != config.GetAccountDomain() {
-		err := fmt.Errorf("requested host %s does not belong to this instance", requestedHost)
-		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
-		return
-	}
-
-	resp, errWithCode := m.processor.Fedi().WebfingerGet(c.Request.Context(), requestedUser)
+	resp, errWithCode := m.processor.Fedi().WebfingerGet(c.Request.Context(), requestedUser, requestedHost)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -27,6 +27,7 @@
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/api/wellknown/webfinger"
@@ -81,6 +82,19 @@ func (suite *WebfingerGetTestSuite) finger(requestPath string) string {
 	return dst.String()
 }
 
+func (suite *WebfingerGetTestSuite) fingerCode(requestPath string) int {
+	// Set up the request.
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, requestPath, nil)
+	ctx.Request.Header.Set("accept", "application/jrd+json")
+
+	// Trigger the handler
+	// + return status code.
+	suite.webfingerModule.WebfingerGETRequest(ctx)
+	return recorder.Code
+}
+
 func (suite *WebfingerGetTestSuite) funkifyAccountDomain(host string, accountDomain string) *gtsmodel.Account {
 	// Reset suite structs + config
 	// to new host + account domain.
@@ -99,6 +113,8 @@ func (suite *WebfingerGetTestSuite) funkifyAccountDomain(host string, accountDom
 		&suite.state,
 		suite.emailSender,
 		testrig.NewNoopWebPushSender(),
+		testrig.NewTestTranslator(),
+		testrig.NewTestDescriber(),
 		visibility.NewFilter(&suite.state),
 		mutes.NewFilter(&suite.state),
 		interaction.NewFilter(&suite.state),
@@ -260,6 +276,130 @@ func (suite *WebfingerGetTestSuite) TestFingerUserWithDifferentAccountDomainByAc
 }`, resp)
 }
 
+func (suite *WebfingerGetTestSuite) TestFingerUserByAccountDomainAlias() {
+	config.SetAccountDomainAliases([]string{"brand-a.com", "brand-b.com"})
+
+	targetAccount := suite.testAccounts["local_account_1"]
+	requestPath := fmt.Sprintf("/%s?resource=acct:%s@%s", webfinger.WebfingerBasePath, targetAccount.Username, "brand-b.com")
+
+	// Subject should still be the canonical
+	// acct, with the vanity acct as an alias.
+	resp := suite.finger(requestPath)
+	suite.Equal(`{
+  "subject": "acct:the_mighty_zork@localhost:8080",
+  "aliases": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/@the_mighty_zork",
+    "acct:the_mighty_zork@brand-b.com"
+  ],
+  "links": [
+    {
+      "rel": "http://webfinger.net/rel/profile-page",
+      "type": "text/html",
+      "href": "http://localhost:8080/@the_mighty_zork"
+    },
+    {
+      "rel": "self",
+      "type": "application/activity+json",
+      "href": "http://localhost:8080/users/the_mighty_zork"
+    }
+  ]
+}`, resp)
+}
+
+func (suite *WebfingerGetTestSuite) TestFingerUserByAccountDomainAliasForAccount() {
+	config.SetAccountDomainAliases([]string{"brand-a.com", "the_mighty_zork@brand-b.com"})
+
+	targetAccount := suite.testAccounts["local_account_1"]
+	requestPath := fmt.Sprintf("/%s?resource=acct:%s@%s", webfinger.WebfingerBasePath, targetAccount.Username, "brand-b.com")
+
+	// Zork is covered by the alias.
+	resp := suite.finger(requestPath)
+	suite.Equal(`{
+  "subject": "acct:the_mighty_zork@localhost:8080",
+  "aliases": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/@the_mighty_zork",
+    "acct:the_mighty_zork@brand-b.com"
+  ],
+  "links": [
+    {
+      "rel": "http://webfinger.net/rel/profile-page",
+      "type": "text/html",
+      "href": "http://localhost:8080/@the_mighty_zork"
+    },
+    {
+      "rel": "self",
+      "type": "application/activity+json",
+      "href": "http://localhost:8080/users/the_mighty_zork"
+    }
+  ]
+}`, resp)
+
+	// Other accounts can't be found at brand-b.com,
+	// but can still be found at the unscoped alias.
+	requestPath = fmt.Sprintf("/%s?resource=acct:%s@%s", webfinger.WebfingerBasePath, "1happyturtle", "brand-b.com")
+	suite.Equal(http.StatusNotFound, suite.fingerCode(requestPath))
+
+	requestPath = fmt.Sprintf("/%s?resource=acct:%s@%s", webfinger.WebfingerBasePath, "1happyturtle", "brand-a.com")
+	suite.Equal(http.StatusOK, suite.fingerCode(requestPath))
+}
+
+func (suite *WebfingerGetTestSuite) TestFingerUserByCustomDomain() {
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	// Give zork a verified custom domain,
+	// which isn't approved by an admin yet.
+	domain := &gtsmodel.AccountCustomDomain{
+		ID:         "01JCQ4B6MC4Y4ZJ9T2A2Y4K6WS",
+		AccountID:  targetAccount.ID,
+		Domain:     "zork.example.org",
+		VerifiedAt: time.Now(),
+	}
+	if err := suite.db.PutAccountCustomDomain(suite.T().Context(), domain); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestPath := fmt.Sprintf("/%s?resource=acct:%s@%s", webfinger.WebfingerBasePath, targetAccount.Username, "zork.example.org")
+
+	// Not approved, so zork
+	// can't be found there yet.
+	suite.Equal(http.StatusBadRequest, suite.fingerCode(requestPath))
+
+	domain.ApprovedAt = time.Now()
+	if err := suite.db.UpdateAccountCustomDomain(suite.T().Context(), domain, "approved_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Subject should still be the canonical
+	// acct, with the vanity acct as an alias.
+	resp := suite.finger(requestPath)
+	suite.Equal(`{
+  "subject": "acct:the_mighty_zork@localhost:8080",
+  "aliases": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/@the_mighty_zork",
+    "acct:the_mighty_zork@zork.example.org"
+  ],
+  "links": [
+    {
+      "rel": "http://webfinger.net/rel/profile-page",
+      "type": "text/html",
+      "href": "http://localhost:8080/@the_mighty_zork"
+    },
+    {
+      "rel": "self",
+      "type": "application/activity+json",
+      "href": "http://localhost:8080/users/the_mighty_zork"
+    }
+  ]
+}`, resp)
+
+	// Other accounts can't be found at zork's domain.
+	requestPath = fmt.Sprintf("/%s?resource=acct:%s@%s", webfinger.WebfingerBasePath, "1happyturtle", "zork.example.org")
+	suite.Equal(http.StatusNotFound, suite.fingerCode(requestPath))
+}
+
 func (suite *WebfingerGetTestSuite) TestFingerUserWithoutAcct() {
 	// Leave out the 'acct:' part in the request path;
 	// the handler should be generous + still work OK.
@@ -21,7 +21,9 @@
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/cache/headerfilter"
+	"code.superseriousbusiness.org/gotosocial/internal/cache/webfinger"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"codeberg.org/gruf/go-cache/v3/ttl"
@@ -46,6 +48,21 @@ type Caches struct {
 	// used in timeline lookups and streaming.
 	Timelines TimelineCaches
 
+	// AccountUsage stores recently calculated account
+	// storage usage reports, as these require a few
+	// aggregate queries that are expensive to repeat.
+	AccountUsage *ttl.Cache[string, *apimodel.AccountUsage] // TTL=10min, sweep=5min
+
+	// AccountActivity stores recently calculated account
+	// activity heatmaps, keyed by account ID and day, as
+	// these require an aggregate query over a year of statuses.
+	AccountActivity *ttl.Cache[string, []*apimodel.AccountActivityDay] // TTL=1hr, sweep=5min
+
+	// Idempotency stores results of requests made with
+	// an idempotency key, so that retries of the same
+	// request can be given the result of the original.
+	Idempotency *ttl.Cache[string, any] // TTL=1hr, sweep=5min
+
 	// Mutes provides access to the item mutes
 	// cache. (used by the item mutes filter).
 	Mutes StructCache[*CachedMute]
@@ -54,6 +71,10 @@ type Caches struct {
 	// cache. (used by the status-filter results filter).
 	StatusFilter StructCache[*CachedStatusFilterResults]
 
+	// StatusRepliesBackfill marks remote status URIs whose
+	// replies were recently backfilled, to enforce cooldown.
+	StatusRepliesBackfill *ttl.Cache[string, struct{}] // TTL=configured cooldown, sweep=5min
+
 	// Visibility provides access to the item visibility
 	// cache. (used by the visibility filter).
 	Visibility StructCache[*CachedVisibility]
@@ -61,6 +82,10 @@ type Caches struct {
 	// Webfinger provides access to the webfinger URL cache.
 	Webfinger *ttl.Cache[string, string] // TTL=24hr, sweep=5min
 
+	// WebfingerResults provides access to the webfinger
+	// lookup results cache, including failed lookups.
+	WebfingerResults webfinger.Cache
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -78,6 +103,7 @@ func (c *Caches) Init() {
 	c.initBlock()
 	c.initBlockIDs()
 	c.initBoostOfIDs()
+	c.initCard()
 	c.initConversation()
 	c.initConversationLastStatusIDs()
 	c.initDomainAllow()
@@ -129,6 +155,7 @@ func (c *Caches) Init() {
 	c.initTag()
 	c.initTagTimelines()
 	c.initThreadMute()
+	c.initThreadSubscription()
 	c.initToken()
 	c.initTombstone()
 	c.initUser()
@@ -137,8 +164,12 @@ func (c *Caches) Init() {
 	c.initWebfinger()
 	c.initWebPushSubscription()
 	c.initWebPushSubscriptionIDs()
+	c.initIdempotency()
+	c.initAccountUsage()
+	c.initAccountActivity()
 	c.initMutes()
 	c.initStatusFilter()
+	c.initStatusRepliesBackfill()
 	c.initVisibility()
 }
 
@@ -151,6 +182,22 @@ func (c *Caches) Start() error {
 		return gtserror.New("could not start webfinger cache")
 	}
 
+	if !c.StatusRepliesBackfill.Start(5 * time.Minute) {
+		return gtserror.New("could not start status replies backfill cache")
+	}
+
+	if !c.Idempotency.Start(5 * time.Minute) {
+		return gtserror.New("could not start idempotency cache")
+	}
+
+	if !c.AccountUsage.Start(5 * time.Minute) {
+		return gtserror.New("could not start account usage cache")
+	}
+
+	if !c.AccountActivity.Start(5 * time.Minute) {
+		return gtserror.New("could not start account activity cache")
+	}
+
 	return nil
 }
 
@@ -162,6 +209,22 @@ func (c *Caches) Stop() {
 	if c.Webfinger != nil {
 		_ = c.Webfinger.Stop()
 	}
+
+	if c.StatusRepliesBackfill != nil {
+		_ = c.StatusRepliesBackfill.Stop()
+	}
+
+	if c.Idempotency != nil {
+		_ = c.Idempotency.Stop()
+	}
+
+	if c.AccountUsage != nil {
+		_ = c.AccountUsage.Stop()
+	}
+
+	if c.AccountActivity != nil {
+		_ = c.AccountActivity.Stop()
+	}
 }
 
 // Sweep will sweep all the available caches to ensure none
@@ -179,6 +242,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.Block.Trim(threshold)
 	c.DB.BlockIDs.Trim(threshold)
 	c.DB.BoostOfIDs.Trim(threshold)
+	c.DB.Card.Trim(threshold)
 	c.DB.Conversation.Trim(threshold)
 	c.DB.ConversationLastStatusIDs.Trim(threshold)
 	c.DB.Emoji.Trim(threshold)
@@ -216,6 +280,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.StatusFaveIDs.Trim(threshold)
 	c.DB.Tag.Trim(threshold)
 	c.DB.ThreadMute.Trim(threshold)
+	c.DB.ThreadSubscription.Trim(threshold)
 	c.DB.Token.Trim(threshold)
 	c.DB.Tombstone.Trim(threshold)
 	c.DB.User.Trim(threshold)
@@ -226,6 +291,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.Timelines.Home.Trim()
 	c.Timelines.List.Trim()
 	c.Visibility.Trim(threshold)
+	c.WebfingerResults.Trim(threshold)
 }
 
 func (c *Caches) initWebfinger() {
@@ -244,3 +310,72 @@ func (c *Caches) initWebfinger() {
 		24*time.Hour,
 	)
 }
+
+func (c *Caches) initStatusRepliesBackfill() {
+	// Calculate maximum cache size.
+	cap := calculateCacheMax(
+		sizeofURIStr, 0,
+		config.GetCacheStatusRepliesBackfillMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.StatusRepliesBackfill = new(ttl.Cache[string, struct{}])
+	c.StatusRepliesBackfill.Init(
+		0,
+		cap,
+		config.GetStatusesBackfillRepliesCooldown(),
+	)
+}
+
+func (c *Caches) initIdempotency() {
+	// Calculate maximum cache size, using
+	// a status as estimate of result size.
+	cap := calculateCacheMax(
+		sizeofResultKey, sizeofStatus(),
+		config.GetCacheIdempotencyMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.Idempotency = new(ttl.Cache[string, any])
+	c.Idempotency.Init(
+		0,
+		cap,
+		time.Hour,
+	)
+}
+
+func (c *Caches) initAccountUsage() {
+	// Calculate maximum cache size.
+	cap := calculateCacheMax(
+		sizeofIDStr, sizeofAccountUsage(),
+		config.GetCacheAccountUsageMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.AccountUsage = new(ttl.Cache[string, *apimodel.AccountUsage])
+	c.AccountUsage.Init(
+		0,
+		cap,
+		10*time.Minute,
+	)
+}
+
+func (c *Caches) initAccountActivity() {
+	// Calculate maximum cache size.
+	cap := calculateCacheMax(
+		sizeofIDStr, sizeofAccountActivity(),
+		config.GetCacheAccountActivityMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.AccountActivity = new(ttl.Cache[string, []*apimodel.AccountActivityDay])
+	c.AccountActivity.Init(
+		0,
+		cap,
+		time.Hour,
+	)
+}
@@ -0,0 +1,165 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"code.superseriousbusiness.org/gotosocial/internal/cache/domain"
+)
+
+// clearCache is implemented by the
+// cache wrapper types to fully empty.
+type clearCache interface{ clear() }
+
+func (c *SliceCache[T]) clear()  { c.Trim(0) }
+func (c *StructCache[T]) clear() { c.Trim(0) }
+
+// ClearNames returns the sorted names of
+// all the caches accepted by ClearNamed().
+func (c *Caches) ClearNames() []string {
+	hooks := c.clearHooks()
+	names := make([]string, 0, len(hooks))
+	for name := range hooks {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ClearNamed clears the cache with given name, as returned
+// by ClearNames(), e.g. "domain-block" or "visibility". This
+// allows clearing a single cache rather than all of them at
+// once, avoiding a thundering herd of database reloads.
+//
+// The keyed timeline caches additionally accept an optional
+// key suffix, to clear only one timeline rather than all of
+// them, e.g. "timelines.home:<account-id>" or "timelines.list:<list-id>".
+func (c *Caches) ClearNamed(name string) error {
+	name, key, _ := strings.Cut(name, ":")
+	hook, ok := c.clearHooks()[name]
+	if !ok {
+		return fmt.Errorf("unknown cache: %s", name)
+	}
+	return hook(key)
+}
+
+// clearHooks returns a map of named
+// invalidation hooks for each cache,
+// each accepting an optional key.
+func (c *Caches) clearHooks() map[string]func(key string) error {
+	hooks := make(map[string]func(string) error)
+
+	// noKey wraps a hook func that
+	// doesn't support keyed clears.
+	noKey := func(name string, clear func()) {
+		hooks[name] = func(key string) error {
+			if key != "" {
+				return fmt.Errorf("cache %s does not support keyed clear", name)
+			}
+			clear()
+			return nil
+		}
+	}
+
+	// Gather the database caches by
+	// reflection, so that newly added
+	// caches are picked up automatically.
+	dbv := reflect.ValueOf(&c.DB).Elem()
+	dbt := dbv.Type()
+	for i := 0; i < dbt.NumField(); i++ {
+		name := kebab(dbt.Field(i).Name)
+		switch v := dbv.Field(i).Addr().Interface().(type) {
+		case clearCache:
+			noKey(name, v.clear)
+		case **domain.Cache:
+			if *v != nil {
+				noKey(name, (*v).Clear)
+			}
+		}
+	}
+
+	// Add the remaining non-database caches.
+	noKey("allow-header-filters", c.AllowHeaderFilters.Clear)
+	noKey("block-header-filters", c.BlockHeaderFilters.Clear)
+	noKey("mutes", c.Mutes.clear)
+	noKey("status-filter", c.StatusFilter.clear)
+	noKey("visibility", c.Visibility.clear)
+	noKey("webfinger-results", c.WebfingerResults.Clear)
+	if c.AccountUsage != nil {
+		noKey("account-usage", c.AccountUsage.Clear)
+	}
+	if c.AccountActivity != nil {
+		noKey("account-activity", c.AccountActivity.Clear)
+	}
+	if c.Idempotency != nil {
+		noKey("idempotency", c.Idempotency.Clear)
+	}
+	if c.Webfinger != nil {
+		noKey("webfinger", c.Webfinger.Clear)
+	}
+	if c.StatusRepliesBackfill != nil {
+		noKey("status-replies-backfill", c.StatusRepliesBackfill.Clear)
+	}
+	noKey("timelines.public", c.Timelines.Public.Clear)
+	noKey("timelines.local", c.Timelines.Local.Clear)
+
+	// Keyed timeline caches clear all
+	// their timelines if no key given.
+	for name, tls := range map[string]interface {
+		Clear(string)
+		ClearAll()
+	}{
+		"timelines.home": &c.Timelines.Home,
+		"timelines.list": &c.Timelines.List,
+		"timelines.tag":  &c.Timelines.Tag,
+	} {
+		hooks[name] = func(key string) error {
+			if key == "" {
+				tls.ClearAll()
+			} else {
+				tls.Clear(key)
+			}
+			return nil
+		}
+	}
+
+	return hooks
+}
+
+// kebab converts a Go field name to kebab-case,
+// e.g. "DomainBlock" => "domain-block" and
+// "BlockIDs" => "block-ids".
+func kebab(name string) string {
+	name = strings.ReplaceAll(name, "IDs", "Ids")
+	var buf strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				buf.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache_test
+
+import (
+	"slices"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/cache"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+)
+
+func TestClearNamed(t *testing.T) {
+	testrig.InitTestConfig()
+
+	var caches cache.Caches
+	caches.Init()
+
+	names := caches.ClearNames()
+	for _, name := range []string{
+		"account",
+		"block-ids",
+		"domain-block",
+		"visibility",
+		"webfinger-results",
+		"timelines.home",
+	} {
+		if !slices.Contains(names, name) {
+			t.Fatalf("expected cache name %s in %v", name, names)
+		}
+	}
+
+	caches.DB.Account.Put(&gtsmodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"})
+	if caches.DB.Account.Len() != 1 {
+		t.Fatal("expected cached account")
+	}
+
+	if err := caches.ClearNamed("account"); err != nil {
+		t.Fatal(err)
+	}
+
+	if caches.DB.Account.Len() != 0 {
+		t.Fatal("expected account cache to be cleared")
+	}
+
+	if err := caches.ClearNamed("timelines.home:01F8MH1H7YV1Z7D2C8K2730QBF"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := caches.ClearNamed("account:01F8MH1H7YV1Z7D2C8K2730QBF"); err == nil {
+		t.Fatal("expected error on keyed clear of unkeyed cache")
+	}
+
+	if err := caches.ClearNamed("does-not-exist"); err == nil {
+		t.Fatal("expected error on unknown cache name")
+	}
+}
@@ -52,6 +52,9 @@ type DBCaches struct {
 	// BoostOfIDs provides access to the boost of IDs list database cache.
 	BoostOfIDs SliceCache[string]
 
+	// Card provides access to the gtsmodel Card database cache.
+	Card StructCache[*gtsmodel.Card]
+
 	// Conversation provides access to the gtsmodel Conversation database cache.
 	Conversation StructCache[*gtsmodel.Conversation]
 
@@ -85,6 +88,10 @@ type DBCaches struct {
 	// EmojiCategory provides access to the gtsmodel EmojiCategory database cache.
 	EmojiCategory StructCache[*gtsmodel.EmojiCategory]
 
+	// FailedActivityCounts caches the counts of stored
+	// failed activities, grouped by type and reason.
+	FailedActivityCounts atomic.Pointer[[]*gtsmodel.FailedActivityCount]
+
 	// Filter provides access to the gtsmodel Filter database cache.
 	Filter StructCache[*gtsmodel.Filter]
 
@@ -259,6 +266,9 @@ type DBCaches struct {
 	// ThreadMute provides access to the gtsmodel ThreadMute database cache.
 	ThreadMute StructCache[*gtsmodel.ThreadMute]
 
+	// ThreadSubscription provides access to the gtsmodel ThreadSubscription database cache.
+	ThreadSubscription StructCache[*gtsmodel.ThreadSubscription]
+
 	// Token provides access to the gtsmodel Token database cache.
 	Token StructCache[*gtsmodel.Token]
 
@@ -314,6 +324,7 @@ func (c *Caches) initAccount() {
 		a2.AlsoKnownAs = nil
 		a2.Move = nil
 		a2.MovedTo = nil
+		a2.Operator = nil
 		a2.Settings = nil
 		a2.Stats = nil
 
@@ -499,6 +510,39 @@ func (c *Caches) initBoostOfIDs() {
 	c.DB.BoostOfIDs.Init(0, cap)
 }
 
+func (c *Caches) initCard() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofCard(), // model in-mem size.
+		config.GetCacheCardMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(c1 *gtsmodel.Card) *gtsmodel.Card {
+		c2 := new(gtsmodel.Card)
+		*c2 = *c1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/card.go.
+		c2.ImageAttachment = nil
+
+		return c2
+	}
+
+	c.DB.Card.Init(structr.CacheConfig[*gtsmodel.Card]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "URL"},
+			{Fields: "ImageAttachmentID"},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initConversation() {
 	cap := calculateResultCacheMax(
 		sizeofConversation(), // model in-mem size.
@@ -1215,6 +1259,7 @@ func (c *Caches) initNotification() {
 		n2.Status = nil
 		n2.OriginAccount = nil
 		n2.TargetAccount = nil
+		n2.AccountWarning = nil
 
 		return n2
 	}
@@ -1431,6 +1476,7 @@ func (c *Caches) initStatus() {
 		s2.BoostOf = nil
 		s2.BoostOfAccount = nil
 		s2.Poll = nil
+		s2.Card = nil
 		s2.Attachments = nil
 		s2.Tags = nil
 		s2.Mentions = nil
@@ -1639,6 +1685,33 @@ func (c *Caches) initThreadMute() {
 	})
 }
 
+func (c *Caches) initThreadSubscription() {
+	cap := calculateResultCacheMax(
+		sizeofThreadSubscription(), // model in-mem size.
+		config.GetCacheThreadSubscriptionMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(t1 *gtsmodel.ThreadSubscription) *gtsmodel.ThreadSubscription {
+		t2 := new(gtsmodel.ThreadSubscription)
+		*t2 = *t1
+		return t2
+	}
+
+	c.DB.ThreadSubscription.Init(structr.CacheConfig[*gtsmodel.ThreadSubscription]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "ThreadID", Multiple: true},
+			{Fields: "AccountID", Multiple: true},
+			{Fields: "ThreadID,AccountID"},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initToken() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
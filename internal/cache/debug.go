@@ -0,0 +1,96 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import "codeberg.org/gruf/go-structr"
+
+// StatusCacheEntries contains the cache entries currently
+// stored for a single requester + status pair, across the
+// visibility, mute and status filter result caches.
+type StatusCacheEntries struct {
+
+	// Visibility contains any cached visibility
+	// lookup results for the status, and for
+	// the status author's account.
+	Visibility []*CachedVisibility
+
+	// Mute contains any cached mute
+	// lookup result for the status.
+	Mute *CachedMute
+
+	// Filter contains any cached status
+	// filter lookup result for the status.
+	Filter *CachedStatusFilterResults
+}
+
+// statusVisibilityTypes are the visibility lookup
+// types that may be cached with a status as item.
+var statusVisibilityTypes = []VisibilityType{
+	VisibilityTypeStatus,
+	VisibilityTypeHome,
+	VisibilityTypePublic,
+}
+
+// GetStatusEntries returns the cache entries currently stored for the
+// given requester, status and status author IDs. This does not perform
+// any loads on cache miss, nor does it count towards cache statistics,
+// so it can be used to inspect cache state when debugging stale results.
+func (c *Caches) GetStatusEntries(requesterID, statusID, authorID string) StatusCacheEntries {
+	var entries StatusCacheEntries
+
+	// Look for cached status visibility results.
+	visIdx := c.Visibility.Index("Type,RequesterID,ItemID")
+	for _, vtype := range statusVisibilityTypes {
+		key := structr.MakeKey(vtype, requesterID, statusID)
+		if v, ok := c.Visibility.Cache.GetOne(visIdx, key); ok {
+			entries.Visibility = append(entries.Visibility, v)
+		}
+	}
+
+	// Look for cached status author account visibility result.
+	key := structr.MakeKey(VisibilityTypeAccount, requesterID, authorID)
+	if v, ok := c.Visibility.Cache.GetOne(visIdx, key); ok {
+		entries.Visibility = append(entries.Visibility, v)
+	}
+
+	// Look for cached status mute result.
+	muteIdx := c.Mutes.Index("RequesterID,StatusID")
+	if m, ok := c.Mutes.Cache.GetOne(muteIdx, structr.MakeKey(requesterID, statusID)); ok {
+		entries.Mute = m
+	}
+
+	// Look for cached status filter results.
+	filterIdx := c.StatusFilter.Index("RequesterID,StatusID")
+	if r, ok := c.StatusFilter.Cache.GetOne(filterIdx, structr.MakeKey(requesterID, statusID)); ok {
+		entries.Filter = r
+	}
+
+	return entries
+}
+
+// InvalidateStatusEntries invalidates only the cache entries for the
+// given requester, status and status author IDs, as would be returned
+// by GetStatusEntries(), leaving all other cache entries untouched.
+func (c *Caches) InvalidateStatusEntries(requesterID, statusID, authorID string) {
+	for _, vtype := range statusVisibilityTypes {
+		c.Visibility.Invalidate("Type,RequesterID,ItemID", vtype, requesterID, statusID)
+	}
+	c.Visibility.Invalidate("Type,RequesterID,ItemID", VisibilityTypeAccount, requesterID, authorID)
+	c.Mutes.Invalidate("RequesterID,StatusID", requesterID, statusID)
+	c.StatusFilter.Invalidate("RequesterID,StatusID", requesterID, statusID)
+}
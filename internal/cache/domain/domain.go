@@ -22,6 +22,9 @@
 	"slices"
 	"strings"
 	"sync/atomic"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/cache/stats"
 )
 
 // Cache provides a means of caching domains in memory to reduce
@@ -37,6 +40,10 @@
 type Cache struct {
 	// current domain cache radix trie.
 	rootptr atomic.Pointer[root]
+
+	// Stats tracks cache hits,
+	// misses and reload durations.
+	Stats stats.Stats
 }
 
 func (c *Cache) hydrate(load func() ([]string, error)) (*root, error) {
@@ -47,13 +54,17 @@ func (c *Cache) hydrate(load func() ([]string, error)) (*root, error) {
 	if ptr != nil {
 		// Cache already hydrated,
 		// nothing else to do.
+		c.Stats.Hit(1)
 		return ptr, nil
 	}
 
 	// Cache is not hydrated.
-	//
+	c.Stats.Miss(1)
+
 	// Load domains from callback.
+	start := time.Now()
 	domains, err := load()
+	c.Stats.Loaded(start)
 	if err != nil {
 		return nil, fmt.Errorf("error reloading cache: %w", err)
 	}
@@ -125,3 +125,39 @@ func TestMatchesOn(t *testing.T) {
 		}
 	}
 }
+
+func TestCacheStats(t *testing.T) {
+
+	// Create test domain cache.
+	c, loader := domainCache(t)
+
+	// First call hydrates the cache, next two are hits.
+	for _, domain := range []string{"google.com", "google.ie", "pleroma.bad.host"} {
+		if _, err := c.Matches(domain, loader); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if hits := c.Stats.Hits(); hits != 2 {
+		t.Fatalf("expected 2 hits, got %d", hits)
+	}
+	if misses := c.Stats.Misses(); misses != 1 {
+		t.Fatalf("expected 1 miss, got %d", misses)
+	}
+	if loads := c.Stats.Loads(); loads != 1 {
+		t.Fatalf("expected 1 load, got %d", loads)
+	}
+
+	// Clearing should cause a reload.
+	c.Clear()
+	if _, err := c.Matches("google.com", loader); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if misses := c.Stats.Misses(); misses != 2 {
+		t.Fatalf("expected 2 misses, got %d", misses)
+	}
+	if loads := c.Stats.Loads(); loads != 2 {
+		t.Fatalf("expected 2 loads, got %d", loads)
+	}
+}
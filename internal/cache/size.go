@@ -19,6 +19,7 @@
 
 import (
 	"crypto/rsa"
+	"net"
 	"regexp"
 	"strings"
 	"time"
@@ -183,6 +184,8 @@ func sizeofAccount() uintptr {
 		DisplayName:             exampleUsername,
 		Note:                    exampleText,
 		NoteRaw:                 exampleText,
+		Pronouns:                exampleUsername,
+		Location:                exampleUsername,
 		MemorializedAt:          exampleTime,
 		CreatedAt:               exampleTime,
 		UpdatedAt:               exampleTime,
@@ -198,6 +201,7 @@ func sizeofAccount() uintptr {
 		FollowingURI:            exampleURI,
 		FeaturedCollectionURI:   exampleURI,
 		ActorType:               gtsmodel.AccountActorTypePerson,
+		OperatorURI:             exampleURI,
 		PrivateKey:              &rsa.PrivateKey{},
 		PublicKey:               &rsa.PublicKey{},
 		PublicKeyURI:            exampleURI,
@@ -219,16 +223,20 @@ func sizeofAccountNote() uintptr {
 
 func sizeofAccountSettings() uintptr {
 	return uintptr(size.Of(&gtsmodel.AccountSettings{
-		AccountID:         exampleID,
-		CreatedAt:         exampleTime,
-		UpdatedAt:         exampleTime,
-		Privacy:           gtsmodel.VisibilityFollowersOnly,
-		Sensitive:         util.Ptr(true),
-		Language:          "fr",
-		StatusContentType: "text/plain",
-		CustomCSS:         exampleText,
-		EnableRSS:         util.Ptr(true),
-		HideCollections:   util.Ptr(false),
+		AccountID:              exampleID,
+		CreatedAt:              exampleTime,
+		UpdatedAt:              exampleTime,
+		Privacy:                gtsmodel.VisibilityFollowersOnly,
+		Sensitive:              util.Ptr(true),
+		Language:               "fr",
+		StatusContentType:      "text/plain",
+		CustomCSS:              exampleText,
+		EnableRSS:              util.Ptr(true),
+		RSSFullContent:         util.Ptr(true),
+		HideCollections:        util.Ptr(false),
+		PublishBlocks:          util.Ptr(false),
+		WebActivityHeatmap:     util.Ptr(false),
+		MediaDescriptionPolicy: gtsmodel.DescriptionPolicyRemind,
 	}))
 }
 
@@ -243,6 +251,29 @@ func sizeofAccountStats() uintptr {
 	}))
 }
 
+func sizeofAccountUsage() uintptr {
+	return uintptr(size.Of(&apimodel.AccountUsage{
+		AccountID:      exampleID,
+		StatusesCount:  100,
+		FollowersCount: 100,
+		FollowingCount: 100,
+		CalculatedAt:   exampleTime.Format(time.RFC3339),
+	}))
+}
+
+func sizeofAccountActivity() uintptr {
+	// Estimate a year of
+	// daily activity counts.
+	days := make([]*apimodel.AccountActivityDay, 366)
+	for i := range days {
+		days[i] = &apimodel.AccountActivityDay{
+			Day:      exampleTime.Format(time.DateOnly),
+			Statuses: 10,
+		}
+	}
+	return uintptr(size.Of(days))
+}
+
 func sizeofApplication() uintptr {
 	return uintptr(size.Of(&gtsmodel.Application{
 		ID:              exampleID,
@@ -267,6 +298,25 @@ func sizeofBlock() uintptr {
 	}))
 }
 
+func sizeofCard() uintptr {
+	return uintptr(size.Of(&gtsmodel.Card{
+		ID:                exampleID,
+		CreatedAt:         exampleTime,
+		UpdatedAt:         exampleTime,
+		URL:               exampleURI,
+		Title:             exampleTextSmall,
+		Description:       exampleText,
+		AuthorName:        exampleUsername,
+		AuthorURL:         exampleURI,
+		ProviderName:      exampleUsername,
+		ProviderURL:       exampleURI,
+		Image:             exampleURI,
+		ImageAttachmentID: exampleID,
+		Width:             640,
+		Height:            480,
+	}))
+}
+
 func sizeofConversation() uintptr {
 	return uintptr(size.Of(&gtsmodel.Conversation{
 		ID:               exampleID,
@@ -410,6 +460,7 @@ func sizeofFollowRequest() uintptr {
 		ShowReblogs:     func() *bool { ok := true; return &ok }(),
 		URI:             exampleURI,
 		Notify:          func() *bool { ok := false; return &ok }(),
+		Message:         exampleText,
 	}))
 }
 
@@ -632,10 +683,12 @@ func sizeofStatus() uintptr {
 		InReplyToAccountID:       exampleID,
 		BoostOfID:                exampleID,
 		BoostOfAccountID:         exampleID,
+		CardID:                   exampleID,
 		ContentWarning:           exampleUsername, // similar length
 		ContentWarningText:       exampleUsername, // similar length
 		Visibility:               gtsmodel.VisibilityPublic,
 		Sensitive:                func() *bool { ok := false; return &ok }(),
+		PreviewLinks:             func() *bool { ok := true; return &ok }(),
 		Language:                 "en",
 		CreatedWithApplicationID: exampleID,
 		Federated:                func() *bool { ok := true; return &ok }(),
@@ -652,6 +705,7 @@ func sizeofStatusBookmark() uintptr {
 		TargetAccount:   nil,
 		StatusID:        exampleID,
 		Status:          nil,
+		FolderID:        exampleID,
 		CreatedAt:       exampleTime,
 		UpdatedAt:       exampleTime,
 	}))
@@ -708,6 +762,7 @@ func sizeofTag() uintptr {
 		UpdatedAt: exampleTime,
 		Useable:   func() *bool { ok := true; return &ok }(),
 		Listable:  func() *bool { ok := true; return &ok }(),
+		AliasOfID: exampleID,
 	}))
 }
 
@@ -721,10 +776,22 @@ func sizeofThreadMute() uintptr {
 	}))
 }
 
+func sizeofThreadSubscription() uintptr {
+	return uintptr(size.Of(&gtsmodel.ThreadSubscription{
+		ID:        exampleID,
+		CreatedAt: exampleTime,
+		UpdatedAt: exampleTime,
+		ThreadID:  exampleID,
+		AccountID: exampleID,
+	}))
+}
+
 func sizeofToken() uintptr {
 	return uintptr(size.Of(&gtsmodel.Token{
 		ID:                  exampleID,
 		LastUsed:            exampleTime,
+		LastUsedIP:          net.IPv6loopback,
+		LastUsedUserAgent:   "Mozilla/5.0 (X11; Linux x86_64; rv:140.0) Gecko/20100101 Firefox/140.0",
 		ClientID:            exampleID,
 		UserID:              exampleID,
 		RedirectURI:         exampleURI,
@@ -0,0 +1,84 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"reflect"
+
+	"code.superseriousbusiness.org/gotosocial/internal/cache/domain"
+	"code.superseriousbusiness.org/gotosocial/internal/cache/stats"
+)
+
+// CacheStats contains the hit / miss / load statistics
+// of a named cache, along with its current length and
+// capacity where these are known (else they are -1).
+type CacheStats struct {
+	Name  string
+	Stats *stats.Stats
+	Len   int
+	Cap   int
+}
+
+// statsCache is implemented by
+// the cache wrapper types that
+// maintain cache statistics.
+type statsCache interface {
+	stats() *stats.Stats
+	Len() int
+	Cap() int
+}
+
+func (c *SliceCache[T]) stats() *stats.Stats  { return &c.Stats }
+func (c *StructCache[T]) stats() *stats.Stats { return &c.Stats }
+
+// Stats returns the statistics of each instrumented cache,
+// named by their field path within Caches{}, e.g. "DB.Account".
+func (c *Caches) Stats() []CacheStats {
+	var all []CacheStats
+
+	// Gather the database caches by
+	// reflection, so that newly added
+	// caches are picked up automatically.
+	dbv := reflect.ValueOf(&c.DB).Elem()
+	dbt := dbv.Type()
+	for i := 0; i < dbt.NumField(); i++ {
+		name := "DB." + dbt.Field(i).Name
+		switch v := dbv.Field(i).Addr().Interface().(type) {
+		case statsCache:
+			all = append(all, CacheStats{name, v.stats(), v.Len(), v.Cap()})
+		case **domain.Cache:
+			if *v != nil {
+				all = append(all, CacheStats{name, &(*v).Stats, -1, -1})
+			}
+		}
+	}
+
+	// Append the remaining non-database caches.
+	all = append(all,
+		CacheStats{"Mutes", &c.Mutes.Stats, c.Mutes.Len(), c.Mutes.Cap()},
+		CacheStats{"StatusFilter", &c.StatusFilter.Stats, c.StatusFilter.Len(), c.StatusFilter.Cap()},
+		CacheStats{"Visibility", &c.Visibility.Stats, c.Visibility.Len(), c.Visibility.Cap()},
+		CacheStats{"Timelines.Public", c.Timelines.Public.Stats(), -1, -1},
+		CacheStats{"Timelines.Local", c.Timelines.Local.Stats(), -1, -1},
+		CacheStats{"Timelines.Home", c.Timelines.Home.Stats(), -1, -1},
+		CacheStats{"Timelines.List", c.Timelines.List.Stats(), -1, -1},
+		CacheStats{"Timelines.Tag", c.Timelines.Tag.Stats(), -1, -1},
+	)
+
+	return all
+}
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package stats
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Stats provides concurrency-safe counters of cache
+// hits, misses and time spent in loader callbacks,
+// such that cache sizes can be tuned empirically.
+//
+// The zero value is ready to use.
+type Stats struct {
+	hits   atomic.Int64
+	misses atomic.Int64
+	loads  atomic.Int64
+	loadNs atomic.Int64
+}
+
+// Hit increments the cache hit count by n.
+func (s *Stats) Hit(n int) { s.hits.Add(int64(n)) }
+
+// Miss increments the cache miss count by n.
+func (s *Stats) Miss(n int) { s.misses.Add(int64(n)) }
+
+// Loaded records a single call to a cache loader
+// callback, that was started at the given time.
+func (s *Stats) Loaded(start time.Time) {
+	s.loads.Add(1)
+	s.loadNs.Add(int64(time.Since(start)))
+}
+
+// Hits returns the total cache hit count.
+func (s *Stats) Hits() int64 { return s.hits.Load() }
+
+// Misses returns the total cache miss count.
+func (s *Stats) Misses() int64 { return s.misses.Load() }
+
+// Loads returns the total number of loader callback calls.
+func (s *Stats) Loads() int64 { return s.loads.Load() }
+
+// LoadDuration returns the total time spent in loader callbacks.
+func (s *Stats) LoadDuration() time.Duration {
+	return time.Duration(s.loadNs.Load())
+}
+
+// HitRatio returns the fraction of cache lookups
+// that were hits, or zero if there were none.
+func (s *Stats) HitRatio() float64 {
+	hits := s.hits.Load()
+	total := hits + s.misses.Load()
+	if total == 0 {
+		return 0
+	}
+	return float64(hits) / float64(total)
+}
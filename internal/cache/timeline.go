@@ -21,6 +21,7 @@
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/cache/timeline"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
 
 type TimelineCaches struct {
@@ -45,6 +46,36 @@ type TimelineCaches struct {
 	Tag timeline.StatusTimelines
 }
 
+// StatusVisibilityChanged updates the instance-level public and local
+// timelines, and tag timelines, all of which only contain public statuses,
+// for a status that has had its visibility switched between public and
+// unlisted. Home and list timelines contain both, so are left alone.
+func (t *TimelineCaches) StatusVisibilityChanged(status *gtsmodel.Status) {
+	if status.Visibility != gtsmodel.VisibilityPublic {
+		// Status de-listed, drop it from
+		// public, local and tag timelines.
+		t.Public.RemoveByStatusIDs(status.ID)
+		t.Local.RemoveByStatusIDs(status.ID)
+		t.Tag.RemoveByStatusIDs(status.ID)
+		return
+	}
+
+	// Status made public, insert it into the public (and
+	// local) timelines. This is a no-op if the status is
+	// older than the current sliding window of either.
+	_ = t.Public.InsertOne(status)
+	if status.IsLocal() {
+		_ = t.Local.InsertOne(status)
+	}
+
+	// Tag timelines are keyed per tag and may
+	// not be loaded, so mark any of the status'
+	// tag timelines for reload on next use.
+	for _, tag := range status.Tags {
+		t.Tag.Clear(tag.CanonicalID())
+	}
+}
+
 func (c *Caches) initPublicTimeline() {
 	// TODO: configurable
 	cap := 800
@@ -20,12 +20,15 @@
 import (
 	"context"
 	"slices"
+	"strings"
+	"time"
 
 	"codeberg.org/gruf/go-structr"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gopkg/xslices"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/cache/stats"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
@@ -54,6 +57,14 @@ type StatusMeta struct {
 	// to it being a recently repeated boost.
 	repeatBoost bool
 
+	// language is the primary language subtag
+	// of the status, (or of the boosted status),
+	// determined once on creation of the entry
+	// so that timelines can be filtered by the
+	// requester's chosen languages without the
+	// need to load each status from the database.
+	language string
+
 	// loaded is a temporary field that may be
 	// set for a newly loaded timeline status
 	// so that statuses don't need to be loaded
@@ -104,6 +115,11 @@ type StatusTimeline struct {
 	// state of the timeline cache.
 	preloader preloader
 
+	// stats tracks timeline cache hits,
+	// misses and database load durations.
+	// this may be shared between timelines.
+	stats *stats.Stats
+
 	// fast-access cache indices.
 	idx_ID               *structr.Index //nolint:revive
 	idx_AccountID        *structr.Index //nolint:revive
@@ -146,6 +162,7 @@ func (t *StatusTimeline) Init(cap int) {
 				BoostOfID:        s.BoostOfID,
 				BoostOfAccountID: s.BoostOfAccountID,
 				repeatBoost:      s.repeatBoost,
+				language:         s.language,
 				loaded:           nil, // NEVER stored
 			}
 		},
@@ -161,8 +178,17 @@ func (t *StatusTimeline) Init(cap int) {
 	// cutoff threshold we trim to.
 	t.cut = int(0.60 * float64(cap))
 	t.max = cap
+
+	if t.stats == nil {
+		// Allocate stats if not
+		// set by a parent map.
+		t.stats = new(stats.Stats)
+	}
 }
 
+// Stats returns the timeline cache statistics.
+func (t *StatusTimeline) Stats() *stats.Stats { return t.stats }
+
 // Preload will fill with StatusTimeline{} cache with
 // the latest sliding window of status metadata for the
 // timeline type returned by database 'loadPage' function.
@@ -302,6 +328,11 @@ func (t *StatusTimeline) Load(
 	// filter performs filtering of returned statuses.
 	filter func(each *gtsmodel.Status) (delete bool),
 
+	// languageChosen determines whether statuses of given primary language
+	// subtag should be returned, this uses the language cached on timeline
+	// entries, so filtering doesn't require loading the statuses themselves.
+	languageChosen func(language string) bool,
+
 	// prepareAPI should prepare internal status model to frontend API model.
 	prepareAPI func(status *gtsmodel.Status) (apiStatus *apimodel.Status, err error),
 ) (
@@ -367,6 +398,13 @@ func (t *StatusTimeline) Load(
 		)
 
 		if len(metas) > 0 {
+			// Update nextPg cursor parameter for database query.
+			nextPageParams(nextPg, metas[len(metas)-1].ID, order)
+
+			// Drop cached entries in languages not chosen,
+			// before going to the trouble of loading them.
+			metas = doLanguageFilter(metas, languageChosen)
+
 			// Before we can do any filtering, we need
 			// to load status models for cached entries.
 			err = loadStatuses(metas, loadIDs)
@@ -374,9 +412,6 @@ func (t *StatusTimeline) Load(
 				return nil, "", "", gtserror.Newf("error loading statuses: %w", err)
 			}
 
-			// Update nextPg cursor parameter for database query.
-			nextPageParams(nextPg, metas[len(metas)-1].ID, order)
-
 			// Prepare frontend API models for
 			// the cached statuses. For now this
 			// also does its own extra filtering.
@@ -393,6 +428,12 @@ func (t *StatusTimeline) Load(
 	// statuses were found for page,
 	// we need to call to database.
 	if len(apiStatuses) < limit {
+		if t != nil {
+			// Only a miss where
+			// a cache exists.
+			t.stats.Miss(1)
+			defer t.stats.Loaded(time.Now())
+		}
 
 		// Pass to main timeline db load function.
 		apiStatuses, err = loadStatusTimeline(ctx,
@@ -401,11 +442,14 @@ func (t *StatusTimeline) Load(
 			apiStatuses,
 			loadPage,
 			filter,
+			languageChosen,
 			prepareAPI,
 		)
 		if err != nil {
 			return nil, "", "", err
 		}
+	} else if t != nil {
+		t.stats.Hit(1)
 	}
 
 	// Reset values.
@@ -444,6 +488,7 @@ func loadStatusTimeline(
 	apiStatuses []*apimodel.Status,
 	loadPage func(page *paging.Page) (statuses []*gtsmodel.Status, err error),
 	filter func(each *gtsmodel.Status) (delete bool),
+	languageChosen func(language string) bool,
 	prepareAPI func(status *gtsmodel.Status) (apiStatus *apimodel.Status, err error),
 ) (
 	[]*apimodel.Status,
@@ -502,6 +547,9 @@ func loadStatusTimeline(
 		// Convert to our interstitial meta type.
 		metas = toStatusMeta(metas[:0], statuses)
 
+		// Drop statuses in languages not chosen.
+		metas = doLanguageFilter(metas, languageChosen)
+
 		// Prepare frontend API models for
 		// the loaded statuses. For now this
 		// also does its own extra filtering.
@@ -562,6 +610,7 @@ func (t *StatusTimeline) InsertOne(status *gtsmodel.Status) (skip bool) {
 		BoostOfID:        status.BoostOfID,
 		BoostOfAccountID: status.BoostOfAccountID,
 		repeatBoost:      skip,
+		language:         statusLanguage(status),
 		loaded:           nil,
 	})
 
@@ -620,6 +669,49 @@ func (t *StatusTimeline) RemoveByAccountIDs(accountIDs ...string) {
 	t.cache.Invalidate(t.idx_BoostOfAccountID, keys...)
 }
 
+// CountNewer returns the number of cached timeline entries with
+// an ID greater (i.e. newer) than the given ID, not including any
+// repeat boosts, or entries in languages not chosen, which would be
+// hidden on load. The returned bool indicates whether this count
+// could be determined exactly from the cache, which is not the case
+// if the timeline has not been preloaded, or the given ID predates
+// the sliding window of cache.
+//
+// Note this does not perform the filtering done at prepare time
+// on load, so the count is only ever an approximation of the
+// number of statuses that would actually be shown on timeline.
+func (t *StatusTimeline) CountNewer(id string, languageChosen func(string) bool) (n int, exact bool) {
+	if !t.preloader.Check() {
+		// Nothing cached
+		// to count from.
+		return 0, false
+	}
+
+	// Iterate timeline descending (i.e. newest -> oldest),
+	// counting until we reach entry at or below given ID.
+	var total int
+	for _, value := range t.cache.RangeUnsafe(structr.Desc) {
+		if value.ID <= id {
+			exact = true
+			break
+		}
+		if !value.repeatBoost && (languageChosen == nil ||
+			languageChosen(value.language)) {
+			n++
+		}
+		total++
+	}
+
+	if !exact {
+		// Window contains nothing older than ID. If
+		// it's below cut-off length it must contain
+		// the entire timeline, so count is still exact.
+		exact = (total < t.cut)
+	}
+
+	return n, exact
+}
+
 // Trim will ensure that receiving timeline is less than or
 // equal in length to the given threshold percentage of the
 // timeline's preconfigured maximum capacity. This will always
@@ -729,11 +821,38 @@ func toStatusMeta(in []*StatusMeta, statuses []*gtsmodel.Status) []*StatusMeta {
 			AccountID:        s.AccountID,
 			BoostOfID:        s.BoostOfID,
 			BoostOfAccountID: s.BoostOfAccountID,
+			language:         statusLanguage(s),
 			loaded:           s,
 		}
 	})
 }
 
+// statusLanguage returns the primary language subtag of given
+// status, using that of the original status in case of a boost.
+func statusLanguage(s *gtsmodel.Status) string {
+	if s.BoostOf != nil {
+		s = s.BoostOf
+	}
+	language, _, _ := strings.Cut(s.Language, "-")
+	return language
+}
+
+// doLanguageFilter drops from given StatusMeta
+// objects those with a language not chosen.
+func doLanguageFilter(metas []*StatusMeta, languageChosen func(string) bool) []*StatusMeta {
+
+	// Check for provided
+	// language function.
+	if languageChosen == nil {
+		return metas
+	}
+
+	// Filter the provided input status metas.
+	return slices.DeleteFunc(metas, func(meta *StatusMeta) bool {
+		return !languageChosen(meta.language)
+	})
+}
+
 // doStatusFilter performs given filter function on provided statuses,
 func doStatusFilter(statuses []*gtsmodel.Status, filter func(*gtsmodel.Status) bool) []*gtsmodel.Status {
 
@@ -21,6 +21,7 @@
 	"sync/atomic"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/cache/stats"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
 
@@ -37,6 +38,11 @@ type StatusTimelines struct {
 	// new StatusTimeline{}
 	// init arguments.
 	cap int
+
+	// stats tracks cache hits, misses
+	// and load durations, shared across
+	// all of the contained timelines.
+	stats stats.Stats
 }
 
 // a simple wrapper around StatusTimeline
@@ -74,6 +80,7 @@ func (t *StatusTimelines) MustGet(key string) *StatusTimeline {
 
 		// Allocate new timeline.
 		tt = new(_StatusTimeline)
+		tt.stats = &t.stats
 		tt.Init(t.cap)
 
 		// Store timeline
@@ -95,6 +102,10 @@ func (t *StatusTimelines) MustGet(key string) *StatusTimeline {
 	return &tt.StatusTimeline
 }
 
+// Stats returns the cache statistics
+// aggregated across all timelines.
+func (t *StatusTimelines) Stats() *stats.Stats { return &t.stats }
+
 // InsertOne attempts to call StatusTimeline{}.InsertOne() on timeline under key, only if it exists.
 func (t *StatusTimelines) InsertOne(key string, status *gtsmodel.Status) bool {
 	if p := t.ptr.Load(); p != nil {
@@ -105,6 +116,16 @@ func (t *StatusTimelines) InsertOne(key string, status *gtsmodel.Status) bool {
 	return false
 }
 
+// CountNewer attempts to call StatusTimeline{}.CountNewer() on timeline under key, only if it exists.
+func (t *StatusTimelines) CountNewer(key string, id string, languageChosen func(string) bool) (n int, exact bool) {
+	if p := t.ptr.Load(); p != nil {
+		if tt := (*p)[key]; tt != nil {
+			return tt.CountNewer(id, languageChosen)
+		}
+	}
+	return 0, false
+}
+
 // Delete will delete the stored StatusTimeline{} under key, if any.
 func (t *StatusTimelines) Delete(key string) {
 	t.loadAndCAS(func(m map[string]*_StatusTimeline) (map[string]*_StatusTimeline, bool) {
@@ -26,6 +26,7 @@
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/gopkg/xslices"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
@@ -183,12 +184,81 @@ func TestStatusTimelineLoadLimit(t *testing.T) {
 		loadGeneratedStatusPage,
 		loadStatusIDsFrom(data),
 		nil, // no filtering
+		nil, // no language filtering
 		func(status *gtsmodel.Status) (*apimodel.Status, error) { return new(apimodel.Status), nil },
 	)
 	assert.NoError(t, err)
 	assert.Len(t, apiStatuses, page.Limit)
 }
 
+func TestStatusTimelineLoadLanguages(t *testing.T) {
+	var tt StatusTimeline
+	tt.Init(1000)
+
+	// Prepare new context for the duration of this test.
+	ctx, cncl := context.WithCancel(t.Context())
+	defer cncl()
+
+	// Copy the input test status data,
+	// alternating the cached languages.
+	var data []*StatusMeta
+	var expect []string
+	for i, meta := range testStatusMeta {
+		meta2 := new(StatusMeta)
+		*meta2 = *meta
+		if i%2 == 0 {
+			meta2.language = "en"
+			expect = append(expect, meta2.ID)
+		} else {
+			meta2.language = "de"
+		}
+		data = append(data, meta2)
+	}
+
+	// Insert test data into timeline.
+	_ = tt.cache.Insert(data...)
+
+	// Manually mark timeline as 'preloaded'.
+	tt.preloader.CheckPreload(func() error { return nil })
+
+	// Craft a new page for selection,
+	// with limit of all cached values.
+	page := new(paging.Page)
+	page.Min = paging.MinID(id.Lowest)
+	page.Max = paging.MaxID(id.Highest)
+	page.Limit = len(data)
+
+	// Wrap the status loader to track
+	// which cached entries get loaded.
+	var loaded []string
+	loadIDs := func(ids []string) ([]*gtsmodel.Status, error) {
+		loaded = append(loaded, ids...)
+		return loadStatusIDsFrom(data)(ids)
+	}
+
+	// Load crafted page from the cache
+	// with only "en" language chosen.
+	apiStatuses, _, _, err := tt.Load(ctx,
+		page,
+		func(*paging.Page) ([]*gtsmodel.Status, error) { return nil, nil },
+		loadIDs,
+		nil, // no filtering
+		func(language string) bool { return language == "en" },
+		func(status *gtsmodel.Status) (*apimodel.Status, error) {
+			return &apimodel.Status{ID: status.ID}, nil
+		},
+	)
+	assert.NoError(t, err)
+
+	// Only statuses in chosen language
+	// should be loaded, and returned.
+	assert.ElementsMatch(t, expect, loaded)
+	assert.ElementsMatch(t, expect, xslices.Gather(nil,
+		apiStatuses,
+		func(s *apimodel.Status) string { return s.ID },
+	))
+}
+
 func TestStatusTimelineRemove(t *testing.T) {
 	var tt StatusTimeline
 	tt.Init(1000)
@@ -298,6 +368,49 @@ func TestStatusTimelineInserts(t *testing.T) {
 	assert.True(t, repeatBoost2)
 }
 
+func TestStatusTimelineCountNewer(t *testing.T) {
+	var tt StatusTimeline
+	tt.Init(1000)
+
+	// Clone the input test status data.
+	data := slices.Clone(testStatusMeta)
+
+	// Insert test data into timeline.
+	_ = tt.cache.Insert(data...)
+
+	// Without preload, count cannot be exact.
+	_, exact := tt.CountNewer(minStatusID(data), nil)
+	assert.False(t, exact)
+
+	// Manually mark timeline as 'preloaded'.
+	tt.preloader.CheckPreload(func() error { return nil })
+
+	// Everything newer than min status.
+	n, exact := tt.CountNewer(minStatusID(data), nil)
+	assert.True(t, exact)
+	assert.Equal(t, len(data)-1, n)
+
+	// Nothing newer than max status.
+	n, exact = tt.CountNewer(maxStatusID(data), nil)
+	assert.True(t, exact)
+	assert.Equal(t, 0, n)
+
+	// Nothing counted when no languages are chosen.
+	n, exact = tt.CountNewer(minStatusID(data), func(string) bool { return false })
+	assert.True(t, exact)
+	assert.Equal(t, 0, n)
+
+	// Set manual cutoff so timeline
+	// appears to be a sliding window.
+	tt.cut = len(data)
+
+	// ID older than the cached window,
+	// should count all but not be exact.
+	n, exact = tt.CountNewer("00000000000000000000000000", nil)
+	assert.False(t, exact)
+	assert.Equal(t, len(data), n)
+}
+
 func TestStatusTimelineTrim(t *testing.T) {
 	var tt StatusTimeline
 	tt.Init(1000)
@@ -69,6 +69,22 @@ func (c *Caches) initVisibility() {
 	VisibilityTypePublic  = VisibilityType('p')
 )
 
+// String returns a readable name for the visibility lookup type.
+func (t VisibilityType) String() string {
+	switch t {
+	case VisibilityTypeAccount:
+		return "account"
+	case VisibilityTypeStatus:
+		return "status"
+	case VisibilityTypeHome:
+		return "home"
+	case VisibilityTypePublic:
+		return "public"
+	default:
+		return "unknown"
+	}
+}
+
 // CachedVisibility represents a
 // cached visibility lookup value.
 type CachedVisibility struct {
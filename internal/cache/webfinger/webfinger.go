@@ -0,0 +1,179 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webfinger
+
+import (
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// PositiveTTL is the time for which
+	// a successful webfinger result is cached.
+	PositiveTTL = time.Hour
+
+	// NegativeTTL is the time for which
+	// a failed webfinger result is cached.
+	NegativeTTL = 10 * time.Minute
+
+	// MaxEntries is the maximum number of
+	// results to keep before the cache
+	// is swapped out for a fresh one.
+	MaxEntries = 10000
+)
+
+// Result is a webfinger lookup result,
+// either successful or failed. When Err is
+// set, the remaining fields will be zero.
+type Result struct {
+	Username string   // discovered username
+	Domain   string   // discovered account domain
+	URI      *url.URL // discovered account URI
+	Err      error    // error on failed lookup
+}
+
+// Cache provides a means of caching webfinger lookup results
+// in memory, to reduce repeated outgoing webfinger requests
+// for the same account (eg., during mention-heavy ingest).
+//
+// Failed lookups are cached too (for a shorter period), so
+// accounts that repeatedly fail to resolve don't result in
+// a new outgoing request each time they're encountered.
+//
+// The in-memory results map is swapped out atomically for a
+// fresh one on .Clear(), or when it grows beyond MaxEntries.
+type Cache struct {
+	// current results map.
+	ptr atomic.Pointer[results]
+}
+
+type results struct {
+	mu sync.Mutex
+	m  map[string]entry
+}
+
+type entry struct {
+	res     Result
+	expires time.Time
+}
+
+// Get returns the cached result for the given
+// "username@host" key, if present and unexpired.
+func (c *Cache) Get(key string) (Result, bool) {
+	ptr := c.ptr.Load()
+	if ptr == nil {
+		// Not hydrated.
+		return Result{}, false
+	}
+
+	ptr.mu.Lock()
+	e, ok := ptr.m[key]
+	ptr.mu.Unlock()
+
+	if !ok || time.Now().After(e.expires) {
+		return Result{}, false
+	}
+
+	res := e.res
+	if res.URI != nil {
+		// Return a copy so callers
+		// can't modify the cached URI.
+		uri := *res.URI
+		res.URI = &uri
+	}
+
+	return res, true
+}
+
+// Set stores the given result under the given "username@host"
+// key, with a TTL depending on whether the lookup succeeded.
+func (c *Cache) Set(key string, res Result) {
+	ttl := PositiveTTL
+	if res.Err != nil {
+		ttl = NegativeTTL
+	}
+
+	if res.URI != nil {
+		// Store a copy so the caller
+		// can't modify the cached URI.
+		uri := *res.URI
+		res.URI = &uri
+	}
+
+	for {
+		ptr := c.ptr.Load()
+
+		if ptr == nil || ptr.len() >= MaxEntries {
+			// Not hydrated, or full. Try
+			// to swap in a fresh results map.
+			fresh := &results{m: make(map[string]entry)}
+			if !c.ptr.CompareAndSwap(ptr, fresh) {
+				// Someone else swapped
+				// first, try again.
+				continue
+			}
+			ptr = fresh
+		}
+
+		ptr.mu.Lock()
+		ptr.m[key] = entry{
+			res:     res,
+			expires: time.Now().Add(ttl),
+		}
+		ptr.mu.Unlock()
+		return
+	}
+}
+
+// Trim removes expired results from the cache. A
+// threshold of 0 clears all results from the cache,
+// (in keeping with Caches.Sweep() semantics).
+func (c *Cache) Trim(threshold float64) {
+	if threshold <= 0 {
+		c.Clear()
+		return
+	}
+
+	ptr := c.ptr.Load()
+	if ptr == nil {
+		// Not hydrated.
+		return
+	}
+
+	now := time.Now()
+
+	ptr.mu.Lock()
+	for key, e := range ptr.m {
+		if now.After(e.expires) {
+			delete(ptr.m, key)
+		}
+	}
+	ptr.mu.Unlock()
+}
+
+// Clear drops all cached results.
+func (c *Cache) Clear() { c.ptr.Store(nil) }
+
+func (r *results) len() int {
+	r.mu.Lock()
+	n := len(r.m)
+	r.mu.Unlock()
+	return n
+}
@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webfinger_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/cache/webfinger"
+)
+
+func TestCache(t *testing.T) {
+	var c webfinger.Cache
+
+	// Nothing cached yet.
+	if _, ok := c.Get("someone@example.org"); ok {
+		t.Fatal("expected no result in empty cache")
+	}
+
+	uri, _ := url.Parse("https://example.org/users/someone")
+	c.Set("someone@example.org", webfinger.Result{
+		Username: "someone",
+		Domain:   "example.org",
+		URI:      uri,
+	})
+
+	errFinger := errors.New("webfinger failed")
+	c.Set("nobody@example.org", webfinger.Result{Err: errFinger})
+
+	// Check positive result.
+	res, ok := c.Get("someone@example.org")
+	if !ok {
+		t.Fatal("expected cached result")
+	}
+	if res.Username != "someone" || res.Domain != "example.org" || res.URI.String() != uri.String() {
+		t.Fatalf("unexpected cached result: %+v", res)
+	}
+
+	// Modifying returned URI shouldn't modify cache.
+	res.URI.Host = "evil.example.org"
+	res, _ = c.Get("someone@example.org")
+	if res.URI.Host != "example.org" {
+		t.Fatalf("cached uri was modified: %s", res.URI)
+	}
+
+	// Check negative result.
+	res, ok = c.Get("nobody@example.org")
+	if !ok {
+		t.Fatal("expected cached failed result")
+	}
+	if !errors.Is(res.Err, errFinger) {
+		t.Fatalf("unexpected cached error: %v", res.Err)
+	}
+
+	// Trimming with non-zero threshold
+	// should keep unexpired results.
+	c.Trim(60)
+	if _, ok := c.Get("someone@example.org"); !ok {
+		t.Fatal("expected result to survive trim")
+	}
+
+	// Trimming with zero threshold clears.
+	c.Trim(0)
+	if _, ok := c.Get("someone@example.org"); ok {
+		t.Fatal("expected result to be cleared")
+	}
+	if _, ok := c.Get("nobody@example.org"); ok {
+		t.Fatal("expected failed result to be cleared")
+	}
+}
@@ -19,7 +19,9 @@
 
 import (
 	"slices"
+	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/cache/stats"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"codeberg.org/gruf/go-cache/v3/simple"
 	"codeberg.org/gruf/go-structr"
@@ -29,6 +31,10 @@
 // functions for fetching + caching slices of objects (e.g. IDs).
 type SliceCache[T any] struct {
 	simple.Cache[string, []T]
+
+	// Stats tracks cache hits,
+	// misses and load durations.
+	Stats stats.Stats
 }
 
 // Init initializes the cache with given length + capacity.
@@ -46,13 +52,18 @@ func (c *SliceCache[T]) Load(key string, load func() ([]T, error)) ([]T, error)
 		var err error
 
 		// Not cached, load!
+		c.Stats.Miss(1)
+		start := time.Now()
 		data, err = load()
+		c.Stats.Loaded(start)
 		if err != nil {
 			return nil, err
 		}
 
 		// Store the data.
 		c.Cache.Set(key, data)
+	} else {
+		c.Stats.Hit(1)
 	}
 
 	// Return data clone for safety.
@@ -72,6 +83,10 @@ func (c *SliceCache[T]) Invalidate(keys ...string) {
 type StructCache[StructType any] struct {
 	structr.Cache[StructType]
 	index map[string]*structr.Index
+
+	// Stats tracks cache hits,
+	// misses and load durations.
+	Stats stats.Stats
 }
 
 // Init initializes the cache with given structr.CacheConfig{}.
@@ -87,19 +102,39 @@ func (c *StructCache[T]) Init(config structr.CacheConfig[T]) {
 // GetOne calls structr.Cache{}.GetOne(), using a cached structr.Index{} by 'index' name.
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
 func (c *StructCache[T]) GetOne(index string, key ...any) (T, bool) {
-	return c.Cache.GetOne(c.index[index], structr.MakeKey(key...))
+	value, ok := c.Cache.GetOne(c.index[index], structr.MakeKey(key...))
+	if ok {
+		c.Stats.Hit(1)
+	} else {
+		c.Stats.Miss(1)
+	}
+	return value, ok
 }
 
 // Get calls structr.Cache{}.Get(), using a cached structr.Index{} by 'index' name.
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
 func (c *StructCache[T]) Get(index string, keys ...[]any) []T {
-	return c.Cache.Get(c.index[index], structr.MakeKeys(keys...)...)
+	values := c.Cache.Get(c.index[index], structr.MakeKeys(keys...)...)
+	c.Stats.Hit(len(values))
+	c.Stats.Miss(max(len(keys)-len(values), 0))
+	return values
 }
 
 // LoadOne calls structr.Cache{}.LoadOne(), using a cached structr.Index{} by 'index' name.
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
 func (c *StructCache[T]) LoadOne(index string, load func() (T, error), key ...any) (T, error) {
-	return c.Cache.LoadOne(c.index[index], structr.MakeKey(key...), load)
+	var missed bool
+	value, err := c.Cache.LoadOne(c.index[index], structr.MakeKey(key...), func() (T, error) {
+		missed = true
+		c.Stats.Miss(1)
+		start := time.Now()
+		defer c.Stats.Loaded(start)
+		return load()
+	})
+	if !missed {
+		c.Stats.Hit(1)
+	}
+	return value, err
 }
 
 // LoadIDs calls structr.Cache{}.Load(), using a cached structr.Index{} by 'index' name. Note: this also handles
@@ -119,7 +154,7 @@ func (c *StructCache[T]) LoadIDs(index string, ids []string, load func([]string)
 
 	// Pass loader callback with
 	// wrapper onto main cache load function.
-	return c.Cache.Load(c.index[index], keys,
+	return c.load(c.index[index], keys,
 		func(uncached []structr.Key) ([]T, error) {
 			uncachedIDs := make([]string, len(uncached))
 			for i := range uncached {
@@ -151,7 +186,7 @@ func (c *StructCache[T]) LoadIDs2Part(index string, id1 string, id2s []string, l
 	}
 
 	// Pass loader callback with wrapper onto main cache load function.
-	return c.Cache.Load(i, keys, func(uncached []structr.Key) ([]T, error) {
+	return c.load(i, keys, func(uncached []structr.Key) ([]T, error) {
 		uncachedIDs := make([]string, len(uncached))
 		for i := range uncached {
 			uncachedIDs[i] = uncached[i].Values()[1].(string)
@@ -160,6 +195,21 @@ func (c *StructCache[T]) LoadIDs2Part(index string, id1 string, id2s []string, l
 	})
 }
 
+// load wraps structr.Cache{}.Load() to record hits
+// and misses for the given keys in cache statistics.
+func (c *StructCache[T]) load(index *structr.Index, keys []structr.Key, load func([]structr.Key) ([]T, error)) ([]T, error) {
+	var uncachedLen int
+	values, err := c.Cache.Load(index, keys, func(uncached []structr.Key) ([]T, error) {
+		uncachedLen = len(uncached)
+		start := time.Now()
+		defer c.Stats.Loaded(start)
+		return load(uncached)
+	})
+	c.Stats.Hit(len(keys) - uncachedLen)
+	c.Stats.Miss(uncachedLen)
+	return values, err
+}
+
 // Invalidate calls structr.Cache{}.Invalidate(), using a cached structr.Index{} by 'index' name.
 // Note: this also handles conversion of the untyped (any) keys to structr.Key{} via structr.Index{}.
 func (c *StructCache[T]) Invalidate(index string, key ...any) {
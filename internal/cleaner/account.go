@@ -0,0 +1,138 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+)
+
+// Accounts encompasses a set
+// of account cleanup utils.
+type Accounts struct{ Cleaner }
+
+// LogPurgeSuspended performs Accounts.PurgeSuspended(...), logging the start and outcome.
+// Any error is returned as well as logged.
+func (a *Accounts) LogPurgeSuspended(ctx context.Context, suspendedBefore time.Time) error {
+	log.Infof(ctx, "start suspended before: %s", suspendedBefore.Format(time.Stamp))
+	n, err := a.PurgeSuspended(ctx, suspendedBefore)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+	log.Infof(ctx, "purged: %d", n)
+	return nil
+}
+
+// PurgeSuspended enqueues deletion of the data of all local accounts
+// that were suspended before the given time, but whose data was kept
+// around for the suspension grace period, returning the number purged.
+// Context will be checked for `gtscontext.DryRun()` in order to
+// actually perform the action.
+func (a *Accounts) PurgeSuspended(ctx context.Context, suspendedBefore time.Time) (int, error) {
+	var (
+		total   int
+		sinceID string
+	)
+
+	for {
+		// Fetch the next batch of suspended accounts. Deletion
+		// happens asynchronously, so page through using sinceID.
+		accounts, err := a.state.DB.GetSuspendedLocalAccounts(ctx,
+			suspendedBefore,
+			sinceID,
+			selectLimit,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting suspended accounts: %w", err)
+		}
+
+		if len(accounts) == 0 {
+			// reached end.
+			break
+		}
+
+		// Use last as the next 'since' value.
+		sinceID = accounts[len(accounts)-1].ID
+
+		for _, account := range accounts {
+			if gtscontext.DryRun(ctx) {
+				// Dry run, don't
+				// delete anything.
+				total++
+				continue
+			}
+
+			// Origin of the delete is whoever
+			// suspended the account, falling
+			// back to the instance account.
+			origin, err := a.suspensionOrigin(ctx, account)
+			if err != nil {
+				return total, err
+			}
+
+			// Enqueue deletion of the account,
+			// which also federates the delete.
+			a.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+				APObjectType:   ap.ActorPerson,
+				APActivityType: ap.ActivityDelete,
+				Origin:         origin,
+				Target:         account,
+			})
+
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+// suspensionOrigin returns the account that
+// originated the suspension of given account,
+// or the instance account if it's unavailable.
+func (a *Accounts) suspensionOrigin(ctx context.Context, account *gtsmodel.Account) (*gtsmodel.Account, error) {
+	if account.SuspensionOrigin != "" {
+		origin, err := a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			account.SuspensionOrigin,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("error getting suspension origin %s: %w", account.SuspensionOrigin, err)
+		}
+
+		if origin != nil {
+			return origin, nil
+		}
+	}
+
+	origin, err := a.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return nil, gtserror.Newf("error getting instance account: %w", err)
+	}
+
+	return origin, nil
+}
@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestAccountsPurgeSuspended() {
+	suite.testAccountsPurgeSuspended(suite.T().Context())
+}
+
+func (suite *CleanerTestSuite) TestAccountsPurgeSuspendedDryRun() {
+	suite.testAccountsPurgeSuspended(gtscontext.SetDryRun(suite.T().Context()))
+}
+
+func (suite *CleanerTestSuite) testAccountsPurgeSuspended(ctx context.Context) {
+	var (
+		accounts        = testrig.NewTestAccounts()
+		now             = time.Now()
+		suspendedBefore = now.Add(-7 * 24 * time.Hour)
+	)
+
+	// Local account suspended
+	// before the grace period.
+	stale := accounts["local_account_1"]
+	stale.SuspendedAt = suspendedBefore.Add(-time.Hour)
+	stale.SuspensionOrigin = accounts["admin_account"].ID
+
+	// Local account suspended
+	// within the grace period.
+	fresh := accounts["local_account_2"]
+	fresh.SuspendedAt = now
+	fresh.SuspensionOrigin = accounts["admin_account"].ID
+
+	// Remote account suspended before
+	// grace period, not ours to purge.
+	remote := accounts["remote_account_1"]
+	remote.SuspendedAt = suspendedBefore.Add(-time.Hour)
+
+	if err := suite.state.DB.UpdateAccount(ctx, stale, "suspended_at", "suspension_origin"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := suite.state.DB.UpdateAccount(ctx, fresh, "suspended_at", "suspension_origin"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := suite.state.DB.UpdateAccount(ctx, remote, "suspended_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	n, err := suite.cleaner.Accounts().PurgeSuspended(ctx, suspendedBefore)
+	suite.NoError(err)
+	suite.Equal(1, n)
+
+	// Deletion of the stale account should
+	// be enqueued, unless this was only a dry run.
+	msg, ok := suite.state.Workers.Client.Queue.Pop()
+	if gtscontext.DryRun(ctx) {
+		suite.False(ok)
+		return
+	}
+
+	suite.True(ok)
+	suite.Equal(ap.ActivityDelete, msg.APActivityType)
+	suite.Equal(stale.ID, msg.Target.ID)
+	suite.Equal(stale.SuspensionOrigin, msg.Origin.ID)
+
+	// Nothing else should be enqueued.
+	_, ok = suite.state.Workers.Client.Queue.Pop()
+	suite.False(ok)
+}
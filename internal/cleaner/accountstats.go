@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// AccountStats encompasses a set of
+// account stats maintenance utils.
+type AccountStats struct{ Cleaner }
+
+// RecountResult summarizes the outcome of AccountStats.Recount(...).
+type RecountResult struct {
+	Checked int // Number of account stats checked.
+	Drifted int // Number of account stats whose counts had drifted.
+	Failed  int // Number of account stats that could not be recounted.
+
+	// Total absolute drift of each
+	// count, across all accounts.
+	Followers      int
+	Following      int
+	FollowRequests int
+	Statuses       int
+	StatusesPinned int
+}
+
+// LogRecount performs AccountStats.Recount(...), logging the start and outcome.
+// Any error is returned as well as logged.
+func (a *AccountStats) LogRecount(ctx context.Context) error {
+	log.Info(ctx, "start")
+	res, err := a.Recount(ctx)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+	log.Infof(ctx,
+		"checked: %d, drifted: %d, failed: %d (followers: %d, following: %d, follow requests: %d, statuses: %d, pinned: %d)",
+		res.Checked, res.Drifted, res.Failed, res.Followers, res.Following, res.FollowRequests, res.Statuses, res.StatusesPinned,
+	)
+	return nil
+}
+
+// Recount regenerates the stored stats of all accounts
+// from the source tables, in batches, returning how many
+// were checked and by how much their counts had drifted.
+// Accounts without stats are skipped, as stats will be
+// generated for them from scratch when next needed. An
+// error recounting one account is logged and counted as
+// failed, rather than stopping the recount of the rest.
+func (a *AccountStats) Recount(ctx context.Context) (RecountResult, error) {
+	var (
+		res     RecountResult
+		sinceID string
+	)
+
+	for {
+		// Fetch the next batch of stored stats.
+		batch, err := a.state.DB.GetAccountStatsBatch(ctx,
+			sinceID,
+			selectLimit,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return res, gtserror.Newf("error getting account stats: %w", err)
+		}
+
+		if len(batch) == 0 {
+			// reached end.
+			break
+		}
+
+		// Use last as the next 'since' value.
+		sinceID = batch[len(batch)-1].AccountID
+
+		for _, stats := range batch {
+			if err := a.recount(ctx, stats, &res); err != nil {
+				log.Error(ctx, err)
+				res.Failed++
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// recount regenerates the given stored account stats,
+// adding the difference with the fresh counts to res.
+func (a *AccountStats) recount(ctx context.Context, stats *gtsmodel.AccountStats, res *RecountResult) error {
+	account, err := a.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		stats.AccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting account %s: %w", stats.AccountID, err)
+	}
+
+	if account == nil {
+		// Account is gone but its stats were
+		// left behind, nothing to recount.
+		log.Warnf(ctx, "stats for missing account %s", stats.AccountID)
+		return nil
+	}
+
+	if err := a.state.DB.RegenerateAccountStats(ctx, account); err != nil {
+		return gtserror.Newf("error regenerating stats for account %s: %w", account.ID, err)
+	}
+
+	res.Checked++
+
+	var (
+		fresh = account.Stats
+		diff  = func(before, after *int) int {
+			d := util.PtrOrZero(after) - util.PtrOrZero(before)
+			if d < 0 {
+				d = -d
+			}
+			return d
+		}
+		followers      = diff(stats.FollowersCount, fresh.FollowersCount)
+		following      = diff(stats.FollowingCount, fresh.FollowingCount)
+		followRequests = diff(stats.FollowRequestsCount, fresh.FollowRequestsCount)
+		statuses       = diff(stats.StatusesCount, fresh.StatusesCount)
+		statusesPinned = diff(stats.StatusesPinnedCount, fresh.StatusesPinnedCount)
+	)
+
+	if followers+following+followRequests+statuses+statusesPinned == 0 {
+		// No drift.
+		return nil
+	}
+
+	log.Debugf(ctx,
+		"stats for account %s drifted (followers: %d, following: %d, follow requests: %d, statuses: %d, pinned: %d)",
+		account.ID, followers, following, followRequests, statuses, statusesPinned,
+	)
+
+	res.Drifted++
+	res.Followers += followers
+	res.Following += following
+	res.FollowRequests += followRequests
+	res.Statuses += statuses
+	res.StatusesPinned += statusesPinned
+	return nil
+}
@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestAccountStatsRecount() {
+	ctx := suite.T().Context()
+	account := testrig.NewTestAccounts()["local_account_1"]
+
+	// Generate correct stats.
+	if err := suite.state.DB.PopulateAccountStats(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+	followers := *account.Stats.FollowersCount
+	statuses := *account.Stats.StatusesCount
+
+	// Nothing has drifted yet.
+	res, err := suite.cleaner.AccountStats().Recount(ctx)
+	suite.NoError(err)
+	suite.NotZero(res.Checked)
+	suite.Zero(res.Drifted)
+	suite.Zero(res.Failed)
+
+	// Make the counts drift, as
+	// though after a crash.
+	account.Stats.FollowersCount = util.Ptr(followers + 3)
+	account.Stats.StatusesCount = util.Ptr(statuses - 2)
+	if err := suite.state.DB.UpdateAccountStats(ctx,
+		account.Stats,
+		"followers_count",
+		"statuses_count",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	res, err = suite.cleaner.AccountStats().Recount(ctx)
+	suite.NoError(err)
+	suite.Equal(1, res.Drifted)
+	suite.Equal(3, res.Followers)
+	suite.Equal(2, res.Statuses)
+	suite.Zero(res.Following)
+
+	// Stored stats should be correct again.
+	account, err = suite.state.DB.GetAccountByID(gtscontext.SetBarebones(ctx), account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	account.Stats = nil
+	if err := suite.state.DB.PopulateAccountStats(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(followers, *account.Stats.FollowersCount)
+	suite.Equal(statuses, *account.Stats.StatusesCount)
+}
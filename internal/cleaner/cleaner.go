@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"errors"
 	"time"
 	"unsafe"
 
@@ -32,6 +33,10 @@
 
 const (
 	selectLimit = 50
+
+	// maxFailedActivities is the maximum number of
+	// stored failed activities kept when pruning.
+	maxFailedActivities = 10000
 )
 
 type Cleaner struct {
@@ -62,6 +67,33 @@ func (c *Cleaner) Media() *Media {
 	return (*Media)(unsafe.Pointer(c))
 }
 
+// FollowRequests returns the follow request set of cleaner utilities.
+func (c *Cleaner) FollowRequests() *FollowRequests {
+	if unsafe.Sizeof(FollowRequests{}) != unsafe.Sizeof(Cleaner{}) ||
+		unsafe.Offsetof(FollowRequests{}.Cleaner) != 0 {
+		panic(gtserror.New("compile time unsafe pointer assertion"))
+	}
+	return (*FollowRequests)(unsafe.Pointer(c))
+}
+
+// Accounts returns the account set of cleaner utilities.
+func (c *Cleaner) Accounts() *Accounts {
+	if unsafe.Sizeof(Accounts{}) != unsafe.Sizeof(Cleaner{}) ||
+		unsafe.Offsetof(Accounts{}.Cleaner) != 0 {
+		panic(gtserror.New("compile time unsafe pointer assertion"))
+	}
+	return (*Accounts)(unsafe.Pointer(c))
+}
+
+// AccountStats returns the account stats set of cleaner utilities.
+func (c *Cleaner) AccountStats() *AccountStats {
+	if unsafe.Sizeof(AccountStats{}) != unsafe.Sizeof(Cleaner{}) ||
+		unsafe.Offsetof(AccountStats{}.Cleaner) != 0 {
+		panic(gtserror.New("compile time unsafe pointer assertion"))
+	}
+	return (*AccountStats)(unsafe.Pointer(c))
+}
+
 // haveFiles returns whether all of the provided files exist within current storage.
 func (c *Cleaner) haveFiles(ctx context.Context, files ...string) (bool, error) {
 	for _, path := range files {
@@ -164,11 +196,12 @@ func (c *Cleaner) ScheduleJobs() error {
 		firstCleanupAt = firstCleanupAt.Add(cleanupEvery)
 	}
 
-	fn := func(ctx context.Context, start time.Time) {
+	fn := func(ctx context.Context, start time.Time) error {
 		log.Info(ctx, "starting media clean")
-		c.Media().All(ctx, config.GetMediaRemoteCacheDays())
-		c.Emoji().All(ctx, config.GetMediaRemoteCacheDays())
+		errMedia := c.Media().All(ctx, config.GetMediaRemoteCacheDays())
+		errEmoji := c.Emoji().All(ctx, config.GetMediaRemoteCacheDays())
 		log.Infof(ctx, "finished media clean after %s", time.Since(start))
+		return errors.Join(errMedia, errEmoji)
 	}
 
 	log.Infof(nil,
@@ -186,5 +219,319 @@ func (c *Cleaner) ScheduleJobs() error {
 		panic("failed to schedule @mediacleanup")
 	}
 
+	c.scheduleFollowRequestExpiry()
+	c.scheduleSuspendedAccountsPurge()
+	c.scheduleSeenActivitiesPrune()
+	c.scheduleFailedActivitiesPrune()
+	c.scheduleUserLoginsPrune()
+	c.scheduleAccountStatsRecount()
+
 	return nil
 }
+
+// scheduleFollowRequestExpiry schedules an hourly job
+// to expire stale follow requests, if configured to.
+func (c *Cleaner) scheduleFollowRequestExpiry() {
+	expiry := config.GetAccountsFollowRequestExpiry()
+	if expiry <= 0 {
+		// Follow requests
+		// never expire.
+		return
+	}
+
+	const every = time.Hour
+
+	fn := func(ctx context.Context, start time.Time) error {
+		log.Info(ctx, "starting follow request expiry")
+		err := c.FollowRequests().LogExpire(ctx, start.Add(-expiry))
+		log.Infof(ctx, "finished follow request expiry after %s", time.Since(start))
+		return err
+	}
+
+	log.Infof(nil,
+		"scheduling follow request expiry to run every %s, expiring requests older than %s",
+		every, expiry,
+	)
+
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@followrequestexpiry",
+		time.Now().Add(every),
+		every,
+		fn,
+	) {
+		panic("failed to schedule @followrequestexpiry")
+	}
+}
+
+// scheduleSuspendedAccountsPurge schedules an hourly job to
+// purge data of local accounts whose suspension grace period
+// has run out, if a grace period is configured.
+func (c *Cleaner) scheduleSuspendedAccountsPurge() {
+	grace := config.GetAccountsSuspensionGracePeriod()
+	if grace <= 0 {
+		// Suspended accounts
+		// are purged immediately.
+		return
+	}
+
+	const every = time.Hour
+
+	fn := func(ctx context.Context, start time.Time) error {
+		log.Info(ctx, "starting suspended accounts purge")
+		err := c.Accounts().LogPurgeSuspended(ctx, start.Add(-grace))
+		log.Infof(ctx, "finished suspended accounts purge after %s", time.Since(start))
+		return err
+	}
+
+	log.Infof(nil,
+		"scheduling suspended accounts purge to run every %s, purging accounts suspended longer than %s",
+		every, grace,
+	)
+
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@suspendedaccountspurge",
+		time.Now().Add(every),
+		every,
+		fn,
+	) {
+		panic("failed to schedule @suspendedaccountspurge")
+	}
+}
+
+// scheduleSeenActivitiesPrune schedules an hourly job to prune
+// the IDs of seen inbound activities older than the configured TTL.
+func (c *Cleaner) scheduleSeenActivitiesPrune() {
+	ttl := config.GetInstanceFederationSeenActivityTTL()
+	if ttl <= 0 {
+		// Seen activities
+		// aren't stored.
+		return
+	}
+
+	const every = time.Hour
+
+	fn := func(ctx context.Context, start time.Time) error {
+		log.Info(ctx, "starting seen activities prune")
+		err := c.LogPruneSeenActivities(ctx, start.Add(-ttl))
+		log.Infof(ctx, "finished seen activities prune after %s", time.Since(start))
+		return err
+	}
+
+	log.Infof(nil,
+		"scheduling seen activities prune to run every %s, pruning activities older than %s",
+		every, ttl,
+	)
+
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@seenactivitiesprune",
+		time.Now().Add(every),
+		every,
+		fn,
+	) {
+		panic("failed to schedule @seenactivitiesprune")
+	}
+}
+
+// LogPruneSeenActivities performs PruneSeenActivities(...), logging the result.
+// Any error is returned as well as logged.
+func (c *Cleaner) LogPruneSeenActivities(ctx context.Context, olderThan time.Time) error {
+	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
+	n, err := c.PruneSeenActivities(ctx, olderThan)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+	log.Infof(ctx, "pruned: %d", n)
+	return nil
+}
+
+// PruneSeenActivities deletes the stored IDs of seen inbound activities
+// older than the given time, after which redeliveries of those activities
+// will no longer be dropped. Returns the number of entries pruned.
+func (c *Cleaner) PruneSeenActivities(ctx context.Context, olderThan time.Time) (int, error) {
+	if gtscontext.DryRun(ctx) {
+		// Nothing to
+		// do in dry run.
+		return 0, nil
+	}
+
+	n, err := c.state.DB.DeleteSeenActivitiesOlderThan(ctx, olderThan)
+	if err != nil {
+		return 0, gtserror.Newf("error pruning seen activities: %w", err)
+	}
+
+	return n, nil
+}
+
+// scheduleFailedActivitiesPrune schedules an hourly job to prune
+// stored failed activities older than the configured retention,
+// and beyond the maximum number of failed activities kept.
+func (c *Cleaner) scheduleFailedActivitiesPrune() {
+	ttl := config.GetInstanceFederationFailedActivityTTL()
+
+	const every = time.Hour
+
+	fn := func(ctx context.Context, start time.Time) error {
+		log.Info(ctx, "starting failed activities prune")
+		var olderThan time.Time
+		if ttl > 0 {
+			olderThan = start.Add(-ttl)
+		}
+		err := c.LogPruneFailedActivities(ctx, olderThan)
+		log.Infof(ctx, "finished failed activities prune after %s", time.Since(start))
+		return err
+	}
+
+	log.Infof(nil,
+		"scheduling failed activities prune to run every %s, pruning activities older than %s or beyond the newest %d",
+		every, ttl, maxFailedActivities,
+	)
+
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@failedactivitiesprune",
+		time.Now().Add(every),
+		every,
+		fn,
+	) {
+		panic("failed to schedule @failedactivitiesprune")
+	}
+}
+
+// LogPruneFailedActivities performs PruneFailedActivities(...), logging the result.
+// Any error is returned as well as logged.
+func (c *Cleaner) LogPruneFailedActivities(ctx context.Context, olderThan time.Time) error {
+	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
+	n, err := c.PruneFailedActivities(ctx, olderThan)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+	log.Infof(ctx, "pruned: %d", n)
+	return nil
+}
+
+// PruneFailedActivities deletes stored failed activities older than
+// the given time (if not zero), then all but the newest failed
+// activities up to the maximum number kept. Returns the number of
+// failed activities pruned.
+func (c *Cleaner) PruneFailedActivities(ctx context.Context, olderThan time.Time) (int, error) {
+	if gtscontext.DryRun(ctx) {
+		// Nothing to
+		// do in dry run.
+		return 0, nil
+	}
+
+	var total int
+
+	if !olderThan.IsZero() {
+		n, err := c.state.DB.DeleteFailedActivitiesOlderThan(ctx, olderThan)
+		if err != nil {
+			return total, gtserror.Newf("error pruning old failed activities: %w", err)
+		}
+		total += n
+	}
+
+	n, err := c.state.DB.DeleteFailedActivitiesOverLimit(ctx, maxFailedActivities)
+	if err != nil {
+		return total, gtserror.Newf("error pruning excess failed activities: %w", err)
+	}
+	total += n
+
+	return total, nil
+}
+
+// scheduleUserLoginsPrune schedules an hourly job to prune recorded
+// sign-in attempts older than the configured login history retention.
+func (c *Cleaner) scheduleUserLoginsPrune() {
+	retention := config.GetAccountsLoginHistoryRetention()
+	if retention <= 0 {
+		// Sign-in attempts
+		// aren't stored.
+		return
+	}
+
+	const every = time.Hour
+
+	fn := func(ctx context.Context, start time.Time) error {
+		log.Info(ctx, "starting user logins prune")
+		err := c.LogPruneUserLogins(ctx, start.Add(-retention))
+		log.Infof(ctx, "finished user logins prune after %s", time.Since(start))
+		return err
+	}
+
+	log.Infof(nil,
+		"scheduling user logins prune to run every %s, pruning sign-ins older than %s",
+		every, retention,
+	)
+
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@userloginsprune",
+		time.Now().Add(every),
+		every,
+		fn,
+	) {
+		panic("failed to schedule @userloginsprune")
+	}
+}
+
+// LogPruneUserLogins performs PruneUserLogins(...), logging the result.
+// Any error is returned as well as logged.
+func (c *Cleaner) LogPruneUserLogins(ctx context.Context, olderThan time.Time) error {
+	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
+	n, err := c.PruneUserLogins(ctx, olderThan)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+	log.Infof(ctx, "pruned: %d", n)
+	return nil
+}
+
+// PruneUserLogins deletes recorded sign-in attempts to local
+// accounts older than the given time. Returns the number pruned.
+func (c *Cleaner) PruneUserLogins(ctx context.Context, olderThan time.Time) (int, error) {
+	if gtscontext.DryRun(ctx) {
+		// Nothing to
+		// do in dry run.
+		return 0, nil
+	}
+
+	n, err := c.state.DB.DeleteUserLoginsOlderThan(ctx, olderThan)
+	if err != nil {
+		return 0, gtserror.Newf("error pruning user logins: %w", err)
+	}
+
+	return n, nil
+}
+
+// scheduleAccountStatsRecount schedules a job to recount
+// the stats of all accounts at the configured interval.
+func (c *Cleaner) scheduleAccountStatsRecount() {
+	every := config.GetAccountsStatsRecountEvery()
+	if every <= 0 {
+		// Stats are
+		// never recounted.
+		return
+	}
+
+	fn := func(ctx context.Context, start time.Time) error {
+		log.Info(ctx, "starting account stats recount")
+		err := c.AccountStats().LogRecount(ctx)
+		log.Infof(ctx, "finished account stats recount after %s", time.Since(start))
+		return err
+	}
+
+	log.Infof(nil,
+		"scheduling account stats recount to run every %s",
+		every,
+	)
+
+	if !c.state.Workers.Scheduler.AddRecurring(
+		"@accountstatsrecount",
+		time.Now().Add(every),
+		every,
+		fn,
+	) {
+		panic("failed to schedule @accountstatsrecount")
+	}
+}
@@ -37,69 +37,87 @@ type Emoji struct{
 
 // All will execute all cleaner.Emoji utilities synchronously, including output logging.
 // Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
-func (e *Emoji) All(ctx context.Context, maxRemoteDays int) {
+// Each utility is run regardless of errors in others, returning any errors combined.
+func (e *Emoji) All(ctx context.Context, maxRemoteDays int) error {
 	t := time.Now().Add(-24 * time.Hour * time.Duration(maxRemoteDays))
-	e.LogUncacheRemote(ctx, t)
-	e.LogFixBroken(ctx)
-	e.LogPruneUnused(ctx)
+	errUncache := e.LogUncacheRemote(ctx, t)
+	errFix := e.LogFixBroken(ctx)
+	errPrune := e.LogPruneUnused(ctx)
 	_ = e.state.Storage.Storage.Clean(ctx)
+	return errors.Join(errUncache, errFix, errPrune)
 }
 
 // AllAndFix calls LogFixCacheStates(), followed by All(), it
 // is done this way round so Storage.Clean() is performed last.
-func (e *Emoji) AllAndFix(ctx context.Context, maxRemoteDays int) {
-	e.LogFixCacheStates(ctx)
-	e.All(ctx, maxRemoteDays)
+func (e *Emoji) AllAndFix(ctx context.Context, maxRemoteDays int) error {
+	errFix := e.LogFixCacheStates(ctx)
+	errAll := e.All(ctx, maxRemoteDays)
+	return errors.Join(errFix, errAll)
 }
 
 // LogUncacheRemote performs Emoji.UncacheRemote(...), logging the start and outcome.
-func (e *Emoji) LogUncacheRemote(ctx context.Context, olderThan time.Time) {
+// Any error is returned as well as logged.
+func (e *Emoji) LogUncacheRemote(ctx context.Context, olderThan time.Time) error {
 	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
-	if n, err := e.UncacheRemote(ctx, olderThan); err != nil {
+	n, err := e.UncacheRemote(ctx, olderThan)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "uncached: %d", n)
+		return err
 	}
+	log.Infof(ctx, "uncached: %d", n)
+	return nil
 }
 
 // LogPurgeRemote performs Emoji.PurgeRemote(...), logging the start and outcome.
-func (e *Emoji) LogPurgeRemote(ctx context.Context, domain string) {
+// Any error is returned as well as logged.
+func (e *Emoji) LogPurgeRemote(ctx context.Context, domain string) error {
 	log.Infof(ctx, "start purge domain: %s", domain)
-	if n, err := e.PurgeRemote(ctx, domain); err != nil {
+	n, err := e.PurgeRemote(ctx, domain)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "purged: %d", n)
+		return err
 	}
+	log.Infof(ctx, "purged: %d", n)
+	return nil
 }
 
 // LogFixBroken performs Emoji.FixBroken(...), logging the start and outcome.
-func (e *Emoji) LogFixBroken(ctx context.Context) {
+// Any error is returned as well as logged.
+func (e *Emoji) LogFixBroken(ctx context.Context) error {
 	log.Info(ctx, "start")
-	if n, err := e.FixBroken(ctx); err != nil {
+	n, err := e.FixBroken(ctx)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "fixed: %d", n)
+		return err
 	}
+	log.Infof(ctx, "fixed: %d", n)
+	return nil
 }
 
 // LogPruneUnused performs Emoji.PruneUnused(...), logging the start and outcome.
-func (e *Emoji) LogPruneUnused(ctx context.Context) {
+// Any error is returned as well as logged.
+func (e *Emoji) LogPruneUnused(ctx context.Context) error {
 	log.Info(ctx, "start")
-	if n, err := e.PruneUnused(ctx); err != nil {
+	n, err := e.PruneUnused(ctx)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "pruned: %d", n)
+		return err
 	}
+	log.Infof(ctx, "pruned: %d", n)
+	return nil
 }
 
 // LogFixCacheStates performs Emoji.FixCacheStates(...), logging the start and outcome.
-func (e *Emoji) LogFixCacheStates(ctx context.Context) {
+// Any error is returned as well as logged.
+func (e *Emoji) LogFixCacheStates(ctx context.Context) error {
 	log.Info(ctx, "start")
-	if n, err := e.FixCacheStates(ctx); err != nil {
+	n, err := e.FixCacheStates(ctx)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "fixed: %d", n)
+		return err
 	}
+	log.Infof(ctx, "fixed: %d", n)
+	return nil
 }
 
 // UncacheRemote will uncache all remote emoji older than given input time. Context
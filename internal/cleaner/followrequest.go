@@ -0,0 +1,106 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+)
+
+// FollowRequests encompasses a set
+// of follow request cleanup utils.
+type FollowRequests struct{ Cleaner }
+
+// LogExpire performs FollowRequests.Expire(...), logging the start and outcome.
+// Any error is returned as well as logged.
+func (f *FollowRequests) LogExpire(ctx context.Context, olderThan time.Time) error {
+	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
+	n, err := f.Expire(ctx, olderThan)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+	log.Infof(ctx, "expired: %d", n)
+	return nil
+}
+
+// Expire rejects all follow requests targeting local accounts
+// that were created before the given time, as if they had been
+// rejected by the target account, returning the number expired.
+// Context will be checked for `gtscontext.DryRun()` in order to
+// actually perform the action.
+func (f *FollowRequests) Expire(ctx context.Context, olderThan time.Time) (int, error) {
+	var total int
+
+	for {
+		// Fetch the next batch of expired follow requests.
+		// As each gets rejected (ie., deleted) in turn, the
+		// next call will return the following batch.
+		followReqs, err := f.state.DB.GetExpiredFollowRequests(ctx, olderThan, selectLimit)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting expired follow requests: %w", err)
+		}
+
+		if len(followReqs) == 0 {
+			// reached end.
+			break
+		}
+
+		if gtscontext.DryRun(ctx) {
+			// Dry run, nothing gets deleted
+			// so we can't page through batches.
+			// Just report the first batch.
+			total += len(followReqs)
+			break
+		}
+
+		for _, followReq := range followReqs {
+			// Reject the follow request, which deletes it.
+			if err := f.state.DB.RejectFollowRequest(ctx,
+				followReq.AccountID,
+				followReq.TargetAccountID,
+			); err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return total, gtserror.Newf("error rejecting follow request %s: %w", followReq.ID, err)
+			}
+
+			if followReq.Account != nil {
+				// Only enqueue side effects (ie., federating
+				// the Reject) if we have the requester stored.
+				f.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+					APObjectType:   ap.ActivityFollow,
+					APActivityType: ap.ActivityReject,
+					GTSModel:       followReq,
+					Origin:         followReq.Account,
+					Target:         followReq.TargetAccount,
+				})
+			}
+
+			total++
+		}
+	}
+
+	return total, nil
+}
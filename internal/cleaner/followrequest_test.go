@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestFollowRequestsExpire() {
+	suite.testFollowRequestsExpire(suite.T().Context())
+}
+
+func (suite *CleanerTestSuite) TestFollowRequestsExpireDryRun() {
+	suite.testFollowRequestsExpire(gtscontext.SetDryRun(suite.T().Context()))
+}
+
+func (suite *CleanerTestSuite) testFollowRequestsExpire(ctx context.Context) {
+	var (
+		accounts  = testrig.NewTestAccounts()
+		now       = time.Now()
+		olderThan = now.Add(-30 * 24 * time.Hour)
+	)
+
+	// Stale follow request from
+	// a remote account to a local one.
+	stale := &gtsmodel.FollowRequest{
+		ID:              id.NewULIDFromTime(olderThan.Add(-time.Hour)),
+		URI:             "http://fossbros-anonymous.io/users/foss_satan/follows/stale",
+		AccountID:       accounts["remote_account_1"].ID,
+		TargetAccountID: accounts["local_account_2"].ID,
+		Message:         "hey, please let me in!",
+	}
+
+	// Fresh follow request from a
+	// remote account to a local one.
+	fresh := &gtsmodel.FollowRequest{
+		ID:              id.NewULIDFromTime(now),
+		URI:             "http://example.org/users/Some_User/follows/fresh",
+		AccountID:       accounts["remote_account_2"].ID,
+		TargetAccountID: accounts["local_account_2"].ID,
+	}
+
+	// Stale follow request from a local
+	// account to a remote one, which is
+	// for the remote to expire, not us.
+	outgoing := &gtsmodel.FollowRequest{
+		ID:              id.NewULIDFromTime(olderThan.Add(-time.Hour)),
+		URI:             "http://localhost:8080/users/1happyturtle/follows/outgoing",
+		AccountID:       accounts["local_account_2"].ID,
+		TargetAccountID: accounts["remote_account_3"].ID,
+	}
+
+	for _, followReq := range []*gtsmodel.FollowRequest{stale, fresh, outgoing} {
+		if err := suite.state.DB.PutFollowRequest(ctx, followReq); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	n, err := suite.cleaner.FollowRequests().Expire(ctx, olderThan)
+	suite.NoError(err)
+	suite.Equal(1, n)
+
+	// The stale request should be gone,
+	// unless this was only a dry run.
+	_, err = suite.state.DB.GetFollowRequestByID(ctx, stale.ID)
+	if gtscontext.DryRun(ctx) {
+		suite.NoError(err)
+	} else {
+		suite.True(errors.Is(err, db.ErrNoEntries))
+	}
+
+	// Other requests should be untouched.
+	for _, followReq := range []*gtsmodel.FollowRequest{fresh, outgoing} {
+		_, err := suite.state.DB.GetFollowRequestByID(ctx, followReq.ID)
+		suite.NoError(err)
+	}
+}
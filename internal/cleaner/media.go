@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"net/netip"
+	"slices"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
@@ -41,69 +42,94 @@ type Media struct{
 
 // All will execute all cleaner.Media utilities synchronously, including output logging.
 // Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
-func (m *Media) All(ctx context.Context, maxRemoteDays int) {
-	t := time.Now().Add(-24 * time.Hour * time.Duration(maxRemoteDays))
-	m.LogUncacheRemote(ctx, t)
-	m.LogPruneOrphaned(ctx)
-	m.LogPruneUnused(ctx)
+// Each utility is run regardless of errors in others, returning any errors combined.
+func (m *Media) All(ctx context.Context, maxRemoteDays int) error {
+	var errUncache error
+	if !m.state.Storage.RemoteCacheLifecycle() {
+		t := time.Now().Add(-24 * time.Hour * time.Duration(maxRemoteDays))
+		errUncache = m.LogUncacheRemote(ctx, t)
+	} else {
+		// Remote media is tagged in storage, leave
+		// expiry of these to bucket lifecycle rules.
+		log.Info(ctx, "skipping uncache remote: expiry managed by storage lifecycle")
+	}
+	errOrphaned := m.LogPruneOrphaned(ctx)
+	errUnused := m.LogPruneUnused(ctx)
 	_ = m.state.Storage.Storage.Clean(ctx)
+	return errors.Join(errUncache, errOrphaned, errUnused)
 }
 
 // AllAndFix calls LogFixCacheStates(), followed by All(), it
 // is done this way round so Storage.Clean() is performed last.
-func (m *Media) AllAndFix(ctx context.Context, maxRemoteDays int) {
-	m.LogFixCacheStates(ctx)
-	m.All(ctx, maxRemoteDays)
+func (m *Media) AllAndFix(ctx context.Context, maxRemoteDays int) error {
+	errFix := m.LogFixCacheStates(ctx)
+	errAll := m.All(ctx, maxRemoteDays)
+	return errors.Join(errFix, errAll)
 }
 
 // LogUncacheRemote performs Media.UncacheRemote(...), logging the start and outcome.
-func (m *Media) LogUncacheRemote(ctx context.Context, olderThan time.Time) {
+// Any error is returned as well as logged.
+func (m *Media) LogUncacheRemote(ctx context.Context, olderThan time.Time) error {
 	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
-	if n, err := m.UncacheRemote(ctx, olderThan); err != nil {
+	n, err := m.UncacheRemote(ctx, olderThan)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "uncached: %d", n)
+		return err
 	}
+	log.Infof(ctx, "uncached: %d", n)
+	return nil
 }
 
 // LogPurgeRemote performs Media.PurgeRemote(...), logging the start and outcome.
-func (m *Media) LogPurgeRemote(ctx context.Context, domain string) {
+// Any error is returned as well as logged.
+func (m *Media) LogPurgeRemote(ctx context.Context, domain string) error {
 	log.Infof(ctx, "start purge domain: %s", domain)
-	if n, err := m.PurgeRemote(ctx, domain); err != nil {
+	n, err := m.PurgeRemote(ctx, domain)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "purged: %d", n)
+		return err
 	}
+	log.Infof(ctx, "purged: %d", n)
+	return nil
 }
 
 // LogPruneOrphaned performs Media.PruneOrphaned(...), logging the start and outcome.
-func (m *Media) LogPruneOrphaned(ctx context.Context) {
+// Any error is returned as well as logged.
+func (m *Media) LogPruneOrphaned(ctx context.Context) error {
 	log.Info(ctx, "start")
-	if n, err := m.PruneOrphaned(ctx); err != nil {
+	n, err := m.PruneOrphaned(ctx)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "pruned: %d", n)
+		return err
 	}
+	log.Infof(ctx, "pruned: %d", n)
+	return nil
 }
 
 // LogPruneUnused performs Media.PruneUnused(...), logging the start and outcome.
-func (m *Media) LogPruneUnused(ctx context.Context) {
+// Any error is returned as well as logged.
+func (m *Media) LogPruneUnused(ctx context.Context) error {
 	log.Info(ctx, "start")
-	if n, err := m.PruneUnused(ctx); err != nil {
+	n, err := m.PruneUnused(ctx)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "pruned: %d", n)
+		return err
 	}
+	log.Infof(ctx, "pruned: %d", n)
+	return nil
 }
 
 // LogFixCacheStates performs Media.FixCacheStates(...), logging the start and outcome.
-func (m *Media) LogFixCacheStates(ctx context.Context) {
+// Any error is returned as well as logged.
+func (m *Media) LogFixCacheStates(ctx context.Context) error {
 	log.Info(ctx, "start")
-	if n, err := m.FixCacheStates(ctx); err != nil {
+	n, err := m.FixCacheStates(ctx)
+	if err != nil {
 		log.Error(ctx, err)
-	} else {
-		log.Infof(ctx, "fixed: %d", n)
+		return err
 	}
+	log.Infof(ctx, "fixed: %d", n)
+	return nil
 }
 
 // PruneOrphaned will delete orphaned files from storage (i.e. media missing a database entry).
@@ -265,7 +291,10 @@ func (m *Media) PurgeRemote(ctx context.Context, domain string) (int, error) {
 			"",           // displayName
 			domain,       // domain
 			"",           // email
+			"",           // emailDomain
 			netip.Addr{}, // ip
+			time.Time{},  // createdAfter
+			time.Time{},  // createdBefore
 			&page,
 		)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
@@ -469,6 +498,7 @@ func (m *Media) isOrphaned(ctx context.Context, path string) (bool, error) {
 			string(media.TypeEmoji),
 			string(media.SizeStatic),
 			mediaID,
+			"",
 			"png",
 		)
 
@@ -514,6 +544,18 @@ func (m *Media) pruneUnused(ctx context.Context, media *gtsmodel.MediaAttachment
 			l.Debug("skipping as account media in use")
 			return false, nil
 		}
+
+		// Check whether this is a previous avatar / header
+		// retained in a local account's history for rollback.
+		retained, err := m.isRetainedProfileMedia(ctx, account, media)
+		if err != nil {
+			return false, err
+		}
+
+		if retained && account.SuspendedAt.IsZero() {
+			l.Debug("skipping as account media retained in history")
+			return false, nil
+		}
 	}
 
 	// Check whether we have the required status for media.
@@ -535,6 +577,17 @@ func (m *Media) pruneUnused(ctx context.Context, media *gtsmodel.MediaAttachment
 		}
 	}
 
+	// Check whether this is the preview image of a link card.
+	cardImage, err := m.isCardImage(ctx, media)
+	if err != nil {
+		return false, err
+	}
+
+	if cardImage {
+		l.Debug("skipping as card image in use")
+		return false, nil
+	}
+
 	// Check whether we have the required scheduled status for media.
 	scheduledStatus, missing, err := m.getRelatedScheduledStatus(ctx, media)
 	if err != nil {
@@ -699,6 +752,48 @@ func (m *Media) getOwningAccount(ctx context.Context, media *gtsmodel.MediaAttac
 	return account, false, nil
 }
 
+// isRetainedProfileMedia returns whether media is a previous avatar or
+// header kept in the given (local) account's profile media history.
+func (m *Media) isRetainedProfileMedia(ctx context.Context, account *gtsmodel.Account, media *gtsmodel.MediaAttachment) (bool, error) {
+	if !account.IsLocal() || (!*media.Avatar && !*media.Header) {
+		// Only local avatars + headers are kept.
+		return false, nil
+	}
+
+	settings, err := m.state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error fetching settings for account %s: %w", account.ID, err)
+	}
+
+	if settings == nil {
+		return false, nil
+	}
+
+	return (*media.Avatar && slices.Contains(settings.AvatarHistoryIDs, media.ID)) ||
+		(*media.Header && slices.Contains(settings.HeaderHistoryIDs, media.ID)), nil
+}
+
+// isCardImage returns whether media is the
+// stored preview image of a link preview card.
+func (m *Media) isCardImage(ctx context.Context, media *gtsmodel.MediaAttachment) (bool, error) {
+	if media.StatusID != "" || media.ScheduledStatusID != "" ||
+		*media.Avatar || *media.Header {
+		// Card images are
+		// none of these.
+		return false, nil
+	}
+
+	card, err := m.state.DB.GetCardByImageAttachmentID(
+		gtscontext.SetBarebones(ctx),
+		media.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error fetching card by image %s: %w", media.ID, err)
+	}
+
+	return card != nil, nil
+}
+
 func (m *Media) getRelatedStatus(ctx context.Context, media *gtsmodel.MediaAttachment) (*gtsmodel.Status, bool, error) {
 	if media.StatusID == "" {
 		// no related status.
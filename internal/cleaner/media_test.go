@@ -109,6 +109,44 @@ func (suite *MediaTestSuite) TestUncacheRemote() {
 	suite.False(uncachedAttachment.Cached())
 }
 
+func (suite *MediaTestSuite) TestAllUncachesRemote() {
+	ctx := suite.T().Context()
+
+	testAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	suite.True(testAttachment.Cached())
+
+	// Without storage lifecycle, old
+	// remote media should be uncached.
+	suite.cleaner.Media().All(ctx, 1)
+
+	attachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.False(attachment.Cached())
+}
+
+func (suite *MediaTestSuite) TestAllSkipsUncacheWithLifecycle() {
+	ctx := suite.T().Context()
+
+	testAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	suite.True(testAttachment.Cached())
+
+	// Tag remote media in storage, so that
+	// expiry is left to lifecycle rules.
+	suite.storage.RemoteCacheTags = map[string]string{"gts-cache": "remote"}
+	suite.True(suite.storage.RemoteCacheLifecycle())
+
+	suite.cleaner.Media().All(ctx, 1)
+
+	// Remote media should still be cached.
+	attachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.True(attachment.Cached())
+
+	has, err := suite.storage.Has(ctx, attachment.File.Path)
+	suite.NoError(err)
+	suite.True(has)
+}
+
 func (suite *MediaTestSuite) TestPurgeRemote() {
 	var (
 		ctx = suite.T().Context()
@@ -254,3 +292,85 @@ func (suite *MediaTestSuite) TestUncacheOneNonExistent() {
 	suite.NoError(err)
 	suite.Equal(3, totalUncached)
 }
+
+func (suite *MediaTestSuite) TestPruneUnusedKeepsProfileMediaHistory() {
+	ctx := suite.T().Context()
+
+	// Store a previous avatar for
+	// account, no longer in use.
+	account := suite.testAccounts["local_account_1"]
+	previous := new(gtsmodel.MediaAttachment)
+	*previous = *suite.testAttachments["local_account_1_avatar"]
+	previous.ID = "01K7QBZ7V3P0A8RK2J5N9X6M4D"
+	previous.URL = ""
+	previous.File.Path = account.ID + "/attachment/original/" + previous.ID + ".jpg"
+	previous.Thumbnail.Path = account.ID + "/attachment/small/" + previous.ID + ".webp"
+	if err := suite.db.PutAttachment(ctx, previous); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Keep it in the account's avatar history.
+	settings, err := suite.db.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.AvatarHistoryIDs = []string{previous.ID}
+	if err := suite.db.UpdateAccountSettings(ctx, settings, "avatar_history"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Retained media should survive pruning.
+	if _, err := suite.cleaner.Media().PruneUnused(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	_, err = suite.db.GetAttachmentByID(ctx, previous.ID)
+	suite.NoError(err)
+
+	// Once dropped from history, it should be pruned.
+	settings.AvatarHistoryIDs = nil
+	if err := suite.db.UpdateAccountSettings(ctx, settings, "avatar_history"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if _, err := suite.cleaner.Media().PruneUnused(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	_, err = suite.db.GetAttachmentByID(ctx, previous.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *MediaTestSuite) TestPruneUnusedKeepsCardImage() {
+	ctx := suite.T().Context()
+
+	// Use unattached media as
+	// a link preview card image.
+	image := suite.testAttachments["local_account_1_unattached_1"]
+	card := &gtsmodel.Card{
+		ID:                "01K7QC4M2R8D5T0W6Y1B3N9F7H",
+		URL:               "https://example.org/some-page",
+		Type:              gtsmodel.CardTypeLink,
+		Title:             "Some page",
+		Image:             "https://example.org/some-image.jpg",
+		ImageAttachmentID: image.ID,
+	}
+	if err := suite.db.PutCard(ctx, card); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Card image should survive pruning.
+	if _, err := suite.cleaner.Media().PruneUnused(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	_, err := suite.db.GetAttachmentByID(ctx, image.ID)
+	suite.NoError(err)
+
+	// Once the card drops it, it should be pruned.
+	card.ImageAttachmentID = ""
+	if err := suite.db.UpdateCard(ctx, card, "image_attachment_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if _, err := suite.cleaner.Media().PruneUnused(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	_, err = suite.db.GetAttachmentByID(ctx, image.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
@@ -66,6 +66,7 @@ type Configuration struct {
 	LandingPageUser            string        `name:"landing-page-user" usage:"the user that should be shown on the instance's landing page"`
 	Host                       string        `name:"host" usage:"Hostname to use for the server (eg., example.org, gotosocial.whatever.com). DO NOT change this on a server that's already run!"`
 	AccountDomain              string        `name:"account-domain" usage:"Domain to use in account names (eg., example.org, whatever.com). If not set, will default to the setting for host. DO NOT change this on a server that's already run!"`
+	AccountDomainAliases       []string      `name:"account-domain-aliases" usage:"Additional vanity domains at which local accounts can be looked up via webfinger (eg., brand-a.com), or username@domain entries to cover only one account at a domain (eg., alice@brand-a.com). Webfinger requests at these domains must be redirected or proxied to this server."`
 	Protocol                   string        `name:"protocol" usage:"Protocol to use for the REST api of the server (only use http if you are debugging; https should be used even if running behind a reverse proxy!)"`
 	BindAddress                string        `name:"bind-address" usage:"Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces."`
 	Port                       int           `name:"port" usage:"Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine."`
@@ -89,30 +90,51 @@ type Configuration struct {
 	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
 	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
 
-	InstanceFederationMode            string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
-	InstanceFederationSpamFilter      bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
-	InstanceExposePeers               bool               `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
-	InstanceExposeBlocklist           bool               `name:"instance-expose-blocklist" usage:"Expose list of blocked domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=blocked and /api/v1/instance/domain_blocks"`
-	InstanceExposeBlocklistWeb        bool               `name:"instance-expose-blocklist-web" usage:"Expose list of explicitly blocked domains as webpage on /about/domain_blocks"`
-	InstanceExposeAllowlist           bool               `name:"instance-expose-allowlist" usage:"Expose list of allowed domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=allowed and /api/v1/instance/domain_allows"`
-	InstanceExposeAllowlistWeb        bool               `name:"instance-expose-allowlist-web" usage:"Expose list of explicitly allowed domains as webpage on /about/domain_allows"`
-	InstanceExposePublicTimeline      bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
-	InstanceExposeCustomEmojis        bool               `name:"instance-expose-custom-emojis" usage:"Allow unauthenticated access to /api/v1/custom_emojis"`
-	InstanceDeliverToSharedInboxes    bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
-	InstanceInjectMastodonVersion     bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
-	InstanceLanguages                 language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
-	InstanceSubscriptionsProcessFrom  string             `name:"instance-subscriptions-process-from" usage:"Time of day from which to start running instance subscriptions processing jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
-	InstanceSubscriptionsProcessEvery time.Duration      `name:"instance-subscriptions-process-every" usage:"Period to elapse between instance subscriptions processing jobs, starting from instance-subscriptions-process-from."`
-	InstanceStatsMode                 string             `name:"instance-stats-mode" usage:"Allows you to customize the way stats are served to crawlers: one of '', 'serve', 'zero', 'baffle'. Home page stats remain unchanged."`
-	InstanceAllowBackdatingStatuses   bool               `name:"instance-allow-backdating-statuses" usage:"Allow local accounts to backdate statuses using the scheduled_at param to /api/v1/statuses"`
-
-	AccountsRegistrationOpen         bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
-	AccountsReasonRequired           bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
-	AccountsRegistrationDailyLimit   int  `name:"accounts-registration-daily-limit" usage:"Limit amount of approved account sign-ups allowed per 24hrs before registration is closed. 0 or less = no limit."`
-	AccountsRegistrationBacklogLimit int  `name:"accounts-registration-backlog-limit" usage:"Limit how big the 'accounts pending approval' queue can grow before registration is closed. 0 or less = no limit."`
-	AccountsAllowCustomCSS           bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
-	AccountsCustomCSSLength          int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
-	AccountsMaxProfileFields         int  `name:"accounts-max-profile-fields" usage:"Maximum number of profile fields allowed for each account."`
+	InstanceFederationMode              string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
+	InstanceFederationSpamFilter        bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
+	InstanceFederationSeenActivityTTL   time.Duration      `name:"instance-federation-seen-activity-ttl" usage:"Period for which the IDs of activities delivered to inboxes are remembered, so that redeliveries of them are dropped, even across restarts. 0 = disabled."`
+	InstanceFederationFailedActivityTTL time.Duration      `name:"instance-federation-failed-activity-ttl" usage:"Period for which inbound activities that failed processing are kept for inspection before being pruned. 0 = kept until deleted."`
+	InstanceExposePeers                 bool               `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
+	InstanceExposeBlocklist             bool               `name:"instance-expose-blocklist" usage:"Expose list of blocked domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=blocked and /api/v1/instance/domain_blocks"`
+	InstanceExposeBlocklistWeb          bool               `name:"instance-expose-blocklist-web" usage:"Expose list of explicitly blocked domains as webpage on /about/domain_blocks"`
+	InstanceExposeBlocklistComments     bool               `name:"instance-expose-blocklist-comments" usage:"Include the public comment of each domain block when exposing the list of blocked domains. If false, only domains are shown."`
+	InstanceExposeAllowlist             bool               `name:"instance-expose-allowlist" usage:"Expose list of allowed domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=allowed and /api/v1/instance/domain_allows"`
+	InstanceExposeAllowlistWeb          bool               `name:"instance-expose-allowlist-web" usage:"Expose list of explicitly allowed domains as webpage on /about/domain_allows"`
+	InstanceExposePublicTimeline        bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
+	InstanceExposeCustomEmojis          bool               `name:"instance-expose-custom-emojis" usage:"Allow unauthenticated access to /api/v1/custom_emojis"`
+	InstanceExposeTagFeeds              bool               `name:"instance-expose-tag-feeds" usage:"Expose RSS/Atom feeds of public posts with a hashtag at /tags/:tag_name.rss"`
+	InstanceExposeLocalFeed             bool               `name:"instance-expose-local-feed" usage:"Expose an RSS/Atom feed of public posts by local accounts at /local.rss"`
+	InstanceDeliverToSharedInboxes      bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceInjectMastodonVersion       bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
+	InstanceLanguages                   language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
+	InstanceSubscriptionsProcessFrom    string             `name:"instance-subscriptions-process-from" usage:"Time of day from which to start running instance subscriptions processing jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
+	InstanceSubscriptionsProcessEvery   time.Duration      `name:"instance-subscriptions-process-every" usage:"Period to elapse between instance subscriptions processing jobs, starting from instance-subscriptions-process-from."`
+	InstancePeersCrawlEvery             time.Duration      `name:"instance-peers-crawl-every" usage:"Period to elapse between fetching nodeinfo (software, version, user counts, registrations) from known peer instances. 0 = disabled."`
+	InstanceSitemapGenerateEvery        time.Duration      `name:"instance-sitemap-generate-every" usage:"Period to elapse between regenerating the cached sitemap of indexable profiles and posts served at /sitemap.xml. 0 = sitemap disabled."`
+	InstanceStatsMode                   string             `name:"instance-stats-mode" usage:"Allows you to customize the way stats are served to crawlers: one of '', 'serve', 'zero', 'baffle'. Home page stats remain unchanged."`
+	InstancePeersMode                   string             `name:"instance-peers-mode" usage:"How the list of known peers is served at /api/v1/instance/peers: one of 'serve', 'anonymize', 'disabled'."`
+	InstanceActivityMode                string             `name:"instance-activity-mode" usage:"How weekly instance activity is served at /api/v1/instance/activity: one of 'disabled', 'serve', 'anonymize'."`
+	InstanceAllowBackdatingStatuses     bool               `name:"instance-allow-backdating-statuses" usage:"Allow local accounts to backdate statuses using the scheduled_at param to /api/v1/statuses"`
+	InstanceRelayEndpoint               bool               `name:"instance-relay-endpoint" usage:"Act as an ActivityPub relay: accept Follows of the instance actor from peers, and Announce local public statuses, and public statuses sent by subscribed peers, to them."`
+	InstanceIntegrityProofsSign         bool               `name:"instance-integrity-proofs-sign" usage:"Sign outgoing activities with FEP-8b32 object integrity proofs, and publish the key used to verify these on local actors."`
+	InstanceIntegrityProofsVerify       bool               `name:"instance-integrity-proofs-verify" usage:"Verify FEP-8b32 object integrity proofs on incoming activities, accepting validly signed forwarded activities without refetching them."`
+	InstanceExperimentalC2S             bool               `name:"instance-experimental-c2s" usage:"Experimental: allow local accounts to post Create (Note) and Delete activities to their own outbox using an OAuth token, as per ActivityPub client-to-server."`
+
+	AccountsRegistrationOpen         bool          `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
+	AccountsReasonRequired           bool          `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
+	AccountsRegistrationDailyLimit   int           `name:"accounts-registration-daily-limit" usage:"Limit amount of approved account sign-ups allowed per 24hrs before registration is closed. 0 or less = no limit."`
+	AccountsRegistrationBacklogLimit int           `name:"accounts-registration-backlog-limit" usage:"Limit how big the 'accounts pending approval' queue can grow before registration is closed. 0 or less = no limit."`
+	AccountsInvitesAllowed           string        `name:"accounts-invites-allowed" usage:"Which local accounts may create invite links for sign-up: none, moderators (includes admins), or users (everyone)."`
+	AccountsAllowCustomCSS           bool          `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
+	AccountsCustomCSSLength          int           `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
+	AccountsAllowCustomDomains       bool          `name:"accounts-allow-custom-domains" usage:"Allow accounts to request a vanity domain of their own, which, once verified and approved by an admin, redirects to their profile and can be used to look them up."`
+	AccountsMaxProfileFields         int           `name:"accounts-max-profile-fields" usage:"Maximum number of profile fields allowed for each account."`
+	AccountsProfileMediaHistory      int           `name:"accounts-profile-media-history" usage:"Number of previous avatars and headers to keep for each local account, so they can be restored without re-uploading. 0 = keep none."`
+	AccountsSuspensionGracePeriod    time.Duration `name:"accounts-suspension-grace-period" usage:"Keep the data of suspended local accounts (hidden) for this long before deleting it, during which the suspension can be reversed. 0 = delete immediately."`
+	AccountsFollowRequestExpiry      time.Duration `name:"accounts-follow-request-expiry" usage:"Automatically reject pending follow requests of local accounts once they are older than this. 0 = never expire."`
+	AccountsLoginHistoryRetention    time.Duration `name:"accounts-login-history-retention" usage:"Keep records of sign-in attempts to local accounts (IP address, user-agent, success) for this long, so users can review them. 0 = don't record sign-in attempts."`
+	AccountsLoginNotificationEmails  bool          `name:"accounts-login-notification-emails" usage:"Email users when their account is signed in to from an IP address not seen in their sign-in history. Requires accounts-login-history-retention to be set."`
+	AccountsStatsRecountEvery        time.Duration `name:"accounts-stats-recount-every" usage:"Recompute the cached follower, following, and status counts of all accounts from scratch this often, to correct any drift. 0 = never."`
 
 	StorageBackend        string `name:"storage-backend" usage:"Storage backend to use for media attachments"`
 	StorageLocalBasePath  string `name:"storage-local-base-path" usage:"Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir."`
@@ -126,11 +148,31 @@ type Configuration struct {
 	StorageS3BucketLookup string `name:"storage-s3-bucket-lookup" usage:"S3 bucket lookup type to use. Can be 'auto', 'dns' or 'path'. Defaults to 'auto'."`
 	StorageS3KeyPrefix    string `name:"storage-s3-key-prefix" usage:"Prefix to use for S3 keys. This is useful for separating multiple instances sharing the same S3 bucket."`
 
+	StorageS3MultipartThreshold bytesize.Size `name:"storage-s3-multipart-threshold" usage:"Files larger than this size will be uploaded to S3 in parts, with each part retried individually on failure. 0 = disabled."`
+	StorageS3MultipartPartSize  bytesize.Size `name:"storage-s3-multipart-part-size" usage:"Size of each part of an S3 multipart upload. Must be at least 5MiB."`
+	StorageS3MultipartRetries   int           `name:"storage-s3-multipart-retries" usage:"Number of times to retry uploading a single part of an S3 multipart upload before giving up."`
+	StorageS3RemoteCacheTag     string        `name:"storage-s3-remote-cache-tag" usage:"Object tag (in the form 'key=value') to apply to cached remote media in S3. If set, GoToSocial will leave expiry of cached remote media to bucket lifecycle rules."`
+
 	StatusesMaxChars           int `name:"statuses-max-chars" usage:"Max permitted characters for posted statuses, including content warning"`
 	StatusesPollMaxOptions     int `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
 	StatusesPollOptionMaxChars int `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
 	StatusesMediaMaxFiles      int `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
 
+	StatusesSyntaxHighlighting bool `name:"statuses-syntax-highlighting" usage:"Apply server-side syntax highlighting to fenced code blocks in markdown statuses that specify a supported language."`
+
+	StatusesBackfillReplies            bool          `name:"statuses-backfill-replies" usage:"When a user views a thread of a remote status, asynchronously dereference its replies collection so more of the conversation is shown on subsequent loads."`
+	StatusesBackfillRepliesMaxDepth    int           `name:"statuses-backfill-replies-max-depth" usage:"Maximum depth of replies to follow down from a viewed status when backfilling replies. 0 = no limit."`
+	StatusesBackfillRepliesMaxStatuses int           `name:"statuses-backfill-replies-max-statuses" usage:"Maximum number of reply statuses to dereference per backfill of a viewed status. 0 = no limit."`
+	StatusesBackfillRepliesCooldown    time.Duration `name:"statuses-backfill-replies-cooldown" usage:"Minimum time between replies backfills of the same status, to avoid hammering origin servers."`
+
+	StatusesExpiryMinDuration time.Duration `name:"statuses-expiry-min-duration" usage:"Minimum amount of time after creation that a status can be set to expire (be automatically deleted)."`
+	StatusesExpiryMaxDuration time.Duration `name:"statuses-expiry-max-duration" usage:"Maximum amount of time after creation that a status can be set to expire (be automatically deleted). 0 = no limit."`
+	StatusesCleanupInterval   time.Duration `name:"statuses-cleanup-interval" usage:"Time to wait between each status deleted or unlisted by a user's cleanup of their old statuses, to avoid flooding other instances with deliveries."`
+
+	StatusesLinkPreviews       bool          `name:"statuses-link-previews" usage:"Generate link preview cards for the first link in statuses, by fetching the Open Graph / oEmbed metadata of the linked page."`
+	StatusesLinkPreviewsTTL    time.Duration `name:"statuses-link-previews-ttl" usage:"Period after which a stored link preview card is considered stale, and refetched the next time a status links to it."`
+	StatusesLinkPreviewsRemote bool          `name:"statuses-link-previews-remote" usage:"Also generate link preview cards for statuses received from remote instances, rather than only for statuses created on this instance."`
+
 	ScheduledStatusesMaxTotal int `name:"scheduled-statuses-max-total" usage:"Maximum number of scheduled statuses per user"`
 	ScheduledStatusesMaxDaily int `name:"scheduled-statuses-max-daily" usage:"Maximum number of scheduled statuses per user for a single day"`
 
@@ -166,6 +208,15 @@ type Configuration struct {
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
 
+	TranslationProvider  string `name:"translation-provider" usage:"Provider to use for translating statuses: [deepl, libretranslate]. Leave empty to disable translation."`
+	TranslationEndpoint  string `name:"translation-endpoint" usage:"Base URL of the translation provider API. Required for libretranslate. Leave empty to use the default DeepL API for deepl."`
+	TranslationAPIKey    string `name:"translation-api-key" usage:"API key to authenticate with the translation provider."`
+	TranslationRateLimit int    `name:"translation-rate-limit" usage:"Amount of translations each user may request within an hour. 0 or less turns rate limiting off."`
+
+	EventBusProvider      string `name:"event-bus-provider" usage:"Message broker to publish lifecycle events (statuses created/deleted, accounts updated, reports opened) to: [nats]. Leave empty to disable the event bus."`
+	EventBusURL           string `name:"event-bus-url" usage:"URL of the event bus message broker, eg., 'nats://localhost:4222'. Use scheme 'tls' to connect with TLS."`
+	EventBusSubjectPrefix string `name:"event-bus-subject-prefix" usage:"Prefix of the subjects events are published on, eg., 'gotosocial' gives 'gotosocial.status.created'."`
+
 	// Advanced flags.
 	Advanced AdvancedConfig `name:"advanced"`
 
@@ -182,36 +233,73 @@ type Configuration struct {
 	AdminAccountUsername     string `name:"username" usage:"the username to create/delete/etc" ephemeral:"yes"`
 	AdminAccountEmail        string `name:"email" usage:"the email address of this account" ephemeral:"yes"`
 	AdminAccountPassword     string `name:"password" usage:"the password to set for this account" ephemeral:"yes"`
+	AdminAccountQuery        string `name:"query" usage:"text to search for in the username or email address of local accounts" ephemeral:"yes"`
+	AdminAccountRole         string `name:"role" usage:"the role to promote this account to: admin or moderator" ephemeral:"yes"`
 	AdminTransPath           string `name:"path" usage:"the path of the file to import from/export to" ephemeral:"yes"`
 	AdminMediaPruneDryRun    bool   `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning" ephemeral:"yes"`
 	AdminMediaListLocalOnly  bool   `name:"local-only" usage:"list only local attachments/emojis; if specified then remote-only cannot also be true" ephemeral:"yes"`
 	AdminMediaListRemoteOnly bool   `name:"remote-only" usage:"list only remote attachments/emojis; if specified then local-only cannot also be true" ephemeral:"yes"`
+	AdminMediaID             string `name:"id" usage:"the ID of the media attachment to inspect/recache" ephemeral:"yes"`
+	AdminMediaDomain         string `name:"domain" usage:"recache all media attachments of accounts on the given remote domain; if specified then id cannot also be set" ephemeral:"yes"`
 	TestrigSkipDBSetup       bool   `name:"skip-db-setup" usage:"skip testrig database setup with population of test models" ephemeral:"yes"`
 	TestrigSkipDBTeardown    bool   `name:"skip-db-teardown" usage:"skip testrig database teardown (i.e. data deletion and tables dropped)" ephemeral:"yes"`
+	TestrigClientFixtures    bool   `name:"client-fixtures" usage:"seed the testrig with the additional documented client fixtures (see docs/api/testrig.md)" ephemeral:"yes"`
 }
 
 type HTTPClientConfiguration struct {
 	AllowIPs              []string      `name:"allow-ips"`
 	BlockIPs              []string      `name:"block-ips"`
 	Timeout               time.Duration `name:"timeout"`
+	WebfingerTimeout      time.Duration `name:"webfinger-timeout"`
+	DereferenceTimeout    time.Duration `name:"dereference-timeout"`
+	MediaTimeout          time.Duration `name:"media-timeout"`
+	DeliveryTimeout       time.Duration `name:"delivery-timeout"`
+	MaxIdleConnsPerHost   int           `name:"max-idle-conns-per-host"`
+	IdleConnTimeout       time.Duration `name:"idle-conn-timeout"`
+	DisableHTTP2          bool          `name:"disable-http2"`
+	DNSCacheTTL           time.Duration `name:"dns-cache-ttl"`
+	DNSCacheNegativeTTL   time.Duration `name:"dns-cache-negative-ttl"`
+	IPFamilyPreference    string        `name:"ip-family-preference"`
+	HappyEyeballsDelay    time.Duration `name:"happy-eyeballs-delay"`
+	IPv4OnlyDomains       []string      `name:"ipv4-only-domains"`
 	TLSInsecureSkipVerify bool          `name:"tls-insecure-skip-verify"`
 	InsecureOutgoing      bool          `name:"insecure-outgoing"`
 }
 
 type MediaConfiguration struct {
-	DescriptionMinChars int           `name:"description-min-chars" usage:"Min required chars for an image description"`
-	DescriptionMaxChars int           `name:"description-max-chars" usage:"Max permitted chars for an image description"`
-	RemoteCacheDays     int           `name:"remote-cache-days" usage:"Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely."`
-	EmojiLocalMaxSize   bytesize.Size `name:"emoji-local-max-size" usage:"Max size in bytes of emojis uploaded to this instance via the admin API."`
-	EmojiRemoteMaxSize  bytesize.Size `name:"emoji-remote-max-size" usage:"Max size in bytes of emojis to download from other instances."`
-	ImageSizeHint       bytesize.Size `name:"image-size-hint" usage:"Size in bytes of max image size referred to on /api/v_/instance endpoints (else, local max size)"`
-	VideoSizeHint       bytesize.Size `name:"video-size-hint" usage:"Size in bytes of max video size referred to on /api/v_/instance endpoints (else, local max size)"`
-	LocalMaxSize        bytesize.Size `name:"local-max-size" usage:"Max size in bytes of media uploaded to this instance via API"`
-	RemoteMaxSize       bytesize.Size `name:"remote-max-size" usage:"Max size in bytes of media to download from other instances"`
-	CleanupFrom         string        `name:"cleanup-from" usage:"Time of day from which to start running media cleanup/prune jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
-	CleanupEvery        time.Duration `name:"cleanup-every" usage:"Period to elapse between cleanups, starting from media-cleanup-at."`
-	FfmpegPoolSize      int           `name:"ffmpeg-pool-size" usage:"Number of instances of the embedded ffmpeg WASM binary to add to the media processing pool. 0 or less uses GOMAXPROCS."`
-	ThumbMaxPixels      int           `name:"thumb-max-pixels" usage:"Max size in pixels of any one dimension of a thumbnail (as input media ratio is preserved)."`
+	DescriptionMinChars         int           `name:"description-min-chars" usage:"Min required chars for an image description"`
+	DescriptionMaxChars         int           `name:"description-max-chars" usage:"Max permitted chars for an image description"`
+	DescriptionPolicy           string        `name:"description-policy" usage:"Policy for descriptions of media attached to new statuses: one of 'off', 'remind', 'require'. Accounts may choose a stricter policy for themselves."`
+	DescribeProvider            string        `name:"describe-provider" usage:"Backend to use for suggesting descriptions of image attachments: [tesseract, http]. Leave empty to disable description suggestions."`
+	DescribeEndpoint            string        `name:"describe-endpoint" usage:"URL of the HTTP endpoint to send images to for description suggestions. Required for http."`
+	DescribeCommand             string        `name:"describe-command" usage:"Command (with any extra arguments) used to run tesseract for description suggestions."`
+	DescribeConcurrency         int           `name:"describe-concurrency" usage:"Max number of description suggestions to generate at once. 0 or less uses 1."`
+	DescribeTimeout             time.Duration `name:"describe-timeout" usage:"Max time to spend generating any one description suggestion."`
+	RemoteCacheDays             int           `name:"remote-cache-days" usage:"Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely."`
+	EmojiLocalMaxSize           bytesize.Size `name:"emoji-local-max-size" usage:"Max size in bytes of emojis uploaded to this instance via the admin API."`
+	EmojiRemoteMaxSize          bytesize.Size `name:"emoji-remote-max-size" usage:"Max size in bytes of emojis to download from other instances."`
+	EmojiStaticOnly             bool          `name:"emoji-static-only" usage:"Serve only the static (non-animated) version of custom emojis to client apps and the web frontend."`
+	ImageSizeHint               bytesize.Size `name:"image-size-hint" usage:"Size in bytes of max image size referred to on /api/v_/instance endpoints (else, local max size)"`
+	VideoSizeHint               bytesize.Size `name:"video-size-hint" usage:"Size in bytes of max video size referred to on /api/v_/instance endpoints (else, local max size)"`
+	LocalMaxSize                bytesize.Size `name:"local-max-size" usage:"Max size in bytes of media uploaded to this instance via API"`
+	LocalQuota                  bytesize.Size `name:"local-quota" usage:"Default max total size in bytes of media stored for each local account, which admins may override per account. 0 = no quota."`
+	RemoteMaxSize               bytesize.Size `name:"remote-max-size" usage:"Max size in bytes of media to download from other instances"`
+	ImageMaxSize                bytesize.Size `name:"image-max-size" usage:"Max size in bytes of image media, local or remote. 0 = only local-max-size / remote-max-size apply."`
+	ImageMaxMegapixels          int           `name:"image-max-megapixels" usage:"Max resolution in megapixels (width x height / 1,000,000) of image media, local or remote. 0 = no limit."`
+	VideoMaxSize                bytesize.Size `name:"video-max-size" usage:"Max size in bytes of video media, local or remote. 0 = only local-max-size / remote-max-size apply."`
+	VideoMaxDuration            time.Duration `name:"video-max-duration" usage:"Max duration of video media, local or remote. 0 = no limit."`
+	VideoMaxBitrate             int           `name:"video-max-bitrate" usage:"Max overall bitrate in bits per second of video media, local or remote. 0 = no limit."`
+	AudioMaxSize                bytesize.Size `name:"audio-max-size" usage:"Max size in bytes of audio media, local or remote. 0 = only local-max-size / remote-max-size apply."`
+	AudioMaxDuration            time.Duration `name:"audio-max-duration" usage:"Max duration of audio media, local or remote. 0 = no limit."`
+	AudioMaxBitrate             int           `name:"audio-max-bitrate" usage:"Max overall bitrate in bits per second of audio media, local or remote. 0 = no limit."`
+	RemoteSensitiveBlurhashOnly bool          `name:"remote-sensitive-blurhash-only" usage:"Do not download media marked sensitive from other instances; keep only its blurhash and dimensions, and link to the remote file instead."`
+	PreserveColorProfile        bool          `name:"preserve-color-profile" usage:"Keep the ICC color profile of uploaded images, and apply any EXIF orientation directly to image pixels so all EXIF data can be stripped. Can be overridden per upload."`
+	CleanupFrom                 string        `name:"cleanup-from" usage:"Time of day from which to start running media cleanup/prune jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
+	CleanupEvery                time.Duration `name:"cleanup-every" usage:"Period to elapse between cleanups, starting from media-cleanup-at."`
+	FfmpegPoolSize              int           `name:"ffmpeg-pool-size" usage:"Number of instances of the embedded ffmpeg WASM binary to add to the media processing pool. 0 or less uses GOMAXPROCS."`
+	ThumbMaxPixels              int           `name:"thumb-max-pixels" usage:"Max size in pixels of any one dimension of a thumbnail (as input media ratio is preserved)."`
+	VariantFormats              []string      `name:"variant-formats" usage:"Formats to convert JPEG and PNG images to on the fly for clients that accept them, in order of preference: [webp]. Leave empty to disable image variants."`
+	VariantCacheSize            bytesize.Size `name:"variant-cache-size" usage:"Max total size in bytes of converted image variants to keep cached in memory."`
 }
 
 type CacheConfiguration struct {
@@ -228,6 +316,7 @@ type CacheConfiguration struct {
 	BlockMemRatio                        float64       `name:"block-mem-ratio"`
 	BlockIDsMemRatio                     float64       `name:"block-ids-mem-ratio"`
 	BoostOfIDsMemRatio                   float64       `name:"boost-of-ids-mem-ratio"`
+	CardMemRatio                         float64       `name:"card-mem-ratio"`
 	ClientMemRatio                       float64       `name:"client-mem-ratio"`
 	ConversationMemRatio                 float64       `name:"conversation-mem-ratio"`
 	ConversationLastStatusIDsMemRatio    float64       `name:"conversation-last-status-ids-mem-ratio"`
@@ -271,6 +360,7 @@ type CacheConfiguration struct {
 	StatusFaveIDsMemRatio                float64       `name:"status-fave-ids-mem-ratio"`
 	TagMemRatio                          float64       `name:"tag-mem-ratio"`
 	ThreadMuteMemRatio                   float64       `name:"thread-mute-mem-ratio"`
+	ThreadSubscriptionMemRatio           float64       `name:"thread-subscription-mem-ratio"`
 	TokenMemRatio                        float64       `name:"token-mem-ratio"`
 	TombstoneMemRatio                    float64       `name:"tombstone-mem-ratio"`
 	UserMemRatio                         float64       `name:"user-mem-ratio"`
@@ -282,6 +372,10 @@ type CacheConfiguration struct {
 	MutesMemRatio                        float64       `name:"mutes-mem-ratio"`
 	StatusFilterMemRatio                 float64       `name:"status-filter-mem-ratio"`
 	VisibilityMemRatio                   float64       `name:"visibility-mem-ratio"`
+	StatusRepliesBackfillMemRatio        float64       `name:"status-replies-backfill-mem-ratio"`
+	IdempotencyMemRatio                  float64       `name:"idempotency-mem-ratio"`
+	AccountUsageMemRatio                 float64       `name:"account-usage-mem-ratio"`
+	AccountActivityMemRatio              float64       `name:"account-activity-mem-ratio"`
 }
 
 type AdvancedConfig struct {
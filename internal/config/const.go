@@ -42,3 +42,76 @@
 	InstanceStatsModeZero    = "zero"
 	InstanceStatsModeBaffle  = "baffle"
 )
+
+// Instance peers mode determines if and how
+// known peers are served at api/v1/instance/peers.
+const (
+	InstancePeersModeServe     = "serve"
+	InstancePeersModeAnonymize = "anonymize"
+	InstancePeersModeDisabled  = "disabled"
+)
+
+// Instance activity mode determines if and how weekly
+// activity is served at api/v1/instance/activity.
+const (
+	InstanceActivityModeDisabled  = "disabled"
+	InstanceActivityModeServe     = "serve"
+	InstanceActivityModeAnonymize = "anonymize"
+)
+
+// HTTP client IP family preference determines which
+// IP address family is dialed first for outgoing
+// connections, when both are available for a host.
+const (
+	HTTPClientIPFamilyAuto = "auto"
+	HTTPClientIPFamilyIPv4 = "ipv4"
+	HTTPClientIPFamilyIPv6 = "ipv6"
+)
+
+// Accounts invites allowed determines which
+// local accounts may create invites for sign-up.
+const (
+	AccountsInvitesAllowedNone       = "none"
+	AccountsInvitesAllowedModerators = "moderators"
+	AccountsInvitesAllowedUsers      = "users"
+)
+
+// Translation provider determines which external
+// service is used to translate statuses (if any).
+const (
+	TranslationProviderNone           = ""
+	TranslationProviderDeepL          = "deepl"
+	TranslationProviderLibreTranslate = "libretranslate"
+)
+
+// Media description policy determines whether
+// media attached to new statuses needs a description.
+const (
+	MediaDescriptionPolicyOff     = "off"
+	MediaDescriptionPolicyRemind  = "remind"
+	MediaDescriptionPolicyRequire = "require"
+)
+
+// Media describe provider determines which backend
+// is used to suggest media descriptions (if any).
+const (
+	MediaDescribeProviderNone      = ""
+	MediaDescribeProviderTesseract = "tesseract"
+	MediaDescribeProviderHTTP      = "http"
+)
+
+// Media variant formats are the formats that
+// JPEG and PNG images may be converted to when
+// a client indicates it accepts them. AVIF is
+// not (yet) among them, as the embedded ffmpeg
+// can't run its AV1 encoder.
+const (
+	MediaVariantFormatWebP = "webp"
+)
+
+// Event bus provider determines which message broker
+// lifecycle events are published to (if any).
+const (
+	EventBusProviderNone = ""
+	EventBusProviderNATS = "nats"
+)
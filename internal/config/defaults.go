@@ -58,29 +58,46 @@
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
 
-	InstanceFederationMode:            InstanceFederationModeDefault,
-	InstanceFederationSpamFilter:      false,
-	InstanceExposePeers:               false,
-	InstanceExposeBlocklist:           false,
-	InstanceExposeBlocklistWeb:        false,
-	InstanceExposeCustomEmojis:        false,
-	InstanceDeliverToSharedInboxes:    true,
-	InstanceLanguages:                 make(language.Languages, 0),
-	InstanceSubscriptionsProcessFrom:  "23:00",        // 11pm,
-	InstanceSubscriptionsProcessEvery: 24 * time.Hour, // 1/day.
-	InstanceAllowBackdatingStatuses:   true,
+	InstanceFederationMode:              InstanceFederationModeDefault,
+	InstanceFederationSpamFilter:        false,
+	InstanceFederationSeenActivityTTL:   72 * time.Hour,
+	InstanceFederationFailedActivityTTL: 168 * time.Hour,
+	InstanceExposePeers:                 false,
+	InstanceExposeBlocklist:             false,
+	InstanceExposeBlocklistWeb:          false,
+	InstanceExposeBlocklistComments:     true,
+	InstanceExposeCustomEmojis:          false,
+	InstanceDeliverToSharedInboxes:      true,
+	InstanceLanguages:                   make(language.Languages, 0),
+	InstanceSubscriptionsProcessFrom:    "23:00",        // 11pm,
+	InstanceSubscriptionsProcessEvery:   24 * time.Hour, // 1/day.
+	InstancePeersCrawlEvery:             24 * time.Hour, // 1/day.
+	InstanceSitemapGenerateEvery:        6 * time.Hour,  // 4/day.
+	InstancePeersMode:                   InstancePeersModeServe,
+	InstanceActivityMode:                InstanceActivityModeDisabled,
+	InstanceAllowBackdatingStatuses:     true,
 
 	AccountsRegistrationOpen:         false,
 	AccountsReasonRequired:           true,
 	AccountsRegistrationDailyLimit:   10,
 	AccountsRegistrationBacklogLimit: 20,
+	AccountsInvitesAllowed:           AccountsInvitesAllowedNone,
 	AccountsAllowCustomCSS:           false,
 	AccountsCustomCSSLength:          10000,
+	AccountsAllowCustomDomains:       false,
 	AccountsMaxProfileFields:         6,
+	AccountsProfileMediaHistory:      3,
+	AccountsLoginHistoryRetention:    90 * 24 * time.Hour,
+	AccountsLoginNotificationEmails:  false,
+	AccountsStatsRecountEvery:        7 * 24 * time.Hour,
 
 	Media: MediaConfiguration{
 		DescriptionMinChars: 0,
 		DescriptionMaxChars: 1500,
+		DescriptionPolicy:   MediaDescriptionPolicyOff,
+		DescribeCommand:     "tesseract",
+		DescribeConcurrency: 1,
+		DescribeTimeout:     30 * time.Second,
 		RemoteCacheDays:     7,
 		LocalMaxSize:        40 * bytesize.MiB,
 		RemoteMaxSize:       40 * bytesize.MiB,
@@ -90,6 +107,7 @@
 		CleanupEvery:        24 * time.Hour, // 1/day.
 		FfmpegPoolSize:      1,
 		ThumbMaxPixels:      512,
+		VariantCacheSize:    64 * bytesize.MiB,
 	},
 
 	StorageBackend:        "local",
@@ -99,11 +117,30 @@
 	StorageS3RedirectURL:  "",
 	StorageS3BucketLookup: "auto",
 
+	StorageS3MultipartThreshold: 32 * bytesize.MiB,
+	StorageS3MultipartPartSize:  16 * bytesize.MiB,
+	StorageS3MultipartRetries:   3,
+
 	StatusesMaxChars:           5000,
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
 
+	StatusesSyntaxHighlighting: true,
+
+	StatusesBackfillReplies:            false,
+	StatusesBackfillRepliesMaxDepth:    5,
+	StatusesBackfillRepliesMaxStatuses: 100,
+	StatusesBackfillRepliesCooldown:    30 * time.Minute,
+
+	StatusesExpiryMinDuration: 5 * time.Minute,
+	StatusesExpiryMaxDuration: 365 * 24 * time.Hour,
+	StatusesCleanupInterval:   2 * time.Second,
+
+	StatusesLinkPreviews:       true,
+	StatusesLinkPreviewsRemote: false,
+	StatusesLinkPreviewsTTL:    7 * 24 * time.Hour,
+
 	ScheduledStatusesMaxTotal: 300,
 	ScheduledStatusesMaxDaily: 25,
 
@@ -138,6 +175,15 @@
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
+	TranslationProvider:  "",
+	TranslationEndpoint:  "",
+	TranslationAPIKey:    "",
+	TranslationRateLimit: 30,
+
+	EventBusProvider:      "",
+	EventBusURL:           "",
+	EventBusSubjectPrefix: "gotosocial",
+
 	Advanced: AdvancedConfig{
 		SenderMultiplier: 2, // 2 senders per CPU
 		CSPExtraURIs:     []string{},
@@ -200,6 +246,7 @@
 		BlockMemRatio:                        2,
 		BlockIDsMemRatio:                     3,
 		BoostOfIDsMemRatio:                   3,
+		CardMemRatio:                         0.5,
 		ClientMemRatio:                       0.1,
 		ConversationMemRatio:                 1,
 		ConversationLastStatusIDsMemRatio:    2,
@@ -243,6 +290,7 @@
 		StatusFaveIDsMemRatio:                3,
 		TagMemRatio:                          2,
 		ThreadMuteMemRatio:                   0.2,
+		ThreadSubscriptionMemRatio:           0.2,
 		TokenMemRatio:                        0.75,
 		TombstoneMemRatio:                    0.5,
 		UserMemRatio:                         0.25,
@@ -254,12 +302,28 @@
 		MutesMemRatio:                        2,
 		StatusFilterMemRatio:                 7,
 		VisibilityMemRatio:                   2,
+		StatusRepliesBackfillMemRatio:        0.1,
+		IdempotencyMemRatio:                  0.1,
+		AccountUsageMemRatio:                 0.1,
+		AccountActivityMemRatio:              0.1,
 	},
 
 	HTTPClient: HTTPClientConfiguration{
 		AllowIPs:              make([]string, 0),
 		BlockIPs:              make([]string, 0),
 		Timeout:               30 * time.Second,
+		WebfingerTimeout:      15 * time.Second,
+		DereferenceTimeout:    30 * time.Second,
+		MediaTimeout:          2 * time.Minute,
+		DeliveryTimeout:       30 * time.Second,
+		MaxIdleConnsPerHost:   2,
+		IdleConnTimeout:       90 * time.Second,
+		DisableHTTP2:          false,
+		DNSCacheTTL:           5 * time.Minute,
+		DNSCacheNegativeTTL:   30 * time.Second,
+		IPFamilyPreference:    HTTPClientIPFamilyAuto,
+		HappyEyeballsDelay:    300 * time.Millisecond,
+		IPv4OnlyDomains:       make([]string, 0),
 		TLSInsecureSkipVerify: false,
 	},
 
@@ -43,6 +43,26 @@ func AddAdminAccountPassword(cmd *cobra.Command) {
 	}
 }
 
+// AddAdminAccountSearch attaches flags pertaining to admin account search.
+func AddAdminAccountSearch(cmd *cobra.Command) {
+	name := AdminAccountQueryFlag
+	usage := fieldtag("AdminAccountQuery", "usage")
+	cmd.Flags().String(name, "", usage) // REQUIRED
+	if err := cmd.MarkFlagRequired(name); err != nil {
+		panic(err)
+	}
+}
+
+// AddAdminAccountPromote attaches flags pertaining to admin account promotion.
+func AddAdminAccountPromote(cmd *cobra.Command) {
+	// Requires account
+	AddAdminAccount(cmd)
+
+	name := AdminAccountRoleFlag
+	usage := fieldtag("AdminAccountRole", "usage")
+	cmd.Flags().String(name, "admin", usage)
+}
+
 // AddAdminAccountCreate attaches flags pertaining to admin account creation.
 func AddAdminAccountCreate(cmd *cobra.Command) {
 	// Requires both account and password
@@ -85,6 +105,27 @@ func AddAdminMediaPrune(cmd *cobra.Command) {
 	cmd.Flags().Bool(name, true, usage)
 }
 
+// AddAdminMediaInspect attaches flags pertaining to media inspect commands.
+func AddAdminMediaInspect(cmd *cobra.Command) {
+	name := AdminMediaIDFlag
+	usage := fieldtag("AdminMediaID", "usage")
+	cmd.Flags().String(name, "", usage) // REQUIRED
+	if err := cmd.MarkFlagRequired(name); err != nil {
+		panic(err)
+	}
+}
+
+// AddAdminMediaRecache attaches flags pertaining to media recache commands.
+func AddAdminMediaRecache(cmd *cobra.Command) {
+	id := AdminMediaIDFlag
+	idUsage := fieldtag("AdminMediaID", "usage")
+	cmd.Flags().String(id, "", idUsage)
+
+	domain := AdminMediaDomainFlag
+	domainUsage := fieldtag("AdminMediaDomain", "usage")
+	cmd.Flags().String(domain, "", domainUsage)
+}
+
 // AddTestrig attaches flags pertaining to testrig commands.
 func AddTestrig(cmd *cobra.Command) {
 	skipDBSetup := TestrigSkipDBSetupFlag
@@ -94,4 +135,8 @@ func AddTestrig(cmd *cobra.Command) {
 	skipDBTeardown := TestrigSkipDBTeardownFlag
 	skipDBTeardownUsage := fieldtag("TestrigSkipDBTeardown", "usage")
 	cmd.Flags().Bool(skipDBTeardown, false, skipDBTeardownUsage)
+
+	clientFixtures := TestrigClientFixturesFlag
+	clientFixturesUsage := fieldtag("TestrigClientFixtures", "usage")
+	cmd.Flags().Bool(clientFixtures, false, clientFixturesUsage)
 }
@@ -40,6 +40,7 @@
 	LandingPageUserFlag                           = "landing-page-user"
 	HostFlag                                      = "host"
 	AccountDomainFlag                             = "account-domain"
+	AccountDomainAliasesFlag                      = "account-domain-aliases"
 	ProtocolFlag                                  = "protocol"
 	BindAddressFlag                               = "bind-address"
 	PortFlag                                      = "port"
@@ -63,27 +64,48 @@
 	WebAssetBaseDirFlag                           = "web-asset-base-dir"
 	InstanceFederationModeFlag                    = "instance-federation-mode"
 	InstanceFederationSpamFilterFlag              = "instance-federation-spam-filter"
+	InstanceFederationSeenActivityTTLFlag         = "instance-federation-seen-activity-ttl"
+	InstanceFederationFailedActivityTTLFlag       = "instance-federation-failed-activity-ttl"
 	InstanceExposePeersFlag                       = "instance-expose-peers"
 	InstanceExposeBlocklistFlag                   = "instance-expose-blocklist"
 	InstanceExposeBlocklistWebFlag                = "instance-expose-blocklist-web"
+	InstanceExposeBlocklistCommentsFlag           = "instance-expose-blocklist-comments"
 	InstanceExposeAllowlistFlag                   = "instance-expose-allowlist"
 	InstanceExposeAllowlistWebFlag                = "instance-expose-allowlist-web"
 	InstanceExposePublicTimelineFlag              = "instance-expose-public-timeline"
 	InstanceExposeCustomEmojisFlag                = "instance-expose-custom-emojis"
+	InstanceExposeTagFeedsFlag                    = "instance-expose-tag-feeds"
+	InstanceExposeLocalFeedFlag                   = "instance-expose-local-feed"
 	InstanceDeliverToSharedInboxesFlag            = "instance-deliver-to-shared-inboxes"
 	InstanceInjectMastodonVersionFlag             = "instance-inject-mastodon-version"
 	InstanceLanguagesFlag                         = "instance-languages"
 	InstanceSubscriptionsProcessFromFlag          = "instance-subscriptions-process-from"
 	InstanceSubscriptionsProcessEveryFlag         = "instance-subscriptions-process-every"
+	InstancePeersCrawlEveryFlag                   = "instance-peers-crawl-every"
+	InstanceSitemapGenerateEveryFlag              = "instance-sitemap-generate-every"
 	InstanceStatsModeFlag                         = "instance-stats-mode"
+	InstancePeersModeFlag                         = "instance-peers-mode"
+	InstanceActivityModeFlag                      = "instance-activity-mode"
 	InstanceAllowBackdatingStatusesFlag           = "instance-allow-backdating-statuses"
+	InstanceRelayEndpointFlag                     = "instance-relay-endpoint"
+	InstanceIntegrityProofsSignFlag               = "instance-integrity-proofs-sign"
+	InstanceIntegrityProofsVerifyFlag             = "instance-integrity-proofs-verify"
+	InstanceExperimentalC2SFlag                   = "instance-experimental-c2s"
 	AccountsRegistrationOpenFlag                  = "accounts-registration-open"
 	AccountsReasonRequiredFlag                    = "accounts-reason-required"
 	AccountsRegistrationDailyLimitFlag            = "accounts-registration-daily-limit"
 	AccountsRegistrationBacklogLimitFlag          = "accounts-registration-backlog-limit"
+	AccountsInvitesAllowedFlag                    = "accounts-invites-allowed"
 	AccountsAllowCustomCSSFlag                    = "accounts-allow-custom-css"
 	AccountsCustomCSSLengthFlag                   = "accounts-custom-css-length"
+	AccountsAllowCustomDomainsFlag                = "accounts-allow-custom-domains"
 	AccountsMaxProfileFieldsFlag                  = "accounts-max-profile-fields"
+	AccountsProfileMediaHistoryFlag               = "accounts-profile-media-history"
+	AccountsSuspensionGracePeriodFlag             = "accounts-suspension-grace-period"
+	AccountsFollowRequestExpiryFlag               = "accounts-follow-request-expiry"
+	AccountsLoginHistoryRetentionFlag             = "accounts-login-history-retention"
+	AccountsLoginNotificationEmailsFlag           = "accounts-login-notification-emails"
+	AccountsStatsRecountEveryFlag                 = "accounts-stats-recount-every"
 	StorageBackendFlag                            = "storage-backend"
 	StorageLocalBasePathFlag                      = "storage-local-base-path"
 	StorageS3EndpointFlag                         = "storage-s3-endpoint"
@@ -95,10 +117,25 @@
 	StorageS3RedirectURLFlag                      = "storage-s3-redirect-url"
 	StorageS3BucketLookupFlag                     = "storage-s3-bucket-lookup"
 	StorageS3KeyPrefixFlag                        = "storage-s3-key-prefix"
+	StorageS3MultipartThresholdFlag               = "storage-s3-multipart-threshold"
+	StorageS3MultipartPartSizeFlag                = "storage-s3-multipart-part-size"
+	StorageS3MultipartRetriesFlag                 = "storage-s3-multipart-retries"
+	StorageS3RemoteCacheTagFlag                   = "storage-s3-remote-cache-tag"
 	StatusesMaxCharsFlag                          = "statuses-max-chars"
 	StatusesPollMaxOptionsFlag                    = "statuses-poll-max-options"
 	StatusesPollOptionMaxCharsFlag                = "statuses-poll-option-max-chars"
 	StatusesMediaMaxFilesFlag                     = "statuses-media-max-files"
+	StatusesSyntaxHighlightingFlag                = "statuses-syntax-highlighting"
+	StatusesBackfillRepliesFlag                   = "statuses-backfill-replies"
+	StatusesBackfillRepliesMaxDepthFlag           = "statuses-backfill-replies-max-depth"
+	StatusesBackfillRepliesMaxStatusesFlag        = "statuses-backfill-replies-max-statuses"
+	StatusesBackfillRepliesCooldownFlag           = "statuses-backfill-replies-cooldown"
+	StatusesExpiryMinDurationFlag                 = "statuses-expiry-min-duration"
+	StatusesExpiryMaxDurationFlag                 = "statuses-expiry-max-duration"
+	StatusesCleanupIntervalFlag                   = "statuses-cleanup-interval"
+	StatusesLinkPreviewsFlag                      = "statuses-link-previews"
+	StatusesLinkPreviewsTTLFlag                   = "statuses-link-previews-ttl"
+	StatusesLinkPreviewsRemoteFlag                = "statuses-link-previews-remote"
 	ScheduledStatusesMaxTotalFlag                 = "scheduled-statuses-max-total"
 	ScheduledStatusesMaxDailyFlag                 = "scheduled-statuses-max-daily"
 	LetsEncryptEnabledFlag                        = "letsencrypt-enabled"
@@ -128,6 +165,13 @@
 	SyslogEnabledFlag                             = "syslog-enabled"
 	SyslogProtocolFlag                            = "syslog-protocol"
 	SyslogAddressFlag                             = "syslog-address"
+	TranslationProviderFlag                       = "translation-provider"
+	TranslationEndpointFlag                       = "translation-endpoint"
+	TranslationAPIKeyFlag                         = "translation-api-key"
+	TranslationRateLimitFlag                      = "translation-rate-limit"
+	EventBusProviderFlag                          = "event-bus-provider"
+	EventBusURLFlag                               = "event-bus-url"
+	EventBusSubjectPrefixFlag                     = "event-bus-subject-prefix"
 	AdvancedCookiesSamesiteFlag                   = "advanced-cookies-samesite"
 	AdvancedSenderMultiplierFlag                  = "advanced-sender-multiplier"
 	AdvancedCSPExtraURIsFlag                      = "advanced-csp-extra-uris"
@@ -139,21 +183,53 @@
 	HTTPClientAllowIPsFlag                        = "http-client-allow-ips"
 	HTTPClientBlockIPsFlag                        = "http-client-block-ips"
 	HTTPClientTimeoutFlag                         = "http-client-timeout"
+	HTTPClientWebfingerTimeoutFlag                = "http-client-webfinger-timeout"
+	HTTPClientDereferenceTimeoutFlag              = "http-client-dereference-timeout"
+	HTTPClientMediaTimeoutFlag                    = "http-client-media-timeout"
+	HTTPClientDeliveryTimeoutFlag                 = "http-client-delivery-timeout"
+	HTTPClientMaxIdleConnsPerHostFlag             = "http-client-max-idle-conns-per-host"
+	HTTPClientIdleConnTimeoutFlag                 = "http-client-idle-conn-timeout"
+	HTTPClientDisableHTTP2Flag                    = "http-client-disable-http2"
+	HTTPClientDNSCacheTTLFlag                     = "http-client-dns-cache-ttl"
+	HTTPClientDNSCacheNegativeTTLFlag             = "http-client-dns-cache-negative-ttl"
+	HTTPClientIPFamilyPreferenceFlag              = "http-client-ip-family-preference"
+	HTTPClientHappyEyeballsDelayFlag              = "http-client-happy-eyeballs-delay"
+	HTTPClientIPv4OnlyDomainsFlag                 = "http-client-ipv4-only-domains"
 	HTTPClientTLSInsecureSkipVerifyFlag           = "http-client-tls-insecure-skip-verify"
 	HTTPClientInsecureOutgoingFlag                = "http-client-insecure-outgoing"
 	MediaDescriptionMinCharsFlag                  = "media-description-min-chars"
 	MediaDescriptionMaxCharsFlag                  = "media-description-max-chars"
+	MediaDescriptionPolicyFlag                    = "media-description-policy"
+	MediaDescribeProviderFlag                     = "media-describe-provider"
+	MediaDescribeEndpointFlag                     = "media-describe-endpoint"
+	MediaDescribeCommandFlag                      = "media-describe-command"
+	MediaDescribeConcurrencyFlag                  = "media-describe-concurrency"
+	MediaDescribeTimeoutFlag                      = "media-describe-timeout"
 	MediaRemoteCacheDaysFlag                      = "media-remote-cache-days"
 	MediaEmojiLocalMaxSizeFlag                    = "media-emoji-local-max-size"
 	MediaEmojiRemoteMaxSizeFlag                   = "media-emoji-remote-max-size"
+	MediaEmojiStaticOnlyFlag                      = "media-emoji-static-only"
 	MediaImageSizeHintFlag                        = "media-image-size-hint"
 	MediaVideoSizeHintFlag                        = "media-video-size-hint"
 	MediaLocalMaxSizeFlag                         = "media-local-max-size"
+	MediaLocalQuotaFlag                           = "media-local-quota"
 	MediaRemoteMaxSizeFlag                        = "media-remote-max-size"
+	MediaImageMaxSizeFlag                         = "media-image-max-size"
+	MediaImageMaxMegapixelsFlag                   = "media-image-max-megapixels"
+	MediaVideoMaxSizeFlag                         = "media-video-max-size"
+	MediaVideoMaxDurationFlag                     = "media-video-max-duration"
+	MediaVideoMaxBitrateFlag                      = "media-video-max-bitrate"
+	MediaAudioMaxSizeFlag                         = "media-audio-max-size"
+	MediaAudioMaxDurationFlag                     = "media-audio-max-duration"
+	MediaAudioMaxBitrateFlag                      = "media-audio-max-bitrate"
+	MediaRemoteSensitiveBlurhashOnlyFlag          = "media-remote-sensitive-blurhash-only"
+	MediaPreserveColorProfileFlag                 = "media-preserve-color-profile"
 	MediaCleanupFromFlag                          = "media-cleanup-from"
 	MediaCleanupEveryFlag                         = "media-cleanup-every"
 	MediaFfmpegPoolSizeFlag                       = "media-ffmpeg-pool-size"
 	MediaThumbMaxPixelsFlag                       = "media-thumb-max-pixels"
+	MediaVariantFormatsFlag                       = "media-variant-formats"
+	MediaVariantCacheSizeFlag                     = "media-variant-cache-size"
 	CacheS3ObjectInfoFlag                         = "cache-s3-object-info"
 	CacheHomeTimelineTimeoutFlag                  = "cache-home-timeline-timeout"
 	CacheListTimelineTimeoutFlag                  = "cache-list-timeline-timeout"
@@ -167,6 +243,7 @@
 	CacheBlockMemRatioFlag                        = "cache-block-mem-ratio"
 	CacheBlockIDsMemRatioFlag                     = "cache-block-ids-mem-ratio"
 	CacheBoostOfIDsMemRatioFlag                   = "cache-boost-of-ids-mem-ratio"
+	CacheCardMemRatioFlag                         = "cache-card-mem-ratio"
 	CacheClientMemRatioFlag                       = "cache-client-mem-ratio"
 	CacheConversationMemRatioFlag                 = "cache-conversation-mem-ratio"
 	CacheConversationLastStatusIDsMemRatioFlag    = "cache-conversation-last-status-ids-mem-ratio"
@@ -210,6 +287,7 @@
 	CacheStatusFaveIDsMemRatioFlag                = "cache-status-fave-ids-mem-ratio"
 	CacheTagMemRatioFlag                          = "cache-tag-mem-ratio"
 	CacheThreadMuteMemRatioFlag                   = "cache-thread-mute-mem-ratio"
+	CacheThreadSubscriptionMemRatioFlag           = "cache-thread-subscription-mem-ratio"
 	CacheTokenMemRatioFlag                        = "cache-token-mem-ratio"
 	CacheTombstoneMemRatioFlag                    = "cache-tombstone-mem-ratio"
 	CacheUserMemRatioFlag                         = "cache-user-mem-ratio"
@@ -221,15 +299,24 @@
 	CacheMutesMemRatioFlag                        = "cache-mutes-mem-ratio"
 	CacheStatusFilterMemRatioFlag                 = "cache-status-filter-mem-ratio"
 	CacheVisibilityMemRatioFlag                   = "cache-visibility-mem-ratio"
+	CacheStatusRepliesBackfillMemRatioFlag        = "cache-status-replies-backfill-mem-ratio"
+	CacheIdempotencyMemRatioFlag                  = "cache-idempotency-mem-ratio"
+	CacheAccountUsageMemRatioFlag                 = "cache-account-usage-mem-ratio"
+	CacheAccountActivityMemRatioFlag              = "cache-account-activity-mem-ratio"
 	AdminAccountUsernameFlag                      = "username"
 	AdminAccountEmailFlag                         = "email"
 	AdminAccountPasswordFlag                      = "password"
+	AdminAccountQueryFlag                         = "query"
+	AdminAccountRoleFlag                          = "role"
 	AdminTransPathFlag                            = "path"
 	AdminMediaPruneDryRunFlag                     = "dry-run"
 	AdminMediaListLocalOnlyFlag                   = "local-only"
 	AdminMediaListRemoteOnlyFlag                  = "remote-only"
+	AdminMediaIDFlag                              = "id"
+	AdminMediaDomainFlag                          = "domain"
 	TestrigSkipDBSetupFlag                        = "skip-db-setup"
 	TestrigSkipDBTeardownFlag                     = "skip-db-teardown"
+	TestrigClientFixturesFlag                     = "client-fixtures"
 )
 
 func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
@@ -244,6 +331,7 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.String("landing-page-user", cfg.LandingPageUser, "the user that should be shown on the instance's landing page")
 	flags.String("host", cfg.Host, "Hostname to use for the server (eg., example.org, gotosocial.whatever.com). DO NOT change this on a server that's already run!")
 	flags.String("account-domain", cfg.AccountDomain, "Domain to use in account names (eg., example.org, whatever.com). If not set, will default to the setting for host. DO NOT change this on a server that's already run!")
+	flags.StringSlice("account-domain-aliases", cfg.AccountDomainAliases, "Additional vanity domains at which local accounts can be looked up via webfinger (eg., brand-a.com), or username@domain entries to cover only one account at a domain (eg., alice@brand-a.com). Webfinger requests at these domains must be redirected or proxied to this server.")
 	flags.String("protocol", cfg.Protocol, "Protocol to use for the REST api of the server (only use http if you are debugging; https should be used even if running behind a reverse proxy!)")
 	flags.String("bind-address", cfg.BindAddress, "Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces.")
 	flags.Int("port", cfg.Port, "Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine.")
@@ -267,27 +355,48 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.String("web-asset-base-dir", cfg.WebAssetBaseDir, "Directory to serve static assets from, accessible at example.org/assets/")
 	flags.String("instance-federation-mode", cfg.InstanceFederationMode, "Set instance federation mode.")
 	flags.Bool("instance-federation-spam-filter", cfg.InstanceFederationSpamFilter, "Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam")
+	flags.Duration("instance-federation-seen-activity-ttl", cfg.InstanceFederationSeenActivityTTL, "Period for which the IDs of activities delivered to inboxes are remembered, so that redeliveries of them are dropped, even across restarts. 0 = disabled.")
+	flags.Duration("instance-federation-failed-activity-ttl", cfg.InstanceFederationFailedActivityTTL, "Period for which inbound activities that failed processing are kept for inspection before being pruned. 0 = kept until deleted.")
 	flags.Bool("instance-expose-peers", cfg.InstanceExposePeers, "Allow unauthenticated users to query /api/v1/instance/peers?filter=open")
 	flags.Bool("instance-expose-blocklist", cfg.InstanceExposeBlocklist, "Expose list of blocked domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=blocked and /api/v1/instance/domain_blocks")
 	flags.Bool("instance-expose-blocklist-web", cfg.InstanceExposeBlocklistWeb, "Expose list of explicitly blocked domains as webpage on /about/domain_blocks")
+	flags.Bool("instance-expose-blocklist-comments", cfg.InstanceExposeBlocklistComments, "Include the public comment of each domain block when exposing the list of blocked domains. If false, only domains are shown.")
 	flags.Bool("instance-expose-allowlist", cfg.InstanceExposeAllowlist, "Expose list of allowed domains via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=allowed and /api/v1/instance/domain_allows")
 	flags.Bool("instance-expose-allowlist-web", cfg.InstanceExposeAllowlistWeb, "Expose list of explicitly allowed domains as webpage on /about/domain_allows")
 	flags.Bool("instance-expose-public-timeline", cfg.InstanceExposePublicTimeline, "Allow unauthenticated users to query /api/v1/timelines/public")
 	flags.Bool("instance-expose-custom-emojis", cfg.InstanceExposeCustomEmojis, "Allow unauthenticated access to /api/v1/custom_emojis")
+	flags.Bool("instance-expose-tag-feeds", cfg.InstanceExposeTagFeeds, "Expose RSS/Atom feeds of public posts with a hashtag at /tags/:tag_name.rss")
+	flags.Bool("instance-expose-local-feed", cfg.InstanceExposeLocalFeed, "Expose an RSS/Atom feed of public posts by local accounts at /local.rss")
 	flags.Bool("instance-deliver-to-shared-inboxes", cfg.InstanceDeliverToSharedInboxes, "Deliver federated messages to shared inboxes, if they're available.")
 	flags.Bool("instance-inject-mastodon-version", cfg.InstanceInjectMastodonVersion, "This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection")
 	flags.StringSlice("instance-languages", cfg.InstanceLanguages.Strings(), "BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred).")
 	flags.String("instance-subscriptions-process-from", cfg.InstanceSubscriptionsProcessFrom, "Time of day from which to start running instance subscriptions processing jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'.")
 	flags.Duration("instance-subscriptions-process-every", cfg.InstanceSubscriptionsProcessEvery, "Period to elapse between instance subscriptions processing jobs, starting from instance-subscriptions-process-from.")
+	flags.Duration("instance-peers-crawl-every", cfg.InstancePeersCrawlEvery, "Period to elapse between fetching nodeinfo (software, version, user counts, registrations) from known peer instances. 0 = disabled.")
+	flags.Duration("instance-sitemap-generate-every", cfg.InstanceSitemapGenerateEvery, "Period to elapse between regenerating the cached sitemap of indexable profiles and posts served at /sitemap.xml. 0 = sitemap disabled.")
 	flags.String("instance-stats-mode", cfg.InstanceStatsMode, "Allows you to customize the way stats are served to crawlers: one of '', 'serve', 'zero', 'baffle'. Home page stats remain unchanged.")
+	flags.String("instance-peers-mode", cfg.InstancePeersMode, "How the list of known peers is served at /api/v1/instance/peers: one of 'serve', 'anonymize', 'disabled'.")
+	flags.String("instance-activity-mode", cfg.InstanceActivityMode, "How weekly instance activity is served at /api/v1/instance/activity: one of 'disabled', 'serve', 'anonymize'.")
 	flags.Bool("instance-allow-backdating-statuses", cfg.InstanceAllowBackdatingStatuses, "Allow local accounts to backdate statuses using the scheduled_at param to /api/v1/statuses")
+	flags.Bool("instance-relay-endpoint", cfg.InstanceRelayEndpoint, "Act as an ActivityPub relay: accept Follows of the instance actor from peers, and Announce local public statuses, and public statuses sent by subscribed peers, to them.")
+	flags.Bool("instance-integrity-proofs-sign", cfg.InstanceIntegrityProofsSign, "Sign outgoing activities with FEP-8b32 object integrity proofs, and publish the key used to verify these on local actors.")
+	flags.Bool("instance-integrity-proofs-verify", cfg.InstanceIntegrityProofsVerify, "Verify FEP-8b32 object integrity proofs on incoming activities, accepting validly signed forwarded activities without refetching them.")
+	flags.Bool("instance-experimental-c2s", cfg.InstanceExperimentalC2S, "Experimental: allow local accounts to post Create (Note) and Delete activities to their own outbox using an OAuth token, as per ActivityPub client-to-server.")
 	flags.Bool("accounts-registration-open", cfg.AccountsRegistrationOpen, "Allow anyone to submit an account signup request. If false, server will be invite-only.")
 	flags.Bool("accounts-reason-required", cfg.AccountsReasonRequired, "Do new account signups require a reason to be submitted on registration?")
 	flags.Int("accounts-registration-daily-limit", cfg.AccountsRegistrationDailyLimit, "Limit amount of approved account sign-ups allowed per 24hrs before registration is closed. 0 or less = no limit.")
 	flags.Int("accounts-registration-backlog-limit", cfg.AccountsRegistrationBacklogLimit, "Limit how big the 'accounts pending approval' queue can grow before registration is closed. 0 or less = no limit.")
+	flags.String("accounts-invites-allowed", cfg.AccountsInvitesAllowed, "Which local accounts may create invite links for sign-up: none, moderators (includes admins), or users (everyone).")
 	flags.Bool("accounts-allow-custom-css", cfg.AccountsAllowCustomCSS, "Allow accounts to enable custom CSS for their profile pages and statuses.")
 	flags.Int("accounts-custom-css-length", cfg.AccountsCustomCSSLength, "Maximum permitted length (characters) of custom CSS for accounts.")
+	flags.Bool("accounts-allow-custom-domains", cfg.AccountsAllowCustomDomains, "Allow accounts to request a vanity domain of their own, which, once verified and approved by an admin, redirects to their profile and can be used to look them up.")
 	flags.Int("accounts-max-profile-fields", cfg.AccountsMaxProfileFields, "Maximum number of profile fields allowed for each account.")
+	flags.Int("accounts-profile-media-history", cfg.AccountsProfileMediaHistory, "Number of previous avatars and headers to keep for each local account, so they can be restored without re-uploading. 0 = keep none.")
+	flags.Duration("accounts-suspension-grace-period", cfg.AccountsSuspensionGracePeriod, "Keep the data of suspended local accounts (hidden) for this long before deleting it, during which the suspension can be reversed. 0 = delete immediately.")
+	flags.Duration("accounts-follow-request-expiry", cfg.AccountsFollowRequestExpiry, "Automatically reject pending follow requests of local accounts once they are older than this. 0 = never expire.")
+	flags.Duration("accounts-login-history-retention", cfg.AccountsLoginHistoryRetention, "Keep records of sign-in attempts to local accounts (IP address, user-agent, success) for this long, so users can review them. 0 = don't record sign-in attempts.")
+	flags.Bool("accounts-login-notification-emails", cfg.AccountsLoginNotificationEmails, "Email users when their account is signed in to from an IP address not seen in their sign-in history. Requires accounts-login-history-retention to be set.")
+	flags.Duration("accounts-stats-recount-every", cfg.AccountsStatsRecountEvery, "Recompute the cached follower, following, and status counts of all accounts from scratch this often, to correct any drift. 0 = never.")
 	flags.String("storage-backend", cfg.StorageBackend, "Storage backend to use for media attachments")
 	flags.String("storage-local-base-path", cfg.StorageLocalBasePath, "Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir.")
 	flags.String("storage-s3-endpoint", cfg.StorageS3Endpoint, "S3 Endpoint URL (e.g 'minio.example.org:9000')")
@@ -299,10 +408,25 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.String("storage-s3-redirect-url", cfg.StorageS3RedirectURL, "Custom URL to use for redirecting S3 media links. If set, this will be used instead of the S3 bucket URL.")
 	flags.String("storage-s3-bucket-lookup", cfg.StorageS3BucketLookup, "S3 bucket lookup type to use. Can be 'auto', 'dns' or 'path'. Defaults to 'auto'.")
 	flags.String("storage-s3-key-prefix", cfg.StorageS3KeyPrefix, "Prefix to use for S3 keys. This is useful for separating multiple instances sharing the same S3 bucket.")
+	flags.String("storage-s3-multipart-threshold", cfg.StorageS3MultipartThreshold.String(), "Files larger than this size will be uploaded to S3 in parts, with each part retried individually on failure. 0 = disabled.")
+	flags.String("storage-s3-multipart-part-size", cfg.StorageS3MultipartPartSize.String(), "Size of each part of an S3 multipart upload. Must be at least 5MiB.")
+	flags.Int("storage-s3-multipart-retries", cfg.StorageS3MultipartRetries, "Number of times to retry uploading a single part of an S3 multipart upload before giving up.")
+	flags.String("storage-s3-remote-cache-tag", cfg.StorageS3RemoteCacheTag, "Object tag (in the form 'key=value') to apply to cached remote media in S3. If set, GoToSocial will leave expiry of cached remote media to bucket lifecycle rules.")
 	flags.Int("statuses-max-chars", cfg.StatusesMaxChars, "Max permitted characters for posted statuses, including content warning")
 	flags.Int("statuses-poll-max-options", cfg.StatusesPollMaxOptions, "Max amount of options permitted on a poll")
 	flags.Int("statuses-poll-option-max-chars", cfg.StatusesPollOptionMaxChars, "Max amount of characters for a poll option")
 	flags.Int("statuses-media-max-files", cfg.StatusesMediaMaxFiles, "Maximum number of media files/attachments per status")
+	flags.Bool("statuses-syntax-highlighting", cfg.StatusesSyntaxHighlighting, "Apply server-side syntax highlighting to fenced code blocks in markdown statuses that specify a supported language.")
+	flags.Bool("statuses-backfill-replies", cfg.StatusesBackfillReplies, "When a user views a thread of a remote status, asynchronously dereference its replies collection so more of the conversation is shown on subsequent loads.")
+	flags.Int("statuses-backfill-replies-max-depth", cfg.StatusesBackfillRepliesMaxDepth, "Maximum depth of replies to follow down from a viewed status when backfilling replies. 0 = no limit.")
+	flags.Int("statuses-backfill-replies-max-statuses", cfg.StatusesBackfillRepliesMaxStatuses, "Maximum number of reply statuses to dereference per backfill of a viewed status. 0 = no limit.")
+	flags.Duration("statuses-backfill-replies-cooldown", cfg.StatusesBackfillRepliesCooldown, "Minimum time between replies backfills of the same status, to avoid hammering origin servers.")
+	flags.Duration("statuses-expiry-min-duration", cfg.StatusesExpiryMinDuration, "Minimum amount of time after creation that a status can be set to expire (be automatically deleted).")
+	flags.Duration("statuses-expiry-max-duration", cfg.StatusesExpiryMaxDuration, "Maximum amount of time after creation that a status can be set to expire (be automatically deleted). 0 = no limit.")
+	flags.Duration("statuses-cleanup-interval", cfg.StatusesCleanupInterval, "Time to wait between each status deleted or unlisted by a user's cleanup of their old statuses, to avoid flooding other instances with deliveries.")
+	flags.Bool("statuses-link-previews", cfg.StatusesLinkPreviews, "Generate link preview cards for the first link in statuses, by fetching the Open Graph / oEmbed metadata of the linked page.")
+	flags.Duration("statuses-link-previews-ttl", cfg.StatusesLinkPreviewsTTL, "Period after which a stored link preview card is considered stale, and refetched the next time a status links to it.")
+	flags.Bool("statuses-link-previews-remote", cfg.StatusesLinkPreviewsRemote, "Also generate link preview cards for statuses received from remote instances, rather than only for statuses created on this instance.")
 	flags.Int("scheduled-statuses-max-total", cfg.ScheduledStatusesMaxTotal, "Maximum number of scheduled statuses per user")
 	flags.Int("scheduled-statuses-max-daily", cfg.ScheduledStatusesMaxDaily, "Maximum number of scheduled statuses per user for a single day")
 	flags.Bool("letsencrypt-enabled", cfg.LetsEncryptEnabled, "Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default).")
@@ -332,6 +456,13 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Bool("syslog-enabled", cfg.SyslogEnabled, "Enable the syslog logging hook. Logs will be mirrored to the configured destination.")
 	flags.String("syslog-protocol", cfg.SyslogProtocol, "Protocol to use when directing logs to syslog. Leave empty to connect to local syslog.")
 	flags.String("syslog-address", cfg.SyslogAddress, "Address:port to send syslog logs to. Leave empty to connect to local syslog.")
+	flags.String("translation-provider", cfg.TranslationProvider, "Provider to use for translating statuses: [deepl, libretranslate]. Leave empty to disable translation.")
+	flags.String("translation-endpoint", cfg.TranslationEndpoint, "Base URL of the translation provider API. Required for libretranslate. Leave empty to use the default DeepL API for deepl.")
+	flags.String("translation-api-key", cfg.TranslationAPIKey, "API key to authenticate with the translation provider.")
+	flags.Int("translation-rate-limit", cfg.TranslationRateLimit, "Amount of translations each user may request within an hour. 0 or less turns rate limiting off.")
+	flags.String("event-bus-provider", cfg.EventBusProvider, "Message broker to publish lifecycle events (statuses created/deleted, accounts updated, reports opened) to: [nats]. Leave empty to disable the event bus.")
+	flags.String("event-bus-url", cfg.EventBusURL, "URL of the event bus message broker, eg., 'nats://localhost:4222'. Use scheme 'tls' to connect with TLS.")
+	flags.String("event-bus-subject-prefix", cfg.EventBusSubjectPrefix, "Prefix of the subjects events are published on, eg., 'gotosocial' gives 'gotosocial.status.created'.")
 	flags.String("advanced-cookies-samesite", cfg.Advanced.CookiesSamesite, "'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite")
 	flags.Int("advanced-sender-multiplier", cfg.Advanced.SenderMultiplier, "Multiplier to use per cpu for batching outgoing fedi messages. 0 or less turns batching off (not recommended).")
 	flags.StringSlice("advanced-csp-extra-uris", cfg.Advanced.CSPExtraURIs, "Additional URIs to allow when building content-security-policy for media + images.")
@@ -343,21 +474,53 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.StringSlice("http-client-allow-ips", cfg.HTTPClient.AllowIPs, "")
 	flags.StringSlice("http-client-block-ips", cfg.HTTPClient.BlockIPs, "")
 	flags.Duration("http-client-timeout", cfg.HTTPClient.Timeout, "")
+	flags.Duration("http-client-webfinger-timeout", cfg.HTTPClient.WebfingerTimeout, "")
+	flags.Duration("http-client-dereference-timeout", cfg.HTTPClient.DereferenceTimeout, "")
+	flags.Duration("http-client-media-timeout", cfg.HTTPClient.MediaTimeout, "")
+	flags.Duration("http-client-delivery-timeout", cfg.HTTPClient.DeliveryTimeout, "")
+	flags.Int("http-client-max-idle-conns-per-host", cfg.HTTPClient.MaxIdleConnsPerHost, "")
+	flags.Duration("http-client-idle-conn-timeout", cfg.HTTPClient.IdleConnTimeout, "")
+	flags.Bool("http-client-disable-http2", cfg.HTTPClient.DisableHTTP2, "")
+	flags.Duration("http-client-dns-cache-ttl", cfg.HTTPClient.DNSCacheTTL, "")
+	flags.Duration("http-client-dns-cache-negative-ttl", cfg.HTTPClient.DNSCacheNegativeTTL, "")
+	flags.String("http-client-ip-family-preference", cfg.HTTPClient.IPFamilyPreference, "")
+	flags.Duration("http-client-happy-eyeballs-delay", cfg.HTTPClient.HappyEyeballsDelay, "")
+	flags.StringSlice("http-client-ipv4-only-domains", cfg.HTTPClient.IPv4OnlyDomains, "")
 	flags.Bool("http-client-tls-insecure-skip-verify", cfg.HTTPClient.TLSInsecureSkipVerify, "")
 	flags.Bool("http-client-insecure-outgoing", cfg.HTTPClient.InsecureOutgoing, "")
 	flags.Int("media-description-min-chars", cfg.Media.DescriptionMinChars, "Min required chars for an image description")
 	flags.Int("media-description-max-chars", cfg.Media.DescriptionMaxChars, "Max permitted chars for an image description")
+	flags.String("media-description-policy", cfg.Media.DescriptionPolicy, "Policy for descriptions of media attached to new statuses: one of 'off', 'remind', 'require'. Accounts may choose a stricter policy for themselves.")
+	flags.String("media-describe-provider", cfg.Media.DescribeProvider, "Backend to use for suggesting descriptions of image attachments: [tesseract, http]. Leave empty to disable description suggestions.")
+	flags.String("media-describe-endpoint", cfg.Media.DescribeEndpoint, "URL of the HTTP endpoint to send images to for description suggestions. Required for http.")
+	flags.String("media-describe-command", cfg.Media.DescribeCommand, "Command (with any extra arguments) used to run tesseract for description suggestions.")
+	flags.Int("media-describe-concurrency", cfg.Media.DescribeConcurrency, "Max number of description suggestions to generate at once. 0 or less uses 1.")
+	flags.Duration("media-describe-timeout", cfg.Media.DescribeTimeout, "Max time to spend generating any one description suggestion.")
 	flags.Int("media-remote-cache-days", cfg.Media.RemoteCacheDays, "Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely.")
 	flags.String("media-emoji-local-max-size", cfg.Media.EmojiLocalMaxSize.String(), "Max size in bytes of emojis uploaded to this instance via the admin API.")
 	flags.String("media-emoji-remote-max-size", cfg.Media.EmojiRemoteMaxSize.String(), "Max size in bytes of emojis to download from other instances.")
+	flags.Bool("media-emoji-static-only", cfg.Media.EmojiStaticOnly, "Serve only the static (non-animated) version of custom emojis to client apps and the web frontend.")
 	flags.String("media-image-size-hint", cfg.Media.ImageSizeHint.String(), "Size in bytes of max image size referred to on /api/v_/instance endpoints (else, local max size)")
 	flags.String("media-video-size-hint", cfg.Media.VideoSizeHint.String(), "Size in bytes of max video size referred to on /api/v_/instance endpoints (else, local max size)")
 	flags.String("media-local-max-size", cfg.Media.LocalMaxSize.String(), "Max size in bytes of media uploaded to this instance via API")
+	flags.String("media-local-quota", cfg.Media.LocalQuota.String(), "Default max total size in bytes of media stored for each local account, which admins may override per account. 0 = no quota.")
 	flags.String("media-remote-max-size", cfg.Media.RemoteMaxSize.String(), "Max size in bytes of media to download from other instances")
+	flags.String("media-image-max-size", cfg.Media.ImageMaxSize.String(), "Max size in bytes of image media, local or remote. 0 = only local-max-size / remote-max-size apply.")
+	flags.Int("media-image-max-megapixels", cfg.Media.ImageMaxMegapixels, "Max resolution in megapixels (width x height / 1,000,000) of image media, local or remote. 0 = no limit.")
+	flags.String("media-video-max-size", cfg.Media.VideoMaxSize.String(), "Max size in bytes of video media, local or remote. 0 = only local-max-size / remote-max-size apply.")
+	flags.Duration("media-video-max-duration", cfg.Media.VideoMaxDuration, "Max duration of video media, local or remote. 0 = no limit.")
+	flags.Int("media-video-max-bitrate", cfg.Media.VideoMaxBitrate, "Max overall bitrate in bits per second of video media, local or remote. 0 = no limit.")
+	flags.String("media-audio-max-size", cfg.Media.AudioMaxSize.String(), "Max size in bytes of audio media, local or remote. 0 = only local-max-size / remote-max-size apply.")
+	flags.Duration("media-audio-max-duration", cfg.Media.AudioMaxDuration, "Max duration of audio media, local or remote. 0 = no limit.")
+	flags.Int("media-audio-max-bitrate", cfg.Media.AudioMaxBitrate, "Max overall bitrate in bits per second of audio media, local or remote. 0 = no limit.")
+	flags.Bool("media-remote-sensitive-blurhash-only", cfg.Media.RemoteSensitiveBlurhashOnly, "Do not download media marked sensitive from other instances; keep only its blurhash and dimensions, and link to the remote file instead.")
+	flags.Bool("media-preserve-color-profile", cfg.Media.PreserveColorProfile, "Keep the ICC color profile of uploaded images, and apply any EXIF orientation directly to image pixels so all EXIF data can be stripped. Can be overridden per upload.")
 	flags.String("media-cleanup-from", cfg.Media.CleanupFrom, "Time of day from which to start running media cleanup/prune jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'.")
 	flags.Duration("media-cleanup-every", cfg.Media.CleanupEvery, "Period to elapse between cleanups, starting from media-cleanup-at.")
 	flags.Int("media-ffmpeg-pool-size", cfg.Media.FfmpegPoolSize, "Number of instances of the embedded ffmpeg WASM binary to add to the media processing pool. 0 or less uses GOMAXPROCS.")
 	flags.Int("media-thumb-max-pixels", cfg.Media.ThumbMaxPixels, "Max size in pixels of any one dimension of a thumbnail (as input media ratio is preserved).")
+	flags.StringSlice("media-variant-formats", cfg.Media.VariantFormats, "Formats to convert JPEG and PNG images to on the fly for clients that accept them, in order of preference: [webp]. Leave empty to disable image variants.")
+	flags.String("media-variant-cache-size", cfg.Media.VariantCacheSize.String(), "Max total size in bytes of converted image variants to keep cached in memory.")
 	flags.Int("cache-s3-object-info", cfg.Cache.S3ObjectInfo, "Enables caching of S3 object information in the storage driver to reduce S3 calls, value is cache capacity.")
 	flags.Duration("cache-home-timeline-timeout", cfg.Cache.HomeTimelineTimeout, "Duration before any one home timeline cache is unloaded from memory. Values <= 0 disable unloading.")
 	flags.Duration("cache-list-timeline-timeout", cfg.Cache.ListTimelineTimeout, "Duration before any one list timeline cache is unloaded from memory. Values <= 0 disable unloading.")
@@ -371,6 +534,7 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Float64("cache-block-mem-ratio", cfg.Cache.BlockMemRatio, "")
 	flags.Float64("cache-block-ids-mem-ratio", cfg.Cache.BlockIDsMemRatio, "")
 	flags.Float64("cache-boost-of-ids-mem-ratio", cfg.Cache.BoostOfIDsMemRatio, "")
+	flags.Float64("cache-card-mem-ratio", cfg.Cache.CardMemRatio, "")
 	flags.Float64("cache-client-mem-ratio", cfg.Cache.ClientMemRatio, "")
 	flags.Float64("cache-conversation-mem-ratio", cfg.Cache.ConversationMemRatio, "")
 	flags.Float64("cache-conversation-last-status-ids-mem-ratio", cfg.Cache.ConversationLastStatusIDsMemRatio, "")
@@ -414,6 +578,7 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Float64("cache-status-fave-ids-mem-ratio", cfg.Cache.StatusFaveIDsMemRatio, "")
 	flags.Float64("cache-tag-mem-ratio", cfg.Cache.TagMemRatio, "")
 	flags.Float64("cache-thread-mute-mem-ratio", cfg.Cache.ThreadMuteMemRatio, "")
+	flags.Float64("cache-thread-subscription-mem-ratio", cfg.Cache.ThreadSubscriptionMemRatio, "")
 	flags.Float64("cache-token-mem-ratio", cfg.Cache.TokenMemRatio, "")
 	flags.Float64("cache-tombstone-mem-ratio", cfg.Cache.TombstoneMemRatio, "")
 	flags.Float64("cache-user-mem-ratio", cfg.Cache.UserMemRatio, "")
@@ -425,10 +590,14 @@ func (cfg *Configuration) RegisterFlags(flags *pflag.FlagSet) {
 	flags.Float64("cache-mutes-mem-ratio", cfg.Cache.MutesMemRatio, "")
 	flags.Float64("cache-status-filter-mem-ratio", cfg.Cache.StatusFilterMemRatio, "")
 	flags.Float64("cache-visibility-mem-ratio", cfg.Cache.VisibilityMemRatio, "")
+	flags.Float64("cache-status-replies-backfill-mem-ratio", cfg.Cache.StatusRepliesBackfillMemRatio, "")
+	flags.Float64("cache-idempotency-mem-ratio", cfg.Cache.IdempotencyMemRatio, "")
+	flags.Float64("cache-account-usage-mem-ratio", cfg.Cache.AccountUsageMemRatio, "")
+	flags.Float64("cache-account-activity-mem-ratio", cfg.Cache.AccountActivityMemRatio, "")
 }
 
 func (cfg *Configuration) MarshalMap() map[string]any {
-	cfgmap := make(map[string]any, 201)
+	cfgmap := make(map[string]any, 287)
 	cfgmap["log-level"] = cfg.LogLevel
 	cfgmap["log-format"] = cfg.LogFormat
 	cfgmap["log-timestamp-format"] = cfg.LogTimestampFormat
@@ -440,6 +609,7 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["landing-page-user"] = cfg.LandingPageUser
 	cfgmap["host"] = cfg.Host
 	cfgmap["account-domain"] = cfg.AccountDomain
+	cfgmap["account-domain-aliases"] = cfg.AccountDomainAliases
 	cfgmap["protocol"] = cfg.Protocol
 	cfgmap["bind-address"] = cfg.BindAddress
 	cfgmap["port"] = cfg.Port
@@ -463,27 +633,48 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["web-asset-base-dir"] = cfg.WebAssetBaseDir
 	cfgmap["instance-federation-mode"] = cfg.InstanceFederationMode
 	cfgmap["instance-federation-spam-filter"] = cfg.InstanceFederationSpamFilter
+	cfgmap["instance-federation-seen-activity-ttl"] = cfg.InstanceFederationSeenActivityTTL
+	cfgmap["instance-federation-failed-activity-ttl"] = cfg.InstanceFederationFailedActivityTTL
 	cfgmap["instance-expose-peers"] = cfg.InstanceExposePeers
 	cfgmap["instance-expose-blocklist"] = cfg.InstanceExposeBlocklist
 	cfgmap["instance-expose-blocklist-web"] = cfg.InstanceExposeBlocklistWeb
+	cfgmap["instance-expose-blocklist-comments"] = cfg.InstanceExposeBlocklistComments
 	cfgmap["instance-expose-allowlist"] = cfg.InstanceExposeAllowlist
 	cfgmap["instance-expose-allowlist-web"] = cfg.InstanceExposeAllowlistWeb
 	cfgmap["instance-expose-public-timeline"] = cfg.InstanceExposePublicTimeline
 	cfgmap["instance-expose-custom-emojis"] = cfg.InstanceExposeCustomEmojis
+	cfgmap["instance-expose-tag-feeds"] = cfg.InstanceExposeTagFeeds
+	cfgmap["instance-expose-local-feed"] = cfg.InstanceExposeLocalFeed
 	cfgmap["instance-deliver-to-shared-inboxes"] = cfg.InstanceDeliverToSharedInboxes
 	cfgmap["instance-inject-mastodon-version"] = cfg.InstanceInjectMastodonVersion
 	cfgmap["instance-languages"] = cfg.InstanceLanguages.Strings()
 	cfgmap["instance-subscriptions-process-from"] = cfg.InstanceSubscriptionsProcessFrom
 	cfgmap["instance-subscriptions-process-every"] = cfg.InstanceSubscriptionsProcessEvery
+	cfgmap["instance-peers-crawl-every"] = cfg.InstancePeersCrawlEvery
+	cfgmap["instance-sitemap-generate-every"] = cfg.InstanceSitemapGenerateEvery
 	cfgmap["instance-stats-mode"] = cfg.InstanceStatsMode
+	cfgmap["instance-peers-mode"] = cfg.InstancePeersMode
+	cfgmap["instance-activity-mode"] = cfg.InstanceActivityMode
 	cfgmap["instance-allow-backdating-statuses"] = cfg.InstanceAllowBackdatingStatuses
+	cfgmap["instance-relay-endpoint"] = cfg.InstanceRelayEndpoint
+	cfgmap["instance-integrity-proofs-sign"] = cfg.InstanceIntegrityProofsSign
+	cfgmap["instance-integrity-proofs-verify"] = cfg.InstanceIntegrityProofsVerify
+	cfgmap["instance-experimental-c2s"] = cfg.InstanceExperimentalC2S
 	cfgmap["accounts-registration-open"] = cfg.AccountsRegistrationOpen
 	cfgmap["accounts-reason-required"] = cfg.AccountsReasonRequired
 	cfgmap["accounts-registration-daily-limit"] = cfg.AccountsRegistrationDailyLimit
 	cfgmap["accounts-registration-backlog-limit"] = cfg.AccountsRegistrationBacklogLimit
+	cfgmap["accounts-invites-allowed"] = cfg.AccountsInvitesAllowed
 	cfgmap["accounts-allow-custom-css"] = cfg.AccountsAllowCustomCSS
 	cfgmap["accounts-custom-css-length"] = cfg.AccountsCustomCSSLength
+	cfgmap["accounts-allow-custom-domains"] = cfg.AccountsAllowCustomDomains
 	cfgmap["accounts-max-profile-fields"] = cfg.AccountsMaxProfileFields
+	cfgmap["accounts-profile-media-history"] = cfg.AccountsProfileMediaHistory
+	cfgmap["accounts-suspension-grace-period"] = cfg.AccountsSuspensionGracePeriod
+	cfgmap["accounts-follow-request-expiry"] = cfg.AccountsFollowRequestExpiry
+	cfgmap["accounts-login-history-retention"] = cfg.AccountsLoginHistoryRetention
+	cfgmap["accounts-login-notification-emails"] = cfg.AccountsLoginNotificationEmails
+	cfgmap["accounts-stats-recount-every"] = cfg.AccountsStatsRecountEvery
 	cfgmap["storage-backend"] = cfg.StorageBackend
 	cfgmap["storage-local-base-path"] = cfg.StorageLocalBasePath
 	cfgmap["storage-s3-endpoint"] = cfg.StorageS3Endpoint
@@ -495,10 +686,25 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["storage-s3-redirect-url"] = cfg.StorageS3RedirectURL
 	cfgmap["storage-s3-bucket-lookup"] = cfg.StorageS3BucketLookup
 	cfgmap["storage-s3-key-prefix"] = cfg.StorageS3KeyPrefix
+	cfgmap["storage-s3-multipart-threshold"] = cfg.StorageS3MultipartThreshold.String()
+	cfgmap["storage-s3-multipart-part-size"] = cfg.StorageS3MultipartPartSize.String()
+	cfgmap["storage-s3-multipart-retries"] = cfg.StorageS3MultipartRetries
+	cfgmap["storage-s3-remote-cache-tag"] = cfg.StorageS3RemoteCacheTag
 	cfgmap["statuses-max-chars"] = cfg.StatusesMaxChars
 	cfgmap["statuses-poll-max-options"] = cfg.StatusesPollMaxOptions
 	cfgmap["statuses-poll-option-max-chars"] = cfg.StatusesPollOptionMaxChars
 	cfgmap["statuses-media-max-files"] = cfg.StatusesMediaMaxFiles
+	cfgmap["statuses-syntax-highlighting"] = cfg.StatusesSyntaxHighlighting
+	cfgmap["statuses-backfill-replies"] = cfg.StatusesBackfillReplies
+	cfgmap["statuses-backfill-replies-max-depth"] = cfg.StatusesBackfillRepliesMaxDepth
+	cfgmap["statuses-backfill-replies-max-statuses"] = cfg.StatusesBackfillRepliesMaxStatuses
+	cfgmap["statuses-backfill-replies-cooldown"] = cfg.StatusesBackfillRepliesCooldown
+	cfgmap["statuses-expiry-min-duration"] = cfg.StatusesExpiryMinDuration
+	cfgmap["statuses-expiry-max-duration"] = cfg.StatusesExpiryMaxDuration
+	cfgmap["statuses-cleanup-interval"] = cfg.StatusesCleanupInterval
+	cfgmap["statuses-link-previews"] = cfg.StatusesLinkPreviews
+	cfgmap["statuses-link-previews-ttl"] = cfg.StatusesLinkPreviewsTTL
+	cfgmap["statuses-link-previews-remote"] = cfg.StatusesLinkPreviewsRemote
 	cfgmap["scheduled-statuses-max-total"] = cfg.ScheduledStatusesMaxTotal
 	cfgmap["scheduled-statuses-max-daily"] = cfg.ScheduledStatusesMaxDaily
 	cfgmap["letsencrypt-enabled"] = cfg.LetsEncryptEnabled
@@ -528,6 +734,13 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["syslog-enabled"] = cfg.SyslogEnabled
 	cfgmap["syslog-protocol"] = cfg.SyslogProtocol
 	cfgmap["syslog-address"] = cfg.SyslogAddress
+	cfgmap["translation-provider"] = cfg.TranslationProvider
+	cfgmap["translation-endpoint"] = cfg.TranslationEndpoint
+	cfgmap["translation-api-key"] = cfg.TranslationAPIKey
+	cfgmap["translation-rate-limit"] = cfg.TranslationRateLimit
+	cfgmap["event-bus-provider"] = cfg.EventBusProvider
+	cfgmap["event-bus-url"] = cfg.EventBusURL
+	cfgmap["event-bus-subject-prefix"] = cfg.EventBusSubjectPrefix
 	cfgmap["advanced-cookies-samesite"] = cfg.Advanced.CookiesSamesite
 	cfgmap["advanced-sender-multiplier"] = cfg.Advanced.SenderMultiplier
 	cfgmap["advanced-csp-extra-uris"] = cfg.Advanced.CSPExtraURIs
@@ -539,21 +752,53 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["http-client-allow-ips"] = cfg.HTTPClient.AllowIPs
 	cfgmap["http-client-block-ips"] = cfg.HTTPClient.BlockIPs
 	cfgmap["http-client-timeout"] = cfg.HTTPClient.Timeout
+	cfgmap["http-client-webfinger-timeout"] = cfg.HTTPClient.WebfingerTimeout
+	cfgmap["http-client-dereference-timeout"] = cfg.HTTPClient.DereferenceTimeout
+	cfgmap["http-client-media-timeout"] = cfg.HTTPClient.MediaTimeout
+	cfgmap["http-client-delivery-timeout"] = cfg.HTTPClient.DeliveryTimeout
+	cfgmap["http-client-max-idle-conns-per-host"] = cfg.HTTPClient.MaxIdleConnsPerHost
+	cfgmap["http-client-idle-conn-timeout"] = cfg.HTTPClient.IdleConnTimeout
+	cfgmap["http-client-disable-http2"] = cfg.HTTPClient.DisableHTTP2
+	cfgmap["http-client-dns-cache-ttl"] = cfg.HTTPClient.DNSCacheTTL
+	cfgmap["http-client-dns-cache-negative-ttl"] = cfg.HTTPClient.DNSCacheNegativeTTL
+	cfgmap["http-client-ip-family-preference"] = cfg.HTTPClient.IPFamilyPreference
+	cfgmap["http-client-happy-eyeballs-delay"] = cfg.HTTPClient.HappyEyeballsDelay
+	cfgmap["http-client-ipv4-only-domains"] = cfg.HTTPClient.IPv4OnlyDomains
 	cfgmap["http-client-tls-insecure-skip-verify"] = cfg.HTTPClient.TLSInsecureSkipVerify
 	cfgmap["http-client-insecure-outgoing"] = cfg.HTTPClient.InsecureOutgoing
 	cfgmap["media-description-min-chars"] = cfg.Media.DescriptionMinChars
 	cfgmap["media-description-max-chars"] = cfg.Media.DescriptionMaxChars
+	cfgmap["media-description-policy"] = cfg.Media.DescriptionPolicy
+	cfgmap["media-describe-provider"] = cfg.Media.DescribeProvider
+	cfgmap["media-describe-endpoint"] = cfg.Media.DescribeEndpoint
+	cfgmap["media-describe-command"] = cfg.Media.DescribeCommand
+	cfgmap["media-describe-concurrency"] = cfg.Media.DescribeConcurrency
+	cfgmap["media-describe-timeout"] = cfg.Media.DescribeTimeout
 	cfgmap["media-remote-cache-days"] = cfg.Media.RemoteCacheDays
 	cfgmap["media-emoji-local-max-size"] = cfg.Media.EmojiLocalMaxSize.String()
 	cfgmap["media-emoji-remote-max-size"] = cfg.Media.EmojiRemoteMaxSize.String()
+	cfgmap["media-emoji-static-only"] = cfg.Media.EmojiStaticOnly
 	cfgmap["media-image-size-hint"] = cfg.Media.ImageSizeHint.String()
 	cfgmap["media-video-size-hint"] = cfg.Media.VideoSizeHint.String()
 	cfgmap["media-local-max-size"] = cfg.Media.LocalMaxSize.String()
+	cfgmap["media-local-quota"] = cfg.Media.LocalQuota.String()
 	cfgmap["media-remote-max-size"] = cfg.Media.RemoteMaxSize.String()
+	cfgmap["media-image-max-size"] = cfg.Media.ImageMaxSize.String()
+	cfgmap["media-image-max-megapixels"] = cfg.Media.ImageMaxMegapixels
+	cfgmap["media-video-max-size"] = cfg.Media.VideoMaxSize.String()
+	cfgmap["media-video-max-duration"] = cfg.Media.VideoMaxDuration
+	cfgmap["media-video-max-bitrate"] = cfg.Media.VideoMaxBitrate
+	cfgmap["media-audio-max-size"] = cfg.Media.AudioMaxSize.String()
+	cfgmap["media-audio-max-duration"] = cfg.Media.AudioMaxDuration
+	cfgmap["media-audio-max-bitrate"] = cfg.Media.AudioMaxBitrate
+	cfgmap["media-remote-sensitive-blurhash-only"] = cfg.Media.RemoteSensitiveBlurhashOnly
+	cfgmap["media-preserve-color-profile"] = cfg.Media.PreserveColorProfile
 	cfgmap["media-cleanup-from"] = cfg.Media.CleanupFrom
 	cfgmap["media-cleanup-every"] = cfg.Media.CleanupEvery
 	cfgmap["media-ffmpeg-pool-size"] = cfg.Media.FfmpegPoolSize
 	cfgmap["media-thumb-max-pixels"] = cfg.Media.ThumbMaxPixels
+	cfgmap["media-variant-formats"] = cfg.Media.VariantFormats
+	cfgmap["media-variant-cache-size"] = cfg.Media.VariantCacheSize.String()
 	cfgmap["cache-s3-object-info"] = cfg.Cache.S3ObjectInfo
 	cfgmap["cache-home-timeline-timeout"] = cfg.Cache.HomeTimelineTimeout
 	cfgmap["cache-list-timeline-timeout"] = cfg.Cache.ListTimelineTimeout
@@ -567,6 +812,7 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["cache-block-mem-ratio"] = cfg.Cache.BlockMemRatio
 	cfgmap["cache-block-ids-mem-ratio"] = cfg.Cache.BlockIDsMemRatio
 	cfgmap["cache-boost-of-ids-mem-ratio"] = cfg.Cache.BoostOfIDsMemRatio
+	cfgmap["cache-card-mem-ratio"] = cfg.Cache.CardMemRatio
 	cfgmap["cache-client-mem-ratio"] = cfg.Cache.ClientMemRatio
 	cfgmap["cache-conversation-mem-ratio"] = cfg.Cache.ConversationMemRatio
 	cfgmap["cache-conversation-last-status-ids-mem-ratio"] = cfg.Cache.ConversationLastStatusIDsMemRatio
@@ -610,6 +856,7 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["cache-status-fave-ids-mem-ratio"] = cfg.Cache.StatusFaveIDsMemRatio
 	cfgmap["cache-tag-mem-ratio"] = cfg.Cache.TagMemRatio
 	cfgmap["cache-thread-mute-mem-ratio"] = cfg.Cache.ThreadMuteMemRatio
+	cfgmap["cache-thread-subscription-mem-ratio"] = cfg.Cache.ThreadSubscriptionMemRatio
 	cfgmap["cache-token-mem-ratio"] = cfg.Cache.TokenMemRatio
 	cfgmap["cache-tombstone-mem-ratio"] = cfg.Cache.TombstoneMemRatio
 	cfgmap["cache-user-mem-ratio"] = cfg.Cache.UserMemRatio
@@ -621,15 +868,24 @@ func (cfg *Configuration) MarshalMap() map[string]any {
 	cfgmap["cache-mutes-mem-ratio"] = cfg.Cache.MutesMemRatio
 	cfgmap["cache-status-filter-mem-ratio"] = cfg.Cache.StatusFilterMemRatio
 	cfgmap["cache-visibility-mem-ratio"] = cfg.Cache.VisibilityMemRatio
+	cfgmap["cache-status-replies-backfill-mem-ratio"] = cfg.Cache.StatusRepliesBackfillMemRatio
+	cfgmap["cache-idempotency-mem-ratio"] = cfg.Cache.IdempotencyMemRatio
+	cfgmap["cache-account-usage-mem-ratio"] = cfg.Cache.AccountUsageMemRatio
+	cfgmap["cache-account-activity-mem-ratio"] = cfg.Cache.AccountActivityMemRatio
 	cfgmap["username"] = cfg.AdminAccountUsername
 	cfgmap["email"] = cfg.AdminAccountEmail
 	cfgmap["password"] = cfg.AdminAccountPassword
+	cfgmap["query"] = cfg.AdminAccountQuery
+	cfgmap["role"] = cfg.AdminAccountRole
 	cfgmap["path"] = cfg.AdminTransPath
 	cfgmap["dry-run"] = cfg.AdminMediaPruneDryRun
 	cfgmap["local-only"] = cfg.AdminMediaListLocalOnly
 	cfgmap["remote-only"] = cfg.AdminMediaListRemoteOnly
+	cfgmap["id"] = cfg.AdminMediaID
+	cfgmap["domain"] = cfg.AdminMediaDomain
 	cfgmap["skip-db-setup"] = cfg.TestrigSkipDBSetup
 	cfgmap["skip-db-teardown"] = cfg.TestrigSkipDBTeardown
+	cfgmap["client-fixtures"] = cfg.TestrigClientFixtures
 	return cfgmap
 }
 
@@ -727,6 +983,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["account-domain-aliases"]; ok {
+		var err error
+		cfg.AccountDomainAliases, err = toStringSlice(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> []string for 'account-domain-aliases': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["protocol"]; ok {
 		var err error
 		cfg.Protocol, err = cast.ToStringE(ival)
@@ -914,6 +1178,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-federation-seen-activity-ttl"]; ok {
+		var err error
+		cfg.InstanceFederationSeenActivityTTL, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'instance-federation-seen-activity-ttl': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-federation-failed-activity-ttl"]; ok {
+		var err error
+		cfg.InstanceFederationFailedActivityTTL, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'instance-federation-failed-activity-ttl': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["instance-expose-peers"]; ok {
 		var err error
 		cfg.InstanceExposePeers, err = cast.ToBoolE(ival)
@@ -938,6 +1218,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-expose-blocklist-comments"]; ok {
+		var err error
+		cfg.InstanceExposeBlocklistComments, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-expose-blocklist-comments': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["instance-expose-allowlist"]; ok {
 		var err error
 		cfg.InstanceExposeAllowlist, err = cast.ToBoolE(ival)
@@ -970,6 +1258,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-expose-tag-feeds"]; ok {
+		var err error
+		cfg.InstanceExposeTagFeeds, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-expose-tag-feeds': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-expose-local-feed"]; ok {
+		var err error
+		cfg.InstanceExposeLocalFeed, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-expose-local-feed': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["instance-deliver-to-shared-inboxes"]; ok {
 		var err error
 		cfg.InstanceDeliverToSharedInboxes, err = cast.ToBoolE(ival)
@@ -1015,6 +1319,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-peers-crawl-every"]; ok {
+		var err error
+		cfg.InstancePeersCrawlEvery, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'instance-peers-crawl-every': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-sitemap-generate-every"]; ok {
+		var err error
+		cfg.InstanceSitemapGenerateEvery, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'instance-sitemap-generate-every': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["instance-stats-mode"]; ok {
 		var err error
 		cfg.InstanceStatsMode, err = cast.ToStringE(ival)
@@ -1023,6 +1343,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-peers-mode"]; ok {
+		var err error
+		cfg.InstancePeersMode, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'instance-peers-mode': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-activity-mode"]; ok {
+		var err error
+		cfg.InstanceActivityMode, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'instance-activity-mode': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["instance-allow-backdating-statuses"]; ok {
 		var err error
 		cfg.InstanceAllowBackdatingStatuses, err = cast.ToBoolE(ival)
@@ -1031,6 +1367,38 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["instance-relay-endpoint"]; ok {
+		var err error
+		cfg.InstanceRelayEndpoint, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-relay-endpoint': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-integrity-proofs-sign"]; ok {
+		var err error
+		cfg.InstanceIntegrityProofsSign, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-integrity-proofs-sign': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-integrity-proofs-verify"]; ok {
+		var err error
+		cfg.InstanceIntegrityProofsVerify, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-integrity-proofs-verify': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["instance-experimental-c2s"]; ok {
+		var err error
+		cfg.InstanceExperimentalC2S, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'instance-experimental-c2s': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["accounts-registration-open"]; ok {
 		var err error
 		cfg.AccountsRegistrationOpen, err = cast.ToBoolE(ival)
@@ -1063,6 +1431,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["accounts-invites-allowed"]; ok {
+		var err error
+		cfg.AccountsInvitesAllowed, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'accounts-invites-allowed': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["accounts-allow-custom-css"]; ok {
 		var err error
 		cfg.AccountsAllowCustomCSS, err = cast.ToBoolE(ival)
@@ -1079,6 +1455,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["accounts-allow-custom-domains"]; ok {
+		var err error
+		cfg.AccountsAllowCustomDomains, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'accounts-allow-custom-domains': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["accounts-max-profile-fields"]; ok {
 		var err error
 		cfg.AccountsMaxProfileFields, err = cast.ToIntE(ival)
@@ -1087,6 +1471,54 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["accounts-profile-media-history"]; ok {
+		var err error
+		cfg.AccountsProfileMediaHistory, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'accounts-profile-media-history': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-suspension-grace-period"]; ok {
+		var err error
+		cfg.AccountsSuspensionGracePeriod, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'accounts-suspension-grace-period': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-follow-request-expiry"]; ok {
+		var err error
+		cfg.AccountsFollowRequestExpiry, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'accounts-follow-request-expiry': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-login-history-retention"]; ok {
+		var err error
+		cfg.AccountsLoginHistoryRetention, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'accounts-login-history-retention': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-login-notification-emails"]; ok {
+		var err error
+		cfg.AccountsLoginNotificationEmails, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'accounts-login-notification-emails': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["accounts-stats-recount-every"]; ok {
+		var err error
+		cfg.AccountsStatsRecountEvery, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'accounts-stats-recount-every': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["storage-backend"]; ok {
 		var err error
 		cfg.StorageBackend, err = cast.ToStringE(ival)
@@ -1175,6 +1607,44 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["storage-s3-multipart-threshold"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'storage-s3-multipart-threshold': %w", ival, err)
+		}
+		cfg.StorageS3MultipartThreshold = 0x0
+		if err := cfg.StorageS3MultipartThreshold.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'storage-s3-multipart-threshold': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["storage-s3-multipart-part-size"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'storage-s3-multipart-part-size': %w", ival, err)
+		}
+		cfg.StorageS3MultipartPartSize = 0x0
+		if err := cfg.StorageS3MultipartPartSize.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'storage-s3-multipart-part-size': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["storage-s3-multipart-retries"]; ok {
+		var err error
+		cfg.StorageS3MultipartRetries, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'storage-s3-multipart-retries': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["storage-s3-remote-cache-tag"]; ok {
+		var err error
+		cfg.StorageS3RemoteCacheTag, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'storage-s3-remote-cache-tag': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["statuses-max-chars"]; ok {
 		var err error
 		cfg.StatusesMaxChars, err = cast.ToIntE(ival)
@@ -1207,6 +1677,94 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["statuses-syntax-highlighting"]; ok {
+		var err error
+		cfg.StatusesSyntaxHighlighting, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'statuses-syntax-highlighting': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-backfill-replies"]; ok {
+		var err error
+		cfg.StatusesBackfillReplies, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'statuses-backfill-replies': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-backfill-replies-max-depth"]; ok {
+		var err error
+		cfg.StatusesBackfillRepliesMaxDepth, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'statuses-backfill-replies-max-depth': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-backfill-replies-max-statuses"]; ok {
+		var err error
+		cfg.StatusesBackfillRepliesMaxStatuses, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'statuses-backfill-replies-max-statuses': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-backfill-replies-cooldown"]; ok {
+		var err error
+		cfg.StatusesBackfillRepliesCooldown, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'statuses-backfill-replies-cooldown': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-expiry-min-duration"]; ok {
+		var err error
+		cfg.StatusesExpiryMinDuration, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'statuses-expiry-min-duration': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-expiry-max-duration"]; ok {
+		var err error
+		cfg.StatusesExpiryMaxDuration, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'statuses-expiry-max-duration': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-cleanup-interval"]; ok {
+		var err error
+		cfg.StatusesCleanupInterval, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'statuses-cleanup-interval': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-link-previews"]; ok {
+		var err error
+		cfg.StatusesLinkPreviews, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'statuses-link-previews': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-link-previews-ttl"]; ok {
+		var err error
+		cfg.StatusesLinkPreviewsTTL, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'statuses-link-previews-ttl': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["statuses-link-previews-remote"]; ok {
+		var err error
+		cfg.StatusesLinkPreviewsRemote, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'statuses-link-previews-remote': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["scheduled-statuses-max-total"]; ok {
 		var err error
 		cfg.ScheduledStatusesMaxTotal, err = cast.ToIntE(ival)
@@ -1439,6 +1997,62 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["translation-provider"]; ok {
+		var err error
+		cfg.TranslationProvider, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'translation-provider': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["translation-endpoint"]; ok {
+		var err error
+		cfg.TranslationEndpoint, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'translation-endpoint': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["translation-api-key"]; ok {
+		var err error
+		cfg.TranslationAPIKey, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'translation-api-key': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["translation-rate-limit"]; ok {
+		var err error
+		cfg.TranslationRateLimit, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'translation-rate-limit': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["event-bus-provider"]; ok {
+		var err error
+		cfg.EventBusProvider, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'event-bus-provider': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["event-bus-url"]; ok {
+		var err error
+		cfg.EventBusURL, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'event-bus-url': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["event-bus-subject-prefix"]; ok {
+		var err error
+		cfg.EventBusSubjectPrefix, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'event-bus-subject-prefix': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["advanced-cookies-samesite"]; ok {
 		var err error
 		cfg.Advanced.CookiesSamesite, err = cast.ToStringE(ival)
@@ -1532,70 +2146,222 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
-	if ival, ok := cfgmap["http-client-tls-insecure-skip-verify"]; ok {
+	if ival, ok := cfgmap["http-client-webfinger-timeout"]; ok {
 		var err error
-		cfg.HTTPClient.TLSInsecureSkipVerify, err = cast.ToBoolE(ival)
+		cfg.HTTPClient.WebfingerTimeout, err = cast.ToDurationE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> bool for 'http-client-tls-insecure-skip-verify': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> time.Duration for 'http-client-webfinger-timeout': %w", ival, err)
 		}
 	}
 
-	if ival, ok := cfgmap["http-client-insecure-outgoing"]; ok {
+	if ival, ok := cfgmap["http-client-dereference-timeout"]; ok {
 		var err error
-		cfg.HTTPClient.InsecureOutgoing, err = cast.ToBoolE(ival)
+		cfg.HTTPClient.DereferenceTimeout, err = cast.ToDurationE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> bool for 'http-client-insecure-outgoing': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> time.Duration for 'http-client-dereference-timeout': %w", ival, err)
 		}
 	}
 
-	if ival, ok := cfgmap["media-description-min-chars"]; ok {
+	if ival, ok := cfgmap["http-client-media-timeout"]; ok {
 		var err error
-		cfg.Media.DescriptionMinChars, err = cast.ToIntE(ival)
+		cfg.HTTPClient.MediaTimeout, err = cast.ToDurationE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> int for 'media-description-min-chars': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> time.Duration for 'http-client-media-timeout': %w", ival, err)
 		}
 	}
 
-	if ival, ok := cfgmap["media-description-max-chars"]; ok {
+	if ival, ok := cfgmap["http-client-delivery-timeout"]; ok {
 		var err error
-		cfg.Media.DescriptionMaxChars, err = cast.ToIntE(ival)
+		cfg.HTTPClient.DeliveryTimeout, err = cast.ToDurationE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> int for 'media-description-max-chars': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> time.Duration for 'http-client-delivery-timeout': %w", ival, err)
 		}
 	}
 
-	if ival, ok := cfgmap["media-remote-cache-days"]; ok {
+	if ival, ok := cfgmap["http-client-max-idle-conns-per-host"]; ok {
 		var err error
-		cfg.Media.RemoteCacheDays, err = cast.ToIntE(ival)
+		cfg.HTTPClient.MaxIdleConnsPerHost, err = cast.ToIntE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> int for 'media-remote-cache-days': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> int for 'http-client-max-idle-conns-per-host': %w", ival, err)
 		}
 	}
 
-	if ival, ok := cfgmap["media-emoji-local-max-size"]; ok {
-		t, err := cast.ToStringE(ival)
+	if ival, ok := cfgmap["http-client-idle-conn-timeout"]; ok {
+		var err error
+		cfg.HTTPClient.IdleConnTimeout, err = cast.ToDurationE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> string for 'media-emoji-local-max-size': %w", ival, err)
-		}
-		cfg.Media.EmojiLocalMaxSize = 0x0
-		if err := cfg.Media.EmojiLocalMaxSize.Set(t); err != nil {
-			return fmt.Errorf("error parsing %#v for 'media-emoji-local-max-size': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> time.Duration for 'http-client-idle-conn-timeout': %w", ival, err)
 		}
 	}
 
-	if ival, ok := cfgmap["media-emoji-remote-max-size"]; ok {
-		t, err := cast.ToStringE(ival)
+	if ival, ok := cfgmap["http-client-disable-http2"]; ok {
+		var err error
+		cfg.HTTPClient.DisableHTTP2, err = cast.ToBoolE(ival)
 		if err != nil {
-			return fmt.Errorf("error casting %#v -> string for 'media-emoji-remote-max-size': %w", ival, err)
-		}
-		cfg.Media.EmojiRemoteMaxSize = 0x0
-		if err := cfg.Media.EmojiRemoteMaxSize.Set(t); err != nil {
-			return fmt.Errorf("error parsing %#v for 'media-emoji-remote-max-size': %w", ival, err)
+			return fmt.Errorf("error casting %#v -> bool for 'http-client-disable-http2': %w", ival, err)
 		}
 	}
 
-	if ival, ok := cfgmap["media-image-size-hint"]; ok {
-		t, err := cast.ToStringE(ival)
+	if ival, ok := cfgmap["http-client-dns-cache-ttl"]; ok {
+		var err error
+		cfg.HTTPClient.DNSCacheTTL, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'http-client-dns-cache-ttl': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["http-client-dns-cache-negative-ttl"]; ok {
+		var err error
+		cfg.HTTPClient.DNSCacheNegativeTTL, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'http-client-dns-cache-negative-ttl': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["http-client-ip-family-preference"]; ok {
+		var err error
+		cfg.HTTPClient.IPFamilyPreference, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'http-client-ip-family-preference': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["http-client-happy-eyeballs-delay"]; ok {
+		var err error
+		cfg.HTTPClient.HappyEyeballsDelay, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'http-client-happy-eyeballs-delay': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["http-client-ipv4-only-domains"]; ok {
+		var err error
+		cfg.HTTPClient.IPv4OnlyDomains, err = toStringSlice(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> []string for 'http-client-ipv4-only-domains': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["http-client-tls-insecure-skip-verify"]; ok {
+		var err error
+		cfg.HTTPClient.TLSInsecureSkipVerify, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'http-client-tls-insecure-skip-verify': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["http-client-insecure-outgoing"]; ok {
+		var err error
+		cfg.HTTPClient.InsecureOutgoing, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'http-client-insecure-outgoing': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-description-min-chars"]; ok {
+		var err error
+		cfg.Media.DescriptionMinChars, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'media-description-min-chars': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-description-max-chars"]; ok {
+		var err error
+		cfg.Media.DescriptionMaxChars, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'media-description-max-chars': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-description-policy"]; ok {
+		var err error
+		cfg.Media.DescriptionPolicy, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-description-policy': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-describe-provider"]; ok {
+		var err error
+		cfg.Media.DescribeProvider, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-describe-provider': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-describe-endpoint"]; ok {
+		var err error
+		cfg.Media.DescribeEndpoint, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-describe-endpoint': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-describe-command"]; ok {
+		var err error
+		cfg.Media.DescribeCommand, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-describe-command': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-describe-concurrency"]; ok {
+		var err error
+		cfg.Media.DescribeConcurrency, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'media-describe-concurrency': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-describe-timeout"]; ok {
+		var err error
+		cfg.Media.DescribeTimeout, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'media-describe-timeout': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-remote-cache-days"]; ok {
+		var err error
+		cfg.Media.RemoteCacheDays, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'media-remote-cache-days': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-emoji-local-max-size"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-emoji-local-max-size': %w", ival, err)
+		}
+		cfg.Media.EmojiLocalMaxSize = 0x0
+		if err := cfg.Media.EmojiLocalMaxSize.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'media-emoji-local-max-size': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-emoji-remote-max-size"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-emoji-remote-max-size': %w", ival, err)
+		}
+		cfg.Media.EmojiRemoteMaxSize = 0x0
+		if err := cfg.Media.EmojiRemoteMaxSize.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'media-emoji-remote-max-size': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-emoji-static-only"]; ok {
+		var err error
+		cfg.Media.EmojiStaticOnly, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'media-emoji-static-only': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-image-size-hint"]; ok {
+		t, err := cast.ToStringE(ival)
 		if err != nil {
 			return fmt.Errorf("error casting %#v -> string for 'media-image-size-hint': %w", ival, err)
 		}
@@ -1627,6 +2393,17 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["media-local-quota"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-local-quota': %w", ival, err)
+		}
+		cfg.Media.LocalQuota = 0x0
+		if err := cfg.Media.LocalQuota.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'media-local-quota': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["media-remote-max-size"]; ok {
 		t, err := cast.ToStringE(ival)
 		if err != nil {
@@ -1638,6 +2415,95 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["media-image-max-size"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-image-max-size': %w", ival, err)
+		}
+		cfg.Media.ImageMaxSize = 0x0
+		if err := cfg.Media.ImageMaxSize.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'media-image-max-size': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-image-max-megapixels"]; ok {
+		var err error
+		cfg.Media.ImageMaxMegapixels, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'media-image-max-megapixels': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-video-max-size"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-video-max-size': %w", ival, err)
+		}
+		cfg.Media.VideoMaxSize = 0x0
+		if err := cfg.Media.VideoMaxSize.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'media-video-max-size': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-video-max-duration"]; ok {
+		var err error
+		cfg.Media.VideoMaxDuration, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'media-video-max-duration': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-video-max-bitrate"]; ok {
+		var err error
+		cfg.Media.VideoMaxBitrate, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'media-video-max-bitrate': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-audio-max-size"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-audio-max-size': %w", ival, err)
+		}
+		cfg.Media.AudioMaxSize = 0x0
+		if err := cfg.Media.AudioMaxSize.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'media-audio-max-size': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-audio-max-duration"]; ok {
+		var err error
+		cfg.Media.AudioMaxDuration, err = cast.ToDurationE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> time.Duration for 'media-audio-max-duration': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-audio-max-bitrate"]; ok {
+		var err error
+		cfg.Media.AudioMaxBitrate, err = cast.ToIntE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> int for 'media-audio-max-bitrate': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-remote-sensitive-blurhash-only"]; ok {
+		var err error
+		cfg.Media.RemoteSensitiveBlurhashOnly, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'media-remote-sensitive-blurhash-only': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-preserve-color-profile"]; ok {
+		var err error
+		cfg.Media.PreserveColorProfile, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'media-preserve-color-profile': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["media-cleanup-from"]; ok {
 		var err error
 		cfg.Media.CleanupFrom, err = cast.ToStringE(ival)
@@ -1670,6 +2536,25 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["media-variant-formats"]; ok {
+		var err error
+		cfg.Media.VariantFormats, err = toStringSlice(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> []string for 'media-variant-formats': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["media-variant-cache-size"]; ok {
+		t, err := cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'media-variant-cache-size': %w", ival, err)
+		}
+		cfg.Media.VariantCacheSize = 0x0
+		if err := cfg.Media.VariantCacheSize.Set(t); err != nil {
+			return fmt.Errorf("error parsing %#v for 'media-variant-cache-size': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["cache-s3-object-info"]; ok {
 		var err error
 		cfg.Cache.S3ObjectInfo, err = cast.ToIntE(ival)
@@ -1777,6 +2662,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["cache-card-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.CardMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-card-mem-ratio': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["cache-client-mem-ratio"]; ok {
 		var err error
 		cfg.Cache.ClientMemRatio, err = cast.ToFloat64E(ival)
@@ -2121,6 +3014,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["cache-thread-subscription-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.ThreadSubscriptionMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-thread-subscription-mem-ratio': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["cache-token-mem-ratio"]; ok {
 		var err error
 		cfg.Cache.TokenMemRatio, err = cast.ToFloat64E(ival)
@@ -2209,6 +3110,38 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["cache-status-replies-backfill-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.StatusRepliesBackfillMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-status-replies-backfill-mem-ratio': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-idempotency-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.IdempotencyMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-idempotency-mem-ratio': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-account-usage-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.AccountUsageMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-account-usage-mem-ratio': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["cache-account-activity-mem-ratio"]; ok {
+		var err error
+		cfg.Cache.AccountActivityMemRatio, err = cast.ToFloat64E(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> float64 for 'cache-account-activity-mem-ratio': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["username"]; ok {
 		var err error
 		cfg.AdminAccountUsername, err = cast.ToStringE(ival)
@@ -2233,6 +3166,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["query"]; ok {
+		var err error
+		cfg.AdminAccountQuery, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'query': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["role"]; ok {
+		var err error
+		cfg.AdminAccountRole, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'role': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["path"]; ok {
 		var err error
 		cfg.AdminTransPath, err = cast.ToStringE(ival)
@@ -2265,6 +3214,22 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["id"]; ok {
+		var err error
+		cfg.AdminMediaID, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'id': %w", ival, err)
+		}
+	}
+
+	if ival, ok := cfgmap["domain"]; ok {
+		var err error
+		cfg.AdminMediaDomain, err = cast.ToStringE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> string for 'domain': %w", ival, err)
+		}
+	}
+
 	if ival, ok := cfgmap["skip-db-setup"]; ok {
 		var err error
 		cfg.TestrigSkipDBSetup, err = cast.ToBoolE(ival)
@@ -2281,6 +3246,14 @@ func (cfg *Configuration) UnmarshalMap(cfgmap map[string]any) error {
 		}
 	}
 
+	if ival, ok := cfgmap["client-fixtures"]; ok {
+		var err error
+		cfg.TestrigClientFixtures, err = cast.ToBoolE(ival)
+		if err != nil {
+			return fmt.Errorf("error casting %#v -> bool for 'client-fixtures': %w", ival, err)
+		}
+	}
+
 	return nil
 }
 
@@ -2526,24 +3499,46 @@ func GetAccountDomain() string { return global.GetAccountDomain() }
 // SetAccountDomain safely sets the value for global configuration 'AccountDomain' field
 func SetAccountDomain(v string) { global.SetAccountDomain(v) }
 
-// GetProtocol safely fetches the Configuration value for state's 'Protocol' field
-func (st *ConfigState) GetProtocol() (v string) {
+// GetAccountDomainAliases safely fetches the Configuration value for state's 'AccountDomainAliases' field
+func (st *ConfigState) GetAccountDomainAliases() (v []string) {
 	st.mutex.RLock()
-	v = st.config.Protocol
+	v = st.config.AccountDomainAliases
 	st.mutex.RUnlock()
 	return
 }
 
-// SetProtocol safely sets the Configuration value for state's 'Protocol' field
-func (st *ConfigState) SetProtocol(v string) {
+// SetAccountDomainAliases safely sets the Configuration value for state's 'AccountDomainAliases' field
+func (st *ConfigState) SetAccountDomainAliases(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Protocol = v
+	st.config.AccountDomainAliases = v
 	st.reloadToViper()
 }
 
-// GetProtocol safely fetches the value for global configuration 'Protocol' field
-func GetProtocol() string { return global.GetProtocol() }
+// GetAccountDomainAliases safely fetches the value for global configuration 'AccountDomainAliases' field
+func GetAccountDomainAliases() []string { return global.GetAccountDomainAliases() }
+
+// SetAccountDomainAliases safely sets the value for global configuration 'AccountDomainAliases' field
+func SetAccountDomainAliases(v []string) { global.SetAccountDomainAliases(v) }
+
+// GetProtocol safely fetches the Configuration value for state's 'Protocol' field
+func (st *ConfigState) GetProtocol() (v string) {
+	st.mutex.RLock()
+	v = st.config.Protocol
+	st.mutex.RUnlock()
+	return
+}
+
+// SetProtocol safely sets the Configuration value for state's 'Protocol' field
+func (st *ConfigState) SetProtocol(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Protocol = v
+	st.reloadToViper()
+}
+
+// GetProtocol safely fetches the value for global configuration 'Protocol' field
+func GetProtocol() string { return global.GetProtocol() }
 
 // SetProtocol safely sets the value for global configuration 'Protocol' field
 func SetProtocol(v string) { global.SetProtocol(v) }
@@ -3032,6 +4027,58 @@ func GetInstanceFederationSpamFilter() bool { return global.GetInstanceFederatio
 // SetInstanceFederationSpamFilter safely sets the value for global configuration 'InstanceFederationSpamFilter' field
 func SetInstanceFederationSpamFilter(v bool) { global.SetInstanceFederationSpamFilter(v) }
 
+// GetInstanceFederationSeenActivityTTL safely fetches the Configuration value for state's 'InstanceFederationSeenActivityTTL' field
+func (st *ConfigState) GetInstanceFederationSeenActivityTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationSeenActivityTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationSeenActivityTTL safely sets the Configuration value for state's 'InstanceFederationSeenActivityTTL' field
+func (st *ConfigState) SetInstanceFederationSeenActivityTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationSeenActivityTTL = v
+	st.reloadToViper()
+}
+
+// GetInstanceFederationSeenActivityTTL safely fetches the value for global configuration 'InstanceFederationSeenActivityTTL' field
+func GetInstanceFederationSeenActivityTTL() time.Duration {
+	return global.GetInstanceFederationSeenActivityTTL()
+}
+
+// SetInstanceFederationSeenActivityTTL safely sets the value for global configuration 'InstanceFederationSeenActivityTTL' field
+func SetInstanceFederationSeenActivityTTL(v time.Duration) {
+	global.SetInstanceFederationSeenActivityTTL(v)
+}
+
+// GetInstanceFederationFailedActivityTTL safely fetches the Configuration value for state's 'InstanceFederationFailedActivityTTL' field
+func (st *ConfigState) GetInstanceFederationFailedActivityTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceFederationFailedActivityTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceFederationFailedActivityTTL safely sets the Configuration value for state's 'InstanceFederationFailedActivityTTL' field
+func (st *ConfigState) SetInstanceFederationFailedActivityTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationFailedActivityTTL = v
+	st.reloadToViper()
+}
+
+// GetInstanceFederationFailedActivityTTL safely fetches the value for global configuration 'InstanceFederationFailedActivityTTL' field
+func GetInstanceFederationFailedActivityTTL() time.Duration {
+	return global.GetInstanceFederationFailedActivityTTL()
+}
+
+// SetInstanceFederationFailedActivityTTL safely sets the value for global configuration 'InstanceFederationFailedActivityTTL' field
+func SetInstanceFederationFailedActivityTTL(v time.Duration) {
+	global.SetInstanceFederationFailedActivityTTL(v)
+}
+
 // GetInstanceExposePeers safely fetches the Configuration value for state's 'InstanceExposePeers' field
 func (st *ConfigState) GetInstanceExposePeers() (v bool) {
 	st.mutex.RLock()
@@ -3098,6 +4145,28 @@ func GetInstanceExposeBlocklistWeb() bool { return global.GetInstanceExposeBlock
 // SetInstanceExposeBlocklistWeb safely sets the value for global configuration 'InstanceExposeBlocklistWeb' field
 func SetInstanceExposeBlocklistWeb(v bool) { global.SetInstanceExposeBlocklistWeb(v) }
 
+// GetInstanceExposeBlocklistComments safely fetches the Configuration value for state's 'InstanceExposeBlocklistComments' field
+func (st *ConfigState) GetInstanceExposeBlocklistComments() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceExposeBlocklistComments
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceExposeBlocklistComments safely sets the Configuration value for state's 'InstanceExposeBlocklistComments' field
+func (st *ConfigState) SetInstanceExposeBlocklistComments(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceExposeBlocklistComments = v
+	st.reloadToViper()
+}
+
+// GetInstanceExposeBlocklistComments safely fetches the value for global configuration 'InstanceExposeBlocklistComments' field
+func GetInstanceExposeBlocklistComments() bool { return global.GetInstanceExposeBlocklistComments() }
+
+// SetInstanceExposeBlocklistComments safely sets the value for global configuration 'InstanceExposeBlocklistComments' field
+func SetInstanceExposeBlocklistComments(v bool) { global.SetInstanceExposeBlocklistComments(v) }
+
 // GetInstanceExposeAllowlist safely fetches the Configuration value for state's 'InstanceExposeAllowlist' field
 func (st *ConfigState) GetInstanceExposeAllowlist() (v bool) {
 	st.mutex.RLock()
@@ -3186,6 +4255,50 @@ func GetInstanceExposeCustomEmojis() bool { return global.GetInstanceExposeCusto
 // SetInstanceExposeCustomEmojis safely sets the value for global configuration 'InstanceExposeCustomEmojis' field
 func SetInstanceExposeCustomEmojis(v bool) { global.SetInstanceExposeCustomEmojis(v) }
 
+// GetInstanceExposeTagFeeds safely fetches the Configuration value for state's 'InstanceExposeTagFeeds' field
+func (st *ConfigState) GetInstanceExposeTagFeeds() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceExposeTagFeeds
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceExposeTagFeeds safely sets the Configuration value for state's 'InstanceExposeTagFeeds' field
+func (st *ConfigState) SetInstanceExposeTagFeeds(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceExposeTagFeeds = v
+	st.reloadToViper()
+}
+
+// GetInstanceExposeTagFeeds safely fetches the value for global configuration 'InstanceExposeTagFeeds' field
+func GetInstanceExposeTagFeeds() bool { return global.GetInstanceExposeTagFeeds() }
+
+// SetInstanceExposeTagFeeds safely sets the value for global configuration 'InstanceExposeTagFeeds' field
+func SetInstanceExposeTagFeeds(v bool) { global.SetInstanceExposeTagFeeds(v) }
+
+// GetInstanceExposeLocalFeed safely fetches the Configuration value for state's 'InstanceExposeLocalFeed' field
+func (st *ConfigState) GetInstanceExposeLocalFeed() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceExposeLocalFeed
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceExposeLocalFeed safely sets the Configuration value for state's 'InstanceExposeLocalFeed' field
+func (st *ConfigState) SetInstanceExposeLocalFeed(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceExposeLocalFeed = v
+	st.reloadToViper()
+}
+
+// GetInstanceExposeLocalFeed safely fetches the value for global configuration 'InstanceExposeLocalFeed' field
+func GetInstanceExposeLocalFeed() bool { return global.GetInstanceExposeLocalFeed() }
+
+// SetInstanceExposeLocalFeed safely sets the value for global configuration 'InstanceExposeLocalFeed' field
+func SetInstanceExposeLocalFeed(v bool) { global.SetInstanceExposeLocalFeed(v) }
+
 // GetInstanceDeliverToSharedInboxes safely fetches the Configuration value for state's 'InstanceDeliverToSharedInboxes' field
 func (st *ConfigState) GetInstanceDeliverToSharedInboxes() (v bool) {
 	st.mutex.RLock()
@@ -3302,6 +4415,50 @@ func SetInstanceSubscriptionsProcessEvery(v time.Duration) {
 	global.SetInstanceSubscriptionsProcessEvery(v)
 }
 
+// GetInstancePeersCrawlEvery safely fetches the Configuration value for state's 'InstancePeersCrawlEvery' field
+func (st *ConfigState) GetInstancePeersCrawlEvery() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstancePeersCrawlEvery
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstancePeersCrawlEvery safely sets the Configuration value for state's 'InstancePeersCrawlEvery' field
+func (st *ConfigState) SetInstancePeersCrawlEvery(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstancePeersCrawlEvery = v
+	st.reloadToViper()
+}
+
+// GetInstancePeersCrawlEvery safely fetches the value for global configuration 'InstancePeersCrawlEvery' field
+func GetInstancePeersCrawlEvery() time.Duration { return global.GetInstancePeersCrawlEvery() }
+
+// SetInstancePeersCrawlEvery safely sets the value for global configuration 'InstancePeersCrawlEvery' field
+func SetInstancePeersCrawlEvery(v time.Duration) { global.SetInstancePeersCrawlEvery(v) }
+
+// GetInstanceSitemapGenerateEvery safely fetches the Configuration value for state's 'InstanceSitemapGenerateEvery' field
+func (st *ConfigState) GetInstanceSitemapGenerateEvery() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceSitemapGenerateEvery
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceSitemapGenerateEvery safely sets the Configuration value for state's 'InstanceSitemapGenerateEvery' field
+func (st *ConfigState) SetInstanceSitemapGenerateEvery(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceSitemapGenerateEvery = v
+	st.reloadToViper()
+}
+
+// GetInstanceSitemapGenerateEvery safely fetches the value for global configuration 'InstanceSitemapGenerateEvery' field
+func GetInstanceSitemapGenerateEvery() time.Duration { return global.GetInstanceSitemapGenerateEvery() }
+
+// SetInstanceSitemapGenerateEvery safely sets the value for global configuration 'InstanceSitemapGenerateEvery' field
+func SetInstanceSitemapGenerateEvery(v time.Duration) { global.SetInstanceSitemapGenerateEvery(v) }
+
 // GetInstanceStatsMode safely fetches the Configuration value for state's 'InstanceStatsMode' field
 func (st *ConfigState) GetInstanceStatsMode() (v string) {
 	st.mutex.RLock()
@@ -3324,6 +4481,50 @@ func GetInstanceStatsMode() string { return global.GetInstanceStatsMode() }
 // SetInstanceStatsMode safely sets the value for global configuration 'InstanceStatsMode' field
 func SetInstanceStatsMode(v string) { global.SetInstanceStatsMode(v) }
 
+// GetInstancePeersMode safely fetches the Configuration value for state's 'InstancePeersMode' field
+func (st *ConfigState) GetInstancePeersMode() (v string) {
+	st.mutex.RLock()
+	v = st.config.InstancePeersMode
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstancePeersMode safely sets the Configuration value for state's 'InstancePeersMode' field
+func (st *ConfigState) SetInstancePeersMode(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstancePeersMode = v
+	st.reloadToViper()
+}
+
+// GetInstancePeersMode safely fetches the value for global configuration 'InstancePeersMode' field
+func GetInstancePeersMode() string { return global.GetInstancePeersMode() }
+
+// SetInstancePeersMode safely sets the value for global configuration 'InstancePeersMode' field
+func SetInstancePeersMode(v string) { global.SetInstancePeersMode(v) }
+
+// GetInstanceActivityMode safely fetches the Configuration value for state's 'InstanceActivityMode' field
+func (st *ConfigState) GetInstanceActivityMode() (v string) {
+	st.mutex.RLock()
+	v = st.config.InstanceActivityMode
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceActivityMode safely sets the Configuration value for state's 'InstanceActivityMode' field
+func (st *ConfigState) SetInstanceActivityMode(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceActivityMode = v
+	st.reloadToViper()
+}
+
+// GetInstanceActivityMode safely fetches the value for global configuration 'InstanceActivityMode' field
+func GetInstanceActivityMode() string { return global.GetInstanceActivityMode() }
+
+// SetInstanceActivityMode safely sets the value for global configuration 'InstanceActivityMode' field
+func SetInstanceActivityMode(v string) { global.SetInstanceActivityMode(v) }
+
 // GetInstanceAllowBackdatingStatuses safely fetches the Configuration value for state's 'InstanceAllowBackdatingStatuses' field
 func (st *ConfigState) GetInstanceAllowBackdatingStatuses() (v bool) {
 	st.mutex.RLock()
@@ -3346,6 +4547,94 @@ func GetInstanceAllowBackdatingStatuses() bool { return global.GetInstanceAllowB
 // SetInstanceAllowBackdatingStatuses safely sets the value for global configuration 'InstanceAllowBackdatingStatuses' field
 func SetInstanceAllowBackdatingStatuses(v bool) { global.SetInstanceAllowBackdatingStatuses(v) }
 
+// GetInstanceRelayEndpoint safely fetches the Configuration value for state's 'InstanceRelayEndpoint' field
+func (st *ConfigState) GetInstanceRelayEndpoint() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceRelayEndpoint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceRelayEndpoint safely sets the Configuration value for state's 'InstanceRelayEndpoint' field
+func (st *ConfigState) SetInstanceRelayEndpoint(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceRelayEndpoint = v
+	st.reloadToViper()
+}
+
+// GetInstanceRelayEndpoint safely fetches the value for global configuration 'InstanceRelayEndpoint' field
+func GetInstanceRelayEndpoint() bool { return global.GetInstanceRelayEndpoint() }
+
+// SetInstanceRelayEndpoint safely sets the value for global configuration 'InstanceRelayEndpoint' field
+func SetInstanceRelayEndpoint(v bool) { global.SetInstanceRelayEndpoint(v) }
+
+// GetInstanceIntegrityProofsSign safely fetches the Configuration value for state's 'InstanceIntegrityProofsSign' field
+func (st *ConfigState) GetInstanceIntegrityProofsSign() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceIntegrityProofsSign
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceIntegrityProofsSign safely sets the Configuration value for state's 'InstanceIntegrityProofsSign' field
+func (st *ConfigState) SetInstanceIntegrityProofsSign(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceIntegrityProofsSign = v
+	st.reloadToViper()
+}
+
+// GetInstanceIntegrityProofsSign safely fetches the value for global configuration 'InstanceIntegrityProofsSign' field
+func GetInstanceIntegrityProofsSign() bool { return global.GetInstanceIntegrityProofsSign() }
+
+// SetInstanceIntegrityProofsSign safely sets the value for global configuration 'InstanceIntegrityProofsSign' field
+func SetInstanceIntegrityProofsSign(v bool) { global.SetInstanceIntegrityProofsSign(v) }
+
+// GetInstanceIntegrityProofsVerify safely fetches the Configuration value for state's 'InstanceIntegrityProofsVerify' field
+func (st *ConfigState) GetInstanceIntegrityProofsVerify() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceIntegrityProofsVerify
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceIntegrityProofsVerify safely sets the Configuration value for state's 'InstanceIntegrityProofsVerify' field
+func (st *ConfigState) SetInstanceIntegrityProofsVerify(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceIntegrityProofsVerify = v
+	st.reloadToViper()
+}
+
+// GetInstanceIntegrityProofsVerify safely fetches the value for global configuration 'InstanceIntegrityProofsVerify' field
+func GetInstanceIntegrityProofsVerify() bool { return global.GetInstanceIntegrityProofsVerify() }
+
+// SetInstanceIntegrityProofsVerify safely sets the value for global configuration 'InstanceIntegrityProofsVerify' field
+func SetInstanceIntegrityProofsVerify(v bool) { global.SetInstanceIntegrityProofsVerify(v) }
+
+// GetInstanceExperimentalC2S safely fetches the Configuration value for state's 'InstanceExperimentalC2S' field
+func (st *ConfigState) GetInstanceExperimentalC2S() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceExperimentalC2S
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceExperimentalC2S safely sets the Configuration value for state's 'InstanceExperimentalC2S' field
+func (st *ConfigState) SetInstanceExperimentalC2S(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceExperimentalC2S = v
+	st.reloadToViper()
+}
+
+// GetInstanceExperimentalC2S safely fetches the value for global configuration 'InstanceExperimentalC2S' field
+func GetInstanceExperimentalC2S() bool { return global.GetInstanceExperimentalC2S() }
+
+// SetInstanceExperimentalC2S safely sets the value for global configuration 'InstanceExperimentalC2S' field
+func SetInstanceExperimentalC2S(v bool) { global.SetInstanceExperimentalC2S(v) }
+
 // GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
 func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
 	st.mutex.RLock()
@@ -3434,6 +4723,28 @@ func GetAccountsRegistrationBacklogLimit() int { return global.GetAccountsRegist
 // SetAccountsRegistrationBacklogLimit safely sets the value for global configuration 'AccountsRegistrationBacklogLimit' field
 func SetAccountsRegistrationBacklogLimit(v int) { global.SetAccountsRegistrationBacklogLimit(v) }
 
+// GetAccountsInvitesAllowed safely fetches the Configuration value for state's 'AccountsInvitesAllowed' field
+func (st *ConfigState) GetAccountsInvitesAllowed() (v string) {
+	st.mutex.RLock()
+	v = st.config.AccountsInvitesAllowed
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsInvitesAllowed safely sets the Configuration value for state's 'AccountsInvitesAllowed' field
+func (st *ConfigState) SetAccountsInvitesAllowed(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsInvitesAllowed = v
+	st.reloadToViper()
+}
+
+// GetAccountsInvitesAllowed safely fetches the value for global configuration 'AccountsInvitesAllowed' field
+func GetAccountsInvitesAllowed() string { return global.GetAccountsInvitesAllowed() }
+
+// SetAccountsInvitesAllowed safely sets the value for global configuration 'AccountsInvitesAllowed' field
+func SetAccountsInvitesAllowed(v string) { global.SetAccountsInvitesAllowed(v) }
+
 // GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
 func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
 	st.mutex.RLock()
@@ -3478,6 +4789,28 @@ func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength
 // SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
 func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
 
+// GetAccountsAllowCustomDomains safely fetches the Configuration value for state's 'AccountsAllowCustomDomains' field
+func (st *ConfigState) GetAccountsAllowCustomDomains() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsAllowCustomDomains
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsAllowCustomDomains safely sets the Configuration value for state's 'AccountsAllowCustomDomains' field
+func (st *ConfigState) SetAccountsAllowCustomDomains(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsAllowCustomDomains = v
+	st.reloadToViper()
+}
+
+// GetAccountsAllowCustomDomains safely fetches the value for global configuration 'AccountsAllowCustomDomains' field
+func GetAccountsAllowCustomDomains() bool { return global.GetAccountsAllowCustomDomains() }
+
+// SetAccountsAllowCustomDomains safely sets the value for global configuration 'AccountsAllowCustomDomains' field
+func SetAccountsAllowCustomDomains(v bool) { global.SetAccountsAllowCustomDomains(v) }
+
 // GetAccountsMaxProfileFields safely fetches the Configuration value for state's 'AccountsMaxProfileFields' field
 func (st *ConfigState) GetAccountsMaxProfileFields() (v int) {
 	st.mutex.RLock()
@@ -3500,115 +4833,251 @@ func GetAccountsMaxProfileFields() int { return global.GetAccountsMaxProfileFiel
 // SetAccountsMaxProfileFields safely sets the value for global configuration 'AccountsMaxProfileFields' field
 func SetAccountsMaxProfileFields(v int) { global.SetAccountsMaxProfileFields(v) }
 
-// GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
-func (st *ConfigState) GetStorageBackend() (v string) {
+// GetAccountsProfileMediaHistory safely fetches the Configuration value for state's 'AccountsProfileMediaHistory' field
+func (st *ConfigState) GetAccountsProfileMediaHistory() (v int) {
 	st.mutex.RLock()
-	v = st.config.StorageBackend
+	v = st.config.AccountsProfileMediaHistory
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageBackend safely sets the Configuration value for state's 'StorageBackend' field
-func (st *ConfigState) SetStorageBackend(v string) {
+// SetAccountsProfileMediaHistory safely sets the Configuration value for state's 'AccountsProfileMediaHistory' field
+func (st *ConfigState) SetAccountsProfileMediaHistory(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageBackend = v
+	st.config.AccountsProfileMediaHistory = v
 	st.reloadToViper()
 }
 
-// GetStorageBackend safely fetches the value for global configuration 'StorageBackend' field
-func GetStorageBackend() string { return global.GetStorageBackend() }
+// GetAccountsProfileMediaHistory safely fetches the value for global configuration 'AccountsProfileMediaHistory' field
+func GetAccountsProfileMediaHistory() int { return global.GetAccountsProfileMediaHistory() }
 
-// SetStorageBackend safely sets the value for global configuration 'StorageBackend' field
-func SetStorageBackend(v string) { global.SetStorageBackend(v) }
+// SetAccountsProfileMediaHistory safely sets the value for global configuration 'AccountsProfileMediaHistory' field
+func SetAccountsProfileMediaHistory(v int) { global.SetAccountsProfileMediaHistory(v) }
 
-// GetStorageLocalBasePath safely fetches the Configuration value for state's 'StorageLocalBasePath' field
-func (st *ConfigState) GetStorageLocalBasePath() (v string) {
+// GetAccountsSuspensionGracePeriod safely fetches the Configuration value for state's 'AccountsSuspensionGracePeriod' field
+func (st *ConfigState) GetAccountsSuspensionGracePeriod() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.StorageLocalBasePath
+	v = st.config.AccountsSuspensionGracePeriod
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageLocalBasePath safely sets the Configuration value for state's 'StorageLocalBasePath' field
-func (st *ConfigState) SetStorageLocalBasePath(v string) {
+// SetAccountsSuspensionGracePeriod safely sets the Configuration value for state's 'AccountsSuspensionGracePeriod' field
+func (st *ConfigState) SetAccountsSuspensionGracePeriod(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageLocalBasePath = v
+	st.config.AccountsSuspensionGracePeriod = v
 	st.reloadToViper()
 }
 
-// GetStorageLocalBasePath safely fetches the value for global configuration 'StorageLocalBasePath' field
-func GetStorageLocalBasePath() string { return global.GetStorageLocalBasePath() }
+// GetAccountsSuspensionGracePeriod safely fetches the value for global configuration 'AccountsSuspensionGracePeriod' field
+func GetAccountsSuspensionGracePeriod() time.Duration {
+	return global.GetAccountsSuspensionGracePeriod()
+}
 
-// SetStorageLocalBasePath safely sets the value for global configuration 'StorageLocalBasePath' field
-func SetStorageLocalBasePath(v string) { global.SetStorageLocalBasePath(v) }
+// SetAccountsSuspensionGracePeriod safely sets the value for global configuration 'AccountsSuspensionGracePeriod' field
+func SetAccountsSuspensionGracePeriod(v time.Duration) { global.SetAccountsSuspensionGracePeriod(v) }
 
-// GetStorageS3Endpoint safely fetches the Configuration value for state's 'StorageS3Endpoint' field
-func (st *ConfigState) GetStorageS3Endpoint() (v string) {
+// GetAccountsFollowRequestExpiry safely fetches the Configuration value for state's 'AccountsFollowRequestExpiry' field
+func (st *ConfigState) GetAccountsFollowRequestExpiry() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.StorageS3Endpoint
+	v = st.config.AccountsFollowRequestExpiry
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3Endpoint safely sets the Configuration value for state's 'StorageS3Endpoint' field
-func (st *ConfigState) SetStorageS3Endpoint(v string) {
+// SetAccountsFollowRequestExpiry safely sets the Configuration value for state's 'AccountsFollowRequestExpiry' field
+func (st *ConfigState) SetAccountsFollowRequestExpiry(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3Endpoint = v
+	st.config.AccountsFollowRequestExpiry = v
 	st.reloadToViper()
 }
 
-// GetStorageS3Endpoint safely fetches the value for global configuration 'StorageS3Endpoint' field
-func GetStorageS3Endpoint() string { return global.GetStorageS3Endpoint() }
+// GetAccountsFollowRequestExpiry safely fetches the value for global configuration 'AccountsFollowRequestExpiry' field
+func GetAccountsFollowRequestExpiry() time.Duration { return global.GetAccountsFollowRequestExpiry() }
 
-// SetStorageS3Endpoint safely sets the value for global configuration 'StorageS3Endpoint' field
-func SetStorageS3Endpoint(v string) { global.SetStorageS3Endpoint(v) }
+// SetAccountsFollowRequestExpiry safely sets the value for global configuration 'AccountsFollowRequestExpiry' field
+func SetAccountsFollowRequestExpiry(v time.Duration) { global.SetAccountsFollowRequestExpiry(v) }
 
-// GetStorageS3AccessKey safely fetches the Configuration value for state's 'StorageS3AccessKey' field
-func (st *ConfigState) GetStorageS3AccessKey() (v string) {
+// GetAccountsLoginHistoryRetention safely fetches the Configuration value for state's 'AccountsLoginHistoryRetention' field
+func (st *ConfigState) GetAccountsLoginHistoryRetention() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.StorageS3AccessKey
+	v = st.config.AccountsLoginHistoryRetention
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3AccessKey safely sets the Configuration value for state's 'StorageS3AccessKey' field
-func (st *ConfigState) SetStorageS3AccessKey(v string) {
+// SetAccountsLoginHistoryRetention safely sets the Configuration value for state's 'AccountsLoginHistoryRetention' field
+func (st *ConfigState) SetAccountsLoginHistoryRetention(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3AccessKey = v
+	st.config.AccountsLoginHistoryRetention = v
 	st.reloadToViper()
 }
 
-// GetStorageS3AccessKey safely fetches the value for global configuration 'StorageS3AccessKey' field
-func GetStorageS3AccessKey() string { return global.GetStorageS3AccessKey() }
+// GetAccountsLoginHistoryRetention safely fetches the value for global configuration 'AccountsLoginHistoryRetention' field
+func GetAccountsLoginHistoryRetention() time.Duration {
+	return global.GetAccountsLoginHistoryRetention()
+}
 
-// SetStorageS3AccessKey safely sets the value for global configuration 'StorageS3AccessKey' field
-func SetStorageS3AccessKey(v string) { global.SetStorageS3AccessKey(v) }
+// SetAccountsLoginHistoryRetention safely sets the value for global configuration 'AccountsLoginHistoryRetention' field
+func SetAccountsLoginHistoryRetention(v time.Duration) { global.SetAccountsLoginHistoryRetention(v) }
 
-// GetStorageS3SecretKey safely fetches the Configuration value for state's 'StorageS3SecretKey' field
-func (st *ConfigState) GetStorageS3SecretKey() (v string) {
+// GetAccountsLoginNotificationEmails safely fetches the Configuration value for state's 'AccountsLoginNotificationEmails' field
+func (st *ConfigState) GetAccountsLoginNotificationEmails() (v bool) {
 	st.mutex.RLock()
-	v = st.config.StorageS3SecretKey
+	v = st.config.AccountsLoginNotificationEmails
 	st.mutex.RUnlock()
 	return
 }
 
-// SetStorageS3SecretKey safely sets the Configuration value for state's 'StorageS3SecretKey' field
-func (st *ConfigState) SetStorageS3SecretKey(v string) {
+// SetAccountsLoginNotificationEmails safely sets the Configuration value for state's 'AccountsLoginNotificationEmails' field
+func (st *ConfigState) SetAccountsLoginNotificationEmails(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.StorageS3SecretKey = v
+	st.config.AccountsLoginNotificationEmails = v
 	st.reloadToViper()
 }
 
-// GetStorageS3SecretKey safely fetches the value for global configuration 'StorageS3SecretKey' field
-func GetStorageS3SecretKey() string { return global.GetStorageS3SecretKey() }
+// GetAccountsLoginNotificationEmails safely fetches the value for global configuration 'AccountsLoginNotificationEmails' field
+func GetAccountsLoginNotificationEmails() bool { return global.GetAccountsLoginNotificationEmails() }
 
-// SetStorageS3SecretKey safely sets the value for global configuration 'StorageS3SecretKey' field
-func SetStorageS3SecretKey(v string) { global.SetStorageS3SecretKey(v) }
+// SetAccountsLoginNotificationEmails safely sets the value for global configuration 'AccountsLoginNotificationEmails' field
+func SetAccountsLoginNotificationEmails(v bool) { global.SetAccountsLoginNotificationEmails(v) }
+
+// GetAccountsStatsRecountEvery safely fetches the Configuration value for state's 'AccountsStatsRecountEvery' field
+func (st *ConfigState) GetAccountsStatsRecountEvery() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.AccountsStatsRecountEvery
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsStatsRecountEvery safely sets the Configuration value for state's 'AccountsStatsRecountEvery' field
+func (st *ConfigState) SetAccountsStatsRecountEvery(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsStatsRecountEvery = v
+	st.reloadToViper()
+}
+
+// GetAccountsStatsRecountEvery safely fetches the value for global configuration 'AccountsStatsRecountEvery' field
+func GetAccountsStatsRecountEvery() time.Duration { return global.GetAccountsStatsRecountEvery() }
+
+// SetAccountsStatsRecountEvery safely sets the value for global configuration 'AccountsStatsRecountEvery' field
+func SetAccountsStatsRecountEvery(v time.Duration) { global.SetAccountsStatsRecountEvery(v) }
+
+// GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
+func (st *ConfigState) GetStorageBackend() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageBackend
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageBackend safely sets the Configuration value for state's 'StorageBackend' field
+func (st *ConfigState) SetStorageBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageBackend = v
+	st.reloadToViper()
+}
+
+// GetStorageBackend safely fetches the value for global configuration 'StorageBackend' field
+func GetStorageBackend() string { return global.GetStorageBackend() }
+
+// SetStorageBackend safely sets the value for global configuration 'StorageBackend' field
+func SetStorageBackend(v string) { global.SetStorageBackend(v) }
+
+// GetStorageLocalBasePath safely fetches the Configuration value for state's 'StorageLocalBasePath' field
+func (st *ConfigState) GetStorageLocalBasePath() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageLocalBasePath
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageLocalBasePath safely sets the Configuration value for state's 'StorageLocalBasePath' field
+func (st *ConfigState) SetStorageLocalBasePath(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageLocalBasePath = v
+	st.reloadToViper()
+}
+
+// GetStorageLocalBasePath safely fetches the value for global configuration 'StorageLocalBasePath' field
+func GetStorageLocalBasePath() string { return global.GetStorageLocalBasePath() }
+
+// SetStorageLocalBasePath safely sets the value for global configuration 'StorageLocalBasePath' field
+func SetStorageLocalBasePath(v string) { global.SetStorageLocalBasePath(v) }
+
+// GetStorageS3Endpoint safely fetches the Configuration value for state's 'StorageS3Endpoint' field
+func (st *ConfigState) GetStorageS3Endpoint() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3Endpoint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3Endpoint safely sets the Configuration value for state's 'StorageS3Endpoint' field
+func (st *ConfigState) SetStorageS3Endpoint(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3Endpoint = v
+	st.reloadToViper()
+}
+
+// GetStorageS3Endpoint safely fetches the value for global configuration 'StorageS3Endpoint' field
+func GetStorageS3Endpoint() string { return global.GetStorageS3Endpoint() }
+
+// SetStorageS3Endpoint safely sets the value for global configuration 'StorageS3Endpoint' field
+func SetStorageS3Endpoint(v string) { global.SetStorageS3Endpoint(v) }
+
+// GetStorageS3AccessKey safely fetches the Configuration value for state's 'StorageS3AccessKey' field
+func (st *ConfigState) GetStorageS3AccessKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3AccessKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3AccessKey safely sets the Configuration value for state's 'StorageS3AccessKey' field
+func (st *ConfigState) SetStorageS3AccessKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3AccessKey = v
+	st.reloadToViper()
+}
+
+// GetStorageS3AccessKey safely fetches the value for global configuration 'StorageS3AccessKey' field
+func GetStorageS3AccessKey() string { return global.GetStorageS3AccessKey() }
+
+// SetStorageS3AccessKey safely sets the value for global configuration 'StorageS3AccessKey' field
+func SetStorageS3AccessKey(v string) { global.SetStorageS3AccessKey(v) }
+
+// GetStorageS3SecretKey safely fetches the Configuration value for state's 'StorageS3SecretKey' field
+func (st *ConfigState) GetStorageS3SecretKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3SecretKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3SecretKey safely sets the Configuration value for state's 'StorageS3SecretKey' field
+func (st *ConfigState) SetStorageS3SecretKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3SecretKey = v
+	st.reloadToViper()
+}
+
+// GetStorageS3SecretKey safely fetches the value for global configuration 'StorageS3SecretKey' field
+func GetStorageS3SecretKey() string { return global.GetStorageS3SecretKey() }
+
+// SetStorageS3SecretKey safely sets the value for global configuration 'StorageS3SecretKey' field
+func SetStorageS3SecretKey(v string) { global.SetStorageS3SecretKey(v) }
 
 // GetStorageS3UseSSL safely fetches the Configuration value for state's 'StorageS3UseSSL' field
 func (st *ConfigState) GetStorageS3UseSSL() (v bool) {
@@ -3742,6 +5211,94 @@ func GetStorageS3KeyPrefix() string { return global.GetStorageS3KeyPrefix() }
 // SetStorageS3KeyPrefix safely sets the value for global configuration 'StorageS3KeyPrefix' field
 func SetStorageS3KeyPrefix(v string) { global.SetStorageS3KeyPrefix(v) }
 
+// GetStorageS3MultipartThreshold safely fetches the Configuration value for state's 'StorageS3MultipartThreshold' field
+func (st *ConfigState) GetStorageS3MultipartThreshold() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.StorageS3MultipartThreshold
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3MultipartThreshold safely sets the Configuration value for state's 'StorageS3MultipartThreshold' field
+func (st *ConfigState) SetStorageS3MultipartThreshold(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3MultipartThreshold = v
+	st.reloadToViper()
+}
+
+// GetStorageS3MultipartThreshold safely fetches the value for global configuration 'StorageS3MultipartThreshold' field
+func GetStorageS3MultipartThreshold() bytesize.Size { return global.GetStorageS3MultipartThreshold() }
+
+// SetStorageS3MultipartThreshold safely sets the value for global configuration 'StorageS3MultipartThreshold' field
+func SetStorageS3MultipartThreshold(v bytesize.Size) { global.SetStorageS3MultipartThreshold(v) }
+
+// GetStorageS3MultipartPartSize safely fetches the Configuration value for state's 'StorageS3MultipartPartSize' field
+func (st *ConfigState) GetStorageS3MultipartPartSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.StorageS3MultipartPartSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3MultipartPartSize safely sets the Configuration value for state's 'StorageS3MultipartPartSize' field
+func (st *ConfigState) SetStorageS3MultipartPartSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3MultipartPartSize = v
+	st.reloadToViper()
+}
+
+// GetStorageS3MultipartPartSize safely fetches the value for global configuration 'StorageS3MultipartPartSize' field
+func GetStorageS3MultipartPartSize() bytesize.Size { return global.GetStorageS3MultipartPartSize() }
+
+// SetStorageS3MultipartPartSize safely sets the value for global configuration 'StorageS3MultipartPartSize' field
+func SetStorageS3MultipartPartSize(v bytesize.Size) { global.SetStorageS3MultipartPartSize(v) }
+
+// GetStorageS3MultipartRetries safely fetches the Configuration value for state's 'StorageS3MultipartRetries' field
+func (st *ConfigState) GetStorageS3MultipartRetries() (v int) {
+	st.mutex.RLock()
+	v = st.config.StorageS3MultipartRetries
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3MultipartRetries safely sets the Configuration value for state's 'StorageS3MultipartRetries' field
+func (st *ConfigState) SetStorageS3MultipartRetries(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3MultipartRetries = v
+	st.reloadToViper()
+}
+
+// GetStorageS3MultipartRetries safely fetches the value for global configuration 'StorageS3MultipartRetries' field
+func GetStorageS3MultipartRetries() int { return global.GetStorageS3MultipartRetries() }
+
+// SetStorageS3MultipartRetries safely sets the value for global configuration 'StorageS3MultipartRetries' field
+func SetStorageS3MultipartRetries(v int) { global.SetStorageS3MultipartRetries(v) }
+
+// GetStorageS3RemoteCacheTag safely fetches the Configuration value for state's 'StorageS3RemoteCacheTag' field
+func (st *ConfigState) GetStorageS3RemoteCacheTag() (v string) {
+	st.mutex.RLock()
+	v = st.config.StorageS3RemoteCacheTag
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStorageS3RemoteCacheTag safely sets the Configuration value for state's 'StorageS3RemoteCacheTag' field
+func (st *ConfigState) SetStorageS3RemoteCacheTag(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StorageS3RemoteCacheTag = v
+	st.reloadToViper()
+}
+
+// GetStorageS3RemoteCacheTag safely fetches the value for global configuration 'StorageS3RemoteCacheTag' field
+func GetStorageS3RemoteCacheTag() string { return global.GetStorageS3RemoteCacheTag() }
+
+// SetStorageS3RemoteCacheTag safely sets the value for global configuration 'StorageS3RemoteCacheTag' field
+func SetStorageS3RemoteCacheTag(v string) { global.SetStorageS3RemoteCacheTag(v) }
+
 // GetStatusesMaxChars safely fetches the Configuration value for state's 'StatusesMaxChars' field
 func (st *ConfigState) GetStatusesMaxChars() (v int) {
 	st.mutex.RLock()
@@ -3830,186 +5387,434 @@ func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
 // SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
 func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
 
-// GetScheduledStatusesMaxTotal safely fetches the Configuration value for state's 'ScheduledStatusesMaxTotal' field
-func (st *ConfigState) GetScheduledStatusesMaxTotal() (v int) {
+// GetStatusesSyntaxHighlighting safely fetches the Configuration value for state's 'StatusesSyntaxHighlighting' field
+func (st *ConfigState) GetStatusesSyntaxHighlighting() (v bool) {
 	st.mutex.RLock()
-	v = st.config.ScheduledStatusesMaxTotal
+	v = st.config.StatusesSyntaxHighlighting
 	st.mutex.RUnlock()
 	return
 }
 
-// SetScheduledStatusesMaxTotal safely sets the Configuration value for state's 'ScheduledStatusesMaxTotal' field
-func (st *ConfigState) SetScheduledStatusesMaxTotal(v int) {
+// SetStatusesSyntaxHighlighting safely sets the Configuration value for state's 'StatusesSyntaxHighlighting' field
+func (st *ConfigState) SetStatusesSyntaxHighlighting(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.ScheduledStatusesMaxTotal = v
+	st.config.StatusesSyntaxHighlighting = v
 	st.reloadToViper()
 }
 
-// GetScheduledStatusesMaxTotal safely fetches the value for global configuration 'ScheduledStatusesMaxTotal' field
-func GetScheduledStatusesMaxTotal() int { return global.GetScheduledStatusesMaxTotal() }
+// GetStatusesSyntaxHighlighting safely fetches the value for global configuration 'StatusesSyntaxHighlighting' field
+func GetStatusesSyntaxHighlighting() bool { return global.GetStatusesSyntaxHighlighting() }
 
-// SetScheduledStatusesMaxTotal safely sets the value for global configuration 'ScheduledStatusesMaxTotal' field
-func SetScheduledStatusesMaxTotal(v int) { global.SetScheduledStatusesMaxTotal(v) }
+// SetStatusesSyntaxHighlighting safely sets the value for global configuration 'StatusesSyntaxHighlighting' field
+func SetStatusesSyntaxHighlighting(v bool) { global.SetStatusesSyntaxHighlighting(v) }
 
-// GetScheduledStatusesMaxDaily safely fetches the Configuration value for state's 'ScheduledStatusesMaxDaily' field
-func (st *ConfigState) GetScheduledStatusesMaxDaily() (v int) {
+// GetStatusesBackfillReplies safely fetches the Configuration value for state's 'StatusesBackfillReplies' field
+func (st *ConfigState) GetStatusesBackfillReplies() (v bool) {
 	st.mutex.RLock()
-	v = st.config.ScheduledStatusesMaxDaily
+	v = st.config.StatusesBackfillReplies
 	st.mutex.RUnlock()
 	return
 }
 
-// SetScheduledStatusesMaxDaily safely sets the Configuration value for state's 'ScheduledStatusesMaxDaily' field
-func (st *ConfigState) SetScheduledStatusesMaxDaily(v int) {
+// SetStatusesBackfillReplies safely sets the Configuration value for state's 'StatusesBackfillReplies' field
+func (st *ConfigState) SetStatusesBackfillReplies(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.ScheduledStatusesMaxDaily = v
+	st.config.StatusesBackfillReplies = v
 	st.reloadToViper()
 }
 
-// GetScheduledStatusesMaxDaily safely fetches the value for global configuration 'ScheduledStatusesMaxDaily' field
-func GetScheduledStatusesMaxDaily() int { return global.GetScheduledStatusesMaxDaily() }
+// GetStatusesBackfillReplies safely fetches the value for global configuration 'StatusesBackfillReplies' field
+func GetStatusesBackfillReplies() bool { return global.GetStatusesBackfillReplies() }
 
-// SetScheduledStatusesMaxDaily safely sets the value for global configuration 'ScheduledStatusesMaxDaily' field
-func SetScheduledStatusesMaxDaily(v int) { global.SetScheduledStatusesMaxDaily(v) }
+// SetStatusesBackfillReplies safely sets the value for global configuration 'StatusesBackfillReplies' field
+func SetStatusesBackfillReplies(v bool) { global.SetStatusesBackfillReplies(v) }
 
-// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+// GetStatusesBackfillRepliesMaxDepth safely fetches the Configuration value for state's 'StatusesBackfillRepliesMaxDepth' field
+func (st *ConfigState) GetStatusesBackfillRepliesMaxDepth() (v int) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptEnabled
+	v = st.config.StatusesBackfillRepliesMaxDepth
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
-func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+// SetStatusesBackfillRepliesMaxDepth safely sets the Configuration value for state's 'StatusesBackfillRepliesMaxDepth' field
+func (st *ConfigState) SetStatusesBackfillRepliesMaxDepth(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEnabled = v
+	st.config.StatusesBackfillRepliesMaxDepth = v
 	st.reloadToViper()
 }
 
-// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
-func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+// GetStatusesBackfillRepliesMaxDepth safely fetches the value for global configuration 'StatusesBackfillRepliesMaxDepth' field
+func GetStatusesBackfillRepliesMaxDepth() int { return global.GetStatusesBackfillRepliesMaxDepth() }
 
-// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
-func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+// SetStatusesBackfillRepliesMaxDepth safely sets the value for global configuration 'StatusesBackfillRepliesMaxDepth' field
+func SetStatusesBackfillRepliesMaxDepth(v int) { global.SetStatusesBackfillRepliesMaxDepth(v) }
 
-// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) GetLetsEncryptPort() (v int) {
+// GetStatusesBackfillRepliesMaxStatuses safely fetches the Configuration value for state's 'StatusesBackfillRepliesMaxStatuses' field
+func (st *ConfigState) GetStatusesBackfillRepliesMaxStatuses() (v int) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptPort
+	v = st.config.StatusesBackfillRepliesMaxStatuses
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
-func (st *ConfigState) SetLetsEncryptPort(v int) {
+// SetStatusesBackfillRepliesMaxStatuses safely sets the Configuration value for state's 'StatusesBackfillRepliesMaxStatuses' field
+func (st *ConfigState) SetStatusesBackfillRepliesMaxStatuses(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptPort = v
+	st.config.StatusesBackfillRepliesMaxStatuses = v
 	st.reloadToViper()
 }
 
-// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
-func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+// GetStatusesBackfillRepliesMaxStatuses safely fetches the value for global configuration 'StatusesBackfillRepliesMaxStatuses' field
+func GetStatusesBackfillRepliesMaxStatuses() int {
+	return global.GetStatusesBackfillRepliesMaxStatuses()
+}
 
-// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
-func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+// SetStatusesBackfillRepliesMaxStatuses safely sets the value for global configuration 'StatusesBackfillRepliesMaxStatuses' field
+func SetStatusesBackfillRepliesMaxStatuses(v int) { global.SetStatusesBackfillRepliesMaxStatuses(v) }
 
-// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+// GetStatusesBackfillRepliesCooldown safely fetches the Configuration value for state's 'StatusesBackfillRepliesCooldown' field
+func (st *ConfigState) GetStatusesBackfillRepliesCooldown() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptCertDir
+	v = st.config.StatusesBackfillRepliesCooldown
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
-func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+// SetStatusesBackfillRepliesCooldown safely sets the Configuration value for state's 'StatusesBackfillRepliesCooldown' field
+func (st *ConfigState) SetStatusesBackfillRepliesCooldown(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptCertDir = v
+	st.config.StatusesBackfillRepliesCooldown = v
 	st.reloadToViper()
 }
 
-// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
-func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+// GetStatusesBackfillRepliesCooldown safely fetches the value for global configuration 'StatusesBackfillRepliesCooldown' field
+func GetStatusesBackfillRepliesCooldown() time.Duration {
+	return global.GetStatusesBackfillRepliesCooldown()
+}
 
-// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
-func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+// SetStatusesBackfillRepliesCooldown safely sets the value for global configuration 'StatusesBackfillRepliesCooldown' field
+func SetStatusesBackfillRepliesCooldown(v time.Duration) {
+	global.SetStatusesBackfillRepliesCooldown(v)
+}
 
-// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+// GetStatusesExpiryMinDuration safely fetches the Configuration value for state's 'StatusesExpiryMinDuration' field
+func (st *ConfigState) GetStatusesExpiryMinDuration() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.LetsEncryptEmailAddress
+	v = st.config.StatusesExpiryMinDuration
 	st.mutex.RUnlock()
 	return
 }
 
-// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
-func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+// SetStatusesExpiryMinDuration safely sets the Configuration value for state's 'StatusesExpiryMinDuration' field
+func (st *ConfigState) SetStatusesExpiryMinDuration(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.LetsEncryptEmailAddress = v
+	st.config.StatusesExpiryMinDuration = v
 	st.reloadToViper()
 }
 
-// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
-func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+// GetStatusesExpiryMinDuration safely fetches the value for global configuration 'StatusesExpiryMinDuration' field
+func GetStatusesExpiryMinDuration() time.Duration { return global.GetStatusesExpiryMinDuration() }
 
-// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
-func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+// SetStatusesExpiryMinDuration safely sets the value for global configuration 'StatusesExpiryMinDuration' field
+func SetStatusesExpiryMinDuration(v time.Duration) { global.SetStatusesExpiryMinDuration(v) }
 
-// GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
-func (st *ConfigState) GetTLSCertificateChain() (v string) {
+// GetStatusesExpiryMaxDuration safely fetches the Configuration value for state's 'StatusesExpiryMaxDuration' field
+func (st *ConfigState) GetStatusesExpiryMaxDuration() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.TLSCertificateChain
+	v = st.config.StatusesExpiryMaxDuration
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTLSCertificateChain safely sets the Configuration value for state's 'TLSCertificateChain' field
-func (st *ConfigState) SetTLSCertificateChain(v string) {
+// SetStatusesExpiryMaxDuration safely sets the Configuration value for state's 'StatusesExpiryMaxDuration' field
+func (st *ConfigState) SetStatusesExpiryMaxDuration(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TLSCertificateChain = v
+	st.config.StatusesExpiryMaxDuration = v
 	st.reloadToViper()
 }
 
-// GetTLSCertificateChain safely fetches the value for global configuration 'TLSCertificateChain' field
-func GetTLSCertificateChain() string { return global.GetTLSCertificateChain() }
+// GetStatusesExpiryMaxDuration safely fetches the value for global configuration 'StatusesExpiryMaxDuration' field
+func GetStatusesExpiryMaxDuration() time.Duration { return global.GetStatusesExpiryMaxDuration() }
 
-// SetTLSCertificateChain safely sets the value for global configuration 'TLSCertificateChain' field
-func SetTLSCertificateChain(v string) { global.SetTLSCertificateChain(v) }
+// SetStatusesExpiryMaxDuration safely sets the value for global configuration 'StatusesExpiryMaxDuration' field
+func SetStatusesExpiryMaxDuration(v time.Duration) { global.SetStatusesExpiryMaxDuration(v) }
 
-// GetTLSCertificateKey safely fetches the Configuration value for state's 'TLSCertificateKey' field
-func (st *ConfigState) GetTLSCertificateKey() (v string) {
+// GetStatusesCleanupInterval safely fetches the Configuration value for state's 'StatusesCleanupInterval' field
+func (st *ConfigState) GetStatusesCleanupInterval() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.TLSCertificateKey
+	v = st.config.StatusesCleanupInterval
 	st.mutex.RUnlock()
 	return
 }
 
-// SetTLSCertificateKey safely sets the Configuration value for state's 'TLSCertificateKey' field
-func (st *ConfigState) SetTLSCertificateKey(v string) {
+// SetStatusesCleanupInterval safely sets the Configuration value for state's 'StatusesCleanupInterval' field
+func (st *ConfigState) SetStatusesCleanupInterval(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.TLSCertificateKey = v
+	st.config.StatusesCleanupInterval = v
 	st.reloadToViper()
 }
 
-// GetTLSCertificateKey safely fetches the value for global configuration 'TLSCertificateKey' field
-func GetTLSCertificateKey() string { return global.GetTLSCertificateKey() }
+// GetStatusesCleanupInterval safely fetches the value for global configuration 'StatusesCleanupInterval' field
+func GetStatusesCleanupInterval() time.Duration { return global.GetStatusesCleanupInterval() }
 
-// SetTLSCertificateKey safely sets the value for global configuration 'TLSCertificateKey' field
-func SetTLSCertificateKey(v string) { global.SetTLSCertificateKey(v) }
+// SetStatusesCleanupInterval safely sets the value for global configuration 'StatusesCleanupInterval' field
+func SetStatusesCleanupInterval(v time.Duration) { global.SetStatusesCleanupInterval(v) }
 
-// GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
-func (st *ConfigState) GetOIDCEnabled() (v bool) {
+// GetStatusesLinkPreviews safely fetches the Configuration value for state's 'StatusesLinkPreviews' field
+func (st *ConfigState) GetStatusesLinkPreviews() (v bool) {
 	st.mutex.RLock()
-	v = st.config.OIDCEnabled
+	v = st.config.StatusesLinkPreviews
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesLinkPreviews safely sets the Configuration value for state's 'StatusesLinkPreviews' field
+func (st *ConfigState) SetStatusesLinkPreviews(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesLinkPreviews = v
+	st.reloadToViper()
+}
+
+// GetStatusesLinkPreviews safely fetches the value for global configuration 'StatusesLinkPreviews' field
+func GetStatusesLinkPreviews() bool { return global.GetStatusesLinkPreviews() }
+
+// SetStatusesLinkPreviews safely sets the value for global configuration 'StatusesLinkPreviews' field
+func SetStatusesLinkPreviews(v bool) { global.SetStatusesLinkPreviews(v) }
+
+// GetStatusesLinkPreviewsTTL safely fetches the Configuration value for state's 'StatusesLinkPreviewsTTL' field
+func (st *ConfigState) GetStatusesLinkPreviewsTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.StatusesLinkPreviewsTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesLinkPreviewsTTL safely sets the Configuration value for state's 'StatusesLinkPreviewsTTL' field
+func (st *ConfigState) SetStatusesLinkPreviewsTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesLinkPreviewsTTL = v
+	st.reloadToViper()
+}
+
+// GetStatusesLinkPreviewsTTL safely fetches the value for global configuration 'StatusesLinkPreviewsTTL' field
+func GetStatusesLinkPreviewsTTL() time.Duration { return global.GetStatusesLinkPreviewsTTL() }
+
+// SetStatusesLinkPreviewsTTL safely sets the value for global configuration 'StatusesLinkPreviewsTTL' field
+func SetStatusesLinkPreviewsTTL(v time.Duration) { global.SetStatusesLinkPreviewsTTL(v) }
+
+// GetStatusesLinkPreviewsRemote safely fetches the Configuration value for state's 'StatusesLinkPreviewsRemote' field
+func (st *ConfigState) GetStatusesLinkPreviewsRemote() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StatusesLinkPreviewsRemote
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesLinkPreviewsRemote safely sets the Configuration value for state's 'StatusesLinkPreviewsRemote' field
+func (st *ConfigState) SetStatusesLinkPreviewsRemote(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesLinkPreviewsRemote = v
+	st.reloadToViper()
+}
+
+// GetStatusesLinkPreviewsRemote safely fetches the value for global configuration 'StatusesLinkPreviewsRemote' field
+func GetStatusesLinkPreviewsRemote() bool { return global.GetStatusesLinkPreviewsRemote() }
+
+// SetStatusesLinkPreviewsRemote safely sets the value for global configuration 'StatusesLinkPreviewsRemote' field
+func SetStatusesLinkPreviewsRemote(v bool) { global.SetStatusesLinkPreviewsRemote(v) }
+
+// GetScheduledStatusesMaxTotal safely fetches the Configuration value for state's 'ScheduledStatusesMaxTotal' field
+func (st *ConfigState) GetScheduledStatusesMaxTotal() (v int) {
+	st.mutex.RLock()
+	v = st.config.ScheduledStatusesMaxTotal
+	st.mutex.RUnlock()
+	return
+}
+
+// SetScheduledStatusesMaxTotal safely sets the Configuration value for state's 'ScheduledStatusesMaxTotal' field
+func (st *ConfigState) SetScheduledStatusesMaxTotal(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.ScheduledStatusesMaxTotal = v
+	st.reloadToViper()
+}
+
+// GetScheduledStatusesMaxTotal safely fetches the value for global configuration 'ScheduledStatusesMaxTotal' field
+func GetScheduledStatusesMaxTotal() int { return global.GetScheduledStatusesMaxTotal() }
+
+// SetScheduledStatusesMaxTotal safely sets the value for global configuration 'ScheduledStatusesMaxTotal' field
+func SetScheduledStatusesMaxTotal(v int) { global.SetScheduledStatusesMaxTotal(v) }
+
+// GetScheduledStatusesMaxDaily safely fetches the Configuration value for state's 'ScheduledStatusesMaxDaily' field
+func (st *ConfigState) GetScheduledStatusesMaxDaily() (v int) {
+	st.mutex.RLock()
+	v = st.config.ScheduledStatusesMaxDaily
+	st.mutex.RUnlock()
+	return
+}
+
+// SetScheduledStatusesMaxDaily safely sets the Configuration value for state's 'ScheduledStatusesMaxDaily' field
+func (st *ConfigState) SetScheduledStatusesMaxDaily(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.ScheduledStatusesMaxDaily = v
+	st.reloadToViper()
+}
+
+// GetScheduledStatusesMaxDaily safely fetches the value for global configuration 'ScheduledStatusesMaxDaily' field
+func GetScheduledStatusesMaxDaily() int { return global.GetScheduledStatusesMaxDaily() }
+
+// SetScheduledStatusesMaxDaily safely sets the value for global configuration 'ScheduledStatusesMaxDaily' field
+func SetScheduledStatusesMaxDaily(v int) { global.SetScheduledStatusesMaxDaily(v) }
+
+// GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptEnabled safely sets the Configuration value for state's 'LetsEncryptEnabled' field
+func (st *ConfigState) SetLetsEncryptEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptEnabled = v
+	st.reloadToViper()
+}
+
+// GetLetsEncryptEnabled safely fetches the value for global configuration 'LetsEncryptEnabled' field
+func GetLetsEncryptEnabled() bool { return global.GetLetsEncryptEnabled() }
+
+// SetLetsEncryptEnabled safely sets the value for global configuration 'LetsEncryptEnabled' field
+func SetLetsEncryptEnabled(v bool) { global.SetLetsEncryptEnabled(v) }
+
+// GetLetsEncryptPort safely fetches the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) GetLetsEncryptPort() (v int) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptPort
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptPort safely sets the Configuration value for state's 'LetsEncryptPort' field
+func (st *ConfigState) SetLetsEncryptPort(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptPort = v
+	st.reloadToViper()
+}
+
+// GetLetsEncryptPort safely fetches the value for global configuration 'LetsEncryptPort' field
+func GetLetsEncryptPort() int { return global.GetLetsEncryptPort() }
+
+// SetLetsEncryptPort safely sets the value for global configuration 'LetsEncryptPort' field
+func SetLetsEncryptPort(v int) { global.SetLetsEncryptPort(v) }
+
+// GetLetsEncryptCertDir safely fetches the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) GetLetsEncryptCertDir() (v string) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptCertDir
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptCertDir safely sets the Configuration value for state's 'LetsEncryptCertDir' field
+func (st *ConfigState) SetLetsEncryptCertDir(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptCertDir = v
+	st.reloadToViper()
+}
+
+// GetLetsEncryptCertDir safely fetches the value for global configuration 'LetsEncryptCertDir' field
+func GetLetsEncryptCertDir() string { return global.GetLetsEncryptCertDir() }
+
+// SetLetsEncryptCertDir safely sets the value for global configuration 'LetsEncryptCertDir' field
+func SetLetsEncryptCertDir(v string) { global.SetLetsEncryptCertDir(v) }
+
+// GetLetsEncryptEmailAddress safely fetches the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) GetLetsEncryptEmailAddress() (v string) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptEmailAddress
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptEmailAddress safely sets the Configuration value for state's 'LetsEncryptEmailAddress' field
+func (st *ConfigState) SetLetsEncryptEmailAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptEmailAddress = v
+	st.reloadToViper()
+}
+
+// GetLetsEncryptEmailAddress safely fetches the value for global configuration 'LetsEncryptEmailAddress' field
+func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddress() }
+
+// SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
+func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
+
+// GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
+func (st *ConfigState) GetTLSCertificateChain() (v string) {
+	st.mutex.RLock()
+	v = st.config.TLSCertificateChain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTLSCertificateChain safely sets the Configuration value for state's 'TLSCertificateChain' field
+func (st *ConfigState) SetTLSCertificateChain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TLSCertificateChain = v
+	st.reloadToViper()
+}
+
+// GetTLSCertificateChain safely fetches the value for global configuration 'TLSCertificateChain' field
+func GetTLSCertificateChain() string { return global.GetTLSCertificateChain() }
+
+// SetTLSCertificateChain safely sets the value for global configuration 'TLSCertificateChain' field
+func SetTLSCertificateChain(v string) { global.SetTLSCertificateChain(v) }
+
+// GetTLSCertificateKey safely fetches the Configuration value for state's 'TLSCertificateKey' field
+func (st *ConfigState) GetTLSCertificateKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.TLSCertificateKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTLSCertificateKey safely sets the Configuration value for state's 'TLSCertificateKey' field
+func (st *ConfigState) SetTLSCertificateKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TLSCertificateKey = v
+	st.reloadToViper()
+}
+
+// GetTLSCertificateKey safely fetches the value for global configuration 'TLSCertificateKey' field
+func GetTLSCertificateKey() string { return global.GetTLSCertificateKey() }
+
+// SetTLSCertificateKey safely sets the value for global configuration 'TLSCertificateKey' field
+func SetTLSCertificateKey(v string) { global.SetTLSCertificateKey(v) }
+
+// GetOIDCEnabled safely fetches the Configuration value for state's 'OIDCEnabled' field
+func (st *ConfigState) GetOIDCEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.OIDCEnabled
 	st.mutex.RUnlock()
 	return
 }
@@ -4468,95 +6273,249 @@ func GetSyslogAddress() string { return global.GetSyslogAddress() }
 // SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
 func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
 
-// GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'Advanced.CookiesSamesite' field
-func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
+// GetTranslationProvider safely fetches the Configuration value for state's 'TranslationProvider' field
+func (st *ConfigState) GetTranslationProvider() (v string) {
 	st.mutex.RLock()
-	v = st.config.Advanced.CookiesSamesite
+	v = st.config.TranslationProvider
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedCookiesSamesite safely sets the Configuration value for state's 'Advanced.CookiesSamesite' field
-func (st *ConfigState) SetAdvancedCookiesSamesite(v string) {
+// SetTranslationProvider safely sets the Configuration value for state's 'TranslationProvider' field
+func (st *ConfigState) SetTranslationProvider(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.CookiesSamesite = v
+	st.config.TranslationProvider = v
 	st.reloadToViper()
 }
 
-// GetAdvancedCookiesSamesite safely fetches the value for global configuration 'Advanced.CookiesSamesite' field
-func GetAdvancedCookiesSamesite() string { return global.GetAdvancedCookiesSamesite() }
+// GetTranslationProvider safely fetches the value for global configuration 'TranslationProvider' field
+func GetTranslationProvider() string { return global.GetTranslationProvider() }
 
-// SetAdvancedCookiesSamesite safely sets the value for global configuration 'Advanced.CookiesSamesite' field
-func SetAdvancedCookiesSamesite(v string) { global.SetAdvancedCookiesSamesite(v) }
+// SetTranslationProvider safely sets the value for global configuration 'TranslationProvider' field
+func SetTranslationProvider(v string) { global.SetTranslationProvider(v) }
 
-// GetAdvancedSenderMultiplier safely fetches the Configuration value for state's 'Advanced.SenderMultiplier' field
-func (st *ConfigState) GetAdvancedSenderMultiplier() (v int) {
+// GetTranslationEndpoint safely fetches the Configuration value for state's 'TranslationEndpoint' field
+func (st *ConfigState) GetTranslationEndpoint() (v string) {
 	st.mutex.RLock()
-	v = st.config.Advanced.SenderMultiplier
+	v = st.config.TranslationEndpoint
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedSenderMultiplier safely sets the Configuration value for state's 'Advanced.SenderMultiplier' field
-func (st *ConfigState) SetAdvancedSenderMultiplier(v int) {
+// SetTranslationEndpoint safely sets the Configuration value for state's 'TranslationEndpoint' field
+func (st *ConfigState) SetTranslationEndpoint(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.SenderMultiplier = v
+	st.config.TranslationEndpoint = v
 	st.reloadToViper()
 }
 
-// GetAdvancedSenderMultiplier safely fetches the value for global configuration 'Advanced.SenderMultiplier' field
-func GetAdvancedSenderMultiplier() int { return global.GetAdvancedSenderMultiplier() }
+// GetTranslationEndpoint safely fetches the value for global configuration 'TranslationEndpoint' field
+func GetTranslationEndpoint() string { return global.GetTranslationEndpoint() }
 
-// SetAdvancedSenderMultiplier safely sets the value for global configuration 'Advanced.SenderMultiplier' field
-func SetAdvancedSenderMultiplier(v int) { global.SetAdvancedSenderMultiplier(v) }
+// SetTranslationEndpoint safely sets the value for global configuration 'TranslationEndpoint' field
+func SetTranslationEndpoint(v string) { global.SetTranslationEndpoint(v) }
 
-// GetAdvancedCSPExtraURIs safely fetches the Configuration value for state's 'Advanced.CSPExtraURIs' field
-func (st *ConfigState) GetAdvancedCSPExtraURIs() (v []string) {
+// GetTranslationAPIKey safely fetches the Configuration value for state's 'TranslationAPIKey' field
+func (st *ConfigState) GetTranslationAPIKey() (v string) {
 	st.mutex.RLock()
-	v = st.config.Advanced.CSPExtraURIs
+	v = st.config.TranslationAPIKey
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedCSPExtraURIs safely sets the Configuration value for state's 'Advanced.CSPExtraURIs' field
-func (st *ConfigState) SetAdvancedCSPExtraURIs(v []string) {
+// SetTranslationAPIKey safely sets the Configuration value for state's 'TranslationAPIKey' field
+func (st *ConfigState) SetTranslationAPIKey(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.CSPExtraURIs = v
+	st.config.TranslationAPIKey = v
 	st.reloadToViper()
 }
 
-// GetAdvancedCSPExtraURIs safely fetches the value for global configuration 'Advanced.CSPExtraURIs' field
-func GetAdvancedCSPExtraURIs() []string { return global.GetAdvancedCSPExtraURIs() }
+// GetTranslationAPIKey safely fetches the value for global configuration 'TranslationAPIKey' field
+func GetTranslationAPIKey() string { return global.GetTranslationAPIKey() }
 
-// SetAdvancedCSPExtraURIs safely sets the value for global configuration 'Advanced.CSPExtraURIs' field
-func SetAdvancedCSPExtraURIs(v []string) { global.SetAdvancedCSPExtraURIs(v) }
+// SetTranslationAPIKey safely sets the value for global configuration 'TranslationAPIKey' field
+func SetTranslationAPIKey(v string) { global.SetTranslationAPIKey(v) }
 
-// GetAdvancedHeaderFilterMode safely fetches the Configuration value for state's 'Advanced.HeaderFilterMode' field
-func (st *ConfigState) GetAdvancedHeaderFilterMode() (v string) {
+// GetTranslationRateLimit safely fetches the Configuration value for state's 'TranslationRateLimit' field
+func (st *ConfigState) GetTranslationRateLimit() (v int) {
 	st.mutex.RLock()
-	v = st.config.Advanced.HeaderFilterMode
+	v = st.config.TranslationRateLimit
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedHeaderFilterMode safely sets the Configuration value for state's 'Advanced.HeaderFilterMode' field
-func (st *ConfigState) SetAdvancedHeaderFilterMode(v string) {
+// SetTranslationRateLimit safely sets the Configuration value for state's 'TranslationRateLimit' field
+func (st *ConfigState) SetTranslationRateLimit(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Advanced.HeaderFilterMode = v
+	st.config.TranslationRateLimit = v
 	st.reloadToViper()
 }
 
-// GetAdvancedHeaderFilterMode safely fetches the value for global configuration 'Advanced.HeaderFilterMode' field
-func GetAdvancedHeaderFilterMode() string { return global.GetAdvancedHeaderFilterMode() }
+// GetTranslationRateLimit safely fetches the value for global configuration 'TranslationRateLimit' field
+func GetTranslationRateLimit() int { return global.GetTranslationRateLimit() }
 
-// SetAdvancedHeaderFilterMode safely sets the value for global configuration 'Advanced.HeaderFilterMode' field
-func SetAdvancedHeaderFilterMode(v string) { global.SetAdvancedHeaderFilterMode(v) }
+// SetTranslationRateLimit safely sets the value for global configuration 'TranslationRateLimit' field
+func SetTranslationRateLimit(v int) { global.SetTranslationRateLimit(v) }
 
-// GetAdvancedRateLimitRequests safely fetches the Configuration value for state's 'Advanced.RateLimit.Requests' field
+// GetEventBusProvider safely fetches the Configuration value for state's 'EventBusProvider' field
+func (st *ConfigState) GetEventBusProvider() (v string) {
+	st.mutex.RLock()
+	v = st.config.EventBusProvider
+	st.mutex.RUnlock()
+	return
+}
+
+// SetEventBusProvider safely sets the Configuration value for state's 'EventBusProvider' field
+func (st *ConfigState) SetEventBusProvider(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.EventBusProvider = v
+	st.reloadToViper()
+}
+
+// GetEventBusProvider safely fetches the value for global configuration 'EventBusProvider' field
+func GetEventBusProvider() string { return global.GetEventBusProvider() }
+
+// SetEventBusProvider safely sets the value for global configuration 'EventBusProvider' field
+func SetEventBusProvider(v string) { global.SetEventBusProvider(v) }
+
+// GetEventBusURL safely fetches the Configuration value for state's 'EventBusURL' field
+func (st *ConfigState) GetEventBusURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.EventBusURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetEventBusURL safely sets the Configuration value for state's 'EventBusURL' field
+func (st *ConfigState) SetEventBusURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.EventBusURL = v
+	st.reloadToViper()
+}
+
+// GetEventBusURL safely fetches the value for global configuration 'EventBusURL' field
+func GetEventBusURL() string { return global.GetEventBusURL() }
+
+// SetEventBusURL safely sets the value for global configuration 'EventBusURL' field
+func SetEventBusURL(v string) { global.SetEventBusURL(v) }
+
+// GetEventBusSubjectPrefix safely fetches the Configuration value for state's 'EventBusSubjectPrefix' field
+func (st *ConfigState) GetEventBusSubjectPrefix() (v string) {
+	st.mutex.RLock()
+	v = st.config.EventBusSubjectPrefix
+	st.mutex.RUnlock()
+	return
+}
+
+// SetEventBusSubjectPrefix safely sets the Configuration value for state's 'EventBusSubjectPrefix' field
+func (st *ConfigState) SetEventBusSubjectPrefix(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.EventBusSubjectPrefix = v
+	st.reloadToViper()
+}
+
+// GetEventBusSubjectPrefix safely fetches the value for global configuration 'EventBusSubjectPrefix' field
+func GetEventBusSubjectPrefix() string { return global.GetEventBusSubjectPrefix() }
+
+// SetEventBusSubjectPrefix safely sets the value for global configuration 'EventBusSubjectPrefix' field
+func SetEventBusSubjectPrefix(v string) { global.SetEventBusSubjectPrefix(v) }
+
+// GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'Advanced.CookiesSamesite' field
+func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
+	st.mutex.RLock()
+	v = st.config.Advanced.CookiesSamesite
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedCookiesSamesite safely sets the Configuration value for state's 'Advanced.CookiesSamesite' field
+func (st *ConfigState) SetAdvancedCookiesSamesite(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Advanced.CookiesSamesite = v
+	st.reloadToViper()
+}
+
+// GetAdvancedCookiesSamesite safely fetches the value for global configuration 'Advanced.CookiesSamesite' field
+func GetAdvancedCookiesSamesite() string { return global.GetAdvancedCookiesSamesite() }
+
+// SetAdvancedCookiesSamesite safely sets the value for global configuration 'Advanced.CookiesSamesite' field
+func SetAdvancedCookiesSamesite(v string) { global.SetAdvancedCookiesSamesite(v) }
+
+// GetAdvancedSenderMultiplier safely fetches the Configuration value for state's 'Advanced.SenderMultiplier' field
+func (st *ConfigState) GetAdvancedSenderMultiplier() (v int) {
+	st.mutex.RLock()
+	v = st.config.Advanced.SenderMultiplier
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedSenderMultiplier safely sets the Configuration value for state's 'Advanced.SenderMultiplier' field
+func (st *ConfigState) SetAdvancedSenderMultiplier(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Advanced.SenderMultiplier = v
+	st.reloadToViper()
+}
+
+// GetAdvancedSenderMultiplier safely fetches the value for global configuration 'Advanced.SenderMultiplier' field
+func GetAdvancedSenderMultiplier() int { return global.GetAdvancedSenderMultiplier() }
+
+// SetAdvancedSenderMultiplier safely sets the value for global configuration 'Advanced.SenderMultiplier' field
+func SetAdvancedSenderMultiplier(v int) { global.SetAdvancedSenderMultiplier(v) }
+
+// GetAdvancedCSPExtraURIs safely fetches the Configuration value for state's 'Advanced.CSPExtraURIs' field
+func (st *ConfigState) GetAdvancedCSPExtraURIs() (v []string) {
+	st.mutex.RLock()
+	v = st.config.Advanced.CSPExtraURIs
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedCSPExtraURIs safely sets the Configuration value for state's 'Advanced.CSPExtraURIs' field
+func (st *ConfigState) SetAdvancedCSPExtraURIs(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Advanced.CSPExtraURIs = v
+	st.reloadToViper()
+}
+
+// GetAdvancedCSPExtraURIs safely fetches the value for global configuration 'Advanced.CSPExtraURIs' field
+func GetAdvancedCSPExtraURIs() []string { return global.GetAdvancedCSPExtraURIs() }
+
+// SetAdvancedCSPExtraURIs safely sets the value for global configuration 'Advanced.CSPExtraURIs' field
+func SetAdvancedCSPExtraURIs(v []string) { global.SetAdvancedCSPExtraURIs(v) }
+
+// GetAdvancedHeaderFilterMode safely fetches the Configuration value for state's 'Advanced.HeaderFilterMode' field
+func (st *ConfigState) GetAdvancedHeaderFilterMode() (v string) {
+	st.mutex.RLock()
+	v = st.config.Advanced.HeaderFilterMode
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedHeaderFilterMode safely sets the Configuration value for state's 'Advanced.HeaderFilterMode' field
+func (st *ConfigState) SetAdvancedHeaderFilterMode(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Advanced.HeaderFilterMode = v
+	st.reloadToViper()
+}
+
+// GetAdvancedHeaderFilterMode safely fetches the value for global configuration 'Advanced.HeaderFilterMode' field
+func GetAdvancedHeaderFilterMode() string { return global.GetAdvancedHeaderFilterMode() }
+
+// SetAdvancedHeaderFilterMode safely sets the value for global configuration 'Advanced.HeaderFilterMode' field
+func SetAdvancedHeaderFilterMode(v string) { global.SetAdvancedHeaderFilterMode(v) }
+
+// GetAdvancedRateLimitRequests safely fetches the Configuration value for state's 'Advanced.RateLimit.Requests' field
 func (st *ConfigState) GetAdvancedRateLimitRequests() (v int) {
 	st.mutex.RLock()
 	v = st.config.Advanced.RateLimit.Requests
@@ -4700,257 +6659,919 @@ func (st *ConfigState) GetHTTPClientTimeout() (v time.Duration) {
 func (st *ConfigState) SetHTTPClientTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.Timeout = v
+	st.config.HTTPClient.Timeout = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientTimeout safely fetches the value for global configuration 'HTTPClient.Timeout' field
+func GetHTTPClientTimeout() time.Duration { return global.GetHTTPClientTimeout() }
+
+// SetHTTPClientTimeout safely sets the value for global configuration 'HTTPClient.Timeout' field
+func SetHTTPClientTimeout(v time.Duration) { global.SetHTTPClientTimeout(v) }
+
+// GetHTTPClientWebfingerTimeout safely fetches the Configuration value for state's 'HTTPClient.WebfingerTimeout' field
+func (st *ConfigState) GetHTTPClientWebfingerTimeout() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.WebfingerTimeout
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientWebfingerTimeout safely sets the Configuration value for state's 'HTTPClient.WebfingerTimeout' field
+func (st *ConfigState) SetHTTPClientWebfingerTimeout(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.WebfingerTimeout = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientWebfingerTimeout safely fetches the value for global configuration 'HTTPClient.WebfingerTimeout' field
+func GetHTTPClientWebfingerTimeout() time.Duration { return global.GetHTTPClientWebfingerTimeout() }
+
+// SetHTTPClientWebfingerTimeout safely sets the value for global configuration 'HTTPClient.WebfingerTimeout' field
+func SetHTTPClientWebfingerTimeout(v time.Duration) { global.SetHTTPClientWebfingerTimeout(v) }
+
+// GetHTTPClientDereferenceTimeout safely fetches the Configuration value for state's 'HTTPClient.DereferenceTimeout' field
+func (st *ConfigState) GetHTTPClientDereferenceTimeout() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.DereferenceTimeout
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientDereferenceTimeout safely sets the Configuration value for state's 'HTTPClient.DereferenceTimeout' field
+func (st *ConfigState) SetHTTPClientDereferenceTimeout(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.DereferenceTimeout = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientDereferenceTimeout safely fetches the value for global configuration 'HTTPClient.DereferenceTimeout' field
+func GetHTTPClientDereferenceTimeout() time.Duration { return global.GetHTTPClientDereferenceTimeout() }
+
+// SetHTTPClientDereferenceTimeout safely sets the value for global configuration 'HTTPClient.DereferenceTimeout' field
+func SetHTTPClientDereferenceTimeout(v time.Duration) { global.SetHTTPClientDereferenceTimeout(v) }
+
+// GetHTTPClientMediaTimeout safely fetches the Configuration value for state's 'HTTPClient.MediaTimeout' field
+func (st *ConfigState) GetHTTPClientMediaTimeout() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.MediaTimeout
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientMediaTimeout safely sets the Configuration value for state's 'HTTPClient.MediaTimeout' field
+func (st *ConfigState) SetHTTPClientMediaTimeout(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.MediaTimeout = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientMediaTimeout safely fetches the value for global configuration 'HTTPClient.MediaTimeout' field
+func GetHTTPClientMediaTimeout() time.Duration { return global.GetHTTPClientMediaTimeout() }
+
+// SetHTTPClientMediaTimeout safely sets the value for global configuration 'HTTPClient.MediaTimeout' field
+func SetHTTPClientMediaTimeout(v time.Duration) { global.SetHTTPClientMediaTimeout(v) }
+
+// GetHTTPClientDeliveryTimeout safely fetches the Configuration value for state's 'HTTPClient.DeliveryTimeout' field
+func (st *ConfigState) GetHTTPClientDeliveryTimeout() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.DeliveryTimeout
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientDeliveryTimeout safely sets the Configuration value for state's 'HTTPClient.DeliveryTimeout' field
+func (st *ConfigState) SetHTTPClientDeliveryTimeout(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.DeliveryTimeout = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientDeliveryTimeout safely fetches the value for global configuration 'HTTPClient.DeliveryTimeout' field
+func GetHTTPClientDeliveryTimeout() time.Duration { return global.GetHTTPClientDeliveryTimeout() }
+
+// SetHTTPClientDeliveryTimeout safely sets the value for global configuration 'HTTPClient.DeliveryTimeout' field
+func SetHTTPClientDeliveryTimeout(v time.Duration) { global.SetHTTPClientDeliveryTimeout(v) }
+
+// GetHTTPClientMaxIdleConnsPerHost safely fetches the Configuration value for state's 'HTTPClient.MaxIdleConnsPerHost' field
+func (st *ConfigState) GetHTTPClientMaxIdleConnsPerHost() (v int) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.MaxIdleConnsPerHost
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientMaxIdleConnsPerHost safely sets the Configuration value for state's 'HTTPClient.MaxIdleConnsPerHost' field
+func (st *ConfigState) SetHTTPClientMaxIdleConnsPerHost(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.MaxIdleConnsPerHost = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientMaxIdleConnsPerHost safely fetches the value for global configuration 'HTTPClient.MaxIdleConnsPerHost' field
+func GetHTTPClientMaxIdleConnsPerHost() int { return global.GetHTTPClientMaxIdleConnsPerHost() }
+
+// SetHTTPClientMaxIdleConnsPerHost safely sets the value for global configuration 'HTTPClient.MaxIdleConnsPerHost' field
+func SetHTTPClientMaxIdleConnsPerHost(v int) { global.SetHTTPClientMaxIdleConnsPerHost(v) }
+
+// GetHTTPClientIdleConnTimeout safely fetches the Configuration value for state's 'HTTPClient.IdleConnTimeout' field
+func (st *ConfigState) GetHTTPClientIdleConnTimeout() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.IdleConnTimeout
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientIdleConnTimeout safely sets the Configuration value for state's 'HTTPClient.IdleConnTimeout' field
+func (st *ConfigState) SetHTTPClientIdleConnTimeout(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.IdleConnTimeout = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientIdleConnTimeout safely fetches the value for global configuration 'HTTPClient.IdleConnTimeout' field
+func GetHTTPClientIdleConnTimeout() time.Duration { return global.GetHTTPClientIdleConnTimeout() }
+
+// SetHTTPClientIdleConnTimeout safely sets the value for global configuration 'HTTPClient.IdleConnTimeout' field
+func SetHTTPClientIdleConnTimeout(v time.Duration) { global.SetHTTPClientIdleConnTimeout(v) }
+
+// GetHTTPClientDisableHTTP2 safely fetches the Configuration value for state's 'HTTPClient.DisableHTTP2' field
+func (st *ConfigState) GetHTTPClientDisableHTTP2() (v bool) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.DisableHTTP2
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientDisableHTTP2 safely sets the Configuration value for state's 'HTTPClient.DisableHTTP2' field
+func (st *ConfigState) SetHTTPClientDisableHTTP2(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.DisableHTTP2 = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientDisableHTTP2 safely fetches the value for global configuration 'HTTPClient.DisableHTTP2' field
+func GetHTTPClientDisableHTTP2() bool { return global.GetHTTPClientDisableHTTP2() }
+
+// SetHTTPClientDisableHTTP2 safely sets the value for global configuration 'HTTPClient.DisableHTTP2' field
+func SetHTTPClientDisableHTTP2(v bool) { global.SetHTTPClientDisableHTTP2(v) }
+
+// GetHTTPClientDNSCacheTTL safely fetches the Configuration value for state's 'HTTPClient.DNSCacheTTL' field
+func (st *ConfigState) GetHTTPClientDNSCacheTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.DNSCacheTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientDNSCacheTTL safely sets the Configuration value for state's 'HTTPClient.DNSCacheTTL' field
+func (st *ConfigState) SetHTTPClientDNSCacheTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.DNSCacheTTL = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientDNSCacheTTL safely fetches the value for global configuration 'HTTPClient.DNSCacheTTL' field
+func GetHTTPClientDNSCacheTTL() time.Duration { return global.GetHTTPClientDNSCacheTTL() }
+
+// SetHTTPClientDNSCacheTTL safely sets the value for global configuration 'HTTPClient.DNSCacheTTL' field
+func SetHTTPClientDNSCacheTTL(v time.Duration) { global.SetHTTPClientDNSCacheTTL(v) }
+
+// GetHTTPClientDNSCacheNegativeTTL safely fetches the Configuration value for state's 'HTTPClient.DNSCacheNegativeTTL' field
+func (st *ConfigState) GetHTTPClientDNSCacheNegativeTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.DNSCacheNegativeTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientDNSCacheNegativeTTL safely sets the Configuration value for state's 'HTTPClient.DNSCacheNegativeTTL' field
+func (st *ConfigState) SetHTTPClientDNSCacheNegativeTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.DNSCacheNegativeTTL = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientDNSCacheNegativeTTL safely fetches the value for global configuration 'HTTPClient.DNSCacheNegativeTTL' field
+func GetHTTPClientDNSCacheNegativeTTL() time.Duration {
+	return global.GetHTTPClientDNSCacheNegativeTTL()
+}
+
+// SetHTTPClientDNSCacheNegativeTTL safely sets the value for global configuration 'HTTPClient.DNSCacheNegativeTTL' field
+func SetHTTPClientDNSCacheNegativeTTL(v time.Duration) { global.SetHTTPClientDNSCacheNegativeTTL(v) }
+
+// GetHTTPClientIPFamilyPreference safely fetches the Configuration value for state's 'HTTPClient.IPFamilyPreference' field
+func (st *ConfigState) GetHTTPClientIPFamilyPreference() (v string) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.IPFamilyPreference
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientIPFamilyPreference safely sets the Configuration value for state's 'HTTPClient.IPFamilyPreference' field
+func (st *ConfigState) SetHTTPClientIPFamilyPreference(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.IPFamilyPreference = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientIPFamilyPreference safely fetches the value for global configuration 'HTTPClient.IPFamilyPreference' field
+func GetHTTPClientIPFamilyPreference() string { return global.GetHTTPClientIPFamilyPreference() }
+
+// SetHTTPClientIPFamilyPreference safely sets the value for global configuration 'HTTPClient.IPFamilyPreference' field
+func SetHTTPClientIPFamilyPreference(v string) { global.SetHTTPClientIPFamilyPreference(v) }
+
+// GetHTTPClientHappyEyeballsDelay safely fetches the Configuration value for state's 'HTTPClient.HappyEyeballsDelay' field
+func (st *ConfigState) GetHTTPClientHappyEyeballsDelay() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.HappyEyeballsDelay
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientHappyEyeballsDelay safely sets the Configuration value for state's 'HTTPClient.HappyEyeballsDelay' field
+func (st *ConfigState) SetHTTPClientHappyEyeballsDelay(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.HappyEyeballsDelay = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientHappyEyeballsDelay safely fetches the value for global configuration 'HTTPClient.HappyEyeballsDelay' field
+func GetHTTPClientHappyEyeballsDelay() time.Duration { return global.GetHTTPClientHappyEyeballsDelay() }
+
+// SetHTTPClientHappyEyeballsDelay safely sets the value for global configuration 'HTTPClient.HappyEyeballsDelay' field
+func SetHTTPClientHappyEyeballsDelay(v time.Duration) { global.SetHTTPClientHappyEyeballsDelay(v) }
+
+// GetHTTPClientIPv4OnlyDomains safely fetches the Configuration value for state's 'HTTPClient.IPv4OnlyDomains' field
+func (st *ConfigState) GetHTTPClientIPv4OnlyDomains() (v []string) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.IPv4OnlyDomains
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientIPv4OnlyDomains safely sets the Configuration value for state's 'HTTPClient.IPv4OnlyDomains' field
+func (st *ConfigState) SetHTTPClientIPv4OnlyDomains(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.IPv4OnlyDomains = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientIPv4OnlyDomains safely fetches the value for global configuration 'HTTPClient.IPv4OnlyDomains' field
+func GetHTTPClientIPv4OnlyDomains() []string { return global.GetHTTPClientIPv4OnlyDomains() }
+
+// SetHTTPClientIPv4OnlyDomains safely sets the value for global configuration 'HTTPClient.IPv4OnlyDomains' field
+func SetHTTPClientIPv4OnlyDomains(v []string) { global.SetHTTPClientIPv4OnlyDomains(v) }
+
+// GetHTTPClientTLSInsecureSkipVerify safely fetches the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
+func (st *ConfigState) GetHTTPClientTLSInsecureSkipVerify() (v bool) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.TLSInsecureSkipVerify
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientTLSInsecureSkipVerify safely sets the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
+func (st *ConfigState) SetHTTPClientTLSInsecureSkipVerify(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.TLSInsecureSkipVerify = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientTLSInsecureSkipVerify safely fetches the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
+func GetHTTPClientTLSInsecureSkipVerify() bool { return global.GetHTTPClientTLSInsecureSkipVerify() }
+
+// SetHTTPClientTLSInsecureSkipVerify safely sets the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
+func SetHTTPClientTLSInsecureSkipVerify(v bool) { global.SetHTTPClientTLSInsecureSkipVerify(v) }
+
+// GetHTTPClientInsecureOutgoing safely fetches the Configuration value for state's 'HTTPClient.InsecureOutgoing' field
+func (st *ConfigState) GetHTTPClientInsecureOutgoing() (v bool) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.InsecureOutgoing
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientInsecureOutgoing safely sets the Configuration value for state's 'HTTPClient.InsecureOutgoing' field
+func (st *ConfigState) SetHTTPClientInsecureOutgoing(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.InsecureOutgoing = v
+	st.reloadToViper()
+}
+
+// GetHTTPClientInsecureOutgoing safely fetches the value for global configuration 'HTTPClient.InsecureOutgoing' field
+func GetHTTPClientInsecureOutgoing() bool { return global.GetHTTPClientInsecureOutgoing() }
+
+// SetHTTPClientInsecureOutgoing safely sets the value for global configuration 'HTTPClient.InsecureOutgoing' field
+func SetHTTPClientInsecureOutgoing(v bool) { global.SetHTTPClientInsecureOutgoing(v) }
+
+// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'Media.DescriptionMinChars' field
+func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.Media.DescriptionMinChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'Media.DescriptionMinChars' field
+func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.DescriptionMinChars = v
+	st.reloadToViper()
+}
+
+// GetMediaDescriptionMinChars safely fetches the value for global configuration 'Media.DescriptionMinChars' field
+func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
+
+// SetMediaDescriptionMinChars safely sets the value for global configuration 'Media.DescriptionMinChars' field
+func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
+
+// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'Media.DescriptionMaxChars' field
+func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.Media.DescriptionMaxChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'Media.DescriptionMaxChars' field
+func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.DescriptionMaxChars = v
+	st.reloadToViper()
+}
+
+// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'Media.DescriptionMaxChars' field
+func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
+
+// SetMediaDescriptionMaxChars safely sets the value for global configuration 'Media.DescriptionMaxChars' field
+func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
+
+// GetMediaDescriptionPolicy safely fetches the Configuration value for state's 'Media.DescriptionPolicy' field
+func (st *ConfigState) GetMediaDescriptionPolicy() (v string) {
+	st.mutex.RLock()
+	v = st.config.Media.DescriptionPolicy
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescriptionPolicy safely sets the Configuration value for state's 'Media.DescriptionPolicy' field
+func (st *ConfigState) SetMediaDescriptionPolicy(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.DescriptionPolicy = v
+	st.reloadToViper()
+}
+
+// GetMediaDescriptionPolicy safely fetches the value for global configuration 'Media.DescriptionPolicy' field
+func GetMediaDescriptionPolicy() string { return global.GetMediaDescriptionPolicy() }
+
+// SetMediaDescriptionPolicy safely sets the value for global configuration 'Media.DescriptionPolicy' field
+func SetMediaDescriptionPolicy(v string) { global.SetMediaDescriptionPolicy(v) }
+
+// GetMediaDescribeProvider safely fetches the Configuration value for state's 'Media.DescribeProvider' field
+func (st *ConfigState) GetMediaDescribeProvider() (v string) {
+	st.mutex.RLock()
+	v = st.config.Media.DescribeProvider
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescribeProvider safely sets the Configuration value for state's 'Media.DescribeProvider' field
+func (st *ConfigState) SetMediaDescribeProvider(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.DescribeProvider = v
+	st.reloadToViper()
+}
+
+// GetMediaDescribeProvider safely fetches the value for global configuration 'Media.DescribeProvider' field
+func GetMediaDescribeProvider() string { return global.GetMediaDescribeProvider() }
+
+// SetMediaDescribeProvider safely sets the value for global configuration 'Media.DescribeProvider' field
+func SetMediaDescribeProvider(v string) { global.SetMediaDescribeProvider(v) }
+
+// GetMediaDescribeEndpoint safely fetches the Configuration value for state's 'Media.DescribeEndpoint' field
+func (st *ConfigState) GetMediaDescribeEndpoint() (v string) {
+	st.mutex.RLock()
+	v = st.config.Media.DescribeEndpoint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescribeEndpoint safely sets the Configuration value for state's 'Media.DescribeEndpoint' field
+func (st *ConfigState) SetMediaDescribeEndpoint(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.DescribeEndpoint = v
+	st.reloadToViper()
+}
+
+// GetMediaDescribeEndpoint safely fetches the value for global configuration 'Media.DescribeEndpoint' field
+func GetMediaDescribeEndpoint() string { return global.GetMediaDescribeEndpoint() }
+
+// SetMediaDescribeEndpoint safely sets the value for global configuration 'Media.DescribeEndpoint' field
+func SetMediaDescribeEndpoint(v string) { global.SetMediaDescribeEndpoint(v) }
+
+// GetMediaDescribeCommand safely fetches the Configuration value for state's 'Media.DescribeCommand' field
+func (st *ConfigState) GetMediaDescribeCommand() (v string) {
+	st.mutex.RLock()
+	v = st.config.Media.DescribeCommand
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescribeCommand safely sets the Configuration value for state's 'Media.DescribeCommand' field
+func (st *ConfigState) SetMediaDescribeCommand(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.DescribeCommand = v
+	st.reloadToViper()
+}
+
+// GetMediaDescribeCommand safely fetches the value for global configuration 'Media.DescribeCommand' field
+func GetMediaDescribeCommand() string { return global.GetMediaDescribeCommand() }
+
+// SetMediaDescribeCommand safely sets the value for global configuration 'Media.DescribeCommand' field
+func SetMediaDescribeCommand(v string) { global.SetMediaDescribeCommand(v) }
+
+// GetMediaDescribeConcurrency safely fetches the Configuration value for state's 'Media.DescribeConcurrency' field
+func (st *ConfigState) GetMediaDescribeConcurrency() (v int) {
+	st.mutex.RLock()
+	v = st.config.Media.DescribeConcurrency
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescribeConcurrency safely sets the Configuration value for state's 'Media.DescribeConcurrency' field
+func (st *ConfigState) SetMediaDescribeConcurrency(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.DescribeConcurrency = v
+	st.reloadToViper()
+}
+
+// GetMediaDescribeConcurrency safely fetches the value for global configuration 'Media.DescribeConcurrency' field
+func GetMediaDescribeConcurrency() int { return global.GetMediaDescribeConcurrency() }
+
+// SetMediaDescribeConcurrency safely sets the value for global configuration 'Media.DescribeConcurrency' field
+func SetMediaDescribeConcurrency(v int) { global.SetMediaDescribeConcurrency(v) }
+
+// GetMediaDescribeTimeout safely fetches the Configuration value for state's 'Media.DescribeTimeout' field
+func (st *ConfigState) GetMediaDescribeTimeout() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Media.DescribeTimeout
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescribeTimeout safely sets the Configuration value for state's 'Media.DescribeTimeout' field
+func (st *ConfigState) SetMediaDescribeTimeout(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.DescribeTimeout = v
+	st.reloadToViper()
+}
+
+// GetMediaDescribeTimeout safely fetches the value for global configuration 'Media.DescribeTimeout' field
+func GetMediaDescribeTimeout() time.Duration { return global.GetMediaDescribeTimeout() }
+
+// SetMediaDescribeTimeout safely sets the value for global configuration 'Media.DescribeTimeout' field
+func SetMediaDescribeTimeout(v time.Duration) { global.SetMediaDescribeTimeout(v) }
+
+// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'Media.RemoteCacheDays' field
+func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
+	st.mutex.RLock()
+	v = st.config.Media.RemoteCacheDays
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'Media.RemoteCacheDays' field
+func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.RemoteCacheDays = v
+	st.reloadToViper()
+}
+
+// GetMediaRemoteCacheDays safely fetches the value for global configuration 'Media.RemoteCacheDays' field
+func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+
+// SetMediaRemoteCacheDays safely sets the value for global configuration 'Media.RemoteCacheDays' field
+func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+
+// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'Media.EmojiLocalMaxSize' field
+func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.Media.EmojiLocalMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'Media.EmojiLocalMaxSize' field
+func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.EmojiLocalMaxSize = v
+	st.reloadToViper()
+}
+
+// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'Media.EmojiLocalMaxSize' field
+func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+
+// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'Media.EmojiLocalMaxSize' field
+func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+
+// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'Media.EmojiRemoteMaxSize' field
+func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.Media.EmojiRemoteMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'Media.EmojiRemoteMaxSize' field
+func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.EmojiRemoteMaxSize = v
+	st.reloadToViper()
+}
+
+// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'Media.EmojiRemoteMaxSize' field
+func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+
+// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'Media.EmojiRemoteMaxSize' field
+func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+
+// GetMediaEmojiStaticOnly safely fetches the Configuration value for state's 'Media.EmojiStaticOnly' field
+func (st *ConfigState) GetMediaEmojiStaticOnly() (v bool) {
+	st.mutex.RLock()
+	v = st.config.Media.EmojiStaticOnly
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaEmojiStaticOnly safely sets the Configuration value for state's 'Media.EmojiStaticOnly' field
+func (st *ConfigState) SetMediaEmojiStaticOnly(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.EmojiStaticOnly = v
+	st.reloadToViper()
+}
+
+// GetMediaEmojiStaticOnly safely fetches the value for global configuration 'Media.EmojiStaticOnly' field
+func GetMediaEmojiStaticOnly() bool { return global.GetMediaEmojiStaticOnly() }
+
+// SetMediaEmojiStaticOnly safely sets the value for global configuration 'Media.EmojiStaticOnly' field
+func SetMediaEmojiStaticOnly(v bool) { global.SetMediaEmojiStaticOnly(v) }
+
+// GetMediaImageSizeHint safely fetches the Configuration value for state's 'Media.ImageSizeHint' field
+func (st *ConfigState) GetMediaImageSizeHint() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.Media.ImageSizeHint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaImageSizeHint safely sets the Configuration value for state's 'Media.ImageSizeHint' field
+func (st *ConfigState) SetMediaImageSizeHint(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.ImageSizeHint = v
+	st.reloadToViper()
+}
+
+// GetMediaImageSizeHint safely fetches the value for global configuration 'Media.ImageSizeHint' field
+func GetMediaImageSizeHint() bytesize.Size { return global.GetMediaImageSizeHint() }
+
+// SetMediaImageSizeHint safely sets the value for global configuration 'Media.ImageSizeHint' field
+func SetMediaImageSizeHint(v bytesize.Size) { global.SetMediaImageSizeHint(v) }
+
+// GetMediaVideoSizeHint safely fetches the Configuration value for state's 'Media.VideoSizeHint' field
+func (st *ConfigState) GetMediaVideoSizeHint() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.Media.VideoSizeHint
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVideoSizeHint safely sets the Configuration value for state's 'Media.VideoSizeHint' field
+func (st *ConfigState) SetMediaVideoSizeHint(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.VideoSizeHint = v
+	st.reloadToViper()
+}
+
+// GetMediaVideoSizeHint safely fetches the value for global configuration 'Media.VideoSizeHint' field
+func GetMediaVideoSizeHint() bytesize.Size { return global.GetMediaVideoSizeHint() }
+
+// SetMediaVideoSizeHint safely sets the value for global configuration 'Media.VideoSizeHint' field
+func SetMediaVideoSizeHint(v bytesize.Size) { global.SetMediaVideoSizeHint(v) }
+
+// GetMediaLocalMaxSize safely fetches the Configuration value for state's 'Media.LocalMaxSize' field
+func (st *ConfigState) GetMediaLocalMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.Media.LocalMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaLocalMaxSize safely sets the Configuration value for state's 'Media.LocalMaxSize' field
+func (st *ConfigState) SetMediaLocalMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.LocalMaxSize = v
+	st.reloadToViper()
+}
+
+// GetMediaLocalMaxSize safely fetches the value for global configuration 'Media.LocalMaxSize' field
+func GetMediaLocalMaxSize() bytesize.Size { return global.GetMediaLocalMaxSize() }
+
+// SetMediaLocalMaxSize safely sets the value for global configuration 'Media.LocalMaxSize' field
+func SetMediaLocalMaxSize(v bytesize.Size) { global.SetMediaLocalMaxSize(v) }
+
+// GetMediaLocalQuota safely fetches the Configuration value for state's 'Media.LocalQuota' field
+func (st *ConfigState) GetMediaLocalQuota() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.Media.LocalQuota
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaLocalQuota safely sets the Configuration value for state's 'Media.LocalQuota' field
+func (st *ConfigState) SetMediaLocalQuota(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.LocalQuota = v
 	st.reloadToViper()
 }
 
-// GetHTTPClientTimeout safely fetches the value for global configuration 'HTTPClient.Timeout' field
-func GetHTTPClientTimeout() time.Duration { return global.GetHTTPClientTimeout() }
+// GetMediaLocalQuota safely fetches the value for global configuration 'Media.LocalQuota' field
+func GetMediaLocalQuota() bytesize.Size { return global.GetMediaLocalQuota() }
 
-// SetHTTPClientTimeout safely sets the value for global configuration 'HTTPClient.Timeout' field
-func SetHTTPClientTimeout(v time.Duration) { global.SetHTTPClientTimeout(v) }
+// SetMediaLocalQuota safely sets the value for global configuration 'Media.LocalQuota' field
+func SetMediaLocalQuota(v bytesize.Size) { global.SetMediaLocalQuota(v) }
 
-// GetHTTPClientTLSInsecureSkipVerify safely fetches the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
-func (st *ConfigState) GetHTTPClientTLSInsecureSkipVerify() (v bool) {
+// GetMediaRemoteMaxSize safely fetches the Configuration value for state's 'Media.RemoteMaxSize' field
+func (st *ConfigState) GetMediaRemoteMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.TLSInsecureSkipVerify
+	v = st.config.Media.RemoteMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientTLSInsecureSkipVerify safely sets the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
-func (st *ConfigState) SetHTTPClientTLSInsecureSkipVerify(v bool) {
+// SetMediaRemoteMaxSize safely sets the Configuration value for state's 'Media.RemoteMaxSize' field
+func (st *ConfigState) SetMediaRemoteMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.TLSInsecureSkipVerify = v
+	st.config.Media.RemoteMaxSize = v
 	st.reloadToViper()
 }
 
-// GetHTTPClientTLSInsecureSkipVerify safely fetches the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
-func GetHTTPClientTLSInsecureSkipVerify() bool { return global.GetHTTPClientTLSInsecureSkipVerify() }
+// GetMediaRemoteMaxSize safely fetches the value for global configuration 'Media.RemoteMaxSize' field
+func GetMediaRemoteMaxSize() bytesize.Size { return global.GetMediaRemoteMaxSize() }
 
-// SetHTTPClientTLSInsecureSkipVerify safely sets the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
-func SetHTTPClientTLSInsecureSkipVerify(v bool) { global.SetHTTPClientTLSInsecureSkipVerify(v) }
+// SetMediaRemoteMaxSize safely sets the value for global configuration 'Media.RemoteMaxSize' field
+func SetMediaRemoteMaxSize(v bytesize.Size) { global.SetMediaRemoteMaxSize(v) }
 
-// GetHTTPClientInsecureOutgoing safely fetches the Configuration value for state's 'HTTPClient.InsecureOutgoing' field
-func (st *ConfigState) GetHTTPClientInsecureOutgoing() (v bool) {
+// GetMediaImageMaxSize safely fetches the Configuration value for state's 'Media.ImageMaxSize' field
+func (st *ConfigState) GetMediaImageMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.InsecureOutgoing
+	v = st.config.Media.ImageMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientInsecureOutgoing safely sets the Configuration value for state's 'HTTPClient.InsecureOutgoing' field
-func (st *ConfigState) SetHTTPClientInsecureOutgoing(v bool) {
+// SetMediaImageMaxSize safely sets the Configuration value for state's 'Media.ImageMaxSize' field
+func (st *ConfigState) SetMediaImageMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.InsecureOutgoing = v
+	st.config.Media.ImageMaxSize = v
 	st.reloadToViper()
 }
 
-// GetHTTPClientInsecureOutgoing safely fetches the value for global configuration 'HTTPClient.InsecureOutgoing' field
-func GetHTTPClientInsecureOutgoing() bool { return global.GetHTTPClientInsecureOutgoing() }
+// GetMediaImageMaxSize safely fetches the value for global configuration 'Media.ImageMaxSize' field
+func GetMediaImageMaxSize() bytesize.Size { return global.GetMediaImageMaxSize() }
 
-// SetHTTPClientInsecureOutgoing safely sets the value for global configuration 'HTTPClient.InsecureOutgoing' field
-func SetHTTPClientInsecureOutgoing(v bool) { global.SetHTTPClientInsecureOutgoing(v) }
+// SetMediaImageMaxSize safely sets the value for global configuration 'Media.ImageMaxSize' field
+func SetMediaImageMaxSize(v bytesize.Size) { global.SetMediaImageMaxSize(v) }
 
-// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'Media.DescriptionMinChars' field
-func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
+// GetMediaImageMaxMegapixels safely fetches the Configuration value for state's 'Media.ImageMaxMegapixels' field
+func (st *ConfigState) GetMediaImageMaxMegapixels() (v int) {
 	st.mutex.RLock()
-	v = st.config.Media.DescriptionMinChars
+	v = st.config.Media.ImageMaxMegapixels
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'Media.DescriptionMinChars' field
-func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
+// SetMediaImageMaxMegapixels safely sets the Configuration value for state's 'Media.ImageMaxMegapixels' field
+func (st *ConfigState) SetMediaImageMaxMegapixels(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.DescriptionMinChars = v
+	st.config.Media.ImageMaxMegapixels = v
 	st.reloadToViper()
 }
 
-// GetMediaDescriptionMinChars safely fetches the value for global configuration 'Media.DescriptionMinChars' field
-func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
+// GetMediaImageMaxMegapixels safely fetches the value for global configuration 'Media.ImageMaxMegapixels' field
+func GetMediaImageMaxMegapixels() int { return global.GetMediaImageMaxMegapixels() }
 
-// SetMediaDescriptionMinChars safely sets the value for global configuration 'Media.DescriptionMinChars' field
-func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
+// SetMediaImageMaxMegapixels safely sets the value for global configuration 'Media.ImageMaxMegapixels' field
+func SetMediaImageMaxMegapixels(v int) { global.SetMediaImageMaxMegapixels(v) }
 
-// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'Media.DescriptionMaxChars' field
-func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
+// GetMediaVideoMaxSize safely fetches the Configuration value for state's 'Media.VideoMaxSize' field
+func (st *ConfigState) GetMediaVideoMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Media.DescriptionMaxChars
+	v = st.config.Media.VideoMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'Media.DescriptionMaxChars' field
-func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
+// SetMediaVideoMaxSize safely sets the Configuration value for state's 'Media.VideoMaxSize' field
+func (st *ConfigState) SetMediaVideoMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.DescriptionMaxChars = v
+	st.config.Media.VideoMaxSize = v
 	st.reloadToViper()
 }
 
-// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'Media.DescriptionMaxChars' field
-func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
+// GetMediaVideoMaxSize safely fetches the value for global configuration 'Media.VideoMaxSize' field
+func GetMediaVideoMaxSize() bytesize.Size { return global.GetMediaVideoMaxSize() }
 
-// SetMediaDescriptionMaxChars safely sets the value for global configuration 'Media.DescriptionMaxChars' field
-func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
+// SetMediaVideoMaxSize safely sets the value for global configuration 'Media.VideoMaxSize' field
+func SetMediaVideoMaxSize(v bytesize.Size) { global.SetMediaVideoMaxSize(v) }
 
-// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'Media.RemoteCacheDays' field
-func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
+// GetMediaVideoMaxDuration safely fetches the Configuration value for state's 'Media.VideoMaxDuration' field
+func (st *ConfigState) GetMediaVideoMaxDuration() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Media.RemoteCacheDays
+	v = st.config.Media.VideoMaxDuration
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'Media.RemoteCacheDays' field
-func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
+// SetMediaVideoMaxDuration safely sets the Configuration value for state's 'Media.VideoMaxDuration' field
+func (st *ConfigState) SetMediaVideoMaxDuration(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.RemoteCacheDays = v
+	st.config.Media.VideoMaxDuration = v
 	st.reloadToViper()
 }
 
-// GetMediaRemoteCacheDays safely fetches the value for global configuration 'Media.RemoteCacheDays' field
-func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+// GetMediaVideoMaxDuration safely fetches the value for global configuration 'Media.VideoMaxDuration' field
+func GetMediaVideoMaxDuration() time.Duration { return global.GetMediaVideoMaxDuration() }
 
-// SetMediaRemoteCacheDays safely sets the value for global configuration 'Media.RemoteCacheDays' field
-func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+// SetMediaVideoMaxDuration safely sets the value for global configuration 'Media.VideoMaxDuration' field
+func SetMediaVideoMaxDuration(v time.Duration) { global.SetMediaVideoMaxDuration(v) }
 
-// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'Media.EmojiLocalMaxSize' field
-func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+// GetMediaVideoMaxBitrate safely fetches the Configuration value for state's 'Media.VideoMaxBitrate' field
+func (st *ConfigState) GetMediaVideoMaxBitrate() (v int) {
 	st.mutex.RLock()
-	v = st.config.Media.EmojiLocalMaxSize
+	v = st.config.Media.VideoMaxBitrate
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'Media.EmojiLocalMaxSize' field
-func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+// SetMediaVideoMaxBitrate safely sets the Configuration value for state's 'Media.VideoMaxBitrate' field
+func (st *ConfigState) SetMediaVideoMaxBitrate(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.EmojiLocalMaxSize = v
+	st.config.Media.VideoMaxBitrate = v
 	st.reloadToViper()
 }
 
-// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'Media.EmojiLocalMaxSize' field
-func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+// GetMediaVideoMaxBitrate safely fetches the value for global configuration 'Media.VideoMaxBitrate' field
+func GetMediaVideoMaxBitrate() int { return global.GetMediaVideoMaxBitrate() }
 
-// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'Media.EmojiLocalMaxSize' field
-func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+// SetMediaVideoMaxBitrate safely sets the value for global configuration 'Media.VideoMaxBitrate' field
+func SetMediaVideoMaxBitrate(v int) { global.SetMediaVideoMaxBitrate(v) }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'Media.EmojiRemoteMaxSize' field
-func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+// GetMediaAudioMaxSize safely fetches the Configuration value for state's 'Media.AudioMaxSize' field
+func (st *ConfigState) GetMediaAudioMaxSize() (v bytesize.Size) {
 	st.mutex.RLock()
-	v = st.config.Media.EmojiRemoteMaxSize
+	v = st.config.Media.AudioMaxSize
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'Media.EmojiRemoteMaxSize' field
-func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+// SetMediaAudioMaxSize safely sets the Configuration value for state's 'Media.AudioMaxSize' field
+func (st *ConfigState) SetMediaAudioMaxSize(v bytesize.Size) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.EmojiRemoteMaxSize = v
+	st.config.Media.AudioMaxSize = v
 	st.reloadToViper()
 }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'Media.EmojiRemoteMaxSize' field
-func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+// GetMediaAudioMaxSize safely fetches the value for global configuration 'Media.AudioMaxSize' field
+func GetMediaAudioMaxSize() bytesize.Size { return global.GetMediaAudioMaxSize() }
 
-// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'Media.EmojiRemoteMaxSize' field
-func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+// SetMediaAudioMaxSize safely sets the value for global configuration 'Media.AudioMaxSize' field
+func SetMediaAudioMaxSize(v bytesize.Size) { global.SetMediaAudioMaxSize(v) }
 
-// GetMediaImageSizeHint safely fetches the Configuration value for state's 'Media.ImageSizeHint' field
-func (st *ConfigState) GetMediaImageSizeHint() (v bytesize.Size) {
+// GetMediaAudioMaxDuration safely fetches the Configuration value for state's 'Media.AudioMaxDuration' field
+func (st *ConfigState) GetMediaAudioMaxDuration() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.Media.ImageSizeHint
+	v = st.config.Media.AudioMaxDuration
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaImageSizeHint safely sets the Configuration value for state's 'Media.ImageSizeHint' field
-func (st *ConfigState) SetMediaImageSizeHint(v bytesize.Size) {
+// SetMediaAudioMaxDuration safely sets the Configuration value for state's 'Media.AudioMaxDuration' field
+func (st *ConfigState) SetMediaAudioMaxDuration(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.ImageSizeHint = v
+	st.config.Media.AudioMaxDuration = v
 	st.reloadToViper()
 }
 
-// GetMediaImageSizeHint safely fetches the value for global configuration 'Media.ImageSizeHint' field
-func GetMediaImageSizeHint() bytesize.Size { return global.GetMediaImageSizeHint() }
+// GetMediaAudioMaxDuration safely fetches the value for global configuration 'Media.AudioMaxDuration' field
+func GetMediaAudioMaxDuration() time.Duration { return global.GetMediaAudioMaxDuration() }
 
-// SetMediaImageSizeHint safely sets the value for global configuration 'Media.ImageSizeHint' field
-func SetMediaImageSizeHint(v bytesize.Size) { global.SetMediaImageSizeHint(v) }
+// SetMediaAudioMaxDuration safely sets the value for global configuration 'Media.AudioMaxDuration' field
+func SetMediaAudioMaxDuration(v time.Duration) { global.SetMediaAudioMaxDuration(v) }
 
-// GetMediaVideoSizeHint safely fetches the Configuration value for state's 'Media.VideoSizeHint' field
-func (st *ConfigState) GetMediaVideoSizeHint() (v bytesize.Size) {
+// GetMediaAudioMaxBitrate safely fetches the Configuration value for state's 'Media.AudioMaxBitrate' field
+func (st *ConfigState) GetMediaAudioMaxBitrate() (v int) {
 	st.mutex.RLock()
-	v = st.config.Media.VideoSizeHint
+	v = st.config.Media.AudioMaxBitrate
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaVideoSizeHint safely sets the Configuration value for state's 'Media.VideoSizeHint' field
-func (st *ConfigState) SetMediaVideoSizeHint(v bytesize.Size) {
+// SetMediaAudioMaxBitrate safely sets the Configuration value for state's 'Media.AudioMaxBitrate' field
+func (st *ConfigState) SetMediaAudioMaxBitrate(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.VideoSizeHint = v
+	st.config.Media.AudioMaxBitrate = v
 	st.reloadToViper()
 }
 
-// GetMediaVideoSizeHint safely fetches the value for global configuration 'Media.VideoSizeHint' field
-func GetMediaVideoSizeHint() bytesize.Size { return global.GetMediaVideoSizeHint() }
+// GetMediaAudioMaxBitrate safely fetches the value for global configuration 'Media.AudioMaxBitrate' field
+func GetMediaAudioMaxBitrate() int { return global.GetMediaAudioMaxBitrate() }
 
-// SetMediaVideoSizeHint safely sets the value for global configuration 'Media.VideoSizeHint' field
-func SetMediaVideoSizeHint(v bytesize.Size) { global.SetMediaVideoSizeHint(v) }
+// SetMediaAudioMaxBitrate safely sets the value for global configuration 'Media.AudioMaxBitrate' field
+func SetMediaAudioMaxBitrate(v int) { global.SetMediaAudioMaxBitrate(v) }
 
-// GetMediaLocalMaxSize safely fetches the Configuration value for state's 'Media.LocalMaxSize' field
-func (st *ConfigState) GetMediaLocalMaxSize() (v bytesize.Size) {
+// GetMediaRemoteSensitiveBlurhashOnly safely fetches the Configuration value for state's 'Media.RemoteSensitiveBlurhashOnly' field
+func (st *ConfigState) GetMediaRemoteSensitiveBlurhashOnly() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Media.LocalMaxSize
+	v = st.config.Media.RemoteSensitiveBlurhashOnly
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaLocalMaxSize safely sets the Configuration value for state's 'Media.LocalMaxSize' field
-func (st *ConfigState) SetMediaLocalMaxSize(v bytesize.Size) {
+// SetMediaRemoteSensitiveBlurhashOnly safely sets the Configuration value for state's 'Media.RemoteSensitiveBlurhashOnly' field
+func (st *ConfigState) SetMediaRemoteSensitiveBlurhashOnly(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.LocalMaxSize = v
+	st.config.Media.RemoteSensitiveBlurhashOnly = v
 	st.reloadToViper()
 }
 
-// GetMediaLocalMaxSize safely fetches the value for global configuration 'Media.LocalMaxSize' field
-func GetMediaLocalMaxSize() bytesize.Size { return global.GetMediaLocalMaxSize() }
+// GetMediaRemoteSensitiveBlurhashOnly safely fetches the value for global configuration 'Media.RemoteSensitiveBlurhashOnly' field
+func GetMediaRemoteSensitiveBlurhashOnly() bool { return global.GetMediaRemoteSensitiveBlurhashOnly() }
 
-// SetMediaLocalMaxSize safely sets the value for global configuration 'Media.LocalMaxSize' field
-func SetMediaLocalMaxSize(v bytesize.Size) { global.SetMediaLocalMaxSize(v) }
+// SetMediaRemoteSensitiveBlurhashOnly safely sets the value for global configuration 'Media.RemoteSensitiveBlurhashOnly' field
+func SetMediaRemoteSensitiveBlurhashOnly(v bool) { global.SetMediaRemoteSensitiveBlurhashOnly(v) }
 
-// GetMediaRemoteMaxSize safely fetches the Configuration value for state's 'Media.RemoteMaxSize' field
-func (st *ConfigState) GetMediaRemoteMaxSize() (v bytesize.Size) {
+// GetMediaPreserveColorProfile safely fetches the Configuration value for state's 'Media.PreserveColorProfile' field
+func (st *ConfigState) GetMediaPreserveColorProfile() (v bool) {
 	st.mutex.RLock()
-	v = st.config.Media.RemoteMaxSize
+	v = st.config.Media.PreserveColorProfile
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaRemoteMaxSize safely sets the Configuration value for state's 'Media.RemoteMaxSize' field
-func (st *ConfigState) SetMediaRemoteMaxSize(v bytesize.Size) {
+// SetMediaPreserveColorProfile safely sets the Configuration value for state's 'Media.PreserveColorProfile' field
+func (st *ConfigState) SetMediaPreserveColorProfile(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Media.RemoteMaxSize = v
+	st.config.Media.PreserveColorProfile = v
 	st.reloadToViper()
 }
 
-// GetMediaRemoteMaxSize safely fetches the value for global configuration 'Media.RemoteMaxSize' field
-func GetMediaRemoteMaxSize() bytesize.Size { return global.GetMediaRemoteMaxSize() }
+// GetMediaPreserveColorProfile safely fetches the value for global configuration 'Media.PreserveColorProfile' field
+func GetMediaPreserveColorProfile() bool { return global.GetMediaPreserveColorProfile() }
 
-// SetMediaRemoteMaxSize safely sets the value for global configuration 'Media.RemoteMaxSize' field
-func SetMediaRemoteMaxSize(v bytesize.Size) { global.SetMediaRemoteMaxSize(v) }
+// SetMediaPreserveColorProfile safely sets the value for global configuration 'Media.PreserveColorProfile' field
+func SetMediaPreserveColorProfile(v bool) { global.SetMediaPreserveColorProfile(v) }
 
 // GetMediaCleanupFrom safely fetches the Configuration value for state's 'Media.CleanupFrom' field
 func (st *ConfigState) GetMediaCleanupFrom() (v string) {
@@ -5040,6 +7661,50 @@ func GetMediaThumbMaxPixels() int { return global.GetMediaThumbMaxPixels() }
 // SetMediaThumbMaxPixels safely sets the value for global configuration 'Media.ThumbMaxPixels' field
 func SetMediaThumbMaxPixels(v int) { global.SetMediaThumbMaxPixels(v) }
 
+// GetMediaVariantFormats safely fetches the Configuration value for state's 'Media.VariantFormats' field
+func (st *ConfigState) GetMediaVariantFormats() (v []string) {
+	st.mutex.RLock()
+	v = st.config.Media.VariantFormats
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVariantFormats safely sets the Configuration value for state's 'Media.VariantFormats' field
+func (st *ConfigState) SetMediaVariantFormats(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.VariantFormats = v
+	st.reloadToViper()
+}
+
+// GetMediaVariantFormats safely fetches the value for global configuration 'Media.VariantFormats' field
+func GetMediaVariantFormats() []string { return global.GetMediaVariantFormats() }
+
+// SetMediaVariantFormats safely sets the value for global configuration 'Media.VariantFormats' field
+func SetMediaVariantFormats(v []string) { global.SetMediaVariantFormats(v) }
+
+// GetMediaVariantCacheSize safely fetches the Configuration value for state's 'Media.VariantCacheSize' field
+func (st *ConfigState) GetMediaVariantCacheSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.Media.VariantCacheSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVariantCacheSize safely sets the Configuration value for state's 'Media.VariantCacheSize' field
+func (st *ConfigState) SetMediaVariantCacheSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Media.VariantCacheSize = v
+	st.reloadToViper()
+}
+
+// GetMediaVariantCacheSize safely fetches the value for global configuration 'Media.VariantCacheSize' field
+func GetMediaVariantCacheSize() bytesize.Size { return global.GetMediaVariantCacheSize() }
+
+// SetMediaVariantCacheSize safely sets the value for global configuration 'Media.VariantCacheSize' field
+func SetMediaVariantCacheSize(v bytesize.Size) { global.SetMediaVariantCacheSize(v) }
+
 // GetCacheS3ObjectInfo safely fetches the Configuration value for state's 'Cache.S3ObjectInfo' field
 func (st *ConfigState) GetCacheS3ObjectInfo() (v int) {
 	st.mutex.RLock()
@@ -5326,6 +7991,28 @@ func GetCacheBoostOfIDsMemRatio() float64 { return global.GetCacheBoostOfIDsMemR
 // SetCacheBoostOfIDsMemRatio safely sets the value for global configuration 'Cache.BoostOfIDsMemRatio' field
 func SetCacheBoostOfIDsMemRatio(v float64) { global.SetCacheBoostOfIDsMemRatio(v) }
 
+// GetCacheCardMemRatio safely fetches the Configuration value for state's 'Cache.CardMemRatio' field
+func (st *ConfigState) GetCacheCardMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.CardMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheCardMemRatio safely sets the Configuration value for state's 'Cache.CardMemRatio' field
+func (st *ConfigState) SetCacheCardMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.CardMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheCardMemRatio safely fetches the value for global configuration 'Cache.CardMemRatio' field
+func GetCacheCardMemRatio() float64 { return global.GetCacheCardMemRatio() }
+
+// SetCacheCardMemRatio safely sets the value for global configuration 'Cache.CardMemRatio' field
+func SetCacheCardMemRatio(v float64) { global.SetCacheCardMemRatio(v) }
+
 // GetCacheClientMemRatio safely fetches the Configuration value for state's 'Cache.ClientMemRatio' field
 func (st *ConfigState) GetCacheClientMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -6284,6 +8971,28 @@ func GetCacheThreadMuteMemRatio() float64 { return global.GetCacheThreadMuteMemR
 // SetCacheThreadMuteMemRatio safely sets the value for global configuration 'Cache.ThreadMuteMemRatio' field
 func SetCacheThreadMuteMemRatio(v float64) { global.SetCacheThreadMuteMemRatio(v) }
 
+// GetCacheThreadSubscriptionMemRatio safely fetches the Configuration value for state's 'Cache.ThreadSubscriptionMemRatio' field
+func (st *ConfigState) GetCacheThreadSubscriptionMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ThreadSubscriptionMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheThreadSubscriptionMemRatio safely sets the Configuration value for state's 'Cache.ThreadSubscriptionMemRatio' field
+func (st *ConfigState) SetCacheThreadSubscriptionMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ThreadSubscriptionMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheThreadSubscriptionMemRatio safely fetches the value for global configuration 'Cache.ThreadSubscriptionMemRatio' field
+func GetCacheThreadSubscriptionMemRatio() float64 { return global.GetCacheThreadSubscriptionMemRatio() }
+
+// SetCacheThreadSubscriptionMemRatio safely sets the value for global configuration 'Cache.ThreadSubscriptionMemRatio' field
+func SetCacheThreadSubscriptionMemRatio(v float64) { global.SetCacheThreadSubscriptionMemRatio(v) }
+
 // GetCacheTokenMemRatio safely fetches the Configuration value for state's 'Cache.TokenMemRatio' field
 func (st *ConfigState) GetCacheTokenMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -6461,76 +9170,168 @@ func GetCacheWebPushSubscriptionIDsMemRatio() float64 {
 	return global.GetCacheWebPushSubscriptionIDsMemRatio()
 }
 
-// SetCacheWebPushSubscriptionIDsMemRatio safely sets the value for global configuration 'Cache.WebPushSubscriptionIDsMemRatio' field
-func SetCacheWebPushSubscriptionIDsMemRatio(v float64) {
-	global.SetCacheWebPushSubscriptionIDsMemRatio(v)
+// SetCacheWebPushSubscriptionIDsMemRatio safely sets the value for global configuration 'Cache.WebPushSubscriptionIDsMemRatio' field
+func SetCacheWebPushSubscriptionIDsMemRatio(v float64) {
+	global.SetCacheWebPushSubscriptionIDsMemRatio(v)
+}
+
+// GetCacheMutesMemRatio safely fetches the Configuration value for state's 'Cache.MutesMemRatio' field
+func (st *ConfigState) GetCacheMutesMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.MutesMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheMutesMemRatio safely sets the Configuration value for state's 'Cache.MutesMemRatio' field
+func (st *ConfigState) SetCacheMutesMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.MutesMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheMutesMemRatio safely fetches the value for global configuration 'Cache.MutesMemRatio' field
+func GetCacheMutesMemRatio() float64 { return global.GetCacheMutesMemRatio() }
+
+// SetCacheMutesMemRatio safely sets the value for global configuration 'Cache.MutesMemRatio' field
+func SetCacheMutesMemRatio(v float64) { global.SetCacheMutesMemRatio(v) }
+
+// GetCacheStatusFilterMemRatio safely fetches the Configuration value for state's 'Cache.StatusFilterMemRatio' field
+func (st *ConfigState) GetCacheStatusFilterMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusFilterMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusFilterMemRatio safely sets the Configuration value for state's 'Cache.StatusFilterMemRatio' field
+func (st *ConfigState) SetCacheStatusFilterMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusFilterMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusFilterMemRatio safely fetches the value for global configuration 'Cache.StatusFilterMemRatio' field
+func GetCacheStatusFilterMemRatio() float64 { return global.GetCacheStatusFilterMemRatio() }
+
+// SetCacheStatusFilterMemRatio safely sets the value for global configuration 'Cache.StatusFilterMemRatio' field
+func SetCacheStatusFilterMemRatio(v float64) { global.SetCacheStatusFilterMemRatio(v) }
+
+// GetCacheVisibilityMemRatio safely fetches the Configuration value for state's 'Cache.VisibilityMemRatio' field
+func (st *ConfigState) GetCacheVisibilityMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.VisibilityMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheVisibilityMemRatio safely sets the Configuration value for state's 'Cache.VisibilityMemRatio' field
+func (st *ConfigState) SetCacheVisibilityMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.VisibilityMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheVisibilityMemRatio safely fetches the value for global configuration 'Cache.VisibilityMemRatio' field
+func GetCacheVisibilityMemRatio() float64 { return global.GetCacheVisibilityMemRatio() }
+
+// SetCacheVisibilityMemRatio safely sets the value for global configuration 'Cache.VisibilityMemRatio' field
+func SetCacheVisibilityMemRatio(v float64) { global.SetCacheVisibilityMemRatio(v) }
+
+// GetCacheStatusRepliesBackfillMemRatio safely fetches the Configuration value for state's 'Cache.StatusRepliesBackfillMemRatio' field
+func (st *ConfigState) GetCacheStatusRepliesBackfillMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusRepliesBackfillMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusRepliesBackfillMemRatio safely sets the Configuration value for state's 'Cache.StatusRepliesBackfillMemRatio' field
+func (st *ConfigState) SetCacheStatusRepliesBackfillMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusRepliesBackfillMemRatio = v
+	st.reloadToViper()
+}
+
+// GetCacheStatusRepliesBackfillMemRatio safely fetches the value for global configuration 'Cache.StatusRepliesBackfillMemRatio' field
+func GetCacheStatusRepliesBackfillMemRatio() float64 {
+	return global.GetCacheStatusRepliesBackfillMemRatio()
+}
+
+// SetCacheStatusRepliesBackfillMemRatio safely sets the value for global configuration 'Cache.StatusRepliesBackfillMemRatio' field
+func SetCacheStatusRepliesBackfillMemRatio(v float64) {
+	global.SetCacheStatusRepliesBackfillMemRatio(v)
 }
 
-// GetCacheMutesMemRatio safely fetches the Configuration value for state's 'Cache.MutesMemRatio' field
-func (st *ConfigState) GetCacheMutesMemRatio() (v float64) {
+// GetCacheIdempotencyMemRatio safely fetches the Configuration value for state's 'Cache.IdempotencyMemRatio' field
+func (st *ConfigState) GetCacheIdempotencyMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.MutesMemRatio
+	v = st.config.Cache.IdempotencyMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheMutesMemRatio safely sets the Configuration value for state's 'Cache.MutesMemRatio' field
-func (st *ConfigState) SetCacheMutesMemRatio(v float64) {
+// SetCacheIdempotencyMemRatio safely sets the Configuration value for state's 'Cache.IdempotencyMemRatio' field
+func (st *ConfigState) SetCacheIdempotencyMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.MutesMemRatio = v
+	st.config.Cache.IdempotencyMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheMutesMemRatio safely fetches the value for global configuration 'Cache.MutesMemRatio' field
-func GetCacheMutesMemRatio() float64 { return global.GetCacheMutesMemRatio() }
+// GetCacheIdempotencyMemRatio safely fetches the value for global configuration 'Cache.IdempotencyMemRatio' field
+func GetCacheIdempotencyMemRatio() float64 { return global.GetCacheIdempotencyMemRatio() }
 
-// SetCacheMutesMemRatio safely sets the value for global configuration 'Cache.MutesMemRatio' field
-func SetCacheMutesMemRatio(v float64) { global.SetCacheMutesMemRatio(v) }
+// SetCacheIdempotencyMemRatio safely sets the value for global configuration 'Cache.IdempotencyMemRatio' field
+func SetCacheIdempotencyMemRatio(v float64) { global.SetCacheIdempotencyMemRatio(v) }
 
-// GetCacheStatusFilterMemRatio safely fetches the Configuration value for state's 'Cache.StatusFilterMemRatio' field
-func (st *ConfigState) GetCacheStatusFilterMemRatio() (v float64) {
+// GetCacheAccountUsageMemRatio safely fetches the Configuration value for state's 'Cache.AccountUsageMemRatio' field
+func (st *ConfigState) GetCacheAccountUsageMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.StatusFilterMemRatio
+	v = st.config.Cache.AccountUsageMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheStatusFilterMemRatio safely sets the Configuration value for state's 'Cache.StatusFilterMemRatio' field
-func (st *ConfigState) SetCacheStatusFilterMemRatio(v float64) {
+// SetCacheAccountUsageMemRatio safely sets the Configuration value for state's 'Cache.AccountUsageMemRatio' field
+func (st *ConfigState) SetCacheAccountUsageMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.StatusFilterMemRatio = v
+	st.config.Cache.AccountUsageMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheStatusFilterMemRatio safely fetches the value for global configuration 'Cache.StatusFilterMemRatio' field
-func GetCacheStatusFilterMemRatio() float64 { return global.GetCacheStatusFilterMemRatio() }
+// GetCacheAccountUsageMemRatio safely fetches the value for global configuration 'Cache.AccountUsageMemRatio' field
+func GetCacheAccountUsageMemRatio() float64 { return global.GetCacheAccountUsageMemRatio() }
 
-// SetCacheStatusFilterMemRatio safely sets the value for global configuration 'Cache.StatusFilterMemRatio' field
-func SetCacheStatusFilterMemRatio(v float64) { global.SetCacheStatusFilterMemRatio(v) }
+// SetCacheAccountUsageMemRatio safely sets the value for global configuration 'Cache.AccountUsageMemRatio' field
+func SetCacheAccountUsageMemRatio(v float64) { global.SetCacheAccountUsageMemRatio(v) }
 
-// GetCacheVisibilityMemRatio safely fetches the Configuration value for state's 'Cache.VisibilityMemRatio' field
-func (st *ConfigState) GetCacheVisibilityMemRatio() (v float64) {
+// GetCacheAccountActivityMemRatio safely fetches the Configuration value for state's 'Cache.AccountActivityMemRatio' field
+func (st *ConfigState) GetCacheAccountActivityMemRatio() (v float64) {
 	st.mutex.RLock()
-	v = st.config.Cache.VisibilityMemRatio
+	v = st.config.Cache.AccountActivityMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// SetCacheVisibilityMemRatio safely sets the Configuration value for state's 'Cache.VisibilityMemRatio' field
-func (st *ConfigState) SetCacheVisibilityMemRatio(v float64) {
+// SetCacheAccountActivityMemRatio safely sets the Configuration value for state's 'Cache.AccountActivityMemRatio' field
+func (st *ConfigState) SetCacheAccountActivityMemRatio(v float64) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.Cache.VisibilityMemRatio = v
+	st.config.Cache.AccountActivityMemRatio = v
 	st.reloadToViper()
 }
 
-// GetCacheVisibilityMemRatio safely fetches the value for global configuration 'Cache.VisibilityMemRatio' field
-func GetCacheVisibilityMemRatio() float64 { return global.GetCacheVisibilityMemRatio() }
+// GetCacheAccountActivityMemRatio safely fetches the value for global configuration 'Cache.AccountActivityMemRatio' field
+func GetCacheAccountActivityMemRatio() float64 { return global.GetCacheAccountActivityMemRatio() }
 
-// SetCacheVisibilityMemRatio safely sets the value for global configuration 'Cache.VisibilityMemRatio' field
-func SetCacheVisibilityMemRatio(v float64) { global.SetCacheVisibilityMemRatio(v) }
+// SetCacheAccountActivityMemRatio safely sets the value for global configuration 'Cache.AccountActivityMemRatio' field
+func SetCacheAccountActivityMemRatio(v float64) { global.SetCacheAccountActivityMemRatio(v) }
 
 // GetAdminAccountUsername safely fetches the Configuration value for state's 'AdminAccountUsername' field
 func (st *ConfigState) GetAdminAccountUsername() (v string) {
@@ -6598,6 +9399,50 @@ func GetAdminAccountPassword() string { return global.GetAdminAccountPassword()
 // SetAdminAccountPassword safely sets the value for global configuration 'AdminAccountPassword' field
 func SetAdminAccountPassword(v string) { global.SetAdminAccountPassword(v) }
 
+// GetAdminAccountQuery safely fetches the Configuration value for state's 'AdminAccountQuery' field
+func (st *ConfigState) GetAdminAccountQuery() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountQuery
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountQuery safely sets the Configuration value for state's 'AdminAccountQuery' field
+func (st *ConfigState) SetAdminAccountQuery(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountQuery = v
+	st.reloadToViper()
+}
+
+// GetAdminAccountQuery safely fetches the value for global configuration 'AdminAccountQuery' field
+func GetAdminAccountQuery() string { return global.GetAdminAccountQuery() }
+
+// SetAdminAccountQuery safely sets the value for global configuration 'AdminAccountQuery' field
+func SetAdminAccountQuery(v string) { global.SetAdminAccountQuery(v) }
+
+// GetAdminAccountRole safely fetches the Configuration value for state's 'AdminAccountRole' field
+func (st *ConfigState) GetAdminAccountRole() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountRole
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountRole safely sets the Configuration value for state's 'AdminAccountRole' field
+func (st *ConfigState) SetAdminAccountRole(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountRole = v
+	st.reloadToViper()
+}
+
+// GetAdminAccountRole safely fetches the value for global configuration 'AdminAccountRole' field
+func GetAdminAccountRole() string { return global.GetAdminAccountRole() }
+
+// SetAdminAccountRole safely sets the value for global configuration 'AdminAccountRole' field
+func SetAdminAccountRole(v string) { global.SetAdminAccountRole(v) }
+
 // GetAdminTransPath safely fetches the Configuration value for state's 'AdminTransPath' field
 func (st *ConfigState) GetAdminTransPath() (v string) {
 	st.mutex.RLock()
@@ -6686,6 +9531,50 @@ func GetAdminMediaListRemoteOnly() bool { return global.GetAdminMediaListRemoteO
 // SetAdminMediaListRemoteOnly safely sets the value for global configuration 'AdminMediaListRemoteOnly' field
 func SetAdminMediaListRemoteOnly(v bool) { global.SetAdminMediaListRemoteOnly(v) }
 
+// GetAdminMediaID safely fetches the Configuration value for state's 'AdminMediaID' field
+func (st *ConfigState) GetAdminMediaID() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaID
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaID safely sets the Configuration value for state's 'AdminMediaID' field
+func (st *ConfigState) SetAdminMediaID(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaID = v
+	st.reloadToViper()
+}
+
+// GetAdminMediaID safely fetches the value for global configuration 'AdminMediaID' field
+func GetAdminMediaID() string { return global.GetAdminMediaID() }
+
+// SetAdminMediaID safely sets the value for global configuration 'AdminMediaID' field
+func SetAdminMediaID(v string) { global.SetAdminMediaID(v) }
+
+// GetAdminMediaDomain safely fetches the Configuration value for state's 'AdminMediaDomain' field
+func (st *ConfigState) GetAdminMediaDomain() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminMediaDomain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminMediaDomain safely sets the Configuration value for state's 'AdminMediaDomain' field
+func (st *ConfigState) SetAdminMediaDomain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminMediaDomain = v
+	st.reloadToViper()
+}
+
+// GetAdminMediaDomain safely fetches the value for global configuration 'AdminMediaDomain' field
+func GetAdminMediaDomain() string { return global.GetAdminMediaDomain() }
+
+// SetAdminMediaDomain safely sets the value for global configuration 'AdminMediaDomain' field
+func SetAdminMediaDomain(v string) { global.SetAdminMediaDomain(v) }
+
 // GetTestrigSkipDBSetup safely fetches the Configuration value for state's 'TestrigSkipDBSetup' field
 func (st *ConfigState) GetTestrigSkipDBSetup() (v bool) {
 	st.mutex.RLock()
@@ -6730,6 +9619,28 @@ func GetTestrigSkipDBTeardown() bool { return global.GetTestrigSkipDBTeardown()
 // SetTestrigSkipDBTeardown safely sets the value for global configuration 'TestrigSkipDBTeardown' field
 func SetTestrigSkipDBTeardown(v bool) { global.SetTestrigSkipDBTeardown(v) }
 
+// GetTestrigClientFixtures safely fetches the Configuration value for state's 'TestrigClientFixtures' field
+func (st *ConfigState) GetTestrigClientFixtures() (v bool) {
+	st.mutex.RLock()
+	v = st.config.TestrigClientFixtures
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTestrigClientFixtures safely sets the Configuration value for state's 'TestrigClientFixtures' field
+func (st *ConfigState) SetTestrigClientFixtures(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TestrigClientFixtures = v
+	st.reloadToViper()
+}
+
+// GetTestrigClientFixtures safely fetches the value for global configuration 'TestrigClientFixtures' field
+func GetTestrigClientFixtures() bool { return global.GetTestrigClientFixtures() }
+
+// SetTestrigClientFixtures safely sets the value for global configuration 'TestrigClientFixtures' field
+func SetTestrigClientFixtures(v bool) { global.SetTestrigClientFixtures(v) }
+
 // GetTotalOfMemRatios safely fetches the combined value for all the state's mem ratio fields
 func (st *ConfigState) GetTotalOfMemRatios() (total float64) {
 	st.mutex.RLock()
@@ -6741,6 +9652,7 @@ func (st *ConfigState) GetTotalOfMemRatios() (total float64) {
 	total += st.config.Cache.BlockMemRatio
 	total += st.config.Cache.BlockIDsMemRatio
 	total += st.config.Cache.BoostOfIDsMemRatio
+	total += st.config.Cache.CardMemRatio
 	total += st.config.Cache.ClientMemRatio
 	total += st.config.Cache.ConversationMemRatio
 	total += st.config.Cache.ConversationLastStatusIDsMemRatio
@@ -6784,6 +9696,7 @@ func (st *ConfigState) GetTotalOfMemRatios() (total float64) {
 	total += st.config.Cache.StatusFaveIDsMemRatio
 	total += st.config.Cache.TagMemRatio
 	total += st.config.Cache.ThreadMuteMemRatio
+	total += st.config.Cache.ThreadSubscriptionMemRatio
 	total += st.config.Cache.TokenMemRatio
 	total += st.config.Cache.TombstoneMemRatio
 	total += st.config.Cache.UserMemRatio
@@ -6795,179 +9708,381 @@ func (st *ConfigState) GetTotalOfMemRatios() (total float64) {
 	total += st.config.Cache.MutesMemRatio
 	total += st.config.Cache.StatusFilterMemRatio
 	total += st.config.Cache.VisibilityMemRatio
+	total += st.config.Cache.StatusRepliesBackfillMemRatio
+	total += st.config.Cache.IdempotencyMemRatio
+	total += st.config.Cache.AccountUsageMemRatio
+	total += st.config.Cache.AccountActivityMemRatio
 	st.mutex.RUnlock()
 	return
 }
 
-// GetTotalOfMemRatios safely fetches the combined value for all the global state's mem ratio fields
-func GetTotalOfMemRatios() (total float64) { return global.GetTotalOfMemRatios() }
+// GetTotalOfMemRatios safely fetches the combined value for all the global state's mem ratio fields
+func GetTotalOfMemRatios() (total float64) { return global.GetTotalOfMemRatios() }
+
+func flattenConfigMap(cfgmap map[string]any) {
+	nestedKeys := make(map[string]struct{})
+	for _, key := range [][]string{
+		{"advanced", "cookies-samesite"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["advanced-cookies-samesite"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"advanced", "sender-multiplier"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["advanced-sender-multiplier"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"advanced", "csp-extra-uris"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["advanced-csp-extra-uris"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"advanced", "header-filter-mode"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["advanced-header-filter-mode"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"advanced-rate-limit", "requests"},
+		{"advanced", "rate-limit", "requests"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["advanced-rate-limit-requests"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"advanced-rate-limit", "exceptions"},
+		{"advanced", "rate-limit", "exceptions"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["advanced-rate-limit-exceptions"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"advanced-throttling", "multiplier"},
+		{"advanced", "throttling", "multiplier"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["advanced-throttling-multiplier"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"advanced-throttling", "retry-after"},
+		{"advanced", "throttling", "retry-after"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["advanced-throttling-retry-after"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"http-client", "allow-ips"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["http-client-allow-ips"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"http-client", "block-ips"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["http-client-block-ips"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"http-client", "timeout"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["http-client-timeout"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"http-client", "webfinger-timeout"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["http-client-webfinger-timeout"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"http-client", "dereference-timeout"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["http-client-dereference-timeout"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"http-client", "media-timeout"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["http-client-media-timeout"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"http-client", "delivery-timeout"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["http-client-delivery-timeout"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"http-client", "max-idle-conns-per-host"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["http-client-max-idle-conns-per-host"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
 
-func flattenConfigMap(cfgmap map[string]any) {
-	nestedKeys := make(map[string]struct{})
 	for _, key := range [][]string{
-		{"advanced", "cookies-samesite"},
+		{"http-client", "idle-conn-timeout"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["advanced-cookies-samesite"] = ival
+			cfgmap["http-client-idle-conn-timeout"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"advanced", "sender-multiplier"},
+		{"http-client", "disable-http2"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["advanced-sender-multiplier"] = ival
+			cfgmap["http-client-disable-http2"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"advanced", "csp-extra-uris"},
+		{"http-client", "dns-cache-ttl"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["advanced-csp-extra-uris"] = ival
+			cfgmap["http-client-dns-cache-ttl"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"advanced", "header-filter-mode"},
+		{"http-client", "dns-cache-negative-ttl"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["advanced-header-filter-mode"] = ival
+			cfgmap["http-client-dns-cache-negative-ttl"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"advanced-rate-limit", "requests"},
-		{"advanced", "rate-limit", "requests"},
+		{"http-client", "ip-family-preference"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["advanced-rate-limit-requests"] = ival
+			cfgmap["http-client-ip-family-preference"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"advanced-rate-limit", "exceptions"},
-		{"advanced", "rate-limit", "exceptions"},
+		{"http-client", "happy-eyeballs-delay"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["advanced-rate-limit-exceptions"] = ival
+			cfgmap["http-client-happy-eyeballs-delay"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"advanced-throttling", "multiplier"},
-		{"advanced", "throttling", "multiplier"},
+		{"http-client", "ipv4-only-domains"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["advanced-throttling-multiplier"] = ival
+			cfgmap["http-client-ipv4-only-domains"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"advanced-throttling", "retry-after"},
-		{"advanced", "throttling", "retry-after"},
+		{"http-client", "tls-insecure-skip-verify"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["advanced-throttling-retry-after"] = ival
+			cfgmap["http-client-tls-insecure-skip-verify"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"http-client", "allow-ips"},
+		{"http-client", "insecure-outgoing"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["http-client-allow-ips"] = ival
+			cfgmap["http-client-insecure-outgoing"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"http-client", "block-ips"},
+		{"media", "description-min-chars"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["http-client-block-ips"] = ival
+			cfgmap["media-description-min-chars"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"http-client", "timeout"},
+		{"media", "description-max-chars"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["http-client-timeout"] = ival
+			cfgmap["media-description-max-chars"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"http-client", "tls-insecure-skip-verify"},
+		{"media", "description-policy"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["http-client-tls-insecure-skip-verify"] = ival
+			cfgmap["media-description-policy"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"http-client", "insecure-outgoing"},
+		{"media", "describe-provider"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["http-client-insecure-outgoing"] = ival
+			cfgmap["media-describe-provider"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"media", "description-min-chars"},
+		{"media", "describe-endpoint"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["media-description-min-chars"] = ival
+			cfgmap["media-describe-endpoint"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
 	}
 
 	for _, key := range [][]string{
-		{"media", "description-max-chars"},
+		{"media", "describe-command"},
 	} {
 		ival, ok := mapGet(cfgmap, key...)
 		if ok {
-			cfgmap["media-description-max-chars"] = ival
+			cfgmap["media-describe-command"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "describe-concurrency"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-describe-concurrency"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "describe-timeout"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-describe-timeout"] = ival
 			nestedKeys[key[0]] = struct{}{}
 			break
 		}
@@ -7006,6 +10121,17 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"media", "emoji-static-only"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-emoji-static-only"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"media", "image-size-hint"},
 	} {
@@ -7039,6 +10165,17 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"media", "local-quota"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-local-quota"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"media", "remote-max-size"},
 	} {
@@ -7050,6 +10187,116 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"media", "image-max-size"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-image-max-size"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "image-max-megapixels"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-image-max-megapixels"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "video-max-size"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-video-max-size"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "video-max-duration"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-video-max-duration"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "video-max-bitrate"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-video-max-bitrate"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "audio-max-size"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-audio-max-size"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "audio-max-duration"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-audio-max-duration"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "audio-max-bitrate"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-audio-max-bitrate"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "remote-sensitive-blurhash-only"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-remote-sensitive-blurhash-only"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "preserve-color-profile"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-preserve-color-profile"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"media", "cleanup-from"},
 	} {
@@ -7094,6 +10341,28 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"media", "variant-formats"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-variant-formats"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"media", "variant-cache-size"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["media-variant-cache-size"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "s3-object-info"},
 	} {
@@ -7237,6 +10506,17 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "card-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-card-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "client-mem-ratio"},
 	} {
@@ -7710,6 +10990,17 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "thread-subscription-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-thread-subscription-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for _, key := range [][]string{
 		{"cache", "token-mem-ratio"},
 	} {
@@ -7831,6 +11122,50 @@ func flattenConfigMap(cfgmap map[string]any) {
 		}
 	}
 
+	for _, key := range [][]string{
+		{"cache", "status-replies-backfill-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-status-replies-backfill-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"cache", "idempotency-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-idempotency-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"cache", "account-usage-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-account-usage-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
+	for _, key := range [][]string{
+		{"cache", "account-activity-mem-ratio"},
+	} {
+		ival, ok := mapGet(cfgmap, key...)
+		if ok {
+			cfgmap["cache-account-activity-mem-ratio"] = ival
+			nestedKeys[key[0]] = struct{}{}
+			break
+		}
+	}
+
 	for key := range nestedKeys {
 		delete(cfgmap, key)
 	}
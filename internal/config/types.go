@@ -20,6 +20,7 @@
 import (
 	"errors"
 	"net/netip"
+	"strings"
 )
 
 // IPPrefixes is a type-alias for []netip.Prefix
@@ -56,3 +57,56 @@ func GetHTTPClientOutgoingScheme() (schema string) {
 
 	return "https://"
 }
+
+// IsLocalAccountDomain returns whether the given domain
+// is one at which local accounts may be addressed, ie.,
+// host, account-domain, or one of account-domain-aliases,
+// including aliases that only cover particular accounts.
+func IsLocalAccountDomain(domain string) bool {
+	if domain == GetHost() || domain == GetAccountDomain() {
+		return true
+	}
+
+	for _, alias := range GetAccountDomainAliases() {
+		_, aliasDomain := splitAccountDomainAlias(alias)
+		if aliasDomain == domain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsLocalAccountAddress returns whether the local account with
+// the given username may be addressed at the given domain, ie.,
+// domain is host or account-domain, or an account-domain-aliases
+// entry covers it: either "domain", covering all local accounts,
+// or "username@domain", covering only the account with username.
+func IsLocalAccountAddress(username string, domain string) bool {
+	if domain == GetHost() || domain == GetAccountDomain() {
+		return true
+	}
+
+	for _, alias := range GetAccountDomainAliases() {
+		aliasUser, aliasDomain := splitAccountDomainAlias(alias)
+		if aliasDomain != domain {
+			continue
+		}
+
+		if aliasUser == "" || strings.EqualFold(aliasUser, username) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitAccountDomainAlias splits an account-domain-aliases
+// entry into its (optional) username and domain parts.
+func splitAccountDomainAlias(alias string) (username string, domain string) {
+	alias = strings.TrimPrefix(alias, "@")
+	if i := strings.LastIndexByte(alias, '@'); i >= 0 {
+		return alias[:i], alias[i+1:]
+	}
+	return "", alias
+}
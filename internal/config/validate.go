@@ -25,6 +25,7 @@
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/language"
+	"codeberg.org/gruf/go-bytesize"
 	"github.com/miekg/dns"
 )
 
@@ -116,6 +117,184 @@ func Validate() error {
 		)
 	}
 
+	// `instance-peers-mode` should be
+	// "serve", "anonymize", or "disabled".
+	switch peersMode := GetInstancePeersMode(); peersMode {
+	case InstancePeersModeServe, InstancePeersModeAnonymize, InstancePeersModeDisabled:
+		// No problem.
+
+	default:
+		errf("%s must be set to serve, anonymize, or disabled, provided value was %s",
+			InstancePeersModeFlag, peersMode,
+		)
+	}
+
+	// `instance-activity-mode` should be
+	// "disabled", "serve", or "anonymize".
+	switch activityMode := GetInstanceActivityMode(); activityMode {
+	case InstanceActivityModeDisabled, InstanceActivityModeServe, InstanceActivityModeAnonymize:
+		// No problem.
+
+	default:
+		errf("%s must be set to disabled, serve, or anonymize, provided value was %s",
+			InstanceActivityModeFlag, activityMode,
+		)
+	}
+
+	// `http-client-ip-family-preference`
+	// should be "auto", "ipv4", or "ipv6".
+	switch family := GetHTTPClientIPFamilyPreference(); family {
+	case HTTPClientIPFamilyAuto, HTTPClientIPFamilyIPv4, HTTPClientIPFamilyIPv6:
+		// No problem.
+
+	default:
+		errf("%s must be set to auto, ipv4, or ipv6, provided value was %s",
+			HTTPClientIPFamilyPreferenceFlag, family,
+		)
+	}
+
+	// `accounts-invites-allowed` should be
+	// "none", "moderators", or "users".
+	switch allowed := GetAccountsInvitesAllowed(); allowed {
+	case AccountsInvitesAllowedNone, AccountsInvitesAllowedModerators, AccountsInvitesAllowedUsers:
+		// No problem.
+
+	default:
+		errf("%s must be set to none, moderators, or users, provided value was %s",
+			AccountsInvitesAllowedFlag, allowed,
+		)
+	}
+
+	// `media-description-policy` should be
+	// "off", "remind", or "require".
+	switch policy := GetMediaDescriptionPolicy(); policy {
+	case MediaDescriptionPolicyOff, MediaDescriptionPolicyRemind, MediaDescriptionPolicyRequire:
+		// No problem.
+
+	default:
+		errf("%s must be set to off, remind, or require, provided value was %s",
+			MediaDescriptionPolicyFlag, policy,
+		)
+	}
+
+	// `media-describe-provider` should be
+	// "", "tesseract", or "http", with
+	// the settings it needs.
+	switch provider := GetMediaDescribeProvider(); provider {
+	case MediaDescribeProviderNone:
+		// No problem.
+
+	case MediaDescribeProviderTesseract:
+		if strings.TrimSpace(GetMediaDescribeCommand()) == "" {
+			errf("%s must be set when %s is %s",
+				MediaDescribeCommandFlag, MediaDescribeProviderFlag, provider)
+		}
+
+	case MediaDescribeProviderHTTP:
+		endpoint := GetMediaDescribeEndpoint()
+		if endpoint == "" {
+			errf("%s must be set when %s is %s",
+				MediaDescribeEndpointFlag, MediaDescribeProviderFlag, provider)
+		} else if url, err := url.Parse(endpoint); err != nil {
+			errf("%s invalid: %w",
+				MediaDescribeEndpointFlag, err)
+		} else if url.Scheme != "https" && url.Scheme != "http" {
+			errf("%s scheme must be https or http",
+				MediaDescribeEndpointFlag)
+		}
+
+	default:
+		errf("%s must be set to empty string, tesseract, or http, provided value was %s",
+			MediaDescribeProviderFlag, provider,
+		)
+	}
+
+	// `media-variant-formats` may
+	// currently only contain "webp".
+	for _, format := range GetMediaVariantFormats() {
+		switch format {
+		case MediaVariantFormatWebP:
+			// No problem.
+
+		default:
+			errf("%s may only contain webp, provided value was %s",
+				MediaVariantFormatsFlag, format,
+			)
+		}
+	}
+
+	// `translation-provider` should be
+	// "", "deepl", or "libretranslate",
+	// with the settings it needs.
+	switch provider := GetTranslationProvider(); provider {
+	case TranslationProviderNone:
+		// No problem.
+
+	case TranslationProviderDeepL:
+		if GetTranslationAPIKey() == "" {
+			errf("%s must be set when %s is %s",
+				TranslationAPIKeyFlag, TranslationProviderFlag, provider)
+		}
+
+	case TranslationProviderLibreTranslate:
+		if GetTranslationEndpoint() == "" {
+			errf("%s must be set when %s is %s",
+				TranslationEndpointFlag, TranslationProviderFlag, provider)
+		}
+
+	default:
+		errf("%s must be set to empty string, deepl, or libretranslate, provided value was %s",
+			TranslationProviderFlag, provider,
+		)
+	}
+
+	// `translation-endpoint`
+	if endpoint := GetTranslationEndpoint(); endpoint != "" {
+		if url, err := url.Parse(endpoint); err != nil {
+			errf("%s invalid: %w",
+				TranslationEndpointFlag, err)
+		} else if url.Scheme != "https" && url.Scheme != "http" {
+			errf("%s scheme must be https or http",
+				TranslationEndpointFlag)
+		}
+	}
+
+	// `event-bus-provider` should be
+	// "" or "nats", with a URL set.
+	switch provider := GetEventBusProvider(); provider {
+	case EventBusProviderNone:
+		// No problem.
+
+	case EventBusProviderNATS:
+		if GetEventBusURL() == "" {
+			errf("%s must be set when %s is %s",
+				EventBusURLFlag, EventBusProviderFlag, provider)
+		}
+
+	default:
+		errf("%s must be set to empty string or nats, provided value was %s",
+			EventBusProviderFlag, provider,
+		)
+	}
+
+	// `event-bus-url`
+	if busURL := GetEventBusURL(); busURL != "" {
+		if url, err := url.Parse(busURL); err != nil {
+			errf("%s invalid: %w",
+				EventBusURLFlag, err)
+		} else if url.Scheme != "nats" && url.Scheme != "tls" {
+			errf("%s scheme must be nats or tls",
+				EventBusURLFlag)
+		}
+	}
+
+	// `event-bus-subject-prefix`
+	if GetEventBusProvider() != EventBusProviderNone &&
+		GetEventBusSubjectPrefix() == "" {
+		errf("%s must be set when %s is set",
+			EventBusSubjectPrefixFlag, EventBusProviderFlag)
+	}
+
 	// `web-assets-base-dir`.
 	webAssetsBaseDir := GetWebAssetBaseDir()
 	if webAssetsBaseDir == "" {
@@ -166,6 +345,26 @@ func Validate() error {
 			HTTPClientInsecureOutgoingFlag)
 	}
 
+	// `storage-s3-multipart-part-size`
+	if GetStorageBackend() == "s3" &&
+		GetStorageS3MultipartThreshold() > 0 &&
+		GetStorageS3MultipartPartSize() < 5*bytesize.MiB {
+		errf("%s must be at least 5MiB", StorageS3MultipartPartSizeFlag)
+	}
+
+	// `storage-s3-remote-cache-tag`
+	if tag := GetStorageS3RemoteCacheTag(); tag != "" {
+		if k, _, ok := strings.Cut(tag, "="); !ok || k == "" {
+			errf("%s must be in the form 'key=value'", StorageS3RemoteCacheTagFlag)
+		}
+	}
+
+	// `statuses-cleanup-interval`, used
+	// as a ticker period so must be > 0.
+	if GetStatusesCleanupInterval() <= 0 {
+		errf("%s must be greater than 0", StatusesCleanupIntervalFlag)
+	}
+
 	// thumb size recommendations,
 	// beyond which we log.Warn().
 	const minThumb = 32
@@ -140,6 +140,15 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigBadProtocolNoHost() {
 	suite.EqualError(err, "host must be set\nprotocol must be set to either http or https, provided value was foo")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateConfigNoStatusesCleanupInterval() {
+	testrig.InitTestConfig()
+
+	config.SetStatusesCleanupInterval(0)
+
+	err := config.Validate()
+	suite.EqualError(err, "statuses-cleanup-interval must be greater than 0")
+}
+
 func TestConfigValidateTestSuite(t *testing.T) {
 	suite.Run(t, &ConfigValidateTestSuite{})
 }
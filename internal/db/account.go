@@ -20,6 +20,7 @@
 import (
 	"context"
 	"net/netip"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
@@ -60,6 +61,18 @@ type Account interface {
 	// GetAccountsByMovedToURI returns any accounts with given moved_to_uri set.
 	GetAccountsByMovedToURI(ctx context.Context, uri string) ([]*gtsmodel.Account, error)
 
+	// GetSuspendedLocalAccounts returns up to limit of local accounts
+	// which were suspended before the given time, but whose data has
+	// not been purged yet (ie., they still have a user), with an ID
+	// greater than sinceID, ordered by ID ascending.
+	GetSuspendedLocalAccounts(ctx context.Context, suspendedBefore time.Time, sinceID string, limit int) ([]*gtsmodel.Account, error)
+
+	// GetIndexableLocalAccounts returns up to limit of local,
+	// unsuspended accounts which are both discoverable and
+	// indexable, with an ID greater than sinceID, ordered
+	// by ID ascending. The instance account is excluded.
+	GetIndexableLocalAccounts(ctx context.Context, sinceID string, limit int) ([]*gtsmodel.Account, error)
+
 	// GetAccounts returns accounts
 	// with the given parameters.
 	GetAccounts(
@@ -72,7 +85,10 @@ type Account interface {
 		displayName string,
 		domain string,
 		email string,
+		emailDomain string,
 		ip netip.Addr,
+		createdAfter time.Time,
+		createdBefore time.Time,
 		page *paging.Page,
 	) (
 		[]*gtsmodel.Account,
@@ -109,6 +125,17 @@ type Account interface {
 	// In the case of no statuses, this function will return db.ErrNoEntries.
 	GetAccountStatuses(ctx context.Context, accountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, mediaOnly bool, publicOnly bool) ([]*gtsmodel.Status, error)
 
+	// CountAccountStatusesBefore counts the statuses of the given account ID
+	// with an ID lower (ie., older) than maxID, excluding boosts. If publicOnly
+	// is set, only statuses with public visibility are counted.
+	CountAccountStatusesBefore(ctx context.Context, accountID string, maxID string, publicOnly bool) (int, error)
+
+	// CountAccountPublicStatusesByDay counts the public statuses of the
+	// given account ID created since the given time, excluding boosts,
+	// grouped by day (UTC). Days without statuses are omitted, and
+	// days are returned in ascending order.
+	CountAccountPublicStatusesByDay(ctx context.Context, accountID string, since time.Time) ([]*gtsmodel.AccountActivityDay, error)
+
 	// GetAccountPinnedStatuses returns ONLY statuses owned by the give accountID for which a corresponding StatusPin
 	// exists in the database. Statuses which are not pinned will not be returned by this function.
 	//
@@ -163,6 +190,11 @@ type Account interface {
 	// specifically), callers should prefer GetAccountStats in 99% of cases.
 	RegenerateAccountStats(ctx context.Context, account *gtsmodel.Account) error
 
+	// GetAccountStatsBatch returns up to limit stored account stats,
+	// ordered by account ID ascending, starting after sinceAccountID
+	// (if set). For paging through the stats of all accounts.
+	GetAccountStatsBatch(ctx context.Context, sinceAccountID string, limit int) ([]*gtsmodel.AccountStats, error)
+
 	// Update account stats.
 	UpdateAccountStats(ctx context.Context, stats *gtsmodel.AccountStats, columns ...string) error
 
@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// AccountCustomDomain handles getting/creation/updating
+// of vanity domains requested by local accounts.
+type AccountCustomDomain interface {
+	// GetAccountCustomDomainByID gets one custom domain by its db id.
+	GetAccountCustomDomainByID(ctx context.Context, id string) (*gtsmodel.AccountCustomDomain, error)
+
+	// GetAccountCustomDomainByDomain gets one custom domain by the domain itself.
+	GetAccountCustomDomainByDomain(ctx context.Context, domain string) (*gtsmodel.AccountCustomDomain, error)
+
+	// GetAccountCustomDomainByAccountID gets the custom domain of the given account ID.
+	GetAccountCustomDomainByAccountID(ctx context.Context, accountID string) (*gtsmodel.AccountCustomDomain, error)
+
+	// GetAccountCustomDomains gets a page of custom domains, optionally
+	// filtered on whether they have been approved by an admin.
+	GetAccountCustomDomains(ctx context.Context, approved *bool, page *paging.Page) ([]*gtsmodel.AccountCustomDomain, error)
+
+	// PopulateAccountCustomDomain populates the struct pointers on the given custom domain.
+	PopulateAccountCustomDomain(ctx context.Context, domain *gtsmodel.AccountCustomDomain) error
+
+	// PutAccountCustomDomain puts the given custom domain in the database.
+	PutAccountCustomDomain(ctx context.Context, domain *gtsmodel.AccountCustomDomain) error
+
+	// UpdateAccountCustomDomain updates the given custom domain.
+	// Columns is optional, if not specified all will be updated.
+	UpdateAccountCustomDomain(ctx context.Context, domain *gtsmodel.AccountCustomDomain, columns ...string) error
+
+	// DeleteAccountCustomDomainByID deletes one custom domain by its db id.
+	DeleteAccountCustomDomainByID(ctx context.Context, id string) error
+
+	// DeleteAccountCustomDomainByAccountID deletes
+	// the custom domain of the given account ID.
+	DeleteAccountCustomDomainByAccountID(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,43 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// AccountWarning handles getting/creation of moderation warnings (strikes) issued to local accounts.
+type AccountWarning interface {
+	// GetAccountWarningByID gets one account warning by its db id.
+	GetAccountWarningByID(ctx context.Context, id string) (*gtsmodel.AccountWarning, error)
+
+	// GetAccountWarnings gets a page of account warnings issued to the given account ID.
+	GetAccountWarnings(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.AccountWarning, error)
+
+	// CountAccountWarnings counts the number of account warnings issued to the given account ID.
+	CountAccountWarnings(ctx context.Context, accountID string) (int, error)
+
+	// PopulateAccountWarning populates the struct pointers on the given account warning.
+	PopulateAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) error
+
+	// PutAccountWarning puts the given account warning in the database.
+	PutAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) error
+}
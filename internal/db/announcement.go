@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Announcement handles getting/creation/deletion/updating of instance
+// announcements, and of local accounts' reads of and reactions to them.
+type Announcement interface {
+	// GetAnnouncementByID gets one announcement by its db id.
+	GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error)
+
+	// GetAnnouncements gets all announcements,
+	// published or not, ordered by ID descending.
+	GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// GetActiveAnnouncements gets all published announcements
+	// which haven't yet ended, ordered by ID ascending.
+	GetActiveAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// PutAnnouncement puts the given announcement in the database.
+	PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error
+
+	// UpdateAnnouncement updates the given announcement.
+	// Columns is optional, if not specified all will be updated.
+	UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error
+
+	// DeleteAnnouncementByID deletes one announcement by
+	// its db id, along with all reads of and reactions to it.
+	DeleteAnnouncementByID(ctx context.Context, id string) error
+
+	// GetReadAnnouncementIDs gets the IDs of all
+	// announcements read by the given account ID.
+	GetReadAnnouncementIDs(ctx context.Context, accountID string) ([]string, error)
+
+	// PutAnnouncementRead puts the given announcement read in the database.
+	// If the account has already read the announcement, this is a no-op.
+	PutAnnouncementRead(ctx context.Context, read *gtsmodel.AnnouncementRead) error
+
+	// GetAnnouncementReactions gets all reactions to the given
+	// announcement ID, ordered by ID ascending. Custom emojis
+	// of reactions are populated where possible.
+	GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error)
+
+	// PutAnnouncementReaction puts the given announcement reaction in the database.
+	// Returns ErrAlreadyExists if the account already reacted with the same name.
+	PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error
+
+	// DeleteAnnouncementReaction deletes the reaction
+	// with given name by account to announcement, if any.
+	DeleteAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) error
+
+	// DeleteAnnouncementReadsAndReactionsByAccountID deletes all
+	// announcement reads and reactions of the given account ID.
+	DeleteAnnouncementReadsAndReactionsByAccountID(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,48 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// Appeal handles getting/creation of appeals against moderation decisions.
+type Appeal interface {
+	// GetAppealByID gets one appeal by its db id.
+	GetAppealByID(ctx context.Context, id string) (*gtsmodel.Appeal, error)
+
+	// GetAppealByAccountWarningID gets the appeal made against the given account warning ID.
+	GetAppealByAccountWarningID(ctx context.Context, accountWarningID string) (*gtsmodel.Appeal, error)
+
+	// GetAppeals gets a page of appeals, optionally filtered by
+	// resolved state and/or the ID of the appealing account.
+	GetAppeals(ctx context.Context, resolved *bool, accountID string, page *paging.Page) ([]*gtsmodel.Appeal, error)
+
+	// PopulateAppeal populates the struct pointers on the given appeal.
+	PopulateAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error
+
+	// PutAppeal puts the given appeal in the database.
+	PutAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error
+
+	// UpdateAppeal updates the given appeal in the database, on the given columns.
+	// If no columns are specified, every column is updated.
+	UpdateAppeal(ctx context.Context, appeal *gtsmodel.Appeal, columns ...string) error
+}
@@ -0,0 +1,56 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// BlocklistSubscription handles storing and getting
+// local accounts' subscriptions to published blocklists.
+type BlocklistSubscription interface {
+	// GetBlocklistSubscriptionByID gets the blocklist subscription with the given ID.
+	GetBlocklistSubscriptionByID(ctx context.Context, id string) (*gtsmodel.BlocklistSubscription, error)
+
+	// GetBlocklistSubscriptionByURI gets the blocklist subscription
+	// of the given account to the blocklist at the given URI.
+	GetBlocklistSubscriptionByURI(ctx context.Context, accountID string, uri string) (*gtsmodel.BlocklistSubscription, error)
+
+	// GetBlocklistSubscriptionsByAccountID gets all
+	// blocklist subscriptions of the given account.
+	GetBlocklistSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.BlocklistSubscription, error)
+
+	// GetBlocklistSubscriptions gets all blocklist subscriptions, of all accounts.
+	GetBlocklistSubscriptions(ctx context.Context) ([]*gtsmodel.BlocklistSubscription, error)
+
+	// PutBlocklistSubscription puts the given blocklist subscription in the database.
+	PutBlocklistSubscription(ctx context.Context, sub *gtsmodel.BlocklistSubscription) error
+
+	// UpdateBlocklistSubscription updates the given blocklist subscription,
+	// only on selected columns if provided (else, all).
+	UpdateBlocklistSubscription(ctx context.Context, sub *gtsmodel.BlocklistSubscription, cols ...string) error
+
+	// DeleteBlocklistSubscriptionByID deletes the blocklist subscription with the given ID.
+	DeleteBlocklistSubscriptionByID(ctx context.Context, id string) error
+
+	// DeleteBlocklistSubscriptionsByAccountID deletes all
+	// blocklist subscriptions of the given account.
+	DeleteBlocklistSubscriptionsByAccountID(ctx context.Context, accountID string) error
+}
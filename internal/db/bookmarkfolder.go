@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// BookmarkFolder handles getting/creation/deletion of bookmark folders.
+type BookmarkFolder interface {
+	// GetBookmarkFolderByID gets one bookmark folder by its db id.
+	GetBookmarkFolderByID(ctx context.Context, id string) (*gtsmodel.BookmarkFolder, error)
+
+	// GetBookmarkFoldersByAccountID gets all bookmark folders
+	// owned by the given account ID, ordered by ID ascending.
+	GetBookmarkFoldersByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.BookmarkFolder, error)
+
+	// PutBookmarkFolder puts the given bookmark folder in the database.
+	PutBookmarkFolder(ctx context.Context, folder *gtsmodel.BookmarkFolder) error
+
+	// UpdateBookmarkFolder updates the given bookmark folder.
+	// Columns is optional, if not specified all will be updated.
+	UpdateBookmarkFolder(ctx context.Context, folder *gtsmodel.BookmarkFolder, columns ...string) error
+
+	// DeleteBookmarkFolderByID deletes one bookmark folder by its db id.
+	// Bookmarks filed in the folder are not deleted, but are moved back
+	// into the default folder (ie., their folder ID is unset).
+	DeleteBookmarkFolderByID(ctx context.Context, id string) error
+
+	// DeleteBookmarkFoldersByAccountID deletes all
+	// bookmark folders owned by the given account ID.
+	DeleteBookmarkFoldersByAccountID(ctx context.Context, accountID string) error
+}
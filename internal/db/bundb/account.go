@@ -329,7 +329,10 @@ func (a *accountDB) GetAccounts(
 	displayName string,
 	domain string,
 	email string,
+	emailDomain string,
 	ip netip.Addr,
+	createdAfter time.Time,
+	createdBefore time.Time,
 	page *paging.Page,
 ) (
 	[]*gtsmodel.Account,
@@ -483,7 +486,29 @@ func (a *accountDB) GetAccounts(
 		useAccountIDIn = true
 	}
 
-	// TODO: invitedBy
+	if invitedBy != "" {
+		// Get only accounts that signed up
+		// with an invite by the given account.
+		invites, err := a.state.DB.GetInvites(ctx, invitedBy)
+		if err != nil {
+			return nil, err
+		}
+
+		inviteIDs := make(map[string]struct{}, len(invites))
+		for _, invite := range invites {
+			inviteIDs[invite.ID] = struct{}{}
+		}
+
+		if err := lazyLoadUsers(); err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			if _, ok := inviteIDs[user.InviteID]; ok {
+				accountIDIn = append(accountIDIn, user.AccountID)
+			}
+		}
+		useAccountIDIn = true
+	}
 
 	if username != "" {
 		q = q.Where("? = ?", bun.Ident("account.username"), username)
@@ -509,6 +534,30 @@ func (a *accountDB) GetAccounts(
 		useAccountIDIn = true
 	}
 
+	if emailDomain != "" {
+		// Get only accounts whose user has a confirmed
+		// or unconfirmed email address at this domain.
+		suffix := "%@" + strings.ToLower(emailDomain)
+		q = q.Where("? IN (?)",
+			bun.Ident("account.id"),
+			a.db.NewSelect().
+				TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+				Column("user.account_id").
+				WhereOr("LOWER(?) LIKE ?", bun.Ident("user.email"), suffix).
+				WhereOr("LOWER(?) LIKE ?", bun.Ident("user.unconfirmed_email"), suffix),
+		)
+	}
+
+	if !createdAfter.IsZero() {
+		// Get only accounts created after given time.
+		q = q.Where("? > ?", bun.Ident("account.created_at"), createdAfter)
+	}
+
+	if !createdBefore.IsZero() {
+		// Get only accounts created before given time.
+		q = q.Where("? < ?", bun.Ident("account.created_at"), createdBefore)
+	}
+
 	// Use ip if not zero value.
 	if ip.IsValid() {
 		if err := lazyLoadUsers(); err != nil {
@@ -714,6 +763,18 @@ func (a *accountDB) PopulateAccount(ctx context.Context, account *gtsmodel.Accou
 		}
 	}
 
+	if account.Operator == nil && account.OperatorURI != "" {
+		// Account operator is not set, try to fetch from database,
+		// but only error on real errors since this field is optional.
+		account.Operator, err = a.state.DB.GetAccountByURI(
+			gtscontext.SetBarebones(ctx),
+			account.OperatorURI,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating operator account: %w", err)
+		}
+	}
+
 	if !account.EmojisPopulated() {
 		// Account emojis are out-of-date with IDs, repopulate.
 		account.Emojis, err = a.state.DB.GetEmojisByIDs(
@@ -977,6 +1038,123 @@ func selectOnlyWithMedia(q *bun.SelectQuery, includeBoosts bool) *bun.SelectQuer
 	}
 }
 
+func (a *accountDB) GetSuspendedLocalAccounts(ctx context.Context, suspendedBefore time.Time, sinceID string, limit int) ([]*gtsmodel.Account, error) {
+	var ids []string
+
+	// Select IDs of local accounts suspended
+	// before the given time, which still have
+	// a user, ie., haven't been purged yet.
+	q := a.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? IS NOT NULL", bun.Ident("account.suspended_at")).
+		Where("? < ?", bun.Ident("account.suspended_at"), suspendedBefore).
+		Where("EXISTS (?)",
+			a.db.NewSelect().
+				TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+				Where("? = ?", bun.Ident("user.account_id"), bun.Ident("account.id")),
+		).
+		OrderExpr("? ASC", bun.Ident("account.id")).
+		Limit(limit)
+
+	if sinceID != "" {
+		q = q.Where("? > ?", bun.Ident("account.id"), sinceID)
+	}
+
+	if err := q.Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return a.GetAccountsByIDs(ctx, ids)
+}
+
+func (a *accountDB) GetIndexableLocalAccounts(ctx context.Context, sinceID string, limit int) ([]*gtsmodel.Account, error) {
+	var ids []string
+
+	// Select IDs of unsuspended local accounts
+	// that have opted in to being both discoverable
+	// and indexable, skipping the instance account.
+	q := a.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? = ?", bun.Ident("account.indexable"), true).
+		Where("? != ?", bun.Ident("account.username"), config.GetHost()).
+		OrderExpr("? ASC", bun.Ident("account.id")).
+		Limit(limit)
+
+	if sinceID != "" {
+		q = q.Where("? > ?", bun.Ident("account.id"), sinceID)
+	}
+
+	if err := q.Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return a.GetAccountsByIDs(ctx, ids)
+}
+
+func (a *accountDB) CountAccountStatusesBefore(ctx context.Context, accountID string, maxID string, publicOnly bool) (int, error) {
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? < ?", bun.Ident("status.id"), maxID).
+		Where("? IS NULL", bun.Ident("status.boost_of_id"))
+
+	if publicOnly {
+		q = q.Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic)
+	}
+
+	return q.Count(ctx)
+}
+
+func (a *accountDB) CountAccountPublicStatusesByDay(ctx context.Context, accountID string, since time.Time) ([]*gtsmodel.AccountActivityDay, error) {
+	// Expression to truncate a status'
+	// creation time to a UTC day, which
+	// must differ according to driver.
+	var dayExpr string
+	switch d := a.db.Dialect().Name(); d {
+	case dialect.PG:
+		dayExpr = "to_char(? AT TIME ZONE 'UTC', 'YYYY-MM-DD')"
+	case dialect.SQLite:
+		dayExpr = "strftime('%Y-%m-%d', ?)"
+	default:
+		panic("dialect " + d.String() + " was neither pg nor sqlite")
+	}
+
+	var days []*gtsmodel.AccountActivityDay
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		ColumnExpr(dayExpr+" AS ?", bun.Ident("status.created_at"), bun.Ident("day")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? >= ?", bun.Ident("status.created_at"), since).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		Where("? = ?", bun.Ident("status.pending_approval"), false).
+		GroupExpr("?", bun.Ident("day")).
+		OrderExpr("? ASC", bun.Ident("day")).
+		Scan(ctx, &days); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	return days, nil
+}
+
 func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, mediaOnly bool, publicOnly bool) ([]*gtsmodel.Status, error) {
 	// Ensure reasonable
 	if limit < 0 {
@@ -1611,6 +1789,31 @@ func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmode
 	return nil
 }
 
+func (a *accountDB) GetAccountStatsBatch(ctx context.Context, sinceAccountID string, limit int) ([]*gtsmodel.AccountStats, error) {
+	var stats []*gtsmodel.AccountStats
+
+	// Select the stats directly rather than via the
+	// cache, as callers want what's actually stored.
+	q := a.db.NewSelect().
+		Model(&stats).
+		OrderExpr("? ASC", bun.Ident("account_stats.account_id")).
+		Limit(limit)
+
+	if sinceAccountID != "" {
+		q = q.Where("? > ?", bun.Ident("account_stats.account_id"), sinceAccountID)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(stats) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return stats, nil
+}
+
 func (a *accountDB) UpdateAccountStats(ctx context.Context, stats *gtsmodel.AccountStats, columns ...string) error {
 	return a.state.Caches.DB.AccountStats.Store(stats, func() error {
 		if _, err := a.db.
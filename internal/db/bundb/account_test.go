@@ -35,6 +35,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -48,6 +49,39 @@ func (suite *AccountTestSuite) TestGetAccountStatuses() {
 	suite.Len(statuses, 9)
 }
 
+func (suite *AccountTestSuite) TestCountAccountPublicStatusesByDay() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Count public, non-boost, approved
+	// test statuses of the account by day.
+	expect := make(map[string]int)
+	for _, status := range suite.testStatuses {
+		if status.AccountID == testAccount.ID &&
+			status.Visibility == gtsmodel.VisibilityPublic &&
+			status.BoostOfID == "" &&
+			!util.PtrOrValue(status.PendingApproval, false) {
+			expect[status.CreatedAt.UTC().Format(time.DateOnly)]++
+		}
+	}
+
+	days, err := suite.db.CountAccountPublicStatusesByDay(suite.T().Context(), testAccount.ID, time.Time{})
+	suite.NoError(err)
+	suite.Len(days, len(expect))
+
+	for i, day := range days {
+		suite.Equal(expect[day.Day], day.Count, day.Day)
+		if i > 0 {
+			// Should be sorted oldest first.
+			suite.Less(days[i-1].Day, day.Day)
+		}
+	}
+
+	// Nothing is newer than now.
+	days, err = suite.db.CountAccountPublicStatusesByDay(suite.T().Context(), testAccount.ID, time.Now())
+	suite.NoError(err)
+	suite.Empty(days)
+}
+
 func (suite *AccountTestSuite) TestGetAccountWebStatuses() {
 	statuses, err := suite.db.GetAccountWebStatuses(suite.T().Context(), suite.testAccounts["admin_account"], &paging.Page{Limit: 20}, false, false)
 	suite.NoError(err)
@@ -651,17 +685,20 @@ func (suite *AccountTestSuite) TestPopulateAccountWithUnknownMovedToURI() {
 
 func (suite *AccountTestSuite) TestGetAccountsAll() {
 	var (
-		ctx         = suite.T().Context()
-		origin      = ""
-		status      = ""
-		mods        = false
-		invitedBy   = ""
-		username    = ""
-		displayName = ""
-		domain      = ""
-		email       = ""
-		ip          netip.Addr
-		page        *paging.Page = nil
+		ctx           = suite.T().Context()
+		origin        = ""
+		status        = ""
+		mods          = false
+		invitedBy     = ""
+		username      = ""
+		displayName   = ""
+		domain        = ""
+		email         = ""
+		emailDomain   = ""
+		ip            netip.Addr
+		createdAfter  time.Time
+		createdBefore time.Time
+		page          *paging.Page = nil
 	)
 
 	accounts, err := suite.db.GetAccounts(
@@ -674,7 +711,10 @@ func (suite *AccountTestSuite) TestGetAccountsAll() {
 		displayName,
 		domain,
 		email,
+		emailDomain,
 		ip,
+		createdAfter,
+		createdBefore,
 		page,
 	)
 	if err != nil {
@@ -686,16 +726,19 @@ func (suite *AccountTestSuite) TestGetAccountsAll() {
 
 func (suite *AccountTestSuite) TestGetAccountsMaxID() {
 	var (
-		ctx         = suite.T().Context()
-		origin      = ""
-		status      = ""
-		mods        = false
-		invitedBy   = ""
-		username    = ""
-		displayName = ""
-		domain      = ""
-		email       = ""
-		ip          netip.Addr
+		ctx           = suite.T().Context()
+		origin        = ""
+		status        = ""
+		mods          = false
+		invitedBy     = ""
+		username      = ""
+		displayName   = ""
+		domain        = ""
+		email         = ""
+		emailDomain   = ""
+		ip            netip.Addr
+		createdAfter  time.Time
+		createdBefore time.Time
 		// Get accounts with `[domain]/@[username]`
 		// later in the alphabet than `/@the_mighty_zork`.
 		page = &paging.Page{Max: paging.MaxID("/@the_mighty_zork")}
@@ -711,7 +754,10 @@ func (suite *AccountTestSuite) TestGetAccountsMaxID() {
 		displayName,
 		domain,
 		email,
+		emailDomain,
 		ip,
+		createdAfter,
+		createdBefore,
 		page,
 	)
 	if err != nil {
@@ -723,16 +769,19 @@ func (suite *AccountTestSuite) TestGetAccountsMaxID() {
 
 func (suite *AccountTestSuite) TestGetAccountsMinID() {
 	var (
-		ctx         = suite.T().Context()
-		origin      = ""
-		status      = ""
-		mods        = false
-		invitedBy   = ""
-		username    = ""
-		displayName = ""
-		domain      = ""
-		email       = ""
-		ip          netip.Addr
+		ctx           = suite.T().Context()
+		origin        = ""
+		status        = ""
+		mods          = false
+		invitedBy     = ""
+		username      = ""
+		displayName   = ""
+		domain        = ""
+		email         = ""
+		emailDomain   = ""
+		ip            netip.Addr
+		createdAfter  time.Time
+		createdBefore time.Time
 		// Get accounts with `[domain]/@[username]`
 		// earlier in the alphabet than `/@the_mighty_zork`.
 		page = &paging.Page{Min: paging.MinID("/@the_mighty_zork")}
@@ -748,7 +797,10 @@ func (suite *AccountTestSuite) TestGetAccountsMinID() {
 		displayName,
 		domain,
 		email,
+		emailDomain,
 		ip,
+		createdAfter,
+		createdBefore,
 		page,
 	)
 	if err != nil {
@@ -760,17 +812,20 @@ func (suite *AccountTestSuite) TestGetAccountsMinID() {
 
 func (suite *AccountTestSuite) TestGetAccountsModsOnly() {
 	var (
-		ctx         = suite.T().Context()
-		origin      = ""
-		status      = ""
-		mods        = true
-		invitedBy   = ""
-		username    = ""
-		displayName = ""
-		domain      = ""
-		email       = ""
-		ip          netip.Addr
-		page        = &paging.Page{
+		ctx           = suite.T().Context()
+		origin        = ""
+		status        = ""
+		mods          = true
+		invitedBy     = ""
+		username      = ""
+		displayName   = ""
+		domain        = ""
+		email         = ""
+		emailDomain   = ""
+		ip            netip.Addr
+		createdAfter  time.Time
+		createdBefore time.Time
+		page          = &paging.Page{
 			Limit: 100,
 		}
 	)
@@ -785,7 +840,10 @@ func (suite *AccountTestSuite) TestGetAccountsModsOnly() {
 		displayName,
 		domain,
 		email,
+		emailDomain,
 		ip,
+		createdAfter,
+		createdBefore,
 		page,
 	)
 	if err != nil {
@@ -797,17 +855,20 @@ func (suite *AccountTestSuite) TestGetAccountsModsOnly() {
 
 func (suite *AccountTestSuite) TestGetAccountsLocalWithEmail() {
 	var (
-		ctx         = suite.T().Context()
-		origin      = "local"
-		status      = ""
-		mods        = false
-		invitedBy   = ""
-		username    = ""
-		displayName = ""
-		domain      = ""
-		email       = "tortle.dude@example.org"
-		ip          netip.Addr
-		page        = &paging.Page{
+		ctx           = suite.T().Context()
+		origin        = "local"
+		status        = ""
+		mods          = false
+		invitedBy     = ""
+		username      = ""
+		displayName   = ""
+		domain        = ""
+		email         = "tortle.dude@example.org"
+		emailDomain   = ""
+		ip            netip.Addr
+		createdAfter  time.Time
+		createdBefore time.Time
+		page          = &paging.Page{
 			Limit: 100,
 		}
 	)
@@ -822,7 +883,10 @@ func (suite *AccountTestSuite) TestGetAccountsLocalWithEmail() {
 		displayName,
 		domain,
 		email,
+		emailDomain,
 		ip,
+		createdAfter,
+		createdBefore,
 		page,
 	)
 	if err != nil {
@@ -834,17 +898,20 @@ func (suite *AccountTestSuite) TestGetAccountsLocalWithEmail() {
 
 func (suite *AccountTestSuite) TestGetAccountsWithIP() {
 	var (
-		ctx         = suite.T().Context()
-		origin      = ""
-		status      = ""
-		mods        = false
-		invitedBy   = ""
-		username    = ""
-		displayName = ""
-		domain      = ""
-		email       = ""
-		ip          = netip.MustParseAddr("199.222.111.89")
-		page        = &paging.Page{
+		ctx           = suite.T().Context()
+		origin        = ""
+		status        = ""
+		mods          = false
+		invitedBy     = ""
+		username      = ""
+		displayName   = ""
+		domain        = ""
+		email         = ""
+		emailDomain   = ""
+		ip            = netip.MustParseAddr("199.222.111.89")
+		createdAfter  time.Time
+		createdBefore time.Time
+		page          = &paging.Page{
 			Limit: 100,
 		}
 	)
@@ -859,7 +926,10 @@ func (suite *AccountTestSuite) TestGetAccountsWithIP() {
 		displayName,
 		domain,
 		email,
+		emailDomain,
 		ip,
+		createdAfter,
+		createdBefore,
 		page,
 	)
 	if err != nil {
@@ -869,19 +939,81 @@ func (suite *AccountTestSuite) TestGetAccountsWithIP() {
 	suite.Len(accounts, 1)
 }
 
+func (suite *AccountTestSuite) TestGetAccountsByEmailDomainAndCreated() {
+	getUsernames := func(emailDomain string, createdAfter, createdBefore time.Time) []string {
+		accounts, err := suite.db.GetAccounts(
+			suite.T().Context(),
+			"",           // origin
+			"",           // status
+			false,        // mods
+			"",           // invitedBy
+			"",           // username
+			"",           // displayName
+			"",           // domain
+			"",           // email
+			emailDomain,  // emailDomain
+			netip.Addr{}, // ip
+			createdAfter,
+			createdBefore,
+			&paging.Page{Limit: 100},
+		)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		usernames := make([]string, len(accounts))
+		for i, account := range accounts {
+			usernames[i] = account.Username
+		}
+		return usernames
+	}
+
+	// Matches confirmed + unconfirmed
+	// emails, case-insensitively.
+	suite.Equal([]string{
+		"1happyturtle",
+		"admin",
+		"media_mogul",
+		"the_mighty_zork",
+		"weed_lord420",
+	}, getUsernames("EXAMPLE.org", time.Time{}, time.Time{}))
+
+	suite.Empty(getUsernames("example.com", time.Time{}, time.Time{}))
+
+	// Signed up between dates.
+	suite.Equal([]string{
+		"1happyturtle",
+		"the_mighty_zork",
+		"weed_lord420",
+	}, getUsernames("",
+		testrig.TimeMustParse("2022-05-18T00:00:00Z"),
+		testrig.TimeMustParse("2023-01-01T00:00:00Z"),
+	))
+
+	// Filters combine.
+	suite.Equal([]string{
+		"media_mogul",
+	}, getUsernames("example.org",
+		testrig.TimeMustParse("2025-01-01T00:00:00Z"),
+		time.Time{},
+	))
+}
+
 func (suite *AccountTestSuite) TestGetPendingAccounts() {
 	var (
-		ctx         = suite.T().Context()
-		origin      = ""
-		status      = "pending"
-		mods        = false
-		invitedBy   = ""
-		username    = ""
-		displayName = ""
-		domain      = ""
-		email       = ""
-		ip          netip.Addr
-		page        = &paging.Page{
+		ctx           = suite.T().Context()
+		origin        = ""
+		status        = "pending"
+		mods          = false
+		invitedBy     = ""
+		username      = ""
+		displayName   = ""
+		domain        = ""
+		email         = ""
+		emailDomain   = ""
+		ip            netip.Addr
+		createdAfter  time.Time
+		createdBefore time.Time
+		page          = &paging.Page{
 			Limit: 100,
 		}
 	)
@@ -896,7 +1028,10 @@ func (suite *AccountTestSuite) TestGetPendingAccounts() {
 		displayName,
 		domain,
 		email,
+		emailDomain,
 		ip,
+		createdAfter,
+		createdBefore,
 		page,
 	)
 	if err != nil {
@@ -906,6 +1041,29 @@ func (suite *AccountTestSuite) TestGetPendingAccounts() {
 	suite.Len(accounts, 1)
 }
 
+func (suite *AccountTestSuite) TestGetIndexableLocalAccounts() {
+	ctx := suite.T().Context()
+
+	accounts, err := suite.db.GetIndexableLocalAccounts(ctx, "", 100)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	usernames := make([]string, len(accounts))
+	for i, account := range accounts {
+		usernames[i] = account.Username
+	}
+
+	// Instance account, remote accounts, and local
+	// accounts that haven't opted in are excluded.
+	suite.Equal([]string{"admin", "the_mighty_zork"}, usernames)
+
+	// Paging past the last
+	// account gives nothing.
+	_, err = suite.db.GetIndexableLocalAccounts(ctx, accounts[len(accounts)-1].ID, 100)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func (suite *AccountTestSuite) TestAccountStatsAll() {
 	ctx := suite.T().Context()
 	for _, account := range suite.testAccounts {
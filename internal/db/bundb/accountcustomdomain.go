@@ -0,0 +1,230 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type accountCustomDomainDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *accountCustomDomainDB) GetAccountCustomDomainByID(ctx context.Context, id string) (*gtsmodel.AccountCustomDomain, error) {
+	return a.getAccountCustomDomain(ctx, "id", id)
+}
+
+func (a *accountCustomDomainDB) GetAccountCustomDomainByDomain(ctx context.Context, domain string) (*gtsmodel.AccountCustomDomain, error) {
+	return a.getAccountCustomDomain(ctx, "domain", domain)
+}
+
+func (a *accountCustomDomainDB) GetAccountCustomDomainByAccountID(ctx context.Context, accountID string) (*gtsmodel.AccountCustomDomain, error) {
+	return a.getAccountCustomDomain(ctx, "account_id", accountID)
+}
+
+func (a *accountCustomDomainDB) getAccountCustomDomain(ctx context.Context, column string, value string) (*gtsmodel.AccountCustomDomain, error) {
+	domain := new(gtsmodel.AccountCustomDomain)
+
+	if err := a.db.
+		NewSelect().
+		Model(domain).
+		Where("? = ?", bun.Ident("account_custom_domain."+column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return domain, nil
+	}
+
+	// Further populate the domain fields where applicable.
+	if err := a.PopulateAccountCustomDomain(ctx, domain); err != nil {
+		return nil, err
+	}
+
+	return domain, nil
+}
+
+func (a *accountCustomDomainDB) GetAccountCustomDomains(ctx context.Context, approved *bool, page *paging.Page) ([]*gtsmodel.AccountCustomDomain, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		domainIDs = make([]string, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_custom_domains"), bun.Ident("account_custom_domain")).
+		// Select only IDs from table.
+		Column("account_custom_domain.id")
+
+	if approved != nil {
+		if *approved {
+			q = q.Where("? IS NOT NULL", bun.Ident("account_custom_domain.approved_at"))
+		} else {
+			q = q.Where("? IS NULL", bun.Ident("account_custom_domain.approved_at"))
+		}
+	}
+
+	// Return only domains with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account_custom_domain.id"), maxID)
+	}
+
+	// Return only domains with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("account_custom_domain.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// domains returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("account_custom_domain.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("account_custom_domain.id"))
+	}
+
+	if err := q.Scan(ctx, &domainIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no domains early
+	if len(domainIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want domains
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(domainIDs)
+	}
+
+	// Allocate return slice (will be at most len domainIDs)
+	domains := make([]*gtsmodel.AccountCustomDomain, 0, len(domainIDs))
+	for _, id := range domainIDs {
+		domain, err := a.GetAccountCustomDomainByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting account custom domain %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice
+		domains = append(domains, domain)
+	}
+
+	return domains, nil
+}
+
+func (a *accountCustomDomainDB) PopulateAccountCustomDomain(ctx context.Context, domain *gtsmodel.AccountCustomDomain) error {
+	var (
+		errs gtserror.MultiError
+		err  error
+	)
+
+	if domain.Account == nil {
+		// Domain account is not set, fetch from the database.
+		domain.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			domain.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating domain account: %w", err)
+		}
+	}
+
+	if domain.ApprovedByAccountID != "" && domain.ApprovedByAccount == nil {
+		// Domain approver is not set, fetch from the database.
+		domain.ApprovedByAccount, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			domain.ApprovedByAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating domain approved by account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (a *accountCustomDomainDB) PutAccountCustomDomain(ctx context.Context, domain *gtsmodel.AccountCustomDomain) error {
+	_, err := a.db.
+		NewInsert().
+		Model(domain).
+		Exec(ctx)
+	return err
+}
+
+func (a *accountCustomDomainDB) UpdateAccountCustomDomain(ctx context.Context, domain *gtsmodel.AccountCustomDomain, columns ...string) error {
+	domain.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.
+		NewUpdate().
+		Model(domain).
+		Where("? = ?", bun.Ident("account_custom_domain.id"), domain.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (a *accountCustomDomainDB) DeleteAccountCustomDomainByID(ctx context.Context, id string) error {
+	_, err := a.db.
+		NewDelete().
+		Table("account_custom_domains").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (a *accountCustomDomainDB) DeleteAccountCustomDomainByAccountID(ctx context.Context, accountID string) error {
+	_, err := a.db.
+		NewDelete().
+		Table("account_custom_domains").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
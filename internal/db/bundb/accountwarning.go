@@ -0,0 +1,192 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type accountWarningDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *accountWarningDB) GetAccountWarningByID(ctx context.Context, id string) (*gtsmodel.AccountWarning, error) {
+	warning := new(gtsmodel.AccountWarning)
+
+	if err := a.db.
+		NewSelect().
+		Model(warning).
+		Where("? = ?", bun.Ident("account_warning.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return warning, nil
+	}
+
+	// Further populate the warning fields where applicable.
+	if err := a.PopulateAccountWarning(ctx, warning); err != nil {
+		return nil, err
+	}
+
+	return warning, nil
+}
+
+func (a *accountWarningDB) GetAccountWarnings(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.AccountWarning, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		warningIDs = make([]string, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_warnings"), bun.Ident("account_warning")).
+		// Select only IDs from table.
+		Column("account_warning.id").
+		Where("? = ?", bun.Ident("account_warning.account_id"), accountID)
+
+	// Return only warnings with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account_warning.id"), maxID)
+	}
+
+	// Return only warnings with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("account_warning.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// warnings returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("account_warning.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("account_warning.id"))
+	}
+
+	if err := q.Scan(ctx, &warningIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no warnings early
+	if len(warningIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want warnings
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(warningIDs)
+	}
+
+	// Allocate return slice (will be at most len warningIDs)
+	warnings := make([]*gtsmodel.AccountWarning, 0, len(warningIDs))
+	for _, id := range warningIDs {
+		warning, err := a.GetAccountWarningByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting account warning %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice
+		warnings = append(warnings, warning)
+	}
+
+	return warnings, nil
+}
+
+func (a *accountWarningDB) CountAccountWarnings(ctx context.Context, accountID string) (int, error) {
+	return a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_warnings"), bun.Ident("account_warning")).
+		Where("? = ?", bun.Ident("account_warning.account_id"), accountID).
+		Count(ctx)
+}
+
+func (a *accountWarningDB) PopulateAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) error {
+	var (
+		errs gtserror.MultiError
+		err  error
+	)
+
+	if warning.Account == nil {
+		// Warning target account is not set, fetch from the database.
+		warning.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			warning.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating warning account: %w", err)
+		}
+	}
+
+	if warning.ByAccount == nil {
+		// Warning issuer account is not set, fetch from the database.
+		warning.ByAccount, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			warning.ByAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating warning by account: %w", err)
+		}
+	}
+
+	if l := len(warning.RuleIDs); l > 0 && l != len(warning.Rules) {
+		// Warning rules are not set, fetch from the database.
+		warning.Rules, err = a.state.DB.GetRulesByIDs(ctx, warning.RuleIDs)
+		if err != nil {
+			errs.Appendf("error populating warning rules: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (a *accountWarningDB) PutAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) error {
+	_, err := a.db.
+		NewInsert().
+		Model(warning).
+		Exec(ctx)
+	return err
+}
@@ -225,12 +225,19 @@ func (a *adminDB) NewSignup(ctx context.Context, newSignup gtsmodel.NewSignup) (
 		EncryptedPassword:      string(encryptedPassword),
 		SignUpIP:               newSignup.SignUpIP.To4(),
 		Reason:                 newSignup.Reason,
+		InviteID:               newSignup.InviteID,
 		Locale:                 account.Settings.Language,
 		UnconfirmedEmail:       newSignup.Email,
 		CreatedByApplicationID: newSignup.AppID,
 		ExternalID:             newSignup.ExternalID,
 	}
 
+	if len(newSignup.RuleIDs) > 0 {
+		// Record acknowledged rules.
+		user.RulesAcknowledgedIDs = newSignup.RuleIDs
+		user.RulesAcknowledgedAt = time.Now()
+	}
+
 	if newSignup.EmailVerified {
 		// Mark given email as confirmed.
 		user.ConfirmedAt = time.Now()
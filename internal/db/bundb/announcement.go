@@ -0,0 +1,234 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type announcementDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *announcementDB) GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error) {
+	announcement := new(gtsmodel.Announcement)
+
+	if err := a.db.
+		NewSelect().
+		Model(announcement).
+		Where("? = ?", bun.Ident("announcement.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return announcement, nil
+}
+
+func (a *announcementDB) GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	var announcements []*gtsmodel.Announcement
+
+	if err := a.db.
+		NewSelect().
+		Model(&announcements).
+		OrderExpr("? DESC", bun.Ident("announcement.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) GetActiveAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	var announcements []*gtsmodel.Announcement
+
+	if err := a.db.
+		NewSelect().
+		Model(&announcements).
+		Where("? = ?", bun.Ident("announcement.published"), true).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("announcement.ends_at")).
+				WhereOr("? > ?", bun.Ident("announcement.ends_at"), time.Now())
+		}).
+		OrderExpr("? ASC", bun.Ident("announcement.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	_, err := a.db.
+		NewInsert().
+		Model(announcement).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error {
+	announcement.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.
+		NewUpdate().
+		Model(announcement).
+		Where("? = ?", bun.Ident("announcement.id"), announcement.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementByID(ctx context.Context, id string) error {
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			Table("announcement_reads").
+			Where("? = ?", bun.Ident("announcement_id"), id).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting announcement reads: %w", err)
+		}
+
+		if _, err := tx.
+			NewDelete().
+			Table("announcement_reactions").
+			Where("? = ?", bun.Ident("announcement_id"), id).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting announcement reactions: %w", err)
+		}
+
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("announcements"), bun.Ident("announcement")).
+			Where("? = ?", bun.Ident("announcement.id"), id).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting announcement: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (a *announcementDB) GetReadAnnouncementIDs(ctx context.Context, accountID string) ([]string, error) {
+	var announcementIDs []string
+
+	if err := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("announcement_reads"), bun.Ident("announcement_read")).
+		Column("announcement_read.announcement_id").
+		Where("? = ?", bun.Ident("announcement_read.account_id"), accountID).
+		Scan(ctx, &announcementIDs); err != nil {
+		return nil, err
+	}
+
+	return announcementIDs, nil
+}
+
+func (a *announcementDB) PutAnnouncementRead(ctx context.Context, read *gtsmodel.AnnouncementRead) error {
+	_, err := a.db.
+		NewInsert().
+		Model(read).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("announcement_id"), bun.Ident("account_id")).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error) {
+	var reactions []*gtsmodel.AnnouncementReaction
+
+	if err := a.db.
+		NewSelect().
+		Model(&reactions).
+		Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), announcementID).
+		OrderExpr("? ASC", bun.Ident("announcement_reaction.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, reaction := range reactions {
+		if reaction.EmojiID == "" {
+			// Unicode emoji,
+			// nothing to do.
+			continue
+		}
+
+		var err error
+
+		// Populate the custom emoji of this reaction.
+		reaction.Emoji, err = a.state.DB.GetEmojiByID(ctx, reaction.EmojiID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "error getting emoji %s of announcement reaction %s: %v", reaction.EmojiID, reaction.ID, err)
+		}
+	}
+
+	return reactions, nil
+}
+
+func (a *announcementDB) PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error {
+	_, err := a.db.
+		NewInsert().
+		Model(reaction).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) error {
+	_, err := a.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("announcement_reactions"), bun.Ident("announcement_reaction")).
+		Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("announcement_reaction.account_id"), accountID).
+		Where("? = ?", bun.Ident("announcement_reaction.name"), name).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementReadsAndReactionsByAccountID(ctx context.Context, accountID string) error {
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			Table("announcement_reads").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting announcement reads: %w", err)
+		}
+
+		if _, err := tx.
+			NewDelete().
+			Table("announcement_reactions").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting announcement reactions: %w", err)
+		}
+
+		return nil
+	})
+}
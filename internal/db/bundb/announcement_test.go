@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type AnnouncementTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) putAnnouncement(id string, published bool, endsAt time.Time) *gtsmodel.Announcement {
+	announcement := &gtsmodel.Announcement{
+		ID:        id,
+		EndsAt:    endsAt,
+		AllDay:    util.Ptr(false),
+		Published: util.Ptr(published),
+		Text:      "hello world",
+		Content:   "<p>hello world</p>",
+	}
+
+	if err := suite.db.PutAnnouncement(suite.T().Context(), announcement); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return announcement
+}
+
+func (suite *AnnouncementTestSuite) TestGetActiveAnnouncements() {
+	ctx := suite.T().Context()
+
+	suite.putAnnouncement("01K7PCZ6Z8D2YJ0W3W0X9Y6Q4A", true, time.Time{})
+	suite.putAnnouncement("01K7PCZ6Z8D2YJ0W3W0X9Y6Q4B", false, time.Time{})
+	suite.putAnnouncement("01K7PCZ6Z8D2YJ0W3W0X9Y6Q4C", true, time.Now().Add(-time.Hour))
+	suite.putAnnouncement("01K7PCZ6Z8D2YJ0W3W0X9Y6Q4D", true, time.Now().Add(time.Hour))
+
+	// Only published, unexpired
+	// announcements, oldest first.
+	active, err := suite.db.GetActiveAnnouncements(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(active, 2)
+	suite.Equal("01K7PCZ6Z8D2YJ0W3W0X9Y6Q4A", active[0].ID)
+	suite.Equal("01K7PCZ6Z8D2YJ0W3W0X9Y6Q4D", active[1].ID)
+
+	// All announcements, newest first.
+	all, err := suite.db.GetAnnouncements(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(all, 4)
+	suite.Equal("01K7PCZ6Z8D2YJ0W3W0X9Y6Q4D", all[0].ID)
+}
+
+func (suite *AnnouncementTestSuite) TestReadsAndReactions() {
+	ctx := suite.T().Context()
+
+	announcement := suite.putAnnouncement("01K7PCZ6Z8D2YJ0W3W0X9Y6Q4E", true, time.Time{})
+	account := suite.testAccounts["local_account_1"]
+	emoji := suite.testEmojis["rainbow"]
+
+	// Marking as read twice should be fine.
+	for _, id := range []string{
+		"01K7PD3F4N6V8Q2M1K9J7H5G3F",
+		"01K7PD3F4N6V8Q2M1K9J7H5G3G",
+	} {
+		if err := suite.db.PutAnnouncementRead(ctx, &gtsmodel.AnnouncementRead{
+			ID:             id,
+			AnnouncementID: announcement.ID,
+			AccountID:      account.ID,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	readIDs, err := suite.db.GetReadAnnouncementIDs(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{announcement.ID}, readIDs)
+
+	reaction := &gtsmodel.AnnouncementReaction{
+		ID:             "01K7PD3F4N6V8Q2M1K9J7H5G3H",
+		AnnouncementID: announcement.ID,
+		AccountID:      account.ID,
+		Name:           emoji.Shortcode,
+		EmojiID:        emoji.ID,
+	}
+	if err := suite.db.PutAnnouncementReaction(ctx, reaction); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Same reaction again should be rejected.
+	reaction.ID = "01K7PD3F4N6V8Q2M1K9J7H5G3J"
+	err = suite.db.PutAnnouncementReaction(ctx, reaction)
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	reactions, err := suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(reactions, 1)
+	suite.NotNil(reactions[0].Emoji)
+
+	// Deleting the announcement
+	// should clean up the rest.
+	if err := suite.db.DeleteAnnouncementByID(ctx, announcement.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetAnnouncementByID(ctx, announcement.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	readIDs, err = suite.db.GetReadAnnouncementIDs(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(readIDs)
+
+	reactions, err = suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	suite.NoError(err)
+	suite.Empty(reactions)
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementTestSuite))
+}
@@ -0,0 +1,232 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type appealDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *appealDB) GetAppealByID(ctx context.Context, id string) (*gtsmodel.Appeal, error) {
+	return a.getAppeal(ctx, func(appeal *gtsmodel.Appeal) error {
+		return a.db.
+			NewSelect().
+			Model(appeal).
+			Where("? = ?", bun.Ident("appeal.id"), id).
+			Scan(ctx)
+	})
+}
+
+func (a *appealDB) GetAppealByAccountWarningID(ctx context.Context, accountWarningID string) (*gtsmodel.Appeal, error) {
+	return a.getAppeal(ctx, func(appeal *gtsmodel.Appeal) error {
+		return a.db.
+			NewSelect().
+			Model(appeal).
+			Where("? = ?", bun.Ident("appeal.account_warning_id"), accountWarningID).
+			Scan(ctx)
+	})
+}
+
+func (a *appealDB) GetAppeals(ctx context.Context, resolved *bool, accountID string, page *paging.Page) ([]*gtsmodel.Appeal, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		appealIDs = make([]string, 0, limit)
+	)
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("appeals"), bun.Ident("appeal")).
+		// Select only IDs from table.
+		Column("appeal.id")
+
+	if resolved != nil {
+		i := bun.Ident("appeal.action_taken_by_account_id")
+		if *resolved {
+			q = q.Where("? IS NOT NULL", i)
+		} else {
+			q = q.Where("? IS NULL", i)
+		}
+	}
+
+	if accountID != "" {
+		q = q.Where("? = ?", bun.Ident("appeal.account_id"), accountID)
+	}
+
+	// Return only appeals with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("appeal.id"), maxID)
+	}
+
+	// Return only appeals with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("appeal.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// appeals returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("appeal.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("appeal.id"))
+	}
+
+	if err := q.Scan(ctx, &appealIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no appeals early
+	if len(appealIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want appeals
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(appealIDs)
+	}
+
+	// Allocate return slice (will be at most len appealIDs)
+	appeals := make([]*gtsmodel.Appeal, 0, len(appealIDs))
+	for _, id := range appealIDs {
+		appeal, err := a.GetAppealByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting appeal %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice
+		appeals = append(appeals, appeal)
+	}
+
+	return appeals, nil
+}
+
+func (a *appealDB) getAppeal(ctx context.Context, dbQuery func(*gtsmodel.Appeal) error) (*gtsmodel.Appeal, error) {
+	appeal := new(gtsmodel.Appeal)
+
+	if err := dbQuery(appeal); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return appeal, nil
+	}
+
+	// Further populate the appeal fields where applicable.
+	if err := a.PopulateAppeal(ctx, appeal); err != nil {
+		return nil, err
+	}
+
+	return appeal, nil
+}
+
+func (a *appealDB) PopulateAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error {
+	var (
+		errs gtserror.MultiError
+		err  error
+	)
+
+	if appeal.Account == nil {
+		// Appeal account is not set, fetch from the database.
+		appeal.Account, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			appeal.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating appeal account: %w", err)
+		}
+	}
+
+	if appeal.AccountWarningID != "" && appeal.AccountWarning == nil {
+		// Appealed warning is not set, fetch from the database.
+		appeal.AccountWarning, err = a.state.DB.GetAccountWarningByID(
+			gtscontext.SetBarebones(ctx),
+			appeal.AccountWarningID,
+		)
+		if err != nil {
+			errs.Appendf("error populating appeal account warning: %w", err)
+		}
+	}
+
+	if appeal.ActionTakenByAccountID != "" && appeal.ActionTakenByAccount == nil {
+		// Appeal action taker is not set, fetch from the database.
+		appeal.ActionTakenByAccount, err = a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			appeal.ActionTakenByAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating appeal action taken by account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (a *appealDB) PutAppeal(ctx context.Context, appeal *gtsmodel.Appeal) error {
+	_, err := a.db.
+		NewInsert().
+		Model(appeal).
+		Exec(ctx)
+	return err
+}
+
+func (a *appealDB) UpdateAppeal(ctx context.Context, appeal *gtsmodel.Appeal, columns ...string) error {
+	// Update the appeal's last-updated
+	appeal.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.
+		NewUpdate().
+		Model(appeal).
+		Where("? = ?", bun.Ident("appeal.id"), appeal.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type blocklistSubscriptionDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (b *blocklistSubscriptionDB) GetBlocklistSubscriptionByID(ctx context.Context, id string) (*gtsmodel.BlocklistSubscription, error) {
+	sub := new(gtsmodel.BlocklistSubscription)
+	if err := b.db.
+		NewSelect().
+		Model(sub).
+		Where("? = ?", bun.Ident("blocklist_subscription.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (b *blocklistSubscriptionDB) GetBlocklistSubscriptionByURI(ctx context.Context, accountID string, uri string) (*gtsmodel.BlocklistSubscription, error) {
+	sub := new(gtsmodel.BlocklistSubscription)
+	if err := b.db.
+		NewSelect().
+		Model(sub).
+		Where("? = ?", bun.Ident("blocklist_subscription.account_id"), accountID).
+		Where("? = ?", bun.Ident("blocklist_subscription.uri"), uri).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (b *blocklistSubscriptionDB) GetBlocklistSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.BlocklistSubscription, error) {
+	var subs []*gtsmodel.BlocklistSubscription
+	if err := b.db.
+		NewSelect().
+		Model(&subs).
+		Where("? = ?", bun.Ident("blocklist_subscription.account_id"), accountID).
+		Order("blocklist_subscription.id ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+func (b *blocklistSubscriptionDB) GetBlocklistSubscriptions(ctx context.Context) ([]*gtsmodel.BlocklistSubscription, error) {
+	var subs []*gtsmodel.BlocklistSubscription
+	if err := b.db.
+		NewSelect().
+		Model(&subs).
+		Order("blocklist_subscription.id ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+func (b *blocklistSubscriptionDB) PutBlocklistSubscription(ctx context.Context, sub *gtsmodel.BlocklistSubscription) error {
+	_, err := b.db.
+		NewInsert().
+		Model(sub).
+		Exec(ctx)
+	return err
+}
+
+func (b *blocklistSubscriptionDB) UpdateBlocklistSubscription(ctx context.Context, sub *gtsmodel.BlocklistSubscription, cols ...string) error {
+	sub.UpdatedAt = time.Now()
+	if len(cols) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		cols = append(cols, "updated_at")
+	}
+
+	_, err := b.db.
+		NewUpdate().
+		Model(sub).
+		Column(cols...).
+		Where("? = ?", bun.Ident("blocklist_subscription.id"), sub.ID).
+		Exec(ctx)
+	return err
+}
+
+func (b *blocklistSubscriptionDB) DeleteBlocklistSubscriptionByID(ctx context.Context, id string) error {
+	_, err := b.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("blocklist_subscriptions"), bun.Ident("blocklist_subscription")).
+		Where("? = ?", bun.Ident("blocklist_subscription.id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (b *blocklistSubscriptionDB) DeleteBlocklistSubscriptionsByAccountID(ctx context.Context, accountID string) error {
+	_, err := b.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("blocklist_subscriptions"), bun.Ident("blocklist_subscription")).
+		Where("? = ?", bun.Ident("blocklist_subscription.account_id"), accountID).
+		Exec(ctx)
+	return err
+}
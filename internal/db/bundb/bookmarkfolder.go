@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type bookmarkFolderDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (b *bookmarkFolderDB) GetBookmarkFolderByID(ctx context.Context, id string) (*gtsmodel.BookmarkFolder, error) {
+	folder := new(gtsmodel.BookmarkFolder)
+
+	if err := b.db.
+		NewSelect().
+		Model(folder).
+		Where("? = ?", bun.Ident("bookmark_folder.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return folder, nil
+}
+
+func (b *bookmarkFolderDB) GetBookmarkFoldersByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.BookmarkFolder, error) {
+	var folders []*gtsmodel.BookmarkFolder
+
+	if err := b.db.
+		NewSelect().
+		Model(&folders).
+		Where("? = ?", bun.Ident("bookmark_folder.account_id"), accountID).
+		OrderExpr("? ASC", bun.Ident("bookmark_folder.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return folders, nil
+}
+
+func (b *bookmarkFolderDB) PutBookmarkFolder(ctx context.Context, folder *gtsmodel.BookmarkFolder) error {
+	_, err := b.db.
+		NewInsert().
+		Model(folder).
+		Exec(ctx)
+	return err
+}
+
+func (b *bookmarkFolderDB) UpdateBookmarkFolder(ctx context.Context, folder *gtsmodel.BookmarkFolder, columns ...string) error {
+	folder.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := b.db.
+		NewUpdate().
+		Model(folder).
+		Where("? = ?", bun.Ident("bookmark_folder.id"), folder.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (b *bookmarkFolderDB) DeleteBookmarkFolderByID(ctx context.Context, id string) error {
+	// IDs of bookmarks
+	// moved out of folder.
+	var bookmarkIDs []string
+
+	if err := b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Move bookmarks filed in this
+		// folder back to the default folder.
+		if _, err := tx.
+			NewUpdate().
+			Table("status_bookmarks").
+			Set("? = NULL", bun.Ident("folder_id")).
+			Where("? = ?", bun.Ident("folder_id"), id).
+			Returning("?", bun.Ident("id")).
+			Exec(ctx, &bookmarkIDs); err != nil {
+			return gtserror.Newf("error unfiling bookmarks: %w", err)
+		}
+
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("bookmark_folders"), bun.Ident("bookmark_folder")).
+			Where("? = ?", bun.Ident("bookmark_folder.id"), id).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error deleting bookmark folder: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Invalidate cached bookmarks whose folder ID changed.
+	b.state.Caches.DB.StatusBookmark.InvalidateIDs("ID", bookmarkIDs)
+
+	return nil
+}
+
+func (b *bookmarkFolderDB) DeleteBookmarkFoldersByAccountID(ctx context.Context, accountID string) error {
+	// Bookmarks of this account
+	// are deleted separately, so
+	// just delete the folders.
+	_, err := b.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("bookmark_folders"), bun.Ident("bookmark_folder")).
+		Where("? = ?", bun.Ident("bookmark_folder.account_id"), accountID).
+		Exec(ctx)
+	return err
+}
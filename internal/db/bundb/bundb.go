@@ -41,15 +41,25 @@
 // DBService satisfies the DB interface
 type DBService struct {
 	db.Account
+	db.AccountCustomDomain
+	db.AccountWarning
 	db.Admin
 	db.AdvancedMigration
+	db.Announcement
+	db.Appeal
 	db.Application
 	db.Basic
+	db.BlocklistSubscription
+	db.BookmarkFolder
+	db.Card
 	db.Conversation
 	db.Domain
 	db.Emoji
+	db.FailedActivity
+	db.FederationStat
 	db.HeaderFilter
 	db.Instance
+	db.Invite
 	db.Interaction
 	db.Filter
 	db.List
@@ -60,20 +70,24 @@ type DBService struct {
 	db.Notification
 	db.Poll
 	db.Relationship
+	db.Relay
 	db.Report
 	db.Rule
 	db.ScheduledStatus
 	db.Search
+	db.SeenActivity
 	db.Session
 	db.SinBinStatus
 	db.Status
 	db.StatusBookmark
+	db.StatusCleanup
 	db.StatusEdit
 	db.StatusFave
 	db.Tag
 	db.Thread
 	db.Timeline
 	db.User
+	db.UserLogin
 	db.Tombstone
 	db.WebPush
 	db.WorkerTask
@@ -165,6 +179,14 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		AccountCustomDomain: &accountCustomDomainDB{
+			db:    db,
+			state: state,
+		},
+		AccountWarning: &accountWarningDB{
+			db:    db,
+			state: state,
+		},
 		Admin: &adminDB{
 			db:    db,
 			state: state,
@@ -173,6 +195,14 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Announcement: &announcementDB{
+			db:    db,
+			state: state,
+		},
+		Appeal: &appealDB{
+			db:    db,
+			state: state,
+		},
 		Application: &applicationDB{
 			db:    db,
 			state: state,
@@ -180,6 +210,18 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		Basic: &basicDB{
 			db: db,
 		},
+		BlocklistSubscription: &blocklistSubscriptionDB{
+			db:    db,
+			state: state,
+		},
+		BookmarkFolder: &bookmarkFolderDB{
+			db:    db,
+			state: state,
+		},
+		Card: &cardDB{
+			db:    db,
+			state: state,
+		},
 		Conversation: &conversationDB{
 			db:    db,
 			state: state,
@@ -192,6 +234,14 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		FailedActivity: &failedActivityDB{
+			db:    db,
+			state: state,
+		},
+		FederationStat: &federationStatDB{
+			db:    db,
+			state: state,
+		},
 		HeaderFilter: &headerFilterDB{
 			db:    db,
 			state: state,
@@ -200,6 +250,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Invite: &inviteDB{
+			db:    db,
+			state: state,
+		},
 		Interaction: &interactionDB{
 			db:    db,
 			state: state,
@@ -240,6 +294,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Relay: &relayDB{
+			db:    db,
+			state: state,
+		},
 		Report: &reportDB{
 			db:    db,
 			state: state,
@@ -256,6 +314,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		SeenActivity: &seenActivityDB{
+			db:    db,
+			state: state,
+		},
 		Session: &sessionDB{
 			db: db,
 		},
@@ -271,6 +333,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		StatusCleanup: &statusCleanupDB{
+			db:    db,
+			state: state,
+		},
 		StatusEdit: &statusEditDB{
 			db:    db,
 			state: state,
@@ -295,6 +361,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		UserLogin: &userLoginDB{
+			db:    db,
+			state: state,
+		},
 		Tombstone: &tombstoneDB{
 			db:    db,
 			state: state,
@@ -0,0 +1,257 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type cardDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (c *cardDB) GetCardByID(ctx context.Context, id string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"ID",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (c *cardDB) GetCardByURL(ctx context.Context, url string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"URL",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.url"), url).
+				Scan(ctx)
+		},
+		url,
+	)
+}
+
+func (c *cardDB) GetCardByImageAttachmentID(ctx context.Context, id string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"ImageAttachmentID",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.image_attachment_id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (c *cardDB) getCard(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Card) error, keyParts ...any) (*gtsmodel.Card, error) {
+	card, err := c.state.Caches.DB.Card.LoadOne(lookup, func() (*gtsmodel.Card, error) {
+		var card gtsmodel.Card
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&card); err != nil {
+			return nil, err
+		}
+
+		return &card, nil
+	}, keyParts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return card, nil
+	}
+
+	if err := c.PopulateCard(ctx, card); err != nil {
+		log.Errorf(ctx, "error populating card %s: %v", card.ID, err)
+	}
+
+	return card, nil
+}
+
+func (c *cardDB) PopulateCard(ctx context.Context, card *gtsmodel.Card) error {
+	var err error
+
+	if card.ImageAttachmentID != "" && card.ImageAttachment == nil {
+		// Card image is not set, fetch from database.
+		card.ImageAttachment, err = c.state.DB.GetAttachmentByID(
+			gtscontext.SetBarebones(ctx),
+			card.ImageAttachmentID,
+		)
+		if err != nil {
+			return gtserror.Newf("error populating card image: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *cardDB) PutCard(ctx context.Context, card *gtsmodel.Card) error {
+	return c.state.Caches.DB.Card.Store(card, func() error {
+		_, err := c.db.NewInsert().Model(card).Exec(ctx)
+		return err
+	})
+}
+
+func (c *cardDB) UpdateCard(ctx context.Context, card *gtsmodel.Card, cols ...string) error {
+	card.UpdatedAt = time.Now()
+	if len(cols) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		cols = append(cols, "updated_at")
+	}
+
+	return c.state.Caches.DB.Card.Store(card, func() error {
+		_, err := c.db.NewUpdate().
+			Model(card).
+			Column(cols...).
+			Where("? = ?", bun.Ident("card.id"), card.ID).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (c *cardDB) GetTrendingCards(ctx context.Context, days int, limit int) ([]*gtsmodel.CardTrend, error) {
+	const day = 24 * time.Hour
+
+	// Count days from midnight (UTC),
+	// today being the newest day.
+	today := time.Now().UTC().Truncate(day)
+	since := today.AddDate(0, 0, 1-days)
+
+	// Statuses created before the window
+	// can be skipped by ID, as IDs are ULIDs.
+	minID := id.NewULIDFromTime(since)
+
+	// Select the cards shared by the most accounts.
+	var cardIDs []string
+	if err := c.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.card_id").
+		Where("? > ?", bun.Ident("status.id"), minID).
+		Where("? IS NOT NULL", bun.Ident("status.card_id")).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		Group("status.card_id").
+		OrderExpr("COUNT(DISTINCT ?) DESC", bun.Ident("status.account_id")).
+		OrderExpr("COUNT(*) DESC").
+		Limit(limit).
+		Scan(ctx, &cardIDs); err != nil {
+		return nil, err
+	}
+
+	if len(cardIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select the statuses sharing those
+	// cards, to count shares for each day.
+	var shares []struct {
+		CardID    string
+		AccountID string
+		CreatedAt time.Time
+	}
+	if err := c.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.card_id", "status.account_id", "status.created_at").
+		Where("? > ?", bun.Ident("status.id"), minID).
+		Where("? IN (?)", bun.Ident("status.card_id"), bun.In(cardIDs)).
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		Scan(ctx, &shares); err != nil {
+		return nil, err
+	}
+
+	type cardDay struct {
+		cardID string
+		day    int
+	}
+
+	uses := make(map[cardDay]int)
+	accounts := make(map[cardDay]map[string]struct{})
+
+	for _, share := range shares {
+		// Days ago the status was created.
+		created := share.CreatedAt.UTC().Truncate(day)
+		key := cardDay{share.CardID, int(today.Sub(created) / day)}
+		if key.day < 0 || key.day >= days {
+			// Outside window,
+			// eg., backdated.
+			continue
+		}
+
+		uses[key]++
+		if accounts[key] == nil {
+			accounts[key] = make(map[string]struct{})
+		}
+		accounts[key][share.AccountID] = struct{}{}
+	}
+
+	trends := make([]*gtsmodel.CardTrend, 0, len(cardIDs))
+	for _, cardID := range cardIDs {
+		card, err := c.GetCardByID(ctx, cardID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// Card deleted
+				// in meantime.
+				continue
+			}
+			return nil, err
+		}
+
+		trend := &gtsmodel.CardTrend{
+			Card:    card,
+			History: make([]gtsmodel.CardShares, days),
+		}
+
+		for i := range trend.History {
+			key := cardDay{cardID, i}
+			trend.History[i] = gtsmodel.CardShares{
+				Day:      today.AddDate(0, 0, -i),
+				Uses:     uses[key],
+				Accounts: len(accounts[key]),
+			}
+		}
+
+		trends = append(trends, trend)
+	}
+
+	return trends, nil
+}
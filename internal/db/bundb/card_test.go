@@ -0,0 +1,122 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type CardTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *CardTestSuite) putCard(url string) *gtsmodel.Card {
+	card := &gtsmodel.Card{
+		ID:    id.NewULID(),
+		URL:   url,
+		Type:  gtsmodel.CardTypeLink,
+		Title: "Some page",
+	}
+	if err := suite.db.PutCard(suite.T().Context(), card); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return card
+}
+
+// putShare stores a new status by the given
+// test account, linking to the given card.
+func (suite *CardTestSuite) putShare(
+	accountKey string,
+	card *gtsmodel.Card,
+	visibility gtsmodel.Visibility,
+	createdAt time.Time,
+) {
+	account := suite.testAccounts[accountKey]
+	statusID := id.NewULIDFromTime(createdAt)
+
+	status := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 account.URI + "/statuses/" + statusID,
+		URL:                 account.URL + "/" + statusID,
+		Content:             `<p><a href="` + card.URL + `">` + card.URL + `</a></p>`,
+		CreatedAt:           createdAt,
+		Local:               util.Ptr(account.IsLocal()),
+		AccountID:           account.ID,
+		AccountURI:          account.URI,
+		Visibility:          visibility,
+		Federated:           util.Ptr(true),
+		ActivityStreamsType: ap.ObjectNote,
+		CardID:              card.ID,
+	}
+	if err := suite.db.PutStatus(suite.T().Context(), status); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *CardTestSuite) TestGetTrendingCards() {
+	now := time.Now()
+
+	popular := suite.putCard("https://example.org/popular")
+	suite.putShare("local_account_1", popular, gtsmodel.VisibilityPublic, now)
+	suite.putShare("local_account_2", popular, gtsmodel.VisibilityPublic, now)
+	suite.putShare("admin_account", popular, gtsmodel.VisibilityPublic, now.AddDate(0, 0, -1))
+
+	// Shared more often, but by only one account.
+	spammy := suite.putCard("https://example.org/spammy")
+	suite.putShare("local_account_1", spammy, gtsmodel.VisibilityPublic, now)
+	suite.putShare("local_account_1", spammy, gtsmodel.VisibilityPublic, now)
+	suite.putShare("local_account_1", spammy, gtsmodel.VisibilityPublic, now)
+	suite.putShare("local_account_1", spammy, gtsmodel.VisibilityPublic, now)
+
+	// Only shared privately, or too long ago.
+	private := suite.putCard("https://example.org/private")
+	suite.putShare("local_account_1", private, gtsmodel.VisibilityFollowersOnly, now)
+	old := suite.putCard("https://example.org/old")
+	suite.putShare("local_account_1", old, gtsmodel.VisibilityPublic, now.AddDate(0, 0, -30))
+
+	trends, err := suite.db.GetTrendingCards(suite.T().Context(), 7, 10)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(trends, 2) {
+		suite.FailNow("")
+	}
+
+	suite.Equal(popular.ID, trends[0].Card.ID)
+	suite.Len(trends[0].History, 7)
+	suite.Equal(2, trends[0].History[0].Uses)
+	suite.Equal(2, trends[0].History[0].Accounts)
+	suite.Equal(1, trends[0].History[1].Uses)
+	suite.Equal(1, trends[0].History[1].Accounts)
+
+	suite.Equal(spammy.ID, trends[1].Card.ID)
+	suite.Equal(4, trends[1].History[0].Uses)
+	suite.Equal(1, trends[1].History[0].Accounts)
+}
+
+func TestCardTestSuite(t *testing.T) {
+	suite.Run(t, new(CardTestSuite))
+}
@@ -130,6 +130,12 @@ func (d *domainDB) GetDomainLimits(
 	[]*gtsmodel.DomainLimit,
 	error,
 ) {
+	if page.Cursor() {
+		// Cursor paging sorts alphabetically
+		// by domain, rather than by ID.
+		return d.getDomainLimitsByCursor(ctx, page)
+	}
+
 	var (
 		// Get paging params.
 		minID = page.GetMin()
@@ -206,9 +212,132 @@ func (d *domainDB) GetDomainLimits(
 		slices.Reverse(permLimitIDs)
 	}
 
-	// Allocate return slice (will be at most len permLimitIDs)
-	permLimits := make([]*gtsmodel.DomainLimit, 0, len(permLimitIDs))
-	for _, id := range permLimitIDs {
+	return d.getDomainLimitsByIDs(ctx, permLimitIDs), nil
+}
+
+// getDomainLimitsByCursor pages through domain limits sorted
+// alphabetically by (domain, id), using the key tuples encoded
+// in the given page's cursor tokens as keyset boundaries.
+func (d *domainDB) getDomainLimitsByCursor(
+	ctx context.Context,
+	page *paging.Page,
+) (
+	[]*gtsmodel.DomainLimit,
+	error,
+) {
+	minKeys, err := page.GetMinCursor()
+	if err == nil && minKeys != nil && len(minKeys) != 2 {
+		err = errors.New("unexpected key count")
+	}
+	if err != nil {
+		err := gtserror.Newf("invalid min cursor: %w", err)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	maxKeys, err := page.GetMaxCursor()
+	if err == nil && maxKeys != nil && len(maxKeys) != 2 {
+		err = errors.New("unexpected key count")
+	}
+	if err != nil {
+		err := gtserror.Newf("invalid max cursor: %w", err)
+		return nil, gtserror.SetMalformed(err)
+	}
+
+	var (
+		// Get paging params.
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		permLimitIDs = make([]string, 0, limit)
+	)
+
+	q := d.db.
+		NewSelect().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("domain_limits"),
+			bun.Ident("domain_limit"),
+		).
+		// Select only IDs from table
+		Column("domain_limit.id")
+
+	// Return only items sorting
+	// after the max cursor keys.
+	if maxKeys != nil {
+		q = whereDomainIDTuple(q, ">", maxKeys[0], maxKeys[1])
+	}
+
+	// Return only items sorting
+	// before the min cursor keys.
+	if minKeys != nil {
+		q = whereDomainIDTuple(q, "<", minKeys[0], minKeys[1])
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? DESC, ? DESC",
+			bun.Ident("domain_limit.domain"),
+			bun.Ident("domain_limit.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? ASC, ? ASC",
+			bun.Ident("domain_limit.domain"),
+			bun.Ident("domain_limit.id"),
+		)
+	}
+
+	if err := q.Scan(ctx, &permLimitIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no items early
+	if len(permLimitIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted alphabetically, so reverse.
+	if order == paging.OrderAscending {
+		slices.Reverse(permLimitIDs)
+	}
+
+	return d.getDomainLimitsByIDs(ctx, permLimitIDs), nil
+}
+
+// whereDomainIDTuple adds a keyset comparison of (domain, id) against
+// the given key tuple to query, e.g. for op ">" this selects rows where
+// domain > $domain OR (domain = $domain AND id > $id).
+func whereDomainIDTuple(q *bun.SelectQuery, op, domain, id string) *bun.SelectQuery {
+	return q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? "+op+" ?", bun.Ident("domain_limit.domain"), domain).
+			WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("? = ?", bun.Ident("domain_limit.domain"), domain).
+					Where("? "+op+" ?", bun.Ident("domain_limit.id"), id)
+			})
+	})
+}
+
+// getDomainLimitsByIDs fetches domain limits with the given IDs,
+// logging and skipping any that can't be fetched, in order.
+func (d *domainDB) getDomainLimitsByIDs(
+	ctx context.Context,
+	ids []string,
+) []*gtsmodel.DomainLimit {
+	// Allocate return slice (will be at most len ids)
+	permLimits := make([]*gtsmodel.DomainLimit, 0, len(ids))
+	for _, id := range ids {
 		permLimit, err := d.GetDomainLimitByID(ctx, id)
 		if err != nil {
 			log.Errorf(ctx, "error getting domain limit %q: %v", id, err)
@@ -219,7 +348,7 @@ func (d *domainDB) GetDomainLimits(
 		permLimits = append(permLimits, permLimit)
 	}
 
-	return permLimits, nil
+	return permLimits
 }
 
 func (d *domainDB) MatchDomainLimit(
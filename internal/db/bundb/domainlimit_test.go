@@ -20,7 +20,9 @@
 import (
 	"testing"
 
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -67,6 +69,83 @@ func (suite *DomainLimitTestSuite) TestCreateMatchDomainLimit() {
 	suite.Equal("", limit.ContentWarning)
 }
 
+func (suite *DomainLimitTestSuite) TestGetDomainLimitsCursor() {
+	ctx := suite.T().Context()
+
+	// Put limits in with IDs deliberately
+	// *not* in alphabetical order of domain.
+	for id, domain := range map[string]string{
+		"01JCZN614XG85GCGAMSV9ZZAE4": "a.example.org",
+		"01JCZN614XG85GCGAMSV9ZZAE1": "b.example.org",
+		"01JCZN614XG85GCGAMSV9ZZAE3": "c.example.org",
+		"01JCZN614XG85GCGAMSV9ZZAE2": "d.example.org",
+	} {
+		if err := suite.state.DB.PutDomainLimit(ctx, &gtsmodel.DomainLimit{
+			ID:                 id,
+			Domain:             domain,
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	getPage := func(page *paging.Page) []string {
+		limits, err := suite.state.DB.GetDomainLimits(ctx, page)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		domains := make([]string, len(limits))
+		for i, limit := range limits {
+			domains[i] = limit.Domain
+		}
+		return domains
+	}
+
+	cursorPage := func(min, max string) *paging.Page {
+		lo := paging.MinCursor(min)
+		if min == "" {
+			lo.Order = paging.OrderDescending
+		}
+		return &paging.Page{
+			Min:   lo,
+			Max:   paging.MaxCursor(max),
+			Limit: 2,
+		}
+	}
+
+	// Page down from the start.
+	suite.Equal(
+		[]string{"a.example.org", "b.example.org"},
+		getPage(cursorPage("", "")),
+	)
+	suite.Equal(
+		[]string{"c.example.org", "d.example.org"},
+		getPage(cursorPage("", paging.EncodeCursor(
+			"b.example.org", "01JCZN614XG85GCGAMSV9ZZAE1",
+		))),
+	)
+	suite.Equal(
+		[]string{"fossbros-anonymous.io"},
+		getPage(cursorPage("", paging.EncodeCursor(
+			"d.example.org", "01JCZN614XG85GCGAMSV9ZZAE2",
+		))),
+	)
+
+	// Page back up from the last item.
+	suite.Equal(
+		[]string{"c.example.org", "d.example.org"},
+		getPage(cursorPage(paging.EncodeCursor(
+			"fossbros-anonymous.io", "01K8TE4ES467FGYGRKVPDM6RF6",
+		), "")),
+	)
+
+	// Wrong key count is malformed.
+	_, err := suite.state.DB.GetDomainLimits(ctx,
+		cursorPage("", paging.EncodeCursor("a.example.org")),
+	)
+	suite.True(gtserror.IsMalformed(err))
+}
+
 func TestDomainLimitTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainLimitTestSuite))
 }
@@ -0,0 +1,375 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type failedActivityDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (f *failedActivityDB) GetFailedActivityByID(ctx context.Context, id string) (*gtsmodel.FailedActivity, error) {
+	failed := new(gtsmodel.FailedActivity)
+
+	if err := f.db.
+		NewSelect().
+		Model(failed).
+		Where("? = ?", bun.Ident("failed_activity.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return failed, nil
+	}
+
+	// Further populate the failed activity fields where applicable.
+	if err := f.PopulateFailedActivity(ctx, failed); err != nil {
+		return nil, err
+	}
+
+	return failed, nil
+}
+
+func (f *failedActivityDB) GetFailedActivities(
+	ctx context.Context,
+	activityType string,
+	reason gtsmodel.FailureReason,
+	page *paging.Page,
+) ([]*gtsmodel.FailedActivity, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		failedIDs = make([]string, 0, limit)
+	)
+
+	q := f.db.
+		NewSelect().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("failed_activities"),
+			bun.Ident("failed_activity"),
+		).
+		// Select only IDs from table
+		Column("failed_activity.id")
+
+	// Return only items with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where(
+			"? < ?",
+			bun.Ident("failed_activity.id"),
+			maxID,
+		)
+	}
+
+	// Return only items with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where(
+			"? > ?",
+			bun.Ident("failed_activity.id"),
+			minID,
+		)
+	}
+
+	// Return only items with
+	// given activity type.
+	if activityType != "" {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("failed_activity.ap_activity_type"),
+			activityType,
+		)
+	}
+
+	// Return only items with
+	// given failure reason.
+	if reason != gtsmodel.FailureReasonUnknown {
+		q = q.Where(
+			"? = ?",
+			bun.Ident("failed_activity.reason"),
+			reason,
+		)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// items returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr(
+			"? ASC",
+			bun.Ident("failed_activity.id"),
+		)
+	} else {
+		// Page down.
+		q = q.OrderExpr(
+			"? DESC",
+			bun.Ident("failed_activity.id"),
+		)
+	}
+
+	if err := q.Scan(ctx, &failedIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no items early
+	if len(failedIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want items
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(failedIDs)
+	}
+
+	// Allocate return slice (will be at most len failedIDs)
+	failedActivities := make([]*gtsmodel.FailedActivity, 0, len(failedIDs))
+	for _, id := range failedIDs {
+		failed, err := f.GetFailedActivityByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting failed activity %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice
+		failedActivities = append(failedActivities, failed)
+	}
+
+	return failedActivities, nil
+}
+
+func (f *failedActivityDB) CountFailedActivities(ctx context.Context) ([]*gtsmodel.FailedActivityCount, error) {
+	// Check for cached failed activity counts, if so return these.
+	if counts := f.state.Caches.DB.FailedActivityCounts.Load(); counts != nil {
+		return *counts, nil
+	}
+
+	var counts []*gtsmodel.FailedActivityCount
+
+	if err := f.db.
+		NewSelect().
+		TableExpr(
+			"? AS ?",
+			bun.Ident("failed_activities"),
+			bun.Ident("failed_activity"),
+		).
+		ColumnExpr("? AS ?", bun.Ident("failed_activity.ap_activity_type"), bun.Ident("ap_activity_type")).
+		ColumnExpr("? AS ?", bun.Ident("failed_activity.ap_object_type"), bun.Ident("ap_object_type")).
+		ColumnExpr("? AS ?", bun.Ident("failed_activity.reason"), bun.Ident("reason")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		GroupExpr("?, ?, ?",
+			bun.Ident("failed_activity.ap_activity_type"),
+			bun.Ident("failed_activity.ap_object_type"),
+			bun.Ident("failed_activity.reason"),
+		).
+		OrderExpr("? DESC", bun.Ident("count")).
+		Scan(ctx, &counts); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	// Update cached failed activity counts.
+	f.state.Caches.DB.FailedActivityCounts.Store(&counts)
+
+	return counts, nil
+}
+
+func (f *failedActivityDB) PopulateFailedActivity(ctx context.Context, failed *gtsmodel.FailedActivity) error {
+	var (
+		errs gtserror.MultiError
+		err  error
+	)
+
+	if failed.RequestingAccount == nil && failed.RequestingAccountID != "" {
+		// Requesting account is not set, fetch from the database.
+		failed.RequestingAccount, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			failed.RequestingAccountID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating failed activity requesting account: %w", err)
+		}
+	}
+
+	if failed.ReceivingAccount == nil && failed.ReceivingAccountID != "" {
+		// Receiving account is not set, fetch from the database.
+		failed.ReceivingAccount, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			failed.ReceivingAccountID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating failed activity receiving account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (f *failedActivityDB) PutFailedActivity(ctx context.Context, failed *gtsmodel.FailedActivity) error {
+	if _, err := f.db.
+		NewInsert().
+		Model(failed).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Count the new failed activity in the cached
+	// counts (if any), rather than invalidating them,
+	// as failures may well arrive in quick succession.
+	for {
+		ptr := f.state.Caches.DB.FailedActivityCounts.Load()
+		if ptr == nil {
+			// Nothing cached.
+			return nil
+		}
+
+		// Copy cached counts, incrementing
+		// the matching count or appending
+		// a new one. Counts are never modified
+		// in place, as callers may hold them.
+		counts := make([]*gtsmodel.FailedActivityCount, 0, len(*ptr)+1)
+		var found bool
+		for _, count := range *ptr {
+			if count.APActivityType == failed.APActivityType &&
+				count.APObjectType == failed.APObjectType &&
+				count.Reason == failed.Reason {
+				count = &gtsmodel.FailedActivityCount{
+					APActivityType: count.APActivityType,
+					APObjectType:   count.APObjectType,
+					Reason:         count.Reason,
+					Count:          count.Count + 1,
+				}
+				found = true
+			}
+			counts = append(counts, count)
+		}
+
+		if !found {
+			counts = append(counts, &gtsmodel.FailedActivityCount{
+				APActivityType: failed.APActivityType,
+				APObjectType:   failed.APObjectType,
+				Reason:         failed.Reason,
+				Count:          1,
+			})
+		}
+
+		// Keep sorted by count, highest first.
+		slices.SortStableFunc(counts, func(a, b *gtsmodel.FailedActivityCount) int {
+			return b.Count - a.Count
+		})
+
+		if f.state.Caches.DB.FailedActivityCounts.CompareAndSwap(ptr, &counts) {
+			return nil
+		}
+	}
+}
+
+func (f *failedActivityDB) DeleteFailedActivityByID(ctx context.Context, id string) error {
+	_, err := f.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("failed_activities"), bun.Ident("failed_activity")).
+		Where("? = ?", bun.Ident("failed_activity.id"), id).
+		Exec(ctx)
+
+	// Invalidate cached failed activity counts.
+	f.state.Caches.DB.FailedActivityCounts.Store(nil)
+
+	return err
+}
+
+func (f *failedActivityDB) DeleteFailedActivitiesOlderThan(ctx context.Context, olderThan time.Time) (int, error) {
+	result, err := f.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("failed_activities"), bun.Ident("failed_activity")).
+		Where("? < ?", bun.Ident("failed_activity.created_at"), olderThan).
+		Exec(ctx)
+
+	// Invalidate cached failed activity counts.
+	f.state.Caches.DB.FailedActivityCounts.Store(nil)
+
+	if err != nil {
+		return 0, gtserror.Newf("error deleting failed activities: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, gtserror.Newf("error getting deleted row count: %w", err)
+	}
+
+	return int(rows), nil
+}
+
+func (f *failedActivityDB) DeleteFailedActivitiesOverLimit(ctx context.Context, limit int) (int, error) {
+	// Select the ID of the newest failed
+	// activity beyond the limit. If there
+	// are fewer than limit this is NULL,
+	// and nothing matches for deletion.
+	beyondLimit := f.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("failed_activities"), bun.Ident("failed_activity")).
+		Column("failed_activity.id").
+		OrderExpr("? DESC", bun.Ident("failed_activity.id")).
+		Limit(1).
+		Offset(limit)
+
+	result, err := f.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("failed_activities"), bun.Ident("failed_activity")).
+		Where("? <= (?)", bun.Ident("failed_activity.id"), beyondLimit).
+		Exec(ctx)
+
+	// Invalidate cached failed activity counts.
+	f.state.Caches.DB.FailedActivityCounts.Store(nil)
+
+	if err != nil {
+		return 0, gtserror.Newf("error deleting failed activities: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, gtserror.Newf("error getting deleted row count: %w", err)
+	}
+
+	return int(rows), nil
+}
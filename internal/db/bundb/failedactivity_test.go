@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package bundb_test
+
+import (
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type FailedActivityTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FailedActivityTestSuite) newFailedActivity(createdAt time.Time) *gtsmodel.FailedActivity {
+	return &gtsmodel.FailedActivity{
+		ID:             id.NewULIDFromTime(createdAt),
+		CreatedAt:      createdAt,
+		APActivityType: "Create",
+		APObjectType:   "Note",
+		Reason:         gtsmodel.FailureReasonUnretrievable,
+		Error:          "error dereferencing status: 410 Gone",
+		Data:           []byte("{}"),
+		Truncated:      util.Ptr(false),
+	}
+}
+
+func (suite *FailedActivityTestSuite) TestCountFailedActivitiesCached() {
+	ctx := suite.T().Context()
+
+	// Nothing stored yet.
+	counts, err := suite.state.DB.CountFailedActivities(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(counts)
+
+	// Putting failed activities should
+	// update the now cached counts.
+	for range 2 {
+		if err := suite.state.DB.PutFailedActivity(ctx, suite.newFailedActivity(time.Now())); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	cached := suite.state.Caches.DB.FailedActivityCounts.Load()
+	if suite.NotNil(cached) {
+		suite.Equal([]*gtsmodel.FailedActivityCount{{
+			APActivityType: "Create",
+			APObjectType:   "Note",
+			Reason:         gtsmodel.FailureReasonUnretrievable,
+			Count:          2,
+		}}, *cached)
+	}
+
+	// Cached counts should match the database.
+	suite.state.Caches.DB.FailedActivityCounts.Store(nil)
+	counts, err = suite.state.DB.CountFailedActivities(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(*cached, counts)
+}
+
+func (suite *FailedActivityTestSuite) TestDeleteFailedActivities() {
+	ctx := suite.T().Context()
+
+	// Put failed activities
+	// from 1, 2 and 3 days ago.
+	for days := range 3 {
+		createdAt := time.Now().Add(-time.Duration(days+1) * 24 * time.Hour)
+		if err := suite.state.DB.PutFailedActivity(ctx, suite.newFailedActivity(createdAt)); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Fewer than the limit, nothing to delete.
+	n, err := suite.state.DB.DeleteFailedActivitiesOverLimit(ctx, 3)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(n)
+
+	// Oldest is beyond the limit.
+	n, err = suite.state.DB.DeleteFailedActivitiesOverLimit(ctx, 2)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, n)
+
+	// Only the second oldest is older than 36 hours.
+	n, err = suite.state.DB.DeleteFailedActivitiesOlderThan(ctx, time.Now().Add(-36*time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, n)
+
+	counts, err := suite.state.DB.CountFailedActivities(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(counts, 1) {
+		suite.Equal(1, counts[0].Count)
+	}
+}
+
+func TestFailedActivityTestSuite(t *testing.T) {
+	suite.Run(t, new(FailedActivityTestSuite))
+}
@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type federationStatDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (f *federationStatDB) GetFederationStats(
+	ctx context.Context,
+	sort string,
+	ascending bool,
+	limit int,
+) ([]*gtsmodel.FederationStat, error) {
+	switch sort {
+	case "domain",
+		"activities_received",
+		"activities_sent",
+		"media_bytes",
+		"last_seen_at":
+		// Fine.
+	default:
+		return nil, gtserror.Newf("invalid sort column %s", sort)
+	}
+
+	dir := "DESC"
+	if ascending {
+		dir = "ASC"
+	}
+
+	stats := make([]*gtsmodel.FederationStat, 0, limit)
+
+	q := f.db.
+		NewSelect().
+		Model(&stats).
+		OrderExpr("? "+dir, bun.Ident("federation_stat."+sort))
+
+	if sort != "domain" {
+		// Stable ordering for ties.
+		q = q.OrderExpr("? ASC", bun.Ident("federation_stat.domain"))
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (f *federationStatDB) IncrementFederationStats(
+	ctx context.Context,
+	stats []*gtsmodel.FederationStat,
+) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for _, stat := range stats {
+		stat.CreatedAt = now
+		stat.UpdatedAt = now
+	}
+
+	_, err := f.db.
+		NewInsert().
+		Model(&stats).
+		On("CONFLICT (?) DO UPDATE", bun.Ident("domain")).
+		Set("? = ? + ?", bun.Ident("activities_received"), bun.Ident("federation_stat.activities_received"), bun.Ident("excluded.activities_received")).
+		Set("? = ? + ?", bun.Ident("activities_sent"), bun.Ident("federation_stat.activities_sent"), bun.Ident("excluded.activities_sent")).
+		Set("? = ? + ?", bun.Ident("media_bytes"), bun.Ident("federation_stat.media_bytes"), bun.Ident("excluded.media_bytes")).
+		Set("? = COALESCE(?, ?)", bun.Ident("last_seen_at"), bun.Ident("excluded.last_seen_at"), bun.Ident("federation_stat.last_seen_at")).
+		Set("? = ?", bun.Ident("updated_at"), bun.Ident("excluded.updated_at")).
+		Exec(ctx)
+	return err
+}
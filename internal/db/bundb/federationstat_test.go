@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FederationStatTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FederationStatTestSuite) TestFlushFederationStats() {
+	ctx := suite.T().Context()
+
+	// Count some traffic and flush it twice,
+	// to check that flushes add to the totals.
+	for range 2 {
+		suite.state.FedStats.Received("example.org")
+		suite.state.FedStats.Received("example.org")
+		suite.state.FedStats.Sent("example.org")
+		suite.state.FedStats.Sent("fossbros-anonymous.io")
+		suite.state.FedStats.MediaCached("fossbros-anonymous.io", 1024)
+
+		if err := suite.state.FedStats.Flush(ctx, suite.state.DB); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Flushing with nothing counted is a no-op.
+	if err := suite.state.FedStats.Flush(ctx, suite.state.DB); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	stats, err := suite.state.DB.GetFederationStats(ctx, "activities_received", false, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(stats, 2)
+
+	suite.Equal("example.org", stats[0].Domain)
+	suite.EqualValues(4, stats[0].ActivitiesReceived)
+	suite.EqualValues(2, stats[0].ActivitiesSent)
+	suite.EqualValues(0, stats[0].MediaBytes)
+	suite.False(stats[0].LastSeenAt.IsZero())
+
+	suite.Equal("fossbros-anonymous.io", stats[1].Domain)
+	suite.EqualValues(0, stats[1].ActivitiesReceived)
+	suite.EqualValues(2, stats[1].ActivitiesSent)
+	suite.EqualValues(2048, stats[1].MediaBytes)
+	suite.True(stats[1].LastSeenAt.IsZero())
+
+	// Sort by media bytes, limit 1.
+	stats, err = suite.state.DB.GetFederationStats(ctx, "media_bytes", false, 1)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(stats, 1)
+	suite.Equal("fossbros-anonymous.io", stats[0].Domain)
+
+	// Sort by domain ascending.
+	stats, err = suite.state.DB.GetFederationStats(ctx, "domain", true, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(stats, 2)
+	suite.Equal("example.org", stats[0].Domain)
+
+	// Bad sort column.
+	_, err = suite.state.DB.GetFederationStats(ctx, "domain; DROP TABLE accounts", true, 0)
+	suite.Error(err)
+}
+
+func TestFederationStatTestSuite(t *testing.T) {
+	suite.Run(t, new(FederationStatTestSuite))
+}
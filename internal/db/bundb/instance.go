@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
@@ -28,6 +29,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -76,6 +78,89 @@ func (i *instanceDB) CountInstanceUsers(ctx context.Context, domain string) (int
 	return count, nil
 }
 
+func (i *instanceDB) CountLocalActiveUsers(ctx context.Context, since time.Time) (int, error) {
+	// Select IDs of accounts belonging
+	// to users with recently used tokens.
+	tokenAccountIDs := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("tokens"), bun.Ident("token")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("users"), bun.Ident("user"),
+			bun.Ident("user.id"), bun.Ident("token.user_id"),
+		).
+		Column("user.account_id").
+		Where("? >= ?", bun.Ident("token.last_used"), since)
+
+	// Select IDs of accounts
+	// with recent local statuses.
+	statusAccountIDs := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.account_id").
+		Where("? = ?", bun.Ident("status.local"), true).
+		Where("? >= ?", bun.Ident("status.created_at"), since)
+
+	return i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? != ?", bun.Ident("account.username"), config.GetHost()).
+		Where("? IS NULL", bun.Ident("account.domain")).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IN (?)", bun.Ident("account.id"), tokenAccountIDs).
+				WhereOr("? IN (?)", bun.Ident("account.id"), statusAccountIDs)
+		}).
+		Count(ctx)
+}
+
+func (i *instanceDB) CountLocalActivity(ctx context.Context, start time.Time, end time.Time) (int, int, int, error) {
+	statuses, err := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.local"), true).
+		Where("? >= ?", bun.Ident("status.created_at"), start).
+		Where("? < ?", bun.Ident("status.created_at"), end).
+		// Ignore boosts.
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		// Ignore pending approval.
+		Where("? = ?", bun.Ident("status.pending_approval"), false).
+		// Ignore direct messages.
+		Where("NOT ? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityDirect).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	var logins int
+	if err := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("user_logins"), bun.Ident("user_login")).
+		ColumnExpr("COUNT(DISTINCT ?)", bun.Ident("user_login.user_id")).
+		Where("? = ?", bun.Ident("user_login.successful"), true).
+		Where("? >= ?", bun.Ident("user_login.created_at"), start).
+		Where("? < ?", bun.Ident("user_login.created_at"), end).
+		Scan(ctx, &logins); err != nil {
+		return 0, 0, 0, err
+	}
+
+	registrations, err := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		Column("user.id").
+		Where("? >= ?", bun.Ident("user.created_at"), start).
+		Where("? < ?", bun.Ident("user.created_at"), end).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return statuses, logins, registrations, nil
+}
+
 func (i *instanceDB) CountInstanceStatuses(ctx context.Context, domain string) (int, error) {
 	local := (domain == config.GetHost() || domain == config.GetAccountDomain())
 
@@ -355,6 +440,91 @@ func (i *instanceDB) GetInstancePeers(ctx context.Context, includeSuspended bool
 	return instances, nil
 }
 
+func (i *instanceDB) GetInstances(ctx context.Context, software string, version string, page *paging.Page) ([]*gtsmodel.Instance, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		instanceIDs = make([]string, 0, limit)
+	)
+
+	q := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("instances"), bun.Ident("instance")).
+		// Select only IDs from table.
+		Column("instance.id").
+		// Exclude our own instance.
+		Where("? != ?", bun.Ident("instance.domain"), config.GetHost())
+
+	if software != "" {
+		q = q.Where("? = ?", bun.Ident("instance.software_name"), software)
+	}
+
+	if version != "" {
+		q = whereStartsLike(q, bun.Ident("instance.software_version"), version)
+	}
+
+	// Return only instances with id
+	// lower than provided maxID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("instance.id"), maxID)
+	}
+
+	// Return only instances with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("instance.id"), minID)
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// instances returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("instance.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("instance.id"))
+	}
+
+	if err := q.Scan(ctx, &instanceIDs); err != nil {
+		return nil, err
+	}
+
+	// Catch case of no instances early
+	if len(instanceIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want instances
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(instanceIDs)
+	}
+
+	// Allocate return slice (will be at most len instanceIDs)
+	instances := make([]*gtsmodel.Instance, 0, len(instanceIDs))
+	for _, id := range instanceIDs {
+		instance, err := i.GetInstanceByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting instance %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice
+		instances = append(instances, instance)
+	}
+
+	return instances, nil
+}
+
 func (i *instanceDB) GetInstanceAccounts(ctx context.Context, domain string, maxID string, limit int) ([]*gtsmodel.Account, error) {
 	// Ensure reasonable
 	if limit < 0 {
@@ -19,10 +19,13 @@
 
 import (
 	"testing"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/stretchr/testify/suite"
 )
@@ -55,6 +58,65 @@ func (suite *InstanceTestSuite) TestCountInstanceStatusesRemote() {
 	suite.Equal(4, count)
 }
 
+func (suite *InstanceTestSuite) TestCountLocalActiveUsers() {
+	ctx := suite.T().Context()
+
+	// All local accounts that have ever posted or
+	// used a token (ie., all but the account
+	// that's still pending approval).
+	count, err := suite.db.CountLocalActiveUsers(ctx, time.Time{})
+	suite.NoError(err)
+	suite.Equal(4, count)
+
+	// Nobody has been active in the future.
+	count, err = suite.db.CountLocalActiveUsers(ctx, time.Now().Add(time.Hour))
+	suite.NoError(err)
+	suite.Zero(count)
+}
+
+func (suite *InstanceTestSuite) TestCountLocalActivity() {
+	ctx := suite.T().Context()
+
+	// Count the statuses we expect to
+	// see over all time: local, no
+	// boosts, pending or direct.
+	var expectStatuses int
+	for _, status := range suite.testStatuses {
+		if util.PtrOrZero(status.Local) &&
+			status.BoostOfID == "" &&
+			!util.PtrOrZero(status.PendingApproval) &&
+			status.Visibility != gtsmodel.VisibilityDirect {
+			expectStatuses++
+		}
+	}
+
+	// Sign in twice with the same
+	// user, this should count once.
+	for range 2 {
+		if err := suite.db.PutUserLogin(ctx, &gtsmodel.UserLogin{
+			ID:         id.NewULID(),
+			UserID:     suite.testUsers["local_account_1"].ID,
+			Successful: util.Ptr(true),
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	end := time.Now().Add(time.Hour)
+	statuses, logins, registrations, err := suite.db.CountLocalActivity(ctx, time.Time{}, end)
+	suite.NoError(err)
+	suite.Equal(expectStatuses, statuses)
+	suite.Equal(1, logins)
+	suite.Equal(len(suite.testUsers), registrations)
+
+	// Nothing happened in the future.
+	statuses, logins, registrations, err = suite.db.CountLocalActivity(ctx, end, end.Add(time.Hour))
+	suite.NoError(err)
+	suite.Zero(statuses)
+	suite.Zero(logins)
+	suite.Zero(registrations)
+}
+
 func (suite *InstanceTestSuite) TestCountInstanceDomains() {
 	count, err := suite.db.CountInstanceDomains(suite.T().Context(), config.GetHost())
 	suite.NoError(err)
@@ -85,6 +147,42 @@ func (suite *InstanceTestSuite) TestGetInstancePeersIncludeSuspended() {
 	suite.Len(peers, 2)
 }
 
+func (suite *InstanceTestSuite) TestGetInstancesFiltered() {
+	ctx := suite.T().Context()
+
+	// Set software info on one of our peers.
+	instance, err := suite.db.GetInstance(ctx, "fossbros-anonymous.io")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	instance.SoftwareName = "gotosocial"
+	instance.SoftwareVersion = "0.20.1"
+	if err := suite.db.UpdateInstance(ctx, instance, "software_name", "software_version"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// All remote instances.
+	instances, err := suite.db.GetInstances(ctx, "", "", &paging.Page{Limit: 20})
+	suite.NoError(err)
+	suite.Len(instances, 2)
+
+	// Software + version prefix match.
+	instances, err = suite.db.GetInstances(ctx, "gotosocial", "0.20", &paging.Page{Limit: 20})
+	suite.NoError(err)
+	suite.Len(instances, 1)
+	suite.Equal("fossbros-anonymous.io", instances[0].Domain)
+
+	// Version prefix doesn't match.
+	instances, err = suite.db.GetInstances(ctx, "gotosocial", "0.19", &paging.Page{Limit: 20})
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(instances)
+
+	// Software doesn't match.
+	instances, err = suite.db.GetInstances(ctx, "mastodon", "", &paging.Page{Limit: 20})
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(instances)
+}
+
 func (suite *InstanceTestSuite) TestGetInstanceAccounts() {
 	accounts, err := suite.db.GetInstanceAccounts(suite.T().Context(), "fossbros-anonymous.io", "", 10)
 	suite.NoError(err)
@@ -239,6 +239,20 @@ func (m *mediaDB) GetRemoteAttachments(ctx context.Context, page *paging.Page) (
 	}, page)
 }
 
+func (m *mediaDB) GetRemoteAttachmentsByDomain(ctx context.Context, domain string, page *paging.Page) ([]*gtsmodel.MediaAttachment, error) {
+	return m.getAttachmentsPagedByID(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		q = q.Where("remote_url IS NOT NULL")
+		q = q.Where("? IN (?)",
+			bun.Ident("account_id"),
+			m.db.NewSelect().
+				Table("accounts").
+				Column("id").
+				Where("? = ?", bun.Ident("domain"), domain),
+		)
+		return q
+	}, page)
+}
+
 func (m *mediaDB) GetCachedAttachments(ctx context.Context, page *paging.Page) ([]*gtsmodel.MediaAttachment, error) {
 	return m.getAttachmentsPagedByID(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
 		q = q.Where("remote_url IS NOT NULL")
@@ -248,6 +262,65 @@ func (m *mediaDB) GetCachedAttachments(ctx context.Context, page *paging.Page) (
 	}, page)
 }
 
+func (m *mediaDB) GetAccountMediaUsage(ctx context.Context, accountID string) (*gtsmodel.AccountMediaUsage, error) {
+	var rows []struct {
+		Category string `bun:"category"`
+		Count    int    `bun:"count"`
+		Bytes    int64  `bun:"bytes"`
+	}
+
+	// Sum file and thumbnail sizes of attachments
+	// grouped by category, only including files
+	// that are actually stored (ie., cached) locally.
+	if err := m.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+		ColumnExpr("CASE"+
+			" WHEN ? THEN 'avatar'"+
+			" WHEN ? THEN 'header'"+
+			" WHEN ? IS NOT NULL THEN 'attachment'"+
+			" ELSE 'unattached' END AS ?",
+			bun.Ident("media_attachment.avatar"),
+			bun.Ident("media_attachment.header"),
+			bun.Ident("media_attachment.status_id"),
+			bun.Ident("category"),
+		).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		ColumnExpr("SUM("+
+			"(CASE WHEN ? != '' THEN ? ELSE 0 END) + "+
+			"(CASE WHEN ? != '' THEN ? ELSE 0 END)"+
+			") AS ?",
+			bun.Ident("media_attachment.file_path"),
+			bun.Ident("media_attachment.file_file_size"),
+			bun.Ident("media_attachment.thumbnail_path"),
+			bun.Ident("media_attachment.thumbnail_file_size"),
+			bun.Ident("bytes"),
+		).
+		Where("? = ?", bun.Ident("media_attachment.account_id"), accountID).
+		GroupExpr("?", bun.Ident("category")).
+		Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	usage := new(gtsmodel.AccountMediaUsage)
+	for _, row := range rows {
+		var category *gtsmodel.MediaUsage
+		switch row.Category {
+		case "avatar":
+			category = &usage.Avatar
+		case "header":
+			category = &usage.Header
+		case "attachment":
+			category = &usage.Attachments
+		default:
+			category = &usage.Unattached
+		}
+		category.Count = row.Count
+		category.Bytes = row.Bytes
+	}
+
+	return usage, nil
+}
+
 func (m *mediaDB) getAttachmentsPagedByID(ctx context.Context, query func(*bun.SelectQuery) *bun.SelectQuery, page *paging.Page) ([]*gtsmodel.MediaAttachment, error) {
 	maxID := page.GetMax()
 	minID := page.GetMin()
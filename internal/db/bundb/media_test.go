@@ -21,6 +21,7 @@
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"github.com/stretchr/testify/suite"
 )
@@ -43,6 +44,66 @@ func (suite *MediaTestSuite) TestGetCachedAttachmentsOlderThan() {
 	suite.Len(attachments, 3)
 }
 
+func (suite *MediaTestSuite) TestGetAccountMediaUsage() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Tally expected usage from test attachments.
+	expect := new(gtsmodel.AccountMediaUsage)
+	for _, attachment := range suite.testAttachments {
+		if attachment.AccountID != testAccount.ID {
+			continue
+		}
+
+		var category *gtsmodel.MediaUsage
+		switch {
+		case *attachment.Avatar:
+			category = &expect.Avatar
+		case *attachment.Header:
+			category = &expect.Header
+		case attachment.StatusID != "":
+			category = &expect.Attachments
+		default:
+			category = &expect.Unattached
+		}
+
+		category.Count++
+		category.Bytes += int64(attachment.File.FileSize)
+		category.Bytes += int64(attachment.Thumbnail.FileSize)
+	}
+
+	usage, err := suite.db.GetAccountMediaUsage(suite.T().Context(), testAccount.ID)
+	suite.NoError(err)
+	suite.Equal(expect, usage)
+	suite.NotZero(usage.Total().Bytes)
+}
+
+func (suite *MediaTestSuite) TestGetRemoteAttachmentsByDomain() {
+	const domain = "fossbros-anonymous.io"
+
+	// Gather IDs of expected test attachments.
+	var expect []string
+	for _, attachment := range suite.testAttachments {
+		if attachment.RemoteURL == "" {
+			continue
+		}
+		for _, account := range suite.testAccounts {
+			if account.ID == attachment.AccountID && account.Domain == domain {
+				expect = append(expect, attachment.ID)
+			}
+		}
+	}
+	suite.NotEmpty(expect)
+
+	attachments, err := suite.db.GetRemoteAttachmentsByDomain(suite.T().Context(), domain, toPage("", "", "", 100))
+	suite.NoError(err)
+
+	ids := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		ids = append(ids, attachment.ID)
+	}
+	suite.ElementsMatch(expect, ids)
+}
+
 func TestMediaTestSuite(t *testing.T) {
 	suite.Run(t, new(MediaTestSuite))
 }
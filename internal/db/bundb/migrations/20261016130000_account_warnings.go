@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	newmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261016130000_account_warnings"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			log.Info(ctx, "creating account warnings and appeals tables...")
+
+			for _, model := range []any{
+				&newmodel.AccountWarning{},
+				&newmodel.Appeal{},
+			} {
+				if _, err := tx.
+					NewCreateTable().
+					Model(model).
+					IfNotExists().
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			// Add indexes to the new tables.
+			for index, spec := range map[string]struct {
+				table   string
+				columns []string
+			}{
+				"account_warnings_account_id_idx": {"account_warnings", []string{"account_id"}},
+				"appeals_account_id_idx":          {"appeals", []string{"account_id"}},
+			} {
+				if _, err := tx.
+					NewCreateIndex().
+					Table(spec.table).
+					Index(index).
+					Column(spec.columns...).
+					IfNotExists().
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			log.Info(ctx, "adding account warning column to notifications table...")
+
+			var newNotif *newmodel.Notification
+			newNotifType := reflect.TypeOf(newNotif)
+
+			// Generate new column definition from bun.
+			colDef, err := getBunColumnDef(tx, newNotifType, "AccountWarningID")
+			if err != nil {
+				return fmt.Errorf("error making column def: %w", err)
+			}
+
+			if _, err := tx.
+				NewAddColumn().
+				Model(newNotif).
+				ColumnExpr(colDef).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("error adding column: %w", err)
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return nil
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
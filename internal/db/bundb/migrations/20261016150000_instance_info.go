@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	newmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261016150000_instance_info"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			log.Info(ctx, "adding nodeinfo columns to instances table...")
+
+			var newInstance *newmodel.Instance
+			newInstanceType := reflect.TypeOf(newInstance)
+
+			for _, column := range []string{
+				"SoftwareName",
+				"SoftwareVersion",
+				"UsersTotal",
+				"UsersActiveMonth",
+				"OpenRegistrations",
+				"InfoFetchedAt",
+			} {
+				// Generate new column definition from bun.
+				colDef, err := getBunColumnDef(tx, newInstanceType, column)
+				if err != nil {
+					return fmt.Errorf("error making column def: %w", err)
+				}
+
+				_, err = tx.
+					NewAddColumn().
+					Model(newInstance).
+					ColumnExpr(colDef).
+					Exec(ctx)
+				if err != nil {
+					return fmt.Errorf("error adding column: %w", err)
+				}
+			}
+
+			// Index software name for
+			// filtering in admin view.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("instances").
+				Index("instances_software_name_idx").
+				Column("software_name").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return nil
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
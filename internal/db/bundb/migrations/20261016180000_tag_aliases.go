@@ -0,0 +1,193 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	newmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261016180000_tag_aliases"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/uptrace/bun"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			log.Info(ctx, "adding alias_of_id column to tags table...")
+
+			if err := addColumn(ctx, tx,
+				(*newmodel.Tag)(nil),
+				"AliasOfID",
+			); err != nil {
+				return err
+			}
+
+			if err := createIndex(ctx, tx,
+				"tags_alias_of_id_idx",
+				"tags",
+				"alias_of_id",
+			); err != nil {
+				return err
+			}
+
+			log.Info(ctx, "case-folding existing tag names, this may take a while...")
+
+			// Select ID and name of all tags, oldest first.
+			var tags []*newmodel.Tag
+			if err := tx.NewSelect().
+				Model(&tags).
+				Column("id", "name").
+				Order("id ASC").
+				Scan(ctx); err != nil {
+				return gtserror.Newf("error selecting tags: %w", err)
+			}
+
+			// Group tags by folded name, each group
+			// staying in ID order, and note the order
+			// folded names were first seen in so the
+			// groups are processed deterministically.
+			var folds []string
+			groups := make(map[string][]*newmodel.Tag)
+			for _, tag := range tags {
+				// Fold the name exactly as text.FoldHashtag
+				// does at the time of this migration.
+				folded := norm.NFC.String(cases.Fold().String(tag.Name))
+				if _, ok := groups[folded]; !ok {
+					folds = append(folds, folded)
+				}
+				groups[folded] = append(groups[folded], tag)
+			}
+
+			var renamed, aliased int
+			for _, folded := range folds {
+				// The oldest tag in each group (ie.,
+				// lowest ID) is kept as the canonical
+				// tag, and given the folded name.
+				group := groups[folded]
+				canonical := group[0]
+
+				if canonical.Name != folded {
+					// A newer tag in the group may already have
+					// the folded name, in which case it swaps names
+					// with the canonical tag (names are unique).
+					var holder *newmodel.Tag
+					for _, tag := range group[1:] {
+						if tag.Name == folded {
+							holder = tag
+							break
+						}
+					}
+
+					if holder != nil {
+						// Move holder out of the way first. Hashtags
+						// can't contain '#', so this can't clash.
+						if err := renameTag(ctx, tx, holder.ID, "#"+holder.ID); err != nil {
+							return err
+						}
+					}
+
+					if err := renameTag(ctx, tx, canonical.ID, folded); err != nil {
+						return err
+					}
+
+					if holder != nil {
+						if err := renameTag(ctx, tx, holder.ID, canonical.Name); err != nil {
+							return err
+						}
+						holder.Name = canonical.Name
+					}
+
+					canonical.Name = folded
+					renamed++
+				}
+
+				for _, tag := range group[1:] {
+					// Newer tags in the group keep their
+					// own names (names are unique), and are
+					// marked as aliases of the canonical tag.
+					if _, err := tx.NewUpdate().
+						Table("tags").
+						Set("? = ?", bun.Ident("alias_of_id"), canonical.ID).
+						Where("? = ?", bun.Ident("id"), tag.ID).
+						Exec(ctx); err != nil {
+						return gtserror.Newf("error aliasing tag %s: %w", tag.ID, err)
+					}
+
+					// Move any follows of this tag onto the
+					// canonical tag, skipping those accounts
+					// that already follow the canonical tag.
+					var accountIDs []string
+					if _, err := tx.NewDelete().
+						Table("followed_tags").
+						Where("? = ?", bun.Ident("tag_id"), tag.ID).
+						Returning("?", bun.Ident("account_id")).
+						Exec(ctx, &accountIDs); err != nil {
+						return gtserror.Newf("error deleting follows of tag %s: %w", tag.ID, err)
+					}
+
+					if len(accountIDs) > 0 {
+						follows := make([]*newmodel.FollowedTag, len(accountIDs))
+						for i, accountID := range accountIDs {
+							follows[i] = &newmodel.FollowedTag{
+								AccountID: accountID,
+								TagID:     canonical.ID,
+							}
+						}
+
+						if _, err := tx.NewInsert().
+							Model(&follows).
+							On("CONFLICT (?, ?) DO NOTHING", bun.Ident("account_id"), bun.Ident("tag_id")).
+							Exec(ctx); err != nil {
+							return gtserror.Newf("error moving follows of tag %s: %w", tag.ID, err)
+						}
+					}
+
+					aliased++
+				}
+			}
+
+			log.Infof(ctx, "renamed %d tags, aliased %d tags", renamed, aliased)
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		// Tag names are folded in place, so
+		// there's nothing to be undone here.
+		return nil
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
+
+// renameTag sets the name of the tag with the given ID.
+func renameTag(ctx context.Context, tx bun.Tx, id string, name string) error {
+	if _, err := tx.NewUpdate().
+		Table("tags").
+		Set("? = ?", bun.Ident("name"), name).
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error renaming tag %s: %w", id, err)
+	}
+	return nil
+}
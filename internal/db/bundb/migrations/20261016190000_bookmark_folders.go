@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	newmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261016190000_bookmark_folders"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			log.Info(ctx, "creating bookmark_folders table...")
+
+			if _, err := tx.
+				NewCreateTable().
+				Model(&newmodel.BookmarkFolder{}).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			log.Info(ctx, "adding folder_id column to status_bookmarks table...")
+
+			// Existing bookmarks keep a null
+			// folder ID, ie., the default folder.
+			if err := addColumn(ctx, tx,
+				(*newmodel.StatusBookmark)(nil),
+				"FolderID",
+			); err != nil {
+				return err
+			}
+
+			if err := createIndex(ctx, tx,
+				"status_bookmarks_folder_id_idx",
+				"status_bookmarks",
+				"folder_id",
+			); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return nil
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
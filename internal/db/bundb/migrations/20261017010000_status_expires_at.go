@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	newmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261017010000_status_expires_at"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			log.Info(ctx, "adding expires_at column to statuses table...")
+
+			var newStatus *newmodel.Status
+			newStatusType := reflect.TypeOf(newStatus)
+
+			// Generate new column definition from bun.
+			colDef, err := getBunColumnDef(tx, newStatusType, "ExpiresAt")
+			if err != nil {
+				return fmt.Errorf("error making column def: %w", err)
+			}
+
+			_, err = tx.
+				NewAddColumn().
+				Model(newStatus).
+				ColumnExpr(colDef).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("error adding column: %w", err)
+			}
+
+			// Index local statuses due to
+			// expire, so they can be quickly
+			// rescheduled again on startup.
+			if _, err := tx.NewCreateIndex().
+				Table("statuses").
+				Index("statuses_expires_at_idx").
+				Column("expires_at").
+				Where("? IS NOT NULL", bun.Ident("expires_at")).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return fmt.Errorf("error creating index: %w", err)
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return nil
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
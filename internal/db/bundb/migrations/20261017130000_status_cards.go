@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	gtsmodel "code.superseriousbusiness.org/gotosocial/internal/db/bundb/migrations/20261017130000_status_cards"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			log.Info(ctx, "creating cards table...")
+			if _, err := tx.
+				NewCreateTable().
+				Model((*gtsmodel.Card)(nil)).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			log.Info(ctx, "adding card_id and preview_links columns to statuses table...")
+			if err := addColumn(ctx, tx, (*gtsmodel.Status)(nil), "CardID"); err != nil {
+				return err
+			}
+			if err := addColumn(ctx, tx, (*gtsmodel.Status)(nil), "PreviewLinks"); err != nil {
+				return err
+			}
+
+			// The scheduled_statuses table is created from the
+			// latest model on fresh installs, so check first.
+			exists, err := doesColumnExist(ctx, tx, "scheduled_statuses", "preview_links")
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				log.Info(ctx, "adding preview_links column to scheduled_statuses table...")
+				if err := addColumn(ctx, tx, (*gtsmodel.ScheduledStatus)(nil), "PreviewLinks"); err != nil {
+					return err
+				}
+			}
+
+			log.Info(ctx, "adding preview_links column to account_settings table...")
+			return addColumn(ctx, tx, (*gtsmodel.AccountSettings)(nil), "PreviewLinks")
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return nil
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
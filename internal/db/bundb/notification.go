@@ -205,6 +205,16 @@ func (n *notificationDB) PopulateNotification(ctx context.Context, notif *gtsmod
 		}
 	}
 
+	if notif.AccountWarningID != "" && notif.AccountWarning == nil {
+		notif.AccountWarning, err = n.state.DB.GetAccountWarningByID(
+			gtscontext.SetBarebones(ctx),
+			notif.AccountWarningID,
+		)
+		if err != nil {
+			errs.Appendf("error populating notif account warning: %w", err)
+		}
+	}
+
 	return errs.Combine()
 }
 
@@ -284,6 +294,15 @@ func (n *notificationDB) GetAccountNotifications(
 	return n.GetNotificationsByIDs(ctx, notifIDs)
 }
 
+func (n *notificationDB) CountAccountNotificationsAfter(ctx context.Context, accountID string, minID string) (int, error) {
+	return n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Where("? = ?", bun.Ident("notification.target_account_id"), accountID).
+		Where("? > ?", bun.Ident("notification.id"), minID).
+		Count(ctx)
+}
+
 func (n *notificationDB) PutNotification(ctx context.Context, notif *gtsmodel.Notification) error {
 	return n.state.Caches.DB.Notification.Store(notif, func() error {
 		_, err := n.db.NewInsert().Model(notif).Exec(ctx)
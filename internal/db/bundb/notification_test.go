@@ -120,6 +120,31 @@ func (suite *NotificationTestSuite) TestGetAccountNotificationsWithoutSpam() {
 	}
 }
 
+func (suite *NotificationTestSuite) TestCountAccountNotificationsAfter() {
+	ctx := suite.T().Context()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	notifications, err := suite.db.GetAccountNotifications(
+		gtscontext.SetBarebones(ctx),
+		testAccount.ID,
+		&paging.Page{Limit: 100},
+		nil,
+		nil,
+	)
+	suite.NoError(err)
+	suite.NotEmpty(notifications)
+
+	// Everything is newer than the lowest ID.
+	count, err := suite.db.CountAccountNotificationsAfter(ctx, testAccount.ID, id.Lowest)
+	suite.NoError(err)
+	suite.Equal(len(notifications), count)
+
+	// Nothing is newer than the newest notification.
+	count, err = suite.db.CountAccountNotificationsAfter(ctx, testAccount.ID, notifications[0].ID)
+	suite.NoError(err)
+	suite.Zero(count)
+}
+
 func (suite *NotificationTestSuite) TestDeleteNotificationsWithSpam() {
 	suite.spamNotifs()
 	testAccount := suite.testAccounts["local_account_1"]
@@ -51,6 +51,59 @@ func(poll *gtsmodel.Poll) error {
 	)
 }
 
+func (p *pollDB) GetPollsByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Poll, error) {
+	// Load all input poll IDs via cache loader callback.
+	polls, err := p.state.Caches.DB.Poll.LoadIDs("ID",
+		ids,
+		func(uncached []string) ([]*gtsmodel.Poll, error) {
+			// Preallocate expected length of uncached polls.
+			polls := make([]*gtsmodel.Poll, 0, len(uncached))
+
+			// Perform database query scanning
+			// the remaining (uncached) poll IDs.
+			if err := p.db.NewSelect().
+				Model(&polls).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			for _, poll := range polls {
+				// Ensure vote slice
+				// is non nil and set.
+				poll.CheckVotes()
+			}
+
+			return polls, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the polls by their
+	// IDs to ensure in correct order.
+	getID := func(p *gtsmodel.Poll) string { return p.ID }
+	xslices.OrderBy(polls, ids, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return polls, nil
+	}
+
+	// Populate all loaded polls, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	polls = slices.DeleteFunc(polls, func(poll *gtsmodel.Poll) bool {
+		if err := p.PopulatePoll(ctx, poll); err != nil {
+			log.Errorf(ctx, "error populating poll %s: %v", poll.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return polls, nil
+}
+
 func (p *pollDB) getPoll(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Poll) error, keyParts ...any) (*gtsmodel.Poll, error) {
 	// Fetch poll from database cache with loader callback
 	poll, err := p.state.Caches.DB.Poll.LoadOne(lookup, func() (*gtsmodel.Poll, error) {
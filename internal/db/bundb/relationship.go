@@ -134,6 +134,186 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 	return &rel, nil
 }
 
+func (r *relationshipDB) GetRelationships(ctx context.Context, requestingAccount string, targetAccounts []string) ([]*gtsmodel.Relationship, error) {
+	// Prepare a relationship model per target account,
+	// keyed by ID (also taking care of any duplicates).
+	rels := make([]*gtsmodel.Relationship, len(targetAccounts))
+	relsByID := make(map[string]*gtsmodel.Relationship, len(targetAccounts))
+	for i, targetAccount := range targetAccounts {
+		rel, ok := relsByID[targetAccount]
+		if !ok {
+			rel = &gtsmodel.Relationship{ID: targetAccount}
+			relsByID[targetAccount] = rel
+		}
+		rels[i] = rel
+	}
+
+	if len(targetAccounts) == 0 {
+		return rels, nil
+	}
+
+	// Get follows from requesting to targets.
+	var follows []*gtsmodel.Follow
+	if err := r.db.NewSelect().
+		Model(&follows).
+		Where("? = ?", bun.Ident("account_id"), requestingAccount).
+		Where("? IN (?)", bun.Ident("target_account_id"), bun.In(targetAccounts)).
+		Scan(ctx); err != nil {
+		return nil, gtserror.Newf("error fetching follows: %w", err)
+	}
+
+	for _, follow := range follows {
+		rel := relsByID[follow.TargetAccountID]
+		rel.Following = true
+		rel.ShowingReblogs = *follow.ShowReblogs
+		rel.Notifying = *follow.Notify
+	}
+
+	// Get follow requests from requesting to targets.
+	var followReqs []*gtsmodel.FollowRequest
+	if err := r.db.NewSelect().
+		Model(&followReqs).
+		Where("? = ?", bun.Ident("account_id"), requestingAccount).
+		Where("? IN (?)", bun.Ident("target_account_id"), bun.In(targetAccounts)).
+		Scan(ctx); err != nil {
+		return nil, gtserror.Newf("error fetching follow requests: %w", err)
+	}
+
+	for _, followReq := range followReqs {
+		rel := relsByID[followReq.TargetAccountID]
+		if rel.Following {
+			// Follow takes
+			// precedence.
+			continue
+		}
+		rel.Requested = true
+		rel.ShowingReblogs = *followReq.ShowReblogs
+		rel.Notifying = *followReq.Notify
+	}
+
+	// Get targets following requesting.
+	followedBy, err := r.selectRelatedAccountIDs(ctx,
+		"follows",
+		"account_id",
+		"target_account_id",
+		requestingAccount,
+		targetAccounts,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error checking followedBy: %w", err)
+	}
+
+	for _, id := range followedBy {
+		relsByID[id].FollowedBy = true
+	}
+
+	// Get targets requesting to follow requesting.
+	requestedBy, err := r.selectRelatedAccountIDs(ctx,
+		"follow_requests",
+		"account_id",
+		"target_account_id",
+		requestingAccount,
+		targetAccounts,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error checking requestedBy: %w", err)
+	}
+
+	for _, id := range requestedBy {
+		relsByID[id].RequestedBy = true
+	}
+
+	// Get targets blocked by requesting.
+	blocking, err := r.selectRelatedAccountIDs(ctx,
+		"blocks",
+		"target_account_id",
+		"account_id",
+		requestingAccount,
+		targetAccounts,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error checking blocking: %w", err)
+	}
+
+	for _, id := range blocking {
+		relsByID[id].Blocking = true
+	}
+
+	// Get targets blocking requesting.
+	blockedBy, err := r.selectRelatedAccountIDs(ctx,
+		"blocks",
+		"account_id",
+		"target_account_id",
+		requestingAccount,
+		targetAccounts,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error checking blockedBy: %w", err)
+	}
+
+	for _, id := range blockedBy {
+		relsByID[id].BlockedBy = true
+	}
+
+	// Get notes by requesting on targets.
+	var notes []*gtsmodel.AccountNote
+	if err := r.db.NewSelect().
+		Model(&notes).
+		Where("? = ?", bun.Ident("account_id"), requestingAccount).
+		Where("? IN (?)", bun.Ident("target_account_id"), bun.In(targetAccounts)).
+		Scan(ctx); err != nil {
+		return nil, gtserror.Newf("error fetching notes: %w", err)
+	}
+
+	for _, note := range notes {
+		relsByID[note.TargetAccountID].Note = note.Comment
+	}
+
+	// Get mutes by requesting of targets.
+	var mutes []*gtsmodel.UserMute
+	if err := r.db.NewSelect().
+		Model(&mutes).
+		Where("? = ?", bun.Ident("account_id"), requestingAccount).
+		Where("? IN (?)", bun.Ident("target_account_id"), bun.In(targetAccounts)).
+		Scan(ctx); err != nil {
+		return nil, gtserror.Newf("error checking muting: %w", err)
+	}
+
+	now := time.Now()
+	for _, mute := range mutes {
+		if mute.Expired(now) {
+			continue
+		}
+		rel := relsByID[mute.TargetAccountID]
+		rel.Muting = true
+		rel.MutingNotifications = *mute.Notifications
+	}
+
+	return rels, nil
+}
+
+// selectRelatedAccountIDs selects the values of selectCol in the given table,
+// for all rows where whereCol equals accountID and selectCol is one of inIDs.
+func (r *relationshipDB) selectRelatedAccountIDs(
+	ctx context.Context,
+	table string,
+	selectCol string,
+	whereCol string,
+	accountID string,
+	inIDs []string,
+) ([]string, error) {
+	var ids []string
+	if err := r.db.NewSelect().
+		Table(table).
+		Column(selectCol).
+		Where("? = ?", bun.Ident(whereCol), accountID).
+		Where("? IN (?)", bun.Ident(selectCol), bun.In(inIDs)).
+		Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 func (r *relationshipDB) GetAccountFollows(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Follow, error) {
 	followIDs, err := r.GetAccountFollowIDs(ctx, accountID, page)
 	if err != nil {
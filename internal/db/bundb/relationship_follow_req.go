@@ -29,6 +29,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"github.com/uptrace/bun"
 )
 
@@ -123,6 +124,41 @@ func(uncached []string) ([]*gtsmodel.FollowRequest, error) {
 	return follows, nil
 }
 
+func (r *relationshipDB) GetExpiredFollowRequests(ctx context.Context, olderThan time.Time, limit int) ([]*gtsmodel.FollowRequest, error) {
+	var ids []string
+
+	// Select IDs of follow requests targeting
+	// local accounts, created before olderThan.
+	// Follow request IDs are ULIDs, so we can
+	// compare against an ID for the given time.
+	q := r.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("follow_requests"), bun.Ident("follow_request")).
+		Column("follow_request.id").
+		Where("? < ?", bun.Ident("follow_request.id"), id.ZeroULIDForTime(olderThan)).
+		Where("? IN (?)",
+			bun.Ident("follow_request.target_account_id"),
+			r.db.NewSelect().
+				Table("accounts").
+				Column("id").
+				Where("? IS NULL", bun.Ident("domain")),
+		).
+		OrderExpr("? ASC", bun.Ident("follow_request.id"))
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return r.GetFollowRequestsByIDs(ctx, ids)
+}
+
 func (r *relationshipDB) IsFollowRequested(ctx context.Context, sourceAccountID string, targetAccountID string) (bool, error) {
 	followReq, err := r.GetFollowRequest(
 		gtscontext.SetBarebones(ctx),
@@ -19,8 +19,11 @@
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gopkg/xslices"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -42,6 +45,69 @@ func(note *gtsmodel.AccountNote) error {
 	)
 }
 
+func (r *relationshipDB) GetNotesByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.AccountNote, error) {
+	var ids []string
+
+	// Select IDs of all notes owned by account.
+	if err := r.db.NewSelect().
+		Table("account_notes").
+		Column("id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	// Load all notes IDs via cache loader callbacks.
+	notes, err := r.state.Caches.DB.AccountNote.LoadIDs("ID",
+		ids,
+		func(uncached []string) ([]*gtsmodel.AccountNote, error) {
+			// Preallocate expected length of uncached notes.
+			notes := make([]*gtsmodel.AccountNote, 0, len(uncached))
+
+			// Perform database query scanning
+			// the remaining (uncached) IDs.
+			if err := r.db.NewSelect().
+				Model(&notes).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return notes, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the notes by their
+	// IDs to ensure in correct order.
+	getID := func(n *gtsmodel.AccountNote) string { return n.ID }
+	xslices.OrderBy(notes, ids, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return notes, nil
+	}
+
+	// Populate all loaded notes, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	notes = slices.DeleteFunc(notes, func(note *gtsmodel.AccountNote) bool {
+		if err := r.PopulateNote(ctx, note); err != nil {
+			log.Errorf(ctx, "error populating note %s: %v", note.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return notes, nil
+}
+
 func (r *relationshipDB) getNote(ctx context.Context, lookup string, dbQuery func(*gtsmodel.AccountNote) error, keyParts ...any) (*gtsmodel.AccountNote, error) {
 	// Fetch note from cache with loader callback
 	note, err := r.state.Caches.DB.AccountNote.LoadOne(lookup, func() (*gtsmodel.AccountNote, error) {
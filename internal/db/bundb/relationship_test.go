@@ -569,6 +569,32 @@ func (suite *RelationshipTestSuite) TestGetRelationship() {
 	suite.Empty(relationship.Note)
 }
 
+func (suite *RelationshipTestSuite) TestGetRelationships() {
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	// Include a duplicate + an
+	// account with no relationship.
+	targetIDs := []string{
+		suite.testAccounts["admin_account"].ID,
+		suite.testAccounts["local_account_2"].ID,
+		suite.testAccounts["remote_account_1"].ID,
+		suite.testAccounts["admin_account"].ID,
+		suite.testAccounts["local_account_1"].ID,
+	}
+
+	relationships, err := suite.db.GetRelationships(suite.T().Context(), requestingAccount.ID, targetIDs)
+	suite.NoError(err)
+	suite.Len(relationships, len(targetIDs))
+
+	// Each bulk fetched relationship
+	// should match singly fetched one.
+	for i, targetID := range targetIDs {
+		expect, err := suite.db.GetRelationship(suite.T().Context(), requestingAccount.ID, targetID)
+		suite.NoError(err)
+		suite.Equal(expect, relationships[i])
+	}
+}
+
 func (suite *RelationshipTestSuite) TestIsFollowingYes() {
 	requestingAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
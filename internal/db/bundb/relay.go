@@ -0,0 +1,148 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type relayDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (r *relayDB) GetRelayByID(ctx context.Context, id string) (*gtsmodel.Relay, error) {
+	return r.getRelay(ctx, func(relay *gtsmodel.Relay) error {
+		return r.db.
+			NewSelect().
+			Model(relay).
+			Where("? = ?", bun.Ident("relay.id"), id).
+			Scan(ctx)
+	})
+}
+
+func (r *relayDB) GetRelayByAccountID(ctx context.Context, accountID string) (*gtsmodel.Relay, error) {
+	return r.getRelay(ctx, func(relay *gtsmodel.Relay) error {
+		return r.db.
+			NewSelect().
+			Model(relay).
+			Where("? = ?", bun.Ident("relay.account_id"), accountID).
+			Scan(ctx)
+	})
+}
+
+func (r *relayDB) GetRelays(ctx context.Context) ([]*gtsmodel.Relay, error) {
+	var relayIDs []string
+
+	if err := r.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("relays"), bun.Ident("relay")).
+		Column("relay.id").
+		OrderExpr("? DESC", bun.Ident("relay.id")).
+		Scan(ctx, &relayIDs); err != nil {
+		return nil, err
+	}
+
+	relays := make([]*gtsmodel.Relay, 0, len(relayIDs))
+	for _, id := range relayIDs {
+		relay, err := r.GetRelayByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting relay %q: %v", id, err)
+			continue
+		}
+
+		relays = append(relays, relay)
+	}
+
+	return relays, nil
+}
+
+func (r *relayDB) getRelay(ctx context.Context, dbQuery func(*gtsmodel.Relay) error) (*gtsmodel.Relay, error) {
+	relay := new(gtsmodel.Relay)
+
+	if err := dbQuery(relay); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return relay, nil
+	}
+
+	// Further populate the relay fields where applicable.
+	if err := r.PopulateRelay(ctx, relay); err != nil {
+		return nil, err
+	}
+
+	return relay, nil
+}
+
+func (r *relayDB) PopulateRelay(ctx context.Context, relay *gtsmodel.Relay) error {
+	var (
+		errs gtserror.MultiError
+		err  error
+	)
+
+	if relay.Account == nil {
+		// Relay account is not set, fetch from the database.
+		relay.Account, err = r.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			relay.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating relay account: %w", err)
+		}
+	}
+
+	if relay.CreatedByAccount == nil {
+		// Relay creator is not set, fetch from the database.
+		relay.CreatedByAccount, err = r.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			relay.CreatedByAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating relay created by account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (r *relayDB) PutRelay(ctx context.Context, relay *gtsmodel.Relay) error {
+	_, err := r.db.
+		NewInsert().
+		Model(relay).
+		Exec(ctx)
+	return err
+}
+
+func (r *relayDB) DeleteRelayByID(ctx context.Context, id string) error {
+	_, err := r.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("relays"), bun.Ident("relay")).
+		Where("? = ?", bun.Ident("relay.id"), id).
+		Exec(ctx)
+	return err
+}
@@ -25,6 +25,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect"
 )
@@ -77,6 +78,7 @@ func (s *searchDB) SearchForAccounts(
 	minID string,
 	limit int,
 	following bool,
+	notes bool,
 	offset int,
 ) ([]*gtsmodel.Account, error) {
 	// Ensure reasonable
@@ -139,7 +141,20 @@ func (s *searchDB) SearchForAccounts(
 		// Search using LIKE for matches of query
 		// string within accountText subquery.
 		subQ := s.accountText(following)
-		q = whereLike(q, subQ, query)
+		if notes {
+			// Also match accounts on which accountID
+			// has left a private note containing query.
+			q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				q = whereLike(q, subQ, query)
+				return q.WhereOr(
+					"? IN (?)",
+					bun.Ident("account.id"),
+					s.notedAccounts(accountID, query),
+				)
+			})
+		} else {
+			q = whereLike(q, subQ, query)
+		}
 	}
 
 	if limit > 0 {
@@ -198,6 +213,18 @@ func (s *searchDB) followedAccounts(accountID string) *bun.SelectQuery {
 		Where("? = ?", bun.Ident("follow.account_id"), accountID)
 }
 
+// notedAccounts returns a subquery that selects only IDs of
+// accounts on which the given accountID has left a private
+// note containing the given query string.
+func (s *searchDB) notedAccounts(accountID string, query string) *bun.SelectQuery {
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("account_notes"), bun.Ident("account_note")).
+		Column("account_note.target_account_id").
+		Where("? = ?", bun.Ident("account_note.account_id"), accountID)
+	return whereLike(q, bun.Ident("account_note.comment"), query)
+}
+
 // accountText returns a subquery that selects a concatenation
 // of account username and display name as "account_text". If
 // `following` is true, then account note will also be included
@@ -444,8 +471,7 @@ func (s *searchDB) SearchForTags(
 	}
 
 	// Normalize tag 'name' string.
-	name := strings.TrimSpace(query)
-	name = strings.ToLower(name)
+	name := text.FoldHashtag(query)
 
 	// Search using LIKE for tags that start with `name`.
 	q = whereStartsLike(q, bun.Ident("tag.name"), name)
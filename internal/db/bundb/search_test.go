@@ -31,7 +31,7 @@ type SearchTestSuite struct {
 func (suite *SearchTestSuite) TestSearchAccountsTurtleAny() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "turtle", "", "", 10, false, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "turtle", "", "", 10, false, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -40,7 +40,7 @@ func (suite *SearchTestSuite) TestSearchAccounts1HappyWithPrefix() {
 	testAccount := suite.testAccounts["local_account_1"]
 
 	// Query will just look for usernames that start with "1happy".
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "@1happy", "", "", 10, false, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "@1happy", "", "", 10, false, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -49,7 +49,7 @@ func (suite *SearchTestSuite) TestSearchAccounts1HappyWithPrefixUpper() {
 	testAccount := suite.testAccounts["local_account_1"]
 
 	// Query will just look for usernames that start with "1HAPPY".
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "@1HAPPY", "", "", 10, false, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "@1HAPPY", "", "", 10, false, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -58,7 +58,7 @@ func (suite *SearchTestSuite) TestSearchAccounts1HappyNoPrefix() {
 	testAccount := suite.testAccounts["local_account_1"]
 
 	// Query will do the full coalesce.
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "1happy", "", "", 10, false, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "1happy", "", "", 10, false, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -66,7 +66,7 @@ func (suite *SearchTestSuite) TestSearchAccounts1HappyNoPrefix() {
 func (suite *SearchTestSuite) TestSearchAccountsTurtleFollowing() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "turtle", "", "", 10, true, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "turtle", "", "", 10, true, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -74,7 +74,7 @@ func (suite *SearchTestSuite) TestSearchAccountsTurtleFollowing() {
 func (suite *SearchTestSuite) TestSearchAccountsTurtleFollowingUpper() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "TURTLE", "", "", 10, true, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "TURTLE", "", "", 10, true, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -82,7 +82,7 @@ func (suite *SearchTestSuite) TestSearchAccountsTurtleFollowingUpper() {
 func (suite *SearchTestSuite) TestSearchAccountsPostFollowing() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "post", "", "", 10, true, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "post", "", "", 10, true, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -90,7 +90,7 @@ func (suite *SearchTestSuite) TestSearchAccountsPostFollowing() {
 func (suite *SearchTestSuite) TestSearchAccountsPostAny() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "post", "", "", 10, false, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "post", "", "", 10, false, false, 0)
 	suite.NoError(err, db.ErrNoEntries)
 	suite.Empty(accounts)
 }
@@ -98,11 +98,40 @@ func (suite *SearchTestSuite) TestSearchAccountsPostAny() {
 func (suite *SearchTestSuite) TestSearchAccountsFossAny() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "foss", "", "", 10, false, 0)
+	accounts, err := suite.db.SearchForAccounts(suite.T().Context(), testAccount.ID, "foss", "", "", 10, false, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
 
+func (suite *SearchTestSuite) TestSearchAccountsNotes() {
+	var (
+		ctx          = suite.T().Context()
+		testAccount  = suite.testAccounts["local_account_2"]
+		notedAccount = suite.testAccounts["local_account_1"]
+		otherAccount = suite.testAccounts["admin_account"]
+	)
+
+	// Without notes, query shouldn't
+	// match anything in account text.
+	accounts, err := suite.db.SearchForAccounts(ctx, testAccount.ID, "average", "", "", 10, false, false, 0)
+	suite.NoError(err)
+	suite.Empty(accounts)
+
+	// With notes, query should match
+	// note left on local_account_1.
+	accounts, err = suite.db.SearchForAccounts(ctx, testAccount.ID, "average", "", "", 10, false, true, 0)
+	suite.NoError(err)
+	if suite.Len(accounts, 1) {
+		suite.Equal(notedAccount.ID, accounts[0].ID)
+	}
+
+	// Notes are private, so other
+	// accounts shouldn't get a match.
+	accounts, err = suite.db.SearchForAccounts(ctx, otherAccount.ID, "average", "", "", 10, false, true, 0)
+	suite.NoError(err)
+	suite.Empty(accounts)
+}
+
 func (suite *SearchTestSuite) TestSearchStatuses() {
 	testAccount := suite.testAccounts["local_account_1"]
 
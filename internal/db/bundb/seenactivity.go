@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type seenActivityDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *seenActivityDB) IsActivitySeen(ctx context.Context, inboxURI string, uri string) (bool, error) {
+	return s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("seen_activities"), bun.Ident("seen_activity")).
+		Where("? = ?", bun.Ident("seen_activity.inbox_uri"), inboxURI).
+		Where("? = ?", bun.Ident("seen_activity.uri"), uri).
+		Exists(ctx)
+}
+
+func (s *seenActivityDB) PutSeenActivity(ctx context.Context, inboxURI string, uri string) error {
+	if _, err := s.db.
+		NewInsert().
+		Model(&gtsmodel.SeenActivity{InboxURI: inboxURI, URI: uri}).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("inbox_uri"), bun.Ident("uri")).
+		Exec(ctx); err != nil {
+		return gtserror.Newf("error inserting seen activity: %w", err)
+	}
+	return nil
+}
+
+func (s *seenActivityDB) DeleteSeenActivitiesOlderThan(ctx context.Context, olderThan time.Time) (int, error) {
+	result, err := s.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("seen_activities"), bun.Ident("seen_activity")).
+		Where("? < ?", bun.Ident("seen_activity.created_at"), olderThan).
+		Exec(ctx)
+	if err != nil {
+		return 0, gtserror.Newf("error deleting seen activities: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, gtserror.Newf("error getting deleted row count: %w", err)
+	}
+
+	return int(rows), nil
+}
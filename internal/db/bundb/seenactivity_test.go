@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package bundb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SeenActivityTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *SeenActivityTestSuite) TestPutSeenActivity() {
+	var (
+		ctx   = suite.T().Context()
+		inbox = "http://localhost:8080/users/the_mighty_zork/inbox"
+		uri   = "http://fossbros-anonymous.io/users/foss_satan/activities/01H8G1XQ4BNC4ENQSFDGMZVXBM"
+	)
+
+	seen, err := suite.state.DB.IsActivitySeen(ctx, inbox, uri)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(seen)
+
+	// Putting the same activity
+	// twice should not error.
+	for range 2 {
+		if err := suite.state.DB.PutSeenActivity(ctx, inbox, uri); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	seen, err = suite.state.DB.IsActivitySeen(ctx, inbox, uri)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(seen)
+
+	// Activity should not be seen
+	// at a different inbox though.
+	seen, err = suite.state.DB.IsActivitySeen(ctx, "http://localhost:8080/users/1happyturtle/inbox", uri)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(seen)
+}
+
+func (suite *SeenActivityTestSuite) TestDeleteSeenActivitiesOlderThan() {
+	var (
+		ctx   = suite.T().Context()
+		inbox = "http://localhost:8080/users/the_mighty_zork/inbox"
+		uri   = "http://fossbros-anonymous.io/users/foss_satan/activities/01H8G1XQ4BNC4ENQSFDGMZVXBM"
+	)
+
+	if err := suite.state.DB.PutSeenActivity(ctx, inbox, uri); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Nothing is older than an hour ago.
+	n, err := suite.state.DB.DeleteSeenActivitiesOlderThan(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(n)
+
+	// Everything is older than an hour from now.
+	n, err = suite.state.DB.DeleteSeenActivitiesOlderThan(ctx, time.Now().Add(time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, n)
+
+	seen, err := suite.state.DB.IsActivitySeen(ctx, inbox, uri)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(seen)
+}
+
+func TestSeenActivityTestSuite(t *testing.T) {
+	suite.Run(t, new(SeenActivityTestSuite))
+}
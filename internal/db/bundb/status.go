@@ -31,6 +31,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/uptrace/bun"
 )
 
@@ -84,6 +85,10 @@ func(uncached []string) ([]*gtsmodel.Status, error) {
 		return statuses, nil
 	}
 
+	// Load sub-models for all statuses at once
+	// before populating, to save on db calls.
+	s.preloadStatuses(ctx, statuses)
+
 	// Populate all loaded statuses, removing those we fail to
 	// populate (removes needing so many nil checks everywhere).
 	statuses = slices.DeleteFunc(statuses, func(status *gtsmodel.Status) bool {
@@ -255,6 +260,17 @@ func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status)
 		}
 	}
 
+	if status.CardID != "" && status.Card == nil {
+		// Status card is not set, fetch from database.
+		status.Card, err = s.state.DB.GetCardByID(
+			ctx, // card only populates its image
+			status.CardID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status card: %w", err)
+		}
+	}
+
 	if !status.AttachmentsPopulated() {
 		// Status attachments are out-of-date with IDs, repopulate.
 		status.Attachments, err = s.state.DB.GetAttachmentsByIDs(
@@ -314,6 +330,210 @@ func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status)
 	return errs.Combine()
 }
 
+func (s *statusDB) PopulateStatuses(ctx context.Context, statuses []*gtsmodel.Status) error {
+	var errs gtserror.MultiError
+
+	// Load sub-models for all
+	// statuses at once first.
+	s.preloadStatuses(ctx, statuses)
+
+	// Populate whatever remains per status.
+	for _, status := range statuses {
+		if err := s.PopulateStatus(ctx, status); err != nil {
+			errs.Appendf("error populating status %s: %w", status.ID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// preloadStatuses sets sub-models on the given statuses (and any statuses
+// they boost) that can be loaded for all statuses in a single query each,
+// i.e. accounts, boosted statuses, polls, attachments, tags, mentions and
+// emojis. Errors are only logged, as anything that fails to load here will
+// be retried (and any error returned) by a subsequent call to PopulateStatus.
+func (s *statusDB) preloadStatuses(ctx context.Context, statuses []*gtsmodel.Status) {
+	if len(statuses) == 0 {
+		return
+	}
+
+	// Sub-models are only
+	// loaded as barebones.
+	bbCtx := gtscontext.SetBarebones(ctx)
+
+	// Load any unset boosted statuses first,
+	// so that their own sub-models can then
+	// be preloaded along with everything else.
+	boostOfIDs := xslices.GatherIf(nil, statuses, func(status *gtsmodel.Status) (string, bool) {
+		return status.BoostOfID, status.BoostOfID != "" && status.BoostOf == nil
+	})
+	if len(boostOfIDs) > 0 {
+		boosts, err := s.GetStatusesByIDs(bbCtx, xslices.Deduplicate(boostOfIDs))
+		if err != nil {
+			log.Errorf(ctx, "error preloading status boosts: %v", err)
+		}
+
+		boostsByID := util.KeyBy(boosts, func(status *gtsmodel.Status) string { return status.ID })
+		for _, status := range statuses {
+			if status.BoostOfID != "" && status.BoostOf == nil {
+				status.BoostOf = boostsByID[status.BoostOfID]
+			}
+		}
+	}
+
+	// Gather all statuses to preload, including boosted ones.
+	all := make([]*gtsmodel.Status, 0, 2*len(statuses))
+	for _, status := range statuses {
+		all = append(all, status)
+		if status.BoostOf != nil {
+			all = append(all, status.BoostOf)
+		}
+	}
+
+	var (
+		accountIDs    []string
+		pollIDs       []string
+		attachmentIDs []string
+		tagIDs        []string
+		mentionIDs    []string
+		emojiIDs      []string
+	)
+
+	// Gather the IDs of all unset sub-models.
+	for _, status := range all {
+		if status.Account == nil {
+			accountIDs = append(accountIDs, status.AccountID)
+		}
+		if status.InReplyToID != "" && status.InReplyToAccount == nil {
+			accountIDs = append(accountIDs, status.InReplyToAccountID)
+		}
+		if status.BoostOfID != "" && status.BoostOfAccount == nil {
+			accountIDs = append(accountIDs, status.BoostOfAccountID)
+		}
+		if status.PollID != "" && status.Poll == nil {
+			pollIDs = append(pollIDs, status.PollID)
+		}
+		if !status.AttachmentsPopulated() {
+			attachmentIDs = append(attachmentIDs, status.AttachmentIDs...)
+		}
+		if !status.TagsPopulated() {
+			tagIDs = append(tagIDs, status.TagIDs...)
+		}
+		if !status.MentionsPopulated() {
+			mentionIDs = append(mentionIDs, status.MentionIDs...)
+		}
+		if !status.EmojisPopulated() {
+			emojiIDs = append(emojiIDs, status.EmojiIDs...)
+		}
+	}
+
+	if len(accountIDs) > 0 {
+		accounts, err := s.state.DB.GetAccountsByIDs(bbCtx, xslices.Deduplicate(accountIDs))
+		if err != nil {
+			log.Errorf(ctx, "error preloading status accounts: %v", err)
+		}
+
+		byID := util.KeyBy(accounts, func(a *gtsmodel.Account) string { return a.ID })
+		for _, status := range all {
+			if status.Account == nil {
+				status.Account = byID[status.AccountID]
+			}
+			if status.InReplyToID != "" && status.InReplyToAccount == nil {
+				status.InReplyToAccount = byID[status.InReplyToAccountID]
+			}
+			if status.BoostOfID != "" && status.BoostOfAccount == nil {
+				status.BoostOfAccount = byID[status.BoostOfAccountID]
+			}
+		}
+	}
+
+	if len(pollIDs) > 0 {
+		polls, err := s.state.DB.GetPollsByIDs(bbCtx, xslices.Deduplicate(pollIDs))
+		if err != nil {
+			log.Errorf(ctx, "error preloading status polls: %v", err)
+		}
+
+		byID := util.KeyBy(polls, func(p *gtsmodel.Poll) string { return p.ID })
+		for _, status := range all {
+			if status.PollID != "" && status.Poll == nil {
+				status.Poll = byID[status.PollID]
+			}
+		}
+	}
+
+	if len(attachmentIDs) > 0 {
+		attachments, err := s.state.DB.GetAttachmentsByIDs(bbCtx, xslices.Deduplicate(attachmentIDs))
+		if err != nil {
+			log.Errorf(ctx, "error preloading status attachments: %v", err)
+		}
+
+		byID := util.KeyBy(attachments, func(a *gtsmodel.MediaAttachment) string { return a.ID })
+		for _, status := range all {
+			if !status.AttachmentsPopulated() {
+				status.Attachments = gatherByIDs(status.AttachmentIDs, byID)
+			}
+		}
+	}
+
+	if len(tagIDs) > 0 {
+		tags, err := s.state.DB.GetTags(bbCtx, xslices.Deduplicate(tagIDs))
+		if err != nil {
+			log.Errorf(ctx, "error preloading status tags: %v", err)
+		}
+
+		byID := util.KeyBy(tags, func(t *gtsmodel.Tag) string { return t.ID })
+		for _, status := range all {
+			if !status.TagsPopulated() {
+				status.Tags = gatherByIDs(status.TagIDs, byID)
+			}
+		}
+	}
+
+	if len(mentionIDs) > 0 {
+		// Mentions are fully populated, as in PopulateStatus().
+		mentions, err := s.state.DB.GetMentions(ctx, xslices.Deduplicate(mentionIDs))
+		if err != nil {
+			log.Errorf(ctx, "error preloading status mentions: %v", err)
+		}
+
+		byID := util.KeyBy(mentions, func(m *gtsmodel.Mention) string { return m.ID })
+		for _, status := range all {
+			if !status.MentionsPopulated() {
+				status.Mentions = gatherByIDs(status.MentionIDs, byID)
+			}
+		}
+	}
+
+	if len(emojiIDs) > 0 {
+		emojis, err := s.state.DB.GetEmojisByIDs(bbCtx, xslices.Deduplicate(emojiIDs))
+		if err != nil {
+			log.Errorf(ctx, "error preloading status emojis: %v", err)
+		}
+
+		byID := util.KeyBy(emojis, func(e *gtsmodel.Emoji) string { return e.ID })
+		for _, status := range all {
+			if !status.EmojisPopulated() {
+				status.Emojis = gatherByIDs(status.EmojiIDs, byID)
+			}
+		}
+	}
+}
+
+// gatherByIDs returns the models in byID with given IDs, in order.
+// If any are missing it returns nil, such that the sub-models will
+// be considered unpopulated and get (re)loaded by PopulateStatus().
+func gatherByIDs[T any](ids []string, byID map[string]T) []T {
+	out := make([]T, 0, len(ids))
+	for _, id := range ids {
+		v, ok := byID[id]
+		if !ok {
+			return nil
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
 func (s *statusDB) PopulateStatusEdits(ctx context.Context, status *gtsmodel.Status) error {
 	var err error
 
@@ -483,6 +703,7 @@ func (s *statusDB) fixStatusThreading(ctx context.Context, tx bun.Tx, threadIDs
 	// preallocating slices for worst-case scenarios.
 	statusIDs := make([]string, 0, 4*len(threadIDs))
 	muteIDs := make([]string, 0, 4*len(threadIDs))
+	subscriptionIDs := make([]string, 0, 4*len(threadIDs))
 
 	// Update all statuses with
 	// thread IDs to use oldest.
@@ -508,6 +729,18 @@ func (s *statusDB) fixStatusThreading(ctx context.Context, tx bun.Tx, threadIDs
 		return "", gtserror.Newf("error updating thread mutes: %w", err)
 	}
 
+	// Update all thread subscriptions
+	// with thread IDs to use oldest.
+	if _, err := tx.
+		NewUpdate().
+		Table("thread_subscriptions").
+		Where("? IN (?)", bun.Ident("thread_id"), bun.In(threadIDs)).
+		Set("? = ?", bun.Ident("thread_id"), threadID).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &subscriptionIDs); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return "", gtserror.Newf("error updating thread subscriptions: %w", err)
+	}
+
 	// Delete all now
 	// unused thread IDs.
 	if _, err := tx.
@@ -518,9 +751,10 @@ func (s *statusDB) fixStatusThreading(ctx context.Context, tx bun.Tx, threadIDs
 		return "", gtserror.Newf("error deleting threads: %w", err)
 	}
 
-	// Invalidate caches for changed statuses and mutes.
+	// Invalidate caches for changed statuses, mutes and subscriptions.
 	s.state.Caches.DB.Status.InvalidateIDs("ID", statusIDs)
 	s.state.Caches.DB.ThreadMute.InvalidateIDs("ID", muteIDs)
+	s.state.Caches.DB.ThreadSubscription.InvalidateIDs("ID", subscriptionIDs)
 
 	return threadID, nil
 }
@@ -782,6 +1016,24 @@ func (s *statusDB) GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([
 	return s.GetStatusesByIDs(ctx, statusIDs)
 }
 
+func (s *statusDB) GetExpiringStatuses(ctx context.Context) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	// SELECT all local statuses
+	// with an expiry time set.
+	if _, err := s.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		Where("? IS NOT NULL", bun.Ident("expires_at")).
+		Where("? = ?", bun.Ident("local"), true).
+		Exec(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (s *statusDB) GetStatusParents(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.Status, error) {
 	var parents []*gtsmodel.Status
 
@@ -85,6 +85,52 @@ func (suite *StatusTestSuite) TestGetStatusesByIDs() {
 	suite.True(*status2.Federated)
 }
 
+func (suite *StatusTestSuite) TestPopulateStatuses() {
+	ctx := suite.T().Context()
+
+	// Load all test statuses barebones.
+	ids := make([]string, 0, len(suite.testStatuses))
+	for _, status := range suite.testStatuses {
+		ids = append(ids, status.ID)
+	}
+	statuses, err := suite.db.GetStatusesByIDs(gtscontext.SetBarebones(ctx), ids)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(statuses, len(ids))
+
+	// Populate them all at once.
+	if err := suite.db.PopulateStatuses(ctx, statuses); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, status := range statuses {
+		// Compare with each
+		// status populated alone.
+		expect, err := suite.db.GetStatusByID(ctx, status.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(expect.Account.ID, status.Account.ID)
+		suite.Equal(expect.BoostOfID, status.BoostOfID)
+		if expect.BoostOf != nil {
+			suite.Equal(expect.BoostOf.ID, status.BoostOf.ID)
+			suite.Equal(expect.BoostOfAccount.ID, status.BoostOfAccount.ID)
+		}
+		if expect.InReplyToAccount != nil {
+			suite.Equal(expect.InReplyToAccount.ID, status.InReplyToAccount.ID)
+		}
+		if expect.Poll != nil {
+			suite.Equal(expect.Poll.ID, status.Poll.ID)
+		}
+		suite.True(status.AttachmentsPopulated())
+		suite.True(status.TagsPopulated())
+		suite.True(status.MentionsPopulated())
+		suite.True(status.EmojisPopulated())
+	}
+}
+
 func (suite *StatusTestSuite) TestGetStatusByURI() {
 	status, err := suite.db.GetStatusByURI(suite.T().Context(), suite.testStatuses["local_account_2_status_3"].URI)
 	if err != nil {
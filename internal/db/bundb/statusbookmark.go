@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"slices"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gopkg/xslices"
@@ -192,6 +193,30 @@ func (s *statusBookmarkDB) PopulateStatusBookmark(ctx context.Context, bookmark
 }
 
 func (s *statusBookmarkDB) GetStatusBookmarks(ctx context.Context, accountID string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error) {
+	if accountID == "" {
+		return nil, errors.New("must provide an account")
+	}
+
+	return s.getStatusBookmarksPage(ctx,
+		bun.Ident("status_bookmark.account_id"), accountID,
+		limit, maxID, minID,
+	)
+}
+
+func (s *statusBookmarkDB) GetStatusBookmarksByFolderID(ctx context.Context, folderID string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error) {
+	if folderID == "" {
+		return nil, errors.New("must provide a folder")
+	}
+
+	return s.getStatusBookmarksPage(ctx,
+		bun.Ident("status_bookmark.folder_id"), folderID,
+		limit, maxID, minID,
+	)
+}
+
+// getStatusBookmarksPage selects a page of status bookmarks
+// where given column equals value, ordered by ID descending.
+func (s *statusBookmarkDB) getStatusBookmarksPage(ctx context.Context, column bun.Ident, value string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error) {
 	// Ensure reasonable
 	if limit < 0 {
 		limit = 0
@@ -204,13 +229,9 @@ func (s *statusBookmarkDB) GetStatusBookmarks(ctx context.Context, accountID str
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("status_bookmarks"), bun.Ident("status_bookmark")).
 		Column("status_bookmark.id").
-		Where("? = ?", bun.Ident("status_bookmark.account_id"), accountID).
+		Where("? = ?", column, value).
 		Order("status_bookmark.id DESC")
 
-	if accountID == "" {
-		return nil, errors.New("must provide an account")
-	}
-
 	if maxID != "" {
 		q = q.Where("? < ?", bun.Ident("status_bookmark.id"), maxID)
 	}
@@ -256,6 +277,23 @@ func (s *statusBookmarkDB) PutStatusBookmark(ctx context.Context, bookmark *gtsm
 	})
 }
 
+func (s *statusBookmarkDB) UpdateStatusBookmark(ctx context.Context, bookmark *gtsmodel.StatusBookmark, columns ...string) error {
+	bookmark.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return s.state.Caches.DB.StatusBookmark.Store(bookmark, func() error {
+		_, err := s.db.NewUpdate().
+			Model(bookmark).
+			Where("? = ?", bun.Ident("status_bookmark.id"), bookmark.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
 func (s *statusBookmarkDB) DeleteStatusBookmarkByID(ctx context.Context, id string) error {
 	// Gather necessary fields from
 	// deleted for cache invaliation.
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type statusCleanupDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *statusCleanupDB) GetStatusCleanupByID(ctx context.Context, id string) (*gtsmodel.StatusCleanup, error) {
+	cleanup := new(gtsmodel.StatusCleanup)
+
+	if err := s.db.
+		NewSelect().
+		Model(cleanup).
+		Where("? = ?", bun.Ident("status_cleanup.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return cleanup, nil
+}
+
+func (s *statusCleanupDB) GetStatusCleanupsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.StatusCleanup, error) {
+	var cleanups []*gtsmodel.StatusCleanup
+
+	if err := s.db.
+		NewSelect().
+		Model(&cleanups).
+		Where("? = ?", bun.Ident("status_cleanup.account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("status_cleanup.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return cleanups, nil
+}
+
+func (s *statusCleanupDB) GetUncompletedStatusCleanups(ctx context.Context) ([]*gtsmodel.StatusCleanup, error) {
+	var cleanups []*gtsmodel.StatusCleanup
+
+	if err := s.db.
+		NewSelect().
+		Model(&cleanups).
+		Where("? IS NULL", bun.Ident("status_cleanup.completed_at")).
+		OrderExpr("? ASC", bun.Ident("status_cleanup.id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return cleanups, nil
+}
+
+func (s *statusCleanupDB) PutStatusCleanup(ctx context.Context, cleanup *gtsmodel.StatusCleanup) error {
+	_, err := s.db.
+		NewInsert().
+		Model(cleanup).
+		Exec(ctx)
+	return err
+}
+
+func (s *statusCleanupDB) UpdateStatusCleanup(ctx context.Context, cleanup *gtsmodel.StatusCleanup, columns ...string) error {
+	cleanup.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := s.db.
+		NewUpdate().
+		Model(cleanup).
+		Where("? = ?", bun.Ident("status_cleanup.id"), cleanup.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (s *statusCleanupDB) DeleteStatusCleanupsByAccountID(ctx context.Context, accountID string) error {
+	_, err := s.db.
+		NewDelete().
+		Table("status_cleanups").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
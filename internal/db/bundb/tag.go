@@ -21,7 +21,7 @@
 	"context"
 	"errors"
 	"slices"
-	"strings"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/xslices"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
@@ -29,6 +29,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"github.com/uptrace/bun"
 )
 
@@ -56,8 +57,7 @@ func (t *tagDB) GetTag(ctx context.Context, id string) (*gtsmodel.Tag, error) {
 
 func (t *tagDB) GetTagByName(ctx context.Context, name string) (*gtsmodel.Tag, error) {
 	// Normalize 'name' string.
-	name = strings.TrimSpace(name)
-	name = strings.ToLower(name)
+	name = text.FoldHashtag(name)
 
 	return t.state.Caches.DB.Tag.LoadOne("Name", func() (*gtsmodel.Tag, error) {
 		var tag gtsmodel.Tag
@@ -116,8 +116,7 @@ func (t *tagDB) PutTag(ctx context.Context, tag *gtsmodel.Tag) error {
 	*t2 = *tag
 
 	// Normalize name on new pointer.
-	t2.Name = strings.TrimSpace(t2.Name)
-	t2.Name = strings.ToLower(t2.Name)
+	t2.Name = text.FoldHashtag(t2.Name)
 
 	// Insert the copy.
 	if err := t.state.Caches.DB.Tag.Store(t2, func() error {
@@ -137,6 +136,154 @@ func (t *tagDB) PutTag(ctx context.Context, tag *gtsmodel.Tag) error {
 	return nil
 }
 
+func (t *tagDB) GetTagAliases(ctx context.Context) ([]*gtsmodel.Tag, error) {
+	var tagIDs []string
+
+	// Select IDs of all tags that are aliases.
+	if err := t.db.
+		NewSelect().
+		Table("tags").
+		Column("id").
+		Where("? IS NOT NULL", bun.Ident("alias_of_id")).
+		OrderExpr("? ASC", bun.Ident("name")).
+		Scan(ctx, &tagIDs); err != nil {
+		return nil, err
+	}
+
+	if len(tagIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return t.GetTags(ctx, tagIDs)
+}
+
+func (t *tagDB) SetTagAliasOf(ctx context.Context, tag *gtsmodel.Tag, aliasOfID string) error {
+	if aliasOfID == tag.ID {
+		return gtserror.Newf("tag %s cannot be an alias of itself", tag.ID)
+	}
+
+	var (
+		// IDs of tags that were aliases of
+		// tag, now re-pointed to aliasOfID.
+		aliasIDs []string
+
+		// IDs of accounts whose follows of tag
+		// have been moved over to aliasOfID.
+		followerIDs []string
+	)
+
+	// Keep hold of the previous alias
+	// target for cache invalidation.
+	prevAliasOfID := tag.AliasOfID
+
+	tag.AliasOfID = aliasOfID
+	tag.UpdatedAt = time.Now()
+
+	if err := t.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.
+			NewUpdate().
+			Model(tag).
+			Column("alias_of_id", "updated_at").
+			Where("? = ?", bun.Ident("tag.id"), tag.ID).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error updating tag: %w", err)
+		}
+
+		if aliasOfID == "" {
+			// Alias unset,
+			// nothing to move.
+			return nil
+		}
+
+		// Re-point any aliases of this tag to
+		// the new target, so we never have to
+		// follow a chain of aliases to the end.
+		if _, err := tx.
+			NewUpdate().
+			Table("tags").
+			Set("? = ?", bun.Ident("alias_of_id"), aliasOfID).
+			Where("? = ?", bun.Ident("alias_of_id"), tag.ID).
+			Returning("?", bun.Ident("id")).
+			Exec(ctx, &aliasIDs); err != nil {
+			return gtserror.Newf("error updating aliases of tag: %w", err)
+		}
+
+		// Delete follows of this tag, returning the
+		// IDs of the following accounts so that we
+		// can recreate the follows for the target.
+		if _, err := tx.
+			NewDelete().
+			Model((*gtsmodel.FollowedTag)(nil)).
+			Where("? = ?", bun.Ident("tag_id"), tag.ID).
+			Returning("?", bun.Ident("account_id")).
+			Exec(ctx, &followerIDs); err != nil {
+			return gtserror.Newf("error deleting follows of tag: %w", err)
+		}
+
+		if len(followerIDs) == 0 {
+			// No follows
+			// to move.
+			return nil
+		}
+
+		follows := make([]*gtsmodel.FollowedTag, len(followerIDs))
+		for i, accountID := range followerIDs {
+			follows[i] = &gtsmodel.FollowedTag{
+				AccountID: accountID,
+				TagID:     aliasOfID,
+			}
+		}
+
+		// Insert follows of the target tag,
+		// skipping accounts that already do.
+		if _, err := tx.
+			NewInsert().
+			Model(&follows).
+			On("CONFLICT (?, ?) DO NOTHING", bun.Ident("account_id"), bun.Ident("tag_id")).
+			Exec(ctx); err != nil {
+			return gtserror.Newf("error inserting follows of alias target tag: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Invalidate all changed tags.
+	t.state.Caches.DB.Tag.Invalidate("ID", tag.ID)
+	for _, id := range aliasIDs {
+		t.state.Caches.DB.Tag.Invalidate("ID", id)
+	}
+
+	if aliasOfID != "" {
+		// Invalidate tag follows of
+		// both tags + moved followers.
+		keys := make([]string, 0, 2+len(followerIDs))
+		keys = append(keys, "<"+tag.ID, "<"+aliasOfID)
+		for _, accountID := range followerIDs {
+			keys = append(keys, ">"+accountID)
+		}
+		t.state.Caches.DB.FollowingTagIDs.Invalidate(keys...)
+
+		// The target tag's timeline
+		// now contains tag's statuses.
+		t.state.Caches.Timelines.Tag.Clear(aliasOfID)
+	}
+
+	if prevAliasOfID != "" {
+		// The previous target tag's timeline
+		// no longer contains tag's statuses.
+		t.state.Caches.Timelines.Tag.Clear(prevAliasOfID)
+	}
+
+	// Tag's timeline is no longer used
+	// if it's an alias, and would be
+	// out of date if no longer one.
+	t.state.Caches.Timelines.Tag.Clear(tag.ID)
+
+	return nil
+}
+
 func (t *tagDB) GetFollowedTags(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Tag, error) {
 	tagIDs, err := t.getTagIDsFollowedByAccount(ctx, accountID, page)
 	if err != nil {
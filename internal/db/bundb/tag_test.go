@@ -88,6 +88,108 @@ func (suite *TagTestSuite) TestPutTag() {
 	}
 }
 
+func (suite *TagTestSuite) TestPutTagUnicode() {
+	// Name is case-folded and composed when
+	// doing inserts to the db, so these should
+	// all yield the same result as each other.
+	for _, names := range [][]string{
+		{"café", "cafe\u0301", "CAFÉ", "CAFE\u0301"},
+		{"straße", "STRASSE", "Strasse"},
+	} {
+		for i, name := range names {
+			err := suite.db.PutTag(suite.T().Context(), &gtsmodel.Tag{
+				ID:   id.NewULID(),
+				Name: name,
+			})
+			if i == 0 {
+				suite.NoError(err)
+				continue
+			}
+			suite.ErrorIs(err, db.ErrAlreadyExists)
+		}
+
+		// And selects should all
+		// yield the same tag again.
+		first, err := suite.db.GetTagByName(suite.T().Context(), names[0])
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		for _, name := range names[1:] {
+			dbTag, err := suite.db.GetTagByName(suite.T().Context(), name)
+			suite.NoError(err)
+			suite.Equal(first.ID, dbTag.ID)
+		}
+	}
+}
+
+func (suite *TagTestSuite) TestSetTagAliasOf() {
+	var (
+		ctx         = suite.T().Context()
+		welcomeTag  = suite.testTags["welcome"]
+		hashtagTag  = suite.testTags["Hashtag"]
+		testAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Follow the tag that's going to be aliased.
+	if err := suite.db.PutFollowedTag(ctx, testAccount.ID, welcomeTag.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tag, err := suite.db.GetTag(ctx, welcomeTag.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.SetTagAliasOf(ctx, tag, hashtagTag.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Tag should now be an alias.
+	tag, err = suite.db.GetTag(ctx, welcomeTag.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(hashtagTag.ID, tag.AliasOfID)
+
+	aliases, err := suite.db.GetTagAliases(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(aliases, 1)
+	suite.Equal(welcomeTag.ID, aliases[0].ID)
+
+	// Follow should have been moved over.
+	following, err := suite.db.IsAccountFollowingTag(ctx, testAccount.ID, welcomeTag.ID)
+	suite.NoError(err)
+	suite.False(following)
+
+	following, err = suite.db.IsAccountFollowingTag(ctx, testAccount.ID, hashtagTag.ID)
+	suite.NoError(err)
+	suite.True(following)
+
+	// Statuses tagged with the alias should
+	// show up in the aliased tag's timeline.
+	statuses, err := suite.db.GetTagTimeline(ctx, hashtagTag.ID, toPage("", "", "", 20))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(statuses, 1)
+	suite.Equal(suite.testStatuses["admin_account_status_1"].ID, statuses[0].ID)
+
+	// Unset the alias again.
+	if err := suite.db.SetTagAliasOf(ctx, tag, ""); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statuses, err = suite.db.GetTagTimeline(ctx, hashtagTag.ID, toPage("", "", "", 20))
+	suite.NoError(err)
+	suite.Empty(statuses)
+
+	_, err = suite.db.GetTagAliases(ctx)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestTagTestSuite(t *testing.T) {
 	suite.Run(t, new(TagTestSuite))
 }
@@ -115,3 +115,77 @@ func (t *threadDB) DeleteThreadMute(ctx context.Context, id string) error {
 	t.state.Caches.DB.ThreadMute.Invalidate("ID", id)
 	return nil
 }
+
+func (t *threadDB) GetThreadSubscribedByAccount(
+	ctx context.Context,
+	threadID string,
+	accountID string,
+) (*gtsmodel.ThreadSubscription, error) {
+	return t.state.Caches.DB.ThreadSubscription.LoadOne("ThreadID,AccountID", func() (*gtsmodel.ThreadSubscription, error) {
+		var threadSubscription gtsmodel.ThreadSubscription
+
+		q := t.db.
+			NewSelect().
+			Model(&threadSubscription).
+			Where("? = ?", bun.Ident("thread_subscription.thread_id"), threadID).
+			Where("? = ?", bun.Ident("thread_subscription.account_id"), accountID)
+
+		if err := q.Scan(ctx); err != nil {
+			return nil, err
+		}
+
+		return &threadSubscription, nil
+	}, threadID, accountID)
+}
+
+func (t *threadDB) IsThreadSubscribedByAccount(
+	ctx context.Context,
+	threadID string,
+	accountID string,
+) (bool, error) {
+	if threadID == "" {
+		return false, nil
+	}
+
+	subscription, err := t.GetThreadSubscribedByAccount(ctx, threadID, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, err
+	}
+
+	return (subscription != nil), nil
+}
+
+func (t *threadDB) GetThreadSubscriberIDs(ctx context.Context, threadID string) ([]string, error) {
+	var accountIDs []string
+
+	if err := t.db.
+		NewSelect().
+		Table("thread_subscriptions").
+		Column("account_id").
+		Where("? = ?", bun.Ident("thread_id"), threadID).
+		Order("account_id ASC").
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (t *threadDB) PutThreadSubscription(ctx context.Context, threadSubscription *gtsmodel.ThreadSubscription) error {
+	return t.state.Caches.DB.ThreadSubscription.Store(threadSubscription, func() error {
+		_, err := t.db.NewInsert().Model(threadSubscription).Exec(ctx)
+		return err
+	})
+}
+
+func (t *threadDB) DeleteThreadSubscription(ctx context.Context, id string) error {
+	if _, err := t.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("thread_subscriptions"), bun.Ident("thread_subscription")).
+		Where("? = ?", bun.Ident("thread_subscription.id"), id).Exec(ctx); err != nil {
+		return err
+	}
+
+	t.state.Caches.DB.ThreadSubscription.Invalidate("ID", id)
+	return nil
+}
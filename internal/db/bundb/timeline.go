@@ -116,72 +116,67 @@ func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
 	)
 }
 
-// TODO optimize this query and the logic here, because it's slow as balls -- it takes like a literal second to return with a limit of 20!
-// It might be worth serving it through a timeline instead of raw DB queries, like we do for Home feeds.
-func (t *timelineDB) GetFavedTimeline(ctx context.Context, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.Status, string, string, error) {
-
-	// Ensure reasonable
-	if limit < 0 {
-		limit = 0
-	}
-
-	// Make educated guess for slice size
-	faves := make([]*gtsmodel.StatusFave, 0, limit)
+func (t *timelineDB) GetFavedTimeline(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.StatusFave, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		faves = make([]*gtsmodel.StatusFave, 0, limit)
+	)
 
-	fq := t.db.
+	// Select faves by the account. Note that
+	// paging is done on the fave ID, not the
+	// status ID, so that paging remains stable
+	// even if faves are removed in the meantime.
+	q := t.db.
 		NewSelect().
 		Model(&faves).
-		Where("? = ?", bun.Ident("status_fave.account_id"), accountID).
-		Order("status_fave.id DESC")
+		Where("? = ?", bun.Ident("status_fave.account_id"), accountID)
 
+	// Add paging param max ID.
 	if maxID != "" {
-		fq = fq.Where("? < ?", bun.Ident("status_fave.id"), maxID)
+		q = q.Where("? < ?", bun.Ident("status_fave.id"), maxID)
 	}
 
+	// Add paging param min ID.
 	if minID != "" {
-		fq = fq.Where("? > ?", bun.Ident("status_fave.id"), minID)
+		q = q.Where("? > ?", bun.Ident("status_fave.id"), minID)
 	}
 
-	if limit > 0 {
-		fq = fq.Limit(limit)
+	// Add paging param order.
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("status_fave.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("status_fave.id"))
 	}
 
-	err := fq.Scan(ctx)
-	if err != nil {
-		return nil, "", "", err
+	// Add paging param limit.
+	if limit > 0 {
+		q = q.Limit(limit)
 	}
 
-	if len(faves) == 0 {
-		return nil, "", "", db.ErrNoEntries
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
 	}
 
-	// Sort by favourite ID rather than status ID
-	slices.SortFunc(faves, func(a, b *gtsmodel.StatusFave) int {
-		const k = -1
-		switch {
-		case a.ID > b.ID:
-			return +k
-		case a.ID < b.ID:
-			return -k
-		default:
-			return 0
-		}
-	})
-
-	// Convert fave IDs to status IDs.
-	statusIDs := make([]string, len(faves))
-	for i, fave := range faves {
-		statusIDs[i] = fave.StatusID
+	// Catch case of no items early
+	if len(faves) == 0 {
+		return nil, db.ErrNoEntries
 	}
 
-	statuses, err := t.state.DB.GetStatusesByIDs(ctx, statusIDs)
-	if err != nil {
-		return nil, "", "", err
+	// If we're paging up, we still want faves
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(faves)
 	}
 
-	nextMaxID := faves[len(faves)-1].ID
-	prevMinID := faves[0].ID
-	return statuses, nextMaxID, prevMinID, nil
+	return faves, nil
 }
 
 func (t *timelineDB) GetListTimeline(ctx context.Context, listID string, page *paging.Page) ([]*gtsmodel.Status, error) {
@@ -241,8 +236,19 @@ func(q *bun.SelectQuery) (*bun.SelectQuery, error) {
 				bun.Ident("status.id"), bun.Ident("status_to_tags.status_id"),
 			)
 
-			// This tag only.
-			q = q.Where("? = ?", bun.Ident("status_to_tags.tag_id"), tagID)
+			// This tag, and any tags that are aliases of it.
+			q = q.Where("? IN (?)",
+				bun.Ident("status_to_tags.tag_id"),
+				t.db.NewSelect().
+					Table("tags").
+					Column("id").
+					Where("? = ?", bun.Ident("id"), tagID).
+					WhereOr("? = ?", bun.Ident("alias_of_id"), tagID),
+			)
+
+			// A status may use more than
+			// one of the aliased tags.
+			q = q.Distinct()
 
 			// Public only.
 			q = q.Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic)
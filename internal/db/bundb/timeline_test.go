@@ -349,6 +349,50 @@ func (suite *TimelineTestSuite) TestGetTagTimelineNoParams() {
 	suite.Equal("01F8MH75CBF9JFX4ZAD54N0W0R", s[0].ID)
 }
 
+func (suite *TimelineTestSuite) TestGetFavedTimeline() {
+	var (
+		ctx     = suite.T().Context()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	getFaveIDs := func(page *paging.Page) []string {
+		faves, err := suite.db.GetFavedTimeline(ctx, account.ID, page)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		ids := make([]string, len(faves))
+		for i, fave := range faves {
+			ids[i] = fave.ID
+		}
+		return ids
+	}
+
+	// Newest faves first.
+	suite.Equal([]string{
+		"01GM43CC47DRPNZZ7BD04BS1YZ",
+		"01GM43AKBMN4YNXQ1HZHVC1SGB",
+	}, getFaveIDs(toPage("", "", "", 2)))
+
+	// Paging up from the oldest fave should return
+	// the faves immediately newer, still sorted desc.
+	suite.Equal([]string{
+		"01GM43AKBMN4YNXQ1HZHVC1SGB",
+		"01GM435XERVPXXRK6NBAHK5HCZ",
+	}, getFaveIDs(toPage("", "", "01F8MHD2QCZSZ6WQS2ATVPEYJ9", 2)))
+
+	// Unfave the status used as max_id. Paging
+	// down from that fave ID should still work.
+	if err := suite.db.DeleteStatusFaveByID(ctx, "01GM43AKBMN4YNXQ1HZHVC1SGB"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]string{
+		"01GM435XERVPXXRK6NBAHK5HCZ",
+		"01F8MHD2QCZSZ6WQS2ATVPEYJ9",
+	}, getFaveIDs(toPage("01GM43AKBMN4YNXQ1HZHVC1SGB", "", "", 2)))
+}
+
 func TestTimelineTestSuite(t *testing.T) {
 	suite.Run(t, new(TimelineTestSuite))
 }
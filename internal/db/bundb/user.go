@@ -135,6 +135,28 @@ func(user *gtsmodel.User) error {
 	)
 }
 
+func (u *userDB) GetUserByResetPasswordToken(ctx context.Context, token string) (*gtsmodel.User, error) {
+	var userID string
+
+	// Find the user ID with given reset token. Reset
+	// tokens are rarely looked up, and are cleared once
+	// used, so we don't keep a cache index for them.
+	if err := u.db.NewSelect().
+		Table("users").
+		Column("id").
+		Where("? = ?", bun.Ident("reset_password_token"), token).
+		Scan(ctx, &userID); err != nil {
+		return nil, err
+	}
+
+	// Reset tokens are set by the CLI, in a separate
+	// process, so any cached copy of this user will be
+	// stale. Drop it so the user is reloaded from the db.
+	u.state.Caches.DB.User.Invalidate("ID", userID)
+
+	return u.GetUserByID(ctx, userID)
+}
+
 func (u *userDB) getUser(ctx context.Context, lookup string, dbQuery func(*gtsmodel.User) error, keyParts ...any) (*gtsmodel.User, error) {
 	// Fetch user from database cache with loader callback.
 	user, err := u.state.Caches.DB.User.LoadOne(lookup, func() (*gtsmodel.User, error) {
@@ -0,0 +1,147 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"net"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type userLoginDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (u *userLoginDB) GetUserLogins(
+	ctx context.Context,
+	userID string,
+	page *paging.Page,
+) ([]*gtsmodel.UserLogin, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size.
+		logins = make([]*gtsmodel.UserLogin, 0, limit)
+	)
+
+	q := u.db.
+		NewSelect().
+		Model(&logins).
+		Where("? = ?", bun.Ident("user_login.user_id"), userID)
+
+	if maxID != "" {
+		// Return only logins LOWER (ie., older) than maxID.
+		q = q.Where("? < ?", bun.Ident("user_login.id"), maxID)
+	}
+
+	if minID != "" {
+		// Return only logins HIGHER (ie., newer) than minID.
+		q = q.Where("? > ?", bun.Ident("user_login.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.Order("user_login.id ASC")
+	} else {
+		// Page down.
+		q = q.Order("user_login.id DESC")
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// If we're paging up, we still want logins
+	// to be sorted by ID desc (ie., newest to
+	// oldest), so reverse the slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(logins)
+	}
+
+	return logins, nil
+}
+
+func (u *userLoginDB) HasUserLogins(ctx context.Context, userID string) (bool, error) {
+	return u.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("user_logins"), bun.Ident("user_login")).
+		Where("? = ?", bun.Ident("user_login.user_id"), userID).
+		Where("? = ?", bun.Ident("user_login.successful"), true).
+		Exists(ctx)
+}
+
+func (u *userLoginDB) IsUserLoginIPSeen(ctx context.Context, userID string, ip net.IP) (bool, error) {
+	return u.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("user_logins"), bun.Ident("user_login")).
+		Where("? = ?", bun.Ident("user_login.user_id"), userID).
+		Where("? = ?", bun.Ident("user_login.successful"), true).
+		Where("? = ?", bun.Ident("user_login.ip"), ip).
+		Exists(ctx)
+}
+
+func (u *userLoginDB) PutUserLogin(ctx context.Context, login *gtsmodel.UserLogin) error {
+	_, err := u.db.
+		NewInsert().
+		Model(login).
+		Exec(ctx)
+	return err
+}
+
+func (u *userLoginDB) DeleteUserLoginsByUserID(ctx context.Context, userID string) error {
+	_, err := u.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("user_logins"), bun.Ident("user_login")).
+		Where("? = ?", bun.Ident("user_login.user_id"), userID).
+		Exec(ctx)
+	return err
+}
+
+func (u *userLoginDB) DeleteUserLoginsOlderThan(ctx context.Context, olderThan time.Time) (int, error) {
+	result, err := u.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("user_logins"), bun.Ident("user_login")).
+		Where("? < ?", bun.Ident("user_login.created_at"), olderThan).
+		Exec(ctx)
+	if err != nil {
+		return 0, gtserror.Newf("error deleting user logins: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, gtserror.Newf("error getting deleted row count: %w", err)
+	}
+
+	return int(rows), nil
+}
@@ -56,3 +56,27 @@ func (w *workerTaskDB) DeleteWorkerTaskByID(ctx context.Context, id uint) error
 		Exec(ctx)
 	return err
 }
+
+func (w *workerTaskDB) GetPausedDeliveries(ctx context.Context) ([]*gtsmodel.PausedDelivery, error) {
+	var deliveries []*gtsmodel.PausedDelivery
+	if err := w.db.NewSelect().
+		Model(&deliveries).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (w *workerTaskDB) PutPausedDelivery(ctx context.Context, delivery *gtsmodel.PausedDelivery) error {
+	_, err := w.db.NewInsert().Model(delivery).Exec(ctx)
+	return err
+}
+
+func (w *workerTaskDB) DeletePausedDeliveryByID(ctx context.Context, id uint) error {
+	_, err := w.db.NewDelete().
+		Table("paused_deliveries").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
@@ -25,15 +25,25 @@
 // DB provides methods for interacting with an underlying database or other storage mechanism.
 type DB interface {
 	Account
+	AccountCustomDomain
+	AccountWarning
 	Admin
 	AdvancedMigration
+	Announcement
+	Appeal
 	Application
 	Basic
+	BlocklistSubscription
+	BookmarkFolder
+	Card
 	Conversation
 	Domain
 	Emoji
+	FailedActivity
+	FederationStat
 	HeaderFilter
 	Instance
+	Invite
 	Interaction
 	Filter
 	List
@@ -44,20 +54,24 @@ type DB interface {
 	Notification
 	Poll
 	Relationship
+	Relay
 	Report
 	Rule
 	ScheduledStatus
 	Search
+	SeenActivity
 	Session
 	SinBinStatus
 	Status
 	StatusBookmark
+	StatusCleanup
 	StatusEdit
 	StatusFave
 	Tag
 	Thread
 	Timeline
 	User
+	UserLogin
 	Tombstone
 	WebPush
 	WorkerTask
@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// FailedActivity handles getting/creation/deletion
+// of inbound activities that failed processing.
+type FailedActivity interface {
+	// GetFailedActivityByID gets one failed activity by its db id.
+	GetFailedActivityByID(ctx context.Context, id string) (*gtsmodel.FailedActivity, error)
+
+	// GetFailedActivities gets a page of failed activities, optionally
+	// filtered by activity type and / or failure reason (if set).
+	GetFailedActivities(
+		ctx context.Context,
+		activityType string,
+		reason gtsmodel.FailureReason,
+		page *paging.Page,
+	) ([]*gtsmodel.FailedActivity, error)
+
+	// CountFailedActivities counts stored failed activities,
+	// grouped by activity type, object type and failure reason.
+	// The returned counts are cached, and must not be modified.
+	CountFailedActivities(ctx context.Context) ([]*gtsmodel.FailedActivityCount, error)
+
+	// PopulateFailedActivity populates the struct pointers on the given failed activity.
+	PopulateFailedActivity(ctx context.Context, failed *gtsmodel.FailedActivity) error
+
+	// PutFailedActivity puts the given failed activity in the database.
+	PutFailedActivity(ctx context.Context, failed *gtsmodel.FailedActivity) error
+
+	// DeleteFailedActivityByID deletes one failed activity by its db id.
+	DeleteFailedActivityByID(ctx context.Context, id string) error
+
+	// DeleteFailedActivitiesOlderThan deletes all failed activities created
+	// before the given time, returning the number of failed activities deleted.
+	DeleteFailedActivitiesOlderThan(ctx context.Context, olderThan time.Time) (int, error)
+
+	// DeleteFailedActivitiesOverLimit deletes all but the newest limit failed
+	// activities, returning the number of failed activities deleted.
+	DeleteFailedActivitiesOverLimit(ctx context.Context, limit int) (int, error)
+}
@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// FederationStat handles storing and getting
+// per-domain federation traffic counters.
+type FederationStat interface {
+	// GetFederationStats gets up to limit federation stats, sorted
+	// by the given column. Valid sort columns are "domain",
+	// "activities_received", "activities_sent", "media_bytes",
+	// and "last_seen_at". Ties are broken by domain ascending.
+	GetFederationStats(ctx context.Context, sort string, ascending bool, limit int) ([]*gtsmodel.FederationStat, error)
+
+	// IncrementFederationStats adds the counters of each given
+	// stat to the stored totals for its domain, creating the
+	// row if it doesn't yet exist. LastSeenAt is only updated
+	// if it's set on the given stat.
+	IncrementFederationStats(ctx context.Context, stats []*gtsmodel.FederationStat) error
+}
@@ -19,8 +19,10 @@
 
 import (
 	"context"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 )
 
 // Instance contains functions for instance-level actions (counting instance users etc.).
@@ -28,6 +30,15 @@ type Instance interface {
 	// CountInstanceUsers returns the number of known accounts registered with the given domain.
 	CountInstanceUsers(ctx context.Context, domain string) (int, error)
 
+	// CountLocalActiveUsers returns the number of local, non-suspended accounts that
+	// have used an access token, or posted a status, at or after the given time.
+	CountLocalActiveUsers(ctx context.Context, since time.Time) (int, error)
+
+	// CountLocalActivity returns the number of statuses posted by local accounts, the number
+	// of distinct local users who successfully signed in, and the number of local users who
+	// signed up, all at or after the given start time, and before the given end time.
+	CountLocalActivity(ctx context.Context, start time.Time, end time.Time) (statuses int, logins int, registrations int, err error)
+
 	// CountInstanceStatuses returns the number of known statuses posted from the given domain.
 	CountInstanceStatuses(ctx context.Context, domain string) (int, error)
 
@@ -55,6 +66,10 @@ type Instance interface {
 	// GetInstancePeers returns a slice of instances that the host instance knows about.
 	GetInstancePeers(ctx context.Context, includeSuspended bool) ([]*gtsmodel.Instance, error)
 
+	// GetInstances returns a page of remote instances that the host instance knows about,
+	// optionally filtered by (nodeinfo) software name, and software version prefix.
+	GetInstances(ctx context.Context, software string, version string, page *paging.Page) ([]*gtsmodel.Instance, error)
+
 	// GetInstanceModeratorAddresses returns a slice of email addresses belonging to active
 	// (as in, not suspended) moderators + admins on this instance.
 	GetInstanceModeratorAddresses(ctx context.Context) ([]string, error)
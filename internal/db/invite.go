@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Invite handles getting/creation/updating of
+// invites created by local accounts for sign-up.
+type Invite interface {
+	// GetInviteByID gets one invite by its db id.
+	GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error)
+
+	// GetInviteByCode gets one invite by its code.
+	GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error)
+
+	// GetInvites gets all invites, optionally only those
+	// created by the given account ID, ordered by ID descending.
+	GetInvites(ctx context.Context, accountID string) ([]*gtsmodel.Invite, error)
+
+	// PutInvite puts the given invite in the database.
+	PutInvite(ctx context.Context, invite *gtsmodel.Invite) error
+
+	// UpdateInvite updates the given invite.
+	// Columns is optional, if not specified all will be updated.
+	UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) error
+
+	// IncrementInviteUses increments the uses of the invite with given
+	// id, as long as it's not used up, revoked, or expired. Returns
+	// ErrNoEntries if the invite couldn't be used.
+	IncrementInviteUses(ctx context.Context, id string) error
+
+	// DecrementInviteUses decrements the uses of the invite with given
+	// id, undoing an IncrementInviteUses for a sign-up that then failed.
+	DecrementInviteUses(ctx context.Context, id string) error
+
+	// DeleteInvitesByAccountID deletes all
+	// invites created by the given account ID.
+	DeleteInvitesByAccountID(ctx context.Context, accountID string) error
+}
@@ -50,6 +50,12 @@ type Media interface {
 	// GetRemoteAttachments fetches media attachments with a non-empty domain, with given paging parameters.
 	GetRemoteAttachments(ctx context.Context, page *paging.Page) ([]*gtsmodel.MediaAttachment, error)
 
+	// GetRemoteAttachmentsByDomain fetches remote media attachments owned by accounts on the given domain, with given paging parameters.
+	GetRemoteAttachmentsByDomain(ctx context.Context, domain string, page *paging.Page) ([]*gtsmodel.MediaAttachment, error)
+
 	// GetCachedAttachments fetches cached media attachments with a non-empty domain, with given paging parameters.
 	GetCachedAttachments(ctx context.Context, page *paging.Page) ([]*gtsmodel.MediaAttachment, error)
+
+	// GetAccountMediaUsage calculates the locally stored media footprint of the given account ID.
+	GetAccountMediaUsage(ctx context.Context, accountID string) (*gtsmodel.AccountMediaUsage, error)
 }
@@ -32,6 +32,10 @@ type Notification interface {
 	// If types is empty, *all* notification types will be included.
 	GetAccountNotifications(ctx context.Context, accountID string, page *paging.Page, types []gtsmodel.NotificationType, excludeTypes []gtsmodel.NotificationType) ([]*gtsmodel.Notification, error)
 
+	// CountAccountNotificationsAfter counts the notifications targeting the
+	// given account ID with an ID greater (ie., newer) than the given minID.
+	CountAccountNotificationsAfter(ctx context.Context, accountID string, minID string) (int, error)
+
 	// GetNotificationByID returns one notification according to its id.
 	GetNotificationByID(ctx context.Context, id string) (*gtsmodel.Notification, error)
 
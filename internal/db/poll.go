@@ -27,6 +27,9 @@ type Poll interface {
 	// GetPollByID fetches the Poll with given ID from the database.
 	GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, error)
 
+	// GetPollsByIDs fetches the Polls with given IDs from the database.
+	GetPollsByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Poll, error)
+
 	// GetOpenPolls fetches all local Polls in the database with an unset `closed_at` column.
 	GetOpenPolls(ctx context.Context) ([]*gtsmodel.Poll, error)
 
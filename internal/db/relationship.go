@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
@@ -59,6 +60,10 @@ type Relationship interface {
 	// GetRelationship retrieves the relationship of the targetAccount to the requestingAccount.
 	GetRelationship(ctx context.Context, requestingAccount string, targetAccount string) (*gtsmodel.Relationship, error)
 
+	// GetRelationships retrieves the relationships of the targetAccounts to the requestingAccount, in the same
+	// order as given. This uses a single query per relationship type, rather than per relationship type per account.
+	GetRelationships(ctx context.Context, requestingAccount string, targetAccounts []string) ([]*gtsmodel.Relationship, error)
+
 	// GetFollowByID fetches follow with given ID from the database.
 	GetFollowByID(ctx context.Context, id string) (*gtsmodel.Follow, error)
 
@@ -92,6 +97,10 @@ type Relationship interface {
 	// IsMutualFollowing returns true if account1 and account2 both follow each other, or an error if something goes wrong while finding out.
 	IsMutualFollowing(ctx context.Context, sourceAccountID string, targetAccountID string) (bool, error)
 
+	// GetExpiredFollowRequests returns up to limit of the oldest follow requests
+	// targeting local accounts, which were created before the given time.
+	GetExpiredFollowRequests(ctx context.Context, olderThan time.Time, limit int) ([]*gtsmodel.FollowRequest, error)
+
 	// IsFollowRequested returns true if sourceAccount has requested to follow target account, or an error if something goes wrong while finding out.
 	IsFollowRequested(ctx context.Context, sourceAccountID string, targetAccountID string) (bool, error)
 
@@ -194,6 +203,9 @@ type Relationship interface {
 	// GetNote gets a private note from a source account on a target account, if it exists.
 	GetNote(ctx context.Context, sourceAccountID string, targetAccountID string) (*gtsmodel.AccountNote, error)
 
+	// GetNotesByAccountID gets all private notes created by the given account.
+	GetNotesByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.AccountNote, error)
+
 	// PutNote creates or updates a private note.
 	PutNote(ctx context.Context, note *gtsmodel.AccountNote) error
 
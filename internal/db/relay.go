@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Relay handles getting/creation/deletion of relay subscriptions.
+type Relay interface {
+	// GetRelayByID gets one relay by its db id.
+	GetRelayByID(ctx context.Context, id string) (*gtsmodel.Relay, error)
+
+	// GetRelayByAccountID gets one relay by the db id of its actor account.
+	GetRelayByAccountID(ctx context.Context, accountID string) (*gtsmodel.Relay, error)
+
+	// GetRelays gets all relays, ordered by ID descending.
+	GetRelays(ctx context.Context) ([]*gtsmodel.Relay, error)
+
+	// PopulateRelay populates the struct pointers on the given relay.
+	PopulateRelay(ctx context.Context, relay *gtsmodel.Relay) error
+
+	// PutRelay puts the given relay in the database.
+	PutRelay(ctx context.Context, relay *gtsmodel.Relay) error
+
+	// DeleteRelayByID deletes one relay by its db id.
+	DeleteRelayByID(ctx context.Context, id string) error
+}
@@ -25,7 +25,8 @@
 
 type Search interface {
 	// SearchForAccounts uses the given query text to search for accounts that accountID follows.
-	SearchForAccounts(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, following bool, offset int) ([]*gtsmodel.Account, error)
+	// If notes is true, accounts on which accountID has left a private note matching query are also returned.
+	SearchForAccounts(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, following bool, notes bool, offset int) ([]*gtsmodel.Account, error)
 
 	// SearchForStatuses uses the given query text to search for statuses created by requestingAccountID, or in reply to requestingAccountID.
 	// If fromAccountID is used, the results are restricted to statuses created by fromAccountID.
@@ -0,0 +1,38 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// SeenActivity handles storing and checking the
+// IDs of activities already delivered to our inboxes.
+type SeenActivity interface {
+	// IsActivitySeen returns whether an activity with the
+	// given ActivityPub ID has been seen at the given inbox.
+	IsActivitySeen(ctx context.Context, inboxURI string, uri string) (bool, error)
+
+	// PutSeenActivity marks the activity with the given ActivityPub ID
+	// as seen at the given inbox. Does nothing if it was already seen.
+	PutSeenActivity(ctx context.Context, inboxURI string, uri string) error
+
+	// DeleteSeenActivitiesOlderThan deletes seen activities first
+	// seen before the given time, returning the amount deleted.
+	DeleteSeenActivitiesOlderThan(ctx context.Context, olderThan time.Time) (int, error)
+}
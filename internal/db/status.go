@@ -44,6 +44,10 @@ type Status interface {
 	// Except for edits, to fetch these please call PopulateStatusEdits() .
 	PopulateStatus(ctx context.Context, status *gtsmodel.Status) error
 
+	// PopulateStatuses is functionally equivalent to calling PopulateStatus() on each of the given statuses,
+	// except that sub-models (e.g. accounts, attachments, emojis, polls) are loaded for all statuses at once.
+	PopulateStatuses(ctx context.Context, statuses []*gtsmodel.Status) error
+
 	// PopulateStatusEdits ensures that status' edits are fully popualted.
 	PopulateStatusEdits(ctx context.Context, status *gtsmodel.Status) error
 
@@ -62,6 +66,9 @@ type Status interface {
 	// GetStatusesUsingEmoji fetches all status models using emoji with given ID stored in their 'emojis' column.
 	GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error)
 
+	// GetExpiringStatuses fetches all local statuses that have an expiry time set, ie., are due to be automatically deleted.
+	GetExpiringStatuses(ctx context.Context) ([]*gtsmodel.Status, error)
+
 	// GetStatusReplies returns the *direct* (i.e. in_reply_to_id column) replies to this status ID, ordered DESC by ID.
 	GetStatusReplies(ctx context.Context, statusID string) ([]*gtsmodel.Status, error)
 
@@ -43,9 +43,18 @@ type StatusBookmark interface {
 	// timeline view.
 	GetStatusBookmarks(ctx context.Context, accountID string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error)
 
+	// GetStatusBookmarksByFolderID retrieves status bookmarks filed in the given
+	// bookmark folder, using the provided parameters. If limit is < 0 then no
+	// limit will be set.
+	GetStatusBookmarksByFolderID(ctx context.Context, folderID string, limit int, maxID string, minID string) ([]*gtsmodel.StatusBookmark, error)
+
 	// PutStatusBookmark inserts the given statusBookmark into the database.
 	PutStatusBookmark(ctx context.Context, statusBookmark *gtsmodel.StatusBookmark) error
 
+	// UpdateStatusBookmark updates the given status bookmark.
+	// Columns is optional, if not specified all will be updated.
+	UpdateStatusBookmark(ctx context.Context, statusBookmark *gtsmodel.StatusBookmark, columns ...string) error
+
 	// DeleteStatusBookmark deletes one status bookmark with the given ID.
 	DeleteStatusBookmarkByID(ctx context.Context, id string) error
 
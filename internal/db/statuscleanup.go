@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// StatusCleanup handles getting/creation/updating of
+// local accounts' cleanups of their own old statuses.
+type StatusCleanup interface {
+	// GetStatusCleanupByID gets one status cleanup by its db id.
+	GetStatusCleanupByID(ctx context.Context, id string) (*gtsmodel.StatusCleanup, error)
+
+	// GetStatusCleanupsByAccountID gets all status cleanups
+	// of the given account ID, ordered by ID descending.
+	GetStatusCleanupsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.StatusCleanup, error)
+
+	// GetUncompletedStatusCleanups gets all status cleanups which
+	// haven't yet completed or been cancelled, ordered by ID ascending.
+	GetUncompletedStatusCleanups(ctx context.Context) ([]*gtsmodel.StatusCleanup, error)
+
+	// PutStatusCleanup puts the given status cleanup in the database.
+	PutStatusCleanup(ctx context.Context, cleanup *gtsmodel.StatusCleanup) error
+
+	// UpdateStatusCleanup updates the given status cleanup.
+	// Columns is optional, if not specified all will be updated.
+	UpdateStatusCleanup(ctx context.Context, cleanup *gtsmodel.StatusCleanup, columns ...string) error
+
+	// DeleteStatusCleanupsByAccountID deletes
+	// all status cleanups of the given account ID.
+	DeleteStatusCleanupsByAccountID(ctx context.Context, accountID string) error
+}
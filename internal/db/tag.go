@@ -38,6 +38,15 @@ type Tag interface {
 	// GetTags gets multiple tags.
 	GetTags(ctx context.Context, ids []string) ([]*gtsmodel.Tag, error)
 
+	// GetTagAliases gets all tags that are
+	// set as an alias of another tag.
+	GetTagAliases(ctx context.Context) ([]*gtsmodel.Tag, error)
+
+	// SetTagAliasOf sets the given tag as an alias of the tag with aliasOfID,
+	// moving follows of the given tag over to that tag, and re-pointing any
+	// tags that were aliases of the given tag. An empty aliasOfID unsets it.
+	SetTagAliasOf(ctx context.Context, tag *gtsmodel.Tag, aliasOfID string) error
+
 	// GetFollowedTags gets the user's followed tags.
 	GetFollowedTags(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.Tag, error)
 
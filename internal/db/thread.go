@@ -23,8 +23,8 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
 
-// Thread contains functions for getting/creating
-// status threads and thread mutes in the database.
+// Thread contains functions for getting/creating status
+// threads, thread mutes and thread subscriptions in the database.
 type Thread interface {
 	// PutThread inserts a new thread.
 	PutThread(ctx context.Context, thread *gtsmodel.Thread) error
@@ -45,4 +45,22 @@ type Thread interface {
 
 	// DeleteThreadMute deletes threadMute with the given ID.
 	DeleteThreadMute(ctx context.Context, id string) error
+
+	// GetThreadSubscribedByAccount gets a threadSubscription targeting
+	// the given thread, created by the given accountID, if it exists.
+	GetThreadSubscribedByAccount(ctx context.Context, threadID string, accountID string) (*gtsmodel.ThreadSubscription, error)
+
+	// IsThreadSubscribedByAccount returns true if threadID is subscribed
+	// to by given account. Empty thread ID will return false early.
+	IsThreadSubscribedByAccount(ctx context.Context, threadID string, accountID string) (bool, error)
+
+	// GetThreadSubscriberIDs gets the IDs of all
+	// accounts subscribed to the given threadID.
+	GetThreadSubscriberIDs(ctx context.Context, threadID string) ([]string, error)
+
+	// PutThreadSubscription inserts a new threadSubscription.
+	PutThreadSubscription(ctx context.Context, threadSubscription *gtsmodel.ThreadSubscription) error
+
+	// DeleteThreadSubscription deletes threadSubscription with the given ID.
+	DeleteThreadSubscription(ctx context.Context, id string) error
 }
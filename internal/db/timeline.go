@@ -36,18 +36,17 @@ type Timeline interface {
 	// GetLocalTimeline fetches the account's LOCAL timeline -- i.e. PUBLIC posts by LOCAL users.
 	GetLocalTimeline(ctx context.Context, page *paging.Page) ([]*gtsmodel.Status, error)
 
-	// GetFavedTimeline fetches the account's FAVED timeline -- ie., posts and replies that the requesting account has faved.
-	// It will use the given filters and try to return as many statuses as possible up to the limit.
+	// GetFavedTimeline fetches the account's FAVED timeline -- ie., faves of posts and replies by the requesting account.
 	//
-	// Note that unlike the other GetTimeline functions, the returned statuses will be arranged by their FAVE id, not the STATUS id.
+	// Note that unlike the other GetTimeline functions, the returned faves are paged and arranged by their FAVE id, not the STATUS id.
 	// In other words, they'll be returned in descending order of when they were faved by the requesting user, not when they were created.
 	//
-	// Also note the extra return values, which correspond to the nextMaxID and prevMinID for building Link headers.
-	GetFavedTimeline(ctx context.Context, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.Status, string, string, error)
+	// Statuses of the returned faves are not populated; a nil page can be used to fetch all of the account's faves.
+	GetFavedTimeline(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.StatusFave, error)
 
 	// GetListTimeline returns a slice of statuses from followed accounts collected within the list with the given listID.
 	GetListTimeline(ctx context.Context, listID string, page *paging.Page) ([]*gtsmodel.Status, error)
 
-	// GetTagTimeline returns a slice of public-visibility statuses that use the given tagID.
+	// GetTagTimeline returns a slice of public-visibility statuses that use the given tagID, or any tag that is an alias of it.
 	GetTagTimeline(ctx context.Context, tagID string, page *paging.Page) ([]*gtsmodel.Status, error)
 }
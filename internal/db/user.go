@@ -43,6 +43,9 @@ type User interface {
 	// GetUserByConfirmationToken returns one user by its confirmation token, or an error if something goes wrong.
 	GetUserByConfirmationToken(ctx context.Context, confirmationToken string) (*gtsmodel.User, error)
 
+	// GetUserByResetPasswordToken returns one user by its reset password token, or an error if something goes wrong.
+	GetUserByResetPasswordToken(ctx context.Context, resetPasswordToken string) (*gtsmodel.User, error)
+
 	// PopulateUser populates the struct pointers on the given user.
 	PopulateUser(ctx context.Context, user *gtsmodel.User) error
 
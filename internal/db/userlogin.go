@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// UserLogin handles storing and getting the
+// sign-in history of local user accounts.
+type UserLogin interface {
+	// GetUserLogins gets a page of sign-in attempts
+	// for the given user ID, newest first.
+	GetUserLogins(ctx context.Context, userID string, page *paging.Page) ([]*gtsmodel.UserLogin, error)
+
+	// HasUserLogins returns whether the given user
+	// has any successful sign-ins in their history.
+	HasUserLogins(ctx context.Context, userID string) (bool, error)
+
+	// IsUserLoginIPSeen returns whether the given user has
+	// any successful sign-ins in their history from the given IP.
+	IsUserLoginIPSeen(ctx context.Context, userID string, ip net.IP) (bool, error)
+
+	// PutUserLogin puts the given sign-in attempt in the database.
+	PutUserLogin(ctx context.Context, login *gtsmodel.UserLogin) error
+
+	// DeleteUserLoginsByUserID deletes all sign-in attempts for the given user ID.
+	DeleteUserLoginsByUserID(ctx context.Context, userID string) error
+
+	// DeleteUserLoginsOlderThan deletes sign-in attempts made
+	// before the given time, returning the amount deleted.
+	DeleteUserLoginsOlderThan(ctx context.Context, olderThan time.Time) (int, error)
+}
@@ -32,4 +32,13 @@ type WorkerTask interface {
 
 	// DeleteWorkerTask deletes worker task with given ID from database.
 	DeleteWorkerTaskByID(ctx context.Context, id uint) error
+
+	// GetPausedDeliveries fetches all deliveries held back by paused federation from the database.
+	GetPausedDeliveries(ctx context.Context) ([]*gtsmodel.PausedDelivery, error)
+
+	// PutPausedDelivery stores the given delivery held back by paused federation in the database.
+	PutPausedDelivery(ctx context.Context, delivery *gtsmodel.PausedDelivery) error
+
+	// DeletePausedDeliveryByID deletes paused delivery with given ID from database.
+	DeletePausedDeliveryByID(ctx context.Context, id uint) error
 }
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package describe
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// Describer suggests descriptions (alt text) for
+// images, using a local OCR or captioning backend.
+type Describer interface {
+	// Describe reads the image from r, with given
+	// content-type, and returns a suggested plain
+	// text description of it. The description may
+	// be empty if nothing was recognised.
+	Describe(ctx context.Context, r io.Reader, contentType string) (string, error)
+
+	// Provider returns the name
+	// of the describe backend.
+	Provider() string
+}
+
+// New returns a new Describer for the configured describe
+// provider, which makes any HTTP requests using the given
+// client. If no provider is configured, New returns nil.
+func New(client *httpclient.Client) Describer {
+	var d Describer
+
+	switch config.GetMediaDescribeProvider() {
+	case config.MediaDescribeProviderTesseract:
+		d = newTesseract(strings.Fields(config.GetMediaDescribeCommand()))
+	case config.MediaDescribeProviderHTTP:
+		d = newHTTP(&http.Client{
+			// Pass in our wrapped httpclient.Client{}
+			// type as http.Transport{} in order to take
+			// advantage of retries, SSF protection etc.
+			Transport: client,
+		}, config.GetMediaDescribeEndpoint())
+	default:
+		return nil
+	}
+
+	return newLimited(d,
+		config.GetMediaDescribeConcurrency(),
+		config.GetMediaDescribeTimeout(),
+	)
+}
+
+// limited wraps a Describer to bound how many
+// descriptions are generated at once, and how
+// long any one of them may take, as backends
+// can be quite heavy on CPU and memory.
+type limited struct {
+	Describer
+	sem     chan struct{}
+	timeout time.Duration
+}
+
+func newLimited(d Describer, concurrency int, timeout time.Duration) *limited {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &limited{
+		Describer: d,
+		sem:       make(chan struct{}, concurrency),
+		timeout:   timeout,
+	}
+}
+
+func (l *limited) Describe(ctx context.Context, r io.Reader, contentType string) (string, error) {
+	// Wait for a free slot,
+	// or give up with caller.
+	select {
+	case l.sem <- struct{}{}:
+		defer func() { <-l.sem }()
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+
+	if l.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.timeout)
+		defer cancel()
+	}
+
+	return l.Describer.Describe(ctx, r, contentType)
+}
@@ -0,0 +1,146 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package describe
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHTTPDescribe(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "image/png" {
+			t.Errorf("unexpected content-type %s", ct)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == "broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"model not loaded"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"description":"  A cat sitting on a keyboard. "}`))
+	}))
+	defer srv.Close()
+
+	h := newHTTP(srv.Client(), srv.URL+"/describe")
+
+	if provider := h.Provider(); provider != strings.TrimPrefix(srv.URL, "http://") {
+		t.Errorf("unexpected provider %s", provider)
+	}
+
+	description, err := h.Describe(t.Context(), strings.NewReader("some image"), "image/png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if description != "A cat sitting on a keyboard." {
+		t.Errorf("unexpected description %q", description)
+	}
+
+	_, err = h.Describe(t.Context(), strings.NewReader("broken"), "image/png")
+	if err == nil || !strings.Contains(err.Error(), "model not loaded") {
+		t.Errorf("expected error from endpoint, got %v", err)
+	}
+}
+
+func TestTesseractDescribe(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a shell")
+	}
+
+	// Stand in for tesseract with a script
+	// which checks its arguments, then "reads"
+	// the text of the image from stdin.
+	script := filepath.Join(t.TempDir(), "tesseract")
+	if err := os.WriteFile(script, []byte(`#!/bin/sh
+[ "$*" = "stdin stdout -l eng" ] || { echo "bad args: $*" >&2; exit 1; }
+cat
+`), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	d := newTesseract([]string{script, "-l", "eng"})
+
+	description, err := d.Describe(t.Context(), strings.NewReader("SALE\n\n50% off\n"), "image/png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if description != "SALE\n\n50% off\n" {
+		t.Errorf("unexpected description %q", description)
+	}
+
+	d = newTesseract([]string{script})
+
+	_, err = d.Describe(t.Context(), strings.NewReader(""), "image/png")
+	if err == nil || !strings.Contains(err.Error(), "bad args: stdin stdout") {
+		t.Errorf("expected error with stderr, got %v", err)
+	}
+}
+
+type blockingDescriber struct{}
+
+func (blockingDescriber) Describe(ctx context.Context, _ io.Reader, _ string) (string, error) {
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+func (blockingDescriber) Provider() string {
+	return "Blocking"
+}
+
+func TestLimited(t *testing.T) {
+	l := newLimited(blockingDescriber{}, 0, 50*time.Millisecond)
+
+	if cap(l.sem) != 1 {
+		t.Errorf("expected concurrency of 1, got %d", cap(l.sem))
+	}
+
+	// Times out by itself.
+	_, err := l.Describe(t.Context(), nil, "")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	// While the only slot is taken, other
+	// callers give up when their ctx does.
+	l.sem <- struct{}{}
+	defer func() { <-l.sem }()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err = l.Describe(ctx, nil, "")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected canceled, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package describe
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// maxResponseSize is the max size of response
+// body read from an HTTP describe endpoint.
+const maxResponseSize = 64 * 1024
+
+// httpDescriber is a Describer which
+// POSTs the image to an HTTP endpoint,
+// eg., a self-hosted captioning model.
+//
+// The endpoint receives the raw image as
+// request body, with its Content-Type, and
+// should respond with a JSON object like:
+//
+//	{"description": "A cat sitting on a keyboard."}
+type httpDescriber struct {
+	client   *http.Client
+	endpoint string
+}
+
+func newHTTP(client *http.Client, endpoint string) *httpDescriber {
+	return &httpDescriber{
+		client:   client,
+		endpoint: endpoint,
+	}
+}
+
+type httpDescribeResponse struct {
+	Description string `json:"description"`
+	Error       string `json:"error"`
+}
+
+func (h *httpDescriber) Provider() string {
+	// Name the endpoint host, as
+	// we don't know what's behind it.
+	if u, err := url.Parse(h.endpoint); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return "HTTP"
+}
+
+func (h *httpDescriber) Describe(ctx context.Context, r io.Reader, contentType string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		h.endpoint,
+		r,
+	)
+	if err != nil {
+		return "", gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := h.client.Do(req)
+	if err != nil {
+		return "", gtserror.Newf("error doing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	var d httpDescribeResponse
+	decodeErr := json.NewDecoder(io.LimitReader(rsp.Body, maxResponseSize)).Decode(&d)
+
+	if rsp.StatusCode != http.StatusOK {
+		return "", gtserror.Newf("%s returned %s: %s", h.endpoint, rsp.Status, d.Error)
+	}
+
+	if decodeErr != nil {
+		return "", gtserror.Newf("error decoding response: %w", decodeErr)
+	}
+
+	return strings.TrimSpace(d.Description), nil
+}
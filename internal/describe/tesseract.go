@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package describe
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os/exec"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// tesseract is a Describer which runs
+// the tesseract OCR engine, suggesting
+// any text in the image as description.
+//
+// See https://tesseract-ocr.github.io/tessdoc/Command-Line-Usage.html
+type tesseract struct {
+	name string
+	args []string
+}
+
+func newTesseract(command []string) *tesseract {
+	// Read the image from stdin and
+	// write the text to stdout, with
+	// any configured options after.
+	args := []string{"stdin", "stdout"}
+	args = append(args, command[1:]...)
+
+	return &tesseract{
+		name: command[0],
+		args: args,
+	}
+}
+
+func (t *tesseract) Provider() string {
+	return "Tesseract"
+}
+
+func (t *tesseract) Describe(ctx context.Context, r io.Reader, _ string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, t.name, t.args...) //nolint:gosec
+	cmd.Stdin = r
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		// Include the first line of stderr,
+		// usually tesseract's error message.
+		msg, _, _ := strings.Cut(stderr.String(), "\n")
+		return "", gtserror.Newf("error running %s: %w: %s", t.name, err, msg)
+	}
+
+	return stdout.String(), nil
+}
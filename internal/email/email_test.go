@@ -220,6 +220,23 @@ func (suite *EmailTestSuite) TestTemplateReportClosedLocalAccountNoComment() {
 	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Report Closed\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello !\r\n\r\nYou recently reported the account @1happyturtle to the moderator(s) of Test Instance (https://example.org).\r\n\r\nThe report you submitted has now been closed.\r\n\r\nThe moderator who closed the report did not leave a comment.\r\n\r\n---\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
 }
 
+func (suite *EmailTestSuite) TestTemplateNewLogin() {
+	newLoginData := email.NewLoginData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		IP:           "192.0.2.1",
+		UserAgent:    "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0",
+		Time:         "Sat, 17 Oct 2026 10:00:00 UTC",
+		SettingsURL:  "https://example.org/settings/user/tokens",
+	}
+
+	suite.sender.SendNewLoginEmail("user@example.org", newLoginData)
+	suite.stripHeaders()
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial New Sign-In\r\nMIME-Version: 1.0\r\nContent-Transfer-Encoding: 8bit\r\nContent-Type: text/plain; charset=\"UTF-8\"\r\n\r\nHello test!\r\n\r\nYou are receiving this mail because your account on https://example.org was just signed in to from an IP address that we haven't seen you sign in from before.\r\n\r\nTime: Sat, 17 Oct 2026 10:00:00 UTC\r\nIP address: 192.0.2.1\r\nUser-agent: Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0\r\n\r\nIf this was you, you can safely ignore this email.\r\n\r\nIf this wasn't you, someone else may know your password. You should change your password as soon as possible, and invalidate any access tokens you don't recognize, via the settings panel:\r\n\r\nhttps://example.org/settings/user/tokens\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
 func TestEmailTestSuite(t *testing.T) {
 	suite.Run(t, new(EmailTestSuite))
 }
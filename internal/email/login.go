@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+const (
+	newLoginTemplate = "email_new_login.tmpl"
+	newLoginSubject  = "GoToSocial New Sign-In"
+)
+
+// NewLoginData represents data passed into the new sign-in email template.
+type NewLoginData struct {
+	// Username to be addressed.
+	Username string
+	// URL of the instance to present to the receiver.
+	InstanceURL string
+	// Name of the instance to present to the receiver.
+	InstanceName string
+	// IP address the sign-in originated from.
+	IP string
+	// User-agent of the client that signed in, if known.
+	UserAgent string
+	// Time of the sign-in, formatted for display.
+	Time string
+	// URL to the settings panel, where the user
+	// can review tokens and change their password.
+	SettingsURL string
+}
+
+func (s *sender) SendNewLoginEmail(toAddress string, data NewLoginData) error {
+	return s.sendTemplate(newLoginTemplate, newLoginSubject, data, toAddress)
+}
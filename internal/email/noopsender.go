@@ -83,6 +83,10 @@ func (s *noopSender) SendSignupRejectedEmail(toAddress string, data SignupReject
 	return s.sendTemplate(signupRejectedTemplate, signupRejectedSubject, data, toAddress)
 }
 
+func (s *noopSender) SendNewLoginEmail(toAddress string, data NewLoginData) error {
+	return s.sendTemplate(newLoginTemplate, newLoginSubject, data, toAddress)
+}
+
 func (s *noopSender) sendTemplate(template string, subject string, data any, toAddresses ...string) error {
 	buf := &bytes.Buffer{}
 	if err := s.template.ExecuteTemplate(buf, template, data); err != nil {
@@ -61,6 +61,10 @@ type Sender interface {
 	// SendSignupRejectedEmail sends an email to the given address
 	// that their sign-up request has been rejected by a moderator.
 	SendSignupRejectedEmail(toAddress string, data SignupRejectedData) error
+
+	// SendNewLoginEmail sends an email to the given address letting them know
+	// that their account was signed in to from a new (unfamiliar) IP address.
+	SendNewLoginEmail(toAddress string, data NewLoginData) error
 }
 
 // NewSender returns a new email Sender interface with the given configuration, or an error if something goes wrong.
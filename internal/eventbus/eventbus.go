@@ -0,0 +1,181 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// Types of lifecycle event published to the bus.
+// Each is published on the subject of the configured
+// prefix joined to the type, eg., "gotosocial.status.created".
+const (
+	StatusCreated  = "status.created"
+	StatusDeleted  = "status.deleted"
+	AccountUpdated = "account.updated"
+	ReportCreated  = "report.created"
+)
+
+// queueSize is the max number of events
+// waiting to be published, beyond which
+// further events are dropped.
+const queueSize = 1024
+
+// publishTimeout is the max time to
+// wait for a single event to publish.
+const publishTimeout = 30 * time.Second
+
+// Event is the envelope in which
+// every event is published, as JSON.
+type Event struct {
+	Type      string `json:"type"`
+	CreatedAt string `json:"created_at"`
+	Payload   any    `json:"payload"`
+}
+
+// DeletedStatus is the payload
+// of StatusDeleted events.
+type DeletedStatus struct {
+	ID        string `json:"id"`
+	URI       string `json:"uri"`
+	AccountID string `json:"account_id"`
+}
+
+// Publisher publishes messages
+// on a message broker.
+type Publisher interface {
+	// Publish publishes data on
+	// the given broker subject.
+	Publish(ctx context.Context, subject string, data []byte) error
+
+	// Close closes any connection
+	// to the broker.
+	Close() error
+}
+
+// Bus queues lifecycle events and publishes
+// them in the background with a Publisher.
+//
+// A nil *Bus is valid, and drops all events.
+type Bus struct {
+	pub    Publisher
+	prefix string
+	queue  chan message
+	done   chan struct{}
+}
+
+type message struct {
+	subject string
+	data    []byte
+}
+
+// New returns a new Bus for the configured event bus
+// provider. If no provider is configured, New returns nil.
+func New() *Bus {
+	var pub Publisher
+
+	switch config.GetEventBusProvider() {
+	case config.EventBusProviderNATS:
+		pub = newNATS(config.GetEventBusURL())
+	default:
+		return nil
+	}
+
+	return NewWith(pub, config.GetEventBusSubjectPrefix())
+}
+
+// NewWith returns a new Bus publishing with the given
+// Publisher, on subjects starting with the given prefix.
+func NewWith(pub Publisher, prefix string) *Bus {
+	return &Bus{
+		pub:    pub,
+		prefix: prefix,
+		queue:  make(chan message, queueSize),
+		done:   make(chan struct{}),
+	}
+}
+
+// Start starts publishing queued events in the background.
+func (b *Bus) Start() {
+	if b == nil {
+		return
+	}
+	go b.run()
+}
+
+// Stop stops accepting events, waits for queued
+// events to be published, and closes the Publisher.
+func (b *Bus) Stop() {
+	if b == nil {
+		return
+	}
+	close(b.queue)
+	<-b.done
+}
+
+// Publish queues an event of the given type, with the
+// given payload, to be published. Events are dropped
+// if the bus is nil or the queue is full.
+func (b *Bus) Publish(ctx context.Context, typ string, payload any) {
+	if b == nil {
+		return
+	}
+
+	data, err := json.Marshal(Event{
+		Type:      typ,
+		CreatedAt: util.FormatISO8601(time.Now()),
+		Payload:   payload,
+	})
+	if err != nil {
+		log.Errorf(ctx, "error marshaling %s event: %v", typ, err)
+		return
+	}
+
+	msg := message{
+		subject: b.prefix + "." + typ,
+		data:    data,
+	}
+
+	select {
+	case b.queue <- msg:
+	default:
+		log.Warnf(ctx, "event bus queue full, dropping %s event", typ)
+	}
+}
+
+func (b *Bus) run() {
+	defer close(b.done)
+
+	for msg := range b.queue {
+		ctx, cncl := context.WithTimeout(context.Background(), publishTimeout)
+		if err := b.pub.Publish(ctx, msg.subject, msg.data); err != nil {
+			log.Errorf(ctx, "error publishing event on %s: %v", msg.subject, err)
+		}
+		cncl()
+	}
+
+	if err := b.pub.Close(); err != nil {
+		log.Errorf(nil, "error closing event bus publisher: %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type testPublisher struct {
+	mu     sync.Mutex
+	msgs   []message
+	closed bool
+}
+
+func (p *testPublisher) Publish(_ context.Context, subject string, data []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.msgs = append(p.msgs, message{subject, data})
+	return nil
+}
+
+func (p *testPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+func TestBusPublish(t *testing.T) {
+	pub := new(testPublisher)
+	bus := NewWith(pub, "gts")
+	bus.Start()
+
+	bus.Publish(t.Context(), StatusDeleted, DeletedStatus{
+		ID:        "01F8MH75CBF9JFX4ZAD54N0W0R",
+		URI:       "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+		AccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+	})
+
+	// Stop waits for
+	// queued events.
+	bus.Stop()
+
+	if !pub.closed {
+		t.Error("expected publisher to be closed")
+	}
+
+	if len(pub.msgs) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(pub.msgs))
+	}
+
+	if subject := pub.msgs[0].subject; subject != "gts.status.deleted" {
+		t.Errorf("unexpected subject %s", subject)
+	}
+
+	var event struct {
+		Type      string        `json:"type"`
+		CreatedAt string        `json:"created_at"`
+		Payload   DeletedStatus `json:"payload"`
+	}
+	if err := json.Unmarshal(pub.msgs[0].data, &event); err != nil {
+		t.Fatal(err)
+	}
+
+	if event.Type != StatusDeleted || event.CreatedAt == "" ||
+		event.Payload.ID != "01F8MH75CBF9JFX4ZAD54N0W0R" {
+		t.Errorf("unexpected event %+v", event)
+	}
+
+	// A nil bus just drops events.
+	var nilBus *Bus
+	nilBus.Start()
+	nilBus.Publish(t.Context(), StatusCreated, nil)
+	nilBus.Stop()
+}
+
+func TestNATSPublish(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	type received struct {
+		connect string
+		subject string
+		payload string
+		err     error
+	}
+	recv := make(chan received, 1)
+
+	// Serve one connection,
+	// as a NATS server would.
+	go func() {
+		var res received
+		defer func() { recv <- res }()
+
+		conn, err := ln.Accept()
+		if err != nil {
+			res.err = err
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		if _, err := io.WriteString(conn, `INFO {"server_id":"test","max_payload":1048576}`+"\r\n"); err != nil {
+			res.err = err
+			return
+		}
+
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				res.err = err
+				return
+			}
+			line = strings.TrimSpace(line)
+
+			switch {
+			case strings.HasPrefix(line, "CONNECT "):
+				res.connect = strings.TrimPrefix(line, "CONNECT ")
+
+			case line == "PING":
+				if _, err := io.WriteString(conn, "PONG\r\n"); err != nil {
+					res.err = err
+					return
+				}
+
+			case strings.HasPrefix(line, "PUB "):
+				// PUB <subject> <#bytes>
+				fields := strings.Fields(line)
+				n, _ := strconv.Atoi(fields[2])
+				payload := make([]byte, n+2)
+				if _, err := io.ReadFull(r, payload); err != nil {
+					res.err = err
+					return
+				}
+				res.subject = fields[1]
+				res.payload = string(payload[:n])
+				return
+			}
+		}
+	}()
+
+	n := newNATS("nats://token@" + ln.Addr().String())
+	defer n.Close()
+
+	if err := n.Publish(t.Context(), "gts.status.created", []byte(`{"type":"status.created"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	res := <-recv
+	if res.err != nil {
+		t.Fatal(res.err)
+	}
+
+	var connect natsConnect
+	if err := json.Unmarshal([]byte(res.connect), &connect); err != nil {
+		t.Fatal(err)
+	}
+
+	if connect.AuthToken != "token" || connect.Verbose {
+		t.Errorf("unexpected connect %+v", connect)
+	}
+
+	if res.subject != "gts.status.created" {
+		t.Errorf("unexpected subject %s", res.subject)
+	}
+
+	if res.payload != `{"type":"status.created"}` {
+		t.Errorf("unexpected payload %s", res.payload)
+	}
+}
@@ -0,0 +1,291 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+)
+
+// natsPublisher is a Publisher using the NATS
+// client protocol, with a single connection to
+// the server that's lazily (re)established.
+//
+// See https://docs.nats.io/reference/reference-protocols/nats-protocol
+type natsPublisher struct {
+	url string
+
+	// mu protects the below.
+	mu         sync.Mutex
+	conn       net.Conn
+	w          *bufio.Writer
+	maxPayload int
+}
+
+// natsInfo is the part we care about
+// of the INFO sent by NATS servers.
+type natsInfo struct {
+	TLSRequired bool `json:"tls_required"`
+	MaxPayload  int  `json:"max_payload"`
+}
+
+// natsConnect is the CONNECT
+// sent to NATS servers.
+type natsConnect struct {
+	Verbose   bool   `json:"verbose"`
+	Pedantic  bool   `json:"pedantic"`
+	Name      string `json:"name"`
+	Lang      string `json:"lang"`
+	Version   string `json:"version"`
+	User      string `json:"user,omitempty"`
+	Pass      string `json:"pass,omitempty"`
+	AuthToken string `json:"auth_token,omitempty"`
+}
+
+func newNATS(url string) *natsPublisher {
+	return &natsPublisher{url: url}
+}
+
+func (n *natsPublisher) Publish(ctx context.Context, subject string, data []byte) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.conn == nil {
+		if err := n.connect(ctx); err != nil {
+			return fmt.Errorf("error connecting to nats server: %w", err)
+		}
+	}
+
+	if n.maxPayload > 0 && len(data) > n.maxPayload {
+		return fmt.Errorf("payload of %d bytes exceeds server max of %d", len(data), n.maxPayload)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = n.conn.SetWriteDeadline(deadline)
+		defer func() {
+			if n.conn != nil {
+				_ = n.conn.SetWriteDeadline(time.Time{})
+			}
+		}()
+	}
+
+	// PUB <subject> <#bytes>\r\n[payload]\r\n
+	n.w.WriteString("PUB " + subject + " " + strconv.Itoa(len(data)) + "\r\n")
+	n.w.Write(data)
+	n.w.WriteString("\r\n")
+
+	if err := n.w.Flush(); err != nil {
+		// Drop broken connection,
+		// reconnect on next publish.
+		n.closeConn()
+		return fmt.Errorf("error writing to nats server: %w", err)
+	}
+
+	return nil
+}
+
+func (n *natsPublisher) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.closeConn()
+}
+
+// connect dials the NATS server and performs
+// the protocol handshake. Caller must hold mu.
+func (n *natsPublisher) connect(ctx context.Context) error {
+	u, err := url.Parse(n.url)
+	if err != nil {
+		return err
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		// Default NATS client port.
+		host = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	// Servers greet
+	// with their INFO.
+	r := bufio.NewReader(conn)
+	line, err := r.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error reading server info: %w", err)
+	}
+
+	infoJSON, ok := strings.CutPrefix(strings.TrimSpace(line), "INFO ")
+	if !ok {
+		conn.Close()
+		return fmt.Errorf("unexpected server greeting: %q", line)
+	}
+
+	var info natsInfo
+	if err := json.Unmarshal([]byte(infoJSON), &info); err != nil {
+		conn.Close()
+		return fmt.Errorf("error parsing server info: %w", err)
+	}
+
+	if u.Scheme == "tls" || info.TLSRequired {
+		// Upgrade the connection
+		// to TLS, as per the INFO.
+		tlsConn := tls.Client(conn, &tls.Config{
+			ServerName: u.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return fmt.Errorf("tls handshake error: %w", err)
+		}
+		conn = tlsConn
+		r = bufio.NewReader(conn)
+	}
+
+	connect := natsConnect{
+		Name:    config.GetApplicationName(),
+		Lang:    "go",
+		Version: config.GetSoftwareVersion(),
+	}
+
+	if u.User != nil {
+		// Credentials from the URL,
+		// a lone user being a token.
+		pass, ok := u.User.Password()
+		if ok {
+			connect.User = u.User.Username()
+			connect.Pass = pass
+		} else {
+			connect.AuthToken = u.User.Username()
+		}
+	}
+
+	connectJSON, err := json.Marshal(connect)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	// Send CONNECT, followed by a PING, so
+	// the server's PONG (or -ERR) confirms
+	// the connection has been accepted.
+	w := bufio.NewWriter(conn)
+	w.WriteString("CONNECT ")
+	w.Write(connectJSON)
+	w.WriteString("\r\nPING\r\n")
+	if err := w.Flush(); err != nil {
+		conn.Close()
+		return fmt.Errorf("error sending connect: %w", err)
+	}
+
+	if err := readPong(r); err != nil {
+		conn.Close()
+		return err
+	}
+
+	// Clear handshake deadlines.
+	_ = conn.SetDeadline(time.Time{})
+
+	n.conn = conn
+	n.w = w
+	n.maxPayload = info.MaxPayload
+
+	// Keep reading from the server in the
+	// background to answer its keep-alive
+	// PINGs, else it drops the connection.
+	go n.read(conn, r)
+
+	return nil
+}
+
+// readPong reads server lines until the
+// PONG in reply to the handshake PING.
+func readPong(r *bufio.Reader) error {
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("error reading connect reply: %w", err)
+		}
+
+		switch line = strings.TrimSpace(line); {
+		case line == "PONG":
+			return nil
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New("server rejected connect: " + line)
+		}
+	}
+}
+
+// read reads server messages from conn until it
+// fails, replying to PINGs. It then closes conn,
+// if it's still the publisher's connection.
+func (n *natsPublisher) read(conn net.Conn, r *bufio.Reader) {
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			break
+		}
+
+		if strings.TrimSpace(line) == "PING" {
+			n.mu.Lock()
+			if n.conn == conn {
+				n.w.WriteString("PONG\r\n")
+				_ = n.w.Flush()
+			}
+			n.mu.Unlock()
+		}
+	}
+
+	n.mu.Lock()
+	if n.conn == conn {
+		n.closeConn()
+	}
+	n.mu.Unlock()
+}
+
+// closeConn closes any current
+// connection. Caller must hold mu.
+func (n *natsPublisher) closeConn() error {
+	if n.conn == nil {
+		return nil
+	}
+	err := n.conn.Close()
+	n.conn = nil
+	n.w = nil
+	return err
+}
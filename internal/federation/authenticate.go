@@ -26,6 +26,7 @@
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"time"
 
 	"code.superseriousbusiness.org/activity/streams"
@@ -116,7 +117,7 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 	if verifier == nil {
 		err := gtserror.Newf("%w", errUnsigned)
 		errWithCode := gtserror.NewErrorUnauthorized(err, errUnsigned.Error(), "(verifier)")
-		return nil, errWithCode
+		return nil, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeSignatureMissing)
 	}
 
 	// We should have the signature itself set too.
@@ -124,7 +125,7 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 	if signature == "" {
 		err := gtserror.Newf("%w", errUnsigned)
 		errWithCode := gtserror.NewErrorUnauthorized(err, errUnsigned.Error(), "(signature)")
-		return nil, errWithCode
+		return nil, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeSignatureMissing)
 	}
 
 	// And finally the public key ID URI.
@@ -132,7 +133,7 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 	if pubKeyID == nil {
 		err := gtserror.Newf("%w", errUnsigned)
 		errWithCode := gtserror.NewErrorUnauthorized(err, errUnsigned.Error(), "(pubKeyID)")
-		return nil, errWithCode
+		return nil, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeSignatureMissing)
 	}
 
 	// At this point we know the request was signed,
@@ -179,7 +180,8 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 
 		const format = "authentication NOT PASSED for public key %s; tried algorithms %+v; signature value was '%s'"
 		text := fmt.Sprintf(format, pubKeyIDStr, signingAlgorithms, signature)
-		return nil, gtserror.NewErrorUnauthorized(errors.New(text), text)
+		errWithCode := gtserror.NewErrorUnauthorized(errors.New(text), text)
+		return nil, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeSignatureInvalid)
 	}
 
 	if pubKeyAuth.Owner == nil {
@@ -223,7 +225,8 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 				"key mismatch: fetched key %s does not match pubkey of fetched Actor %s",
 				pubKeyID, pubKeyAuth.Owner.URI,
 			)
-			return nil, gtserror.NewErrorUnauthorized(err)
+			errWithCode := gtserror.NewErrorUnauthorized(err)
+			return nil, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeSignatureInvalid)
 		}
 	}
 
@@ -288,7 +291,8 @@ func keyOwnerFetchError(
 		)
 	}
 
-	return gtserror.NewErrorUnauthorized(newErr)
+	errWithCode := gtserror.NewErrorUnauthorized(newErr)
+	return gtserror.WithErrCode(errWithCode, gtserror.ErrCodeKeyUnavailable)
 }
 
 // derefPubKeyDBOnly tries to dereference the given
@@ -408,10 +412,11 @@ func (f *Federator) derefPubKey(
 	}
 
 	// Extract the key and the owner from the response.
-	pubKey, pubKeyOwner, err := parsePubKeyBytes(ctx, pubKeyBytes, pubKeyID)
+	pubKey, pubKeyOwner, err := ParsePubKeyBytes(ctx, pubKeyBytes, pubKeyID)
 	if err != nil {
 		err := gtserror.Newf("error parsing public key (%s): %w", pubKeyID, err)
-		return nil, gtserror.NewErrorUnauthorized(err)
+		errWithCode := gtserror.NewErrorUnauthorized(err)
+		return nil, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeKeyUnavailable)
 	}
 
 	if !expired {
@@ -557,11 +562,11 @@ func (f *Federator) fetchAccountInstance(
 	return nil
 }
 
-// parsePubKeyBytes extracts an rsa public key from the
+// ParsePubKeyBytes extracts an rsa public key from the
 // given pubKeyBytes by trying to parse the pubKeyBytes
 // as an ActivityPub type. It will return the public key
 // itself, and the URI of the public key owner.
-func parsePubKeyBytes(
+func ParsePubKeyBytes(
 	ctx context.Context,
 	pubKeyBytes []byte,
 	pubKeyID *url.URL,
@@ -618,6 +623,13 @@ func parsePubKeyBytes(
 	httpsig.ED25519,    // Try ED25519 as a long shot.
 }
 
+// SigningAlgorithms returns the http signature
+// algorithms that are tried, in order, when
+// verifying the signature of an incoming request.
+func SigningAlgorithms() []httpsig.Algorithm {
+	return slices.Clone(signingAlgorithms)
+}
+
 // Cheeky type to wrap a signing option with a
 // description of that option for logging purposes.
 type signingOption struct {
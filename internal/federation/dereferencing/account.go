@@ -25,6 +25,7 @@
 	"time"
 
 	"code.superseriousbusiness.org/activity/pub"
+	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
@@ -267,7 +268,7 @@ func (d *Dereferencer) getAccountByUsernameDomain(
 	username string,
 	domain string,
 ) (*gtsmodel.Account, ap.Accountable, error) {
-	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+	if config.IsLocalAccountAddress(username, domain) {
 		// We do local lookups using an empty domain,
 		// else it will fail the db search below.
 		domain = ""
@@ -543,7 +544,7 @@ func (d *Dereferencer) enrichAccount(
 		// A username was provided so we can attempt to webfinger,
 		// this ensures up-to-date account domain, and handles some
 		// edge cases where servers don't provide a preferred_username.
-		accUsername, accDomain, accURI, err := d.fingerRemoteAccount(ctx,
+		accUsername, accDomain, accURI, err := d.fingerRemoteAccountCached(ctx,
 			tsport,
 			account.Username,
 			account.Domain,
@@ -739,7 +740,7 @@ func (d *Dereferencer) enrichAccount(
 		// https://example.org/@someone@somewhere.else and we've been redirected
 		// from example.org to somewhere.else: we want to take somewhere.else
 		// as the accountDomain then, not the example.org we were redirected from.
-		accUsername, latestAcc.Domain, _, err = d.fingerRemoteAccount(ctx,
+		accUsername, latestAcc.Domain, _, err = d.fingerRemoteAccountCached(ctx,
 			tsport,
 			latestAcc.Username,
 			accHost,
@@ -1294,6 +1295,155 @@ func (d *Dereferencer) countCollection(
 	return collect.TotalItems(), nil
 }
 
+const (
+	// maxFeaturedItems is the maximum number of items we
+	// gather from a remote account's featured collection.
+	maxFeaturedItems = 40
+
+	// maxFeaturedPages is the maximum number of pages we follow
+	// in a remote account's featured collection, if it's paged.
+	maxFeaturedPages = 5
+)
+
+// collectionItemIRIs dereferences the collection at given URI,
+// returning the IRIs of up to maxItems items. Items are taken
+// inline from the collection itself if present, else from its
+// first page onwards, following up to maxPages pages.
+//
+// If strict is set, failing to dereference any page is an error,
+// else the items gathered from the pages before it are returned.
+func (d *Dereferencer) collectionItemIRIs(
+	ctx context.Context,
+	requestUser string,
+	uri *url.URL,
+	maxItems int,
+	maxPages int,
+	strict bool,
+) ([]*url.URL, error) {
+	collect, err := d.dereferenceCollection(ctx, requestUser, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	var iris []*url.URL
+
+	// appendItems appends the IRIs of items
+	// from iterator function, up to max items.
+	appendItems := func(next func() ap.TypeOrIRI) {
+		for len(iris) < maxItems {
+			item := next()
+			if item == nil {
+				return
+			}
+
+			// Check for available IRI.
+			itemIRI, _ := pub.ToId(item)
+			if itemIRI == nil {
+				continue
+			}
+
+			iris = append(iris, itemIRI)
+		}
+	}
+
+	// Start with any inline items.
+	appendItems(collect.NextItem)
+	if len(iris) > 0 {
+		return iris, nil
+	}
+
+	// No inline items, look for a first page.
+	page, err := d.collectionFirstPage(ctx, requestUser, collect)
+	if err != nil || page == nil {
+		return nil, err
+	}
+
+	// Track deref'd pages to
+	// catch self-references.
+	derefd := map[string]struct{}{
+		uri.String(): {},
+	}
+
+	for i := 0; ; i++ {
+		// Append items from page.
+		appendItems(page.NextItem)
+
+		if len(iris) >= maxItems ||
+			i+1 >= maxPages {
+			break
+		}
+
+		// Get the next page IRI, if any.
+		next := page.NextPage()
+		if next == nil || !next.IsIRI() {
+			break
+		}
+		nextURI := next.GetIRI()
+
+		if _, ok := derefd[nextURI.String()]; ok {
+			log.Warnf(ctx, "self referencing collection page(s): %s", nextURI)
+			break
+		}
+		derefd[nextURI.String()] = struct{}{}
+
+		// Dereference the next collection page.
+		page, err = d.dereferenceCollectionPage(ctx,
+			requestUser,
+			nextURI,
+		)
+		if err != nil {
+			if strict {
+				return nil, gtserror.Newf("error dereferencing collection page %s: %w", nextURI, err)
+			}
+
+			// Keep what we have so far.
+			log.Errorf(ctx, "error dereferencing collection page %s: %v", nextURI, err)
+			break
+		}
+	}
+
+	return iris, nil
+}
+
+// collectionFirstPage returns the first page of the given
+// collection, either as embedded in the collection or dereferenced
+// from its IRI. Returns nil if the collection has no first page.
+func (d *Dereferencer) collectionFirstPage(
+	ctx context.Context,
+	requestUser string,
+	collect ap.CollectionIterator,
+) (ap.CollectionPageIterator, error) {
+	withFirst, ok := collect.(interface {
+		GetActivityStreamsFirst() vocab.ActivityStreamsFirstProperty
+	})
+	if !ok {
+		return nil, nil
+	}
+
+	first := withFirst.GetActivityStreamsFirst()
+	switch {
+	case first == nil:
+		return nil, nil
+
+	case first.IsActivityStreamsOrderedCollectionPage():
+		page := first.GetActivityStreamsOrderedCollectionPage()
+		return ap.WrapOrderedCollectionPage(page), nil
+
+	case first.IsActivityStreamsCollectionPage():
+		page := first.GetActivityStreamsCollectionPage()
+		return ap.WrapCollectionPage(page), nil
+
+	case first.IsIRI():
+		return d.dereferenceCollectionPage(ctx,
+			requestUser,
+			first.GetIRI(),
+		)
+
+	default:
+		return nil, nil
+	}
+}
+
 // dereferenceAccountFeatured dereferences an account's featuredCollectionURI (if not empty). For each discovered status, this status will
 // be dereferenced (if necessary) and marked as pinned (if necessary). Then, old pins will be removed if they're not included in new pins.
 func (d *Dereferencer) dereferenceAccountFeatured(ctx context.Context, requestUser string, account *gtsmodel.Account) error {
@@ -1302,7 +1452,15 @@ func (d *Dereferencer) dereferenceAccountFeatured(ctx context.Context, requestUs
 		return err
 	}
 
-	collect, err := d.dereferenceCollection(ctx, requestUser, uri)
+	// Gather featured item IRIs,
+	// following pages if necessary.
+	itemIRIs, err := d.collectionItemIRIs(ctx,
+		requestUser,
+		uri,
+		maxFeaturedItems,
+		maxFeaturedPages,
+		false, // strict
+	)
 	if err != nil {
 		return err
 	}
@@ -1315,19 +1473,7 @@ func (d *Dereferencer) dereferenceAccountFeatured(ctx context.Context, requestUs
 
 	var statusURIs []*url.URL
 
-	for {
-		// Get next collect item.
-		item := collect.NextItem()
-		if item == nil {
-			break
-		}
-
-		// Check for available IRI.
-		itemIRI, _ := pub.ToId(item)
-		if itemIRI == nil {
-			continue
-		}
-
+	for _, itemIRI := range itemIRIs {
 		if itemIRI.Host != uri.Host {
 			// If this status doesn't share a host with its featured
 			// collection URI, we shouldn't trust it. Just move on.
@@ -85,6 +85,9 @@ func (d *Dereferencer) EnrichAnnounce(
 	// Boosts are not considered sensitive even if their target is.
 	boost.Sensitive = util.Ptr(false)
 
+	// Boosts don't get their own link preview card.
+	boost.PreviewLinks = util.Ptr(false)
+
 	// Populate remaining fields on
 	// the boost wrapper using target.
 	boost.ActivityStreamsType = target.ActivityStreamsType
@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	// maxBlocklistItems is the maximum number of items
+	// we gather from a remote account's published blocklist.
+	maxBlocklistItems = 5000
+
+	// maxBlocklistPages is the maximum number of pages we
+	// follow in a remote account's published blocklist.
+	maxBlocklistPages = 125
+)
+
+// DereferenceBlocklist dereferences the published blocklist
+// collection at given URI, returning the IRIs of the accounts
+// it contains, up to maxBlocklistItems. The accounts themselves
+// are not dereferenced, that's left up to the caller.
+//
+// An error is returned if any page of the blocklist fails to
+// dereference, so that callers never mistake accounts on the
+// missing page(s) for accounts removed from the blocklist.
+func (d *Dereferencer) DereferenceBlocklist(
+	ctx context.Context,
+	requestUser string,
+	uri *url.URL,
+) ([]*url.URL, error) {
+	return d.collectionItemIRIs(ctx,
+		requestUser,
+		uri,
+		maxBlocklistItems,
+		maxBlocklistPages,
+		true, // strict
+	)
+}
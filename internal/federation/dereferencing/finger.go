@@ -25,12 +25,13 @@
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/cache/webfinger"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/transport"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
-// fingerRemoteAccount performs a webfinger call for the
+// fingerRemoteAccountCached performs a webfinger call for the
 // given username and host, using the provided transport.
 //
 // The webfinger response will be parsed, and the subject
@@ -39,6 +40,50 @@
 // In case the response cannot be parsed, or the response
 // does not contain a valid subject string or AP URI, an
 // error will be returned instead.
+//
+// Results (including failures) are cached in memory for
+// a while, to avoid repeatedly webfingering the same
+// account, eg., when processing lots of mentions of it.
+func (d *Dereferencer) fingerRemoteAccountCached(
+	ctx context.Context,
+	transport transport.Transport,
+	username string,
+	host string,
+) (
+	string, // discovered username
+	string, // discovered account domain
+	*url.URL, // discovered account URI
+	error,
+) {
+	key := username + "@" + host
+
+	// Check for a cached result.
+	cache := &d.state.Caches.WebfingerResults
+	if res, ok := cache.Get(key); ok {
+		return res.Username, res.Domain, res.URI, res.Err
+	}
+
+	accUsername, accDomain, uri, err := d.fingerRemoteAccount(ctx, transport, username, host)
+	if err != nil && ctx.Err() != nil {
+		// Don't cache failures that were
+		// caused by the context being
+		// canceled or timing out.
+		return "", "", nil, err
+	}
+
+	// Cache this result, failed or not.
+	cache.Set(key, webfinger.Result{
+		Username: accUsername,
+		Domain:   accDomain,
+		URI:      uri,
+		Err:      err,
+	})
+
+	return accUsername, accDomain, uri, err
+}
+
+// fingerRemoteAccount is the uncached
+// form of fingerRemoteAccountCached().
 func (d *Dereferencer) fingerRemoteAccount(
 	ctx context.Context,
 	transport transport.Transport,
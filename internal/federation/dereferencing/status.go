@@ -20,8 +20,10 @@
 import (
 	"context"
 	"errors"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
@@ -604,6 +606,21 @@ func (d *Dereferencer) enrichStatus(
 		)
 	}
 
+	// If sensitive media is limited, either for this
+	// domain or for all, set reject reason for the
+	// attachments of a sensitive status. These are then
+	// kept with only the blurhash and dimensions from
+	// the AP object, and a link to the remote file.
+	attachRejectReason := rejectReason
+	if attachRejectReason == nil && util.PtrOrZero(latestStatus.Sensitive) &&
+		(limit.MediaSensitiveBlurhashOnly() || config.GetMediaRemoteSensitiveBlurhashOnly()) {
+		attachRejectReason = new(gtsmodel.MediaErrorDetails)
+		*attachRejectReason = gtsmodel.NewMediaErrorDetails(
+			gtsmodel.MediaErrorTypePolicy,
+			gtsmodel.MediaErrorTypePolicy_Sensitive,
+		)
+	}
+
 	// Populate media attachments associated with status,
 	// passing in existing status to reuse old where possible
 	// (especially important here to reduce need to dereference).
@@ -611,7 +628,7 @@ func (d *Dereferencer) enrichStatus(
 		requestUser,
 		status,
 		latestStatus,
-		rejectReason,
+		attachRejectReason,
 	)
 	if err != nil {
 		return nil, nil, gtserror.Newf("error populating attachments for status %s: %w", uri, err)
@@ -659,6 +676,12 @@ func (d *Dereferencer) enrichStatus(
 		if err := d.state.DB.UpdateStatus(ctx, latestStatus, cols...); err != nil {
 			return nil, nil, gtserror.Newf("error updating existing status %s: %w", uri, err)
 		}
+
+		if slices.Contains(cols, "visibility") {
+			// Status was de-listed or made public,
+			// update the public timeline caches.
+			d.state.Caches.Timelines.StatusVisibilityChanged(latestStatus)
+		}
 	}
 
 	return latestStatus, statusable, nil
@@ -903,6 +926,7 @@ func (d *Dereferencer) fetchStatusAttachments(
 				Blurhash:    &placeholder.Blurhash,
 				FocusX:      &placeholder.FileMeta.Focus.X,
 				FocusY:      &placeholder.FileMeta.Focus.Y,
+				Original:    &placeholder.FileMeta.Original,
 
 				// Pass reject reason ptr, which
 				// will skip downloading if set.
@@ -1107,7 +1131,7 @@ func (d *Dereferencer) handleStatusEdit(
 	status.Edits = existing.Edits
 
 	// Preallocate max slice length.
-	cols = make([]string, 1, 13)
+	cols = make([]string, 1, 16)
 
 	// Always update `fetched_at`.
 	cols[0] = "fetched_at"
@@ -1118,12 +1142,36 @@ func (d *Dereferencer) handleStatusEdit(
 		edited = true
 	}
 
+	// Check for edited status content language variants.
+	if !maps.Equal(existing.ContentMap, status.ContentMap) {
+		cols = append(cols, "content_map")
+
+		// Variants previously being unset doesn't
+		// necessarily indicate an edit, it may be a
+		// status stored before variants were.
+		if existing.ContentMap != nil {
+			edited = true
+		}
+	}
+
 	// Check for edited status content warning.
 	if existing.ContentWarning != status.ContentWarning {
 		cols = append(cols, "content_warning")
 		edited = true
 	}
 
+	// Check for edited (article) status title.
+	if existing.Title != status.Title {
+		cols = append(cols, "title")
+
+		// Title previously being unset doesn't
+		// necessarily indicate an edit, it may
+		// be an article stored before titles were.
+		if existing.Title != "" {
+			edited = true
+		}
+	}
+
 	// Check for edited status sensitive flag.
 	if *existing.Sensitive != *status.Sensitive {
 		cols = append(cols, "sensitive")
@@ -1136,6 +1184,17 @@ func (d *Dereferencer) handleStatusEdit(
 		edited = true
 	}
 
+	// Check for changed status visibility. Only a
+	// switch between public and unlisted is accepted,
+	// as anything else would change who may see it.
+	if existing.Visibility != status.Visibility {
+		if gtsmodel.VisibilityChangeable(existing.Visibility, status.Visibility) {
+			cols = append(cols, "visibility")
+		} else {
+			status.Visibility = existing.Visibility
+		}
+	}
+
 	if pollChanged {
 		// Attached poll was changed.
 		cols = append(cols, "poll_id")
@@ -24,6 +24,7 @@
 
 	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/federation/dereferencing"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -217,6 +218,59 @@ func (suite *StatusTestSuite) TestDereferenceStatusWithImageAndNoContent() {
 	suite.NoError(err)
 }
 
+func (suite *StatusTestSuite) TestDereferenceSensitiveStatusBlurhashOnly() {
+	// Only keep blurhash for
+	// all sensitive remote media.
+	config.SetMediaRemoteSensitiveBlurhashOnly(true)
+
+	suite.testDereferenceSensitiveStatusBlurhashOnly()
+}
+
+func (suite *StatusTestSuite) TestDereferenceSensitiveStatusBlurhashOnlyDomainLimit() {
+	// Only keep blurhash for sensitive
+	// remote media from turnip.farm.
+	limit := &gtsmodel.DomainLimit{
+		ID:                 "01K7QF1RQ8N0ZB5X3Y6T2W9C4V",
+		Domain:             "turnip.farm",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		MediaPolicy:        gtsmodel.MediaPolicySensitiveBlurhashOnly,
+		FollowsPolicy:      gtsmodel.FollowsPolicyNoAction,
+		StatusesPolicy:     gtsmodel.StatusesPolicyNoAction,
+		AccountsPolicy:     gtsmodel.AccountsPolicyNoAction,
+		FederationPolicy:   gtsmodel.FederationPolicyNoAction,
+	}
+	if err := suite.db.PutDomainLimit(suite.T().Context(), limit); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.testDereferenceSensitiveStatusBlurhashOnly()
+}
+
+func (suite *StatusTestSuite) testDereferenceSensitiveStatusBlurhashOnly() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+	statusURI := "https://turnip.farm/users/turniplover6969/statuses/70c53e54-3146-42d5-a630-83c8b6c7c042"
+
+	// Mark the remote status as sensitive.
+	ap.AppendSensitive(suite.client.TestRemoteStatuses[statusURI], true)
+
+	status, _, _, err := suite.dereferencer.GetStatusByURI(suite.T().Context(), fetchingAccount.Username, testrig.URLMustParse(statusURI), nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*status.Sensitive)
+
+	// The attachment should be stored, but not downloaded.
+	suite.Len(status.Attachments, 1)
+	attachment := status.Attachments[0]
+	suite.Equal("https://turnip.farm/attachments/f17843c7-015e-4251-9b5a-91389c49ee57.jpg", attachment.RemoteURL)
+	suite.Equal(gtsmodel.NewMediaErrorDetails(
+		gtsmodel.MediaErrorTypePolicy,
+		gtsmodel.MediaErrorTypePolicy_Sensitive,
+	), attachment.Error)
+	suite.Empty(attachment.File.Path)
+	suite.Empty(attachment.Thumbnail.Path)
+}
+
 func (suite *StatusTestSuite) TestDereferenceStatusWithNonMatchingURI() {
 	fetchingAccount := suite.testAccounts["local_account_1"]
 
@@ -448,6 +502,32 @@ func (suite *StatusTestSuite) TestDereferencerRefreshStatusRace() {
 	suite.Equal(afterEdit.EditIDs, afterBodge.EditIDs)
 }
 
+func (suite *StatusTestSuite) TestBackfillStatusRepliesCooldown() {
+	config.SetStatusesBackfillReplies(true)
+
+	var (
+		ctx          = suite.T().Context()
+		statuses     = testrig.NewTestStatuses()
+		localStatus  = statuses["local_account_1_status_1"]
+		remoteStatus = statuses["remote_account_1_status_1"]
+		cache        = suite.state.Caches.StatusRepliesBackfill
+	)
+
+	// Local statuses should never be backfilled.
+	suite.dereferencer.BackfillStatusReplies(ctx, "admin", localStatus, nil)
+	suite.False(cache.Has(localStatus.URI))
+
+	// Remote status should be marked as backfilled.
+	suite.dereferencer.BackfillStatusReplies(ctx, "admin", remoteStatus, nil)
+	suite.True(cache.Has(remoteStatus.URI))
+
+	// A repeat backfill within the cooldown
+	// should not enqueue another dereference.
+	queued := suite.state.Workers.Dereference.Queue.Len()
+	suite.dereferencer.BackfillStatusReplies(ctx, "admin", remoteStatus, nil)
+	suite.Equal(queued, suite.state.Workers.Dereference.Queue.Len())
+}
+
 // editStatusable updates the given statusable attributes.
 // note that this acts on the original object, no copying.
 func (suite *StatusTestSuite) editStatusable(
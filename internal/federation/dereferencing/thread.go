@@ -35,6 +35,97 @@
 // ancesters we are willing to follow before returning error.
 const maxIter = 512
 
+// descendantLimits provides optional bounds on
+// dereferencing of status descendants, beyond
+// maxIter. Zero values indicate no limit.
+type descendantLimits struct {
+	// maxDepth is the maximum depth of
+	// replies to follow down from the
+	// starting status, where direct
+	// replies are at depth 1.
+	maxDepth int
+
+	// maxStatuses is the maximum number
+	// of reply statuses to dereference.
+	maxStatuses int
+}
+
+// BackfillStatusReplies enqueues asynchronous dereferencing of the
+// given remote status' replies collection, bounded in depth and
+// total number of statuses by configuration. This is intended to
+// be called when a user views a thread, so that more of the remote
+// conversation is available on subsequent loads.
+//
+// To avoid hammering origin servers, replies of each status will
+// be backfilled at most once per configured cooldown period.
+//
+// If set, newThreadEntryCallback will be called for
+// each *new* status dereferenced in this way.
+func (d *Dereferencer) BackfillStatusReplies(
+	ctx context.Context,
+	requestUser string,
+	status *gtsmodel.Status,
+	newThreadEntryCallback func(context.Context, *gtsmodel.Status) error,
+) {
+	if !config.GetStatusesBackfillReplies() {
+		// Backfill disabled.
+		return
+	}
+
+	if status.IsLocal() {
+		// We already have
+		// all local replies.
+		return
+	}
+
+	// Mark this status as backfilled, returning early
+	// if it was already marked within cooldown period.
+	if !d.state.Caches.StatusRepliesBackfill.Add(status.URI, struct{}{}) {
+		return
+	}
+
+	uri, err := url.Parse(status.URI)
+	if err != nil {
+		log.Errorf(ctx, "invalid status uri %q: %v", status.URI, err)
+		return
+	}
+
+	limits := descendantLimits{
+		maxDepth:    config.GetStatusesBackfillRepliesMaxDepth(),
+		maxStatuses: config.GetStatusesBackfillRepliesMaxStatuses(),
+	}
+
+	d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		// Refetch the status to get an up-to-date
+		// statusable, with its replies collection.
+		_, statusable, _, err := d.enrichStatusSafely(ctx,
+			requestUser,
+			uri,
+			status,
+			nil,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error dereferencing status %s: %v", status.URI, err)
+			return
+		}
+
+		if statusable == nil {
+			// Nothing to walk.
+			return
+		}
+
+		if err := d.dereferenceStatusDescendants(ctx,
+			requestUser,
+			uri,
+			statusable,
+			limits,
+			newThreadEntryCallback,
+		); err != nil {
+			log.Error(ctx, err)
+		}
+	})
+}
+
 // dereferenceThread handles dereferencing status thread after
 // fetch. Passing off appropriate parts to be enqueued for async
 // processing, or handling some parts synchronously when required.
@@ -61,7 +152,7 @@ func (d *Dereferencer) dereferenceThread(
 
 		// Enqueue dereferencing remaining status thread, (children), asychronously .
 		d.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
-			if err := d.dereferenceStatusDescendants(ctx, requestUser, uri, statusable, newThreadEntryCallback); err != nil {
+			if err := d.dereferenceStatusDescendants(ctx, requestUser, uri, statusable, descendantLimits{}, newThreadEntryCallback); err != nil {
 				log.Error(ctx, err)
 			}
 		})
@@ -71,7 +162,7 @@ func (d *Dereferencer) dereferenceThread(
 			if err := d.dereferenceStatusAncestors(ctx, requestUser, status, newThreadEntryCallback); err != nil {
 				log.Error(ctx, err)
 			}
-			if err := d.dereferenceStatusDescendants(ctx, requestUser, uri, statusable, newThreadEntryCallback); err != nil {
+			if err := d.dereferenceStatusDescendants(ctx, requestUser, uri, statusable, descendantLimits{}, newThreadEntryCallback); err != nil {
 				log.Error(ctx, err)
 			}
 		})
@@ -242,7 +333,8 @@ func (d *Dereferencer) dereferenceStatusAncestors(
 
 // DereferenceStatusDescendents iterates downwards from
 // the given status, using its replies, to ensure that
-// as many children statuses as possible are dereferenced.
+// as many children statuses as possible are dereferenced,
+// within the bounds of maxIter and any given limits.
 //
 // If set, newThreadEntryCallback will be called for
 // each *new* status dereferenced in this way.
@@ -251,6 +343,7 @@ func (d *Dereferencer) dereferenceStatusDescendants(
 	username string,
 	statusIRI *url.URL,
 	parent ap.Statusable,
+	limits descendantLimits,
 	newThreadEntryCallback func(context.Context, *gtsmodel.Status) error,
 ) error {
 	statusIRIStr := statusIRI.String()
@@ -272,6 +365,10 @@ func (d *Dereferencer) dereferenceStatusDescendants(
 	// pages for this thread to prevent recursion.
 	derefdPages := make(map[string]struct{}, 16)
 
+	// Number of descendant statuses
+	// dereferenced so far, (for limits).
+	var derefdStatuses int
+
 	// frame represents a single stack frame when
 	// iteratively derefencing status descendants.
 	type frame struct {
@@ -354,6 +451,13 @@ func() *frame {
 					continue itemLoop
 				}
 
+				if limits.maxStatuses > 0 &&
+					derefdStatuses >= limits.maxStatuses {
+					l.Debugf("reached %d descendant statuses limit", limits.maxStatuses)
+					return nil
+				}
+				derefdStatuses++
+
 				// Dereference the remote status and store in the database.
 				// getStatusByURI guards against the following conditions:
 				//   - refetching recently fetched statuses (recursion!)
@@ -382,6 +486,13 @@ func() *frame {
 					}
 				}
 
+				// Items of the current frame are at depth len(stack)+1,
+				// so a frame for this child's replies would be +1 that.
+				if limits.maxDepth > 0 &&
+					len(stack)+2 > limits.maxDepth {
+					continue itemLoop
+				}
+
 				// Extract any attached collection + ID URI from status.
 				page, pageURI := getAttachedStatusCollectionPage(statusable)
 				if page == nil {
@@ -46,6 +46,12 @@
 type federatingActor struct {
 	sideEffectActor pub.DelegateActor
 	wrapped         pub.FederatingActor
+	db              *federatingdb.DB
+
+	// verifyProof checks incoming inbox requests
+	// for a valid FEP-8b32 integrity proof, marking
+	// the returned context if one is present.
+	verifyProof func(context.Context, *http.Request) context.Context
 }
 
 func deliveryRecipientPreSort(actorAndCollectionIRIs []*url.URL) []*url.URL {
@@ -97,7 +103,7 @@ func(a *url.URL, b *url.URL) int {
 }
 
 // newFederatingActor returns a federatingActor.
-func newFederatingActor(c pub.CommonBehavior, s2s pub.FederatingProtocol, db pub.Database, clock pub.Clock) pub.FederatingActor {
+func newFederatingActor(c pub.CommonBehavior, s2s pub.FederatingProtocol, db *federatingdb.DB, clock pub.Clock) *federatingActor {
 	sideEffectActor := pub.NewSideEffectActor(c, s2s, nil, db, clock)
 
 	// Hook in our own custom Serialize function.
@@ -109,6 +115,7 @@ func newFederatingActor(c pub.CommonBehavior, s2s pub.FederatingProtocol, db pub
 	return &federatingActor{
 		sideEffectActor: sideEffectActor,
 		wrapped:         pub.NewCustomActor(sideEffectActor, false, true, clock),
+		db:              db,
 	}
 }
 
@@ -135,7 +142,7 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 		const ct1 = "application/activity+json"
 		const ct2 = "application/ld+json;profile=https://w3.org/ns/activitystreams"
 		err := fmt.Errorf("Content-Type %s not acceptable, this endpoint accepts: [%q %q]", ct, ct1, ct2)
-		return false, gtserror.NewErrorNotAcceptable(err)
+		return false, gtserror.WithErrCode(gtserror.NewErrorNotAcceptable(err), gtserror.ErrCodeContentTypeInvalid)
 	}
 
 	// Authenticate request by checking http signature.
@@ -208,14 +215,25 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 		// Likely suspended by an admin or
 		// defed action on *this* instance.
 		const text = "requesting account suspended"
-		return false, gtserror.NewErrorForbidden(errors.New(text), text)
+		errWithCode := gtserror.NewErrorForbidden(errors.New(text), text)
+		return false, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeAccountSuspended)
 	}
 
+	// Count this delivery towards
+	// the requester's domain stats.
+	f.db.MarkActivityReceived(requester)
+
 	/*
 		Begin processing the request, but note that we
 		have not yet applied authorization (ie., blocks).
 	*/
 
+	// Check for a valid integrity proof on the activity,
+	// allowing us to trust forwarded activities as-is.
+	if f.verifyProof != nil && config.GetInstanceIntegrityProofsVerify() {
+		ctx = f.verifyProof(ctx, r)
+	}
+
 	// Resolve the activity, rejecting badly formatted / transient.
 	activity, ok, errWithCode := ap.ResolveIncomingActivity(r)
 	if errWithCode != nil {
@@ -257,7 +275,8 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 		// one or more directly involved actors, or between
 		// receiving account and one of those actors.
 		const text = "blocked"
-		return false, gtserror.NewErrorForbidden(errors.New(text), text)
+		errWithCode := gtserror.NewErrorForbidden(errors.New(text), text)
+		return false, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeActorBlocked)
 	}
 
 	// Copy existing URL + add
@@ -293,7 +312,8 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 			l.Warnf("malformed incoming activity: %v", err)
 
 			const text = "malformed incoming activity"
-			return false, gtserror.NewErrorBadRequest(errors.New(text), text)
+			errWithCode := gtserror.NewErrorBadRequest(errors.New(text), text)
+			return false, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeActivityMalformed)
 		}
 
 		// Check if a function in the federatingDB
@@ -329,7 +349,7 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 			// Include the activity in attached log fields on error for useful info in later logging.
 			const text = "actor not permitted to delete or update object that doesn't belong to them"
 			err := gtserror.WithLogField(errors.New(text), "activity", federatingdb.Serialize{activity})
-			return false, gtserror.NewErrorForbidden(err, text)
+			return false, gtserror.WithErrCode(gtserror.NewErrorForbidden(err, text), gtserror.ErrCodeActivityOriginMismatch)
 		}
 
 		// Something else went wrong, what the heck! This is an actual 500-able error.
@@ -339,6 +359,12 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 		return false, gtserror.NewErrorInternalError(err)
 	}
 
+	// Remember that we've seen this activity, so
+	// that any redeliveries of it (eg., replays by
+	// relays, or retries by a peer that timed out
+	// waiting for our response) will be dropped.
+	f.db.MarkActivitySeen(ctx, inboxID, activity)
+
 	// Side effects are complete. Now delegate determining whether
 	// to do inbox forwarding, as well as the action to do it.
 	if err := f.sideEffectActor.InboxForwarding(ctx, inboxID, activity); err != nil {
@@ -19,16 +19,19 @@
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"encoding/json"
 	"io"
 	"net/url"
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"code.superseriousbusiness.org/gotosocial/testrig"
 	"github.com/stretchr/testify/suite"
@@ -166,6 +169,82 @@ func (suite *FederatingActorTestSuite) TestSendRemoteFollower() {
 }`, dst.String())
 }
 
+func (suite *FederatingActorTestSuite) TestSendRemoteFollowerIntegrityProof() {
+	ctx := suite.T().Context()
+	testAccount := suite.testAccounts["local_account_1"]
+	testRemoteAccount := suite.testAccounts["remote_account_1"]
+
+	config.SetInstanceIntegrityProofsSign(true)
+
+	err := suite.state.DB.Put(ctx, &gtsmodel.Follow{
+		ID:              "01G1TRWV4AYCDBX5HRWT2EVBCV",
+		CreatedAt:       testrig.TimeMustParse("2022-06-02T12:22:21+02:00"),
+		UpdatedAt:       testrig.TimeMustParse("2022-06-02T12:22:21+02:00"),
+		AccountID:       testRemoteAccount.ID,
+		TargetAccountID: testAccount.ID,
+		ShowReblogs:     util.Ptr(true),
+		URI:             "http://fossbros-anonymous.io/users/foss_satan/follows/01G1TRWV4AYCDBX5HRWT2EVBCV",
+		Notify:          util.Ptr(false),
+	})
+	suite.NoError(err)
+
+	testNote := testrig.NewAPNote(
+		&testrig.NewAPNoteParams{
+			ID:           testrig.URLMustParse("http://localhost:8080/users/the_mighty_zork/statuses/01G1TR6BADACCZWQMNF9X21TV5"),
+			URL:          testrig.URLMustParse("http://localhost:8080/@the_mighty_zork/statuses/01G1TR6BADACCZWQMNF9X21TV5"),
+			CreatedAt:    testrig.TimeMustParse("2022-06-02T12:22:21+02:00"),
+			Content:      "boobies",
+			AttributedTo: testrig.URLMustParse(testAccount.URI),
+			To:           []*url.URL{testrig.URLMustParse(testAccount.FollowersURI)},
+		},
+	)
+	testActivity := testrig.WrapAPNoteInCreate(testrig.URLMustParse("http://localhost:8080/whatever_some_create"), testrig.URLMustParse(testAccount.URI), testrig.TimeMustParse("2022-06-02T12:22:21+02:00"), testNote)
+
+	httpClient := testrig.NewMockHTTPClient(nil, "../../testrig/media")
+	tc := testrig.NewTestTransportController(&suite.state, httpClient)
+
+	// setup module being tested
+	federator := federation.NewFederator(
+		&suite.state,
+		testrig.NewTestFederatingDB(&suite.state),
+		tc,
+		suite.typeconverter,
+		visibility.NewFilter(&suite.state),
+		interaction.NewFilter(&suite.state),
+		testrig.NewTestMediaManager(&suite.state),
+	)
+
+	_, err = federator.FederatingActor().Send(ctx, testrig.URLMustParse(testAccount.OutboxURI), testActivity)
+	suite.NoError(err)
+
+	var sent []byte
+	if !testrig.WaitFor(func() bool {
+		delivery, ok := suite.state.Workers.Delivery.Queue.Pop()
+		if !ok {
+			return false
+		}
+		sent, err = io.ReadAll(delivery.Request.Body)
+		if err != nil {
+			panic("error reading body: " + err.Error())
+		}
+		return true
+	}) {
+		suite.FailNow("timed out waiting for message")
+	}
+
+	var doc map[string]any
+	suite.NoError(json.Unmarshal(sent, &doc))
+
+	// Proof should be made with zork's
+	// derived key, and verify successfully.
+	method, ok := integrity.Method(doc)
+	suite.True(ok)
+	suite.Equal(integrity.KeyID(testAccount.URI), method)
+
+	key := integrity.DeriveKey(testAccount.PrivateKey)
+	suite.NoError(integrity.Verify(doc, key.Public().(ed25519.PublicKey)))
+}
+
 func TestFederatingActorTestSuite(t *testing.T) {
 	suite.Run(t, new(FederatingActorTestSuite))
 }
@@ -19,13 +19,18 @@
 
 import (
 	"context"
+	"errors"
 	"net/url"
 	"slices"
 
+	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
 )
 
@@ -60,6 +65,39 @@ func (f *DB) Announce(ctx context.Context, announce vocab.ActivityStreamsAnnounc
 		)
 	}
 
+	// Announces from relays we're subscribed to
+	// aren't stored as boosts; instead we pull
+	// in the Announced statuses themselves.
+	if receivingAcct.IsInstance() {
+		relay, err := f.state.DB.GetRelayByAccountID(
+			gtscontext.SetBarebones(ctx),
+			requestingAcct.ID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting relay: %w", err)
+		}
+
+		if relay != nil {
+			f.relayAnnounce(ctx, announce, receivingAcct, requestingAcct)
+			return nil
+		}
+	}
+
+	// Group actors (eg., Lemmy / kbin communities)
+	// Announce the activities of their members; unwrap
+	// these so the objects can be handled as boosts.
+	if requestingAcct.ActorType.IsGroup() {
+		objIRIs := ap.ExtractGroupAnnounceObjectURIs(announce)
+		if len(objIRIs) == 0 {
+			log.Debugf(ctx, "no supported objects in group announce from %s", requestingAcct.URI)
+			return nil
+		}
+
+		objProp := streams.NewActivityStreamsObjectProperty()
+		announce.SetActivityStreamsObject(objProp)
+		ap.AppendObjectIRIs(announce, objIRIs...)
+	}
+
 	boost, isNew, err := f.converter.ASAnnounceToStatus(ctx, announce)
 	if err != nil {
 		return gtserror.Newf("error converting announce to boost: %w", err)
@@ -82,3 +120,24 @@ func (f *DB) Announce(ctx context.Context, announce vocab.ActivityStreamsAnnounc
 
 	return nil
 }
+
+// relayAnnounce queues the objects of an Announce received from
+// a subscribed relay for dereferencing, as if they were forwarded
+// Creates. This will cause them to be inserted into the database,
+// and hence to show up in the federated timeline as appropriate.
+func (f *DB) relayAnnounce(
+	ctx context.Context,
+	announce vocab.ActivityStreamsAnnounce,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) {
+	for _, objIRI := range ap.GetObjectIRIs(announce) {
+		f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			APIRI:          objIRI,
+			Receiving:      receivingAcct,
+			Requesting:     requestingAcct,
+		})
+	}
+}
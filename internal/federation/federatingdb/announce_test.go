@@ -18,6 +18,8 @@
 package federatingdb_test
 
 import (
+	"bytes"
+	"io"
 	"testing"
 	"time"
 
@@ -99,6 +101,90 @@ func (suite *AnnounceTestSuite) TestAnnounceTwice() {
 	suite.False(ok)
 }
 
+func (suite *AnnounceTestSuite) TestGroupAnnounceCreate() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	// Pretend foss_satan is a Lemmy-style community.
+	groupAccount := new(gtsmodel.Account)
+	*groupAccount = *suite.testAccounts["remote_account_1"]
+	groupAccount.ActorType = gtsmodel.AccountActorTypeGroup
+
+	const announceJSON = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/activities/announce/page/1234",
+  "type": "Announce",
+  "actor": "http://fossbros-anonymous.io/users/foss_satan",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "cc": "http://fossbros-anonymous.io/users/foss_satan/followers",
+  "published": "2024-01-01T12:00:00Z",
+  "object": {
+    "id": "http://fossbros-anonymous.io/activities/create/5678",
+    "type": "Create",
+    "actor": "http://example.org/users/Some_User",
+    "object": "http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1"
+  }
+}`
+
+	ctx := createTestContext(suite.T(), receivingAccount, groupAccount)
+
+	t, err := ap.DecodeType(ctx, io.NopCloser(bytes.NewBufferString(announceJSON)))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	err = suite.federatingDB.Announce(ctx, t.(vocab.ActivityStreamsAnnounce))
+	suite.NoError(err)
+
+	// The wrapped Create should be unwrapped, to
+	// give a boost of the created object by the group.
+	msg, _ := suite.getFederatorMsg(5 * time.Second)
+	suite.Equal(ap.ActivityAnnounce, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+
+	boost, ok := msg.GTSModel.(*gtsmodel.Status)
+	suite.True(ok)
+	suite.Equal(groupAccount.ID, boost.AccountID)
+	suite.Equal("http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1", boost.BoostOfURI)
+}
+
+func (suite *AnnounceTestSuite) TestGroupAnnounceLike() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	// Pretend foss_satan is a Lemmy-style community.
+	groupAccount := new(gtsmodel.Account)
+	*groupAccount = *suite.testAccounts["remote_account_1"]
+	groupAccount.ActorType = gtsmodel.AccountActorTypeGroup
+
+	const announceJSON = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/activities/announce/like/1234",
+  "type": "Announce",
+  "actor": "http://fossbros-anonymous.io/users/foss_satan",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "object": {
+    "id": "http://fossbros-anonymous.io/activities/like/5678",
+    "type": "Like",
+    "actor": "http://example.org/users/Some_User",
+    "object": "http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1"
+  }
+}`
+
+	ctx := createTestContext(suite.T(), receivingAccount, groupAccount)
+
+	t, err := ap.DecodeType(ctx, io.NopCloser(bytes.NewBufferString(announceJSON)))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	err = suite.federatingDB.Announce(ctx, t.(vocab.ActivityStreamsAnnounce))
+	suite.NoError(err)
+
+	// Forwarded Likes aren't supported,
+	// so nothing should have been queued.
+	_, ok := suite.getFederatorMsg(time.Second)
+	suite.False(ok)
+}
+
 func TestAnnounceTestSuite(t *testing.T) {
 	suite.Run(t, &AnnounceTestSuite{})
 }
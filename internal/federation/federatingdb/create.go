@@ -50,7 +50,7 @@ func (f *DB) Create(ctx context.Context, asType vocab.Type) error {
 
 	// Cache entry for this activity type's ID for later
 	// checks in the Exist() function if we see it again.
-	f.activityIDs.Set(ap.GetJSONLDId(asType).String(), struct{}{})
+	f.storeActivityID(ctx, asType)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -102,7 +102,24 @@ func (f *DB) Create(ctx context.Context, asType vocab.Type) error {
 	for _, statusable := range statusables {
 		// Check if this is a forwarded object, i.e. did
 		// the account making the request also create this?
-		forwarded := !isSender(statusable, requesting)
+		// (or did its author sign an integrity proof for it?)
+		forwarded := !isSender(statusable, requesting) &&
+			!isProofSigner(ctx, statusable)
+
+		if receiving.IsInstance() && !forwarded &&
+			isRelayable(statusable) {
+			// Public statuses sent to our instance actor
+			// by their authors are relayed on to relay
+			// subscribers. The worker will check that the
+			// author's instance is actually subscribed.
+			f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityAnnounce,
+				APIRI:          ap.GetJSONLDId(statusable),
+				Receiving:      receiving,
+				Requesting:     requesting,
+			})
+		}
 
 		// Handle create event for this statusable.
 		if err := f.createStatusable(ctx,
@@ -27,6 +27,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/spam"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"codeberg.org/gruf/go-cache/v3/simple"
@@ -94,6 +95,17 @@ func New(
 
 // storeActivityID stores an entry in the .activityIDs cache for this
 // type's JSON-LD ID, for later checks in Exist() to mark it as seen.
-func (f *DB) storeActivityID(asType vocab.Type) {
-	f.activityIDs.Set(ap.GetJSONLDId(asType).String(), struct{}{})
+func (f *DB) storeActivityID(ctx context.Context, asType vocab.Type) {
+	f.activityIDs.Set(activityIDKey(ctx, ap.GetJSONLDId(asType).String()), struct{}{})
+}
+
+// activityIDKey returns the .activityIDs cache key for given activity ID.
+// This is scoped to the receiving account (if any), as the same activity
+// may be delivered to the inboxes of multiple accounts, and whether it is
+// handled can depend on the receiver.
+func activityIDKey(ctx context.Context, id string) string {
+	if receiving := gtscontext.ReceivingAccount(ctx); receiving != nil {
+		return receiving.ID + " " + id
+	}
+	return id
 }
@@ -34,7 +34,7 @@ func (f *DB) Flag(ctx context.Context, flaggable vocab.ActivityStreamsFlag) erro
 	log.DebugKV(ctx, "flag", Serialize{flaggable})
 
 	// Mark activity as handled.
-	f.storeActivityID(flaggable)
+	f.storeActivityID(ctx, flaggable)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -21,10 +21,13 @@
 	"context"
 	"net/http"
 
+	"code.superseriousbusiness.org/activity/pub"
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
 )
@@ -33,7 +36,7 @@ func (f *DB) Follow(ctx context.Context, followable vocab.ActivityStreamsFollow)
 	log.DebugKV(ctx, "follow", Serialize{followable})
 
 	// Mark activity as handled.
-	f.storeActivityID(followable)
+	f.storeActivityID(ctx, followable)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -44,11 +47,29 @@ func (f *DB) Follow(ctx context.Context, followable vocab.ActivityStreamsFollow)
 	requesting := activityContext.requestingAcct
 	receiving := activityContext.receivingAcct
 
-	// Convert received AS block type to internal follow request model.
-	followreq, err := f.converter.ASFollowToFollowRequest(ctx, followable)
-	if err != nil {
-		err := gtserror.Newf("error converting from AS type: %w", err)
-		return gtserror.WrapWithCode(http.StatusBadRequest, err)
+	var followreq *gtsmodel.FollowRequest
+
+	if receiving.IsInstance() && isRelayFollow(followable) {
+		// This is a Mastodon-style relay
+		// subscription Follow of the Public
+		// collection, sent to our instance
+		// actor. Treat it as a Follow of the
+		// instance actor itself.
+		followreq = &gtsmodel.FollowRequest{
+			URI:             ap.GetJSONLDId(followable).String(),
+			AccountID:       requesting.ID,
+			Account:         requesting,
+			TargetAccountID: receiving.ID,
+			TargetAccount:   receiving,
+		}
+	} else {
+		// Convert received AS block type to internal follow request model.
+		var err error
+		followreq, err = f.converter.ASFollowToFollowRequest(ctx, followable)
+		if err != nil {
+			err := gtserror.Newf("error converting from AS type: %w", err)
+			return gtserror.WrapWithCode(http.StatusBadRequest, err)
+		}
 	}
 
 	// Ensure follow enacted by correct account.
@@ -82,3 +103,46 @@ func (f *DB) Follow(ctx context.Context, followable vocab.ActivityStreamsFollow)
 
 	return nil
 }
+
+// isRelayFollow returns whether the given Follow has the magic
+// Public collection as its only object, and has a usable id,
+// indicating that it's a relay subscription request. Only
+// considered when this instance is acting as a relay endpoint.
+func isRelayFollow(followable vocab.ActivityStreamsFollow) bool {
+	if !config.GetInstanceRelayEndpoint() {
+		return false
+	}
+
+	if ap.GetJSONLDId(followable) == nil {
+		return false
+	}
+
+	objIRIs := ap.GetObjectIRIs(followable)
+	return len(objIRIs) == 1 && pub.IsPublic(objIRIs[0].String())
+}
+
+// isRelayable returns whether the given statusable
+// is addressed to public, and has a usable id, such
+// that it can be relayed on to relay subscribers.
+// Only considered when this instance is acting as
+// a relay endpoint.
+func isRelayable(statusable ap.Statusable) bool {
+	if !config.GetInstanceRelayEndpoint() {
+		return false
+	}
+
+	if ap.GetJSONLDId(statusable) == nil {
+		return false
+	}
+
+	for _, iri := range append(
+		ap.GetTo(statusable),
+		ap.GetCc(statusable)...,
+	) {
+		if pub.IsPublic(iri.String()) {
+			return true
+		}
+	}
+
+	return false
+}
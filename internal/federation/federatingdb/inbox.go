@@ -22,10 +22,16 @@
 	"fmt"
 	"net/url"
 
+	"code.superseriousbusiness.org/activity/pub"
 	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/activity/streams/vocab"
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/fedstats"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
 )
 
@@ -34,9 +40,60 @@
 //
 // The library makes this call only after acquiring a lock first.
 //
-// Implementation note: we have our own logic for inboxes so always return false here.
+// Implementation note: we have our own logic for inboxes, so we
+// only check here whether an activity with the given id has already
+// been delivered to the given inbox. If so, the library will skip
+// processing its side effects, dropping the duplicate delivery.
+//
+// Deliveries of the same activity to other inboxes are not dropped,
+// as whether an activity is accepted can depend on its receiver.
 func (f *DB) InboxContains(c context.Context, inbox, id *url.URL) (contains bool, err error) {
-	return false, nil
+	if inbox == nil || id == nil ||
+		config.GetInstanceFederationSeenActivityTTL() <= 0 {
+		// Can't check, or not
+		// storing seen activities.
+		return false, nil
+	}
+
+	seen, err := f.state.DB.IsActivitySeen(c, inbox.String(), id.String())
+	if err != nil {
+		return false, gtserror.Newf("db error checking seen activity %s: %w", id, err)
+	}
+
+	if seen {
+		log.Debugf(c, "dropping activity %s already seen at %s", id, inbox)
+	}
+
+	return seen, nil
+}
+
+// MarkActivitySeen stores the ID of the given activity,
+// which has been successfully posted to the given inbox, so
+// that later deliveries of it there are dropped by InboxContains.
+func (f *DB) MarkActivitySeen(ctx context.Context, inbox *url.URL, activity pub.Activity) {
+	if config.GetInstanceFederationSeenActivityTTL() <= 0 {
+		// Not storing
+		// seen activities.
+		return
+	}
+
+	id := ap.GetJSONLDId(activity)
+	if id == nil {
+		// Can't store
+		// without an ID.
+		return
+	}
+
+	if err := f.state.DB.PutSeenActivity(ctx, inbox.String(), id.String()); err != nil {
+		log.Errorf(ctx, "error storing seen activity %s: %v", id, err)
+	}
+}
+
+// MarkActivityReceived counts an activity posted
+// to one of our inboxes by the given requester
+// towards the federation stats of their host.
+func (f *DB) MarkActivityReceived(requester *gtsmodel.Account) {
+	f.state.FedStats.Received(fedstats.AccountHost(requester))
 }
 
 // GetInbox returns the first ordered collection page of the outbox at
@@ -94,6 +151,15 @@ func (f *DB) InboxesForIRI(c context.Context, iri *url.URL) (inboxIRIs []*url.UR
 			return nil, fmt.Errorf("couldn't get followers of local account %s: %s", localAccountUsername, err)
 		}
 
+		// Followers on the same instance usually share an
+		// inbox (ie., the sharedInbox of their instance), so
+		// coalesce these to deliver only once to each inbox.
+		var (
+			recipients int
+			inboxes    = make(map[string]struct{}, len(follows))
+		)
+
+		inboxIRIs = make([]*url.URL, 0, len(follows))
 		for _, follow := range follows {
 			if follow.Account == nil {
 				// No account exists for this follow,
@@ -101,13 +167,15 @@ func (f *DB) InboxesForIRI(c context.Context, iri *url.URL) (inboxIRIs []*url.UR
 				continue
 			}
 
-			// deliver to a shared inbox if we have that option
-			var inbox string
-			if config.GetInstanceDeliverToSharedInboxes() && follow.Account.SharedInboxURI != nil && *follow.Account.SharedInboxURI != "" {
-				inbox = *follow.Account.SharedInboxURI
-			} else {
-				inbox = follow.Account.InboxURI
+			recipients++
+
+			inbox := deliveryInbox(follow.Account)
+			if _, ok := inboxes[inbox]; ok {
+				// Already delivering
+				// to this inbox.
+				continue
 			}
+			inboxes[inbox] = struct{}{}
 
 			inboxIRI, err := url.Parse(inbox)
 			if err != nil {
@@ -115,19 +183,16 @@ func (f *DB) InboxesForIRI(c context.Context, iri *url.URL) (inboxIRIs []*url.UR
 			}
 			inboxIRIs = append(inboxIRIs, inboxIRI)
 		}
+
+		// Track how many followers were coalesced onto how many inboxes.
+		f.state.Workers.Delivery.Stats.Add(recipients, len(inboxIRIs))
+
 		return inboxIRIs, nil
 	}
 
 	// check if this is just an account IRI...
 	if account, err := f.state.DB.GetAccountByURI(c, iri.String()); err == nil {
-		// deliver to a shared inbox if we have that option
-		var inbox string
-		if config.GetInstanceDeliverToSharedInboxes() && account.SharedInboxURI != nil && *account.SharedInboxURI != "" {
-			inbox = *account.SharedInboxURI
-		} else {
-			inbox = account.InboxURI
-		}
-
+		inbox := deliveryInbox(account)
 		inboxIRI, err := url.Parse(inbox)
 		if err != nil {
 			return nil, fmt.Errorf("error parsing account inbox uri %s: %s", account.InboxURI, account.InboxURI)
@@ -143,3 +208,14 @@ func (f *DB) InboxesForIRI(c context.Context, iri *url.URL) (inboxIRIs []*url.UR
 	// no error, we just didn't find anything so let the library handle the rest
 	return nil, nil
 }
+
+// deliveryInbox returns the inbox to deliver to for the given account:
+// the sharedInbox of its instance, if set and delivering to shared
+// inboxes is enabled, else falling back to the account's own inbox.
+func deliveryInbox(account *gtsmodel.Account) string {
+	if config.GetInstanceDeliverToSharedInboxes() &&
+		account.SharedInboxURI != nil && *account.SharedInboxURI != "" {
+		return *account.SharedInboxURI
+	}
+	return account.InboxURI
+}
@@ -20,6 +20,10 @@
 import (
 	"testing"
 
+	"code.superseriousbusiness.org/activity/streams"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/testrig"
 	"github.com/stretchr/testify/suite"
 )
@@ -82,6 +86,116 @@ func (suite *InboxTestSuite) TestInboxesForAccountIRIWithSharedInbox() {
 	suite.Contains(asStrings, "http://some-inbox-iri/weeeeeeeeeeeee")
 }
 
+func (suite *InboxTestSuite) TestInboxesForFollowersIRICoalesceSharedInbox() {
+	ctx := suite.T().Context()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Give both followers
+	// the same shared inbox.
+	sharedInbox := "http://some-inbox-iri/weeeeeeeeeeeee"
+	for _, follower := range []string{"local_account_2", "admin_account"} {
+		account := suite.testAccounts[follower]
+		account.SharedInboxURI = &sharedInbox
+		if err := suite.db.UpdateAccount(ctx, account); err != nil {
+			suite.FailNow("error updating account")
+		}
+	}
+
+	stats := &suite.state.Workers.Delivery.Stats
+	recipients, inboxes := stats.Recipients(), stats.Inboxes()
+
+	inboxIRIs, err := suite.federatingDB.InboxesForIRI(ctx, testrig.URLMustParse(testAccount.FollowersURI))
+	suite.NoError(err)
+
+	// Both followers should be
+	// coalesced onto one inbox.
+	suite.Len(inboxIRIs, 1)
+	suite.Equal(sharedInbox, inboxIRIs[0].String())
+	suite.Equal(recipients+2, stats.Recipients())
+	suite.Equal(inboxes+1, stats.Inboxes())
+}
+
+func (suite *InboxTestSuite) TestInboxContainsSeenActivity() {
+	var (
+		ctx      = suite.T().Context()
+		inboxIRI = testrig.URLMustParse(suite.testAccounts["local_account_1"].InboxURI)
+		id       = testrig.URLMustParse("http://fossbros-anonymous.io/users/foss_satan/activities/01H8G1XQ4BNC4ENQSFDGMZVXBM")
+	)
+
+	like := streams.NewActivityStreamsLike()
+	ap.SetJSONLDId(like, id)
+
+	// Not yet seen.
+	contains, err := suite.federatingDB.InboxContains(ctx, inboxIRI, id)
+	suite.NoError(err)
+	suite.False(contains)
+
+	suite.federatingDB.MarkActivitySeen(ctx, inboxIRI, like)
+
+	// Now it's been seen.
+	contains, err = suite.federatingDB.InboxContains(ctx, inboxIRI, id)
+	suite.NoError(err)
+	suite.True(contains)
+
+	// But not at another account's inbox, as it
+	// may not have been accepted for that account.
+	otherInboxIRI := testrig.URLMustParse(suite.testAccounts["local_account_2"].InboxURI)
+	contains, err = suite.federatingDB.InboxContains(ctx, otherInboxIRI, id)
+	suite.NoError(err)
+	suite.False(contains)
+
+	// Nothing is seen if
+	// storing is disabled.
+	config.SetInstanceFederationSeenActivityTTL(0)
+	contains, err = suite.federatingDB.InboxContains(ctx, inboxIRI, id)
+	suite.NoError(err)
+	suite.False(contains)
+}
+
+func (suite *InboxTestSuite) TestMarkActivityReceivedSplitDomain() {
+	ctx := suite.T().Context()
+
+	// Account on a split-domain peer: handle
+	// is @someone@example.org, but the server
+	// itself lives at fedi.example.org.
+	requester := &gtsmodel.Account{
+		Username: "someone",
+		Domain:   "example.org",
+		URI:      "https://fedi.example.org/users/someone",
+		InboxURI: "https://fedi.example.org/users/someone/inbox",
+	}
+	suite.federatingDB.MarkActivityReceived(requester)
+
+	// Delivery back to the account's inbox is
+	// counted by the host of the inbox URL.
+	inbox := testrig.URLMustParse(requester.InboxURI)
+	suite.state.FedStats.Sent(inbox.Hostname())
+
+	if err := suite.state.FedStats.Flush(ctx, suite.db); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	stats, err := suite.db.GetFederationStats(ctx, "domain", true, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Both directions should be
+	// counted under the same host.
+	var found *gtsmodel.FederationStat
+	for _, stat := range stats {
+		suite.NotEqual("example.org", stat.Domain)
+		if stat.Domain == "fedi.example.org" {
+			found = stat
+		}
+	}
+	if found == nil {
+		suite.FailNow("no stats for fedi.example.org")
+	}
+	suite.EqualValues(1, found.ActivitiesReceived)
+	suite.EqualValues(1, found.ActivitiesSent)
+}
+
 func TestInboxTestSuite(t *testing.T) {
 	suite.Run(t, &InboxTestSuite{})
 }
@@ -103,7 +103,7 @@ func (f *DB) parseInteractionRequest(ctx context.Context, intRequest ap.Interact
 	// Get and stringify the ID/URI of interaction request once,
 	// and mark this particular activity as handled in ID cache.
 	intRequestURI := ap.GetJSONLDId(intRequest).String()
-	f.activityIDs.Set(intRequestURI, struct{}{})
+	f.activityIDs.Set(activityIDKey(ctx, intRequestURI), struct{}{})
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -35,7 +35,7 @@ func (f *DB) Like(ctx context.Context, likeable vocab.ActivityStreamsLike) error
 	log.DebugKV(ctx, "like", Serialize{likeable})
 
 	// Mark activity as handled.
-	f.storeActivityID(likeable)
+	f.storeActivityID(ctx, likeable)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -39,7 +39,7 @@ func (f *DB) Move(ctx context.Context, move vocab.ActivityStreamsMove) error {
 	log.DebugKV(ctx, "move", Serialize{move})
 
 	// Mark activity as handled.
-	f.storeActivityID(move)
+	f.storeActivityID(ctx, move)
 
 	activityContext := getActivityContext(ctx)
 	if activityContext.internal {
@@ -44,7 +44,7 @@ func (f *DB) Update(ctx context.Context, asType vocab.Type) error {
 	log.DebugKV(ctx, "update", Serialize{asType})
 
 	// Mark activity as handled.
-	f.storeActivityID(asType)
+	f.storeActivityID(ctx, asType)
 
 	// Extract relevant values from passed ctx.
 	activityContext := getActivityContext(ctx)
@@ -123,7 +123,9 @@ func (f *DB) updateStatusable(ctx context.Context, receivingAcct *gtsmodel.Accou
 
 	// Check if this is a forwarded object, i.e. did
 	// the account making the request also create this?
-	forwarded := !isSender(statusable, requestingAcct)
+	// (or did its author sign an integrity proof for it?)
+	forwarded := !isSender(statusable, requestingAcct) &&
+		!isProofSigner(ctx, statusable)
 
 	// Get the status we have on file for this URI string.
 	status, err := f.state.DB.GetStatusByURI(ctx, statusURIStr)
@@ -60,6 +60,22 @@ func isSender(with ap.WithAttributedTo, requester *gtsmodel.Account) bool {
 	return false
 }
 
+// isProofSigner returns whether an object with AttributedTo property comes from the
+// actor whose integrity proof on the incoming activity was verified, if any. Such objects
+// can be trusted as-is, even when forwarded to us by an account other than the author.
+func isProofSigner(ctx context.Context, with ap.WithAttributedTo) bool {
+	actor := gtscontext.IntegrityProofActor(ctx)
+	if actor == "" {
+		return false
+	}
+	for _, uri := range ap.GetAttributedTo(with) {
+		if uri.String() == actor {
+			return true
+		}
+	}
+	return false
+}
+
 func sameActor(actor1 vocab.ActivityStreamsActorProperty, actor2 vocab.ActivityStreamsActorProperty) bool {
 	if actor1 == nil || actor2 == nil {
 		return false
@@ -28,6 +28,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/transport"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"codeberg.org/gruf/go-cache/v3"
 )
 
 var _ interface {
@@ -45,6 +46,10 @@ type Federator struct {
 	actor        pub.FederatingActor
 	dereferencing.Dereferencer
 
+	// cache of remote actors'
+	// integrity proof keys.
+	proofKeys cache.TTLCache[string, proofKey]
+
 	// store result of FederatingCallbacks() ahead
 	// of time since it's called in every PostInbox().
 	wrapped  pub.FederatingWrappedCallbacks
@@ -69,6 +74,7 @@ func NewFederator(
 		converter:    converter,
 		transport:    transportController,
 		mediaManager: mediaManager,
+		proofKeys:    newProofKeyCache(),
 
 		Dereferencer: dereferencing.NewDereferencer(
 			state,
@@ -105,6 +111,7 @@ func NewFederator(
 		},
 	}
 	actor := newFederatingActor(f, f, federatingDB, clock)
+	actor.verifyProof = f.verifyIntegrityProof
 	f.actor = actor
 	return f
 }
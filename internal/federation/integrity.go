@@ -0,0 +1,214 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
+	"codeberg.org/gruf/go-cache/v3"
+)
+
+// proofKeyTTL is how long an actor's integrity
+// proof key is cached for before being refetched.
+const proofKeyTTL = time.Hour
+
+// proofKey is a cached integrity proof key.
+type proofKey struct {
+	key     ed25519.PublicKey
+	expires time.Time
+}
+
+// newProofKeyCache returns a new cache of integrity proof
+// keys. Entry expiries are checked on fetch, so this only
+// needs to evict entries when it reaches capacity.
+func newProofKeyCache() cache.TTLCache[string, proofKey] {
+	return cache.NewTTL[string, proofKey](0, 1000, 0)
+}
+
+// verifyIntegrityProof checks the body of the incoming inbox
+// POST request for an FEP-8b32 integrity proof made by the
+// activity's actor. If one is present and valid, the returned
+// context is marked with the actor's URI, so that the activity
+// can be trusted without dereferencing it, even if forwarded.
+//
+// Missing or invalid proofs are not treated as an error,
+// in which case the activity is handled as it would
+// have been without integrity proof verification.
+func (f *Federator) verifyIntegrityProof(ctx context.Context, r *http.Request) context.Context {
+	// Read the request body, and
+	// restore it for later handlers.
+	b, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		log.Errorf(ctx, "error reading request body: %v", err)
+		return ctx
+	}
+
+	// Cheaply skip
+	// unproofed bodies.
+	if !bytes.Contains(b, []byte(`"proof"`)) {
+		return ctx
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		// Leave it to the usual
+		// handling to reject this.
+		return ctx
+	}
+
+	// Get activity actor URI.
+	var actor string
+	switch v := doc["actor"].(type) {
+	case string:
+		actor = v
+	case map[string]any:
+		actor, _ = v["id"].(string)
+	}
+
+	// Only accept proofs made with
+	// a key belonging to the actor.
+	keyID, ok := integrity.Method(doc)
+	if !ok || actor == "" || !strings.HasPrefix(keyID, actor+"#") {
+		return ctx
+	}
+
+	l := log.WithContext(ctx).
+		WithField("keyID", keyID)
+
+	actorIRI, err := url.Parse(actor)
+	if err != nil || actorIRI.Host == "" {
+		return ctx
+	}
+
+	// Don't go fetching
+	// keys from blocked hosts.
+	blocked, err := f.db.IsDomainBlocked(ctx, actorIRI.Host)
+	if err != nil {
+		l.Errorf("db error checking domain block: %v", err)
+		return ctx
+	} else if blocked {
+		return ctx
+	}
+
+	// Check for an unexpired cached key.
+	pk, cached := f.proofKeys.Get(keyID)
+	cached = cached && time.Now().Before(pk.expires)
+
+	if !cached {
+		// Fetch proof key from the actor.
+		pk.key, err = f.fetchProofKey(ctx, actorIRI, keyID)
+		if err != nil {
+			l.Debugf("error fetching integrity proof key: %v", err)
+			return ctx
+		}
+	}
+
+	if err := integrity.Verify(doc, pk.key); err != nil {
+		if !cached {
+			l.Debugf("invalid integrity proof: %v", err)
+			return ctx
+		}
+
+		// Key may have been rotated
+		// since we cached it, refetch.
+		f.proofKeys.Invalidate(keyID)
+		pk.key, err = f.fetchProofKey(ctx, actorIRI, keyID)
+		if err != nil {
+			l.Debugf("error fetching integrity proof key: %v", err)
+			return ctx
+		}
+
+		if err := integrity.Verify(doc, pk.key); err != nil {
+			l.Debugf("invalid integrity proof: %v", err)
+			return ctx
+		}
+
+		cached = false
+	}
+
+	if !cached {
+		// Cache the verified key.
+		f.proofKeys.Set(keyID, proofKey{
+			key:     pk.key,
+			expires: time.Now().Add(proofKeyTTL),
+		})
+	}
+
+	return gtscontext.SetIntegrityProofActor(ctx, actor)
+}
+
+// fetchProofKey dereferences the actor at the given
+// IRI, returning the integrity proof key with keyID
+// from its published assertion methods.
+func (f *Federator) fetchProofKey(
+	ctx context.Context,
+	actorIRI *url.URL,
+	keyID string,
+) (ed25519.PublicKey, error) {
+	// Use the receiving account's
+	// transport (or instance account).
+	var username string
+	if receiver := gtscontext.ReceivingAccount(ctx); receiver != nil {
+		username = receiver.Username
+	}
+
+	trans, err := f.transport.NewTransportForUsername(
+
+		// We're on a hot path: don't retry if req fails.
+		gtscontext.SetFastFail(ctx),
+		username,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error creating transport: %w", err)
+	}
+
+	rsp, err := trans.Dereference(ctx, actorIRI)
+	if err != nil {
+		return nil, gtserror.Newf("error dereferencing actor: %w", err)
+	}
+
+	var doc map[string]any
+	err = json.NewDecoder(rsp.Body).Decode(&doc)
+	_ = rsp.Body.Close() // done
+	if err != nil {
+		return nil, gtserror.Newf("error decoding actor: %w", err)
+	}
+
+	// Ensure we actually got the actor we asked for,
+	// (and not eg. one it redirected to elsewhere).
+	if doc["id"] != actorIRI.String() {
+		return nil, errors.New("actor id does not match")
+	}
+
+	return integrity.ActorKey(doc, keyID)
+}
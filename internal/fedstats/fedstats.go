@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package fedstats provides cheap, in-memory
+// per-domain federation traffic counters, which
+// are periodically flushed to the database.
+//
+// Counters are keyed by the host remote servers
+// are actually reached at, rather than the account
+// domain, so that traffic to and from split-domain
+// peers (accounts @example.org, server at
+// fedi.example.org) is counted under a single key.
+package fedstats
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Counters accumulates per-domain federation
+// traffic deltas in memory until Flush is called.
+// The zero value is ready to use, and all methods
+// are safe for concurrent use.
+type Counters struct {
+	deltas map[string]*gtsmodel.FederationStat
+	mu     sync.Mutex
+}
+
+// AccountHost returns the host that traffic
+// for the given remote account is counted under,
+// ie., the host of its URI, falling back to its
+// domain if the URI can't be parsed.
+func AccountHost(account *gtsmodel.Account) string {
+	if u, err := url.Parse(account.URI); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return account.Domain
+}
+
+// Received increments the count of activities
+// received from domain, and marks it as seen now.
+func (c *Counters) Received(domain string) {
+	if domain == "" {
+		return
+	}
+	now := time.Now()
+	c.mu.Lock()
+	delta := c.get(domain)
+	delta.ActivitiesReceived++
+	delta.LastSeenAt = now
+	c.mu.Unlock()
+}
+
+// Sent increments the count of
+// activities queued for delivery to domain.
+func (c *Counters) Sent(domain string) {
+	if domain == "" {
+		return
+	}
+	c.mu.Lock()
+	c.get(domain).ActivitiesSent++
+	c.mu.Unlock()
+}
+
+// MediaCached increments the amount of bytes of
+// remote media from domain stored in our cache.
+func (c *Counters) MediaCached(domain string, bytes int64) {
+	if domain == "" || bytes <= 0 {
+		return
+	}
+	c.mu.Lock()
+	c.get(domain).MediaBytes += bytes
+	c.mu.Unlock()
+}
+
+// Flush writes all accumulated deltas to the database,
+// adding them to the stored totals, and resets the
+// in-memory counters. On error the deltas are merged
+// back in so they can be retried on the next flush.
+func (c *Counters) Flush(ctx context.Context, db db.FederationStat) error {
+	c.mu.Lock()
+	deltas := c.deltas
+	c.deltas = nil
+	c.mu.Unlock()
+
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	stats := make([]*gtsmodel.FederationStat, 0, len(deltas))
+	for _, delta := range deltas {
+		stats = append(stats, delta)
+	}
+
+	if err := db.IncrementFederationStats(ctx, stats); err != nil {
+		c.restore(deltas)
+		return gtserror.Newf("error flushing federation stats: %w", err)
+	}
+
+	return nil
+}
+
+// restore merges unflushed deltas
+// back into the in-memory counters.
+func (c *Counters) restore(deltas map[string]*gtsmodel.FederationStat) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for domain, old := range deltas {
+		delta := c.get(domain)
+		delta.ActivitiesReceived += old.ActivitiesReceived
+		delta.ActivitiesSent += old.ActivitiesSent
+		delta.MediaBytes += old.MediaBytes
+		if old.LastSeenAt.After(delta.LastSeenAt) {
+			delta.LastSeenAt = old.LastSeenAt
+		}
+	}
+}
+
+// get returns the delta for domain, creating
+// it if necessary. Caller must hold the lock.
+func (c *Counters) get(domain string) *gtsmodel.FederationStat {
+	delta, ok := c.deltas[domain]
+	if !ok {
+		if c.deltas == nil {
+			c.deltas = make(map[string]*gtsmodel.FederationStat)
+		}
+		delta = &gtsmodel.FederationStat{Domain: domain}
+		c.deltas[domain] = delta
+	}
+	return delta
+}
@@ -91,6 +91,17 @@ func (f *Filter) isStatusPublicTimelineable(ctx context.Context, requester *gtsm
 		return false, nil
 	}
 
+	// Check whether status author is silenced to requester.
+	silenced, err := f.isAuthorSilenced(ctx, requester, status)
+	if err != nil {
+		return false, err
+	}
+
+	if silenced {
+		log.Trace(ctx, "status author silenced to timeline requester")
+		return false, nil
+	}
+
 	for parent := status; parent.InReplyToURI != ""; {
 		// Fetch next parent to lookup.
 		parentID := parent.InReplyToID
@@ -121,3 +132,34 @@ func (f *Filter) isStatusPublicTimelineable(ctx context.Context, requester *gtsm
 	// level status. Show on public timeline.
 	return true, nil
 }
+
+// isAuthorSilenced returns whether the author of given status has been
+// silenced by an admin, and the requester neither is nor follows them.
+func (f *Filter) isAuthorSilenced(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	if !status.Account.IsSilenced() {
+		return false, nil
+	}
+
+	if requester == nil {
+		// Silenced to all
+		// unauthed requests.
+		return true, nil
+	}
+
+	if requester.ID == status.AccountID {
+		// Own statuses are
+		// never silenced.
+		return false, nil
+	}
+
+	// Followers still see a silenced account's statuses.
+	follows, err := f.state.DB.IsFollowing(ctx,
+		requester.ID,
+		status.AccountID,
+	)
+	if err != nil {
+		return false, gtserror.Newf("error checking follow %s->%s: %w", requester.ID, status.AccountID, err)
+	}
+
+	return !follows, nil
+}
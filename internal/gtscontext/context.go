@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/url"
 
@@ -45,6 +46,9 @@
 	dryRunKey
 	httpClientSignFnKey
 	workerKey
+	idempotencyKeyKey
+	requestClientKey
+	integrityProofActorKey
 )
 
 // IsWorker returns whether the "worker" context key has been set. This can
@@ -120,6 +124,91 @@ func (ctx requestIDContext) Value(key any) any {
 	return ctx.Context.Value(key)
 }
 
+// IdempotencyKey returns the idempotency key associated with context. This value
+// will usually be set by the idempotency key middleware handler, from the client
+// supplied Idempotency-Key header. It allows functions that create something to
+// recognize a retried request, and return the result of the original request.
+func IdempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyKey).(string)
+	return key
+}
+
+// SetIdempotencyKey stores the given idempotency key and returns the wrapped
+// context. See IdempotencyKey() for further information on the idempotency key.
+func SetIdempotencyKey(ctx context.Context, key string) context.Context {
+	return idempotencyKeyContext{Context: ctx, key: key}
+}
+
+type idempotencyKeyContext struct {
+	context.Context
+	key string
+}
+
+func (ctx idempotencyKeyContext) Value(key any) any {
+	if key == idempotencyKeyKey {
+		return ctx.key
+	}
+	return ctx.Context.Value(key)
+}
+
+// IntegrityProofActor returns the URI of the actor whose FEP-8b32 integrity proof
+// on the incoming activity associated with context was successfully verified, if any.
+// This allows the activity to be trusted as authored by that actor, even when it was
+// forwarded to us by another instance, without having to dereference it again.
+func IntegrityProofActor(ctx context.Context) string {
+	actor, _ := ctx.Value(integrityProofActorKey).(string)
+	return actor
+}
+
+// SetIntegrityProofActor stores the given actor URI and returns the wrapped context.
+// See IntegrityProofActor() for further information on the integrity proof actor.
+func SetIntegrityProofActor(ctx context.Context, actor string) context.Context {
+	return integrityProofActorContext{Context: ctx, actor: actor}
+}
+
+type integrityProofActorContext struct {
+	context.Context
+	actor string
+}
+
+func (ctx integrityProofActorContext) Value(key any) any {
+	if key == integrityProofActorKey {
+		return ctx.actor
+	}
+	return ctx.Context.Value(key)
+}
+
+// RequestClient returns the client IP address and User-Agent of the incoming
+// request associated with context, if set. These are set by the token check
+// middleware, so that token usage can be recorded alongside where it came from.
+func RequestClient(ctx context.Context) (ip net.IP, userAgent string) {
+	client, _ := ctx.Value(requestClientKey).(requestClient)
+	return client.ip, client.userAgent
+}
+
+// SetRequestClient stores the given client IP address and User-Agent and returns
+// the wrapped context. See RequestClient() for further information on these values.
+func SetRequestClient(ctx context.Context, ip net.IP, userAgent string) context.Context {
+	return requestClientContext{Context: ctx, client: requestClient{ip, userAgent}}
+}
+
+type requestClient struct {
+	ip        net.IP
+	userAgent string
+}
+
+type requestClientContext struct {
+	context.Context
+	client requestClient
+}
+
+func (ctx requestClientContext) Value(key any) any {
+	if key == requestClientKey {
+		return ctx.client
+	}
+	return ctx.Context.Value(key)
+}
+
 // OutgoingPublicKeyID returns the public key ID (URI) associated with context. This
 // value is useful for logging situations in which a given public key URI is
 // relevant, e.g. for outgoing requests being signed by the given key.
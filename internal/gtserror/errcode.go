@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtserror
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Machine-readable error codes served to clients
+// in API error responses, for distinguishing errors
+// more specifically than by http status code alone.
+//
+// These are part of the client API, so once added
+// they should not be changed or removed.
+const (
+	ErrCodeAccountNotFound          = "account_not_found"
+	ErrCodeStatusNotFound           = "status_not_found"
+	ErrCodeInteractionRejected      = "interaction_rejected"
+	ErrCodeMediaDescriptionRequired = "media_description_required"
+	ErrCodeIdempotencyKeyInvalid    = "idempotency_key_invalid"
+	ErrCodeAccountMoved             = "account_moved"
+	ErrCodeAuthRequired             = "auth_required"
+	ErrCodeScopeInsufficient        = "scope_insufficient"
+	ErrCodeRateLimited              = "rate_limited"
+	ErrCodeCapacityExceeded         = "capacity_exceeded"
+	ErrCodeParamRequired            = "param_required"
+	ErrCodeParamInvalid             = "param_invalid"
+	ErrCodeValidationFailed         = "validation_failed"
+)
+
+// Machine-readable reason codes served to remote
+// instances in federation (ActivityPub) error
+// responses, for diagnosing interop problems.
+//
+// These are part of the federation API, so once
+// added they should not be changed or removed.
+// Where the same condition applies to a client
+// request, eg., domain_blocked, they may also be
+// served to clients in API error responses.
+const (
+	ErrCodeSignatureMissing       = "signature_missing"
+	ErrCodeSignatureInvalid       = "signature_invalid"
+	ErrCodeKeyUnavailable         = "key_unavailable"
+	ErrCodeDomainBlocked          = "domain_blocked"
+	ErrCodeFederationPaused       = "federation_paused"
+	ErrCodeAccountSuspended       = "account_suspended"
+	ErrCodeActorBlocked           = "actor_blocked"
+	ErrCodeContentTypeInvalid     = "content_type_invalid"
+	ErrCodeActivityMalformed      = "activity_malformed"
+	ErrCodeActivityOriginMismatch = "activity_origin_mismatch"
+)
+
+// WithErrCode returns a copy of the given gtserror.WithCode,
+// returning the given machine-readable errCode from ErrCode().
+func WithErrCode(errWithCode WithCode, errCode string) WithCode {
+	return &withCode{
+		err:     errWithCode.Unwrap(),
+		safe:    errWithCode.Safe(),
+		code:    errWithCode.Code(),
+		errCode: errCode,
+	}
+}
+
+// statusErrCode derives a generic machine-readable error
+// code from the given http status code, for errors with
+// no more specific code set, eg., "Bad Request" becomes
+// "bad_request", and "I'm a teapot" becomes "im_a_teapot".
+func statusErrCode(code int) string {
+	text := http.StatusText(code)
+	if code == StatusClientClosedRequest {
+		text = StatusTextClientClosedRequest
+	}
+
+	if text == "" {
+		// Unknown
+		// status code.
+		return "unknown"
+	}
+
+	text = strings.ToLower(text)
+	text = strings.ReplaceAll(text, "'", "")
+	text = strings.ReplaceAll(text, "-", "_")
+	text = strings.ReplaceAll(text, " ", "_")
+	return text
+}
@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtserror_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+func TestWithErrCode(t *testing.T) {
+	err := errors.New("status 01J... not found in db")
+	errWithCode := gtserror.NewErrorNotFound(err, "target status not found")
+	if errCode := errWithCode.ErrCode(); errCode != "not_found" {
+		t.Errorf("unexpected default error code: %q", errCode)
+	}
+
+	errWithCode = gtserror.WithErrCode(errWithCode, gtserror.ErrCodeStatusNotFound)
+	if errCode := errWithCode.ErrCode(); errCode != gtserror.ErrCodeStatusNotFound {
+		t.Errorf("unexpected error code: %q", errCode)
+	}
+
+	// Everything else should be left as-is.
+	if errWithCode.Code() != http.StatusNotFound {
+		t.Errorf("unexpected status code: %d", errWithCode.Code())
+	}
+	if errWithCode.Safe() != "Not Found: target status not found" {
+		t.Errorf("unexpected safe message: %q", errWithCode.Safe())
+	}
+	if !errors.Is(errWithCode, err) {
+		t.Error("expected wrapped error to be preserved")
+	}
+}
+
+func TestDefaultErrCode(t *testing.T) {
+	for _, test := range []struct {
+		errWithCode gtserror.WithCode
+		expect      string
+	}{
+		{gtserror.NewErrorBadRequest(errors.New("oops")), "bad_request"},
+		{gtserror.NewErrorUnprocessableEntity(errors.New("oops")), "unprocessable_entity"},
+		{gtserror.NewErrorClientClosedRequest(errors.New("oops")), "client_closed_request"},
+		{gtserror.NewWithCode(http.StatusTeapot, "oops"), "im_a_teapot"},
+		{gtserror.NewWithCode(http.StatusNonAuthoritativeInfo, "oops"), "non_authoritative_information"},
+		{gtserror.NewWithCode(599, "oops"), "unknown"},
+	} {
+		if errCode := test.errWithCode.ErrCode(); errCode != test.expect {
+			t.Errorf("unexpected error code for %d: expected %q, got %q",
+				test.errWithCode.Code(), test.expect, errCode)
+		}
+	}
+}
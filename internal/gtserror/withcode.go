@@ -50,12 +50,20 @@ type WithCode interface {
 
 	// Code returns the status code for serving to a client.
 	Code() int
+
+	// ErrCode returns a stable, machine-readable error code
+	// for serving to a client alongside the Safe() message,
+	// eg., "status_not_found", as set by WithErrCode(). If
+	// not set, a generic code is derived from the status
+	// code instead, eg., "not_found" for a 404.
+	ErrCode() string
 }
 
 type withCode struct {
-	err  error
-	safe string
-	code int
+	err     error
+	safe    string
+	code    int
+	errCode string
 }
 
 func (e *withCode) Unwrap() error {
@@ -74,6 +82,13 @@ func (e *withCode) Code() int {
 	return e.code
 }
 
+func (e *withCode) ErrCode() string {
+	if e.errCode == "" {
+		return statusErrCode(e.code)
+	}
+	return e.errCode
+}
+
 // NewWithCode returns a new gtserror.WithCode that implements the error interface
 // with given HTTP status code, providing status message of "${httpStatus}: ${msg}".
 func NewWithCode(code int, msg string) WithCode {
@@ -123,6 +123,19 @@ type Account struct {
 	// Only set for local accounts.
 	NoteRaw string `bun:",nullzero"`
 
+	// Pronouns that this account has set
+	// on their profile, eg., "they/them".
+	//
+	// Corresponds to the schema.org `pronouns` property.
+	Pronouns string `bun:",nullzero"`
+
+	// Location that this account has set
+	// on their profile, eg., "Berlin".
+	//
+	// Corresponds to the name of an ActivityStreams
+	// `Place` in the actor's `location` property.
+	Location string `bun:",nullzero"`
+
 	// ActivityPub URI/IDs by which this account is also known.
 	//
 	// Corresponds to the ActivityStreams `alsoKnownAs` property.
@@ -229,6 +242,16 @@ type Account struct {
 	// Application, Group, Organization, Person, or Service.
 	ActorType AccountActorType `bun:",nullzero,notnull"`
 
+	// URI/ID of the actor that operates this account,
+	// only relevant if ActorType is Service (ie., a bot).
+	//
+	// Corresponds to the ActivityStreams `attributedTo`
+	// property of the actor.
+	OperatorURI string `bun:",nullzero"`
+
+	// Account matching OperatorURI.
+	Operator *Account `bun:"-"`
+
 	// Private key for signing http requests.
 	//
 	// Only defined for local accounts
@@ -397,6 +420,14 @@ func (a *Account) IsSuspended() bool {
 	return !a.SuspendedAt.IsZero()
 }
 
+// IsSilenced returns true if account
+// has been silenced (limited) on this
+// instance, ie., its statuses are kept
+// off public timelines for non-followers.
+func (a *Account) IsSilenced() bool {
+	return !a.SilencedAt.IsZero()
+}
+
 // DeletedSelf returns true
 // if account deleted itself.
 func (a *Account) DeletedSelf() bool {
@@ -491,6 +522,10 @@ func (t AccountActorType) IsBot() bool {
 	return t == AccountActorTypeApplication || t == AccountActorTypeService
 }
 
+func (t AccountActorType) IsGroup() bool {
+	return t == AccountActorTypeGroup
+}
+
 // Relationship describes a requester's relationship with another account.
 type Relationship struct {
 	ID                  string // The account id.
@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AccountCustomDomain models a vanity domain
+// requested by a local account. Once verified
+// (the account has proven control of the domain)
+// and approved by an admin, requests to the
+// domain redirect to the account's profile, and
+// the account can be looked up via webfinger at it.
+type AccountCustomDomain struct {
+	ID                  string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt           time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt           time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID           string    `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // id of the local account this domain belongs to
+	Account             *Account  `bun:"-"`                                                           // local account this domain belongs to
+	Domain              string    `bun:",nullzero,notnull,unique"`                                    // the vanity domain, lowercase and punycoded
+	VerifiedAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // when was control of the domain verified, if at all
+	ApprovedAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // when was the domain approved by an admin, if at all
+	ApprovedByAccountID string    `bun:"type:CHAR(26),nullzero"`                                      // id of the admin account that approved the domain
+	ApprovedByAccount   *Account  `bun:"-"`                                                           // admin account that approved the domain
+}
+
+// IsVerified returns whether control of
+// the domain has been verified.
+func (d *AccountCustomDomain) IsVerified() bool {
+	return !d.VerifiedAt.IsZero()
+}
+
+// IsApproved returns whether the
+// domain was approved by an admin.
+func (d *AccountCustomDomain) IsApproved() bool {
+	return !d.ApprovedAt.IsZero()
+}
+
+// IsActive returns whether the domain
+// is both verified and approved, ie.,
+// whether it should be served.
+func (d *AccountCustomDomain) IsActive() bool {
+	return d.IsVerified() && d.IsApproved()
+}
+
+// VerificationTXTName returns the name of the DNS
+// TXT record that verifies control of the domain.
+func (d *AccountCustomDomain) VerificationTXTName() string {
+	return "_gotosocial." + d.Domain
+}
+
+// VerificationTXTValue returns the value of the DNS
+// TXT record that verifies control of the domain.
+//
+// Account must be populated.
+func (d *AccountCustomDomain) VerificationTXTValue() string {
+	return "gotosocial-account=" + d.Account.URI
+}
@@ -18,6 +18,7 @@
 package gtsmodel
 
 import (
+	"slices"
 	"strings"
 	"time"
 )
@@ -29,19 +30,76 @@ type AccountSettings struct {
 	UpdatedAt                      time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item was last updated.
 	Privacy                        Visibility         `bun:",nullzero,default:3"`                                         // Default post privacy for this account
 	Sensitive                      *bool              `bun:",nullzero,notnull,default:false"`                             // Set posts from this account to sensitive by default?
+	PreviewLinks                   *bool              `bun:",nullzero,notnull,default:true"`                              // Generate link preview cards for links in posts from this account by default?
 	Language                       string             `bun:",nullzero,notnull,default:'en'"`                              // What language does this account post in?
 	StatusContentType              string             `bun:",nullzero"`                                                   // What is the default format for statuses posted by this account (only for local accounts).
 	Theme                          string             `bun:",nullzero"`                                                   // Preset CSS theme filename selected by this Account (empty string if nothing set).
 	CustomCSS                      string             `bun:",nullzero"`                                                   // Custom CSS that should be displayed for this Account's profile and statuses.
 	EnableRSS                      *bool              `bun:",nullzero,notnull,default:false"`                             // enable RSS feed subscription for this account's public posts at [URL]/feed
+	RSSFullContent                 *bool              `bun:",nullzero,notnull,default:false"`                             // Embed all media attachments in the content of this account's RSS feed items.
 	HideCollections                *bool              `bun:",nullzero,notnull,default:false"`                             // Hide this account's followers/following collections.
 	WebLayout                      WebLayout          `bun:",nullzero,notnull,default:1"`                                 // Layout to use when showing this profile via the web.
 	WebIncludeBoosts               *bool              `bun:",nullzero,notnull,default:false"`                             // Include boosts when showing this profile via the web.
+	WebActivityHeatmap             *bool              `bun:",nullzero,notnull,default:false"`                             // Show a heatmap of public posting activity when showing this profile via the web, and allow it to be fetched via the API.
 	InteractionPolicyDirect        *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new direct visibility statuses by this account. If null, assume default policy.
 	InteractionPolicyMutualsOnly   *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new mutuals only visibility statuses. If null, assume default policy.
 	InteractionPolicyFollowersOnly *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new followers only visibility statuses. If null, assume default policy.
 	InteractionPolicyUnlocked      *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new unlocked visibility statuses. If null, assume default policy.
 	InteractionPolicyPublic        *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new public visibility statuses. If null, assume default policy.
+	ChosenLanguages                []string           `bun:",array"`                                                      // Primary language subtags of statuses to show on timelines. If empty, show all languages.
+	StatusExpiresIn                int                `bun:",nullzero"`                                                   // Default lifetime in seconds of new statuses by this account, after which they're automatically deleted. 0 = no expiry.
+	AvatarHistoryIDs               []string           `bun:"avatar_history,array"`                                        // IDs of previous avatar media attachments of this account, newest first, kept so they can be restored.
+	HeaderHistoryIDs               []string           `bun:"header_history,array"`                                        // IDs of previous header media attachments of this account, newest first, kept so they can be restored.
+	MediaQuota                     *int64             `bun:""`                                                            // Admin override of max total bytes of media stored for this account. If null, use instance default. 0 = no quota.
+	MediaDescriptionPolicy         DescriptionPolicy  `bun:",nullzero"`                                                   // Whether this account wants reminders for, or to require, media descriptions on its own statuses. If unset, use instance policy.
+	PublishBlocks                  *bool              `bun:",nullzero,notnull,default:false"`                             // Publish accounts blocked by this account as an ActivityPub collection, for others to import.
+}
+
+// DescriptionPolicyOr returns the stricter of this
+// account's own media description policy and the given
+// instance policy. This is safe to call on a nil *AccountSettings.
+func (s *AccountSettings) DescriptionPolicyOr(instance DescriptionPolicy) DescriptionPolicy {
+	if s == nil || s.MediaDescriptionPolicy < instance {
+		return instance
+	}
+	return s.MediaDescriptionPolicy
+}
+
+// LanguageChosen returns whether statuses in the given language
+// should be shown on timelines, according to ChosenLanguages.
+// Languages are compared by primary subtag only, such that eg.,
+// "en-GB" is shown when "en" is chosen. Statuses of an unknown
+// language are always shown, as are all statuses when no languages
+// are chosen. This is safe to call on a nil *AccountSettings.
+func (s *AccountSettings) LanguageChosen(language string) bool {
+	if s == nil || len(s.ChosenLanguages) == 0 || language == "" {
+		return true
+	}
+	primary, _, _ := strings.Cut(language, "-")
+	return slices.Contains(s.ChosenLanguages, primary)
+}
+
+// PushProfileMediaHistory returns the given avatar or header
+// history with id prepended as the newest entry, removing any
+// earlier occurrence of id, and trimmed to at most max entries.
+// An empty id leaves history unchanged apart from trimming.
+func PushProfileMediaHistory(history []string, id string, max int) []string {
+	if max <= 0 {
+		return nil
+	}
+	pushed := make([]string, 0, max)
+	if id != "" {
+		pushed = append(pushed, id)
+	}
+	for _, h := range history {
+		if len(pushed) == max {
+			break
+		}
+		if h != id {
+			pushed = append(pushed, h)
+		}
+	}
+	return pushed
 }
 
 // WebLayout represents an account owner's
@@ -84,3 +142,58 @@ func ParseWebLayout(in string) WebLayout {
 		return WebLayoutUnknown
 	}
 }
+
+// DescriptionPolicy represents a policy for
+// descriptions (alt text) on media attachments
+// of new statuses. Policies are ordered from
+// least to most strict, so they can be compared.
+type DescriptionPolicy enumType
+
+const (
+	DescriptionPolicyUnknown DescriptionPolicy = 0
+
+	// Media descriptions are optional.
+	DescriptionPolicyOff DescriptionPolicy = 1
+
+	// Media descriptions are optional, but
+	// clients should remind posters to add them.
+	DescriptionPolicyRemind DescriptionPolicy = 2
+
+	// Media descriptions are required, and
+	// statuses with undescribed media are rejected.
+	DescriptionPolicyRequire DescriptionPolicy = 3
+)
+
+// String returns a stringified, frontend
+// API compatible form of DescriptionPolicy.
+func (p DescriptionPolicy) String() string {
+	switch p {
+	case DescriptionPolicyUnknown:
+		// Not set, falls
+		// back to instance.
+		return ""
+	case DescriptionPolicyOff:
+		return "off"
+	case DescriptionPolicyRemind:
+		return "remind"
+	case DescriptionPolicyRequire:
+		return "require"
+	default:
+		panic("invalid description policy")
+	}
+}
+
+// ParseDescriptionPolicy returns a
+// description policy from the given value.
+func ParseDescriptionPolicy(in string) DescriptionPolicy {
+	switch strings.ToLower(in) {
+	case "off":
+		return DescriptionPolicyOff
+	case "remind":
+		return DescriptionPolicyRemind
+	case "require":
+		return DescriptionPolicyRequire
+	default:
+		return DescriptionPolicyUnknown
+	}
+}
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"strings"
+	"time"
+)
+
+// AccountWarningAction describes an additional moderation
+// action that was taken alongside an account warning.
+type AccountWarningAction uint8
+
+// Only ever add new warning actions to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	AccountWarningActionUnknown AccountWarningAction = iota
+	AccountWarningActionNone
+	AccountWarningActionDeleteStatuses
+	AccountWarningActionMarkStatusesSensitive
+	AccountWarningActionSuspend
+	AccountWarningActionSilence
+)
+
+// String returns a stringified, frontend API compatible form of AccountWarningAction.
+func (a AccountWarningAction) String() string {
+	switch a {
+	case AccountWarningActionNone:
+		return "none"
+	case AccountWarningActionDeleteStatuses:
+		return "delete_statuses"
+	case AccountWarningActionMarkStatusesSensitive:
+		return "mark_statuses_as_sensitive"
+	case AccountWarningActionSuspend:
+		return "suspend"
+	case AccountWarningActionSilence:
+		return "silence"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAccountWarningAction returns an account warning action from the given value.
+func ParseAccountWarningAction(in string) AccountWarningAction {
+	switch strings.ToLower(in) {
+	case "", "none":
+		return AccountWarningActionNone
+	case "delete_statuses":
+		return AccountWarningActionDeleteStatuses
+	case "mark_statuses_as_sensitive":
+		return AccountWarningActionMarkStatusesSensitive
+	case "suspend":
+		return AccountWarningActionSuspend
+	case "silence":
+		return AccountWarningActionSilence
+	default:
+		return AccountWarningActionUnknown
+	}
+}
+
+// IsReversible returns whether approving an appeal
+// against a warning with this action can undo it.
+// Deleted statuses cannot be brought back.
+func (a AccountWarningAction) IsReversible() bool {
+	return a != AccountWarningActionDeleteStatuses
+}
+
+// AccountWarning models a warning (aka strike) issued by
+// an instance moderator to a local account, optionally
+// alongside the removal or sensitivity marking of statuses,
+// or recorded when a local account is suspended or silenced.
+type AccountWarning struct {
+	ID            string               `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt     time.Time            `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time            `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID     string               `bun:"type:CHAR(26),nullzero,notnull"`                              // which (local) account received this warning
+	Account       *Account             `bun:"-"`                                                           // account corresponding to AccountID
+	ByAccountID   string               `bun:"type:CHAR(26),nullzero,notnull"`                              // which moderator account issued this warning
+	ByAccount     *Account             `bun:"-"`                                                           // account corresponding to ByAccountID
+	AdminActionID string               `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the admin action under which this warning was issued
+	Action        AccountWarningAction `bun:",nullzero,notnull"`                                           // additional action taken on statuses, if any
+	Text          string               `bun:",nullzero"`                                                   // text explaining this warning, as shown to the warned account
+	StatusIDs     []string             `bun:"statuses,array"`                                              // database IDs of any statuses cited by this warning
+	RuleIDs       []string             `bun:"rules,array"`                                                 // database IDs of any rules cited by this warning
+	Rules         []*Rule              `bun:"-"`                                                           // rules corresponding to RuleIDs
+	ReportID      string               `bun:"type:CHAR(26),nullzero"`                                      // database ID of any report that led to this warning
+}
@@ -75,6 +75,8 @@ func ParseAdminActionCategory(in string) AdminActionCategory {
 	AdminActionUnsuspend
 	AdminActionExpireKeys
 	AdminActionUnallow
+	AdminActionWarn
+	AdminActionUnwarn
 )
 
 func (t AdminActionType) String() string {
@@ -95,6 +97,10 @@ func (t AdminActionType) String() string {
 		return "expire-keys"
 	case AdminActionUnallow:
 		return "unallow"
+	case AdminActionWarn:
+		return "warn"
+	case AdminActionUnwarn:
+		return "unwarn"
 	default:
 		return "unknown"
 	}
@@ -118,6 +124,10 @@ func ParseAdminActionType(in string) AdminActionType {
 		return AdminActionExpireKeys
 	case "unallow":
 		return AdminActionUnallow
+	case "warn":
+		return AdminActionWarn
+	case "unwarn":
+		return AdminActionUnwarn
 	default:
 		return AdminActionUnknown
 	}
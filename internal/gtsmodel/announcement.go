@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Announcement models an instance-wide announcement
+// written by an admin, to be shown to all local users.
+type Announcement struct {
+	ID          string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	PublishedAt time.Time `bun:"type:timestamptz,nullzero"`                                   // when was announcement (most recently) published, if ever
+	StartsAt    time.Time `bun:"type:timestamptz,nullzero"`                                   // optional start of the period the announcement refers to, eg., a maintenance window
+	EndsAt      time.Time `bun:"type:timestamptz,nullzero"`                                   // optional end of the period the announcement refers to; announcement is no longer shown after this
+	AllDay      *bool     `bun:",nullzero,notnull,default:false"`                             // only the dates of starts at / ends at are relevant, not the times
+	Published   *bool     `bun:",nullzero,notnull,default:false"`                             // is announcement published, ie., visible to users
+	Text        string    `bun:",nullzero,notnull"`                                           // markdown text of the announcement, as written by the admin
+	Content     string    `bun:",nullzero,notnull"`                                           // html content of the announcement, parsed from text
+	TagIDs      []string  `bun:"tags,array"`                                                  // Database IDs of any tags used in this announcement
+	Tags        []*Tag    `bun:"-"`                                                           // Tags used in this announcement
+	EmojiIDs    []string  `bun:"emojis,array"`                                                // Database IDs of any emojis used in this announcement
+	Emojis      []*Emoji  `bun:"-"`                                                           // Emojis used in this announcement
+}
+
+// IsPublished returns whether announcement is published.
+func (a *Announcement) IsPublished() bool {
+	return a.Published != nil && *a.Published
+}
+
+// IsActive returns whether the announcement is
+// published and, if it has an end time, not yet
+// ended at the given time. Only active announcements
+// are shown to users.
+func (a *Announcement) IsActive(now time.Time) bool {
+	return a.IsPublished() &&
+		(a.EndsAt.IsZero() || a.EndsAt.After(now))
+}
+
+// AnnouncementRead marks an announcement
+// as read (dismissed) by a local account.
+type AnnouncementRead struct {
+	ID             string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                 // id of this item in the database
+	CreatedAt      time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                              // when was item created
+	AnnouncementID string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reads_announcement_id_account_id_uniq"` // id of the announcement read
+	AccountID      string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reads_announcement_id_account_id_uniq"` // id of the account that read the announcement
+}
+
+// AnnouncementReaction models an emoji reaction
+// by a local account to an announcement.
+type AnnouncementReaction struct {
+	ID             string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                          // id of this item in the database
+	CreatedAt      time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                       // when was item created
+	AnnouncementID string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reactions_announcement_id_account_id_name_uniq"` // id of the announcement reacted to
+	AccountID      string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reactions_announcement_id_account_id_name_uniq"` // id of the reacting account
+	Name           string    `bun:",nullzero,notnull,unique:announcement_reactions_announcement_id_account_id_name_uniq"`              // unicode emoji, or custom emoji shortcode
+	EmojiID        string    `bun:"type:CHAR(26),nullzero"`                                                                            // id of the custom emoji, if a custom emoji reaction
+	Emoji          *Emoji    `bun:"-"`                                                                                                 // custom emoji, if a custom emoji reaction
+}
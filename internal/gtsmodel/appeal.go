@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Appeal models an appeal by a local account
+// against a moderation decision made about it.
+type Appeal struct {
+	ID                     string          `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt              time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt              time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID              string          `bun:"type:CHAR(26),nullzero,notnull"`                              // which (local) account submitted this appeal
+	Account                *Account        `bun:"-"`                                                           // account corresponding to AccountID
+	AccountWarningID       string          `bun:"type:CHAR(26),nullzero,unique"`                               // id of the account warning being appealed, if any
+	AccountWarning         *AccountWarning `bun:"-"`                                                           // account warning corresponding to AccountWarningID
+	Text                   string          `bun:",nullzero,notnull"`                                           // text explaining the reasons for this appeal
+	ActionTakenAt          time.Time       `bun:"type:timestamptz,nullzero"`                                   // time at which this appeal was approved or rejected, if at all
+	ActionTakenByAccountID string          `bun:"type:CHAR(26),nullzero"`                                      // database ID of moderator account which approved or rejected this appeal, if any
+	ActionTakenByAccount   *Account        `bun:"-"`                                                           // account corresponding to ActionTakenByAccountID, if any
+	Approved               *bool           `bun:",nullzero,notnull,default:false"`                             // whether this appeal was approved (only meaningful once action taken)
+	ResponseText           string          `bun:",nullzero"`                                                   // text response from the moderator who approved or rejected this appeal
+}
+
+// IsPending returns whether this appeal
+// is still awaiting a moderator decision.
+func (a *Appeal) IsPending() bool {
+	return a.ActionTakenAt.IsZero()
+}
+
+// State returns a stringified, frontend API
+// compatible form of the state of this appeal.
+func (a *Appeal) State() string {
+	switch {
+	case a.IsPending():
+		return "pending"
+	case *a.Approved:
+		return "approved"
+	default:
+		return "rejected"
+	}
+}
@@ -0,0 +1,37 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// BlocklistSubscription represents a local account's subscription
+// to a blocklist published by another account as an ActivityPub
+// collection. The blocklist is refetched periodically, blocking
+// accounts newly added to it, and unblocking accounts removed
+// from it, if they were blocked because of this subscription.
+type BlocklistSubscription struct {
+	ID         string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                       // id of this item in the database
+	CreatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                    // when was item created
+	UpdatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                    // when was item last updated
+	AccountID  string    `bun:"type:CHAR(26),nullzero,notnull,unique:blocklist_subscriptions_account_uri_uniq"` // ID of the local account that subscribed
+	Account    *Account  `bun:"-"`                                                                              // Account corresponding to AccountID
+	URI        string    `bun:",nullzero,notnull,unique:blocklist_subscriptions_account_uri_uniq"`              // URI of the published blocklist collection
+	TargetURIs []string  `bun:"target_uris,array"`                                                              // URIs of the listed accounts that were blocked because of this subscription
+	FetchedAt  time.Time `bun:"type:timestamptz,nullzero"`                                                      // when the blocklist was last fetched (successfully or not)
+	Error      string    `bun:",nullzero"`                                                                      // error from the last fetch of the blocklist, if it failed
+}
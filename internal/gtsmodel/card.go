@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Card represents a link preview card for a URL,
+// generated from the metadata of the page found at
+// that URL, and shared by all statuses linking it.
+type Card struct {
+	ID                string           `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt         time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt         time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated (ie., page last fetched)
+	URL               string           `bun:",unique,nullzero,notnull"`                                    // URL of the linked page, as given in the status
+	Type              CardType         `bun:",nullzero,notnull,default:1"`                                 // type of card, as determined by the oEmbed type of the linked page
+	Title             string           `bun:",nullzero"`                                                   // title of the linked page
+	Description       string           `bun:",nullzero"`                                                   // description of the linked page
+	AuthorName        string           `bun:",nullzero"`                                                   // author of the linked page
+	AuthorURL         string           `bun:",nullzero"`                                                   // link to the author of the linked page
+	ProviderName      string           `bun:",nullzero"`                                                   // name of the site hosting the linked page
+	ProviderURL       string           `bun:",nullzero"`                                                   // link to the site hosting the linked page
+	Image             string           `bun:",nullzero"`                                                   // URL of the (remote) preview image for the linked page; never served to clients
+	ImageAttachmentID string           `bun:"type:CHAR(26),nullzero"`                                      // id of the locally stored copy of the preview image, owned by the instance account
+	ImageAttachment   *MediaAttachment `bun:"-"`                                                           // locally stored copy of the preview image, if loaded
+	Width             int              `bun:",nullzero"`                                                   // width of the preview image or embed, if known
+	Height            int              `bun:",nullzero"`                                                   // height of the preview image or embed, if known
+	HTML              string           `bun:",nullzero"`                                                   // sanitized iframe HTML to embed, for video and rich cards
+	EmbedURL          string           `bun:",nullzero"`                                                   // URL of the full-size photo, for photo cards
+}
+
+// CardType represents the type of a link preview card.
+type CardType enumType
+
+const (
+	// CardTypeUnknown is
+	// an unset card type.
+	CardTypeUnknown CardType = 0
+
+	// CardTypeLink is a
+	// plain link preview.
+	CardTypeLink CardType = 1
+
+	// CardTypePhoto is a
+	// preview of a photo.
+	CardTypePhoto CardType = 2
+
+	// CardTypeVideo is a preview
+	// of an embeddable video.
+	CardTypeVideo CardType = 3
+
+	// CardTypeRich is a preview of
+	// other embeddable content.
+	CardTypeRich CardType = 4
+)
+
+// String returns a stringified, frontend API compatible form of CardType.
+func (t CardType) String() string {
+	switch t {
+	case CardTypeLink:
+		return "link"
+	case CardTypePhoto:
+		return "photo"
+	case CardTypeVideo:
+		return "video"
+	case CardTypeRich:
+		return "rich"
+	default:
+		panic("invalid card type")
+	}
+}
+
+// CardTrend contains the recent daily
+// share counts of a link preview card.
+type CardTrend struct {
+	Card    *Card
+	History []CardShares // one entry per day, newest first
+}
+
+// CardShares contains the number of public statuses
+// sharing a card on a day, and the number of distinct
+// accounts that posted them.
+type CardShares struct {
+	Day      time.Time // midnight (UTC) starting the day
+	Uses     int
+	Accounts int
+}
@@ -60,6 +60,10 @@ type DomainLimit struct {
 	// accounts on the limited domain.
 	AccountsPolicy AccountsPolicy `bun:",nullzero,notnull,default:1"`
 
+	// Policy to apply to federation
+	// with the limited domain.
+	FederationPolicy FederationPolicy `bun:",nullzero,notnull,default:1"`
+
 	// Content warning to prepend to statuses
 	// originating from the limited domain.
 	ContentWarning string `bun:",nullzero"`
@@ -83,6 +87,13 @@ type DomainLimit struct {
 	// any media files rejected in this way will be
 	// appended to the bottom of the status.
 	MediaPolicyReject MediaPolicy = 3
+
+	// Do not download, thumbnail, or store media
+	// files from the limited domain that are marked
+	// sensitive. The blurhash and dimensions given by
+	// the remote are kept, so clients can show a blurred
+	// placeholder that links to the remote media file.
+	MediaPolicySensitiveBlurhashOnly MediaPolicy = 4
 )
 
 // MediaReject returns true if this domain
@@ -99,6 +110,13 @@ func (l *DomainLimit) MediaMarkSensitive() bool {
 	return l != nil && l.MediaPolicy == MediaPolicyMarkSensitive
 }
 
+// MediaSensitiveBlurhashOnly returns true if this
+// domain limit is not nil and its MediaPolicy says
+// that media marked sensitive should not be downloaded.
+func (l *DomainLimit) MediaSensitiveBlurhashOnly() bool {
+	return l != nil && l.MediaPolicy == MediaPolicySensitiveBlurhashOnly
+}
+
 type FollowsPolicy enumType
 
 const (
@@ -176,3 +194,28 @@ func (l *DomainLimit) StatusesFilter() bool {
 func (l *DomainLimit) AccountsMute() bool {
 	return l != nil && l.AccountsPolicy == AccountsPolicyMute
 }
+
+type FederationPolicy enumType
+
+const (
+	FederationPolicyUnknown FederationPolicy = 0
+
+	// Default federation behavior
+	// for domains that aren't limited.
+	FederationPolicyNoAction FederationPolicy = 1
+
+	// Temporarily pause federation with the
+	// limited domain: outgoing deliveries are
+	// held back until federation is resumed,
+	// and incoming activities are rejected
+	// with a request to retry later.
+	FederationPolicyPause FederationPolicy = 2
+)
+
+// FederationPaused returns true if this
+// domain limit is not nil and its
+// FederationPolicy says that federation
+// with the domain should be paused.
+func (l *DomainLimit) FederationPaused() bool {
+	return l != nil && l.FederationPolicy == FederationPolicyPause
+}
@@ -0,0 +1,130 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"strings"
+	"time"
+)
+
+// FailedActivity models an inbound ActivityPub
+// activity that failed asynchronous processing.
+//
+// Rather than just dropping such activities with
+// a log line, they are stored (along with their
+// serialized worker message, which includes the
+// raw activity JSON) so that admins can inspect,
+// retry, or discard them after the fact.
+//
+// Oversized activity JSON is not stored, such
+// activities are marked as truncated instead.
+type FailedActivity struct {
+	ID                  string        `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt           time.Time     `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	APActivityType      string        `bun:",nullzero,notnull"`                                           // activity type, eg., Create
+	APObjectType        string        `bun:",nullzero,notnull"`                                           // object type, eg., Note
+	APIRI               string        `bun:",nullzero"`                                                   // IRI of the activity or object, if known
+	RequestingAccountID string        `bun:"type:CHAR(26),nullzero"`                                      // id of the remote account that posted the activity
+	RequestingAccount   *Account      `bun:"-"`                                                           // account corresponding to RequestingAccountID
+	ReceivingAccountID  string        `bun:"type:CHAR(26),nullzero"`                                      // id of the local account whose inbox received the activity
+	ReceivingAccount    *Account      `bun:"-"`                                                           // account corresponding to ReceivingAccountID
+	Reason              FailureReason `bun:",nullzero,notnull"`                                           // classified reason for the failure
+	Error               string        `bun:",nullzero"`                                                   // text of the processing error
+	Data                []byte        `bun:",nullzero,notnull"`                                           // serialized worker message, for retries
+	Truncated           *bool         `bun:",nullzero,notnull,default:false"`                             // raw activity JSON was too large to store, so was dropped from Data
+}
+
+// FailedActivityCount is the number of stored failed
+// activities with a given activity type, object type
+// and failure reason. It is not a database table.
+type FailedActivityCount struct {
+	APActivityType string
+	APObjectType   string
+	Reason         FailureReason
+	Count          int
+}
+
+// FailureReason classifies the
+// failure of an inbound activity.
+type FailureReason enumType
+
+const (
+	FailureReasonUnknown FailureReason = 0
+
+	// Some other error occurred
+	// while processing the activity.
+	FailureReasonOther FailureReason = 1
+
+	// The activity (or something it
+	// refers to) was malformed.
+	FailureReasonMalformed FailureReason = 2
+
+	// Something the activity refers to
+	// could not be dereferenced remotely.
+	FailureReasonUnretrievable FailureReason = 3
+
+	// Something the activity refers
+	// to could not be found locally.
+	FailureReasonNotFound FailureReason = 4
+
+	// Processing the activity
+	// took too long and timed out.
+	FailureReasonTimeout FailureReason = 5
+
+	// The combination of activity and
+	// object type is not handled by us.
+	FailureReasonUnhandled FailureReason = 6
+)
+
+func (r FailureReason) String() string {
+	switch r {
+	case FailureReasonOther:
+		return "other"
+	case FailureReasonMalformed:
+		return "malformed"
+	case FailureReasonUnretrievable:
+		return "unretrievable"
+	case FailureReasonNotFound:
+		return "not_found"
+	case FailureReasonTimeout:
+		return "timeout"
+	case FailureReasonUnhandled:
+		return "unhandled"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseFailureReason(in string) FailureReason {
+	switch strings.ToLower(in) {
+	case "other":
+		return FailureReasonOther
+	case "malformed":
+		return FailureReasonMalformed
+	case "unretrievable":
+		return FailureReasonUnretrievable
+	case "not_found":
+		return FailureReasonNotFound
+	case "timeout":
+		return FailureReasonTimeout
+	case "unhandled":
+		return FailureReasonUnhandled
+	default:
+		return FailureReasonUnknown
+	}
+}
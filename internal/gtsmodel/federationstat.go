@@ -0,0 +1,32 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// FederationStat holds running totals of federation
+// traffic between this instance and one remote domain.
+type FederationStat struct {
+	Domain             string    `bun:",pk,nullzero,notnull"`                                        // Remote server host these stats are for.
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When was this domain first counted?
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When were the stats last flushed?
+	ActivitiesReceived int64     `bun:",notnull"`                                                    // Number of activities received in our inboxes from this domain.
+	ActivitiesSent     int64     `bun:",notnull"`                                                    // Number of activities queued for delivery to this domain.
+	MediaBytes         int64     `bun:",notnull"`                                                    // Number of bytes of remote media from this domain stored in our cache.
+	LastSeenAt         time.Time `bun:"type:timestamptz,nullzero"`                                   // When did we last receive an activity from this domain?
+}
@@ -43,5 +43,11 @@ type Instance struct {
 	ContactAccount         *Account     `bun:"rel:belongs-to"`                                              // account corresponding to contactAccountID
 	Reputation             int64        `bun:",notnull,default:0"`                                          // Reputation score of this instance
 	Version                string       `bun:",nullzero"`                                                   // Version of the software used on this instance
+	SoftwareName           string       `bun:",nullzero"`                                                   // Name of the software used on this instance, as reported by nodeinfo, eg., "gotosocial".
+	SoftwareVersion        string       `bun:",nullzero"`                                                   // Version of the software used on this instance, as reported by nodeinfo, eg., "0.20.0".
+	UsersTotal             int          `bun:",nullzero"`                                                   // Total number of users on this instance, as reported by nodeinfo.
+	UsersActiveMonth       int          `bun:",nullzero"`                                                   // Number of users active on this instance in the past month, as reported by nodeinfo.
+	OpenRegistrations      *bool        `bun:",nullzero"`                                                   // Whether this instance has open registrations, as reported by nodeinfo.
+	InfoFetchedAt          time.Time    `bun:"type:timestamptz,nullzero"`                                   // When was nodeinfo last fetched (or attempted to be fetched) for this instance, if at all?
 	Rules                  []Rule       `bun:"-"`                                                           // List of instance rules
 }
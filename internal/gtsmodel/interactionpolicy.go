@@ -210,6 +210,15 @@ type InteractionPolicy struct {
 	// interaction will be accepted
 	// for an item with this policy.
 	CanAnnounce *PolicyRules
+	// Conditions in which a quote
+	// interaction will be accepted
+	// for an item with this policy.
+	//
+	// Quote posts aren't supported yet,
+	// so this isn't enforced; it's stored
+	// so policies set by other software
+	// survive edits and can be shown.
+	CanQuote *PolicyRules
 }
 
 // PolicyRules represents the rules according
@@ -290,6 +299,30 @@ func DefaultInteractionPolicyFor(v Visibility) *InteractionPolicy {
 	}
 }
 
+// BotInteractionPolicyFor returns the interaction
+// policy preset for bot accounts, for the given
+// visibility level.
+//
+// This is the same as the default policy, except
+// that only the author, followers, and mentioned
+// accounts may reply to public and unlisted posts,
+// to avoid bots being drawn into reply chains with
+// strangers (or other bots) they didn't ask for.
+func BotInteractionPolicyFor(v Visibility) *InteractionPolicy {
+	policy := copyPolicy(DefaultInteractionPolicyFor(v))
+	if v == VisibilityPublic || v == VisibilityUnlocked {
+		policy.CanReply = &PolicyRules{
+			AutomaticApproval: PolicyValues{
+				PolicyValueAuthor,
+				PolicyValueFollowers,
+				PolicyValueMentioned,
+			},
+			ManualApproval: make(PolicyValues, 0),
+		}
+	}
+	return policy
+}
+
 // DefaultCanLikeFor returns the default
 // policy rules for the canLike sub-policy.
 func DefaultCanLikeFor(v Visibility) *PolicyRules {
@@ -461,7 +494,7 @@ func DefaultInteractionPolicyDirect() *InteractionPolicy {
 // copyPolicy returns a copy of the given InteractionPolicy
 // that can be modified safely without affecting the original.
 func copyPolicy(src *InteractionPolicy) *InteractionPolicy {
-	return &InteractionPolicy{
+	dst := &InteractionPolicy{
 		// Copy CanLike.
 		CanLike: &PolicyRules{
 			AutomaticApproval: slices.Clone(src.CanLike.AutomaticApproval),
@@ -478,6 +511,16 @@ func copyPolicy(src *InteractionPolicy) *InteractionPolicy {
 			ManualApproval:    slices.Clone(src.CanAnnounce.ManualApproval),
 		},
 	}
+
+	// Copy CanQuote, if set.
+	if src.CanQuote != nil {
+		dst.CanQuote = &PolicyRules{
+			AutomaticApproval: slices.Clone(src.CanQuote.AutomaticApproval),
+			ManualApproval:    slices.Clone(src.CanQuote.ManualApproval),
+		}
+	}
+
+	return dst
 }
 
 // DifferentFrom returns true if p1 and p2 are different.
@@ -513,6 +556,12 @@ func (ip1 *InteractionPolicy) DifferentFrom(ip2 *InteractionPolicy) bool {
 		return true
 	}
 
+	// If CanQuote differs from one policy
+	// to the next, they're different.
+	if ip1.CanQuote.DifferentFrom(ip2.CanQuote) {
+		return true
+	}
+
 	// Looks the
 	// same chief.
 	return false
@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Invite models an invite link created by a local
+// account, which allows others to sign up to the
+// instance using the invite code, even when
+// registration is otherwise closed.
+type Invite struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Code      string    `bun:",nullzero,notnull,unique"`                                    // random code used in the invite link
+	AccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that created the invite
+	Account   *Account  `bun:"-"`                                                           // account that created the invite
+	MaxUses   int       `bun:",notnull,default:0"`                                          // max number of sign-ups using this invite, 0 = unlimited
+	Uses      int       `bun:",notnull,default:0"`                                          // number of sign-ups using this invite so far
+	ExpiresAt time.Time `bun:"type:timestamptz,nullzero"`                                   // when does this invite expire, if at all
+	RevokedAt time.Time `bun:"type:timestamptz,nullzero"`                                   // when was this invite revoked, if at all
+}
+
+// IsRevoked returns whether the invite was revoked.
+func (i *Invite) IsRevoked() bool {
+	return !i.RevokedAt.IsZero()
+}
+
+// IsExpired returns whether the invite
+// has an expiry which has passed by now.
+func (i *Invite) IsExpired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && !i.ExpiresAt.After(now)
+}
+
+// IsUsedUp returns whether the invite has a
+// max number of uses which has been reached.
+func (i *Invite) IsUsedUp() bool {
+	return i.MaxUses > 0 && i.Uses >= i.MaxUses
+}
+
+// IsUsable returns whether the invite can
+// currently be used to sign up, ie., it's
+// not revoked, expired, or used up.
+func (i *Invite) IsUsable(now time.Time) bool {
+	return !i.IsRevoked() &&
+		!i.IsExpired(now) &&
+		!i.IsUsedUp()
+}
@@ -37,9 +37,18 @@
 	MediaErrorTypeNone MediaErrorType = 0
 
 	// MediaErrorTypePolicy: file(s) not downloaded due to configured policy.
-	MediaErrorTypePolicy        MediaErrorType = 1
-	MediaErrorTypePolicy_Size   uint16         = 1 // nolint:revive
-	MediaErrorTypePolicy_Domain uint16         = 2 // nolint:revive
+	MediaErrorTypePolicy               MediaErrorType = 1
+	MediaErrorTypePolicy_Size          uint16         = 1  // nolint:revive
+	MediaErrorTypePolicy_Domain        uint16         = 2  // nolint:revive
+	MediaErrorTypePolicy_Sensitive     uint16         = 3  // nolint:revive
+	MediaErrorTypePolicy_ImageSize     uint16         = 4  // nolint:revive
+	MediaErrorTypePolicy_ImagePixels   uint16         = 5  // nolint:revive
+	MediaErrorTypePolicy_VideoSize     uint16         = 6  // nolint:revive
+	MediaErrorTypePolicy_VideoDuration uint16         = 7  // nolint:revive
+	MediaErrorTypePolicy_AudioSize     uint16         = 8  // nolint:revive
+	MediaErrorTypePolicy_AudioDuration uint16         = 9  // nolint:revive
+	MediaErrorTypePolicy_VideoBitrate  uint16         = 10 // nolint:revive
+	MediaErrorTypePolicy_AudioBitrate  uint16         = 11 // nolint:revive
 
 	// MediaErrorTypeInterrupt: file(s) not downloaded due to interrupt (i.e. context errors).
 	MediaErrorTypeInterrupt MediaErrorType = 2
@@ -148,6 +157,24 @@ func (d MediaErrorDetails) String() string {
 			return "file size limit reached"
 		case MediaErrorTypePolicy_Domain:
 			return "domain media policy"
+		case MediaErrorTypePolicy_Sensitive:
+			return "sensitive media policy"
+		case MediaErrorTypePolicy_ImageSize:
+			return "image file size limit reached"
+		case MediaErrorTypePolicy_ImagePixels:
+			return "image pixel limit reached"
+		case MediaErrorTypePolicy_VideoSize:
+			return "video file size limit reached"
+		case MediaErrorTypePolicy_VideoDuration:
+			return "video duration limit reached"
+		case MediaErrorTypePolicy_AudioSize:
+			return "audio file size limit reached"
+		case MediaErrorTypePolicy_AudioDuration:
+			return "audio duration limit reached"
+		case MediaErrorTypePolicy_VideoBitrate:
+			return "video bitrate limit reached"
+		case MediaErrorTypePolicy_AudioBitrate:
+			return "audio bitrate limit reached"
 		default:
 			return "configuration policy"
 		}
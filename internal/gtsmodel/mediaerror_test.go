@@ -54,6 +54,21 @@
 		u1: gtsmodel.MediaErrorTypePolicy,
 		u2: gtsmodel.MediaErrorTypePolicy_Domain,
 	},
+	{
+		p:  gtsmodel.NewMediaErrorDetails(gtsmodel.MediaErrorTypePolicy, gtsmodel.MediaErrorTypePolicy_Sensitive),
+		u1: gtsmodel.MediaErrorTypePolicy,
+		u2: gtsmodel.MediaErrorTypePolicy_Sensitive,
+	},
+	{
+		p:  gtsmodel.NewMediaErrorDetails(gtsmodel.MediaErrorTypePolicy, gtsmodel.MediaErrorTypePolicy_ImagePixels),
+		u1: gtsmodel.MediaErrorTypePolicy,
+		u2: gtsmodel.MediaErrorTypePolicy_ImagePixels,
+	},
+	{
+		p:  gtsmodel.NewMediaErrorDetails(gtsmodel.MediaErrorTypePolicy, gtsmodel.MediaErrorTypePolicy_VideoDuration),
+		u1: gtsmodel.MediaErrorTypePolicy,
+		u2: gtsmodel.MediaErrorTypePolicy_VideoDuration,
+	},
 	{
 		p:  gtsmodel.NewMediaErrorDetails(gtsmodel.MediaErrorTypeNetwork, gtsmodel.MediaErrorTypeNetwork_DNS),
 		u1: gtsmodel.MediaErrorTypeNetwork,
@@ -0,0 +1,42 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+// MediaUsage models the number and total
+// stored size of a category of media files.
+type MediaUsage struct {
+	Count int   // Number of media attachments.
+	Bytes int64 // Total bytes stored locally, including thumbnails.
+}
+
+// AccountMediaUsage models the locally stored
+// media footprint of an account, by category.
+type AccountMediaUsage struct {
+	Attachments MediaUsage // Media attached to statuses.
+	Unattached  MediaUsage // Media not attached to a status, eg., pending or scheduled.
+	Avatar      MediaUsage // Current and previous avatars.
+	Header      MediaUsage // Current and previous headers.
+}
+
+// Total returns the sum of all media usage categories.
+func (u *AccountMediaUsage) Total() MediaUsage {
+	return MediaUsage{
+		Count: u.Attachments.Count + u.Unattached.Count + u.Avatar.Count + u.Header.Count,
+		Bytes: u.Attachments.Bytes + u.Unattached.Bytes + u.Avatar.Bytes + u.Header.Bytes,
+	}
+}
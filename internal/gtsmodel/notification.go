@@ -34,6 +34,8 @@ type Notification struct {
 	OriginAccount    *Account         `bun:"-"`                                                           // Account corresponding to OriginAccountID. Can be nil, always check first + select using ID if necessary.
 	StatusOrEditID   string           `bun:"status_id,type:CHAR(26),nullzero"`                            // If the notification pertains to a status or a status edit event, what is the database ID of the status or status edit?
 	Status           *Status          `bun:"-"`                                                           // Status corresponding to StatusOrEditID. Can be nil, always check first + select using ID if necessary.
+	AccountWarningID string           `bun:"type:CHAR(26),nullzero"`                                      // If the notification pertains to a moderation warning, what is the database ID of the warning?
+	AccountWarning   *AccountWarning  `bun:"-"`                                                           // AccountWarning corresponding to AccountWarningID. Can be nil, always check first + select using ID if necessary.
 	Read             *bool            `bun:",nullzero,notnull,default:false"`                             // Notification has been seen/read
 }
 
@@ -43,21 +45,25 @@ type Notification struct {
 
 const (
 	// Notification Types
-	NotificationUnknown       NotificationType = 0  // NotificationUnknown -- unknown notification type, error if this occurs
-	NotificationFollow        NotificationType = 1  // NotificationFollow -- someone followed you
-	NotificationFollowRequest NotificationType = 2  // NotificationFollowRequest -- someone requested to follow you
-	NotificationMention       NotificationType = 3  // NotificationMention -- someone mentioned you in their status
-	NotificationReblog        NotificationType = 4  // NotificationReblog -- someone boosted one of your statuses
-	NotificationFavourite     NotificationType = 5  // NotificationFavourite -- someone faved/liked one of your statuses
-	NotificationPoll          NotificationType = 6  // NotificationPoll -- a poll you voted in or created has ended
-	NotificationStatus        NotificationType = 7  // NotificationStatus -- someone you enabled notifications for has posted a status.
-	NotificationAdminSignup   NotificationType = 8  // NotificationAdminSignup -- someone has submitted a new account sign-up to the instance.
-	NotificationPendingFave   NotificationType = 9  // NotificationPendingFave -- Someone has faved a status of yours, which requires approval by you.
-	NotificationPendingReply  NotificationType = 10 // NotificationPendingReply -- Someone has replied to a status of yours, which requires approval by you.
-	NotificationPendingReblog NotificationType = 11 // NotificationPendingReblog -- Someone has boosted a status of yours, which requires approval by you.
-	NotificationAdminReport   NotificationType = 12 // NotificationAdminReport -- someone has submitted a new report to the instance.
-	NotificationUpdate        NotificationType = 13 // NotificationUpdate -- someone has edited their status.
-	NotificationTypeNumValues NotificationType = 14 // NotificationTypeNumValues -- 1 + number of max notification type
+	NotificationUnknown           NotificationType = 0  // NotificationUnknown -- unknown notification type, error if this occurs
+	NotificationFollow            NotificationType = 1  // NotificationFollow -- someone followed you
+	NotificationFollowRequest     NotificationType = 2  // NotificationFollowRequest -- someone requested to follow you
+	NotificationMention           NotificationType = 3  // NotificationMention -- someone mentioned you in their status
+	NotificationReblog            NotificationType = 4  // NotificationReblog -- someone boosted one of your statuses
+	NotificationFavourite         NotificationType = 5  // NotificationFavourite -- someone faved/liked one of your statuses
+	NotificationPoll              NotificationType = 6  // NotificationPoll -- a poll you voted in or created has ended
+	NotificationStatus            NotificationType = 7  // NotificationStatus -- someone you enabled notifications for has posted a status.
+	NotificationAdminSignup       NotificationType = 8  // NotificationAdminSignup -- someone has submitted a new account sign-up to the instance.
+	NotificationPendingFave       NotificationType = 9  // NotificationPendingFave -- Someone has faved a status of yours, which requires approval by you.
+	NotificationPendingReply      NotificationType = 10 // NotificationPendingReply -- Someone has replied to a status of yours, which requires approval by you.
+	NotificationPendingReblog     NotificationType = 11 // NotificationPendingReblog -- Someone has boosted a status of yours, which requires approval by you.
+	NotificationAdminReport       NotificationType = 12 // NotificationAdminReport -- someone has submitted a new report to the instance.
+	NotificationUpdate            NotificationType = 13 // NotificationUpdate -- someone has edited their status.
+	NotificationModerationWarning NotificationType = 14 // NotificationModerationWarning -- a moderator has issued a warning to you.
+	NotificationAppealApproved    NotificationType = 15 // NotificationAppealApproved -- a moderator has approved your appeal against a warning.
+	NotificationAppealRejected    NotificationType = 16 // NotificationAppealRejected -- a moderator has rejected your appeal against a warning.
+	NotificationThreadReply       NotificationType = 17 // NotificationThreadReply -- someone has replied in a thread you subscribed to.
+	NotificationTypeNumValues     NotificationType = 18 // NotificationTypeNumValues -- 1 + number of max notification type
 )
 
 // String returns a stringified, frontend API compatible form of NotificationType.
@@ -89,6 +95,14 @@ func (t NotificationType) String() string {
 		return "admin.report"
 	case NotificationUpdate:
 		return "update"
+	case NotificationModerationWarning:
+		return "moderation_warning"
+	case NotificationAppealApproved:
+		return "appeal.approved"
+	case NotificationAppealRejected:
+		return "appeal.rejected"
+	case NotificationThreadReply:
+		return "thread_reply"
 	default:
 		panic("invalid notification type")
 	}
@@ -123,6 +137,14 @@ func ParseNotificationType(in string) NotificationType {
 		return NotificationAdminReport
 	case "update":
 		return NotificationUpdate
+	case "moderation_warning":
+		return NotificationModerationWarning
+	case "appeal.approved":
+		return NotificationAppealApproved
+	case "appeal.rejected":
+		return NotificationAppealRejected
+	case "thread_reply":
+		return NotificationThreadReply
 	default:
 		return NotificationUnknown
 	}
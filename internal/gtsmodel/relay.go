@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Relay models a subscription of this
+// instance to a remote ActivityPub relay.
+//
+// The subscription itself is modelled as a
+// follow (request) from the instance account
+// to the relay actor account, so the state of
+// the subscription can be derived from that.
+type Relay struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID          string    `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // id of the relay actor account
+	Account            *Account  `bun:"-"`                                                           // account corresponding to AccountID
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the (admin) account that added this relay
+	CreatedByAccount   *Account  `bun:"-"`                                                           // account corresponding to CreatedByAccountID
+}
+
+// RelayState denotes the state of
+// a subscription to a remote relay.
+type RelayState string
+
+const (
+	RelayStatePending  RelayState = "pending"  // Follow sent, awaiting Accept.
+	RelayStateAccepted RelayState = "accepted" // Follow accepted by relay.
+	RelayStateRejected RelayState = "rejected" // Follow rejected (or undone) by relay.
+)
@@ -38,6 +38,7 @@ type ScheduledStatus struct {
 	Application       *Application        `bun:"-"`                                        //
 	LocalOnly         *bool               `bun:",nullzero,notnull,default:false"`          // Whether the status is not federated
 	ContentType       string              `bun:",nullzero"`                                // Content type used to process the original text of the status
+	PreviewLinks      *bool               `bun:""`                                         // Generate a link preview card for the status? If null, the account default is used.
 	InteractionPolicy *InteractionPolicy  `bun:""`                                         // InteractionPolicy for this status. If null then the default InteractionPolicy should be assumed for this status's Visibility. Always null for boost wrappers.
 	Idempotency       string              `bun:",nullzero"`                                // Currently unused
 }
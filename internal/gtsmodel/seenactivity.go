@@ -0,0 +1,30 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package gtsmodel
+
+import "time"
+
+// SeenActivity records the ID of an activity that was
+// successfully delivered to one of our inboxes, so that
+// redeliveries of the same activity to that inbox can be
+// dropped, even across restarts. Entries are pruned after
+// a configured TTL.
+type SeenActivity struct {
+	InboxURI  string    `bun:",pk,nullzero,notnull"`                                        // URI of the inbox the activity was delivered to.
+	URI       string    `bun:",pk,nullzero,notnull"`                                        // ActivityPub ID of the activity.
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When was the activity first seen?
+}
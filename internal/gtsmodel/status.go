@@ -32,9 +32,11 @@ type Status struct {
 	EditedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                           // when this status was last edited (if set)
 	FetchedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                           // when was item (remote) last fetched.
 	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                           // Status was pinned by owning account at this time.
+	ExpiresAt                time.Time          `bun:"type:timestamptz,nullzero"`                                           // Status (if local) will be automatically deleted at this time.
 	URI                      string             `bun:",unique,nullzero,notnull"`                                            // activitypub URI of this status
 	URL                      string             `bun:",nullzero"`                                                           // web url for viewing this status
 	Content                  string             `bun:""`                                                                    // Content HTML for this status.
+	ContentMap               map[string]string  `bun:",nullzero"`                                                           // Content HTML variants for this status keyed by BCP47 language tag, only set if there are multiple language variants.
 	AttachmentIDs            []string           `bun:"attachments,array"`                                                   // Database IDs of any media attachments associated with this status
 	Attachments              []*MediaAttachment `bun:"attached_media,rel:has-many"`                                         // Attachments corresponding to attachmentIDs
 	TagIDs                   []string           `bun:"tags,array"`                                                          // Database IDs of any tags used in this status
@@ -62,8 +64,12 @@ type Status struct {
 	Edits                    []*StatusEdit      `bun:"-"`                                                                   // Edits of this status, ordered from oldest -> newest edit.
 	PollID                   string             `bun:"type:CHAR(26),nullzero"`                                              //
 	Poll                     *Poll              `bun:"-"`                                                                   //
+	CardID                   string             `bun:"type:CHAR(26),nullzero"`                                              // id of the link preview card for the first link in this status, if any
+	Card                     *Card              `bun:"-"`                                                                   // card corresponding to cardID
+	PreviewLinks             *bool              `bun:",nullzero,notnull,default:true"`                                      // Generate a link preview card for links in this status?
 	ContentWarning           string             `bun:",nullzero"`                                                           // Content warning HTML for this status.
 	ContentWarningText       string             `bun:""`                                                                    // Original text of the content warning without formatting
+	Title                    string             `bun:",nullzero"`                                                           // Title of this status, if it's a long-form article.
 	Visibility               Visibility         `bun:",nullzero,notnull"`                                                   // visibility entry for this status
 	Sensitive                *bool              `bun:",nullzero,notnull,default:false"`                                     // mark the status as sensitive?
 	Language                 string             `bun:",nullzero"`                                                           // what language is this status written in?
@@ -96,6 +102,13 @@ func (s *Status) GetAccount() *Account {
 	return s.Account
 }
 
+// IsArticle returns true if this status is a
+// long-form Article, eg., from a blogging platform
+// like WriteFreely or Plume, rather than a Note.
+func (s *Status) IsArticle() bool {
+	return s.ActivityStreamsType == "Article"
+}
+
 // GetBoostOfID implements timeline.Timelineable{}.
 func (s *Status) GetBoostOfID() string {
 	return s.BoostOfID
@@ -397,6 +410,17 @@ func (v Visibility) String() string {
 	}
 }
 
+// VisibilityChangeable returns whether a published status may have
+// its visibility changed from one visibility to the other. Only the
+// switch between public and unlocked is allowed, as this only changes
+// which timelines the status is shown on, not who is able to see it.
+func VisibilityChangeable(from, to Visibility) bool {
+	publicOrUnlocked := func(v Visibility) bool {
+		return v == VisibilityPublic || v == VisibilityUnlocked
+	}
+	return from != to && publicOrUnlocked(from) && publicOrUnlocked(to)
+}
+
 // StatusContentType is the content type with which a status's text is
 // parsed. Can be either plain or markdown. Empty will default to plain.
 type StatusContentType enumType
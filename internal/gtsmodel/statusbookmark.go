@@ -30,4 +30,15 @@ type StatusBookmark struct {
 	TargetAccount   *Account  `bun:"rel:belongs-to"`                                              // account owning the bookmarked status
 	StatusID        string    `bun:"type:CHAR(26),nullzero,notnull"`                              // database id of the status that has been bookmarked
 	Status          *Status   `bun:"rel:belongs-to"`                                              // the bookmarked status
+	FolderID        string    `bun:"type:CHAR(26),nullzero"`                                      // id of the bookmark folder this bookmark is filed in, if any; bookmarks without folder are in the default folder
+}
+
+// BookmarkFolder refers to a named folder that
+// an account can file its status bookmarks into.
+type BookmarkFolder struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                         // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`      // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`      // when was item last updated
+	Title     string    `bun:",nullzero,notnull,unique:bookmarkfolderaccounttitle"`              // Title of this folder.
+	AccountID string    `bun:"type:CHAR(26),notnull,nullzero,unique:bookmarkfolderaccounttitle"` // Account that created/owns the folder
 }
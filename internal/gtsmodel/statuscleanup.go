@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"strings"
+	"time"
+)
+
+// StatusCleanupAction describes what a status
+// cleanup does to each of the matching statuses.
+type StatusCleanupAction uint8
+
+// Only ever add new cleanup actions to the *END* of the list
+// below, DO NOT insert them before/between other entries!
+
+const (
+	StatusCleanupUnknown StatusCleanupAction = iota
+	StatusCleanupDelete
+	StatusCleanupUnlist
+)
+
+func (a StatusCleanupAction) String() string {
+	switch a {
+	case StatusCleanupDelete:
+		return "delete"
+	case StatusCleanupUnlist:
+		return "unlist"
+	default:
+		return "unknown" //nolint:goconst
+	}
+}
+
+func ParseStatusCleanupAction(in string) StatusCleanupAction {
+	switch strings.ToLower(in) {
+	case "delete":
+		return StatusCleanupDelete
+	case "unlist":
+		return StatusCleanupUnlist
+	default:
+		return StatusCleanupUnknown
+	}
+}
+
+// StatusCleanup models a background job, invoked by a
+// local account, which either deletes or unlists (ie.,
+// changes public -> unlisted) all of their own statuses
+// created before a cutoff time. Pinned statuses and
+// boosts are left alone.
+type StatusCleanup struct {
+	ID           string              `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt    time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt    time.Time           `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID    string              `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account whose statuses are cleaned up
+	Action       StatusCleanupAction `bun:",nullzero,notnull"`                                           // what to do to each status
+	OlderThan    time.Time           `bun:"type:timestamptz,nullzero,notnull"`                           // statuses created before this time are cleaned up
+	Total        int                 `bun:",notnull,default:0"`                                          // number of statuses to clean up, counted at start
+	Processed    int                 `bun:",notnull,default:0"`                                          // number of statuses cleaned up (or skipped) so far
+	LastStatusID string              `bun:"type:CHAR(26),nullzero"`                                      // id of the last status processed, to resume from
+	CompletedAt  time.Time           `bun:"type:timestamptz,nullzero"`                                   // when was cleanup completed (or cancelled)
+	CancelledAt  time.Time           `bun:"type:timestamptz,nullzero"`                                   // when was cleanup cancelled, if it was
+}
+
+// IsCompleted returns whether the cleanup has
+// either finished running or was cancelled.
+func (c *StatusCleanup) IsCompleted() bool {
+	return !c.CompletedAt.IsZero()
+}
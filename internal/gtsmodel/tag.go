@@ -24,12 +24,31 @@ type Tag struct {
 	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
 	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
 	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	Name      string    `bun:",unique,nullzero,notnull"`                                    // (lowercase) name of the tag without the hash prefix
+	Name      string    `bun:",unique,nullzero,notnull"`                                    // (case-folded) name of the tag without the hash prefix
 	Useable   *bool     `bun:",nullzero,notnull,default:true"`                              // Tag is useable on this instance.
 	Listable  *bool     `bun:",nullzero,notnull,default:true"`                              // Tagged statuses can be listed on this instance.
+	AliasOfID string    `bun:"type:CHAR(26),nullzero"`                                      // If set, this tag is an (admin-set) alias of the tag with this ID, and its timeline + follows are merged into that tag's.
 	Href      string    `bun:"-"`                                                           // Href of the hashtag. Will only be set on freshly-extracted hashtags from remote AP messages. Not stored in the database.
 }
 
+// IsAlias returns whether this
+// tag is an alias of another tag.
+func (t *Tag) IsAlias() bool {
+	return t.AliasOfID != ""
+}
+
+// CanonicalID returns the ID of the tag
+// that this tag's timeline and follows
+// are merged into, ie., the ID of the
+// aliased tag if this tag is an alias,
+// else the ID of this tag itself.
+func (t *Tag) CanonicalID() string {
+	if t.AliasOfID != "" {
+		return t.AliasOfID
+	}
+	return t.ID
+}
+
 // FollowedTag represents a user following a tag.
 type FollowedTag struct {
 	// ID of the account that follows the tag.
@@ -17,13 +17,18 @@
 
 package gtsmodel
 
-import "time"
+import (
+	"net"
+	"time"
+)
 
 // Token is a translation of the gotosocial token
 // with the ExpiresIn fields replaced with ExpiresAt.
 type Token struct {
 	ID                  string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"` // id of this item in the database
 	LastUsed            time.Time `bun:"type:timestamptz,nullzero"`                // approximate time when this token was last used
+	LastUsedIP          net.IP    `bun:",nullzero"`                                // IP address this token was last used from, if known
+	LastUsedUserAgent   string    `bun:",nullzero"`                                // User-Agent this token was last used with, if known
 	ClientID            string    `bun:"type:CHAR(26),nullzero,notnull"`           // ID of the client who owns this token
 	UserID              string    `bun:"type:CHAR(26),nullzero"`                   // ID of the user who owns this token
 	RedirectURI         string    `bun:",nullzero,notnull"`                        // Oauth redirect URI for this token
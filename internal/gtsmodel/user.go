@@ -88,6 +88,14 @@ type User struct {
 	// when this user was created.
 	Reason string `bun:",nullzero"`
 
+	// Database IDs of the instance rules this
+	// user acknowledged when they signed up.
+	RulesAcknowledgedIDs []string `bun:",nullzero,array"`
+
+	// Datetime when the user acknowledged
+	// instance rules, if applicable.
+	RulesAcknowledgedAt time.Time `bun:"type:timestamptz,nullzero"`
+
 	// Timezone/locale in which
 	// this user is located.
 	Locale string `bun:",nullzero"`
@@ -203,12 +211,14 @@ type NewSignup struct {
 	Email    string // Email address of the user (required).
 	Password string // Plaintext (not yet hashed) password for the user (required).
 
-	Reason        string // Reason given by the user when submitting a sign up request (optional).
-	PreApproved   bool   // Mark the new user/account as preapproved (optional)
-	SignUpIP      net.IP // IP address from which the sign up request occurred (optional).
-	Locale        string // Locale code for the new account/user (optional).
-	AppID         string // ID of the application used to create this account (optional).
-	EmailVerified bool   // Mark submitted email address as already verified (optional).
-	ExternalID    string // ID of this user in external OIDC system (optional).
-	Admin         bool   // Mark new user as an admin user (optional).
+	Reason        string   // Reason given by the user when submitting a sign up request (optional).
+	RuleIDs       []string // IDs of instance rules acknowledged by the user when submitting a sign up request (optional).
+	PreApproved   bool     // Mark the new user/account as preapproved (optional)
+	SignUpIP      net.IP   // IP address from which the sign up request occurred (optional).
+	Locale        string   // Locale code for the new account/user (optional).
+	InviteID      string   // ID of the invite used to create this account (optional).
+	AppID         string   // ID of the application used to create this account (optional).
+	EmailVerified bool     // Mark submitted email address as already verified (optional).
+	ExternalID    string   // ID of this user in external OIDC system (optional).
+	Admin         bool     // Mark new user as an admin user (optional).
 }
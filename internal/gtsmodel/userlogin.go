@@ -0,0 +1,36 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"net"
+	"time"
+)
+
+// UserLogin represents one attempt to sign in to
+// a local user account via the sign-in flow, used
+// to show a user their recent sign-in history, and
+// to notify them of sign-ins from unfamiliar places.
+type UserLogin struct {
+	ID         string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UserID     string    `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the user whose account was signed in to
+	IP         net.IP    `bun:",nullzero"`                                                   // IP address the sign-in attempt originated from
+	UserAgent  string    `bun:",nullzero"`                                                   // User-agent of the client that made the sign-in attempt
+	Successful *bool     `bun:",nullzero,notnull,default:false"`                             // Whether the sign-in attempt succeeded
+}
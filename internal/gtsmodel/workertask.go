@@ -39,3 +39,16 @@ type WorkerTask struct {
 	TaskData   []byte     `bun:",nullzero,notnull"`
 	CreatedAt  time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`
 }
+
+// PausedDelivery represents an outgoing delivery to
+// a domain that federation is currently paused with.
+// It is held in the database until federation with
+// the domain is resumed, at which point it is pushed
+// onto the delivery worker queue. Like WorkerTask,
+// it is simply a blob of serialized delivery data.
+type PausedDelivery struct {
+	ID        uint      `bun:",pk,autoincrement"`
+	Domain    string    `bun:",nullzero,notnull"`
+	TaskData  []byte    `bun:",nullzero,notnull"`
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`
+}
@@ -79,13 +79,50 @@ type Config struct {
 	// MaxIdleConns: see http.Transport{}.MaxIdleConns.
 	MaxIdleConns int
 
+	// MaxIdleConnsPerHost: see http.Transport{}.MaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout: see http.Transport{}.IdleConnTimeout.
+	IdleConnTimeout time.Duration
+
+	// DisableHTTP2 disables negotiating HTTP/2
+	// with remote servers, using only HTTP/1.1.
+	DisableHTTP2 bool
+
+	// DNSCacheTTL is the duration for which successful
+	// host lookups are cached, zero disables caching.
+	DNSCacheTTL time.Duration
+
+	// DNSCacheNegativeTTL is the duration for which lookups
+	// of hosts that don't exist are cached, zero disables.
+	DNSCacheNegativeTTL time.Duration
+
+	// PreferIPFamily is the IP address family to dial first
+	// when a host has both IPv4 and IPv6 addresses, either
+	// "ipv4" or "ipv6". Otherwise the resolver order is used.
+	PreferIPFamily string
+
+	// FallbackDelay is the delay after starting to dial the
+	// preferred IP family before racing the other family, as
+	// per RFC 8305 (happy eyeballs). Zero disables racing.
+	FallbackDelay time.Duration
+
+	// IPv4OnlyDomains are domains (incl. subdomains) for
+	// which only IPv4 addresses will be dialed, eg. for
+	// those with broken IPv6 connectivity / AAAA records.
+	IPv4OnlyDomains []string
+
 	// ReadBufferSize: see http.Transport{}.ReadBufferSize.
 	ReadBufferSize int
 
 	// WriteBufferSize: see http.Transport{}.WriteBufferSize.
 	WriteBufferSize int
 
-	// Timeout: see http.Client{}.Timeout.
+	// Timeout is the default timeout to use for a single
+	// request attempt, when the request context doesn't
+	// already carry a deadline of its own. This allows
+	// callers to set their own (longer or shorter)
+	// per-operation timeouts via context deadlines.
 	Timeout time.Duration
 
 	// DisableCompression: see http.Transport{}.DisableCompression.
@@ -103,8 +140,10 @@ type Config struct {
 //   - request logging
 type Client struct {
 	client   http.Client
+	resolver *Resolver
 	badHosts cache.TTLCache[string, struct{}]
 	retries  uint
+	timeout  time.Duration
 }
 
 // New returns a new instance of Client initialized using configuration.
@@ -112,10 +151,18 @@ func New(cfg Config) *Client {
 	var c Client
 	c.retries = 5
 
-	d := &net.Dialer{
-		Timeout:   15 * time.Second,
-		KeepAlive: 30 * time.Second,
-		Resolver:  &net.Resolver{},
+	d := &dialer{
+		Dialer: net.Dialer{
+			Timeout:   15 * time.Second,
+			KeepAlive: 30 * time.Second,
+		},
+
+		// Wrap system resolver with lookup cache.
+		resolver: NewResolver(cfg.DNSCacheTTL, cfg.DNSCacheNegativeTTL),
+
+		prefer:   cfg.PreferIPFamily,
+		fallback: cfg.FallbackDelay,
+		ipv4Only: cfg.IPv4OnlyDomains,
 	}
 
 	if cfg.MaxOpenConnsPerHost <= 0 {
@@ -129,6 +176,15 @@ func New(cfg Config) *Client {
 		cfg.MaxIdleConns = cfg.MaxOpenConnsPerHost * 10
 	}
 
+	if cfg.IdleConnTimeout <= 0 {
+		// By default use http.DefaultTransport value.
+		cfg.IdleConnTimeout = 90 * time.Second
+	}
+
+	// Share resolver with client,
+	// for any non-dial lookups.
+	c.resolver = d.resolver
+
 	// Protect the dialer
 	// with IP range sanitizer.
 	d.Control = (&Sanitizer{
@@ -136,8 +192,10 @@ func New(cfg Config) *Client {
 		Block: cfg.BlockRanges,
 	}).Sanitize
 
-	// Prepare client fields.
-	c.client.Timeout = cfg.Timeout
+	// Set default attempt timeout, note we don't set this
+	// on the http.Client{} as that would override any
+	// longer deadlines set on individual request contexts.
+	c.timeout = cfg.Timeout
 
 	// Prepare transport TLS config.
 	tlsClientConfig := &tls.Config{
@@ -155,12 +213,18 @@ func New(cfg Config) *Client {
 
 	// Set underlying HTTP client roundtripper.
 	c.client.Transport = &signingtransport{http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
-		ForceAttemptHTTP2:     true,
+		Proxy: http.ProxyFromEnvironment,
+
+		// Note that as we set our own DialContext and
+		// TLSClientConfig, HTTP/2 will only be attempted
+		// when explicitly forced, so this toggles it.
+		ForceAttemptHTTP2: !cfg.DisableHTTP2,
+
 		DialContext:           d.DialContext,
 		TLSClientConfig:       tlsClientConfig,
 		MaxIdleConns:          cfg.MaxIdleConns,
-		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		IdleConnTimeout:       cfg.IdleConnTimeout,
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 		ReadBufferSize:        cfg.ReadBufferSize,
@@ -178,6 +242,12 @@ func New(cfg Config) *Client {
 	return &c
 }
 
+// LookupTXT looks up the DNS TXT records of name, using the
+// same resolver as the client uses to look up dialed hosts.
+func (c *Client) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return c.resolver.LookupTXT(ctx, name)
+}
+
 // RoundTrip allows httpclient.Client{} to be used as an http.Transport{}, just calling Client{}.Do().
 func (c *Client) RoundTrip(r *http.Request) (rsp *http.Response, err error) { return c.Do(r) }
 
@@ -295,10 +365,25 @@ func (c *Client) DoOnce(r *Request) (rsp *http.Response, retry bool, err error)
 // do performs the "meat" of DoOnce(), but it's separated out to allow
 // easier wrapping of the response, retry, error returns with further logic.
 func (c *Client) do(r *Request) (rsp *http.Response, retry bool, err error) {
+	req := r.Request
+	cncl := func() {}
+
+	if _, ok := req.Context().Deadline(); !ok && c.timeout > 0 {
+		var ctx context.Context
+
+		// No deadline was set by the caller, so wrap
+		// the request ctx with our default timeout.
+		ctx, cncl = context.WithTimeout(req.Context(), c.timeout)
+		req = req.WithContext(ctx)
+	}
+
 	// Perform the HTTP request.
-	rsp, err = c.client.Do(r.Request)
+	rsp, err = c.client.Do(req)
 	if err != nil {
 
+		// Release ctx.
+		cncl()
+
 		if errorsv2.IsV2(err,
 			context.DeadlineExceeded,
 			context.Canceled,
@@ -358,6 +443,7 @@ func (c *Client) do(r *Request) (rsp *http.Response, retry bool, err error) {
 
 		// Unset + close rsp.
 		_ = rsp.Body.Close()
+		cncl()
 		return nil, true, err
 	}
 
@@ -370,6 +456,9 @@ func (c *Client) do(r *Request) (rsp *http.Response, retry bool, err error) {
 		_, _ = discard.ReadFrom(rbody)
 	})
 
+	// Wrap closer to release ctx AFTER close.
+	cbody = iotools.CloserCallback(cbody, cncl)
+
 	// Set the wrapped response body.
 	rsp.Body = &iotools.ReadCloserType{
 		Reader: rbody,
@@ -19,12 +19,15 @@
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/netip"
+	"strings"
 	"testing"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
 )
@@ -116,3 +119,121 @@ func TestHTTPClientPrivateIP(t *testing.T) {
 		}
 	}
 }
+
+func TestHTTPClientTimeout(t *testing.T) {
+	client := httpclient.New(httpclient.Config{
+		Timeout: 100 * time.Millisecond,
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+	})
+
+	// Set slow responding test handler
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+		_, _ = rw.Write([]byte("hello world!"))
+	})
+
+	// Start the test server
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	// Without a deadline the client default timeout should apply.
+	req, _ := http.NewRequest("GET", srv.URL, nil)
+	if _, err := client.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded error, got: %v", err)
+	}
+
+	// A (longer) deadline on the request ctx should take precedence.
+	ctx, cncl := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cncl()
+	req, _ = http.NewRequestWithContext(ctx, "GET", srv.URL, nil)
+	rsp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("error performing client request: %v", err)
+	}
+	defer rsp.Body.Close()
+
+	if _, err := io.ReadAll(rsp.Body); err != nil {
+		t.Fatalf("error reading response body: %v", err)
+	}
+}
+
+func TestHTTPClientConnReuse(t *testing.T) {
+	client := httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+	})
+
+	// Set simple body-writing test handler
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte("hello world!"))
+	})
+
+	// Start the test server
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	before := httpclient.Conns()
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest("GET", srv.URL, nil)
+		rsp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("error performing client request: %v", err)
+		}
+
+		// Closing drains body, allowing reuse.
+		_ = rsp.Body.Close()
+	}
+
+	after := httpclient.Conns()
+	if n := after.New - before.New; n != 1 {
+		t.Errorf("unexpected new conns: %d", n)
+	}
+	if n := after.Reused - before.Reused; n != 2 {
+		t.Errorf("unexpected reused conns: %d", n)
+	}
+}
+
+func TestHTTPClientIPFamilyFallback(t *testing.T) {
+	// Set simple body-writing test handler
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte("hello world!"))
+	})
+
+	// Start the test server, this
+	// only listens on IPv4 loopback.
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	// Address via hostname, so the dialer resolves it.
+	url := strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)
+
+	for _, cfg := range []httpclient.Config{
+		{PreferIPFamily: "ipv6", FallbackDelay: 50 * time.Millisecond},
+		{PreferIPFamily: "ipv6"},
+		{PreferIPFamily: "ipv6", IPv4OnlyDomains: []string{"localhost"}},
+	} {
+		cfg.AllowRanges = []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+			netip.MustParsePrefix("::1/128"),
+		}
+
+		// Prepare client and request.
+		client := httpclient.New(cfg)
+		req, _ := http.NewRequest("GET", url, nil)
+
+		// Any IPv6 dials should fail, falling
+		// back to (or only dialing) IPv4 address.
+		rsp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("error performing client request with %+v: %v", cfg, err)
+		}
+		_ = rsp.Body.Close()
+	}
+}
@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package httpclient
+
+import (
+	"net/http/httptrace"
+	"sync/atomic"
+)
+
+// conns counts connections obtained for
+// outgoing requests by any Client{}. These
+// are deliberately not kept per host, as the
+// number of remote hosts is unbounded.
+var conns struct {
+	new    atomic.Int64
+	reused atomic.Int64
+}
+
+// ConnStats contains counts of connections obtained
+// for outgoing requests, by whether an idle connection
+// from the pool was reused or a new one dialed.
+type ConnStats struct {
+	New    int64 // newly dialed connections
+	Reused int64 // reused idle connections
+}
+
+// Conns returns the number of connections obtained
+// for outgoing requests since startup, across all
+// clients within this process.
+func Conns() ConnStats {
+	return ConnStats{
+		New:    conns.new.Load(),
+		Reused: conns.reused.Load(),
+	}
+}
+
+// connTrace is a client trace
+// that counts connections obtained.
+var connTrace = &httptrace.ClientTrace{
+	GotConn: func(info httptrace.GotConnInfo) {
+		if info.Reused {
+			conns.reused.Add(1)
+		} else {
+			conns.new.Add(1)
+		}
+	},
+}
@@ -0,0 +1,262 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package httpclient
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// dialer wraps a net.Dialer{} to first resolve
+// hostnames using our own (caching) Resolver{},
+// before dialing the resolved addresses. Where
+// addresses of both IP families are available,
+// these are raced as per RFC 8305 (happy eyeballs).
+type dialer struct {
+	net.Dialer
+	resolver *Resolver
+
+	// preferred IP family to dial
+	// first, "ipv4", "ipv6" or "".
+	prefer string
+
+	// delay before racing fallback
+	// family, <= 0 disables racing.
+	fallback time.Duration
+
+	// domains for which only
+	// IPv4 addrs should be dialed.
+	ipv4Only []string
+}
+
+// DialContext implements the http.Transport{}.DialContext function signature.
+func (d *dialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := netip.ParseAddr(host); err == nil {
+		// Already an IP address,
+		// no resolving necessary.
+		return d.Dialer.DialContext(ctx, network, address)
+	}
+
+	// Look up IP addresses for host.
+	addrs, err := d.resolver.LookupNetIP(ctx, host)
+	if err != nil {
+		return nil, &net.OpError{Op: "dial", Net: network, Err: err}
+	}
+
+	// Drop any addresses not
+	// to be dialed for host.
+	addrs = d.filter(network, host, addrs)
+	if len(addrs) == 0 {
+		return nil, &net.OpError{Op: "dial", Net: network, Err: &net.DNSError{
+			Err:        "no suitable address found",
+			Name:       host,
+			IsNotFound: true,
+		}}
+	}
+
+	// Split addresses by family.
+	primaries, fallbacks := d.partition(addrs)
+
+	if len(fallbacks) == 0 || d.fallback <= 0 {
+		// Only a single family, or racing disabled,
+		// simply dial each of the addresses in turn.
+		addrs = append(primaries, fallbacks...)
+		return d.dialSerial(ctx, network, port, addrs)
+	}
+
+	return d.dialParallel(ctx, network, port, primaries, fallbacks)
+}
+
+// filter drops addresses not suitable for network, or
+// excluded by any per-domain overrides for given host.
+func (d *dialer) filter(network, host string, addrs []netip.Addr) []netip.Addr {
+	ipv4Only := (network == "tcp4")
+	ipv6Only := (network == "tcp6")
+
+	if !ipv4Only {
+		for _, domain := range d.ipv4Only {
+			if dns.IsSubDomain(domain, host) {
+				ipv4Only = true
+				break
+			}
+		}
+	}
+
+	if !ipv4Only && !ipv6Only {
+		return addrs
+	}
+
+	filtered := make([]netip.Addr, 0, len(addrs))
+	for _, addr := range addrs {
+		if (ipv4Only && addr.Is4()) ||
+			(ipv6Only && addr.Is6()) {
+			filtered = append(filtered, addr)
+		}
+	}
+
+	return filtered
+}
+
+// partition splits addrs into primaries of the preferred IP
+// family, and fallbacks of the other. With no preference set
+// the family of the first (resolver sorted) address is used.
+func (d *dialer) partition(addrs []netip.Addr) (primaries, fallbacks []netip.Addr) {
+	var primary func(netip.Addr) bool
+
+	switch {
+	case d.prefer == "ipv4":
+		primary = netip.Addr.Is4
+	case d.prefer == "ipv6":
+		primary = netip.Addr.Is6
+	case addrs[0].Is4():
+		primary = netip.Addr.Is4
+	default:
+		primary = netip.Addr.Is6
+	}
+
+	for _, addr := range addrs {
+		if primary(addr) {
+			primaries = append(primaries, addr)
+		} else {
+			fallbacks = append(fallbacks, addr)
+		}
+	}
+
+	if len(primaries) == 0 {
+		// Only addresses of non-preferred
+		// family, use these as primaries.
+		primaries, fallbacks = fallbacks, nil
+	}
+
+	return
+}
+
+// dialSerial dials each of addrs in turn, returning
+// the first successful connection, or first error.
+func (d *dialer) dialSerial(ctx context.Context, network, port string, addrs []netip.Addr) (net.Conn, error) {
+	var firstErr error
+
+	for _, addr := range addrs {
+		addr := net.JoinHostPort(addr.String(), port)
+		conn, err := d.Dialer.DialContext(ctx, network, addr)
+		if err == nil {
+			return conn, nil
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+
+		if ctx.Err() != nil {
+			// Context cancelled
+			// or deadline reached.
+			break
+		}
+	}
+
+	return nil, firstErr
+}
+
+// dialParallel races dialing primaries against fallbacks, the
+// latter being started after a short delay, or as soon as all
+// primaries fail. The first established connection is returned.
+func (d *dialer) dialParallel(ctx context.Context, network, port string, primaries, fallbacks []netip.Addr) (net.Conn, error) {
+	type result struct {
+		conn    net.Conn
+		err     error
+		primary bool
+		done    bool
+	}
+
+	results := make(chan result)
+	returned := make(chan struct{})
+	defer close(returned)
+
+	racer := func(ctx context.Context, primary bool) {
+		addrs := primaries
+		if !primary {
+			addrs = fallbacks
+		}
+
+		conn, err := d.dialSerial(ctx, network, port, addrs)
+
+		select {
+		case results <- result{
+			conn:    conn,
+			err:     err,
+			primary: primary,
+			done:    true,
+		}:
+		case <-returned:
+			if conn != nil {
+				// Lost the race.
+				_ = conn.Close()
+			}
+		}
+	}
+
+	var primary, fallback result
+
+	// Start the primary racer.
+	pctx, pcncl := context.WithCancel(ctx)
+	defer pcncl()
+	go racer(pctx, true)
+
+	// Start timer for the fallback racer.
+	timer := time.NewTimer(d.fallback)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			fctx, fcncl := context.WithCancel(ctx)
+			defer fcncl()
+			go racer(fctx, false)
+
+		case res := <-results:
+			if res.err == nil {
+				return res.conn, nil
+			}
+
+			if res.primary {
+				primary = res
+			} else {
+				fallback = res
+			}
+
+			if primary.done && fallback.done {
+				return nil, primary.err
+			}
+
+			if res.primary && timer.Stop() {
+				// Primaries failed before the fallback
+				// delay expired, start fallback now.
+				timer.Reset(0)
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package httpclient
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync/atomic"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"codeberg.org/gruf/go-cache/v3"
+	errorsv2 "codeberg.org/gruf/go-errors/v2"
+)
+
+// lookups counts host lookups made by
+// any Resolver{}, by lookup result.
+var lookups struct {
+	cached   atomic.Int64
+	resolved atomic.Int64
+	failed   atomic.Int64
+	duration atomic.Int64
+}
+
+// DNSStats contains counts of host lookups
+// made by Resolver{}, and time spent on them.
+type DNSStats struct {
+	Cached   int64         // lookups served from cache (incl. cached failures)
+	Resolved int64         // lookups successfully resolved by system resolver
+	Failed   int64         // lookups that failed at system resolver
+	Duration time.Duration // total time spent in system resolver lookups
+}
+
+// DNSLookups returns the number of host lookups
+// made by Resolver{} since startup, across all
+// clients within this process.
+func DNSLookups() DNSStats {
+	return DNSStats{
+		Cached:   lookups.cached.Load(),
+		Resolved: lookups.resolved.Load(),
+		Failed:   lookups.failed.Load(),
+		Duration: time.Duration(lookups.duration.Load()),
+	}
+}
+
+// Resolver wraps the system net.Resolver{} to cache results of
+// host lookups, including lookups of hosts that don't exist
+// (negative caching), to reduce load on (and latency from)
+// slow local resolvers when federating with many hosts.
+//
+// Note that Go's resolver does not expose record TTLs, so
+// the configured TTLs act as a clamp on how long any one
+// result is trusted for, regardless of the record TTL.
+type Resolver struct {
+	resolver net.Resolver
+	cache    cache.TTLCache[string, lookup]
+	ttl      time.Duration
+	negTTL   time.Duration
+}
+
+// lookup is a cached host lookup result.
+type lookup struct {
+	addrs   []netip.Addr
+	err     error
+	expires time.Time
+}
+
+// NewResolver returns a new Resolver{} caching successful
+// lookups for ttl, and lookups of non-existent hosts for
+// negTTL. A ttl of zero or less disables caching of either.
+func NewResolver(ttl, negTTL time.Duration) *Resolver {
+	r := &Resolver{ttl: ttl, negTTL: negTTL}
+	r.cache = cache.NewTTL[string, lookup](0, 1000, 0)
+
+	if ttl > 0 {
+		// Entry expiries are checked on fetch, so this
+		// just needs to be long enough to evict either.
+		r.cache.SetTTL(max(ttl, negTTL), false)
+		if !r.cache.Start(time.Minute) {
+			log.Panic(nil, "failed to start resolver cache")
+		}
+	}
+
+	return r
+}
+
+// LookupNetIP looks up host, returning its IP addresses either
+// from cache, or from the system resolver. Returned errors for
+// non-existent hosts will be of type *net.DNSError{}.
+func (r *Resolver) LookupNetIP(ctx context.Context, host string) ([]netip.Addr, error) {
+	if r.ttl > 0 {
+		// Check for an unexpired cached result.
+		l, ok := r.cache.Get(host)
+		if ok && time.Now().Before(l.expires) {
+			lookups.cached.Add(1)
+			return l.addrs, l.err
+		}
+	}
+
+	// Perform lookup using system resolver.
+	before := time.Now()
+	addrs, err := r.resolver.LookupNetIP(ctx, "ip", host)
+	lookups.duration.Add(int64(time.Since(before)))
+
+	if err != nil {
+		lookups.failed.Add(1)
+
+		if dnserr := errorsv2.AsV2[*net.DNSError](err); //
+		r.ttl > 0 && r.negTTL > 0 && dnserr != nil && dnserr.IsNotFound {
+			// Host does not exist, cache this
+			// response. Other errors are likely
+			// temporary so we don't cache them.
+			r.cache.Set(host, lookup{
+				err:     err,
+				expires: time.Now().Add(r.negTTL),
+			})
+		}
+
+		return nil, err
+	}
+
+	lookups.resolved.Add(1)
+
+	for i := range addrs {
+		// Ensure IPv4 addresses
+		// are in their 4 byte form.
+		addrs[i] = addrs[i].Unmap()
+	}
+
+	if r.ttl > 0 {
+		// Cache successful lookup.
+		r.cache.Set(host, lookup{
+			addrs:   addrs,
+			expires: time.Now().Add(r.ttl),
+		})
+	}
+
+	return addrs, nil
+}
+
+// LookupTXT looks up the DNS TXT records of name using the system
+// resolver. Unlike host lookups, these are never cached, as they're
+// only used for one-off checks, eg., verifying control of a domain,
+// where a stale (negative) result would only get in the way.
+func (r *Resolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	before := time.Now()
+	txts, err := r.resolver.LookupTXT(ctx, name)
+	lookups.duration.Add(int64(time.Since(before)))
+
+	if err != nil {
+		lookups.failed.Add(1)
+		return nil, err
+	}
+
+	lookups.resolved.Add(1)
+	return txts, nil
+}
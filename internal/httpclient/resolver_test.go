@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package httpclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+func TestResolverCache(t *testing.T) {
+	r := httpclient.NewResolver(time.Minute, time.Minute)
+	ctx := context.Background()
+	before := httpclient.DNSLookups()
+
+	// First lookup should go to system resolver.
+	addrs1, err := r.LookupNetIP(ctx, "localhost")
+	if err != nil {
+		t.Fatalf("error looking up localhost: %v", err)
+	}
+
+	// Second lookup should be served from cache.
+	addrs2, err := r.LookupNetIP(ctx, "localhost")
+	if err != nil {
+		t.Fatalf("error looking up localhost: %v", err)
+	}
+
+	if len(addrs1) == 0 || len(addrs1) != len(addrs2) {
+		t.Fatalf("unexpected lookup results: %v %v", addrs1, addrs2)
+	}
+
+	after := httpclient.DNSLookups()
+	if after.Resolved-before.Resolved != 1 {
+		t.Errorf("expected 1 resolved lookup, got %d", after.Resolved-before.Resolved)
+	}
+	if after.Cached-before.Cached != 1 {
+		t.Errorf("expected 1 cached lookup, got %d", after.Cached-before.Cached)
+	}
+}
+
+func TestResolverNoCache(t *testing.T) {
+	r := httpclient.NewResolver(0, 0)
+	ctx := context.Background()
+	before := httpclient.DNSLookups()
+
+	for i := 0; i < 2; i++ {
+		if _, err := r.LookupNetIP(ctx, "localhost"); err != nil {
+			t.Fatalf("error looking up localhost: %v", err)
+		}
+	}
+
+	after := httpclient.DNSLookups()
+	if after.Resolved-before.Resolved != 2 {
+		t.Errorf("expected 2 resolved lookups, got %d", after.Resolved-before.Resolved)
+	}
+	if after.Cached != before.Cached {
+		t.Errorf("expected no cached lookups, got %d", after.Cached-before.Cached)
+	}
+}
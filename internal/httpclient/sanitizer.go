@@ -19,6 +19,7 @@
 
 import (
 	"net/netip"
+	"sync/atomic"
 	"syscall"
 )
 
@@ -43,7 +44,7 @@
 		netip.MustParsePrefix("10.0.0.0/8"),      // Private
 		netip.MustParsePrefix("100.64.0.0/10"),   // RFC6598
 		netip.MustParsePrefix("127.0.0.0/8"),     // Loopback
-		netip.MustParsePrefix("169.254.0.0/16"),  // Link-local
+		netip.MustParsePrefix("169.254.0.0/16"),  // Link-local (includes cloud metadata endpoints)
 		netip.MustParsePrefix("172.16.0.0/12"),   // Private
 		netip.MustParsePrefix("192.0.0.0/24"),    // RFC6890
 		netip.MustParsePrefix("192.0.2.0/24"),    // Test, doc, examples
@@ -60,6 +61,39 @@
 	}
 )
 
+// blocked counts dials refused by
+// any Sanitizer{}, by refusal reason.
+var blocked struct {
+	network  atomic.Int64
+	blocked  atomic.Int64
+	reserved atomic.Int64
+}
+
+// DialStats contains counts of outgoing
+// dials refused by Sanitizer{}, by reason.
+type DialStats struct {
+	Network  int64 // dials over a non-TCP network
+	Blocked  int64 // dials to explicitly blocked IPs
+	Reserved int64 // dials to reserved / non-public IPs
+}
+
+// BlockedDials returns the number of outgoing
+// dials refused by Sanitizer{} since startup,
+// across all clients within this process.
+func BlockedDials() DialStats {
+	return DialStats{
+		Network:  blocked.network.Load(),
+		Blocked:  blocked.blocked.Load(),
+		Reserved: blocked.reserved.Load(),
+	}
+}
+
+// Sanitizer protects against server side request forgery
+// (SSRF) by refusing dials to reserved and non-public IP
+// ranges, as well as any configured ranges to block. Allowed
+// ranges take precedence over both of these. As it checks the
+// IP actually being dialed, it also covers any redirects and
+// DNS responses that change between checks (DNS rebinding).
 type Sanitizer struct {
 	Allow []netip.Prefix
 	Block []netip.Prefix
@@ -80,28 +114,36 @@ func (s *Sanitizer) Sanitize(ntwrk, addr string, _ syscall.RawConn) error {
 	)
 
 	if !(ntwrk == tcp4 || ntwrk == tcp6) {
+		blocked.network.Add(1)
 		return ErrInvalidNetwork
 	}
 
-	// Separate the IP.
+	// Separate the IP, and its IPv4 form
+	// if it's an IPv4-mapped IPv6 address,
+	// so configured IPv4 ranges apply to it.
 	ip := ipport.Addr()
+	ip4 := ip.Unmap()
 
 	// Check if this IP is explicitly allowed.
 	for i := 0; i < len(s.Allow); i++ {
-		if s.Allow[i].Contains(ip) {
+		if s.Allow[i].Contains(ip) ||
+			s.Allow[i].Contains(ip4) {
 			return nil
 		}
 	}
 
 	// Check if this IP is explicitly blocked.
 	for i := 0; i < len(s.Block); i++ {
-		if s.Block[i].Contains(ip) {
+		if s.Block[i].Contains(ip) ||
+			s.Block[i].Contains(ip4) {
+			blocked.blocked.Add(1)
 			return ErrReservedAddr
 		}
 	}
 
 	// Validate this is a safe IP.
 	if !SafeIP(ip) {
+		blocked.reserved.Add(1)
 		return ErrReservedAddr
 	}
 
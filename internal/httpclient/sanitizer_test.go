@@ -133,6 +133,12 @@ func TestSanitizer(t *testing.T) {
 			addr:     "93.184.216.34:80",
 			expected: httpclient.ErrReservedAddr, // We blocked this explicitly.
 		},
+		{
+			name:     "IPv4 over UDP",
+			ntwrk:    "udp4",
+			addr:     "192.0.0.8:53",
+			expected: httpclient.ErrInvalidNetwork,
+		},
 		// IPv6 tests
 		{
 			name:     "IPv4-mapped address",
@@ -140,6 +146,24 @@ func TestSanitizer(t *testing.T) {
 			addr:     "[::ffff:169.254.169.254]:80",
 			expected: nil, // We allowed this explicitly.
 		},
+		{
+			name:     "IPv4-mapped dummy address",
+			ntwrk:    "tcp6",
+			addr:     "[::ffff:192.0.0.8]:80",
+			expected: nil, // We allowed the IPv4 form explicitly.
+		},
+		{
+			name:     "IPv4-mapped example.org",
+			ntwrk:    "tcp6",
+			addr:     "[::ffff:93.184.216.34]:80",
+			expected: httpclient.ErrReservedAddr, // We blocked the IPv4 form explicitly.
+		},
+		{
+			name:     "IPv4-mapped private address",
+			ntwrk:    "tcp6",
+			addr:     "[::ffff:10.0.0.1]:80",
+			expected: httpclient.ErrReservedAddr,
+		},
 	}
 
 	for _, tc := range tests {
@@ -152,3 +176,31 @@ func TestSanitizer(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockedDials(t *testing.T) {
+	s := httpclient.Sanitizer{
+		Block: []netip.Prefix{
+			netip.MustParsePrefix("93.184.216.34/32"), // example.org
+		},
+	}
+
+	before := httpclient.BlockedDials()
+
+	_ = s.Sanitize("udp4", "93.184.216.34:53", nil)
+	_ = s.Sanitize("tcp4", "93.184.216.34:443", nil)
+	_ = s.Sanitize("tcp4", "127.0.0.1:443", nil)
+	_ = s.Sanitize("tcp6", "[::1]:443", nil)
+	_ = s.Sanitize("tcp4", "1.1.1.1:443", nil)
+
+	after := httpclient.BlockedDials()
+
+	if n := after.Network - before.Network; n != 1 {
+		t.Errorf("Expected 1 dial blocked by network, got: %d", n)
+	}
+	if n := after.Blocked - before.Blocked; n != 1 {
+		t.Errorf("Expected 1 dial blocked by block list, got: %d", n)
+	}
+	if n := after.Reserved - before.Reserved; n != 2 {
+		t.Errorf("Expected 2 dials blocked by reserved range, got: %d", n)
+	}
+}
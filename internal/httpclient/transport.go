@@ -19,6 +19,7 @@
 
 import (
 	"net/http"
+	"net/http/httptrace"
 	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
@@ -50,6 +51,11 @@ func (t *signingtransport) RoundTrip(r *http.Request) (*http.Response, error) {
 		}
 	}
 
+	// Track connection reuse.
+	ctx := httptrace.WithClientTrace(r.Context(),
+		connTrace,
+	)
+
 	// Pass to underlying transport.
-	return t.Transport.RoundTrip(r)
+	return t.Transport.RoundTrip(r.WithContext(ctx))
 }
@@ -0,0 +1,255 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package integrity implements creating and verifying FEP-8b32
+// object integrity proofs on ActivityPub documents, using the
+// eddsa-jcs-2022 Data Integrity cryptosuite. These allow an
+// activity to be validated when it's forwarded (eg. relayed)
+// by another server, without needing to refetch it from origin.
+//
+// See: https://codeberg.org/fediverse/fep/src/branch/main/fep/8b32/fep-8b32.md
+package integrity
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+)
+
+const (
+	// ContextURI is the JSON-LD context for Data Integrity proofs.
+	ContextURI = "https://w3id.org/security/data-integrity/v1"
+
+	// MultikeyContextURI is the JSON-LD context for Multikey public keys.
+	MultikeyContextURI = "https://w3id.org/security/multikey/v1"
+
+	// KeyFragment is the URI fragment appended
+	// to an actor's URI to form the ID of the
+	// key used for integrity proofs by the actor.
+	KeyFragment = "#ed25519-key"
+
+	proofType    = "DataIntegrityProof"
+	cryptosuite  = "eddsa-jcs-2022"
+	proofPurpose = "assertionMethod"
+)
+
+// KeyID returns the ID of the integrity
+// proof verification key of given actor.
+func KeyID(actorURI string) string {
+	return actorURI + KeyFragment
+}
+
+// Sign returns a copy of doc with an added integrity proof, signed
+// with key identified by keyID. Any existing proof is replaced. Note
+// that doc itself is not modified, though nested values are shared.
+func Sign(doc map[string]any, key ed25519.PrivateKey, keyID string) (map[string]any, error) {
+	signed := maps.Clone(doc)
+	delete(signed, "proof")
+
+	if ctx, ok := signed["@context"]; ok {
+		// Ensure proof context included.
+		signed["@context"] = addContext(ctx, ContextURI)
+	}
+
+	proof := map[string]any{
+		"type":               proofType,
+		"cryptosuite":        cryptosuite,
+		"verificationMethod": keyID,
+		"proofPurpose":       proofPurpose,
+		"created":            time.Now().UTC().Format(time.RFC3339),
+	}
+
+	hash, err := hashData(signed, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := ed25519.Sign(key, hash)
+	proof["proofValue"] = "z" + base58Encode(sig)
+	signed["proof"] = proof
+
+	return signed, nil
+}
+
+// Verify verifies the integrity proof on doc
+// using the given Ed25519 public key. Use
+// Method() to find the ID of the key required.
+func Verify(doc map[string]any, pub ed25519.PublicKey) error {
+	proof := getProof(doc)
+	if proof == nil {
+		return errors.New("no supported proof found")
+	}
+
+	if proof["proofPurpose"] != proofPurpose {
+		return errors.New("unsupported proof purpose")
+	}
+
+	value, _ := proof["proofValue"].(string)
+	sig, err := decodeMultibase(value)
+	if err != nil {
+		return err
+	}
+
+	unsecured := maps.Clone(doc)
+	delete(unsecured, "proof")
+
+	hash, err := hashData(unsecured, proof)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(pub, hash, sig) {
+		return errors.New("invalid proof signature")
+	}
+
+	return nil
+}
+
+// Method returns the verificationMethod, i.e. the
+// key ID, of the supported integrity proof on doc.
+func Method(doc map[string]any) (string, bool) {
+	proof := getProof(doc)
+	if proof == nil {
+		return "", false
+	}
+	method, ok := proof["verificationMethod"].(string)
+	return method, ok && method != ""
+}
+
+// AddAssertionMethod adds the given Ed25519 public
+// key to an actor's serialized AP representation, as
+// a Multikey assertion method, for use in verifying
+// integrity proofs made by the actor.
+func AddAssertionMethod(doc map[string]any, actorURI string, pub ed25519.PublicKey) {
+	doc["@context"] = addContext(doc["@context"], MultikeyContextURI)
+	doc["assertionMethod"] = []any{
+		map[string]any{
+			"id":                 KeyID(actorURI),
+			"type":               "Multikey",
+			"controller":         actorURI,
+			"publicKeyMultibase": EncodeMultikey(pub),
+		},
+	}
+}
+
+// ActorKey returns the Ed25519 public key with given key
+// ID from the assertion methods in actor's serialized AP
+// representation, checking the key is controlled by actor.
+func ActorKey(doc map[string]any, keyID string) (ed25519.PublicKey, error) {
+	var methods []any
+
+	switch v := doc["assertionMethod"].(type) {
+	case []any:
+		methods = v
+	case map[string]any:
+		methods = []any{v}
+	}
+
+	for _, m := range methods {
+		method, _ := m.(map[string]any)
+		if method == nil ||
+			method["id"] != keyID ||
+			method["type"] != "Multikey" {
+			continue
+		}
+
+		if method["controller"] != doc["id"] {
+			return nil, errors.New("key not controlled by actor")
+		}
+
+		multikey, _ := method["publicKeyMultibase"].(string)
+		return DecodeMultikey(multikey)
+	}
+
+	return nil, errors.New("key not found in actor assertion methods")
+}
+
+// hashData returns the data to be signed (or verified) for
+// the given unsecured doc and proof, as per eddsa-jcs-2022.
+func hashData(doc map[string]any, proof map[string]any) ([]byte, error) {
+	config := maps.Clone(proof)
+	delete(config, "proofValue")
+
+	if ctx, ok := doc["@context"]; ok {
+		config["@context"] = ctx
+	}
+
+	cconfig, err := canonicalize(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cdoc, err := canonicalize(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	h1 := sha256.Sum256(cconfig)
+	h2 := sha256.Sum256(cdoc)
+	return append(h1[:], h2[:]...), nil
+}
+
+// getProof returns the first proof on doc
+// with type and cryptosuite we support.
+func getProof(doc map[string]any) map[string]any {
+	var proofs []any
+
+	switch v := doc["proof"].(type) {
+	case []any:
+		proofs = v
+	case map[string]any:
+		proofs = []any{v}
+	}
+
+	for _, p := range proofs {
+		proof, _ := p.(map[string]any)
+		if proof != nil &&
+			proof["type"] == proofType &&
+			proof["cryptosuite"] == cryptosuite {
+			return proof
+		}
+	}
+
+	return nil
+}
+
+// addContext returns the JSON-LD context
+// value ctx with given context URI added.
+func addContext(ctx any, uri string) any {
+	switch v := ctx.(type) {
+	case nil:
+		return uri
+
+	case string:
+		if v == uri {
+			return v
+		}
+		return []any{v, uri}
+
+	case []any:
+		if slices.Contains(v, any(uri)) {
+			return v
+		}
+		return append(slices.Clone(v), uri)
+
+	default:
+		return []any{v, uri}
+	}
+}
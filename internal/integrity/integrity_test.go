@@ -0,0 +1,159 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package integrity_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
+	"github.com/stretchr/testify/suite"
+)
+
+type IntegrityTestSuite struct {
+	suite.Suite
+}
+
+const testActivity = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/someone/statuses/01J/activity",
+  "type": "Create",
+  "actor": "https://example.org/users/someone",
+  "to": ["https://www.w3.org/ns/activitystreams#Public"],
+  "object": {
+    "id": "https://example.org/users/someone/statuses/01J",
+    "type": "Note",
+    "attributedTo": "https://example.org/users/someone",
+    "content": "<p>hello \u2028 world \"quoted\" 🦥</p>",
+    "sensitive": false,
+    "replies": 1e21,
+    "likes": 0.0000001
+  }
+}`
+
+func (suite *IntegrityTestSuite) rsaKey() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.NoError(err)
+	return key
+}
+
+func (suite *IntegrityTestSuite) doc() map[string]any {
+	var doc map[string]any
+	suite.NoError(json.Unmarshal([]byte(testActivity), &doc))
+	return doc
+}
+
+func (suite *IntegrityTestSuite) TestSignVerify() {
+	key := integrity.DeriveKey(suite.rsaKey())
+	keyID := integrity.KeyID("https://example.org/users/someone")
+	doc := suite.doc()
+
+	signed, err := integrity.Sign(doc, key, keyID)
+	suite.NoError(err)
+
+	// Original doc should be untouched.
+	suite.NotContains(doc, "proof")
+	suite.Equal("https://www.w3.org/ns/activitystreams", doc["@context"])
+
+	// Signed doc should have proof context.
+	suite.Equal([]any{
+		"https://www.w3.org/ns/activitystreams",
+		integrity.ContextURI,
+	}, signed["@context"])
+
+	// Round trip signed doc through JSON, as if sent remotely.
+	b, err := json.Marshal(signed)
+	suite.NoError(err)
+	var received map[string]any
+	suite.NoError(json.Unmarshal(b, &received))
+
+	method, ok := integrity.Method(received)
+	suite.True(ok)
+	suite.Equal(keyID, method)
+
+	pub := key.Public().(ed25519.PublicKey)
+	suite.NoError(integrity.Verify(received, pub))
+
+	// Tampering with the object should fail verification.
+	received["object"].(map[string]any)["content"] = "<p>goodbye world</p>"
+	suite.Error(integrity.Verify(received, pub))
+}
+
+func (suite *IntegrityTestSuite) TestVerifyWrongKey() {
+	key := integrity.DeriveKey(suite.rsaKey())
+	other := integrity.DeriveKey(suite.rsaKey())
+
+	signed, err := integrity.Sign(suite.doc(), key, "https://example.org/users/someone#ed25519-key")
+	suite.NoError(err)
+
+	suite.Error(integrity.Verify(signed, other.Public().(ed25519.PublicKey)))
+}
+
+func (suite *IntegrityTestSuite) TestDeriveKeyStable() {
+	rsaKey := suite.rsaKey()
+	suite.Equal(integrity.DeriveKey(rsaKey), integrity.DeriveKey(rsaKey))
+}
+
+func (suite *IntegrityTestSuite) TestMultikey() {
+	key := integrity.DeriveKey(suite.rsaKey())
+	pub := key.Public().(ed25519.PublicKey)
+
+	multikey := integrity.EncodeMultikey(pub)
+	suite.Regexp(`^z6Mk`, multikey)
+
+	decoded, err := integrity.DecodeMultikey(multikey)
+	suite.NoError(err)
+	suite.Equal(pub, decoded)
+
+	// Known test vector from the W3C Controlled Identifiers spec.
+	_, err = integrity.DecodeMultikey("z6MkmM42vxfqZQsv4ehtTjFFxQ4sQKS2w6WR7emozFAn5cxu")
+	suite.NoError(err)
+
+	_, err = integrity.DecodeMultikey("uNotBase58")
+	suite.Error(err)
+}
+
+func (suite *IntegrityTestSuite) TestActorKey() {
+	const actorURI = "https://example.org/users/someone"
+	key := integrity.DeriveKey(suite.rsaKey())
+	pub := key.Public().(ed25519.PublicKey)
+
+	actor := map[string]any{
+		"@context": []any{"https://www.w3.org/ns/activitystreams"},
+		"id":       actorURI,
+		"type":     "Person",
+	}
+	integrity.AddAssertionMethod(actor, actorURI, pub)
+	suite.Contains(actor["@context"], integrity.MultikeyContextURI)
+
+	found, err := integrity.ActorKey(actor, integrity.KeyID(actorURI))
+	suite.NoError(err)
+	suite.Equal(pub, found)
+
+	// Key controlled by someone else should be rejected.
+	actor["id"] = "https://example.org/users/someone_else"
+	_, err = integrity.ActorKey(actor, integrity.KeyID(actorURI))
+	suite.Error(err)
+}
+
+func TestIntegrityTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrityTestSuite))
+}
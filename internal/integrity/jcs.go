@@ -0,0 +1,176 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package integrity
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// canonicalize returns the JSON Canonicalization Scheme (RFC 8785)
+// serialization of v. The value is first round-tripped through
+// encoding/json, so that any JSON serializable type is supported,
+// and numbers are treated as IEEE 754 doubles as the RFC requires.
+func canonicalize(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic any
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := appendCanonical(&buf, generic); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// appendCanonical appends canonical serialization of
+// generic JSON value v (as decoded by encoding/json).
+func appendCanonical(buf *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("null")
+
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+
+	case float64:
+		s, err := formatNumber(v)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+
+	case string:
+		appendString(buf, v)
+
+	case []any:
+		buf.WriteByte('[')
+		for i, elem := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := appendCanonical(buf, elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+
+	case map[string]any:
+		// Property names are sorted by
+		// their UTF-16 code units.
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.SortFunc(keys, func(a, b string) int {
+			return slices.Compare(
+				utf16.Encode([]rune(a)),
+				utf16.Encode([]rune(b)),
+			)
+		})
+
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			appendString(buf, key)
+			buf.WriteByte(':')
+			if err := appendCanonical(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+
+	default:
+		return errors.New("unexpected json value type")
+	}
+
+	return nil
+}
+
+// appendString appends JSON string serialization of s, only
+// escaping those characters which RFC 8785 requires escaping.
+func appendString(buf *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hex[r>>4])
+				buf.WriteByte(hex[r&0xf])
+				continue
+			}
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// formatNumber formats f as per ECMAScript's Number.prototype.toString(),
+// which is what RFC 8785 mandates for serializing JSON numbers.
+func formatNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", errors.New("invalid json number")
+	}
+
+	if f == 0 {
+		// Also covers -0.
+		return "0", nil
+	}
+
+	if abs := math.Abs(f); abs >= 1e-6 && abs < 1e21 {
+		// Within range of plain decimal notation.
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	}
+
+	// Go pads exponent to 2 digits, ECMAScript does not.
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	mant, exp, _ := strings.Cut(s, "e")
+	sign, digits := exp[:1], strings.TrimLeft(exp[1:], "0")
+	return mant + "e" + sign + digits, nil
+}
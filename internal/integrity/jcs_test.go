@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package integrity
+
+import (
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	for _, test := range []struct {
+		in  any
+		out string
+	}{
+		// Examples from RFC 8785.
+		{
+			in: map[string]any{
+				"numbers":  []any{333333333.33333329, 1e30, 4.50, 2e-3, 0.000000000000000000000000001},
+				"string":   "\u20ac$\u000f\nA'B\"\\\\\"/",
+				"literals": []any{nil, true, false},
+			},
+			out: `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		},
+		{
+			in: map[string]any{
+				"\u20ac":     "Euro Sign",
+				"\r":         "Carriage Return",
+				"\ufb33":     "Hebrew Letter Dalet With Dagesh",
+				"1":          "One",
+				"\U0001f600": "Emoji: Grinning Face",
+				"\u0080":     "Control",
+				"\u00f6":     "Latin Small Letter O With Diaeresis",
+			},
+			out: "{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin Small Letter O With Diaeresis\",\"\u20ac\":\"Euro Sign\",\"\U0001f600\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}",
+		},
+		{
+			in:  []any{0.0, -0.0, 1e21, 1e-7, 1e-6, 100, -5.5},
+			out: `[0,0,1e+21,1e-7,0.000001,100,-5.5]`,
+		},
+	} {
+		b, err := canonicalize(test.in)
+		if err != nil {
+			t.Fatalf("error canonicalizing %v: %v", test.in, err)
+		}
+		if string(b) != test.out {
+			t.Errorf("unexpected canonical json: expect=%s actual=%s", test.out, b)
+		}
+	}
+}
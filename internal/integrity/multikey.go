@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package integrity
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// ed25519Codec is the multicodec prefix
+// for an Ed25519 public key (varint 0xed).
+var ed25519Codec = []byte{0xed, 0x01}
+
+// DeriveKey derives an account's Ed25519 signing key
+// from its RSA private key. This allows accounts to sign
+// integrity proofs without needing to generate and store
+// a second private key, as the derived key is stable for
+// as long as the account keeps the same RSA private key.
+func DeriveKey(rsaKey *rsa.PrivateKey) ed25519.PrivateKey {
+	h := sha256.New()
+	h.Write([]byte("gotosocial-integrity-proof-key:"))
+	h.Write(x509.MarshalPKCS1PrivateKey(rsaKey))
+	return ed25519.NewKeyFromSeed(h.Sum(nil))
+}
+
+// EncodeMultikey returns the publicKeyMultibase
+// encoding of the given Ed25519 public key.
+func EncodeMultikey(pub ed25519.PublicKey) string {
+	b := make([]byte, 0, len(ed25519Codec)+len(pub))
+	b = append(b, ed25519Codec...)
+	b = append(b, pub...)
+	return "z" + base58Encode(b)
+}
+
+// DecodeMultikey parses the publicKeyMultibase
+// encoding of an Ed25519 public key.
+func DecodeMultikey(s string) (ed25519.PublicKey, error) {
+	b, err := decodeMultibase(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) != len(ed25519Codec)+ed25519.PublicKeySize ||
+		b[0] != ed25519Codec[0] || b[1] != ed25519Codec[1] {
+		return nil, errors.New("not an ed25519 multikey")
+	}
+
+	return ed25519.PublicKey(b[len(ed25519Codec):]), nil
+}
+
+// decodeMultibase decodes a base58btc
+// multibase string, i.e. prefixed by 'z'.
+func decodeMultibase(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "z") {
+		return nil, errors.New("unsupported multibase encoding")
+	}
+	return base58Decode(s[1:])
+}
+
+// base58Alphabet is the bitcoin base58 alphabet,
+// as used by the base58btc multibase encoding.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58Encode returns base58btc encoding of b.
+func base58Encode(b []byte) string {
+	var (
+		n    = new(big.Int).SetBytes(b)
+		mod  = new(big.Int)
+		base = big.NewInt(58)
+		out  []byte
+	)
+
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+
+	// Leading zero bytes are
+	// each encoded as a '1'.
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+
+	// Reverse into big-endian order.
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return string(out)
+}
+
+// base58Decode decodes base58btc encoded s.
+func base58Decode(s string) ([]byte, error) {
+	var (
+		n    = new(big.Int)
+		base = big.NewInt(58)
+	)
+
+	for _, c := range []byte(s) {
+		i := strings.IndexByte(base58Alphabet, c)
+		if i < 0 {
+			return nil, errors.New("invalid base58 character")
+		}
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(i)))
+	}
+
+	// Leading '1's are each
+	// decoded as a zero byte.
+	var zeros int
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
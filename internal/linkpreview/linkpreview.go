@@ -0,0 +1,381 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/federation/dereferencing"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+const (
+	// fetchTimeout is the max time to wait for
+	// a linked page (and its oEmbed) to load.
+	fetchTimeout = 10 * time.Second
+
+	// maxPageSize is the max number of bytes
+	// of a linked page read looking for metadata.
+	maxPageSize = 1 << 20 // 1MiB
+
+	// maxOEmbedSize is the max number of
+	// bytes of an oEmbed response read.
+	maxOEmbedSize = 64 << 10 // 64KiB
+)
+
+// Fetcher generates link preview
+// cards for the links in statuses.
+type Fetcher struct {
+	state     *state.State
+	transport transport.Controller
+	deref     *dereferencing.Dereferencer
+}
+
+// New returns a new Fetcher, fetching pages with the given
+// transport controller, and their preview images with the
+// given dereferencer, so they're stored and served locally.
+func New(
+	state *state.State,
+	transport transport.Controller,
+	deref *dereferencing.Dereferencer,
+) *Fetcher {
+	return &Fetcher{
+		state:     state,
+		transport: transport,
+		deref:     deref,
+	}
+}
+
+// SetStatusCard sets the link preview card of the given status
+// to a card for the first link in its content, fetching the
+// linked page if there's no (recent) card for it yet, and
+// updates the status in the database if its card changed.
+//
+// No card is set if the status has PreviewLinks disabled,
+// is a boost, or has no suitable link. Nothing is done at
+// all if link previews are disabled on this instance, or
+// for remote statuses unless enabled for those too.
+func (f *Fetcher) SetStatusCard(ctx context.Context, status *gtsmodel.Status) error {
+	if !config.GetStatusesLinkPreviews() {
+		return nil
+	}
+
+	if !status.IsLocal() && !config.GetStatusesLinkPreviewsRemote() {
+		// Don't have every incoming
+		// status make us fetch its links.
+		return nil
+	}
+
+	var card *gtsmodel.Card
+
+	if status.BoostOfID == "" &&
+		util.PtrOrValue(status.PreviewLinks, true) {
+		link := FirstLink(status.Content)
+		if link != nil && fetchable(link) {
+			var err error
+			card, err = f.getCard(ctx, link)
+			if err != nil {
+				// Not fatal, status just
+				// won't get a card for now.
+				log.Debugf(ctx, "error getting card for %s: %v", link, err)
+			}
+		}
+	}
+
+	var cardID string
+	if card != nil {
+		cardID = card.ID
+	}
+
+	if cardID == status.CardID {
+		// Nothing changed.
+		status.Card = card
+		return nil
+	}
+
+	status.CardID = cardID
+	status.Card = card
+	if err := f.state.DB.UpdateStatus(ctx, status, "card_id"); err != nil {
+		return gtserror.Newf("error updating status card: %w", err)
+	}
+
+	return nil
+}
+
+// getCard returns the card for the given link from the database,
+// fetching the linked page if not stored (or stored a while ago).
+func (f *Fetcher) getCard(ctx context.Context, link *url.URL) (*gtsmodel.Card, error) {
+	card, err := f.state.DB.GetCardByURL(ctx, link.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting card: %w", err)
+	}
+
+	if card != nil && time.Since(card.UpdatedAt) < config.GetStatusesLinkPreviewsTTL() {
+		// Recent enough.
+		return card, nil
+	}
+
+	fetched, err := f.fetchCard(ctx, link)
+	if err != nil {
+		if card != nil {
+			// Fall back to the
+			// card we already had.
+			log.Debugf(ctx, "error refreshing card for %s: %v", link, err)
+			return card, nil
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	fetched.CreatedAt = now
+	fetched.UpdatedAt = now
+
+	// Store a local copy of the preview
+	// image, reusing any we already have.
+	f.setCardImage(ctx, fetched, card)
+
+	if card != nil {
+		// Update the existing card
+		// with the refreshed data.
+		fetched.ID = card.ID
+		fetched.CreatedAt = card.CreatedAt
+		if err := f.state.DB.UpdateCard(ctx, fetched); err != nil {
+			return nil, gtserror.Newf("db error updating card: %w", err)
+		}
+		return fetched, nil
+	}
+
+	fetched.ID = id.NewULID()
+	if err := f.state.DB.PutCard(ctx, fetched); err != nil {
+		if !errors.Is(err, db.ErrAlreadyExists) {
+			return nil, gtserror.Newf("db error putting card: %w", err)
+		}
+
+		// Stored concurrently
+		// for another status.
+		return f.state.DB.GetCardByURL(ctx, link.String())
+	}
+
+	return fetched, nil
+}
+
+// setCardImage sets the image attachment of the given (freshly
+// fetched) card to a locally stored copy of its preview image,
+// reusing the attachment of the previous version of the card,
+// if any, when the image is unchanged. On failure, the card
+// is just left without an image.
+func (f *Fetcher) setCardImage(ctx context.Context, card *gtsmodel.Card, prev *gtsmodel.Card) {
+	if card.Image == "" {
+		// Nothing to do.
+		return
+	}
+
+	if prev != nil &&
+		prev.Image == card.Image &&
+		prev.ImageAttachmentID != "" {
+		// Image unchanged, keep
+		// the copy we already have.
+		card.ImageAttachmentID = prev.ImageAttachmentID
+		card.ImageAttachment = prev.ImageAttachment
+		return
+	}
+
+	image, err := url.Parse(card.Image)
+	if err != nil || !fetchable(image) {
+		log.Debugf(ctx, "not fetching card image %s", card.Image)
+		return
+	}
+
+	blocked, err := f.state.DB.IsURIBlocked(ctx, image)
+	if err != nil {
+		log.Errorf(ctx, "db error checking domain block: %v", err)
+		return
+	}
+
+	if blocked {
+		log.Debugf(ctx, "card image domain %s is blocked", image.Host)
+		return
+	}
+
+	// Card images are shared by
+	// all statuses linking the page,
+	// so they're owned by the instance.
+	instanceAcct, err := f.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		log.Errorf(ctx, "db error getting instance account: %v", err)
+		return
+	}
+
+	attachment, err := f.deref.GetMedia(ctx,
+		"", // instance transport
+		instanceAcct.ID,
+		card.Image,
+		media.AdditionalMediaInfo{
+			RemoteURL:   &card.Image,
+			Description: &card.Title,
+		},
+		false, // async
+	)
+	if err != nil {
+		if attachment == nil {
+			log.Debugf(ctx, "error fetching card image %s: %v", card.Image, err)
+			return
+		}
+
+		// Non-fatal error during loading, can still use it.
+		log.Debugf(ctx, "partially loaded card image: %v", err)
+	}
+
+	card.ImageAttachmentID = attachment.ID
+	card.ImageAttachment = attachment
+}
+
+// fetchCard fetches the page at the given link, and
+// returns a card for it, if any, filled in from the
+// page's oEmbed if it advertises one.
+func (f *Fetcher) fetchCard(ctx context.Context, link *url.URL) (*gtsmodel.Card, error) {
+	tsport, err := f.transport.NewTransportForUsername(ctx, "")
+	if err != nil {
+		return nil, gtserror.Newf("error getting transport: %w", err)
+	}
+
+	// Cards are nice to have, so
+	// don't wait around for them.
+	ctx, cncl := context.WithTimeout(ctx, fetchTimeout)
+	defer cncl()
+	ctx = gtscontext.SetFastFail(ctx)
+
+	rsp, err := f.get(ctx, tsport, link, "text/html")
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	ct, _, _ := mime.ParseMediaType(rsp.Header.Get("Content-Type"))
+	if ct != "text/html" && ct != "application/xhtml+xml" {
+		return nil, fmt.Errorf("unexpected content type %q", ct)
+	}
+
+	card, oembed := ParseCard(rsp.Body, link, maxPageSize)
+
+	if oembed != nil && fetchable(oembed) {
+		if err := f.fetchOEmbed(ctx, tsport, oembed, card); err != nil {
+			// Not fatal, just use
+			// the page metadata.
+			log.Debugf(ctx, "error fetching oembed %s: %v", oembed, err)
+		}
+	}
+
+	if card.Title == "" {
+		return nil, errors.New("no title found")
+	}
+
+	return card, nil
+}
+
+// fetchOEmbed fetches the oEmbed at the given
+// link, and fills in the given card from it.
+func (f *Fetcher) fetchOEmbed(
+	ctx context.Context,
+	tsport transport.Transport,
+	link *url.URL,
+	card *gtsmodel.Card,
+) error {
+	rsp, err := f.get(ctx, tsport, link, "application/json")
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	return ApplyOEmbed(io.LimitReader(rsp.Body, maxOEmbedSize), card)
+}
+
+// get performs a GET request for the given link with
+// given accept header, checking the link's domain isn't
+// blocked first, and that the response status is 200 OK.
+func (f *Fetcher) get(
+	ctx context.Context,
+	tsport transport.Transport,
+	link *url.URL,
+	accept string,
+) (*http.Response, error) {
+	blocked, err := f.state.DB.IsURIBlocked(ctx, link)
+	if err != nil {
+		return nil, gtserror.Newf("db error checking domain block: %w", err)
+	}
+
+	if blocked {
+		return nil, fmt.Errorf("domain %s is blocked", link.Host)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link.String(), nil)
+	if err != nil {
+		return nil, gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", accept)
+
+	rsp, err := tsport.GET(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		_ = rsp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d", rsp.StatusCode)
+	}
+
+	return rsp, nil
+}
+
+// fetchable returns whether the given link may be fetched
+// for a card: a link on a standard port, without credentials,
+// to a page that's not on this instance. Schemes and dialed
+// IP addresses are checked by the http client for all fetches.
+func fetchable(link *url.URL) bool {
+	if link.User != nil {
+		return false
+	}
+
+	switch link.Port() {
+	case "", "80", "443":
+	default:
+		return false
+	}
+
+	host := link.Hostname()
+	return host != "" &&
+		host != config.GetHost() &&
+		host != config.GetAccountDomain()
+}
@@ -0,0 +1,214 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package linkpreview
+
+import (
+	"encoding/json"
+	"html"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// oEmbed contains the fields of an
+// oEmbed response used to fill in a card.
+//
+// See https://oembed.com/#section2.3
+type oEmbed struct {
+	Type            string       `json:"type"`
+	Title           string       `json:"title"`
+	AuthorName      string       `json:"author_name"`
+	AuthorURL       string       `json:"author_url"`
+	ProviderName    string       `json:"provider_name"`
+	ProviderURL     string       `json:"provider_url"`
+	URL             string       `json:"url"`
+	HTML            string       `json:"html"`
+	Width           oEmbedLength `json:"width"`
+	Height          oEmbedLength `json:"height"`
+	ThumbnailURL    string       `json:"thumbnail_url"`
+	ThumbnailWidth  oEmbedLength `json:"thumbnail_width"`
+	ThumbnailHeight oEmbedLength `json:"thumbnail_height"`
+}
+
+// oEmbedLength is an oEmbed width or height, which
+// some providers give as a string instead of a number.
+type oEmbedLength int
+
+func (l *oEmbedLength) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	// Invalid or relative
+	// (eg., "100%") is 0.
+	*l = oEmbedLength(dimension(s))
+	return nil
+}
+
+// ApplyOEmbed fills in the given card from the JSON
+// oEmbed response read from r, with oEmbed values
+// taking precedence over those from page metadata.
+//
+// Photo oEmbeds make a photo card. Video and rich
+// oEmbeds make video and rich cards, but only when
+// their HTML is a single iframe from an https URL,
+// which is rebuilt from scratch to embed safely.
+func ApplyOEmbed(r io.Reader, card *gtsmodel.Card) error {
+	var oe oEmbed
+	if err := json.NewDecoder(r).Decode(&oe); err != nil {
+		return err
+	}
+
+	link, err := url.Parse(card.URL)
+	if err != nil {
+		return err
+	}
+
+	if title := first(oe.Title); title != "" {
+		card.Title = truncate(title, maxTitleLen)
+	}
+
+	if name := first(oe.AuthorName); name != "" {
+		card.AuthorName = name
+	}
+
+	if authorURL := httpURL(link, oe.AuthorURL); authorURL != "" {
+		card.AuthorURL = authorURL
+	}
+
+	if name := first(oe.ProviderName); name != "" {
+		card.ProviderName = name
+	}
+
+	if providerURL := httpURL(link, oe.ProviderURL); providerURL != "" {
+		card.ProviderURL = providerURL
+	}
+
+	if thumbnail := httpURL(link, oe.ThumbnailURL); thumbnail != "" {
+		card.Image = thumbnail
+		card.Width = int(oe.ThumbnailWidth)
+		card.Height = int(oe.ThumbnailHeight)
+	}
+
+	switch oe.Type {
+	case "photo":
+		photo := httpURL(link, oe.URL)
+		if photo == "" {
+			break
+		}
+
+		card.Type = gtsmodel.CardTypePhoto
+		card.EmbedURL = photo
+		card.Width = int(oe.Width)
+		card.Height = int(oe.Height)
+
+	case "video", "rich":
+		embed := sanitizeEmbed(oe.HTML, int(oe.Width), int(oe.Height))
+		if embed == "" {
+			break
+		}
+
+		card.Type = gtsmodel.CardTypeRich
+		if oe.Type == "video" {
+			card.Type = gtsmodel.CardTypeVideo
+		}
+		card.HTML = embed
+		card.Width = int(oe.Width)
+		card.Height = int(oe.Height)
+	}
+
+	return nil
+}
+
+// sanitizeEmbed returns a new iframe embedding the https
+// src of the only iframe in the given oEmbed HTML, with
+// the given dimensions, or an empty string if the HTML
+// contains no iframe, or anything but the iframe.
+func sanitizeEmbed(embedHTML string, width, height int) string {
+	var src string
+
+	z := xhtml.NewTokenizer(strings.NewReader(embedHTML))
+	for {
+		tt := z.Next()
+		if tt == xhtml.ErrorToken {
+			// EOF or
+			// malformed.
+			break
+		}
+
+		switch tt {
+		case xhtml.TextToken:
+			if strings.TrimSpace(string(z.Text())) != "" {
+				// Text outside iframe.
+				return ""
+			}
+			continue
+
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken, xhtml.EndTagToken:
+			name, _ := z.TagName()
+			if atom.Lookup(name) != atom.Iframe {
+				// Not an iframe.
+				return ""
+			}
+
+			if tt == xhtml.EndTagToken {
+				continue
+			}
+
+			if src != "" {
+				// Multiple iframes.
+				return ""
+			}
+
+			src = tagAttrs(z)["src"]
+			if src == "" {
+				return ""
+			}
+
+		default:
+			// Comments, doctypes.
+			return ""
+		}
+	}
+
+	srcURL, err := url.Parse(src)
+	if err != nil || srcURL.Scheme != "https" || srcURL.Host == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(`<iframe src="`)
+	b.WriteString(html.EscapeString(srcURL.String()))
+	b.WriteString(`"`)
+	if width > 0 {
+		b.WriteString(` width="` + strconv.Itoa(width) + `"`)
+	}
+	if height > 0 {
+		b.WriteString(` height="` + strconv.Itoa(height) + `"`)
+	}
+	b.WriteString(` frameborder="0" allowfullscreen="true"`)
+	b.WriteString(` sandbox="allow-scripts allow-same-origin allow-popups allow-popups-to-escape-sandbox allow-forms"`)
+	b.WriteString(`></iframe>`)
+	return b.String()
+}
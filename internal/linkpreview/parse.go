@@ -0,0 +1,238 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package linkpreview
+
+import (
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// maxTitleLen and maxDescriptionLen
+	// are the max lengths in characters
+	// of the title and description of a
+	// card, longer ones being truncated.
+	maxTitleLen       = 256
+	maxDescriptionLen = 1024
+)
+
+// FirstLink returns the first http(s) link in the given
+// status content HTML, not counting mentions or hashtags.
+func FirstLink(content string) *url.URL {
+	z := html.NewTokenizer(strings.NewReader(content))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// EOF or
+			// malformed.
+			return nil
+
+		case html.StartTagToken:
+			name, hasAttr := z.TagName()
+			if !hasAttr || atom.Lookup(name) != atom.A {
+				continue
+			}
+
+			attrs := tagAttrs(z)
+			if slices.Contains(strings.Fields(attrs["class"]), "mention") ||
+				slices.Contains(strings.Fields(attrs["rel"]), "tag") {
+				// Mention or hashtag.
+				continue
+			}
+
+			link, err := url.Parse(attrs["href"])
+			if err != nil ||
+				(link.Scheme != "http" && link.Scheme != "https") ||
+				link.Host == "" {
+				continue
+			}
+
+			return link
+		}
+	}
+}
+
+// ParseCard parses a card from the metadata in the <head> of the
+// HTML page read from r, found at the given link, reading at most
+// max bytes. Open Graph metadata is preferred where present. The
+// card's title is empty if the page has none. It also returns the
+// link to the page's JSON oEmbed, if the page advertises one.
+func ParseCard(r io.Reader, link *url.URL, max int64) (*gtsmodel.Card, *url.URL) {
+	var (
+		meta   = make(map[string]string)
+		title  string
+		oembed *url.URL
+	)
+
+	z := html.NewTokenizer(io.LimitReader(r, max))
+
+loop:
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// EOF or
+			// malformed.
+			break loop
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+
+			switch atom.Lookup(name) {
+			case atom.Body:
+				// Metadata is only
+				// found in the head.
+				break loop
+
+			case atom.Title:
+				if title == "" && z.Next() == html.TextToken {
+					title = string(z.Text())
+				}
+
+			case atom.Link:
+				if !hasAttr || oembed != nil {
+					continue
+				}
+
+				// Look for oEmbed discovery link, see:
+				// https://oembed.com/#section4
+				attrs := tagAttrs(z)
+				if attrs["type"] != "application/json+oembed" ||
+					!slices.Contains(strings.Fields(attrs["rel"]), "alternate") {
+					continue
+				}
+
+				if href, err := link.Parse(attrs["href"]); err == nil {
+					oembed = href
+				}
+
+			case atom.Meta:
+				if !hasAttr {
+					continue
+				}
+
+				// Open Graph uses "property",
+				// everything else uses "name".
+				attrs := tagAttrs(z)
+				key := attrs["property"]
+				if key == "" {
+					key = attrs["name"]
+				}
+				key = strings.ToLower(key)
+
+				if _, ok := meta[key]; !ok && key != "" {
+					meta[key] = attrs["content"]
+				}
+			}
+		}
+	}
+
+	card := &gtsmodel.Card{
+		URL:          link.String(),
+		Type:         gtsmodel.CardTypeLink,
+		Title:        first(meta["og:title"], meta["twitter:title"], title),
+		Description:  first(meta["og:description"], meta["twitter:description"], meta["description"]),
+		AuthorName:   meta["author"],
+		ProviderName: meta["og:site_name"],
+		ProviderURL:  link.Scheme + "://" + link.Host,
+	}
+
+	card.Title = truncate(card.Title, maxTitleLen)
+	card.Description = truncate(card.Description, maxDescriptionLen)
+
+	// Resolve any image relative to the page,
+	// only allowing plain http(s) image links.
+	image := first(meta["og:image"], meta["og:image:url"], meta["twitter:image"])
+	if imageURL := httpURL(link, image); imageURL != "" {
+		card.Image = imageURL
+		card.Width = dimension(meta["og:image:width"])
+		card.Height = dimension(meta["og:image:height"])
+	}
+
+	return card, oembed
+}
+
+// tagAttrs returns the attributes of the
+// current tag token of z, keeping only the
+// first value of any repeated attribute.
+func tagAttrs(z *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for more := true; more; {
+		var k, v []byte
+		k, v, more = z.TagAttr()
+		if _, ok := attrs[string(k)]; !ok {
+			attrs[string(k)] = string(v)
+		}
+	}
+	return attrs
+}
+
+// httpURL resolves the given reference relative to
+// the given base link, returning it if it's a valid
+// http(s) URL, or an empty string otherwise.
+func httpURL(base *url.URL, ref string) string {
+	if ref == "" {
+		return ""
+	}
+
+	u, err := base.Parse(ref)
+	if err != nil ||
+		(u.Scheme != "http" && u.Scheme != "https") ||
+		u.Host == "" {
+		return ""
+	}
+
+	return u.String()
+}
+
+// first returns the first non-empty value,
+// with its whitespace collapsed and trimmed.
+func first(values ...string) string {
+	for _, v := range values {
+		if v = strings.Join(strings.Fields(v), " "); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// truncate truncates s to max characters,
+// adding an ellipsis if it was truncated.
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
+
+// dimension parses the given image width or
+// height, returning 0 if not a positive int.
+func dimension(s string) int {
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || i < 0 {
+		return 0
+	}
+	return i
+}
@@ -0,0 +1,206 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package linkpreview_test
+
+import (
+	"strings"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/linkpreview"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type ParseTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ParseTestSuite) TestFirstLink() {
+	for _, testCase := range []struct {
+		content string
+		expect  string
+	}{
+		{
+			content: `<p>hi <span class="h-card"><a href="https://example.org/@someone" class="u-url mention">@<span>someone</span></a></span> look at <a href="https://example.org/some/page" rel="nofollow noreferrer noopener" target="_blank">https://example.org/some/page</a></p>`,
+			expect:  "https://example.org/some/page",
+		},
+		{
+			content: `<p><a href="https://example.org/tags/cats" class="mention hashtag" rel="tag">#<span>cats</span></a> <a href="mailto:someone@example.org">mail me</a></p>`,
+			expect:  "",
+		},
+		{
+			content: `<p>no links here</p>`,
+			expect:  "",
+		},
+	} {
+		link := linkpreview.FirstLink(testCase.content)
+		if testCase.expect == "" {
+			suite.Nil(link)
+			continue
+		}
+		if suite.NotNil(link) {
+			suite.Equal(testCase.expect, link.String())
+		}
+	}
+}
+
+func (suite *ParseTestSuite) TestParseCardOpenGraph() {
+	page := `<!DOCTYPE html>
+<html>
+<head>
+	<title>Fallback title</title>
+	<meta property="og:title" content="  Some   Page &amp; Things ">
+	<meta property="og:description" content="A description of the page.">
+	<meta property="og:site_name" content="Example">
+	<meta property="og:image" content="/images/preview.png">
+	<meta property="og:image:width" content="640">
+	<meta property="og:image:height" content="480">
+	<meta name="author" content="Someone">
+</head>
+<body>
+	<meta property="og:title" content="Not this one">
+</body>
+</html>`
+
+	link := testrig.URLMustParse("https://example.org/some/page")
+	card, oembed := linkpreview.ParseCard(strings.NewReader(page), link, 1<<20)
+	suite.Nil(oembed)
+
+	suite.Equal("https://example.org/some/page", card.URL)
+	suite.Equal(gtsmodel.CardTypeLink, card.Type)
+	suite.Equal("Some Page & Things", card.Title)
+	suite.Equal("A description of the page.", card.Description)
+	suite.Equal("Example", card.ProviderName)
+	suite.Equal("https://example.org", card.ProviderURL)
+	suite.Equal("Someone", card.AuthorName)
+	suite.Equal("https://example.org/images/preview.png", card.Image)
+	suite.Equal(640, card.Width)
+	suite.Equal(480, card.Height)
+}
+
+func (suite *ParseTestSuite) TestParseCardTitleOnly() {
+	page := `<html><head><title>Just a title</title><meta name="description" content="Plain description"></head><body></body></html>`
+
+	link := testrig.URLMustParse("https://example.org/")
+	card, _ := linkpreview.ParseCard(strings.NewReader(page), link, 1<<20)
+
+	suite.Equal("Just a title", card.Title)
+	suite.Equal("Plain description", card.Description)
+	suite.Empty(card.Image)
+}
+
+func (suite *ParseTestSuite) TestParseCardNoTitle() {
+	page := `<html><head><meta name="description" content="No title"></head><body><title>Too late</title></body></html>`
+
+	link := testrig.URLMustParse("https://example.org/")
+	card, _ := linkpreview.ParseCard(strings.NewReader(page), link, 1<<20)
+	suite.Empty(card.Title)
+}
+
+func (suite *ParseTestSuite) TestParseCardOEmbedLink() {
+	page := `<html><head>
+	<title>A video</title>
+	<link rel="alternate" type="application/json+oembed" href="/oembed?url=https%3A%2F%2Fvideos.example.org%2Fw%2F1" title="A video">
+	<link rel="alternate" type="text/xml+oembed" href="/oembed.xml">
+</head><body></body></html>`
+
+	link := testrig.URLMustParse("https://videos.example.org/w/1")
+	_, oembed := linkpreview.ParseCard(strings.NewReader(page), link, 1<<20)
+	if suite.NotNil(oembed) {
+		suite.Equal("https://videos.example.org/oembed?url=https%3A%2F%2Fvideos.example.org%2Fw%2F1", oembed.String())
+	}
+}
+
+func (suite *ParseTestSuite) TestApplyOEmbedVideo() {
+	const oembed = `{
+	"type": "video",
+	"version": "1.0",
+	"title": "A video",
+	"author_name": "someone",
+	"author_url": "https://videos.example.org/a/someone",
+	"provider_name": "Example Videos",
+	"provider_url": "https://videos.example.org",
+	"html": "<iframe width=\"560\" height=\"315\" src=\"https://videos.example.org/videos/embed/1\" onload=\"alert(1)\"></iframe>",
+	"width": 560,
+	"height": "315",
+	"thumbnail_url": "https://videos.example.org/thumbnails/1.jpg",
+	"thumbnail_width": 480,
+	"thumbnail_height": 270
+}`
+
+	card := &gtsmodel.Card{
+		URL:   "https://videos.example.org/w/1",
+		Type:  gtsmodel.CardTypeLink,
+		Title: "Page title",
+	}
+	suite.NoError(linkpreview.ApplyOEmbed(strings.NewReader(oembed), card))
+
+	suite.Equal(gtsmodel.CardTypeVideo, card.Type)
+	suite.Equal("A video", card.Title)
+	suite.Equal("someone", card.AuthorName)
+	suite.Equal("https://videos.example.org/a/someone", card.AuthorURL)
+	suite.Equal("Example Videos", card.ProviderName)
+	suite.Equal("https://videos.example.org/thumbnails/1.jpg", card.Image)
+	suite.Equal(560, card.Width)
+	suite.Equal(315, card.Height)
+	suite.Equal(`<iframe src="https://videos.example.org/videos/embed/1" width="560" height="315" frameborder="0" allowfullscreen="true" sandbox="allow-scripts allow-same-origin allow-popups allow-popups-to-escape-sandbox allow-forms"></iframe>`, card.HTML)
+}
+
+func (suite *ParseTestSuite) TestApplyOEmbedUnsafeHTML() {
+	for _, embedHTML := range []string{
+		`<script src=\"https://example.org/embed.js\"></script>`,
+		`<iframe src=\"http://example.org/embed\"></iframe>`,
+		`<iframe src=\"https://example.org/embed\"></iframe><script>alert(1)</script>`,
+		`<blockquote>hi</blockquote><iframe src=\"https://example.org/embed\"></iframe>`,
+	} {
+		card := &gtsmodel.Card{
+			URL:   "https://example.org/some/page",
+			Type:  gtsmodel.CardTypeLink,
+			Title: "Page title",
+		}
+
+		oembed := `{"type": "rich", "html": "` + embedHTML + `"}`
+		suite.NoError(linkpreview.ApplyOEmbed(strings.NewReader(oembed), card))
+
+		// Stays a plain link.
+		suite.Equal(gtsmodel.CardTypeLink, card.Type)
+		suite.Empty(card.HTML)
+	}
+}
+
+func (suite *ParseTestSuite) TestApplyOEmbedPhoto() {
+	const oembed = `{"type": "photo", "url": "https://example.org/photos/1.png", "width": 1024, "height": 768}`
+
+	card := &gtsmodel.Card{
+		URL:   "https://example.org/photos/1",
+		Type:  gtsmodel.CardTypeLink,
+		Title: "A photo",
+	}
+	suite.NoError(linkpreview.ApplyOEmbed(strings.NewReader(oembed), card))
+
+	suite.Equal(gtsmodel.CardTypePhoto, card.Type)
+	suite.Equal("A photo", card.Title)
+	suite.Equal("https://example.org/photos/1.png", card.EmbedURL)
+	suite.Equal(1024, card.Width)
+	suite.Equal(768, card.Height)
+}
+
+func TestParseTestSuite(t *testing.T) {
+	suite.Run(t, &ParseTestSuite{})
+}
@@ -67,6 +67,43 @@ func ffmpegClearMetadata(ctx context.Context, outpath, inpath string) error {
 	)
 }
 
+// ffmpegBakeOrientation re-encodes input image with given
+// orientation filter applied, dropping all metadata except
+// the color profile, which gets passed through as frame data.
+func ffmpegBakeOrientation(ctx context.Context, outpath, inpath string, filter string) error {
+	return ffmpeg(ctx, inpath, outpath,
+
+		// Only log errors.
+		"-loglevel", "error",
+
+		// Don't apply any rotation
+		// ourselves, we do it below.
+		"-noautorotate",
+
+		// Input file path.
+		"-i", inpath,
+
+		// Apply orientation.
+		"-filter:v", filter,
+
+		// Drop all metadata.
+		"-map_metadata", "-1",
+
+		// Highest quality, only
+		// used by jpeg encoder.
+		"-qscale:v", "1",
+
+		// Only one frame.
+		"-frames:v", "1",
+
+		// Overwrite.
+		"-y",
+
+		// Output.
+		outpath,
+	)
+}
+
 // ffmpegGenerateWebpThumb generates a thumbnail webp from input media of any type, useful for any media.
 func ffmpegGenerateWebpThumb(ctx context.Context, inpath, outpath string, width, height int, pixfmt string) error {
 	return ffmpeg(ctx, inpath, outpath,
@@ -107,6 +144,44 @@ func ffmpegGenerateWebpThumb(ctx context.Context, inpath, outpath string, width,
 	)
 }
 
+// ffmpegGenerateVariant converts a static input image to given variant format (webp), at original dimensions.
+//
+// NOTE: avif would be nice to have here too, but the libaom encoder setjmp()s far more
+// often than our WASM setjmp / longjmp support can take snapshots, so fails on any image.
+func ffmpegGenerateVariant(ctx context.Context, inpath, outpath, format string) error {
+	var codec string
+	switch format {
+	case "webp":
+		// Encode using libwebp,
+		// at default 75% quality.
+		// (libwebp codec: https://ffmpeg.org/ffmpeg-codecs.html#Options-36)
+		codec = "libwebp"
+	default:
+		return gtserror.Newf("unsupported variant format %s", format)
+	}
+
+	return ffmpeg(ctx, inpath, outpath,
+
+		// Only log errors.
+		"-loglevel", "error",
+
+		// Input file.
+		"-i", inpath,
+
+		// Encode using codec.
+		"-codec:v", codec,
+
+		// Only one frame
+		"-frames:v", "1",
+
+		// Overwrite.
+		"-y",
+
+		// Output.
+		outpath,
+	)
+}
+
 // ffmpegGenerateStatic generates a static png from input image of any type, useful for emoji.
 func ffmpegGenerateStatic(ctx context.Context, inpath string) (string, error) {
 	var outpath string
@@ -24,6 +24,7 @@
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
@@ -83,7 +84,8 @@
 }
 
 type Manager struct {
-	state *state.State
+	state    *state.State
+	variants variants
 }
 
 // NewManager returns a media manager with given state.
@@ -150,6 +152,9 @@ func (m *Manager) CreateMedia(
 	if info.FocusY != nil {
 		attachment.FileMeta.Focus.Y = *info.FocusY
 	}
+	if info.Original != nil {
+		attachment.FileMeta.Original = *info.Original
+	}
 
 	// Store attachment in database in initial form.
 	err := m.state.DB.PutAttachment(ctx, attachment)
@@ -183,11 +188,19 @@ func (m *Manager) CacheMedia(
 		stubErr = &errWithDetails{error: err, details: details}
 	}
 
+	// Check whether to preserve color profile,
+	// preferring any value given with the media.
+	preserveColor := config.GetMediaPreserveColorProfile()
+	if info.PreserveColorProfile != nil {
+		preserveColor = *info.PreserveColorProfile
+	}
+
 	return &ProcessingMedia{
-		media:  media,
-		dataFn: data,
-		mgr:    m,
-		err:    stubErr,
+		media:         media,
+		dataFn:        data,
+		mgr:           m,
+		err:           stubErr,
+		preserveColor: preserveColor,
 	}
 }
 
@@ -22,11 +22,14 @@
 	"context"
 	"crypto/md5"
 	"fmt"
+	"image/jpeg"
 	"io"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	gtsmodel "code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
@@ -289,6 +292,12 @@ func (suite *ManagerTestSuite) TestSimpleJpegProcess() {
 	suite.Equal(22858, attachment.Thumbnail.FileSize)
 	suite.Equal("LiB|W-#6RQR.~qvzRjWF_3rqV@a$", attachment.Blurhash)
 
+	// URLs should be versioned by the processed
+	// file contents, but storage paths should not.
+	suite.Equal("http://localhost:8080/fileserver/"+accountID+"/attachment/original/56fa04afda513ea1/"+attachment.ID+".jpeg", attachment.URL)
+	suite.Equal("http://localhost:8080/fileserver/"+accountID+"/attachment/small/62999a549264bda8/"+attachment.ID+".jpeg", attachment.Thumbnail.URL)
+	suite.Equal(accountID+"/attachment/original/"+attachment.ID+".jpeg", attachment.File.Path)
+
 	// now make sure the attachment is in the database
 	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
 	suite.NoError(err)
@@ -299,6 +308,86 @@ func (suite *ManagerTestSuite) TestSimpleJpegProcess() {
 	equalFiles(suite.T(), suite.state.Storage, dbAttachment.Thumbnail.Path, "./test/test-jpeg-thumbnail.jpeg")
 }
 
+func (suite *ManagerTestSuite) TestSimpleJpegProcessPreserveColorProfile() {
+	ctx := suite.T().Context()
+
+	// Load bytes from a test image.
+	b, err := os.ReadFile("./test/test-jpeg.jpg")
+	if err != nil {
+		panic(err)
+	}
+
+	// Fake ICC color profile data.
+	icc := bytes.Repeat([]byte("icc!"), 32)
+
+	// Insert an EXIF segment with orientation 6 (rotate 90 CW),
+	// and an ICC profile segment, right after the JPEG SOI marker.
+	b = slices.Concat(b[:2],
+		jpegSegment(0xe1, []byte(
+			"Exif\x00\x00"+ // exif header
+				"MM\x00\x2a\x00\x00\x00\x08"+ // big-endian tiff header
+				"\x00\x01"+ // 1 ifd entry
+				"\x01\x12\x00\x03\x00\x00\x00\x01\x00\x06\x00\x00"+ // orientation=6
+				"\x00\x00\x00\x00", // no next ifd
+		)),
+		jpegSegment(0xe2, slices.Concat(
+			[]byte("ICC_PROFILE\x00\x01\x01"),
+			icc,
+		)),
+		b[2:],
+	)
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media, asking to preserve color profile
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		func(ctx context.Context) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(b)), nil
+		},
+		media.AdditionalMediaInfo{
+			PreserveColorProfile: util.Ptr(true),
+		},
+	)
+	suite.NoError(err)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+	suite.Zero(attachment.Error)
+
+	// Dimensions should have orientation applied.
+	suite.Equal(1080, attachment.FileMeta.Original.Width)
+	suite.Equal(1920, attachment.FileMeta.Original.Height)
+	suite.Equal(288, attachment.FileMeta.Small.Width)
+	suite.Equal(512, attachment.FileMeta.Small.Height)
+
+	// Fetch stored file data.
+	stored, err := suite.storage.Get(ctx, attachment.File.Path)
+	suite.NoError(err)
+
+	// Stored pixels should be rotated already.
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(stored))
+	suite.NoError(err)
+	suite.Equal(1080, cfg.Width)
+	suite.Equal(1920, cfg.Height)
+
+	// The ICC profile should be kept,
+	// but all EXIF data dropped.
+	suite.True(bytes.Contains(stored, icc))
+	suite.False(bytes.Contains(stored, []byte("Exif\x00\x00")))
+}
+
+// jpegSegment returns JPEG segment
+// bytes with given marker and data.
+func jpegSegment(marker byte, data []byte) []byte {
+	sz := len(data) + 2
+	return slices.Concat(
+		[]byte{0xff, marker, byte(sz >> 8), byte(sz)},
+		data,
+	)
+}
+
 func (suite *ManagerTestSuite) TestSimpleJpegProcessTooLarge() {
 	ctx := suite.T().Context()
 
@@ -348,6 +437,133 @@ func(ctx context.Context) (reader io.ReadCloser, err error) {
 	), dbAttachment.Error)
 }
 
+func (suite *ManagerTestSuite) TestSimpleJpegProcessPixelLimit() {
+	ctx := suite.T().Context()
+
+	// Test jpeg is 1920x1080, so
+	// just over 2 megapixels.
+	config.SetMediaImageMaxMegapixels(1)
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test image
+		b, err := os.ReadFile("./test/test-jpeg.jpg")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+
+	// Attachment should have type unknown and
+	// error details for the image pixel limit.
+	suite.Equal(gtsmodel.FileTypeUnknown, attachment.Type)
+	suite.Equal(gtsmodel.NewMediaErrorDetails(
+		gtsmodel.MediaErrorTypePolicy,
+		gtsmodel.MediaErrorTypePolicy_ImagePixels,
+	), attachment.Error)
+	suite.Equal("image pixel limit reached", attachment.Error.String())
+
+	// Nothing should have made it into storage.
+	suite.Empty(attachment.File.Path)
+}
+
+func (suite *ManagerTestSuite) TestMp4ProcessDurationLimit() {
+	ctx := suite.T().Context()
+
+	// Limit video duration, but allow
+	// images of any size through still.
+	config.SetMediaVideoMaxDuration(time.Second)
+	config.SetMediaImageMaxSize(1)
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test video
+		b, err := os.ReadFile("./test/longer-mp4-original.mp4")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+
+	// Attachment should have error details
+	// for the video (not image) limit.
+	suite.Equal(gtsmodel.FileTypeUnknown, attachment.Type)
+	suite.Equal(gtsmodel.NewMediaErrorDetails(
+		gtsmodel.MediaErrorTypePolicy,
+		gtsmodel.MediaErrorTypePolicy_VideoDuration,
+	), attachment.Error)
+	suite.Equal("video duration limit reached", attachment.Error.String())
+}
+
+func (suite *ManagerTestSuite) TestMp4ProcessBitrateLimit() {
+	ctx := suite.T().Context()
+
+	// Limit video bitrate to less than
+	// any real video could ever manage.
+	config.SetMediaVideoMaxBitrate(1)
+
+	data := func(_ context.Context) (io.ReadCloser, error) {
+		// load bytes from a test video
+		b, err := os.ReadFile("./test/longer-mp4-original.mp4")
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), nil
+	}
+
+	accountID := "01FS1X72SK9ZPW0J1QQ68BD264"
+
+	// process the media with no additional info provided
+	processing, err := suite.manager.CreateMedia(ctx,
+		accountID,
+		data,
+		media.AdditionalMediaInfo{},
+	)
+	suite.NoError(err)
+
+	// do a blocking call to fetch the attachment
+	attachment, err := processing.Load(ctx)
+	suite.NoError(err)
+
+	// Attachment should have error
+	// details for the bitrate limit.
+	suite.Equal(gtsmodel.FileTypeUnknown, attachment.Type)
+	suite.Equal(gtsmodel.NewMediaErrorDetails(
+		gtsmodel.MediaErrorTypePolicy,
+		gtsmodel.MediaErrorTypePolicy_VideoBitrate,
+	), attachment.Error)
+	suite.Equal("video bitrate limit reached", attachment.Error.String())
+
+	// Nothing should have made it into storage.
+	suite.Empty(attachment.File.Path)
+}
+
 func (suite *ManagerTestSuite) TestPDFProcess() {
 	ctx := suite.T().Context()
 
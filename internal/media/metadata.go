@@ -72,6 +72,67 @@ func clearMetadata(ctx context.Context, filepath string) error {
 	return nil
 }
 
+// bakeOrientation applies any given EXIF orientation directly to the
+// pixels of the image at input file path, re-encoding it in place with
+// ffmpeg such that orientation data is no longer required. The ICC color
+// profile, which ffmpeg carries between decode and encode, is retained.
+// Returns false if there was nothing to apply, or if unsupported for type.
+func bakeOrientation(ctx context.Context, filepath string, orientation int) (bool, error) {
+	var filter string
+
+	// Get ffmpeg filter(s) for orientation,
+	// returning early where none required.
+	switch orientation {
+	case orientationFlipH:
+		filter = "hflip"
+	case orientationFlipV:
+		filter = "vflip"
+	case orientationRotate90:
+		filter = "transpose=cclock"
+	case orientationRotate180:
+		filter = "hflip,vflip"
+	case orientationRotate270:
+		filter = "transpose=clock"
+	case orientationTranspose:
+		filter = "transpose=cclock_flip"
+	case orientationTransverse:
+		filter = "transpose=clock_flip"
+	default:
+		return false, nil
+	}
+
+	var ext, outpath string
+
+	// Generate baked output path MAINTAINING extension.
+	if i := strings.IndexByte(filepath, '.'); i != -1 {
+		outpath = filepath[:i] + "_baked" + filepath[i:]
+		ext = filepath[i+1:]
+	} else {
+		return false, gtserror.New("input file missing extension")
+	}
+
+	switch ext {
+	case "jpeg", "png":
+		// Only these have ffmpeg
+		// encoders that write out
+		// ICC color profile data.
+	default:
+		return false, nil
+	}
+
+	log.Debugf(ctx, "baking orientation with ffmpeg -vf %s", filter)
+	if err := ffmpegBakeOrientation(ctx, outpath, filepath, filter); err != nil {
+		return false, err
+	}
+
+	// Move the new output file path to original location.
+	if err := os.Rename(outpath, filepath); err != nil {
+		return false, gtserror.Newf("error renaming %s -> %s: %w", outpath, filepath, err)
+	}
+
+	return true, nil
+}
+
 // terminateExif cleans exif data from file at input path, into file
 // at output path, using given file extension to determine cleaning type.
 func terminateExif(outpath, inpath string, ext string) (err error) {
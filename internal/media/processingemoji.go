@@ -118,8 +118,8 @@ func (p *ProcessingEmoji) Placeholder() *gtsmodel.Emoji {
 	//
 	// This way the API caller can (in the worst case that it hasn't loaded yet) attempt to fetch the emoji,
 	// then block on ProcessingEmoji{}.Load() for the processing entry it gets from a call to the dereferencer.
-	emoji.ImageURL = uris.URIForAttachment(p.instAccID, string(TypeEmoji), string(SizeOriginal), pathID, "loading")
-	emoji.ImageStaticURL = uris.URIForAttachment(p.instAccID, string(TypeEmoji), string(SizeStatic), pathID, "png")
+	emoji.ImageURL = uris.URIForAttachment(p.instAccID, string(TypeEmoji), string(SizeOriginal), pathID, "", "loading")
+	emoji.ImageStaticURL = uris.URIForAttachment(p.instAccID, string(TypeEmoji), string(SizeStatic), pathID, "", "png")
 	return emoji
 }
 
@@ -307,7 +307,9 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 	filesz, err := p.mgr.state.Storage.PutFile(ctx,
 		p.emoji.ImagePath,
 		temppath,
-		p.emoji.ImageContentType,
+		storage.PutFileOpts{
+			ContentType: p.emoji.ImageContentType,
+		},
 	)
 	if err != nil {
 		return gtserror.Newf("error writing emoji to storage: %w", err)
@@ -317,7 +319,9 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 	staticsz, err := p.mgr.state.Storage.PutFile(ctx,
 		p.emoji.ImageStaticPath,
 		staticpath,
-		p.emoji.ImageStaticContentType,
+		storage.PutFileOpts{
+			ContentType: p.emoji.ImageStaticContentType,
+		},
 	)
 	if err != nil {
 		return gtserror.Newf("error writing static to storage: %w", err)
@@ -327,12 +331,16 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 	p.emoji.ImageFileSize = int(filesz)
 	p.emoji.ImageStaticFileSize = int(staticsz)
 
+	// Emoji URLs aren't versioned, as refreshed
+	// emojis are given a new path ID instead.
+	//
 	// Generate an emoji media static URL.
 	p.emoji.ImageURL = uris.URIForAttachment(
 		p.instAccID,
 		string(TypeEmoji),
 		string(SizeOriginal),
 		pathID,
+		"",
 		ext,
 	)
 
@@ -342,6 +350,7 @@ func (p *ProcessingEmoji) store(ctx context.Context) error {
 		string(TypeEmoji),
 		string(SizeStatic),
 		pathID,
+		"",
 		"png",
 	)
 
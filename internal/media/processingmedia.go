@@ -27,6 +27,8 @@
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/fedstats"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/storage"
@@ -59,6 +61,11 @@ type ProcessingMedia struct {
 	// db / storage during processing
 	mgr *Manager
 
+	// preserveColor indicates whether to keep
+	// image color profile, baking orientation
+	// into pixels instead of stripping it.
+	preserveColor bool
+
 	// done is set when process finishes
 	// with non ctx canceled type error
 	done bool
@@ -110,8 +117,8 @@ func (p *ProcessingMedia) Placeholder() *gtsmodel.MediaAttachment {
 	//
 	// This way the API caller can (in the worst case that it hasn't loaded yet) attempt to fetch the media,
 	// then block on ProcessingMedia{}.Load() for the processing entry it gets from a call to the dereferencer.
-	media.Thumbnail.URL = uris.URIForAttachment(media.AccountID, string(TypeAttachment), string(SizeSmall), media.ID, "loading")
-	media.URL = uris.URIForAttachment(media.AccountID, string(TypeAttachment), string(SizeOriginal), media.ID, "loading")
+	media.Thumbnail.URL = uris.URIForAttachment(media.AccountID, string(TypeAttachment), string(SizeSmall), media.ID, "", "loading")
+	media.URL = uris.URIForAttachment(media.AccountID, string(TypeAttachment), string(SizeOriginal), media.ID, "", "loading")
 	return media
 }
 
@@ -261,6 +268,25 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		))
 	}
 
+	// Get size of the drained media file,
+	// to check against per-type size limits.
+	stat, err := os.Stat(temppath)
+	if err != nil {
+		return gtserror.Newf("error statting %s: %w", temppath, err)
+	}
+
+	// Now the media type is known, check against
+	// the configured limits for this type of media.
+	if details := checkTypeLimits(p.media.Type,
+		stat.Size(),
+		width,
+		height,
+		result.duration,
+		result.bitrate,
+	); details != 0 {
+		return withDetails(nil, details)
+	}
+
 	// Add file extension to path.
 	newpath := temppath + "." + ext
 
@@ -273,9 +299,32 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	// AFTER successful.
 	temppath = newpath
 
+	// Orientation to
+	// use for thumbnail.
+	orientation := result.orientation
+
 	switch p.media.Type {
-	case gtsmodel.FileTypeImage,
-		gtsmodel.FileTypeVideo,
+	case gtsmodel.FileTypeImage:
+		if p.preserveColor {
+			// Apply orientation to image pixels where
+			// possible, so orientation data isn't needed.
+			baked, err := bakeOrientation(ctx, temppath, orientation)
+			if err != nil {
+				return gtserror.Newf("error baking orientation: %w", err)
+			}
+
+			if baked {
+				// Already applied.
+				orientation = 0
+			}
+		}
+
+		// Attempt to clean as much metadata from file as possible.
+		if err := clearMetadata(ctx, temppath); err != nil {
+			return gtserror.Newf("error cleaning metadata: %w", err)
+		}
+
+	case gtsmodel.FileTypeVideo,
 		gtsmodel.FileTypeGifv:
 		// Attempt to clean as much metadata from file as possible.
 		if err := clearMetadata(ctx, temppath); err != nil {
@@ -308,7 +357,7 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		thumbpath, mimeType, newBlurhash, err = generateThumb(ctx, temppath,
 			thumbWidth,
 			thumbHeight,
-			result.orientation,
+			orientation,
 			result.PixFmt(),
 			needBlurhash,
 		)
@@ -334,11 +383,24 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		ext,
 	)
 
+	// Version media URL by file contents, before
+	// the temporary file is moved into storage.
+	version, err := fileVersion(temppath)
+	if err != nil {
+		return gtserror.Newf("error versioning media: %w", err)
+	}
+
 	// Copy temporary file into storage at path.
 	filesz, err := p.mgr.state.Storage.PutFile(ctx,
 		p.media.File.Path,
 		temppath,
-		p.media.File.ContentType,
+		storage.PutFileOpts{
+			ContentType: p.media.File.ContentType,
+			RemoteCache: !p.media.IsLocal(),
+			Progress: func(written, total int64) {
+				log.Debugf(ctx, "uploaded %d of %d bytes of media %s", written, total, p.media.ID)
+			},
+		},
 	)
 	if err != nil {
 		return gtserror.Newf("error writing media to storage: %w", err)
@@ -360,11 +422,21 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 			thumbExt,
 		)
 
+		// Version thumbnail URL by file contents, before
+		// the thumbnail file is moved into storage.
+		thumbVersion, err := fileVersion(thumbpath)
+		if err != nil {
+			return gtserror.Newf("error versioning thumb: %w", err)
+		}
+
 		// Copy thumbnail file into storage at path.
 		thumbsz, err := p.mgr.state.Storage.PutFile(ctx,
 			p.media.Thumbnail.Path,
 			thumbpath,
-			p.media.Thumbnail.ContentType,
+			storage.PutFileOpts{
+				ContentType: p.media.Thumbnail.ContentType,
+				RemoteCache: !p.media.IsLocal(),
+			},
 		)
 		if err != nil {
 			return gtserror.Newf("error writing thumb to storage: %w", err)
@@ -379,6 +451,7 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 			string(TypeAttachment),
 			string(SizeSmall),
 			p.media.ID,
+			thumbVersion,
 			thumbExt,
 		)
 	}
@@ -389,9 +462,16 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		string(TypeAttachment),
 		string(SizeOriginal),
 		p.media.ID,
+		version,
 		ext,
 	)
 
+	if !p.media.IsLocal() {
+		// Count cached remote media towards
+		// the owning domain's federation stats.
+		p.countMediaCached(ctx)
+	}
+
 	// Success! Unset previous
 	// error details for media.
 	p.media.Error = 0
@@ -399,6 +479,22 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	return nil
 }
 
+// countMediaCached adds the stored size of this remote
+// media to the federation stats of its owner's domain.
+func (p *ProcessingMedia) countMediaCached(ctx context.Context) {
+	account, err := p.mgr.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		p.media.AccountID,
+	)
+	if err != nil {
+		log.Warnf(ctx, "error getting media owner %s: %v", p.media.AccountID, err)
+		return
+	}
+
+	size := int64(p.media.File.FileSize) + int64(p.media.Thumbnail.FileSize)
+	p.mgr.state.FedStats.MediaCached(fedstats.AccountHost(account), size)
+}
+
 // cleanup will remove any traces of processing media from storage.
 // and perform any other necessary cleanup steps after failure.
 func (p *ProcessingMedia) cleanup(ctx context.Context) {
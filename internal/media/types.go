@@ -81,9 +81,19 @@ type AdditionalMediaInfo struct {
 	// this media; defaults to 0.
 	FocusY *float32
 
+	// Original dimensions of this media as
+	// given by a remote, used until (if ever)
+	// the media is decoded; defaults to zero.
+	Original *gtsmodel.Original
+
 	// Set this if media should be rejected due to
 	// some predetermined reason, e.g. domain policy.
 	RejectReason *gtsmodel.MediaErrorDetails
+
+	// Whether to keep image ICC color profile, baking
+	// in EXIF orientation to pixels so all EXIF can be
+	// stripped; defaults to media-preserve-color-profile.
+	PreserveColorProfile *bool
 }
 
 // AdditionalEmojiInfo represents additional information
@@ -18,6 +18,8 @@
 package media
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -26,8 +28,11 @@
 	"path"
 	"runtime"
 	"syscall"
+	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"codeberg.org/gruf/go-bytesize"
 	"codeberg.org/gruf/go-iotools"
 	"codeberg.org/gruf/go-mmap"
 )
@@ -193,6 +198,103 @@ func drainToTmp(rc io.ReadCloser) (string, error) {
 	return path, nil
 }
 
+// checkTypeLimits checks media of the given (already
+// probed) type against the configured per-type limits,
+// returning policy error details for the first limit
+// exceeded, or zero details if within all limits.
+func checkTypeLimits(
+	typ gtsmodel.FileType,
+	size int64,
+	width, height int,
+	duration float64,
+	bitrate uint64,
+) gtsmodel.MediaErrorDetails {
+	var (
+		maxsz    bytesize.Size
+		maxdur   time.Duration
+		maxbr    int
+		sizeCode uint16
+		durCode  uint16
+		brCode   uint16
+	)
+
+	switch typ {
+	case gtsmodel.FileTypeImage:
+		maxsz = config.GetMediaImageMaxSize()
+		sizeCode = gtsmodel.MediaErrorTypePolicy_ImageSize
+
+		// Images are also limited by resolution.
+		maxmp := config.GetMediaImageMaxMegapixels()
+		if maxmp > 0 && int64(width)*int64(height) > int64(maxmp)*1_000_000 {
+			return gtsmodel.NewMediaErrorDetails(
+				gtsmodel.MediaErrorTypePolicy,
+				gtsmodel.MediaErrorTypePolicy_ImagePixels,
+			)
+		}
+
+	case gtsmodel.FileTypeVideo,
+		gtsmodel.FileTypeGifv:
+		maxsz = config.GetMediaVideoMaxSize()
+		maxdur = config.GetMediaVideoMaxDuration()
+		maxbr = config.GetMediaVideoMaxBitrate()
+		sizeCode = gtsmodel.MediaErrorTypePolicy_VideoSize
+		durCode = gtsmodel.MediaErrorTypePolicy_VideoDuration
+		brCode = gtsmodel.MediaErrorTypePolicy_VideoBitrate
+
+	case gtsmodel.FileTypeAudio:
+		maxsz = config.GetMediaAudioMaxSize()
+		maxdur = config.GetMediaAudioMaxDuration()
+		maxbr = config.GetMediaAudioMaxBitrate()
+		sizeCode = gtsmodel.MediaErrorTypePolicy_AudioSize
+		durCode = gtsmodel.MediaErrorTypePolicy_AudioDuration
+		brCode = gtsmodel.MediaErrorTypePolicy_AudioBitrate
+	}
+
+	if maxsz > 0 && size > int64(maxsz) { // #nosec G115 -- Already validated.
+		return gtsmodel.NewMediaErrorDetails(
+			gtsmodel.MediaErrorTypePolicy,
+			sizeCode,
+		)
+	}
+
+	if maxdur > 0 && duration > maxdur.Seconds() {
+		return gtsmodel.NewMediaErrorDetails(
+			gtsmodel.MediaErrorTypePolicy,
+			durCode,
+		)
+	}
+
+	if maxbr > 0 && bitrate > uint64(maxbr) { // #nosec G115 -- Checked > 0.
+		return gtsmodel.NewMediaErrorDetails(
+			gtsmodel.MediaErrorTypePolicy,
+			brCode,
+		)
+	}
+
+	return 0
+}
+
+// fileVersion returns a short version string for the
+// file at path, derived from a hash of its contents,
+// such that re-processed media with different bytes
+// gets a different URL (see uris.URIForAttachment()).
+func fileVersion(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	// 64 bits of hash is plenty to tell
+	// apart versions of the same media.
+	return hex.EncodeToString(hash.Sum(nil)[:8]), nil
+}
+
 // remove only removes paths if not-empty.
 func remove(paths ...string) error {
 	var errs []error
@@ -0,0 +1,287 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"sync"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"codeberg.org/gruf/go-mutexes"
+)
+
+// Variant is a copy of a stored image
+// converted to another format, to serve
+// to clients which indicate they accept it.
+type Variant struct {
+	ContentType string
+	Data        []byte
+}
+
+// variants is an in-memory cache of converted image
+// variants, bounded by total size in bytes, with the
+// least recently used variants dropped first.
+type variants struct {
+	locks   mutexes.MutexMap
+	mu      sync.Mutex
+	lru     list.List
+	entries map[string]*list.Element
+	size    int64
+}
+
+// variantEntry is one cached variant. A nil
+// variant means the image couldn't be made
+// any smaller in this format, so we remember
+// not to try converting it again.
+type variantEntry struct {
+	key     string
+	variant *Variant
+}
+
+// cost returns the number of bytes entry
+// counts for against the variant cache size.
+func (e *variantEntry) cost() int64 {
+	n := int64(len(e.key))
+	if e.variant != nil {
+		n += int64(len(e.variant.Data))
+	}
+	return n
+}
+
+// get returns the cached entry under key, if any,
+// marking it as most recently used.
+func (v *variants) get(key string) (*variantEntry, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	elem, ok := v.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	v.lru.MoveToFront(elem)
+	return elem.Value.(*variantEntry), true
+}
+
+// put caches entry, dropping least recently
+// used entries until within the given max size.
+func (v *variants) put(entry *variantEntry, max int64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.entries == nil {
+		v.entries = make(map[string]*list.Element)
+	}
+
+	if elem, ok := v.entries[entry.key]; ok {
+		// Drop any existing entry.
+		v.remove(elem)
+	}
+
+	v.entries[entry.key] = v.lru.PushFront(entry)
+	v.size += entry.cost()
+
+	for v.size > max {
+		v.remove(v.lru.Back())
+	}
+}
+
+// remove drops elem from the cache,
+// must be called with mutex held.
+func (v *variants) remove(elem *list.Element) {
+	entry := v.lru.Remove(elem).(*variantEntry)
+	delete(v.entries, entry.key)
+	v.size -= entry.cost()
+}
+
+// GetVariant returns a variant of the JPEG or PNG image with given
+// content type, in the first of the configured media variant formats
+// that is also in accept (as content types, i.e. "image/webp"). Key
+// should identify the image, and change whenever its contents do,
+// e.g. the versioned URL. The image data is only read to generate
+// variants that weren't cached yet. Returns nil if variants are
+// disabled, or none of the accepted formats ended up smaller.
+func (m *Manager) GetVariant(
+	ctx context.Context,
+	key string,
+	contentType string,
+	accept []string,
+	data DataFunc,
+) (*Variant, error) {
+	switch contentType {
+	case "image/jpeg", "image/png":
+		// Variants of these only.
+	default:
+		return nil, nil
+	}
+
+	max := int64(config.GetMediaVariantCacheSize())
+	if max <= 0 {
+		return nil, nil
+	}
+
+	// Lazily drained copy of
+	// the original image, to
+	// convert from if needed.
+	var gen variantGenerator
+	gen.data = data
+	defer gen.close(ctx)
+
+	for _, format := range config.GetMediaVariantFormats() {
+		variantType := "image/" + format
+		if !slices.Contains(accept, variantType) {
+			continue
+		}
+
+		variant, err := m.getVariant(ctx, key+"@"+format, max, func() (*Variant, error) {
+			return gen.generate(ctx, format, variantType)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if variant != nil {
+			return variant, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getVariant returns the variant cached under key,
+// else generates it once, even for concurrent callers.
+func (m *Manager) getVariant(
+	ctx context.Context,
+	key string,
+	max int64,
+	generate func() (*Variant, error),
+) (*Variant, error) {
+	if entry, ok := m.variants.get(key); ok {
+		return entry.variant, nil
+	}
+
+	// Lock on key so concurrent requests for
+	// the same variant don't each convert it.
+	unlock := m.variants.locks.Lock(key)
+	defer unlock()
+
+	// Check again, in case it
+	// was generated while we
+	// were waiting for the lock.
+	if entry, ok := m.variants.get(key); ok {
+		return entry.variant, nil
+	}
+
+	variant, err := generate()
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &variantEntry{key: key, variant: variant}
+	if entry.cost() > max {
+		// Too big to ever be cached,
+		// don't evict everything else.
+		log.Debugf(ctx, "variant %s larger than cache", key)
+		return variant, nil
+	}
+
+	m.variants.put(entry, max)
+	return variant, nil
+}
+
+// variantGenerator converts the original
+// image to variant formats, only reading
+// it from storage once if it's needed.
+type variantGenerator struct {
+	data   DataFunc
+	inpath string
+	size   int64
+}
+
+// generate converts the original image to
+// format, returning nil if it's no smaller.
+func (g *variantGenerator) generate(ctx context.Context, format, contentType string) (*Variant, error) {
+	if g.inpath == "" {
+		if err := g.load(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	outpath := g.inpath + "_variant." + format
+	defer func() {
+		if err := remove(outpath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Errorf(ctx, "error removing variant: %v", err)
+		}
+	}()
+
+	if err := ffmpegGenerateVariant(ctx, g.inpath, outpath, format); err != nil {
+		return nil, gtserror.Newf("error generating %s variant: %w", format, err)
+	}
+
+	b, err := os.ReadFile(outpath)
+	if err != nil {
+		return nil, gtserror.Newf("error reading %s variant: %w", format, err)
+	}
+
+	if int64(len(b)) >= g.size {
+		// Not worth it.
+		return nil, nil
+	}
+
+	return &Variant{
+		ContentType: contentType,
+		Data:        b,
+	}, nil
+}
+
+// load drains the original image to a tmp file.
+func (g *variantGenerator) load(ctx context.Context) error {
+	rc, err := g.data(ctx)
+	if err != nil {
+		return gtserror.Newf("error opening original: %w", err)
+	}
+
+	// Set inpath before checking error,
+	// so close() removes a partial file.
+	g.inpath, err = drainToTmp(rc)
+	if err != nil {
+		return gtserror.Newf("error draining original to tmp: %w", err)
+	}
+
+	stat, err := os.Stat(g.inpath)
+	if err != nil {
+		return gtserror.Newf("error statting original: %w", err)
+	}
+	g.size = stat.Size()
+
+	return nil
+}
+
+// close removes the tmp copy
+// of the original, if any.
+func (g *variantGenerator) close(ctx context.Context) {
+	if err := remove(g.inpath); err != nil {
+		log.Errorf(ctx, "error removing original: %v", err)
+	}
+}
@@ -0,0 +1,190 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"codeberg.org/gruf/go-bytesize"
+	"github.com/stretchr/testify/suite"
+)
+
+type VariantTestSuite struct {
+	MediaStandardTestSuite
+}
+
+func (suite *VariantTestSuite) SetupTest() {
+	suite.MediaStandardTestSuite.SetupTest()
+	config.SetMediaVariantFormats([]string{"webp"})
+}
+
+// fileData returns a data function opening the file at path,
+// which fails the test if it's called more than allowed times.
+func (suite *VariantTestSuite) fileData(path string, allowed int) media.DataFunc {
+	return func(context.Context) (io.ReadCloser, error) {
+		if allowed--; allowed < 0 {
+			suite.FailNow("data read too many times")
+		}
+		return os.Open(path)
+	}
+}
+
+func (suite *VariantTestSuite) TestJpegVariant() {
+	ctx := suite.T().Context()
+
+	stat, err := os.Stat("./test/test-jpeg.jpg")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	variant, err := suite.manager.GetVariant(ctx,
+		"test-jpeg",
+		"image/jpeg",
+		[]string{"image/webp", "image/png"},
+		suite.fileData("./test/test-jpeg.jpg", 1),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("image/webp", variant.ContentType)
+	suite.Less(int64(len(variant.Data)), stat.Size())
+
+	// Second time is served from
+	// cache, without reading data.
+	cached, err := suite.manager.GetVariant(ctx,
+		"test-jpeg",
+		"image/jpeg",
+		[]string{"image/webp"},
+		suite.fileData("./test/test-jpeg.jpg", 0),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(variant, cached)
+}
+
+func (suite *VariantTestSuite) TestPngAlphaVariant() {
+	ctx := suite.T().Context()
+
+	stat, err := os.Stat("./test/test-png-alphachannel.png")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	variant, err := suite.manager.GetVariant(ctx,
+		"test-png-alphachannel",
+		"image/png",
+		[]string{"image/webp"},
+		suite.fileData("./test/test-png-alphachannel.png", 1),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("image/webp", variant.ContentType)
+	suite.Less(int64(len(variant.Data)), stat.Size())
+}
+
+func (suite *VariantTestSuite) TestNoVariant() {
+	ctx := suite.T().Context()
+
+	for _, test := range []struct {
+		name        string
+		contentType string
+		accept      []string
+	}{
+		{
+			name:        "not accepted",
+			contentType: "image/jpeg",
+			accept:      []string{"image/avif"},
+		},
+		{
+			name:        "not jpeg or png",
+			contentType: "image/gif",
+			accept:      []string{"image/webp"},
+		},
+	} {
+		variant, err := suite.manager.GetVariant(ctx,
+			test.name,
+			test.contentType,
+			test.accept,
+			suite.fileData("./test/test-jpeg.jpg", 0),
+		)
+		suite.NoError(err, test.name)
+		suite.Nil(variant, test.name)
+	}
+
+	// Data errors are returned
+	// and not cached as "no variant".
+	_, err := suite.manager.GetVariant(ctx,
+		"test-jpeg",
+		"image/jpeg",
+		[]string{"image/webp"},
+		func(context.Context) (io.ReadCloser, error) {
+			return nil, errors.New("storage is down")
+		},
+	)
+	suite.ErrorContains(err, "storage is down")
+
+	variant, err := suite.manager.GetVariant(ctx,
+		"test-jpeg",
+		"image/jpeg",
+		[]string{"image/webp"},
+		suite.fileData("./test/test-jpeg.jpg", 1),
+	)
+	suite.NoError(err)
+	suite.Equal("image/webp", variant.ContentType)
+}
+
+func (suite *VariantTestSuite) TestVariantCacheSize() {
+	ctx := suite.T().Context()
+
+	variant, err := suite.manager.GetVariant(ctx,
+		"test-jpeg-1",
+		"image/jpeg",
+		[]string{"image/webp"},
+		suite.fileData("./test/test-jpeg.jpg", 1),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only room for one variant.
+	config.SetMediaVariantCacheSize(bytesize.Size(len(variant.Data) + 64))
+
+	for _, key := range []string{"test-jpeg-2", "test-jpeg-1"} {
+		_, err := suite.manager.GetVariant(ctx,
+			key,
+			"image/jpeg",
+			[]string{"image/webp"},
+			suite.fileData("./test/test-jpeg.jpg", 1),
+		)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+}
+
+func TestVariantTestSuite(t *testing.T) {
+	suite.Run(t, &VariantTestSuite{})
+}
@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"net/http"
+
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/gin-gonic/gin"
+)
+
+// Drain returns a gin middleware which, once the server has started
+// draining (see state.Drain{}), rejects any new incoming requests with
+// 503: Service Unavailable and asks the client to close its connection,
+// so that a load balancer or reverse proxy can retry them elsewhere.
+// Requests that were already in-flight are left to finish as normal.
+func Drain(state *state.State) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !state.Drain.Draining() {
+			// Not draining,
+			// nothing to do.
+			return
+		}
+
+		c.Header("Connection", "close")
+		c.Header("Retry-After", "30")
+		apiutil.Data(c,
+			http.StatusServiceUnavailable,
+			apiutil.AppJSON,
+			apiutil.ErrorDraining,
+		)
+		c.Abort()
+	}
+}
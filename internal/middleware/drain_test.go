@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"github.com/gin-gonic/gin"
+)
+
+func TestDrain(t *testing.T) {
+	var state state.State
+
+	// Gin test http engine
+	// with drain middleware.
+	e := gin.New()
+	e.Use(middleware.Drain(&state))
+	e.Handle("GET", "/", func(c *gin.Context) {
+		// Start draining during
+		// this (in-flight) request.
+		state.Drain.Start()
+		c.Status(http.StatusOK)
+	})
+
+	// First request should be served
+	// as normal, despite draining
+	// being started during it.
+	rw := httptest.NewRecorder()
+	e.ServeHTTP(rw, httptest.NewRequest("GET", "/", nil))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("unexpected in-flight response code: %d", rw.Code)
+	}
+
+	select {
+	case <-state.Drain.Done():
+	default:
+		t.Fatal("drain channel not closed")
+	}
+
+	// Any new request should
+	// now be rejected with 503.
+	rw = httptest.NewRecorder()
+	e.ServeHTTP(rw, httptest.NewRequest("GET", "/", nil))
+	if rw.Code != http.StatusServiceUnavailable {
+		t.Fatalf("unexpected draining response code: %d", rw.Code)
+	}
+	if rw.Header().Get("Retry-After") == "" {
+		t.Fatal("no Retry-After header set on draining response")
+	}
+}
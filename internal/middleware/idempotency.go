@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/gin-gonic/gin"
+)
+
+// maxIdempotencyKeyLen is the maximum
+// accepted length of an Idempotency-Key.
+const maxIdempotencyKeyLen = 255
+
+// IdempotencyKey returns a gin middleware which stores the value
+// of the Idempotency-Key header of POST requests (if any) in the
+// request context, where it can be picked up by processing functions
+// that support idempotent creation. See gtscontext.IdempotencyKey().
+//
+// The instanceGet function is used when responding to
+// requests with an invalid Idempotency-Key header.
+func IdempotencyKey(
+	instanceGet func(context.Context) (*apimodel.InstanceV1, gtserror.WithCode),
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodPost {
+			// Only creation requests
+			// can be made idempotent.
+			return
+		}
+
+		key := c.GetHeader("Idempotency-Key")
+		if key == "" {
+			// Nothing
+			// to do.
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLen {
+			const text = "Idempotency-Key header too long"
+			errWithCode := gtserror.WithErrCode(
+				gtserror.NewErrorBadRequest(errors.New(text), text),
+				gtserror.ErrCodeIdempotencyKeyInvalid,
+			)
+			apiutil.ErrorHandler(c, errWithCode, instanceGet)
+			c.Abort()
+			return
+		}
+
+		// Store key in request context for processing.
+		ctx := gtscontext.SetIdempotencyKey(c.Request.Context(), key)
+		c.Request = c.Request.WithContext(ctx)
+	}
+}
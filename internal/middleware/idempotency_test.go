@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	const header = "X-Request-Id"
+
+	for _, test := range []struct {
+		input   string
+		reached bool
+	}{
+		{
+			// No key given,
+			// nothing stored.
+			input:   "",
+			reached: true,
+		},
+		{
+			// Valid key, store it.
+			input:   "e2a6a3f4-9d7c-4a4e-8b1d-3f0c2f7b6a51",
+			reached: true,
+		},
+		{
+			// Key too long,
+			// reject request.
+			input:   strings.Repeat("a", 256),
+			reached: false,
+		},
+	} {
+		var (
+			reached bool
+			ctxKey  string
+		)
+
+		// Gin test http engine
+		// that records the stored key.
+		e := gin.New()
+		e.Use(middleware.AddRequestID(header))
+		e.Use(middleware.IdempotencyKey(
+			func(context.Context) (*apimodel.InstanceV1, gtserror.WithCode) { return nil, nil },
+		))
+		e.Handle("POST", "/", func(c *gin.Context) {
+			reached = true
+			ctxKey = gtscontext.IdempotencyKey(c.Request.Context())
+			c.Status(http.StatusOK)
+		})
+
+		r := httptest.NewRequest("POST", "/", nil)
+		r.Header.Set("Accept", "application/json")
+		if test.input != "" {
+			r.Header.Set("Idempotency-Key", test.input)
+		}
+		rw := httptest.NewRecorder()
+		e.ServeHTTP(rw, r)
+
+		if reached != test.reached {
+			t.Errorf("unexpected handler reached=%t for input %q", reached, test.input)
+			continue
+		}
+
+		if test.reached {
+			if ctxKey != test.input {
+				t.Errorf("unexpected idempotency key in context: recv=%q expct=%q", ctxKey, test.input)
+			}
+			continue
+		}
+
+		var apiErr apimodel.Error
+		if err := json.NewDecoder(rw.Body).Decode(&apiErr); err != nil {
+			t.Fatal(err)
+		}
+
+		id := rw.Header().Get(header)
+		if rw.Code != http.StatusBadRequest ||
+			apiErr.ErrorCode != gtserror.ErrCodeIdempotencyKeyInvalid ||
+			apiErr.RequestID == "" || apiErr.RequestID != id {
+			t.Errorf("unexpected error response: code=%d errorCode=%q requestID=%q expct=%q",
+				rw.Code, apiErr.ErrorCode, apiErr.RequestID, id)
+		}
+	}
+}
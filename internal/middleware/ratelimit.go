@@ -141,7 +141,10 @@ func RateLimit(limit int, except []netip.Prefix) gin.HandlerFunc {
 			// Bail with 500.
 			c.AbortWithStatusJSON(
 				errWithCode.Code(),
-				gin.H{"error": errWithCode.Safe()},
+				gin.H{
+					"error":      errWithCode.Safe(),
+					"error_code": errWithCode.ErrCode(),
+				},
 			)
 			return
 		}
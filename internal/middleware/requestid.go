@@ -53,11 +53,36 @@ func NewRequestID() string {
 	return b32.EncodeToString(buf[:])
 }
 
+// maxRequestIDLen is the maximum length of
+// a request ID accepted from incoming requests.
+const maxRequestIDLen = 128
+
+// validRequestID returns whether the given request ID,
+// as provided in a request header, is safe to propagate
+// into logs and responses. This allows only a limited
+// length of printable ASCII with no spaces or quotes.
+func validRequestID(id string) bool {
+	if len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if c <= ' ' || c > '~' ||
+			c == '"' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
+
 // AddRequestID returns a gin middleware which adds a unique ID to each request (both response header and context).
+//
+// If the request already contains a (valid) ID in the given header, eg., as set by a reverse proxy, this is
+// propagated instead, so that server logs can be correlated with those of other services.
 func AddRequestID(header string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		id := c.GetHeader(header)
-		if id == "" {
+		if id == "" || !validRequestID(id) {
 
 			// Generate new ID.
 			id = NewRequestID()
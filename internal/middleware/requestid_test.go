@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestID(t *testing.T) {
+	const header = "X-Request-Id"
+
+	for _, test := range []struct {
+		input     string
+		propagate bool
+	}{
+		{
+			// No ID given, generate one.
+			input:     "",
+			propagate: false,
+		},
+		{
+			// Valid ID, propagate it.
+			input:     "e2a6a3f4-9d7c-4a4e-8b1d-3f0c2f7b6a51",
+			propagate: true,
+		},
+		{
+			// ID containing spaces,
+			// generate a new one.
+			input:     "hello world",
+			propagate: false,
+		},
+		{
+			// ID containing quotes,
+			// generate a new one.
+			input:     `"quoted"`,
+			propagate: false,
+		},
+		{
+			// ID too long,
+			// generate a new one.
+			input:     strings.Repeat("a", 129),
+			propagate: false,
+		},
+	} {
+		var ctxID string
+
+		// Gin test http engine
+		// that serves an error.
+		e := gin.New()
+		e.Use(middleware.AddRequestID(header))
+		e.Handle("GET", "/", func(c *gin.Context) {
+			ctxID = gtscontext.RequestID(c.Request.Context())
+			apiutil.ErrorHandler(c,
+				gtserror.NewErrorBadRequest(errors.New("oops"), "oops"),
+				func(context.Context) (*apimodel.InstanceV1, gtserror.WithCode) { return nil, nil },
+			)
+		})
+
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("Accept", "application/json")
+		if test.input != "" {
+			r.Header.Set(header, test.input)
+		}
+		rw := httptest.NewRecorder()
+		e.ServeHTTP(rw, r)
+
+		id := rw.Header().Get(header)
+		if id == "" {
+			t.Errorf("no request ID in response for input %q", test.input)
+			continue
+		}
+
+		if test.propagate && id != test.input {
+			t.Errorf("request ID not propagated: recv=%q expct=%q", id, test.input)
+		} else if !test.propagate && id == test.input {
+			t.Errorf("invalid request ID %q propagated", test.input)
+		}
+
+		if ctxID != id {
+			t.Errorf("unexpected request ID in context: recv=%q expct=%q", ctxID, id)
+		}
+
+		var apiErr apimodel.Error
+		if err := json.NewDecoder(rw.Body).Decode(&apiErr); err != nil {
+			t.Fatal(err)
+		}
+
+		if rw.Code != http.StatusBadRequest || apiErr.RequestID != id {
+			t.Errorf("unexpected error response: code=%d requestID=%q expct=%q", rw.Code, apiErr.RequestID, id)
+		}
+	}
+}
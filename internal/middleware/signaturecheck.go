@@ -19,11 +19,14 @@
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 
 	"code.superseriousbusiness.org/httpsig"
 	"github.com/gin-gonic/gin"
@@ -46,6 +49,9 @@
 // blocked, the handler will set the key verifier and the signature in the
 // context for use down the line.
 //
+// Rejections with 401 or 403 are served with an application/problem+json
+// body giving a machine-readable reason for the rejection.
+//
 // In case of an error, the request will be aborted with http code 500.
 func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) func(*gin.Context) {
 	return func(c *gin.Context) {
@@ -61,7 +67,8 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 			// it's up to other functions to reject this.
 			if err.Error() != noSigError {
 				log.Debugf(ctx, "http signature was present but invalid: %s", err)
-				c.AbortWithStatus(http.StatusUnauthorized)
+				const text = "http signature was present but invalid"
+				abortWithProblem(c, http.StatusUnauthorized, err, text, gtserror.ErrCodeSignatureInvalid)
 			}
 
 			return
@@ -80,7 +87,8 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 		pubKeyID, err := url.Parse(pubKeyIDStr)
 		if err != nil || pubKeyID == nil {
 			log.Warnf(ctx, "pubkey id %s could not be parsed as a url", pubKeyIDStr)
-			c.AbortWithStatus(http.StatusUnauthorized)
+			const text = "http signature keyId could not be parsed as a url"
+			abortWithProblem(c, http.StatusUnauthorized, err, text, gtserror.ErrCodeSignatureInvalid)
 			return
 		}
 
@@ -95,7 +103,8 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 
 		if blocked {
 			log.Infof(ctx, "domain %s is blocked", pubKeyID.Host)
-			c.AbortWithStatus(http.StatusForbidden)
+			const text = "domain blocked"
+			abortWithProblem(c, http.StatusForbidden, errors.New(text), text, gtserror.ErrCodeDomainBlocked)
 			return
 		}
 
@@ -117,3 +126,14 @@ func SignatureCheck(uriBlocked func(context.Context, *url.URL) (bool, error)) fu
 		c.Request = c.Request.WithContext(ctx)
 	}
 }
+
+// abortWithProblem aborts the request with given status
+// code, serving an application/problem+json body with
+// given safe text and machine-readable reason, so that
+// remotes can tell *why* their request was rejected.
+func abortWithProblem(c *gin.Context, code int, err error, text string, reason string) {
+	errWithCode := gtserror.NewWithCodeSafe(code, err, text)
+	errWithCode = gtserror.WithErrCode(errWithCode, reason)
+	apiutil.ProblemErrorHandler(c, errWithCode)
+	c.Abort()
+}
@@ -0,0 +1,95 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func TestSignatureCheckProblem(t *testing.T) {
+	// Gin test http engine with signature check
+	// middleware, blocking "blocked.example.org".
+	e := gin.New()
+	e.Use(middleware.SignatureCheck(func(_ context.Context, u *url.URL) (bool, error) {
+		return u.Host == "blocked.example.org", nil
+	}))
+	e.Handle("POST", "/", func(c *gin.Context) {
+		c.Status(http.StatusAccepted)
+	})
+
+	for _, test := range []struct {
+		signature string
+		code      int
+		reason    string
+	}{
+		{
+			// Unsigned, let through.
+			signature: "",
+			code:      http.StatusAccepted,
+		},
+		{
+			// Signature is missing keyId.
+			signature: `algorithm="rsa-sha256",headers="(request-target) host date",signature="c2lnbmF0dXJl"`,
+			code:      http.StatusUnauthorized,
+			reason:    "signature_invalid",
+		},
+		{
+			// Signed by blocked domain.
+			signature: `keyId="https://blocked.example.org/users/someone#main-key",algorithm="rsa-sha256",headers="(request-target) host date",signature="c2lnbmF0dXJl"`,
+			code:      http.StatusForbidden,
+			reason:    "domain_blocked",
+		},
+	} {
+		r := httptest.NewRequest("POST", "/", nil)
+		if test.signature != "" {
+			r.Header.Set("Signature", test.signature)
+		}
+
+		rw := httptest.NewRecorder()
+		e.ServeHTTP(rw, r)
+		if rw.Code != test.code {
+			t.Fatalf("unexpected response code for %q: %d", test.signature, rw.Code)
+		}
+
+		if test.reason == "" {
+			continue
+		}
+
+		if ct := rw.Header().Get("Content-Type"); ct != "application/problem+json" {
+			t.Fatalf("unexpected content-type: %s", ct)
+		}
+
+		var problem apimodel.Problem
+		if err := json.Unmarshal(rw.Body.Bytes(), &problem); err != nil {
+			t.Fatalf("error decoding problem: %v", err)
+		}
+
+		if problem.Status != test.code || problem.Reason != test.reason {
+			t.Fatalf("unexpected problem: %+v", problem)
+		}
+	}
+}
@@ -18,10 +18,12 @@
 package middleware
 
 import (
+	"net"
 	"net/http"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/oauth"
 	"code.superseriousbusiness.org/oauth2/v4"
 	"github.com/gin-gonic/gin"
@@ -58,7 +60,15 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 			return
 		}
 
-		ti, err := validateBearerToken(c.Copy().Request)
+		// Pass client details on to token validation
+		// so that token usage can be recorded with them.
+		r := c.Copy().Request
+		r = r.WithContext(gtscontext.SetRequestClient(ctx,
+			net.ParseIP(c.ClientIP()),
+			c.Request.UserAgent(),
+		))
+
+		ti, err := validateBearerToken(r)
 		if err != nil {
 			log.Debugf(ctx, "token was passed in Authorization header but we could not validate it: %s", err)
 			return
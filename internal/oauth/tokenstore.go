@@ -23,6 +23,7 @@
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
@@ -201,12 +202,31 @@ func (ts *tokenStore) getUpdateToken(
 		return nil, err
 	}
 
-	// If token was last used more than
-	// an hour ago, update this in the db.
+	// Get client details of the request
+	// using this token, where available.
+	ip, userAgent := gtscontext.RequestClient(ctx)
+	clientChanged := (ip != nil && !ip.Equal(token.LastUsedIP)) ||
+		(userAgent != "" && userAgent != token.LastUsedUserAgent)
+
+	// If token was last used more than an hour ago,
+	// update this in the db, along with the client
+	// details if they've changed since. Client changes
+	// within the hour are throttled in the same way, so
+	// that a token alternating between clients (eg., a
+	// phone hopping between wifi and mobile data)
+	// doesn't cause a db write on every request.
 	wasLastUsed := token.LastUsed
 	if now := time.Now(); now.Sub(wasLastUsed) > 1*time.Hour {
 		token.LastUsed = now
-		if err := ts.state.DB.UpdateToken(ctx, token, "last_used"); err != nil {
+		columns := []string{"last_used"}
+
+		if clientChanged {
+			token.LastUsedIP = ip
+			token.LastUsedUserAgent = userAgent
+			columns = append(columns, "last_used_ip", "last_used_user_agent")
+		}
+
+		if err := ts.state.DB.UpdateToken(ctx, token, columns...); err != nil {
 			// Unlock on error.
 			unlock()
 			err := gtserror.Newf("error updating last_used on token: %w", err)
@@ -24,12 +24,14 @@
 	"fmt"
 
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/contrib/exporters/autoexport"
 	"go.opentelemetry.io/contrib/instrumentation/runtime"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	sdk "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
@@ -141,6 +143,42 @@ func InitializeMetrics(ctx context.Context, state *state.State) error {
 		return err
 	}
 
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.federation.delivery.recipients",
+		metric.WithDescription("Total number of follower recipients that outgoing activities were fanned out to"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(state.Workers.Delivery.Stats.Recipients())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.federation.delivery.inboxes",
+		metric.WithDescription("Total number of inboxes that follower recipients were coalesced onto for delivery"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			o.Observe(state.Workers.Delivery.Stats.Inboxes())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"gotosocial.federation.delivery.dedup_ratio",
+		metric.WithDescription("Fraction of per-recipient deliveries saved by coalescing recipients onto shared inboxes"),
+		metric.WithFloat64Callback(func(ctx context.Context, o metric.Float64Observer) error {
+			o.Observe(state.Workers.Delivery.Stats.DedupRatio())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
 	_, err = meter.Int64ObservableGauge(
 		"gotosocial.workers.dereference.count",
 		metric.WithDescription("Current number of dereference workers"),
@@ -261,9 +299,184 @@ func InitializeMetrics(ctx context.Context, state *state.State) error {
 		return err
 	}
 
+	_, err = meter.Int64ObservableGauge(
+		"gotosocial.federation.failed_activities",
+		metric.WithDescription("Current number of stored inbound activities that failed processing, by activity type and failure reason"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			counts, err := state.DB.CountFailedActivities(ctx)
+			if err != nil {
+				return err
+			}
+			for _, count := range counts {
+				o.Observe(int64(count.Count), metric.WithAttributes(
+					attribute.String("activity_type", count.APActivityType),
+					attribute.String("object_type", count.APObjectType),
+					attribute.String("reason", count.Reason.String()),
+				))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.httpclient.blocked_dials",
+		metric.WithDescription("Total number of outgoing connections refused to protect against server side request forgery, by reason"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			stats := httpclient.BlockedDials()
+			o.Observe(stats.Network, metric.WithAttributes(attribute.String("reason", "network")))
+			o.Observe(stats.Blocked, metric.WithAttributes(attribute.String("reason", "blocked")))
+			o.Observe(stats.Reserved, metric.WithAttributes(attribute.String("reason", "reserved")))
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	dnsLookups, err := meter.Int64ObservableCounter(
+		"gotosocial.httpclient.dns.lookups",
+		metric.WithDescription("Total number of DNS lookups for outgoing connections, by result"),
+	)
+	if err != nil {
+		return err
+	}
+
+	dnsLookupDuration, err := meter.Float64ObservableCounter(
+		"gotosocial.httpclient.dns.lookup_duration",
+		metric.WithDescription("Total time spent in DNS lookups not served from cache"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(
+		func(ctx context.Context, o metric.Observer) error {
+			stats := httpclient.DNSLookups()
+			o.ObserveInt64(dnsLookups, stats.Cached, metric.WithAttributes(attribute.String("result", "cached")))
+			o.ObserveInt64(dnsLookups, stats.Resolved, metric.WithAttributes(attribute.String("result", "resolved")))
+			o.ObserveInt64(dnsLookups, stats.Failed, metric.WithAttributes(attribute.String("result", "failed")))
+			o.ObserveFloat64(dnsLookupDuration, stats.Duration.Seconds())
+			return nil
+		},
+		dnsLookups,
+		dnsLookupDuration,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.httpclient.conns",
+		metric.WithDescription("Total number of connections obtained for outgoing requests, by whether an idle connection was reused"),
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			stats := httpclient.Conns()
+			o.Observe(stats.New, metric.WithAttributes(attribute.Bool("reused", false)))
+			o.Observe(stats.Reused, metric.WithAttributes(attribute.Bool("reused", true)))
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := initializeCacheMetrics(meter, state); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// initializeCacheMetrics registers hit / miss / load
+// metrics for each instrumented cache, attributed by
+// cache name, so that operators can size caches from
+// observed behaviour rather than guesswork.
+func initializeCacheMetrics(meter metric.Meter, state *state.State) error {
+	hits, err := meter.Int64ObservableCounter(
+		"gotosocial.cache.hits",
+		metric.WithDescription("Total number of cache lookups that were served from cache"),
+	)
+	if err != nil {
+		return err
+	}
+
+	misses, err := meter.Int64ObservableCounter(
+		"gotosocial.cache.misses",
+		metric.WithDescription("Total number of cache lookups that were not served from cache"),
+	)
+	if err != nil {
+		return err
+	}
+
+	loads, err := meter.Int64ObservableCounter(
+		"gotosocial.cache.loads",
+		metric.WithDescription("Total number of calls made to cache loader functions on miss"),
+	)
+	if err != nil {
+		return err
+	}
+
+	loadDuration, err := meter.Float64ObservableCounter(
+		"gotosocial.cache.load_duration",
+		metric.WithDescription("Total time spent in cache loader functions on miss"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	size, err := meter.Int64ObservableGauge(
+		"gotosocial.cache.size",
+		metric.WithDescription("Current number of entries in cache"),
+	)
+	if err != nil {
+		return err
+	}
+
+	capacity, err := meter.Int64ObservableGauge(
+		"gotosocial.cache.capacity",
+		metric.WithDescription("Maximum number of entries in cache"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(
+		func(ctx context.Context, o metric.Observer) error {
+			for _, c := range state.Caches.Stats() {
+				if c.Stats == nil {
+					continue
+				}
+
+				attrs := metric.WithAttributes(
+					attribute.String("cache", c.Name),
+				)
+
+				o.ObserveInt64(hits, c.Stats.Hits(), attrs)
+				o.ObserveInt64(misses, c.Stats.Misses(), attrs)
+				o.ObserveInt64(loads, c.Stats.Loads(), attrs)
+				o.ObserveFloat64(loadDuration, c.Stats.LoadDuration().Seconds(), attrs)
+
+				if c.Len >= 0 {
+					o.ObserveInt64(size, int64(c.Len), attrs)
+				}
+
+				if c.Cap >= 0 {
+					o.ObserveInt64(capacity, int64(c.Cap), attrs)
+				}
+			}
+			return nil
+		},
+		hits, misses, loads,
+		loadDuration,
+		size, capacity,
+	)
+	return err
+}
+
 func MetricsMiddleware() gin.HandlerFunc {
 	return ginMiddleware()
 }
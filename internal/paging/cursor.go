@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package paging
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// Query keys used for cursor paging.
+const (
+	MinCursorKey = "min_cursor"
+	MaxCursorKey = "max_cursor"
+)
+
+// EncodeCursor encodes the given key tuple (e.g. a sort column
+// value followed by a tie-breaking ID) into an opaque cursor
+// token, safe for use as a URL query parameter value.
+//
+// Unlike a bare ID, a cursor token identifies a position in
+// an endpoint's sort order, so paging remains correct even
+// if items are created in between pages, or if the order
+// isn't (strictly) chronological by ID.
+func EncodeCursor(keys ...string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(keys)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor decodes the key tuple from a cursor token
+// as encoded by EncodeCursor(). An empty token returns
+// nil keys, and an unparseable token returns an error.
+func DecodeCursor(token string) ([]string, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("invalid cursor encoding")
+	}
+
+	var keys []string
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return nil, errors.New("invalid cursor data")
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("empty cursor")
+	}
+
+	return keys, nil
+}
+
+// MinCursor returns a cursor boundary with given min cursor
+// token, and the "min_cursor" query key set. Paging with a
+// min cursor returns items immediately *BEFORE* the cursor
+// position in the endpoint's sort order (i.e. paging up).
+func MinCursor(minCursor string) Boundary {
+	return Boundary{
+		Name:  MinCursorKey,
+		Value: minCursor,
+		Order: OrderAscending,
+	}
+}
+
+// MaxCursor returns a cursor boundary with given max cursor
+// token, and the "max_cursor" query key set. Paging with a
+// max cursor returns items immediately *AFTER* the cursor
+// position in the endpoint's sort order (i.e. paging down).
+func MaxCursor(maxCursor string) Boundary {
+	return Boundary{
+		Name:  MaxCursorKey,
+		Value: maxCursor,
+		Order: OrderDescending,
+	}
+}
+
+// Cursor returns whether this page's boundaries contain
+// opaque cursor tokens, rather than plain value boundaries.
+func (p *Page) Cursor() bool {
+	return p != nil &&
+		p.Min.Name == MinCursorKey &&
+		p.Max.Name == MaxCursorKey
+}
+
+// GetMinCursor is a small helper function to return the decoded
+// key tuple of the minimum cursor boundary (checking for nil page).
+func (p *Page) GetMinCursor() ([]string, error) {
+	return DecodeCursor(p.GetMin())
+}
+
+// GetMaxCursor is a small helper function to return the decoded
+// key tuple of the maximum cursor boundary (checking for nil page).
+func (p *Page) GetMaxCursor() ([]string, error) {
+	return DecodeCursor(p.GetMax())
+}
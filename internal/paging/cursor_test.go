@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package paging_test
+
+import (
+	"net/url"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, keys := range [][]string{
+		{"01FC0SKA48HNSVR6YKZCQGS2V8"},
+		{"example.org", "01FC0SKA48HNSVR6YKZCQGS2V8"},
+		{"weird,value", "with \"quotes\"", ""},
+	} {
+		token := paging.EncodeCursor(keys...)
+		assert.Equal(t, url.QueryEscape(token), token, "token should be query safe")
+
+		decoded, err := paging.DecodeCursor(token)
+		assert.NoError(t, err)
+		assert.Equal(t, keys, decoded)
+	}
+}
+
+func TestCursorDecodeInvalid(t *testing.T) {
+	keys, err := paging.DecodeCursor("")
+	assert.NoError(t, err)
+	assert.Nil(t, keys)
+
+	for _, token := range []string{
+		"not base64!",
+		"e30", // "{}"
+		"W10", // "[]"
+	} {
+		_, err := paging.DecodeCursor(token)
+		assert.Error(t, err, "token=%q", token)
+	}
+}
+
+func TestCursorNextPrev(t *testing.T) {
+	page := &paging.Page{
+		Min:   paging.MinCursor(""),
+		Max:   paging.MaxCursor(paging.EncodeCursor("a.example.org", "01J")),
+		Limit: 10,
+	}
+	assert.True(t, page.Cursor())
+
+	lo := paging.EncodeCursor("c.example.org", "01K")
+	hi := paging.EncodeCursor("b.example.org", "01L")
+
+	next := page.Next(lo, hi)
+	assert.True(t, next.Cursor())
+	assert.Equal(t,
+		"https://example.org/api/v1/admin/domain_limits?limit=10&max_cursor="+lo,
+		next.ToLink("https", "example.org", "/api/v1/admin/domain_limits", nil),
+	)
+
+	prev := page.Prev(lo, hi)
+	assert.True(t, prev.Cursor())
+	assert.Equal(t,
+		"https://example.org/api/v1/admin/domain_limits?limit=10&min_cursor="+hi,
+		prev.ToLink("https", "example.org", "/api/v1/admin/domain_limits", nil),
+	)
+
+	keys, err := prev.GetMinCursor()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b.example.org", "01L"}, keys)
+
+	// ID pages aren't cursor pages.
+	assert.False(t, (&paging.Page{
+		Min: paging.MinID(""),
+		Max: paging.MaxID(""),
+	}).Cursor())
+}
@@ -104,6 +104,61 @@ func ParseShortcodeDomainPage(c *gin.Context, min, max, _default int) (*Page, gt
 	}, nil
 }
 
+// ParseCursorPage parses a cursor Page from a request context, returning BadRequest on error parsing
+// or on invalid cursor tokens. The min, max and default parameters define the page size limit minimum,
+// maximum and default value, where a non-zero default will enforce paging for the endpoint on which this
+// is called. While conversely, a zero default limit will not enforce paging, returning a nil page value.
+func ParseCursorPage(c *gin.Context, min, max, _default int) (*Page, gtserror.WithCode) {
+	// Extract request query params.
+	minCursor, haveMin := c.GetQuery(MinCursorKey)
+	maxCursor, haveMax := c.GetQuery(MaxCursorKey)
+
+	// Ensure any provided cursor tokens are valid.
+	for key, token := range map[string]string{
+		MinCursorKey: minCursor,
+		MaxCursorKey: maxCursor,
+	} {
+		if _, err := DecodeCursor(token); err != nil {
+			const help = "bad cursor value"
+			err := gtserror.Newf("%s: %w", key, err)
+			return nil, gtserror.NewErrorBadRequest(err, help)
+		}
+	}
+
+	// Extract request limit parameter.
+	limit, errWithCode := ParseLimit(c, min, max, _default)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	switch {
+	case haveMin:
+		// A min_cursor was supplied, even if the value
+		// itself is empty. This indicates paging up.
+		return &Page{
+			Min:   MinCursor(minCursor),
+			Max:   MaxCursor(maxCursor),
+			Limit: limit,
+		}, nil
+
+	case !haveMax && limit == 0:
+		// No cursor paging params provided, and no default
+		// limit value which indicates paging not enforced.
+		return nil, nil
+
+	default:
+		// A max_cursor or only limit was
+		// supplied. This indicates paging down.
+		lo := MinCursor("")
+		lo.Order = OrderDescending
+		return &Page{
+			Min:   lo,
+			Max:   MaxCursor(maxCursor),
+			Limit: limit,
+		}, nil
+	}
+}
+
 // ParseLimit parses the limit query parameter from a request context, returning BadRequest on error parsing and _default if zero limit given.
 func ParseLimit(c *gin.Context, min, max, _default int) (int, gtserror.WithCode) {
 	// Get limit query param.
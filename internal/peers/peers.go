@@ -0,0 +1,188 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package peers
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
+)
+
+// Peers wraps functionality for periodically
+// crawling known peer instances for their
+// nodeinfo, and storing the results.
+type Peers struct {
+	state               *state.State
+	transportController transport.Controller
+}
+
+func New(
+	state *state.State,
+	transportController transport.Controller,
+) *Peers {
+	return &Peers{
+		state:               state,
+		transportController: transportController,
+	}
+}
+
+// ScheduleJobs schedules crawling of known peers
+// to run every `InstancePeersCrawlEvery`. If this
+// value is 0, then no crawling will be scheduled.
+func (p *Peers) ScheduleJobs() error {
+	crawlEvery := config.GetInstancePeersCrawlEvery()
+	if crawlEvery <= 0 {
+		log.Info(nil, "instance-peers-crawl-every set to 0, not scheduling peers crawling")
+		return nil
+	}
+
+	fn := func(ctx context.Context, start time.Time) error {
+		// Crawling may make a lot of outgoing
+		// requests, so rather than blocking the
+		// scheduler, push it onto the low
+		// priority processing worker queue.
+		p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+			log.Info(ctx, "starting instance peers crawl")
+			p.Crawl(ctx, crawlEvery)
+			log.Infof(ctx, "finished instance peers crawl after %s", time.Since(start))
+		})
+
+		// Errors crawling are
+		// logged by the queued task.
+		return nil
+	}
+
+	log.Infof(nil, "scheduling instance peers crawl to run every %s", crawlEvery)
+
+	// Schedule crawl to start after one period has elapsed.
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@peerscrawl",
+		time.Now().Add(crawlEvery),
+		crawlEvery,
+		fn,
+	) {
+		panic("failed to schedule @peerscrawl")
+	}
+
+	return nil
+}
+
+// Crawl fetches nodeinfo for each non-suspended known peer
+// whose info was last fetched longer ago than staleAfter,
+// and stores the resulting software, version, user counts
+// and registration state on the instance model.
+func (p *Peers) Crawl(ctx context.Context, staleAfter time.Duration) {
+	peers, err := p.state.DB.GetInstancePeers(ctx, false)
+	if err != nil {
+		log.Errorf(ctx, "db error getting instance peers: %v", err)
+		return
+	}
+
+	if len(peers) == 0 {
+		// Nothing to do.
+		return
+	}
+
+	// Crawl using the instance account's transport.
+	tsport, err := p.transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		log.Errorf(ctx, "error getting instance account transport: %v", err)
+		return
+	}
+
+	var (
+		now     = time.Now()
+		crawled int
+	)
+
+	for _, peer := range peers {
+		if ctx.Err() != nil {
+			// Context canceled,
+			// we're shutting down.
+			return
+		}
+
+		if now.Sub(peer.InfoFetchedAt) < staleAfter {
+			// Fetched recently
+			// enough, skip it.
+			continue
+		}
+
+		if err := p.crawlPeer(ctx, tsport, peer); err != nil {
+			log.Debugf(ctx, "error crawling %s: %v", peer.Domain, err)
+			continue
+		}
+
+		crawled++
+	}
+
+	log.Infof(ctx, "crawled %d of %d known peers", crawled, len(peers))
+}
+
+func (p *Peers) crawlPeer(
+	ctx context.Context,
+	tsport transport.Transport,
+	peer *gtsmodel.Instance,
+) error {
+	// Don't reach out to blocked domains.
+	blocked, err := p.state.DB.IsDomainBlocked(ctx, peer.Domain)
+	if err != nil {
+		return gtserror.Newf("db error checking domain block: %w", err)
+	}
+
+	if blocked {
+		return gtserror.New("domain is blocked")
+	}
+
+	iri := &url.URL{Scheme: "https", Host: peer.Domain}
+	ni, err := tsport.DereferenceNodeInfo(ctx, iri)
+
+	// Mark as fetched even on failure, so
+	// dead peers are only retried once a
+	// crawl period has elapsed.
+	peer.InfoFetchedAt = time.Now()
+	columns := []string{"info_fetched_at"}
+
+	if err == nil {
+		peer.SoftwareName = ni.Software.Name
+		peer.SoftwareVersion = ni.Software.Version
+		peer.UsersTotal = ni.Usage.Users.Total
+		peer.UsersActiveMonth = ni.Usage.Users.ActiveMonth
+		peer.OpenRegistrations = &ni.OpenRegistrations
+		columns = append(columns,
+			"software_name",
+			"software_version",
+			"users_total",
+			"users_active_month",
+			"open_registrations",
+		)
+	}
+
+	if dbErr := p.state.DB.UpdateInstance(ctx, peer, columns...); dbErr != nil {
+		return gtserror.Newf("db error updating instance: %w", dbErr)
+	}
+
+	return err
+}
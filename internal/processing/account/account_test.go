@@ -121,3 +121,20 @@ func (suite *AccountStandardTestSuite) TearDownTest() {
 	testrig.StandardStorageTeardown(suite.storage)
 	testrig.StopWorkers(&suite.state)
 }
+
+// processorWithClient returns an account
+// processor using the given http client.
+func (suite *AccountStandardTestSuite) processorWithClient(httpClient *testrig.MockHTTPClient) *account.Processor {
+	var (
+		transportController = testrig.NewTestTransportController(&suite.state, httpClient)
+		federator           = testrig.NewTestFederator(&suite.state, transportController, suite.mediaManager)
+		visFilter           = visibility.NewFilter(&suite.state)
+		mutesFilter         = mutes.NewFilter(&suite.state)
+		statusFilter        = status.NewFilter(&suite.state)
+		surfacer            = testrig.NewTestSurfacer(&suite.state, suite.emailSender, testrig.NewNoopWebPushSender())
+		common              = common.New(&suite.state, suite.mediaManager, suite.tc, federator, visFilter, mutesFilter, statusFilter, surfacer)
+	)
+
+	processor := account.New(&common, &suite.state, suite.tc, suite.mediaManager, federator, visFilter, statusFilter, processing.GetParseMentionFunc(&suite.state, federator))
+	return &processor
+}
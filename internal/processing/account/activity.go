@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// activityDays is the number of days
+// covered by an account activity heatmap,
+// ie., the last year including today.
+const activityDays = 365
+
+// ActivityGet returns per-day counts of public statuses posted
+// by the target account over the last year, oldest day first,
+// including days without statuses. Requester may be nil.
+//
+// Only local accounts that have enabled the activity heatmap
+// setting have their activity shown, though an account can
+// always see its own.
+func (p *Processor) ActivityGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountID string,
+) ([]*apimodel.AccountActivityDay, gtserror.WithCode) {
+	target, err := p.state.DB.GetAccountByID(ctx, targetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if target == nil || !target.IsLocal() || target.IsSuspended() {
+		const text = "account not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	isOwner := requester != nil && requester.ID == target.ID
+	if !isOwner && (target.Settings == nil ||
+		!util.PtrOrValue(target.Settings.WebActivityHeatmap, false)) {
+		const text = "account has not enabled activity heatmap"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if requester != nil {
+		blocked, err := p.state.DB.IsEitherBlocked(ctx, requester.ID, target.ID)
+		if err != nil {
+			err := gtserror.Newf("db error checking blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if blocked {
+			const text = "account not found"
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+	}
+
+	// Days are UTC. Key the cache by
+	// today too, so that cached activity
+	// rolls over with the calendar.
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	key := target.ID + "/" + today.Format(time.DateOnly)
+	if days, ok := p.state.Caches.AccountActivity.Get(key); ok {
+		return days, nil
+	}
+
+	since := today.AddDate(0, 0, -(activityDays - 1))
+	counts, err := p.state.DB.CountAccountPublicStatusesByDay(ctx, target.ID, since)
+	if err != nil {
+		err := gtserror.Newf("db error counting statuses by day: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Fill in every day of the year,
+	// taking counts where there are any.
+	days := make([]*apimodel.AccountActivityDay, activityDays)
+	for i := range days {
+		day := since.AddDate(0, 0, i).Format(time.DateOnly)
+		days[i] = &apimodel.AccountActivityDay{Day: day}
+
+		if len(counts) > 0 && counts[0].Day == day {
+			days[i].Statuses = counts[0].Count
+			counts = counts[1:]
+		}
+	}
+
+	p.state.Caches.AccountActivity.Set(key, days)
+	return days, nil
+}
@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type ActivityTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *ActivityTestSuite) TestActivityGet() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_2"]
+		target    = suite.testAccounts["local_account_1"]
+		now       = time.Now()
+	)
+
+	// Put a public status
+	// by the target today.
+	status := &gtsmodel.Status{
+		ID:                  id.NewULIDFromTime(now),
+		URI:                 "http://localhost:8080/users/the_mighty_zork/statuses/" + id.NewULIDFromTime(now),
+		URL:                 "http://localhost:8080/@the_mighty_zork/statuses/" + id.NewULIDFromTime(now),
+		Content:             "hello world",
+		CreatedAt:           now,
+		Local:               util.Ptr(true),
+		AccountURI:          target.URI,
+		AccountID:           target.ID,
+		Visibility:          gtsmodel.VisibilityPublic,
+		Federated:           util.Ptr(true),
+		ActivityStreamsType: "Note",
+	}
+	if err := suite.state.DB.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	days, errWithCode := suite.accountProcessor.ActivityGet(ctx, requester, target.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Should have a year of days, ending today.
+	suite.Len(days, 365)
+	today := days[len(days)-1]
+	suite.Equal(now.UTC().Format(time.DateOnly), today.Day)
+	suite.Equal(1, today.Statuses)
+
+	// A second call should be
+	// served from the cache.
+	cached, errWithCode := suite.accountProcessor.ActivityGet(ctx, requester, target.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Same(days[0], cached[0])
+}
+
+func (suite *ActivityTestSuite) TestActivityGetNotEnabled() {
+	var (
+		ctx    = suite.T().Context()
+		target = suite.testAccounts["local_account_2"]
+	)
+
+	// Target hasn't enabled heatmap,
+	// so others can't see activity.
+	_, errWithCode := suite.accountProcessor.ActivityGet(ctx, suite.testAccounts["local_account_1"], target.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	_, errWithCode = suite.accountProcessor.ActivityGet(ctx, nil, target.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// But the target can see their own.
+	days, errWithCode := suite.accountProcessor.ActivityGet(ctx, target, target.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(days, 365)
+}
+
+func (suite *ActivityTestSuite) TestActivityGetRemote() {
+	ctx := suite.T().Context()
+
+	_, errWithCode := suite.accountProcessor.ActivityGet(ctx, nil, suite.testAccounts["remote_account_1"].ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestActivityTestSuite(t *testing.T) {
+	suite.Run(t, new(ActivityTestSuite))
+}
@@ -0,0 +1,329 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// blocklistRefreshEvery is the period
+// between refreshes of all subscribed
+// blocklists by the scheduled job.
+const blocklistRefreshEvery = 24 * time.Hour
+
+// BlocklistSubscribe subscribes requester to the blocklist
+// published by another account at the given URL, blocking
+// each of the accounts in it. The blocklist is then refetched
+// periodically, see BlocklistSubscriptionsScheduleRefresh.
+//
+// Subscribing again to the same blocklist just refreshes
+// it now. The blocklist is fetched, and the blocks created,
+// asynchronously.
+func (p *Processor) BlocklistSubscribe(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	blocklistURL string,
+) gtserror.WithCode {
+	iri, err := url.Parse(blocklistURL)
+	if err != nil || (iri.Scheme != "https" && iri.Scheme != "http") || iri.Host == "" {
+		const text = "url must be an absolute http(s) url"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	sub, err := p.state.DB.GetBlocklistSubscriptionByURI(ctx, requester.ID, iri.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting blocklist subscription: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if sub == nil {
+		sub = &gtsmodel.BlocklistSubscription{
+			ID:        id.NewULID(),
+			AccountID: requester.ID,
+			URI:       iri.String(),
+		}
+
+		if err := p.state.DB.PutBlocklistSubscription(ctx, sub); err != nil {
+			err := gtserror.Newf("db error putting blocklist subscription: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Do the (first) fetch of the blocklist asynchronously.
+	p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+		if err := p.BlocklistSubscriptionRefresh(ctx, sub); err != nil {
+			log.Errorf(ctx, "error refreshing blocklist %s: %v", sub.URI, err)
+		}
+	})
+
+	return nil
+}
+
+// BlocklistSubscriptionsGet returns all blocklist subscriptions of requester.
+func (p *Processor) BlocklistSubscriptionsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]*apimodel.BlocklistSubscription, gtserror.WithCode) {
+	subs, err := p.state.DB.GetBlocklistSubscriptionsByAccountID(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting blocklist subscriptions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiSubs := make([]*apimodel.BlocklistSubscription, 0, len(subs))
+	for _, sub := range subs {
+		apiSubs = append(apiSubs, p.converter.BlocklistSubscriptionToAPIBlocklistSubscription(sub))
+	}
+
+	return apiSubs, nil
+}
+
+// BlocklistSubscriptionDelete unsubscribes requester from the
+// blocklist subscription with the given ID. Accounts that were
+// blocked because of the subscription stay blocked.
+func (p *Processor) BlocklistSubscriptionDelete(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	subID string,
+) (*apimodel.BlocklistSubscription, gtserror.WithCode) {
+	sub, err := p.state.DB.GetBlocklistSubscriptionByID(ctx, subID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting blocklist subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if sub == nil || sub.AccountID != requester.ID {
+		const text = "blocklist subscription not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if err := p.state.DB.DeleteBlocklistSubscriptionByID(ctx, sub.ID); err != nil {
+		err := gtserror.Newf("db error deleting blocklist subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.BlocklistSubscriptionToAPIBlocklistSubscription(sub), nil
+}
+
+// BlocklistSubscriptionsScheduleRefresh schedules a
+// refresh of all subscribed blocklists once a day.
+func (p *Processor) BlocklistSubscriptionsScheduleRefresh() error {
+	fn := func(ctx context.Context, start time.Time) error {
+		// Refreshing may dereference lots of
+		// accounts, so rather than blocking the
+		// scheduler, push it onto the low
+		// priority processing queue.
+		p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+			p.BlocklistSubscriptionsRefresh(ctx)
+			log.Infof(ctx, "finished refreshing blocklist subscriptions after %s", time.Since(start))
+		})
+
+		// Errors refreshing are stored
+		// on each subscription instead.
+		return nil
+	}
+
+	log.Infof(nil, "scheduling blocklist subscriptions refresh to run every %s", blocklistRefreshEvery)
+
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@blocklistsubsrefresh",
+		time.Now().Add(blocklistRefreshEvery),
+		blocklistRefreshEvery,
+		fn,
+	) {
+		panic("failed to schedule @blocklistsubsrefresh")
+	}
+
+	return nil
+}
+
+// BlocklistSubscriptionsRefresh refreshes
+// all subscribed blocklists, of all accounts.
+func (p *Processor) BlocklistSubscriptionsRefresh(ctx context.Context) {
+	subs, err := p.state.DB.GetBlocklistSubscriptions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting blocklist subscriptions: %v", err)
+		return
+	}
+
+	for _, sub := range subs {
+		if err := p.BlocklistSubscriptionRefresh(ctx, sub); err != nil {
+			log.Errorf(ctx, "error refreshing blocklist %s: %v", sub.URI, err)
+		}
+	}
+}
+
+// BlocklistSubscriptionRefresh fetches the subscribed blocklist,
+// blocks the accounts newly listed in it, and unblocks the accounts
+// no longer listed, if they were blocked because of this subscription.
+//
+// Accounts the subscriber had already blocked themselves are never
+// unblocked. If the blocklist can't be fetched in full, nothing is
+// unblocked, and the error is stored on the subscription.
+func (p *Processor) BlocklistSubscriptionRefresh(
+	ctx context.Context,
+	sub *gtsmodel.BlocklistSubscription,
+) error {
+	requester, err := p.state.DB.GetAccountByID(ctx, sub.AccountID)
+	if err != nil {
+		return gtserror.Newf("db error getting subscriber account: %w", err)
+	}
+
+	if requester.IsSuspended() {
+		// Nothing to
+		// do for them.
+		return nil
+	}
+
+	iri, err := url.Parse(sub.URI)
+	if err != nil {
+		return gtserror.Newf("invalid blocklist uri: %w", err)
+	}
+
+	// Gather IRIs of the accounts in the blocklist,
+	// signing the requests as requester, since the
+	// publisher may only show it to accounts it knows.
+	accountIRIs, err := p.federator.Dereferencer.DereferenceBlocklist(
+		ctx,
+		requester.Username,
+		iri,
+	)
+	if err != nil {
+		sub.FetchedAt = time.Now()
+		sub.Error = err.Error()
+		if err := p.state.DB.UpdateBlocklistSubscription(ctx, sub,
+			"fetched_at",
+			"error",
+		); err != nil {
+			log.Errorf(ctx, "db error updating blocklist subscription: %v", err)
+		}
+		return gtserror.Newf("could not dereference blocklist: %w", err)
+	}
+
+	listed := make(map[string]struct{}, len(accountIRIs))
+	for _, accountIRI := range accountIRIs {
+		listed[accountIRI.String()] = struct{}{}
+	}
+
+	// Accounts blocked because of
+	// the subscription after refresh.
+	targets := make(map[string]struct{}, len(accountIRIs))
+
+	// Unblock accounts no longer listed.
+	for _, uri := range sub.TargetURIs {
+		if _, ok := listed[uri]; ok {
+			// Still listed.
+			targets[uri] = struct{}{}
+			continue
+		}
+
+		targetAcct, err := p.state.DB.GetAccountByURI(gtscontext.SetBarebones(ctx), uri)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "db error getting account %s: %v", uri, err)
+				targets[uri] = struct{}{}
+			}
+			continue
+		}
+
+		if _, errWithCode := p.BlockRemove(
+			ctx,
+			requester,
+			targetAcct.ID,
+		); errWithCode != nil {
+			log.Errorf(ctx, "could not unblock account: %v", errWithCode.Unwrap())
+			targets[uri] = struct{}{}
+			continue
+		}
+	}
+
+	// Block accounts newly listed.
+	for _, accountIRI := range accountIRIs {
+		uri := accountIRI.String()
+		if _, ok := targets[uri]; ok || uri == requester.URI {
+			// Already blocked,
+			// or ourselves.
+			continue
+		}
+
+		// Get the target account, dereferencing it if necessary,
+		// using the instance account as for blocks from CSV.
+		targetAcct, _, err := p.federator.Dereferencer.GetAccountByURI(
+			ctx,
+			"",
+			accountIRI,
+			false,
+		)
+		if err != nil {
+			log.Errorf(ctx, "could not retrieve account: %v", err)
+			continue
+		}
+
+		blocked, err := p.state.DB.IsBlocked(ctx, requester.ID, targetAcct.ID)
+		if err != nil {
+			log.Errorf(ctx, "db error checking block: %v", err)
+			continue
+		}
+
+		if blocked {
+			// Blocked by requester themselves,
+			// so not ours to ever unblock.
+			continue
+		}
+
+		if _, errWithCode := p.BlockCreate(
+			ctx,
+			requester,
+			targetAcct.ID,
+		); errWithCode != nil {
+			log.Errorf(ctx, "could not block account: %v", errWithCode.Unwrap())
+			continue
+		}
+
+		targets[uri] = struct{}{}
+	}
+
+	sub.TargetURIs = make([]string, 0, len(targets))
+	for uri := range targets {
+		sub.TargetURIs = append(sub.TargetURIs, uri)
+	}
+	slices.Sort(sub.TargetURIs)
+	sub.FetchedAt = time.Now()
+	sub.Error = ""
+	if err := p.state.DB.UpdateBlocklistSubscription(ctx, sub,
+		"target_uris",
+		"fetched_at",
+		"error",
+	); err != nil {
+		return gtserror.Newf("db error updating blocklist subscription: %w", err)
+	}
+
+	return nil
+}
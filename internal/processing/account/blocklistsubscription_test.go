@@ -0,0 +1,148 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type BlocklistSubscriptionTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *BlocklistSubscriptionTestSuite) TestBlocklistSubscriptionRefresh() {
+	var (
+		ctx          = suite.T().Context()
+		zork         = suite.testAccounts["local_account_1"]
+		listedAcct   = suite.testAccounts["remote_account_1"]
+		blockedAcct  = suite.testAccounts["remote_account_2"]
+		blocklistURI = "https://blocklist.example.org/users/someone/collections/blocklist"
+	)
+
+	// Serve the blocklist with the accounts
+	// currently in listed, or fail if unavailable.
+	var (
+		listed      []string
+		unavailable bool
+	)
+	fallback := testrig.NewMockHTTPClient(nil, "../../../testrig/media")
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != blocklistURI {
+			return fallback.Do(req)
+		}
+
+		if unavailable {
+			return &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		}
+
+		b, err := json.Marshal(map[string]any{
+			"@context":     "https://www.w3.org/ns/activitystreams",
+			"id":           blocklistURI,
+			"type":         "OrderedCollection",
+			"totalItems":   len(listed),
+			"orderedItems": listed,
+		})
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/activity+json"}},
+			Body:       io.NopCloser(strings.NewReader(string(b))),
+		}, nil
+	}, "")
+	processor := suite.processorWithClient(httpClient)
+
+	// Zork already blocks one of the
+	// accounts on the list themselves.
+	if _, errWithCode := processor.BlockCreate(ctx, zork, blockedAcct.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	sub := &gtsmodel.BlocklistSubscription{
+		ID:        id.NewULID(),
+		AccountID: zork.ID,
+		URI:       blocklistURI,
+	}
+	if err := suite.db.PutBlocklistSubscription(ctx, sub); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	isBlocked := func(target *gtsmodel.Account) bool {
+		blocked, err := suite.db.IsBlocked(ctx, zork.ID, target.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return blocked
+	}
+
+	// Both listed accounts end up blocked, but
+	// only one is blocked because of the list.
+	listed = []string{listedAcct.URI, blockedAcct.URI}
+	if err := processor.BlocklistSubscriptionRefresh(ctx, sub); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(isBlocked(listedAcct))
+	suite.True(isBlocked(blockedAcct))
+	suite.Equal([]string{listedAcct.URI}, sub.TargetURIs)
+	suite.Empty(sub.Error)
+
+	// A failed fetch unblocks nothing.
+	listed = nil
+	unavailable = true
+	suite.Error(processor.BlocklistSubscriptionRefresh(ctx, sub))
+	suite.True(isBlocked(listedAcct))
+	suite.Equal([]string{listedAcct.URI}, sub.TargetURIs)
+	suite.NotEmpty(sub.Error)
+
+	// Accounts removed from the list are unblocked,
+	// unless zork had blocked them themselves.
+	unavailable = false
+	if err := processor.BlocklistSubscriptionRefresh(ctx, sub); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(isBlocked(listedAcct))
+	suite.True(isBlocked(blockedAcct))
+	suite.Empty(sub.TargetURIs)
+	suite.Empty(sub.Error)
+
+	// Stored subscription is up to date.
+	dbSub, err := suite.db.GetBlocklistSubscriptionByID(ctx, sub.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbSub.TargetURIs)
+	suite.False(dbSub.FetchedAt.IsZero())
+}
+
+func TestBlocklistSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, new(BlocklistSubscriptionTestSuite))
+}
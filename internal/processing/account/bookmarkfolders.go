@@ -0,0 +1,178 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+)
+
+// BookmarkFoldersGet returns all bookmark folders owned by requestingAccount.
+func (p *Processor) BookmarkFoldersGet(ctx context.Context, requestingAccount *gtsmodel.Account) ([]*apimodel.BookmarkFolder, gtserror.WithCode) {
+	folders, err := p.state.DB.GetBookmarkFoldersByAccountID(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting bookmark folders: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFolders := make([]*apimodel.BookmarkFolder, 0, len(folders))
+	for _, folder := range folders {
+		apiFolders = append(apiFolders, apiBookmarkFolder(folder))
+	}
+
+	return apiFolders, nil
+}
+
+// BookmarkFolderGet returns the bookmark folder
+// with the given ID, owned by requestingAccount.
+func (p *Processor) BookmarkFolderGet(ctx context.Context, requestingAccount *gtsmodel.Account, id string) (*apimodel.BookmarkFolder, gtserror.WithCode) {
+	folder, errWithCode := p.getBookmarkFolder(ctx, requestingAccount, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return apiBookmarkFolder(folder), nil
+}
+
+// BookmarkFolderCreate creates a new bookmark
+// folder with the given title for requestingAccount.
+func (p *Processor) BookmarkFolderCreate(ctx context.Context, requestingAccount *gtsmodel.Account, title string) (*apimodel.BookmarkFolder, gtserror.WithCode) {
+	if err := validate.BookmarkFolderTitle(title); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	folder := &gtsmodel.BookmarkFolder{
+		ID:        id.NewULID(),
+		Title:     title,
+		AccountID: requestingAccount.ID,
+	}
+
+	if err := p.state.DB.PutBookmarkFolder(ctx, folder); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = errors.New("you already have a bookmark folder with this title")
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		err := gtserror.Newf("db error putting bookmark folder: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiBookmarkFolder(folder), nil
+}
+
+// BookmarkFolderUpdate renames the bookmark folder
+// with the given ID, owned by requestingAccount.
+func (p *Processor) BookmarkFolderUpdate(ctx context.Context, requestingAccount *gtsmodel.Account, id string, title string) (*apimodel.BookmarkFolder, gtserror.WithCode) {
+	if err := validate.BookmarkFolderTitle(title); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	folder, errWithCode := p.getBookmarkFolder(ctx, requestingAccount, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	folder.Title = title
+	if err := p.state.DB.UpdateBookmarkFolder(ctx, folder, "title"); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = errors.New("you already have a bookmark folder with this title")
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		err := gtserror.Newf("db error updating bookmark folder: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiBookmarkFolder(folder), nil
+}
+
+// BookmarkFolderDelete deletes the bookmark folder with the given ID,
+// owned by requestingAccount. Bookmarks filed in the folder are not
+// deleted, but moved back into the default folder.
+func (p *Processor) BookmarkFolderDelete(ctx context.Context, requestingAccount *gtsmodel.Account, id string) gtserror.WithCode {
+	folder, errWithCode := p.getBookmarkFolder(ctx, requestingAccount, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteBookmarkFolderByID(ctx, folder.ID); err != nil {
+		err := gtserror.Newf("db error deleting bookmark folder: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// BookmarkFolderBookmarksGet returns a pageable response of statuses bookmarked by
+// requestingAccount into the bookmark folder with the given ID. As with BookmarksGet,
+// paging for this response is done based on bookmark ID rather than status ID.
+func (p *Processor) BookmarkFolderBookmarksGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	id string,
+	limit int,
+	maxID string,
+	minID string,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	folder, errWithCode := p.getBookmarkFolder(ctx, requestingAccount, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	bookmarks, err := p.state.DB.GetStatusBookmarksByFolderID(ctx, folder.ID, limit, maxID, minID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.packageBookmarks(ctx,
+		requestingAccount,
+		bookmarks,
+		"/api/v1/bookmarks/folders/"+folder.ID+"/statuses",
+		limit,
+	)
+}
+
+// getBookmarkFolder gets the bookmark folder with the given ID,
+// returning 404 if it doesn't exist or isn't owned by requester.
+func (p *Processor) getBookmarkFolder(ctx context.Context, requestingAccount *gtsmodel.Account, id string) (*gtsmodel.BookmarkFolder, gtserror.WithCode) {
+	folder, err := p.state.DB.GetBookmarkFolderByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting bookmark folder: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if folder == nil || folder.AccountID != requestingAccount.ID {
+		err := fmt.Errorf("bookmark folder %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return folder, nil
+}
+
+func apiBookmarkFolder(folder *gtsmodel.BookmarkFolder) *apimodel.BookmarkFolder {
+	return &apimodel.BookmarkFolder{
+		ID:    folder.ID,
+		Title: folder.Title,
+	}
+}
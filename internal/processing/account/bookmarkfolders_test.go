@@ -0,0 +1,117 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type BookmarkFoldersTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *BookmarkFoldersTestSuite) TestBookmarkFolders() {
+	var (
+		ctx         = suite.T().Context()
+		testAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Create a new folder.
+	folder, errWithCode := suite.accountProcessor.BookmarkFolderCreate(ctx, testAccount, "Recipes")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("Recipes", folder.Title)
+
+	// Creating another with the same title should conflict.
+	_, errWithCode = suite.accountProcessor.BookmarkFolderCreate(ctx, testAccount, "Recipes")
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Empty title isn't allowed.
+	_, errWithCode = suite.accountProcessor.BookmarkFolderCreate(ctx, testAccount, "")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	// Rename the folder.
+	folder, errWithCode = suite.accountProcessor.BookmarkFolderUpdate(ctx, testAccount, folder.ID, "Tasty recipes")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("Tasty recipes", folder.Title)
+
+	folders, errWithCode := suite.accountProcessor.BookmarkFoldersGet(ctx, testAccount)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal([]*apimodel.BookmarkFolder{folder}, folders)
+
+	// Other accounts can't see the folder.
+	_, errWithCode = suite.accountProcessor.BookmarkFolderGet(ctx, suite.testAccounts["local_account_2"], folder.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// File the account's existing bookmark in the folder.
+	bookmark := testrig.NewTestBookmarks()["local_account_1_admin_account_status_1"]
+	bookmark.FolderID = folder.ID
+	if err := suite.db.UpdateStatusBookmark(ctx, bookmark, "folder_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	resp, errWithCode := suite.accountProcessor.BookmarkFolderBookmarksGet(ctx, testAccount, folder.ID, 10, "", "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+	suite.Equal(bookmark.StatusID, resp.Items[0].(*apimodel.Status).ID)
+
+	// Bookmarks in folders are still
+	// listed in all bookmarks as well.
+	resp, errWithCode = suite.accountProcessor.BookmarksGet(ctx, testAccount, 10, "", "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+
+	// Delete the folder, bookmark should
+	// be moved back to the default folder.
+	if errWithCode := suite.accountProcessor.BookmarkFolderDelete(ctx, testAccount, folder.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	dbBookmark, err := suite.db.GetStatusBookmarkByID(ctx, bookmark.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbBookmark.FolderID)
+
+	_, errWithCode = suite.accountProcessor.BookmarkFolderGet(ctx, testAccount, folder.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	folders, errWithCode = suite.accountProcessor.BookmarkFoldersGet(ctx, testAccount)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(folders)
+}
+
+func TestBookmarkFoldersTestSuite(t *testing.T) {
+	suite.Run(t, new(BookmarkFoldersTestSuite))
+}
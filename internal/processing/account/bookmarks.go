@@ -20,6 +20,7 @@
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -37,6 +38,23 @@ func (p *Processor) BookmarksGet(ctx context.Context, requestingAccount *gtsmode
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	return p.packageBookmarks(ctx,
+		requestingAccount,
+		bookmarks,
+		"/api/v1/bookmarks",
+		limit,
+	)
+}
+
+// packageBookmarks converts the given bookmarks to a pageable response of
+// bookmarked statuses visible to requestingAccount, paged by bookmark ID.
+func (p *Processor) packageBookmarks(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	bookmarks []*gtsmodel.StatusBookmark,
+	path string,
+	limit int,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
 	count := len(bookmarks)
 	if count == 0 {
 		return util.EmptyPageableResponse(), nil
@@ -52,40 +70,40 @@ func (p *Processor) BookmarksGet(ctx context.Context, requestingAccount *gtsmode
 		prevMinIDValue = bookmarks[0].ID
 	)
 
-	for _, bookmark := range bookmarks {
-		status, err := p.state.DB.GetStatusByID(ctx, bookmark.StatusID)
-		if err != nil {
-			if errors.Is(err, db.ErrNoEntries) {
-				// We just don't have the status for some reason.
-				// Skip this one.
-				continue
-			}
-			return nil, gtserror.NewErrorInternalError(err) // A real error has occurred.
-		}
+	// Gather the bookmarked status IDs.
+	statusIDs := make([]string, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		statusIDs[i] = bookmark.StatusID
+	}
 
+	// Load all bookmarked statuses at once. Any
+	// we just don't have for some reason are skipped.
+	statuses, err := p.state.DB.GetStatusesByIDs(ctx, statusIDs)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err) // A real error has occurred.
+	}
+
+	// Drop statuses not visible to requester.
+	statuses = slices.DeleteFunc(statuses, func(status *gtsmodel.Status) bool {
 		visible, err := p.visFilter.StatusVisible(ctx, requestingAccount, status)
 		if err != nil {
 			log.Errorf(ctx, "error checking bookmarked status visibility: %s", err)
-			continue
-		}
-
-		if !visible {
-			continue
-		}
-
-		// Convert the status.
-		item, err := p.converter.StatusToAPIStatus(ctx, status, requestingAccount)
-		if err != nil {
-			log.Errorf(ctx, "error converting bookmarked status to api: %s", err)
-			continue
+			return true
 		}
+		return !visible
+	})
 
+	// Convert the statuses.
+	for _, item := range p.converter.StatusesToAPIStatuses(ctx,
+		requestingAccount,
+		statuses,
+	) {
 		items = append(items, item)
 	}
 
 	return util.PackagePageableResponse(util.PageableResponseParams{
 		Items:          items,
-		Path:           "/api/v1/bookmarks",
+		Path:           path,
 		NextMaxIDValue: nextMaxIDValue,
 		PrevMinIDValue: prevMinIDValue,
 		Limit:          limit,
@@ -0,0 +1,371 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"golang.org/x/net/html"
+)
+
+// customDomainHomepageMaxSize is the maximum number
+// of bytes of a custom domain's homepage to read when
+// looking for a rel="me" link to verify the domain.
+const customDomainHomepageMaxSize = 1 << 20 // 1MiB
+
+// CustomDomainGet returns the custom domain
+// requested by the given account, if any.
+func (p *Processor) CustomDomainGet(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (*apimodel.AccountCustomDomain, gtserror.WithCode) {
+	domain, errWithCode := p.getCustomDomain(ctx, account)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiCustomDomain(ctx, domain)
+}
+
+// CustomDomainSet sets the custom domain of the given account,
+// replacing any existing one. The domain must then be verified
+// and approved by an admin before it's put in use. Until then,
+// another account may claim the domain in its place.
+func (p *Processor) CustomDomainSet(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	domainStr string,
+) (*apimodel.AccountCustomDomain, gtserror.WithCode) {
+	if !config.GetAccountsAllowCustomDomains() {
+		const text = "custom domains are not enabled on this instance"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	domainStr = strings.TrimSpace(domainStr)
+	if domainStr == "" {
+		const text = "domain must be set"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Only accept bare domains, we can't
+	// serve a profile at a port or path.
+	if strings.ContainsAny(domainStr, ":/@") || !strings.Contains(domainStr, ".") {
+		const text = "domain must be a plain domain name, eg., example.com"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	domainStr, err := util.PunifySafely(domainStr)
+	if err != nil {
+		text := fmt.Sprintf("invalid domain: %v", err)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if config.IsLocalAccountDomain(domainStr) {
+		const text = "domain is already in use by this instance"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	existing, err := p.state.DB.GetAccountCustomDomainByDomain(
+		gtscontext.SetBarebones(ctx),
+		domainStr,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error checking existing custom domain: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		if existing.AccountID == account.ID {
+			// Account already has this
+			// domain, nothing to change.
+			return p.apiCustomDomain(ctx, existing)
+		}
+
+		if existing.IsVerified() {
+			const text = "domain is already in use by another account"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		// The other account's claim is still unverified, and
+		// may never be. Don't let it squat on the domain:
+		// drop it in favour of this new claim.
+		if err := p.state.DB.DeleteAccountCustomDomainByID(ctx, existing.ID); err != nil {
+			err := gtserror.Newf("db error deleting pending custom domain: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Remove any previous domain of the account,
+	// so that it can't be served any longer.
+	if err := p.state.DB.DeleteAccountCustomDomainByAccountID(ctx, account.ID); err != nil {
+		err := gtserror.Newf("db error deleting previous custom domain: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	domain := &gtsmodel.AccountCustomDomain{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Account:   account,
+		Domain:    domainStr,
+	}
+
+	if err := p.state.DB.PutAccountCustomDomain(ctx, domain); err != nil {
+		err := gtserror.Newf("db error putting custom domain: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiCustomDomain(ctx, domain)
+}
+
+// CustomDomainDelete removes the custom domain
+// of the given account, returning the removed domain.
+func (p *Processor) CustomDomainDelete(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (*apimodel.AccountCustomDomain, gtserror.WithCode) {
+	domain, errWithCode := p.getCustomDomain(ctx, account)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteAccountCustomDomainByID(ctx, domain.ID); err != nil {
+		err := gtserror.Newf("db error deleting custom domain: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiCustomDomain(ctx, domain)
+}
+
+// CustomDomainVerify checks whether the given account has proven
+// control of its custom domain, either by adding a DNS TXT record
+// to the domain, or a rel="me" link to the homepage of the domain.
+func (p *Processor) CustomDomainVerify(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (*apimodel.AccountCustomDomain, gtserror.WithCode) {
+	domain, errWithCode := p.getCustomDomain(ctx, account)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if domain.IsVerified() {
+		// Nothing
+		// to do.
+		return p.apiCustomDomain(ctx, domain)
+	}
+
+	if !p.verifyCustomDomain(ctx, domain) {
+		text := fmt.Sprintf(
+			"could not verify control of %s: add a DNS TXT record %s with value %s, "+
+				"or a link to %s with rel=\"me\" to https://%s/, then try again",
+			domain.Domain,
+			domain.VerificationTXTName(),
+			domain.VerificationTXTValue(),
+			account.URL,
+			domain.Domain,
+		)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	domain.VerifiedAt = time.Now()
+	if err := p.state.DB.UpdateAccountCustomDomain(ctx, domain, "verified_at"); err != nil {
+		err := gtserror.Newf("db error updating custom domain: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiCustomDomain(ctx, domain)
+}
+
+// CustomDomainProfileURLGet returns the profile URL of the account
+// whose active custom domain is the given host, or an empty
+// string if host is not the active custom domain of any account.
+func (p *Processor) CustomDomainProfileURLGet(
+	ctx context.Context,
+	host string,
+) (string, gtserror.WithCode) {
+	if !config.GetAccountsAllowCustomDomains() ||
+		config.IsLocalAccountDomain(host) {
+		// Can't be a
+		// custom domain.
+		return "", nil
+	}
+
+	domain, err := p.state.DB.GetAccountCustomDomainByDomain(ctx, strings.ToLower(host))
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting custom domain: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	if domain == nil || !domain.IsActive() {
+		return "", nil
+	}
+
+	return domain.Account.URL, nil
+}
+
+// verifyCustomDomain returns whether control of the given
+// domain can be verified via DNS TXT record or rel="me" link.
+func (p *Processor) verifyCustomDomain(
+	ctx context.Context,
+	domain *gtsmodel.AccountCustomDomain,
+) bool {
+	tsport, err := p.federator.TransportController().NewTransportForUsername(ctx, "")
+	if err != nil {
+		log.Errorf(ctx, "error getting transport: %v", err)
+		return false
+	}
+
+	// Check DNS first, as it's cheapest.
+	txts, err := tsport.LookupTXT(ctx, domain.VerificationTXTName())
+	if err != nil {
+		log.Debugf(ctx, "error looking up txt records for %s: %v", domain.Domain, err)
+	} else if slices.Contains(txts, domain.VerificationTXTValue()) {
+		return true
+	}
+
+	// Fall back to checking homepage.
+	verified, err := customDomainRelMe(ctx, tsport, domain)
+	if err != nil {
+		log.Debugf(ctx, "error checking homepage of %s: %v", domain.Domain, err)
+	}
+
+	return verified
+}
+
+// customDomainRelMe returns whether the homepage of the
+// given domain contains a rel="me" link to the profile
+// of the account that requested the domain.
+func customDomainRelMe(
+	ctx context.Context,
+	tsport transport.Transport,
+	domain *gtsmodel.AccountCustomDomain,
+) (bool, error) {
+	// This is a one-off check triggered by
+	// the user, so don't retry on failure.
+	ctx = gtscontext.SetFastFail(ctx)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+domain.Domain+"/", nil)
+	if err != nil {
+		return false, gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "text/html")
+
+	rsp, err := tsport.GET(req)
+	if err != nil {
+		return false, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return false, gtserror.Newf("unexpected status code %d", rsp.StatusCode)
+	}
+
+	body := io.LimitReader(rsp.Body, customDomainHomepageMaxSize)
+	return hasRelMeLink(body, domain.Account.URL), nil
+}
+
+// hasRelMeLink returns whether the given html contains an
+// <a> or <link> element with rel="me" pointing to href.
+func hasRelMeLink(r io.Reader, href string) bool {
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// EOF or
+			// malformed.
+			return false
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			if !hasAttr {
+				continue
+			}
+
+			if tag := string(name); tag != "a" && tag != "link" {
+				continue
+			}
+
+			var rel, linkHref string
+			for more := true; more; {
+				var key, val []byte
+				key, val, more = z.TagAttr()
+				switch string(key) {
+				case "rel":
+					rel = string(val)
+				case "href":
+					linkHref = string(val)
+				}
+			}
+
+			if linkHref == href &&
+				slices.Contains(strings.Fields(strings.ToLower(rel)), "me") {
+				return true
+			}
+		}
+	}
+}
+
+// getCustomDomain fetches the custom domain of the
+// given account, returning 404 if it doesn't have one.
+func (p *Processor) getCustomDomain(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (*gtsmodel.AccountCustomDomain, gtserror.WithCode) {
+	domain, err := p.state.DB.GetAccountCustomDomainByAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting custom domain: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if domain == nil {
+		const text = "account has no custom domain"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return domain, nil
+}
+
+func (p *Processor) apiCustomDomain(
+	ctx context.Context,
+	domain *gtsmodel.AccountCustomDomain,
+) (*apimodel.AccountCustomDomain, gtserror.WithCode) {
+	apiDomain, err := p.converter.CustomDomainToAPICustomDomain(ctx, domain, false)
+	if err != nil {
+		err := gtserror.Newf("error converting custom domain to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDomain, nil
+}
@@ -0,0 +1,331 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/account"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomDomainTestSuite struct {
+	AccountStandardTestSuite
+}
+
+// processorWithHomepage returns an account processor whose
+// http client serves the given html as homepage of domain.
+func (suite *CustomDomainTestSuite) processorWithHomepage(domain string, homepage string) *account.Processor {
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://"+domain+"/" {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"text/html"}},
+			Body:       io.NopCloser(strings.NewReader(homepage)),
+		}, nil
+	}, "")
+
+	return suite.processorWithClient(httpClient)
+}
+
+// processorWithTXT returns an account processor whose http
+// client resolves the given DNS TXT record name to value.
+func (suite *CustomDomainTestSuite) processorWithTXT(name string, value string) *account.Processor {
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	}, "")
+	httpClient.TestTXTRecords = map[string][]string{name: {value}}
+
+	return suite.processorWithClient(httpClient)
+}
+
+func (suite *CustomDomainTestSuite) TestCustomDomainSet() {
+	var (
+		ctx          = suite.T().Context()
+		zork         = suite.testAccounts["local_account_1"]
+		turtle       = suite.testAccounts["local_account_2"]
+		accountAlias = "brand-a.com"
+	)
+
+	config.SetAccountDomainAliases([]string{accountAlias})
+
+	for _, test := range []struct {
+		domain      string
+		expectedErr string
+	}{
+		{
+			domain:      "",
+			expectedErr: "domain must be set",
+		},
+		{
+			domain:      "https://example.org/",
+			expectedErr: "domain must be a plain domain name, eg., example.com",
+		},
+		{
+			domain:      "localhost",
+			expectedErr: "domain must be a plain domain name, eg., example.com",
+		},
+		{
+			domain:      accountAlias,
+			expectedErr: "domain is already in use by this instance",
+		},
+	} {
+		_, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, zork, test.domain)
+		if suite.NotNil(errWithCode, test.domain) {
+			suite.Equal(test.expectedErr, errWithCode.Error())
+		}
+	}
+
+	// Set a valid domain,
+	// should be stored punified.
+	domain, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, zork, " Zörk.Example.org ")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("zörk.example.org", domain.Domain)
+	suite.Equal("_gotosocial.xn--zrk-sna.example.org", domain.VerificationTXTName)
+	suite.Equal("gotosocial-account="+zork.URI, domain.VerificationTXTValue)
+	suite.Equal(zork.URL, domain.VerificationRelMe)
+	suite.Nil(domain.VerifiedAt)
+	suite.Nil(domain.ApprovedAt)
+	suite.False(domain.Active)
+
+	// Setting a new domain replaces the old one.
+	if _, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, zork, "zork.example.org"); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	domain, errWithCode = suite.accountProcessor.CustomDomainGet(ctx, zork)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("zork.example.org", domain.Domain)
+
+	// So now the old one is free.
+	if _, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, turtle, "xn--zrk-sna.example.org"); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
+func (suite *CustomDomainTestSuite) TestCustomDomainSetPendingClaim() {
+	var (
+		ctx    = suite.T().Context()
+		zork   = suite.testAccounts["local_account_1"]
+		turtle = suite.testAccounts["local_account_2"]
+		domain = "zork.example.org"
+	)
+
+	// Zork claims the domain, but never verifies it.
+	if _, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, zork, domain); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// So turtle can claim it instead.
+	if _, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, turtle, domain); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Zork's claim is gone.
+	_, errWithCode := suite.accountProcessor.CustomDomainGet(ctx, zork)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusNotFound, errWithCode.Code())
+	}
+
+	// Verify and approve turtle's claim.
+	dbDomain, err := suite.db.GetAccountCustomDomainByDomain(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(turtle.ID, dbDomain.AccountID)
+
+	dbDomain.VerifiedAt = time.Now()
+	dbDomain.ApprovedAt = time.Now()
+	if err := suite.db.UpdateAccountCustomDomain(ctx, dbDomain, "verified_at", "approved_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Now zork can't take it back.
+	_, errWithCode = suite.accountProcessor.CustomDomainSet(ctx, zork, domain)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusConflict, errWithCode.Code())
+	}
+}
+
+func (suite *CustomDomainTestSuite) TestCustomDomainSetVerifiedClaim() {
+	var (
+		ctx    = suite.T().Context()
+		zork   = suite.testAccounts["local_account_1"]
+		turtle = suite.testAccounts["local_account_2"]
+		domain = "zork.example.org"
+	)
+
+	// Zork claims the domain.
+	if _, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, zork, domain); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Verify zork's claim, but
+	// don't approve it (yet).
+	dbDomain, err := suite.db.GetAccountCustomDomainByDomain(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbDomain.VerifiedAt = time.Now()
+	if err := suite.db.UpdateAccountCustomDomain(ctx, dbDomain, "verified_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Turtle can't take over the verified claim.
+	_, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, turtle, domain)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusConflict, errWithCode.Code())
+	}
+
+	// Zork's claim is still there.
+	dbDomain, err = suite.db.GetAccountCustomDomainByDomain(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(zork.ID, dbDomain.AccountID)
+}
+
+func (suite *CustomDomainTestSuite) TestCustomDomainSetNotAllowed() {
+	config.SetAccountsAllowCustomDomains(false)
+
+	_, errWithCode := suite.accountProcessor.CustomDomainSet(
+		suite.T().Context(),
+		suite.testAccounts["local_account_1"],
+		"zork.example.org",
+	)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusForbidden, errWithCode.Code())
+	}
+}
+
+func (suite *CustomDomainTestSuite) TestCustomDomainVerifyRelMe() {
+	var (
+		ctx    = suite.T().Context()
+		zork   = suite.testAccounts["local_account_1"]
+		domain = "zork.invalid"
+	)
+
+	if _, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, zork, domain); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Homepage doesn't link to zork, so
+	// verification should fail for now.
+	processor := suite.processorWithHomepage(domain, `<html><body>
+<a href="https://example.org/@someone_else" rel="me">me elsewhere</a>
+<a href="`+zork.URL+`">not rel me</a>
+</body></html>`)
+
+	_, errWithCode := processor.CustomDomainVerify(ctx, zork)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	}
+
+	// Now link to zork with rel="me".
+	processor = suite.processorWithHomepage(domain, `<html><head>
+<link rel="stylesheet" href="/style.css">
+<link rel="Me authn" href="`+zork.URL+`">
+</head></html>`)
+
+	apiDomain, errWithCode := processor.CustomDomainVerify(ctx, zork)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotNil(apiDomain.VerifiedAt)
+
+	// Not active until approved.
+	suite.False(apiDomain.Active)
+	profileURL, errWithCode := suite.accountProcessor.CustomDomainProfileURLGet(ctx, domain)
+	suite.Nil(errWithCode)
+	suite.Empty(profileURL)
+
+	// Approve the domain.
+	dbDomain, err := suite.db.GetAccountCustomDomainByDomain(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	dbDomain.ApprovedAt = time.Now()
+	if err := suite.db.UpdateAccountCustomDomain(ctx, dbDomain, "approved_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Domain should now redirect to zork.
+	profileURL, errWithCode = suite.accountProcessor.CustomDomainProfileURLGet(ctx, domain)
+	suite.Nil(errWithCode)
+	suite.Equal(zork.URL, profileURL)
+
+	// But not once it's deleted.
+	if _, errWithCode := suite.accountProcessor.CustomDomainDelete(ctx, zork); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	profileURL, errWithCode = suite.accountProcessor.CustomDomainProfileURLGet(ctx, domain)
+	suite.Nil(errWithCode)
+	suite.Empty(profileURL)
+}
+
+func (suite *CustomDomainTestSuite) TestCustomDomainVerifyTXT() {
+	var (
+		ctx    = suite.T().Context()
+		zork   = suite.testAccounts["local_account_1"]
+		domain = "zork.invalid"
+	)
+
+	apiDomain, errWithCode := suite.accountProcessor.CustomDomainSet(ctx, zork, domain)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Wrong TXT record value, can't verify.
+	processor := suite.processorWithTXT(apiDomain.VerificationTXTName, "gotosocial-account=https://example.org/users/someone_else")
+	_, errWithCode = processor.CustomDomainVerify(ctx, zork)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	}
+
+	// Right TXT record value, verified.
+	processor = suite.processorWithTXT(apiDomain.VerificationTXTName, apiDomain.VerificationTXTValue)
+	apiDomain, errWithCode = processor.CustomDomainVerify(ctx, zork)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotNil(apiDomain.VerifiedAt)
+}
+
+func TestCustomDomainTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomDomainTestSuite))
+}
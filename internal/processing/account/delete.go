@@ -148,6 +148,11 @@ func (p *Processor) deleteUserAndTokensForAccount(
 		}
 	}
 
+	// Delete the user's sign-in history.
+	if err := p.state.DB.DeleteUserLoginsByUserID(ctx, user.ID); err != nil {
+		log.Errorf("error deleting user logins: %v", err)
+	}
+
 	// Delete any web push subscriptions created by this local user account.
 	if err := p.state.DB.DeleteWebPushSubscriptionsByAccountID(ctx, account.ID); err != nil {
 		log.Errorf("error deleting account web push subscriptions: %v", err)
@@ -464,6 +469,36 @@ func (p *Processor) deleteAccountPeripheral(
 		if err := p.state.DB.DeleteScheduledStatusesByAccountID(ctx, account.ID); err != nil {
 			log.Errorf("error deleting scheduled statuses for account: %v", err)
 		}
+
+		// Delete bookmark folders owned by given account, only for local.
+		if err := p.state.DB.DeleteBookmarkFoldersByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting bookmark folders for account: %v", err)
+		}
+
+		// Delete announcement reads and reactions of given account, only for local.
+		if err := p.state.DB.DeleteAnnouncementReadsAndReactionsByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting announcement reads and reactions for account: %v", err)
+		}
+
+		// Delete status cleanups of given account, only for local.
+		if err := p.state.DB.DeleteStatusCleanupsByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting status cleanups for account: %v", err)
+		}
+
+		// Delete invites created by given account, only for local.
+		if err := p.state.DB.DeleteInvitesByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting invites for account: %v", err)
+		}
+
+		// Delete custom domain of given account, only for local.
+		if err := p.state.DB.DeleteAccountCustomDomainByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting custom domain for account: %v", err)
+		}
+
+		// Delete blocklist subscriptions of given account, only for local.
+		if err := p.state.DB.DeleteBlocklistSubscriptionsByAccountID(ctx, account.ID); err != nil {
+			log.Errorf("error deleting blocklist subscriptions for account: %v", err)
+		}
 	}
 
 	// Delete all bookmarks targeting given account, local and remote.
@@ -554,9 +589,12 @@ func stubbifyAccount(account *gtsmodel.Account, origin string) []string {
 	account.Fields = nil
 	account.Note = ""
 	account.NoteRaw = ""
+	account.Pronouns = ""
+	account.Location = ""
 	account.MemorializedAt = never
 	account.AlsoKnownAsURIs = nil
 	account.MovedToURI = ""
+	account.OperatorURI = ""
 	account.Discoverable = util.Ptr(false)
 	account.Indexable = util.Ptr(false)
 	account.SuspendedAt = now
@@ -573,9 +611,12 @@ func stubbifyAccount(account *gtsmodel.Account, origin string) []string {
 		"fields",
 		"note",
 		"note_raw",
+		"pronouns",
+		"location",
 		"memorialized_at",
 		"also_known_as_uris",
 		"moved_to_uri",
+		"operator_uri",
 		"discoverable",
 		"suspended_at",
 		"suspension_origin",
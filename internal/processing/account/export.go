@@ -23,6 +23,7 @@
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
@@ -157,3 +158,108 @@ func (p *Processor) ExportMutes(
 
 	return records, nil
 }
+
+// ExportBlocksJSON is like ExportBlocks, but
+// returns blocks as JSON-compatible entries.
+func (p *Processor) ExportBlocksJSON(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]apimodel.BlockExport, gtserror.WithCode) {
+	records, errWithCode := p.ExportBlocks(ctx, requester)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Records are only the account address.
+	entries := make([]apimodel.BlockExport, 0, len(records))
+	for _, record := range records {
+		entries = append(entries, apimodel.BlockExport{
+			Account: record[0],
+		})
+	}
+
+	return entries, nil
+}
+
+// ExportMutesJSON is like ExportMutes, but
+// returns mutes as JSON-compatible entries.
+func (p *Processor) ExportMutesJSON(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]apimodel.MuteExport, gtserror.WithCode) {
+	records, errWithCode := p.ExportMutes(ctx, requester)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Skip the header row at the top of records.
+	entries := make([]apimodel.MuteExport, 0, len(records)-1)
+	for _, record := range records[1:] {
+		hideNotifications := record[1] == "true"
+		entries = append(entries, apimodel.MuteExport{
+			Account:           record[0],
+			HideNotifications: &hideNotifications,
+		})
+	}
+
+	return entries, nil
+}
+
+// ExportFaves returns a CSV file of
+// statuses that the requester has faved.
+func (p *Processor) ExportFaves(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([][]string, gtserror.WithCode) {
+	// Fetch faves by requester,
+	// using a nil page to get everything.
+	faves, err := p.state.DB.GetFavedTimeline(ctx, requester.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting faves: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Populate the faved statuses; we only
+	// need their URIs so barebones is fine.
+	for _, fave := range faves {
+		fave.Status, err = p.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			fave.StatusID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err = gtserror.Newf("db error getting faved status %s: %w", fave.StatusID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Convert faves to CSV-compatible records.
+	records, err := p.converter.FavesToCSV(ctx, faves)
+	if err != nil {
+		err = gtserror.Newf("error converting faves to records: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return records, nil
+}
+
+// ExportNotes returns a CSV file of private
+// notes the requester has left on accounts.
+func (p *Processor) ExportNotes(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([][]string, gtserror.WithCode) {
+	notes, err := p.state.DB.GetNotesByAccountID(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting notes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Convert notes to CSV-compatible records.
+	records, err := p.converter.NotesToCSV(ctx, notes)
+	if err != nil {
+		err = gtserror.Newf("error converting notes to records: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return records, nil
+}
@@ -103,13 +103,28 @@ func (p *Processor) FollowRequestsGet(ctx context.Context, requestingAccount *gt
 		return followRequests[i].Account
 	}
 
-	// Get a filtered slice of public API account models.
-	items := p.c.GetVisibleAPIAccountsPaged(ctx,
+	// Get a filtered slice of API account models.
+	accounts := p.c.GetVisibleAPIAccounts(ctx,
 		requestingAccount,
 		getIdx,
 		count,
 	)
 
+	// Gather messages sent with follow
+	// requests, keyed by requester ID.
+	reqMessages := make(map[string]string, count)
+	for _, followRequest := range followRequests {
+		if followRequest.Message != "" {
+			reqMessages[followRequest.AccountID] = followRequest.Message
+		}
+	}
+
+	items := make([]interface{}, len(accounts))
+	for i, account := range accounts {
+		account.FollowRequestMessage = reqMessages[account.ID]
+		items[i] = account
+	}
+
 	return paging.PackageResponse(paging.ResponseParams{
 		Items: items,
 		Path:  "/api/v1/follow_requests",
@@ -20,9 +20,14 @@
 import (
 	"context"
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"mime"
 	"mime/multipart"
+	"path"
+	"strconv"
+	"strings"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -69,6 +74,15 @@ func (p *Processor) ImportData(
 	}
 }
 
+// isJSONData returns whether the uploaded data file
+// is JSON rather than CSV, going by its content type
+// or, failing that, its file extension.
+func isJSONData(data *multipart.FileHeader) bool {
+	contentType, _, _ := mime.ParseMediaType(data.Header.Get("Content-Type"))
+	return contentType == "application/json" ||
+		strings.EqualFold(path.Ext(data.Filename), ".json")
+}
+
 func (p *Processor) importFollowing(
 	ctx context.Context,
 	requester *gtsmodel.Account,
@@ -89,6 +103,19 @@ func (p *Processor) importFollowing(
 		return gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
+	return p.ImportFollowingRecords(ctx, requester, records, overwrite)
+}
+
+// ImportFollowingRecords follows, from requester, each of the
+// accounts in the given following CSV records, in the same
+// format as produced by ExportFollowing. The follows are
+// created asynchronously after the records are validated.
+func (p *Processor) ImportFollowingRecords(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	records [][]string,
+	overwrite bool,
+) gtserror.WithCode {
 	// Convert the records into a slice of barebones follows.
 	//
 	// Only TargetAccount.Username, TargetAccount.Domain,
@@ -253,11 +280,26 @@ func (p *Processor) importBlocks(
 	}
 	defer file.Close()
 
-	// Parse records out of the file.
-	records, err := csv.NewReader(file).ReadAll()
-	if err != nil {
-		err := fmt.Errorf("error reading blocks data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+	// Parse records out of the file,
+	// converting from JSON if needed.
+	var records [][]string
+	if isJSONData(blocksData) {
+		var entries []apimodel.BlockExport
+		if err := json.NewDecoder(file).Decode(&entries); err != nil {
+			err := fmt.Errorf("error reading blocks data file: %w", err)
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		records = make([][]string, 0, len(entries))
+		for _, entry := range entries {
+			records = append(records, []string{entry.Account})
+		}
+	} else {
+		records, err = csv.NewReader(file).ReadAll()
+		if err != nil {
+			err := fmt.Errorf("error reading blocks data file: %w", err)
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
 	}
 
 	// Convert the records into a slice of barebones blocks.
@@ -399,11 +441,30 @@ func (p *Processor) importMutes(
 	}
 	defer file.Close()
 
-	// Parse records out of the file.
-	records, err := csv.NewReader(file).ReadAll()
-	if err != nil {
-		err := fmt.Errorf("error reading mutes data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+	// Parse records out of the file,
+	// converting from JSON if needed.
+	var records [][]string
+	if isJSONData(mutesData) {
+		var entries []apimodel.MuteExport
+		if err := json.NewDecoder(file).Decode(&entries); err != nil {
+			err := fmt.Errorf("error reading mutes data file: %w", err)
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		records = make([][]string, 0, len(entries))
+		for _, entry := range entries {
+			record := []string{entry.Account}
+			if entry.HideNotifications != nil {
+				record = append(record, strconv.FormatBool(*entry.HideNotifications))
+			}
+			records = append(records, record)
+		}
+	} else {
+		records, err = csv.NewReader(file).ReadAll()
+		if err != nil {
+			err := fmt.Errorf("error reading mutes data file: %w", err)
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
 	}
 
 	// Convert the records into a slice of barebones mutes.
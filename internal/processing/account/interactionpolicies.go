@@ -20,6 +20,7 @@
 import (
 	"cmp"
 	"context"
+	"errors"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
@@ -110,9 +111,23 @@ func (p *Processor) DefaultInteractionPoliciesUpdate(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Get the policy to use for any
+	// visibility left unspecified;
+	// nil means the global default.
+	var presetFor func(gtsmodel.Visibility) *gtsmodel.InteractionPolicy
+	switch form.Preset {
+	case "":
+		presetFor = func(gtsmodel.Visibility) *gtsmodel.InteractionPolicy { return nil }
+	case "bot":
+		presetFor = gtsmodel.BotInteractionPolicyFor
+	default:
+		text := "preset " + form.Preset + " not recognized; supported presets are: bot"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
 	if form.Direct == nil {
-		// Unset/return to global default.
-		requester.Settings.InteractionPolicyDirect = nil
+		// Unset/return to preset.
+		requester.Settings.InteractionPolicyDirect = presetFor(gtsmodel.VisibilityDirect)
 	} else {
 		policy, err := typeutils.APIInteractionPolicyToInteractionPolicy(
 			form.Direct,
@@ -127,8 +142,8 @@ func (p *Processor) DefaultInteractionPoliciesUpdate(
 	}
 
 	if form.Private == nil {
-		// Unset/return to global default.
-		requester.Settings.InteractionPolicyFollowersOnly = nil
+		// Unset/return to preset.
+		requester.Settings.InteractionPolicyFollowersOnly = presetFor(gtsmodel.VisibilityFollowersOnly)
 	} else {
 		policy, err := typeutils.APIInteractionPolicyToInteractionPolicy(
 			form.Private,
@@ -143,8 +158,8 @@ func (p *Processor) DefaultInteractionPoliciesUpdate(
 	}
 
 	if form.Unlisted == nil {
-		// Unset/return to global default.
-		requester.Settings.InteractionPolicyUnlocked = nil
+		// Unset/return to preset.
+		requester.Settings.InteractionPolicyUnlocked = presetFor(gtsmodel.VisibilityUnlocked)
 	} else {
 		policy, err := typeutils.APIInteractionPolicyToInteractionPolicy(
 			form.Unlisted,
@@ -159,8 +174,8 @@ func (p *Processor) DefaultInteractionPoliciesUpdate(
 	}
 
 	if form.Public == nil {
-		// Unset/return to global default.
-		requester.Settings.InteractionPolicyPublic = nil
+		// Unset/return to preset.
+		requester.Settings.InteractionPolicyPublic = presetFor(gtsmodel.VisibilityPublic)
 	} else {
 		policy, err := typeutils.APIInteractionPolicyToInteractionPolicy(
 			form.Public,
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type InteractionPoliciesTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *InteractionPoliciesTestSuite) TestUpdateBotPreset() {
+	ctx := suite.T().Context()
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	testAccount.Settings = nil
+
+	policies, errWithCode := suite.accountProcessor.DefaultInteractionPoliciesUpdate(
+		ctx,
+		testAccount,
+		&apimodel.UpdateInteractionPoliciesRequest{
+			Preset: "bot",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Public and unlisted replies should be
+	// limited to followers and mentioned.
+	for _, policy := range []apimodel.InteractionPolicy{
+		policies.Public,
+		policies.Unlisted,
+	} {
+		suite.Equal([]apimodel.PolicyValue{
+			apimodel.PolicyValueAuthor,
+			apimodel.PolicyValueFollowers,
+			apimodel.PolicyValueMentioned,
+		}, policy.CanReply.AutomaticApproval)
+		suite.Equal([]apimodel.PolicyValue{
+			apimodel.PolicyValuePublic,
+		}, policy.CanFavourite.AutomaticApproval)
+	}
+
+	// Preset should be stored as the account's settings.
+	settings, err := suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(
+		gtsmodel.BotInteractionPolicyFor(gtsmodel.VisibilityPublic),
+		settings.InteractionPolicyPublic,
+	)
+
+	// Resetting should return to the global default.
+	policies, errWithCode = suite.accountProcessor.DefaultInteractionPoliciesUpdate(
+		ctx,
+		testAccount,
+		&apimodel.UpdateInteractionPoliciesRequest{},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal([]apimodel.PolicyValue{
+		apimodel.PolicyValuePublic,
+	}, policies.Public.CanReply.AutomaticApproval)
+}
+
+func (suite *InteractionPoliciesTestSuite) TestUpdateUnknownPreset() {
+	_, errWithCode := suite.accountProcessor.DefaultInteractionPoliciesUpdate(
+		suite.T().Context(),
+		suite.testAccounts["local_account_1"],
+		&apimodel.UpdateInteractionPoliciesRequest{
+			Preset: "robot",
+		},
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestInteractionPoliciesTestSuite(t *testing.T) {
+	suite.Run(t, new(InteractionPoliciesTestSuite))
+}
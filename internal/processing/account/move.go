@@ -95,7 +95,10 @@ func (p *Processor) MoveSelf(
 				"you will not be able to Move to that account",
 			targetAcctURIStr,
 		)
-		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		return gtserror.WithErrCode(
+			gtserror.NewErrorUnprocessableEntity(errors.New(text), text),
+			gtserror.ErrCodeDomainBlocked,
+		)
 	}
 
 	var (
@@ -165,3 +165,27 @@ func (p *Processor) RelationshipGet(ctx context.Context, requestingAccount *gtsm
 
 	return r, nil
 }
+
+// RelationshipsGet returns relationship models describing the relationships of the targetAccounts to the Authed
+// account, in the same order as the given target account IDs. Relationships are fetched from the database in bulk.
+func (p *Processor) RelationshipsGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountIDs []string) ([]apimodel.Relationship, gtserror.WithCode) {
+	if requestingAccount == nil {
+		return nil, gtserror.NewErrorForbidden(gtserror.New("not authed"))
+	}
+
+	gtsRs, err := p.state.DB.GetRelationships(ctx, requestingAccount.ID, targetAccountIDs)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(gtserror.Newf("error getting relationships: %s", err))
+	}
+
+	rs := make([]apimodel.Relationship, 0, len(gtsRs))
+	for _, gtsR := range gtsRs {
+		r, err := p.converter.RelationshipToAPIRelationship(ctx, gtsR)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(gtserror.Newf("error converting relationship: %s", err))
+		}
+		rs = append(rs, *r)
+	}
+
+	return rs, nil
+}
@@ -27,6 +27,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/gorilla/feeds"
 )
 
@@ -168,9 +169,13 @@ func (p *Processor) GetRSSFeedForUsername(ctx context.Context, username string,
 		next := page.Next(lo, hi)
 		prev := page.Prev(lo, hi)
 
+		// Check whether the account opted in to
+		// embedding media in its feed item content.
+		fullContent := util.PtrOrZero(account.Settings.RSSFullContent)
+
 		// Add each status to the rss feed.
 		for _, status := range statuses {
-			item, err := p.converter.StatusToRSSItem(ctx, status)
+			item, err := p.converter.StatusToRSSItem(ctx, status, fullContent)
 			if err != nil {
 				err := gtserror.Newf("error converting status to feed item: %w", err)
 				return nil, gtserror.NewErrorInternalError(err)
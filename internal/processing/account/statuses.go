@@ -22,6 +22,7 @@
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -102,35 +103,37 @@ func (p *Processor) StatusesGet(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	for _, status := range filtered {
-		// Apply status filtering in account context to each of the statuses.
-		filtered, hide, err := p.statusFilter.StatusFilterResultsInContext(ctx,
+	// Filter results of statuses to keep, by ID.
+	results := make(map[string][]apimodel.FilterResult, len(filtered))
+
+	// Apply status filtering in account context to each of the statuses.
+	filtered = slices.DeleteFunc(filtered, func(status *gtsmodel.Status) bool {
+		result, hide, err := p.statusFilter.StatusFilterResultsInContext(ctx,
 			requester,
 			status,
 			gtsmodel.FilterContextAccount,
 		)
 		if err != nil {
 			log.Errorf(ctx, "error filtering status: %v", err)
-			continue
+			return true
 		}
 
 		if hide {
 			// Don't show.
-			continue
+			return true
 		}
 
-		// Convert filtered statuses to API statuses.
-		item, err := p.converter.StatusToAPIStatus(ctx,
-			status,
-			requester,
-		)
-		if err != nil {
-			log.Errorf(ctx, "error convering to api status: %v", err)
-			continue
-		}
+		results[status.ID] = result
+		return false
+	})
 
+	// Convert filtered statuses to API statuses.
+	for _, item := range p.converter.StatusesToAPIStatuses(ctx,
+		requester,
+		filtered,
+	) {
 		// Set any filter results.
-		item.Filtered = filtered
+		item.Filtered = results[item.ID]
 
 		// Append item to ret slice.
 		items = append(items, item)
@@ -28,9 +28,13 @@
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 )
 
+// TokensGet returns a page of token info for tokens
+// belonging to the given user. The token with access
+// code currentAccess, if any, will be marked as current.
 func (p *Processor) TokensGet(
 	ctx context.Context,
 	userID string,
+	currentAccess string,
 	page *paging.Page,
 ) (*apimodel.PageableResponse, gtserror.WithCode) {
 	tokens, err := p.state.DB.GetAccessTokens(ctx, userID, page)
@@ -60,6 +64,7 @@ func (p *Processor) TokensGet(
 			log.Errorf(ctx, "error converting token to api token info: %v", err)
 			continue
 		}
+		tokenInfo.Current = (token.Access == currentAccess)
 
 		// Append req to return items.
 		items = append(items, tokenInfo)
@@ -73,9 +78,13 @@ func (p *Processor) TokensGet(
 	}), nil
 }
 
+// TokenGet returns token info for the given token ID, if it
+// belongs to the given user. If the token has access code
+// currentAccess, it will be marked as current.
 func (p *Processor) TokenGet(
 	ctx context.Context,
 	userID string,
+	currentAccess string,
 	tokenID string,
 ) (*apimodel.TokenInfo, gtserror.WithCode) {
 	token, err := p.state.DB.GetTokenByID(ctx, tokenID)
@@ -99,16 +108,20 @@ func (p *Processor) TokenGet(
 		err := gtserror.Newf("error converting token to api token info: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
+	tokenInfo.Current = (token.Access == currentAccess)
 
 	return tokenInfo, nil
 }
 
+// TokenInvalidate deletes the given token ID, if it
+// belongs to the given user, returning info about it.
 func (p *Processor) TokenInvalidate(
 	ctx context.Context,
 	userID string,
+	currentAccess string,
 	tokenID string,
 ) (*apimodel.TokenInfo, gtserror.WithCode) {
-	tokenInfo, errWithCode := p.TokenGet(ctx, userID, tokenID)
+	tokenInfo, errWithCode := p.TokenGet(ctx, userID, currentAccess, tokenID)
 	if errWithCode != nil {
 		return nil, errWithCode
 	}
@@ -120,3 +133,44 @@ func (p *Processor) TokenInvalidate(
 
 	return tokenInfo, nil
 }
+
+// TokensInvalidateOthers deletes all tokens belonging to the given user,
+// except for the token with access code currentAccess (ie., the token
+// used to make this request), returning info about the deleted tokens.
+func (p *Processor) TokensInvalidateOthers(
+	ctx context.Context,
+	userID string,
+	currentAccess string,
+) ([]*apimodel.TokenInfo, gtserror.WithCode) {
+	tokens, err := p.state.DB.GetAccessTokens(ctx, userID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tokens: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	tokenInfos := make([]*apimodel.TokenInfo, 0, len(tokens))
+	for _, token := range tokens {
+		if token.Access == currentAccess {
+			// Leave the token
+			// making this request.
+			continue
+		}
+
+		// Always delete the token, even if we
+		// fail to describe it in the response.
+		if err := p.state.DB.DeleteTokenByID(ctx, token.ID); err != nil {
+			err := gtserror.Newf("db error deleting token %s: %w", token.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		tokenInfo, err := p.converter.TokenToAPITokenInfo(ctx, token)
+		if err != nil {
+			log.Errorf(ctx, "error converting deleted token %s to api token info: %v", token.ID, err)
+			continue
+		}
+
+		tokenInfos = append(tokenInfos, tokenInfo)
+	}
+
+	return tokenInfos, nil
+}
@@ -23,11 +23,14 @@
 	"fmt"
 	"io"
 	"mime/multipart"
+	"strings"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gopkg/xslices"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
@@ -95,6 +98,22 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		acctColumns = append(acctColumns, "actor_type")
 	}
 
+	if form.Bot != nil && !*form.Bot {
+		// Account is not (or no longer)
+		// a bot, so clear any operator set,
+		// ignoring any operator_uri given.
+		if account.OperatorURI != "" {
+			account.OperatorURI = ""
+			account.Operator = nil
+			acctColumns = append(acctColumns, "operator_uri")
+		}
+	} else if form.OperatorURI != nil {
+		if errWithCode := p.updateOperator(ctx, account, *form.OperatorURI); errWithCode != nil {
+			return nil, errWithCode
+		}
+		acctColumns = append(acctColumns, "operator_uri")
+	}
+
 	if form.Locked != nil {
 		account.Locked = form.Locked
 		acctColumns = append(acctColumns, "locked")
@@ -107,7 +126,10 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 
 		displayName := *form.DisplayName
 		if err := validate.DisplayName(displayName); err != nil {
-			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			return nil, gtserror.WithErrCode(
+				gtserror.NewErrorBadRequest(err, err.Error()),
+				gtserror.ErrCodeValidationFailed,
+			)
 		}
 
 		// HTML tags not allowed in display name.
@@ -121,7 +143,10 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 
 		note := *form.Note
 		if err := validate.Note(note); err != nil {
-			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			return nil, gtserror.WithErrCode(
+				gtserror.NewErrorBadRequest(err, err.Error()),
+				gtserror.ErrCodeValidationFailed,
+			)
 		}
 
 		// Store raw version of note
@@ -134,6 +159,32 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		}...)
 	}
 
+	if form.Pronouns != nil {
+		pronouns := text.StripHTMLFromText(*form.Pronouns)
+		if err := validate.Pronouns(pronouns); err != nil {
+			return nil, gtserror.WithErrCode(
+				gtserror.NewErrorBadRequest(err, err.Error()),
+				gtserror.ErrCodeValidationFailed,
+			)
+		}
+
+		account.Pronouns = pronouns
+		acctColumns = append(acctColumns, "pronouns")
+	}
+
+	if form.Location != nil {
+		location := text.StripHTMLFromText(*form.Location)
+		if err := validate.Location(location); err != nil {
+			return nil, gtserror.WithErrCode(
+				gtserror.NewErrorBadRequest(err, err.Error()),
+				gtserror.ErrCodeValidationFailed,
+			)
+		}
+
+		account.Location = location
+		acctColumns = append(acctColumns, "location")
+	}
+
 	if form.FieldsAttributes != nil {
 		// Field text is changing.
 		textChanged = true
@@ -171,6 +222,17 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		if errWithCode != nil {
 			return nil, errWithCode
 		}
+
+		// Keep the replaced avatar in the
+		// account's history so it can be
+		// restored without re-uploading.
+		account.Settings.AvatarHistoryIDs = gtsmodel.PushProfileMediaHistory(
+			account.Settings.AvatarHistoryIDs,
+			account.AvatarMediaAttachmentID,
+			config.GetAccountsProfileMediaHistory(),
+		)
+		settingsColumns = append(settingsColumns, "avatar_history")
+
 		account.AvatarMediaAttachmentID = avatarInfo.ID
 		account.AvatarMediaAttachment = avatarInfo
 		acctColumns = append(acctColumns, "avatar_media_attachment_id")
@@ -201,6 +263,17 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		if errWithCode != nil {
 			return nil, errWithCode
 		}
+
+		// Keep the replaced header in the
+		// account's history so it can be
+		// restored without re-uploading.
+		account.Settings.HeaderHistoryIDs = gtsmodel.PushProfileMediaHistory(
+			account.Settings.HeaderHistoryIDs,
+			account.HeaderMediaAttachmentID,
+			config.GetAccountsProfileMediaHistory(),
+		)
+		settingsColumns = append(settingsColumns, "header_history")
+
 		account.HeaderMediaAttachmentID = headerInfo.ID
 		account.HeaderMediaAttachment = headerInfo
 		acctColumns = append(acctColumns, "header_media_attachment_id")
@@ -261,14 +334,60 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 			settingsColumns = append(settingsColumns, "language")
 		}
 
+		if form.Source.ChosenLanguages != nil {
+			chosen := make([]string, 0, len(*form.Source.ChosenLanguages))
+			for _, lang := range *form.Source.ChosenLanguages {
+				if lang == "" {
+					// Allow clearing
+					// via form data.
+					continue
+				}
+
+				language, err := validate.Language(lang)
+				if err != nil {
+					return nil, gtserror.NewErrorBadRequest(err, err.Error())
+				}
+
+				// Only primary language subtags are stored,
+				// these are what timelines are filtered by.
+				primary, _, _ := strings.Cut(language, "-")
+				chosen = append(chosen, primary)
+			}
+
+			account.Settings.ChosenLanguages = xslices.Deduplicate(chosen)
+			settingsColumns = append(settingsColumns, "chosen_languages")
+		}
+
 		if form.Source.Sensitive != nil {
 			account.Settings.Sensitive = form.Source.Sensitive
 			settingsColumns = append(settingsColumns, "sensitive")
 		}
 
+		if form.Source.PreviewLinks != nil {
+			account.Settings.PreviewLinks = form.Source.PreviewLinks
+			settingsColumns = append(settingsColumns, "preview_links")
+		}
+
+		if form.Source.MediaDescriptionPolicy != nil {
+			policy := gtsmodel.DescriptionPolicyUnknown
+			if in := *form.Source.MediaDescriptionPolicy; in != "" {
+				policy = gtsmodel.ParseDescriptionPolicy(in)
+				if policy == gtsmodel.DescriptionPolicyUnknown {
+					text := fmt.Sprintf("unrecognized media description policy %s: must be off, remind, or require", in)
+					return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+				}
+			}
+
+			account.Settings.MediaDescriptionPolicy = policy
+			settingsColumns = append(settingsColumns, "media_description_policy")
+		}
+
 		if form.Source.Privacy != nil {
 			if err := validate.Privacy(*form.Source.Privacy); err != nil {
-				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+				return nil, gtserror.WithErrCode(
+					gtserror.NewErrorBadRequest(err, err.Error()),
+					gtserror.ErrCodeValidationFailed,
+				)
 			}
 
 			priv := apimodel.Visibility(*form.Source.Privacy)
@@ -278,12 +397,28 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 
 		if form.Source.StatusContentType != nil {
 			if err := validate.StatusContentType(*form.Source.StatusContentType); err != nil {
-				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+				return nil, gtserror.WithErrCode(
+					gtserror.NewErrorBadRequest(err, err.Error()),
+					gtserror.ErrCodeValidationFailed,
+				)
 			}
 
 			account.Settings.StatusContentType = *form.Source.StatusContentType
 			settingsColumns = append(settingsColumns, "status_content_type")
 		}
+
+		if form.Source.StatusExpiresIn != nil {
+			expiresIn := *form.Source.StatusExpiresIn
+			if err := validate.StatusExpiresIn(expiresIn); err != nil {
+				return nil, gtserror.WithErrCode(
+					gtserror.NewErrorBadRequest(err, err.Error()),
+					gtserror.ErrCodeValidationFailed,
+				)
+			}
+
+			account.Settings.StatusExpiresIn = expiresIn
+			settingsColumns = append(settingsColumns, "status_expires_in")
+		}
 	}
 
 	if form.Theme != nil {
@@ -306,7 +441,10 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 	if form.CustomCSS != nil {
 		customCSS := *form.CustomCSS
 		if err := validate.CustomCSS(customCSS); err != nil {
-			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			return nil, gtserror.WithErrCode(
+				gtserror.NewErrorBadRequest(err, err.Error()),
+				gtserror.ErrCodeValidationFailed,
+			)
 		}
 
 		account.Settings.CustomCSS = text.StripHTMLFromText(customCSS)
@@ -318,11 +456,21 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		settingsColumns = append(settingsColumns, "enable_rss")
 	}
 
+	if form.RSSFullContent != nil {
+		account.Settings.RSSFullContent = form.RSSFullContent
+		settingsColumns = append(settingsColumns, "rss_full_content")
+	}
+
 	if form.HideCollections != nil {
 		account.Settings.HideCollections = form.HideCollections
 		settingsColumns = append(settingsColumns, "hide_collections")
 	}
 
+	if form.PublishBlocks != nil {
+		account.Settings.PublishBlocks = form.PublishBlocks
+		settingsColumns = append(settingsColumns, "publish_blocks")
+	}
+
 	if form.WebLayout != nil {
 		webLayout := gtsmodel.ParseWebLayout(*form.WebLayout)
 		if webLayout == gtsmodel.WebLayoutUnknown {
@@ -340,6 +488,11 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		settingsColumns = append(settingsColumns, "web_include_boosts")
 	}
 
+	if form.WebActivityHeatmap != nil {
+		account.Settings.WebActivityHeatmap = form.WebActivityHeatmap
+		settingsColumns = append(settingsColumns, "web_activity_heatmap")
+	}
+
 	// We've parsed + set everything, do
 	// necessary database updates now.
 
@@ -410,7 +563,10 @@ func (p *Processor) updateFields(
 
 	// Check length of parsed raw fields.
 	if err := validate.ProfileFields(fieldsRaw); err != nil {
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		return gtserror.WithErrCode(
+			gtserror.NewErrorBadRequest(err, err.Error()),
+			gtserror.ErrCodeValidationFailed,
+		)
 	}
 
 	// OK, new raw fields are valid.
@@ -419,6 +575,52 @@ func (p *Processor) updateFields(
 	return nil
 }
 
+// updateOperator sets the OperatorURI of the given (bot)
+// account to the account with the given URI (or profile URL),
+// which must be another account already known to this instance.
+// Empty uri unsets.
+func (p *Processor) updateOperator(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	uri string,
+) gtserror.WithCode {
+	if uri == "" {
+		// Unset operator.
+		account.OperatorURI = ""
+		account.Operator = nil
+		return nil
+	}
+
+	if account.ActorType != gtsmodel.AccountActorTypeService {
+		const text = "operator_uri can only be set for bot accounts"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	operator, err := p.state.DB.GetAccountByURI(ctx, uri)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting operator account %s: %w", uri, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if operator == nil {
+		// Try by profile URL instead.
+		operator, err = p.state.DB.GetOneAccountByURL(ctx, uri)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting operator account %s: %w", uri, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if operator == nil || operator.ID == account.ID {
+		const text = "operator_uri must be the uri or url of another account known to this instance"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	account.OperatorURI = operator.URI
+	account.Operator = operator
+	return nil
+}
+
 // processAccountText processes the raw versions of the given
 // account's display name, note, and fields, and sets those
 // processed versions on the account, while also updating the
@@ -517,6 +719,11 @@ func (p *Processor) UpdateAvatar(
 		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 	}
 
+	// Ensure media within account's storage quota.
+	if errWithCode := p.c.CheckMediaQuota(ctx, account, avatar.Size); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Open multipart file reader.
 	mpfile, err := avatar.Open()
 	if err != nil {
@@ -529,7 +736,7 @@ func (p *Processor) UpdateAvatar(
 
 	// Write to instance storage.
 	return p.c.StoreLocalMedia(ctx,
-		account.ID,
+		account,
 		func(ctx context.Context) (reader io.ReadCloser, err error) {
 			return rc, nil
 		},
@@ -563,6 +770,11 @@ func (p *Processor) UpdateHeader(
 		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 	}
 
+	// Ensure media within account's storage quota.
+	if errWithCode := p.c.CheckMediaQuota(ctx, account, header.Size); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Open multipart file reader.
 	mpfile, err := header.Open()
 	if err != nil {
@@ -575,7 +787,7 @@ func (p *Processor) UpdateHeader(
 
 	// Write to instance storage.
 	return p.c.StoreLocalMedia(ctx,
-		account.ID,
+		account,
 		func(ctx context.Context) (reader io.ReadCloser, err error) {
 			return rc, nil
 		},
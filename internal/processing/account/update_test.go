@@ -18,11 +18,14 @@
 package account_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/stretchr/testify/suite"
@@ -389,6 +392,333 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateBotNotBot() {
 	suite.False(dbAccount.ActorType.IsBot())
 }
 
+func (suite *AccountUpdateTestSuite) TestAccountUpdateBotOperator() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	operator := suite.testAccounts["admin_account"]
+	ctx := suite.T().Context()
+
+	// Setting an operator on a
+	// non-bot account should fail.
+	_, errWithCode := suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			OperatorURI: util.Ptr(operator.URI),
+		},
+	)
+	suite.EqualError(errWithCode, "operator_uri can only be set for bot accounts")
+
+	// Set bot = true with operator's profile URL.
+	apiAccount, errWithCode := suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			Bot:         util.Ptr(true),
+			OperatorURI: util.Ptr(operator.URL),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Returned profile should have operator set.
+	suite.True(apiAccount.Bot)
+	if suite.NotNil(apiAccount.Operator) {
+		suite.Equal(operator.ID, apiAccount.Operator.ID)
+	}
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	// Check database model of account as well.
+	dbAccount, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(operator.URI, dbAccount.OperatorURI)
+
+	// Set bot = false, operator should be cleared.
+	apiAccount, errWithCode = suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			Bot: util.Ptr(false),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Nil(apiAccount.Operator)
+
+	// We should have an update in the client api channel.
+	msg, _ = suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	dbAccount, err = suite.db.GetAccountByID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbAccount.OperatorURI)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdatePronounsLocation() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	var (
+		ctx      = suite.T().Context()
+		pronouns = "<b>he/they</b>"
+		location = "The Great Underground Empire"
+	)
+
+	// Set pronouns and location.
+	apiAccount, errWithCode := suite.accountProcessor.Update(ctx, testAccount, &apimodel.UpdateCredentialsRequest{
+		Pronouns: &pronouns,
+		Location: &location,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.getClientMsg(5 * time.Second)
+
+	// HTML should be stripped.
+	suite.Equal("he/they", apiAccount.Pronouns)
+	suite.Equal(location, apiAccount.Location)
+
+	dbAccount, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("he/they", dbAccount.Pronouns)
+	suite.Equal(location, dbAccount.Location)
+
+	// Overlong pronouns should be rejected.
+	tooLong := strings.Repeat("a", 41)
+	_, errWithCode = suite.accountProcessor.Update(ctx, dbAccount, &apimodel.UpdateCredentialsRequest{
+		Pronouns: &tooLong,
+	})
+	suite.EqualError(errWithCode, "pronouns should be no more than 40 chars but given pronouns were 41")
+
+	// Empty string unsets.
+	empty := ""
+	apiAccount, errWithCode = suite.accountProcessor.Update(ctx, dbAccount, &apimodel.UpdateCredentialsRequest{
+		Location: &empty,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.getClientMsg(5 * time.Second)
+
+	suite.Equal("he/they", apiAccount.Pronouns)
+	suite.Empty(apiAccount.Location)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateChosenLanguages() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	ctx := suite.T().Context()
+
+	// Call update function to set chosen languages,
+	// these should be reduced to deduplicated primary
+	// subtags, and empty values should be dropped.
+	apiAccount, errWithCode := suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			Source: &apimodel.UpdateSource{
+				ChosenLanguages: &[]string{"en-GB", "", "fr", "en"},
+			},
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Returned profile should be updated.
+	suite.Equal([]string{"en", "fr"}, apiAccount.Source.ChosenLanguages)
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	// Check database model of account settings as well.
+	dbSettings, err := suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{"en", "fr"}, dbSettings.ChosenLanguages)
+
+	// Call update function with an invalid language.
+	_, errWithCode = suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			Source: &apimodel.UpdateSource{
+				ChosenLanguages: &[]string{"not a language"},
+			},
+		},
+	)
+	suite.NotNil(errWithCode)
+
+	// Call update function with a single empty value to clear.
+	apiAccount, errWithCode = suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			Source: &apimodel.UpdateSource{
+				ChosenLanguages: &[]string{""},
+			},
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiAccount.Source.ChosenLanguages)
+
+	// We should have an update in the client api channel.
+	msg, _ = suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateStatusExpiresIn() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	ctx := suite.T().Context()
+
+	// Set a default status expiry of one week.
+	apiAccount, errWithCode := suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			Source: &apimodel.UpdateSource{
+				StatusExpiresIn: util.Ptr(604800),
+			},
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(604800, apiAccount.Source.StatusExpiresIn)
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	dbSettings, err := suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(604800, dbSettings.StatusExpiresIn)
+
+	// Expiry below the configured minimum is rejected.
+	_, errWithCode = suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			Source: &apimodel.UpdateSource{
+				StatusExpiresIn: util.Ptr(60),
+			},
+		},
+	)
+	if suite.NotNil(errWithCode) {
+		suite.Equal("Bad Request: status expiry must be 0 or at least 5m0s", errWithCode.Safe())
+	}
+
+	// Zero clears the default.
+	apiAccount, errWithCode = suite.accountProcessor.Update(
+		ctx,
+		testAccount,
+		&apimodel.UpdateCredentialsRequest{
+			Source: &apimodel.UpdateSource{
+				StatusExpiresIn: util.Ptr(0),
+			},
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Zero(apiAccount.Source.StatusExpiresIn)
+
+	// We should have an update in the client api channel.
+	msg, _ = suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateMediaDescriptionPolicy() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	settings := &gtsmodel.AccountSettings{}
+	*settings = *suite.testAccounts["local_account_1"].Settings
+	testAccount.Settings = settings
+	ctx := suite.T().Context()
+
+	config.SetMediaDescriptionPolicy(config.MediaDescriptionPolicyRemind)
+
+	update := func(policy string) (*apimodel.Account, gtserror.WithCode) {
+		return suite.accountProcessor.Update(
+			ctx,
+			testAccount,
+			&apimodel.UpdateCredentialsRequest{
+				Source: &apimodel.UpdateSource{
+					MediaDescriptionPolicy: &policy,
+				},
+			},
+		)
+	}
+
+	// Require descriptions for own statuses.
+	apiAccount, errWithCode := update("require")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("require", apiAccount.Source.MediaDescriptionPolicy)
+
+	// We should have an update in the client api channel.
+	msg, _ := suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	dbSettings, err := suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.DescriptionPolicyRequire, dbSettings.MediaDescriptionPolicy)
+
+	// A more lenient policy than the
+	// instance's is stored, but the
+	// instance policy is what's shown.
+	apiAccount, errWithCode = update("off")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("remind", apiAccount.Source.MediaDescriptionPolicy)
+
+	msg, _ = suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	// Empty value follows the instance policy.
+	_, errWithCode = update("")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	dbSettings, err = suite.db.GetAccountSettings(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.DescriptionPolicyUnknown, dbSettings.MediaDescriptionPolicy)
+
+	msg, _ = suite.getClientMsg(5 * time.Second)
+	suite.NotNil(msg)
+
+	// Unknown policies are rejected.
+	_, errWithCode = update("sometimes")
+	if suite.NotNil(errWithCode) {
+		suite.Equal("Bad Request: unrecognized media description policy sometimes: must be off, remind, or require", errWithCode.Safe())
+	}
+}
+
 func TestAccountUpdateTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountUpdateTestSuite))
 }
@@ -0,0 +1,60 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UsageTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *UsageTestSuite) TestUsageGet() {
+	ctx := suite.T().Context()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	usage, errWithCode := suite.accountProcessor.UsageGet(ctx, testAccount)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal(testAccount.ID, usage.AccountID)
+	suite.NotZero(usage.StatusesCount)
+	suite.NotZero(usage.FollowersCount)
+	suite.NotZero(usage.Media.Total.Count)
+	suite.Equal(
+		usage.Media.Attachments.Bytes+usage.Media.Unattached.Bytes+
+			usage.Media.Avatar.Bytes+usage.Media.Header.Bytes,
+		usage.Media.Total.Bytes,
+	)
+
+	// A second call should be
+	// served from the cache.
+	cached, errWithCode := suite.accountProcessor.UsageGet(ctx, testAccount)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Same(usage, cached)
+}
+
+func TestUsageTestSuite(t *testing.T) {
+	suite.Run(t, new(UsageTestSuite))
+}
@@ -0,0 +1,222 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// appealMaxChars is the maximum permitted
+// length of text submitted with an appeal.
+const appealMaxChars = 5000
+
+// WarningsGet returns a page of moderation
+// warnings issued to the requesting account.
+func (p *Processor) WarningsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	warnings, err := p.state.DB.GetAccountWarnings(ctx, requester.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account warnings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(warnings)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := warnings[count-1].ID
+	hi := warnings[0].ID
+
+	// Convert each warning to API model.
+	items := make([]interface{}, 0, count)
+	for _, warning := range warnings {
+		item, err := p.converter.AccountWarningToAPIAccountWarning(ctx, warning)
+		if err != nil {
+			err := gtserror.Newf("error converting account warning to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		items = append(items, item)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/account_warnings",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// WarningGet returns the moderation warning with the
+// given ID, if it was issued to the requesting account.
+func (p *Processor) WarningGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	warningID string,
+) (*apimodel.AccountWarning, gtserror.WithCode) {
+	warning, errWithCode := p.getOwnWarning(ctx, requester, warningID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiWarning, err := p.converter.AccountWarningToAPIAccountWarning(ctx, warning)
+	if err != nil {
+		err := gtserror.Newf("error converting account warning to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiWarning, nil
+}
+
+// SuspensionWarningGet returns the most recent warning recorded
+// when the requesting account was suspended. This lets a user read
+// and appeal their suspension during the suspension grace period,
+// while they can't otherwise use the API.
+func (p *Processor) SuspensionWarningGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.AccountWarning, gtserror.WithCode) {
+	if !requester.IsSuspended() {
+		const text = "account is not suspended"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	warnings, err := p.state.DB.GetAccountWarnings(ctx, requester.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account warnings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Warnings are sorted newest first,
+	// so take the first suspension.
+	for _, warning := range warnings {
+		if warning.Action != gtsmodel.AccountWarningActionSuspend {
+			continue
+		}
+
+		apiWarning, err := p.converter.AccountWarningToAPIAccountWarning(ctx, warning)
+		if err != nil {
+			err := gtserror.Newf("error converting account warning to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		return apiWarning, nil
+	}
+
+	const text = "no suspension warning found for account"
+	return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+}
+
+// WarningAppeal submits an appeal by the requesting account against
+// the moderation warning with the given ID, returning the updated warning.
+func (p *Processor) WarningAppeal(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	warningID string,
+	form *apimodel.AppealRequest,
+) (*apimodel.AccountWarning, gtserror.WithCode) {
+	if form.Text == "" {
+		const text = "appeal text must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if l := len([]rune(form.Text)); l > appealMaxChars {
+		text := fmt.Sprintf("appeal text must be %d characters or fewer, provided text was %d characters", appealMaxChars, l)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	warning, errWithCode := p.getOwnWarning(ctx, requester, warningID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Ensure the warning wasn't already appealed.
+	existing, err := p.state.DB.GetAppealByAccountWarningID(
+		gtscontext.SetBarebones(ctx),
+		warning.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error checking existing appeal: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		const text = "this warning has already been appealed"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	appeal := &gtsmodel.Appeal{
+		ID:               id.NewULID(),
+		AccountID:        requester.ID,
+		Account:          requester,
+		AccountWarningID: warning.ID,
+		AccountWarning:   warning,
+		Text:             form.Text,
+		Approved:         util.Ptr(false),
+	}
+
+	if err := p.state.DB.PutAppeal(ctx, appeal); err != nil {
+		err := gtserror.Newf("db error putting appeal: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiWarning, err := p.converter.AccountWarningToAPIAccountWarning(ctx, warning)
+	if err != nil {
+		err := gtserror.Newf("error converting account warning to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiWarning, nil
+}
+
+// getOwnWarning fetches the moderation warning with the given ID,
+// returning 404 if it wasn't issued to the requesting account.
+func (p *Processor) getOwnWarning(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	warningID string,
+) (*gtsmodel.AccountWarning, gtserror.WithCode) {
+	warning, err := p.state.DB.GetAccountWarningByID(ctx, warningID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account warning %s: %w", warningID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if warning == nil || warning.AccountID != requester.ID {
+		err := fmt.Errorf("account warning %s not found", warningID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return warning, nil
+}
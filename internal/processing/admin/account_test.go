@@ -18,11 +18,16 @@
 package admin_test
 
 import (
+	"strconv"
 	"testing"
+	"time"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"code.superseriousbusiness.org/gotosocial/testrig"
+	"codeberg.org/gruf/go-bytesize"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -76,6 +81,252 @@ func (suite *AccountTestSuite) TestAccountActionSuspend() {
 	suite.NotZero(targetAcct.SuspendedAt)
 }
 
+func (suite *AccountTestSuite) TestAccountActionSuspendGracePeriod() {
+	config.SetAccountsSuspensionGracePeriod(7 * 24 * time.Hour)
+	defer config.SetAccountsSuspensionGracePeriod(0)
+
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		request   = &apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     gtsmodel.AdminActionSuspend.String(),
+			Text:     "stinky",
+			TargetID: suite.testAccounts["local_account_1"].ID,
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(actionID)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure target account suspended,
+	// but its data is still around.
+	targetAcct, err := suite.db.GetAccountByID(ctx, request.TargetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotZero(targetAcct.SuspendedAt)
+	suite.Equal(adminAcct.ID, targetAcct.SuspensionOrigin)
+	suite.NotEmpty(targetAcct.DisplayName)
+
+	if _, err := suite.db.GetUserByAccountID(ctx, targetAcct.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reverse the suspension.
+	apiAcct, errWithCode := suite.adminProcessor.AccountUnsuspend(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+	)
+	suite.NoError(errWithCode)
+	suite.False(apiAcct.Suspended)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	targetAcct, err = suite.db.GetAccountByID(ctx, request.TargetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Zero(targetAcct.SuspendedAt)
+	suite.Empty(targetAcct.SuspensionOrigin)
+}
+
+func (suite *AccountTestSuite) TestAccountUnsuspendNotSuspended() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		targetID  = suite.testAccounts["local_account_1"].ID
+	)
+
+	apiAcct, errWithCode := suite.adminProcessor.AccountUnsuspend(
+		ctx,
+		adminAcct,
+		targetID,
+	)
+	suite.EqualError(errWithCode, "account is not suspended")
+	suite.Nil(apiAcct)
+}
+
+func (suite *AccountTestSuite) TestAccountActionSilence() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		request   = &apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     gtsmodel.AdminActionSilence.String(),
+			Text:     "too loud",
+			TargetID: suite.testAccounts["local_account_1"].ID,
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(actionID)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure target account silenced.
+	targetAcct, err := suite.db.GetAccountByID(ctx, request.TargetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(targetAcct.IsSilenced())
+
+	// Ensure silence recorded as a warning.
+	warnings, err := suite.db.GetAccountWarnings(ctx, targetAcct.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(warnings, 1)
+	suite.Equal(actionID, warnings[0].AdminActionID)
+	suite.Equal(gtsmodel.AccountWarningActionSilence, warnings[0].Action)
+	suite.Equal(request.Text, warnings[0].Text)
+
+	// Reverse the silence.
+	if _, errWithCode := suite.adminProcessor.AccountUnsilence(
+		ctx,
+		adminAcct,
+		targetAcct.ID,
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	targetAcct, err = suite.db.GetAccountByID(ctx, request.TargetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(targetAcct.IsSilenced())
+}
+
+func (suite *AccountTestSuite) TestAccountActionWarn() {
+	var (
+		ctx        = suite.T().Context()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+		status     = suite.testStatuses["local_account_1_status_1"]
+		rule       = suite.testRules["rule1"]
+		request    = &apimodel.AdminActionRequest{
+			Category:       gtsmodel.AdminActionCategoryAccount.String(),
+			Type:           gtsmodel.AdminActionWarn.String(),
+			Text:           "please don't",
+			TargetID:       targetAcct.ID,
+			StatusIDs:      []string{status.ID},
+			StatusesAction: gtsmodel.AccountWarningActionDeleteStatuses.String(),
+			RuleIDs:        []string{rule.ID},
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(actionID)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure warning stored for target account.
+	warnings, err := suite.db.GetAccountWarnings(ctx, targetAcct.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(warnings, 1)
+	warning := warnings[0]
+	suite.Equal(actionID, warning.AdminActionID)
+	suite.Equal(adminAcct.ID, warning.ByAccountID)
+	suite.Equal(gtsmodel.AccountWarningActionDeleteStatuses, warning.Action)
+	suite.Equal([]string{status.ID}, warning.StatusIDs)
+	suite.Equal([]string{rule.ID}, warning.RuleIDs)
+
+	// Ensure cited status was deleted.
+	if !testrig.WaitFor(func() bool {
+		_, err := suite.db.GetStatusByID(ctx, status.ID)
+		return err != nil
+	}) {
+		suite.FailNow("timed out waiting for status to be deleted")
+	}
+
+	// Ensure target account was notified.
+	notifs, err := suite.db.GetAccountNotifications(ctx,
+		targetAcct.ID,
+		nil,
+		[]gtsmodel.NotificationType{gtsmodel.NotificationModerationWarning},
+		nil,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(notifs, 1)
+	suite.Equal(warning.ID, notifs[0].AccountWarningID)
+}
+
+func (suite *AccountTestSuite) TestAccountActionWarnOtherAccountStatus() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		status    = suite.testStatuses["local_account_2_status_1"]
+		request   = &apimodel.AdminActionRequest{
+			Category:       gtsmodel.AdminActionCategoryAccount.String(),
+			Type:           gtsmodel.AdminActionWarn.String(),
+			TargetID:       suite.testAccounts["local_account_1"].ID,
+			StatusIDs:      []string{status.ID},
+			StatusesAction: gtsmodel.AccountWarningActionMarkStatusesSensitive.String(),
+		}
+	)
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	suite.EqualError(errWithCode, "status "+status.ID+" not found for account "+request.TargetID)
+	suite.Empty(actionID)
+}
+
 func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 	var (
 		ctx       = suite.T().Context()
@@ -93,10 +344,136 @@ func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 		adminAcct,
 		request,
 	)
-	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\"]")
+	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\" \"silence\" \"warn\"]")
 	suite.Empty(actionID)
 }
 
+func (suite *AccountTestSuite) TestAccountMediaQuotaSet() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		targetID  = suite.testAccounts["local_account_1"].ID
+	)
+
+	// Instance default quota.
+	config.SetMediaLocalQuota(1 * bytesize.GiB)
+
+	usage, errWithCode := suite.adminProcessor.AccountUsageGet(ctx, targetID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(int64(bytesize.GiB), *usage.MediaQuota)
+
+	// Override the quota.
+	usage, errWithCode = suite.adminProcessor.AccountMediaQuotaSet(ctx, adminAcct, targetID, util.Ptr(int64(1024)))
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(int64(1024), *usage.MediaQuota)
+
+	settings, err := suite.state.DB.GetAccountSettings(ctx, targetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(int64(1024), *settings.MediaQuota)
+
+	// Remove the quota, 0 = no quota.
+	usage, errWithCode = suite.adminProcessor.AccountMediaQuotaSet(ctx, adminAcct, targetID, util.Ptr(int64(0)))
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Nil(usage.MediaQuota)
+
+	// Reset to the instance default.
+	usage, errWithCode = suite.adminProcessor.AccountMediaQuotaSet(ctx, adminAcct, targetID, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(int64(bytesize.GiB), *usage.MediaQuota)
+
+	// Negative quotas are invalid.
+	_, errWithCode = suite.adminProcessor.AccountMediaQuotaSet(ctx, adminAcct, targetID, util.Ptr(int64(-1)))
+	suite.EqualError(errWithCode, "quota must be 0 or greater")
+}
+
+func (suite *AccountTestSuite) TestAccountsBulkActionApprove() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		pendingID = suite.testAccounts["unconfirmed_account"].ID
+		request   = &apimodel.AdminAccountsBulkActionRequest{
+			AccountIDs: []string{
+				pendingID,
+				suite.testAccounts["local_account_1"].ID,
+				"01JCZN614XG85GCGAMSV9ZZAEJ",
+				pendingID, // duplicate
+			},
+			Type: "approve",
+		}
+	)
+
+	results, errWithCode := suite.adminProcessor.AccountsBulkAction(
+		ctx,
+		adminAcct,
+		request,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal([]*apimodel.AdminAccountsBulkActionResult{
+		{AccountID: pendingID, Success: true},
+		{AccountID: suite.testAccounts["local_account_1"].ID, Success: true},
+		{AccountID: "01JCZN614XG85GCGAMSV9ZZAEJ", Error: "Not Found: user for account 01JCZN614XG85GCGAMSV9ZZAEJ not found"},
+	}, results)
+
+	// Pending user should now be approved,
+	// once the side effects have processed.
+	if !testrig.WaitFor(func() bool {
+		user, err := suite.db.GetUserByAccountID(ctx, pendingID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return *user.Approved
+	}) {
+		suite.FailNow("timed out waiting for user to be approved")
+	}
+}
+
+func (suite *AccountTestSuite) TestAccountsBulkActionBadRequest() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+	)
+
+	_, errWithCode := suite.adminProcessor.AccountsBulkAction(ctx, adminAcct,
+		&apimodel.AdminAccountsBulkActionRequest{
+			AccountIDs: []string{suite.testAccounts["local_account_1"].ID},
+			Type:       "silence",
+		},
+	)
+	suite.EqualError(errWithCode, "type silence not recognized; valid choices are [approve reject suspend]")
+
+	_, errWithCode = suite.adminProcessor.AccountsBulkAction(ctx, adminAcct,
+		&apimodel.AdminAccountsBulkActionRequest{
+			Type: "suspend",
+		},
+	)
+	suite.EqualError(errWithCode, "no account_ids provided")
+
+	tooMany := make([]string, 101)
+	for i := range tooMany {
+		tooMany[i] = strconv.Itoa(i)
+	}
+	_, errWithCode = suite.adminProcessor.AccountsBulkAction(ctx, adminAcct,
+		&apimodel.AdminAccountsBulkActionRequest{
+			AccountIDs: tooMany,
+			Type:       "suspend",
+		},
+	)
+	suite.EqualError(errWithCode, "too many account_ids provided: 101; maximum is 100")
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
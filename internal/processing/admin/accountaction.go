@@ -19,10 +19,14 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
@@ -35,20 +39,37 @@ func (p *Processor) AccountAction(
 	request *apimodel.AdminActionRequest,
 ) (string, gtserror.WithCode) {
 	targetAcct, err := p.state.DB.GetAccountByID(ctx, request.TargetID)
-	if err != nil {
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		err := gtserror.Newf("db error getting target account: %w", err)
 		return "", gtserror.NewErrorInternalError(err)
 	}
 
+	if targetAcct == nil {
+		err := fmt.Errorf("account %s not found", request.TargetID)
+		return "", gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if errWithCode := p.checkCanActionAccount(ctx, adminAcct, targetAcct); errWithCode != nil {
+		return "", errWithCode
+	}
+
 	switch gtsmodel.ParseAdminActionType(request.Type) {
 	case gtsmodel.AdminActionSuspend:
 		return p.accountActionSuspend(ctx, adminAcct, targetAcct, request.Text)
 
+	case gtsmodel.AdminActionSilence:
+		return p.accountActionSilence(ctx, adminAcct, targetAcct, request.Text)
+
+	case gtsmodel.AdminActionWarn:
+		return p.accountActionWarn(ctx, adminAcct, targetAcct, request)
+
 	default:
 		// TODO: add more types to this slice when adding
 		//       more types to the switch statement above.
 		supportedTypes := []string{
 			gtsmodel.AdminActionSuspend.String(),
+			gtsmodel.AdminActionSilence.String(),
+			gtsmodel.AdminActionWarn.String(),
 		}
 
 		err := fmt.Errorf(
@@ -60,12 +81,58 @@ func (p *Processor) AccountAction(
 	}
 }
 
+// checkCanActionAccount returns a 403 Forbidden error if
+// targetAcct is a local admin or moderator, and adminAcct
+// is not an admin, as only admins may take action against
+// other members of instance staff.
+func (p *Processor) checkCanActionAccount(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+) gtserror.WithCode {
+	if targetAcct.IsRemote() {
+		// Remote accounts are
+		// never instance staff.
+		return nil
+	}
+
+	targetUser, err := p.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting target user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if targetUser == nil || (!*targetUser.Admin && !*targetUser.Moderator) {
+		// Target is not staff.
+		return nil
+	}
+
+	adminUser, err := p.state.DB.GetUserByAccountID(ctx, adminAcct.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting admin user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if !*adminUser.Admin {
+		const text = "only admins may take action against admin or moderator accounts"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	return nil
+}
+
 func (p *Processor) accountActionSuspend(
 	ctx context.Context,
 	adminAcct *gtsmodel.Account,
 	targetAcct *gtsmodel.Account,
 	text string,
 ) (string, gtserror.WithCode) {
+	if targetAcct.IsLocal() && config.GetAccountsSuspensionGracePeriod() > 0 {
+		// Keep data of local accounts
+		// around until grace period is over.
+		return p.accountActionSuspendGrace(ctx, adminAcct, targetAcct, text)
+	}
+
 	actionID := id.NewULID()
 
 	errWithCode := p.state.AdminActions.Run(
@@ -100,3 +167,76 @@ func(ctx context.Context) gtserror.MultiError {
 
 	return actionID, errWithCode
 }
+
+// accountActionSuspendGrace suspends the given local account
+// without deleting any of its data yet: the account and its
+// statuses are hidden, the user can no longer log in, and
+// queued deliveries from the account are dropped. The data
+// is purged by the cleaner once the grace period is over,
+// unless the suspension is reversed before then.
+//
+// The suspension is recorded as a warning on the account,
+// which can be appealed during the grace period.
+func (p *Processor) accountActionSuspendGrace(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	text string,
+) (string, gtserror.WithCode) {
+	actionID := id.NewULID()
+
+	errWithCode := p.state.AdminActions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionSuspend,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			var errs gtserror.MultiError
+
+			targetAcct.SuspendedAt = time.Now()
+			targetAcct.SuspensionOrigin = adminAcct.ID
+			if err := p.state.DB.UpdateAccount(ctx,
+				targetAcct,
+				"suspended_at",
+				"suspension_origin",
+			); err != nil {
+				errs.Appendf("db error updating account: %w", err)
+				return errs
+			}
+
+			// Drop any outgoing queued AP requests
+			// from this account, so nothing more
+			// gets federated out while suspended.
+			p.state.Workers.Delivery.Queue.Delete("ActorID", targetAcct.URI)
+
+			// Remove any entries authored
+			// by account from timelines.
+			p.state.Caches.Timelines.Public.RemoveByAccountIDs(targetAcct.ID)
+			p.state.Caches.Timelines.Local.RemoveByAccountIDs(targetAcct.ID)
+			p.state.Caches.Timelines.Home.RemoveByAccountIDs(targetAcct.ID)
+			p.state.Caches.Timelines.List.RemoveByAccountIDs(targetAcct.ID)
+			p.state.Caches.Timelines.Tag.RemoveByAccountIDs(targetAcct.ID)
+
+			// Record suspension so it can be appealed.
+			if err := p.putWarning(ctx, adminAcct, newActionWarning(
+				adminAcct,
+				targetAcct,
+				actionID,
+				gtsmodel.AccountWarningActionSuspend,
+				text,
+			)); err != nil {
+				errs.Append(err)
+			}
+
+			return errs
+		},
+	)
+
+	return actionID, errWithCode
+}
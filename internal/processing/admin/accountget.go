@@ -45,5 +45,25 @@ func (p *Processor) AccountGet(ctx context.Context, accountID string) (*apimodel
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if account.IsLocal() {
+		// Include strike history
+		// for local accounts.
+		warnings, err := p.state.DB.GetAccountWarnings(ctx, account.ID, nil)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting warnings for account %s: %w", accountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiAccount.Warnings = make([]*apimodel.AccountWarning, 0, len(warnings))
+		for _, warning := range warnings {
+			apiWarning, err := p.converter.AccountWarningToAPIAccountWarning(ctx, warning)
+			if err != nil {
+				err := gtserror.Newf("error converting warning %s to api model: %w", warning.ID, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+			apiAccount.Warnings = append(apiAccount.Warnings, apiWarning)
+		}
+	}
+
 	return apiAccount, nil
 }
@@ -24,6 +24,8 @@
 	"net/netip"
 	"net/url"
 	"slices"
+	"strings"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -31,6 +33,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 var (
@@ -91,6 +94,36 @@ func (p *Processor) AccountsGet(
 		}
 	}
 
+	// Validate/normalize email domain.
+	var emailDomain string
+	if v := request.EmailDomain; v != "" {
+		// Only accept bare domains (allowing
+		// a leading @ for convenience), also
+		// rejecting SQL LIKE pattern chars.
+		emailDomain = strings.TrimPrefix(v, "@")
+		if strings.ContainsAny(emailDomain, ":/@%_ ") || !strings.Contains(emailDomain, ".") {
+			err := fmt.Errorf("invalid email_domain provided: %s", v)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		var err error
+		emailDomain, err = util.PunifySafely(emailDomain)
+		if err != nil {
+			err := fmt.Errorf("invalid email_domain provided: %w", err)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	// Validate/parse created after + before.
+	createdAfter, errWithCode := parseAccountsCreated(request.CreatedAfter, "created_after")
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	createdBefore, errWithCode := parseAccountsCreated(request.CreatedBefore, "created_before")
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Get accounts with the given params.
 	accounts, err := p.state.DB.GetAccounts(
 		ctx,
@@ -102,7 +135,10 @@ func() bool { return request.Permissions == "staff" }(),
 		request.DisplayName,
 		request.ByDomain,
 		request.Email,
+		emailDomain,
 		ip,
+		createdAfter,
+		createdBefore,
 		page,
 	)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
@@ -153,7 +189,7 @@ func packageAccountsV1(
 	request *apimodel.AdminGetAccountsRequest,
 	page *paging.Page,
 ) (*apimodel.PageableResponse, gtserror.WithCode) {
-	queryParams := make(url.Values, 8)
+	queryParams := make(url.Values, 11)
 
 	// Translate origin to v1.
 	if v := request.Origin; v != "" {
@@ -208,6 +244,18 @@ func packageAccountsV1(
 		queryParams.Add(apiutil.AdminIPKey, v)
 	}
 
+	if v := request.EmailDomain; v != "" {
+		queryParams.Add(apiutil.AdminEmailDomainKey, v)
+	}
+
+	if v := request.CreatedAfter; v != "" {
+		queryParams.Add(apiutil.AdminCreatedAfterKey, v)
+	}
+
+	if v := request.CreatedBefore; v != "" {
+		queryParams.Add(apiutil.AdminCreatedBeforeKey, v)
+	}
+
 	// Translate permissions to v1.
 	if v := request.Permissions; v != "" {
 		queryParams.Add(apiutil.AdminStaffKey, v)
@@ -228,7 +276,7 @@ func packageAccountsV2(
 	request *apimodel.AdminGetAccountsRequest,
 	page *paging.Page,
 ) (*apimodel.PageableResponse, gtserror.WithCode) {
-	queryParams := make(url.Values, 9)
+	queryParams := make(url.Values, 12)
 
 	if v := request.Origin; v != "" {
 		queryParams.Add(apiutil.AdminOriginKey, v)
@@ -266,6 +314,18 @@ func packageAccountsV2(
 		queryParams.Add(apiutil.AdminIPKey, v)
 	}
 
+	if v := request.EmailDomain; v != "" {
+		queryParams.Add(apiutil.AdminEmailDomainKey, v)
+	}
+
+	if v := request.CreatedAfter; v != "" {
+		queryParams.Add(apiutil.AdminCreatedAfterKey, v)
+	}
+
+	if v := request.CreatedBefore; v != "" {
+		queryParams.Add(apiutil.AdminCreatedBeforeKey, v)
+	}
+
 	return paging.PackageResponse(paging.ResponseParams{
 		Items: items,
 		Path:  "/api/v2/admin/accounts",
@@ -274,3 +334,26 @@ func packageAccountsV2(
 		Query: queryParams,
 	}), nil
 }
+
+// parseAccountsCreated parses the given created_after / created_before
+// filter value as either an RFC3339 timestamp or a YYYY-MM-DD date.
+func parseAccountsCreated(value string, key string) (time.Time, gtserror.WithCode) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t, err = time.Parse(time.DateOnly, value)
+	}
+
+	if err != nil {
+		err := fmt.Errorf(
+			"invalid %s provided: %s; must be RFC3339 timestamp or YYYY-MM-DD date",
+			key, value,
+		)
+		return time.Time{}, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return t, nil
+}
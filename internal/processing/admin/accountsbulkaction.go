@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gopkg/xslices"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// accountsBulkActionMax is the maximum number of
+// accounts that may be targeted by one bulk action.
+const accountsBulkActionMax = 100
+
+var accountsBulkActionTypes = []string{"approve", "reject", "suspend"}
+
+// AccountsBulkAction takes the requested action against each of
+// the requested accounts in turn, returning one result per account.
+// Failure to action one account does not prevent actioning the rest.
+func (p *Processor) AccountsBulkAction(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	request *apimodel.AdminAccountsBulkActionRequest,
+) ([]*apimodel.AdminAccountsBulkActionResult, gtserror.WithCode) {
+	if !slices.Contains(accountsBulkActionTypes, request.Type) {
+		err := fmt.Errorf(
+			"type %s not recognized; valid choices are %+v",
+			request.Type, accountsBulkActionTypes,
+		)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	accountIDs := xslices.Deduplicate(request.AccountIDs)
+	switch l := len(accountIDs); {
+	case l == 0:
+		const text = "no account_ids provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+
+	case l > accountsBulkActionMax:
+		err := fmt.Errorf(
+			"too many account_ids provided: %d; maximum is %d",
+			l, accountsBulkActionMax,
+		)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	results := make([]*apimodel.AdminAccountsBulkActionResult, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		result := &apimodel.AdminAccountsBulkActionResult{
+			AccountID: accountID,
+		}
+
+		var errWithCode gtserror.WithCode
+		switch request.Type {
+		case "approve":
+			_, errWithCode = p.SignupApprove(ctx,
+				adminAcct,
+				accountID,
+			)
+
+		case "reject":
+			_, errWithCode = p.SignupReject(ctx,
+				adminAcct,
+				accountID,
+				request.Text,
+				request.SendEmail,
+				request.Message,
+			)
+
+		case "suspend":
+			result.ActionID, errWithCode = p.AccountAction(ctx,
+				adminAcct,
+				&apimodel.AdminActionRequest{
+					Category: gtsmodel.AdminActionCategoryAccount.String(),
+					Type:     gtsmodel.AdminActionSuspend.String(),
+					Text:     request.Text,
+					TargetID: accountID,
+				},
+			)
+		}
+
+		if errWithCode != nil {
+			log.Warnf(ctx,
+				"error taking bulk %s action on account %s: %v",
+				request.Type, accountID, errWithCode,
+			)
+			result.Error = errWithCode.Safe()
+		} else {
+			result.Success = true
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,189 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// accountActionSilence silences (aka limits) the given account:
+// its statuses are kept off public and tag timelines for anyone
+// who doesn't follow it. For local accounts, the silence is also
+// recorded as a warning on the account, so that it can be appealed.
+func (p *Processor) accountActionSilence(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	text string,
+) (string, gtserror.WithCode) {
+	if targetAcct.IsSilenced() {
+		const text = "account is already silenced"
+		return "", gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	actionID := id.NewULID()
+
+	errWithCode := p.state.AdminActions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionSilence,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			var errs gtserror.MultiError
+
+			targetAcct.SilencedAt = time.Now()
+			if err := p.state.DB.UpdateAccount(ctx,
+				targetAcct,
+				"silenced_at",
+			); err != nil {
+				errs.Appendf("db error updating account: %w", err)
+				return errs
+			}
+
+			// Cached timeline visibility of
+			// the account's statuses is now
+			// stale, so drop all of it.
+			p.state.Caches.Visibility.Clear()
+
+			// Remove any entries authored by
+			// account from public timelines.
+			p.state.Caches.Timelines.Public.RemoveByAccountIDs(targetAcct.ID)
+			p.state.Caches.Timelines.Local.RemoveByAccountIDs(targetAcct.ID)
+			p.state.Caches.Timelines.Tag.RemoveByAccountIDs(targetAcct.ID)
+
+			if targetAcct.IsLocal() {
+				// Record silence so it can be appealed.
+				if err := p.putWarning(ctx, adminAcct, newActionWarning(
+					adminAcct,
+					targetAcct,
+					actionID,
+					gtsmodel.AccountWarningActionSilence,
+					text,
+				)); err != nil {
+					errs.Append(err)
+				}
+			}
+
+			return errs
+		},
+	)
+
+	return actionID, errWithCode
+}
+
+// AccountUnsilence reverses the silencing of an account.
+func (p *Processor) AccountUnsilence(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	accountID string,
+) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	targetAcct, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAcct == nil {
+		err := fmt.Errorf("account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if !targetAcct.IsSilenced() {
+		const text = "account is not silenced"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if errWithCode := p.checkCanActionAccount(ctx, adminAcct, targetAcct); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Copy the account so the
+	// action func can update it
+	// independently of this one.
+	unsilenced := new(gtsmodel.Account)
+	*unsilenced = *targetAcct
+	unsilenced.SilencedAt = time.Time{}
+
+	if errWithCode := p.state.AdminActions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             id.NewULID(),
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionUnsilence,
+			AccountID:      adminAcct.ID,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			if err := p.unsilenceAccount(ctx, targetAcct); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Append(err)
+				return errs
+			}
+
+			return nil
+		},
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, unsilenced)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s to admin api model: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
+
+// unsilenceAccount clears the
+// silence of the given account.
+func (p *Processor) unsilenceAccount(
+	ctx context.Context,
+	targetAcct *gtsmodel.Account,
+) error {
+	targetAcct.SilencedAt = time.Time{}
+	if err := p.state.DB.UpdateAccount(ctx,
+		targetAcct,
+		"silenced_at",
+	); err != nil {
+		return gtserror.Newf("db error updating account: %w", err)
+	}
+
+	// Cached timeline visibility of
+	// the account's statuses is now
+	// stale, so drop all of it.
+	p.state.Caches.Visibility.Clear()
+
+	return nil
+}
@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// AccountUnsuspend reverses the suspension of a local account
+// that is still within its suspension grace period, ie., whose
+// data has not yet been purged.
+func (p *Processor) AccountUnsuspend(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	accountID string,
+) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	targetAcct, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAcct == nil {
+		err := fmt.Errorf("account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if !targetAcct.IsLocal() {
+		const text = "only local accounts can be unsuspended"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if !targetAcct.IsSuspended() {
+		const text = "account is not suspended"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if errWithCode := p.checkCanUnsuspend(ctx, targetAcct); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.checkCanActionAccount(ctx, adminAcct, targetAcct); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Copy the account so the
+	// action func can update it
+	// independently of this one.
+	unsuspended := new(gtsmodel.Account)
+	*unsuspended = *targetAcct
+	unsuspended.SuspendedAt = time.Time{}
+	unsuspended.SuspensionOrigin = ""
+
+	if errWithCode := p.state.AdminActions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             id.NewULID(),
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionUnsuspend,
+			AccountID:      adminAcct.ID,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			if err := p.unsuspendAccount(ctx, targetAcct); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Append(err)
+				return errs
+			}
+
+			return nil
+		},
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, unsuspended)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s to admin api model: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
+
+// checkCanUnsuspend returns a 422 Unprocessable Entity error
+// if the given suspended local account's data has already
+// been deleted, meaning the suspension can't be reversed.
+func (p *Processor) checkCanUnsuspend(
+	ctx context.Context,
+	targetAcct *gtsmodel.Account,
+) gtserror.WithCode {
+	// Account data is only still around if the
+	// user wasn't deleted as part of suspension.
+	user, err := p.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting user for account %s: %w", targetAcct.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if user == nil {
+		const text = "account data has already been deleted, suspension cannot be reversed"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// unsuspendAccount clears the suspension
+// of the given (grace period) local account.
+func (p *Processor) unsuspendAccount(
+	ctx context.Context,
+	targetAcct *gtsmodel.Account,
+) error {
+	targetAcct.SuspendedAt = time.Time{}
+	targetAcct.SuspensionOrigin = ""
+	if err := p.state.DB.UpdateAccount(ctx,
+		targetAcct,
+		"suspended_at",
+		"suspension_origin",
+	); err != nil {
+		return gtserror.Newf("db error updating account: %w", err)
+	}
+
+	return nil
+}
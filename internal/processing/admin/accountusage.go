@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// AccountUsageGet returns a report of the
+// storage footprint of the given account ID.
+func (p *Processor) AccountUsageGet(ctx context.Context, accountID string) (*apimodel.AccountUsage, gtserror.WithCode) {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if account == nil {
+		err := fmt.Errorf("account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return p.c.GetAccountUsage(ctx, account)
+}
+
+// AccountMediaQuotaSet overrides the media quota of the
+// given local account ID with the given number of bytes,
+// 0 meaning no quota. If quota is nil, the override is
+// removed, and the instance default quota applies again.
+func (p *Processor) AccountMediaQuotaSet(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	accountID string,
+	quota *int64,
+) (*apimodel.AccountUsage, gtserror.WithCode) {
+	if quota != nil && *quota < 0 {
+		const text = "quota must be 0 or greater"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	targetAcct, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAcct == nil {
+		err := fmt.Errorf("account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if !targetAcct.IsLocal() || targetAcct.IsInstance() {
+		const text = "media quotas only apply to local user accounts"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if errWithCode := p.checkCanActionAccount(ctx, adminAcct, targetAcct); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	targetAcct.Settings.MediaQuota = quota
+	if err := p.state.DB.UpdateAccountSettings(ctx,
+		targetAcct.Settings,
+		"media_quota",
+	); err != nil {
+		err := gtserror.Newf("db error updating settings for account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Drop any cached usage
+	// report with old quota.
+	p.state.Caches.AccountUsage.Invalidate(targetAcct.ID)
+
+	return p.c.GetAccountUsage(ctx, targetAcct)
+}
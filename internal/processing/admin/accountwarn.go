@@ -0,0 +1,255 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.superseriousbusiness.org/gopkg/xslices"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+func (p *Processor) accountActionWarn(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	request *apimodel.AdminActionRequest,
+) (string, gtserror.WithCode) {
+	if targetAcct.IsRemote() {
+		const text = "warnings can only be issued to local accounts"
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	action := gtsmodel.ParseAccountWarningAction(request.StatusesAction)
+	switch action {
+	case gtsmodel.AccountWarningActionUnknown,
+		gtsmodel.AccountWarningActionSuspend,
+		gtsmodel.AccountWarningActionSilence:
+		// Suspend / silence are only recorded by
+		// the account actions of the same name.
+		text := fmt.Sprintf("statuses_action %s not recognized", request.StatusesAction)
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if action != gtsmodel.AccountWarningActionNone && len(request.StatusIDs) == 0 {
+		text := fmt.Sprintf("statuses_action %s requires at least one status ID", action)
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Validate cited statuses, ensuring
+	// they were authored by the target.
+	statuses := make([]*gtsmodel.Status, 0, len(request.StatusIDs))
+	for _, statusID := range xslices.Deduplicate(request.StatusIDs) {
+		status, err := p.state.DB.GetStatusByID(ctx, statusID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting status %s: %w", statusID, err)
+			return "", gtserror.NewErrorInternalError(err)
+		}
+
+		if status == nil || status.AccountID != targetAcct.ID {
+			text := fmt.Sprintf("status %s not found for account %s", statusID, targetAcct.ID)
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	// Validate cited rules.
+	ruleIDs := xslices.Deduplicate(request.RuleIDs)
+	for _, ruleID := range ruleIDs {
+		rule, err := p.state.DB.GetRuleByID(ctx, ruleID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting rule %s: %w", ruleID, err)
+			return "", gtserror.NewErrorInternalError(err)
+		}
+
+		if rule == nil {
+			text := fmt.Sprintf("rule %s not found", ruleID)
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	// Validate cited report, if set,
+	// ensuring it targets the account.
+	var reportIDs []string
+	if request.ReportID != "" {
+		report, err := p.state.DB.GetReportByID(ctx, request.ReportID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting report %s: %w", request.ReportID, err)
+			return "", gtserror.NewErrorInternalError(err)
+		}
+
+		if report == nil || report.TargetAccountID != targetAcct.ID {
+			text := fmt.Sprintf("report %s not found for account %s", request.ReportID, targetAcct.ID)
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		reportIDs = []string{report.ID}
+	}
+
+	actionID := id.NewULID()
+	warning := &gtsmodel.AccountWarning{
+		ID:            id.NewULID(),
+		AccountID:     targetAcct.ID,
+		Account:       targetAcct,
+		ByAccountID:   adminAcct.ID,
+		ByAccount:     adminAcct,
+		AdminActionID: actionID,
+		Action:        action,
+		Text:          request.Text,
+		StatusIDs:     xslices.Gather(nil, statuses, (*gtsmodel.Status).GetID),
+		RuleIDs:       ruleIDs,
+		ReportID:      request.ReportID,
+	}
+
+	errWithCode := p.state.AdminActions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionWarn,
+			AccountID:      adminAcct.ID,
+			Text:           request.Text,
+			ReportIDs:      reportIDs,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			var errs gtserror.MultiError
+
+			// Apply any required action to cited statuses.
+			for _, status := range statuses {
+				if err := p.warnStatus(ctx, targetAcct, status, action); err != nil {
+					errs.Appendf("error actioning status %s: %w", status.ID, err)
+				}
+			}
+
+			if err := p.putWarning(ctx, adminAcct, warning); err != nil {
+				errs.Append(err)
+			}
+
+			return errs
+		},
+	)
+
+	return actionID, errWithCode
+}
+
+// newActionWarning returns a new account warning recording
+// an account action of given type taken against targetAcct,
+// so that the account is notified of the action and can
+// appeal it, same as with a warning issued by itself.
+func newActionWarning(
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	actionID string,
+	action gtsmodel.AccountWarningAction,
+	text string,
+) *gtsmodel.AccountWarning {
+	return &gtsmodel.AccountWarning{
+		ID:            id.NewULID(),
+		AccountID:     targetAcct.ID,
+		Account:       targetAcct,
+		ByAccountID:   adminAcct.ID,
+		ByAccount:     adminAcct,
+		AdminActionID: actionID,
+		Action:        action,
+		Text:          text,
+	}
+}
+
+// putWarning stores the given account warning,
+// and notifies the warned account about it.
+func (p *Processor) putWarning(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	warning *gtsmodel.AccountWarning,
+) error {
+	if err := p.state.DB.PutAccountWarning(ctx, warning); err != nil {
+		return gtserror.Newf("db error putting account warning: %w", err)
+	}
+
+	// Process side effects (ie.,
+	// notifying the warned account).
+	return p.state.Workers.Client.Process(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectProfile,
+			APActivityType: ap.ActivityFlag,
+			GTSModel:       warning,
+			Origin:         adminAcct,
+			Target:         warning.Account,
+		},
+	)
+}
+
+// warnStatus applies the given account warning
+// action to a status authored by the warned account.
+func (p *Processor) warnStatus(
+	ctx context.Context,
+	targetAcct *gtsmodel.Account,
+	status *gtsmodel.Status,
+	action gtsmodel.AccountWarningAction,
+) error {
+	switch action {
+	case gtsmodel.AccountWarningActionDeleteStatuses:
+		return p.state.Workers.Client.Process(
+			ctx,
+			&messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityDelete,
+				GTSModel:       status,
+				Origin:         targetAcct,
+				Target:         targetAcct,
+			},
+		)
+
+	case gtsmodel.AccountWarningActionMarkStatusesSensitive:
+		if *status.Sensitive {
+			// Already marked.
+			return nil
+		}
+
+		status.Sensitive = util.Ptr(true)
+		if err := p.state.DB.UpdateStatus(ctx, status, "sensitive"); err != nil {
+			return gtserror.Newf("db error updating status: %w", err)
+		}
+
+		return p.state.Workers.Client.Process(
+			ctx,
+			&messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityUpdate,
+				GTSModel:       status,
+				Origin:         targetAcct,
+			},
+		)
+
+	default:
+		return nil
+	}
+}
@@ -67,6 +67,7 @@ type AdminStandardTestSuite struct {
 	testAttachments  map[string]*gtsmodel.MediaAttachment
 	testStatuses     map[string]*gtsmodel.Status
 	testEmojis       map[string]*gtsmodel.Emoji
+	testRules        map[string]*gtsmodel.Rule
 
 	// module being tested
 	adminProcessor *admin.Processor
@@ -81,6 +82,7 @@ func (suite *AdminStandardTestSuite) SetupSuite() {
 	suite.testAttachments = testrig.NewTestAttachments()
 	suite.testStatuses = testrig.NewTestStatuses()
 	suite.testEmojis = testrig.NewTestEmojis()
+	suite.testRules = testrig.NewTestRules()
 }
 
 func (suite *AdminStandardTestSuite) SetupTest() {
@@ -114,6 +116,8 @@ func (suite *AdminStandardTestSuite) SetupTest() {
 		&suite.state,
 		suite.emailSender,
 		testrig.NewNoopWebPushSender(),
+		testrig.NewTestTranslator(),
+		testrig.NewTestDescriber(),
 		visibility.NewFilter(&suite.state),
 		mutes.NewFilter(&suite.state),
 		interaction.NewFilter(&suite.state),
@@ -0,0 +1,339 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// AppealsGet returns appeals stored on
+// this instance, with the given parameters.
+func (p *Processor) AppealsGet(
+	ctx context.Context,
+	resolved *bool,
+	accountID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	appeals, err := p.state.DB.GetAppeals(
+		ctx,
+		resolved,
+		accountID,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(appeals)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := appeals[count-1].ID
+	hi := appeals[0].ID
+
+	// Convert each appeal to API model.
+	items := make([]interface{}, 0, count)
+	for _, appeal := range appeals {
+		item, err := p.converter.AppealToAdminAPIAppeal(ctx, appeal)
+		if err != nil {
+			err := gtserror.Newf("error converting appeal to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		items = append(items, item)
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 2)
+	if resolved != nil {
+		query.Set(apiutil.ResolvedKey, strconv.FormatBool(*resolved))
+	}
+	if accountID != "" {
+		query.Set(apiutil.AccountIDKey, accountID)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/appeals",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// AppealGet returns one appeal, with the given ID.
+func (p *Processor) AppealGet(ctx context.Context, id string) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	appeal, errWithCode := p.getAppeal(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiAppeal, err := p.converter.AppealToAdminAPIAppeal(ctx, appeal)
+	if err != nil {
+		err := gtserror.Newf("error converting appeal to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAppeal, nil
+}
+
+// AppealApprove approves the appeal with the given id, storing
+// the provided responseText (if not null) and reverting what can
+// be reverted of the appealed warning, including any suspension
+// or silence of the account. The appealing account is notified
+// of the outcome.
+func (p *Processor) AppealApprove(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	responseText *string,
+) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	return p.appealResolve(ctx, adminAcct, id, responseText, true)
+}
+
+// AppealReject rejects the appeal with the given id, storing
+// the provided responseText (if not null). The appealing
+// account is notified of the outcome.
+func (p *Processor) AppealReject(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	responseText *string,
+) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	return p.appealResolve(ctx, adminAcct, id, responseText, false)
+}
+
+func (p *Processor) appealResolve(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	responseText *string,
+	approve bool,
+) (*apimodel.AdminAppeal, gtserror.WithCode) {
+	appeal, errWithCode := p.getAppeal(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !appeal.IsPending() {
+		const text = "appeal has already been approved or rejected"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if approve &&
+		appeal.AccountWarning.Action == gtsmodel.AccountWarningActionSuspend &&
+		appeal.Account.IsSuspended() {
+		// Make sure suspension can still be
+		// reversed before approving the appeal.
+		if errWithCode := p.checkCanUnsuspend(ctx, appeal.Account); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	columns := []string{
+		"action_taken_at",
+		"action_taken_by_account_id",
+		"approved",
+	}
+
+	appeal.ActionTakenAt = time.Now()
+	appeal.ActionTakenByAccountID = adminAcct.ID
+	appeal.ActionTakenByAccount = adminAcct
+	appeal.Approved = &approve
+
+	if responseText != nil {
+		appeal.ResponseText = *responseText
+		columns = append(columns, "response_text")
+	}
+
+	if approve {
+		// Store the appeal and revert the appealed
+		// warning as an admin action on the account.
+		if errWithCode := p.accountActionUnwarn(ctx, adminAcct, appeal, columns); errWithCode != nil {
+			return nil, errWithCode
+		}
+	} else {
+		if err := p.state.DB.UpdateAppeal(ctx, appeal, columns...); err != nil {
+			err := gtserror.Newf("db error updating appeal: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Process side effects of
+		// rejecting the appeal.
+		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ActivityFlag,
+			APActivityType: ap.ActivityReject,
+			GTSModel:       appeal,
+			Origin:         adminAcct,
+			Target:         appeal.Account,
+		})
+	}
+
+	apiAppeal, err := p.converter.AppealToAdminAPIAppeal(ctx, appeal)
+	if err != nil {
+		err := gtserror.Newf("error converting appeal to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAppeal, nil
+}
+
+// accountActionUnwarn updates the given (approved) appeal on the given
+// columns, and reverts the appealed account warning as far as is possible.
+// Statuses cited by a warning that marked them as sensitive will have their
+// sensitive flag removed, and a suspended or silenced account is unsuspended
+// or unsilenced. Deleted statuses cannot be restored.
+func (p *Processor) accountActionUnwarn(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	appeal *gtsmodel.Appeal,
+	columns []string,
+) gtserror.WithCode {
+	warning := appeal.AccountWarning
+	targetAcct := appeal.Account
+
+	return p.state.AdminActions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             id.NewULID(),
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionUnwarn,
+			AccountID:      adminAcct.ID,
+			Text:           appeal.ResponseText,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			var errs gtserror.MultiError
+
+			if err := p.state.DB.UpdateAppeal(ctx, appeal, columns...); err != nil {
+				errs.Appendf("db error updating appeal: %w", err)
+				return errs
+			}
+
+			switch warning.Action {
+			case gtsmodel.AccountWarningActionMarkStatusesSensitive:
+				for _, statusID := range warning.StatusIDs {
+					if err := p.unsensitizeStatus(ctx, targetAcct, statusID); err != nil {
+						errs.Appendf("error unmarking status %s as sensitive: %w", statusID, err)
+					}
+				}
+
+			case gtsmodel.AccountWarningActionSuspend:
+				if targetAcct.IsSuspended() {
+					if err := p.unsuspendAccount(ctx, targetAcct); err != nil {
+						errs.Appendf("error unsuspending account: %w", err)
+					}
+				}
+
+			case gtsmodel.AccountWarningActionSilence:
+				if targetAcct.IsSilenced() {
+					if err := p.unsilenceAccount(ctx, targetAcct); err != nil {
+						errs.Appendf("error unsilencing account: %w", err)
+					}
+				}
+			}
+
+			// Process side effects (ie.,
+			// notifying the appealing account).
+			if err := p.state.Workers.Client.Process(
+				ctx,
+				&messages.FromClientAPI{
+					APObjectType:   ap.ActivityFlag,
+					APActivityType: ap.ActivityAccept,
+					GTSModel:       appeal,
+					Origin:         adminAcct,
+					Target:         targetAcct,
+				},
+			); err != nil {
+				errs.Append(err)
+			}
+
+			return errs
+		},
+	)
+}
+
+// unsensitizeStatus removes the sensitive flag
+// from the status with the given ID, if it
+// still exists, federating the change.
+func (p *Processor) unsensitizeStatus(
+	ctx context.Context,
+	targetAcct *gtsmodel.Account,
+	statusID string,
+) error {
+	status, err := p.state.DB.GetStatusByID(ctx, statusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting status: %w", err)
+	}
+
+	if status == nil || !*status.Sensitive {
+		// Status deleted
+		// or not sensitive.
+		return nil
+	}
+
+	status.Sensitive = util.Ptr(false)
+	if err := p.state.DB.UpdateStatus(ctx, status, "sensitive"); err != nil {
+		return gtserror.Newf("db error updating status: %w", err)
+	}
+
+	return p.state.Workers.Client.Process(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       status,
+			Origin:         targetAcct,
+		},
+	)
+}
+
+// getAppeal fetches the appeal with the
+// given ID, returning 404 if not found.
+func (p *Processor) getAppeal(ctx context.Context, id string) (*gtsmodel.Appeal, gtserror.WithCode) {
+	appeal, err := p.state.DB.GetAppealByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting appeal %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if appeal == nil {
+		err := gtserror.Newf("appeal %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return appeal, nil
+}
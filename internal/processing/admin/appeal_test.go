@@ -0,0 +1,319 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"testing"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type AppealTestSuite struct {
+	AdminStandardTestSuite
+}
+
+// putWarningAndAppeal stores a warning marking the given
+// status as sensitive, and an appeal against that warning.
+func (suite *AppealTestSuite) putWarningAndAppeal(status *gtsmodel.Status) *gtsmodel.Appeal {
+	ctx := suite.T().Context()
+
+	// Mark status as sensitive,
+	// as though by a warning.
+	status.Sensitive = util.Ptr(true)
+	if err := suite.db.UpdateStatus(ctx, status, "sensitive"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	warning := &gtsmodel.AccountWarning{
+		ID:            id.NewULID(),
+		AccountID:     status.AccountID,
+		ByAccountID:   suite.testAccounts["admin_account"].ID,
+		AdminActionID: id.NewULID(),
+		Action:        gtsmodel.AccountWarningActionMarkStatusesSensitive,
+		StatusIDs:     []string{status.ID},
+	}
+	if err := suite.db.PutAccountWarning(ctx, warning); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return suite.putAppeal(warning)
+}
+
+// putAppeal stores an appeal against the given warning.
+func (suite *AppealTestSuite) putAppeal(warning *gtsmodel.AccountWarning) *gtsmodel.Appeal {
+	appeal := &gtsmodel.Appeal{
+		ID:               id.NewULID(),
+		AccountID:        warning.AccountID,
+		AccountWarningID: warning.ID,
+		Text:             "it's not that spicy",
+		Approved:         util.Ptr(false),
+	}
+	if err := suite.db.PutAppeal(suite.T().Context(), appeal); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return appeal
+}
+
+// takeAccountAction performs the given admin action against
+// the given account, waits for it to finish, and returns the
+// warning recorded against the account by the action.
+func (suite *AppealTestSuite) takeAccountAction(
+	actionType gtsmodel.AdminActionType,
+	targetAcct *gtsmodel.Account,
+) *gtsmodel.AccountWarning {
+	ctx := suite.T().Context()
+
+	_, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		suite.testAccounts["admin_account"],
+		&apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     actionType.String(),
+			Text:     "stinky",
+			TargetID: targetAcct.ID,
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	warnings, err := suite.db.GetAccountWarnings(ctx, targetAcct.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if len(warnings) != 1 {
+		suite.FailNow("", "expected 1 warning, got %d", len(warnings))
+	}
+
+	return warnings[0]
+}
+
+func (suite *AppealTestSuite) TestAppealApprove() {
+	var (
+		ctx          = suite.T().Context()
+		adminAcct    = suite.testAccounts["admin_account"]
+		status       = suite.testStatuses["local_account_1_status_1"]
+		appeal       = suite.putWarningAndAppeal(status)
+		responseText = "fair enough"
+	)
+
+	apiAppeal, errWithCode := suite.adminProcessor.AppealApprove(ctx, adminAcct, appeal.ID, &responseText)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("approved", apiAppeal.State)
+	suite.Equal(responseText, *apiAppeal.ResponseText)
+	suite.Equal(adminAcct.ID, apiAppeal.ActionTakenByAccount.ID)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure appeal updated in the db.
+	dbAppeal, err := suite.db.GetAppealByID(ctx, appeal.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("approved", dbAppeal.State())
+
+	// Ensure status no longer sensitive.
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*dbStatus.Sensitive)
+
+	// Ensure appealing account was notified.
+	if !testrig.WaitFor(func() bool {
+		notifs, _ := suite.db.GetAccountNotifications(ctx,
+			appeal.AccountID,
+			nil,
+			[]gtsmodel.NotificationType{gtsmodel.NotificationAppealApproved},
+			nil,
+		)
+		return len(notifs) == 1
+	}) {
+		suite.FailNow("timed out waiting for appeal notification")
+	}
+
+	// Appeal can't be resolved twice.
+	_, errWithCode = suite.adminProcessor.AppealReject(ctx, adminAcct, appeal.ID, nil)
+	suite.EqualError(errWithCode, "appeal has already been approved or rejected")
+}
+
+func (suite *AppealTestSuite) TestAppealReject() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		status    = suite.testStatuses["local_account_1_status_1"]
+		appeal    = suite.putWarningAndAppeal(status)
+	)
+
+	apiAppeal, errWithCode := suite.adminProcessor.AppealReject(ctx, adminAcct, appeal.ID, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("rejected", apiAppeal.State)
+	suite.Nil(apiAppeal.ResponseText)
+
+	// Ensure status still sensitive.
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbStatus.Sensitive)
+
+	// Ensure appealing account was notified.
+	if !testrig.WaitFor(func() bool {
+		notifs, _ := suite.db.GetAccountNotifications(ctx,
+			appeal.AccountID,
+			nil,
+			[]gtsmodel.NotificationType{gtsmodel.NotificationAppealRejected},
+			nil,
+		)
+		return len(notifs) == 1
+	}) {
+		suite.FailNow("timed out waiting for appeal notification")
+	}
+}
+
+func (suite *AppealTestSuite) TestAppealApproveSuspension() {
+	config.SetAccountsSuspensionGracePeriod(7 * 24 * time.Hour)
+	defer config.SetAccountsSuspensionGracePeriod(0)
+
+	var (
+		ctx        = suite.T().Context()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+		warning    = suite.takeAccountAction(gtsmodel.AdminActionSuspend, targetAcct)
+		appeal     = suite.putAppeal(warning)
+	)
+
+	suite.Equal(gtsmodel.AccountWarningActionSuspend, warning.Action)
+
+	apiAppeal, errWithCode := suite.adminProcessor.AppealApprove(ctx, adminAcct, appeal.ID, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(apiAppeal.Reversible)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure account no longer suspended.
+	dbAccount, err := suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(dbAccount.SuspendedAt)
+	suite.Empty(dbAccount.SuspensionOrigin)
+}
+
+func (suite *AppealTestSuite) TestAppealApproveSilence() {
+	var (
+		ctx        = suite.T().Context()
+		adminAcct  = suite.testAccounts["admin_account"]
+		targetAcct = suite.testAccounts["local_account_1"]
+		warning    = suite.takeAccountAction(gtsmodel.AdminActionSilence, targetAcct)
+		appeal     = suite.putAppeal(warning)
+	)
+
+	suite.Equal(gtsmodel.AccountWarningActionSilence, warning.Action)
+
+	// Ensure account silenced.
+	dbAccount, err := suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbAccount.IsSilenced())
+
+	if _, errWithCode := suite.adminProcessor.AppealApprove(ctx, adminAcct, appeal.ID, nil); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.state.AdminActions.TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure account no longer silenced.
+	dbAccount, err = suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(dbAccount.IsSilenced())
+}
+
+func (suite *AppealTestSuite) TestAppealDeleteStatusesNotReversible() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		status    = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	warning := &gtsmodel.AccountWarning{
+		ID:            id.NewULID(),
+		AccountID:     status.AccountID,
+		ByAccountID:   adminAcct.ID,
+		AdminActionID: id.NewULID(),
+		Action:        gtsmodel.AccountWarningActionDeleteStatuses,
+		StatusIDs:     []string{status.ID},
+	}
+	if err := suite.db.PutAccountWarning(ctx, warning); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	appeal := suite.putAppeal(warning)
+
+	apiAppeal, errWithCode := suite.adminProcessor.AppealGet(ctx, appeal.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(apiAppeal.Reversible)
+}
+
+func TestAppealTestSuite(t *testing.T) {
+	suite.Run(t, new(AppealTestSuite))
+}
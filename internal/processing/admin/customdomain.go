@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// CustomDomainsGet returns custom domains requested
+// by local accounts, with the given parameters.
+func (p *Processor) CustomDomainsGet(
+	ctx context.Context,
+	approved *bool,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	domains, err := p.state.DB.GetAccountCustomDomains(ctx, approved, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(domains)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := domains[count-1].ID
+	hi := domains[0].ID
+
+	// Convert each domain to API model.
+	items := make([]interface{}, 0, count)
+	for _, domain := range domains {
+		item, err := p.converter.CustomDomainToAPICustomDomain(ctx, domain, true)
+		if err != nil {
+			err := gtserror.Newf("error converting custom domain to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		items = append(items, item)
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 1)
+	if approved != nil {
+		query.Set(apiutil.ApprovedKey, strconv.FormatBool(*approved))
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/custom_domains",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// CustomDomainApprove approves the custom domain with the given id.
+// Once control of the domain has also been verified by the account
+// that requested it, the domain is put in use.
+func (p *Processor) CustomDomainApprove(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.AccountCustomDomain, gtserror.WithCode) {
+	domain, errWithCode := p.getCustomDomain(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if domain.IsApproved() {
+		const text = "custom domain has already been approved"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	domain.ApprovedAt = time.Now()
+	domain.ApprovedByAccountID = adminAcct.ID
+	domain.ApprovedByAccount = adminAcct
+
+	if err := p.state.DB.UpdateAccountCustomDomain(
+		ctx,
+		domain,
+		"approved_at",
+		"approved_by_account_id",
+	); err != nil {
+		err := gtserror.Newf("db error updating custom domain: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiCustomDomain(ctx, domain)
+}
+
+// CustomDomainReject rejects the custom domain with the given
+// id, removing it from the account that requested it. This can
+// also be used to take an already approved domain out of use.
+func (p *Processor) CustomDomainReject(
+	ctx context.Context,
+	id string,
+) (*apimodel.AccountCustomDomain, gtserror.WithCode) {
+	domain, errWithCode := p.getCustomDomain(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteAccountCustomDomainByID(ctx, domain.ID); err != nil {
+		err := gtserror.Newf("db error deleting custom domain: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiCustomDomain(ctx, domain)
+}
+
+// getCustomDomain fetches the custom domain
+// with the given ID, returning 404 if not found.
+func (p *Processor) getCustomDomain(ctx context.Context, id string) (*gtsmodel.AccountCustomDomain, gtserror.WithCode) {
+	domain, err := p.state.DB.GetAccountCustomDomainByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting custom domain %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if domain == nil {
+		err := gtserror.Newf("custom domain %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return domain, nil
+}
+
+func (p *Processor) apiCustomDomain(
+	ctx context.Context,
+	domain *gtsmodel.AccountCustomDomain,
+) (*apimodel.AccountCustomDomain, gtserror.WithCode) {
+	apiDomain, err := p.converter.CustomDomainToAPICustomDomain(ctx, domain, true)
+	if err != nil {
+		err := gtserror.Newf("error converting custom domain to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDomain, nil
+}
@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomDomainTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *CustomDomainTestSuite) putCustomDomain(account *gtsmodel.Account, domain string) *gtsmodel.AccountCustomDomain {
+	customDomain := &gtsmodel.AccountCustomDomain{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Domain:    domain,
+	}
+	if err := suite.db.PutAccountCustomDomain(suite.T().Context(), customDomain); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return customDomain
+}
+
+func (suite *CustomDomainTestSuite) TestCustomDomainApproveReject() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		zork      = suite.testAccounts["local_account_1"]
+		turtle    = suite.testAccounts["local_account_2"]
+		page      = &paging.Page{Limit: 20}
+	)
+
+	zorkDomain := suite.putCustomDomain(zork, "zork.example.org")
+	turtleDomain := suite.putCustomDomain(turtle, "turtle.example.org")
+
+	// Both domains should be pending.
+	resp, errWithCode := suite.adminProcessor.CustomDomainsGet(ctx, util.Ptr(false), page)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 2)
+
+	// Approve zork's domain.
+	apiDomain, errWithCode := suite.adminProcessor.CustomDomainApprove(ctx, adminAcct, zorkDomain.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotNil(apiDomain.ApprovedAt)
+	suite.Equal(zork.ID, apiDomain.Account.ID)
+
+	// Zork's domain can't be approved twice.
+	_, errWithCode = suite.adminProcessor.CustomDomainApprove(ctx, adminAcct, zorkDomain.ID)
+	suite.EqualError(errWithCode, "custom domain has already been approved")
+
+	// Now only turtle's should be pending.
+	resp, errWithCode = suite.adminProcessor.CustomDomainsGet(ctx, util.Ptr(false), page)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+
+	// Reject turtle's domain.
+	if _, errWithCode := suite.adminProcessor.CustomDomainReject(ctx, turtleDomain.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// It should be gone from the db.
+	_, err := suite.db.GetAccountCustomDomainByID(ctx, turtleDomain.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestCustomDomainTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomDomainTestSuite))
+}
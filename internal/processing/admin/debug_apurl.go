@@ -49,32 +49,8 @@ func (p *Processor) DebugAPUrl(
 	urlStr string,
 ) (*apimodel.DebugAPUrlResponse, gtserror.WithCode) {
 	// Validate URL.
-	if urlStr == "" {
-		err := gtserror.New("empty URL")
-		return nil, gtserror.NewErrorBadRequest(err, err.Error())
-	}
-
-	url, err := url.Parse(urlStr)
-	if err != nil {
-		err := gtserror.Newf("invalid URL: %w", err)
-		return nil, gtserror.NewErrorBadRequest(err, err.Error())
-	}
-
-	if url == nil || (url.Scheme != "http" && url.Scheme != "https") {
-		err = gtserror.New("invalid URL scheme, acceptable schemes are http or https")
-		return nil, gtserror.NewErrorBadRequest(err, err.Error())
-	}
-
-	// Ensure URL not blocked.
-	blocked, err := p.state.DB.IsDomainBlocked(ctx, url.Host)
-	if err != nil {
-		err = gtserror.Newf("db error checking for domain block: %w", err)
-		return nil, gtserror.NewErrorInternalError(err, err.Error())
-	}
-
-	if blocked {
-		err = gtserror.Newf("target domain %s is blocked", url.Host)
-		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	if _, errWithCode := p.parseDebugURL(ctx, urlStr); errWithCode != nil {
+		return nil, errWithCode
 	}
 
 	// All looks fine. Prepare the transport and (signed) GET request.
@@ -123,3 +99,38 @@ func (p *Processor) DebugAPUrl(
 
 	return debugResponse, nil
 }
+
+// parseDebugURL parses the given url string for use by
+// the debug functions, checking that it's an http(s)
+// url, and that it doesn't point to a blocked domain.
+func (p *Processor) parseDebugURL(ctx context.Context, urlStr string) (*url.URL, gtserror.WithCode) {
+	if urlStr == "" {
+		err := gtserror.New("empty URL")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	url, err := url.Parse(urlStr)
+	if err != nil {
+		err := gtserror.Newf("invalid URL: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if url == nil || (url.Scheme != "http" && url.Scheme != "https") {
+		err = gtserror.New("invalid URL scheme, acceptable schemes are http or https")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Ensure URL not blocked.
+	blocked, err := p.state.DB.IsDomainBlocked(ctx, url.Host)
+	if err != nil {
+		err = gtserror.Newf("db error checking for domain block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err, err.Error())
+	}
+
+	if blocked {
+		err = gtserror.Newf("target domain %s is blocked", url.Host)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return url, nil
+}
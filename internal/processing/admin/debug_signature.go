@@ -0,0 +1,369 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.superseriousbusiness.org/activity/streams"
+	"code.superseriousbusiness.org/activity/streams/vocab"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/federation"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/transport"
+)
+
+// maxClockSkew is the clock difference beyond which
+// signed requests from a remote instance are likely
+// to be rejected by others as being expired / from
+// the future (eg., Mastodon allows up to 1 hour).
+const maxClockSkew = time.Hour
+
+// DebugSignature dereferences the given url using the signature
+// of the given admin account, and then performs the same steps
+// this instance takes when verifying the http signature of an
+// incoming request made by the actor of the dereferenced object:
+// locating the actor's public key, fetching it, and checking
+// its owner, algorithm, and any cached copy in the database.
+//
+// Failed steps don't return an error; they're reported in
+// the response instead, along with verbose details, to help
+// with debugging. Errors are only returned for invalid input.
+func (p *Processor) DebugSignature(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	urlStr string,
+) (*apimodel.DebugSignatureResponse, gtserror.WithCode) {
+	// Validate URL.
+	uri, errWithCode := p.parseDebugURL(ctx, urlStr)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	tsport, err := p.transport.NewTransportForUsername(ctx, adminAcct.Username)
+	if err != nil {
+		err = gtserror.Newf("error creating transport: %w", err)
+		return nil, gtserror.NewErrorInternalError(err, err.Error())
+	}
+
+	algos := federation.SigningAlgorithms()
+	resp := &apimodel.DebugSignatureResponse{
+		RequestURI:          urlStr,
+		SignatureAlgorithms: make([]string, len(algos)),
+		DigestCheck:         "absent",
+		Steps:               make([]apimodel.DebugSignatureStep, 0, 8),
+	}
+
+	for i, algo := range algos {
+		resp.SignatureAlgorithms[i] = string(algo)
+	}
+
+	// step appends a verification step result to
+	// the response, returning the given ok value.
+	step := func(name string, ok bool, format string, args ...any) bool {
+		resp.Steps = append(resp.Steps, apimodel.DebugSignatureStep{
+			Name:   name,
+			OK:     ok,
+			Detail: fmt.Sprintf(format, args...),
+		})
+		return ok
+	}
+
+	// stepErr appends a verification step result
+	// to the response, failed if err is set.
+	stepErr := func(name string, err error, detail string) bool {
+		if err != nil {
+			return step(name, false, "%s: %v", detail, err)
+		}
+		return step(name, true, "%s: ok", detail)
+	}
+
+	// Caller will want a snappy
+	// response so don't retry.
+	ctx = gtscontext.SetFastFail(ctx)
+
+	// Perform a signed GET of the object itself. Unlike for
+	// the key we use the raw response here regardless of
+	// status code, as its headers may still be informative.
+	rsp, err := debugGet(ctx, tsport, uri)
+	if !stepErr("fetch_object", err, "GET "+uri.String()) {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	resp.ResponseCode = rsp.StatusCode
+
+	// Check the remote's clock against ours, as
+	// a skewed clock on either side will cause
+	// the Date of signed requests to be rejected.
+	if date, err := http.ParseTime(rsp.Header.Get("Date")); err == nil {
+		skew := time.Until(date).Round(time.Second)
+		secs := skew.Seconds()
+		resp.ClockSkew = &secs
+
+		ok := skew.Abs() <= maxClockSkew
+		step("clock_skew", ok, "remote clock differs from ours by %s (max allowed %s)", skew, maxClockSkew)
+	} else {
+		step("clock_skew", false, "no valid Date header in response: %q", rsp.Header.Get("Date"))
+	}
+
+	// Check any Digest given in the
+	// response against the read body.
+	var digestOK bool
+	resp.DigestCheck, digestOK = checkDigest(rsp.Header.Get("Digest"), body)
+	step("digest_check", digestOK, "Digest header %q: %s", rsp.Header.Get("Digest"), resp.DigestCheck)
+
+	switch {
+	case err != nil:
+		step("read_object", false, "error reading response body: %v", err)
+		return resp, nil
+
+	case rsp.StatusCode == http.StatusUnauthorized ||
+		rsp.StatusCode == http.StatusForbidden:
+		step("read_object", false, "remote responded %s; it may have failed to "+
+			"verify OUR signature, or be refusing to serve requests from us", rsp.Status)
+		return resp, nil
+
+	case rsp.StatusCode != http.StatusOK:
+		step("read_object", false, "remote responded %s", rsp.Status)
+		return resp, nil
+	}
+
+	t, err := resolveDebugType(ctx, body)
+	if !stepErr("read_object", err, "resolving response body as ActivityStreams type") {
+		return resp, nil
+	}
+
+	// Locate the actor who signs requests on behalf
+	// of the object, and the actor's public key.
+	actor, err := p.debugFindActor(ctx, tsport, uri, t)
+	if !stepErr("find_actor", err, "locating actor of "+t.GetTypeName()+" "+uri.String()) {
+		return resp, nil
+	}
+	resp.ActorURI = ap.GetJSONLDId(actor).String()
+
+	actorKey, keyID, _, err := ap.ExtractPubKeyFromActor(actor)
+	if !stepErr("find_key_id", err, "extracting public key from actor "+resp.ActorURI) {
+		return resp, nil
+	}
+	resp.KeyID = keyID.String()
+
+	// Fetch the key from its ID, the same way we
+	// would do when verifying a request. Fragment
+	// is never sent, so don't report it either.
+	keyURL := *keyID
+	keyURL.Fragment = ""
+	rsp, err = tsport.Dereference(ctx, &keyURL)
+	if !stepErr("fetch_key", err, "dereferencing public key "+keyID.String()) {
+		return resp, nil
+	}
+
+	body, err = io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if rsp.Request != nil {
+		// Final URL, after redirects.
+		resp.KeyFetchedFrom = rsp.Request.URL.String()
+	} else {
+		resp.KeyFetchedFrom = keyURL.String()
+	}
+	if !stepErr("read_key", err, "reading public key from "+resp.KeyFetchedFrom) {
+		return resp, nil
+	}
+
+	pubKey, owner, err := federation.ParsePubKeyBytes(ctx, body, keyID)
+	if !stepErr("parse_key", err, "parsing public key") {
+		return resp, nil
+	}
+	resp.KeyOwner = owner.String()
+	resp.KeyAlgorithm = fmt.Sprintf("RSA-%d", pubKey.N.BitLen())
+
+	step("key_owner", resp.KeyOwner == resp.ActorURI,
+		"key owner %s, actor %s", resp.KeyOwner, resp.ActorURI)
+
+	step("key_matches_actor", pubKey.Equal(actorKey),
+		"fetched public key equal to public key embedded in actor: %t", pubKey.Equal(actorKey))
+
+	// Check against what we already have stored. A stale
+	// cached key is the most common reason for a remote
+	// that recently rotated keys (or reinstalled) to be
+	// unable to authenticate with us.
+	p.debugCheckCachedKey(ctx, resp.KeyID, pubKey, step)
+
+	return resp, nil
+}
+
+// debugGet performs a signed ActivityPub GET
+// of the given url, returning the raw response.
+func debugGet(ctx context.Context, tsport transport.Transport, uri *url.URL) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", string(apiutil.AppActivityLDJSON)+","+string(apiutil.AppActivityJSON))
+	req.Header.Add("Accept-Charset", "utf-8")
+
+	return tsport.GET(req)
+}
+
+// resolveDebugType resolves the given
+// JSON bytes as an ActivityStreams type.
+func resolveDebugType(ctx context.Context, b []byte) (vocab.Type, error) {
+	m := make(map[string]any)
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return streams.ToType(ctx, m)
+}
+
+// debugFindActor returns the actor who signs requests on
+// behalf of the given object, which is either the object
+// itself (if an actor), or its attributedTo / actor.
+func (p *Processor) debugFindActor(
+	ctx context.Context,
+	tsport transport.Transport,
+	uri *url.URL,
+	t vocab.Type,
+) (ap.Accountable, error) {
+	if actor, ok := ap.ToAccountable(t); ok {
+		return actor, nil
+	}
+
+	var (
+		actorURI *url.URL
+		err      error
+	)
+
+	switch t := t.(type) {
+	case ap.WithAttributedTo:
+		actorURI, err = ap.ExtractAttributedToURI(t)
+	case ap.WithActor:
+		actorURI, err = ap.ExtractActorURI(t)
+	default:
+		err = gtserror.Newf("type %T has no attributedTo or actor", t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if actorURI.Host != uri.Host {
+		return nil, gtserror.Newf("actor %s is on different host to object", actorURI)
+	}
+
+	rsp, err := tsport.Dereference(ctx, actorURI)
+	if err != nil {
+		return nil, gtserror.Newf("error dereferencing actor %s: %w", actorURI, err)
+	}
+
+	b, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, gtserror.Newf("error reading actor %s: %w", actorURI, err)
+	}
+
+	t, err = resolveDebugType(ctx, b)
+	if err != nil {
+		return nil, gtserror.Newf("error resolving actor %s: %w", actorURI, err)
+	}
+
+	actor, ok := ap.ToAccountable(t)
+	if !ok {
+		return nil, gtserror.Newf("%s at %s is not an actor", t.GetTypeName(), actorURI)
+	}
+
+	return actor, nil
+}
+
+// debugCheckCachedKey compares the given fetched public
+// key against any copy cached in the database under keyID.
+func (p *Processor) debugCheckCachedKey(
+	ctx context.Context,
+	keyID string,
+	pubKey *rsa.PublicKey,
+	step func(name string, ok bool, format string, args ...any) bool,
+) {
+	owner, err := p.state.DB.GetAccountByPubkeyID(gtscontext.SetBarebones(ctx), keyID)
+	switch {
+	case errors.Is(err, db.ErrNoEntries):
+		step("cached_key", true, "public key not cached, it will be fetched on first signed request")
+	case err != nil:
+		step("cached_key", false, "db error getting account with public key: %v", err)
+	case owner.PubKeyExpired():
+		step("cached_key", true, "cached public key expired at %s, it will be refetched on next signed request",
+			owner.PublicKeyExpiresAt.Format(time.RFC3339))
+	case !owner.PublicKey.Equal(pubKey):
+		step("cached_key", false, "cached public key differs from fetched public key; "+
+			"if the remote rotated its keys, expire them with the domain keys expire endpoint")
+	default:
+		step("cached_key", true, "cached public key matches fetched public key")
+	}
+}
+
+// checkDigest checks the given Digest header value
+// against the given body, returning the result of
+// the check, and whether the check passed. A missing
+// or unsupported Digest is considered to have passed,
+// as Digest is not required on responses.
+func checkDigest(digest string, body []byte) (string, bool) {
+	if digest == "" {
+		return "absent", true
+	}
+
+	algo, value, ok := strings.Cut(digest, "=")
+	if !ok {
+		return "mismatch", false
+	}
+
+	var sum []byte
+	switch strings.ToUpper(algo) {
+	case "SHA-256":
+		s := sha256.Sum256(body)
+		sum = s[:]
+	case "SHA-512":
+		s := sha512.Sum512(body)
+		sum = s[:]
+	default:
+		return "unsupported", true
+	}
+
+	expect, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || !bytes.Equal(expect, sum) {
+		return "mismatch", false
+	}
+
+	return "ok", true
+}
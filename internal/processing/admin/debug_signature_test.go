@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DebugSignatureTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DebugSignatureTestSuite) TestDebugSignatureStatus() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		uri       = "https://unknown-instance.com/users/brand_new_person/statuses/01FE4NTHKWW7THT67EF10EB839"
+	)
+
+	resp, errWithCode := suite.adminProcessor.DebugSignature(ctx, adminAcct, uri)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal(http.StatusOK, resp.ResponseCode)
+	suite.Equal("https://unknown-instance.com/users/brand_new_person", resp.ActorURI)
+	suite.Equal("https://unknown-instance.com/users/brand_new_person#main-key", resp.KeyID)
+	suite.Equal("https://unknown-instance.com/users/brand_new_person", resp.KeyFetchedFrom)
+	suite.Equal("https://unknown-instance.com/users/brand_new_person", resp.KeyOwner)
+	suite.Equal("RSA-2048", resp.KeyAlgorithm)
+	suite.Equal("absent", resp.DigestCheck)
+
+	// Test transport doesn't set a
+	// Date header, so can't check skew.
+	suite.Nil(resp.ClockSkew)
+
+	failed := make([]string, 0)
+	for _, step := range resp.Steps {
+		if !step.OK {
+			failed = append(failed, step.Name)
+		}
+	}
+	suite.Equal([]string{"clock_skew"}, failed)
+	suite.Equal("cached_key", resp.Steps[len(resp.Steps)-1].Name)
+}
+
+func (suite *DebugSignatureTestSuite) TestDebugSignatureInvalidScheme() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		uri       = "ftp://unknown-instance.com/users/brand_new_person"
+	)
+
+	_, errWithCode := suite.adminProcessor.DebugSignature(ctx, adminAcct, uri)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestDebugSignatureTestSuite(t *testing.T) {
+	suite.Run(t, new(DebugSignatureTestSuite))
+}
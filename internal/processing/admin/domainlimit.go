@@ -23,6 +23,7 @@
 	"slices"
 	"strings"
 
+	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gopkg/xslices"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
@@ -36,6 +37,10 @@
 func (p *Processor) DomainLimitsGet(ctx context.Context, page *paging.Page) (*apimodel.PageableResponse, gtserror.WithCode) {
 	// Get domain limits.
 	domainLimits, err := p.state.DB.GetDomainLimits(ctx, page)
+	if gtserror.IsMalformed(err) {
+		const help = "bad cursor value"
+		return nil, gtserror.NewErrorBadRequest(err, help)
+	}
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		err := gtserror.Newf("db error: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
@@ -59,7 +64,8 @@ func (p *Processor) DomainLimitsGet(ctx context.Context, page *paging.Page) (*ap
 	}
 
 	var lo, hi string
-	if !page.Paging() {
+	switch {
+	case !page.Paging():
 		// If not paging, sort
 		// items alphabetically.
 		slices.SortFunc(
@@ -68,7 +74,16 @@ func(a *apimodel.DomainLimit, b *apimodel.DomainLimit) int {
 				return strings.Compare(a.Domain, b.Domain)
 			},
 		)
-	} else {
+
+	case page.Cursor():
+		// If cursor paging, items are already
+		// sorted alphabetically, so assemble
+		// next/prev cursors from domain + ID.
+		first, last := domainLimits[0], domainLimits[count-1]
+		lo = paging.EncodeCursor(last.Domain, last.ID)
+		hi = paging.EncodeCursor(first.Domain, first.ID)
+
+	default:
 		// If paging, leave sorted by ID,
 		// and assemble next/prev queries.
 		lo = domainLimits[count-1].ID
@@ -113,6 +128,7 @@ func (p *Processor) DomainLimitCreate(
 	followsPolicy apimodel.FollowsPolicy,
 	statusesPolicy apimodel.StatusesPolicy,
 	accountsPolicy apimodel.AccountsPolicy,
+	federationPolicy apimodel.FederationPolicy,
 	contentWarning string,
 	publicComment string,
 	privateComment string,
@@ -139,6 +155,11 @@ func (p *Processor) DomainLimitCreate(
 		return nil, errWithCode
 	}
 
+	fedp, errWithCode := parseFederationPolicy(federationPolicy)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Create + store domain limit.
 	domainLimit := &gtsmodel.DomainLimit{
 		ID:                 id.NewULID(),
@@ -151,6 +172,7 @@ func (p *Processor) DomainLimitCreate(
 		FollowsPolicy:      fp,
 		StatusesPolicy:     sp,
 		AccountsPolicy:     ap,
+		FederationPolicy:   fedp,
 		ContentWarning:     contentWarning,
 	}
 
@@ -183,6 +205,7 @@ func (p *Processor) DomainLimitUpdate(
 	followsPolicy *apimodel.FollowsPolicy,
 	statusesPolicy *apimodel.StatusesPolicy,
 	accountsPolicy *apimodel.AccountsPolicy,
+	federationPolicy *apimodel.FederationPolicy,
 	contentWarning *string,
 	publicComment *string,
 	privateComment *string,
@@ -202,6 +225,10 @@ func (p *Processor) DomainLimitUpdate(
 	// for selective updating.
 	var columns []string
 
+	// Note whether federation was
+	// paused prior to the update.
+	wasPaused := domainLimit.FederationPaused()
+
 	// Parse policies (if set).
 	if mediaPolicy != nil {
 		mp, errWithCode := parseMediaPolicy(*mediaPolicy)
@@ -243,6 +270,16 @@ func (p *Processor) DomainLimitUpdate(
 		columns = append(columns, "accounts_policy")
 	}
 
+	if federationPolicy != nil {
+		fedp, errWithCode := parseFederationPolicy(*federationPolicy)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		domainLimit.FederationPolicy = fedp
+		columns = append(columns, "federation_policy")
+	}
+
 	// Parse other nillable fields.
 	if contentWarning != nil {
 		domainLimit.ContentWarning = *contentWarning
@@ -266,6 +303,12 @@ func (p *Processor) DomainLimitUpdate(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if wasPaused && !domainLimit.FederationPaused() {
+		// Federation with the domain was
+		// just resumed, deliver held back.
+		p.resumePausedDeliveries()
+	}
+
 	apiDomainLimit, err := p.converter.DomainLimitToAPIDomainLimit(ctx, domainLimit)
 	if err != nil {
 		err := gtserror.Newf("error converting domain limit: %w", err)
@@ -304,6 +347,12 @@ func (p *Processor) DomainLimitDelete(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if domainLimit.FederationPaused() {
+		// Federation with the domain was
+		// just resumed, deliver held back.
+		p.resumePausedDeliveries()
+	}
+
 	return apiDomainLimit, nil
 }
 
@@ -350,3 +399,24 @@ func parseAccountsPolicy(accountsPolicy apimodel.AccountsPolicy) (gtsmodel.Accou
 	errWithCode := gtserror.NewErrorBadRequest(errors.New(text), text)
 	return 0, errWithCode
 }
+
+func parseFederationPolicy(federationPolicy apimodel.FederationPolicy) (gtsmodel.FederationPolicy, gtserror.WithCode) {
+	fp := typeutils.APIFederationPolicyToFederationPolicy(federationPolicy)
+	if fp != gtsmodel.FederationPolicyUnknown {
+		return fp, nil
+	}
+
+	const text = "federation_policy unknown, must be one of no_action (default), or pause"
+	errWithCode := gtserror.NewErrorBadRequest(errors.New(text), text)
+	return 0, errWithCode
+}
+
+// resumePausedDeliveries asynchronously pushes deliveries
+// held back by paused federation to the delivery queue.
+func (p *Processor) resumePausedDeliveries() {
+	p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+		if err := p.ResumePausedDeliveries(ctx); err != nil {
+			log.Error(ctx, err)
+		}
+	})
+}
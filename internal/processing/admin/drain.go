@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Drain puts the server into drain mode on behalf of the given
+// admin account, after which it will stop accepting new requests,
+// finish serving in-flight ones, persist any queued worker tasks
+// to the database, and then exit. This is the same as sending
+// the process a SIGTERM, for when that isn't possible.
+func (p *Processor) Drain(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+) gtserror.WithCode {
+	log.Infof(ctx, "drain requested by admin %s", adminAcct.Username)
+	p.state.Drain.Start()
+	return nil
+}
@@ -0,0 +1,209 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// FailedActivitiesGet returns a page of inbound activities
+// that failed processing, optionally filtered by activity
+// type and / or failure reason.
+func (p *Processor) FailedActivitiesGet(
+	ctx context.Context,
+	activityType string,
+	reason gtsmodel.FailureReason,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	failedActivities, err := p.state.DB.GetFailedActivities(
+		ctx,
+		activityType,
+		reason,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(failedActivities)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := failedActivities[count-1].ID
+	hi := failedActivities[0].ID
+
+	// Convert each failed activity to API model.
+	items := make([]any, len(failedActivities))
+	for i, failed := range failedActivities {
+		apiFailed, errWithCode := p.apiFailedActivity(ctx, failed)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items[i] = apiFailed
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 2)
+	if activityType != "" {
+		query.Set(apiutil.FailedActivityTypeKey, activityType)
+	}
+	if reason != gtsmodel.FailureReasonUnknown {
+		query.Set(apiutil.FailedActivityReasonKey, reason.String())
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/failed_activities",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// FailedActivityGet returns one failed activity, with the given ID.
+func (p *Processor) FailedActivityGet(ctx context.Context, id string) (*apimodel.AdminFailedActivity, gtserror.WithCode) {
+	failed, errWithCode := p.getFailedActivity(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFailedActivity(ctx, failed)
+}
+
+// FailedActivitiesCount returns counts of stored failed activities,
+// grouped by activity type, object type and failure reason.
+func (p *Processor) FailedActivitiesCount(ctx context.Context) ([]*apimodel.AdminFailedActivityCount, gtserror.WithCode) {
+	counts, err := p.state.DB.CountFailedActivities(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error counting failed activities: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiCounts := make([]*apimodel.AdminFailedActivityCount, 0, len(counts))
+	for _, count := range counts {
+		apiCounts = append(apiCounts, &apimodel.AdminFailedActivityCount{
+			ActivityType: count.APActivityType,
+			ObjectType:   count.APObjectType,
+			Reason:       count.Reason.String(),
+			Count:        count.Count,
+		})
+	}
+
+	return apiCounts, nil
+}
+
+// FailedActivityRetry removes the failed activity with the
+// given ID from the database, and pushes it back onto the
+// federator worker queue for processing. If processing
+// fails again, it will be stored as a new failed activity.
+func (p *Processor) FailedActivityRetry(ctx context.Context, id string) (*apimodel.AdminFailedActivity, gtserror.WithCode) {
+	failed, errWithCode := p.getFailedActivity(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiFailed, errWithCode := p.apiFailedActivity(ctx, failed)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if util.PtrOrZero(failed.Truncated) {
+		// Raw activity JSON was dropped, there's
+		// nothing left to actually reprocess.
+		const text = "failed activity was truncated and cannot be retried"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Rebuild the original federator message.
+	msg, err := p.deserializeFederator(ctx, failed.Data)
+	if err != nil {
+		err := fmt.Errorf("failed activity %s cannot be retried: %w", id, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if err := p.state.DB.DeleteFailedActivityByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting failed activity: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Push message back onto the federator queue.
+	p.state.Workers.Federator.Queue.Push(msg)
+
+	return apiFailed, nil
+}
+
+// FailedActivityDelete discards the failed activity with the given ID.
+func (p *Processor) FailedActivityDelete(ctx context.Context, id string) (*apimodel.AdminFailedActivity, gtserror.WithCode) {
+	failed, errWithCode := p.getFailedActivity(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiFailed, errWithCode := p.apiFailedActivity(ctx, failed)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteFailedActivityByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting failed activity: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiFailed, nil
+}
+
+func (p *Processor) getFailedActivity(ctx context.Context, id string) (*gtsmodel.FailedActivity, gtserror.WithCode) {
+	failed, err := p.state.DB.GetFailedActivityByID(gtscontext.SetBarebones(ctx), id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("failed activity %s not found", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err := gtserror.Newf("db error getting failed activity %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return failed, nil
+}
+
+func (p *Processor) apiFailedActivity(ctx context.Context, failed *gtsmodel.FailedActivity) (*apimodel.AdminFailedActivity, gtserror.WithCode) {
+	apiFailed, err := p.converter.FailedActivityToAdminAPIFailedActivity(ctx, failed)
+	if err != nil {
+		err := gtserror.Newf("error converting failed activity %s: %w", failed.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiFailed, nil
+}
@@ -0,0 +1,168 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type FailedActivityTestSuite struct {
+	AdminStandardTestSuite
+}
+
+// putFailedActivity stores an unhandled
+// activity from remote_account_1 to
+// local_account_1 as a failed activity.
+func (suite *FailedActivityTestSuite) putFailedActivity(iri string) *gtsmodel.FailedActivity {
+	msg := &messages.FromFediAPI{
+		APObjectType:   ap.ActivityFlag,
+		APActivityType: ap.ActivityUpdate,
+		APIRI:          testrig.URLMustParse(iri),
+		Requesting:     suite.testAccounts["remote_account_1"],
+		Receiving:      suite.testAccounts["local_account_1"],
+	}
+
+	data, err := msg.Serialize()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	failed := &gtsmodel.FailedActivity{
+		ID:                  id.NewULID(),
+		APActivityType:      msg.APActivityType,
+		APObjectType:        msg.APObjectType,
+		APIRI:               iri,
+		RequestingAccountID: msg.Requesting.ID,
+		ReceivingAccountID:  msg.Receiving.ID,
+		Reason:              gtsmodel.FailureReasonUnhandled,
+		Error:               "unhandled: Update Flag",
+		Data:                data,
+	}
+
+	if err := suite.db.PutFailedActivity(suite.T().Context(), failed); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return failed
+}
+
+func (suite *FailedActivityTestSuite) TestFailedActivityGetDelete() {
+	ctx := suite.T().Context()
+	failed := suite.putFailedActivity("http://fossbros-anonymous.io/users/foss_satan/flags/1")
+
+	apiFailed, errWithCode := suite.adminProcessor.FailedActivityGet(ctx, failed.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal(failed.ID, apiFailed.ID)
+	suite.Equal(ap.ActivityUpdate, apiFailed.ActivityType)
+	suite.Equal(ap.ActivityFlag, apiFailed.ObjectType)
+	suite.Equal(failed.APIRI, apiFailed.IRI)
+	suite.Equal("unhandled", apiFailed.Reason)
+	suite.Equal(suite.testAccounts["remote_account_1"].URI, apiFailed.RequestingAccountURI)
+	suite.Equal("the_mighty_zork", apiFailed.ReceivingAccountUsername)
+
+	counts, errWithCode := suite.adminProcessor.FailedActivitiesCount(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if suite.Len(counts, 1) {
+		suite.Equal("unhandled", counts[0].Reason)
+		suite.Equal(1, counts[0].Count)
+	}
+
+	if _, errWithCode := suite.adminProcessor.FailedActivityDelete(ctx, failed.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, errWithCode = suite.adminProcessor.FailedActivityGet(ctx, failed.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *FailedActivityTestSuite) TestFailedActivityRetry() {
+	ctx := suite.T().Context()
+	failed := suite.putFailedActivity("http://fossbros-anonymous.io/users/foss_satan/flags/2")
+
+	if _, errWithCode := suite.adminProcessor.FailedActivityRetry(ctx, failed.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// The original failed activity
+	// should be removed on retry.
+	_, errWithCode := suite.adminProcessor.FailedActivityGet(ctx, failed.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// We still don't handle this type of activity,
+	// so the retry should fail again, storing a new
+	// failed activity for the same activity IRI.
+	if !testrig.WaitFor(func() bool {
+		resp, errWithCode := suite.adminProcessor.FailedActivitiesGet(ctx,
+			ap.ActivityUpdate,
+			gtsmodel.FailureReasonUnhandled,
+			nil,
+		)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+		return len(resp.Items) == 1
+	}) {
+		suite.FailNow("timed out waiting for retried activity to fail again")
+	}
+
+	// Retrying something that isn't
+	// there any more should 404.
+	_, errWithCode = suite.adminProcessor.FailedActivityRetry(ctx, failed.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *FailedActivityTestSuite) TestFailedActivityRetryTruncated() {
+	ctx := suite.T().Context()
+	failed := suite.putFailedActivity("http://fossbros-anonymous.io/users/foss_satan/flags/3")
+
+	// Mark the failed activity as truncated.
+	failed.Truncated = util.Ptr(true)
+	if err := suite.db.UpdateByID(ctx, failed, failed.ID, "truncated"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Truncated activities can't be retried.
+	_, errWithCode := suite.adminProcessor.FailedActivityRetry(ctx, failed.ID)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// But can still be viewed.
+	apiFailed, errWithCode := suite.adminProcessor.FailedActivityGet(ctx, failed.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(apiFailed.Truncated)
+}
+
+func TestFailedActivityTestSuite(t *testing.T) {
+	suite.Run(t, &FailedActivityTestSuite{})
+}
@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// FederationStatsGet returns up to limit per-domain
+// federation stats, sorted by the given column, which
+// must be one of domain, activities_received,
+// activities_sent, media_bytes, or last_seen_at.
+// Order must be either asc or desc.
+func (p *Processor) FederationStatsGet(
+	ctx context.Context,
+	sort string,
+	order string,
+	limit int,
+) ([]*apimodel.AdminFederationStat, gtserror.WithCode) {
+	switch sort {
+	case "domain",
+		"activities_received",
+		"activities_sent",
+		"media_bytes",
+		"last_seen_at":
+		// Fine.
+	default:
+		text := fmt.Sprintf("sort %s not recognized; valid values are domain, activities_received, activities_sent, media_bytes, last_seen_at", sort)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	var ascending bool
+	switch order {
+	case "asc":
+		ascending = true
+	case "desc":
+		ascending = false
+	default:
+		text := fmt.Sprintf("order %s not recognized; valid values are asc, desc", order)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	stats, err := p.state.DB.GetFederationStats(ctx, sort, ascending, limit)
+	if err != nil {
+		err := gtserror.Newf("db error getting federation stats: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiStats := make([]*apimodel.AdminFederationStat, 0, len(stats))
+	for _, stat := range stats {
+		apiStat := &apimodel.AdminFederationStat{
+			Domain:             stat.Domain,
+			ActivitiesReceived: stat.ActivitiesReceived,
+			ActivitiesSent:     stat.ActivitiesSent,
+			MediaBytes:         stat.MediaBytes,
+			UpdatedAt:          util.FormatISO8601(stat.UpdatedAt),
+		}
+
+		if !stat.LastSeenAt.IsZero() {
+			apiStat.LastSeenAt = util.FormatISO8601(stat.LastSeenAt)
+		}
+
+		apiStats = append(apiStats, apiStat)
+	}
+
+	return apiStats, nil
+}
@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FederationStatTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *FederationStatTestSuite) TestFederationStatsGet() {
+	ctx := suite.T().Context()
+
+	suite.state.FedStats.Received("fossbros-anonymous.io")
+	suite.state.FedStats.Sent("fossbros-anonymous.io")
+	suite.state.FedStats.Sent("example.org")
+	suite.state.FedStats.Sent("example.org")
+	if err := suite.state.FedStats.Flush(ctx, suite.state.DB); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	stats, errWithCode := suite.adminProcessor.FederationStatsGet(ctx, "activities_sent", "desc", 10)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(stats, 2)
+
+	suite.Equal("example.org", stats[0].Domain)
+	suite.EqualValues(2, stats[0].ActivitiesSent)
+	suite.Empty(stats[0].LastSeenAt)
+
+	suite.Equal("fossbros-anonymous.io", stats[1].Domain)
+	suite.EqualValues(1, stats[1].ActivitiesReceived)
+	suite.EqualValues(1, stats[1].ActivitiesSent)
+	suite.NotEmpty(stats[1].LastSeenAt)
+	suite.NotEmpty(stats[1].UpdatedAt)
+}
+
+func (suite *FederationStatTestSuite) TestFederationStatsGetInvalid() {
+	ctx := suite.T().Context()
+
+	_, errWithCode := suite.adminProcessor.FederationStatsGet(ctx, "bananas", "desc", 10)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	_, errWithCode = suite.adminProcessor.FederationStatsGet(ctx, "domain", "sideways", 10)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestFederationStatTestSuite(t *testing.T) {
+	suite.Run(t, new(FederationStatTestSuite))
+}
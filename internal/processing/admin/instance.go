@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+)
+
+// InstancesGet returns known remote instances,
+// optionally filtered by nodeinfo software name
+// and software version prefix.
+func (p *Processor) InstancesGet(
+	ctx context.Context,
+	software string,
+	version string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	instances, err := p.state.DB.GetInstances(
+		ctx,
+		software,
+		version,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(instances)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := instances[count-1].ID
+	hi := instances[0].ID
+
+	// Convert each instance to API model.
+	items := make([]interface{}, 0, count)
+	for _, instance := range instances {
+		item, err := p.converter.InstanceToAdminAPIInstance(ctx, instance)
+		if err != nil {
+			err := gtserror.Newf("error converting instance to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		items = append(items, item)
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 2)
+	if software != "" {
+		query.Set(apiutil.AdminSoftwareKey, software)
+	}
+	if version != "" {
+		query.Set(apiutil.AdminVersionKey, version)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/instances",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/scheduler"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// JobsGet returns the state of all
+// recurring background jobs.
+func (p *Processor) JobsGet(ctx context.Context) []*apimodel.AdminJob {
+	jobs := p.state.Workers.Scheduler.Jobs()
+	apiJobs := make([]*apimodel.AdminJob, 0, len(jobs))
+	for _, job := range jobs {
+		apiJobs = append(apiJobs, apiJob(job))
+	}
+	return apiJobs
+}
+
+// JobTrigger runs the recurring background job
+// with the given ID now, even if it's paused.
+func (p *Processor) JobTrigger(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.AdminJob, gtserror.WithCode) {
+	job, errWithCode := p.getJob(id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !p.state.Workers.Scheduler.Trigger(id) {
+		// Only reason for this
+		// is that it's running.
+		text := fmt.Sprintf("job %s is already running", id)
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	log.Infof(ctx, "job %s triggered by admin %s", id, adminAcct.Username)
+	job.Running = true
+	return apiJob(job), nil
+}
+
+// JobPause pauses scheduled runs of the
+// recurring background job with the given ID.
+func (p *Processor) JobPause(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.AdminJob, gtserror.WithCode) {
+	if _, errWithCode := p.getJob(id); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.state.Workers.Scheduler.Pause(id)
+	log.Infof(ctx, "job %s paused by admin %s", id, adminAcct.Username)
+	return p.jobGet(id)
+}
+
+// JobResume resumes scheduled runs of the
+// recurring background job with the given ID.
+func (p *Processor) JobResume(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.AdminJob, gtserror.WithCode) {
+	if _, errWithCode := p.getJob(id); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.state.Workers.Scheduler.Resume(id)
+	log.Infof(ctx, "job %s resumed by admin %s", id, adminAcct.Username)
+	return p.jobGet(id)
+}
+
+// WorkerPoolsGet returns the state
+// of all background worker pools.
+func (p *Processor) WorkerPoolsGet(ctx context.Context) []*apimodel.AdminWorkerPool {
+	workers := &p.state.Workers
+	return []*apimodel.AdminWorkerPool{
+		{Name: "client", Workers: workers.Client.Len(), Queued: workers.Client.Queue.Len()},
+		{Name: "delivery", Workers: workers.Delivery.Len(), Queued: workers.Delivery.Queue.Len()},
+		{Name: "dereference", Workers: workers.Dereference.Len(), Queued: workers.Dereference.Queue.Len()},
+		{Name: "federator", Workers: workers.Federator.Len(), Queued: workers.Federator.Queue.Len()},
+		{Name: "processing", Workers: workers.Processing.Len(), Queued: workers.Processing.Queue.Len()},
+		{Name: "webpush", Workers: workers.WebPush.Len(), Queued: workers.WebPush.Queue.Len()},
+	}
+}
+
+// getJob gets the state of job with id, or returns a 404.
+func (p *Processor) getJob(id string) (scheduler.Job, gtserror.WithCode) {
+	job, ok := p.state.Workers.Scheduler.Job(id)
+	if !ok {
+		text := fmt.Sprintf("job %s not found", id)
+		return scheduler.Job{}, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+	return job, nil
+}
+
+// jobGet is like getJob, but converts to the API model.
+func (p *Processor) jobGet(id string) (*apimodel.AdminJob, gtserror.WithCode) {
+	job, errWithCode := p.getJob(id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	return apiJob(job), nil
+}
+
+func apiJob(job scheduler.Job) *apimodel.AdminJob {
+	apiJob := &apimodel.AdminJob{
+		ID:              job.ID,
+		Every:           int64(job.Period.Seconds()),
+		NextRunAt:       util.FormatISO8601(job.NextRun),
+		LastRunDuration: job.LastDuration.Milliseconds(),
+		Runs:            job.Runs,
+		Running:         job.Running,
+		Paused:          job.Paused,
+	}
+
+	if !job.LastRun.IsZero() {
+		apiJob.LastRunAt = util.FormatISO8601(job.LastRun)
+	}
+
+	if !job.LastErrorAt.IsZero() {
+		apiJob.LastError = job.LastError
+		apiJob.LastErrorAt = util.FormatISO8601(job.LastErrorAt)
+	}
+
+	return apiJob
+}
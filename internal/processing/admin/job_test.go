@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type JobTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *JobTestSuite) TestJobs() {
+	var (
+		ctx   = suite.T().Context()
+		admin = suite.testAccounts["admin_account"]
+		ran   = make(chan struct{}, 1)
+	)
+
+	if !suite.state.Workers.Scheduler.AddRecurring(
+		"@testjob",
+		time.Now().Add(time.Hour),
+		time.Hour,
+		func(context.Context, time.Time) error {
+			ran <- struct{}{}
+			return nil
+		},
+	) {
+		suite.FailNow("failed to schedule job")
+	}
+	defer suite.state.Workers.Scheduler.Cancel("@testjob")
+
+	jobs := suite.adminProcessor.JobsGet(ctx)
+	suite.Len(jobs, 1)
+	suite.Equal("@testjob", jobs[0].ID)
+	suite.EqualValues(3600, jobs[0].Every)
+	suite.Empty(jobs[0].LastRunAt)
+	suite.Empty(jobs[0].LastError)
+
+	job, errWithCode := suite.adminProcessor.JobPause(ctx, admin, "@testjob")
+	suite.Nil(errWithCode)
+	suite.True(job.Paused)
+
+	job, errWithCode = suite.adminProcessor.JobTrigger(ctx, admin, "@testjob")
+	suite.Nil(errWithCode)
+	suite.True(job.Running)
+	<-ran
+
+	job, errWithCode = suite.adminProcessor.JobResume(ctx, admin, "@testjob")
+	suite.Nil(errWithCode)
+	suite.False(job.Paused)
+}
+
+func (suite *JobTestSuite) TestJobNotFound() {
+	var (
+		ctx   = suite.T().Context()
+		admin = suite.testAccounts["admin_account"]
+	)
+
+	_, errWithCode := suite.adminProcessor.JobTrigger(ctx, admin, "@nope")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	_, errWithCode = suite.adminProcessor.JobPause(ctx, admin, "@nope")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *JobTestSuite) TestWorkerPoolsGet() {
+	pools := suite.adminProcessor.WorkerPoolsGet(suite.T().Context())
+	names := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		names = append(names, pool.Name)
+	}
+	suite.Equal([]string{
+		"client",
+		"delivery",
+		"dereference",
+		"federator",
+		"processing",
+		"webpush",
+	}, names)
+}
+
+func TestJobTestSuite(t *testing.T) {
+	suite.Run(t, new(JobTestSuite))
+}
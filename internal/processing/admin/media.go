@@ -60,8 +60,8 @@ func (p *Processor) MediaPrune(
 	// performing media cleanup.
 	go func() {
 		ctx := gtscontext.WithValues(context.Background(), ctx)
-		p.cleaner.Media().AllAndFix(ctx, remoteCacheDays)
-		p.cleaner.Emoji().AllAndFix(ctx, remoteCacheDays)
+		_ = p.cleaner.Media().AllAndFix(ctx, remoteCacheDays)
+		_ = p.cleaner.Emoji().AllAndFix(ctx, remoteCacheDays)
 	}()
 
 	return nil
@@ -78,8 +78,8 @@ func (p *Processor) MediaPurge(
 	// performing media purge.
 	go func() {
 		ctx := gtscontext.WithValues(context.Background(), ctx)
-		p.cleaner.Media().LogPurgeRemote(ctx, domain)
-		p.cleaner.Emoji().LogPurgeRemote(ctx, domain)
+		_ = p.cleaner.Media().LogPurgeRemote(ctx, domain)
+		_ = p.cleaner.Emoji().LogPurgeRemote(ctx, domain)
 	}()
 
 	return nil
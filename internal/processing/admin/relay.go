@@ -0,0 +1,356 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/uris"
+)
+
+// RelaysGet returns all relays this instance is subscribed to.
+func (p *Processor) RelaysGet(ctx context.Context) ([]*apimodel.AdminRelay, gtserror.WithCode) {
+	relays, err := p.state.DB.GetRelays(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting relays: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("db error getting instance account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRelays := make([]*apimodel.AdminRelay, 0, len(relays))
+	for _, relay := range relays {
+		apiRelay, errWithCode := p.apiRelay(ctx, instanceAcct, relay)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiRelays = append(apiRelays, apiRelay)
+	}
+
+	return apiRelays, nil
+}
+
+// RelayGet returns one relay, with the given ID.
+func (p *Processor) RelayGet(ctx context.Context, id string) (*apimodel.AdminRelay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("db error getting instance account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiRelay(ctx, instanceAcct, relay)
+}
+
+// RelayCreate subscribes this instance to the relay with the given
+// actor URI, by dereferencing the relay actor and sending a Follow
+// to it from the instance account. Once the relay Accepts the Follow,
+// public statuses Announced by the relay will be pulled in.
+func (p *Processor) RelayCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	actorURIStr string,
+) (*apimodel.AdminRelay, gtserror.WithCode) {
+	actorURI, err := url.Parse(actorURIStr)
+	if err != nil || (actorURI.Scheme != "https" && actorURI.Scheme != "http") {
+		const text = "actor_uri must be a valid http(s) URI"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("db error getting instance account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Dereference the relay actor
+	// using the instance account.
+	relayAcct, _, err := p.federator.GetAccountByURI(
+		gtscontext.SetFastFail(ctx),
+		instanceAcct.Username,
+		actorURI,
+		false,
+	)
+	if err != nil {
+		err := fmt.Errorf("error dereferencing relay actor %s: %w", actorURIStr, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if relayAcct.IsLocal() {
+		const text = "cannot subscribe to a local account as a relay"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Check we're not already subscribed to this relay.
+	existing, err := p.state.DB.GetRelayByAccountID(
+		gtscontext.SetBarebones(ctx),
+		relayAcct.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error checking existing relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		err := fmt.Errorf("relay %s already exists with id %s", relayAcct.URI, existing.ID)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	relay := &gtsmodel.Relay{
+		ID:                 id.NewRandomULID(),
+		AccountID:          relayAcct.ID,
+		Account:            relayAcct,
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+	}
+
+	if err := p.state.DB.PutRelay(ctx, relay); err != nil {
+		err := gtserror.Newf("db error putting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Subscribe to the relay with a follow
+	// request from the instance account, if
+	// one doesn't exist already.
+	if errWithCode := p.relayFollow(ctx, instanceAcct, relayAcct); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiRelay(ctx, instanceAcct, relay)
+}
+
+// RelayDelete unsubscribes this instance from the relay with
+// the given ID, sending an Undo Follow to the relay actor.
+func (p *Processor) RelayDelete(ctx context.Context, id string) (*apimodel.AdminRelay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("db error getting instance account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Convert to API model before we
+	// remove the follow (request), so
+	// the returned state is accurate.
+	apiRelay, errWithCode := p.apiRelay(ctx, instanceAcct, relay)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteRelayByID(ctx, relay.ID); err != nil {
+		err := gtserror.Newf("db error deleting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if errWithCode := p.relayUnfollow(ctx, instanceAcct, relay.Account); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return apiRelay, nil
+}
+
+func (p *Processor) getRelay(ctx context.Context, id string) (*gtsmodel.Relay, gtserror.WithCode) {
+	relay, err := p.state.DB.GetRelayByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting relay %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if relay == nil {
+		err := gtserror.Newf("relay %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return relay, nil
+}
+
+// apiRelay derives the subscription state of the given
+// relay from the relationship between the instance account
+// and the relay account, and converts it to the API model.
+func (p *Processor) apiRelay(
+	ctx context.Context,
+	instanceAcct *gtsmodel.Account,
+	relay *gtsmodel.Relay,
+) (*apimodel.AdminRelay, gtserror.WithCode) {
+	state := gtsmodel.RelayStateRejected
+
+	following, err := p.state.DB.IsFollowing(ctx, instanceAcct.ID, relay.AccountID)
+	if err != nil {
+		err := gtserror.Newf("db error checking following: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if following {
+		state = gtsmodel.RelayStateAccepted
+	} else {
+		requested, err := p.state.DB.IsFollowRequested(ctx, instanceAcct.ID, relay.AccountID)
+		if err != nil {
+			err := gtserror.Newf("db error checking follow requested: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if requested {
+			state = gtsmodel.RelayStatePending
+		}
+	}
+
+	apiRelay, err := p.converter.RelayToAdminAPIRelay(ctx, relay, state)
+	if err != nil {
+		err := gtserror.Newf("error converting relay to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRelay, nil
+}
+
+// relayFollow creates a follow request from the instance
+// account to the relay account, and queues it for sending.
+func (p *Processor) relayFollow(
+	ctx context.Context,
+	instanceAcct *gtsmodel.Account,
+	relayAcct *gtsmodel.Account,
+) gtserror.WithCode {
+	following, err := p.state.DB.IsFollowing(ctx, instanceAcct.ID, relayAcct.ID)
+	if err != nil {
+		err := gtserror.Newf("db error checking following: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	requested, err := p.state.DB.IsFollowRequested(ctx, instanceAcct.ID, relayAcct.ID)
+	if err != nil {
+		err := gtserror.Newf("db error checking follow requested: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if following || requested {
+		// Already subscribed
+		// (or subscribing).
+		return nil
+	}
+
+	followID := id.NewRandomULID()
+	followReq := &gtsmodel.FollowRequest{
+		ID:              followID,
+		URI:             uris.GenerateURIForFollow(instanceAcct.Username, followID),
+		AccountID:       instanceAcct.ID,
+		Account:         instanceAcct,
+		TargetAccountID: relayAcct.ID,
+		TargetAccount:   relayAcct,
+	}
+
+	if err := p.state.DB.PutFollowRequest(ctx, followReq); err != nil {
+		err := gtserror.Newf("db error putting follow request: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Process side effects (ie., sending the Follow) async.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ActivityFollow,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       followReq,
+		Origin:         instanceAcct,
+		Target:         relayAcct,
+	})
+
+	return nil
+}
+
+// relayUnfollow removes any follow and / or follow request from
+// the instance account to the relay account, and queues Undos.
+func (p *Processor) relayUnfollow(
+	ctx context.Context,
+	instanceAcct *gtsmodel.Account,
+	relayAcct *gtsmodel.Account,
+) gtserror.WithCode {
+	follow, err := p.state.DB.GetFollow(ctx, instanceAcct.ID, relayAcct.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting follow: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if follow != nil {
+		if err := p.state.DB.DeleteFollowByID(ctx, follow.ID); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error deleting follow: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityUndo,
+			GTSModel:       follow,
+			Origin:         instanceAcct,
+			Target:         relayAcct,
+		})
+	}
+
+	followReq, err := p.state.DB.GetFollowRequest(ctx, instanceAcct.ID, relayAcct.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting follow request: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if followReq != nil {
+		if err := p.state.DB.DeleteFollowRequestByID(ctx, followReq.ID); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error deleting follow request: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityUndo,
+			// Dummy out a follow to undo,
+			// based on the follow request.
+			GTSModel: &gtsmodel.Follow{
+				AccountID:       instanceAcct.ID,
+				Account:         instanceAcct,
+				TargetAccountID: relayAcct.ID,
+				TargetAccount:   relayAcct,
+				URI:             followReq.URI,
+			},
+			Origin: instanceAcct,
+			Target: relayAcct,
+		})
+	}
+
+	return nil
+}
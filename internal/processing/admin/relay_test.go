@@ -0,0 +1,85 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelayTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *RelayTestSuite) TestRelayCreateDelete() {
+	var (
+		ctx       = suite.T().Context()
+		adminAcct = suite.testAccounts["admin_account"]
+		relayAcct = suite.testAccounts["remote_account_1"]
+	)
+
+	instanceAcct, err := suite.db.GetInstanceAccount(ctx, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiRelay, errWithCode := suite.adminProcessor.RelayCreate(ctx, adminAcct, relayAcct.URI)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal(relayAcct.URI, apiRelay.ActorURI)
+	suite.Equal(relayAcct.InboxURI, apiRelay.InboxURI)
+	suite.Equal(string(gtsmodel.RelayStatePending), apiRelay.State)
+	suite.Equal(adminAcct.ID, apiRelay.CreatedBy.ID)
+
+	// Instance account should
+	// now follow request the relay.
+	requested, err := suite.db.IsFollowRequested(ctx, instanceAcct.ID, relayAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(requested)
+
+	// Adding the same relay
+	// again should conflict.
+	_, errWithCode = suite.adminProcessor.RelayCreate(ctx, adminAcct, relayAcct.URI)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Remove the relay again.
+	if _, errWithCode := suite.adminProcessor.RelayDelete(ctx, apiRelay.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Follow request should be gone.
+	requested, err = suite.db.IsFollowRequested(ctx, instanceAcct.ID, relayAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(requested)
+
+	_, errWithCode = suite.adminProcessor.RelayGet(ctx, apiRelay.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, &RelayTestSuite{})
+}
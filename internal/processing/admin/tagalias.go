@@ -0,0 +1,200 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// TagAliasesGet returns all hashtags that
+// are set as an alias of another hashtag.
+func (p *Processor) TagAliasesGet(ctx context.Context) ([]*apimodel.AdminTagAlias, gtserror.WithCode) {
+	tags, err := p.state.DB.GetTagAliases(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tag aliases: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAliases := make([]*apimodel.AdminTagAlias, 0, len(tags))
+	for _, tag := range tags {
+		apiAlias, errWithCode := p.apiTagAlias(ctx, tag)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiAliases = append(apiAliases, apiAlias)
+	}
+
+	return apiAliases, nil
+}
+
+// TagAliasCreate sets the hashtag with the given name as an alias
+// of the hashtag with name aliasOf, creating either if necessary.
+// Statuses using the aliased hashtag will then be shown in the
+// timeline of aliasOf, and follows of the aliased hashtag will be
+// moved over to aliasOf. If aliasOf is itself an alias, the hashtag
+// is set as an alias of the hashtag that aliasOf is an alias of.
+func (p *Processor) TagAliasCreate(
+	ctx context.Context,
+	name string,
+	aliasOf string,
+) (*apimodel.AdminTagAlias, gtserror.WithCode) {
+	tag, errWithCode := p.getOrCreateTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	target, errWithCode := p.getOrCreateTag(ctx, aliasOf)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if target.IsAlias() {
+		// Don't create chains of aliases,
+		// alias directly to the end target.
+		var err error
+		target, err = p.state.DB.GetTag(ctx, target.AliasOfID)
+		if err != nil {
+			err := gtserror.Newf("db error getting tag %s: %w", target.AliasOfID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if target.ID == tag.ID {
+		err := fmt.Errorf("hashtag %s cannot be an alias of itself", tag.Name)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := p.state.DB.SetTagAliasOf(ctx, tag, target.ID); err != nil {
+		err := gtserror.Newf("db error setting tag alias: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.AdminTagAlias{
+		Name:      tag.Name,
+		AliasOf:   target.Name,
+		UpdatedAt: util.FormatISO8601(tag.UpdatedAt),
+	}, nil
+}
+
+// TagAliasDelete unsets the hashtag with the given name as an alias.
+// Statuses using the hashtag are shown in its own timeline again.
+// Follows that were moved when the alias was set are not moved back.
+func (p *Processor) TagAliasDelete(
+	ctx context.Context,
+	name string,
+) (*apimodel.AdminTagAlias, gtserror.WithCode) {
+	normal, ok := text.NormalizeHashtag(name)
+	if !ok {
+		const text = "invalid hashtag name"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	tag, err := p.state.DB.GetTagByName(ctx, normal)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tag %s: %w", normal, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag == nil || !tag.IsAlias() {
+		err := fmt.Errorf("hashtag alias %s not found", normal)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	// Convert to API model before
+	// we unset the alias target.
+	apiAlias, errWithCode := p.apiTagAlias(ctx, tag)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.SetTagAliasOf(ctx, tag, ""); err != nil {
+		err := gtserror.Newf("db error unsetting tag alias: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAlias, nil
+}
+
+// getOrCreateTag gets the tag with the given
+// name from the database, or creates it.
+func (p *Processor) getOrCreateTag(ctx context.Context, name string) (*gtsmodel.Tag, gtserror.WithCode) {
+	normal, ok := text.NormalizeHashtag(name)
+	if !ok {
+		err := fmt.Errorf("invalid hashtag name %s", name)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.state.DB.GetTagByName(ctx, normal)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tag %s: %w", normal, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag != nil {
+		return tag, nil
+	}
+
+	tag = &gtsmodel.Tag{
+		ID:       id.NewULID(),
+		Name:     normal,
+		Useable:  util.Ptr(true),
+		Listable: util.Ptr(true),
+	}
+
+	if err := p.state.DB.PutTag(ctx, tag); err != nil {
+		err := gtserror.Newf("db error putting tag %s: %w", normal, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Refetch the tag, as
+	// its name is normalized
+	// on insert into the db.
+	tag, err = p.state.DB.GetTag(ctx, tag.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting tag %s: %w", normal, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return tag, nil
+}
+
+// apiTagAlias converts the given
+// alias tag to its API model.
+func (p *Processor) apiTagAlias(ctx context.Context, tag *gtsmodel.Tag) (*apimodel.AdminTagAlias, gtserror.WithCode) {
+	target, err := p.state.DB.GetTag(ctx, tag.AliasOfID)
+	if err != nil {
+		err := gtserror.Newf("db error getting tag %s: %w", tag.AliasOfID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.AdminTagAlias{
+		Name:      tag.Name,
+		AliasOf:   target.Name,
+		UpdatedAt: util.FormatISO8601(tag.UpdatedAt),
+	}, nil
+}
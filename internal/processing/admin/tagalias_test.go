@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TagAliasTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *TagAliasTestSuite) TestTagAliasCreateDelete() {
+	ctx := suite.T().Context()
+
+	welcomeTag, err := suite.db.GetTagByName(ctx, "welcome")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Alias a new tag to an existing
+	// one, using different casing.
+	apiAlias, errWithCode := suite.adminProcessor.TagAliasCreate(ctx, "#Bienvenue", "WELCOME")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("bienvenue", apiAlias.Name)
+	suite.Equal("welcome", apiAlias.AliasOf)
+
+	tag, err := suite.db.GetTagByName(ctx, "bienvenue")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(welcomeTag.ID, tag.AliasOfID)
+
+	// Aliasing to an alias should
+	// alias to its target instead.
+	apiAlias, errWithCode = suite.adminProcessor.TagAliasCreate(ctx, "willkommen", "bienvenue")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("welcome", apiAlias.AliasOf)
+
+	apiAliases, errWithCode := suite.adminProcessor.TagAliasesGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(apiAliases, 2)
+
+	// A tag can't be an alias of itself,
+	// even via the tag it's an alias of.
+	_, errWithCode = suite.adminProcessor.TagAliasCreate(ctx, "welcome", "bienvenue")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	// Remove one of the aliases again.
+	if _, errWithCode := suite.adminProcessor.TagAliasDelete(ctx, "Bienvenue"); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	tag, err = suite.db.GetTagByName(ctx, "bienvenue")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(tag.IsAlias())
+
+	// It's not an alias anymore.
+	_, errWithCode = suite.adminProcessor.TagAliasDelete(ctx, "bienvenue")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestTagAliasTestSuite(t *testing.T) {
+	suite.Run(t, &TagAliasTestSuite{})
+}
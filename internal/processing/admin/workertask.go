@@ -232,11 +232,25 @@ func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
 
 // pushDelivery parses a valid delivery.Delivery{} from serialized task data and pushes to queue.
 func (p *Processor) pushDelivery(ctx context.Context, task *gtsmodel.WorkerTask) error {
+	dlv, err := p.deserializeDelivery(ctx, task.TaskData)
+	if err != nil {
+		return err
+	}
+
+	// Push deserialized task to delivery queue.
+	p.state.Workers.Delivery.Queue.Push(dlv)
+
+	return nil
+}
+
+// deserializeDelivery parses a valid delivery.Delivery{} from serialized
+// task data, and signs it using the transport of the delivery's actor.
+func (p *Processor) deserializeDelivery(ctx context.Context, data []byte) (*delivery.Delivery, error) {
 	dlv := new(delivery.Delivery)
 
 	// Deserialize the raw worker task data into delivery.
-	if err := dlv.Deserialize(task.TaskData); err != nil {
-		return gtserror.Newf("error deserializing delivery: %w", err)
+	if err := dlv.Deserialize(data); err != nil {
+		return nil, gtserror.Newf("error deserializing delivery: %w", err)
 	}
 
 	var tsport transport.Transport
@@ -245,13 +259,13 @@ func (p *Processor) pushDelivery(ctx context.Context, task *gtsmodel.WorkerTask)
 		// Fetch the actor account by provided URI from db.
 		account, err := p.state.DB.GetAccountByURI(ctx, uri)
 		if err != nil {
-			return gtserror.Newf("error getting actor account %s from db: %w", uri, err)
+			return nil, gtserror.Newf("error getting actor account %s from db: %w", uri, err)
 		}
 
 		// Fetch a transport for request signing for actor's account username.
 		tsport, err = p.transport.NewTransportForUsername(ctx, account.Username)
 		if err != nil {
-			return gtserror.Newf("error getting transport for actor %s: %w", uri, err)
+			return nil, gtserror.Newf("error getting transport for actor %s: %w", uri, err)
 		}
 	} else {
 		var err error
@@ -259,17 +273,76 @@ func (p *Processor) pushDelivery(ctx context.Context, task *gtsmodel.WorkerTask)
 		// No actor was given, will be signed by instance account.
 		tsport, err = p.transport.NewTransportForUsername(ctx, "")
 		if err != nil {
-			return gtserror.Newf("error getting instance account transport: %w", err)
+			return nil, gtserror.Newf("error getting instance account transport: %w", err)
 		}
 	}
 
 	// Using transport, add actor signature to delivery.
 	if err := tsport.SignDelivery(dlv); err != nil {
-		return gtserror.Newf("error signing delivery: %w", err)
+		return nil, gtserror.Newf("error signing delivery: %w", err)
 	}
 
-	// Push deserialized task to delivery queue.
-	p.state.Workers.Delivery.Queue.Push(dlv)
+	return dlv, nil
+}
+
+// ResumePausedDeliveries pushes all deliveries held back by paused
+// federation to the delivery queue, for those domains that federation
+// is no longer paused with, removing them from the database.
+func (p *Processor) ResumePausedDeliveries(ctx context.Context) error {
+	// Get all paused deliveries from db.
+	//
+	// (database returns these as ASCENDING, i.e.
+	// returned in the order they were inserted).
+	paused, err := p.state.DB.GetPausedDeliveries(ctx)
+	if err != nil {
+		return gtserror.Newf("error fetching paused deliveries from db: %w", err)
+	}
+
+	var (
+		// Count of deliveries
+		// successfully resumed.
+		resumed int
+
+		// Failed resumes.
+		errors int
+	)
+
+	for _, pd := range paused {
+		// Check whether federation with
+		// the delivery domain is still paused.
+		limit, err := p.state.DB.MatchDomainLimit(ctx, pd.Domain)
+		if err != nil {
+			return gtserror.Newf("error matching domain limit for %s: %w", pd.Domain, err)
+		}
+
+		if limit.FederationPaused() {
+			// Keep it
+			// held back.
+			continue
+		}
+
+		dlv, err := p.deserializeDelivery(ctx, pd.TaskData)
+		if err != nil {
+			log.Errorf(ctx, "error resuming paused delivery %d: %v", pd.ID, err)
+			errors++
+		} else {
+			// Push deserialized delivery to queue.
+			p.state.Workers.Delivery.Queue.Push(dlv)
+			resumed++
+		}
+
+		// Either resumed or can never be resumed,
+		// in both cases remove it from the database.
+		if err := p.state.DB.DeletePausedDeliveryByID(ctx, pd.ID); err != nil {
+			log.Errorf(ctx, "error deleting paused delivery from db: %v", err)
+		}
+	}
+
+	// Log resumed deliveries.
+	log.WithContext(ctx).
+		WithField("resumed", resumed).
+		WithField("errors", errors).
+		Info("resumed paused deliveries")
 
 	return nil
 }
@@ -297,13 +370,27 @@ func (p *Processor) popDelivery() (*gtsmodel.WorkerTask, error) {
 	}, nil
 }
 
-// pushClient parses a valid messages.FromFediAPI{} from serialized task data and pushes to queue.
+// pushFederator parses a valid messages.FromFediAPI{} from serialized task data and pushes to queue.
 func (p *Processor) pushFederator(ctx context.Context, task *gtsmodel.WorkerTask) error {
+	msg, err := p.deserializeFederator(ctx, task.TaskData)
+	if err != nil {
+		return err
+	}
+
+	// Push populated task to the federator queue.
+	p.state.Workers.Federator.Queue.Push(msg)
+
+	return nil
+}
+
+// deserializeFederator parses a valid messages.FromFediAPI{} from serialized
+// data, populating its placeholder account models from the database.
+func (p *Processor) deserializeFederator(ctx context.Context, data []byte) (*messages.FromFediAPI, error) {
 	var msg messages.FromFediAPI
 
 	// Deserialize the raw worker task data into message.
-	if err := msg.Deserialize(task.TaskData); err != nil {
-		return gtserror.Newf("error deserializing federator message: %w", err)
+	if err := msg.Deserialize(data); err != nil {
+		return nil, gtserror.Newf("error deserializing federator message: %w", err)
 	}
 
 	if rcv := msg.Receiving; rcv != nil {
@@ -311,7 +398,7 @@ func (p *Processor) pushFederator(ctx context.Context, task *gtsmodel.WorkerTask
 		// fetch the actual model from database by persisted ID.
 		account, err := p.state.DB.GetAccountByID(ctx, rcv.ID)
 		if err != nil {
-			return gtserror.Newf("error fetching receiving account %s from db: %w", rcv.ID, err)
+			return nil, gtserror.Newf("error fetching receiving account %s from db: %w", rcv.ID, err)
 		}
 
 		// Set the now populated
@@ -324,7 +411,7 @@ func (p *Processor) pushFederator(ctx context.Context, task *gtsmodel.WorkerTask
 		// fetch the actual model from database by persisted ID.
 		account, err := p.state.DB.GetAccountByID(ctx, req.ID)
 		if err != nil {
-			return gtserror.Newf("error fetching requesting account %s from db: %w", req.ID, err)
+			return nil, gtserror.Newf("error fetching requesting account %s from db: %w", req.ID, err)
 		}
 
 		// Set the now populated
@@ -332,10 +419,7 @@ func (p *Processor) pushFederator(ctx context.Context, task *gtsmodel.WorkerTask
 		msg.Requesting = account
 	}
 
-	// Push populated task to the federator queue.
-	p.state.Workers.Federator.Queue.Push(&msg)
-
-	return nil
+	return &msg, nil
 }
 
 // popFederator pops messages.FromFediAPI{} from queue and serializes as valid task data.
@@ -335,6 +335,88 @@ func (suite *WorkerTaskTestSuite) TestPersistWorkerQueues() {
 	suite.Equal(len(testClientMsgs), nclient)
 }
 
+func (suite *WorkerTaskTestSuite) TestResumePausedDeliveries() {
+	ctx, cncl := context.WithCancel(suite.T().Context())
+	defer cncl()
+
+	// Pause federation with askjeeves.com.
+	limit := &gtsmodel.DomainLimit{
+		ID:                 "01K8TE4ES467FGYGRKVPDM6RF7",
+		Domain:             "askjeeves.com",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		MediaPolicy:        gtsmodel.MediaPolicyNoAction,
+		FollowsPolicy:      gtsmodel.FollowsPolicyNoAction,
+		StatusesPolicy:     gtsmodel.StatusesPolicyNoAction,
+		AccountsPolicy:     gtsmodel.AccountsPolicyNoAction,
+		FederationPolicy:   gtsmodel.FederationPolicyPause,
+	}
+	if err := suite.state.DB.PutDomainLimit(ctx, limit); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tsport, err := suite.transportController.NewTransportForUsername(ctx, "the_mighty_zork")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Deliver to the paused domain.
+	obj := map[string]any{
+		"type":   "Like",
+		"actor":  suite.testAccounts["local_account_1"].URI,
+		"object": "https://askjeeves.com/users/smallboy/statuses/1",
+	}
+	to := testrig.URLMustParse("https://askjeeves.com/users/smallboy/inbox")
+	if err := tsport.Deliver(ctx, obj, to); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The delivery should be held
+	// back instead of being queued.
+	paused, err := suite.state.DB.GetPausedDeliveries(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(paused, 1)
+	suite.Equal("askjeeves.com", paused[0].Domain)
+
+	_, ok := suite.state.Workers.Delivery.Queue.Pop()
+	suite.False(ok)
+
+	// Resuming while still paused
+	// should leave the delivery alone.
+	if err := suite.adminProcessor.ResumePausedDeliveries(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	paused, err = suite.state.DB.GetPausedDeliveries(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(paused, 1)
+
+	// Unpause federation and resume.
+	limit.FederationPolicy = gtsmodel.FederationPolicyNoAction
+	if err := suite.state.DB.UpdateDomainLimit(ctx, limit, "federation_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.adminProcessor.ResumePausedDeliveries(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Delivery should now be queued.
+	paused, err = suite.state.DB.GetPausedDeliveries(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(paused)
+
+	dlv, ok := suite.state.Workers.Delivery.Queue.Pop()
+	suite.True(ok)
+	suite.Equal(to.String(), dlv.Request.URL.String())
+	suite.Equal(obj["object"], dlv.ObjectID)
+}
+
 func (suite *WorkerTaskTestSuite) SetupTest() {
 	suite.AdminStandardTestSuite.SetupTest()
 	// we don't want workers running
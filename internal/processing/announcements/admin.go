@@ -0,0 +1,295 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+)
+
+// AdminGetAll returns all announcements,
+// published or not, for viewing by an admin.
+func (p *Processor) AdminGetAll(ctx context.Context) ([]*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetAnnouncements(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := make([]*apimodel.AdminAnnouncement, 0, len(announcements))
+	for _, announcement := range announcements {
+		apiAnnouncement, errWithCode := p.adminAPIAnnouncement(ctx, announcement)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiAnnouncements = append(apiAnnouncements, apiAnnouncement)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// AdminGet returns the announcement with
+// given ID, for viewing by an admin.
+func (p *Processor) AdminGet(ctx context.Context, id string) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id, false)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.adminAPIAnnouncement(ctx, announcement)
+}
+
+// Create creates a new announcement from the given form,
+// streaming it to all users if it's published straight away.
+func (p *Processor) Create(
+	ctx context.Context,
+	form *apimodel.AnnouncementCreateRequest,
+) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	now := time.Now()
+	announcement := &gtsmodel.Announcement{
+		ID:        id.NewULID(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		AllDay:    util.Ptr(form.AllDay),
+		Published: util.Ptr(form.Published),
+	}
+
+	if errWithCode := p.setText(ctx, announcement, form.Text); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := setTimes(announcement, &form.StartsAt, &form.EndsAt); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if announcement.IsPublished() {
+		announcement.PublishedAt = now
+	}
+
+	if err := p.state.DB.PutAnnouncement(ctx, announcement); err != nil {
+		err := gtserror.Newf("db error putting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.streamAnnouncement(ctx, announcement); err != nil {
+		log.Errorf(ctx, "error streaming announcement: %v", err)
+	}
+
+	return p.adminAPIAnnouncement(ctx, announcement)
+}
+
+// Update updates the announcement with given ID using the set
+// fields of the given form, streaming the updated announcement
+// to all users if it's published, or its deletion if it's been
+// unpublished.
+func (p *Processor) Update(
+	ctx context.Context,
+	id string,
+	form *apimodel.AnnouncementUpdateRequest,
+) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id, false)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	wasActive := announcement.IsActive(time.Now())
+
+	if form.Text != nil {
+		if errWithCode := p.setText(ctx, announcement, *form.Text); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	if errWithCode := setTimes(announcement, form.StartsAt, form.EndsAt); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.AllDay != nil {
+		announcement.AllDay = form.AllDay
+	}
+
+	if form.Published != nil {
+		if *form.Published && !announcement.IsPublished() {
+			// Newly (re)published.
+			announcement.PublishedAt = time.Now()
+		}
+		announcement.Published = form.Published
+	}
+
+	if err := p.state.DB.UpdateAnnouncement(ctx, announcement); err != nil {
+		err := gtserror.Newf("db error updating announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if wasActive && !announcement.IsActive(time.Now()) {
+		// No longer shown,
+		// remove from clients.
+		p.stream.AnnouncementDelete(ctx, announcement.ID)
+	} else if err := p.streamAnnouncement(ctx, announcement); err != nil {
+		log.Errorf(ctx, "error streaming announcement: %v", err)
+	}
+
+	return p.adminAPIAnnouncement(ctx, announcement)
+}
+
+// Delete deletes the announcement with given ID,
+// streaming its deletion to all users if it was
+// being shown to them.
+func (p *Processor) Delete(ctx context.Context, id string) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id, false)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before deleting,
+	// while reactions still exist.
+	apiAnnouncement, errWithCode := p.adminAPIAnnouncement(ctx, announcement)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteAnnouncementByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if announcement.IsActive(time.Now()) {
+		p.stream.AnnouncementDelete(ctx, announcement.ID)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// adminAPIAnnouncement converts the given
+// announcement to an admin API announcement,
+// fetching its reactions from the database.
+func (p *Processor) adminAPIAnnouncement(
+	ctx context.Context,
+	announcement *gtsmodel.Announcement,
+) (*apimodel.AdminAnnouncement, gtserror.WithCode) {
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement reactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.AnnouncementToAdminAPIAnnouncement(ctx,
+		announcement,
+		reactions,
+	), nil
+}
+
+// setText validates the given markdown text and sets
+// it on the announcement, along with the parsed HTML
+// content and any tags and emojis used in it.
+func (p *Processor) setText(
+	ctx context.Context,
+	announcement *gtsmodel.Announcement,
+	text string,
+) gtserror.WithCode {
+	if err := validate.AnnouncementText(text); err != nil {
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	instanceAcc, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("db error getting instance account: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Parse text as Markdown, keep
+	// the raw version for later editing.
+	result := p.formatter.FromMarkdown(ctx,
+		p.parseMention,
+		instanceAcc.ID,
+		"",
+		text,
+	)
+
+	announcement.Text = text
+	announcement.Content = result.HTML
+
+	announcement.Tags = result.Tags
+	announcement.TagIDs = make([]string, len(result.Tags))
+	for i, tag := range result.Tags {
+		announcement.TagIDs[i] = tag.ID
+	}
+
+	announcement.Emojis = result.Emojis
+	announcement.EmojiIDs = make([]string, len(result.Emojis))
+	for i, emoji := range result.Emojis {
+		announcement.EmojiIDs[i] = emoji.ID
+	}
+
+	return nil
+}
+
+// setTimes parses and sets the given start and
+// end times on the announcement. Nil times are
+// left as they are, empty times are unset.
+func setTimes(
+	announcement *gtsmodel.Announcement,
+	startsAt *string,
+	endsAt *string,
+) gtserror.WithCode {
+	for _, t := range []struct {
+		name  string
+		value *string
+		dst   *time.Time
+	}{
+		{"starts_at", startsAt, &announcement.StartsAt},
+		{"ends_at", endsAt, &announcement.EndsAt},
+	} {
+		switch {
+		case t.value == nil:
+			// Not set.
+
+		case *t.value == "":
+			// Unset.
+			*t.dst = time.Time{}
+
+		default:
+			parsed, err := time.Parse(time.RFC3339, *t.value)
+			if err != nil {
+				err := fmt.Errorf("invalid %s %s, must be an ISO 8601 datetime", t.name, *t.value)
+				return gtserror.NewErrorBadRequest(err, err.Error())
+			}
+			*t.dst = parsed
+		}
+	}
+
+	if !announcement.StartsAt.IsZero() &&
+		!announcement.EndsAt.IsZero() &&
+		!announcement.EndsAt.After(announcement.StartsAt) {
+		const text = "ends_at must be after starts_at"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/stream"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+)
+
+type Processor struct {
+	state        *state.State
+	converter    *typeutils.Converter
+	stream       *stream.Processor
+	formatter    *text.Formatter
+	parseMention gtsmodel.ParseMentionFunc
+}
+
+func New(
+	state *state.State,
+	converter *typeutils.Converter,
+	stream *stream.Processor,
+	parseMention gtsmodel.ParseMentionFunc,
+) Processor {
+	return Processor{
+		state:        state,
+		converter:    converter,
+		stream:       stream,
+		formatter:    text.NewFormatter(state.DB),
+		parseMention: parseMention,
+	}
+}
+
+// getAnnouncement gets the announcement with given ID,
+// returning a 404 if it doesn't exist or, if activeOnly
+// is set, if it isn't currently shown to users.
+func (p *Processor) getAnnouncement(
+	ctx context.Context,
+	id string,
+	activeOnly bool,
+) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if announcement == nil ||
+		(activeOnly && !announcement.IsActive(time.Now())) {
+		err := gtserror.Newf("announcement %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return announcement, nil
+}
+
+// streamAnnouncement streams the given announcement to
+// all users, if it's active, with its current reactions.
+func (p *Processor) streamAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	if !announcement.IsActive(time.Now()) {
+		return nil
+	}
+
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting announcement reactions: %w", err)
+	}
+
+	// Stream without any requester-specific
+	// state, as this is sent to everyone.
+	p.stream.Announcement(ctx,
+		p.converter.AnnouncementToAPIAnnouncement(ctx,
+			announcement,
+			reactions,
+			"",
+			false,
+		),
+	)
+
+	return nil
+}
+
+// streamReactionCount streams the current count of reactions
+// with given name to the given announcement to all users.
+func (p *Processor) streamReactionCount(ctx context.Context, announcementID string, name string) error {
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcementID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting announcement reactions: %w", err)
+	}
+
+	var count int
+	for _, reaction := range reactions {
+		if reaction.Name == name {
+			count++
+		}
+	}
+
+	p.stream.AnnouncementReaction(ctx, &apimodel.AnnouncementReactionEvent{
+		Name:           name,
+		Count:          count,
+		AnnouncementID: announcementID,
+	})
+
+	return nil
+}
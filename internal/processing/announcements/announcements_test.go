@@ -0,0 +1,257 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/processing"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/stream"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type AnnouncementsTestSuite struct {
+	suite.Suite
+	state     state.State
+	processor *processing.Processor
+
+	testAccounts map[string]*gtsmodel.Account
+}
+
+func (suite *AnnouncementsTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+}
+
+func (suite *AnnouncementsTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+	testrig.NewTestDB(&suite.state)
+	suite.state.Storage = testrig.NewInMemoryStorage()
+
+	mediaManager := testrig.NewTestMediaManager(&suite.state)
+	federator := testrig.NewTestFederator(&suite.state, testrig.NewTestTransportController(&suite.state, testrig.NewMockHTTPClient(nil, "../../../testrig/media")), mediaManager)
+	suite.processor = testrig.NewTestProcessor(
+		&suite.state,
+		federator,
+		testrig.NewEmailSender("../../../web/template/", nil),
+		testrig.NewNoopWebPushSender(),
+		mediaManager,
+	)
+
+	testrig.StandardDBSetup(suite.state.DB, nil)
+}
+
+func (suite *AnnouncementsTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.state.DB)
+	testrig.StopWorkers(&suite.state)
+}
+
+func (suite *AnnouncementsTestSuite) openStream(ctx context.Context, account *gtsmodel.Account) *stream.Stream {
+	s, errWithCode := suite.processor.Stream().Open(ctx, account, stream.TimelineHome)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	return s
+}
+
+func (suite *AnnouncementsTestSuite) recv(ctx context.Context, s *stream.Stream) stream.Message {
+	ctx, cncl := context.WithTimeout(ctx, 5*time.Second)
+	defer cncl()
+
+	msg, ok := s.Recv(ctx)
+	if !ok {
+		suite.FailNow("timed out waiting for stream message")
+	}
+	return msg
+}
+
+func (suite *AnnouncementsTestSuite) TestCreateGetDismiss() {
+	ctx := suite.T().Context()
+	account := suite.testAccounts["local_account_1"]
+	s := suite.openStream(ctx, account)
+	defer s.Close()
+
+	created, errWithCode := suite.processor.Announcements().Create(ctx,
+		&apimodel.AnnouncementCreateRequest{
+			Text:      "hey **everyone**, server maintenance tonight #gts",
+			Published: true,
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("hey **everyone**, server maintenance tonight #gts", created.Text)
+	suite.Contains(created.Content, "<strong>everyone</strong>")
+	suite.Len(created.Tags, 1)
+	suite.True(created.Published)
+	suite.Nil(created.StartsAt)
+
+	// Published announcement
+	// should have been streamed.
+	msg := suite.recv(ctx, s)
+	suite.Equal(stream.EventTypeAnnouncement, msg.Event)
+
+	announcements, errWithCode := suite.processor.Announcements().Get(ctx, account, true)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(announcements, 1)
+	suite.False(announcements[0].Read)
+
+	if errWithCode := suite.processor.Announcements().Dismiss(ctx, account, created.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Dismissed announcement
+	// should be marked as read...
+	announcements, errWithCode = suite.processor.Announcements().Get(ctx, account, true)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(announcements, 1)
+	suite.True(announcements[0].Read)
+
+	// ... or left out entirely.
+	announcements, errWithCode = suite.processor.Announcements().Get(ctx, account, false)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(announcements)
+
+	// Unpublishing should stream a delete.
+	if _, errWithCode := suite.processor.Announcements().Update(ctx, created.ID,
+		&apimodel.AnnouncementUpdateRequest{Published: util.Ptr(false)},
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	msg = suite.recv(ctx, s)
+	suite.Equal(stream.EventTypeAnnouncementDelete, msg.Event)
+	suite.Equal(created.ID, msg.Payload)
+
+	// Unpublished announcements
+	// can't be dismissed.
+	errWithCode = suite.processor.Announcements().Dismiss(ctx, account, created.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *AnnouncementsTestSuite) TestCreateInvalid() {
+	ctx := suite.T().Context()
+
+	for _, form := range []*apimodel.AnnouncementCreateRequest{
+		{Text: ""},
+		{Text: "hello", StartsAt: "not a time"},
+		{Text: "hello", StartsAt: "2026-10-17T10:00:00Z", EndsAt: "2026-10-17T09:00:00Z"},
+	} {
+		_, errWithCode := suite.processor.Announcements().Create(ctx, form)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	}
+}
+
+func (suite *AnnouncementsTestSuite) TestReactions() {
+	ctx := suite.T().Context()
+	account1 := suite.testAccounts["local_account_1"]
+	account2 := suite.testAccounts["local_account_2"]
+
+	created, errWithCode := suite.processor.Announcements().Create(ctx,
+		&apimodel.AnnouncementCreateRequest{
+			Text:      "new emojis just dropped",
+			Published: true,
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	s := suite.openStream(ctx, account2)
+	defer s.Close()
+
+	for _, r := range []struct {
+		account *gtsmodel.Account
+		name    string
+	}{
+		{account1, "rainbow"},
+		{account2, "rainbow"},
+		{account2, "rainbow"}, // no-op
+		{account1, "🎉"},
+	} {
+		if errWithCode := suite.processor.Announcements().ReactionAdd(ctx, r.account, created.ID, r.name); errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+	}
+
+	// The second reaction should have
+	// streamed an updated count of two.
+	suite.recv(ctx, s)
+	msg := suite.recv(ctx, s)
+	suite.Equal(stream.EventTypeAnnouncementReaction, msg.Event)
+	var event apimodel.AnnouncementReactionEvent
+	if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(apimodel.AnnouncementReactionEvent{
+		Name:           "rainbow",
+		Count:          2,
+		AnnouncementID: created.ID,
+	}, event)
+
+	announcements, errWithCode := suite.processor.Announcements().Get(ctx, account2, true)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	reactions := announcements[0].Reactions
+	suite.Len(reactions, 2)
+	suite.Equal("rainbow", reactions[0].Name)
+	suite.Equal(2, reactions[0].Count)
+	suite.True(reactions[0].Me)
+	suite.NotEmpty(reactions[0].URL)
+	suite.Equal("🎉", reactions[1].Name)
+	suite.Equal(1, reactions[1].Count)
+	suite.False(reactions[1].Me)
+
+	// Unknown emoji and
+	// plain text are rejected.
+	for _, name := range []string{"not_an_emoji", "hello there"} {
+		errWithCode = suite.processor.Announcements().ReactionAdd(ctx, account1, created.ID, name)
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	}
+
+	if errWithCode := suite.processor.Announcements().ReactionRemove(ctx, account1, created.ID, "🎉"); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	announcements, errWithCode = suite.processor.Announcements().Get(ctx, account2, true)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(announcements[0].Reactions, 1)
+}
+
+func TestAnnouncementsTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementsTestSuite))
+}
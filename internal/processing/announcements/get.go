@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// Get returns all active announcements, from the perspective
+// of the requester. Unless withDismissed is set, announcements
+// already dismissed (ie., read) by the requester are left out.
+func (p *Processor) Get(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	withDismissed bool,
+) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetActiveAnnouncements(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	readIDs, err := p.state.DB.GetReadAnnouncementIDs(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting read announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := make([]*apimodel.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		read := slices.Contains(readIDs, announcement.ID)
+		if read && !withDismissed {
+			continue
+		}
+
+		reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting announcement reactions: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiAnnouncements = append(apiAnnouncements,
+			p.converter.AnnouncementToAPIAnnouncement(ctx,
+				announcement,
+				reactions,
+				requester.ID,
+				read,
+			),
+		)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// Dismiss marks the active announcement with
+// given ID as read (dismissed) by the requester.
+func (p *Processor) Dismiss(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+) gtserror.WithCode {
+	if _, errWithCode := p.getAnnouncement(ctx, announcementID, true); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.PutAnnouncementRead(ctx, &gtsmodel.AnnouncementRead{
+		ID:             id.NewULID(),
+		AnnouncementID: announcementID,
+		AccountID:      requester.ID,
+	}); err != nil {
+		err := gtserror.Newf("db error marking announcement read: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
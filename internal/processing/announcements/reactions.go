@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/regexes"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// maxReactionNames is the maximum number of distinct
+// reactions (by name) allowed on one announcement,
+// same as Mastodon.
+const maxReactionNames = 8
+
+// maxUnicodeReactionRunes is the maximum number of runes
+// allowed in a unicode emoji reaction, generous enough
+// for sequences like flags, skin tones and ZWJ emojis.
+const maxUnicodeReactionRunes = 16
+
+// ReactionAdd adds a reaction with the given name,
+// either a unicode emoji or the shortcode of a local
+// custom emoji, by the requester to the active
+// announcement with given ID. Adding an existing
+// reaction again is a no-op.
+func (p *Processor) ReactionAdd(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	name string,
+) gtserror.WithCode {
+	if _, errWithCode := p.getAnnouncement(ctx, announcementID, true); errWithCode != nil {
+		return errWithCode
+	}
+
+	reaction := &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AnnouncementID: announcementID,
+		AccountID:      requester.ID,
+		Name:           name,
+	}
+
+	if regexes.EmojiValidator.MatchString(name) {
+		// Name is a shortcode,
+		// look for local emoji.
+		emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, name, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting emoji %s: %w", name, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if emoji == nil || util.PtrOrZero(emoji.Disabled) {
+			err := fmt.Errorf("custom emoji %s not found", name)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		reaction.EmojiID = emoji.ID
+		reaction.Emoji = emoji
+	} else if !isUnicodeEmoji(name) {
+		err := fmt.Errorf("reaction %s is not a unicode emoji or custom emoji shortcode", name)
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// Check existing reactions to
+	// ensure we don't go over limit.
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcementID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement reactions: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	names := make(map[string]struct{}, maxReactionNames)
+	for _, r := range reactions {
+		names[r.Name] = struct{}{}
+	}
+
+	if _, ok := names[name]; !ok && len(names) >= maxReactionNames {
+		err := fmt.Errorf("announcement already has the maximum of %d different reactions", maxReactionNames)
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if err := p.state.DB.PutAnnouncementReaction(ctx, reaction); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Already reacted,
+			// nothing to do.
+			return nil
+		}
+
+		err := gtserror.Newf("db error putting announcement reaction: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.streamReactionCount(ctx, announcementID, name); err != nil {
+		log.Errorf(ctx, "error streaming announcement reaction: %v", err)
+	}
+
+	return nil
+}
+
+// ReactionRemove removes the reaction with the
+// given name by the requester from the active
+// announcement with given ID, if it exists.
+func (p *Processor) ReactionRemove(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	name string,
+) gtserror.WithCode {
+	if _, errWithCode := p.getAnnouncement(ctx, announcementID, true); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteAnnouncementReaction(ctx,
+		announcementID,
+		requester.ID,
+		name,
+	); err != nil {
+		err := gtserror.Newf("db error deleting announcement reaction: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.streamReactionCount(ctx, announcementID, name); err != nil {
+		log.Errorf(ctx, "error streaming announcement reaction: %v", err)
+	}
+
+	return nil
+}
+
+// isUnicodeEmoji loosely checks whether the given string
+// looks like a single unicode emoji (sequence), ie., it's
+// short, contains at least one symbol, and contains no
+// letters, spaces or control characters.
+func isUnicodeEmoji(s string) bool {
+	if s == "" || utf8.RuneCountInString(s) > maxUnicodeReactionRunes {
+		return false
+	}
+
+	var symbol bool
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r),
+			unicode.IsSpace(r),
+			unicode.IsControl(r):
+			return false
+		case unicode.Is(unicode.So, r),
+			unicode.Is(unicode.Me, r): // eg., keycaps
+			symbol = true
+		}
+	}
+
+	return symbol
+}
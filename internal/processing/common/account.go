@@ -49,10 +49,7 @@ func (p *Processor) GetTargetAccountBy(
 	if target == nil {
 		// DB loader could not find account in database.
 		const text = "target account not found"
-		return nil, false, gtserror.NewErrorNotFound(
-			errors.New(text),
-			text,
-		)
+		return nil, false, newAccountNotFoundErr(errors.New(text))
 	}
 
 	// Check whether target account is visible to requesting account.
@@ -121,10 +118,7 @@ func (p *Processor) GetVisibleTargetAccount(
 	if !visible {
 		// Pretend account doesn't exist if not visible.
 		const text = "target account not found"
-		return nil, gtserror.NewErrorNotFound(
-			errors.New(text),
-			text,
-		)
+		return nil, newAccountNotFoundErr(errors.New(text))
 	}
 
 	return target, nil
@@ -268,3 +262,13 @@ func (p *Processor) getVisibleAPIAccounts(
 
 	return accounts
 }
+
+// newAccountNotFoundErr returns a 404 gtserror.WithCode
+// wrapping err, with "target account not found" help
+// text and the corresponding machine-readable code.
+func newAccountNotFoundErr(err error) gtserror.WithCode {
+	return gtserror.WithErrCode(
+		gtserror.NewErrorNotFound(err, "target account not found"),
+		gtserror.ErrCodeAccountNotFound,
+	)
+}
@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package common
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+)
+
+// Idempotent calls create on behalf of the given account, unless the
+// account recently made a request of the given scope (eg., "status")
+// with the same idempotency key as set in ctx, in which case the result
+// of that earlier request is returned instead. This prevents retried
+// requests from flaky clients creating duplicates of the same thing.
+//
+// Requests using the same key are serialized, so that of concurrent
+// retries only the first creates anything. Errors are not stored, so
+// the request can be retried with the same key after a failure.
+func Idempotent[T any](
+	ctx context.Context,
+	state *state.State,
+	account *gtsmodel.Account,
+	scope string,
+	create func() (T, gtserror.WithCode),
+) (T, gtserror.WithCode) {
+	key := gtscontext.IdempotencyKey(ctx)
+	if key == "" {
+		// Not an
+		// idempotent request.
+		return create()
+	}
+
+	// Scope key to requesting
+	// account and type of request.
+	key = scope + ":" + account.ID + ":" + key
+
+	// Acquire lock for key.
+	unlock := state.ProcessingLocks.Lock("idempotency:" + key)
+	defer unlock()
+
+	// Check for stored result of a previous request.
+	if result, ok := state.Caches.Idempotency.Get(key); ok {
+		if t, ok := result.(T); ok {
+			return t, nil
+		}
+	}
+
+	result, errWithCode := create()
+	if errWithCode != nil {
+		return result, errWithCode
+	}
+
+	// Store result for any retries.
+	state.Caches.Idempotency.Set(key, result)
+
+	return result, nil
+}
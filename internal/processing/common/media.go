@@ -22,17 +22,25 @@
 	"errors"
 	"fmt"
 
+	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/storage"
 )
 
 // StoreLocalMedia is a wrapper around CreateMedia() and
 // ProcessingMedia{}.Load() with appropriate error responses.
+//
+// As the stored size of media (including its generated
+// thumbnail) isn't known until after processing, the
+// account's media quota is checked again once stored,
+// removing the attachment again if it would exceed it.
 func (p *Processor) StoreLocalMedia(
 	ctx context.Context,
-	accountID string,
+	account *gtsmodel.Account,
 	data media.DataFunc,
 	info media.AdditionalMediaInfo,
 ) (
@@ -41,7 +49,7 @@ func (p *Processor) StoreLocalMedia(
 ) {
 	// Create a new processing media attachment.
 	processing, err := p.media.CreateMedia(ctx,
-		accountID,
+		account.ID,
 		data,
 		info,
 	)
@@ -63,14 +71,69 @@ func (p *Processor) StoreLocalMedia(
 		err := gtserror.Newf("error processing media: %w", err)
 		return nil, gtserror.NewErrorUnprocessableEntity(err, text)
 
+	case attachment.Error.Type() == gtsmodel.MediaErrorTypePolicy:
+		text := fmt.Sprintf("media rejected: %s", attachment.Error)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+
 	case attachment.Type == gtsmodel.FileTypeUnknown:
 		text := fmt.Sprintf("could not process %s type media", attachment.File.ContentType)
 		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
 	}
 
+	// Account's storage usage has changed.
+	p.state.Caches.AccountUsage.Invalidate(account.ID)
+
+	// Check quota against actual stored size.
+	if errWithCode := p.checkStoredMediaQuota(ctx,
+		account,
+		attachment,
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	return attachment, nil
 }
 
+// checkStoredMediaQuota checks whether the given local account is
+// over its media quota now that the given attachment has been stored,
+// deleting the attachment and its files and returning an appropriate
+// error response if so.
+func (p *Processor) checkStoredMediaQuota(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	attachment *gtsmodel.MediaAttachment,
+) gtserror.WithCode {
+	// Usage now includes the stored
+	// attachment, so check nothing more.
+	errWithCode := p.CheckMediaQuota(ctx, account, 0)
+	if errWithCode == nil {
+		return nil
+	}
+
+	// Over quota, roll back the attachment. We use a
+	// separate context here in case of ctx cancellation.
+	ctx = context.WithoutCancel(ctx)
+	for _, path := range []string{
+		attachment.Thumbnail.Path,
+		attachment.File.Path,
+	} {
+		if path == "" {
+			continue
+		}
+		if err := p.state.Storage.Delete(ctx, path); err != nil && !storage.IsNotFound(err) {
+			log.Errorf(ctx, "error deleting %s: %v", path, err)
+		}
+	}
+	if err := p.state.DB.DeleteAttachment(ctx, attachment.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "error deleting attachment %s: %v", attachment.ID, err)
+	}
+
+	// Account's storage usage has changed again.
+	p.state.Caches.AccountUsage.Invalidate(account.ID)
+
+	return errWithCode
+}
+
 // StoreLocalMedia is a wrapper around CreateMedia() and
 // ProcessingMedia{}.Load() with appropriate error responses.
 func (p *Processor) StoreLocalEmoji(
@@ -48,15 +48,11 @@ func (p *Processor) GetOwnStatus(
 	switch {
 	case target == nil:
 		const text = "target status not found"
-		return nil, gtserror.NewErrorNotFound(
-			errors.New(text),
-			text,
-		)
+		return nil, newStatusNotFoundErr(errors.New(text))
 
 	case target.AccountID != requester.ID:
-		return nil, gtserror.NewErrorNotFound(
+		return nil, newStatusNotFoundErr(
 			errors.New("status does not belong to requester"),
-			"target status not found",
 		)
 	}
 
@@ -90,10 +86,7 @@ func (p *Processor) GetTargetStatusBy(
 	if target == nil {
 		// DB loader could not find status in database.
 		const text = "target status not found"
-		return nil, false, gtserror.NewErrorNotFound(
-			errors.New(text),
-			text,
-		)
+		return nil, false, newStatusNotFoundErr(errors.New(text))
 	}
 
 	// Check whether target status is visible to requesting account.
@@ -129,6 +122,31 @@ func (p *Processor) GetTargetStatusBy(
 	return target, visible, nil
 }
 
+// BackfillStatusReplies enqueues asynchronous backfill of the
+// replies of given (remote) status, if enabled, on behalf of the
+// given authorized requester. See Dereferencer{}.BackfillStatusReplies().
+func (p *Processor) BackfillStatusReplies(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+) {
+	if requester == nil {
+		// Only backfill for *authorized*
+		// requesters, to prevent a possible
+		// DOS vector for unauthorized clients.
+		return
+	}
+
+	p.federator.BackfillStatusReplies(ctx,
+		requester.Username,
+		status,
+		// Pass callback to insert
+		// other statuses in thread
+		// into timelines (as appropriate).
+		p.surfacer.TimelineAndNotifyStatus,
+	)
+}
+
 // GetVisibleTargetStatus calls GetTargetStatusBy(),
 // but converts a non-visible result to not-found error.
 //
@@ -156,10 +174,7 @@ func (p *Processor) GetVisibleTargetStatusBy(
 	if !visible {
 		// Target should not be seen by requester.
 		const text = "target status not found"
-		return nil, gtserror.NewErrorNotFound(
-			errors.New(text),
-			text,
-		)
+		return nil, newStatusNotFoundErr(errors.New(text))
 	}
 
 	return target, nil
@@ -307,3 +322,13 @@ func (p *Processor) GetVisibleAPIStatuses(
 
 	return apiStatuses
 }
+
+// newStatusNotFoundErr returns a 404 gtserror.WithCode
+// wrapping err, with "target status not found" help
+// text and the corresponding machine-readable code.
+func newStatusNotFoundErr(err error) gtserror.WithCode {
+	return gtserror.WithErrCode(
+		gtserror.NewErrorNotFound(err, "target status not found"),
+		gtserror.ErrCodeStatusNotFound,
+	)
+}
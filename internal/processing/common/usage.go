@@ -0,0 +1,175 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"codeberg.org/gruf/go-bytesize"
+)
+
+// GetAccountUsage returns a report of the storage footprint of
+// the given account, ie., its locally stored media by category,
+// and its status and follow counts. Reports are calculated with
+// aggregate queries, so are cached for a short while afterwards.
+func (p *Processor) GetAccountUsage(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (*apimodel.AccountUsage, gtserror.WithCode) {
+	if usage, ok := p.state.Caches.AccountUsage.Get(account.ID); ok {
+		return usage, nil
+	}
+
+	// Ensure account stats are populated.
+	if err := p.state.DB.PopulateAccountStats(ctx, account); err != nil {
+		err := gtserror.Newf("db error getting stats for account %s: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Calculate usage of locally stored media.
+	media, err := p.state.DB.GetAccountMediaUsage(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting media usage for account %s: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var quota *int64
+	if account.IsLocal() {
+		// Include media quota of local accounts, if any.
+		q, errWithCode := p.mediaQuota(ctx, account)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		if q > 0 {
+			quota = &q
+		}
+	}
+
+	usage := &apimodel.AccountUsage{
+		AccountID: account.ID,
+		Media: apimodel.AccountMediaUsage{
+			Attachments: apiMediaUsage(media.Attachments),
+			Unattached:  apiMediaUsage(media.Unattached),
+			Avatar:      apiMediaUsage(media.Avatar),
+			Header:      apiMediaUsage(media.Header),
+			Total:       apiMediaUsage(media.Total()),
+		},
+		MediaQuota:     quota,
+		StatusesCount:  *account.Stats.StatusesCount,
+		FollowersCount: *account.Stats.FollowersCount,
+		FollowingCount: *account.Stats.FollowingCount,
+		CalculatedAt:   util.FormatISO8601(time.Now()),
+	}
+
+	p.state.Caches.AccountUsage.Set(account.ID, usage)
+	return usage, nil
+}
+
+// CheckMediaQuota checks whether storing media of given size
+// would take the given local account over its media quota,
+// returning an appropriate error response if so. As the stored
+// size of media isn't known until processed, this only gives an
+// early check on upload size; StoreLocalMedia checks it again
+// against the stored size (including thumbnail).
+func (p *Processor) CheckMediaQuota(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	size int64,
+) gtserror.WithCode {
+	quota, errWithCode := p.mediaQuota(ctx, account)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if quota <= 0 {
+		// No quota.
+		return nil
+	}
+
+	// Get current usage fresh from the db,
+	// rather than the (possibly stale) cache.
+	usage, err := p.state.DB.GetAccountMediaUsage(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting media usage for account %s: %w", account.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if used := usage.Total().Bytes; used+size > quota {
+		var text string
+		if size > 0 {
+			text = fmt.Sprintf(
+				"media storage quota reached: %s of %s used, cannot store a further %s",
+				bytesize.Size(used), bytesize.Size(quota), bytesize.Size(size), // #nosec G115 -- Non-negative.
+			)
+		} else {
+			text = fmt.Sprintf(
+				"media storage quota reached: storing this media would use %s of %s",
+				bytesize.Size(used), bytesize.Size(quota), // #nosec G115 -- Non-negative.
+			)
+		}
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return nil
+}
+
+// mediaQuota returns the max total bytes of media that may
+// be stored for the given local account, 0 meaning no quota.
+func (p *Processor) mediaQuota(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) (int64, gtserror.WithCode) {
+	if account.IsInstance() {
+		// The instance account has no
+		// settings and is never limited.
+		return 0, nil
+	}
+
+	if account.Settings == nil {
+		var err error
+
+		// Ensure account settings are populated.
+		account.Settings, err = p.state.DB.GetAccountSettings(ctx, account.ID)
+		if err != nil {
+			err := gtserror.Newf("db error getting settings for account %s: %w", account.ID, err)
+			return 0, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if quota := account.Settings.MediaQuota; quota != nil {
+		// Admin override.
+		return *quota, nil
+	}
+
+	return int64(config.GetMediaLocalQuota()), nil // #nosec G115 -- Already validated.
+}
+
+func apiMediaUsage(usage gtsmodel.MediaUsage) apimodel.MediaUsage {
+	return apimodel.MediaUsage{
+		Count: usage.Count,
+		Bytes: usage.Bytes,
+	}
+}
@@ -22,17 +22,28 @@
 	"errors"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
+	"time"
 
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/uris"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
+// pausedRetryAfter is the Retry-After duration sent
+// in response to inbox POSTs from domains that
+// federation is currently paused with.
+const pausedRetryAfter = time.Hour
+
 // InboxPost handles POST requests to a user's inbox for new activitypub messages.
 //
 // InboxPost returns true if the request was handled as an ActivityPub POST to an actor's inbox.
@@ -44,6 +55,25 @@
 //
 // If the Federated Protocol is not enabled, writes the http.StatusMethodNotAllowed status code in the response. No side effects occur.
 func (p *Processor) InboxPost(ctx context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	// Before doing any authentication (which may
+	// involve calling out to the remote), check
+	// whether federation with the domain the request
+	// was signed by is paused, asking them to retry.
+	if pubKeyID := gtscontext.HTTPSignaturePubKeyID(ctx); pubKeyID != nil {
+		limit, err := p.state.DB.MatchDomainLimit(ctx, pubKeyID.Hostname())
+		if err != nil {
+			err := gtserror.Newf("db error matching domain limit: %w", err)
+			return false, gtserror.NewErrorInternalError(err)
+		}
+
+		if limit.FederationPaused() {
+			w.Header().Set("Retry-After", strconv.Itoa(int(pausedRetryAfter.Seconds())))
+			err := gtserror.Newf("federation with %s is paused", pubKeyID.Hostname())
+			errWithCode := gtserror.NewWithCodeSafe(http.StatusServiceUnavailable, err, "federation paused")
+			return false, gtserror.WithErrCode(errWithCode, gtserror.ErrCodeFederationPaused)
+		}
+	}
+
 	return p.federator.FederatingActor().PostInbox(ctx, w, r)
 }
 
@@ -414,9 +444,139 @@ func (p *Processor) FollowingGet(ctx context.Context, requestedUser string, page
 	return data, nil
 }
 
+// maxInlineFeatured is the maximum number of pinned
+// statuses we return inline in an unpaged featured
+// collection before falling back to a paged response.
+const maxInlineFeatured = 20
+
+// BlocklistGet returns the serialized ActivityPub
+// collection of a local account's published blocklist,
+// which contains links to accounts blocked by this account.
+//
+// Only accounts that have opted in to publishing their
+// blocks have this collection, for others it's not found.
+func (p *Processor) BlocklistGet(
+	ctx context.Context,
+	requestedUser string,
+	page *paging.Page,
+) (any, gtserror.WithCode) {
+	// Authenticate incoming request, getting related accounts.
+	auth, errWithCode := p.authenticate(ctx, requestedUser)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	receiver := auth.receiver
+
+	if receiver.IsInstance() ||
+		!util.PtrOrValue(receiver.Settings.PublishBlocks, false) {
+		const text = "account does not publish its blocks"
+		return nil, gtserror.NewErrorNotFound(errors.New(text))
+	}
+
+	// Parse the collection ID object from account's blocklist URI.
+	blocklistURI := uris.GenerateURIsForAccount(receiver.Username).BlocklistURI
+	collectionID, err := url.Parse(blocklistURI)
+	if err != nil {
+		err := gtserror.Newf("error parsing account blocklist uri %s: %w", blocklistURI, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Count accounts blocked by receiver.
+	total, err := p.state.DB.CountAccountBlocking(ctx, receiver.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error counting blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var obj vocab.Type
+
+	// Start the AS collection params.
+	var params ap.CollectionParams
+	params.ID = collectionID
+	params.Total = &total
+
+	switch {
+
+	case page == nil || auth.handshakingURI != nil:
+		// If paging disabled, or we're currently handshaking
+		// the requester, just return collection that links
+		// to first page (i.e. path below), with no items.
+		params.First = new(paging.Page)
+		params.Query = make(url.Values, 1)
+		params.Query.Set("limit", "40") // enables paging
+		obj = ap.NewASOrderedCollection(params)
+
+	default:
+		// Paging enabled.
+		// Get page of full block objects with attached accounts.
+		blocks, err := p.state.DB.GetAccountBlocking(ctx, receiver.ID, page)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error getting blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// page ID values.
+		var lo, hi string
+
+		if len(blocks) > 0 {
+			// Get the lowest and highest
+			// ID values, used for paging.
+			lo = blocks[len(blocks)-1].ID
+			hi = blocks[0].ID
+		}
+
+		// Start building AS collection page params.
+		var pageParams ap.CollectionPageParams
+		pageParams.CollectionParams = params
+
+		// Current page details.
+		pageParams.Current = page
+		pageParams.Count = len(blocks)
+
+		// Set linked next/prev parameters.
+		pageParams.Next = page.Next(lo, hi)
+		pageParams.Prev = page.Prev(lo, hi)
+
+		// Set the collection item property builder function.
+		pageParams.Append = func(i int, itemsProp ap.ItemsPropertyBuilder) {
+			// Get blocked account URI at index.
+			block := blocks[i]
+			if block.TargetAccount == nil {
+				return
+			}
+			accURI := block.TargetAccount.URI
+
+			// Parse URL object from URI.
+			iri, err := url.Parse(accURI)
+			if err != nil {
+				log.Errorf(ctx, "error parsing account uri %s: %v", accURI, err)
+				return
+			}
+
+			// Add to item property.
+			itemsProp.AppendIRI(iri)
+		}
+
+		// Build AS collection page object from params.
+		obj = ap.NewASOrderedCollectionPage(pageParams)
+	}
+
+	// Serialize the prepared object.
+	data, err := ap.Serialize(obj)
+	if err != nil {
+		err := gtserror.Newf("error serializing: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return data, nil
+}
+
 // FeaturedCollectionGet returns an ordered collection of the requested username's Pinned posts.
-// The returned collection have an `items` property which contains an ordered list of status URIs.
-func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUser string) (any, gtserror.WithCode) {
+//
+// If page is nil and the account has few enough pins, the returned collection will have an
+// `items` property which contains an ordered list of status URIs. Otherwise the collection
+// links to its first page, and with paging a single page of status URIs will be returned.
+func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUser string, page *paging.Page) (any, gtserror.WithCode) {
 	// Authenticate incoming request, getting related accounts.
 	auth, errWithCode := p.authenticate(ctx, requestedUser)
 	if errWithCode != nil {
@@ -430,13 +590,95 @@ func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUser str
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	collection, err := p.converter.StatusesToASFeaturedCollection(ctx, receiver.FeaturedCollectionURI, statuses)
-	if err != nil {
-		err := gtserror.Newf("error converting pinned statuses: %w", err)
-		return nil, gtserror.NewErrorInternalError(err)
+	var obj vocab.Type
+
+	switch {
+	case page == nil && len(statuses) <= maxInlineFeatured:
+		// Paging disabled and few enough
+		// pins, return all items inline.
+		obj, err = p.converter.StatusesToASFeaturedCollection(ctx, receiver.FeaturedCollectionURI, statuses)
+		if err != nil {
+			err := gtserror.Newf("error converting pinned statuses: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+	case page == nil:
+		// Paging disabled but too many pins
+		// to inline, return collection that
+		// links to first page, with no items.
+		params, errWithCode := featuredParams(receiver, len(statuses))
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		params.First = new(paging.Page)
+		params.Query = make(url.Values, 1)
+		params.Query.Set("limit", strconv.Itoa(maxInlineFeatured)) // enables paging
+		obj = ap.NewASOrderedCollection(params)
+
+	default:
+		// Paging enabled.
+		params, errWithCode := featuredParams(receiver, len(statuses))
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		// Pinned statuses are sorted by pin time
+		// (descending) rather than by ID, so page
+		// them positionally using their IDs. For
+		// ascending pages the input is expected
+		// in ascending order, so reverse first.
+		if page.Order().Ascending() {
+			statuses = slices.Clone(statuses)
+			slices.Reverse(statuses)
+		}
+		statuses = paging.Page_PageFunc(page, statuses,
+			func(s *gtsmodel.Status) string { return s.ID },
+		)
+
+		// page ID values.
+		var lo, hi string
+
+		if len(statuses) > 0 {
+			// Get the lowest and highest
+			// positioned ID values, used
+			// for paging.
+			lo = statuses[len(statuses)-1].ID
+			hi = statuses[0].ID
+		}
+
+		// Start AS collection page params.
+		var pageParams ap.CollectionPageParams
+		pageParams.CollectionParams = params
+
+		// Current page details.
+		pageParams.Current = page
+		pageParams.Count = len(statuses)
+
+		// Set linked next/prev parameters.
+		pageParams.Next = page.Next(lo, hi)
+		pageParams.Prev = page.Prev(lo, hi)
+
+		// Set the collection item property builder function.
+		pageParams.Append = func(i int, itemsProp ap.ItemsPropertyBuilder) {
+			// Get status URI at index.
+			statusURI := statuses[i].URI
+
+			// Parse URL object from URI.
+			iri, err := url.Parse(statusURI)
+			if err != nil {
+				log.Errorf(ctx, "error parsing status uri %s: %v", statusURI, err)
+				return
+			}
+
+			// Add to item property.
+			itemsProp.AppendIRI(iri)
+		}
+
+		// Build AS collection page object from params.
+		obj = ap.NewASOrderedCollectionPage(pageParams)
 	}
 
-	data, err := ap.Serialize(collection)
+	data, err := ap.Serialize(obj)
 	if err != nil {
 		err := gtserror.Newf("error serializing: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
@@ -444,3 +686,20 @@ func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUser str
 
 	return data, nil
 }
+
+// featuredParams prepares base AS collection
+// params for given account's featured collection.
+func featuredParams(account *gtsmodel.Account, total int) (ap.CollectionParams, gtserror.WithCode) {
+	var params ap.CollectionParams
+
+	// Parse collection ID from account's featured URI.
+	collectionID, err := url.Parse(account.FeaturedCollectionURI)
+	if err != nil {
+		err := gtserror.Newf("error parsing account featured uri %s: %w", account.FeaturedCollectionURI, err)
+		return params, gtserror.NewErrorInternalError(err)
+	}
+
+	params.ID = collectionID
+	params.Total = util.Ptr(total)
+	return params, nil
+}
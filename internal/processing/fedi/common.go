@@ -82,7 +82,10 @@ func (p *Processor) authenticate(ctx context.Context, requestedUser string) (*co
 	default:
 		// Admin from our instance likely suspended account.
 		err := gtserror.Newf("requester %s is suspended", requester.UsernameDomain())
-		return nil, gtserror.NewErrorForbidden(err)
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorForbidden(err),
+			gtserror.ErrCodeAccountSuspended,
+		)
 	}
 
 	// Ensure receiver does not block requester.
@@ -94,7 +97,10 @@ func (p *Processor) authenticate(ctx context.Context, requestedUser string) (*co
 
 	if blocked {
 		var text = requestedUser + " blocks " + requester.Username
-		return nil, gtserror.NewErrorForbidden(errors.New(text))
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorForbidden(errors.New(text)),
+			gtserror.ErrCodeActorBlocked,
+		)
 	}
 
 	return &commonAuth{
@@ -19,12 +19,16 @@
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
 )
 
 // UserGet handles getting an AP representation of an account.
@@ -66,6 +70,9 @@ func (p *Processor) UserGet(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Publish integrity proof key, if enabled.
+	addIntegrityKey(data, auth.receiver)
+
 	return data, nil
 }
 
@@ -103,5 +110,21 @@ func (p *Processor) UserGetMinimal(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Publish integrity proof key, if enabled.
+	addIntegrityKey(data, acct)
+
 	return data, nil
 }
+
+// addIntegrityKey adds the ed25519 key used to sign
+// FEP-8b32 integrity proofs on the account's outgoing
+// activities to its serialized AP representation, if
+// integrity proof signing is enabled on this instance.
+func addIntegrityKey(data map[string]any, acct *gtsmodel.Account) {
+	if !config.GetInstanceIntegrityProofsSign() || acct.PrivateKey == nil {
+		return
+	}
+
+	key := integrity.DeriveKey(acct.PrivateKey)
+	integrity.AddAssertionMethod(data, acct.URI, key.Public().(ed25519.PublicKey))
+}
@@ -19,10 +19,13 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 )
@@ -77,6 +80,7 @@ func (p *Processor) NodeInfoGet(ctx context.Context, schemaVersion string) (*api
 		userCount int
 		postCount int
 		mau       int
+		hyau      int
 		err       error
 	)
 
@@ -88,6 +92,7 @@ func (p *Processor) NodeInfoGet(ctx context.Context, schemaVersion string) (*api
 		userCount = int(stats.TotalUsers)
 		postCount = int(stats.Statuses)
 		mau = int(stats.MonthlyActiveUsers)
+		hyau = int(stats.HalfYearActiveUsers)
 
 	case config.InstanceStatsModeZero:
 		// Use zeroed stats
@@ -107,6 +112,15 @@ func (p *Processor) NodeInfoGet(ctx context.Context, schemaVersion string) (*api
 		if err != nil {
 			return nil, gtserror.NewErrorInternalError(err)
 		}
+
+		// Activity stats are aggregated
+		// + cached by the converter.
+		stats, err := p.converter.ActivityStats(ctx)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		mau = stats.MonthlyActiveUsers
+		hyau = stats.HalfYearActiveUsers
 	}
 
 	// Fill `metadata` field with instance info
@@ -131,8 +145,9 @@ func (p *Processor) NodeInfoGet(ctx context.Context, schemaVersion string) (*api
 		OpenRegistrations: config.GetAccountsRegistrationOpen(),
 		Usage: apimodel.NodeInfoUsage{
 			Users: apimodel.NodeInfoUsers{
-				Total:       userCount,
-				ActiveMonth: mau,
+				Total:          userCount,
+				ActiveHalfYear: hyau,
+				ActiveMonth:    mau,
 			},
 			LocalPosts: postCount,
 		},
@@ -190,6 +205,22 @@ func getNodeInfoMetadata(instance *gtsmodel.Instance) map[string]any {
 	nodeInfoMetadata["maxNoteTextLength"] = config.GetStatusesMaxChars()
 	nodeInfoMetadata["tosUrl"] = config.GetProtocol() + "://" + config.GetHost() + "/about#terms"
 
+	// Federation mode, ie., "blocklist" or "allowlist".
+	nodeInfoMetadata["federation"] = map[string]any{
+		"mode": config.GetInstanceFederationMode(),
+	}
+
+	// Configured limits on statuses, media, and accounts.
+	nodeInfoMetadata["limits"] = map[string]any{
+		"statusMaxChars":           config.GetStatusesMaxChars(),
+		"statusMaxMediaFiles":      config.GetStatusesMediaMaxFiles(),
+		"pollMaxOptions":           config.GetStatusesPollMaxOptions(),
+		"pollOptionMaxChars":       config.GetStatusesPollOptionMaxChars(),
+		"mediaMaxSize":             int64(config.GetMediaLocalMaxSize()),
+		"mediaDescriptionMaxChars": config.GetMediaDescriptionMaxChars(),
+		"profileMaxFields":         config.GetAccountsMaxProfileFields(),
+	}
+
 	return nodeInfoMetadata
 }
 
@@ -210,20 +241,55 @@ func (p *Processor) HostMetaGet() *apimodel.HostMeta {
 }
 
 // WebfingerGet handles the GET for a webfinger resource. Most commonly, it will be used for returning account lookups.
-func (p *Processor) WebfingerGet(ctx context.Context, requestedUser string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
-	// Get the local account the request is referring to.
-	requestedAccount, err := p.state.DB.GetAccountByUsernameDomain(ctx, requestedUser, "")
-	if err != nil {
-		err := gtserror.Newf("db error getting account %s: %s", requestedUser, err)
+//
+// If requestedHost is a configured account-domain-alias covering the requested account,
+// or the active custom domain of the requested account, the canonical account-domain
+// subject is still returned, with the vanity "acct:" URI as an alias.
+func (p *Processor) WebfingerGet(ctx context.Context, requestedUser string, requestedHost string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
+	var requestedAccount *gtsmodel.Account
+
+	switch {
+	case config.IsLocalAccountAddress(requestedUser, requestedHost):
+		// Get the local account the request is referring to.
+		var err error
+		requestedAccount, err = p.state.DB.GetAccountByUsernameDomain(ctx, requestedUser, "")
+		if err != nil {
+			err := gtserror.Newf("db error getting account %s: %s", requestedUser, err)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+	case config.IsLocalAccountDomain(requestedHost):
+		// Host is an alias domain that
+		// doesn't cover the requested account.
+		err := gtserror.Newf("account %s not found at alias domain %s", requestedUser, requestedHost)
 		return nil, gtserror.NewErrorNotFound(err)
+
+	default:
+		// Host may be the custom domain of a local account.
+		var errWithCode gtserror.WithCode
+		requestedAccount, errWithCode = p.customDomainAccount(ctx, requestedUser, requestedHost)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	aliases := []string{
+		requestedAccount.URI,
+		requestedAccount.URL,
+	}
+
+	if requestedHost != config.GetHost() &&
+		requestedHost != config.GetAccountDomain() {
+		// Looked up at a vanity domain, include
+		// the vanity acct URI as an alias too.
+		aliases = append(aliases,
+			webfingerAccount+":"+requestedAccount.Username+"@"+requestedHost,
+		)
 	}
 
 	return &apimodel.WellKnownResponse{
 		Subject: webfingerAccount + ":" + requestedAccount.Username + "@" + config.GetAccountDomain(),
-		Aliases: []string{
-			requestedAccount.URI,
-			requestedAccount.URL,
-		},
+		Aliases: aliases,
 		Links: []apimodel.Link{
 			{
 				Rel:  webfingerProfilePage,
@@ -238,3 +304,30 @@ func (p *Processor) WebfingerGet(ctx context.Context, requestedUser string) (*ap
 		},
 	}, nil
 }
+
+// customDomainAccount returns the local account with the given
+// username, if requestedHost is its active custom domain.
+func (p *Processor) customDomainAccount(ctx context.Context, requestedUser string, requestedHost string) (*gtsmodel.Account, gtserror.WithCode) {
+	var domain *gtsmodel.AccountCustomDomain
+
+	if config.GetAccountsAllowCustomDomains() {
+		var err error
+		domain, err = p.state.DB.GetAccountCustomDomainByDomain(ctx, strings.ToLower(requestedHost))
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting custom domain %s: %w", requestedHost, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if domain == nil || !domain.IsActive() {
+		err := fmt.Errorf("requested host %s does not belong to this instance", requestedHost)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if !strings.EqualFold(domain.Account.Username, requestedUser) {
+		err := gtserror.Newf("account %s not found at custom domain %s", requestedUser, requestedHost)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return domain.Account, nil
+}
@@ -22,7 +22,9 @@
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gopkg/xslices"
@@ -117,11 +119,17 @@ func (p *Processor) InstancePeersGet(
 		}
 
 		apiDomain := &apimodel.Domain{
-			Domain:  depunied,
-			Comment: util.Ptr(domainPerm.GetPublicComment()),
+			Domain: depunied,
 		}
 
-		if domainPerm.GetType() == gtsmodel.DomainPermissionBlock {
+		isBlock := domainPerm.GetType() == gtsmodel.DomainPermissionBlock
+		if !isBlock || config.GetInstanceExposeBlocklistComments() {
+			// Only include public comment of blocks
+			// if the instance is configured to do so.
+			apiDomain.Comment = util.Ptr(domainPerm.GetPublicComment())
+		}
+
+		if isBlock {
 			const severity = "suspend"
 			apiDomain.Severity = severity
 			suspendedAt := domainPerm.GetCreatedAt()
@@ -132,26 +140,25 @@ func (p *Processor) InstancePeersGet(
 	}
 
 	if includeOpen {
-		instances, err := p.state.DB.GetInstancePeers(ctx, false)
-		if err != nil && !errors.Is(err, db.ErrNoEntries) {
-			err = gtserror.Newf("db error getting instance peers: %w", err)
+		peers, err := p.instancePeers(ctx)
+		if err != nil {
 			return nil, gtserror.NewErrorInternalError(err)
 		}
 
-		for _, instance := range instances {
-			// Domain may be in Punycode,
-			// de-punify it just in case.
-			domain := instance.Domain
-			depunied, err := util.DePunify(domain)
-			if err != nil {
-				log.Errorf(ctx, "couldn't depunify domain %s: %v", domain, err)
+		anonymize := config.GetInstancePeersMode() == config.InstancePeersModeAnonymize
+		for _, peer := range peers.peers {
+			if anonymize && peer.users <= 1 {
+				// Leave out single-user instances
+				// (or instances with unknown user
+				// count), as their domain may well
+				// identify the person running them.
 				continue
 			}
 
 			apiDomains = append(
 				apiDomains,
 				&apimodel.Domain{
-					Domain: depunied,
+					Domain: peer.domain,
 				},
 			)
 		}
@@ -187,6 +194,186 @@ func(v *apimodel.Domain) string {
 	return apiDomains, nil
 }
 
+// instancePeers is a cached list of
+// the open peers of this instance.
+type instancePeers struct {
+	peers     []instancePeer
+	generated time.Time
+}
+
+type instancePeer struct {
+	domain string // de-punified
+	users  int    // as reported by nodeinfo
+}
+
+// instancePeers returns the cached open peers of this
+// instance, (re)fetching them from the database if they're
+// missing or stale. This avoids loading every known instance
+// on each request to the (often crawled) peers endpoint.
+func (p *Processor) instancePeers(ctx context.Context) (*instancePeers, error) {
+	if peers := p.peers.Load(); peers != nil &&
+		time.Since(peers.generated) < time.Hour {
+		// Peers are still fresh
+		// (less than 1hr old),
+		// so return them as-is.
+		return peers, nil
+	}
+
+	// Only allow one caller to
+	// refetch peers at a time.
+	p.peersMu.Lock()
+	defer p.peersMu.Unlock()
+
+	// Check again in case peers were
+	// refetched while we waited.
+	if peers := p.peers.Load(); peers != nil &&
+		time.Since(peers.generated) < time.Hour {
+		return peers, nil
+	}
+
+	instances, err := p.state.DB.GetInstancePeers(ctx, false)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting instance peers: %w", err)
+	}
+
+	peers := &instancePeers{
+		peers:     make([]instancePeer, 0, len(instances)),
+		generated: time.Now(),
+	}
+
+	for _, instance := range instances {
+		// Domain may be in Punycode,
+		// de-punify it just in case.
+		domain := instance.Domain
+		depunied, err := util.DePunify(domain)
+		if err != nil {
+			log.Errorf(ctx, "couldn't depunify domain %s: %v", domain, err)
+			continue
+		}
+
+		peers.peers = append(peers.peers, instancePeer{
+			domain: depunied,
+			users:  instance.UsersTotal,
+		})
+	}
+
+	p.peers.Store(peers)
+	return peers, nil
+}
+
+// instanceActivityWeeks is the number of weeks
+// of activity served, as in the Mastodon API.
+const instanceActivityWeeks = 12
+
+// instanceActivity is cached weekly
+// activity of this instance, newest first.
+type instanceActivity struct {
+	weeks     []instanceActivityWeek
+	generated time.Time
+}
+
+type instanceActivityWeek struct {
+	start         time.Time
+	statuses      int
+	logins        int
+	registrations int
+}
+
+// InstanceActivityGet returns the weekly activity of this
+// instance over the last 12 weeks, newest first, according
+// to the configured instance activity mode.
+func (p *Processor) InstanceActivityGet(ctx context.Context) ([]*apimodel.InstanceActivity, gtserror.WithCode) {
+	mode := config.GetInstanceActivityMode()
+	if mode == config.InstanceActivityModeDisabled {
+		const text = "instance activity is not available on this instance"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	activity, err := p.instanceActivity(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := func(n int) string {
+		if mode == config.InstanceActivityModeAnonymize {
+			// Round to the nearest 10 so
+			// small counts can't be tied
+			// back to individual users.
+			n = (n + 5) / 10 * 10
+		}
+		return strconv.Itoa(n)
+	}
+
+	apiActivity := make([]*apimodel.InstanceActivity, 0, len(activity.weeks))
+	for _, week := range activity.weeks {
+		apiActivity = append(apiActivity, &apimodel.InstanceActivity{
+			Week:          strconv.FormatInt(week.start.Unix(), 10),
+			Statuses:      count(week.statuses),
+			Logins:        count(week.logins),
+			Registrations: count(week.registrations),
+		})
+	}
+
+	return apiActivity, nil
+}
+
+// instanceActivity returns the cached weekly activity of
+// this instance, (re)calculating it from the database if it's
+// missing or stale. This avoids running the (relatively
+// expensive) activity queries on every request.
+func (p *Processor) instanceActivity(ctx context.Context) (*instanceActivity, error) {
+	if activity := p.activity.Load(); activity != nil &&
+		time.Since(activity.generated) < time.Hour {
+		// Activity is still fresh
+		// (less than 1hr old),
+		// so return it as-is.
+		return activity, nil
+	}
+
+	// Only allow one caller to
+	// recalculate activity at a time.
+	p.activityMu.Lock()
+	defer p.activityMu.Unlock()
+
+	// Check again in case activity was
+	// recalculated while we waited.
+	if activity := p.activity.Load(); activity != nil &&
+		time.Since(activity.generated) < time.Hour {
+		return activity, nil
+	}
+
+	now := time.Now()
+
+	// Weeks start on Monday at midnight UTC.
+	today := now.UTC().Truncate(24 * time.Hour)
+	thisWeek := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+
+	activity := &instanceActivity{
+		weeks:     make([]instanceActivityWeek, 0, instanceActivityWeeks),
+		generated: now,
+	}
+
+	for i := range instanceActivityWeeks {
+		start := thisWeek.AddDate(0, 0, -7*i)
+		end := start.AddDate(0, 0, 7)
+
+		statuses, logins, registrations, err := p.state.DB.CountLocalActivity(ctx, start, end)
+		if err != nil {
+			return nil, gtserror.Newf("db error counting activity for week starting %s: %w", start, err)
+		}
+
+		activity.weeks = append(activity.weeks, instanceActivityWeek{
+			start:         start,
+			statuses:      statuses,
+			logins:        logins,
+			registrations: registrations,
+		})
+	}
+
+	p.activity.Store(activity)
+	return activity, nil
+}
+
 func (p *Processor) InstanceGetRules(ctx context.Context) ([]apimodel.InstanceRule, gtserror.WithCode) {
 	i, err := p.getThisInstance(ctx)
 	if err != nil {
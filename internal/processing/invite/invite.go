@@ -0,0 +1,236 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// Processor wraps functionality for creating, listing,
+// and revoking invites, which allow others to sign up
+// to the instance, even when registration is closed.
+type Processor struct {
+	state     *state.State
+	converter *typeutils.Converter
+}
+
+// New returns a new invite processor.
+func New(state *state.State, converter *typeutils.Converter) Processor {
+	return Processor{
+		state:     state,
+		converter: converter,
+	}
+}
+
+// canCreate returns whether the given
+// user may create invites, according to
+// the configured accounts-invites-allowed.
+func canCreate(user *gtsmodel.User) bool {
+	switch config.GetAccountsInvitesAllowed() {
+	case config.AccountsInvitesAllowedUsers:
+		return true
+	case config.AccountsInvitesAllowedModerators:
+		return *user.Admin || *user.Moderator
+	default:
+		return false
+	}
+}
+
+// Create creates a new invite owned by the requesting account.
+func (p *Processor) Create(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	user *gtsmodel.User,
+	form *apimodel.InviteCreateRequest,
+) (*apimodel.Invite, gtserror.WithCode) {
+	if !canCreate(user) {
+		const text = "you are not permitted to create invites on this instance"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if form.MaxUses < 0 {
+		const text = "max_uses must be 0 (unlimited) or more"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if form.ExpiresIn < 0 {
+		const text = "expires_in must be 0 (never) or more"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	invite := &gtsmodel.Invite{
+		ID:        id.NewULID(),
+		Code:      util.MustGenerateSecret(),
+		AccountID: requester.ID,
+		MaxUses:   form.MaxUses,
+	}
+
+	if form.ExpiresIn > 0 {
+		invite.ExpiresAt = time.Now().Add(time.Duration(form.ExpiresIn) * time.Second)
+	}
+
+	if err := p.state.DB.PutInvite(ctx, invite); err != nil {
+		err := gtserror.Newf("db error putting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiInvite(ctx, invite)
+}
+
+// GetAll returns all invites created by the requesting account.
+func (p *Processor) GetAll(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]*apimodel.Invite, gtserror.WithCode) {
+	return p.getInvites(ctx, requester.ID, false)
+}
+
+// Revoke revokes one invite created by the requesting account.
+func (p *Processor) Revoke(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	inviteID string,
+) (*apimodel.Invite, gtserror.WithCode) {
+	return p.revoke(ctx, requester.ID, inviteID, false)
+}
+
+// AdminGetAll returns all invites created by any
+// account on the instance, or only those created
+// by the given account ID, if set.
+func (p *Processor) AdminGetAll(
+	ctx context.Context,
+	accountID string,
+) ([]*apimodel.Invite, gtserror.WithCode) {
+	return p.getInvites(ctx, accountID, true)
+}
+
+// AdminRevoke revokes one invite, regardless of who created it.
+func (p *Processor) AdminRevoke(
+	ctx context.Context,
+	inviteID string,
+) (*apimodel.Invite, gtserror.WithCode) {
+	return p.revoke(ctx, "", inviteID, true)
+}
+
+func (p *Processor) getInvites(
+	ctx context.Context,
+	accountID string,
+	withAccount bool,
+) ([]*apimodel.Invite, gtserror.WithCode) {
+	invites, err := p.state.DB.GetInvites(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invites: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiInvites := make([]*apimodel.Invite, 0, len(invites))
+	for _, invite := range invites {
+		if withAccount {
+			if errWithCode := p.populateAccount(ctx, invite); errWithCode != nil {
+				return nil, errWithCode
+			}
+		}
+
+		apiInvite, errWithCode := p.apiInvite(ctx, invite)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiInvites = append(apiInvites, apiInvite)
+	}
+
+	return apiInvites, nil
+}
+
+// revoke revokes the invite with the given ID. If accountID
+// is set, the invite must have been created by that account.
+func (p *Processor) revoke(
+	ctx context.Context,
+	accountID string,
+	inviteID string,
+	withAccount bool,
+) (*apimodel.Invite, gtserror.WithCode) {
+	invite, err := p.state.DB.GetInviteByID(ctx, inviteID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil || (accountID != "" && invite.AccountID != accountID) {
+		err := fmt.Errorf("invite %s not found", inviteID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if !invite.IsRevoked() {
+		invite.RevokedAt = time.Now()
+		if err := p.state.DB.UpdateInvite(ctx, invite, "revoked_at"); err != nil {
+			err := gtserror.Newf("db error updating invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if withAccount {
+		if errWithCode := p.populateAccount(ctx, invite); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	return p.apiInvite(ctx, invite)
+}
+
+func (p *Processor) populateAccount(
+	ctx context.Context,
+	invite *gtsmodel.Invite,
+) gtserror.WithCode {
+	if invite.Account != nil {
+		return nil
+	}
+
+	account, err := p.state.DB.GetAccountByID(ctx, invite.AccountID)
+	if err != nil {
+		err := gtserror.Newf("db error getting invite account %s: %w", invite.AccountID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	invite.Account = account
+	return nil
+}
+
+func (p *Processor) apiInvite(
+	ctx context.Context,
+	invite *gtsmodel.Invite,
+) (*apimodel.Invite, gtserror.WithCode) {
+	apiInvite, err := p.converter.InviteToAPIInvite(ctx, invite)
+	if err != nil {
+		err := gtserror.Newf("error converting invite to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiInvite, nil
+}
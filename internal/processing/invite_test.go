@@ -0,0 +1,170 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package processing_test
+
+import (
+	"net"
+	"net/http"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+	"github.com/stretchr/testify/suite"
+)
+
+type InviteTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *InviteTestSuite) signupForm(username string, invite *apimodel.Invite) *apimodel.AccountCreateRequest {
+	return &apimodel.AccountCreateRequest{
+		Reason:     "a long enough explanation of why I am doing api calls",
+		Username:   username,
+		Email:      username + "@example.org",
+		Password:   "a long enough password for this endpoint",
+		Agreement:  true,
+		InviteCode: invite.Code,
+		IP:         net.ParseIP("192.0.2.128"),
+	}
+}
+
+func (suite *InviteTestSuite) TestInviteSignup() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		user      = suite.testUsers["local_account_1"]
+	)
+
+	// Registration is closed,
+	// but invites are allowed.
+	config.SetAccountsRegistrationOpen(false)
+	config.SetAccountsInvitesAllowed(config.AccountsInvitesAllowedUsers)
+
+	invite, errWithCode := suite.processor.Invite().Create(ctx, requester, user, &apimodel.InviteCreateRequest{
+		MaxUses:   1,
+		ExpiresIn: 3600,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(1, invite.MaxUses)
+	suite.Equal(0, invite.Uses)
+	suite.NotNil(invite.ExpiresAt)
+	suite.True(invite.Usable)
+	suite.Equal("http://localhost:8080/signup?invite="+invite.Code, invite.URL)
+
+	// Sign-up without invite is not allowed.
+	form := suite.signupForm("someone_new", invite)
+	form.InviteCode = ""
+	suite.EqualError(validate.CreateAccount(form), "registration is not open for this server")
+
+	// Sign-up with invite is allowed.
+	form.InviteCode = invite.Code
+	suite.NoError(validate.CreateAccount(form))
+	newUser, errWithCode := suite.processor.User().Create(ctx, nil, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(invite.ID, newUser.InviteID)
+
+	// New account should be attributed to the inviter.
+	adminAccount, err := suite.typeconverter.AccountToAdminAPIAccount(ctx, newUser.Account)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(requester.ID, adminAccount.InvitedByAccountID)
+
+	// Invite is now used up.
+	invites, errWithCode := suite.processor.Invite().GetAll(ctx, requester)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(invites, 1)
+	suite.Equal(1, invites[0].Uses)
+	suite.False(invites[0].Usable)
+
+	// So further sign-ups fail.
+	_, errWithCode = suite.processor.User().Create(ctx, nil, suite.signupForm("someone_else", invite))
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *InviteTestSuite) TestInviteRevoke() {
+	var (
+		ctx       = suite.T().Context()
+		requester = suite.testAccounts["local_account_1"]
+		user      = suite.testUsers["local_account_1"]
+	)
+
+	config.SetAccountsInvitesAllowed(config.AccountsInvitesAllowedUsers)
+
+	invite, errWithCode := suite.processor.Invite().Create(ctx, requester, user, &apimodel.InviteCreateRequest{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(0, invite.MaxUses)
+	suite.Nil(invite.ExpiresAt)
+
+	// Other accounts can't revoke the invite.
+	_, errWithCode = suite.processor.Invite().Revoke(ctx, suite.testAccounts["local_account_2"], invite.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Owner can.
+	revoked, errWithCode := suite.processor.Invite().Revoke(ctx, requester, invite.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotNil(revoked.RevokedAt)
+	suite.False(revoked.Usable)
+
+	// Revoked invite can't be used.
+	_, errWithCode = suite.processor.User().Create(ctx, nil, suite.signupForm("someone_new", invite))
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// Admin sees the invite along with its creator.
+	invites, errWithCode := suite.processor.Invite().AdminGetAll(ctx, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(invites, 1)
+	suite.Equal(requester.ID, invites[0].Account.ID)
+}
+
+func (suite *InviteTestSuite) TestInviteCreateNotAllowed() {
+	ctx := suite.T().Context()
+
+	// Only mods and admins may create invites.
+	config.SetAccountsInvitesAllowed(config.AccountsInvitesAllowedModerators)
+
+	_, errWithCode := suite.processor.Invite().Create(ctx,
+		suite.testAccounts["local_account_1"],
+		suite.testUsers["local_account_1"],
+		&apimodel.InviteCreateRequest{},
+	)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	_, errWithCode = suite.processor.Invite().Create(ctx,
+		suite.testAccounts["admin_account"],
+		suite.testUsers["admin_account"],
+		&apimodel.InviteCreateRequest{},
+	)
+	suite.Nil(errWithCode)
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
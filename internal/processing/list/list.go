@@ -18,6 +18,7 @@
 package list
 
 import (
+	"code.superseriousbusiness.org/gotosocial/internal/processing/stream"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 )
@@ -25,11 +26,13 @@
 type Processor struct {
 	state     *state.State
 	converter *typeutils.Converter
+	stream    *stream.Processor
 }
 
-func New(state *state.State, converter *typeutils.Converter) Processor {
+func New(state *state.State, converter *typeutils.Converter, stream *stream.Processor) Processor {
 	return Processor{
 		state:     state,
 		converter: converter,
+		stream:    stream,
 	}
 }
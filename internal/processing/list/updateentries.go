@@ -163,6 +163,14 @@ func (p *Processor) RemoveFromList(
 			errs.Appendf("error removing list entry: %w", err)
 			continue
 		}
+
+		// Let any open list streams know
+		// they can prune this account.
+		p.stream.ListRemove(ctx,
+			account,
+			listID,
+			targetAccountID,
+		)
 	}
 
 	// Wrap errors in errWithCode if set.
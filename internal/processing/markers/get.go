@@ -27,6 +27,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 // Get returns an API model for the markers of the requested timelines.
@@ -50,5 +51,36 @@ func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, names []
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting marker to api: %w", err))
 	}
 
+	if m := apiMarker.Home; m != nil {
+		if account.Settings == nil {
+			settings, err := p.state.DB.GetAccountSettings(ctx, account.ID)
+			if err != nil {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting account settings: %w", err))
+			}
+			account.Settings = settings
+		}
+
+		// Count the unread statuses from the home timeline cache,
+		// if any, without allocating one. Mutes and filters are not
+		// checked here, so this is only ever an approximate count.
+		n, exact := p.state.Caches.Timelines.Home.CountNewer(
+			account.ID,
+			m.LastReadID,
+			account.Settings.LanguageChosen,
+		)
+		m.UnreadCount = &n
+		m.UnreadCountExact = &exact
+	}
+
+	if m := apiMarker.Notifications; m != nil {
+		// Count the unread notifications from the database.
+		n, err := p.state.DB.CountAccountNotificationsAfter(ctx, account.ID, m.LastReadID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error counting unread notifications: %w", err))
+		}
+		m.UnreadCount = &n
+		m.UnreadCountExact = util.Ptr(true)
+	}
+
 	return apiMarker, nil
 }
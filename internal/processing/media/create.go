@@ -29,12 +29,23 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/common"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"codeberg.org/gruf/go-iotools"
 )
 
 // Create creates a new media attachment belonging to the given account, using the request form.
+//
+// If ctx carries an idempotency key, a retry of the same request returns the originally created attachment.
 func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.AttachmentRequest) (*apimodel.Attachment, gtserror.WithCode) {
+	return common.Idempotent(ctx, p.state, account, "media",
+		func() (*apimodel.Attachment, gtserror.WithCode) {
+			return p.create(ctx, account, form)
+		},
+	)
+}
+
+func (p *Processor) create(ctx context.Context, account *gtsmodel.Account, form *apimodel.AttachmentRequest) (*apimodel.Attachment, gtserror.WithCode) {
 
 	// Get maximum supported local media size.
 	maxsz := config.GetMediaLocalMaxSize()
@@ -46,6 +57,11 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
 	}
 
+	// Ensure media within account's storage quota.
+	if errWithCode := p.c.CheckMediaQuota(ctx, account, form.File.Size); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Parse focus details from API form input.
 	focusX, focusY, errWithCode := apiutil.ParseFocus(form.Focus)
 	if errWithCode != nil {
@@ -76,7 +92,7 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 
 	// Create local media and write to instance storage.
 	attachment, errWithCode := p.c.StoreLocalMedia(ctx,
-		account.ID,
+		account,
 		func(ctx context.Context) (reader io.ReadCloser, err error) {
 			return rc, nil
 		},
@@ -84,6 +100,8 @@ func(ctx context.Context) (reader io.ReadCloser, err error) {
 			Description: &form.Description,
 			FocusX:      &focusX,
 			FocusY:      &focusY,
+
+			PreserveColorProfile: form.PreserveColorProfile,
 		},
 	)
 	if errWithCode != nil {
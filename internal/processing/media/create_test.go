@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"codeberg.org/gruf/go-bytesize"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateTestSuite struct {
+	MediaStandardTestSuite
+}
+
+// fileHeader returns a multipart file
+// header for the given testrig media file.
+func (suite *CreateTestSuite) fileHeader(name string) *multipart.FileHeader {
+	b, err := os.ReadFile("../../../testrig/media/" + name)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+	fw, err := w.CreateFormFile("file", name)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if _, err := fw.Write(b); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := w.Close(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form, err := multipart.NewReader(buf, w.Boundary()).ReadForm(int64(len(b)) * 2)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return form.File["file"][0]
+}
+
+func (suite *CreateTestSuite) TestCreateOverQuota() {
+	ctx := suite.T().Context()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Set a quota that's already used up.
+	config.SetMediaLocalQuota(1 * bytesize.KiB)
+
+	_, errWithCode := suite.mediaProcessor.Create(ctx, testAccount, &apimodel.AttachmentRequest{
+		File: suite.fileHeader("beeplushie.jpg"),
+	})
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Contains(errWithCode.Safe(), "media storage quota reached")
+
+	// An admin override of 0 means no quota.
+	account, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	account.Settings.MediaQuota = util.Ptr(int64(0))
+
+	attachment, errWithCode := suite.mediaProcessor.Create(ctx, account, &apimodel.AttachmentRequest{
+		File: suite.fileHeader("beeplushie.jpg"),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotEmpty(attachment.ID)
+}
+
+func (suite *CreateTestSuite) TestCreateOverQuotaAfterProcessing() {
+	ctx := suite.T().Context()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Store the media once without a quota, to
+	// find out the size it actually takes up.
+	attachment, errWithCode := suite.mediaProcessor.Create(ctx, testAccount, &apimodel.AttachmentRequest{
+		File: suite.fileHeader("beeplushie.jpg"),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	stored, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if errWithCode := suite.mediaProcessor.Delete(ctx, attachment.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Stored size includes the thumbnail,
+	// so should be more than upload size.
+	header := suite.fileHeader("beeplushie.jpg")
+	storedSize := int64(stored.File.FileSize) + int64(stored.Thumbnail.FileSize)
+	if storedSize <= header.Size {
+		suite.FailNow("stored size not larger than upload size")
+	}
+
+	before, err := suite.db.GetAccountMediaUsage(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Set a quota with enough room left for the
+	// upload, but not for the media once stored.
+	config.SetMediaLocalQuota(bytesize.Size(before.Total().Bytes + header.Size)) // #nosec G115 -- Non-negative.
+
+	_, errWithCode = suite.mediaProcessor.Create(ctx, testAccount, &apimodel.AttachmentRequest{
+		File: header,
+	})
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Contains(errWithCode.Safe(), "media storage quota reached")
+
+	// The stored media should have been rolled back.
+	after, err := suite.db.GetAccountMediaUsage(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(before.Total(), after.Total())
+}
+
+func TestCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateTestSuite))
+}
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// Describe runs the configured describe backend over the
+// cached file of the given image attachment, returning
+// a suggested description for the requester to review.
+// The suggestion is not stored on the attachment.
+func (p *Processor) Describe(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	mediaAttachmentID string,
+) (*apimodel.AttachmentDescriptionSuggestion, gtserror.WithCode) {
+	if p.describer == nil {
+		const text = "description suggestions are not enabled on this instance"
+		return nil, gtserror.NewErrorNotImplemented(errors.New(text), text)
+	}
+
+	attachment, err := p.state.DB.GetAttachmentByID(ctx, mediaAttachmentID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting attachment %s: %w", mediaAttachmentID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if attachment == nil {
+		const text = "attachment not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if attachment.AccountID != account.ID {
+		err := errors.New("attachment not owned by requesting account")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if attachment.Type != gtsmodel.FileTypeImage {
+		const text = "only image attachments can be described"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if !attachment.File.Cached() {
+		const text = "attachment file not cached"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	rc, err := p.state.Storage.GetStream(ctx, attachment.File.Path)
+	if err != nil {
+		err := gtserror.Newf("storage error getting attachment %s: %w", attachment.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	defer rc.Close()
+
+	description, err := p.describer.Describe(ctx, rc, attachment.File.ContentType)
+	if err != nil {
+		err := gtserror.Newf("error describing attachment %s: %w", attachment.ID, err)
+		return nil, gtserror.NewWithCodeSafe(http.StatusServiceUnavailable, err, "description provider unavailable")
+	}
+
+	// OCR output in particular is full of line
+	// breaks and runs of spaces, so flatten it,
+	// and make sure it's usable as description.
+	description = strings.Join(strings.Fields(description), " ")
+	if max := config.GetMediaDescriptionMaxChars(); len([]rune(description)) > max {
+		description = strings.TrimSpace(string([]rune(description)[:max]))
+	}
+
+	if description == "" {
+		const text = "no description could be suggested for this attachment"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return &apimodel.AttachmentDescriptionSuggestion{
+		Description: description,
+		Provider:    p.describer.Provider(),
+	}, nil
+}
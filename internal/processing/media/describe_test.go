@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DescribeTestSuite struct {
+	MediaStandardTestSuite
+}
+
+func (suite *DescribeTestSuite) TestDescribe() {
+	ctx := suite.T().Context()
+
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+
+	b, err := suite.storage.Get(ctx, testAttachment.File.Path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suggestion, errWithCode := suite.mediaProcessor.Describe(ctx, testAccount, testAttachment.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Whitespace from the
+	// describer is flattened.
+	suite.Equal("image/jpeg, "+strconv.Itoa(len(b))+" bytes", suggestion.Description)
+	suite.Equal("Mock", suggestion.Provider)
+
+	// Suggestion isn't stored.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(testAttachment.Description, dbAttachment.Description)
+}
+
+func (suite *DescribeTestSuite) TestDescribeNotOwned() {
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+	testAccount := suite.testAccounts["local_account_2"]
+
+	suggestion, errWithCode := suite.mediaProcessor.Describe(suite.T().Context(), testAccount, testAttachment.ID)
+	suite.Nil(suggestion)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *DescribeTestSuite) TestDescribeNotImage() {
+	testAttachment := suite.testAttachments["local_account_1_status_4_attachment_2"]
+	testAccount := suite.testAccounts["local_account_1"]
+
+	suggestion, errWithCode := suite.mediaProcessor.Describe(suite.T().Context(), testAccount, testAttachment.ID)
+	suite.Nil(suggestion)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: only image attachments can be described", errWithCode.Safe())
+}
+
+func TestDescribeTestSuite(t *testing.T) {
+	suite.Run(t, &DescribeTestSuite{})
+}
@@ -18,6 +18,7 @@
 package media
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -27,6 +28,7 @@
 	"strings"
 	"time"
 
+	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
@@ -128,6 +130,7 @@ func (p *Processor) GetFile(
 			acctID,
 			mediaSize,
 			mediaID,
+			form.Accept,
 		)
 
 	default:
@@ -142,6 +145,7 @@ func (p *Processor) getAttachmentContent(
 	acctID string,
 	sizeStr media.Size,
 	mediaID string,
+	accept []string,
 ) (
 	*apimodel.Content,
 	gtserror.WithCode,
@@ -182,7 +186,7 @@ func (p *Processor) getAttachmentContent(
 	// Start preparing API content model and other
 	// values depending on requested media size.
 	var content apimodel.Content
-	var mediaPath func(*gtsmodel.MediaAttachment) string
+	var mediaPath, mediaURL func(*gtsmodel.MediaAttachment) string
 	switch sizeStr {
 
 	// Original media size.
@@ -192,6 +196,9 @@ func (p *Processor) getAttachmentContent(
 		mediaPath = func(a *gtsmodel.MediaAttachment) string {
 			return a.File.Path
 		}
+		mediaURL = func(a *gtsmodel.MediaAttachment) string {
+			return a.URL
+		}
 
 	// Thumbnail media size.
 	case media.SizeSmall:
@@ -200,6 +207,9 @@ func (p *Processor) getAttachmentContent(
 		mediaPath = func(a *gtsmodel.MediaAttachment) string {
 			return a.Thumbnail.Path
 		}
+		mediaURL = func(a *gtsmodel.MediaAttachment) string {
+			return a.Thumbnail.URL
+		}
 
 	default:
 		const text = "invalid media size"
@@ -213,6 +223,11 @@ func (p *Processor) getAttachmentContent(
 	// stream from storage.
 	var rc io.ReadCloser
 
+	// Set if media is expected to be
+	// cached, but wasn't found in storage,
+	// e.g. expired by S3 lifecycle rules.
+	var missing bool
+
 	// Check media is meant
 	// to be cached locally.
 	if attach.Cached() {
@@ -223,6 +238,9 @@ func (p *Processor) getAttachmentContent(
 			err := gtserror.Newf("storage error getting cached media %s: %w", attach.URL, err)
 			return nil, gtserror.NewErrorInternalError(err)
 		}
+
+		// Treat as uncached.
+		missing = (rc == nil)
 	}
 
 	if rc == nil {
@@ -233,12 +251,13 @@ func (p *Processor) getAttachmentContent(
 				"local media file not found")
 		}
 
-		// Attempt to recache this remote media.
+		// Attempt to recache this remote media,
+		// forcing if it was unexpectedly missing.
 		attach, err = p.federator.RefreshMedia(ctx,
 			requestUser,
 			attach,
 			media.AdditionalMediaInfo{},
-			false,
+			missing,
 			false, // async
 		)
 		if err != nil {
@@ -263,6 +282,35 @@ func (p *Processor) getAttachmentContent(
 		return &content, nil
 	}
 
+	// If the requester accepts a converted variant
+	// of this image, serve that instead if we can.
+	if len(accept) > 0 && attach.Type == gtsmodel.FileTypeImage {
+		variant, err := p.mediaManager.GetVariant(ctx,
+
+			// The URL is versioned by
+			// contents, unlike the path.
+			mediaURL(attach),
+			content.ContentType,
+			accept,
+
+			// Open a separate stream, so rc
+			// is still intact if we fall back.
+			func(ctx context.Context) (io.ReadCloser, error) {
+				return p.state.Storage.GetStream(ctx, mediaPath(attach))
+			},
+		)
+		if err != nil {
+			// Not fatal, fall back to serving original.
+			log.Errorf(ctx, "error getting variant of media %s: %v", attach.ID, err)
+		} else if variant != nil {
+			_ = rc.Close() // close storage stream
+			content.ContentType = variant.ContentType
+			content.ContentLength = int64(len(variant.Data))
+			content.Content = io.NopCloser(bytes.NewReader(variant.Data))
+			return &content, nil
+		}
+	}
+
 	// Return with stream.
 	content.Content = rc
 	return &content, nil
@@ -285,6 +333,7 @@ func (p *Processor) getEmojiContent(
 		string(media.TypeEmoji),
 		string(media.SizeStatic),
 		emojiID,
+		"",
 		"png",
 	)
 
@@ -117,6 +117,48 @@ func (suite *GetFileTestSuite) TestGetRemoteFileUncached() {
 	suite.Equal(suite.testRemoteAttachments[testAttachment.RemoteURL].Data, refreshedBytes)
 }
 
+func (suite *GetFileTestSuite) TestGetRemoteFileCachedMissing() {
+	ctx := suite.T().Context()
+
+	// remove the file from storage, but leave the attachment
+	// marked as cached, as if expired by storage lifecycle rules
+	testAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	suite.True(testAttachment.Cached())
+
+	err := suite.storage.Delete(ctx, testAttachment.File.Path)
+	suite.NoError(err)
+	err = suite.storage.Delete(ctx, testAttachment.Thumbnail.Path)
+	suite.NoError(err)
+
+	// now fetch it
+	requestingAccount := suite.testAccounts["local_account_1"]
+	content, errWithCode := suite.mediaProcessor.GetFile(ctx, requestingAccount, &apimodel.GetContentRequestForm{
+		AccountID: testAttachment.AccountID,
+		MediaType: string(media.TypeAttachment),
+		MediaSize: string(media.SizeOriginal),
+		FileName:  path.Base(testAttachment.File.Path),
+	})
+	suite.NoError(errWithCode)
+	suite.NotNil(content)
+
+	b, err := io.ReadAll(content.Content)
+	suite.NoError(err)
+	suite.NoError(content.Content.Close())
+
+	// the media should have been force refetched
+	suite.Equal(suite.testRemoteAttachments[testAttachment.RemoteURL].Data, b)
+	suite.Equal(suite.testRemoteAttachments[testAttachment.RemoteURL].ContentType, content.ContentType)
+
+	// and the file should be back in storage
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.True(dbAttachment.Cached())
+
+	refreshedBytes, err := suite.storage.Get(ctx, dbAttachment.File.Path)
+	suite.NoError(err)
+	suite.Equal(suite.testRemoteAttachments[testAttachment.RemoteURL].Data, refreshedBytes)
+}
+
 func (suite *GetFileTestSuite) TestGetRemoteFileUncachedInterrupted() {
 	ctx := suite.T().Context()
 
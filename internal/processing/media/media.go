@@ -18,6 +18,7 @@
 package media
 
 import (
+	"code.superseriousbusiness.org/gotosocial/internal/describe"
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/common"
@@ -35,6 +36,7 @@ type Processor struct {
 	federator           *federation.Federator
 	mediaManager        *media.Manager
 	transportController transport.Controller
+	describer           describe.Describer
 }
 
 // New returns a new media processor.
@@ -45,6 +47,7 @@ func New(
 	federator *federation.Federator,
 	mediaManager *media.Manager,
 	transportController transport.Controller,
+	describer describe.Describer,
 ) Processor {
 	return Processor{
 		c:                   common,
@@ -53,5 +56,6 @@ func New(
 		federator:           federator,
 		mediaManager:        mediaManager,
 		transportController: transportController,
+		describer:           describer,
 	}
 }
@@ -81,7 +81,7 @@ func (suite *MediaStandardTestSuite) SetupTest() {
 	surfacer := testrig.NewTestSurfacer(&suite.state, testrig.NewEmailSender("../../../web/template", nil), testrig.NewNoopWebPushSender())
 	common := common.New(&suite.state, suite.mediaManager, suite.tc, federator, visFilter, muteFilter, statusFilter, surfacer)
 
-	suite.mediaProcessor = mediaprocessing.New(&common, &suite.state, suite.tc, federator, suite.mediaManager, suite.transportController)
+	suite.mediaProcessor = mediaprocessing.New(&common, &suite.state, suite.tc, federator, suite.mediaManager, suite.transportController, testrig.NewTestDescriber())
 	testrig.StandardDBSetup(suite.db, nil)
 	testrig.StandardStorageSetup(suite.storage, "../../../testrig/media")
 
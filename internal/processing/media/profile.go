@@ -19,11 +19,17 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
 )
 
 // DeleteAvatar deletes the account's avatar, if one exists, and returns the updated account.
@@ -75,3 +81,122 @@ func (p *Processor) deleteProfileAttachment(
 
 	return acctSensitive, nil
 }
+
+// RollbackAvatar restores one of the account's previous avatars,
+// given by attachmentID, reusing the stored media rather than
+// requiring a re-upload. The current avatar, if any, takes its
+// place in the account's avatar history.
+func (p *Processor) RollbackAvatar(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	attachmentID string,
+) (*apimodel.Account, gtserror.WithCode) {
+	return p.rollbackProfileAttachment(ctx, account, attachmentID, false)
+}
+
+// RollbackHeader restores one of the account's previous headers,
+// given by attachmentID, reusing the stored media rather than
+// requiring a re-upload. The current header, if any, takes its
+// place in the account's header history.
+func (p *Processor) RollbackHeader(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	attachmentID string,
+) (*apimodel.Account, gtserror.WithCode) {
+	return p.rollbackProfileAttachment(ctx, account, attachmentID, true)
+}
+
+// rollbackProfileAttachment swaps the account's current avatar or
+// header with the given attachment from the account's history.
+func (p *Processor) rollbackProfileAttachment(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	attachmentID string,
+	header bool,
+) (*apimodel.Account, gtserror.WithCode) {
+	if account.Settings == nil {
+		var err error
+		account.Settings, err = p.state.DB.GetAccountSettings(ctx, account.ID)
+		if err != nil {
+			err := gtserror.Newf("db error getting account settings: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Select avatar or header fields to work on.
+	var (
+		currentID     *string
+		current       **gtsmodel.MediaAttachment
+		history       *[]string
+		column        string
+		historyColumn string
+	)
+	if header {
+		currentID = &account.HeaderMediaAttachmentID
+		current = &account.HeaderMediaAttachment
+		history = &account.Settings.HeaderHistoryIDs
+		column = "header_media_attachment_id"
+		historyColumn = "header_history"
+	} else {
+		currentID = &account.AvatarMediaAttachmentID
+		current = &account.AvatarMediaAttachment
+		history = &account.Settings.AvatarHistoryIDs
+		column = "avatar_media_attachment_id"
+		historyColumn = "avatar_history"
+	}
+
+	if !slices.Contains(*history, attachmentID) {
+		const text = "no previous profile media with that id"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	attachment, err := p.state.DB.GetAttachmentByID(ctx, attachmentID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting attachment %s: %w", attachmentID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if attachment == nil || attachment.AccountID != account.ID {
+		const text = "no previous profile media with that id"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Take the restored attachment out of history,
+	// and put the one it replaces in its place.
+	*history = slices.DeleteFunc(slices.Clone(*history), func(id string) bool {
+		return id == attachmentID
+	})
+	*history = gtsmodel.PushProfileMediaHistory(
+		*history,
+		*currentID,
+		config.GetAccountsProfileMediaHistory(),
+	)
+	*currentID = attachment.ID
+	*current = attachment
+
+	if err := p.state.DB.UpdateAccount(ctx, account, column); err != nil {
+		err := gtserror.Newf("db error updating account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.UpdateAccountSettings(ctx, account.Settings, historyColumn); err != nil {
+		err := gtserror.Newf("db error updating account settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Send out Update message over the s2s (fedi) API.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ActorPerson,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       account,
+		Origin:         account,
+	})
+
+	acctSensitive, err := p.converter.AccountToAPIAccountSensitive(ctx, account)
+	if err != nil {
+		err := gtserror.Newf("error converting account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return acctSensitive, nil
+}
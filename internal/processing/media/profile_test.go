@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProfileTestSuite struct {
+	MediaStandardTestSuite
+}
+
+func (suite *ProfileTestSuite) TestRollbackAvatar() {
+	ctx := suite.T().Context()
+
+	account, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	currentID := account.AvatarMediaAttachmentID
+
+	// Store a previous avatar in the account's history.
+	previous := new(gtsmodel.MediaAttachment)
+	*previous = *suite.testAttachments["local_account_1_avatar"]
+	previous.ID = "01K7QBZ7V3P0A8RK2J5N9X6M4D"
+	if err := suite.db.PutAttachment(ctx, previous); err != nil {
+		suite.FailNow(err.Error())
+	}
+	account.Settings.AvatarHistoryIDs = []string{previous.ID}
+	if err := suite.db.UpdateAccountSettings(ctx, account.Settings, "avatar_history"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiAccount, errWithCode := suite.mediaProcessor.RollbackAvatar(ctx, account, previous.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// The previous avatar should be back in use,
+	// with the replaced one now in history.
+	suite.Equal(previous.URL, apiAccount.Avatar)
+	suite.Len(apiAccount.Source.AvatarHistory, 1)
+	suite.Equal(currentID, apiAccount.Source.AvatarHistory[0].ID)
+
+	dbAccount, err := suite.db.GetAccountByID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(previous.ID, dbAccount.AvatarMediaAttachmentID)
+	suite.Equal([]string{currentID}, dbAccount.Settings.AvatarHistoryIDs)
+}
+
+func (suite *ProfileTestSuite) TestRollbackAvatarNotInHistory() {
+	ctx := suite.T().Context()
+
+	account, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// An attachment not in the account's history can't be restored.
+	_, errWithCode := suite.mediaProcessor.RollbackAvatar(ctx, account,
+		suite.testAttachments["local_account_1_unattached_1"].ID,
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestProfileTestSuite(t *testing.T) {
+	suite.Run(t, &ProfileTestSuite{})
+}
@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
@@ -56,15 +57,20 @@ func GetParseMentionFunc(state *state.State, federator *federation.Federator) gt
 			)
 		}
 
+		// Domains are case-insensitive, so
+		// normalize the host before comparing
+		// it to our own or looking it up.
+		targetHost = strings.ToLower(targetHost)
+
 		// It's a "local" mention if namestring
 		// looks like one of the following:
 		//
 		//   - "@someone" with no host component.
 		//   - "@someone@gts.example.org" and we're host "gts.example.org".
 		//   - "@someone@example.org" and we're account-domain "example.org".
+		//   - "@someone@brand.example" and one of our account-domain-aliases covers it.
 		local := targetHost == "" ||
-			targetHost == config.GetHost() ||
-			targetHost == config.GetAccountDomain()
+			config.IsLocalAccountAddress(targetUsername, targetHost)
 
 		// Either a local or remote
 		// target for the mention.
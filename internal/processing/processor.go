@@ -18,7 +18,11 @@
 package processing
 
 import (
+	"sync"
+	"sync/atomic"
+
 	"code.superseriousbusiness.org/gotosocial/internal/cleaner"
+	"code.superseriousbusiness.org/gotosocial/internal/describe"
 	"code.superseriousbusiness.org/gotosocial/internal/email"
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
@@ -31,6 +35,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/processing/account"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/admin"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/advancedmigrations"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/announcements"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/application"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/common"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/conversations"
@@ -39,6 +44,7 @@
 	filtersv1 "code.superseriousbusiness.org/gotosocial/internal/processing/filters/v1"
 	filtersv2 "code.superseriousbusiness.org/gotosocial/internal/processing/filters/v2"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/interactionrequests"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/invite"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/list"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/markers"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/media"
@@ -46,16 +52,19 @@
 	"code.superseriousbusiness.org/gotosocial/internal/processing/push"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/report"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/search"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/softmigration"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/status"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/stream"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/tags"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/timeline"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/trends"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/user"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/workers"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/subscriptions"
 	"code.superseriousbusiness.org/gotosocial/internal/surfacing"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/webpush"
 )
@@ -79,6 +88,24 @@ type Processor struct {
 	formatter        *text.Formatter
 	parseMentionFunc gtsmodel.ParseMentionFunc
 
+	/*
+		Cached sitemap of indexable pages,
+		regenerated on a schedule.
+	*/
+
+	sitemap   atomic.Pointer[sitemapPages]
+	sitemapMu sync.Mutex
+
+	/*
+		Cached instance activity and peers,
+		recalculated lazily when stale.
+	*/
+
+	activity   atomic.Pointer[instanceActivity]
+	activityMu sync.Mutex
+	peers      atomic.Pointer[instancePeers]
+	peersMu    sync.Mutex
+
 	/*
 		SUB-PROCESSORS
 	*/
@@ -86,12 +113,14 @@ type Processor struct {
 	account             account.Processor
 	admin               admin.Processor
 	advancedmigrations  advancedmigrations.Processor
+	announcements       announcements.Processor
 	application         application.Processor
 	conversations       conversations.Processor
 	fedi                fedi.Processor
 	filtersv1           filtersv1.Processor
 	filtersv2           filtersv2.Processor
 	interactionRequests interactionrequests.Processor
+	invite              invite.Processor
 	list                list.Processor
 	markers             markers.Processor
 	media               media.Processor
@@ -99,10 +128,12 @@ type Processor struct {
 	push                push.Processor
 	report              report.Processor
 	search              search.Processor
+	softMigration       softmigration.Processor
 	status              status.Processor
 	stream              stream.Processor
 	tags                tags.Processor
 	timeline            timeline.Processor
+	trends              trends.Processor
 	user                user.Processor
 	workers             workers.Processor
 }
@@ -119,6 +150,10 @@ func (p *Processor) AdvancedMigrations() *advancedmigrations.Processor {
 	return &p.advancedmigrations
 }
 
+func (p *Processor) Announcements() *announcements.Processor {
+	return &p.announcements
+}
+
 func (p *Processor) Application() *application.Processor {
 	return &p.application
 }
@@ -143,6 +178,10 @@ func (p *Processor) InteractionRequests() *interactionrequests.Processor {
 	return &p.interactionRequests
 }
 
+func (p *Processor) Invite() *invite.Processor {
+	return &p.invite
+}
+
 func (p *Processor) List() *list.Processor {
 	return &p.list
 }
@@ -171,6 +210,10 @@ func (p *Processor) Search() *search.Processor {
 	return &p.search
 }
 
+func (p *Processor) SoftMigration() *softmigration.Processor {
+	return &p.softMigration
+}
+
 func (p *Processor) Status() *status.Processor {
 	return &p.status
 }
@@ -187,6 +230,10 @@ func (p *Processor) Timeline() *timeline.Processor {
 	return &p.timeline
 }
 
+func (p *Processor) Trends() *trends.Processor {
+	return &p.trends
+}
+
 func (p *Processor) User() *user.Processor {
 	return &p.user
 }
@@ -207,6 +254,8 @@ func NewProcessor(
 	state *state.State,
 	emailSender email.Sender,
 	webPushSender webpush.Sender,
+	translator translate.Translator,
+	describer describe.Describer,
 	visFilter *visibility.Filter,
 	muteFilter *mutes.Filter,
 	intFilter *interaction.Filter,
@@ -227,28 +276,32 @@ func NewProcessor(
 	surfacer := surfacing.New(state, converter, &processor.stream, visFilter, muteFilter, statusFilter, emailSender, webPushSender, &processor.conversations)
 	common := common.New(state, mediaManager, converter, federator, visFilter, muteFilter, statusFilter, surfacer)
 	processor.account = account.New(&common, state, converter, mediaManager, federator, visFilter, statusFilter, parseMentionFunc)
-	processor.media = media.New(&common, state, converter, federator, mediaManager, federator.TransportController())
+	processor.media = media.New(&common, state, converter, federator, mediaManager, federator.TransportController(), describer)
 	filterCommon := filterCommon.New(state, &processor.stream)
 
 	// Instantiate the rest of the sub
 	// processors + pin them to this struct.
 	processor.account = account.New(&common, state, converter, mediaManager, federator, visFilter, statusFilter, parseMentionFunc)
 	processor.admin = admin.New(&common, state, cleaner, subscriptions, federator, converter, mediaManager, federator.TransportController(), emailSender)
+	processor.announcements = announcements.New(state, converter, &processor.stream, parseMentionFunc)
 	processor.application = application.New(state, converter)
 	processor.fedi = fedi.New(state, &common, converter, federator, visFilter)
 	processor.filtersv1 = filtersv1.New(state, converter, filterCommon)
 	processor.filtersv2 = filtersv2.New(state, converter, filterCommon)
 	processor.interactionRequests = interactionrequests.New(&common, state, converter)
-	processor.list = list.New(state, converter)
+	processor.invite = invite.New(state, converter)
+	processor.list = list.New(state, converter, &processor.stream)
 	processor.markers = markers.New(state, converter)
 	processor.polls = polls.New(&common, state, converter)
 	processor.push = push.New(state, converter)
 	processor.report = report.New(state, converter)
 	processor.tags = tags.New(state, converter)
 	processor.timeline = timeline.New(state, converter, visFilter, muteFilter, statusFilter)
+	processor.trends = trends.New(state, converter)
 	processor.search = search.New(state, federator, converter, visFilter, surfacer)
-	processor.status = status.New(state, &common, &processor.polls, &processor.interactionRequests, federator, converter, visFilter, muteFilter, statusFilter, intFilter, parseMentionFunc)
+	processor.status = status.New(state, &common, &processor.polls, &processor.interactionRequests, federator, converter, visFilter, muteFilter, statusFilter, intFilter, parseMentionFunc, translator)
 	processor.user = user.New(state, converter, oauthServer, emailSender)
+	processor.softMigration = softmigration.New(state, federator, converter, &processor.account, &processor.status)
 
 	// The advanced migrations processor sequences advanced migrations from all other processors.
 	processor.advancedmigrations = advancedmigrations.New(&processor.conversations)
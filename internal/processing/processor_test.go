@@ -131,6 +131,8 @@ func (suite *ProcessingStandardTestSuite) SetupTest() {
 		&suite.state,
 		suite.emailSender,
 		testrig.NewNoopWebPushSender(),
+		testrig.NewTestTranslator(),
+		testrig.NewTestDescriber(),
 		visibility.NewFilter(&suite.state),
 		mutes.NewFilter(&suite.state),
 		interaction.NewFilter(&suite.state),
@@ -80,6 +80,7 @@ func alertsToNotificationFlags(alerts *apimodel.WebPushSubscriptionAlerts) gtsmo
 	n.Set(gtsmodel.NotificationPendingFave, alerts.PendingFavourite)
 	n.Set(gtsmodel.NotificationPendingReply, alerts.PendingReply)
 	n.Set(gtsmodel.NotificationPendingReblog, alerts.PendingReblog)
+	n.Set(gtsmodel.NotificationThreadReply, alerts.ThreadReply)
 
 	return n
 }
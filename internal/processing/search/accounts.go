@@ -140,6 +140,7 @@ func (p *Processor) Accounts(
 			offset,
 			query,
 			following,
+			false, // notes not supported here
 			appendAccount,
 		); err != nil && !errors.Is(err, db.ErrNoEntries) {
 			err = gtserror.Newf("error searching by text: %w", err)
@@ -70,6 +70,7 @@ func (p *Processor) Get(
 		queryType     = strings.TrimSpace(strings.ToLower(req.QueryType)) // Trim trailing/leading whitespace; convert to lowercase.
 		resolve       = req.Resolve
 		following     = req.Following
+		notes         = req.Notes
 		fromAccountID = req.AccountID
 
 		// Include instance accounts in the first
@@ -115,6 +116,7 @@ func (p *Processor) Get(
 			{"queryType", queryType},
 			{"resolve", resolve},
 			{"following", following},
+			{"notes", notes},
 			{"fromAccountID", fromAccountID},
 		}...).
 		Debugf("beginning search")
@@ -311,6 +313,7 @@ func (p *Processor) Get(
 		query,
 		queryType,
 		following,
+		notes,
 		fromAccountID,
 		appendAccount,
 		appendStatus,
@@ -368,6 +371,7 @@ func (p *Processor) accountsByUsernameDomain(
 			// an account by its username.
 			"@"+username,
 			following,
+			false, // notes only apply to text search
 			appendAccount,
 		)
 	}
@@ -410,7 +414,7 @@ func (p *Processor) accountByUsernameDomain(
 	resolve bool,
 ) (*gtsmodel.Account, error) {
 	var usernameDomain string
-	if domain == "" || domain == config.GetHost() || domain == config.GetAccountDomain() {
+	if domain == "" || config.IsLocalAccountAddress(username, domain) {
 		// Local lookup, normalize domain.
 		domain = ""
 		usernameDomain = username
@@ -771,6 +775,7 @@ func (p *Processor) byText(
 	query string,
 	queryType string,
 	following bool,
+	notes bool,
 	fromAccountID string,
 	appendAccount func(*gtsmodel.Account),
 	appendStatus func(*gtsmodel.Status),
@@ -793,6 +798,7 @@ func (p *Processor) byText(
 			offset,
 			query,
 			following,
+			notes,
 			appendAccount,
 		); err != nil {
 			return err
@@ -829,12 +835,13 @@ func (p *Processor) accountsByText(
 	offset int,
 	query string,
 	following bool,
+	notes bool,
 	appendAccount func(*gtsmodel.Account),
 ) error {
 	accounts, err := p.state.DB.SearchForAccounts(
 		ctx,
 		requestingAccountID,
-		query, maxID, minID, limit, following, offset)
+		query, maxID, minID, limit, following, notes, offset)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return gtserror.Newf("error checking database for accounts using text %s: %w", query, err)
 	}
@@ -0,0 +1,286 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+const (
+	// sitemapXMLNS is the xml namespace
+	// of sitemaps and sitemap indexes.
+	sitemapXMLNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+	// sitemapMaxURLs is the maximum number of
+	// urls allowed in one sitemap by the protocol.
+	sitemapMaxURLs = 50000
+
+	// sitemapStatusesPerAccount is the number of recent
+	// statuses of each account to include in the sitemap.
+	sitemapStatusesPerAccount = 20
+
+	// sitemapAccountsBatch is the number of
+	// accounts to select from the db at once.
+	sitemapAccountsBatch = 100
+)
+
+// sitemapPages is a generated
+// sitemap, split into pages of
+// at most sitemapMaxURLs each.
+type sitemapPages struct {
+	pages     [][]apimodel.SitemapURL
+	generated time.Time
+}
+
+// SitemapScheduleGeneration schedules regeneration of the
+// cached sitemap every `instance-sitemap-generate-every`.
+// If this value is 0, the sitemap is disabled, and no
+// regeneration will be scheduled.
+func (p *Processor) SitemapScheduleGeneration() error {
+	every := config.GetInstanceSitemapGenerateEvery()
+	if every <= 0 {
+		log.Info(nil, "instance-sitemap-generate-every set to 0, not scheduling sitemap generation")
+		return nil
+	}
+
+	fn := func(ctx context.Context, start time.Time) error {
+		// Generation may make many db calls, so
+		// rather than blocking the scheduler, push
+		// it onto the low priority processing queue.
+		p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+			if err := p.SitemapGenerate(ctx); err != nil {
+				log.Errorf(ctx, "error generating sitemap: %v", err)
+				return
+			}
+			log.Infof(ctx, "finished generating sitemap after %s", time.Since(start))
+		})
+
+		// Errors generating are
+		// logged by the queued task.
+		return nil
+	}
+
+	log.Infof(nil, "scheduling sitemap generation to run every %s", every)
+
+	// Schedule generation to start after one period has
+	// elapsed; until then, the sitemap is generated lazily
+	// on the first request for it.
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@sitemapgenerate",
+		time.Now().Add(every),
+		every,
+		fn,
+	) {
+		panic("failed to schedule @sitemapgenerate")
+	}
+
+	return nil
+}
+
+// SitemapGenerate generates a sitemap of the web profiles
+// of local accounts that are both discoverable and indexable,
+// and the recent top-level public posts of those accounts,
+// replacing the currently cached sitemap.
+//
+// Accounts that haven't opted in to being indexed are never
+// included, so as not to point crawlers at their pages.
+func (p *Processor) SitemapGenerate(ctx context.Context) error {
+	p.sitemapMu.Lock()
+	defer p.sitemapMu.Unlock()
+	return p.sitemapGenerate(ctx)
+}
+
+// sitemapGenerate implements SitemapGenerate,
+// expecting the caller to hold sitemapMu.
+func (p *Processor) sitemapGenerate(ctx context.Context) error {
+	var (
+		urls    []apimodel.SitemapURL
+		sinceID string
+	)
+
+	for {
+		accounts, err := p.state.DB.GetIndexableLocalAccounts(ctx, sinceID, sitemapAccountsBatch)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting indexable accounts: %w", err)
+		}
+
+		if len(accounts) == 0 {
+			// Reached the end.
+			break
+		}
+
+		for _, account := range accounts {
+			accountURLs, err := p.sitemapAccountURLs(ctx, account)
+			if err != nil {
+				return err
+			}
+
+			urls = append(urls, accountURLs...)
+		}
+
+		sinceID = accounts[len(accounts)-1].ID
+	}
+
+	// Split urls into pages,
+	// always having at least
+	// one (maybe empty) page.
+	pages := make([][]apimodel.SitemapURL, 0, 1+len(urls)/sitemapMaxURLs)
+	for {
+		n := min(len(urls), sitemapMaxURLs)
+		pages = append(pages, urls[:n])
+		urls = urls[n:]
+		if len(urls) == 0 {
+			break
+		}
+	}
+
+	p.sitemap.Store(&sitemapPages{
+		pages:     pages,
+		generated: time.Now(),
+	})
+
+	return nil
+}
+
+// sitemapAccountURLs returns sitemap urls for the web profile
+// of the given account, and its recent top-level web statuses.
+func (p *Processor) sitemapAccountURLs(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) ([]apimodel.SitemapURL, error) {
+	statuses, err := p.state.DB.GetAccountWebStatuses(ctx,
+		account,
+		&paging.Page{Limit: sitemapStatusesPerAccount},
+		false, // mediaOnly
+		false, // includeBoosts
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting web statuses for account %s: %w", account.ID, err)
+	}
+
+	// The profile page shows the account's
+	// recent posts, so take the newest of
+	// those as profile modification time.
+	lastMod := account.UpdatedAt
+	if len(statuses) != 0 {
+		if updated := statuses[0].UpdatedAt(); updated.After(lastMod) {
+			lastMod = updated
+		}
+	}
+
+	urls := make([]apimodel.SitemapURL, 0, 1+len(statuses))
+	urls = append(urls, apimodel.SitemapURL{
+		Loc:     account.URL,
+		LastMod: util.FormatISO8601(lastMod),
+	})
+
+	for _, status := range statuses {
+		if status.InReplyToID != "" {
+			// Skip replies, their thread pages are
+			// only indexable if every account in
+			// the thread is, so leave them be.
+			continue
+		}
+
+		urls = append(urls, apimodel.SitemapURL{
+			Loc:     status.URL,
+			LastMod: util.FormatISO8601(status.UpdatedAt()),
+		})
+	}
+
+	return urls, nil
+}
+
+// SitemapGet returns the cached sitemap, generating it first
+// if it hasn't been generated yet.
+//
+// If page is 0, then either the only page of the sitemap is
+// returned as *apimodel.Sitemap, or, if the sitemap has more
+// than one page, an *apimodel.SitemapIndex linking to each.
+//
+// If page is > 0, then that (1-indexed) page of the
+// sitemap is returned as *apimodel.Sitemap.
+func (p *Processor) SitemapGet(ctx context.Context, page int) (any, gtserror.WithCode) {
+	if config.GetInstanceSitemapGenerateEvery() <= 0 {
+		const text = "sitemap is not enabled on this instance"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	sitemap := p.sitemap.Load()
+	if sitemap == nil {
+		p.sitemapMu.Lock()
+
+		// Check again now we hold the lock,
+		// in case of concurrent generation.
+		if sitemap = p.sitemap.Load(); sitemap == nil {
+			if err := p.sitemapGenerate(ctx); err != nil {
+				p.sitemapMu.Unlock()
+				err := gtserror.Newf("error generating sitemap: %w", err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+			sitemap = p.sitemap.Load()
+		}
+
+		p.sitemapMu.Unlock()
+	}
+
+	switch {
+	case page == 0 && len(sitemap.pages) == 1:
+		// Just serve the
+		// single page.
+		page = 1
+
+	case page == 0:
+		// Serve index linking to each page.
+		lastMod := util.FormatISO8601(sitemap.generated)
+		index := &apimodel.SitemapIndex{
+			XMLNS:    sitemapXMLNS,
+			Sitemaps: make([]apimodel.SitemapURL, len(sitemap.pages)),
+		}
+
+		for i := range sitemap.pages {
+			index.Sitemaps[i] = apimodel.SitemapURL{
+				Loc:     config.GetProtocol() + "://" + config.GetHost() + "/sitemap.xml?page=" + strconv.Itoa(i+1),
+				LastMod: lastMod,
+			}
+		}
+
+		return index, nil
+
+	case page > len(sitemap.pages):
+		const text = "sitemap page not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return &apimodel.Sitemap{
+		XMLNS: sitemapXMLNS,
+		URLs:  sitemap.pages[page-1],
+	}, nil
+}
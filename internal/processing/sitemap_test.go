@@ -0,0 +1,122 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type SitemapTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *SitemapTestSuite) getSitemapLocs() []string {
+	resp, errWithCode := suite.processor.SitemapGet(suite.T().Context(), 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Test instance is small enough
+	// to fit in a single sitemap page.
+	sitemap, ok := resp.(*apimodel.Sitemap)
+	if !ok {
+		suite.FailNowf("unexpected sitemap type", "%T", resp)
+	}
+
+	locs := make([]string, len(sitemap.URLs))
+	for i, url := range sitemap.URLs {
+		suite.NotEmpty(url.LastMod)
+		locs[i] = url.Loc
+	}
+
+	return locs
+}
+
+func (suite *SitemapTestSuite) TestSitemapGet() {
+	locs := suite.getSitemapLocs()
+
+	// Profiles of indexable accounts should be included.
+	suite.Contains(locs, suite.testAccounts["admin_account"].URL)
+	suite.Contains(locs, suite.testAccounts["local_account_1"].URL)
+
+	// As well as their top-level public posts.
+	suite.Contains(locs, suite.testStatuses["local_account_1_status_1"].URL)
+
+	// But nothing of accounts that aren't indexable.
+	notIndexable := suite.testAccounts["local_account_2"]
+	for _, loc := range locs {
+		suite.False(strings.HasPrefix(loc, notIndexable.URL), loc)
+	}
+}
+
+func (suite *SitemapTestSuite) TestSitemapGetCached() {
+	var (
+		ctx    = suite.T().Context()
+		status = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	suite.Contains(suite.getSitemapLocs(), status.URL)
+
+	// Delete the status from the db.
+	if err := suite.state.DB.DeleteStatusByID(ctx, status.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Sitemap is cached, so
+	// status should still be there.
+	suite.Contains(suite.getSitemapLocs(), status.URL)
+
+	// Regenerate the sitemap,
+	// now status should be gone.
+	if err := suite.processor.SitemapGenerate(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotContains(suite.getSitemapLocs(), status.URL)
+}
+
+func (suite *SitemapTestSuite) TestSitemapGetPage() {
+	ctx := suite.T().Context()
+
+	// First page is the only page.
+	resp, errWithCode := suite.processor.SitemapGet(ctx, 1)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.IsType(&apimodel.Sitemap{}, resp)
+
+	// Second page doesn't exist.
+	_, errWithCode = suite.processor.SitemapGet(ctx, 2)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *SitemapTestSuite) TestSitemapGetDisabled() {
+	config.SetInstanceSitemapGenerateEvery(0)
+
+	_, errWithCode := suite.processor.SitemapGet(suite.T().Context(), 0)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestSitemapTestSuite(t *testing.T) {
+	suite.Run(t, new(SitemapTestSuite))
+}
@@ -0,0 +1,258 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package softmigration
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/url"
+	"slices"
+	"strings"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/federation"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/account"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/status"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// Processor wraps functionality for "soft" migration from an
+// aliased (old) account to the requesting (new) account, as an
+// alternative to a full Move: announcing the new account to
+// followers of the old one, and following from the new account
+// the same accounts that the old account follows.
+//
+// Unlike a Move, the old account is left untouched, and
+// followers are not automatically transferred across.
+type Processor struct {
+	state     *state.State
+	federator *federation.Federator
+	converter *typeutils.Converter
+
+	// other processors
+	account *account.Processor
+	status  *status.Processor
+}
+
+// New returns a new soft migration processor.
+func New(
+	state *state.State,
+	federator *federation.Federator,
+	converter *typeutils.Converter,
+	account *account.Processor,
+	status *status.Processor,
+) Processor {
+	return Processor{
+		state:     state,
+		federator: federator,
+		converter: converter,
+		account:   account,
+		status:    status,
+	}
+}
+
+// AliasesGet returns each of the requester's aliases, and which
+// steps of a soft migration from that alias can be performed.
+func (p *Processor) AliasesGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]*apimodel.SoftMigrationAlias, gtserror.WithCode) {
+	aliases := make([]*apimodel.SoftMigrationAlias, 0, len(requester.AlsoKnownAsURIs))
+
+	for _, uri := range requester.AlsoKnownAsURIs {
+		alias, errWithCode := p.getAlias(ctx, requester, uri)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, alias)
+		if err != nil {
+			err := gtserror.Newf("error converting account %s: %w", alias.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		verified := isVerified(requester, alias)
+		aliases = append(aliases, &apimodel.SoftMigrationAlias{
+			Account:           apiAccount,
+			Verified:          verified,
+			CanFetchFollowing: verified && alias.IsLocal(),
+		})
+	}
+
+	return aliases, nil
+}
+
+// NoticeCreate posts a public status from the requester, mentioning
+// the aliased account, to let followers of the old account know
+// where to find the requester now. The mention ensures the notice
+// also lands in the old account's notifications, so it can easily
+// be boosted from there to reach the old account's followers.
+func (p *Processor) NoticeCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	form *apimodel.SoftMigrationNoticeRequest,
+) (*apimodel.Status, gtserror.WithCode) {
+	alias, errWithCode := p.getAlias(ctx, requester, form.AlsoKnownAsURI)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Mention the alias by namestring.
+	mention := "@" + alias.Username
+	if !alias.IsLocal() {
+		mention += "@" + alias.Domain
+	}
+
+	text := strings.TrimSpace(form.Status)
+	if text == "" {
+		text = fmt.Sprintf(
+			"I've moved here from %s! If you followed me there, please follow me here too.",
+			mention,
+		)
+	} else if !strings.Contains(text, mention) {
+		// Ensure the alias is mentioned
+		// so it's delivered to them.
+		text += "\n\n" + mention
+	}
+
+	apiStatus, errWithCode := p.status.Create(ctx, requester, application, &apimodel.StatusCreateRequest{
+		Status:      text,
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		ContentType: apimodel.StatusContentTypePlain,
+		Language:    requester.Settings.Language,
+	}, nil)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return apiStatus.(*apimodel.Status), nil
+}
+
+// FollowingImport follows, from the requester, the accounts followed by
+// the aliased account. These are fetched directly if the alias is a local,
+// verified account, else they're read from the uploaded following CSV
+// exported from the alias. Follows are created in the background.
+func (p *Processor) FollowingImport(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.SoftMigrationFollowingRequest,
+) gtserror.WithCode {
+	alias, errWithCode := p.getAlias(ctx, requester, form.AlsoKnownAsURI)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	var records [][]string
+
+	switch {
+	case form.Data != nil:
+		// Read records out of the uploaded file.
+		records, errWithCode = readCSV(form.Data)
+		if errWithCode != nil {
+			return errWithCode
+		}
+
+	case alias.IsLocal() && isVerified(requester, alias):
+		// Alias is ours and confirmed to be
+		// controlled by the same person, so
+		// we can fetch its following directly.
+		records, errWithCode = p.account.ExportFollowing(ctx, alias)
+		if errWithCode != nil {
+			return errWithCode
+		}
+
+	default:
+		const text = "following of this alias can't be fetched by this instance; " +
+			"please upload a following CSV exported from it instead"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Merge, never overwrite, the requester's existing follows.
+	return p.account.ImportFollowingRecords(ctx, requester, records, false)
+}
+
+// getAlias returns the account at given URI,
+// ensuring it's one of the requester's aliases.
+func (p *Processor) getAlias(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	uriStr string,
+) (*gtsmodel.Account, gtserror.WithCode) {
+	if !slices.Contains(requester.AlsoKnownAsURIs, uriStr) {
+		err := fmt.Errorf(
+			"%s is not an alias of your account; add it to your also_known_as_uris first",
+			uriStr,
+		)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	uri, err := url.Parse(uriStr)
+	if err != nil {
+		err := gtserror.Newf("invalid alias uri %s: %w", uriStr, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Get the alias, refreshing if
+	// necessary to get its latest
+	// alsoKnownAs for verification.
+	alias, _, err := p.federator.GetAccountByURI(ctx,
+		requester.Username,
+		uri,
+		false,
+	)
+	if err != nil {
+		err := fmt.Errorf("error dereferencing alias %s: %w", uriStr, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return alias, nil
+}
+
+// isVerified returns whether alias has the requester
+// as an alias in turn, meaning they're controlled by
+// the same person.
+func isVerified(requester *gtsmodel.Account, alias *gtsmodel.Account) bool {
+	return slices.Contains(alias.AlsoKnownAsURIs, requester.URI)
+}
+
+// readCSV reads all records from the given CSV file.
+func readCSV(data *multipart.FileHeader) ([][]string, gtserror.WithCode) {
+	file, err := data.Open()
+	if err != nil {
+		err := fmt.Errorf("error opening following data file: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		err := fmt.Errorf("error reading following data file: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return records, nil
+}
@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type SoftMigrationTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+// setAliases aliases account to each of
+// the given accounts, and stores it.
+func (suite *SoftMigrationTestSuite) setAliases(account *gtsmodel.Account, aliases ...*gtsmodel.Account) {
+	account.AlsoKnownAsURIs = nil
+	for _, alias := range aliases {
+		account.AlsoKnownAsURIs = append(account.AlsoKnownAsURIs, alias.URI)
+	}
+
+	if err := suite.db.UpdateAccount(suite.T().Context(), account, "also_known_as_uris"); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *SoftMigrationTestSuite) TestSoftMigration() {
+	ctx := suite.T().Context()
+
+	// Turtle is moving softly from zork, who aliases turtle back.
+	newAccount := new(gtsmodel.Account)
+	*newAccount = *suite.testAccounts["local_account_2"]
+	oldAccount := new(gtsmodel.Account)
+	*oldAccount = *suite.testAccounts["local_account_1"]
+	suite.setAliases(newAccount, oldAccount)
+	suite.setAliases(oldAccount, newAccount)
+
+	aliases, errWithCode := suite.processor.SoftMigration().AliasesGet(ctx, newAccount)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if suite.Len(aliases, 1) {
+		suite.Equal(oldAccount.ID, aliases[0].Account.ID)
+		suite.True(aliases[0].Verified)
+		suite.True(aliases[0].CanFetchFollowing)
+	}
+
+	// Post the default notice.
+	apiStatus, errWithCode := suite.processor.SoftMigration().NoticeCreate(ctx,
+		newAccount,
+		suite.testApplications["application_1"],
+		&apimodel.SoftMigrationNoticeRequest{
+			AlsoKnownAsURI: oldAccount.URI,
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(apimodel.VisibilityPublic, apiStatus.Visibility)
+	if suite.Len(apiStatus.Mentions, 1) {
+		suite.Equal(oldAccount.ID, apiStatus.Mentions[0].ID)
+	}
+
+	// Import follows; zork follows admin, turtle doesn't yet.
+	adminAccount := suite.testAccounts["admin_account"]
+	errWithCode = suite.processor.SoftMigration().FollowingImport(ctx,
+		newAccount,
+		&apimodel.SoftMigrationFollowingRequest{
+			AlsoKnownAsURI: oldAccount.URI,
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if !suite.Eventually(func() bool {
+		following, err := suite.db.IsFollowing(ctx, newAccount.ID, adminAccount.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return following
+	}, 10*time.Second, 10*time.Millisecond) {
+		suite.FailNow("timed out waiting for follow")
+	}
+}
+
+func (suite *SoftMigrationTestSuite) TestSoftMigrationNotVerified() {
+	ctx := suite.T().Context()
+
+	// Turtle aliases zork, but zork doesn't alias turtle back.
+	newAccount := new(gtsmodel.Account)
+	*newAccount = *suite.testAccounts["local_account_2"]
+	oldAccount := suite.testAccounts["local_account_1"]
+	suite.setAliases(newAccount, oldAccount)
+
+	aliases, errWithCode := suite.processor.SoftMigration().AliasesGet(ctx, newAccount)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if suite.Len(aliases, 1) {
+		suite.False(aliases[0].Verified)
+		suite.False(aliases[0].CanFetchFollowing)
+	}
+
+	// Zork's follows can't be fetched without an upload.
+	errWithCode = suite.processor.SoftMigration().FollowingImport(ctx,
+		newAccount,
+		&apimodel.SoftMigrationFollowingRequest{
+			AlsoKnownAsURI: oldAccount.URI,
+		},
+	)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	}
+
+	// Not an alias at all.
+	_, errWithCode = suite.processor.SoftMigration().NoticeCreate(ctx,
+		newAccount,
+		suite.testApplications["application_1"],
+		&apimodel.SoftMigrationNoticeRequest{
+			AlsoKnownAsURI: suite.testAccounts["admin_account"].URI,
+		},
+	)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	}
+}
+
+func TestSoftMigrationTestSuite(t *testing.T) {
+	suite.Run(t, new(SoftMigrationTestSuite))
+}
@@ -20,6 +20,7 @@
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
@@ -30,14 +31,43 @@
 )
 
 // BookmarkCreate adds a bookmark for the requestingAccount, targeting the given status (no-op if bookmark already exists).
-func (p *Processor) BookmarkCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+//
+// If folderID is set, the bookmark is filed in the bookmark folder with that ID, which must be owned by
+// requestingAccount; an existing bookmark is moved into the folder. Otherwise, a new bookmark is filed in
+// the default folder, and an existing bookmark is left where it is.
+func (p *Processor) BookmarkCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, folderID string) (*apimodel.Status, gtserror.WithCode) {
 	targetStatus, existing, errWithCode := p.getBookmarkableStatus(ctx, requestingAccount, targetStatusID)
 	if errWithCode != nil {
 		return nil, errWithCode
 	}
 
+	if folderID != "" {
+		// Ensure folder exists and is owned by requester.
+		folder, err := p.state.DB.GetBookmarkFolderByID(ctx, folderID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error getting bookmark folder: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if folder == nil || folder.AccountID != requestingAccount.ID {
+			err := fmt.Errorf("bookmark folder %s not found", folderID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+	}
+
 	if existing != nil {
-		// Status is already bookmarked.
+		if folderID == "" || existing.FolderID == folderID {
+			// Status is already bookmarked.
+			return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
+		}
+
+		// Move existing bookmark into the given folder.
+		existing.FolderID = folderID
+		if err := p.state.DB.UpdateStatusBookmark(ctx, existing, "folder_id"); err != nil {
+			err = gtserror.Newf("error updating bookmark in database: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
 		return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
 	}
 
@@ -50,6 +80,7 @@ func (p *Processor) BookmarkCreate(ctx context.Context, requestingAccount *gtsmo
 		TargetAccount:   targetStatus.Account,
 		StatusID:        targetStatus.ID,
 		Status:          targetStatus,
+		FolderID:        folderID,
 	}
 
 	if err := p.state.DB.PutStatusBookmark(ctx, gtsBookmark); err != nil {
@@ -18,8 +18,11 @@
 package status_test
 
 import (
+	"net/http"
 	"testing"
 
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -34,7 +37,7 @@ func (suite *StatusBookmarkTestSuite) TestBookmark() {
 	bookmarkingAccount1 := suite.testAccounts["local_account_1"]
 	targetStatus1 := suite.testStatuses["admin_account_status_1"]
 
-	bookmark1, err := suite.status.BookmarkCreate(ctx, bookmarkingAccount1, targetStatus1.ID)
+	bookmark1, err := suite.status.BookmarkCreate(ctx, bookmarkingAccount1, targetStatus1.ID, "")
 	suite.NoError(err)
 	suite.NotNil(bookmark1)
 	suite.True(bookmark1.Bookmarked)
@@ -48,7 +51,7 @@ func (suite *StatusBookmarkTestSuite) TestUnbookmark() {
 	bookmarkingAccount1 := suite.testAccounts["local_account_1"]
 	targetStatus1 := suite.testStatuses["admin_account_status_1"]
 
-	bookmark1, err := suite.status.BookmarkCreate(ctx, bookmarkingAccount1, targetStatus1.ID)
+	bookmark1, err := suite.status.BookmarkCreate(ctx, bookmarkingAccount1, targetStatus1.ID, "")
 	suite.NoError(err)
 	suite.NotNil(bookmark1)
 	suite.True(bookmark1.Bookmarked)
@@ -61,6 +64,60 @@ func (suite *StatusBookmarkTestSuite) TestUnbookmark() {
 	suite.Equal(targetStatus1.ID, bookmark1.ID)
 }
 
+func (suite *StatusBookmarkTestSuite) TestBookmarkIntoFolder() {
+	ctx := suite.T().Context()
+
+	bookmarkingAccount1 := suite.testAccounts["local_account_1"]
+	targetStatus1 := suite.testStatuses["admin_account_status_1"]
+
+	folder := &gtsmodel.BookmarkFolder{
+		ID:        id.NewULID(),
+		Title:     "cool posts",
+		AccountID: bookmarkingAccount1.ID,
+	}
+	if err := suite.db.PutBookmarkFolder(ctx, folder); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Bookmark into default folder.
+	bookmark1, err := suite.status.BookmarkCreate(ctx, bookmarkingAccount1, targetStatus1.ID, "")
+	suite.NoError(err)
+	suite.True(bookmark1.Bookmarked)
+
+	dbBookmark, dbErr := suite.db.GetStatusBookmark(ctx, bookmarkingAccount1.ID, targetStatus1.ID)
+	if dbErr != nil {
+		suite.FailNow(dbErr.Error())
+	}
+	suite.Empty(dbBookmark.FolderID)
+
+	// Bookmarking again into the
+	// folder should move the bookmark.
+	bookmark1, err = suite.status.BookmarkCreate(ctx, bookmarkingAccount1, targetStatus1.ID, folder.ID)
+	suite.NoError(err)
+	suite.True(bookmark1.Bookmarked)
+
+	dbBookmark, dbErr = suite.db.GetStatusBookmark(ctx, bookmarkingAccount1.ID, targetStatus1.ID)
+	if dbErr != nil {
+		suite.FailNow(dbErr.Error())
+	}
+	suite.Equal(folder.ID, dbBookmark.FolderID)
+
+	// Bookmarking again without a folder
+	// should leave the bookmark where it is.
+	_, err = suite.status.BookmarkCreate(ctx, bookmarkingAccount1, targetStatus1.ID, "")
+	suite.NoError(err)
+
+	dbBookmark, dbErr = suite.db.GetStatusBookmark(ctx, bookmarkingAccount1.ID, targetStatus1.ID)
+	if dbErr != nil {
+		suite.FailNow(dbErr.Error())
+	}
+	suite.Equal(folder.ID, dbBookmark.FolderID)
+
+	// Another account can't use this folder.
+	_, err = suite.status.BookmarkCreate(ctx, suite.testAccounts["local_account_2"], targetStatus1.ID, folder.ID)
+	suite.Equal(http.StatusNotFound, err.Code())
+}
+
 func TestStatusBookmarkTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusBookmarkTestSuite))
 }
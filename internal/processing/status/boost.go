@@ -79,7 +79,10 @@ func (p *Processor) BoostCreate(
 	if policyResult.Forbidden() {
 		const errText = "you do not have permission to boost this status"
 		err := gtserror.New(errText)
-		return nil, gtserror.NewErrorForbidden(err, errText)
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorForbidden(err, errText),
+			gtserror.ErrCodeInteractionRejected,
+		)
 	}
 
 	// Status is visible and boostable
@@ -0,0 +1,402 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// cleanups tracks the currently running
+// status cleanups, keyed by account ID,
+// as only one may run per account.
+type cleanups struct {
+	mu      sync.Mutex
+	running map[string]runningCleanup
+}
+
+// runningCleanup is the ID of a
+// running cleanup, and its cancel.
+type runningCleanup struct {
+	id     string
+	cancel context.CancelFunc
+}
+
+// CleanupCreate starts a background cleanup of the requester's
+// own statuses older than the given form's minimum age, either
+// deleting or unlisting each of them in turn, paced to avoid
+// flooding other instances with deliveries.
+func (p *Processor) CleanupCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.StatusCleanupRequest,
+) (*apimodel.StatusCleanup, gtserror.WithCode) {
+	action := gtsmodel.ParseStatusCleanupAction(form.Action)
+	if action == gtsmodel.StatusCleanupUnknown {
+		const text = "action must be one of delete or unlist"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if form.MinAge <= 0 {
+		const text = "min_age must be a positive number of seconds"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	cleanup := &gtsmodel.StatusCleanup{
+		ID:        id.NewULID(),
+		AccountID: requester.ID,
+		Action:    action,
+		OlderThan: time.Now().Add(-time.Duration(form.MinAge) * time.Second),
+	}
+
+	// Count statuses to clean up, for progress.
+	total, err := p.state.DB.CountAccountStatusesBefore(ctx,
+		requester.ID,
+		cleanupMaxID(cleanup),
+		action == gtsmodel.StatusCleanupUnlist,
+	)
+	if err != nil {
+		err := gtserror.Newf("db error counting statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	cleanup.Total = total
+
+	if errWithCode := p.startCleanup(ctx, cleanup, true); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.StatusCleanupToAPIStatusCleanup(cleanup), nil
+}
+
+// CleanupsGet returns all of the requester's status cleanups, newest first.
+func (p *Processor) CleanupsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]*apimodel.StatusCleanup, gtserror.WithCode) {
+	cleanups, err := p.state.DB.GetStatusCleanupsByAccountID(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status cleanups: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiCleanups := make([]*apimodel.StatusCleanup, 0, len(cleanups))
+	for _, cleanup := range cleanups {
+		apiCleanups = append(apiCleanups, p.converter.StatusCleanupToAPIStatusCleanup(cleanup))
+	}
+
+	return apiCleanups, nil
+}
+
+// CleanupGet returns the requester's status cleanup with the given ID.
+func (p *Processor) CleanupGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	cleanupID string,
+) (*apimodel.StatusCleanup, gtserror.WithCode) {
+	cleanup, errWithCode := p.getOwnCleanup(ctx, requester, cleanupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.StatusCleanupToAPIStatusCleanup(cleanup), nil
+}
+
+// CleanupCancel stops the requester's running status cleanup with
+// the given ID. Statuses already cleaned up are left as they are.
+func (p *Processor) CleanupCancel(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	cleanupID string,
+) (*apimodel.StatusCleanup, gtserror.WithCode) {
+	cleanup, errWithCode := p.getOwnCleanup(ctx, requester, cleanupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if cleanup.IsCompleted() {
+		const text = "status cleanup already completed"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Stop the running cleanup, if
+	// any, before marking cancelled.
+	p.cleanups.mu.Lock()
+	if running, ok := p.cleanups.running[requester.ID]; ok && running.id == cleanup.ID {
+		running.cancel()
+		delete(p.cleanups.running, requester.ID)
+	}
+	p.cleanups.mu.Unlock()
+
+	// Refetch the cleanup, to
+	// get its latest progress.
+	cleanup, errWithCode = p.getOwnCleanup(ctx, requester, cleanupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	now := time.Now()
+	cleanup.CompletedAt = now
+	cleanup.CancelledAt = now
+	if err := p.state.DB.UpdateStatusCleanup(ctx, cleanup,
+		"completed_at",
+		"cancelled_at",
+	); err != nil {
+		err := gtserror.Newf("db error updating status cleanup: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.StatusCleanupToAPIStatusCleanup(cleanup), nil
+}
+
+// CleanupsResumeAll resumes all status cleanups that
+// were still running when the instance was stopped.
+func (p *Processor) CleanupsResumeAll(ctx context.Context) error {
+	log.Info(ctx, "resuming status cleanups...")
+
+	cleanups, err := p.state.DB.GetUncompletedStatusCleanups(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting uncompleted status cleanups from db: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, cleanup := range cleanups {
+		// Resume each of the cleanups and catch any errors.
+		if errWithCode := p.startCleanup(ctx, cleanup, false); errWithCode != nil {
+			errs.Append(errWithCode.Unwrap())
+		}
+	}
+
+	return errs.Combine()
+}
+
+// getOwnCleanup fetches the status cleanup with
+// given ID, ensuring it's owned by requester.
+func (p *Processor) getOwnCleanup(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	cleanupID string,
+) (*gtsmodel.StatusCleanup, gtserror.WithCode) {
+	cleanup, err := p.state.DB.GetStatusCleanupByID(ctx, cleanupID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status cleanup: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if cleanup == nil || cleanup.AccountID != requester.ID {
+		const text = "status cleanup not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return cleanup, nil
+}
+
+// startCleanup starts running the given status cleanup
+// in the background, optionally inserting it first. Only
+// one cleanup may run per account at a time.
+func (p *Processor) startCleanup(
+	ctx context.Context,
+	cleanup *gtsmodel.StatusCleanup,
+	insert bool,
+) gtserror.WithCode {
+	// Fetch the cleanup's account, with settings.
+	account, err := p.state.DB.GetAccountByID(ctx, cleanup.AccountID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account %s: %w", cleanup.AccountID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.cleanups.mu.Lock()
+	defer p.cleanups.mu.Unlock()
+
+	if running, ok := p.cleanups.running[cleanup.AccountID]; ok {
+		text := "status cleanup " + running.id + " is already running"
+		return gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	if insert {
+		if err := p.state.DB.PutStatusCleanup(ctx, cleanup); err != nil {
+			err := gtserror.Newf("db error putting status cleanup: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Use a background context with existing values,
+	// as the cleanup continues beyond this request.
+	runCtx, cancel := context.WithCancel(
+		gtscontext.WithValues(context.Background(), ctx),
+	)
+
+	p.cleanups.running[cleanup.AccountID] = runningCleanup{
+		id:     cleanup.ID,
+		cancel: cancel,
+	}
+
+	go func() {
+		p.runCleanup(runCtx, account, cleanup)
+
+		// Cleanup is no longer running, remove it,
+		// unless it was already removed by a cancel.
+		p.cleanups.mu.Lock()
+		if running, ok := p.cleanups.running[cleanup.AccountID]; ok && running.id == cleanup.ID {
+			delete(p.cleanups.running, cleanup.AccountID)
+		}
+		p.cleanups.mu.Unlock()
+
+		cancel()
+	}()
+
+	return nil
+}
+
+// runCleanup runs the given status cleanup of account's statuses,
+// until there's nothing left to clean up or the context is cancelled.
+func (p *Processor) runCleanup(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	cleanup *gtsmodel.StatusCleanup,
+) {
+	l := log.WithContext(ctx).
+		WithField("cleanup", cleanup.ID).
+		WithField("account", account.ID)
+
+	// Tick once per status, to pace deletes / updates
+	// so they don't all get delivered out at once.
+	ticker := time.NewTicker(config.GetStatusesCleanupInterval())
+	defer ticker.Stop()
+
+	// Start from the last processed
+	// status if we're resuming, else
+	// from the cleanup cutoff time.
+	maxID := cleanup.LastStatusID
+	if maxID == "" {
+		maxID = cleanupMaxID(cleanup)
+	}
+
+	unlist := cleanup.Action == gtsmodel.StatusCleanupUnlist
+
+	for {
+		// Fetch next batch of statuses to clean up.
+		statuses, err := p.state.DB.GetAccountStatuses(ctx,
+			account.ID,
+			20,     // limit
+			false,  // excludeReplies
+			true,   // excludeReblogs
+			maxID,  // maxID
+			"",     // minID
+			false,  // mediaOnly
+			unlist, // publicOnly
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			if ctx.Err() != nil {
+				// Cancelled or
+				// shutting down.
+				return
+			}
+			l.Errorf("db error getting statuses: %v", err)
+			break
+		}
+
+		if len(statuses) == 0 {
+			// Nothing
+			// left to do.
+			break
+		}
+
+		for _, status := range statuses {
+			select {
+			case <-ctx.Done():
+				// Cancelled or
+				// shutting down.
+				return
+			case <-ticker.C:
+			}
+
+			if err := p.cleanupStatus(ctx, account, cleanup.Action, status); err != nil {
+				l.Errorf("error cleaning up status %s: %v", status.ID, err)
+			}
+
+			// Update progress.
+			cleanup.Processed++
+			cleanup.LastStatusID = status.ID
+			if err := p.state.DB.UpdateStatusCleanup(ctx, cleanup,
+				"processed",
+				"last_status_id",
+			); err != nil {
+				l.Errorf("db error updating status cleanup: %v", err)
+			}
+		}
+
+		// Page down.
+		maxID = statuses[len(statuses)-1].ID
+	}
+
+	// Mark as completed.
+	cleanup.CompletedAt = time.Now()
+	if err := p.state.DB.UpdateStatusCleanup(ctx, cleanup, "completed_at"); err != nil {
+		l.Errorf("db error updating status cleanup: %v", err)
+	}
+}
+
+// cleanupStatus performs the given cleanup action on
+// account's status. Pinned statuses are always skipped.
+func (p *Processor) cleanupStatus(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	action gtsmodel.StatusCleanupAction,
+	status *gtsmodel.Status,
+) error {
+	if !status.PinnedAt.IsZero() {
+		// Leave pinned
+		// statuses alone.
+		return nil
+	}
+
+	switch action {
+	case gtsmodel.StatusCleanupDelete:
+		p.queueDelete(account, status)
+		return nil
+
+	case gtsmodel.StatusCleanupUnlist:
+		if !gtsmodel.VisibilityChangeable(status.Visibility, gtsmodel.VisibilityUnlocked) {
+			// Not public (anymore).
+			return nil
+		}
+		return p.setVisibility(ctx, account, status, gtsmodel.VisibilityUnlocked)
+
+	default:
+		return gtserror.Newf("unknown cleanup action %d", action)
+	}
+}
+
+// cleanupMaxID returns a status ID (exclusive) below
+// which statuses were created before cleanup cutoff.
+func cleanupMaxID(cleanup *gtsmodel.StatusCleanup) string {
+	return id.NewULIDFromTime(cleanup.OlderThan)
+}
@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusCleanupTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusCleanupTestSuite) TestCleanupUnlist() {
+	ctx := suite.T().Context()
+	config.SetStatusesCleanupInterval(time.Millisecond)
+
+	requester := suite.testAccounts["local_account_1"]
+
+	apiCleanup, errWithCode := suite.status.CleanupCreate(ctx, requester, &apimodel.StatusCleanupRequest{
+		Action: "unlist",
+		MinAge: 86400,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("unlist", apiCleanup.Action)
+	suite.Equal("running", apiCleanup.State)
+	suite.Equal(5, apiCleanup.Total)
+
+	// Wait for the cleanup to complete.
+	if !suite.Eventually(func() bool {
+		apiCleanup, errWithCode = suite.status.CleanupGet(ctx, requester, apiCleanup.ID)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+		return apiCleanup.State == "completed"
+	}, 10*time.Second, 10*time.Millisecond) {
+		suite.FailNow("timed out waiting for cleanup to complete")
+	}
+	suite.Equal(apiCleanup.Total, apiCleanup.Processed)
+
+	// None of the requester's old statuses should be public anymore.
+	_, err := suite.db.GetAccountStatuses(ctx, requester.ID, 0, false, false, "", "", false, true)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// But they should all still be there, unlisted.
+	status, err := suite.db.GetStatusByID(ctx, suite.testStatuses["local_account_1_status_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.VisibilityUnlocked, status.Visibility)
+}
+
+func (suite *StatusCleanupTestSuite) TestCleanupConflictCancel() {
+	ctx := suite.T().Context()
+
+	// Long interval so the cleanup stays running.
+	config.SetStatusesCleanupInterval(time.Hour)
+
+	requester := suite.testAccounts["local_account_1"]
+	form := &apimodel.StatusCleanupRequest{
+		Action: "delete",
+		MinAge: 86400,
+	}
+
+	apiCleanup, errWithCode := suite.status.CleanupCreate(ctx, requester, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Only one cleanup may run at a time.
+	_, errWithCode = suite.status.CleanupCreate(ctx, requester, form)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Someone else can't see or cancel it.
+	otherAccount := suite.testAccounts["local_account_2"]
+	_, errWithCode = suite.status.CleanupCancel(ctx, otherAccount, apiCleanup.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	apiCleanup, errWithCode = suite.status.CleanupCancel(ctx, requester, apiCleanup.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("cancelled", apiCleanup.State)
+	suite.Zero(apiCleanup.Processed)
+	suite.NotNil(apiCleanup.CompletedAt)
+
+	// Can't cancel twice.
+	_, errWithCode = suite.status.CleanupCancel(ctx, requester, apiCleanup.ID)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// And a new one can be started.
+	_, errWithCode = suite.status.CleanupCreate(ctx, requester, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	apiCleanups, errWithCode := suite.status.CleanupsGet(ctx, requester)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(apiCleanups, 2)
+	suite.Equal("running", apiCleanups[0].State)
+	suite.Equal("cancelled", apiCleanups[1].State)
+
+	// Tidy up the running cleanup.
+	if _, errWithCode := suite.status.CleanupCancel(ctx, requester, apiCleanups[0].ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
+func (suite *StatusCleanupTestSuite) TestCleanupInvalid() {
+	ctx := suite.T().Context()
+	requester := suite.testAccounts["local_account_1"]
+
+	for _, form := range []*apimodel.StatusCleanupRequest{
+		{Action: "explode", MinAge: 86400},
+		{Action: "delete", MinAge: 0},
+	} {
+		_, errWithCode := suite.status.CleanupCreate(ctx, requester, form)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	}
+}
+
+func TestStatusCleanupTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusCleanupTestSuite))
+}
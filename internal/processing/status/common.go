@@ -21,6 +21,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/xslices"
@@ -32,6 +33,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"code.superseriousbusiness.org/gotosocial/internal/validate"
 )
 
@@ -135,6 +137,22 @@ func processContentType(
 	}
 }
 
+// previewLinks returns whether to generate a link preview
+// card for a new status, preferring the value set in the
+// request, then the account default, then true.
+func previewLinks(
+	requestPreviewLinks *bool,
+	settings *gtsmodel.AccountSettings,
+) bool {
+	if requestPreviewLinks != nil {
+		return *requestPreviewLinks
+	}
+	if settings != nil {
+		return util.PtrOrValue(settings.PreviewLinks, true)
+	}
+	return true
+}
+
 func (p *Processor) processContent(
 	ctx context.Context,
 	author *gtsmodel.Account,
@@ -341,6 +359,33 @@ func (p *Processor) processMedia(
 	return attachments, nil
 }
 
+// processMediaDescriptions checks the given media attachments
+// all have a description, if this is required by the media
+// description policy of the instance, or of the requester.
+func processMediaDescriptions(
+	requester *gtsmodel.Account,
+	attachments []*gtsmodel.MediaAttachment,
+) gtserror.WithCode {
+	policy := gtsmodel.ParseDescriptionPolicy(config.GetMediaDescriptionPolicy())
+	if requester.Settings.DescriptionPolicyOr(policy) != gtsmodel.DescriptionPolicyRequire {
+		// Descriptions
+		// not required.
+		return nil
+	}
+
+	for _, media := range attachments {
+		if strings.TrimSpace(media.Description) == "" {
+			text := fmt.Sprintf("media description required: %s", media.ID)
+			return gtserror.WithErrCode(
+				gtserror.NewErrorUnprocessableEntity(errors.New(text), text),
+				gtserror.ErrCodeMediaDescriptionRequired,
+			)
+		}
+	}
+
+	return nil
+}
+
 func (p *Processor) processPoll(
 	ctx context.Context,
 	statusID string,
@@ -57,6 +57,10 @@ func (p *Processor) contextGet(
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
+	// Backfill more of the remote conversation
+	// below target, for subsequent loads (if enabled).
+	p.c.BackfillStatusReplies(ctx, requester, targetStatus)
+
 	// Fetch up to the top of the thread.
 	ancestors, err := p.state.DB.GetStatusParents(ctx, targetStatus)
 	if err != nil {
@@ -32,6 +32,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/processing/common"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
@@ -39,12 +40,33 @@
 
 // Create processes the given form to create a new status, returning the api model representation of that status if it's OK.
 // Note this also handles validation of incoming form field data.
+//
+// If ctx carries an idempotency key, a retry of the same request returns the originally created (scheduled) status.
 func (p *Processor) Create(
 	ctx context.Context,
 	requester *gtsmodel.Account,
 	application *gtsmodel.Application,
 	form *apimodel.StatusCreateRequest,
 	scheduledStatusID *string,
+) (any, gtserror.WithCode) {
+	return common.Idempotent(ctx, p.state, requester, "status",
+		func() (any, gtserror.WithCode) {
+			return p.create(ctx,
+				requester,
+				application,
+				form,
+				scheduledStatusID,
+			)
+		},
+	)
+}
+
+func (p *Processor) create(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	form *apimodel.StatusCreateRequest,
+	scheduledStatusID *string,
 ) (any, gtserror.WithCode) {
 	// Validate incoming form status content.
 	if errWithCode := validateStatusContent(
@@ -91,6 +113,12 @@ func (p *Processor) Create(
 	// time as creation time.
 	createdAt := now
 
+	// Process incoming status expiry.
+	expiresAt, errWithCode := processExpiry(form, requester.Settings, now)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Handle backfilled/scheduled statuses.
 	backfill := false
 
@@ -156,17 +184,24 @@ func (p *Processor) Create(
 		return nil, errWithCode
 	}
 
+	// Check attachments are described, if required.
+	if errWithCode := processMediaDescriptions(requester, media); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	status := &gtsmodel.Status{
 		ID:                       statusID,
 		URI:                      accountURIs.StatusesURI + "/" + statusID,
 		URL:                      accountURIs.StatusesURL + "/" + statusID,
 		CreatedAt:                createdAt,
+		ExpiresAt:                expiresAt,
 		Local:                    util.Ptr(true),
 		Account:                  requester,
 		AccountID:                requester.ID,
 		AccountURI:               requester.URI,
 		ActivityStreamsType:      ap.ObjectNote,
 		Sensitive:                &form.Sensitive,
+		PreviewLinks:             util.Ptr(previewLinks(form.PreviewLinks, requester.Settings)),
 		CreatedWithApplicationID: application.ID,
 
 		// Set validated language.
@@ -285,6 +320,14 @@ func (p *Processor) Create(
 		}
 	}
 
+	if !status.ExpiresAt.IsZero() {
+		// Now that the status is inserted, attempt
+		// to schedule its deletion at expiry time.
+		if err := p.ScheduleExpiry(ctx, status); err != nil {
+			log.Errorf(ctx, "error scheduling status expiry: %v", err)
+		}
+	}
+
 	// If the new status replies to a status that
 	// replies to us, use our reply as an implicit
 	// accept of any pending interaction.
@@ -416,7 +459,10 @@ func (p *Processor) processInReplyTo(
 	if policyResult.Forbidden() {
 		const errText = "you do not have permission to reply to this status"
 		err := gtserror.New(errText)
-		return gtserror.NewErrorForbidden(err, errText)
+		return gtserror.WithErrCode(
+			gtserror.NewErrorForbidden(err, errText),
+			gtserror.ErrCodeInteractionRejected,
+		)
 	}
 
 	// When backfilling, only self-replies are allowed.
@@ -593,6 +639,13 @@ func (p *Processor) processScheduledStatus(
 	if errWithCode != nil {
 		return nil, errWithCode
 	}
+
+	// Check attachments are described now, rather
+	// than failing later when the status is posted.
+	if errWithCode := processMediaDescriptions(requester, media); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	status := &gtsmodel.ScheduledStatus{
 		ID:               statusID,
 		Account:          requester,
@@ -608,6 +661,7 @@ func (p *Processor) processScheduledStatus(
 		InReplyToID:      form.InReplyToID,
 		Language:         form.Language,
 		LocalOnly:        form.LocalOnly,
+		PreviewLinks:     form.PreviewLinks,
 		ContentType:      string(form.ContentType),
 	}
 
@@ -24,6 +24,8 @@
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 	"github.com/stretchr/testify/suite"
@@ -61,6 +63,53 @@ func (suite *StatusCreateTestSuite) TestProcessContentWarningWithQuotationMarks(
 	suite.Equal("\"test\"", apiStatus.SpoilerText)
 }
 
+func (suite *StatusCreateTestSuite) TestCreateIdempotent() {
+	var (
+		ctx                 = gtscontext.SetIdempotencyKey(suite.T().Context(), "some-key")
+		creatingAccount     = suite.testAccounts["local_account_1"]
+		creatingApplication = suite.testApplications["application_1"]
+	)
+
+	newForm := func() *apimodel.StatusCreateRequest {
+		return &apimodel.StatusCreateRequest{
+			Status:      "did this post twice?",
+			Visibility:  apimodel.VisibilityPublic,
+			LocalOnly:   util.Ptr(false),
+			ContentType: apimodel.StatusContentTypePlain,
+		}
+	}
+
+	apiStatus1, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, newForm(), nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Retry with the same key
+	// should give the same status.
+	apiStatus2, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, newForm(), nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(apiStatus1.(*apimodel.Status).ID, apiStatus2.(*apimodel.Status).ID)
+
+	// Same key used by another
+	// account gives a new status.
+	otherAccount := suite.testAccounts["local_account_2"]
+	apiStatus3, errWithCode := suite.status.Create(ctx, otherAccount, creatingApplication, newForm(), nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotEqual(apiStatus1.(*apimodel.Status).ID, apiStatus3.(*apimodel.Status).ID)
+
+	// And no key at all
+	// gives a new status.
+	apiStatus4, errWithCode := suite.status.Create(suite.T().Context(), creatingAccount, creatingApplication, newForm(), nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotEqual(apiStatus1.(*apimodel.Status).ID, apiStatus4.(*apimodel.Status).ID)
+}
+
 func (suite *StatusCreateTestSuite) TestProcessStatusMarkdownWithUnderscoreEmoji() {
 	ctx := suite.T().Context()
 
@@ -150,6 +199,89 @@ func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 	suite.Nil(apiStatus)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionRequired() {
+	ctx := suite.T().Context()
+
+	config.SetMediaDescriptionPolicy(config.MediaDescriptionPolicyRequire)
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Remove the description of the attachment.
+	attachment := new(gtsmodel.MediaAttachment)
+	*attachment = *suite.testAttachments["local_account_1_unattached_1"]
+	attachment.Description = " "
+	if err := suite.db.UpdateAttachment(ctx, attachment, "description"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:      "look at this",
+		MediaIDs:    []string{attachment.ID},
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		Language:    "en",
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.EqualError(errWithCode, "media description required: "+attachment.ID)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal(gtserror.ErrCodeMediaDescriptionRequired, errWithCode.ErrCode())
+	suite.Nil(apiStatus)
+
+	// Once described, the status can be created.
+	attachment.Description = "a cool picture"
+	if err := suite.db.UpdateAttachment(ctx, attachment, "description"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiStatus, errWithCode = suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.NoError(errWithCode)
+	suite.NotNil(apiStatus)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionRequiredByAccount() {
+	ctx := suite.T().Context()
+
+	// Instance policy is only to remind,
+	// but the account requires descriptions.
+	config.SetMediaDescriptionPolicy(config.MediaDescriptionPolicyRemind)
+
+	creatingAccount := new(gtsmodel.Account)
+	*creatingAccount = *suite.testAccounts["local_account_1"]
+	creatingAccount.Settings = new(gtsmodel.AccountSettings)
+	*creatingAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+	creatingAccount.Settings.MediaDescriptionPolicy = gtsmodel.DescriptionPolicyRequire
+	creatingApplication := suite.testApplications["application_1"]
+
+	attachment := new(gtsmodel.MediaAttachment)
+	*attachment = *suite.testAttachments["local_account_1_unattached_1"]
+	attachment.Description = ""
+	if err := suite.db.UpdateAttachment(ctx, attachment, "description"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status:      "look at this",
+		MediaIDs:    []string{attachment.ID},
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		Language:    "en",
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.EqualError(errWithCode, "media description required: "+attachment.ID)
+	suite.Equal(gtserror.ErrCodeMediaDescriptionRequired, errWithCode.ErrCode())
+	suite.Nil(apiStatus)
+
+	// With the instance policy, the status is fine.
+	apiStatus, errWithCode = suite.status.Create(ctx, suite.testAccounts["local_account_1"], creatingApplication, statusCreateForm, nil)
+	suite.NoError(errWithCode)
+	suite.NotNil(apiStatus)
+}
+
 func (suite *StatusCreateTestSuite) TestProcessLanguageWithScriptPart() {
 	ctx := suite.T().Context()
 
@@ -247,6 +379,66 @@ func (suite *StatusCreateTestSuite) TestProcessNoContentTypeUsesDefault() {
 	suite.Equal(apimodel.StatusContentTypeDefault, apiStatus.ContentType)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessPollOptionEmojis() {
+	ctx := suite.T().Context()
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status: "which is better?",
+		Poll: &apimodel.PollRequest{
+			Options:   []string{"rainbows :rainbow:", "clouds"},
+			ExpiresIn: 3600,
+		},
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		Language:    "en",
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	apiStatusAny, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.NoError(errWithCode)
+	apiStatus := apiStatusAny.(*apimodel.Status)
+
+	// Emoji used in poll option should be set
+	// on the status, and on the poll itself.
+	suite.Len(apiStatus.Emojis, 1)
+	suite.Equal("rainbow", apiStatus.Emojis[0].Shortcode)
+	suite.NotNil(apiStatus.Poll)
+	suite.Equal("rainbows :rainbow:", apiStatus.Poll.Options[0].Title)
+	suite.Len(apiStatus.Poll.Emojis, 1)
+	suite.Equal("rainbow", apiStatus.Poll.Emojis[0].Shortcode)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessPollContentEmojis() {
+	ctx := suite.T().Context()
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.StatusCreateRequest{
+		Status: "which is better? :rainbow:",
+		Poll: &apimodel.PollRequest{
+			Options:   []string{"rainbows", "clouds"},
+			ExpiresIn: 3600,
+		},
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		Language:    "en",
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	apiStatusAny, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, nil)
+	suite.NoError(errWithCode)
+	apiStatus := apiStatusAny.(*apimodel.Status)
+
+	// Emoji used only in status content
+	// should not be set on the poll.
+	suite.Len(apiStatus.Emojis, 1)
+	suite.NotNil(apiStatus.Poll)
+	suite.NotNil(apiStatus.Poll.Emojis)
+	suite.Empty(apiStatus.Poll.Emojis)
+}
+
 func (suite *StatusCreateTestSuite) TestProcessInvalidVisibility() {
 	ctx := suite.T().Context()
 	creatingAccount := suite.testAccounts["local_account_1"]
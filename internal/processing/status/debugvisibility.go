@@ -26,6 +26,7 @@
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/cache"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
@@ -160,6 +161,130 @@ func (p *Processor) DebugVisibilityGet(ctx context.Context, requester *gtsmodel.
 	return &rsp, nil
 }
 
+// DebugVisibilityCacheGet returns the visibility, mute and filter cache
+// entries currently stored for the given account and status URI / URL,
+// without performing any lookups that would populate them. If invalidate
+// is set, these entries are invalidated after being returned, leaving all
+// other cache entries untouched.
+func (p *Processor) DebugVisibilityCacheGet(
+	ctx context.Context,
+	accountID string,
+	statusURI string,
+	invalidate bool,
+) (*apimodel.StatusVisibilityCacheDebugResponse, gtserror.WithCode) {
+	if statusURI == "" {
+		const text = "target status not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New(text),
+			text,
+		)
+	}
+
+	// Look for the status by URI, falling back to URL.
+	// Unlike DebugVisibilityGet() we do not dereference
+	// here, as we only care about locally cached entries.
+	status, err := p.state.DB.GetStatusByURI(ctx, statusURI)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status %s: %w", statusURI, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if status == nil {
+		status, err = p.state.DB.GetStatusByURL(ctx, statusURI)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting status %s: %w", statusURI, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if status == nil {
+		const text = "target status not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New(text),
+			text,
+		)
+	}
+
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if account == nil {
+		const text = "target account not found"
+		return nil, gtserror.NewErrorNotFound(
+			errors.New(text),
+			text,
+		)
+	}
+
+	// Fetch the cache entries for this status + account pair.
+	entries := p.state.Caches.GetStatusEntries(account.ID, status.ID, status.AccountID)
+
+	if invalidate {
+		// Drop just these entries from caches.
+		p.state.Caches.InvalidateStatusEntries(
+			account.ID,
+			status.ID,
+			status.AccountID,
+		)
+	}
+
+	rsp := &apimodel.StatusVisibilityCacheDebugResponse{
+		URI:        status.URI,
+		StatusID:   status.ID,
+		AccountID:  account.ID,
+		Visibility: make([]apimodel.StatusVisibilityCacheEntry, 0, len(entries.Visibility)),
+	}
+
+	for _, v := range entries.Visibility {
+		rsp.Visibility = append(rsp.Visibility, apimodel.StatusVisibilityCacheEntry{
+			Type:    v.Type.String(),
+			ItemID:  v.ItemID,
+			Visible: v.Value,
+		})
+	}
+
+	now := time.Now()
+
+	if mute := entries.Mute; mute != nil {
+		rsp.MuteCached = true
+
+		if mute.Mute {
+			// Convert mute expiry time to a mute result obj.
+			rsp.Mute = toMuteResult(mute.MuteExpiry, now)
+		}
+
+		if mute.Notifications {
+			// Convert notifications expiry time to a notifications result obj.
+			rsp.MuteNotifications = toMuteResult(mute.NotificationExpiry, now)
+		}
+	}
+
+	if filters := entries.Filter; filters != nil {
+		rsp.FiltersCached = true
+		rsp.Filters = new(apimodel.StatusFiltersResult)
+		for _, filter := range filters.Results[cache.KeyContextHome] {
+			rsp.Filters.Home = append(rsp.Filters.Home, toFilterResult(filter, now))
+		}
+		for _, filter := range filters.Results[cache.KeyContextPublic] {
+			rsp.Filters.Public = append(rsp.Filters.Public, toFilterResult(filter, now))
+		}
+		for _, filter := range filters.Results[cache.KeyContextNotifs] {
+			rsp.Filters.Notifications = append(rsp.Filters.Notifications, toFilterResult(filter, now))
+		}
+		for _, filter := range filters.Results[cache.KeyContextThread] {
+			rsp.Filters.Thread = append(rsp.Filters.Thread, toFilterResult(filter, now))
+		}
+		for _, filter := range filters.Results[cache.KeyContextAccount] {
+			rsp.Filters.Account = append(rsp.Filters.Account, toFilterResult(filter, now))
+		}
+	}
+
+	return rsp, nil
+}
+
 func toFilterResult(filter cache.StatusFilterResult, now time.Time) apimodel.StatusFilterResult {
 	var expiry *string
 	active := true
@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusDebugVisibilityTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusDebugVisibilityTestSuite) TestDebugVisibilityCache() {
+	ctx := suite.T().Context()
+
+	requester := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	// Nothing should be cached yet.
+	entries, errWithCode := suite.status.DebugVisibilityCacheGet(ctx, requester.ID, targetStatus.URI, false)
+	suite.NoError(errWithCode)
+	suite.Equal(targetStatus.ID, entries.StatusID)
+	suite.Equal(requester.ID, entries.AccountID)
+	suite.Empty(entries.Visibility)
+	suite.False(entries.MuteCached)
+	suite.False(entries.FiltersCached)
+
+	// Perform full visibility lookup, which populates caches.
+	_, errWithCode = suite.status.DebugVisibilityGet(ctx, requester, targetStatus.URI)
+	suite.NoError(errWithCode)
+
+	// The cached entries should now be visible,
+	// and invalidated at the same time.
+	entries, errWithCode = suite.status.DebugVisibilityCacheGet(ctx, requester.ID, targetStatus.URI, true)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(entries.Visibility)
+	suite.True(entries.MuteCached)
+	suite.True(entries.FiltersCached)
+
+	types := make(map[string]bool)
+	for _, entry := range entries.Visibility {
+		types[entry.Type] = entry.Visible
+	}
+	suite.True(types["status"])
+	suite.True(types["public"])
+	suite.True(types["home"])
+
+	// Entries should have been invalidated.
+	entries, errWithCode = suite.status.DebugVisibilityCacheGet(ctx, requester.ID, targetStatus.URI, false)
+	suite.NoError(errWithCode)
+	suite.Empty(entries.Visibility)
+	suite.False(entries.MuteCached)
+	suite.False(entries.FiltersCached)
+}
+
+func (suite *StatusDebugVisibilityTestSuite) TestDebugVisibilityCacheNotFound() {
+	ctx := suite.T().Context()
+
+	requester := suite.testAccounts["local_account_1"]
+
+	_, errWithCode := suite.status.DebugVisibilityCacheGet(ctx, requester.ID, "https://example.org/does/not/exist", false)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	_, errWithCode = suite.status.DebugVisibilityCacheGet(ctx, "01J0000000000000000000NOPE", suite.testStatuses["admin_account_status_1"].URI, false)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestStatusDebugVisibilityTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusDebugVisibilityTestSuite))
+}
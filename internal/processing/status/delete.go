@@ -58,13 +58,27 @@ func (p *Processor) Delete(ctx context.Context, requestingAccount *gtsmodel.Acco
 	}
 
 	// Process delete side effects.
+	p.queueDelete(requestingAccount, targetStatus)
+
+	return apiStatus, nil
+}
+
+// queueDelete queues the given status of requester
+// for deletion by the client API worker, which also
+// federates out the delete. Any scheduled expiry of
+// the status is cancelled, as it's no longer needed.
+func (p *Processor) queueDelete(requester *gtsmodel.Account, status *gtsmodel.Status) {
+	if !status.ExpiresAt.IsZero() {
+		// Status is being deleted before
+		// expiry, cancel scheduled delete.
+		p.state.Workers.Scheduler.Cancel(status.ID)
+	}
+
 	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
 		APObjectType:   ap.ObjectNote,
 		APActivityType: ap.ActivityDelete,
-		GTSModel:       targetStatus,
-		Origin:         requestingAccount,
-		Target:         requestingAccount,
+		GTSModel:       status,
+		Origin:         requester,
+		Target:         requester,
 	})
-
-	return apiStatus, nil
 }
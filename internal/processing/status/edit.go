@@ -125,6 +125,12 @@ func (p *Processor) Edit(
 		return nil, errWithCode
 	}
 
+	// Check attachments are described, if required,
+	// now that any description edits are applied.
+	if errWithCode := processMediaDescriptions(requester, media); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Process incoming edits of any attached status poll.
 	poll, pollEdited, errWithCode := p.processPollEdit(ctx,
 		statusID,
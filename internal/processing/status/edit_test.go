@@ -25,6 +25,7 @@
 
 	"code.superseriousbusiness.org/gopkg/xslices"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
@@ -649,6 +650,7 @@ func (suite *StatusEditTestSuite) TestEditOthersStatus1() {
 	suite.Equal(http.StatusNotFound, errWithCode.Code())
 	suite.Equal("status does not belong to requester", errWithCode.Error())
 	suite.Equal("Not Found: target status not found", errWithCode.Safe())
+	suite.Equal(gtserror.ErrCodeStatusNotFound, errWithCode.ErrCode())
 }
 
 func (suite *StatusEditTestSuite) TestEditOthersStatus2() {
@@ -674,6 +676,7 @@ func (suite *StatusEditTestSuite) TestEditOthersStatus2() {
 	suite.Equal(http.StatusNotFound, errWithCode.Code())
 	suite.Equal("status does not belong to requester", errWithCode.Error())
 	suite.Equal("Not Found: target status not found", errWithCode.Safe())
+	suite.Equal(gtserror.ErrCodeStatusNotFound, errWithCode.ErrCode())
 }
 
 func TestStatusEditTestSuite(t *testing.T) {
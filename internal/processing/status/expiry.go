@@ -0,0 +1,184 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+)
+
+// ExpiringStatusesScheduleAll schedules deletion
+// of all local statuses with an expiry time set.
+func (p *Processor) ExpiringStatusesScheduleAll(ctx context.Context) error {
+	log.Info(ctx, "scheduling status expiries...")
+
+	// Fetch all expiring statuses from the database (barebones models are enough).
+	statuses, err := p.state.DB.GetExpiringStatuses(gtscontext.SetBarebones(ctx))
+	if err != nil {
+		return gtserror.Newf("error getting expiring statuses from db: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, status := range statuses {
+		// Schedule each of the statuses and catch any errors.
+		if err := p.ScheduleExpiry(ctx, status); err != nil {
+			errs.Append(err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// ScheduleExpiry schedules deletion of the
+// given status at its set expiry time. Expiry
+// times already in the past are run immediately.
+func (p *Processor) ScheduleExpiry(ctx context.Context, status *gtsmodel.Status) error {
+	// Ensure has a valid expiry.
+	if status.ExpiresAt.IsZero() {
+		return gtserror.Newf("status %s has no expiry", status.ID)
+	}
+
+	// Add the given status to the scheduler.
+	ok := p.state.Workers.Scheduler.AddOnce(
+		status.ID,
+		status.ExpiresAt,
+		p.onExpiry(status.ID),
+	)
+
+	if !ok {
+		// Failed to add the status to the scheduler, either it was
+		// starting / stopping or there already exists a task for status.
+		return gtserror.Newf("failed adding status %s expiry to scheduler", status.ID)
+	}
+
+	atStr := status.ExpiresAt.Local().Format("Jan _2 2006 15:04:05")
+	log.Infof(ctx, "scheduled status expiry for %s at '%s'", status.ID, atStr)
+	return nil
+}
+
+// onExpiry returns a callback function to be used by the scheduler when the given status expires.
+func (p *Processor) onExpiry(statusID string) func(context.Context, time.Time) {
+	return func(ctx context.Context, now time.Time) {
+		// Get the latest version of status from database.
+		status, err := p.state.DB.GetStatusByID(ctx, statusID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// Status was already deleted
+				// some other way, nothing to do.
+				log.Debugf(ctx, "expiring status %s already deleted", statusID)
+				return
+			}
+			log.Errorf(ctx, "error getting status %s from db: %v", statusID, err)
+			return
+		}
+
+		if status.ExpiresAt.IsZero() {
+			// Expiry was removed.
+			return
+		}
+
+		if status.Account == nil {
+			// Cannot continue
+			// without author.
+			log.Errorf(ctx, "no status owner for status %s", statusID)
+			return
+		}
+
+		// Enqueue a status delete operation to the client API worker,
+		// this will asynchronously delete and federate out the status.
+		p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       status,
+			Origin:         status.Account,
+			Target:         status.Account,
+		})
+	}
+}
+
+// processExpiry returns the expiry time of a status created
+// at now using given form, or a zero time if not expiring.
+// The expiry is checked against configured min / max bounds.
+//
+// If the form doesn't set an expiry, the account's default
+// status lifetime is used (if any), clamped to the bounds.
+func processExpiry(
+	form *apimodel.StatusCreateRequest,
+	settings *gtsmodel.AccountSettings,
+	now time.Time,
+) (time.Time, gtserror.WithCode) {
+	var expiresAt time.Time
+
+	switch {
+	case form.ExpiresIn != 0:
+		expiresAt = now.Add(time.Duration(form.ExpiresIn) * time.Second)
+	case form.ExpiresAt != nil:
+		expiresAt = *form.ExpiresAt
+	case form.ScheduledAt == nil &&
+		settings != nil &&
+		settings.StatusExpiresIn > 0:
+		return now.Add(defaultExpiresIn(settings.StatusExpiresIn)), nil
+	default:
+		// Not expiring.
+		return time.Time{}, nil
+	}
+
+	if form.ScheduledAt != nil {
+		const errText = "statuses can't be scheduled or backdated with an expiry"
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(gtserror.New(errText), errText)
+	}
+
+	expiresIn := expiresAt.Sub(now)
+
+	if minExpiresIn := config.GetStatusesExpiryMinDuration(); expiresIn < minExpiresIn {
+		errText := fmt.Sprintf("status expiry must be at least %s in the future", minExpiresIn)
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(errors.New(errText), errText)
+	}
+
+	if maxExpiresIn := config.GetStatusesExpiryMaxDuration(); maxExpiresIn > 0 && expiresIn > maxExpiresIn {
+		errText := fmt.Sprintf("status expiry must be at most %s in the future", maxExpiresIn)
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(errors.New(errText), errText)
+	}
+
+	return expiresAt, nil
+}
+
+// defaultExpiresIn returns the given default status lifetime
+// in seconds as a duration, clamped to the configured bounds,
+// as these may have changed since the default was set.
+func defaultExpiresIn(seconds int) time.Duration {
+	expiresIn := time.Duration(seconds) * time.Second
+	expiresIn = max(expiresIn, config.GetStatusesExpiryMinDuration())
+	if maxExpiresIn := config.GetStatusesExpiryMaxDuration(); maxExpiresIn > 0 {
+		expiresIn = min(expiresIn, maxExpiresIn)
+	}
+	return expiresIn
+}
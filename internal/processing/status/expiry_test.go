@@ -0,0 +1,214 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusExpiryTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusExpiryTestSuite) TestCreateExpiring() {
+	ctx := suite.T().Context()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	apiStatusAny, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, &apimodel.StatusCreateRequest{
+		Status:      "this status will self destruct in one hour",
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		ContentType: apimodel.StatusContentTypePlain,
+		ExpiresIn:   3600,
+	}, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	apiStatus := apiStatusAny.(*apimodel.Status)
+
+	// Expiry should be set in db.
+	dbStatus, err := suite.db.GetStatusByID(ctx, apiStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(time.Now().Add(time.Hour), dbStatus.ExpiresAt, time.Minute)
+
+	// And serialized via the API.
+	suite.Equal(util.FormatISO8601(dbStatus.ExpiresAt), util.PtrOrZero(apiStatus.ExpiresAt))
+
+	// Deleting the status early should
+	// cancel its scheduled expiry task.
+	_, errWithCode = suite.status.Delete(ctx, creatingAccount, apiStatus.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(suite.state.Workers.Scheduler.Cancel(apiStatus.ID))
+}
+
+func (suite *StatusExpiryTestSuite) TestCreateExpiringInvalid() {
+	ctx := suite.T().Context()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	for _, test := range []struct {
+		expiresIn   int
+		expiresAt   *time.Time
+		scheduledAt *time.Time
+		errText     string
+	}{
+		{
+			expiresIn: 60,
+			errText:   "status expiry must be at least 5m0s in the future",
+		},
+		{
+			expiresAt: util.Ptr(time.Now().Add(-time.Hour)),
+			errText:   "status expiry must be at least 5m0s in the future",
+		},
+		{
+			expiresAt: util.Ptr(time.Now().Add(2 * 365 * 24 * time.Hour)),
+			errText:   "status expiry must be at most 8760h0m0s in the future",
+		},
+		{
+			expiresIn:   3600,
+			scheduledAt: util.Ptr(time.Now().Add(-time.Hour)),
+			errText:     "statuses can't be scheduled or backdated with an expiry",
+		},
+	} {
+		_, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, &apimodel.StatusCreateRequest{
+			Status:      "this status will not be posted",
+			Visibility:  apimodel.VisibilityPublic,
+			LocalOnly:   util.Ptr(false),
+			ContentType: apimodel.StatusContentTypePlain,
+			ExpiresIn:   test.expiresIn,
+			ExpiresAt:   test.expiresAt,
+			ScheduledAt: test.scheduledAt,
+		}, nil)
+		if suite.NotNil(errWithCode) {
+			suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+			suite.Equal("Unprocessable Entity: "+test.errText, errWithCode.Safe())
+		}
+	}
+}
+
+func (suite *StatusExpiryTestSuite) TestCreateDefaultExpiry() {
+	ctx := suite.T().Context()
+
+	// Set a default expiry on the account.
+	creatingAccount := new(gtsmodel.Account)
+	*creatingAccount = *suite.testAccounts["local_account_1"]
+	creatingAccount.Settings = new(gtsmodel.AccountSettings)
+	*creatingAccount.Settings = *suite.testAccounts["local_account_1"].Settings
+	creatingAccount.Settings.StatusExpiresIn = 86400
+	creatingApplication := suite.testApplications["application_1"]
+
+	apiStatusAny, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, &apimodel.StatusCreateRequest{
+		Status:      "this status will self destruct in a day",
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		ContentType: apimodel.StatusContentTypePlain,
+	}, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	apiStatus := apiStatusAny.(*apimodel.Status)
+
+	dbStatus, err := suite.db.GetStatusByID(ctx, apiStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(time.Now().Add(24*time.Hour), dbStatus.ExpiresAt, time.Minute)
+
+	// An explicit expiry overrides the default.
+	apiStatusAny, errWithCode = suite.status.Create(ctx, creatingAccount, creatingApplication, &apimodel.StatusCreateRequest{
+		Status:      "this status will self destruct in one hour",
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		ContentType: apimodel.StatusContentTypePlain,
+		ExpiresIn:   3600,
+	}, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	apiStatus = apiStatusAny.(*apimodel.Status)
+
+	dbStatus, err = suite.db.GetStatusByID(ctx, apiStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(time.Now().Add(time.Hour), dbStatus.ExpiresAt, time.Minute)
+}
+
+func (suite *StatusExpiryTestSuite) TestStatusExpires() {
+	ctx, cncl := context.WithTimeout(suite.T().Context(), 10*time.Second)
+	defer cncl()
+
+	// Allow expiries to be set
+	// in the very near future.
+	config.SetStatusesExpiryMinDuration(0)
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	apiStatusAny, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, &apimodel.StatusCreateRequest{
+		Status:      "this status will self destruct in one second",
+		Visibility:  apimodel.VisibilityPublic,
+		LocalOnly:   util.Ptr(false),
+		ContentType: apimodel.StatusContentTypePlain,
+		ExpiresIn:   1,
+	}, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	apiStatus := apiStatusAny.(*apimodel.Status)
+
+	// On expiry a delete for the status
+	// should be pushed to client worker.
+	for {
+		msg, ok := suite.state.Workers.Client.Queue.PopCtx(ctx)
+		if !ok {
+			suite.FailNow("timed out waiting for status delete")
+		}
+
+		if msg.APActivityType != ap.ActivityDelete {
+			continue
+		}
+
+		status, ok := msg.GTSModel.(*gtsmodel.Status)
+		if ok && status.ID == apiStatus.ID {
+			suite.Equal(creatingAccount.ID, msg.Origin.ID)
+			break
+		}
+	}
+}
+
+func TestStatusExpiryTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusExpiryTestSuite))
+}
@@ -101,7 +101,10 @@ func (p *Processor) FaveCreate(
 	if policyResult.Forbidden() {
 		const errText = "you do not have permission to fave this status"
 		err := gtserror.New(errText)
-		return nil, gtserror.NewErrorForbidden(err, errText)
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorForbidden(err, errText),
+			gtserror.ErrCodeInteractionRejected,
+		)
 	}
 
 	// Derive pendingApproval
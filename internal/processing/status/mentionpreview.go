@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/regexes"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// MentionPreview resolves the given mention the same way as it
+// would be resolved in the text of a status posted by requester,
+// and returns the normalized mention along with its target account.
+//
+// This allows clients to show which account a mention refers to
+// while a status is still being composed.
+func (p *Processor) MentionPreview(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	mention string,
+) (*apimodel.MentionPreview, gtserror.WithCode) {
+	// Be nice and normalize
+	// mention by prepending '@'.
+	mention = strings.TrimSpace(mention)
+	if !strings.HasPrefix(mention, "@") {
+		mention = "@" + mention
+	}
+
+	// Extract the mention from the start of the
+	// given text, dropping trailing punctuation,
+	// just like the status text formatter does.
+	if loc := regexes.MentionFinder.FindStringIndex(mention); loc != nil && loc[0] == 0 {
+		mention = text.TrimMentionPunctuation(mention[:loc[1]])
+	}
+
+	if _, _, err := util.ExtractNamestringParts(mention); err != nil {
+		const errText = "bad mention, must be in the form '@[username]' or '@[username]@[domain]'"
+		return nil, gtserror.NewErrorBadRequest(err, errText)
+	}
+
+	// Resolve the mention using the same
+	// func as used for parsing status text.
+	// Empty statusID as this isn't stored.
+	resolved, err := p.parseMention(ctx,
+		mention,
+		requester.ID,
+		"",
+	)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) ||
+			gtserror.IsUnretrievable(err) {
+			err := fmt.Errorf("%s not found", mention)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err := gtserror.Newf("error resolving mention %s: %w", mention, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	target := resolved.TargetAccount
+
+	// Check if block exists between requester and target.
+	blocked, err := p.state.DB.IsEitherBlocked(ctx, requester.ID, target.ID)
+	if err != nil {
+		err := gtserror.Newf("error checking block between %s and %s: %w", requester.ID, target.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	var apiAccount *apimodel.Account
+	if blocked {
+		apiAccount, err = p.converter.AccountToAPIAccountBlocked(ctx, target)
+	} else {
+		apiAccount, err = p.converter.AccountToAPIAccountPublic(ctx, target)
+	}
+
+	if err != nil {
+		err := gtserror.Newf("error converting account %s: %w", target.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.MentionPreview{
+		Mention: mention,
+		Account: apiAccount,
+	}, nil
+}
@@ -0,0 +1,156 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/activity/streams/vocab"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+)
+
+// OutboxPost processes the given activity, POSTed by requester
+// to their own outbox using ActivityPub client-to-server.
+//
+// Supported are Create activities wrapping a Note, bare Notes
+// (which are treated as though wrapped in a Create), and Delete
+// activities targeting one of requester's own statuses.
+//
+// Returns the URI of the created or deleted status.
+func (p *Processor) OutboxPost(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	t vocab.Type,
+) (string, gtserror.WithCode) {
+	switch typeName := t.GetTypeName(); typeName {
+
+	case ap.ObjectNote:
+		return p.outboxCreate(ctx, requester, application, t)
+
+	case ap.ActivityCreate:
+		create, ok := t.(vocab.ActivityStreamsCreate)
+		if !ok {
+			const text = "could not parse Create activity"
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		objectProp := create.GetActivityStreamsObject()
+		if objectProp == nil || objectProp.Len() != 1 {
+			const text = "Create activity must have exactly one object"
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		object := objectProp.At(0).GetType()
+		if object == nil || object.GetTypeName() != ap.ObjectNote {
+			const text = "Create activity object must be an embedded Note"
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		return p.outboxCreate(ctx, requester, application, object)
+
+	case ap.ActivityDelete:
+		del, ok := t.(vocab.ActivityStreamsDelete)
+		if !ok {
+			const text = "could not parse Delete activity"
+			return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		return p.outboxDelete(ctx, requester, del)
+
+	default:
+		text := "activity type " + typeName + " not supported; supported types are Create (Note), Note, and Delete"
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+}
+
+// outboxCreate converts the given Note into a status create
+// request form, and passes it to the status create processor.
+func (p *Processor) outboxCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	t vocab.Type,
+) (string, gtserror.WithCode) {
+	statusable, ok := ap.ToStatusable(t)
+	if !ok {
+		const text = "could not parse Note"
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	form, err := p.converter.ASStatusToStatusCreateRequest(ctx, statusable, requester)
+	if err != nil {
+		if gtserror.IsMalformed(err) {
+			return "", gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		err := gtserror.Newf("error converting Note: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	created, errWithCode := p.Create(ctx, requester, application, form, nil)
+	if errWithCode != nil {
+		return "", errWithCode
+	}
+
+	apiStatus, ok := created.(*apimodel.Status)
+	if !ok {
+		err := gtserror.Newf("unexpected status create result type %T", created)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	return apiStatus.URI, nil
+}
+
+// outboxDelete deletes the status of requester
+// targeted by the object of the given Delete.
+func (p *Processor) outboxDelete(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	del vocab.ActivityStreamsDelete,
+) (string, gtserror.WithCode) {
+	objectURI, err := ap.ExtractObjectURI(del)
+	if err != nil {
+		const text = "Delete activity must have an object"
+		return "", gtserror.NewErrorBadRequest(err, text)
+	}
+
+	uri := objectURI.String()
+
+	status, err := p.state.DB.GetStatusByURI(ctx, uri)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status %s: %w", uri, err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	if status == nil {
+		const text = "Delete activity object not found"
+		return "", gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if _, errWithCode := p.Delete(ctx, requester, status.ID); errWithCode != nil {
+		return "", errWithCode
+	}
+
+	return status.URI, nil
+}
@@ -188,6 +188,10 @@ func (p *Processor) onPublish(statusID string) func(context.Context, time.Time)
 			SpoilerText: status.SpoilerText,
 			Visibility:  typeutils.VisToAPIVis(status.Visibility),
 			Language:    status.Language,
+
+			// Nil here falls back to
+			// the account default.
+			PreviewLinks: status.PreviewLinks,
 		}
 
 		if status.Poll.Options != nil && len(status.Poll.Options) > 1 {
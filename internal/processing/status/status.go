@@ -18,6 +18,9 @@
 package status
 
 import (
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/mutes"
@@ -29,6 +32,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/processing/polls"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 )
 
@@ -46,6 +50,14 @@ type Processor struct {
 	formatter    *text.Formatter
 	parseMention gtsmodel.ParseMentionFunc
 
+	// translation provider, nil if
+	// translation isn't configured.
+	translator       translate.Translator
+	translateLimiter *translate.RateLimiter
+
+	// running status cleanups.
+	cleanups *cleanups
+
 	// other processors
 	polls   *polls.Processor
 	intReqs *interactionrequests.Processor
@@ -64,6 +76,7 @@ func New(
 	statusFilter *statusfilter.Filter,
 	intFilter *interaction.Filter,
 	parseMention gtsmodel.ParseMentionFunc,
+	translator translate.Translator,
 ) Processor {
 	return Processor{
 		c:            common,
@@ -76,7 +89,15 @@ func New(
 		intFilter:    intFilter,
 		formatter:    text.NewFormatter(state.DB),
 		parseMention: parseMention,
-		polls:        polls,
-		intReqs:      intReqs,
+		translator:   translator,
+		translateLimiter: translate.NewRateLimiter(
+			config.GetTranslationRateLimit(),
+			time.Hour,
+		),
+		cleanups: &cleanups{
+			running: make(map[string]runningCleanup),
+		},
+		polls:   polls,
+		intReqs: intReqs,
 	}
 }
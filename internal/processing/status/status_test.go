@@ -119,6 +119,7 @@ func (suite *StatusStandardTestSuite) SetupTest() {
 			&suite.state,
 			suite.federator,
 		),
+		testrig.NewTestTranslator(),
 	)
 
 	testrig.StandardDBSetup(suite.db, suite.testAccounts)
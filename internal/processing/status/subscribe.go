@@ -0,0 +1,145 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+)
+
+// getSubscribableStatus fetches targetStatusID status and
+// ensures that requestingAccount can subscribe or unsubscribe.
+//
+// It checks:
+//   - Status exists and is visible to requester.
+//   - Status is not a boost.
+//   - Status has a thread ID.
+func (p *Processor) getSubscribableStatus(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+) (*gtsmodel.Status, gtserror.WithCode) {
+	targetStatus, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requestingAccount,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if targetStatus.BoostOfID != "" {
+		err := gtserror.New("cannot subscribe to or unsubscribe from boosts")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if targetStatus.ThreadID == "" {
+		err := gtserror.New("cannot subscribe to or unsubscribe from status with no threadID")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return targetStatus, nil
+}
+
+// SubscribeCreate subscribes requestingAccount to the thread
+// of the target status, to be notified of all new replies in it.
+func (p *Processor) SubscribeCreate(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getSubscribableStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var (
+		threadID  = targetStatus.ThreadID
+		accountID = requestingAccount.ID
+	)
+
+	// Check if subscription already exists for this thread ID.
+	subscription, err := p.state.DB.GetThreadSubscribedByAccount(ctx, threadID, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Real db error.
+		err := gtserror.Newf("db error fetching subscription to thread %s for account %s: %w", threadID, accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if subscription != nil {
+		// Thread subscription already exists.
+		return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
+	}
+
+	// Gotta create a subscription.
+	if err := p.state.DB.PutThreadSubscription(ctx, &gtsmodel.ThreadSubscription{
+		ID:        id.NewULID(),
+		ThreadID:  threadID,
+		AccountID: accountID,
+	}); err != nil {
+		err := gtserror.Newf("db error putting subscription to thread %s for account %s: %w", threadID, accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
+}
+
+// SubscribeRemove removes any subscription of
+// requestingAccount to the thread of the target status.
+func (p *Processor) SubscribeRemove(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getSubscribableStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var (
+		threadID  = targetStatus.ThreadID
+		accountID = requestingAccount.ID
+	)
+
+	// Check if subscription exists for this thread ID.
+	subscription, err := p.state.DB.GetThreadSubscribedByAccount(ctx, threadID, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Real db error.
+		err := gtserror.Newf("db error fetching subscription to thread %s for account %s: %w", threadID, accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if subscription == nil {
+		// Thread subscription doesn't exist.
+		return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
+	}
+
+	// Gotta remove the subscription.
+	if err := p.state.DB.DeleteThreadSubscription(ctx, subscription.ID); err != nil {
+		err := gtserror.Newf("db error deleting subscription to thread %s for account %s: %w", threadID, accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.c.GetAPIStatus(ctx, requestingAccount, targetStatus)
+}
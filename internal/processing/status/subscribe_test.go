@@ -0,0 +1,78 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusSubscribeTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusSubscribeTestSuite) TestSubscribeUnsubscribe() {
+	ctx := suite.T().Context()
+
+	requester := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	// Subscribe to the thread.
+	apiStatus, errWithCode := suite.status.SubscribeCreate(ctx, requester, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiStatus.Subscribed)
+
+	subscribed, err := suite.db.IsThreadSubscribedByAccount(ctx, targetStatus.ThreadID, requester.ID)
+	suite.NoError(err)
+	suite.True(subscribed)
+
+	subscriberIDs, err := suite.db.GetThreadSubscriberIDs(ctx, targetStatus.ThreadID)
+	suite.NoError(err)
+	suite.Equal([]string{requester.ID}, subscriberIDs)
+
+	// Subscribing again is a no-op.
+	apiStatus, errWithCode = suite.status.SubscribeCreate(ctx, requester, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiStatus.Subscribed)
+
+	// Unsubscribe from the thread.
+	apiStatus, errWithCode = suite.status.SubscribeRemove(ctx, requester, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.False(apiStatus.Subscribed)
+
+	subscribed, err = suite.db.IsThreadSubscribedByAccount(ctx, targetStatus.ThreadID, requester.ID)
+	suite.NoError(err)
+	suite.False(subscribed)
+}
+
+func (suite *StatusSubscribeTestSuite) TestSubscribeBoost() {
+	ctx := suite.T().Context()
+
+	requester := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_4"]
+	suite.NotEmpty(targetStatus.BoostOfID)
+
+	_, errWithCode := suite.status.SubscribeCreate(ctx, requester, targetStatus.ID)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestStatusSubscribeTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusSubscribeTestSuite))
+}
@@ -0,0 +1,206 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
+)
+
+// Translate translates the content, content warning, poll options
+// and media descriptions of the target status into the given
+// language, using the configured translation provider. If lang
+// is empty, the requester's default posting language is used.
+//
+// Only public and unlisted statuses can be translated,
+// to avoid sending private posts to a third party.
+func (p *Processor) Translate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+	lang string,
+) (*apimodel.Translation, gtserror.WithCode) {
+	if p.translator == nil {
+		const text = "translation is not enabled on this instance"
+		return nil, gtserror.NewErrorNotImplemented(errors.New(text), text)
+	}
+
+	if lang == "" {
+		lang = requester.Settings.Language
+	}
+
+	lang, err := validate.Language(lang)
+	if err != nil {
+		text := fmt.Sprintf("invalid language tag: %v", err)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	target, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requester,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if target.Visibility != gtsmodel.VisibilityPublic &&
+		target.Visibility != gtsmodel.VisibilityUnlocked {
+		const text = "only public or unlisted statuses can be translated"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if target.Language != "" &&
+		translateBase(target.Language) == translateBase(lang) {
+		text := fmt.Sprintf("status is already in %s", lang)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Gather all the texts of the status,
+	// escaping plain text fields as the
+	// provider is asked to translate HTML.
+	texts := &translateTexts{}
+	content := texts.add(target.Content)
+	spoilerText := texts.add(html.EscapeString(target.ContentWarning))
+
+	var pollOptions []int
+	if target.Poll != nil {
+		pollOptions = make([]int, len(target.Poll.Options))
+		for i, option := range target.Poll.Options {
+			pollOptions[i] = texts.add(html.EscapeString(option))
+		}
+	}
+
+	descriptions := make([]int, len(target.Attachments))
+	for i, attachment := range target.Attachments {
+		descriptions[i] = texts.add(html.EscapeString(attachment.Description))
+	}
+
+	if len(texts.texts) == 0 {
+		const text = "status has nothing to translate"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Only count requests which would actually
+	// be sent to the provider against the limit.
+	if ok, reset := p.translateLimiter.Allow(requester.ID, time.Now()); !ok {
+		err := fmt.Errorf("translation rate limit reached for account %s", requester.ID)
+		text := "translation rate limit reached, try again after " + util.FormatISO8601(reset)
+		return nil, gtserror.NewWithCodeSafe(http.StatusTooManyRequests, err, text)
+	}
+
+	// Translate from the status language, letting
+	// the provider detect it if the status has none.
+	translated, source, err := p.translator.Translate(ctx,
+		texts.texts,
+		target.Language,
+		lang,
+	)
+	if err != nil {
+		if errors.Is(err, translate.ErrUnsupportedLanguage) {
+			text := fmt.Sprintf("translation from %s to %s is not supported", target.Language, lang)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, text)
+		}
+
+		err := gtserror.Newf("error translating status %s: %w", target.ID, err)
+		return nil, gtserror.NewWithCodeSafe(http.StatusServiceUnavailable, err, "translation provider unavailable")
+	}
+
+	// Don't trust HTML from the provider; sanitize
+	// everything before it gets served to clients.
+	apiTranslation := &apimodel.Translation{
+		Content:                text.SanitizeHTML(texts.get(translated, content)),
+		SpoilerText:            translatePlain(texts.get(translated, spoilerText)),
+		MediaAttachments:       make([]apimodel.TranslationAttachment, len(target.Attachments)),
+		DetectedSourceLanguage: source,
+		Provider:               p.translator.Provider(),
+	}
+
+	if target.Poll != nil {
+		apiTranslation.Poll = &apimodel.TranslationPoll{
+			ID:      target.Poll.ID,
+			Options: make([]apimodel.TranslationPollOption, len(pollOptions)),
+		}
+		for i, idx := range pollOptions {
+			apiTranslation.Poll.Options[i].Title = translatePlain(texts.get(translated, idx))
+		}
+	}
+
+	for i, attachment := range target.Attachments {
+		apiTranslation.MediaAttachments[i] = apimodel.TranslationAttachment{
+			ID:          attachment.ID,
+			Description: translatePlain(texts.get(translated, descriptions[i])),
+		}
+	}
+
+	return apiTranslation, nil
+}
+
+// translateTexts collects non-empty
+// texts to send for translation, so
+// empty fields don't use up quota.
+type translateTexts struct {
+	texts []string
+}
+
+// add adds text for translation, returning
+// its index, or -1 if the text is empty.
+func (t *translateTexts) add(text string) int {
+	if text == "" {
+		return -1
+	}
+	t.texts = append(t.texts, text)
+	return len(t.texts) - 1
+}
+
+// get returns the translation
+// at given index, if any.
+func (t *translateTexts) get(translated []string, idx int) string {
+	if idx < 0 {
+		return ""
+	}
+	return translated[idx]
+}
+
+// translatePlain sanitizes a translated
+// plain text field, which was sent to the
+// provider escaped, and unescapes it again.
+func translatePlain(translated string) string {
+	return html.UnescapeString(text.SanitizeHTML(translated))
+}
+
+// translateBase returns the base
+// language of a language tag, so
+// "en-GB" and "en" are the same.
+func translateBase(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return strings.ToLower(base)
+}
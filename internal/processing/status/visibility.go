@@ -0,0 +1,117 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+)
+
+// VisibilityChange changes the visibility of the requester's own
+// target status after publication, federating it out as an Update.
+//
+// Only the "safe" directions public -> unlisted (ie., de-listing a
+// status), and unlisted -> public are allowed, as these don't change
+// who is able to see the status, only where it gets shown.
+func (p *Processor) VisibilityChange(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	statusID string,
+	visibility apimodel.Visibility,
+) (*apimodel.Status, gtserror.WithCode) {
+	// Fetch status and ensure it's owned by requesting account.
+	status, errWithCode := p.c.GetOwnStatus(ctx, requester, statusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Ensure this isn't a boost.
+	if status.BoostOfID != "" {
+		return nil, gtserror.NewErrorNotFound(
+			errors.New("status is a boost wrapper"),
+			"target status not found",
+		)
+	}
+
+	// Check the new visibility is one we can switch to.
+	newVis := typeutils.APIVisToVis(visibility)
+	if newVis != gtsmodel.VisibilityPublic &&
+		newVis != gtsmodel.VisibilityUnlocked {
+		const text = "visibility must be one of public or unlisted"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if status.Visibility == newVis {
+		text := fmt.Sprintf("status visibility is already %s", visibility)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// And the current visibility is one we can switch from.
+	if !gtsmodel.VisibilityChangeable(status.Visibility, newVis) {
+		const text = "only visibility of public or unlisted statuses can be changed"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if err := p.setVisibility(ctx, requester, status, newVis); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Return an API model of the updated status.
+	return p.c.GetAPIStatus(ctx, requester, status)
+}
+
+// setVisibility sets the visibility of requester's own status
+// to newVis, updating it in the database and timeline caches,
+// and queueing side effects. The change must already be checked
+// as allowed using gtsmodel.VisibilityChangeable().
+func (p *Processor) setVisibility(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+	newVis gtsmodel.Visibility,
+) error {
+	// Update the status visibility in the database.
+	status.Visibility = newVis
+	if err := p.state.DB.UpdateStatus(ctx, status, "visibility"); err != nil {
+		return gtserror.Newf("error updating status in db: %w", err)
+	}
+
+	// Fix up the public / local
+	// timeline caches to match.
+	p.state.Caches.Timelines.StatusVisibilityChanged(status)
+
+	// Send it to the client API worker for async side-effects,
+	// ie. federating out the Update with new to / cc addressing,
+	// and streaming the update to relevant timelines.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       status,
+		Origin:         requester,
+	})
+
+	return nil
+}
@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gopkg/xslices"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusVisibilityTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusVisibilityTestSuite) TestVisibilityChange() {
+	ctx, cncl := context.WithCancel(suite.T().Context())
+	defer cncl()
+
+	requester := suite.testAccounts["local_account_1"]
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	// Status should be in the
+	// public timeline to start.
+	suite.Contains(suite.publicTimelineIDs(ctx), status.ID)
+
+	// De-list the status.
+	apiStatus, errWithCode := suite.status.VisibilityChange(ctx,
+		requester,
+		status.ID,
+		apimodel.VisibilityUnlisted,
+	)
+	suite.NoError(errWithCode)
+	suite.Equal(apimodel.VisibilityUnlisted, apiStatus.Visibility)
+
+	// Check database model was updated.
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.VisibilityUnlocked, dbStatus.Visibility)
+
+	// Status should now be gone from the public timeline.
+	suite.NotContains(suite.publicTimelineIDs(ctx), status.ID)
+
+	// De-listing again should fail.
+	_, errWithCode = suite.status.VisibilityChange(ctx,
+		requester,
+		status.ID,
+		apimodel.VisibilityUnlisted,
+	)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// Make the status public again.
+	apiStatus, errWithCode = suite.status.VisibilityChange(ctx,
+		requester,
+		status.ID,
+		apimodel.VisibilityPublic,
+	)
+	suite.NoError(errWithCode)
+	suite.Equal(apimodel.VisibilityPublic, apiStatus.Visibility)
+
+	// Status should be back in the public timeline.
+	suite.Contains(suite.publicTimelineIDs(ctx), status.ID)
+}
+
+func (suite *StatusVisibilityTestSuite) TestVisibilityChangeNotAllowed() {
+	ctx, cncl := context.WithCancel(suite.T().Context())
+	defer cncl()
+
+	requester := suite.testAccounts["local_account_1"]
+
+	for _, test := range []struct {
+		statusID   string
+		visibility apimodel.Visibility
+		code       int
+	}{
+		{
+			// Can't make a public status followers-only.
+			statusID:   suite.testStatuses["local_account_1_status_1"].ID,
+			visibility: apimodel.VisibilityPrivate,
+			code:       http.StatusBadRequest,
+		},
+		{
+			// Can't make a followers-only status public.
+			statusID:   suite.testStatuses["local_account_1_status_5"].ID,
+			visibility: apimodel.VisibilityPublic,
+			code:       http.StatusUnprocessableEntity,
+		},
+		{
+			// Can't change someone else's status.
+			statusID:   suite.testStatuses["local_account_2_status_1"].ID,
+			visibility: apimodel.VisibilityUnlisted,
+			code:       http.StatusNotFound,
+		},
+	} {
+		_, errWithCode := suite.status.VisibilityChange(ctx,
+			requester,
+			test.statusID,
+			test.visibility,
+		)
+		if suite.NotNil(errWithCode) {
+			suite.Equal(test.code, errWithCode.Code())
+		}
+	}
+}
+
+// publicTimelineIDs loads the first page
+// of the public timeline cache, returning
+// the IDs of the contained statuses.
+func (suite *StatusVisibilityTestSuite) publicTimelineIDs(ctx context.Context) []string {
+	page := &paging.Page{
+		Min:   paging.MinID(id.Lowest),
+		Max:   paging.MaxID(id.Highest),
+		Limit: 100,
+	}
+
+	apiStatuses, _, _, err := suite.state.Caches.Timelines.Public.Load(ctx,
+		page,
+		func(pg *paging.Page) ([]*gtsmodel.Status, error) {
+			return suite.db.GetPublicTimeline(ctx, pg)
+		},
+		func(ids []string) ([]*gtsmodel.Status, error) {
+			return suite.db.GetStatusesByIDs(ctx, ids)
+		},
+		nil, // no filtering
+		nil, // no language filtering
+		func(status *gtsmodel.Status) (*apimodel.Status, error) {
+			return &apimodel.Status{ID: status.ID}, nil
+		},
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return xslices.Gather(nil, apiStatuses, func(s *apimodel.Status) string {
+		return s.ID
+	})
+}
+
+func TestStatusVisibilityTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusVisibilityTestSuite))
+}
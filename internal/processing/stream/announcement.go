@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package stream
+
+import (
+	"context"
+	"encoding/json"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/stream"
+	"codeberg.org/gruf/go-byteutil"
+)
+
+// Announcement streams the given published or updated
+// announcement to *ALL* open user streams. As the same
+// payload is sent to everyone, it should not contain
+// any requester-specific state (ie., read, reactions).
+func (p *Processor) Announcement(ctx context.Context, announcement *apimodel.Announcement) {
+	b, err := json.Marshal(announcement)
+	if err != nil {
+		log.Errorf(ctx, "error marshaling json: %v", err)
+		return
+	}
+	p.streams.PostAll(ctx, stream.Message{
+		Payload: byteutil.B2S(b),
+		Event:   stream.EventTypeAnnouncement,
+		Stream:  []string{stream.TimelineHome},
+	})
+}
+
+// AnnouncementReaction streams the given updated reaction
+// count of an announcement to *ALL* open user streams.
+func (p *Processor) AnnouncementReaction(ctx context.Context, reaction *apimodel.AnnouncementReactionEvent) {
+	b, err := json.Marshal(reaction)
+	if err != nil {
+		log.Errorf(ctx, "error marshaling json: %v", err)
+		return
+	}
+	p.streams.PostAll(ctx, stream.Message{
+		Payload: byteutil.B2S(b),
+		Event:   stream.EventTypeAnnouncementReaction,
+		Stream:  []string{stream.TimelineHome},
+	})
+}
+
+// AnnouncementDelete streams the delete (or unpublish) of the
+// given announcement ID to *ALL* open user streams.
+func (p *Processor) AnnouncementDelete(ctx context.Context, announcementID string) {
+	p.streams.PostAll(ctx, stream.Message{
+		Payload: announcementID,
+		Event:   stream.EventTypeAnnouncementDelete,
+		Stream:  []string{stream.TimelineHome},
+	})
+}
@@ -0,0 +1,65 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/stream"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListRemoveTestSuite struct {
+	StreamTestSuite
+}
+
+func (suite *ListRemoveTestSuite) TestStreamListRemove() {
+	var (
+		ctx           = suite.T().Context()
+		account       = suite.testAccounts["local_account_1"]
+		targetAccount = suite.testAccounts["local_account_2"]
+		listID        = "01H0G8E4Q2J3FE3JDWJVWEDCD1"
+		streamType    = stream.TimelineList + ":" + listID
+	)
+
+	listStream, errWithCode := suite.streamProcessor.Open(ctx, account, streamType)
+	suite.NoError(errWithCode)
+
+	homeStream, errWithCode := suite.streamProcessor.Open(ctx, account, stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	suite.streamProcessor.ListRemove(ctx, account, listID, targetAccount.ID)
+
+	msg, ok := listStream.Recv(ctx)
+	suite.True(ok)
+	suite.Equal(stream.EventTypeListRemove, msg.Event)
+	suite.Equal(targetAccount.ID, msg.Payload)
+	suite.Equal([]string{streamType}, msg.Stream)
+
+	// Removal should only go to the list stream.
+	ctx, cncl := context.WithTimeout(ctx, time.Second)
+	defer cncl()
+	_, ok = homeStream.Recv(ctx)
+	suite.False(ok)
+}
+
+func TestListRemoveTestSuite(t *testing.T) {
+	suite.Run(t, &ListRemoveTestSuite{})
+}
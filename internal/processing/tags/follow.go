@@ -58,8 +58,10 @@ func (p *Processor) Follow(
 		}
 	}
 
-	// Follow the tag.
-	if err := p.state.DB.PutFollowedTag(ctx, account.ID, tag.ID); err != nil {
+	// Follow the tag. Follows of an aliased
+	// tag are stored against the tag it's an
+	// alias of, which shares its timeline.
+	if err := p.state.DB.PutFollowedTag(ctx, account.ID, tag.CanonicalID()); err != nil {
 		return nil, gtserror.NewErrorInternalError(
 			gtserror.Newf("DB error following tag %s: %w", tag.ID, err),
 		)
@@ -47,7 +47,7 @@ func (p *Processor) Get(
 		)
 	}
 
-	following, err := p.state.DB.IsAccountFollowingTag(ctx, account.ID, tag.ID)
+	following, err := p.state.DB.IsAccountFollowingTag(ctx, account.ID, tag.CanonicalID())
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(
 			gtserror.Newf("DB error checking whether account %s follows tag %s: %w", account.ID, tag.ID, err),
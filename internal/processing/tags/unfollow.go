@@ -49,8 +49,9 @@ func (p *Processor) Unfollow(
 		)
 	}
 
-	// Unfollow the tag.
-	if err := p.state.DB.DeleteFollowedTag(ctx, account.ID, tag.ID); err != nil {
+	// Unfollow the tag (or the
+	// tag it's an alias of).
+	if err := p.state.DB.DeleteFollowedTag(ctx, account.ID, tag.CanonicalID()); err != nil {
 		return nil, gtserror.NewErrorInternalError(
 			gtserror.Newf("DB error unfollowing tag %s: %w", tag.ID, err),
 		)
@@ -20,55 +20,81 @@
 import (
 	"context"
 	"errors"
-	"fmt"
+	"slices"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
-	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
 )
 
-// FavedTimelineGet ...
-func (p *Processor) FavedTimelineGet(ctx context.Context, authed *apiutil.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
-	statuses, nextMaxID, prevMinID, err := p.state.DB.GetFavedTimeline(ctx, authed.Account.ID, maxID, minID, limit)
+// FavedTimelineGet returns a pageable response of statuses that
+// have been faved by the requesting account. Paging for this response
+// is done based on fave ID rather than status ID, so that the response
+// is ordered by when statuses were faved, and so that paging remains
+// stable when statuses are unfaved in between requests for pages.
+func (p *Processor) FavedTimelineGet(ctx context.Context, authed *apiutil.Auth, page *paging.Page) (*apimodel.PageableResponse, gtserror.WithCode) {
+	faves, err := p.state.DB.GetFavedTimeline(ctx, authed.Account.ID, page)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		err = fmt.Errorf("FavedTimelineGet: db error getting statuses: %w", err)
+		err = gtserror.Newf("db error getting faves: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	count := len(statuses)
+	count := len(faves)
 	if count == 0 {
-		return util.EmptyPageableResponse(), nil
+		return paging.EmptyResponse(), nil
 	}
 
-	items := make([]interface{}, 0, count)
-	for _, s := range statuses {
-		visible, err := p.visFilter.StatusVisible(ctx, authed.Account, s)
-		if err != nil {
-			log.Errorf(ctx, "error checking status visibility: %v", err)
-			continue
-		}
+	var (
+		// Get the lowest and highest fave
+		// ID values, used for paging. These
+		// are set before filtering statuses,
+		// so the caller can still page properly.
+		lo = faves[count-1].ID
+		hi = faves[0].ID
 
-		if !visible {
-			continue
-		}
+		// Best-guess items length.
+		items = make([]interface{}, 0, count)
+	)
 
-		apiStatus, err := p.converter.StatusToAPIStatus(ctx, s, authed.Account)
+	// Gather the faved status IDs.
+	statusIDs := make([]string, len(faves))
+	for i, fave := range faves {
+		statusIDs[i] = fave.StatusID
+	}
+
+	// Load all faved statuses at once.
+	statuses, err := p.state.DB.GetStatusesByIDs(ctx, statusIDs)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting faved statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Drop statuses not visible to requester.
+	statuses = slices.DeleteFunc(statuses, func(status *gtsmodel.Status) bool {
+		visible, err := p.visFilter.StatusVisible(ctx, authed.Account, status)
 		if err != nil {
-			log.Errorf(ctx, "error convering to api status: %v", err)
-			continue
+			log.Errorf(ctx, "error checking status visibility: %v", err)
+			return true
 		}
+		return !visible
+	})
 
+	// Convert the statuses.
+	for _, apiStatus := range p.converter.StatusesToAPIStatuses(ctx,
+		authed.Account,
+		statuses,
+	) {
 		items = append(items, apiStatus)
 	}
 
-	return util.PackagePageableResponse(util.PageableResponseParams{
-		Items:          items,
-		Path:           "/api/v1/favourites",
-		NextMaxIDValue: nextMaxID,
-		PrevMinIDValue: prevMinID,
-		Limit:          limit,
-	})
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/favourites",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
 }
@@ -0,0 +1,178 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"github.com/gorilla/feeds"
+)
+
+// TagFeedGet returns a feed of the latest public statuses with the
+// given tag name, for syndication to unauthenticated readers. Only
+// statuses by discoverable and indexable accounts are included.
+func (p *Processor) TagFeedGet(
+	ctx context.Context,
+	tagName string,
+	page *paging.Page,
+) (*feeds.Feed, gtserror.WithCode) {
+	if !config.GetInstanceExposeTagFeeds() {
+		const text = "this instance does not expose tag feeds"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Fetch the requested tag with name.
+	tag, errWithCode := p.getTag(ctx, tagName)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Check for a useable returned tag for endpoint.
+	if tag == nil || !*tag.Useable || !*tag.Listable {
+		const text = "tag was not found, or not useable/listable on this instance"
+		return nil, gtserror.NewWithCode(http.StatusNotFound, text)
+	}
+
+	// Load latest page of statuses with tag.
+	statuses, err := p.state.DB.GetTagTimeline(ctx, tag.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tag timeline: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	host := config.GetHost()
+	protocol := config.GetProtocol()
+	return p.statusesToFeed(ctx, &feeds.Feed{
+		Title:       "Posts tagged #" + tag.Name + " on " + host,
+		Description: "Public posts tagged #" + tag.Name + " on " + host,
+		Link:        &feeds.Link{Href: protocol + "://" + host + "/tags/" + tag.Name},
+	}, statuses)
+}
+
+// LocalFeedGet returns a feed of the latest public statuses by local
+// accounts, for syndication to unauthenticated readers. Only statuses
+// by discoverable and indexable accounts are included.
+func (p *Processor) LocalFeedGet(
+	ctx context.Context,
+	page *paging.Page,
+) (*feeds.Feed, gtserror.WithCode) {
+	if !config.GetInstanceExposeLocalFeed() {
+		const text = "this instance does not expose its local feed"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Load latest page of local statuses.
+	statuses, err := p.state.DB.GetLocalTimeline(ctx, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting local timeline: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	host := config.GetHost()
+	protocol := config.GetProtocol()
+	return p.statusesToFeed(ctx, &feeds.Feed{
+		Title:       "Local posts on " + host,
+		Description: "Public posts by accounts on " + host,
+		Link:        &feeds.Link{Href: protocol + "://" + host},
+	}, statuses)
+}
+
+// statusesToFeed adds the given statuses to feed as items,
+// skipping those that should not be syndicated publicly.
+// The feed's updated time is set from the latest status.
+func (p *Processor) statusesToFeed(
+	ctx context.Context,
+	feed *feeds.Feed,
+	statuses []*gtsmodel.Status,
+) (*feeds.Feed, gtserror.WithCode) {
+	for _, status := range statuses {
+		if !p.feedable(ctx, status) {
+			continue
+		}
+
+		// Only embed media in item content
+		// for local accounts that opted in.
+		var fullContent bool
+		if s := status.Account.Settings; s != nil {
+			fullContent = util.PtrOrZero(s.RSSFullContent)
+		}
+
+		item, err := p.converter.StatusToRSSItem(ctx, status, fullContent)
+		if err != nil {
+			err := gtserror.Newf("error converting status to feed item: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if feed.Updated.IsZero() {
+			// Statuses are newest first, so
+			// use first as the updated time.
+			feed.Updated = status.CreatedAt
+		}
+
+		feed.Add(item)
+	}
+
+	return feed, nil
+}
+
+// feedable returns whether status may be included in
+// a feed served to unauthenticated readers, ie., that
+// it's publicly visible, federated, and its author is
+// both discoverable and indexable.
+func (p *Processor) feedable(ctx context.Context, status *gtsmodel.Status) bool {
+	if status.Account == nil {
+		// Ensure author populated.
+		var err error
+		status.Account, err = p.state.DB.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			log.Errorf(ctx, "error getting status %s author: %v", status.URI, err)
+			return false
+		}
+	}
+
+	if !*status.Account.Discoverable ||
+		!*status.Account.Indexable {
+		// Author hasn't
+		// opted in.
+		return false
+	}
+
+	if !*status.Federated {
+		// Local-only statuses are
+		// never shown on the web.
+		return false
+	}
+
+	// Check the visibility of status to unauthenticated requester.
+	ok, err := p.visFilter.StatusPublicTimelineable(ctx, nil, status)
+	if err != nil {
+		log.Errorf(ctx, "error checking status %s visibility: %v", status.URI, err)
+		return false
+	}
+
+	return ok
+}
@@ -0,0 +1,116 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline_test
+
+import (
+	"net/http"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeedTestSuite struct {
+	TimelineStandardTestSuite
+}
+
+func (suite *FeedTestSuite) TestTagFeedGetDisabled() {
+	_, errWithCode := suite.timeline.TagFeedGet(
+		suite.T().Context(),
+		"welcome",
+		&paging.Page{Limit: 20},
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *FeedTestSuite) TestTagFeedGet() {
+	config.SetInstanceExposeTagFeeds(true)
+
+	feed, errWithCode := suite.timeline.TagFeedGet(
+		suite.T().Context(),
+		"welcome",
+		&paging.Page{Limit: 20},
+	)
+	suite.NoError(errWithCode)
+
+	suite.Equal("Posts tagged #welcome on localhost:8080", feed.Title)
+	suite.Equal("http://localhost:8080/tags/welcome", feed.Link.Href)
+	if suite.Len(feed.Items, 1) {
+		suite.Equal(suite.testStatuses["admin_account_status_1"].URL, feed.Items[0].Id)
+		suite.Equal(suite.testStatuses["admin_account_status_1"].CreatedAt.Unix(), feed.Updated.Unix())
+	}
+}
+
+func (suite *FeedTestSuite) TestTagFeedGetUnknownTag() {
+	config.SetInstanceExposeTagFeeds(true)
+
+	_, errWithCode := suite.timeline.TagFeedGet(
+		suite.T().Context(),
+		"thisTagDoesNotExist",
+		&paging.Page{Limit: 20},
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *FeedTestSuite) TestLocalFeedGetDisabled() {
+	_, errWithCode := suite.timeline.LocalFeedGet(
+		suite.T().Context(),
+		&paging.Page{Limit: 20},
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *FeedTestSuite) TestLocalFeedGet() {
+	config.SetInstanceExposeLocalFeed(true)
+
+	feed, errWithCode := suite.timeline.LocalFeedGet(
+		suite.T().Context(),
+		&paging.Page{Limit: 20},
+	)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(feed.Items)
+
+	// Index test accounts by ID.
+	accounts := make(map[string]*gtsmodel.Account, len(suite.testAccounts))
+	for _, account := range suite.testAccounts {
+		accounts[account.ID] = account
+	}
+
+	// Only statuses by accounts that are both
+	// discoverable and indexable should be included.
+	for _, item := range feed.Items {
+		var found bool
+		for _, status := range suite.testStatuses {
+			if status.URL != item.Id {
+				continue
+			}
+			found = true
+
+			account := accounts[status.AccountID]
+			suite.True(*account.Discoverable, item.Id)
+			suite.True(*account.Indexable, item.Id)
+		}
+		suite.True(found, item.Id)
+	}
+}
+
+func TestFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedTestSuite))
+}
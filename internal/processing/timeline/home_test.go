@@ -126,6 +126,54 @@ func (suite *HomeTestSuite) TestHomeTimelineGetHideFiltered() {
 	suite.False(filteredStatusFound)
 }
 
+// A timeline should only contain statuses in the requester's chosen languages.
+func (suite *HomeTestSuite) TestHomeTimelineGetChosenLanguages() {
+	var (
+		ctx       = suite.T().Context()
+		requester = new(gtsmodel.Account)
+		page      = &paging.Page{Min: paging.SinceID(""), Max: paging.MaxID(""), Limit: 40}
+	)
+
+	// Copy the requester so we can
+	// safely modify their settings.
+	*requester = *suite.testAccounts["local_account_1"]
+	requester.Settings = new(gtsmodel.AccountSettings)
+
+	// Fetch the timeline with no languages chosen.
+	resp, errWithCode := suite.timeline.HomeTimelineGet(ctx, requester, page, false)
+	suite.NoError(errWithCode)
+	all := len(resp.Items)
+
+	// Precondition, english statuses in the timeline.
+	english := 0
+	for _, item := range resp.Items {
+		if lang := item.(*apimodel.Status).Language; lang != nil && *lang == "en" {
+			english++
+		}
+	}
+	if english == 0 {
+		suite.FailNow("precondition failed: no english statuses in timeline")
+	}
+
+	// Fetch timeline again with only french chosen.
+	requester.Settings.ChosenLanguages = []string{"fr"}
+	resp, errWithCode = suite.timeline.HomeTimelineGet(ctx, requester, page, false)
+	suite.NoError(errWithCode)
+
+	// No english statuses should be left,
+	// only those with language unknown.
+	suite.Len(resp.Items, all-english)
+	for _, item := range resp.Items {
+		suite.Nil(item.(*apimodel.Status).Language)
+	}
+
+	// Fetch timeline again with english also chosen.
+	requester.Settings.ChosenLanguages = []string{"fr", "en"}
+	resp, errWithCode = suite.timeline.HomeTimelineGet(ctx, requester, page, false)
+	suite.NoError(errWithCode)
+	suite.Len(resp.Items, all)
+}
+
 func TestHomeTestSuite(t *testing.T) {
 	suite.Run(t, new(HomeTestSuite))
 }
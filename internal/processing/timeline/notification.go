@@ -31,6 +31,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
@@ -67,6 +68,11 @@ func (p *Processor) NotificationsGet(
 		hi = notifs[0].ID
 	)
 
+	// Convert this page using a conversion cache,
+	// so that accounts, emojis etc repeated across
+	// notifications are only converted to API models once.
+	ctx = typeutils.WithConversionCache(ctx)
+
 	for _, n := range notifs {
 		visible, err := p.notifVisible(ctx, n, requester)
 		if err != nil {
@@ -139,5 +139,15 @@ func (p *Processor) getTag(ctx context.Context, tagName string) (*gtsmodel.Tag,
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if tag != nil && tag.IsAlias() {
+		// Aliased tags share the timeline of the tag
+		// they're an alias of, so return that instead.
+		tag, err = p.state.DB.GetTag(ctx, tag.AliasOfID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting aliased tag: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
 	return tag, nil
 }
@@ -86,10 +86,22 @@ func (p *Processor) getStatusTimeline(
 ) {
 	var err error
 
+	// Convert this page using a conversion cache,
+	// so that accounts, emojis etc repeated across
+	// statuses are only converted to API models once.
+	ctx = typeutils.WithConversionCache(ctx)
+
 	// Ensure we have valid
 	// input paging cursor.
 	id.ValidatePage(page)
 
+	// Get requester's settings for language filtering,
+	// nil (i.e. all languages) if unauthenticated.
+	var settings *gtsmodel.AccountSettings
+	if requester != nil {
+		settings = requester.Settings
+	}
+
 	// Load status page via timeline cache, also
 	// getting lo, hi values for next, prev pages.
 	//
@@ -114,6 +126,10 @@ func(ids []string) ([]*gtsmodel.Status, error) {
 		// filtering function.
 		filter,
 
+		// Requester's chosen
+		// languages function.
+		settings.LanguageChosen,
+
 		// Frontend API model preparation function.
 		func(status *gtsmodel.Status) (*apimodel.Status, error) {
 
@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"context"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// linksDays is the number of days
+// over which link shares are counted.
+const linksDays = 7
+
+// LinksGet returns up to limit links shared in public
+// statuses by the most accounts over the past week.
+func (p *Processor) LinksGet(ctx context.Context, limit int) ([]*apimodel.TrendsLink, gtserror.WithCode) {
+	trends, err := p.state.DB.GetTrendingCards(ctx, linksDays, limit)
+	if err != nil {
+		err := gtserror.Newf("db error getting trending cards: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	links := make([]*apimodel.TrendsLink, 0, len(trends))
+	for _, trend := range trends {
+		links = append(links, p.converter.CardTrendToAPITrendsLink(trend))
+	}
+
+	return links, nil
+}
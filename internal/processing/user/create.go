@@ -19,12 +19,16 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/messages"
@@ -103,6 +107,12 @@ func (p *Processor) Create(
 		return nil, gtserror.NewErrorConflict(err, err.Error())
 	}
 
+	// Ensure all instance rules were acknowledged.
+	ruleIDs, errWithCode := p.acknowledgedRules(ctx, form)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Only store reason if one is required.
 	var reason string
 	if config.GetAccountsReasonRequired() {
@@ -118,16 +128,39 @@ func (p *Processor) Create(
 		}
 	}
 
+	// Check invite code, if provided.
+	var inviteID string
+	if form.InviteCode != "" {
+		invite, errWithCode := p.useInvite(ctx, form.InviteCode)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		inviteID = invite.ID
+	}
+
 	user, err := p.state.DB.NewSignup(ctx, gtsmodel.NewSignup{
 		Username: form.Username,
 		Email:    form.Email,
 		Password: form.Password,
 		Reason:   text.StripHTMLFromText(reason),
+		RuleIDs:  ruleIDs,
 		SignUpIP: form.IP,
 		Locale:   form.Locale,
+		InviteID: inviteID,
 		AppID:    app.ID,
 	})
 	if err != nil {
+		if inviteID != "" {
+			// Give back the invite use taken above,
+			// as nobody signed up with it after all.
+			// Use a separate context here in case the
+			// error was ctx cancellation.
+			ctx := context.WithoutCancel(ctx)
+			if err := p.state.DB.DecrementInviteUses(ctx, inviteID); err != nil {
+				log.Errorf(ctx, "db error decrementing invite uses: %v", err)
+			}
+		}
+
 		err := fmt.Errorf("db error creating new signup: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -147,6 +180,89 @@ func (p *Processor) Create(
 	return user, nil
 }
 
+// useInvite gets the invite with the given code and, if
+// it's still usable, increments its uses by one, so that
+// it's counted against the invite's limit from now on.
+func (p *Processor) useInvite(
+	ctx context.Context,
+	code string,
+) (*gtsmodel.Invite, gtserror.WithCode) {
+	const text = "invite is invalid, expired, or has been used up"
+
+	if config.GetAccountsInvitesAllowed() == config.AccountsInvitesAllowedNone {
+		const text = "invites are not enabled on this instance"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	invite, err := p.state.DB.GetInviteByCode(ctx, code)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := fmt.Errorf("db error getting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil || !invite.IsUsable(time.Now()) {
+		err := fmt.Errorf("invite %s not usable", code)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, text)
+	}
+
+	// Increment uses in the db, which
+	// rechecks usability atomically in
+	// case of concurrent sign-ups.
+	if err := p.state.DB.IncrementInviteUses(ctx, invite.ID); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("invite %s not usable", code)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, text)
+		}
+
+		err := fmt.Errorf("db error incrementing invite uses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return invite, nil
+}
+
+// acknowledgedRules checks that the given sign up form acknowledges
+// all current instance rules, returning the IDs of acknowledged rules.
+func (p *Processor) acknowledgedRules(
+	ctx context.Context,
+	form *apimodel.AccountCreateRequest,
+) ([]string, gtserror.WithCode) {
+	rules, err := p.state.DB.GetActiveRules(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := fmt.Errorf("db error getting instance rules: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(rules) == 0 {
+		// No rules
+		// to check.
+		return nil, nil
+	}
+
+	ruleIDs := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+
+	if len(form.RuleIDs) == 0 {
+		// No specific rules were given, as is
+		// the case for Mastodon API clients. The
+		// (required) agreement covers all rules.
+		return ruleIDs, nil
+	}
+
+	// Ensure every current rule was given.
+	for _, ruleID := range ruleIDs {
+		if !slices.Contains(form.RuleIDs, ruleID) {
+			const text = "you must acknowledge all instance rules"
+			err := fmt.Errorf("rule %s not acknowledged", ruleID)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, text)
+		}
+	}
+
+	return ruleIDs, nil
+}
+
 // TokenForNewUser generates an OAuth Bearer token
 // for a new user (with account) created by Create().
 func (p *Processor) TokenForNewUser(
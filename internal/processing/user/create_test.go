@@ -19,6 +19,7 @@
 
 import (
 	"net"
+	"net/http"
 	"testing"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -68,6 +69,69 @@ func (suite *CreateTestSuite) TestCreateOK() {
 	suite.Equal("Bearer", userAccessToken.TokenType)
 }
 
+func (suite *CreateTestSuite) TestCreateRulesAcknowledged() {
+	var (
+		ctx  = suite.T().Context()
+		app  = suite.testApps["application_1"]
+		form = &apimodel.AccountCreateRequest{
+			Username:  "someone_new",
+			Email:     "someone_new@example.org",
+			Password:  "a long enough password for this endpoint",
+			Agreement: true,
+			IP:        net.ParseIP("192.0.2.128"),
+		}
+	)
+
+	// Acknowledge all the current instance rules.
+	rules, err := suite.db.GetActiveRules(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	for _, rule := range rules {
+		form.RuleIDs = append(form.RuleIDs, rule.ID)
+	}
+
+	user, errWithCode := suite.user.Create(ctx, app, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Acknowledged rules should be stored on the user.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(form.RuleIDs, dbUser.RulesAcknowledgedIDs)
+	suite.False(dbUser.RulesAcknowledgedAt.IsZero())
+}
+
+func (suite *CreateTestSuite) TestCreateRulesNotAcknowledged() {
+	var (
+		ctx  = suite.T().Context()
+		app  = suite.testApps["application_1"]
+		form = &apimodel.AccountCreateRequest{
+			Username:  "someone_new",
+			Email:     "someone_new@example.org",
+			Password:  "a long enough password for this endpoint",
+			Agreement: true,
+			IP:        net.ParseIP("192.0.2.128"),
+		}
+	)
+
+	// Acknowledge only the first instance rule.
+	rules, err := suite.db.GetActiveRules(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Greater(len(rules), 1)
+	form.RuleIDs = []string{rules[0].ID}
+
+	_, errWithCode := suite.user.Create(ctx, app, form)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: you must acknowledge all instance rules", errWithCode.Safe())
+}
+
 func TestCreateTestSuite(t *testing.T) {
 	suite.Run(t, &CreateTestSuite{})
 }
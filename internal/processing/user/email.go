@@ -52,7 +52,10 @@ func (p *Processor) EmailChange(
 
 	// Ensure new email address is valid.
 	if err := validate.Email(newEmail); err != nil {
-		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorBadRequest(err, err.Error()),
+			gtserror.ErrCodeValidationFailed,
+		)
 	}
 
 	// Ensure new email address is different
@@ -0,0 +1,204 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/email"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// LoginRecord records a sign-in attempt to the given user's
+// account in their sign-in history, if configured to do so.
+//
+// If the sign-in was successful, and it came from an IP address
+// not seen in the user's previous successful sign-ins, the user
+// will be emailed about it (if configured), asynchronously.
+//
+// Errors are logged rather than returned, as failing to
+// record a sign-in should not prevent the sign-in itself.
+func (p *Processor) LoginRecord(
+	ctx context.Context,
+	user *gtsmodel.User,
+	ip net.IP,
+	userAgent string,
+	successful bool,
+) {
+	if config.GetAccountsLoginHistoryRetention() <= 0 {
+		// Sign-in history
+		// not recorded.
+		return
+	}
+
+	if successful && config.GetAccountsLoginNotificationEmails() {
+		// Check whether this sign-in is from a new IP
+		// *before* storing it, and email user if so.
+		newIP, err := p.isNewLoginIP(ctx, user.ID, ip)
+		if err != nil {
+			log.Errorf(ctx, "error checking sign-in history: %v", err)
+		} else if newIP {
+			p.emailNewLogin(user, ip, userAgent, time.Now())
+		}
+	}
+
+	login := &gtsmodel.UserLogin{
+		ID:         id.NewULID(),
+		UserID:     user.ID,
+		IP:         ip,
+		UserAgent:  userAgent,
+		Successful: util.Ptr(successful),
+	}
+
+	if err := p.state.DB.PutUserLogin(ctx, login); err != nil {
+		log.Errorf(ctx, "error storing sign-in: %v", err)
+	}
+}
+
+// isNewLoginIP returns whether a successful sign-in to the
+// given user's account from ip should be considered "new",
+// ie., the user has signed in before, but never from this IP.
+func (p *Processor) isNewLoginIP(ctx context.Context, userID string, ip net.IP) (bool, error) {
+	if ip == nil {
+		// Can't tell.
+		return false, nil
+	}
+
+	// Don't consider the very first
+	// recorded sign-in to be new, to
+	// avoid emailing on every signup.
+	has, err := p.state.DB.HasUserLogins(ctx, userID)
+	if err != nil {
+		return false, gtserror.Newf("db error checking user logins: %w", err)
+	}
+
+	if !has {
+		return false, nil
+	}
+
+	seen, err := p.state.DB.IsUserLoginIPSeen(ctx, userID, ip)
+	if err != nil {
+		return false, gtserror.Newf("db error checking user login ip: %w", err)
+	}
+
+	return !seen, nil
+}
+
+// emailNewLogin enqueues a job to email the given
+// user about a sign-in to their account from a new IP.
+func (p *Processor) emailNewLogin(
+	user *gtsmodel.User,
+	ip net.IP,
+	userAgent string,
+	at time.Time,
+) {
+	if user.Email == "" {
+		// Nowhere
+		// to send.
+		return
+	}
+
+	// Take a copy of what we need
+	// from user before going async.
+	toAddress := user.Email
+	accountID := user.AccountID
+
+	p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+		account, err := p.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			accountID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "db error getting account: %v", err)
+			return
+		}
+
+		instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+		if err != nil {
+			log.Errorf(ctx, "db error getting instance: %v", err)
+			return
+		}
+
+		if err := p.emailSender.SendNewLoginEmail(
+			toAddress,
+			email.NewLoginData{
+				Username:     account.Username,
+				InstanceURL:  instance.URI,
+				InstanceName: instance.Title,
+				IP:           ip.String(),
+				UserAgent:    userAgent,
+				Time:         at.UTC().Format(time.RFC1123),
+				SettingsURL:  instance.URI + "/settings/user/tokens",
+			},
+		); err != nil {
+			log.Errorf(ctx, "error sending new sign-in email: %v", err)
+		}
+	})
+}
+
+// LoginsGet returns a page of the given
+// user's sign-in history, newest first.
+func (p *Processor) LoginsGet(
+	ctx context.Context,
+	userID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	logins, err := p.state.DB.GetUserLogins(ctx, userID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting user logins: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(logins)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	var (
+		// Get the lowest and highest
+		// ID values, used for paging.
+		lo = logins[count-1].ID
+		hi = logins[0].ID
+
+		// Best-guess items length.
+		items = make([]interface{}, 0, count)
+	)
+
+	for _, login := range logins {
+		items = append(items, p.converter.UserLoginToAPIUserLogin(login))
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/user/logins",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
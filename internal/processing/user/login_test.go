@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/paging"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoginTestSuite struct {
+	UserStandardTestSuite
+}
+
+// runQueued runs any jobs queued on the processing worker queue.
+func (suite *LoginTestSuite) runQueued(ctx context.Context) {
+	for {
+		fn, ok := suite.state.Workers.Processing.Queue.Pop()
+		if !ok {
+			return
+		}
+		fn(ctx)
+	}
+}
+
+func (suite *LoginTestSuite) TestLoginRecord() {
+	var (
+		ctx       = suite.T().Context()
+		user      = suite.testUsers["local_account_1"]
+		knownIP   = net.ParseIP("192.0.2.1")
+		unknownIP = net.ParseIP("198.51.100.1")
+		userAgent = "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0"
+	)
+
+	// First successful sign-in: nothing
+	// to compare against, so no email.
+	suite.user.LoginRecord(ctx, user, knownIP, userAgent, true)
+	suite.runQueued(ctx)
+	suite.Empty(suite.sentEmails)
+
+	// Failed sign-in from a new IP: no email.
+	suite.user.LoginRecord(ctx, user, unknownIP, userAgent, false)
+	suite.runQueued(ctx)
+	suite.Empty(suite.sentEmails)
+
+	// Successful sign-in from the
+	// same IP as before: no email.
+	suite.user.LoginRecord(ctx, user, knownIP, userAgent, true)
+	suite.runQueued(ctx)
+	suite.Empty(suite.sentEmails)
+
+	// Successful sign-in from a new IP: email.
+	suite.user.LoginRecord(ctx, user, unknownIP, userAgent, true)
+	suite.runQueued(ctx)
+	suite.Len(suite.sentEmails, 1)
+	suite.Contains(suite.sentEmails[user.Email], "Subject: GoToSocial New Sign-In")
+	suite.Contains(suite.sentEmails[user.Email], "IP address: 198.51.100.1")
+	suite.Contains(suite.sentEmails[user.Email], "User-agent: "+userAgent)
+	suite.Contains(suite.sentEmails[user.Email], "http://localhost:8080/settings/user/tokens")
+
+	// All four attempts should be
+	// in the history, newest first.
+	resp, errWithCode := suite.user.LoginsGet(ctx, user.ID, &paging.Page{Limit: 20})
+	suite.NoError(errWithCode)
+	suite.Len(resp.Items, 4)
+}
+
+func TestLoginTestSuite(t *testing.T) {
+	suite.Run(t, new(LoginTestSuite))
+}
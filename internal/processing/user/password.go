@@ -19,7 +19,11 @@
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/validate"
@@ -40,7 +44,10 @@ func (p *Processor) PasswordChange(ctx context.Context, user *gtsmodel.User, old
 
 	// Ensure new password is strong enough.
 	if err := validate.Password(newPassword); err != nil {
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		return gtserror.WithErrCode(
+			gtserror.NewErrorBadRequest(err, err.Error()),
+			gtserror.ErrCodeValidationFailed,
+		)
 	}
 
 	// Ensure new password is different from old password.
@@ -72,3 +79,98 @@ func (p *Processor) PasswordChange(ctx context.Context, user *gtsmodel.User, old
 
 	return nil
 }
+
+// PasswordResetGetUser retrieves the user (with account) from
+// the database for the given "reset your password" token string.
+func (p *Processor) PasswordResetGetUser(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode) {
+	if token == "" {
+		err := errors.New("no token provided")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	user, err := p.state.DB.GetUserByResetPasswordToken(ctx, token)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// Real error.
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// No user found for this token.
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	// Ensure token actually belongs to this user.
+	if user.ResetPasswordToken != token {
+		err := errors.New("reset password token does not match user")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	// Ensure token not expired.
+	const oneDay = 24 * time.Hour
+	if user.ResetPasswordSentAt.Before(time.Now().Add(-oneDay)) {
+		err := errors.New("reset password token expired (older than one day)")
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	if user.Account == nil {
+		user.Account, err = p.state.DB.GetAccountByID(ctx, user.AccountID)
+		if err != nil {
+			// We need the account for a local user.
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !user.Account.SuspendedAt.IsZero() {
+		err := fmt.Errorf("account %s is suspended", user.AccountID)
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	return user, nil
+}
+
+// PasswordReset processes a password reset request, usually
+// initiated as a result of clicking on a link in a 'reset
+// your password' type email. The token is removed on success,
+// so each reset link can only be used once.
+func (p *Processor) PasswordReset(ctx context.Context, token string, newPassword string) (*gtsmodel.User, gtserror.WithCode) {
+	user, errWithCode := p.PasswordResetGetUser(ctx, token)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Ensure new password is strong enough.
+	if err := validate.Password(newPassword); err != nil {
+		return nil, gtserror.WithErrCode(
+			gtserror.NewErrorBadRequest(err, err.Error()),
+			gtserror.ErrCodeValidationFailed,
+		)
+	}
+
+	// Hash the new password.
+	encryptedPassword, err := bcrypt.GenerateFromPassword(
+		byteutil.S2B(newPassword),
+		bcrypt.DefaultCost,
+	)
+	if err != nil {
+		err := gtserror.Newf("%w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Set new password on user,
+	// and remove the used token.
+	user.EncryptedPassword = string(encryptedPassword)
+	user.ResetPasswordToken = ""
+	user.ResetPasswordSentAt = time.Time{}
+
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"encrypted_password",
+		"reset_password_token",
+		"reset_password_sent_at",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return user, nil
+}
@@ -20,6 +20,7 @@
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"codeberg.org/gruf/go-byteutil"
@@ -98,6 +99,89 @@ func (suite *ChangePasswordTestSuite) TestChangePasswordWeakNew() {
 	suite.NoError(err)
 }
 
+func (suite *ChangePasswordTestSuite) TestResetPasswordOK() {
+	ctx := suite.T().Context()
+	user := suite.testUsers["local_account_1"]
+
+	// Set a reset token on the user.
+	user.ResetPasswordToken = "some-reset-token"
+	user.ResetPasswordSentAt = time.Now()
+	err := suite.db.UpdateUser(ctx, user, "reset_password_token", "reset_password_sent_at")
+	suite.NoError(err)
+
+	resetUser, errWithCode := suite.user.PasswordReset(ctx, "some-reset-token", "verygoodnewpassword")
+	suite.NoError(errWithCode)
+	suite.Equal(user.ID, resetUser.ID)
+
+	// get user from the db again
+	dbUser := &gtsmodel.User{}
+	err = suite.db.GetByID(ctx, user.ID, dbUser)
+	suite.NoError(err)
+
+	// check the password has changed
+	err = bcrypt.CompareHashAndPassword(
+		byteutil.S2B(dbUser.EncryptedPassword),
+		byteutil.S2B("verygoodnewpassword"),
+	)
+	suite.NoError(err)
+
+	// check the token can't be used again
+	suite.Empty(dbUser.ResetPasswordToken)
+	_, errWithCode = suite.user.PasswordReset(ctx, "some-reset-token", "anotherverygoodnewpassword")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *ChangePasswordTestSuite) TestResetPasswordSetElsewhere() {
+	ctx := suite.T().Context()
+	testUser := suite.testUsers["local_account_1"]
+
+	// Ensure the user is cached.
+	if _, err := suite.db.GetUserByID(ctx, testUser.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Set a reset token on the user directly in
+	// the db, bypassing the cache, as the CLI does
+	// from a separate process to the server.
+	user := new(gtsmodel.User)
+	*user = *testUser
+	user.ResetPasswordToken = "some-reset-token"
+	user.ResetPasswordSentAt = time.Now()
+	err := suite.db.UpdateByID(ctx, user, user.ID, "reset_password_token", "reset_password_sent_at")
+	suite.NoError(err)
+
+	resetUser, errWithCode := suite.user.PasswordReset(ctx, "some-reset-token", "verygoodnewpassword")
+	suite.NoError(errWithCode)
+	suite.Equal(user.ID, resetUser.ID)
+}
+
+func (suite *ChangePasswordTestSuite) TestResetPasswordExpired() {
+	ctx := suite.T().Context()
+	user := suite.testUsers["local_account_1"]
+
+	// Set an old reset token on the user.
+	user.ResetPasswordToken = "some-reset-token"
+	user.ResetPasswordSentAt = time.Now().Add(-48 * time.Hour)
+	err := suite.db.UpdateUser(ctx, user, "reset_password_token", "reset_password_sent_at")
+	suite.NoError(err)
+
+	_, errWithCode := suite.user.PasswordReset(ctx, "some-reset-token", "verygoodnewpassword")
+	suite.EqualError(errWithCode, "reset password token expired (older than one day)")
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// get user from the db again
+	dbUser := &gtsmodel.User{}
+	err = suite.db.GetByID(ctx, user.ID, dbUser)
+	suite.NoError(err)
+
+	// check the password has not changed
+	err = bcrypt.CompareHashAndPassword(
+		byteutil.S2B(dbUser.EncryptedPassword),
+		byteutil.S2B("password"),
+	)
+	suite.NoError(err)
+}
+
 func TestChangePasswordTestSuite(t *testing.T) {
 	suite.Run(t, &ChangePasswordTestSuite{})
 }
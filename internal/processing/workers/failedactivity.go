@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
+)
+
+// errUnhandled is returned when processing a message
+// with an activity + object type that we don't handle.
+var errUnhandled = errors.New("unhandled")
+
+const (
+	// maxFailedActivityData is the maximum size of
+	// serialized message stored for a failed activity;
+	// above this the raw activity JSON gets dropped.
+	maxFailedActivityData = 64 * 1024
+
+	// maxFailedActivityError is the maximum length (in
+	// characters) of error text stored for a failed activity.
+	maxFailedActivityError = 2048
+)
+
+// storeFailedActivity stores the given message from the federation
+// API, which failed processing with err, as a failed activity in the
+// database, so that it isn't just dropped with a log line.
+func (p *fediAPI) storeFailedActivity(ctx context.Context, fMsg *messages.FromFediAPI, err error) {
+	if errors.Is(err, context.Canceled) && ctx.Err() != nil {
+		// Our own context was cancelled, ie.,
+		// we're shutting down. The message will
+		// be pushed back onto the queue for
+		// persisting, so nothing to do here.
+		return
+	}
+
+	// Serialize the message for storage, this
+	// includes the raw JSON of the AP object.
+	data, serr := fMsg.Serialize()
+	if serr != nil {
+		log.Errorf(ctx, "error serializing failed activity: %v", serr)
+		return
+	}
+
+	var truncated bool
+	if len(data) > maxFailedActivityData {
+		// Too large to store in full, drop the
+		// raw activity JSON (and anything else
+		// large with it) and only keep the rest.
+		msg := &messages.FromFediAPI{
+			APObjectType:   fMsg.APObjectType,
+			APActivityType: fMsg.APActivityType,
+			APIRI:          fMsg.APIRI,
+			Requesting:     fMsg.Requesting,
+			Receiving:      fMsg.Receiving,
+		}
+
+		data, serr = msg.Serialize()
+		if serr != nil {
+			log.Errorf(ctx, "error serializing failed activity: %v", serr)
+			return
+		}
+
+		truncated = true
+	}
+
+	// Cap the length of stored error text.
+	errText := err.Error()
+	if runes := []rune(errText); len(runes) > maxFailedActivityError {
+		errText = string(runes[:maxFailedActivityError])
+	}
+
+	failed := &gtsmodel.FailedActivity{
+		ID:             id.NewULID(),
+		APActivityType: fMsg.APActivityType,
+		APObjectType:   fMsg.APObjectType,
+		Reason:         failureReason(err),
+		Error:          errText,
+		Data:           data,
+		Truncated:      &truncated,
+	}
+
+	// Set the IRI of the activity / object
+	// if known, from either the message IRI
+	// or the ID of the provided AP object.
+	if fMsg.APIRI != nil {
+		failed.APIRI = fMsg.APIRI.String()
+	} else if withID, ok := fMsg.APObject.(ap.WithJSONLDId); ok {
+		if iri := ap.GetJSONLDId(withID); iri != nil {
+			failed.APIRI = iri.String()
+		}
+	}
+
+	if fMsg.Requesting != nil {
+		failed.RequestingAccountID = fMsg.Requesting.ID
+	}
+
+	if fMsg.Receiving != nil {
+		failed.ReceivingAccountID = fMsg.Receiving.ID
+	}
+
+	if err := p.state.DB.PutFailedActivity(ctx, failed); err != nil {
+		log.Errorf(ctx, "error storing failed activity: %v", err)
+	}
+}
+
+// failureReason classifies the given
+// error from processing an activity.
+func failureReason(err error) gtsmodel.FailureReason {
+	switch {
+	case errors.Is(err, errUnhandled):
+		return gtsmodel.FailureReasonUnhandled
+
+	case errors.Is(err, context.DeadlineExceeded):
+		return gtsmodel.FailureReasonTimeout
+
+	case gtserror.IsMalformed(err),
+		gtserror.IsWrongType(err):
+		return gtsmodel.FailureReasonMalformed
+
+	case gtserror.IsUnretrievable(err):
+		return gtsmodel.FailureReasonUnretrievable
+
+	case errors.Is(err, db.ErrNoEntries),
+		gtserror.IsNotFound(err):
+		return gtsmodel.FailureReasonNotFound
+
+	default:
+		return gtsmodel.FailureReasonOther
+	}
+}
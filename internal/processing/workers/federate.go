@@ -19,15 +19,22 @@
 
 import (
 	"context"
+	"errors"
 	"net/url"
+	"time"
 
 	"code.superseriousbusiness.org/activity/streams"
+	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/typeutils"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
 )
 
 // federate wraps functions for federating
@@ -169,6 +176,197 @@ func (f *federate) CreateStatus(ctx context.Context, status *gtsmodel.Status) er
 	return nil
 }
 
+// RelayLocalStatus Announces the given status from the instance
+// actor to its followers, when this instance is acting as a
+// relay endpoint. Peers subscribe to the relay by following
+// the instance actor, so this fans local public statuses out
+// to all of them. See RelayRemoteStatus for peer statuses.
+func (f *federate) RelayLocalStatus(ctx context.Context, status *gtsmodel.Status) error {
+	// Do nothing if we're
+	// not acting as a relay.
+	if !config.GetInstanceRelayEndpoint() {
+		return nil
+	}
+
+	// Only relay our own, top-level public statuses.
+	if status.IsLocalOnly() ||
+		!*status.Local ||
+		status.Visibility != gtsmodel.VisibilityPublic ||
+		status.BoostOfID != "" {
+		return nil
+	}
+
+	instanceAcct, err := f.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("db error getting instance account: %w", err)
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(instanceAcct.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	actorIRI, err := parseURI(instanceAcct.URI)
+	if err != nil {
+		return err
+	}
+
+	followersIRI, err := parseURI(instanceAcct.FollowersURI)
+	if err != nil {
+		return err
+	}
+
+	statusIRI, err := parseURI(status.URI)
+	if err != nil {
+		return err
+	}
+
+	// Create a new Announce of the status
+	// from the instance actor, addressed to
+	// relay subscribers, and CC'd public.
+	announce := streams.NewActivityStreamsAnnounce()
+	if err := ap.SetJSONLDIdStr(announce, instanceAcct.URI+"#relay/"+status.ID); err != nil {
+		return gtserror.Newf("error setting announce id: %w", err)
+	}
+	ap.AppendActorIRIs(announce, actorIRI)
+	ap.AppendObjectIRIs(announce, statusIRI)
+	ap.AppendTo(announce, followersIRI)
+	ap.AppendCc(announce, ap.PublicIRI())
+	ap.SetPublished(announce, status.CreatedAt)
+
+	// Send the Announce via the instance actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, announce,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			announce, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
+// RelayRemoteStatus Announces the status at the given IRI,
+// sent to the instance actor by its author, from the instance
+// actor to all relay subscribers except the author's instance.
+// Statuses from instances that aren't subscribed are dropped,
+// so that only subscribers can have statuses relayed.
+func (f *federate) RelayRemoteStatus(
+	ctx context.Context,
+	author *gtsmodel.Account,
+	statusIRI *url.URL,
+) error {
+	// Do nothing if we're
+	// not acting as a relay.
+	if !config.GetInstanceRelayEndpoint() {
+		return nil
+	}
+
+	instanceAcct, err := f.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("db error getting instance account: %w", err)
+	}
+
+	// Relay subscribers are
+	// followers of instance actor.
+	follows, err := f.state.DB.GetAccountFollowers(ctx, instanceAcct.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting relay subscribers: %w", err)
+	}
+
+	var (
+		subscribed bool
+		inboxes    = make([]*url.URL, 0, len(follows))
+		seen       = make(map[string]struct{}, len(follows))
+	)
+
+	for _, follow := range follows {
+		subscriber := follow.Account
+		if subscriber == nil || subscriber.IsLocal() {
+			continue
+		}
+
+		if subscriber.Domain == author.Domain {
+			// Don't relay the status
+			// back to where it came from.
+			subscribed = true
+			continue
+		}
+
+		// Prefer shared inbox where available,
+		// to only deliver once per instance.
+		inbox := subscriber.InboxURI
+		if s := util.PtrOrZero(subscriber.SharedInboxURI); s != "" {
+			inbox = s
+		}
+
+		if _, ok := seen[inbox]; ok || inbox == "" {
+			continue
+		}
+		seen[inbox] = struct{}{}
+
+		inboxIRI, err := parseURI(inbox)
+		if err != nil {
+			return err
+		}
+		inboxes = append(inboxes, inboxIRI)
+	}
+
+	if !subscribed {
+		log.Debugf(ctx, "dropping status %s from non-subscriber %s", statusIRI, author.URI)
+		return nil
+	}
+
+	if len(inboxes) == 0 {
+		// No one
+		// to relay to.
+		return nil
+	}
+
+	actorIRI, err := parseURI(instanceAcct.URI)
+	if err != nil {
+		return err
+	}
+
+	followersIRI, err := parseURI(instanceAcct.FollowersURI)
+	if err != nil {
+		return err
+	}
+
+	// Create a new Announce of the status
+	// from the instance actor, addressed to
+	// relay subscribers, and CC'd public.
+	announce := streams.NewActivityStreamsAnnounce()
+	if err := ap.SetJSONLDIdStr(announce, instanceAcct.URI+"#relay/"+id.NewULID()); err != nil {
+		return gtserror.Newf("error setting announce id: %w", err)
+	}
+	ap.AppendActorIRIs(announce, actorIRI)
+	ap.AppendObjectIRIs(announce, statusIRI)
+	ap.AppendTo(announce, followersIRI)
+	ap.AppendCc(announce, ap.PublicIRI())
+	ap.SetPublished(announce, time.Now())
+
+	data, err := ap.Serialize(announce)
+	if err != nil {
+		return gtserror.Newf("error serializing announce: %w", err)
+	}
+
+	tsport, err := f.TransportController().NewTransportForUsername(ctx, "")
+	if err != nil {
+		return gtserror.Newf("error getting instance transport: %w", err)
+	}
+
+	// Deliver the Announce directly to the other
+	// subscribers' inboxes, skipping the author's.
+	if err := tsport.BatchDeliver(ctx, data, inboxes); err != nil {
+		return gtserror.Newf("error delivering announce: %w", err)
+	}
+
+	return nil
+}
+
 func (f *federate) CreatePollVote(ctx context.Context, poll *gtsmodel.Poll, vote *gtsmodel.PollVote) error {
 	// Extract status from poll.
 	status := poll.Status
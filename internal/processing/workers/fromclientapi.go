@@ -161,6 +161,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		// ACCEPT BOOST
 		case ap.ActivityAnnounce:
 			return p.clientAPI.AcceptAnnounce(ctx, cMsg)
+
+		// ACCEPT FLAG (ie., approve an appeal)
+		case ap.ActivityFlag:
+			return p.clientAPI.AcceptAppeal(ctx, cMsg)
 		}
 
 	// REJECT SOMETHING
@@ -186,6 +190,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		// REJECT BOOST
 		case ap.ActivityAnnounce:
 			return p.clientAPI.RejectAnnounce(ctx, cMsg)
+
+		// REJECT FLAG (ie., reject an appeal)
+		case ap.ActivityFlag:
+			return p.clientAPI.RejectAppeal(ctx, cMsg)
 		}
 
 	// UNDO SOMETHING
@@ -224,11 +232,15 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 
 	// FLAG/REPORT SOMETHING
 	case ap.ActivityFlag:
-		switch cMsg.APObjectType { //nolint:gocritic
+		switch cMsg.APObjectType {
 
 		// FLAG/REPORT ACCOUNT
 		case ap.ActorPerson:
 			return p.clientAPI.ReportAccount(ctx, cMsg)
+
+		// FLAG/WARN LOCAL USER
+		case ap.ObjectProfile:
+			return p.clientAPI.WarnUser(ctx, cMsg)
 		}
 
 	// MOVE SOMETHING
@@ -274,6 +286,10 @@ func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientA
 		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
 	}
 
+	// Fetch link preview card
+	// for the status (async).
+	p.utils.setStatusCard(status)
+
 	if err := p.surfacer.TimelineAndNotifyStatus(ctx, status); err != nil {
 		log.Errorf(ctx, "error timelining and notifying status: %v", err)
 	}
@@ -282,6 +298,12 @@ func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error federating status: %v", err)
 	}
 
+	if err := p.federate.RelayLocalStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error relaying status: %v", err)
+	}
+
+	p.utils.publishStatusCreated(ctx, status)
+
 	return nil
 }
 
@@ -758,6 +780,10 @@ func (p *clientAPI) UpdateStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error federating status update: %v", err)
 	}
 
+	// Links may have changed, so
+	// update link preview card (async).
+	p.utils.setStatusCard(status)
+
 	// Stream and notify relevant local users that the status has been edited.
 	if err := p.surfacer.TimelineAndNotifyStatusUpdate(ctx, status); err != nil {
 		log.Errorf(ctx, "error streaming status edit: %v", err)
@@ -776,6 +802,8 @@ func (p *clientAPI) UpdateAccount(ctx context.Context, cMsg *messages.FromClient
 		log.Errorf(ctx, "error federating account update: %v", err)
 	}
 
+	p.utils.publishAccountUpdated(ctx, account)
+
 	return nil
 }
 
@@ -981,6 +1009,8 @@ func (p *clientAPI) DeleteStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error federating status delete: %v", err)
 	}
 
+	p.utils.publishStatusDeleted(ctx, status)
+
 	return nil
 }
 
@@ -1069,6 +1099,50 @@ func (p *clientAPI) ReportAccount(ctx context.Context, cMsg *messages.FromClient
 		log.Errorf(ctx, "error emailing report opened: %v", err)
 	}
 
+	p.utils.publishReportCreated(ctx, report)
+
+	return nil
+}
+
+func (p *clientAPI) WarnUser(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	warning, ok := cMsg.GTSModel.(*gtsmodel.AccountWarning)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.AccountWarning", cMsg.GTSModel)
+	}
+
+	// Notify the warned account.
+	if err := p.surfacer.NotifyAccountWarning(ctx, warning); err != nil {
+		log.Errorf(ctx, "error notifying account warning: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) AcceptAppeal(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	appeal, ok := cMsg.GTSModel.(*gtsmodel.Appeal)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Appeal", cMsg.GTSModel)
+	}
+
+	// Notify the appealing account.
+	if err := p.surfacer.NotifyAppealResolved(ctx, appeal); err != nil {
+		log.Errorf(ctx, "error notifying appeal approved: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) RejectAppeal(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	appeal, ok := cMsg.GTSModel.(*gtsmodel.Appeal)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Appeal", cMsg.GTSModel)
+	}
+
+	// Notify the appealing account.
+	if err := p.surfacer.NotifyAppealResolved(ctx, appeal); err != nil {
+		log.Errorf(ctx, "error notifying appeal rejected: %v", err)
+	}
+
 	return nil
 }
 
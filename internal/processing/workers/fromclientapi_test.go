@@ -517,6 +517,79 @@ func (suite *FromClientAPITestSuite) TestProcessCreateStatusReplyMuted() {
 	suite.checkNotWebPushed(testStructs.WebPushSender, receivingAccount.ID)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusReplySubscribed() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = suite.T().Context()
+		postingAccount   = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		parentStatus     = suite.testStatuses["local_account_2_status_1"]
+
+		// Admin account posts a reply to turtle.
+		// Zork isn't mentioned in the reply, but
+		// is subscribed to the thread, so should
+		// get a thread reply notification for it.
+		status = suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			parentStatus,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+		threadSubscription = &gtsmodel.ThreadSubscription{
+			ID:        "01HD3KRMBB1M85QRWHD912QWRF",
+			ThreadID:  parentStatus.ThreadID,
+			AccountID: receivingAccount.ID,
+		}
+	)
+
+	// Store the thread subscription before processing new status.
+	if err := testStructs.State.DB.PutThreadSubscription(ctx, threadSubscription); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the new status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Ensure thread reply notification received.
+	notif, err := testStructs.State.DB.GetNotification(
+		ctx,
+		gtsmodel.NotificationThreadReply,
+		receivingAccount.ID,
+		postingAccount.ID,
+		status.ID,
+	)
+	suite.NoError(err)
+	suite.NotNil(notif)
+
+	// Replied-to account was notified
+	// of the mention, not thread reply.
+	_, err = testStructs.State.DB.GetNotification(
+		ctx,
+		gtsmodel.NotificationThreadReply,
+		parentStatus.AccountID,
+		postingAccount.ID,
+		status.ID,
+	)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func (suite *FromClientAPITestSuite) TestProcessCreateStatusBoostMuted() {
 	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
 	defer testrig.TearDownTestStructs(testStructs)
@@ -2167,6 +2240,65 @@ func (suite *FromClientAPITestSuite) TestProcessUpdateStatusInteractedWith() {
 	)
 }
 
+// Test that when someone edits a direct status, the
+// edit is streamed to the direct timeline of participants.
+func (suite *FromClientAPITestSuite) TestProcessUpdateDirectStatus() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = suite.T().Context()
+		postingAccount   = suite.testAccounts["local_account_2"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		streams          = suite.openStreams(ctx,
+			testStructs.Processor,
+			receivingAccount,
+			nil,
+		)
+		directStream = streams[stream.TimelineDirect]
+
+		// turtle posts a new top-level DM mentioning zork.
+		status = suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			gtsmodel.VisibilityDirect,
+			nil,
+			nil,
+			[]*gtsmodel.Account{receivingAccount},
+			true,
+			nil,
+		)
+	)
+
+	// Update the status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       status,
+			Origin:         postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Check status update in direct stream.
+	statusJSON := suite.statusJSON(
+		ctx,
+		testStructs.TypeConverter,
+		status,
+		receivingAccount,
+	)
+	suite.checkStreamed(
+		directStream,
+		true,
+		statusJSON,
+		stream.EventTypeStatusUpdate,
+	)
+}
+
 func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
 	defer testrig.TearDownTestStructs(testStructs)
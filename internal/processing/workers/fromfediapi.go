@@ -24,6 +24,7 @@
 	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/federation/dereferencing"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
@@ -53,7 +54,18 @@ type fediAPI struct {
 	utils    *utils
 }
 
+// ProcessFromFediAPI processes the given message from the
+// federation API. If processing fails, the message is stored
+// as a failed activity so it can be inspected and retried.
 func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	err := p.processFromFediAPI(ctx, fMsg)
+	if err != nil {
+		p.fediAPI.storeFailedActivity(ctx, fMsg, err)
+	}
+	return err
+}
+
+func (p *Processor) processFromFediAPI(ctx context.Context, fMsg *messages.FromFediAPI) error {
 	// Allocate new log fields slice
 	fields := make([]kv.Field, 3, 5)
 	fields[0] = kv.Field{"activityType", fMsg.APActivityType}
@@ -201,6 +213,14 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 			return p.fediAPI.DeleteAccount(ctx, fMsg)
 		}
 
+	// ANNOUNCE SOMETHING
+	case ap.ActivityAnnounce:
+
+		// RELAY NOTE/STATUS
+		if fMsg.APObjectType == ap.ObjectNote {
+			return p.fediAPI.RelayStatus(ctx, fMsg)
+		}
+
 	// MOVE SOMETHING
 	case ap.ActivityMove:
 
@@ -232,7 +252,7 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 		}
 	}
 
-	return gtserror.Newf("unhandled: %s %s", fMsg.APActivityType, fMsg.APObjectType)
+	return gtserror.Newf("%w: %s %s", errUnhandled, fMsg.APActivityType, fMsg.APObjectType)
 }
 
 // CreateStatus handles the creation of a status/post sent as a Create message.
@@ -390,13 +410,33 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		// side effects as normal.
 	}
 
+	// Fetch link preview card
+	// for the status (async).
+	p.utils.setStatusCard(status)
+
 	if err := p.surfacer.TimelineAndNotifyStatus(ctx, status); err != nil {
 		log.Errorf(ctx, "error timelining and notifying status: %v", err)
 	}
 
+	p.utils.publishStatusCreated(ctx, status)
+
 	return nil
 }
 
+// RelayStatus handles a public status sent to our instance
+// actor by its author, relaying it on to the other subscribers
+// of this relay endpoint.
+func (p *fediAPI) RelayStatus(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	if fMsg.APIRI == nil {
+		return gtserror.New("APIRI not set")
+	}
+
+	return p.federate.RelayRemoteStatus(ctx,
+		fMsg.Requesting,
+		fMsg.APIRI,
+	)
+}
+
 // CreateReplyRequest handles a polite ReplyRequest.
 // This is distinct from CreateStatus, which is capable
 // of handling both "normal" top-level status creation,
@@ -617,6 +657,14 @@ func (p *fediAPI) CreateFollowReq(ctx context.Context, fMsg *messages.FromFediAP
 		accept bool = !*followReq.TargetAccount.Locked
 	)
 
+	// When acting as a relay endpoint, Follows of
+	// the instance actor are relay subscriptions,
+	// which we accept unless limited otherwise.
+	if followReq.TargetAccount.IsInstance() &&
+		config.GetInstanceRelayEndpoint() {
+		accept = true
+	}
+
 	// See if there's a limit on the would-be follower's domain.
 	limit, err := p.state.DB.MatchDomainLimit(ctx, followReq.Account.Domain)
 	if err != nil {
@@ -1251,6 +1299,8 @@ func (p *fediAPI) CreateFlag(ctx context.Context, fMsg *messages.FromFediAPI) er
 		log.Errorf(ctx, "error emailing report opened: %v", err)
 	}
 
+	p.utils.publishReportCreated(ctx, incomingReport)
+
 	return nil
 }
 
@@ -1268,7 +1318,7 @@ func (p *fediAPI) UpdateAccount(ctx context.Context, fMsg *messages.FromFediAPI)
 	}
 
 	// Fetch up-to-date bio, avatar, header, etc.
-	_, _, err := p.federate.RefreshAccount(
+	account, _, err := p.federate.RefreshAccount(
 		ctx,
 		fMsg.Receiving.Username,
 		account,
@@ -1283,8 +1333,11 @@ func (p *fediAPI) UpdateAccount(ctx context.Context, fMsg *messages.FromFediAPI)
 	)
 	if err != nil {
 		log.Errorf(ctx, "error refreshing account: %v", err)
+		return nil
 	}
 
+	p.utils.publishAccountUpdated(ctx, account)
+
 	return nil
 }
 
@@ -1460,6 +1513,10 @@ func (p *fediAPI) UpdateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		log.Errorf(ctx, "error refreshing status: %v", err)
 	}
 
+	// Links may have changed, so
+	// update link preview card (async).
+	p.utils.setStatusCard(status)
+
 	// Stream and notify relevant local users that the status has been edited.
 	if err := p.surfacer.TimelineAndNotifyStatusUpdate(ctx, status); err != nil {
 		log.Errorf(ctx, "error streaming status edit: %v", err)
@@ -1514,6 +1571,8 @@ func (p *fediAPI) DeleteStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		log.Errorf(ctx, "error wiping status: %v", err)
 	}
 
+	p.utils.publishStatusDeleted(ctx, status)
+
 	return nil
 }
 
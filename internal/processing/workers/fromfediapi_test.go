@@ -24,12 +24,15 @@
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"testing"
 	"time"
 
+	"code.superseriousbusiness.org/activity/streams"
 	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -1149,6 +1152,178 @@ func (suite *FromFediAPITestSuite) TestCreateReplyRequest() {
 	// }
 }
 
+func (suite *FromFediAPITestSuite) TestProcessUnhandledStoresFailedActivity() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = suite.T().Context()
+		requestingAcct   = suite.testAccounts["remote_account_1"]
+		receivingAcct    = suite.testAccounts["local_account_1"]
+		activityIRI      = testrig.URLMustParse("http://fossbros-anonymous.io/users/foss_satan/flags/01J3ZJ4AC6JCTA9V2YPSBKVPNA")
+		activityType     = ap.ActivityUpdate
+		activityObjType  = ap.ActivityFlag
+		expectFailReason = gtsmodel.FailureReasonUnhandled
+	)
+
+	// Process a message with an activity +
+	// object type combination we don't handle.
+	err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   activityObjType,
+		APActivityType: activityType,
+		APIRI:          activityIRI,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+	suite.ErrorContains(err, "unhandled")
+
+	// The message should have been
+	// stored as a failed activity.
+	failed, err := testStructs.State.DB.GetFailedActivities(ctx, activityType, expectFailReason, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(failed, 1) {
+		suite.Equal(activityObjType, failed[0].APObjectType)
+		suite.Equal(activityIRI.String(), failed[0].APIRI)
+		suite.Equal(requestingAcct.ID, failed[0].RequestingAccountID)
+		suite.Equal(receivingAcct.ID, failed[0].ReceivingAccountID)
+		suite.Contains(failed[0].Error, "unhandled")
+		suite.NotEmpty(failed[0].Data)
+	}
+
+	// And it should be counted.
+	counts, err := testStructs.State.DB.CountFailedActivities(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]*gtsmodel.FailedActivityCount{{
+		APActivityType: activityType,
+		APObjectType:   activityObjType,
+		Reason:         expectFailReason,
+		Count:          1,
+	}}, counts)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessUnhandledStoresTruncatedFailedActivity() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx            = suite.T().Context()
+		requestingAcct = suite.testAccounts["remote_account_1"]
+		receivingAcct  = suite.testAccounts["local_account_1"]
+	)
+
+	// Build an oversized Flag with
+	// a huge amount of content.
+	flag := streams.NewActivityStreamsFlag()
+	ap.SetJSONLDIdStr(flag, "http://fossbros-anonymous.io/users/foss_satan/flags/01J3ZJ4AC6JCTA9V2YPSBKVPNA")
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString(strings.Repeat("A", 128*1024))
+	flag.SetActivityStreamsContent(content)
+
+	err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ActivityFlag,
+		APActivityType: ap.ActivityUpdate,
+		APObject:       flag,
+		Receiving:      receivingAcct,
+		Requesting:     requestingAcct,
+	})
+	suite.ErrorContains(err, "unhandled")
+
+	// The failed activity should have been
+	// stored without the raw activity JSON.
+	failed, err := testStructs.State.DB.GetFailedActivities(ctx, ap.ActivityUpdate, gtsmodel.FailureReasonUnhandled, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(failed, 1) {
+		suite.True(*failed[0].Truncated)
+		suite.Equal("http://fossbros-anonymous.io/users/foss_satan/flags/01J3ZJ4AC6JCTA9V2YPSBKVPNA", failed[0].APIRI)
+		suite.Less(len(failed[0].Data), 64*1024)
+
+		var msg messages.FromFediAPI
+		if err := msg.Deserialize(failed[0].Data); err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Nil(msg.APObject)
+	}
+}
+
+func (suite *FromFediAPITestSuite) TestProcessRelayStatus() {
+	var (
+		ctx          = suite.T().Context()
+		testStructs  = testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+		instanceAcct = suite.testAccounts["instance_account"]
+		author       = suite.testAccounts["remote_account_1"]
+		subscriber   = suite.testAccounts["remote_account_2"]
+		stranger     = suite.testAccounts["remote_account_3"]
+		statusIRI    = testrig.URLMustParse("http://fossbros-anonymous.io/users/foss_satan/statuses/01JRELAY0000000000000000000")
+	)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	config.SetInstanceRelayEndpoint(true)
+
+	// Subscribe author's and subscriber's
+	// instances to our relay endpoint.
+	for _, acct := range []*gtsmodel.Account{author, subscriber} {
+		if err := testStructs.State.DB.PutFollow(ctx, &gtsmodel.Follow{
+			ID:              id.NewULID(),
+			URI:             acct.URI + "/follows/" + id.NewULID(),
+			AccountID:       acct.ID,
+			TargetAccountID: instanceAcct.ID,
+			ShowReblogs:     util.Ptr(true),
+			Notify:          util.Ptr(false),
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	relay := func(requesting *gtsmodel.Account) {
+		if err := testStructs.Processor.Workers().ProcessFromFediAPI(
+			ctx,
+			&messages.FromFediAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityAnnounce,
+				APIRI:          statusIRI,
+				Receiving:      instanceAcct,
+				Requesting:     requesting,
+			},
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Statuses from non-subscribers aren't relayed.
+	relay(stranger)
+	_, ok := testStructs.State.Workers.Delivery.Queue.Pop()
+	suite.False(ok)
+
+	// Status from the author is relayed only
+	// to the other subscriber, not back to them.
+	relay(author)
+	delivery, ok := testStructs.State.Workers.Delivery.Queue.Pop()
+	if !suite.True(ok) {
+		suite.FailNow("expected relay delivery")
+	}
+	suite.True(testrig.EqualRequestURIs(delivery.Request.URL, subscriber.InboxURI))
+
+	body, err := io.ReadAll(delivery.Request.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Contains(string(body), `"type":"Announce"`)
+	suite.Contains(string(body), `"actor":"`+instanceAcct.URI+`"`)
+	suite.Contains(string(body), `"object":"`+statusIRI.String()+`"`)
+
+	_, ok = testStructs.State.Workers.Delivery.Queue.Pop()
+	suite.False(ok)
+}
+
 func TestFromFederatorTestSuite(t *testing.T) {
 	suite.Run(t, &FromFediAPITestSuite{})
 }
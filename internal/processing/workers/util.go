@@ -24,9 +24,11 @@
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/eventbus"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/linkpreview"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/account"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/media"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
@@ -37,11 +39,12 @@
 // util provides util functions used by both
 // the fromClientAPI and fromFediAPI functions.
 type utils struct {
-	state     *state.State
-	media     *media.Processor
-	account   *account.Processor
-	surfacer  *surfacing.Surfacer
-	converter *typeutils.Converter
+	state       *state.State
+	media       *media.Processor
+	account     *account.Processor
+	surfacer    *surfacing.Surfacer
+	converter   *typeutils.Converter
+	linkpreview *linkpreview.Fetcher
 }
 
 // wipeStatus encapsulates common logic used to
@@ -315,3 +318,90 @@ func (u *utils) storeInteractionRequest(
 
 	return nil
 }
+
+// setStatusCard asynchronously sets the link preview card of
+// the given status, as fetching the linked page may be slow.
+// Any error is only logged, as a missing card isn't fatal.
+func (u *utils) setStatusCard(status *gtsmodel.Status) {
+	statusID := status.ID
+	u.state.Workers.Dereference.Queue.Push(func(ctx context.Context) {
+		// Load a fresh copy of status, as the one
+		// passed in is still used by the caller.
+		status, err := u.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			statusID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error getting status %s: %v", statusID, err)
+			return
+		}
+
+		if err := u.linkpreview.SetStatusCard(ctx, status); err != nil {
+			log.Errorf(ctx, "error setting status card: %v", err)
+		}
+	})
+}
+
+// publishStatusCreated publishes a StatusCreated
+// event for the given status to the event bus.
+func (u *utils) publishStatusCreated(ctx context.Context, status *gtsmodel.Status) {
+	if u.state.EventBus == nil {
+		// No event bus,
+		// nothing to do.
+		return
+	}
+
+	apiStatus, err := u.converter.StatusToAPIStatus(ctx, status, nil)
+	if err != nil {
+		log.Errorf(ctx, "error converting status for event: %v", err)
+		return
+	}
+
+	u.state.EventBus.Publish(ctx, eventbus.StatusCreated, apiStatus)
+}
+
+// publishStatusDeleted publishes a StatusDeleted
+// event for the given status to the event bus.
+func (u *utils) publishStatusDeleted(ctx context.Context, status *gtsmodel.Status) {
+	u.state.EventBus.Publish(ctx, eventbus.StatusDeleted, eventbus.DeletedStatus{
+		ID:        status.ID,
+		URI:       status.URI,
+		AccountID: status.AccountID,
+	})
+}
+
+// publishAccountUpdated publishes an AccountUpdated
+// event for the given account to the event bus.
+func (u *utils) publishAccountUpdated(ctx context.Context, account *gtsmodel.Account) {
+	if u.state.EventBus == nil {
+		// No event bus,
+		// nothing to do.
+		return
+	}
+
+	apiAccount, err := u.converter.AccountToAPIAccountPublic(ctx, account)
+	if err != nil {
+		log.Errorf(ctx, "error converting account for event: %v", err)
+		return
+	}
+
+	u.state.EventBus.Publish(ctx, eventbus.AccountUpdated, apiAccount)
+}
+
+// publishReportCreated publishes a ReportCreated
+// event for the given report to the event bus.
+func (u *utils) publishReportCreated(ctx context.Context, report *gtsmodel.Report) {
+	if u.state.EventBus == nil {
+		// No event bus,
+		// nothing to do.
+		return
+	}
+
+	apiReport, err := u.converter.ReportToAdminAPIReport(ctx, report, nil)
+	if err != nil {
+		log.Errorf(ctx, "error converting report for event: %v", err)
+		return
+	}
+
+	u.state.EventBus.Publish(ctx, eventbus.ReportCreated, apiReport)
+}
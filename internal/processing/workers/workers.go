@@ -19,6 +19,7 @@
 
 import (
 	"code.superseriousbusiness.org/gotosocial/internal/federation"
+	"code.superseriousbusiness.org/gotosocial/internal/linkpreview"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/account"
 	"code.superseriousbusiness.org/gotosocial/internal/processing/media"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
@@ -51,11 +52,12 @@ func New(
 
 	// Init shared util funcs.
 	utils := &utils{
-		state:     state,
-		media:     media,
-		account:   account,
-		surfacer:  surfacer,
-		converter: converter,
+		state:       state,
+		media:       media,
+		account:     account,
+		surfacer:    surfacer,
+		converter:   converter,
+		linkpreview: linkpreview.New(state, federator.TransportController(), &federator.Dereferencer),
 	}
 
 	return Processor{
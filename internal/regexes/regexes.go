@@ -54,6 +54,7 @@
 	misskeyReportNotesFinder = `(?m)(?:^Note: ((?:http|https):\/\/.*)$)`                 // Extract reported Note URIs from the text of a Misskey report/flag.
 	ulid                     = `[0123456789ABCDEFGHJKMNPQRSTVWXYZ]{26}`                  // Pattern for ULID.
 	ulidValidate             = `^` + ulid + `$`                                          // Validate one ULID.
+	mediaVersion             = `^[0-9a-f]{16}$`                                          // Validate one media URL version, 64 bits of hex encoded hash.
 
 	/*
 		Path parts / capture.
@@ -167,6 +168,11 @@
 	// It captures the account id, media type, media size, file name, and file extension, eg
 	// `01F8MH1H7YV1Z7D2C8K2730QBF`, `attachment`, `small`, `01F8MH8RMYQ6MSNY3JM2XT1CQ5`, `jpeg`.
 	FilePath = regexp.MustCompile(filePath)
+
+	// MediaVersion validates the version part of a versioned fileserver
+	// URL of the form [ACCOUNT_ID]/[MEDIA_TYPE]/[MEDIA_SIZE]/[MEDIA_VERSION]/[FILE_NAME],
+	// eg `5d41402abc4b2a76` in 01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/5d41402abc4b2a76/01F8MH8RMYQ6MSNY3JM2XT1CQ5.jpeg
+	MediaVersion = regexp.MustCompile(mediaVersion)
 )
 
 // bufpool is a memory pool of byte buffers for use in our regex utility functions.
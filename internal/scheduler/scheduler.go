@@ -19,9 +19,12 @@
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	"code.superseriousbusiness.org/gopkg/log"
 	"codeberg.org/gruf/go-runners"
 	"codeberg.org/gruf/go-sched"
 )
@@ -29,6 +32,10 @@
 // Scheduler wraps an underlying scheduler to provide
 // task tracking by unique string identifiers, so jobs
 // may be cancelled with only an identifier.
+//
+// Recurring tasks are additionally tracked as named
+// jobs, which can be listed along with their last run,
+// triggered to run immediately, paused and resumed.
 type Scheduler struct {
 	sch sched.Scheduler
 	ts  map[string]*task
@@ -56,12 +63,94 @@ func (sch *Scheduler) Stop() bool {
 
 // AddOnce schedules the given task to run at time, registered under the given ID. Returns false if task already exists for id.
 func (sch *Scheduler) AddOnce(id string, start time.Time, fn func(context.Context, time.Time)) bool {
-	return sch.schedule(id, fn, (*sched.Once)(&start))
+	return sch.schedule(id, fn, (*sched.Once)(&start), nil)
 }
 
 // AddRecurring schedules the given task to return at given period, starting at given time, registered under given id. Returns false if task already exists for id.
-func (sch *Scheduler) AddRecurring(id string, start time.Time, freq time.Duration, fn func(context.Context, time.Time)) bool {
-	return sch.schedule(id, fn, &sched.PeriodicAt{Once: sched.Once(start), Period: sched.Periodic(freq)})
+//
+// A scheduled run of the task is skipped if the task is paused,
+// or if the previous run (scheduled or triggered) is still going.
+// An error returned by a run of the task is logged, and recorded
+// as the last error of the job.
+func (sch *Scheduler) AddRecurring(id string, start time.Time, freq time.Duration, fn func(context.Context, time.Time) error) bool {
+	if fn == nil {
+		panic("nil function")
+	}
+	rec := &recurring{id: id, fn: fn, period: freq}
+	return sch.schedule(id, rec.scheduled, &sched.PeriodicAt{Once: sched.Once(start), Period: sched.Periodic(freq)}, rec)
+}
+
+// Jobs returns the current state of all
+// recurring jobs, ordered by their ID.
+func (sch *Scheduler) Jobs() []Job {
+	sch.mu.Lock()
+	jobs := make([]Job, 0, len(sch.ts))
+	for _, task := range sch.ts {
+		if task.rec != nil {
+			jobs = append(jobs, task.state())
+		}
+	}
+	sch.mu.Unlock()
+
+	slices.SortFunc(jobs, func(a, b Job) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return jobs
+}
+
+// Job returns the current state of recurring
+// job with id, returns false if none found.
+func (sch *Scheduler) Job(id string) (Job, bool) {
+	task := sch.recurring(id)
+	if task == nil {
+		return Job{}, false
+	}
+	return task.state(), true
+}
+
+// Trigger runs the recurring job with id now, in
+// the background, regardless of whether it is paused.
+// Returns false if no job found, or if it's already running.
+func (sch *Scheduler) Trigger(id string) bool {
+	task := sch.recurring(id)
+	if task == nil {
+		return false
+	}
+	return task.rec.trigger()
+}
+
+// Pause stops scheduled runs of the recurring job with id,
+// until it is resumed. A run that's already going is not
+// interrupted. Returns false if no job found.
+func (sch *Scheduler) Pause(id string) bool {
+	task := sch.recurring(id)
+	if task == nil {
+		return false
+	}
+	task.rec.setPaused(true)
+	return true
+}
+
+// Resume undoes a previous pause of the recurring
+// job with id. Returns false if no job found.
+func (sch *Scheduler) Resume(id string) bool {
+	task := sch.recurring(id)
+	if task == nil {
+		return false
+	}
+	task.rec.setPaused(false)
+	return true
+}
+
+// recurring returns the task of recurring job with id, if any.
+func (sch *Scheduler) recurring(id string) *task {
+	sch.mu.Lock()
+	defer sch.mu.Unlock()
+	task, ok := sch.ts[id]
+	if !ok || task.rec == nil {
+		return nil
+	}
+	return task
 }
 
 // Cancel attempts to cancel a scheduled task with id, returns false if no task found.
@@ -84,7 +173,7 @@ func (sch *Scheduler) Cancel(id string) bool {
 	return true
 }
 
-func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t sched.Timing) bool {
+func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t sched.Timing, rec *recurring) bool {
 	if fn == nil {
 		panic("nil function")
 	}
@@ -102,6 +191,12 @@ func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t
 	// Extract current scheduler context.
 	ctx := runners.CancelCtx(sch.sch.Done())
 
+	if rec != nil {
+		// Triggered runs need
+		// the context as well.
+		rec.ctx = ctx
+	}
+
 	// Create a new job to hold task function with
 	// timing, passing in the current sched context.
 	job := sched.NewJob(func(now time.Time) {
@@ -114,14 +209,122 @@ func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t
 	sch.ts[id] = &task{
 		job:  job,
 		cncl: cncl,
+		rec:  rec,
 	}
 
 	return true
 }
 
-// task simply wraps together a scheduled
-// job, and the matching cancel function.
+// task simply wraps together a scheduled job, the
+// matching cancel function and, for recurring tasks,
+// the job run state.
 type task struct {
 	job  *sched.Job
 	cncl func()
+	rec  *recurring
+}
+
+// state returns the current state of recurring task.
+func (t *task) state() Job {
+	t.rec.mu.Lock()
+	defer t.rec.mu.Unlock()
+	return Job{
+		ID:           t.rec.id,
+		Period:       t.rec.period,
+		NextRun:      t.job.Next(),
+		LastRun:      t.rec.lastRun,
+		LastDuration: t.rec.lastDuration,
+		Runs:         t.rec.runs,
+		Running:      t.rec.running,
+		Paused:       t.rec.paused,
+		LastError:    t.rec.lastError,
+		LastErrorAt:  t.rec.lastErrorAt,
+	}
+}
+
+// Job describes the state
+// of a recurring job.
+type Job struct {
+	ID           string        // Unique ID the job was scheduled under.
+	Period       time.Duration // Period between scheduled runs.
+	NextRun      time.Time     // Time of next scheduled run.
+	LastRun      time.Time     // Start time of last finished run, zero if never run.
+	LastDuration time.Duration // Duration of last finished run.
+	Runs         int           // Number of finished runs since startup.
+	Running      bool          // Whether the job is running right now.
+	Paused       bool          // Whether scheduled runs are paused.
+	LastError    string        // Error returned by the last failed run, empty if none since startup.
+	LastErrorAt  time.Time     // Start time of the last failed run, zero if none since startup.
+}
+
+// recurring wraps the function of a recurring
+// job to keep track of its runs and paused state.
+type recurring struct {
+	id     string
+	fn     func(context.Context, time.Time) error
+	ctx    context.Context
+	period time.Duration
+
+	mu           sync.Mutex
+	lastRun      time.Time
+	lastDuration time.Duration
+	runs         int
+	running      bool
+	paused       bool
+	lastError    string
+	lastErrorAt  time.Time
+}
+
+// scheduled is passed to the scheduler, running
+// the job unless it's paused or already running.
+func (r *recurring) scheduled(ctx context.Context, now time.Time) {
+	r.mu.Lock()
+	if r.paused || r.running {
+		r.mu.Unlock()
+		return
+	}
+	r.running = true
+	r.mu.Unlock()
+	r.run(ctx, now)
+}
+
+// trigger starts a run of the job in the
+// background, unless it's already running.
+func (r *recurring) trigger() bool {
+	r.mu.Lock()
+	if r.running {
+		r.mu.Unlock()
+		return false
+	}
+	r.running = true
+	r.mu.Unlock()
+	go r.run(r.ctx, time.Now())
+	return true
+}
+
+// run calls the job function, recording the outcome.
+// The caller must have already set running to true.
+func (r *recurring) run(ctx context.Context, now time.Time) {
+	var err error
+	defer func() {
+		r.mu.Lock()
+		r.lastRun = now
+		r.lastDuration = time.Since(now)
+		r.runs++
+		r.running = false
+		if err != nil {
+			r.lastError = err.Error()
+			r.lastErrorAt = now
+		}
+		r.mu.Unlock()
+	}()
+	if err = r.fn(ctx, now); err != nil {
+		log.Errorf(ctx, "error running job %s: %v", r.id, err)
+	}
+}
+
+func (r *recurring) setPaused(paused bool) {
+	r.mu.Lock()
+	r.paused = paused
+	r.mu.Unlock()
 }
@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"code.superseriousbusiness.org/gotosocial/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurringJobs(t *testing.T) {
+	var sch scheduler.Scheduler
+	if !sch.Start() {
+		t.Fatal("failed to start scheduler")
+	}
+	defer sch.Stop()
+
+	var (
+		start   = time.Now().Add(time.Hour)
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+
+	// Recurring job that blocks until
+	// released, failing on its first run.
+	var runs int
+	assert.True(t, sch.AddRecurring("@test", start, time.Hour, func(context.Context, time.Time) error {
+		started <- struct{}{}
+		<-release
+		if runs++; runs == 1 {
+			return errors.New("oh no")
+		}
+		return nil
+	}))
+
+	// One-off tasks aren't jobs.
+	assert.True(t, sch.AddOnce("once", start, func(context.Context, time.Time) {}))
+
+	jobs := sch.Jobs()
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, "@test", jobs[0].ID)
+		assert.Equal(t, time.Hour, jobs[0].Period)
+		assert.False(t, jobs[0].Running)
+		assert.Zero(t, jobs[0].Runs)
+	}
+
+	_, ok := sch.Job("once")
+	assert.False(t, ok)
+	assert.False(t, sch.Trigger("once"))
+	assert.False(t, sch.Pause("nope"))
+
+	// Pause the job, it can still be triggered.
+	assert.True(t, sch.Pause("@test"))
+	assert.True(t, sch.Trigger("@test"))
+	<-started
+
+	job, ok := sch.Job("@test")
+	assert.True(t, ok)
+	assert.True(t, job.Running)
+	assert.True(t, job.Paused)
+
+	// Can't trigger while running.
+	assert.False(t, sch.Trigger("@test"))
+
+	// Let the run finish.
+	close(release)
+	assert.Eventually(t, func() bool {
+		job, _ := sch.Job("@test")
+		return !job.Running
+	}, 5*time.Second, 10*time.Millisecond)
+
+	job, _ = sch.Job("@test")
+	assert.Equal(t, 1, job.Runs)
+	assert.False(t, job.LastRun.IsZero())
+
+	// The failed run's error is recorded.
+	assert.Equal(t, "oh no", job.LastError)
+	assert.Equal(t, job.LastRun, job.LastErrorAt)
+	lastErrorAt := job.LastErrorAt
+
+	// A successful run keeps the
+	// last error for reference.
+	assert.True(t, sch.Trigger("@test"))
+	<-started
+	assert.Eventually(t, func() bool {
+		job, _ := sch.Job("@test")
+		return !job.Running
+	}, 5*time.Second, 10*time.Millisecond)
+
+	job, _ = sch.Job("@test")
+	assert.Equal(t, 2, job.Runs)
+	assert.Equal(t, "oh no", job.LastError)
+	assert.Equal(t, lastErrorAt, job.LastErrorAt)
+	assert.True(t, job.LastRun.After(job.LastErrorAt))
+
+	assert.True(t, sch.Resume("@test"))
+	job, _ = sch.Job("@test")
+	assert.False(t, job.Paused)
+}
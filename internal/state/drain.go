@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Drain signals that the server should drain, ie., stop
+// accepting new requests, finish serving in-flight ones,
+// persist queued worker tasks to the database, then exit.
+//
+// The zero value is ready to use.
+type Drain struct {
+	once     sync.Once
+	init     sync.Once
+	ch       chan struct{}
+	draining atomic.Bool
+}
+
+// Start marks the server as draining and
+// closes the channel returned by Done().
+// Safe to call multiple times.
+func (d *Drain) Start() {
+	d.once.Do(func() {
+		d.draining.Store(true)
+		close(d.done())
+	})
+}
+
+// Draining returns whether
+// Start() has been called.
+func (d *Drain) Draining() bool {
+	return d.draining.Load()
+}
+
+// Done returns a channel which is
+// closed once Start() has been called.
+func (d *Drain) Done() <-chan struct{} {
+	return d.done()
+}
+
+func (d *Drain) done() chan struct{} {
+	d.init.Do(func() { d.ch = make(chan struct{}) })
+	return d.ch
+}
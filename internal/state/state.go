@@ -21,6 +21,8 @@
 	"code.superseriousbusiness.org/gotosocial/internal/admin"
 	"code.superseriousbusiness.org/gotosocial/internal/cache"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/eventbus"
+	"code.superseriousbusiness.org/gotosocial/internal/fedstats"
 	"code.superseriousbusiness.org/gotosocial/internal/storage"
 	"code.superseriousbusiness.org/gotosocial/internal/workers"
 	"codeberg.org/gruf/go-mutexes"
@@ -49,6 +51,11 @@ type State struct {
 	// and by the go-fed/activity library.
 	FedLocks mutexes.MutexMap
 
+	// FedStats accumulates per-domain federation
+	// traffic counters, periodically flushed to
+	// the database by a scheduled job.
+	FedStats fedstats.Counters
+
 	// ProcessingLocks provides access to this state's
 	// mutex map of per URI locks, intended for use
 	// in internal/processing functions, for example
@@ -69,6 +76,15 @@ type State struct {
 	// actions (and locks thereupon).
 	AdminActions *admin.Actions
 
+	// Drain signals when the server
+	// has been asked to drain and exit.
+	Drain Drain
+
+	// EventBus publishes lifecycle
+	// events to a message broker,
+	// nil if no broker is configured.
+	EventBus *eventbus.Bus
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -22,10 +22,12 @@
 import (
 	"context"
 	"fmt"
+	"io"
 	"mime"
 	"net/url"
 	"os"
 	"path"
+	"strings"
 	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
@@ -57,10 +59,22 @@ type Driver struct {
 	Bucket         string
 	PresignedCache *ttl.Cache[string, PresignedURL]
 	RedirectURL    string
+	KeyPrefix      string
+
+	// RemoteCacheTags are S3 object tags
+	// applied to cached remote media, to
+	// allow expiry via lifecycle rules.
+	RemoteCacheTags map[string]string
+
+	// S3 multipart upload parameters,
+	// see: Driver{}.putMultipart().
+	MultipartThreshold int64
+	MultipartPartSize  int64
+	MultipartRetries   int
 }
 
-// PutFile moves the contents of file at path, to storage.Driver{} under given key (with content-type if supported).
-func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string) (int64, error) {
+// PutFile moves the contents of file at path, to storage.Driver{} under given key (with options where supported).
+func (d *Driver) PutFile(ctx context.Context, key, filepath string, opts PutFileOpts) (int64, error) {
 
 	// Open file at path for reading.
 	file, err := os.Open(filepath)
@@ -70,16 +84,37 @@ func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string)
 
 	var sz int64
 
-	switch d := d.Storage.(type) {
+	switch st := d.Storage.(type) {
 	case *s3.S3Storage:
-		var info minio.UploadInfo
+		var (
+			info minio.UploadInfo
+			stat os.FileInfo
+		)
 
-		// For S3 storage, write the file but specifically pass in the
-		// content-type as an extra option. This handles the case of media
-		// being served via CDN redirect (where we don't handle content-type).
-		info, err = d.PutObject(ctx, key, file, minio.PutObjectOptions{
-			ContentType: contentType,
-		})
+		// Stat the file to get upload size.
+		stat, err = file.Stat()
+		if err != nil {
+			err = gtserror.Newf("error statting file %s: %w", filepath, err)
+			break
+		}
+
+		// Get S3 specific put options.
+		putOpts := d.putObjectOptions(opts)
+
+		if d.MultipartThreshold > 0 && stat.Size() > d.MultipartThreshold {
+			// Large files get uploaded in parts, which allows
+			// us to retry individual parts on transient errors.
+			info, err = d.putMultipart(ctx, st.Client(), key, file,
+				stat.Size(),
+				putOpts,
+				opts.Progress,
+			)
+		} else {
+			// Wrap the file in a section reader so the S3 library
+			// can perform a singular upload with known file size.
+			r := io.NewSectionReader(file, 0, stat.Size())
+			info, err = st.PutObject(ctx, key, r, putOpts)
+		}
 
 		// Get size from
 		// uploaded info.
@@ -89,7 +124,7 @@ func (d *Driver) PutFile(ctx context.Context, key, filepath, contentType string)
 		// Write the file data to storage under key. Note
 		// that for disk.DiskStorage{} this should end up
 		// being a highly optimized Linux sendfile syscall.
-		sz, err = d.WriteStream(ctx, key, file)
+		sz, err = st.WriteStream(ctx, key, file)
 	}
 
 	// Wrap write error.
@@ -156,6 +191,29 @@ func (d *Driver) URL(ctx context.Context, key string) *PresignedURL {
 	return &psu
 }
 
+// putObjectOptions returns the S3 put options for given PutFile() options.
+func (d *Driver) putObjectOptions(opts PutFileOpts) minio.PutObjectOptions {
+	// For S3 storage, write the file but specifically pass in the
+	// content-type as an extra option. This handles the case of media
+	// being served via CDN redirect (where we don't handle content-type).
+	putOpts := minio.PutObjectOptions{ContentType: opts.ContentType}
+
+	if opts.RemoteCache && d.RemoteCacheTags != nil {
+		// Tag remote media so it can be
+		// expired by bucket lifecycle rules.
+		putOpts.UserTags = d.RemoteCacheTags
+	}
+
+	return putOpts
+}
+
+// RemoteCacheLifecycle returns whether cached remote media is tagged
+// in storage, such that its expiry is managed by bucket lifecycle rules.
+// Note that remote cache tags are only ever configured for S3 storage.
+func (d *Driver) RemoteCacheLifecycle() bool {
+	return d.RemoteCacheTags != nil
+}
+
 // ProbeCSPUri returns a URI string that can be added
 // to a content-security-policy to allow requests to
 // endpoints served by this driver.
@@ -264,6 +322,14 @@ func NewS3Storage() (*Driver, error) {
 		return nil, fmt.Errorf("error opening s3 storage: %w", err)
 	}
 
+	var remoteCacheTags map[string]string
+
+	// Check if remote cache object tagging was requested.
+	if tag := config.GetStorageS3RemoteCacheTag(); tag != "" {
+		k, v, _ := strings.Cut(tag, "=")
+		remoteCacheTags = map[string]string{k: v}
+	}
+
 	// ttl should be lower than the expiry used by S3 to avoid serving invalid URLs
 	presignedCache := ttl.New[string, PresignedURL](0, 1000, urlCacheTTL-urlCacheExpiryFrequency)
 	presignedCache.Start(urlCacheExpiryFrequency)
@@ -274,5 +340,12 @@ func NewS3Storage() (*Driver, error) {
 		Storage:        s3,
 		PresignedCache: presignedCache,
 		RedirectURL:    redirectURL,
+		KeyPrefix:      config.GetStorageS3KeyPrefix(),
+
+		RemoteCacheTags: remoteCacheTags,
+
+		MultipartThreshold: int64(config.GetStorageS3MultipartThreshold()),
+		MultipartPartSize:  int64(config.GetStorageS3MultipartPartSize()),
+		MultipartRetries:   config.GetStorageS3MultipartRetries(),
 	}, nil
 }
@@ -39,6 +39,31 @@ type PresignedURL struct {
 	Expiry time.Time // link expires at this time
 }
 
+// ProgressFunc may be passed to storage write
+// operations in order to receive progress updates,
+// with bytes written so far and total to be written.
+type ProgressFunc func(written, total int64)
+
+// PutFileOpts provides optional
+// parameters to Driver{}.PutFile().
+type PutFileOpts struct {
+
+	// ContentType of file,
+	// where supported.
+	ContentType string
+
+	// Progress is an optional function to
+	// receive upload progress updates. This
+	// is only called for multipart uploads
+	// to S3, after each part is uploaded.
+	Progress ProgressFunc
+
+	// RemoteCache indicates this file
+	// is cached remote media, which
+	// may be tagged for expiry on S3.
+	RemoteCache bool
+}
+
 // IsInvalidKey returns whether error is an invalid-key
 // type error returned by the underlying storage library.
 func IsInvalidKey(err error) bool {
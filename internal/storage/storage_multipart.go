@@ -0,0 +1,233 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !nos3
+
+package storage
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"github.com/minio/minio-go/v7"
+)
+
+// minPartSize is the minimum size of an S3 multipart
+// upload part (except the last one), as dictated by:
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/qfacts.html
+const minPartSize = 5 * 1024 * 1024
+
+// maxPartSize and maxParts are the maximum size of
+// an S3 multipart upload part, and the maximum number
+// of parts in a single upload, as dictated by:
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/qfacts.html
+const (
+	maxPartSize = 5 * 1024 * 1024 * 1024
+	maxParts    = 10000
+)
+
+// partRetryBackoff is the base backoff time
+// between attempts at uploading a failed part,
+// this is multiplied by the attempt number.
+var partRetryBackoff = 2 * time.Second
+
+// multipartClient is the subset of minio.Core{}
+// methods needed to perform a multipart upload.
+type multipartClient interface {
+	NewMultipartUpload(ctx context.Context, bucket, object string, opts minio.PutObjectOptions) (string, error)
+	PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, data io.Reader, size int64, opts minio.PutObjectPartOptions) (minio.ObjectPart, error)
+	CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, parts []minio.CompletePart, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) error
+}
+
+// putMultipart uploads the contents of file (of given size) to S3 under key,
+// as a multipart upload in parts of d.MultipartPartSize. Each part upload is
+// retried up to d.MultipartRetries times on failure, so that transient network
+// errors don't require restarting an upload of (potentially very) large media.
+func (d *Driver) putMultipart(
+	ctx context.Context,
+	client multipartClient,
+	key string,
+	file io.ReaderAt,
+	size int64,
+	opts minio.PutObjectOptions,
+	progress ProgressFunc,
+) (
+	minio.UploadInfo,
+	error,
+) {
+	// Update given key with prefix,
+	// as we're using the client directly.
+	key = d.KeyPrefix + key
+
+	// Ensure a compatible part size. This is
+	// done before starting the upload, so that
+	// files too large for S3 fail fast without
+	// uploading any parts.
+	partSz, err := d.partSize(size)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	// Start a new multipart upload to get ID.
+	uploadID, err := client.NewMultipartUpload(ctx,
+		d.Bucket,
+		key,
+		opts,
+	)
+	if err != nil {
+		return minio.UploadInfo{}, gtserror.Newf("error starting multipart upload: %w", err)
+	}
+
+	var (
+		parts   []minio.CompletePart
+		written int64
+	)
+
+	for partID := 1; written < size; partID++ {
+		// Determine size of the next part,
+		// the last part is allowed to be <5MiB.
+		sz := min(partSz, size-written)
+
+		// Upload this next part of the file, retrying on error.
+		part, err := d.putPart(ctx, client, key, uploadID, partID,
+			io.NewSectionReader(file, written, sz),
+		)
+		if err != nil {
+			// Abort the upload so S3 can free already uploaded parts. We use
+			// a separate context here in case the error was ctx cancellation.
+			ctx := context.WithoutCancel(ctx)
+			if err := client.AbortMultipartUpload(ctx, d.Bucket, key, uploadID); err != nil {
+				log.Errorf(ctx, "error aborting multipart upload %s: %v", uploadID, err)
+			}
+			return minio.UploadInfo{}, gtserror.Newf("error uploading part %d: %w", partID, err)
+		}
+
+		// Append uploaded part to the list of completed.
+		parts = append(parts, minio.CompletePart{
+			PartNumber:     part.PartNumber,
+			ETag:           part.ETag,
+			ChecksumCRC32:  part.ChecksumCRC32,
+			ChecksumCRC32C: part.ChecksumCRC32C,
+			ChecksumSHA1:   part.ChecksumSHA1,
+			ChecksumSHA256: part.ChecksumSHA256,
+
+			ChecksumCRC64NVME: part.ChecksumCRC64NVME,
+		})
+
+		// Update total.
+		written += sz
+
+		if progress != nil {
+			// Report progress.
+			progress(written, size)
+		}
+	}
+
+	// Finally, complete the multipart upload with uploaded parts.
+	info, err := client.CompleteMultipartUpload(ctx,
+		d.Bucket,
+		key,
+		uploadID,
+		parts,
+		opts,
+	)
+	if err != nil {
+		return info, gtserror.Newf("error completing multipart upload: %w", err)
+	}
+
+	// Set the actual size, as
+	// complete doesn't return it.
+	info.Size = written
+
+	return info, nil
+}
+
+// partSize returns the part size to use for a multipart upload of
+// given size. This is d.MultipartPartSize, raised where necessary so
+// that the upload fits within S3's maximum number of parts. An error
+// is returned if size is too large to upload even at max part size.
+func (d *Driver) partSize(size int64) (int64, error) {
+	partSz := max(d.MultipartPartSize, minPartSize)
+
+	// Smallest part size with
+	// which size fits in maxParts.
+	need := (size + maxParts - 1) / maxParts
+	if need > partSz {
+		partSz = need
+	}
+
+	if partSz > maxPartSize {
+		return 0, gtserror.Newf("size %d too large for multipart upload", size)
+	}
+
+	return partSz, nil
+}
+
+// putPart uploads a singular part of a multipart upload, retrying on failure.
+func (d *Driver) putPart(
+	ctx context.Context,
+	client multipartClient,
+	key string,
+	uploadID string,
+	partID int,
+	r *io.SectionReader,
+) (
+	part minio.ObjectPart,
+	err error,
+) {
+	for i := 0; ; i++ {
+		// Attempt to upload part data.
+		part, err = client.PutObjectPart(ctx,
+			d.Bucket,
+			key,
+			uploadID,
+			partID,
+			r,
+			r.Size(),
+			minio.PutObjectPartOptions{},
+		)
+		if err == nil {
+			return part, nil
+		}
+
+		if i >= d.MultipartRetries {
+			// Out of retries.
+			return part, err
+		}
+
+		// Calculate backoff for this attempt.
+		backoff := time.Duration(i+1) * partRetryBackoff
+
+		log.Warnf(ctx, "error uploading part %d of %s (retrying in %s): %v",
+			partID, key, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return part, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		// Rewind part data to the start.
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return part, err
+		}
+	}
+}
@@ -0,0 +1,322 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !nos3
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// fakeMultipartClient implements multipartClient{},
+// storing uploaded parts in memory and failing
+// part uploads the configured number of times.
+type fakeMultipartClient struct {
+	mu sync.Mutex
+
+	// failures maps part IDs to number
+	// of times uploading should fail.
+	failures map[int]int
+
+	// attempts counts upload
+	// attempts for each part.
+	attempts map[int]int
+
+	// parts stores data of
+	// successfully uploaded parts.
+	parts map[int][]byte
+
+	// completed stores parts passed
+	// to CompleteMultipartUpload().
+	completed []minio.CompletePart
+
+	// aborted is set on
+	// AbortMultipartUpload().
+	aborted bool
+}
+
+func (c *fakeMultipartClient) NewMultipartUpload(ctx context.Context, bucket, object string, opts minio.PutObjectOptions) (string, error) {
+	return "upload-id", nil
+}
+
+func (c *fakeMultipartClient) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, data io.Reader, size int64, opts minio.PutObjectPartOptions) (minio.ObjectPart, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.attempts[partID]++
+
+	if c.failures[partID] > 0 {
+		c.failures[partID]--
+
+		// Read only some of the data before
+		// failing, like a dropped connection.
+		_, _ = io.CopyN(io.Discard, data, size/2)
+		return minio.ObjectPart{}, errors.New("connection reset by peer")
+	}
+
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return minio.ObjectPart{}, err
+	}
+
+	c.parts[partID] = b
+
+	return minio.ObjectPart{
+		PartNumber: partID,
+		ETag:       "etag-" + strconv.Itoa(partID),
+		Size:       int64(len(b)),
+	}, nil
+}
+
+func (c *fakeMultipartClient) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, parts []minio.CompletePart, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.completed = parts
+	return minio.UploadInfo{Bucket: bucket, Key: object}, nil
+}
+
+func (c *fakeMultipartClient) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.aborted = true
+	return nil
+}
+
+func newFakeMultipartClient(failures map[int]int) *fakeMultipartClient {
+	return &fakeMultipartClient{
+		failures: failures,
+		attempts: make(map[int]int),
+		parts:    make(map[int][]byte),
+	}
+}
+
+// testMultipartData returns data spanning two
+// full size parts and a smaller final part.
+func testMultipartData() []byte {
+	data := make([]byte, 2*minPartSize+1234)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func TestPutMultipartRetryPart(t *testing.T) {
+	defer func(b time.Duration) { partRetryBackoff = b }(partRetryBackoff)
+	partRetryBackoff = time.Millisecond
+
+	d := &Driver{Bucket: "bucket", MultipartRetries: 2}
+	data := testMultipartData()
+
+	// Fail the second part twice, each
+	// time after reading half its data.
+	client := newFakeMultipartClient(map[int]int{2: 2})
+
+	// Record reported progress.
+	var progress []int64
+	info, err := d.putMultipart(t.Context(), client, "key",
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{},
+		func(written, total int64) {
+			if total != int64(len(data)) {
+				t.Errorf("unexpected progress total: recv=%d expct=%d", total, len(data))
+			}
+			progress = append(progress, written)
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Progress is reported once per part.
+	expectProgress := []int64{minPartSize, 2 * minPartSize, int64(len(data))}
+	if !slices.Equal(progress, expectProgress) {
+		t.Errorf("unexpected progress: recv=%v expct=%v", progress, expectProgress)
+	}
+
+	if info.Size != int64(len(data)) {
+		t.Errorf("unexpected upload size: recv=%d expct=%d", info.Size, len(data))
+	}
+
+	if client.attempts[2] != 3 {
+		t.Errorf("unexpected attempts for part 2: recv=%d expct=3", client.attempts[2])
+	}
+
+	if client.aborted {
+		t.Error("upload was unexpectedly aborted")
+	}
+
+	if len(client.completed) != 3 {
+		t.Fatalf("unexpected number of completed parts: recv=%d expct=3", len(client.completed))
+	}
+
+	// Reassemble the parts in completion order, which
+	// checks each retried part was rewound to its start.
+	var uploaded []byte
+	for i, part := range client.completed {
+		if part.PartNumber != i+1 {
+			t.Errorf("unexpected part number at %d: recv=%d expct=%d", i, part.PartNumber, i+1)
+		}
+		if part.ETag != "etag-"+strconv.Itoa(i+1) {
+			t.Errorf("unexpected part etag at %d: %s", i, part.ETag)
+		}
+		uploaded = append(uploaded, client.parts[part.PartNumber]...)
+	}
+
+	if !bytes.Equal(uploaded, data) {
+		t.Error("uploaded data does not match original")
+	}
+}
+
+func TestPutMultipartAbortOnFailure(t *testing.T) {
+	defer func(b time.Duration) { partRetryBackoff = b }(partRetryBackoff)
+	partRetryBackoff = time.Millisecond
+
+	d := &Driver{Bucket: "bucket", MultipartRetries: 2}
+	data := testMultipartData()
+
+	// Fail the second part more
+	// times than we will retry.
+	client := newFakeMultipartClient(map[int]int{2: 3})
+
+	_, err := d.putMultipart(t.Context(), client, "key",
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{},
+		nil,
+	)
+	if err == nil {
+		t.Fatal("expected error uploading part")
+	}
+
+	if client.attempts[2] != 3 {
+		t.Errorf("unexpected attempts for part 2: recv=%d expct=3", client.attempts[2])
+	}
+
+	if client.attempts[3] != 0 {
+		t.Errorf("part 3 unexpectedly uploaded after failure")
+	}
+
+	if !client.aborted {
+		t.Error("upload was not aborted")
+	}
+
+	if client.completed != nil {
+		t.Error("upload was unexpectedly completed")
+	}
+}
+
+func TestMultipartPartSize(t *testing.T) {
+	d := &Driver{MultipartPartSize: 16 * 1024 * 1024}
+
+	for _, test := range []struct {
+		size   int64
+		expect int64
+	}{
+		// Configured part size is used
+		// where it fits within maxParts.
+		{size: 1024, expect: 16 * 1024 * 1024},
+		{size: maxParts * 16 * 1024 * 1024, expect: 16 * 1024 * 1024},
+
+		// Otherwise it's raised to the smallest
+		// part size that fits within maxParts.
+		{size: maxParts*16*1024*1024 + 1, expect: 16*1024*1024 + 1},
+		{size: 200 * 1024 * 1024 * 1024, expect: 21474837},
+
+		// Up to the maximum part size.
+		{size: maxParts * maxPartSize, expect: maxPartSize},
+	} {
+		partSz, err := d.partSize(test.size)
+		if err != nil {
+			t.Errorf("unexpected error for size %d: %v", test.size, err)
+			continue
+		}
+
+		if partSz != test.expect {
+			t.Errorf("unexpected part size for size %d: recv=%d expct=%d", test.size, partSz, test.expect)
+		}
+
+		if parts := (test.size + partSz - 1) / partSz; parts > maxParts {
+			t.Errorf("too many parts for size %d: %d", test.size, parts)
+		}
+	}
+
+	// Configured part size below
+	// minimum is raised to minimum.
+	d.MultipartPartSize = 1024
+	if partSz, err := d.partSize(1024); err != nil || partSz != minPartSize {
+		t.Errorf("unexpected part size: recv=%d expct=%d (err=%v)", partSz, minPartSize, err)
+	}
+}
+
+func TestPutMultipartTooLarge(t *testing.T) {
+	d := &Driver{Bucket: "bucket"}
+	client := newFakeMultipartClient(nil)
+
+	// Too large for S3 even at max part size,
+	// so this should fail before uploading.
+	_, err := d.putMultipart(t.Context(), client, "key",
+		bytes.NewReader(nil),
+		maxParts*maxPartSize+1,
+		minio.PutObjectOptions{},
+		nil,
+	)
+	if err == nil {
+		t.Fatal("expected error for too large upload")
+	}
+
+	if len(client.attempts) != 0 {
+		t.Errorf("parts unexpectedly uploaded: %v", client.attempts)
+	}
+
+	if client.aborted {
+		t.Error("upload was unexpectedly started and aborted")
+	}
+}
+
+func TestPutPartCanceled(t *testing.T) {
+	d := &Driver{Bucket: "bucket", MultipartRetries: 5}
+	data := testMultipartData()
+
+	// Always fail, and cancel the context so
+	// that retry backoff is interrupted.
+	client := newFakeMultipartClient(map[int]int{1: 10})
+	ctx, cncl := context.WithCancel(t.Context())
+	cncl()
+
+	_, err := d.putPart(ctx, client, "key", "upload-id", 1,
+		io.NewSectionReader(bytes.NewReader(data), 0, minPartSize),
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if client.attempts[1] != 1 {
+		t.Errorf("unexpected attempts for part 1: recv=%d expct=1", client.attempts[1])
+	}
+}
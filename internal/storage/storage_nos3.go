@@ -34,7 +34,7 @@
 type Driver struct{ Storage storage.Storage }
 
 // PutFile: see PutFile() in storage.go.
-func (d *Driver) PutFile(ctx context.Context, key, filepath, _ string) (int64, error) {
+func (d *Driver) PutFile(ctx context.Context, key, filepath string, opts PutFileOpts) (int64, error) {
 
 	// Open file at path for reading.
 	file, err := os.Open(filepath)
@@ -65,6 +65,11 @@ func (d *Driver) URL(ctx context.Context, key string) *PresignedURL {
 	return nil
 }
 
+// RemoteCacheLifecycle: not implemented for 'nos3'.
+func (d *Driver) RemoteCacheLifecycle() bool {
+	return false
+}
+
 // ProbeCSPUri: not implemented for 'nos3'.
 func (d *Driver) ProbeCSPUri(ctx context.Context) (string, error) {
 	return "", nil
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !nos3
+
+package storage
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestPutObjectOptionsRemoteCache(t *testing.T) {
+	tags := map[string]string{"gts-cache": "remote"}
+
+	for _, test := range []struct {
+		tags        map[string]string
+		remoteCache bool
+		expectTags  map[string]string
+	}{
+		{
+			// Remote media with tagging
+			// configured, should be tagged.
+			tags:        tags,
+			remoteCache: true,
+			expectTags:  tags,
+		},
+		{
+			// Local media with tagging
+			// configured, never tagged.
+			tags:        tags,
+			remoteCache: false,
+			expectTags:  nil,
+		},
+		{
+			// Remote media without
+			// tagging configured.
+			tags:        nil,
+			remoteCache: true,
+			expectTags:  nil,
+		},
+	} {
+		d := &Driver{RemoteCacheTags: test.tags}
+
+		opts := d.putObjectOptions(PutFileOpts{
+			ContentType: "image/png",
+			RemoteCache: test.remoteCache,
+		})
+
+		if opts.ContentType != "image/png" {
+			t.Errorf("unexpected content-type: %s", opts.ContentType)
+		}
+
+		if !maps.Equal(opts.UserTags, test.expectTags) {
+			t.Errorf("unexpected tags for remote=%v: recv=%v expct=%v",
+				test.remoteCache, opts.UserTags, test.expectTags)
+		}
+	}
+}
@@ -50,6 +50,23 @@
 	// EventTypeConversation -- a user
 	// should be shown an updated conversation.
 	EventTypeConversation = "conversation"
+
+	// EventTypeListRemove -- an account has been
+	// removed from a list, and its statuses should
+	// be pruned from that list's timeline.
+	EventTypeListRemove = "list.remove"
+
+	// EventTypeAnnouncement -- an instance
+	// announcement has been published or updated.
+	EventTypeAnnouncement = "announcement"
+
+	// EventTypeAnnouncementReaction -- the count of
+	// a reaction to an announcement has changed.
+	EventTypeAnnouncementReaction = "announcement.reaction"
+
+	// EventTypeAnnouncementDelete -- an instance
+	// announcement has been deleted or unpublished.
+	EventTypeAnnouncementDelete = "announcement.delete"
 )
 
 const (
@@ -86,7 +86,7 @@ func (s *Subscriptions) ScheduleJobs() error {
 		firstProcessAt = firstProcessAt.Add(processEvery)
 	}
 
-	fn := func(ctx context.Context, start time.Time) {
+	fn := func(ctx context.Context, start time.Time) error {
 		log.Info(ctx, "starting instance subscriptions processing")
 
 		// In blocklist (default) mode, process allows
@@ -113,6 +113,10 @@ func (s *Subscriptions) ScheduleJobs() error {
 		}
 
 		log.Infof(ctx, "finished instance subscriptions processing after %s", time.Since(start))
+
+		// Errors processing are stored
+		// on each subscription instead.
+		return nil
 	}
 
 	log.Infof(nil,
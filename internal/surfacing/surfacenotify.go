@@ -169,6 +169,87 @@ func (s *Surfacer) notifyMention(
 	return nil
 }
 
+// notifyThreadSubscribers notifies local accounts subscribed
+// to the thread of the given reply status that there has been
+// a new reply in the thread. Subscribers that are the author of
+// the reply, that were mentioned in it (and so already notified),
+// that have muted the thread, or that cannot see the reply, are
+// skipped.
+func (s *Surfacer) notifyThreadSubscribers(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	if status.InReplyToID == "" || status.ThreadID == "" {
+		// Not a reply in
+		// a known thread.
+		return nil
+	}
+
+	subscriberIDs, err := s.state.DB.GetThreadSubscriberIDs(ctx, status.ThreadID)
+	if err != nil {
+		return gtserror.Newf(
+			"error getting thread %s subscribers: %w",
+			status.ThreadID, err,
+		)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, subscriberID := range subscriberIDs {
+		if subscriberID == status.AccountID ||
+			status.MentionsAccount(subscriberID) {
+			// Either reply author, or
+			// notified of the mention.
+			continue
+		}
+
+		// Ensure thread not muted by subscriber.
+		muted, err := s.state.DB.IsThreadMutedByAccount(ctx,
+			status.ThreadID,
+			subscriberID,
+		)
+		if err != nil {
+			errs.Appendf("error checking status thread mute %s: %w", status.ThreadID, err)
+			continue
+		}
+
+		if muted {
+			continue
+		}
+
+		subscriber, err := s.state.DB.GetAccountByID(ctx, subscriberID)
+		if err != nil {
+			errs.Appendf("error getting subscriber %s: %w", subscriberID, err)
+			continue
+		}
+
+		// Ensure reply is visible to subscriber.
+		visible, err := s.visFilter.StatusVisible(ctx, subscriber, status)
+		if err != nil {
+			errs.Appendf("error checking status visibility for %s: %w", subscriberID, err)
+			continue
+		}
+
+		if !visible {
+			continue
+		}
+
+		// Notify subscriber
+		// by reply author.
+		if err := s.Notify(ctx,
+			gtsmodel.NotificationThreadReply,
+			subscriber,
+			status.Account,
+			status,
+			nil,
+		); err != nil {
+			errs.Appendf("error notifying thread subscriber %s: %w", subscriberID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
 // NotifyFollowRequest notifies the target of the given
 // follow request that they have a new follow request.
 func (s *Surfacer) NotifyFollowRequest(
@@ -561,6 +642,95 @@ func (s *Surfacer) NotifySignup(ctx context.Context, newUser *gtsmodel.User) err
 	return errs.Combine()
 }
 
+// NotifyAccountWarning notifies the target of the given
+// account warning that a moderator has issued them a warning.
+// The notification originates from the instance account,
+// so as not to reveal which moderator issued the warning.
+func (s *Surfacer) NotifyAccountWarning(ctx context.Context, warning *gtsmodel.AccountWarning) error {
+	return s.notifyModeration(ctx,
+		gtsmodel.NotificationModerationWarning,
+		warning,
+	)
+}
+
+// NotifyAppealResolved notifies the creator of the given
+// appeal that a moderator has approved or rejected it.
+// The notification originates from the instance account,
+// so as not to reveal which moderator resolved the appeal.
+func (s *Surfacer) NotifyAppealResolved(ctx context.Context, appeal *gtsmodel.Appeal) error {
+	// Ensure appeal populated.
+	if err := s.state.DB.PopulateAppeal(ctx, appeal); err != nil {
+		return gtserror.Newf("db error populating appeal: %w", err)
+	}
+
+	notifType := gtsmodel.NotificationAppealRejected
+	if *appeal.Approved {
+		notifType = gtsmodel.NotificationAppealApproved
+	}
+
+	return s.notifyModeration(ctx,
+		notifType,
+		appeal.AccountWarning,
+	)
+}
+
+// notifyModeration notifies the target of the given
+// account warning with a notification of the given type,
+// originating from the instance account.
+func (s *Surfacer) notifyModeration(
+	ctx context.Context,
+	notifType gtsmodel.NotificationType,
+	warning *gtsmodel.AccountWarning,
+) error {
+	// Ensure warning populated.
+	if err := s.state.DB.PopulateAccountWarning(ctx, warning); err != nil {
+		return gtserror.Newf("db error populating account warning: %w", err)
+	}
+
+	if warning.Account.IsRemote() {
+		// nothing to do.
+		return nil
+	}
+
+	instanceAcct, err := s.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("db error getting instance account: %w", err)
+	}
+
+	// Each warning / appeal outcome is unique,
+	// so there's no need to check for an existing notif.
+	notif := &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: notifType,
+		TargetAccountID:  warning.AccountID,
+		TargetAccount:    warning.Account,
+		OriginAccountID:  instanceAcct.ID,
+		OriginAccount:    instanceAcct,
+		AccountWarningID: warning.ID,
+		AccountWarning:   warning,
+	}
+
+	if err := s.state.DB.PutNotification(ctx, notif); err != nil {
+		return gtserror.Newf("error putting notification in database: %w", err)
+	}
+
+	// Convert notification to frontend API model for streaming / web push.
+	apiNotif, err := s.converter.NotificationToAPINotification(ctx, notif)
+	if err != nil {
+		return gtserror.Newf("error converting notification to api representation: %w", err)
+	}
+
+	// Stream notification to the user.
+	s.stream.Notify(ctx, warning.Account, apiNotif)
+
+	// Send Web Push notification to the user.
+	if err = s.webPushSender.Send(ctx, notif, apiNotif); err != nil {
+		return gtserror.Newf("error sending Web Push notifications: %w", err)
+	}
+
+	return nil
+}
+
 func getNotifyLockURI(
 	notificationType gtsmodel.NotificationType,
 	targetAccount *gtsmodel.Account,
@@ -62,8 +62,10 @@ func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 				_ = s.state.Caches.Timelines.Local.InsertOne(status)
 			}
 
-			// Stream the status model as local timeline update event.
-			s.stream.Update(ctx, account, apiStatus, stream.TimelineLocal)
+			if s.languageChosen(ctx, account, status) {
+				// Stream the status model as local timeline update event.
+				s.stream.Update(ctx, account, apiStatus, stream.TimelineLocal)
+			}
 		},
 
 		// public timelining and streaming function
@@ -75,8 +77,10 @@ func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 				_ = s.state.Caches.Timelines.Public.InsertOne(status)
 			}
 
-			// Stream the status model as public timeline update event.
-			s.stream.Update(ctx, account, apiStatus, stream.TimelinePublic)
+			if s.languageChosen(ctx, account, status) {
+				// Stream the status model as public timeline update event.
+				s.stream.Update(ctx, account, apiStatus, stream.TimelinePublic)
+			}
 		},
 	)
 
@@ -91,8 +95,9 @@ func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 			// Insert this new status into the relevant list timeline cache.
 			repeatBoost := s.state.Caches.Timelines.Home.InsertOne(account.ID, status)
 
-			if !repeatBoost {
-				// Only stream if not repeated boost of recent status.
+			if !repeatBoost && s.languageChosen(ctx, account, status) {
+				// Only stream if not repeated boost of recent
+				// status, and in a language account has chosen.
 				s.stream.Update(ctx, account, apiStatus, stream.TimelineHome)
 			}
 		},
@@ -103,8 +108,9 @@ func(list *gtsmodel.List, account *gtsmodel.Account, apiStatus *apimodel.Status)
 			// Insert this new status into the relevant list timeline cache.
 			repeatBoost := s.state.Caches.Timelines.List.InsertOne(list.ID, status)
 
-			if !repeatBoost {
-				// Only stream if not repeated boost of recent status.
+			if !repeatBoost && s.languageChosen(ctx, account, status) {
+				// Only stream if not repeated boost of recent
+				// status, and in a language account has chosen.
 				streamType := stream.TimelineList + ":" + list.ID
 				s.stream.Update(ctx, account, apiStatus, streamType)
 			}
@@ -132,6 +138,11 @@ func(account *gtsmodel.Account) {
 		return gtserror.Newf("error notifying status mentions for status %s: %w", status.URI, err)
 	}
 
+	// Notify each local account subscribed to the thread.
+	if err := s.notifyThreadSubscribers(ctx, status); err != nil {
+		log.Errorf(ctx, "error notifying thread subscribers for status %s: %v", status.URI, err)
+	}
+
 	// Update conversations containing this status, and get notifications for them.
 	notifications, err := s.conversations.UpdateConversationsForStatus(ctx, status)
 	if err != nil {
@@ -147,7 +158,7 @@ func(account *gtsmodel.Account) {
 }
 
 // TimelineAndNotifyStatusUpdate handles streaming an update event for the given status model to HOME,
-// LIST, LOCAL and PUBLIC timelines, as well as adding to their relevant in-memory caches. It also handles
+// LIST, LOCAL, PUBLIC and DIRECT timelines, as well as adding to their relevant in-memory caches. It also handles
 // sending any relevant notifications for the received updated status, e.g. new mentions, poll closing,
 // followers with the notify flag, and edits to a status that anyone local has previously interacted with.
 func (s *Surfacer) TimelineAndNotifyStatusUpdate(ctx context.Context, status *gtsmodel.Status) error {
@@ -218,14 +229,18 @@ func (s *Surfacer) TimelineAndNotifyStatusUpdate(ctx context.Context, status *gt
 		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 			// NOTE: timeline invalidation is handled separately
 			// as we don't need to perform it per user account.
-			s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelineLocal)
+			if s.languageChosen(ctx, account, status) {
+				s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelineLocal)
+			}
 		},
 
 		// public timelining and streaming function
 		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 			// NOTE: timeline invalidation is handled separately
 			// as we don't need to perform it per user account.
-			s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelinePublic)
+			if s.languageChosen(ctx, account, status) {
+				s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelinePublic)
+			}
 		},
 	)
 
@@ -237,15 +252,19 @@ func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
 			// NOTE: timeline invalidation is handled separately
 			// as we don't need to perform it per account or list.
-			s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelineHome)
+			if s.languageChosen(ctx, account, status) {
+				s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelineHome)
+			}
 		},
 
 		// list timelining and streaming function
 		func(list *gtsmodel.List, account *gtsmodel.Account, apiStatus *apimodel.Status) {
 			// NOTE: timeline invalidation is handled separately
 			// as we don't need to perform it per account or list.
-			streamType := stream.TimelineList + ":" + list.ID
-			s.stream.StatusUpdate(ctx, account, apiStatus, streamType)
+			if s.languageChosen(ctx, account, status) {
+				streamType := stream.TimelineList + ":" + list.ID
+				s.stream.StatusUpdate(ctx, account, apiStatus, streamType)
+			}
 		},
 
 		// notify status for
@@ -253,6 +272,16 @@ func(list *gtsmodel.List, account *gtsmodel.Account, apiStatus *apimodel.Status)
 		notifyAccount,
 	)
 
+	// Stream the status update to the direct
+	// timelines of any local participants.
+	s.timelineStatusForDirect(ctx, status,
+
+		// direct streaming function
+		func(account *gtsmodel.Account, apiStatus *apimodel.Status) {
+			s.stream.StatusUpdate(ctx, account, apiStatus, stream.TimelineDirect)
+		},
+	)
+
 	// Notify any *new* mentions added by editor.
 	for _, mention := range status.Mentions {
 
@@ -352,6 +381,71 @@ func (s *Surfacer) timelineStatusForPublic(
 	}
 }
 
+// timelineStatusForDirect passes the given direct status to
+// the given function for each local participant of it, i.e.
+// the author and any mentioned accounts, that can see it.
+//
+// This is used to stream updates to direct statuses, as the
+// creation of these is instead streamed as conversation events.
+func (s *Surfacer) timelineStatusForDirect(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	directTimelineFn func(*gtsmodel.Account, *apimodel.Status),
+) {
+	if directTimelineFn == nil {
+		panic("nil timeline func")
+	}
+
+	if status.Visibility != gtsmodel.VisibilityDirect ||
+		status.BoostOfID != "" {
+		// Only direct non-boost statuses
+		// show up in direct timelines.
+		return
+	}
+
+	// Gather the local participants of this status.
+	participants := make([]*gtsmodel.Account, 0, 1+len(status.Mentions))
+	if status.Account.IsLocal() {
+		participants = append(participants, status.Account)
+	}
+	for _, mention := range status.Mentions {
+		if mention.TargetAccount == nil ||
+			mention.TargetAccount.IsRemote() ||
+			mention.TargetAccountID == status.AccountID {
+			continue
+		}
+		participants = append(participants, mention.TargetAccount)
+	}
+
+	// Deduplicate participants, in case
+	// someone was mentioned more than once.
+	participants = xslices.DeduplicateFunc(participants,
+		func(account *gtsmodel.Account) string {
+			return account.ID
+		})
+
+	for _, account := range participants {
+		// Try to prepare status for direct timelining for this
+		// participant, using same filter context as conversations.
+		apiStatus, timelineable, err := s.prepareStatusForTimeline(ctx,
+			account,
+			status,
+			gtsmodel.FilterContextNotifications,
+			(*visibility.Filter).StatusVisible,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error preparing status %s for participant %s: %v", status.URI, account.URI, err)
+			continue
+		}
+
+		if !timelineable {
+			continue
+		}
+
+		directTimelineFn(account, apiStatus)
+	}
+}
+
 // timelineAndNotifyStatusForFollowers timelines and notifies (where
 // appropriate) of the given status for all the local followers of the author
 // account, and all the local followers the tags contained in status.
@@ -500,10 +594,14 @@ func (s *Surfacer) timelineAndNotifyStatusForFollowers(
 	}
 
 	// Gather *useable* tag IDs from tag status.
+	//
+	// Follows of aliased tags are stored against the
+	// tag they're an alias of, so use canonical IDs.
 	tagIDs := xslices.GatherIf(nil, tagStatus.Tags,
 		func(tag *gtsmodel.Tag) (string, bool) {
-			return tag.ID, (*tag.Useable)
+			return tag.CanonicalID(), (*tag.Useable)
 		})
+	tagIDs = xslices.Deduplicate(tagIDs)
 
 	if len(tagIDs) == 0 {
 		// No tags to
@@ -575,11 +673,15 @@ func (s *Surfacer) timelineStatusForTags(status *gtsmodel.Status) {
 	}
 
 	// Gather timelineable tag IDs from status.
+	//
+	// Aliased tags share the timeline of the
+	// tag they're an alias of, so use canonical IDs.
 	tagIDs := xslices.GatherIf(nil, status.Tags,
 		func(tag *gtsmodel.Tag) (string, bool) {
-			return tag.ID, (*tag.Useable) &&
+			return tag.CanonicalID(), (*tag.Useable) &&
 				(*tag.Listable)
 		})
+	tagIDs = xslices.Deduplicate(tagIDs)
 
 	if len(tagIDs) == 0 {
 		// No tags to
@@ -652,16 +754,44 @@ func (s *Surfacer) prepareStatusForTimeline(
 		account,
 	)
 	if err != nil {
-		log.Error(ctx, "error converting status %s to frontend: %v", status.URI, err)
-	} else {
-
-		// Attach any filter results.
-		apiStatus.Filtered = filtered
+		return nil, false, gtserror.Newf("error converting status %s to frontend: %w", status.URI, err)
 	}
 
+	// Attach any filter results,
+	// consistent with REST responses.
+	apiStatus.Filtered = filtered
+
 	return apiStatus, true, nil
 }
 
+// languageChosen returns whether the given status (or the original,
+// if a boost) is in one of the languages the given account has chosen
+// to see on timelines, populating the account's settings if needed.
+func (s *Surfacer) languageChosen(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	status *gtsmodel.Status,
+) bool {
+	if account.Settings == nil {
+		settings, err := s.state.DB.GetAccountSettings(ctx, account.ID)
+		if err != nil {
+			log.Errorf(ctx, "error getting settings for account %s: %v", account.URI, err)
+			return true // default assume chosen
+		}
+
+		// Set account's settings.
+		account.Settings = settings
+	}
+
+	if status.BoostOf != nil {
+		// Use language
+		// of original.
+		status = status.BoostOf
+	}
+
+	return account.Settings.LanguageChosen(status.Language)
+}
+
 // listEligible checks if the given status is eligible
 // for inclusion in the list that that the given listEntry
 // belongs to, based on the replies policy of the list.
@@ -107,6 +107,33 @@ func Demojify(text string) string {
 	return regexes.EmojiFinder.ReplaceAllString(text, "")
 }
 
+// EmojisIn returns those of the given emojis whose shortcodes
+// are used in any of the given text fragments, in their original
+// order. This is useful for getting the emojis to be used when
+// rendering just one part of a status or account, eg., poll options.
+func EmojisIn(emojis []apimodel.Emoji, texts ...string) []apimodel.Emoji {
+	// Gather set of all shortcodes
+	// used in the given fragments.
+	used := make(map[string]struct{})
+	for _, text := range texts {
+		for _, match := range regexes.EmojiFinder.FindAllStringSubmatch(text, -1) {
+			used[match[1]] = struct{}{}
+		}
+	}
+
+	// Select emojis with a used shortcode.
+	// Use non-nil slice so that this is
+	// serialized as `[]` rather than null.
+	in := make([]apimodel.Emoji, 0, len(used))
+	for _, emoji := range emojis {
+		if _, ok := used[emoji.Shortcode]; ok {
+			in = append(in, emoji)
+		}
+	}
+
+	return in
+}
+
 func emojify(
 	emojis []apimodel.Emoji,
 	input string,
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	mdutil "github.com/yuin/goldmark/util"
+)
+
+// codeHighlighter fulfils the following goldmark interfaces:
+//
+//   - renderer.NodeRenderer
+//   - goldmark.Extender.
+//
+// It is used as a goldmark extension by fromMarkdown
+// to render fenced code blocks with syntax highlighting
+// of supported languages. Code blocks in other languages
+// are rendered the same as by the default html renderer.
+type codeHighlighter struct{}
+
+func (ch *codeHighlighter) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFencedCodeBlock, ch.renderFencedCodeBlock)
+}
+
+func (ch *codeHighlighter) Extend(markdown goldmark.Markdown) {
+	// Must be a higher priority (ie., lower
+	// number) than the default html renderer
+	// (1000), so that our render func for
+	// fenced code blocks takes precedence.
+	const prio = 500
+
+	markdown.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			mdutil.Prioritized(ch, prio),
+		),
+	)
+}
+
+// renderFencedCodeBlock takes a fenced code
+// block ast.Node and renders it as HTML.
+func (ch *codeHighlighter) renderFencedCodeBlock(
+	w mdutil.BufWriter,
+	source []byte,
+	node ast.Node,
+	entering bool,
+) (ast.WalkStatus, error) {
+	if !entering {
+		_, _ = w.WriteString("</code></pre>\n")
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*ast.FencedCodeBlock)
+
+	_, _ = w.WriteString("<pre><code")
+	language := n.Language(source)
+	if language != nil {
+		_, _ = w.WriteString(` class="language-`)
+		html.DefaultWriter.Write(w, language)
+		_ = w.WriteByte('"')
+	}
+	_ = w.WriteByte('>')
+
+	// Gather the lines of the code block
+	// so that tokens spanning multiple
+	// lines (eg., comments) are lexed.
+	var code []byte
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		code = append(code, line.Value(source)...)
+	}
+
+	if language == nil ||
+		!highlightCode(w, string(language), code) {
+		// No (supported) language,
+		// just write out escaped.
+		html.DefaultWriter.RawWrite(w, code)
+	}
+
+	return ast.WalkContinue, nil
+}
@@ -90,16 +90,26 @@ func (p *mentionParser) Parse(
 		return nil
 	}
 
+	// Leave any trailing punctuation
+	// out of the mention, so that eg.,
+	// "cc @someone." mentions "@someone".
+	end := len(TrimMentionPunctuation(string(line[:loc[1]])))
+	if end <= 1 {
+		// Nothing left
+		// but the '@'.
+		return nil
+	}
+
 	// Advance the block to
 	// the end of the mention.
-	block.Advance(loc[1])
+	block.Advance(end)
 
 	// mention ast.Node spans from the
 	// beginning of this segment up to
 	// the last character of the mention.
 	return newMention(
 		segment.WithStop(
-			segment.Start + loc[1],
+			segment.Start + end,
 		),
 	)
 }
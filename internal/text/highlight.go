@@ -0,0 +1,364 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark/renderer/html"
+	mdutil "github.com/yuin/goldmark/util"
+)
+
+// Token classes used when highlighting code. These
+// are the same class names that Prism uses, so that
+// the Prism themes bundled with the web UI style
+// server-side highlighted code blocks as well.
+const (
+	tokenComment  = "comment"
+	tokenString   = "string"
+	tokenNumber   = "number"
+	tokenKeyword  = "keyword"
+	tokenBoolean  = "boolean"
+	tokenProperty = "property"
+)
+
+// lexer contains the rules for
+// tokenizing source code of one
+// (family of) programming language.
+type lexer struct {
+	// Words to highlight as keywords.
+	keywords map[string]struct{}
+
+	// Words to highlight as
+	// booleans / null values.
+	literals map[string]struct{}
+
+	// Markers that start a comment
+	// running until the end of a line.
+	lineComments []string
+
+	// Start and end markers
+	// of a block comment, if any.
+	blockComment [2]string
+
+	// Characters that open and close
+	// a string literal. Strings opened
+	// with a backtick may span lines.
+	quotes string
+
+	// Whether a string literal directly
+	// followed by a colon should be
+	// highlighted as a property (key).
+	propertyKeys bool
+}
+
+// words returns a set containing the
+// space-separated words in the given string.
+func words(s string) map[string]struct{} {
+	fields := strings.Fields(s)
+	set := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		set[f] = struct{}{}
+	}
+	return set
+}
+
+var (
+	cLikeLiterals = words("true false NULL nullptr")
+
+	goLexer = &lexer{
+		keywords: words("break case chan const continue default defer else fallthrough for func go goto if " +
+			"import interface map package range return select struct switch type var"),
+		literals:     words("true false nil iota"),
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+	}
+
+	rustLexer = &lexer{
+		keywords: words("as async await break const continue crate dyn else enum extern fn for if impl in " +
+			"let loop match mod move mut pub ref return self Self static struct super trait type unsafe use where while"),
+		literals:     words("true false None Some Ok Err"),
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		// Single quotes are used for lifetimes
+		// as well as chars, so ignore them.
+		quotes: "\"",
+	}
+
+	cLexer = &lexer{
+		keywords: words("auto break case char class const continue default delete do double else enum extern " +
+			"float for goto if inline int long namespace new private protected public register return short " +
+			"signed sizeof static struct switch template this typedef union unsigned using virtual void volatile while"),
+		literals:     cLikeLiterals,
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'",
+	}
+
+	javaLexer = &lexer{
+		keywords: words("abstract boolean break byte case catch char class const continue default do double " +
+			"else enum extends final finally float for if implements import instanceof int interface long native " +
+			"new package private protected public return short static super switch synchronized this throw throws " +
+			"try void volatile while var"),
+		literals:     words("true false null"),
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'",
+	}
+
+	jsLexer = &lexer{
+		keywords: words("as async await break case catch class const continue debugger default delete do else " +
+			"enum export extends finally for from function if implements import in instanceof interface let new " +
+			"of private protected public return static super switch this throw try type typeof var void while with yield"),
+		literals:     words("true false null undefined NaN Infinity"),
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+	}
+
+	pythonLexer = &lexer{
+		keywords: words("and as assert async await break class continue def del elif else except finally for " +
+			"from global if import in is lambda nonlocal not or pass raise return try while with yield"),
+		literals:     words("True False None"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+
+	rubyLexer = &lexer{
+		keywords: words("alias and begin break case class def defined do else elsif end ensure for if in module " +
+			"next not or redo rescue retry return self super then undef unless until when while yield"),
+		literals:     words("true false nil"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+
+	shellLexer = &lexer{
+		keywords: words("case do done elif else esac export fi for function if in local readonly return " +
+			"select then until while"),
+		literals:     words("true false"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+
+	sqlLexer = &lexer{
+		keywords: words("add all alter and as asc between by case create delete desc distinct drop else end " +
+			"exists from group having in index inner insert into is join key left like limit not offset on or " +
+			"order outer primary references returning right select set table then union unique update values " +
+			"view when where with " +
+			"ADD ALL ALTER AND AS ASC BETWEEN BY CASE CREATE DELETE DESC DISTINCT DROP ELSE END " +
+			"EXISTS FROM GROUP HAVING IN INDEX INNER INSERT INTO IS JOIN KEY LEFT LIKE LIMIT NOT OFFSET ON OR " +
+			"ORDER OUTER PRIMARY REFERENCES RETURNING RIGHT SELECT SET TABLE THEN UNION UNIQUE UPDATE VALUES " +
+			"VIEW WHEN WHERE WITH"),
+		literals:     words("true false null TRUE FALSE NULL"),
+		lineComments: []string{"--"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "'\"",
+	}
+
+	jsonLexer = &lexer{
+		literals:     words("true false null"),
+		quotes:       "\"",
+		propertyKeys: true,
+	}
+)
+
+// lexers maps (lowercase) fenced code
+// block language names to their lexer.
+var lexers = map[string]*lexer{
+	"go":         goLexer,
+	"golang":     goLexer,
+	"rust":       rustLexer,
+	"rs":         rustLexer,
+	"c":          cLexer,
+	"h":          cLexer,
+	"cpp":        cLexer,
+	"cxx":        cLexer,
+	"hpp":        cLexer,
+	"java":       javaLexer,
+	"kotlin":     javaLexer,
+	"kt":         javaLexer,
+	"javascript": jsLexer,
+	"js":         jsLexer,
+	"jsx":        jsLexer,
+	"typescript": jsLexer,
+	"ts":         jsLexer,
+	"tsx":        jsLexer,
+	"python":     pythonLexer,
+	"py":         pythonLexer,
+	"ruby":       rubyLexer,
+	"rb":         rubyLexer,
+	"shell":      shellLexer,
+	"sh":         shellLexer,
+	"bash":       shellLexer,
+	"zsh":        shellLexer,
+	"sql":        sqlLexer,
+	"json":       jsonLexer,
+}
+
+// highlightCode writes the given source code to the given writer
+// as HTML, wrapping recognized tokens in Prism-style span elements,
+// eg., `<span class="token keyword">func</span>`. All other text is
+// HTML-escaped. Returns false (without writing) if the given language
+// is not supported.
+func highlightCode(w mdutil.BufWriter, lang string, code []byte) bool {
+	lx, ok := lexers[strings.ToLower(lang)]
+	if !ok {
+		return false
+	}
+
+	var plainStart int
+	flushPlain := func(end int) {
+		if end > plainStart {
+			html.DefaultWriter.RawWrite(w, code[plainStart:end])
+		}
+	}
+
+	for i := 0; i < len(code); {
+		class, end := lx.next(code, i)
+		if class == "" {
+			// Nothing recognized,
+			// keep as plain text.
+			i = end
+			continue
+		}
+
+		// Write any plain text
+		// preceding this token.
+		flushPlain(i)
+
+		_, _ = w.WriteString(`<span class="token `)
+		_, _ = w.WriteString(class)
+		_, _ = w.WriteString(`">`)
+		html.DefaultWriter.RawWrite(w, code[i:end])
+		_, _ = w.WriteString(`</span>`)
+
+		i = end
+		plainStart = end
+	}
+
+	flushPlain(len(code))
+	return true
+}
+
+// next lexes the token starting at index i of code, returning
+// its class (empty for plain text) and the index at which it ends.
+func (lx *lexer) next(code []byte, i int) (string, int) {
+	rest := code[i:]
+
+	// Comment running to end of line.
+	for _, marker := range lx.lineComments {
+		if bytes.HasPrefix(rest, []byte(marker)) {
+			end := bytes.IndexByte(rest, '\n')
+			if end == -1 {
+				end = len(rest)
+			}
+			return tokenComment, i + end
+		}
+	}
+
+	// Block comment running to its end
+	// marker, or the end of the code.
+	if start, stop := lx.blockComment[0], lx.blockComment[1]; start != "" &&
+		bytes.HasPrefix(rest, []byte(start)) {
+		end := bytes.Index(rest[len(start):], []byte(stop))
+		if end == -1 {
+			return tokenComment, len(code)
+		}
+		return tokenComment, i + len(start) + end + len(stop)
+	}
+
+	c := rest[0]
+	switch {
+	case strings.IndexByte(lx.quotes, c) != -1:
+		end := i + stringLen(rest)
+		if lx.propertyKeys && followedByColon(code[end:]) {
+			return tokenProperty, end
+		}
+		return tokenString, end
+
+	case isDigit(c) && (i == 0 || !isWordChar(code[i-1])):
+		end := i + 1
+		for end < len(code) && (isWordChar(code[end]) || code[end] == '.') {
+			end++
+		}
+		return tokenNumber, end
+
+	case isWordChar(c):
+		end := i + 1
+		for end < len(code) && isWordChar(code[end]) {
+			end++
+		}
+
+		// Don't treat word chars following
+		// a digit or another word as a word.
+		if i > 0 && isWordChar(code[i-1]) {
+			return "", end
+		}
+
+		word := string(code[i:end])
+		if _, ok := lx.keywords[word]; ok {
+			return tokenKeyword, end
+		}
+		if _, ok := lx.literals[word]; ok {
+			return tokenBoolean, end
+		}
+		return "", end
+	}
+
+	return "", i + 1
+}
+
+// stringLen returns the length of the string literal at
+// the start of b, including opening and closing quotes.
+// Backslash escapes are skipped over. Strings that aren't
+// opened with a backtick are terminated at a newline.
+func stringLen(b []byte) int {
+	quote := b[0]
+	for i := 1; i < len(b); i++ {
+		switch b[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		case '\n':
+			if quote != '`' {
+				return i
+			}
+		}
+	}
+	return len(b)
+}
+
+// followedByColon returns whether the first
+// non-whitespace character in b is a colon.
+func followedByColon(b []byte) bool {
+	b = bytes.TrimLeft(b, " \t")
+	return len(b) > 0 && b[0] == ':'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || isDigit(c) ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z')
+}
@@ -24,6 +24,7 @@
 	"strings"
 
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/regexes"
@@ -134,30 +135,39 @@ func (f *Formatter) fromMarkdown(
 	// Instantiate goldmark parser for
 	// markdown, using custom renderer
 	// to add hashtag/mention links.
+	exts := []goldmark.Extender{
+		&customRenderer{
+			ctx,
+			f.db,
+			parseMention,
+			authorID,
+			statusID,
+			// If basic, pass
+			// emojiOnly = true.
+			basic,
+			result,
+		},
+		// Turns URLs into links.
+		extension.NewLinkify(
+			extension.WithLinkifyURLRegexp(regexes.URLLike),
+		),
+		extension.NewFootnote(
+			extension.WithFootnoteIDPrefix(footnoteIDPrefix),
+		),
+		extension.Strikethrough,
+	}
+
+	if config.GetStatusesSyntaxHighlighting() {
+		// Highlight fenced code blocks.
+		exts = append(exts, new(codeHighlighter))
+	}
+
 	md := goldmark.New(
 		goldmark.WithRendererOptions(
 			opts...,
 		),
 		goldmark.WithExtensions(
-			&customRenderer{
-				ctx,
-				f.db,
-				parseMention,
-				authorID,
-				statusID,
-				// If basic, pass
-				// emojiOnly = true.
-				basic,
-				result,
-			},
-			// Turns URLs into links.
-			extension.NewLinkify(
-				extension.WithLinkifyURLRegexp(regexes.URLLike),
-			),
-			extension.NewFootnote(
-				extension.WithFootnoteIDPrefix(footnoteIDPrefix),
-			),
-			extension.Strikethrough,
+			exts...,
 		),
 	)
 
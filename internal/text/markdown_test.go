@@ -20,6 +20,7 @@
 import (
 	"testing"
 
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -43,7 +44,12 @@
 const (
 	simpleMarkdown                     = "# Title\n\nHere's a simple text in markdown.\n\nHere's a [link](https://example.org)."
 	simpleMarkdownExpected             = "<h1>Title</h1><p>Here's a simple text in markdown.</p><p>Here's a <a href=\"https://example.org\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">link</a>.</p>"
-	withCodeBlockExpected              = "<h1>Title</h1><p>Below is some JSON.</p><pre><code class=\"language-json\">{\n  &#34;key&#34;: &#34;value&#34;,\n  &#34;another_key&#34;: [\n    &#34;value1&#34;,\n    &#34;value2&#34;\n  ]\n}\n</code></pre><p>that was some JSON :)</p>"
+	withCodeBlockExpected              = "<h1>Title</h1><p>Below is some JSON.</p><pre><code class=\"language-json\">{\n  <span class=\"token property\">&#34;key&#34;</span>: <span class=\"token string\">&#34;value&#34;</span>,\n  <span class=\"token property\">&#34;another_key&#34;</span>: [\n    <span class=\"token string\">&#34;value1&#34;</span>,\n    <span class=\"token string\">&#34;value2&#34;</span>\n  ]\n}\n</code></pre><p>that was some JSON :)</p>"
+	withCodeBlockUnhighlighted         = "<h1>Title</h1><p>Below is some JSON.</p><pre><code class=\"language-json\">{\n  &#34;key&#34;: &#34;value&#34;,\n  &#34;another_key&#34;: [\n    &#34;value1&#34;,\n    &#34;value2&#34;\n  ]\n}\n</code></pre><p>that was some JSON :)</p>"
+	mdGoCodeBlock                      = "```go\n// Add two numbers.\nfunc add(a, b int) int {\n\treturn a + b + 0x10 // \"<b>\"\n}\n```"
+	mdGoCodeBlockExpected              = "<pre><code class=\"language-go\"><span class=\"token comment\">// Add two numbers.</span>\n<span class=\"token keyword\">func</span> add(a, b int) int {\n\t<span class=\"token keyword\">return</span> a + b + <span class=\"token number\">0x10</span> <span class=\"token comment\">// &#34;&lt;b&gt;&#34;</span>\n}\n</code></pre>"
+	mdUnknownLanguageCodeBlock         = "```brainfuck\n++>+<[-]\n```"
+	mdUnknownLanguageCodeBlockExpected = "<pre><code class=\"language-brainfuck\">++&gt;+&lt;[-]\n</code></pre>"
 	withInlineCode                     = "`Nobody tells you about the <code><del>SECRET CODE</del></code>, do they?`"
 	withInlineCodeExpected             = "<p><code>Nobody tells you about the &lt;code>&lt;del>SECRET CODE&lt;/del>&lt;/code>, do they?</code></p>"
 	withInlineCode2                    = "`Nobody tells you about the </code><del>SECRET CODE</del><code>, do they?`"
@@ -112,6 +118,23 @@ func (suite *MarkdownTestSuite) TestParseWithCodeBlock() {
 	suite.Equal(withCodeBlockExpected, formatted.HTML)
 }
 
+func (suite *MarkdownTestSuite) TestParseWithCodeBlockNoHighlighting() {
+	config.SetStatusesSyntaxHighlighting(false)
+
+	formatted := suite.FromMarkdown(withCodeBlock)
+	suite.Equal(withCodeBlockUnhighlighted, formatted.HTML)
+}
+
+func (suite *MarkdownTestSuite) TestParseGoCodeBlock() {
+	formatted := suite.FromMarkdown(mdGoCodeBlock)
+	suite.Equal(mdGoCodeBlockExpected, formatted.HTML)
+}
+
+func (suite *MarkdownTestSuite) TestParseUnknownLanguageCodeBlock() {
+	formatted := suite.FromMarkdown(mdUnknownLanguageCodeBlock)
+	suite.Equal(mdUnknownLanguageCodeBlockExpected, formatted.HTML)
+}
+
 func (suite *MarkdownTestSuite) TestParseWithInlineCode() {
 	formatted := suite.FromMarkdown(withInlineCode)
 	suite.Equal(withInlineCodeExpected, formatted.HTML)
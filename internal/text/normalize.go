@@ -20,6 +20,7 @@
 import (
 	"strings"
 
+	"golang.org/x/text/cases"
 	"golang.org/x/text/unicode/norm"
 )
 
@@ -75,3 +76,19 @@ func NormalizeHashtag(text string) (string, bool) {
 
 	return normalized, lengthOK && onlyPermittedChars && atLeastOneRequiredChar
 }
+
+// FoldHashtag folds the given (normalized) hashtag name
+// into the form in which it is stored in the database,
+// such that names which differ only in case, or in their
+// unicode representation, eg., "Café" and "cafe\u0301",
+// or "Straße" and "STRASSE", are folded to the same name.
+//
+// This is done by applying full unicode case folding,
+// followed by canonical composition (Normalization Form C),
+// as case folding may decompose some characters.
+func FoldHashtag(name string) string {
+	name = strings.TrimSpace(name)
+	name = strings.TrimPrefix(name, "#")
+	name = cases.Fold().String(name)
+	return norm.NFC.String(name)
+}
@@ -179,6 +179,32 @@ func (suite *PlainTestSuite) TestDeriveMultiple() {
 	suite.Len(f.Emojis, 0)
 }
 
+func (suite *PlainTestSuite) TestDeriveMentionTrailingPunctuation() {
+	statusText := `thanks @the_mighty_zork. and cc @foss_satan@fossbros-anonymous.io: see above`
+
+	f := suite.FromPlain(statusText)
+
+	if suite.Len(f.Mentions, 2) {
+		suite.Equal("@the_mighty_zork", f.Mentions[0].NameString)
+		suite.Equal("@foss_satan@fossbros-anonymous.io", f.Mentions[1].NameString)
+	}
+
+	// Punctuation should be left in the text after the mention.
+	suite.Contains(f.HTML, "</a></span>. and cc")
+	suite.Contains(f.HTML, "</a></span>: see above")
+}
+
+func (suite *PlainTestSuite) TestDeriveMentionCaseInsensitive() {
+	statusText := `hey @The_Mighty_Zork and @foss_satan@FOSSBROS-ANONYMOUS.IO`
+
+	f := suite.FromPlain(statusText)
+
+	if suite.Len(f.Mentions, 2) {
+		suite.Equal(suite.testAccounts["local_account_1"].ID, f.Mentions[0].TargetAccountID)
+		suite.Equal(suite.testAccounts["remote_account_1"].ID, f.Mentions[1].TargetAccountID)
+	}
+}
+
 func (suite *PlainTestSuite) TestZalgoHashtag() {
 	statusText := `yo who else loves #praying to #z̸͉̅a̸͚͋l̵͈̊g̸̫͌ỏ̷̪?`
 	f := suite.FromPlain(statusText)
@@ -17,7 +17,10 @@
 
 package text
 
-import "unicode"
+import (
+	"strings"
+	"unicode"
+)
 
 func isPermittedInHashtag(r rune) bool {
 	return unicode.IsLetter(r) || isPermittedIfNotEntireHashtag(r)
@@ -70,3 +73,18 @@ func isMentionBoundary(r rune) bool {
 	return unicode.IsSpace(r) ||
 		unicode.IsPunct(r)
 }
+
+// mentionTrailingPunct contains characters that
+// are permitted within usernames and/or domains,
+// but which can't be the last character of one.
+const mentionTrailingPunct = ".-:@"
+
+// TrimMentionPunctuation trims characters from the end
+// of the given mention namestring that may be part of
+// a username or domain, but never end one. These are
+// most likely punctuation in the text following the
+// mention, eg., "@someone@example.org." at the end of
+// a sentence becomes "@someone@example.org".
+func TrimMentionPunctuation(mention string) string {
+	return strings.TrimRight(mention, mentionTrailingPunct)
+}
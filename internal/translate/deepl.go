@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+const (
+	deepLEndpoint     = "https://api.deepl.com"
+	deepLFreeEndpoint = "https://api-free.deepl.com"
+)
+
+// deepL is a Translator
+// using the DeepL API.
+//
+// See https://developers.deepl.com/docs/api-reference/translate
+type deepL struct {
+	client   *http.Client
+	endpoint string
+	apiKey   string
+}
+
+func newDeepL(client *http.Client, endpoint string, apiKey string) *deepL {
+	if endpoint == "" {
+		// Free API keys must be used
+		// with the free API endpoint.
+		if strings.HasSuffix(apiKey, ":fx") {
+			endpoint = deepLFreeEndpoint
+		} else {
+			endpoint = deepLEndpoint
+		}
+	}
+
+	return &deepL{
+		client:   client,
+		endpoint: endpoint,
+		apiKey:   apiKey,
+	}
+}
+
+type deepLRequest struct {
+	Text        []string `json:"text"`
+	SourceLang  string   `json:"source_lang,omitempty"`
+	TargetLang  string   `json:"target_lang"`
+	TagHandling string   `json:"tag_handling"`
+}
+
+type deepLResponse struct {
+	Translations []struct {
+		DetectedSourceLanguage string `json:"detected_source_language"`
+		Text                   string `json:"text"`
+	} `json:"translations"`
+}
+
+type deepLError struct {
+	Message string `json:"message"`
+}
+
+func (d *deepL) Provider() string {
+	return "DeepL.com"
+}
+
+func (d *deepL) Translate(ctx context.Context, texts []string, source string, target string) ([]string, string, error) {
+	// DeepL only accepts base languages as source,
+	// but does accept variants like EN-GB as target.
+	body, err := json.Marshal(deepLRequest{
+		Text:        texts,
+		SourceLang:  strings.ToUpper(baseLanguage(source)),
+		TargetLang:  strings.ToUpper(target),
+		TagHandling: "html",
+	})
+	if err != nil {
+		return nil, "", gtserror.Newf("error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		d.endpoint+"/v2/translate",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, "", gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "DeepL-Auth-Key "+d.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := d.client.Do(req)
+	if err != nil {
+		return nil, "", gtserror.Newf("error doing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		var e deepLError
+		_ = json.NewDecoder(io.LimitReader(rsp.Body, 4096)).Decode(&e)
+
+		if rsp.StatusCode == http.StatusBadRequest {
+			// Most likely an unsupported
+			// source or target language.
+			return nil, "", fmt.Errorf("%w: %s", ErrUnsupportedLanguage, e.Message)
+		}
+
+		return nil, "", gtserror.Newf("DeepL returned %s: %s", rsp.Status, e.Message)
+	}
+
+	var r deepLResponse
+	if err := json.NewDecoder(rsp.Body).Decode(&r); err != nil {
+		return nil, "", gtserror.Newf("error decoding response: %w", err)
+	}
+
+	if len(r.Translations) != len(texts) {
+		return nil, "", gtserror.Newf("DeepL returned %d translations for %d texts", len(r.Translations), len(texts))
+	}
+
+	translated := make([]string, len(r.Translations))
+	for i, t := range r.Translations {
+		translated[i] = t.Text
+	}
+
+	if source == "" && len(r.Translations) > 0 {
+		source = strings.ToLower(r.Translations[0].DetectedSourceLanguage)
+	}
+
+	return translated, source, nil
+}
@@ -0,0 +1,134 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+)
+
+// libreTranslate is a Translator using
+// the API of a LibreTranslate instance.
+//
+// See https://docs.libretranslate.com/guides/api_usage/
+type libreTranslate struct {
+	client   *http.Client
+	endpoint string
+	apiKey   string
+}
+
+func newLibreTranslate(client *http.Client, endpoint string, apiKey string) *libreTranslate {
+	return &libreTranslate{
+		client:   client,
+		endpoint: endpoint,
+		apiKey:   apiKey,
+	}
+}
+
+type libreTranslateRequest struct {
+	Q      []string `json:"q"`
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Format string   `json:"format"`
+	APIKey string   `json:"api_key,omitempty"`
+}
+
+type libreTranslateResponse struct {
+	TranslatedText   []string `json:"translatedText"`
+	DetectedLanguage []struct {
+		Language string `json:"language"`
+	} `json:"detectedLanguage"`
+}
+
+type libreTranslateError struct {
+	Error string `json:"error"`
+}
+
+func (l *libreTranslate) Provider() string {
+	return "LibreTranslate"
+}
+
+func (l *libreTranslate) Translate(ctx context.Context, texts []string, source string, target string) ([]string, string, error) {
+	// LibreTranslate only
+	// knows base languages.
+	reqSource := baseLanguage(source)
+	if reqSource == "" {
+		reqSource = "auto"
+	}
+
+	body, err := json.Marshal(libreTranslateRequest{
+		Q:      texts,
+		Source: reqSource,
+		Target: baseLanguage(target),
+		Format: "html",
+		APIKey: l.apiKey,
+	})
+	if err != nil {
+		return nil, "", gtserror.Newf("error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		l.endpoint+"/translate",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, "", gtserror.Newf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := l.client.Do(req)
+	if err != nil {
+		return nil, "", gtserror.Newf("error doing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		var e libreTranslateError
+		_ = json.NewDecoder(io.LimitReader(rsp.Body, 4096)).Decode(&e)
+
+		if rsp.StatusCode == http.StatusBadRequest {
+			// Most likely an unsupported
+			// source or target language.
+			return nil, "", fmt.Errorf("%w: %s", ErrUnsupportedLanguage, e.Error)
+		}
+
+		return nil, "", gtserror.Newf("LibreTranslate returned %s: %s", rsp.Status, e.Error)
+	}
+
+	var r libreTranslateResponse
+	if err := json.NewDecoder(rsp.Body).Decode(&r); err != nil {
+		return nil, "", gtserror.Newf("error decoding response: %w", err)
+	}
+
+	if len(r.TranslatedText) != len(texts) {
+		return nil, "", gtserror.Newf("LibreTranslate returned %d translations for %d texts", len(r.TranslatedText), len(texts))
+	}
+
+	if source == "" && len(r.DetectedLanguage) > 0 {
+		source = r.DetectedLanguage[0].Language
+	}
+
+	return r.TranslatedText, source, nil
+}
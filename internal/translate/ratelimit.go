@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter limits how many translations each
+// account can request within a fixed time window.
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu        sync.Mutex
+	counts    map[string]*rateWindow
+	nextSweep time.Time
+}
+
+type rateWindow struct {
+	count int
+	reset time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter allowing limit
+// translations per account per window. A limit of 0 or
+// less means requests are never limited.
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:  limit,
+		window: window,
+		counts: make(map[string]*rateWindow),
+	}
+}
+
+// Allow reports whether the account with given ID may request
+// a translation at given time, counting it if so. If not, the
+// time at which the account may request translations again is
+// also returned.
+func (r *RateLimiter) Allow(accountID string, now time.Time) (bool, time.Time) {
+	if r.limit <= 0 {
+		return true, time.Time{}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Every so often drop windows which have
+	// passed, so accounts which stop requesting
+	// translations don't stick around forever.
+	if now.After(r.nextSweep) {
+		for id, w := range r.counts {
+			if now.After(w.reset) {
+				delete(r.counts, id)
+			}
+		}
+		r.nextSweep = now.Add(r.window)
+	}
+
+	w, ok := r.counts[accountID]
+	if !ok || now.After(w.reset) {
+		w = &rateWindow{reset: now.Add(r.window)}
+		r.counts[accountID] = w
+	}
+
+	if w.count >= r.limit {
+		return false, w.reset
+	}
+
+	w.count++
+	return true, time.Time{}
+}
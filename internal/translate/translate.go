@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"code.superseriousbusiness.org/gotosocial/internal/config"
+	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+)
+
+// ErrUnsupportedLanguage is returned by Translate when the
+// provider can't translate between the given languages.
+var ErrUnsupportedLanguage = errors.New("unsupported language")
+
+// Translator translates text using an
+// external translation provider.
+type Translator interface {
+	// Translate translates the given HTML texts from the source
+	// language into the target language, returning the translated
+	// texts in the same order. If source is empty, the provider
+	// detects it. The source language, as given or detected,
+	// is returned alongside the translations.
+	Translate(ctx context.Context, texts []string, source string, target string) ([]string, string, error)
+
+	// Provider returns the name of
+	// the translation provider.
+	Provider() string
+}
+
+// New returns a new Translator for the configured translation
+// provider, which makes requests using the given client. If no
+// provider is configured, New returns nil.
+func New(client *httpclient.Client) Translator {
+	httpClient := &http.Client{
+		// Pass in our wrapped httpclient.Client{}
+		// type as http.Transport{} in order to take
+		// advantage of retries, SSF protection etc.
+		Transport: client,
+	}
+
+	var (
+		endpoint = strings.TrimSuffix(config.GetTranslationEndpoint(), "/")
+		apiKey   = config.GetTranslationAPIKey()
+	)
+
+	switch config.GetTranslationProvider() {
+	case config.TranslationProviderDeepL:
+		return newDeepL(httpClient, endpoint, apiKey)
+	case config.TranslationProviderLibreTranslate:
+		return newLibreTranslate(httpClient, endpoint, apiKey)
+	default:
+		return nil
+	}
+}
+
+// baseLanguage returns the base language
+// subtag of the given BCP 47 language tag,
+// eg., "en" for "en-US".
+func baseLanguage(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return strings.ToLower(base)
+}
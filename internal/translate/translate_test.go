@@ -0,0 +1,170 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeepLTranslate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/translate" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "DeepL-Auth-Key some-key" {
+			t.Errorf("unexpected authorization %s", auth)
+		}
+
+		var req deepLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+
+		if req.TargetLang == "XX" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Value for 'target_lang' not supported."}`))
+			return
+		}
+
+		if req.SourceLang != "" || req.TargetLang != "DE" || req.TagHandling != "html" {
+			t.Errorf("unexpected request %+v", req)
+		}
+
+		_, _ = w.Write([]byte(`{"translations":[` +
+			`{"detected_source_language":"EN","text":"<p>Hallo Welt</p>"},` +
+			`{"detected_source_language":"EN","text":"Achtung"}` +
+			`]}`))
+	}))
+	defer srv.Close()
+
+	d := newDeepL(srv.Client(), srv.URL, "some-key")
+
+	translated, source, err := d.Translate(t.Context(),
+		[]string{"<p>Hello world</p>", "Warning"},
+		"",
+		"de",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if source != "en" {
+		t.Errorf("expected detected source en, got %s", source)
+	}
+
+	if len(translated) != 2 || translated[0] != "<p>Hallo Welt</p>" || translated[1] != "Achtung" {
+		t.Errorf("unexpected translations %v", translated)
+	}
+
+	_, _, err = d.Translate(t.Context(), []string{"Hello"}, "en", "xx")
+	if !errors.Is(err, ErrUnsupportedLanguage) {
+		t.Errorf("expected unsupported language error, got %v", err)
+	}
+}
+
+func TestDeepLEndpoint(t *testing.T) {
+	if d := newDeepL(nil, "", "some-key:fx"); d.endpoint != deepLFreeEndpoint {
+		t.Errorf("expected free endpoint for free key, got %s", d.endpoint)
+	}
+
+	if d := newDeepL(nil, "", "some-key"); d.endpoint != deepLEndpoint {
+		t.Errorf("expected pro endpoint for pro key, got %s", d.endpoint)
+	}
+}
+
+func TestLibreTranslateTranslate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/translate" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		var req libreTranslateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+
+		if req.Source != "en" || req.Target != "fr" || req.Format != "html" || req.APIKey != "" {
+			t.Errorf("unexpected request %+v", req)
+		}
+
+		_, _ = w.Write([]byte(`{"translatedText":["<p>Bonjour</p>"]}`))
+	}))
+	defer srv.Close()
+
+	l := newLibreTranslate(srv.Client(), srv.URL, "")
+
+	translated, source, err := l.Translate(t.Context(),
+		[]string{"<p>Hello</p>"},
+		"en-GB",
+		"fr-CA",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if source != "en-GB" {
+		t.Errorf("expected given source en-GB, got %s", source)
+	}
+
+	if len(translated) != 1 || translated[0] != "<p>Bonjour</p>" {
+		t.Errorf("unexpected translations %v", translated)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	r := NewRateLimiter(2, time.Hour)
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := r.Allow("account", now); !ok {
+			t.Fatalf("request %d should be allowed", i)
+		}
+	}
+
+	ok, reset := r.Allow("account", now)
+	if ok {
+		t.Fatal("third request should be limited")
+	}
+	if !reset.Equal(now.Add(time.Hour)) {
+		t.Errorf("unexpected reset time %s", reset)
+	}
+
+	// Other accounts have their own limits.
+	if ok, _ := r.Allow("other_account", now); !ok {
+		t.Error("other account should be allowed")
+	}
+
+	// Once the window has passed,
+	// requests are allowed again.
+	if ok, _ := r.Allow("account", now.Add(time.Hour+time.Second)); !ok {
+		t.Error("request after window should be allowed")
+	}
+
+	// No limit at all.
+	r = NewRateLimiter(0, time.Hour)
+	for i := 0; i < 10; i++ {
+		if ok, _ := r.Allow("account", now); !ok {
+			t.Fatal("unlimited requests should always be allowed")
+		}
+	}
+}
@@ -20,16 +20,23 @@
 import (
 	"bytes"
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
+	"time"
 
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
+	"code.superseriousbusiness.org/gotosocial/internal/integrity"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
 	"code.superseriousbusiness.org/gotosocial/internal/transport/delivery"
 )
 
@@ -46,6 +53,12 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 		host   = config.GetHost()
 	)
 
+	// Add integrity proof, if enabled.
+	obj, err := t.addProof(obj)
+	if err != nil {
+		return err
+	}
+
 	// Marshal object as JSON.
 	b, err := json.Marshal(obj)
 	if err != nil {
@@ -81,7 +94,9 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 	}
 
 	// Push prepared request list to the delivery queue.
-	t.controller.state.Workers.Delivery.Queue.Push(reqs...)
+	if err := t.push(ctx, reqs...); err != nil {
+		errs.Append(err)
+	}
 
 	// Return combined err.
 	return errs.Combine()
@@ -93,6 +108,12 @@ func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to
 		return nil
 	}
 
+	// Add integrity proof, if enabled.
+	obj, err := t.addProof(obj)
+	if err != nil {
+		return err
+	}
+
 	// Marshal object as JSON.
 	b, err := json.Marshal(obj)
 	if err != nil {
@@ -112,9 +133,85 @@ func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to
 	}
 
 	// Push prepared request to the delivery queue.
-	t.controller.state.Workers.Delivery.Queue.Push(req)
+	return t.push(ctx, req)
+}
 
-	return nil
+// push pushes the given deliveries to the delivery queue,
+// holding back in the database instead any deliveries to
+// domains that federation is currently paused with, so
+// they can be pushed to the queue once federation resumes.
+func (t *transport) push(ctx context.Context, reqs ...*delivery.Delivery) error {
+	var errs gtserror.MultiError
+
+	for i := 0; i < len(reqs); {
+		held, err := HoldPaused(ctx, t.controller.state, reqs[i])
+		if err != nil {
+			errs.Append(err)
+		}
+
+		if !held && err == nil {
+			// Deliver as normal.
+			i++
+			continue
+		}
+
+		// Drop from slice to queue.
+		reqs = slices.Delete(reqs, i, i+1)
+	}
+
+	// Count remaining deliveries towards each
+	// recipient host's federation stats. This must
+	// match the key used for received activities,
+	// see fedstats.AccountHost.
+	for _, dlv := range reqs {
+		t.controller.state.FedStats.Sent(dlv.Request.URL.Hostname())
+	}
+
+	// Push remaining deliveries to the delivery queue.
+	t.controller.state.Workers.Delivery.Queue.Push(reqs...)
+
+	return errs.Combine()
+}
+
+// HoldPaused checks whether federation with the host of given delivery
+// is currently paused and, if so, holds the delivery back in the database
+// so that it can be pushed to the queue once federation resumes. Returns
+// true if the delivery was held back, or should otherwise not be sent.
+//
+// This is checked both when deliveries are first pushed to the queue,
+// and by delivery workers before each attempt, so that deliveries queued
+// or awaiting retry when federation gets paused are also held back.
+func HoldPaused(ctx context.Context, state *state.State, dlv *delivery.Delivery) (bool, error) {
+	host := dlv.Request.URL.Hostname()
+
+	// Check whether federation with
+	// delivery domain is paused.
+	limit, err := state.DB.MatchDomainLimit(ctx, host)
+	if err != nil {
+		return false, gtserror.Newf("error matching domain limit for %s: %w", host, err)
+	}
+
+	if !limit.FederationPaused() {
+		return false, nil
+	}
+
+	// Serialize delivery to hold back in the database.
+	data, err := dlv.Serialize()
+	if err != nil {
+		return true, gtserror.Newf("error serializing delivery to %s: %w", host, err)
+	}
+
+	if err := state.DB.PutPausedDelivery(ctx,
+		&gtsmodel.PausedDelivery{
+			Domain:    host,
+			TaskData:  data,
+			CreatedAt: time.Now(),
+		},
+	); err != nil {
+		return true, gtserror.Newf("error storing paused delivery to %s: %w", host, err)
+	}
+
+	return true, nil
 }
 
 // prepare will prepare a POST http.Request{}
@@ -170,6 +267,39 @@ func (t *transport) prepare(
 	}, nil
 }
 
+// addProof returns a copy of obj with an FEP-8b32
+// integrity proof added, signed with the ed25519 key
+// derived from the transport's private key. If proof
+// signing is disabled, or the transport does not
+// belong to the activity's actor, obj is returned as-is.
+func (t *transport) addProof(obj map[string]interface{}) (map[string]interface{}, error) {
+	if !config.GetInstanceIntegrityProofsSign() {
+		return obj, nil
+	}
+
+	// Only sign activities by
+	// the transport's own actor.
+	actor, _ := obj["actor"].(string)
+	if actor == "" ||
+		!(strings.HasPrefix(t.pubKeyID, actor+"#") ||
+			strings.HasPrefix(t.pubKeyID, actor+"/")) {
+		return obj, nil
+	}
+
+	rsaKey, ok := t.privkey.(*rsa.PrivateKey)
+	if !ok {
+		return obj, nil
+	}
+
+	key := integrity.DeriveKey(rsaKey)
+	obj, err := integrity.Sign(obj, key, integrity.KeyID(actor))
+	if err != nil {
+		return nil, gtserror.Newf("error adding integrity proof: %w", err)
+	}
+
+	return obj, nil
+}
+
 func (t *transport) SignDelivery(dlv *delivery.Delivery) error {
 	if dlv.Request.GetBody == nil {
 		return gtserror.New("delivery request body not rewindable")
@@ -54,7 +54,8 @@ type Delivery struct {
 	Request *httpclient.Request
 
 	// internal fields.
-	next time.Time
+	next     time.Time
+	released bool // host released during backoff
 }
 
 // delivery is an internal type
@@ -0,0 +1,156 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delivery
+
+import (
+	"sync"
+
+	"code.superseriousbusiness.org/gotosocial/internal/queue"
+)
+
+// hostQueues serializes deliveries by destination
+// host, so that deliveries to a remote instance arrive
+// in the order they were queued (eg., a Create before
+// an Update before a Delete), while deliveries to
+// different hosts run in parallel.
+//
+// Once a worker claims a host, any deliveries to
+// that host popped by other workers are queued
+// behind it, to be delivered in order by that same
+// worker until the host is released again.
+//
+// Ordering is per host rather than per inbox, as a
+// remote may process activities sent to different
+// inboxes on it (eg., its shared inbox and a personal
+// inbox) against the same state.
+//
+// A delivery that fails and has to wait out a retry
+// backoff releases its host while waiting, so that one
+// failing inbox doesn't stall all deliveries to the host,
+// and claims it again when retried, taking its place
+// behind any deliveries to the host already in progress.
+// So a retried delivery may arrive after later ones.
+type hostQueues struct {
+	mutex sync.Mutex
+	hosts map[string][]*Delivery
+}
+
+// pop pops the next delivery from the queue that can
+// be delivered by the caller, claiming its destination
+// host. Deliveries to a host already claimed are
+// instead queued behind its claimant. Popping and
+// claiming happen under the same lock, so that two
+// workers cannot claim consecutive deliveries to the
+// same host out of order.
+func (q *hostQueues) pop(queue *queue.StructQueue[*Delivery]) (*Delivery, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.hosts == nil {
+		q.hosts = make(map[string][]*Delivery)
+	}
+
+	for {
+		dlv, ok := queue.Pop()
+		if !ok {
+			return nil, false
+		}
+
+		host := dlv.Request.URL.Host
+
+		if queued, ok := q.hosts[host]; ok {
+			// Host already claimed,
+			// queue behind claimant.
+			q.hosts[host] = append(queued, dlv)
+			continue
+		}
+
+		// Mark as claimed.
+		q.hosts[host] = nil
+		return dlv, true
+	}
+}
+
+// claim claims the destination host of the given delivery,
+// being retried after having released its host. Returns
+// false if the host is already claimed, in which case the
+// delivery is instead queued behind its claimant.
+func (q *hostQueues) claim(dlv *Delivery) bool {
+	host := dlv.Request.URL.Host
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.hosts == nil {
+		q.hosts = make(map[string][]*Delivery)
+	}
+
+	if queued, ok := q.hosts[host]; ok {
+		// Host already claimed,
+		// queue behind claimant.
+		q.hosts[host] = append(queued, dlv)
+		return false
+	}
+
+	// Mark as claimed.
+	q.hosts[host] = nil
+	return true
+}
+
+// release is called by a claimant once the given
+// delivery is finished with (delivered or dropped),
+// or is to wait out a backoff before being retried.
+// It returns the next delivery queued to the same
+// host, keeping the host claimed, or releases the
+// host if nothing else is queued to it.
+func (q *hostQueues) release(dlv *Delivery) (*Delivery, bool) {
+	host := dlv.Request.URL.Host
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	queued := q.hosts[host]
+	if len(queued) == 0 {
+		// Nothing queued
+		// behind, release.
+		delete(q.hosts, host)
+		return nil, false
+	}
+
+	// Pop next from host queue.
+	next := queued[0]
+	queued[0] = nil
+	q.hosts[host] = queued[1:]
+
+	return next, true
+}
+
+// drain releases all claimed hosts, returning
+// any deliveries that were queued behind them.
+func (q *hostQueues) drain() []*Delivery {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	var dlvs []*Delivery
+	for _, queued := range q.hosts {
+		dlvs = append(dlvs, queued...)
+	}
+
+	clear(q.hosts)
+	return dlvs
+}
@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package delivery
+
+import "sync/atomic"
+
+// Stats tracks the number of recipient actors
+// that outgoing activities were addressed to,
+// against the number of inboxes actually
+// delivered to for them, after coalescing
+// recipients that share an inbox (ie., the
+// sharedInbox of a remote instance).
+type Stats struct {
+	recipients atomic.Int64
+	inboxes    atomic.Int64
+}
+
+// Add records a fan-out of the given number of
+// recipient actors onto the given number of inboxes.
+func (s *Stats) Add(recipients int, inboxes int) {
+	s.recipients.Add(int64(recipients))
+	s.inboxes.Add(int64(inboxes))
+}
+
+// Recipients returns the total number of recipient actors.
+func (s *Stats) Recipients() int64 {
+	return s.recipients.Load()
+}
+
+// Inboxes returns the total number of inboxes delivered to.
+func (s *Stats) Inboxes() int64 {
+	return s.inboxes.Load()
+}
+
+// DedupRatio returns the fraction of per-actor deliveries
+// that were saved by coalescing recipients onto shared
+// inboxes, from 0 (no savings) up to (but not including) 1.
+func (s *Stats) DedupRatio() float64 {
+	recipients := s.recipients.Load()
+	if recipients == 0 {
+		return 0
+	}
+	inboxes := s.inboxes.Load()
+	return 1 - float64(inboxes)/float64(recipients)
+}
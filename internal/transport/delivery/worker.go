@@ -44,8 +44,24 @@ type WorkerPool struct {
 	// passed to each of delivery pool Worker{}s.
 	Queue queue.StructQueue[*Delivery]
 
+	// Stats tracks coalescing of
+	// recipients onto shared inboxes.
+	Stats Stats
+
+	// Timeout is the time limit for each
+	// delivery attempt, passed to each of
+	// delivery pool Worker{}s. Zero leaves
+	// it to the client default timeout.
+	Timeout time.Duration
+
+	// Hold, if set, is called by delivery pool
+	// Worker{}s before each delivery attempt,
+	// see Worker{}.Hold for details.
+	Hold func(context.Context, *Delivery) (bool, error)
+
 	// internal fields.
 	workers []*Worker
+	hosts   hostQueues
 }
 
 // Init will initialize the Worker{} pool
@@ -79,6 +95,9 @@ func (p *WorkerPool) Start(n int) {
 		p.workers[i] = new(Worker)
 		p.workers[i].Client = p.Client
 		p.workers[i].Queue = &p.Queue
+		p.workers[i].Timeout = p.Timeout
+		p.workers[i].Hold = p.Hold
+		p.workers[i].hosts = &p.hosts
 
 		// Attempt to start worker.
 		// Return bool not useful
@@ -106,6 +125,15 @@ func (p *WorkerPool) Stop() {
 		_ = p.workers[i].Stop()
 	}
 
+	// Push any deliveries still waiting
+	// on a claimed host back onto queue,
+	// so they may be persisted on exit.
+	for i := range p.workers {
+		p.Queue.Push(p.workers[i].ready...)
+		p.workers[i].ready = nil
+	}
+	p.Queue.Push(p.hosts.drain()...)
+
 	// Unset workers slice.
 	p.workers = p.workers[:0]
 }
@@ -119,6 +147,11 @@ func (p *WorkerPool) Len() int {
 // from queue.StructQueue{} for ActivityPub reqs
 // to deliver. It does so while prioritizing new
 // queued requests over backlogged retries.
+//
+// When started by a WorkerPool{}, deliveries are
+// ordered per destination host across workers;
+// see hostQueues{} for details, including how
+// deliveries being retried are ordered.
 type Worker struct {
 
 	// Client is the httpclient.Client{} that
@@ -129,8 +162,25 @@ type Worker struct {
 	// that delivery worker will feed from.
 	Queue *queue.StructQueue[*Delivery]
 
+	// Timeout is the time limit for
+	// each delivery attempt, if set.
+	Timeout time.Duration
+
+	// Hold, if set, is called before each
+	// delivery attempt (including retries) to
+	// check whether the delivery should be held
+	// back, eg. as federation with its host has
+	// since been paused. When it returns true,
+	// the delivery was taken over by Hold (which
+	// is responsible for requeuing it later),
+	// and the worker drops it. On error with
+	// false, the delivery is attempted anyway.
+	Hold func(context.Context, *Delivery) (bool, error)
+
 	// internal fields.
 	backlog []*Delivery
+	ready   []*Delivery
+	hosts   *hostQueues
 	service runners.Service
 }
 
@@ -207,15 +257,35 @@ func (w *Worker) process(ctx context.Context) bool {
 			}
 		}
 
+		if dlv.released {
+			// Retried delivery released its host
+			// while waiting, so claim it again, else
+			// it gets queued behind the claimant.
+			dlv.released = false
+			if !w.claim(dlv) {
+				continue loop
+			}
+		}
+
+		if w.Hold != nil {
+			// Check whether delivery
+			// should be held back.
+			held, err := w.Hold(ctx, dlv)
+			if err != nil {
+				log.Errorf(ctx, "error checking delivery hold: %v", err)
+			}
+			if held {
+				w.release(dlv)
+				continue loop
+			}
+		}
+
 		// Attempt delivery of AP request.
-		rsp, retry, err := w.Client.DoOnce(
-			dlv.Request,
-		)
+		retry, err := w.deliver(dlv)
 
 		switch {
 		case err == nil:
-			// Ensure body closed.
-			_ = rsp.Body.Close()
+			w.release(dlv)
 			continue loop
 
 		case errors.Is(err, context.Canceled) &&
@@ -236,9 +306,16 @@ func (w *Worker) process(ctx context.Context) bool {
 			// Drop deliveries when no
 			// retry requested, or they
 			// reached max (either).
+			w.release(dlv)
 			continue loop
 		}
 
+		// Release host while waiting, so
+		// one failing inbox doesn't hold up
+		// all deliveries to the same host.
+		w.release(dlv)
+		dlv.released = (w.hosts != nil)
+
 		// Determine next delivery attempt.
 		backoff := dlv.Request.BackOff()
 		dlv.next = time.Now().Add(backoff)
@@ -248,20 +325,93 @@ func (w *Worker) process(ctx context.Context) bool {
 	}
 }
 
+// deliver performs a single attempt at given delivery, within
+// the configured attempt timeout, ensuring rsp body is closed.
+func (w *Worker) deliver(dlv *Delivery) (retry bool, err error) {
+	if w.Timeout > 0 {
+		// Wrap request ctx with attempt timeout. Note the
+		// request ctx gets reset on each call to next().
+		ctx := dlv.Request.Context()
+		ctx, cncl := context.WithTimeout(ctx, w.Timeout)
+		dlv.Request.Request = dlv.Request.Request.WithContext(ctx)
+		defer cncl()
+	}
+
+	rsp, retry, err := w.Client.DoOnce(dlv.Request)
+	if err != nil {
+		return retry, err
+	}
+
+	// Ensure body closed.
+	_ = rsp.Body.Close()
+	return retry, nil
+}
+
 // next gets the next available delivery, blocking until available if necessary.
 func (w *Worker) next(ctx context.Context) (*Delivery, bool) {
-	// Try a fast-pop of queued
-	// delivery before anything.
-	dlv, ok := w.Queue.Pop()
+	var dlv *Delivery
+
+	if len(w.ready) > 0 {
+		// Deliveries queued behind a
+		// host we have claimed go first.
+		dlv = w.ready[0]
+		w.ready = w.ready[1:]
+	} else {
+		var ok bool
+
+		// Pop from queue or backlog.
+		dlv, ok = w.pop(ctx)
+		if !ok {
+			return nil, false
+		}
+	}
+
+	// Replace request context for worker state canceling.
+	ctx = gtscontext.WithValues(ctx, dlv.Request.Context())
+	dlv.Request.Request = dlv.Request.Request.WithContext(ctx)
+
+	return dlv, true
+}
+
+// pop pops the next available delivery from the queue, or else
+// the backlog, blocking until available if necessary. Queued
+// deliveries to a host claimed by another worker are skipped,
+// as these get handed over to that worker by hostQueues{}.
+func (w *Worker) pop(ctx context.Context) (*Delivery, bool) {
+	if w.hosts == nil {
+		// Not part of a pool, no
+		// ordering to preserve.
+		if dlv, ok := w.Queue.Pop(); ok {
+			return dlv, true
+		}
+		if len(w.backlog) > 0 {
+			sortDeliveries(w.backlog)
+			return w.popBacklog(), true
+		}
+		return w.Queue.PopCtx(ctx)
+	}
+
+	for {
+		// Get wait channel before
+		// popping, so that no push
+		// between the two is missed.
+		wait := w.Queue.Wait()
+
+		// Try a fast-pop of queued
+		// delivery before anything.
+		if dlv, ok := w.hosts.pop(w.Queue); ok {
+			return dlv, true
+		}
 
-	if !ok {
 		// Check the backlog.
 		if len(w.backlog) > 0 {
 
 			// Sort by 'next' time.
 			sortDeliveries(w.backlog)
 
-			// Pop next delivery.
+			// Pop next delivery. Backlogged
+			// deliveries claim their host
+			// again once ready to be sent.
 			dlv := w.popBacklog()
 
 			return dlv, true
@@ -269,17 +419,34 @@ func (w *Worker) next(ctx context.Context) (*Delivery, bool) {
 
 		// Block on next delivery push
 		// OR worker context canceled.
-		dlv, ok = w.Queue.PopCtx(ctx)
-		if !ok {
+		select {
+		case <-ctx.Done():
 			return nil, false
+		case <-wait:
 		}
 	}
+}
 
-	// Replace request context for worker state canceling.
-	ctx = gtscontext.WithValues(ctx, dlv.Request.Context())
-	dlv.Request.Request = dlv.Request.Request.WithContext(ctx)
+// claim claims the destination host of given delivery being
+// retried, returning false if it was instead queued behind
+// the host's claimant.
+func (w *Worker) claim(dlv *Delivery) bool {
+	if w.hosts == nil {
+		return true
+	}
+	return w.hosts.claim(dlv)
+}
 
-	return dlv, true
+// release releases the destination host of given finished
+// (or backlogged) delivery, picking up the next delivery
+// queued behind it.
+func (w *Worker) release(dlv *Delivery) {
+	if w.hosts == nil {
+		return
+	}
+	if next, ok := w.hosts.release(dlv); ok {
+		w.ready = append(w.ready, next)
+	}
 }
 
 // popBacklog pops next available from the backlog.
@@ -19,6 +19,7 @@
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"math/rand"
@@ -28,6 +29,7 @@
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
 	"code.superseriousbusiness.org/gotosocial/internal/queue"
@@ -42,6 +44,248 @@ func TestDeliveryWorkerPool(t *testing.T) {
 	}
 }
 
+func TestDeliveryWorkerPoolHostOrdering(t *testing.T) {
+	const n = 100
+
+	received := make(chan string, n)
+
+	// Prepare an HTTP test handler that records order of received deliveries.
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		received <- string(b)
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	srv := new(http.Server)
+	srv.Addr = "http://" + l.Addr().String()
+	srv.Handler = handler
+	go srv.Serve(l)
+	defer srv.Close()
+
+	wp := new(delivery.WorkerPool)
+	allowLocal := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+	wp.Init(httpclient.New(httpclient.Config{AllowRanges: allowLocal}))
+	wp.Start(8)
+	defer wp.Stop()
+
+	// Enqueue all deliveries to different
+	// inboxes on the same host at once, so
+	// that many workers are racing to pick
+	// these up.
+	inboxes := []string{"/inbox", "/users/a/inbox", "/users/b/inbox"}
+	dlvs := make([]*delivery.Delivery, n)
+	for i := range dlvs {
+		body := strings.NewReader(strconv.Itoa(i))
+		inbox := inboxes[i%len(inboxes)]
+		req, err := http.NewRequest(http.MethodPost, srv.Addr+inbox, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dlvs[i] = new(delivery.Delivery)
+		dlvs[i].Request = httpclient.WrapRequest(req)
+	}
+	wp.Queue.Push(dlvs...)
+
+	// Deliveries should arrive in queued order.
+	for i := range n {
+		if b := <-received; b != strconv.Itoa(i) {
+			t.Fatalf("delivery %d arrived out of order: %s", i, b)
+		}
+	}
+}
+
+func TestDeliveryWorkerPoolRetryDoesNotBlockOtherHosts(t *testing.T) {
+	received := make(chan string, 10)
+
+	// Prepare one HTTP server that fails all
+	// deliveries, and another that records
+	// the deliveries it receives.
+	failing := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	})
+	ok := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		received <- r.URL.Path
+	})
+
+	var addrs []string
+	for _, handler := range []http.Handler{failing, ok} {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+
+		srv := new(http.Server)
+		srv.Addr = "http://" + l.Addr().String()
+		srv.Handler = handler
+		go srv.Serve(l)
+		defer srv.Close()
+
+		addrs = append(addrs, srv.Addr)
+	}
+
+	wp := new(delivery.WorkerPool)
+	allowLocal := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+	wp.Init(httpclient.New(httpclient.Config{AllowRanges: allowLocal}))
+	wp.Start(1)
+	defer wp.Stop()
+
+	// Enqueue a delivery that will be retried with
+	// backoff, followed by one to a different host.
+	for _, addr := range addrs {
+		req, err := http.NewRequest(http.MethodPost, addr+"/inbox", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dlv := new(delivery.Delivery)
+		dlv.Request = httpclient.WrapRequest(req)
+		wp.Queue.Push(dlv)
+	}
+
+	// The second delivery should not be held
+	// up by the first waiting out its backoff.
+	select {
+	case path := <-received:
+		if path != "/inbox" {
+			t.Fatalf("unexpected delivery: %s", path)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("delivery held up by retrying delivery to other host")
+	}
+}
+
+func TestDeliveryWorkerPoolRetryDoesNotBlockSameHost(t *testing.T) {
+	received := make(chan string, 10)
+
+	// Prepare an HTTP server with one inbox that fails
+	// all deliveries, recording deliveries to the others.
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/users/failing/inbox" {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received <- r.URL.Path
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	srv := new(http.Server)
+	srv.Addr = "http://" + l.Addr().String()
+	srv.Handler = handler
+	go srv.Serve(l)
+	defer srv.Close()
+
+	wp := new(delivery.WorkerPool)
+	allowLocal := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+	wp.Init(httpclient.New(httpclient.Config{AllowRanges: allowLocal}))
+	wp.Start(2)
+	defer wp.Stop()
+
+	// Enqueue a delivery that will be retried with
+	// backoff, followed by one to the same host.
+	for _, inbox := range []string{"/users/failing/inbox", "/inbox"} {
+		req, err := http.NewRequest(http.MethodPost, srv.Addr+inbox, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dlv := new(delivery.Delivery)
+		dlv.Request = httpclient.WrapRequest(req)
+		wp.Queue.Push(dlv)
+	}
+
+	// The second delivery should not be held
+	// up by the first waiting out its backoff.
+	select {
+	case path := <-received:
+		if path != "/inbox" {
+			t.Fatalf("unexpected delivery: %s", path)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("delivery held up by retrying delivery to same host")
+	}
+}
+
+func TestDeliveryWorkerPoolHold(t *testing.T) {
+	received := make(chan string, 10)
+	held := make(chan string, 10)
+
+	// Prepare an HTTP server that records deliveries.
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		received <- r.URL.Path
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	srv := new(http.Server)
+	srv.Addr = "http://" + l.Addr().String()
+	srv.Handler = handler
+	go srv.Serve(l)
+	defer srv.Close()
+
+	wp := new(delivery.WorkerPool)
+	allowLocal := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+	wp.Init(httpclient.New(httpclient.Config{AllowRanges: allowLocal}))
+
+	// Hold back deliveries to one inbox,
+	// as though federation had been paused
+	// after they were already queued.
+	wp.Hold = func(ctx context.Context, dlv *delivery.Delivery) (bool, error) {
+		if path := dlv.Request.URL.Path; path == "/users/held/inbox" {
+			held <- path
+			return true, nil
+		}
+		return false, nil
+	}
+
+	wp.Start(1)
+	defer wp.Stop()
+
+	// Enqueue a delivery that gets held
+	// back, followed by one to the same host.
+	for _, inbox := range []string{"/users/held/inbox", "/inbox"} {
+		req, err := http.NewRequest(http.MethodPost, srv.Addr+inbox, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dlv := new(delivery.Delivery)
+		dlv.Request = httpclient.WrapRequest(req)
+		wp.Queue.Push(dlv)
+	}
+
+	select {
+	case path := <-held:
+		if path != "/users/held/inbox" {
+			t.Fatalf("unexpected held delivery: %s", path)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("delivery was not checked for hold")
+	}
+
+	// Only the second delivery should be sent, and
+	// it shouldn't be held up by the held delivery.
+	select {
+	case path := <-received:
+		if path != "/inbox" {
+			t.Fatalf("unexpected delivery: %s", path)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("delivery held up by held delivery to same host")
+	}
+}
+
 func testDeliveryWorkerPool(t *testing.T, sz int, input []*testrequest) {
 	wp := new(delivery.WorkerPool)
 	allowLocal := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
@@ -54,6 +54,21 @@ func (t *transport) Dereference(ctx context.Context, iri *url.URL) (*http.Respon
 		log.Warnf(ctx, "about to perform request to self: GET %s", iri)
 	}
 
+	// Wrap ctx with configured dereference timeout,
+	// only released once the response body is closed.
+	ctx, cncl := withTimeout(ctx, config.GetHTTPClientDereferenceTimeout())
+
+	rsp, err := t.dereference(ctx, iri)
+	if err != nil {
+		cncl()
+		return nil, err
+	}
+
+	rsp.Body = cancelOnClose(rsp.Body, cncl)
+	return rsp, nil
+}
+
+func (t *transport) dereference(ctx context.Context, iri *url.URL) (*http.Response, error) {
 	// Build IRI just once
 	iriStr := iri.String()
 
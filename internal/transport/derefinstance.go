@@ -26,6 +26,7 @@
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -175,11 +176,25 @@ func(key string) bool {
 	return i, nil
 }
 
-func (t *transport) dereferenceByNodeInfo(
+func (t *transport) DereferenceNodeInfo(ctx context.Context, iri *url.URL) (*apimodel.Nodeinfo, error) {
+	// Try to fetch robots.txt to check
+	// if we're allowed to try endpoints:
+	//
+	//   - /.well-known/nodeinfo
+	//   - /nodeinfo/2.0|2.1 endpoints
+	robotsTxt, err := t.DereferenceRobots(ctx, iri.Scheme, iri.Host)
+	if err != nil {
+		log.Debugf(ctx, "couldn't fetch robots.txt from %s: %v", iri.Host, err)
+	}
+
+	return t.fetchNodeInfo(ctx, iri, robotsTxt)
+}
+
+func (t *transport) fetchNodeInfo(
 	ctx context.Context,
 	iri *url.URL,
 	robotsTxt *robotstxt.RobotsData,
-) (*gtsmodel.Instance, error) {
+) (*apimodel.Nodeinfo, error) {
 	// Retrieve the nodeinfo IRI from .well-known/nodeinfo.
 	niIRI, err := t.callNodeInfoWellKnown(ctx, iri, robotsTxt)
 	if err != nil {
@@ -192,6 +207,19 @@ func (t *transport) dereferenceByNodeInfo(
 		return nil, gtserror.Newf("error during call to %s: %w", niIRI.String(), err)
 	}
 
+	return ni, nil
+}
+
+func (t *transport) dereferenceByNodeInfo(
+	ctx context.Context,
+	iri *url.URL,
+	robotsTxt *robotstxt.RobotsData,
+) (*gtsmodel.Instance, error) {
+	ni, err := t.fetchNodeInfo(ctx, iri, robotsTxt)
+	if err != nil {
+		return nil, err
+	}
+
 	// We got a response of some kind!
 	//
 	// Start building out the bare minimum
@@ -260,6 +288,15 @@ func (t *transport) dereferenceByNodeInfo(
 	}
 	i.Version = software
 
+	// Store structured software
+	// info and usage stats too.
+	i.SoftwareName = ni.Software.Name
+	i.SoftwareVersion = ni.Software.Version
+	i.UsersTotal = ni.Usage.Users.Total
+	i.UsersActiveMonth = ni.Usage.Users.ActiveMonth
+	i.OpenRegistrations = &ni.OpenRegistrations
+	i.InfoFetchedAt = time.Now()
+
 	return i, nil
 }
 
@@ -24,6 +24,7 @@
 	"net/http"
 	"net/url"
 
+	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"codeberg.org/gruf/go-iotools"
 )
@@ -34,6 +35,21 @@ func (t *transport) DereferenceMedia(ctx context.Context, iri *url.URL, maxsz in
 		return emptyLimitedReader(), nil
 	}
 
+	// Wrap ctx with configured media timeout, only released
+	// once the body is closed, so the deadline includes the
+	// time spent reading (i.e. downloading) the media body.
+	ctx, cncl := withTimeout(ctx, config.GetHTTPClientMediaTimeout())
+
+	rc, err := t.dereferenceMedia(ctx, iri, maxsz)
+	if err != nil {
+		cncl()
+		return nil, err
+	}
+
+	return cancelOnClose(rc, cncl), nil
+}
+
+func (t *transport) dereferenceMedia(ctx context.Context, iri *url.URL, maxsz int64) (io.ReadCloser, error) {
 	// Build IRI just once.
 	iriStr := iri.String()
 
@@ -82,6 +82,11 @@ func (t *transport) Finger(ctx context.Context, targetUsername string, targetDom
 		return nil, gtserror.Newf("error punifying %s: %w", targetDomain, err)
 	}
 
+	// Limit the total time spent on this lookup, including
+	// any host-meta fallback, to configured webfinger timeout.
+	ctx, cncl := withTimeout(ctx, config.GetHTTPClientWebfingerTimeout())
+	defer cncl()
+
 	// Generate new GET request
 	url, cached := t.webfingerURLFor(punyDomain)
 	req, err := prepWebfingerReq(ctx, url, punyDomain, targetUsername)
@@ -0,0 +1,39 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"errors"
+)
+
+// txtResolver is implemented by http
+// clients that can also look up DNS TXT
+// records, ie., *httpclient.Client{}.
+type txtResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+func (t *transport) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	r, ok := t.controller.client.(txtResolver)
+	if !ok {
+		return nil, errors.New("http client does not support txt lookups")
+	}
+
+	return r.LookupTXT(ctx, name)
+}
@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"codeberg.org/gruf/go-iotools"
+)
+
+// withTimeout wraps the given context with a deadline of timeout
+// from now, returning unchanged if timeout is <= zero. In that
+// case the httpclient's default per-attempt timeout is used.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// cancelOnClose wraps the given ReadCloser such that
+// the cancel func is called once the body is closed,
+// i.e. the operation deadline covers the body read.
+func cancelOnClose(rc io.ReadCloser, cncl context.CancelFunc) io.ReadCloser {
+	return &iotools.ReadCloserType{
+		Reader: rc,
+		Closer: iotools.CloserCallback(rc, cncl),
+	}
+}
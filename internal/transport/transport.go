@@ -27,6 +27,7 @@
 	"sync"
 	"time"
 
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/httpclient"
@@ -78,6 +79,9 @@ type Transport interface {
 	// DereferenceInstance dereferences remote instance information, first by checking /api/v1/instance, and then by checking /.well-known/nodeinfo.
 	DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error)
 
+	// DereferenceNodeInfo dereferences the nodeinfo document of a remote instance, via /.well-known/nodeinfo.
+	DereferenceNodeInfo(ctx context.Context, iri *url.URL) (*apimodel.Nodeinfo, error)
+
 	// DereferenceDomainPermissions dereferences the
 	// permissions list present at the given permSub's URI.
 	//
@@ -94,6 +98,13 @@ type Transport interface {
 
 	// Finger performs a webfinger request with the given username and domain, and returns the bytes from the response body.
 	Finger(ctx context.Context, targetUsername string, targetDomain string) ([]byte, error)
+
+	/*
+		DNS functions
+	*/
+
+	// LookupTXT looks up the DNS TXT records of name, using the resolver of the transport client.
+	LookupTXT(ctx context.Context, name string) ([]string, error)
 }
 
 // transport implements
@@ -26,12 +26,15 @@
 
 	"code.superseriousbusiness.org/gopkg/log"
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
+	"code.superseriousbusiness.org/gotosocial/internal/validate"
 	"github.com/miekg/dns"
 )
 
@@ -117,6 +120,18 @@ func (c *Converter) ASRepresentationToAccount(
 		acct.DisplayName = acct.Username
 	}
 
+	// Extract structured profile metadata,
+	// dropping anything that's too long.
+	if pronouns := ap.GetPronouns(accountable); // nocollapse
+	pronouns != "" && validate.Pronouns(pronouns) == nil {
+		acct.Pronouns = pronouns
+	}
+
+	if location := ap.GetLocationName(accountable); // nocollapse
+	location != "" && validate.Location(location) == nil {
+		acct.Location = location
+	}
+
 	// Check for separaate account
 	// domain to the instance hostname.
 	if accountDomain != "" {
@@ -235,6 +250,17 @@ func (c *Converter) ASRepresentationToAccount(
 		acct.AlsoKnownAsURIs = append(acct.AlsoKnownAsURIs, uri.String())
 	}
 
+	// Operator of automated (Service) actors,
+	// as indicated by actor's attributedTo.
+	if acct.ActorType == gtsmodel.AccountActorTypeService {
+		for _, uri := range ap.GetAttributedTo(accountable) {
+			if uri.String() != acct.URI {
+				acct.OperatorURI = uri.String()
+				break
+			}
+		}
+	}
+
 	// Extract account public key and verify ownership to account.
 	pkey, pkeyURL, pkeyOwnerID, err := ap.ExtractPubKeyFromActor(accountable)
 	if err != nil {
@@ -290,10 +316,14 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	// Many implementations set both content
 	// and contentMap; we can use these to
 	// infer the language of the status.
-	status.Content, status.Language = ContentToContentLanguage(
-		ctx,
-		ap.ExtractContent(statusable),
-	)
+	content := ap.ExtractContent(statusable)
+	status.Content, status.Language = ContentToContentLanguage(ctx, content)
+
+	// status.ContentMap
+	//
+	// All language variants of content, if
+	// the status is in multiple languages.
+	status.ContentMap = ContentToLanguageVariants(ctx, content)
 
 	// status.Attachments
 	//
@@ -342,10 +372,17 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	}
 
 	// status.ContentWarning
+	// status.Title
 	//
-	// Topic or content warning for this status;
-	// prefer Summary, fall back to Name.
-	if summary := ap.ExtractSummary(statusable); summary != "" {
+	// Long-form articles have a Name title, and
+	// Summary which acts as a preview of content.
+	//
+	// Else topic or content warning for this
+	// status; prefer Summary, fall back to Name.
+	if statusable.GetTypeName() == ap.ObjectArticle {
+		status.Title = ap.ExtractName(statusable)
+		status.ContentWarning = ap.ExtractSummary(statusable)
+	} else if summary := ap.ExtractSummary(statusable); summary != "" {
 		status.ContentWarning = summary
 	} else {
 		status.ContentWarning = ap.ExtractName(statusable)
@@ -440,6 +477,10 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	status.Federated = util.Ptr(true)
 	status.Local = util.Ptr(false)
 
+	// Remote statuses always get link
+	// previews, if enabled on this instance.
+	status.PreviewLinks = util.Ptr(true)
+
 	// Derive interaction policy for this status.
 	status.InteractionPolicy = ap.ExtractInteractionPolicy(
 		statusable,
@@ -496,9 +537,32 @@ func (c *Converter) ASFollowToFollowRequest(ctx context.Context, followable ap.F
 		TargetAccountID: target.ID,
 	}
 
+	// Some implementations allow a message to be
+	// sent along with a follow, set as the content
+	// of the Follow activity. Store it as plaintext.
+	if withContent, ok := followable.(ap.WithContent); ok {
+		content := ap.ExtractContent(withContent).Content
+		followRequest.Message = followRequestMessage(content)
+	}
+
 	return followRequest, nil
 }
 
+// maxFollowRequestMessageLength is the maximum
+// length in runes of a stored follow request message.
+const maxFollowRequestMessageLength = 500
+
+// followRequestMessage converts the given HTML content of
+// a Follow to a plaintext follow request message, truncated
+// to maxFollowRequestMessageLength if necessary.
+func followRequestMessage(content string) string {
+	message := text.ParseHTMLToPlain(content)
+	if r := []rune(message); len(r) > maxFollowRequestMessageLength {
+		message = string(r[:maxFollowRequestMessageLength])
+	}
+	return message
+}
+
 // ASFollowToFollowRequest converts a remote activitystreams `follow` representation into gts model follow.
 func (c *Converter) ASFollowToFollow(ctx context.Context, followable ap.Followable) (*gtsmodel.Follow, error) {
 	uriObj := ap.GetJSONLDId(followable)
@@ -836,6 +900,75 @@ func (c *Converter) ASFlagToReport(ctx context.Context, flaggable ap.Flaggable)
 	}, nil
 }
 
+// ASStatusToStatusCreateRequest converts the given statusable,
+// as POSTed by requester to their own outbox using ActivityPub
+// client-to-server, into a status create request form, so that
+// it can be passed through the usual status creation processing.
+//
+// Content and summary are converted to plain text, and visibility
+// is inferred from the statusable's addressing. If the statusable
+// is addressed to no-one, visibility is left unset, so that the
+// default visibility of requester will be used.
+func (c *Converter) ASStatusToStatusCreateRequest(
+	ctx context.Context,
+	statusable ap.Statusable,
+	requester *gtsmodel.Account,
+) (*apimodel.StatusCreateRequest, error) {
+	form := &apimodel.StatusCreateRequest{
+		ContentType: apimodel.StatusContentTypePlain,
+		Sensitive:   ap.ExtractSensitive(statusable),
+	}
+
+	// form.Status
+	// form.Language
+	content, lang := ContentToContentLanguage(
+		ctx,
+		ap.ExtractContent(statusable),
+	)
+	form.Status = text.ParseHTMLToPlain(content)
+	form.Language = lang
+
+	// form.SpoilerText
+	if summary := ap.ExtractSummary(statusable); summary != "" {
+		form.SpoilerText = text.ParseHTMLToPlain(summary)
+	}
+
+	// form.InReplyToID
+	//
+	// Only replies to statuses we already
+	// know about can be created this way.
+	if inReplyTo := ap.ExtractInReplyToURI(statusable); inReplyTo != nil {
+		uri := inReplyTo.String()
+
+		inReplyToStatus, err := c.state.DB.GetStatusByURI(ctx, uri)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("db error getting inReplyTo status %s: %w", uri, err)
+		}
+
+		if inReplyToStatus == nil {
+			err := gtserror.Newf("inReplyTo status %s not known", uri)
+			return nil, gtserror.SetMalformed(err)
+		}
+
+		form.InReplyToID = inReplyToStatus.ID
+	}
+
+	// form.Visibility
+	if len(ap.ExtractToURIs(statusable)) != 0 ||
+		len(ap.ExtractCcURIs(statusable)) != 0 {
+		visibility, err := ap.ExtractVisibility(
+			statusable,
+			requester.FollowersURI,
+		)
+		if err != nil {
+			return nil, gtserror.SetMalformed(err)
+		}
+		form.Visibility = VisToAPIVis(visibility)
+	}
+
+	return form, nil
+}
+
 func (c *Converter) getASActorAccount(ctx context.Context, id string, with ap.WithActor) (*gtsmodel.Account, error) {
 	// Get actor IRIs from type.
 	actor := ap.GetActorIRIs(with)
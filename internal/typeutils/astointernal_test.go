@@ -22,6 +22,7 @@
 	"context"
 	"encoding/json"
 	"io"
+	"strings"
 	"testing"
 
 	"code.superseriousbusiness.org/activity/streams"
@@ -229,6 +230,52 @@ func (suite *ASToInternalTestSuite) TestParseOwncastService() {
 	suite.NotNil(b)
 }
 
+func (suite *ASToInternalTestSuite) TestParseServiceWithOperator() {
+	// Give the owncast service an operator.
+	in := strings.Replace(owncastService,
+		`"discoverable": true,`,
+		`"discoverable": true, "attributedTo": "https://owncast.example.org/federation/user/rob",`,
+		1,
+	)
+
+	t := suite.jsonToType(in)
+	rep, ok := t.(ap.Accountable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	acct, err := suite.typeconverter.ASRepresentationToAccount(suite.T().Context(), rep, "", "")
+	suite.NoError(err)
+
+	suite.Equal(gtsmodel.AccountActorTypeService, acct.ActorType)
+	suite.Equal("https://owncast.example.org/federation/user/rob", acct.OperatorURI)
+}
+
+func (suite *ASToInternalTestSuite) TestParsePronounsAndLocation() {
+	// Give the owncast service pronouns and
+	// a location, plus an overlong location.
+	in := strings.Replace(owncastService,
+		`"discoverable": true,`,
+		`"discoverable": true, "pronouns": "it/its", "location": [{"type": "Place", "name": "`+strings.Repeat("a", 101)+`"}, {"type": "Place", "name": "Rotterdam"}],`,
+		1,
+	)
+
+	t := suite.jsonToType(in)
+	rep, ok := t.(ap.Accountable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	acct, err := suite.typeconverter.ASRepresentationToAccount(suite.T().Context(), rep, "", "")
+	suite.NoError(err)
+
+	suite.Equal("it/its", acct.Pronouns)
+
+	// Only the first named Place is taken, so the
+	// overlong location should be dropped entirely.
+	suite.Empty(acct.Location)
+}
+
 func (suite *ASToInternalTestSuite) TestParseBookwyrmStatus() {
 	authorAccount := suite.testAccounts["remote_account_1"]
 
@@ -278,7 +325,10 @@ func (suite *ASToInternalTestSuite) TestParseBookwyrmStatus() {
 		suite.FailNow(err.Error())
 	}
 
-	suite.Equal("Review of \"Dracula\" (5 stars): A great read, not just for codifying vampire lore, but the way it's built from letters and diaries.", status.ContentWarning)
+	// Article name should be used as title, not content warning.
+	suite.True(status.IsArticle())
+	suite.Equal("Review of \"Dracula\" (5 stars): A great read, not just for codifying vampire lore, but the way it's built from letters and diaries.", status.Title)
+	suite.Empty(status.ContentWarning)
 	suite.Len(status.Attachments, 1)
 }
 
@@ -571,6 +621,35 @@ func (suite *ASToInternalTestSuite) TestParseAnnounce() {
 	suite.Nil(boost.BoostOfAccount)
 }
 
+func (suite *ASToInternalTestSuite) TestParseFollowWithMessage() {
+	followingAccount := suite.testAccounts["remote_account_1"]
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "` + followingAccount.URI + `",
+  "id": "http://fossbros-anonymous.io/users/foss_satan/follows/01J3ZJ4AC6JCTA9V2YPSBKVPNA",
+  "object": "` + targetAccount.URI + `",
+  "content": "<p>Hi! We met at the <strong>turtle</strong> meetup, please let me in.</p>",
+  "type": "Follow"
+  }`
+
+	t := suite.jsonToType(raw)
+	asFollow, ok := t.(ap.Followable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	followRequest, err := suite.typeconverter.ASFollowToFollowRequest(suite.T().Context(), asFollow)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(followingAccount.ID, followRequest.AccountID)
+	suite.Equal(targetAccount.ID, followRequest.TargetAccountID)
+	suite.Equal("Hi! We met at the turtle meetup, please let me in.", followRequest.Message)
+}
+
 func (suite *ASToInternalTestSuite) TestParseHonkAccount() {
 	// Hopefully comprehensive checks for
 	// https://codeberg.org/superseriousbusiness/gotosocial/issues/2527.
@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils
+
+import (
+	"context"
+	"sync"
+
+	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
+)
+
+// convCacheKey is the context key
+// under which a *convCache is stored.
+type convCacheKey struct{}
+
+// convCache caches frontend API models of sub-entities
+// (accounts, emojis, mentions, attachments) converted
+// during a single request, so that entities repeated
+// across eg., a page of timeline statuses only need
+// to be converted once. Only immutable value types are
+// shared; pointer types are copied on the way out so
+// callers can still safely modify what they're given.
+type convCache struct {
+	mu          sync.Mutex
+	accounts    map[string]*apimodel.Account
+	emojis      map[string]apimodel.Emoji
+	mentions    map[string]apimodel.Mention
+	attachments map[string]apimodel.Attachment
+}
+
+// WithConversionCache returns a copy of ctx carrying a new conversion cache,
+// which Converter functions called with the returned context will use to avoid
+// re-converting the same sub-entities. This should only be used for the lifetime
+// of a single request, eg., when converting a page of timeline statuses, as the
+// cached models are never invalidated.
+func WithConversionCache(ctx context.Context) context.Context {
+	if getConvCache(ctx) != nil {
+		// Already set.
+		return ctx
+	}
+	return context.WithValue(ctx, convCacheKey{}, &convCache{
+		accounts:    make(map[string]*apimodel.Account),
+		emojis:      make(map[string]apimodel.Emoji),
+		mentions:    make(map[string]apimodel.Mention),
+		attachments: make(map[string]apimodel.Attachment),
+	})
+}
+
+// getConvCache returns the conversion
+// cache stored in ctx, if any, else nil.
+func getConvCache(ctx context.Context) *convCache {
+	cc, _ := ctx.Value(convCacheKey{}).(*convCache)
+	return cc
+}
+
+// getAccount returns a shallow copy of
+// the cached API account with ID, if any.
+func (cc *convCache) getAccount(id string) (*apimodel.Account, bool) {
+	if cc == nil {
+		return nil, false
+	}
+	cc.mu.Lock()
+	account, ok := cc.accounts[id]
+	cc.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	cp := *account
+	return &cp, true
+}
+
+// putAccount stores a shallow copy of
+// the given API account under ID.
+func (cc *convCache) putAccount(id string, account *apimodel.Account) {
+	if cc == nil {
+		return
+	}
+	cp := *account
+	cc.mu.Lock()
+	cc.accounts[id] = &cp
+	cc.mu.Unlock()
+}
+
+// getEmoji returns the cached
+// API emoji with ID, if any.
+func (cc *convCache) getEmoji(id string) (apimodel.Emoji, bool) {
+	if cc == nil {
+		return apimodel.Emoji{}, false
+	}
+	cc.mu.Lock()
+	emoji, ok := cc.emojis[id]
+	cc.mu.Unlock()
+	return emoji, ok
+}
+
+// putEmoji stores the given
+// API emoji under ID.
+func (cc *convCache) putEmoji(id string, emoji apimodel.Emoji) {
+	if cc == nil {
+		return
+	}
+	cc.mu.Lock()
+	cc.emojis[id] = emoji
+	cc.mu.Unlock()
+}
+
+// getMention returns the cached API
+// mention of target account ID, if any.
+func (cc *convCache) getMention(targetAccountID string) (apimodel.Mention, bool) {
+	if cc == nil {
+		return apimodel.Mention{}, false
+	}
+	cc.mu.Lock()
+	mention, ok := cc.mentions[targetAccountID]
+	cc.mu.Unlock()
+	return mention, ok
+}
+
+// putMention stores the given API
+// mention under target account ID.
+func (cc *convCache) putMention(targetAccountID string, mention apimodel.Mention) {
+	if cc == nil {
+		return
+	}
+	cc.mu.Lock()
+	cc.mentions[targetAccountID] = mention
+	cc.mu.Unlock()
+}
+
+// getAttachment returns the cached
+// API attachment with ID, if any.
+func (cc *convCache) getAttachment(id string) (apimodel.Attachment, bool) {
+	if cc == nil {
+		return apimodel.Attachment{}, false
+	}
+	cc.mu.Lock()
+	attachment, ok := cc.attachments[id]
+	cc.mu.Unlock()
+	return attachment, ok
+}
+
+// putAttachment stores the
+// given API attachment under ID.
+func (cc *convCache) putAttachment(id string, attachment apimodel.Attachment) {
+	if cc == nil {
+		return
+	}
+	cc.mu.Lock()
+	cc.attachments[id] = attachment
+	cc.mu.Unlock()
+}
@@ -18,6 +18,7 @@
 package typeutils
 
 import (
+	"context"
 	crand "crypto/rand"
 	"math/big"
 	"math/rand"
@@ -29,6 +30,7 @@
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/interaction"
 	"code.superseriousbusiness.org/gotosocial/internal/filter/visibility"
+	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/state"
 )
 
@@ -39,6 +41,8 @@ type Converter struct {
 	visFilter      *visibility.Filter
 	intFilter      *interaction.Filter
 	randStats      atomic.Pointer[apimodel.RandomStats]
+	actStats       atomic.Pointer[apimodel.ActivityStats]
+	actStatsMu     sync.Mutex
 }
 
 func NewConverter(state *state.State) *Converter {
@@ -69,6 +73,57 @@ func (c *Converter) RandomStats() apimodel.RandomStats {
 	return newStats
 }
 
+// ActivityStats returns cached local instance activity stats,
+// (re)calculating them from the database if they're missing
+// or stale. This avoids running the (relatively expensive)
+// activity queries on every nodeinfo or instance request.
+func (c *Converter) ActivityStats(ctx context.Context) (apimodel.ActivityStats, error) {
+	if stats := c.actStats.Load(); stats != nil &&
+		time.Since(stats.Generated) < time.Hour {
+		// Activity stats are still
+		// fresh (less than 1hr old),
+		// so return them as-is.
+		return *stats, nil
+	}
+
+	// Only allow one caller to
+	// recalculate stats at a time.
+	c.actStatsMu.Lock()
+	defer c.actStatsMu.Unlock()
+
+	// Check again in case stats were
+	// recalculated while we waited.
+	if stats := c.actStats.Load(); stats != nil &&
+		time.Since(stats.Generated) < time.Hour {
+		return *stats, nil
+	}
+
+	const (
+		month    = 30 * 24 * time.Hour
+		halfYear = 180 * 24 * time.Hour
+	)
+
+	now := time.Now()
+
+	mau, err := c.state.DB.CountLocalActiveUsers(ctx, now.Add(-month))
+	if err != nil {
+		return apimodel.ActivityStats{}, gtserror.Newf("db error counting monthly active users: %w", err)
+	}
+
+	hyau, err := c.state.DB.CountLocalActiveUsers(ctx, now.Add(-halfYear))
+	if err != nil {
+		return apimodel.ActivityStats{}, gtserror.Newf("db error counting half-yearly active users: %w", err)
+	}
+
+	newStats := apimodel.ActivityStats{
+		MonthlyActiveUsers:  mau,
+		HalfYearActiveUsers: hyau,
+		Generated:           now,
+	}
+	c.actStats.Store(&newStats)
+	return newStats, nil
+}
+
 func genRandStats() apimodel.RandomStats {
 	const (
 		statusesMax = 10000000
@@ -87,15 +142,19 @@ func genRandStats() apimodel.RandomStats {
 		log.Panicf(nil, "error randomly generating users count: %v", err)
 	}
 
-	// Monthly users should only ever
-	// be <= 100% of total users.
+	// Half-yearly users should only ever
+	// be <= 100% of total users, and
+	// monthly users <= half-yearly users.
 	totalUsers := totalUsersB.Int64()
-	activeRatio := rand.Float64() //nolint
-	mau := int64(float64(totalUsers) * activeRatio)
+	halfYearRatio := rand.Float64() //nolint
+	monthRatio := rand.Float64()    //nolint
+	hyau := int64(float64(totalUsers) * halfYearRatio)
+	mau := int64(float64(hyau) * monthRatio)
 
 	return apimodel.RandomStats{
-		Statuses:           statusesB.Int64(),
-		TotalUsers:         totalUsers,
-		MonthlyActiveUsers: mau,
+		Statuses:            statusesB.Int64(),
+		TotalUsers:          totalUsers,
+		MonthlyActiveUsers:  mau,
+		HalfYearActiveUsers: hyau,
 	}
 }
@@ -383,6 +383,95 @@ func(a *gtsmodel.UserMute, b *gtsmodel.UserMute) int {
 	return records, nil
 }
 
+// FavesToCSV converts a slice of faves into
+// a slice of CSV-compatible Favourites records.
+//
+// Like Mastodon's bookmarks export, records have
+// no header, and consist only of the URI of each
+// faved status, in the order of the given faves.
+//
+// Each fave should have its Status populated.
+func (c *Converter) FavesToCSV(
+	ctx context.Context,
+	faves []*gtsmodel.StatusFave,
+) ([][]string, error) {
+	records := make([][]string, 0, len(faves))
+
+	// For each item, add a record.
+	for _, fave := range faves {
+		if fave.Status == nil {
+			// Status not populated,
+			// probably since deleted.
+			continue
+		}
+
+		records = append(records, []string{
+			// Status URI: eg., https://example.org/users/someone/statuses/01F8MH75CBF9JFX4ZAD54N0W0R
+			fave.Status.URI,
+		})
+	}
+
+	return records, nil
+}
+
+// NotesToCSV converts a slice of account notes
+// into a slice of CSV-compatible Notes records.
+//
+// Each note should be populated.
+func (c *Converter) NotesToCSV(
+	ctx context.Context,
+	notes []*gtsmodel.AccountNote,
+) ([][]string, error) {
+	// Records should be length of
+	// input + 1 so we can add headers.
+	records := make([][]string, 1, len(notes)+1)
+
+	// Add headers at the
+	// top of records.
+	records[0] = []string{
+		"Account address",
+		"Note",
+	}
+
+	// We need to know our own domain for this.
+	// Try account domain, fall back to host.
+	thisDomain := config.GetAccountDomain()
+	if thisDomain == "" {
+		thisDomain = config.GetHost()
+	}
+
+	// Pre-sort the notes
+	// by domain and username.
+	slices.SortFunc(
+		notes,
+		func(a *gtsmodel.AccountNote, b *gtsmodel.AccountNote) int {
+			aStr := a.TargetAccount.Domain + "/" + a.TargetAccount.Username
+			bStr := b.TargetAccount.Domain + "/" + b.TargetAccount.Username
+			return cmp.Compare(aStr, bStr)
+		},
+	)
+
+	// For each item, add a record.
+	for _, note := range notes {
+		domain := note.TargetAccount.Domain
+		if domain == "" {
+			// Local account,
+			// use our domain.
+			domain = thisDomain
+		}
+
+		records = append(records, []string{
+			// Account address: eg., someone@example.org
+			// -- NOTE: without the leading '@'!
+			note.TargetAccount.Username + "@" + domain,
+			// Note: eg., met at the bike repair workshop
+			note.Comment,
+		})
+	}
+
+	return records, nil
+}
+
 // CSVToFollowing converts a slice of CSV records
 // to a slice of barebones *gtsmodel.Follow's,
 // ready for further processing.
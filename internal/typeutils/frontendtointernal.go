@@ -178,11 +178,34 @@ func APIInteractionPolicyToInteractionPolicy(
 		return nil, err
 	}
 
+	// CanQuote is optional, and left
+	// nil if not set on the API policy.
+	var canQuote *gtsmodel.PolicyRules
+	if p.CanQuote != nil {
+		canQuoteAutomaticApproval, err := convertURIs(p.CanQuote.AutomaticApproval)
+		if err != nil {
+			err := fmt.Errorf("error converting %s.can_quote.automatic_approval: %w", v, err)
+			return nil, err
+		}
+
+		canQuoteManualApproval, err := convertURIs(p.CanQuote.ManualApproval)
+		if err != nil {
+			err := fmt.Errorf("error converting %s.can_quote.manual_approval: %w", v, err)
+			return nil, err
+		}
+
+		canQuote = &gtsmodel.PolicyRules{
+			AutomaticApproval: canQuoteAutomaticApproval,
+			ManualApproval:    canQuoteManualApproval,
+		}
+	}
+
 	// Normalize URIs.
 	//
 	// 1. Ensure canLikeAlways, canReplyAlways,
-	//    and canAnnounceAlways include self
-	//    (either explicitly or within public).
+	//    canAnnounceAlways, and canQuoteAlways (if
+	//    set) include self (either explicitly or
+	//    within public).
 
 	// ensureIncludesSelf adds the "author" PolicyValue
 	// to given slice of PolicyValues, if not already
@@ -212,6 +235,9 @@ func(uri gtsmodel.PolicyValue) bool {
 	canLikeAutomaticApproval = ensureIncludesSelf(canLikeAutomaticApproval)
 	canReplyAutomaticApproval = ensureIncludesSelf(canReplyAutomaticApproval)
 	canAnnounceAutomaticApproval = ensureIncludesSelf(canAnnounceAutomaticApproval)
+	if canQuote != nil {
+		canQuote.AutomaticApproval = ensureIncludesSelf(canQuote.AutomaticApproval)
+	}
 
 	// 2. Ensure canReplyAlways includes mentioned
 	//    accounts (either explicitly or within public).
@@ -241,6 +267,7 @@ func(uri gtsmodel.PolicyValue) bool {
 			AutomaticApproval: canAnnounceAutomaticApproval,
 			ManualApproval:    canAnnounceManualApproval,
 		},
+		CanQuote: canQuote,
 	}, nil
 }
 
@@ -266,6 +293,8 @@ func APIMediaPolicyToMediaPolicy(policy apimodel.MediaPolicy) gtsmodel.MediaPoli
 		return gtsmodel.MediaPolicyMarkSensitive
 	case apimodel.MediaPolicyReject:
 		return gtsmodel.MediaPolicyReject
+	case apimodel.MediaPolicySensitiveBlurhashOnly:
+		return gtsmodel.MediaPolicySensitiveBlurhashOnly
 	default:
 		return gtsmodel.MediaPolicyUnknown
 	}
@@ -309,3 +338,14 @@ func APIAccountsPolicyToAccountsPolicy(policy apimodel.AccountsPolicy) gtsmodel.
 		return gtsmodel.AccountsPolicyUnknown
 	}
 }
+
+func APIFederationPolicyToFederationPolicy(policy apimodel.FederationPolicy) gtsmodel.FederationPolicy {
+	switch policy {
+	case apimodel.FederationPolicyNoAction:
+		return gtsmodel.FederationPolicyNoAction
+	case apimodel.FederationPolicyPause:
+		return gtsmodel.FederationPolicyPause
+	default:
+		return gtsmodel.FederationPolicyUnknown
+	}
+}
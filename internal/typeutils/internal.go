@@ -87,6 +87,9 @@ func (c *Converter) StatusToBoost(
 		// Boosts are not considered sensitive even if their target is.
 		Sensitive: util.Ptr(false),
 
+		// Boosts don't get their own link preview card.
+		PreviewLinks: util.Ptr(false),
+
 		// Remaining fields all
 		// taken from boosted status.
 		ActivityStreamsType: target.ActivityStreamsType,
@@ -234,6 +234,30 @@ func (c *Converter) AccountToAS(
 		ap.SetMovedTo(accountable, movedTo)
 	}
 
+	// attributedTo
+	// Operator of a bot account.
+	if a.ActorType == gtsmodel.AccountActorTypeService &&
+		a.OperatorURI != "" {
+		operator, err := url.Parse(a.OperatorURI)
+		if err != nil {
+			return nil, err
+		}
+
+		ap.AppendAttributedTo(accountable, operator)
+	}
+
+	// pronouns
+	// Set as schema.org extension.
+	if a.Pronouns != "" {
+		ap.SetPronouns(accountable, a.Pronouns)
+	}
+
+	// location
+	// Set as a named Place.
+	if a.Location != "" {
+		ap.SetLocationName(accountable, a.Location)
+	}
+
 	// publicKey
 	// Required for signatures.
 	publicKeyProp := streams.NewW3IDSecurityV1PublicKeyProperty()
@@ -2024,6 +2048,9 @@ func (c *Converter) InteractionPolicyToASInteractionPolicy(
 		CAN QUOTE
 		todo: GtS doesn't support quote posts yet so
 		just set restrictive quote policy (self only).
+		Any CanQuote stored on the policy is deliberately
+		not federated, since we couldn't honour it by
+		approving quotes of our statuses.
 	*/
 
 	canQuote := streams.NewGoToSocialCanQuote()
@@ -20,6 +20,8 @@
 import (
 	"encoding/json"
 	"errors"
+	"io"
+	"strings"
 	"testing"
 
 	"code.superseriousbusiness.org/gotosocial/internal/ap"
@@ -173,6 +175,96 @@ func (suite *InternalToASTestSuite) TestAccountToASBot() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestAccountToASBotWithOperator() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"] // take zork for this test
+
+	// Update zork to be a bot operated by admin.
+	testAccount.ActorType = gtsmodel.AccountActorTypeService
+	testAccount.OperatorURI = suite.testAccounts["admin_account"].URI
+
+	accountable, err := suite.typeconverter.AccountToAS(suite.T().Context(), testAccount)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(accountable)
+	suite.NoError(err)
+
+	suite.Equal("Service", ser["type"])
+	suite.Equal("http://localhost:8080/users/admin", ser["attributedTo"])
+
+	// Operator should be dropped
+	// if zork is no longer a bot.
+	testAccount.ActorType = gtsmodel.AccountActorTypePerson
+
+	accountable, err = suite.typeconverter.AccountToAS(suite.T().Context(), testAccount)
+	suite.NoError(err)
+
+	ser, err = ap.Serialize(accountable)
+	suite.NoError(err)
+
+	suite.Equal("Person", ser["type"])
+	suite.NotContains(ser, "attributedTo")
+}
+
+func (suite *InternalToASTestSuite) TestAccountToASWithPronounsAndLocation() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"] // take zork for this test
+
+	testAccount.Pronouns = "he/they"
+	testAccount.Location = "The Great Underground Empire"
+
+	accountable, err := suite.typeconverter.AccountToAS(suite.T().Context(), testAccount)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(accountable)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(map[string]any{
+		"@context": ser["@context"],
+		"location": ser["location"],
+		"pronouns": ser["pronouns"],
+	}, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": [
+    "https://gotosocial.org/ns",
+    "https://w3id.org/security/v1",
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "discoverable": "toot:discoverable",
+      "featured": {
+        "@id": "toot:featured",
+        "@type": "@id"
+      },
+      "indexable": "toot:indexable",
+      "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "pronouns": "schema:pronouns",
+      "schema": "http://schema.org#",
+      "toot": "http://joinmastodon.org/ns#"
+    }
+  ],
+  "location": {
+    "name": "The Great Underground Empire",
+    "type": "Place"
+  },
+  "pronouns": "he/they"
+}`, string(bytes))
+
+	// Ensure the values survive a round trip.
+	raw, err := json.Marshal(ser)
+	suite.NoError(err)
+
+	accountable, err = ap.ResolveAccountable(suite.T().Context(), io.NopCloser(strings.NewReader(string(raw))))
+	suite.NoError(err)
+
+	acct, err := suite.typeconverter.ASRepresentationToAccount(suite.T().Context(), accountable, "", "")
+	suite.NoError(err)
+
+	suite.Equal("he/they", acct.Pronouns)
+	suite.Equal("The Great Underground Empire", acct.Location)
+}
+
 func (suite *InternalToASTestSuite) TestAccountToASWithFields() {
 	testAccount := &gtsmodel.Account{}
 	*testAccount = *suite.testAccounts["local_account_2"]
@@ -22,20 +22,27 @@
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
+	"net/url"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"code.superseriousbusiness.org/activity/streams/vocab"
 	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtscontext"
 	"code.superseriousbusiness.org/gotosocial/internal/gtserror"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
 	"code.superseriousbusiness.org/gotosocial/internal/id"
 	"code.superseriousbusiness.org/gotosocial/internal/language"
 	"code.superseriousbusiness.org/gotosocial/internal/media"
+	"code.superseriousbusiness.org/gotosocial/internal/messages"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
 	"code.superseriousbusiness.org/gotosocial/internal/uris"
 	"code.superseriousbusiness.org/gotosocial/internal/util"
@@ -102,6 +109,23 @@ func (c *Converter) UserToAPIUser(ctx context.Context, u *gtsmodel.User) *apimod
 	return user
 }
 
+// UserLoginToAPIUserLogin converts a gts model
+// user login into its api (frontend) representation.
+func (c *Converter) UserLoginToAPIUserLogin(login *gtsmodel.UserLogin) *apimodel.UserLogin {
+	apiLogin := &apimodel.UserLogin{
+		ID:         login.ID,
+		CreatedAt:  util.FormatISO8601(login.CreatedAt),
+		UserAgent:  login.UserAgent,
+		Successful: *login.Successful,
+	}
+
+	if login.IP != nil {
+		apiLogin.IP = login.IP.String()
+	}
+
+	return apiLogin
+}
+
 // AccountToAPIAccountSensitive takes a db model account as a param, and returns a populated apitype account, or an error
 // if something goes wrong. The returned account should be ready to serialize on an API level, and may have sensitive fields
 // (such as user settings and follow requests count), so serve it only to an authorized user who should have permission to see it.
@@ -151,18 +175,40 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		webVisibility = apimodel.VisibilityPublic
 	}
 
+	// Show the policy applying to the account, ie.,
+	// the stricter of their own and instance policy.
+	descriptionPolicy := a.Settings.DescriptionPolicyOr(
+		gtsmodel.ParseDescriptionPolicy(config.GetMediaDescriptionPolicy()),
+	)
+
 	apiAccount.Source = &apimodel.Source{
-		Privacy:             VisToAPIVis(a.Settings.Privacy),
-		WebVisibility:       webVisibility,
-		WebLayout:           a.Settings.WebLayout.String(),
-		WebIncludeBoosts:    *a.Settings.WebIncludeBoosts,
-		Sensitive:           *a.Settings.Sensitive,
-		Language:            a.Settings.Language,
-		StatusContentType:   statusContentType,
-		Note:                a.NoteRaw,
-		Fields:              c.fieldsToAPIFields(a.FieldsRaw),
-		FollowRequestsCount: *a.Stats.FollowRequestsCount,
-		AlsoKnownAsURIs:     a.AlsoKnownAsURIs,
+		Privacy:                VisToAPIVis(a.Settings.Privacy),
+		WebVisibility:          webVisibility,
+		WebLayout:              a.Settings.WebLayout.String(),
+		WebIncludeBoosts:       *a.Settings.WebIncludeBoosts,
+		WebActivityHeatmap:     util.PtrOrValue(a.Settings.WebActivityHeatmap, false),
+		RSSFullContent:         *a.Settings.RSSFullContent,
+		Sensitive:              *a.Settings.Sensitive,
+		PreviewLinks:           util.PtrOrValue(a.Settings.PreviewLinks, true),
+		MediaDescriptionPolicy: descriptionPolicy.String(),
+		Language:               a.Settings.Language,
+		ChosenLanguages:        a.Settings.ChosenLanguages,
+		StatusContentType:      statusContentType,
+		StatusExpiresIn:        a.Settings.StatusExpiresIn,
+		Note:                   a.NoteRaw,
+		Fields:                 c.fieldsToAPIFields(a.FieldsRaw),
+		FollowRequestsCount:    *a.Stats.FollowRequestsCount,
+		AlsoKnownAsURIs:        a.AlsoKnownAsURIs,
+	}
+
+	// Include previous avatars + headers.
+	apiAccount.Source.AvatarHistory, err = c.profileMediaHistoryToAPI(ctx, a.Settings.AvatarHistoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	apiAccount.Source.HeaderHistory, err = c.profileMediaHistoryToAPI(ctx, a.Settings.HeaderHistoryIDs)
+	if err != nil {
+		return nil, err
 	}
 
 	return apiAccount, nil
@@ -172,6 +218,13 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 // if something goes wrong. The returned account should be ready to serialize on an API level, and may NOT have sensitive fields.
 // In other words, this is the public record that the server has of an account.
 func (c *Converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.Account) (*apimodel.Account, error) {
+	// Check for already converted
+	// account in conversion cache.
+	cc := getConvCache(ctx)
+	if account, ok := cc.getAccount(a.ID); ok {
+		return account, nil
+	}
+
 	account, err := c.accountToAPIAccountPublic(ctx, a)
 	if err != nil {
 		return nil, err
@@ -184,6 +237,16 @@ func (c *Converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		}
 	}
 
+	if a.Operator != nil && a.ActorType == gtsmodel.AccountActorTypeService {
+		account.Operator, err = c.accountToAPIAccountPublic(ctx, a.Operator)
+		if err != nil {
+			log.Errorf(ctx, "error converting account operator: %v", err)
+		}
+	}
+
+	// Store in conversion cache.
+	cc.putAccount(a.ID, account)
+
 	return account, nil
 }
 
@@ -240,6 +303,7 @@ func (c *Converter) AccountToWebAccount(
 	// settings struct.
 	if account.Settings != nil {
 		webAccount.WebLayout = account.Settings.WebLayout.String()
+		webAccount.WebActivityHeatmap = util.PtrOrValue(account.Settings.WebActivityHeatmap, false)
 	}
 
 	return webAccount, nil
@@ -336,6 +400,7 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		theme           string
 		customCSS       string
 		hideCollections bool
+		publishBlocks   bool
 	)
 
 	if a.IsRemote() {
@@ -364,6 +429,7 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 			theme = a.Settings.Theme
 			customCSS = a.Settings.CustomCSS
 			hideCollections = *a.Settings.HideCollections
+			publishBlocks = *a.Settings.PublishBlocks
 		}
 
 		acct = a.Username // omit domain
@@ -405,13 +471,16 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		LastStatusAt:      lastStatusAt,
 		Emojis:            apiEmojis,
 		Fields:            fields,
+		Pronouns:          a.Pronouns,
+		Location:          a.Location,
 		Suspended:         !a.SuspendedAt.IsZero(),
 		Theme:             theme,
 		CustomCSS:         customCSS,
 		EnableRSS:         enableRSS,
 		HideCollections:   hideCollections,
+		PublishBlocks:     publishBlocks,
 		Roles:             roles,
-		Group:             false,
+		Group:             a.ActorType.IsGroup(),
 	}
 
 	// Bodge default avatar + header in,
@@ -565,6 +634,7 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		disabled               bool
 		role                   = *c.APIAccountDisplayRoleToAPIAccountRoleSensitive(nil)
 		createdByApplicationID string
+		invitedByAccountID     string
 	)
 
 	if err := c.state.DB.PopulateAccount(ctx, a); err != nil {
@@ -611,6 +681,19 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		approved = *user.Approved
 		disabled = *user.Disabled
 		createdByApplicationID = user.CreatedByApplicationID
+
+		if user.InviteID != "" {
+			// Attribute sign-up to the
+			// account that created the invite.
+			invite, err := c.state.DB.GetInviteByID(ctx, user.InviteID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return nil, fmt.Errorf("AccountToAdminAPIAccount: error getting invite %s: %w", user.InviteID, err)
+			}
+
+			if invite != nil {
+				invitedByAccountID = invite.AccountID
+			}
+		}
 	}
 
 	apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
@@ -636,7 +719,7 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Suspended:              !a.SuspendedAt.IsZero(),
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
-		InvitedByAccountID:     "", // not implemented (yet)
+		InvitedByAccountID:     invitedByAccountID,
 	}, nil
 }
 
@@ -675,6 +758,27 @@ func AppToAPIAppPublic(app *gtsmodel.Application) *apimodel.Application {
 	}
 }
 
+// profileMediaHistoryToAPI converts the given avatar or header
+// history IDs of an account to API attachments, skipping any
+// that are no longer stored.
+func (c *Converter) profileMediaHistoryToAPI(ctx context.Context, ids []string) ([]apimodel.Attachment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	attachments, err := c.state.DB.GetAttachmentsByIDs(ctx, ids)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error getting profile media history: %w", err)
+	}
+
+	apiAttachments := make([]apimodel.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		apiAttachments = append(apiAttachments, AttachmentToAPIAttachment(attachment))
+	}
+
+	return apiAttachments, nil
+}
+
 // zero value media filemeta.
 var zeroSmall gtsmodel.Small
 var zeroOriginal gtsmodel.Original
@@ -695,6 +799,23 @@ func AttachmentToAPIAttachment(media *gtsmodel.MediaAttachment) apimodel.Attachm
 		// Set media error string.
 		api.Error = new(string)
 		*api.Error = media.Error.String()
+
+		// Include any dimensions given by the remote
+		// (e.g. media not downloaded due to policy),
+		// so clients can size a blurhash placeholder.
+		if media.FileMeta.Original != zeroOriginal {
+			api.Meta = new(apimodel.MediaMeta)
+			api.Meta.Focus = new(apimodel.MediaFocus)
+			api.Meta.Focus.X = media.FileMeta.Focus.X
+			api.Meta.Focus.Y = media.FileMeta.Focus.Y
+			api.Meta.Original = apimodel.MediaDimensions{
+				Width:  media.FileMeta.Original.Width,
+				Height: media.FileMeta.Original.Height,
+				Aspect: media.FileMeta.Original.Aspect,
+				Size:   toAPISize(media.FileMeta.Original.Width, media.FileMeta.Original.Height),
+			}
+		}
+
 		return api
 	}
 
@@ -809,9 +930,16 @@ func (c *Converter) EmojiToAPIEmoji(ctx context.Context, emoji *gtsmodel.Emoji)
 		category = emoji.Category.Name
 	}
 
+	url := emoji.ImageURL
+	if config.GetMediaEmojiStaticOnly() {
+		// Instance is configured to
+		// serve only static emojis.
+		url = emoji.ImageStaticURL
+	}
+
 	return apimodel.Emoji{
 		Shortcode:       emoji.Shortcode,
-		URL:             emoji.ImageURL,
+		URL:             url,
 		StaticURL:       emoji.ImageStaticURL,
 		VisibleInPicker: *emoji.VisibleInPicker,
 		Category:        category,
@@ -825,6 +953,10 @@ func (c *Converter) EmojiToAdminAPIEmoji(ctx context.Context, emoji *gtsmodel.Em
 		return nil, err
 	}
 
+	// Admins always see the original,
+	// even when serving only statics.
+	apiEmoji.URL = emoji.ImageURL
+
 	if !emoji.IsLocal() {
 		// Domain may be in Punycode,
 		// de-punify it just in case.
@@ -893,6 +1025,44 @@ func (c *Converter) StatusToAPIStatus(
 	)
 }
 
+// StatusesToAPIStatuses converts the given gts model
+// statuses into their api (frontend) representations,
+// as StatusToAPIStatus, but first loading the sub-models
+// of all statuses at once, and using a conversion cache
+// for sub-entities repeated across them. Any statuses
+// that fail to convert are logged and skipped.
+//
+// Requesting account can be nil.
+func (c *Converter) StatusesToAPIStatuses(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	statuses []*gtsmodel.Status,
+) []*apimodel.Status {
+	// Ensure all statuses populated, errors are only logged
+	// as the statuses will be re-populated on conversion.
+	if err := c.state.DB.PopulateStatuses(ctx, statuses); err != nil {
+		log.Errorf(ctx, "error(s) populating statuses: %v", err)
+	}
+
+	// Convert all statuses using same conversion cache.
+	ctx = WithConversionCache(ctx)
+
+	apiStatuses := make([]*apimodel.Status, 0, len(statuses))
+	for _, status := range statuses {
+		apiStatus, err := c.StatusToAPIStatus(ctx,
+			status,
+			requestingAccount,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error converting status %s: %v", status.URI, err)
+			continue
+		}
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses
+}
+
 // statusToAPIStatus is the package-internal implementation
 // of StatusToAPIStatus that lets the caller customize whether
 // to placehold unknown attachment types, and/or add a note
@@ -988,6 +1158,7 @@ func (c *Converter) StatusToWebStatus(
 	webStatus := &apimodel.WebStatus{
 		Status:         apiStatus,
 		SpoilerContent: s.ContentWarning,
+		ArticleTitle:   s.Title,
 		Account:        acct,
 	}
 
@@ -1183,6 +1354,7 @@ func (c *Converter) statusToFrontend(
 		apiStatus.Favourited = apiStatus.Reblog.Favourited
 		apiStatus.Bookmarked = apiStatus.Reblog.Bookmarked
 		apiStatus.Muted = apiStatus.Reblog.Muted
+		apiStatus.Subscribed = apiStatus.Reblog.Subscribed
 		apiStatus.Reblogged = apiStatus.Reblog.Reblogged
 		apiStatus.Pinned = apiStatus.Reblog.Pinned
 		apiStatus.Filtered = apiStatus.Reblog.Filtered
@@ -1282,6 +1454,11 @@ func (c *Converter) baseStatusToFrontend(
 		return nil, gtserror.Newf("error converting interaction policy: %w", err)
 	}
 
+	// Pick the content variant best matching
+	// the requester's language preferences,
+	// if the status is in multiple languages.
+	content, lang := pickLanguageVariant(status, languagePrefs(requester))
+
 	apiStatus := &apimodel.Status{
 		ID:                 status.ID,
 		CreatedAt:          util.FormatISO8601(status.CreatedAt),
@@ -1296,7 +1473,7 @@ func (c *Converter) baseStatusToFrontend(
 		RepliesCount:       repliesCount,
 		ReblogsCount:       reblogsCount,
 		FavouritesCount:    favesCount,
-		Content:            status.Content,
+		Content:            content,
 		Reblog:             nil, // Set below.
 		Application:        nil, // Set below.
 		Account:            nil, // Caller must do this.
@@ -1304,7 +1481,7 @@ func (c *Converter) baseStatusToFrontend(
 		Mentions:           apiMentions,
 		Tags:               apiTags,
 		Emojis:             apiEmojis,
-		Card:               nil, // TODO: implement cards
+		Card:               c.CardToAPICard(status.Card),
 		Text:               status.Text,
 		ContentType:        ContentTypeToAPIContentType(status.ContentType),
 		InteractionPolicy:  apiInteractionPolicy,
@@ -1320,9 +1497,28 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.EditedAt = util.Ptr(timestamp)
 	}
 
+	if at := status.ExpiresAt; !at.IsZero() {
+		timestamp := util.FormatISO8601(at)
+		apiStatus.ExpiresAt = util.Ptr(timestamp)
+	}
+
+	if status.IsArticle() {
+		// Long-form article; include a summary-only
+		// form of content for clients that prefer
+		// to show a preview instead of full content.
+		apiStatus.Article = true
+		apiStatus.ArticleSummary = articleSummary(status)
+	}
+
 	apiStatus.InReplyToID = util.PtrIf(status.InReplyToID)
 	apiStatus.InReplyToAccountID = util.PtrIf(status.InReplyToAccountID)
-	apiStatus.Language = util.PtrIf(status.Language)
+	apiStatus.Language = util.PtrIf(lang)
+
+	if len(status.ContentMap) > 0 {
+		// Expose all languages the
+		// status is available in.
+		apiStatus.Languages = slices.Sorted(maps.Keys(status.ContentMap))
+	}
 
 	switch {
 	case status.CreatedWithApplication != nil:
@@ -1370,6 +1566,7 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.Favourited = interacts.Favourited
 		apiStatus.Bookmarked = interacts.Bookmarked
 		apiStatus.Muted = interacts.Muted
+		apiStatus.Subscribed = interacts.Subscribed
 		apiStatus.Reblogged = interacts.Reblogged
 		apiStatus.Pinned = interacts.Pinned
 	}
@@ -1508,6 +1705,9 @@ func (c *Converter) StatusToEditHistory(
 					apiPoll.Options[i].VotesCount = &votes
 				}
 			}
+
+			// Set emojis used in this revision of the poll options.
+			apiPoll.Emojis = text.EmojisIn(apiEmojis, edit.PollOptions...)
 		}
 
 		// Set status edit on return slice.
@@ -1581,6 +1781,103 @@ func InstanceRuleToAdminAPIRule(r *gtsmodel.Rule) *apimodel.AdminInstanceRule {
 	}
 }
 
+// AnnouncementToAPIAnnouncement converts the given announcement into its api
+// equivalent, from the perspective of the given requester, who may have read
+// the announcement, and whose own reactions are marked as such.
+func (c *Converter) AnnouncementToAPIAnnouncement(
+	ctx context.Context,
+	announcement *gtsmodel.Announcement,
+	reactions []*gtsmodel.AnnouncementReaction,
+	requesterID string,
+	read bool,
+) *apimodel.Announcement {
+	apiAnnouncement := &apimodel.Announcement{
+		ID:        announcement.ID,
+		Content:   announcement.Content,
+		AllDay:    util.PtrOrZero(announcement.AllDay),
+		UpdatedAt: util.FormatISO8601(announcement.UpdatedAt),
+		Published: announcement.IsPublished(),
+		Read:      read,
+		Mentions:  []apimodel.Mention{},
+		Statuses:  []apimodel.Status{},
+		Tags:      c.tagsToAPI(ctx, announcement.Tags, announcement.TagIDs),
+		Emojis:    c.emojisToAPI(ctx, announcement.Emojis, announcement.EmojiIDs),
+		Reactions: AnnouncementReactionsToAPIReactions(reactions, requesterID),
+	}
+
+	if !announcement.StartsAt.IsZero() {
+		startsAt := util.FormatISO8601(announcement.StartsAt)
+		apiAnnouncement.StartsAt = &startsAt
+	}
+
+	if !announcement.EndsAt.IsZero() {
+		endsAt := util.FormatISO8601(announcement.EndsAt)
+		apiAnnouncement.EndsAt = &endsAt
+	}
+
+	if !announcement.PublishedAt.IsZero() {
+		apiAnnouncement.PublishedAt = util.FormatISO8601(announcement.PublishedAt)
+	}
+
+	return apiAnnouncement
+}
+
+// AnnouncementToAdminAPIAnnouncement converts the given announcement
+// into its admin api equivalent, including the original markdown text.
+func (c *Converter) AnnouncementToAdminAPIAnnouncement(
+	ctx context.Context,
+	announcement *gtsmodel.Announcement,
+	reactions []*gtsmodel.AnnouncementReaction,
+) *apimodel.AdminAnnouncement {
+	return &apimodel.AdminAnnouncement{
+		Announcement: *c.AnnouncementToAPIAnnouncement(ctx,
+			announcement,
+			reactions,
+			"",
+			false,
+		),
+		Text: announcement.Text,
+	}
+}
+
+// AnnouncementReactionsToAPIReactions aggregates the given reactions to
+// an announcement by name, in order of first use, into api reactions.
+// Reactions by the given requester are marked as such.
+func AnnouncementReactionsToAPIReactions(
+	reactions []*gtsmodel.AnnouncementReaction,
+	requesterID string,
+) []apimodel.AnnouncementReaction {
+	apiReactions := make([]apimodel.AnnouncementReaction, 0, len(reactions))
+	indices := make(map[string]int, len(reactions))
+
+	for _, reaction := range reactions {
+		i, ok := indices[reaction.Name]
+		if !ok {
+			// First reaction with this name,
+			// add a new api reaction for it.
+			apiReaction := apimodel.AnnouncementReaction{
+				Name: reaction.Name,
+			}
+
+			if reaction.Emoji != nil {
+				apiReaction.URL = reaction.Emoji.ImageURL
+				apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+
+			i = len(apiReactions)
+			indices[reaction.Name] = i
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReactions[i].Count++
+		if reaction.AccountID == requesterID {
+			apiReactions[i].Me = true
+		}
+	}
+
+	return apiReactions
+}
+
 // InstanceToAPIV1Instance converts a gts instance into its api equivalent for serving at /api/v1/instance
 func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.InstanceV1, error) {
 	domain := i.Domain
@@ -1602,8 +1899,8 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 		Version:              config.GetSoftwareVersion(),
 		Languages:            config.GetInstanceLanguages().TagStrs(),
 		Registrations:        config.GetAccountsRegistrationOpen(),
-		ApprovalRequired:     true,                               // approval always required
-		InvitesEnabled:       false,                              // todo: not supported yet
+		ApprovalRequired:     true, // approval always required
+		InvitesEnabled:       config.GetAccountsInvitesAllowed() != config.AccountsInvitesAllowedNone,
 		MaxTootChars:         uint(config.GetStatusesMaxChars()), // #nosec G115 -- Already validated.
 		Rules:                InstanceRulesToAPIRules(i.Rules),
 		Terms:                i.Terms,
@@ -1623,6 +1920,8 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Statuses.MaxMediaAttachments = config.GetStatusesMediaMaxFiles()
 	instance.Configuration.Statuses.CharactersReservedPerURL = instanceStatusesCharactersReservedPerURL
 	instance.Configuration.Statuses.SupportedMimeTypes = instanceStatusesSupportedMimeTypes
+	instance.Configuration.Statuses.MinExpiration = int(config.GetStatusesExpiryMinDuration().Seconds())
+	instance.Configuration.Statuses.MaxExpiration = int(config.GetStatusesExpiryMaxDuration().Seconds())
 	instance.Configuration.MediaAttachments.SupportedMimeTypes = media.SupportedMIMETypes
 
 	// NOTE: we use the local max sizes here
@@ -1632,13 +1931,14 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 	// TODO: return local / remote depending
 	// on authorized endpoint user (if any)?
 	localMax := config.GetMediaLocalMaxSize()
-	imageSz := cmp.Or(config.GetMediaImageSizeHint(), localMax)
-	videoSz := cmp.Or(config.GetMediaVideoSizeHint(), localMax)
+	imageSz := cmp.Or(config.GetMediaImageSizeHint(), typeMaxSize(config.GetMediaImageMaxSize(), localMax))
+	videoSz := cmp.Or(config.GetMediaVideoSizeHint(), typeMaxSize(config.GetMediaVideoMaxSize(), localMax))
 	instance.Configuration.MediaAttachments.ImageSizeLimit = int(imageSz) // #nosec G115 -- Already validated.
 	instance.Configuration.MediaAttachments.VideoSizeLimit = int(videoSz) // #nosec G115 -- Already validated.
 
-	// we don't actually set any limits on these. set to max possible.
-	instance.Configuration.MediaAttachments.ImageMatrixLimit = math.MaxInt32
+	// image pixels may be limited by config, we don't
+	// actually set limits on the rest so set to max possible.
+	instance.Configuration.MediaAttachments.ImageMatrixLimit = imageMatrixLimit()
 	instance.Configuration.MediaAttachments.VideoFrameRateLimit = math.MaxInt32
 	instance.Configuration.MediaAttachments.VideoMatrixLimit = math.MaxInt32
 
@@ -1803,9 +2103,15 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Statuses.MaxMediaAttachments = config.GetStatusesMediaMaxFiles()
 	instance.Configuration.Statuses.CharactersReservedPerURL = instanceStatusesCharactersReservedPerURL
 	instance.Configuration.Statuses.SupportedMimeTypes = instanceStatusesSupportedMimeTypes
+	instance.Configuration.Statuses.MinExpiration = int(config.GetStatusesExpiryMinDuration().Seconds())
+	instance.Configuration.Statuses.MaxExpiration = int(config.GetStatusesExpiryMaxDuration().Seconds())
 	instance.Configuration.MediaAttachments.SupportedMimeTypes = media.SupportedMIMETypes
 	instance.Configuration.MediaAttachments.DescriptionLimit = config.GetMediaDescriptionMaxChars()
 	instance.Configuration.MediaAttachments.DescriptionMinimum = config.GetMediaDescriptionMinChars()
+	if policy := config.GetMediaDescriptionPolicy(); policy != config.MediaDescriptionPolicyOff {
+		instance.Configuration.MediaAttachments.DescriptionPolicy = policy
+	}
+	instance.Configuration.MediaAttachments.DescriptionSuggestions = config.GetMediaDescribeProvider() != config.MediaDescribeProviderNone
 
 	// NOTE: we use the local max sizes here
 	// as it hints to apps like Tusky for image
@@ -1814,13 +2120,14 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	// TODO: return local / remote depending
 	// on authorized endpoint user (if any)?
 	localMax := config.GetMediaLocalMaxSize()
-	imageSz := cmp.Or(config.GetMediaImageSizeHint(), localMax)
-	videoSz := cmp.Or(config.GetMediaVideoSizeHint(), localMax)
+	imageSz := cmp.Or(config.GetMediaImageSizeHint(), typeMaxSize(config.GetMediaImageMaxSize(), localMax))
+	videoSz := cmp.Or(config.GetMediaVideoSizeHint(), typeMaxSize(config.GetMediaVideoMaxSize(), localMax))
 	instance.Configuration.MediaAttachments.ImageSizeLimit = int(imageSz) // #nosec G115 -- Already validated.
 	instance.Configuration.MediaAttachments.VideoSizeLimit = int(videoSz) // #nosec G115 -- Already validated.
 
-	// we don't actually set any limits on these. set to max possible.
-	instance.Configuration.MediaAttachments.ImageMatrixLimit = math.MaxInt32
+	// image pixels may be limited by config, we don't
+	// actually set limits on the rest so set to max possible.
+	instance.Configuration.MediaAttachments.ImageMatrixLimit = imageMatrixLimit()
 	instance.Configuration.MediaAttachments.VideoFrameRateLimit = math.MaxInt32
 	instance.Configuration.MediaAttachments.VideoMatrixLimit = math.MaxInt32
 
@@ -1833,6 +2140,7 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.MaxProfileFields = config.GetAccountsMaxProfileFields()
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize()) // #nosec G115 -- Already validated.
 	instance.Configuration.OIDCEnabled = config.GetOIDCEnabled()
+	instance.Configuration.Translation.Enabled = config.GetTranslationProvider() != config.TranslationProviderNone
 
 	vapidKeyPair, err := c.state.DB.GetVAPIDKeyPair(ctx)
 	if err != nil {
@@ -1922,12 +2230,24 @@ func (c *Converter) NotificationToAPINotification(
 		}
 	}
 
+	// Get warning that triggered this notif, if set.
+	var apiWarning *apimodel.AccountWarning
+	if notif.AccountWarning != nil {
+		apiWarning, err = c.AccountWarningToAPIAccountWarning(ctx,
+			notif.AccountWarning,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error converting account warning to api: %w", err)
+		}
+	}
+
 	return &apimodel.Notification{
-		ID:        notif.ID,
-		Type:      notif.NotificationType.String(),
-		CreatedAt: util.FormatISO8601(notif.CreatedAt),
-		Account:   apiAccount,
-		Status:    apiStatus,
+		ID:                notif.ID,
+		Type:              notif.NotificationType.String(),
+		CreatedAt:         util.FormatISO8601(notif.CreatedAt),
+		Account:           apiAccount,
+		Status:            apiStatus,
+		ModerationWarning: apiWarning,
 	}, nil
 }
 
@@ -2268,6 +2588,239 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 	}, nil
 }
 
+// AccountWarningToAPIAccountWarning converts a gts model account warning into its
+// api representation, for serving at /api/v1/account_warnings and in notifications.
+func (c *Converter) AccountWarningToAPIAccountWarning(
+	ctx context.Context,
+	warning *gtsmodel.AccountWarning,
+) (*apimodel.AccountWarning, error) {
+	// Ensure warning populated.
+	if err := c.state.DB.PopulateAccountWarning(ctx, warning); err != nil {
+		return nil, gtserror.Newf("error populating account warning: %w", err)
+	}
+
+	apiAccount, err := c.AccountToAPIAccountPublic(ctx, warning.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting target account to api: %w", err)
+	}
+
+	rules := make([]*apimodel.InstanceRule, 0, len(warning.Rules))
+	for _, rule := range warning.Rules {
+		rules = append(rules, &apimodel.InstanceRule{
+			ID:   rule.ID,
+			Text: rule.Text,
+		})
+	}
+
+	// Include appeal against the warning, if any.
+	var apiAppeal *apimodel.Appeal
+	appeal, err := c.state.DB.GetAppealByAccountWarningID(
+		gtscontext.SetBarebones(ctx),
+		warning.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting appeal: %w", err)
+	}
+
+	if appeal != nil {
+		apiAppeal = c.AppealToAPIAppeal(appeal)
+	}
+
+	statusIDs := warning.StatusIDs
+	if statusIDs == nil {
+		statusIDs = []string{}
+	}
+
+	return &apimodel.AccountWarning{
+		ID:            warning.ID,
+		CreatedAt:     util.FormatISO8601(warning.CreatedAt),
+		Action:        warning.Action.String(),
+		Text:          warning.Text,
+		StatusIDs:     statusIDs,
+		Rules:         rules,
+		TargetAccount: apiAccount,
+		Appeal:        apiAppeal,
+	}, nil
+}
+
+// AppealToAPIAppeal converts a gts model appeal into its api representation.
+func (c *Converter) AppealToAPIAppeal(appeal *gtsmodel.Appeal) *apimodel.Appeal {
+	return &apimodel.Appeal{
+		Text:         appeal.Text,
+		State:        appeal.State(),
+		ResponseText: appeal.ResponseText,
+	}
+}
+
+// AppealToAdminAPIAppeal converts a gts model appeal into its admin view
+// api representation, for serving at /api/v1/admin/appeals.
+func (c *Converter) AppealToAdminAPIAppeal(ctx context.Context, appeal *gtsmodel.Appeal) (*apimodel.AdminAppeal, error) {
+	// Ensure appeal populated.
+	if err := c.state.DB.PopulateAppeal(ctx, appeal); err != nil {
+		return nil, gtserror.Newf("error populating appeal: %w", err)
+	}
+
+	account, err := c.AccountToAdminAPIAccount(ctx, appeal.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting account %s to admin api: %w", appeal.AccountID, err)
+	}
+
+	warning, err := c.AccountWarningToAPIAccountWarning(ctx, appeal.AccountWarning)
+	if err != nil {
+		return nil, gtserror.Newf("error converting account warning %s to api: %w", appeal.AccountWarningID, err)
+	}
+
+	var (
+		actionTakenAt        *string
+		actionTakenByAccount *apimodel.AdminAccountInfo
+		responseText         *string
+	)
+
+	if !appeal.IsPending() {
+		ata := util.FormatISO8601(appeal.ActionTakenAt)
+		actionTakenAt = &ata
+	}
+
+	if appeal.ActionTakenByAccount != nil {
+		actionTakenByAccount, err = c.AccountToAdminAPIAccount(ctx, appeal.ActionTakenByAccount)
+		if err != nil {
+			return nil, gtserror.Newf("error converting action taken by account %s to admin api: %w", appeal.ActionTakenByAccountID, err)
+		}
+	}
+
+	if appeal.ResponseText != "" {
+		responseText = &appeal.ResponseText
+	}
+
+	return &apimodel.AdminAppeal{
+		ID:                   appeal.ID,
+		CreatedAt:            util.FormatISO8601(appeal.CreatedAt),
+		UpdatedAt:            util.FormatISO8601(appeal.UpdatedAt),
+		State:                appeal.State(),
+		Text:                 appeal.Text,
+		Account:              account,
+		Warning:              warning,
+		Reversible:           appeal.AccountWarning.Action.IsReversible(),
+		ActionTakenAt:        actionTakenAt,
+		ActionTakenByAccount: actionTakenByAccount,
+		ResponseText:         responseText,
+	}, nil
+}
+
+// InstanceToAdminAPIInstance converts a gts model instance into its admin
+// view api representation, for serving at /api/v1/admin/instances.
+func (c *Converter) InstanceToAdminAPIInstance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.AdminInstance, error) {
+	adminInstance := &apimodel.AdminInstance{
+		ID:                i.ID,
+		Domain:            i.Domain,
+		CreatedAt:         util.FormatISO8601(i.CreatedAt),
+		UsersTotal:        i.UsersTotal,
+		UsersActiveMonth:  i.UsersActiveMonth,
+		OpenRegistrations: i.OpenRegistrations,
+	}
+
+	if !i.SuspendedAt.IsZero() {
+		suspendedAt := util.FormatISO8601(i.SuspendedAt)
+		adminInstance.SuspendedAt = &suspendedAt
+	}
+
+	if i.SoftwareName != "" {
+		adminInstance.SoftwareName = &i.SoftwareName
+	}
+
+	if i.SoftwareVersion != "" {
+		adminInstance.SoftwareVersion = &i.SoftwareVersion
+	}
+
+	if !i.InfoFetchedAt.IsZero() {
+		infoFetchedAt := util.FormatISO8601(i.InfoFetchedAt)
+		adminInstance.InfoFetchedAt = &infoFetchedAt
+	}
+
+	return adminInstance, nil
+}
+
+// RelayToAdminAPIRelay converts a gts model relay, in the given
+// subscription state, into its admin view api representation,
+// for serving at /api/v1/admin/relays.
+func (c *Converter) RelayToAdminAPIRelay(
+	ctx context.Context,
+	relay *gtsmodel.Relay,
+	state gtsmodel.RelayState,
+) (*apimodel.AdminRelay, error) {
+	// Ensure relay populated.
+	if err := c.state.DB.PopulateRelay(ctx, relay); err != nil {
+		return nil, gtserror.Newf("error populating relay: %w", err)
+	}
+
+	adminRelay := &apimodel.AdminRelay{
+		ID:        relay.ID,
+		CreatedAt: util.FormatISO8601(relay.CreatedAt),
+		ActorURI:  relay.Account.URI,
+		InboxURI:  relay.Account.InboxURI,
+		State:     string(state),
+	}
+
+	if relay.CreatedByAccount != nil {
+		createdBy, err := c.AccountToAdminAPIAccount(ctx, relay.CreatedByAccount)
+		if err != nil {
+			return nil, gtserror.Newf("error converting account %s to admin api: %w", relay.CreatedByAccountID, err)
+		}
+		adminRelay.CreatedBy = createdBy
+	}
+
+	return adminRelay, nil
+}
+
+// FailedActivityToAdminAPIFailedActivity converts a gts model failed
+// activity into its admin view api representation, for serving at
+// /api/v1/admin/failed_activities.
+func (c *Converter) FailedActivityToAdminAPIFailedActivity(
+	ctx context.Context,
+	failed *gtsmodel.FailedActivity,
+) (*apimodel.AdminFailedActivity, error) {
+	// Ensure failed activity populated.
+	if err := c.state.DB.PopulateFailedActivity(ctx, failed); err != nil {
+		return nil, gtserror.Newf("error populating failed activity: %w", err)
+	}
+
+	adminFailed := &apimodel.AdminFailedActivity{
+		ID:           failed.ID,
+		CreatedAt:    util.FormatISO8601(failed.CreatedAt),
+		ActivityType: failed.APActivityType,
+		ObjectType:   failed.APObjectType,
+		IRI:          failed.APIRI,
+		Reason:       failed.Reason.String(),
+		Error:        failed.Error,
+		Truncated:    util.PtrOrZero(failed.Truncated),
+	}
+
+	if failed.RequestingAccount != nil {
+		adminFailed.RequestingAccountURI = failed.RequestingAccount.URI
+	}
+
+	if failed.ReceivingAccount != nil {
+		adminFailed.ReceivingAccountUsername = failed.ReceivingAccount.Username
+	}
+
+	// Extract the raw AP object from the
+	// stored serialized worker message.
+	var msg messages.FromFediAPI
+	if err := msg.Deserialize(failed.Data); err != nil {
+		return nil, gtserror.Newf("error deserializing failed activity %s: %w", failed.ID, err)
+	}
+
+	if t, ok := msg.APObject.(vocab.Type); ok {
+		object, err := ap.Serialize(t)
+		if err != nil {
+			return nil, gtserror.Newf("error serializing failed activity %s object: %w", failed.ID, err)
+		}
+		adminFailed.Object = object
+	}
+
+	return adminFailed, nil
+}
+
 // ListToAPIList converts one gts model list into an api model list, for serving at /api/v1/lists/{id}
 func (c *Converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error) {
 	return &apimodel.List{
@@ -2299,6 +2852,80 @@ func (c *Converter) MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.
 	return apiMarker, nil
 }
 
+// BlocklistSubscriptionToAPIBlocklistSubscription converts a database (gtsmodel)
+// BlocklistSubscription into an API model representation.
+func (c *Converter) BlocklistSubscriptionToAPIBlocklistSubscription(
+	sub *gtsmodel.BlocklistSubscription,
+) *apimodel.BlocklistSubscription {
+	apiSub := &apimodel.BlocklistSubscription{
+		ID:          sub.ID,
+		URL:         sub.URI,
+		CreatedAt:   util.FormatISO8601(sub.CreatedAt),
+		BlocksCount: len(sub.TargetURIs),
+	}
+
+	if !sub.FetchedAt.IsZero() {
+		fetchedAt := util.FormatISO8601(sub.FetchedAt)
+		apiSub.FetchedAt = &fetchedAt
+	}
+
+	if sub.Error != "" {
+		apiSub.Error = &sub.Error
+	}
+
+	return apiSub
+}
+
+// CardToAPICard converts a database (gtsmodel) link preview Card
+// into an API model representation. Returns nil if card is nil.
+func (c *Converter) CardToAPICard(card *gtsmodel.Card) *apimodel.Card {
+	if card == nil {
+		return nil
+	}
+
+	// Only serve the locally stored copy
+	// of the preview image, so clients
+	// don't hotlink the linked site.
+	var image string
+	if card.ImageAttachment != nil {
+		image = card.ImageAttachment.URL
+	}
+
+	return &apimodel.Card{
+		URL:          card.URL,
+		Title:        card.Title,
+		Description:  card.Description,
+		Type:         card.Type.String(),
+		AuthorName:   card.AuthorName,
+		AuthorURL:    card.AuthorURL,
+		ProviderName: card.ProviderName,
+		ProviderURL:  card.ProviderURL,
+		HTML:         card.HTML,
+		Width:        card.Width,
+		Height:       card.Height,
+		Image:        image,
+		EmbedURL:     card.EmbedURL,
+	}
+}
+
+// CardTrendToAPITrendsLink converts a database (gtsmodel) CardTrend
+// into an API model representation of a trending link.
+func (c *Converter) CardTrendToAPITrendsLink(trend *gtsmodel.CardTrend) *apimodel.TrendsLink {
+	history := make([]apimodel.History, len(trend.History))
+	for i, shares := range trend.History {
+		history[i] = apimodel.History{
+			Day:      strconv.FormatInt(shares.Day.Unix(), 10),
+			Uses:     strconv.Itoa(shares.Uses),
+			Accounts: strconv.Itoa(shares.Accounts),
+		}
+	}
+
+	return &apimodel.TrendsLink{
+		Card:    *c.CardToAPICard(trend.Card),
+		History: history,
+	}
+}
+
 // PollToAPIPoll converts a database (gtsmodel) Poll into an API model representation appropriate for the given requesting account.
 func (c *Converter) PollToAPIPoll(ctx context.Context, requester *gtsmodel.Account, poll *gtsmodel.Poll) (*apimodel.Poll, error) {
 
@@ -2391,10 +3018,15 @@ func (c *Converter) PollToAPIPoll(ctx context.Context, requester *gtsmodel.Accou
 		expiresAt = &str
 	}
 
-	// Get emojis from parent status.
-	apiEmojis := c.emojisToAPI(ctx,
-		poll.Status.Emojis,
-		poll.Status.EmojiIDs,
+	// Get emojis from parent status,
+	// keeping only those that are used
+	// for rendering the poll options.
+	apiEmojis := text.EmojisIn(
+		c.emojisToAPI(ctx,
+			poll.Status.Emojis,
+			poll.Status.EmojiIDs,
+		),
+		poll.Options...,
 	)
 
 	return &apimodel.Poll{
@@ -2607,6 +3239,17 @@ func (c *Converter) InteractionPolicyToAPIInteractionPolicy(
 		}
 	}
 
+	// gtsmodel CanQuote -> apimodel CanQuote
+	if policy.CanQuote != nil {
+		// Use the set CanQuote value. There's no
+		// default, as we don't support quotes yet,
+		// and the "me" value isn't added for it.
+		apiPolicy.CanQuote = &apimodel.PolicyRules{
+			AutomaticApproval: policyValsToAPIPolicyVals(policy.CanQuote.AutomaticApproval),
+			ManualApproval:    policyValsToAPIPolicyVals(policy.CanQuote.ManualApproval),
+		}
+	}
+
 	if status == nil || requester == nil {
 		// We're done here!
 		return apiPolicy, nil
@@ -2871,6 +3514,7 @@ func (c *Converter) WebPushSubscriptionToAPIWebPushSubscription(
 			PendingFavourite: subscription.NotificationFlags.Get(gtsmodel.NotificationPendingFave),
 			PendingReply:     subscription.NotificationFlags.Get(gtsmodel.NotificationPendingReply),
 			PendingReblog:    subscription.NotificationFlags.Get(gtsmodel.NotificationPendingReblog),
+			ThreadReply:      subscription.NotificationFlags.Get(gtsmodel.NotificationThreadReply),
 		},
 		Policy:   webPushNotificationPolicyToAPIWebPushNotificationPolicy(subscription.Policy),
 		Standard: true,
@@ -2889,6 +3533,11 @@ func (c *Converter) TokenToAPITokenInfo(ctx context.Context, token *gtsmodel.Tok
 		lastUsed = util.FormatISO8601(token.LastUsed)
 	}
 
+	var lastUsedIP string
+	if token.LastUsedIP != nil {
+		lastUsedIP = token.LastUsedIP.String()
+	}
+
 	application, err := c.state.DB.GetApplicationByClientID(ctx, token.ClientID)
 	if err != nil {
 		err := gtserror.Newf("db error getting application with client id %s: %w", token.ClientID, err)
@@ -2898,11 +3547,13 @@ func (c *Converter) TokenToAPITokenInfo(ctx context.Context, token *gtsmodel.Tok
 	apiApplication := AppToAPIAppPublic(application)
 
 	return &apimodel.TokenInfo{
-		ID:          token.ID,
-		CreatedAt:   util.FormatISO8601(createdAt),
-		LastUsed:    lastUsed,
-		Scope:       token.Scope,
-		Application: apiApplication,
+		ID:                token.ID,
+		CreatedAt:         util.FormatISO8601(createdAt),
+		LastUsed:          lastUsed,
+		LastUsedIP:        lastUsedIP,
+		LastUsedUserAgent: token.LastUsedUserAgent,
+		Scope:             token.Scope,
+		Application:       apiApplication,
 	}, nil
 }
 
@@ -2948,6 +3599,124 @@ func (c *Converter) ScheduledStatusToAPIScheduledStatus(ctx context.Context, sta
 	return apiScheduledStatus, nil
 }
 
+// StatusCleanupToAPIStatusCleanup converts a gts model
+// status cleanup into its api (frontend) representation.
+func (c *Converter) StatusCleanupToAPIStatusCleanup(cleanup *gtsmodel.StatusCleanup) *apimodel.StatusCleanup {
+	apiCleanup := &apimodel.StatusCleanup{
+		ID:        cleanup.ID,
+		CreatedAt: util.FormatISO8601(cleanup.CreatedAt),
+		Action:    cleanup.Action.String(),
+		OlderThan: util.FormatISO8601(cleanup.OlderThan),
+		Total:     cleanup.Total,
+		Processed: cleanup.Processed,
+	}
+
+	switch {
+	case !cleanup.CancelledAt.IsZero():
+		apiCleanup.State = "cancelled"
+	case cleanup.IsCompleted():
+		apiCleanup.State = "completed"
+	default:
+		apiCleanup.State = "running"
+	}
+
+	if cleanup.IsCompleted() {
+		completedAt := util.FormatISO8601(cleanup.CompletedAt)
+		apiCleanup.CompletedAt = &completedAt
+	}
+
+	return apiCleanup
+}
+
+// InviteToAPIInvite converts the given invite to its API
+// model representation. If the invite's Account is set,
+// it will be included in the returned model, for admins.
+func (c *Converter) InviteToAPIInvite(
+	ctx context.Context,
+	invite *gtsmodel.Invite,
+) (*apimodel.Invite, error) {
+	apiInvite := &apimodel.Invite{
+		ID:        invite.ID,
+		CreatedAt: util.FormatISO8601(invite.CreatedAt),
+		Code:      invite.Code,
+		URL:       config.GetProtocol() + "://" + config.GetHost() + "/signup?invite=" + url.QueryEscape(invite.Code),
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+		Usable:    invite.IsUsable(time.Now()),
+	}
+
+	if !invite.ExpiresAt.IsZero() {
+		expiresAt := util.FormatISO8601(invite.ExpiresAt)
+		apiInvite.ExpiresAt = &expiresAt
+	}
+
+	if invite.IsRevoked() {
+		revokedAt := util.FormatISO8601(invite.RevokedAt)
+		apiInvite.RevokedAt = &revokedAt
+	}
+
+	if invite.Account != nil {
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, invite.Account)
+		if err != nil {
+			return nil, gtserror.Newf("error converting account %s: %w", invite.AccountID, err)
+		}
+		apiInvite.Account = apiAccount
+	}
+
+	return apiInvite, nil
+}
+
+// CustomDomainToAPICustomDomain converts a gts model account
+// custom domain into its api representation. If admin is true,
+// the account that requested the domain will be included.
+func (c *Converter) CustomDomainToAPICustomDomain(
+	ctx context.Context,
+	domain *gtsmodel.AccountCustomDomain,
+	admin bool,
+) (*apimodel.AccountCustomDomain, error) {
+	// Ensure domain populated.
+	if err := c.state.DB.PopulateAccountCustomDomain(ctx, domain); err != nil {
+		return nil, gtserror.Newf("error populating custom domain: %w", err)
+	}
+
+	// Domain is stored in Punycode,
+	// de-punify it for display.
+	domainStr, err := util.DePunify(domain.Domain)
+	if err != nil {
+		return nil, gtserror.Newf("error de-punifying %s: %w", domain.Domain, err)
+	}
+
+	apiDomain := &apimodel.AccountCustomDomain{
+		ID:                   domain.ID,
+		CreatedAt:            util.FormatISO8601(domain.CreatedAt),
+		Domain:               domainStr,
+		VerificationTXTName:  domain.VerificationTXTName(),
+		VerificationTXTValue: domain.VerificationTXTValue(),
+		VerificationRelMe:    domain.Account.URL,
+		Active:               domain.IsActive(),
+	}
+
+	if domain.IsVerified() {
+		verifiedAt := util.FormatISO8601(domain.VerifiedAt)
+		apiDomain.VerifiedAt = &verifiedAt
+	}
+
+	if domain.IsApproved() {
+		approvedAt := util.FormatISO8601(domain.ApprovedAt)
+		apiDomain.ApprovedAt = &approvedAt
+	}
+
+	if admin {
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, domain.Account)
+		if err != nil {
+			return nil, gtserror.Newf("error converting account %s: %w", domain.AccountID, err)
+		}
+		apiDomain.Account = apiAccount
+	}
+
+	return apiDomain, nil
+}
+
 func (c *Converter) DomainLimitToAPIDomainLimit(
 	ctx context.Context,
 	domainLimit *gtsmodel.DomainLimit,
@@ -2976,6 +3745,8 @@ func (c *Converter) DomainLimitToAPIDomainLimit(
 		mediaPolicy = apimodel.MediaPolicyMarkSensitive
 	case gtsmodel.MediaPolicyReject:
 		mediaPolicy = apimodel.MediaPolicyReject
+	case gtsmodel.MediaPolicySensitiveBlurhashOnly:
+		mediaPolicy = apimodel.MediaPolicySensitiveBlurhashOnly
 	default:
 		err := gtserror.Newf("unknown media policy %d", p)
 		return nil, err
@@ -3023,18 +3794,31 @@ func (c *Converter) DomainLimitToAPIDomainLimit(
 		return nil, err
 	}
 
+	// Derive federation policy.
+	var federationPolicy apimodel.FederationPolicy
+	switch p := domainLimit.FederationPolicy; p {
+	case gtsmodel.FederationPolicyNoAction:
+		federationPolicy = apimodel.FederationPolicyNoAction
+	case gtsmodel.FederationPolicyPause:
+		federationPolicy = apimodel.FederationPolicyPause
+	default:
+		err := gtserror.Newf("unknown federation policy %d", p)
+		return nil, err
+	}
+
 	return &apimodel.DomainLimit{
-		ID:             domainLimit.ID,
-		Domain:         domain,
-		MediaPolicy:    mediaPolicy,
-		FollowsPolicy:  followsPolicy,
-		StatusesPolicy: statusesPolicy,
-		AccountsPolicy: accountsPolicy,
-		ContentWarning: domainLimit.ContentWarning,
-		PublicComment:  util.PtrIf(domainLimit.PublicComment),
-		PrivateComment: util.PtrIf(domainLimit.PrivateComment),
-		CreatedBy:      domainLimit.CreatedByAccountID,
-		CreatedAt:      util.FormatISO8601(createdAt),
+		ID:               domainLimit.ID,
+		Domain:           domain,
+		MediaPolicy:      mediaPolicy,
+		FollowsPolicy:    followsPolicy,
+		StatusesPolicy:   statusesPolicy,
+		AccountsPolicy:   accountsPolicy,
+		FederationPolicy: federationPolicy,
+		ContentWarning:   domainLimit.ContentWarning,
+		PublicComment:    util.PtrIf(domainLimit.PublicComment),
+		PrivateComment:   util.PtrIf(domainLimit.PrivateComment),
+		CreatedBy:        domainLimit.CreatedByAccountID,
+		CreatedAt:        util.FormatISO8601(createdAt),
 	}, nil
 }
 
@@ -3102,8 +3886,13 @@ func (c *Converter) attachmentsToAPI(
 	if len(apiModels) != len(attachments) {
 		panic(gtserror.New("bound check elimination"))
 	}
+	cc := getConvCache(ctx)
 	for i, media := range attachments {
-		apiModel := AttachmentToAPIAttachment(media)
+		apiModel, ok := cc.getAttachment(media.ID)
+		if !ok {
+			apiModel = AttachmentToAPIAttachment(media)
+			cc.putAttachment(media.ID, apiModel)
+		}
 		apiModels[i] = &apiModel
 	}
 
@@ -3134,8 +3923,15 @@ func (c *Converter) emojisToAPI(
 
 	// Preallocate a biggest-case slice of frontend emojis.
 	apiModels := make([]apimodel.Emoji, 0, len(emojis))
+	cc := getConvCache(ctx)
 	for _, emoji := range emojis {
 
+		// Check for already converted emoji.
+		if apiModel, ok := cc.getEmoji(emoji.ID); ok {
+			apiModels = append(apiModels, apiModel)
+			continue
+		}
+
 		// Convert each database emoji to API model.
 		apiModel, err := c.EmojiToAPIEmoji(ctx, emoji)
 		if err != nil {
@@ -3143,6 +3939,9 @@ func (c *Converter) emojisToAPI(
 			continue
 		}
 
+		// Store in conversion cache.
+		cc.putEmoji(emoji.ID, apiModel)
+
 		// Append API model to the return slice.
 		apiModels = append(apiModels, apiModel)
 	}
@@ -3174,8 +3973,15 @@ func (c *Converter) mentionsToAPI(
 
 	// Preallocate a biggest-case slice of frontend mentions.
 	apiModels := make([]apimodel.Mention, 0, len(mentions))
+	cc := getConvCache(ctx)
 	for _, mention := range mentions {
 
+		// Check for already converted mention of target.
+		if apiModel, ok := cc.getMention(mention.TargetAccountID); ok {
+			apiModels = append(apiModels, apiModel)
+			continue
+		}
+
 		// Convert each database mention to frontend API model.
 		apiModel, err := c.MentionToAPIMention(ctx, mention)
 		if err != nil {
@@ -3183,6 +3989,9 @@ func (c *Converter) mentionsToAPI(
 			continue
 		}
 
+		// Store in conversion cache.
+		cc.putMention(mention.TargetAccountID, apiModel)
+
 		// Append API model to the return slice.
 		apiModels = append(apiModels, apiModel)
 	}
@@ -24,6 +24,7 @@
 	"strings"
 	"testing"
 
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
 	"code.superseriousbusiness.org/gotosocial/internal/db"
 	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
@@ -132,7 +133,11 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendAliasedAndMoved()
     "web_visibility": "unlisted",
     "web_layout": "microblog",
     "web_include_boosts": true,
+    "web_activity_heatmap": true,
+    "rss_full_content": false,
     "sensitive": false,
+    "preview_links": true,
+    "media_description_policy": "off",
     "language": "en",
     "status_content_type": "text/plain",
     "note": "hey yo this is my profile!",
@@ -338,7 +343,11 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendSensitive() {
     "web_visibility": "unlisted",
     "web_layout": "microblog",
     "web_include_boosts": true,
+    "web_activity_heatmap": true,
+    "rss_full_content": false,
     "sensitive": false,
+    "preview_links": true,
+    "media_description_policy": "off",
     "language": "en",
     "status_content_type": "text/plain",
     "note": "hey yo this is my profile!",
@@ -481,6 +490,57 @@ func (suite *InternalToFrontendTestSuite) TestLocalInstanceAccountToFrontendBloc
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestStatusToFrontendConversionCache() {
+	var (
+		ctx               = typeutils.WithConversionCache(suite.T().Context())
+		requestingAccount = suite.testAccounts["local_account_1"]
+		status1           = suite.testStatuses["admin_account_status_1"]
+		status2           = suite.testStatuses["admin_account_status_2"]
+	)
+
+	apiStatus1, err := suite.typeconverter.StatusToAPIStatus(ctx, status1, requestingAccount)
+	suite.NoError(err)
+
+	apiStatus2, err := suite.typeconverter.StatusToAPIStatus(ctx, status2, requestingAccount)
+	suite.NoError(err)
+
+	// Author should be converted the same for both statuses,
+	// and equal to a conversion done without the cache.
+	apiAccount, err := suite.typeconverter.AccountToAPIAccountPublic(suite.T().Context(), status1.Account)
+	suite.NoError(err)
+	suite.Equal(apiAccount, apiStatus1.Account)
+	suite.Equal(apiAccount, apiStatus2.Account)
+
+	// But each status should still get its own
+	// copy of the account, to allow modification.
+	suite.NotSame(apiStatus1.Account, apiStatus2.Account)
+	apiStatus1.Account.DisplayName = "changed"
+	suite.Equal(apiAccount.DisplayName, apiStatus2.Account.DisplayName)
+}
+
+func (suite *InternalToFrontendTestSuite) TestStatusesToFrontend() {
+	var (
+		ctx               = suite.T().Context()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		statuses          = []*gtsmodel.Status{
+			suite.testStatuses["admin_account_status_1"],
+			suite.testStatuses["local_account_1_status_1"],
+			suite.testStatuses["local_account_2_status_1"],
+		}
+	)
+
+	apiStatuses := suite.typeconverter.StatusesToAPIStatuses(ctx, requestingAccount, statuses)
+	suite.Len(apiStatuses, len(statuses))
+
+	// Each status should be converted the
+	// same as when converted on its own.
+	for i, status := range statuses {
+		expect, err := suite.typeconverter.StatusToAPIStatus(ctx, status, requestingAccount)
+		suite.NoError(err)
+		suite.Equal(expect, apiStatuses[i])
+	}
+}
+
 func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
 	testStatus := suite.testStatuses["admin_account_status_1"]
 	requestingAccount := suite.testAccounts["local_account_1"]
@@ -1056,6 +1116,24 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownAttachments
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestArticleStatusToFrontend() {
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["remote_account_1_status_1"]
+	testStatus.ActivityStreamsType = ap.ObjectArticle
+	testStatus.Title = "Some <article> title"
+	testStatus.ContentWarning = "<p>A short summary of this article.</p>"
+
+	requestingAccount := suite.testAccounts["local_account_1"]
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(suite.T().Context(), testStatus, requestingAccount)
+	suite.NoError(err)
+
+	// Full content should be kept,
+	// with summary given separately.
+	suite.True(apiStatus.Article)
+	suite.Equal(testStatus.Content, apiStatus.Content)
+	suite.Equal(`<p><strong>Some &lt;article&gt; title</strong></p><p>A short summary of this article.</p><p><a href="`+testStatus.URL+`" rel="nofollow noreferrer noopener" target="_blank">`+testStatus.URL+`</a></p>`, apiStatus.ArticleSummary)
+}
+
 func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
 	testStatus := suite.testStatuses["remote_account_2_status_1"]
 
@@ -1704,7 +1782,9 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
-      ]
+      ],
+      "min_expiration": 300,
+      "max_expiration": 31536000
     },
     "media_attachments": {
       "supported_mime_types": [
@@ -1864,7 +1944,9 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
-      ]
+      ],
+      "min_expiration": 300,
+      "max_expiration": 31536000
     },
     "media_attachments": {
       "supported_mime_types": [
@@ -1980,6 +2062,23 @@ func (suite *InternalToFrontendTestSuite) TestEmojiToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestEmojiToFrontendStaticOnly() {
+	config.SetMediaEmojiStaticOnly(true)
+	defer config.SetMediaEmojiStaticOnly(false)
+
+	emoji, err := suite.typeconverter.EmojiToAPIEmoji(suite.T().Context(), suite.testEmojis["rainbow"])
+	suite.NoError(err)
+
+	// Both URLs should point to the static image.
+	suite.Equal("http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/static/01F8MH9H8E4VG3KDYJR9EGPXCQ.png", emoji.URL)
+	suite.Equal(emoji.StaticURL, emoji.URL)
+
+	// Admin view should still show the original.
+	adminEmoji, err := suite.typeconverter.EmojiToAdminAPIEmoji(suite.T().Context(), suite.testEmojis["rainbow"])
+	suite.NoError(err)
+	suite.Equal("http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png", adminEmoji.URL)
+}
+
 func (suite *InternalToFrontendTestSuite) TestEmojiToFrontendAdmin1() {
 	emoji, err := suite.typeconverter.EmojiToAdminAPIEmoji(suite.T().Context(), suite.testEmojis["rainbow"])
 	suite.NoError(err)
@@ -2277,7 +2376,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "557041",
       "highlighted": true
     },
     "confirmed": true,
@@ -2335,7 +2434,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "557041",
       "highlighted": true
     },
     "confirmed": true,
@@ -2792,7 +2891,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "557041",
       "highlighted": true
     },
     "confirmed": true,
@@ -2850,7 +2949,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "id": "admin",
       "name": "admin",
       "color": "",
-      "permissions": "546033",
+      "permissions": "557041",
       "highlighted": true
     },
     "confirmed": true,
@@ -3828,6 +3927,7 @@ func (suite *InternalToFrontendTestSuite) TestDomainLimitToAPIDomainLimit() {
     "follows_policy": "reject_non_mutual",
     "statuses_policy": "filter_warn",
     "accounts_policy": "no_action",
+    "federation_policy": "no_action",
     "content_warning": "potentially annoying post ahead",
     "public_comment": "they're kind of annoying",
     "private_comment": "they're actually really annoying I just wanna be coy about it",
@@ -19,6 +19,9 @@
 
 import (
 	"context"
+	"html"
+	"mime"
+	"path"
 	"strconv"
 	"strings"
 
@@ -34,8 +37,12 @@
 	rssDescriptionMaxRunes = 256
 )
 
+// StatusToRSSItem converts the given status to an RSS feed item.
+// If fullContent is set, all of the status' media attachments are
+// also embedded in the item content, following the post HTML.
+//
 // see https://cyber.harvard.edu/rss/rss.html
-func (c *Converter) StatusToRSSItem(ctx context.Context, status *gtsmodel.Status) (*feeds.Item, error) {
+func (c *Converter) StatusToRSSItem(ctx context.Context, status *gtsmodel.Status, fullContent bool) (*feeds.Item, error) {
 	var err error
 
 	// Ensure account populated.
@@ -46,14 +53,11 @@ func (c *Converter) StatusToRSSItem(ctx context.Context, status *gtsmodel.Status
 		}
 	}
 
-	// Get first attachment if present.
-	var media0 *gtsmodel.MediaAttachment
-	if status.AttachmentsPopulated() && len(status.Attachments) > 0 {
-		media0 = status.Attachments[0]
-	} else if len(status.AttachmentIDs) > 0 {
-		media0, err = c.state.DB.GetAttachmentByID(ctx, status.AttachmentIDs[0])
+	// Ensure attachments populated.
+	if !status.AttachmentsPopulated() {
+		status.Attachments, err = c.state.DB.GetAttachmentsByIDs(ctx, status.AttachmentIDs)
 		if err != nil {
-			return nil, gtserror.Newf("db error getting status attachment: %w", err)
+			return nil, gtserror.Newf("db error getting status attachments: %w", err)
 		}
 	}
 
@@ -67,8 +71,19 @@ func (c *Converter) StatusToRSSItem(ctx context.Context, status *gtsmodel.Status
 	}
 
 	// Generate author name string for status.
+	authorDomain := status.Account.Domain
+	if authorDomain == "" {
+		authorDomain = config.GetAccountDomain()
+	}
 	authorName := "@" + status.Account.Username +
-		"@" + config.GetAccountDomain()
+		"@" + authorDomain
+
+	// Source -- The RSS channel that the item came from.
+	// Only local accounts have a feed we can point to.
+	var source *feeds.Link
+	if status.Account.IsLocal() {
+		source = &feeds.Link{Href: status.Account.URL + "/feed.rss"}
+	}
 
 	var buf strings.Builder
 	buf.Grow(512)
@@ -95,21 +110,26 @@ func (c *Converter) StatusToRSSItem(ctx context.Context, status *gtsmodel.Status
 	description := trimTo(buf.String(), rssDescriptionMaxRunes)
 
 	// Enclosure, describes a media object
-	// that is attached to the item.
+	// that is attached to the item. RSS
+	// only allows one per item, so use
+	// the first attachment we can serve.
 	var enclosure *feeds.Enclosure
-
-	// Set media details.
-	if media0 != nil {
-		enclosure = new(feeds.Enclosure)
-		enclosure.Type = media0.File.ContentType
-		enclosure.Length = strconv.Itoa(media0.File.FileSize)
-		enclosure.Url = media0.URL
+	for _, media := range status.Attachments {
+		if enclosure = rssEnclosure(media); enclosure != nil {
+			break
+		}
 	}
 
 	// Generate emojified content.
 	apiEmojis := c.emojisToAPI(ctx, status.Emojis, status.EmojiIDs)
 	content := text.EmojifyRSS(apiEmojis, status.Content)
 
+	if fullContent {
+		// Append all media to the content,
+		// as only one fits in the enclosure.
+		content += rssMediaHTML(status.Attachments)
+	}
+
 	return &feeds.Item{
 		// we specifcally do not set the author, as a lot
 		// of feed readers rely on the RSS standard of the
@@ -122,8 +142,7 @@ func (c *Converter) StatusToRSSItem(ctx context.Context, status *gtsmodel.Status
 		// example: http://inessential.com/2002/09/01.php#a2
 		Id: status.URL,
 
-		// Source -- The RSS channel that the item came from.
-		Source: &feeds.Link{Href: status.Account.URL + "/feed.rss"},
+		Source: source,
 
 		// Link -- The URL of the item.
 		// example: http://nytimes.com/2004/12/07FEST.html
@@ -139,6 +158,60 @@ func (c *Converter) StatusToRSSItem(ctx context.Context, status *gtsmodel.Status
 	}, nil
 }
 
+// rssEnclosure returns an RSS enclosure for the given
+// media attachment, or nil if the attachment has no
+// servable file with known content type and size.
+func rssEnclosure(media *gtsmodel.MediaAttachment) *feeds.Enclosure {
+	if media.Type == gtsmodel.FileTypeUnknown ||
+		media.URL == "" || media.File.FileSize <= 0 {
+		return nil
+	}
+
+	// Prefer the stored content type,
+	// falling back to the file extension.
+	contentType := media.File.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(media.URL))
+		if contentType == "" {
+			return nil
+		}
+	}
+
+	return &feeds.Enclosure{
+		Url:    media.URL,
+		Length: strconv.Itoa(media.File.FileSize),
+		Type:   contentType,
+	}
+}
+
+// rssMediaHTML returns HTML embedding the given media
+// attachments, to be appended to RSS item content.
+func rssMediaHTML(attachments []*gtsmodel.MediaAttachment) string {
+	var buf strings.Builder
+	for _, media := range attachments {
+		if media.Type == gtsmodel.FileTypeUnknown || media.URL == "" {
+			continue
+		}
+
+		src := html.EscapeString(media.URL)
+		alt := html.EscapeString(media.Description)
+
+		buf.WriteString("<p>")
+		switch media.Type {
+		case gtsmodel.FileTypeImage:
+			buf.WriteString(`<img src="` + src + `" alt="` + alt + `" />`)
+		case gtsmodel.FileTypeVideo, gtsmodel.FileTypeGifv:
+			buf.WriteString(`<video src="` + src + `" title="` + alt + `" controls></video>`)
+		case gtsmodel.FileTypeAudio:
+			buf.WriteString(`<audio src="` + src + `" title="` + alt + `" controls></audio>`)
+		default:
+			buf.WriteString(`<a href="` + src + `">` + src + `</a>`)
+		}
+		buf.WriteString("</p>")
+	}
+	return buf.String()
+}
+
 // trimTo trims the given `in` string to
 // the length `to`, measured in runes.
 //
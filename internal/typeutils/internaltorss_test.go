@@ -33,7 +33,7 @@ type InternalToRSSTestSuite struct {
 
 func (suite *InternalToRSSTestSuite) TestStatusToRSSItem1() {
 	s := suite.testStatuses["local_account_1_status_1"]
-	item, err := suite.typeconverter.StatusToRSSItem(suite.T().Context(), s)
+	item, err := suite.typeconverter.StatusToRSSItem(suite.T().Context(), s, false)
 	suite.NoError(err)
 
 	suite.Equal("introduction post", item.Title)
@@ -54,7 +54,7 @@ func (suite *InternalToRSSTestSuite) TestStatusToRSSItem1() {
 
 func (suite *InternalToRSSTestSuite) TestStatusToRSSItem2() {
 	s := suite.testStatuses["admin_account_status_1"]
-	item, err := suite.typeconverter.StatusToRSSItem(suite.T().Context(), s)
+	item, err := suite.typeconverter.StatusToRSSItem(suite.T().Context(), s, false)
 	suite.NoError(err)
 
 	suite.Equal("hello world! #welcome ! first post on the instance :rainbow: !", item.Title)
@@ -75,6 +75,33 @@ func (suite *InternalToRSSTestSuite) TestStatusToRSSItem2() {
 	suite.Equal("<p>hello world! <a href=\"http://localhost:8080/tags/welcome\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>welcome</span></a> ! first post on the instance <img src=\"http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png\" title=\":rainbow:\" alt=\":rainbow:\" width=\"25\" height=\"25\" /> !</p>", item.Content)
 }
 
+func (suite *InternalToRSSTestSuite) TestStatusToRSSItemFullContent() {
+	s := suite.testStatuses["admin_account_status_1"]
+	item, err := suite.typeconverter.StatusToRSSItem(suite.T().Context(), s, true)
+	suite.NoError(err)
+
+	// Enclosure is still set, and the
+	// media is also embedded in content.
+	suite.Equal("62529", item.Enclosure.Length)
+	suite.Equal("image/jpeg", item.Enclosure.Type)
+	suite.Equal("http://localhost:8080/fileserver/01F8MH17FWEB39HZJ76B6VXSKF/attachment/original/01F8MH6NEM8D7527KZAECTCR76.jpg", item.Enclosure.Url)
+	suite.Equal("<p>hello world! <a href=\"http://localhost:8080/tags/welcome\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>welcome</span></a> ! first post on the instance <img src=\"http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png\" title=\":rainbow:\" alt=\":rainbow:\" width=\"25\" height=\"25\" /> !</p><p><img src=\"http://localhost:8080/fileserver/01F8MH17FWEB39HZJ76B6VXSKF/attachment/original/01F8MH6NEM8D7527KZAECTCR76.jpg\" alt=\"Black and white image of some 50&#39;s style text saying: Welcome On Board\" /></p>", item.Content)
+}
+
+func (suite *InternalToRSSTestSuite) TestStatusToRSSItemMultipleMedia() {
+	s := suite.testStatuses["local_account_3_status_2"]
+	item, err := suite.typeconverter.StatusToRSSItem(suite.T().Context(), s, true)
+	suite.NoError(err)
+
+	// First attachment is the enclosure,
+	// all attachments are in the content.
+	suite.Equal(s.Attachments[0].URL, item.Enclosure.Url)
+	suite.Equal(s.Attachments[0].File.ContentType, item.Enclosure.Type)
+	for _, media := range s.Attachments {
+		suite.Contains(item.Content, media.URL)
+	}
+}
+
 func (suite *InternalToRSSTestSuite) TestStatusToRSSItem3() {
 	account := suite.testAccounts["admin_account"]
 	s := &gtsmodel.Status{
@@ -90,7 +117,7 @@ func (suite *InternalToRSSTestSuite) TestStatusToRSSItem3() {
 		ActivityStreamsType: ap.ObjectNote,
 		Federated:           util.Ptr(true),
 	}
-	item, err := suite.typeconverter.StatusToRSSItem(suite.T().Context(), s)
+	item, err := suite.typeconverter.StatusToRSSItem(suite.T().Context(), s, false)
 	suite.NoError(err)
 
 	data, err := xml.MarshalIndent(item, "", "  ")
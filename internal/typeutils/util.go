@@ -20,6 +20,8 @@
 import (
 	"context"
 	"errors"
+	"html"
+	"maps"
 	"math"
 	"net/url"
 	"path"
@@ -36,6 +38,7 @@
 	"code.superseriousbusiness.org/gotosocial/internal/language"
 	"code.superseriousbusiness.org/gotosocial/internal/regexes"
 	"code.superseriousbusiness.org/gotosocial/internal/text"
+	"codeberg.org/gruf/go-bytesize"
 )
 
 // toAPISize converts a set of media dimensions
@@ -46,6 +49,26 @@ func toAPISize(width, height int) string {
 		strconv.Itoa(height)
 }
 
+// typeMaxSize returns the effective max size for
+// local uploads of one media type, i.e. the lower
+// of the per-type max (if set) and local max size.
+func typeMaxSize(typeMax, localMax bytesize.Size) bytesize.Size {
+	if typeMax > 0 && typeMax < localMax {
+		return typeMax
+	}
+	return localMax
+}
+
+// imageMatrixLimit returns the max number of pixels
+// allowed in an image, as advertised on the instance
+// endpoints, or max possible value when not limited.
+func imageMatrixLimit() int {
+	if mp := config.GetMediaImageMaxMegapixels(); mp > 0 {
+		return min(mp*1_000_000, math.MaxInt32)
+	}
+	return math.MaxInt32
+}
+
 // toAPIFrameRate converts a media framerate ptr
 // to mastodon API compatible framerate string.
 func toAPIFrameRate(framerate *float32) string {
@@ -61,6 +84,7 @@ func toAPIFrameRate(framerate *float32) string {
 type statusInteractions struct {
 	Favourited bool
 	Muted      bool
+	Subscribed bool
 	Bookmarked bool
 	Reblogged  bool
 	Pinned     bool
@@ -83,6 +107,11 @@ func (c *Converter) interactionsWithStatusForAccount(ctx context.Context, s *gts
 			return si, gtserror.Newf("error checking if requesting account has muted status: %s", err)
 		}
 
+		si.Subscribed, err = c.state.DB.IsThreadSubscribedByAccount(ctx, s.ThreadID, requestingAccount.ID)
+		if err != nil {
+			return si, gtserror.Newf("error checking if requesting account has subscribed to status thread: %s", err)
+		}
+
 		si.Bookmarked, err = c.state.DB.IsStatusBookmarkedBy(ctx, requestingAccount.ID, s.ID)
 		if err != nil {
 			return si, gtserror.Newf("error checking if requesting account has bookmarked status: %s", err)
@@ -230,6 +259,35 @@ func (c *Converter) pendingReplyNote(
 	return systemMessage("gts-pending-reply", note.String()), nil
 }
 
+// articleSummary returns a summary-only HTML form of the
+// content of the given long-form article status, made
+// up of its title, summary, and a link to the article.
+func articleSummary(s *gtsmodel.Status) string {
+	var summary strings.Builder
+
+	if s.Title != "" {
+		summary.WriteString(`<p><strong>`)
+		summary.WriteString(html.EscapeString(s.Title))
+		summary.WriteString(`</strong></p>`)
+	}
+
+	if s.ContentWarning != "" {
+		summary.WriteString(`<p>`)
+		summary.WriteString(html.EscapeString(text.ParseHTMLToPlain(s.ContentWarning)))
+		summary.WriteString(`</p>`)
+	}
+
+	if s.URL != "" {
+		link := html.EscapeString(s.URL)
+		summary.WriteString(`<p><a href="` + link + `" `)
+		summary.WriteString(`rel="nofollow noreferrer noopener" target="_blank">`)
+		summary.WriteString(link)
+		summary.WriteString(`</a></p>`)
+	}
+
+	return text.SanitizeHTML(summary.String())
+}
+
 // systemMessage wraps a note with a div with semantic classes that aren't allowed through the sanitizer,
 // but may be emitted to the client as an addition to the status's actual content.
 // Clients may want to display these specially or suppress them in favor of their own UI.
@@ -348,3 +406,98 @@ func ContentToContentLanguage(
 
 	return contentStr, langTagStr
 }
+
+// ContentToLanguageVariants returns the contentMap of
+// given intermediary content keyed by normalized BCP47
+// language tags, dropping entries with unparseable tags.
+// This returns nil when there are fewer than two language
+// variants, as the status content alone then suffices.
+func ContentToLanguageVariants(
+	ctx context.Context,
+	content gtsmodel.Content,
+) map[string]string {
+	if len(content.ContentMap) < 2 {
+		return nil
+	}
+
+	variants := make(map[string]string, len(content.ContentMap))
+	for langTagStr, contentStr := range content.ContentMap {
+		if contentStr == "" {
+			continue
+		}
+
+		lang, err := language.Parse(langTagStr)
+		if err != nil {
+			log.Warnf(
+				ctx,
+				"could not parse %s as BCP47 language tag in status contentMap: %v",
+				langTagStr, err,
+			)
+			continue
+		}
+
+		variants[lang.TagStr] = contentStr
+	}
+
+	if len(variants) < 2 {
+		return nil
+	}
+
+	return variants
+}
+
+// languagePrefs returns the language preferences of
+// the given requester (which may be nil), in order of
+// preference, followed by the instance's languages.
+func languagePrefs(requester *gtsmodel.Account) []string {
+	var prefs []string
+	if requester != nil && requester.Settings != nil {
+		prefs = append(prefs, requester.Settings.ChosenLanguages...)
+		prefs = append(prefs, requester.Settings.Language)
+	}
+	return append(prefs, config.GetInstanceLanguages().TagStrs()...)
+}
+
+// pickLanguageVariant selects the status content variant
+// that best matches the given language preferences, in
+// order. A variant matches a preferred language exactly,
+// or failing that, by primary subtag, eg., "en-GB" and
+// "en". If nothing matches, the status' primary content
+// and language are returned.
+func pickLanguageVariant(
+	status *gtsmodel.Status,
+	prefs []string,
+) (
+	string, // content
+	string, // language
+) {
+	if len(status.ContentMap) == 0 {
+		return status.Content, status.Language
+	}
+
+	primary := func(tag string) string {
+		p, _, _ := strings.Cut(tag, "-")
+		return strings.ToLower(p)
+	}
+
+	for _, pref := range prefs {
+		if pref == "" {
+			continue
+		}
+
+		// Check for an exact match.
+		if content, ok := status.ContentMap[pref]; ok {
+			return content, pref
+		}
+
+		// Check for primary subtag match, sorting
+		// candidates for stable results across calls.
+		for _, tag := range slices.Sorted(maps.Keys(status.ContentMap)) {
+			if primary(tag) == primary(pref) {
+				return status.ContentMap[tag], tag
+			}
+		}
+	}
+
+	return status.Content, status.Language
+}
@@ -156,3 +156,89 @@ type testcase struct {
 		}
 	}
 }
+
+func TestContentToLanguageVariants(t *testing.T) {
+	ctx := t.Context()
+
+	// Single language, no variants.
+	variants := ContentToLanguageVariants(ctx, gtsmodel.Content{
+		ContentMap: map[string]string{"en": "hello world"},
+	})
+	if variants != nil {
+		t.Errorf("expected nil variants, got %v", variants)
+	}
+
+	// Multiple languages, with normalized tags,
+	// dropping unparseable and empty entries.
+	variants = ContentToLanguageVariants(ctx, gtsmodel.Content{
+		ContentMap: map[string]string{
+			"EN-gb":     "hello world",
+			"fr":        "bonjour le monde",
+			"nl":        "",
+			"not a tag": "???",
+		},
+	})
+	if len(variants) != 2 ||
+		variants["en-GB"] != "hello world" ||
+		variants["fr"] != "bonjour le monde" {
+		t.Errorf("unexpected variants %v", variants)
+	}
+}
+
+func TestPickLanguageVariant(t *testing.T) {
+	status := &gtsmodel.Status{
+		Content:  "hello world",
+		Language: "en-GB",
+		ContentMap: map[string]string{
+			"en-GB": "hello world",
+			"fr":    "bonjour le monde",
+			"nl":    "hallo wereld",
+		},
+	}
+
+	for i, testcase := range []struct {
+		prefs           []string
+		expectedContent string
+		expectedLang    string
+	}{
+		{
+			// No preferences, primary content.
+			prefs:           nil,
+			expectedContent: "hello world",
+			expectedLang:    "en-GB",
+		},
+		{
+			// Exact match.
+			prefs:           []string{"fr", "nl"},
+			expectedContent: "bonjour le monde",
+			expectedLang:    "fr",
+		},
+		{
+			// Primary subtag match.
+			prefs:           []string{"de", "nl-BE"},
+			expectedContent: "hallo wereld",
+			expectedLang:    "nl",
+		},
+		{
+			// No match, primary content.
+			prefs:           []string{"de"},
+			expectedContent: "hello world",
+			expectedLang:    "en-GB",
+		},
+	} {
+		content, lang := pickLanguageVariant(status, testcase.prefs)
+		if content != testcase.expectedContent {
+			t.Errorf(
+				"test %d expected content '%s' got '%s'",
+				i, testcase.expectedContent, content,
+			)
+		}
+
+		if lang != testcase.expectedLang {
+			t.Errorf(
+				"test %d expected language '%s' got '%s'",
+				i, testcase.expectedLang, lang,
+			)
+		}
+	}
+}
@@ -36,6 +36,7 @@
 	LikedPath            = "liked"             // LikedPath represents the activitypub liked location
 	CollectionsPath      = "collections"       // CollectionsPath represents the activitypub collections location
 	FeaturedPath         = "featured"          // FeaturedPath represents the activitypub featured location
+	BlocklistPath        = "blocklist"         // BlocklistPath represents the activitypub published blocklist location
 	PublicKeyPath        = "main-key"          // PublicKeyPath is for serving an account's public key
 	FollowPath           = "follow"            // FollowPath used to generate the URI for an individual follow or follow request
 	UpdatePath           = "updates"           // UpdatePath is used to generate the URI for an account update
@@ -43,6 +44,7 @@
 	MovesPath            = "moves"             // MovesPath is used to generate the URI for a move
 	ReportsPath          = "reports"           // ReportsPath is used to generate the URI for a report/flag
 	ConfirmEmailPath     = "confirm_email"     // ConfirmEmailPath is used to generate the URI for an email confirmation link
+	ResetPasswordPath    = "reset_password"    // ResetPasswordPath is used to generate the URI for a password reset link
 	FileserverPath       = "fileserver"        // FileserverPath is a path component for serving attachments + media
 	EmojiPath            = "emoji"             // EmojiPath represents the activitypub emoji location
 	TagsPath             = "tags"              // TagsPath represents the activitypub tags location
@@ -102,6 +104,10 @@ type UserURIs struct {
 	// eg., https://example.org/users/example_user/collections/featured
 	FeaturedCollectionURI string
 
+	// The activitypub URI for this user's published blocklist,
+	// eg., https://example.org/users/example_user/collections/blocklist
+	BlocklistURI string
+
 	// The URI for this user's public key,
 	// eg., https://example.org/users/example_user/publickey
 	PublicKeyURI string
@@ -200,6 +206,14 @@ func GenerateURIForEmailConfirm(token string) string {
 	return buildURL1(proto, host, ConfirmEmailPath) + "?token=" + token
 }
 
+// GenerateURIForPasswordReset returns a link for password reset -- something like:
+// https://example.org/reset_password?token=490e337c-0162-454f-ac48-4b22bb92a205
+func GenerateURIForPasswordReset(token string) string {
+	proto := config.GetProtocol()
+	host := config.GetHost()
+	return buildURL1(proto, host, ResetPasswordPath) + "?token=" + token
+}
+
 // GenerateURIForAccept returns the AP URI for a new Accept activity -- something like:
 // https://example.org/users/whatever_user/accepts/01F7XTH1QGBAPMGF49WJZ91XGC
 func GenerateURIForAccept(username string, thisAcceptID string) string {
@@ -305,6 +319,7 @@ func GenerateURIsForAccount(username string) UserURIs {
 	followingURI := userURI + "/" + FollowingPath
 	likedURI := userURI + "/" + LikedPath
 	collectionURI := userURI + "/" + CollectionsPath + "/" + FeaturedPath
+	blocklistURI := userURI + "/" + CollectionsPath + "/" + BlocklistPath
 	publicKeyURI := userURI + "/" + PublicKeyPath
 
 	return UserURIs{
@@ -320,6 +335,7 @@ func GenerateURIsForAccount(username string) UserURIs {
 		FollowingURI:          followingURI,
 		LikedURI:              likedURI,
 		FeaturedCollectionURI: collectionURI,
+		BlocklistURI:          blocklistURI,
 		PublicKeyURI:          publicKeyURI,
 	}
 }
@@ -330,15 +346,26 @@ func GenerateURIsForAccount(username string) UserURIs {
 // Will produce something like:
 //
 //	"https://example.org/fileserver/01FPST95B8FC3HG3AGCDKPQNQ2/attachment/original/01FPST9QK4V5XWS3F9Z4F2G1X7.gif"
+//
+// Or, if a version is given (eg., derived from the file
+// contents), something like the following. The version
+// isn't part of the storage path, it only changes the URL
+// whenever the file changes, so that caches fetch it anew:
+//
+//	"https://example.org/fileserver/01FPST95B8FC3HG3AGCDKPQNQ2/attachment/original/5d41402abc4b2a76/01FPST9QK4V5XWS3F9Z4F2G1X7.gif"
 func URIForAttachment(
 	accountID string,
 	mediaType string,
 	mediaSize string,
 	mediaID string,
+	version string,
 	extension string,
 ) string {
 	proto := config.GetProtocol()
 	host := config.GetHost()
+	if version != "" {
+		mediaSize += "/" + version
+	}
 	return buildURL5(proto,
 		host,
 		FileserverPath,
@@ -21,6 +21,7 @@
 	"errors"
 	"fmt"
 	"net/mail"
+	"time"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	"code.superseriousbusiness.org/gotosocial/internal/config"
@@ -31,20 +32,24 @@
 )
 
 const (
-	maximumPasswordLength         = 72 // 72 bytes is the maximum length afforded by bcrypt. See https://pkg.go.dev/golang.org/x/crypto/bcrypt#GenerateFromPassword.
-	minimumPasswordEntropy        = 60 // Heuristic for password strength. See https://github.com/wagslane/go-password-validator.
-	minimumReasonLength           = 40
-	maximumReasonLength           = 500
-	maximumSiteTitleLength        = 40
-	maximumShortDescriptionLength = 500
-	maximumDescriptionLength      = 5000
-	maximumSiteTermsLength        = 5000
-	maximumUsernameLength         = 64
-	maximumEmojiCategoryLength    = 64
-	maximumProfileFieldLength     = 255
-	maximumListTitleLength        = 200
-	maximumFilterKeywordLength    = 40
-	maximumFilterTitleLength      = 200
+	maximumPasswordLength            = 72 // 72 bytes is the maximum length afforded by bcrypt. See https://pkg.go.dev/golang.org/x/crypto/bcrypt#GenerateFromPassword.
+	minimumPasswordEntropy           = 60 // Heuristic for password strength. See https://github.com/wagslane/go-password-validator.
+	minimumReasonLength              = 40
+	maximumReasonLength              = 500
+	maximumSiteTitleLength           = 40
+	maximumShortDescriptionLength    = 500
+	maximumDescriptionLength         = 5000
+	maximumSiteTermsLength           = 5000
+	maximumUsernameLength            = 64
+	maximumEmojiCategoryLength       = 64
+	maximumProfileFieldLength        = 255
+	maximumPronounsLength            = 40
+	maximumLocationLength            = 100
+	maximumListTitleLength           = 200
+	maximumBookmarkFolderTitleLength = 200
+	maximumFilterKeywordLength       = 40
+	maximumFilterTitleLength         = 200
+	maximumAnnouncementLength        = 5000
 )
 
 // Password returns a helpful error if the given password
@@ -151,6 +156,22 @@ func Note(note string) error {
 	return nil
 }
 
+// Pronouns checks that the given profile pronouns are valid.
+func Pronouns(pronouns string) error {
+	if length := len([]rune(pronouns)); length > maximumPronounsLength {
+		return fmt.Errorf("pronouns should be no more than %d chars but given pronouns were %d", maximumPronounsLength, length)
+	}
+	return nil
+}
+
+// Location checks that the given profile location is valid.
+func Location(location string) error {
+	if length := len([]rune(location)); length > maximumLocationLength {
+		return fmt.Errorf("location should be no more than %d chars but given location was %d", maximumLocationLength, length)
+	}
+	return nil
+}
+
 // Privacy checks that the desired privacy setting is valid
 func Privacy(privacy string) error {
 	if privacy == "" {
@@ -175,6 +196,25 @@ func StatusContentType(statusContentType string) error {
 	return fmt.Errorf("status content type '%s' was not recognized, valid options are 'text/plain', 'text/markdown'", statusContentType)
 }
 
+// StatusExpiresIn checks that the desired default status expiry,
+// in seconds, is either 0 (no expiry) or within configured bounds.
+func StatusExpiresIn(seconds int) error {
+	if seconds == 0 {
+		return nil
+	}
+
+	expiresIn := time.Duration(seconds) * time.Second
+	if minExpiresIn := config.GetStatusesExpiryMinDuration(); seconds < 0 || expiresIn < minExpiresIn {
+		return fmt.Errorf("status expiry must be 0 or at least %s", minExpiresIn)
+	}
+
+	if maxExpiresIn := config.GetStatusesExpiryMaxDuration(); maxExpiresIn > 0 && expiresIn > maxExpiresIn {
+		return fmt.Errorf("status expiry must be at most %s", maxExpiresIn)
+	}
+
+	return nil
+}
+
 func CustomCSS(customCSS string) error {
 	if !config.GetAccountsAllowCustomCSS() {
 		return errors.New("accounts-allow-custom-css is not enabled for this instance")
@@ -302,6 +342,32 @@ func ListTitle(title string) error {
 	return nil
 }
 
+// BookmarkFolderTitle validates the title of a new or updated bookmark folder.
+func BookmarkFolderTitle(title string) error {
+	if title == "" {
+		return fmt.Errorf("bookmark folder title must be provided, and must be no more than %d chars", maximumBookmarkFolderTitleLength)
+	}
+
+	if length := len([]rune(title)); length > maximumBookmarkFolderTitleLength {
+		return fmt.Errorf("bookmark folder title length must be no more than %d chars, provided title was %d chars", maximumBookmarkFolderTitleLength, length)
+	}
+
+	return nil
+}
+
+// AnnouncementText validates the text of a new or updated announcement.
+func AnnouncementText(text string) error {
+	if text == "" {
+		return fmt.Errorf("announcement text must be provided, and must be no more than %d chars", maximumAnnouncementLength)
+	}
+
+	if length := len([]rune(text)); length > maximumAnnouncementLength {
+		return fmt.Errorf("announcement text length must be no more than %d chars, provided text was %d chars", maximumAnnouncementLength, length)
+	}
+
+	return nil
+}
+
 // ListRepliesPolicy validates the replies_policy of a new or updated list.
 func ListRepliesPolicy(repliesPolicy gtsmodel.RepliesPolicy) error {
 	switch repliesPolicy {
@@ -406,7 +472,10 @@ func CreateAccount(form *apimodel.AccountCreateRequest) error {
 		return errors.New("form was nil")
 	}
 
-	if !config.GetAccountsRegistrationOpen() {
+	// If registration is closed, sign-up is
+	// only possible with an invite. Whether the
+	// invite is actually usable is checked later.
+	if !config.GetAccountsRegistrationOpen() && form.InviteCode == "" {
 		return errors.New("registration is not open for this server")
 	}
 
@@ -77,7 +77,11 @@ func (m *Module) domainBlocklistGETHandler(c *gin.Context) {
 		Instance:    instance,
 		OGMeta:      apiutil.OGBase(instance),
 		Stylesheets: []string{cssFA},
-		Extra:       map[string]any{"blocklist": domainBlocks},
+		Extra: map[string]any{
+			"blocklist":    domainBlocks,
+			"showComments": config.GetInstanceExposeBlocklistComments(),
+			"exposeJSON":   config.GetInstanceExposeBlocklist(),
+		},
 	}
 
 	apiutil.TemplateWebPage(c, page)
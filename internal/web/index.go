@@ -30,6 +30,19 @@
 )
 
 func (m *Module) indexHandler(c *gin.Context) {
+	// If the request came in at the custom domain of
+	// an account, redirect to that account's profile.
+	profileURL, errWithCode := m.processor.Account().CustomDomainProfileURLGet(c.Request.Context(), c.Request.Host)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if profileURL != "" {
+		c.Redirect(http.StatusFound, profileURL)
+		return
+	}
+
 	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
 	if errWithCode != nil {
 		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -22,6 +22,7 @@
 	"maps"
 	"net/http"
 	"net/url"
+	"time"
 
 	"code.superseriousbusiness.org/gopkg/log"
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
@@ -43,6 +44,70 @@ type profile struct {
 	paging            bool
 	includeBoostsLink string
 	excludeBoostsLink string
+	activityHeatmap   *activityHeatmap
+}
+
+// activityHeatmap is the web
+// view of an account's activity.
+type activityHeatmap struct {
+	// Total public posts in the period.
+	Total int
+
+	// Days in the period, oldest first, preceded by
+	// padding days (with empty Day) so that the first
+	// day of the period lands on its day of the week
+	// when laid out in columns of seven from Sunday.
+	Days []heatmapDay
+}
+
+type heatmapDay struct {
+	Day      string
+	Statuses int
+
+	// Intensity of this day relative to the
+	// busiest day in the period, from 0 to 4.
+	Level int
+}
+
+// newActivityHeatmap prepares the given
+// days of activity for templating.
+func newActivityHeatmap(days []*apimodel.AccountActivityDay) *activityHeatmap {
+	if len(days) == 0 {
+		return nil
+	}
+
+	// Pad up to the weekday of the first day.
+	first, err := time.Parse(time.DateOnly, days[0].Day)
+	if err != nil {
+		return nil
+	}
+	padding := int(first.Weekday())
+
+	heatmap := &activityHeatmap{
+		Days: make([]heatmapDay, padding, padding+len(days)),
+	}
+
+	var busiest int
+	for _, day := range days {
+		heatmap.Total += day.Statuses
+		busiest = max(busiest, day.Statuses)
+	}
+
+	for _, day := range days {
+		var level int
+		if day.Statuses > 0 {
+			// Round up so that any activity is visible.
+			level = (4*day.Statuses + busiest - 1) / busiest
+		}
+
+		heatmap.Days = append(heatmap.Days, heatmapDay{
+			Day:      day.Day,
+			Statuses: day.Statuses,
+			Level:    level,
+		})
+	}
+
+	return heatmap
 }
 
 // prepareProfile does content type checks, fetches the
@@ -125,10 +190,7 @@ func (m *Module) prepareProfile(c *gin.Context) *profile {
 	// Since we serve the profile and posts together,
 	// only allow search robots
 	// if account is discoverable *and* indexable.
-	var robotsMeta string
-	if account.Discoverable && account.Indexable {
-		robotsMeta = apiutil.RobotsDirectivesAllowSome
-	}
+	robotsMeta := robotsDirectives(c, account.Discoverable && account.Indexable)
 
 	// Check if paging.
 	maxStatusID := apiutil.ParseMaxID(c.Query(apiutil.MaxIDKey), "")
@@ -190,6 +252,19 @@ func (m *Module) prepareProfile(c *gin.Context) *profile {
 	// the include_boosts param removed so default (true) is used.
 	includeBoostsLink, excludeBoostsLink := includeExcludeBoostsLinks(c, statusResp)
 
+	// Only show activity heatmap
+	// if account has enabled it.
+	var heatmap *activityHeatmap
+	if account.WebActivityHeatmap {
+		days, errWithCode := m.processor.Account().ActivityGet(ctx, nil, account.ID)
+		if errWithCode != nil {
+			// Not critical, render the profile anyway.
+			log.Errorf(ctx, "error getting account activity: %v", errWithCode)
+		} else {
+			heatmap = newActivityHeatmap(days)
+		}
+	}
+
 	return &profile{
 		instance:          instance,
 		account:           account,
@@ -200,6 +275,7 @@ func (m *Module) prepareProfile(c *gin.Context) *profile {
 		paging:            doPaging,
 		includeBoostsLink: includeBoostsLink,
 		excludeBoostsLink: excludeBoostsLink,
+		activityHeatmap:   heatmap,
 	}
 }
 
@@ -331,6 +407,7 @@ func (m *Module) profileMicroblog(c *gin.Context, p *profile) {
 			"show_back_to_top":  p.paging,
 			"includeBoostsLink": p.includeBoostsLink,
 			"excludeBoostsLink": p.excludeBoostsLink,
+			"activityHeatmap":   p.activityHeatmap,
 		},
 	}
 
@@ -415,6 +492,7 @@ func (m *Module) profileGallery(c *gin.Context, p *profile) {
 			"show_back_to_top":   p.paging,
 			"includeBoostsLink":  p.includeBoostsLink,
 			"excludeBoostsLink":  p.excludeBoostsLink,
+			"activityHeatmap":    p.activityHeatmap,
 		},
 	}
 
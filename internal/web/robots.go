@@ -0,0 +1,39 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
+	"github.com/gin-gonic/gin"
+)
+
+// robotsDirectives sets the X-Robots-Tag header on the response
+// to match the given indexability of the page being served, and
+// returns the same directives for use in the page's robots meta.
+//
+// Web handlers are routed behind middleware that only sets the
+// noai headers, so this adds indexing directives to those.
+func robotsDirectives(c *gin.Context, indexable bool) string {
+	directives := apiutil.RobotsDirectivesDisallow
+	if indexable {
+		directives = apiutil.RobotsDirectivesAllowSome
+	}
+
+	c.Writer.Header().Add("X-Robots-Tag", directives)
+	return directives
+}
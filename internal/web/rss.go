@@ -178,6 +178,85 @@ func (m *Module) rssFeedGETHandler(c *gin.Context) {
 	}
 
 	// Encode response.
+	writeFeed(c, contentType, feed)
+}
+
+// tagFeedGETHandler serves the feed of
+// public posts with the given tag name.
+func (m *Module) tagFeedGETHandler(c *gin.Context, tagName string) {
+	m.timelineFeedGETHandler(c, func(page *paging.Page) (*feeds.Feed, gtserror.WithCode) {
+		return m.processor.Timeline().TagFeedGet(c.Request.Context(), tagName, page)
+	})
+}
+
+// localFeedGETHandler serves the feed
+// of public posts by local accounts.
+func (m *Module) localFeedGETHandler(c *gin.Context) {
+	m.timelineFeedGETHandler(c, func(page *paging.Page) (*feeds.Feed, gtserror.WithCode) {
+		return m.processor.Timeline().LocalFeedGet(c.Request.Context(), page)
+	})
+}
+
+// timelineFeedGETHandler serves the feed returned by getFeed
+// for the requested page. Unlike account feeds these are not
+// kept in the ETag cache, as they have no cheap last-modified
+// time to check freshness against; instead an ETag is generated
+// for each response, which is still checked for a 304 response.
+func (m *Module) timelineFeedGETHandler(
+	c *gin.Context,
+	getFeed func(*paging.Page) (*feeds.Feed, gtserror.WithCode),
+) {
+	contentType, err := apiutil.NegotiateAccept(c,
+		apiutil.AppRSSXML,
+		apiutil.AppAtomXML,
+		apiutil.AppFeedJSON,
+		apiutil.AppJSON,
+	)
+	if err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Parse paging parameters from request.
+	page, errWithCode := paging.ParseIDPage(c,
+		1,  // min limit
+		40, // max limit
+		20, // default limit
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	feed, errWithCode := getFeed(page)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	etag, genErr := generateFeedETag(feed, contentType)
+	if genErr != nil {
+		errWithCode := gtserror.NewErrorInternalError(genErr)
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header(eTagHeader, etag)
+	c.Header(cacheControlHeader, cacheControlNoCache)
+
+	// Check if caller has already seen this version.
+	if c.Request.Header.Get(ifNoneMatchHeader) == etag {
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	// Encode response.
+	writeFeed(c, contentType, feed)
+}
+
+// writeFeed writes the given feed to the response
+// in the appropriate encoding for content-type.
+func writeFeed(c *gin.Context, contentType string, feed *feeds.Feed) {
 	switch contentType {
 	case apiutil.AppRSSXML:
 		apiutil.XMLType(c, http.StatusOK, appRSSUTF8, (&feeds.Rss{feed}).FeedXml())
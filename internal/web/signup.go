@@ -61,6 +61,7 @@ func (m *Module) signupGETHandler(c *gin.Context) {
 			"oidcEnabled":      config.GetOIDCEnabled(),
 			"registrationOpen": config.GetAccountsRegistrationOpen(),
 			"reasonRequired":   config.GetAccountsReasonRequired(),
+			"inviteCode":       c.Query("invite"),
 		},
 	}
 
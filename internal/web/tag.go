@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"strings"
 
 	apimodel "code.superseriousbusiness.org/gotosocial/internal/api/model"
 	apiutil "code.superseriousbusiness.org/gotosocial/internal/api/util"
@@ -43,6 +44,13 @@ func (m *Module) tagGETHandler(c *gin.Context) {
 		return instance, nil
 	}
 
+	// Serve the tag's feed for "/tags/:tag_name.rss".
+	// Hashtags can't contain dots, so this is unambiguous.
+	if tagName, ok := strings.CutSuffix(c.Param(apiutil.TagNameKey), ".rss"); ok {
+		m.tagFeedGETHandler(c, tagName)
+		return
+	}
+
 	// We only serve text/html at this endpoint.
 	if _, errWithCode := apiutil.NegotiateAccept(c, apiutil.TextHTML); errWithCode != nil {
 		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
@@ -108,10 +108,7 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 	}
 
 	// If every account in the thread is indexable, then we allow the thread page to be indexed.
-	var robotsMeta string
-	if context.Indexable {
-		robotsMeta = apiutil.RobotsDirectivesAllowSome
-	}
+	robotsMeta := robotsDirectives(c, context.Indexable)
 
 	// Prepare stylesheets for thread.
 	stylesheets := make([]string, 0, 6)
@@ -34,12 +34,15 @@
 
 const (
 	confirmEmailPath         = "/" + uris.ConfirmEmailPath
+	resetPasswordPath        = "/" + uris.ResetPasswordPath
 	profileGroupPath         = "/@:username"
 	statusPath               = "/statuses/:" + apiutil.IDKey // leave out the '/@:username' prefix as this will be served within the profile group
 	tagsPath                 = "/tags/:" + apiutil.TagNameKey
 	customCSSPath            = profileGroupPath + "/custom.css"
 	instanceCustomCSSPath    = "/custom.css"
 	rssFeedPath              = profileGroupPath + "/feed.rss"
+	localFeedPath            = "/local.rss"
+	sitemapPath              = "/sitemap.xml"
 	assetsPathPrefix         = "/assets"
 	distPathPrefix           = assetsPathPrefix + "/dist"
 	themesPathPrefix         = assetsPathPrefix + "/themes"
@@ -120,8 +123,12 @@ func (m *Module) Route(r *router.Router, mi ...gin.HandlerFunc) {
 	everythingElseGroup.Handle(http.MethodGet, customCSSPath, m.customCSSGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, instanceCustomCSSPath, m.instanceCustomCSSGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, rssFeedPath, m.rssFeedGETHandler)
+	everythingElseGroup.Handle(http.MethodGet, localFeedPath, m.localFeedGETHandler)
+	everythingElseGroup.Handle(http.MethodGet, sitemapPath, m.sitemapGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, confirmEmailPath, m.confirmEmailGETHandler)
 	everythingElseGroup.Handle(http.MethodPost, confirmEmailPath, m.confirmEmailPOSTHandler)
+	everythingElseGroup.Handle(http.MethodGet, resetPasswordPath, m.resetPasswordGETHandler)
+	everythingElseGroup.Handle(http.MethodPost, resetPasswordPath, m.resetPasswordPOSTHandler)
 	everythingElseGroup.Handle(http.MethodGet, aboutPath, m.aboutGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, loginPath, m.loginGETHandler)
 	everythingElseGroup.Handle(http.MethodGet, domainBlocklistPath, m.domainBlocklistGETHandler)
@@ -344,6 +344,14 @@ func formatNotificationTitle(
 		return displayNameOrAcct + " submitted a report"
 	case gtsmodel.NotificationUpdate:
 		return displayNameOrAcct + " updated their post"
+	case gtsmodel.NotificationModerationWarning:
+		return "You have received a moderation warning"
+	case gtsmodel.NotificationAppealApproved:
+		return "Your appeal has been approved"
+	case gtsmodel.NotificationAppealRejected:
+		return "Your appeal has been rejected"
+	case gtsmodel.NotificationThreadReply:
+		return displayNameOrAcct + " replied in a thread you follow"
 	default:
 		log.Warnf(ctx, "Unknown notification type: %d", notification.NotificationType)
 		return displayNameOrAcct + " did something (unknown notification type)"
@@ -360,7 +368,12 @@ func formatNotificationBody(apiNotification *apimodel.Notification) string {
 	const bodyMaxLen = 1500
 
 	var body string
-	if apiNotification.Status != nil {
+	if w := apiNotification.ModerationWarning; w != nil {
+		body = w.Text
+		if w.Appeal != nil && w.Appeal.ResponseText != "" {
+			body = w.Appeal.ResponseText
+		}
+	} else if apiNotification.Status != nil {
 		if apiNotification.Status.SpoilerText != "" {
 			body = apiNotification.Status.SpoilerText
 		} else {
@@ -124,6 +124,8 @@ func (suite *RealSenderStandardTestSuite) SetupTest() {
 		&suite.state,
 		suite.emailSender,
 		suite.webPushSender,
+		testrig.NewTestTranslator(),
+		testrig.NewTestDescriber(),
 		visibility.NewFilter(&suite.state),
 		mutes.NewFilter(&suite.state),
 		interaction.NewFilter(&suite.state),
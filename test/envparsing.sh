@@ -5,13 +5,25 @@ set -eu
 EXPECT=$(cat << "EOF"
 {
     "account-domain": "peepee",
+    "account-domain-aliases": [
+        "brand-a.com",
+        "brand-b.com"
+    ],
     "accounts-allow-custom-css": true,
+    "accounts-allow-custom-domains": true,
     "accounts-custom-css-length": 5000,
+    "accounts-follow-request-expiry": 2592000000000000,
+    "accounts-invites-allowed": "moderators",
+    "accounts-login-history-retention": 2592000000000000,
+    "accounts-login-notification-emails": true,
     "accounts-max-profile-fields": 8,
+    "accounts-profile-media-history": 5,
     "accounts-reason-required": false,
     "accounts-registration-backlog-limit": 100,
     "accounts-registration-daily-limit": 50,
     "accounts-registration-open": true,
+    "accounts-stats-recount-every": 86400000000000,
+    "accounts-suspension-grace-period": 604800000000000,
     "advanced-cookies-samesite": "strict",
     "advanced-csp-extra-uris": [],
     "advanced-header-filter-mode": "block",
@@ -25,14 +37,17 @@ EXPECT=$(cat << "EOF"
     "advanced-throttling-retry-after": 10000000000,
     "application-name": "gts",
     "bind-address": "127.0.0.1",
+    "cache-account-activity-mem-ratio": 0.1,
     "cache-account-mem-ratio": 5,
     "cache-account-note-mem-ratio": 1,
     "cache-account-settings-mem-ratio": 0.1,
     "cache-account-stats-mem-ratio": 2,
+    "cache-account-usage-mem-ratio": 0.1,
     "cache-application-mem-ratio": 0.1,
     "cache-block-ids-mem-ratio": 3,
     "cache-block-mem-ratio": 2,
     "cache-boost-of-ids-mem-ratio": 3,
+    "cache-card-mem-ratio": 0.5,
     "cache-client-mem-ratio": 0.1,
     "cache-conversation-last-status-ids-mem-ratio": 2,
     "cache-conversation-mem-ratio": 1,
@@ -52,6 +67,7 @@ EXPECT=$(cat << "EOF"
     "cache-following-tag-ids-mem-ratio": 2,
     "cache-home-account-ids-mem-ratio": 2,
     "cache-home-timeline-timeout": 21600000000000,
+    "cache-idempotency-mem-ratio": 0.1,
     "cache-in-reply-to-ids-mem-ratio": 3,
     "cache-instance-mem-ratio": 1,
     "cache-interaction-request-mem-ratio": 1,
@@ -80,9 +96,11 @@ EXPECT=$(cat << "EOF"
     "cache-status-fave-mem-ratio": 2,
     "cache-status-filter-mem-ratio": 7,
     "cache-status-mem-ratio": 5,
+    "cache-status-replies-backfill-mem-ratio": 0.1,
     "cache-tag-mem-ratio": 2,
     "cache-tag-timeline-timeout": 600000000000,
     "cache-thread-mute-mem-ratio": 0.2,
+    "cache-thread-subscription-mem-ratio": 0.2,
     "cache-token-mem-ratio": 0.75,
     "cache-tombstone-mem-ratio": 0.5,
     "cache-user-mem-ratio": 0.25,
@@ -92,6 +110,7 @@ EXPECT=$(cat << "EOF"
     "cache-web-push-subscription-ids-mem-ratio": 1,
     "cache-web-push-subscription-mem-ratio": 1,
     "cache-webfinger-mem-ratio": 0.1,
+    "client-fixtures": false,
     "config-path": "internal/config/testdata/test.yaml",
     "db-address": ":memory:",
     "db-database": "gotosocial_prod",
@@ -107,30 +126,60 @@ EXPECT=$(cat << "EOF"
     "db-tls-mode": "disable",
     "db-type": "sqlite",
     "db-user": "sex-haver",
+    "domain": "",
     "dry-run": true,
     "email": "",
+    "event-bus-provider": "nats",
+    "event-bus-subject-prefix": "gotosocial",
+    "event-bus-url": "nats://localhost:4222",
     "host": "example.com",
     "http-client-allow-ips": [],
     "http-client-block-ips": [],
+    "http-client-delivery-timeout": 30000000000,
+    "http-client-dereference-timeout": 30000000000,
+    "http-client-disable-http2": false,
+    "http-client-dns-cache-negative-ttl": 30000000000,
+    "http-client-dns-cache-ttl": 300000000000,
+    "http-client-happy-eyeballs-delay": 300000000,
+    "http-client-idle-conn-timeout": 90000000000,
     "http-client-insecure-outgoing": false,
+    "http-client-ip-family-preference": "auto",
+    "http-client-ipv4-only-domains": [],
+    "http-client-max-idle-conns-per-host": 2,
+    "http-client-media-timeout": 120000000000,
     "http-client-timeout": 30000000000,
     "http-client-tls-insecure-skip-verify": false,
+    "http-client-webfinger-timeout": 15000000000,
+    "id": "",
+    "instance-activity-mode": "serve",
     "instance-allow-backdating-statuses": true,
     "instance-deliver-to-shared-inboxes": false,
+    "instance-experimental-c2s": true,
     "instance-expose-allowlist": true,
     "instance-expose-allowlist-web": true,
     "instance-expose-blocklist": true,
+    "instance-expose-blocklist-comments": false,
     "instance-expose-blocklist-web": true,
     "instance-expose-custom-emojis": true,
+    "instance-expose-local-feed": true,
     "instance-expose-peers": true,
     "instance-expose-public-timeline": true,
+    "instance-expose-tag-feeds": true,
+    "instance-federation-failed-activity-ttl": 43200000000000,
     "instance-federation-mode": "allowlist",
+    "instance-federation-seen-activity-ttl": 86400000000000,
     "instance-federation-spam-filter": true,
     "instance-inject-mastodon-version": true,
+    "instance-integrity-proofs-sign": true,
+    "instance-integrity-proofs-verify": true,
     "instance-languages": [
         "nl",
         "en-GB"
     ],
+    "instance-peers-crawl-every": 86400000000000,
+    "instance-peers-mode": "anonymize",
+    "instance-relay-endpoint": false,
+    "instance-sitemap-generate-every": 21600000000000,
     "instance-stats-mode": "baffle",
     "instance-subscriptions-process-every": 86400000000000,
     "instance-subscriptions-process-from": "23:00",
@@ -145,18 +194,40 @@ EXPECT=$(cat << "EOF"
     "log-format": "json",
     "log-level": "info",
     "log-timestamp-format": "banana",
+    "media-audio-max-bitrate": 320000,
+    "media-audio-max-duration": 600000000000,
+    "media-audio-max-size": "420B",
     "media-cleanup-every": 86400000000000,
     "media-cleanup-from": "00:00",
+    "media-describe-command": "tesseract",
+    "media-describe-concurrency": 1,
+    "media-describe-endpoint": "",
+    "media-describe-provider": "",
+    "media-describe-timeout": 30000000000,
     "media-description-max-chars": 5000,
     "media-description-min-chars": 69,
+    "media-description-policy": "off",
     "media-emoji-local-max-size": "420B",
     "media-emoji-remote-max-size": "420B",
+    "media-emoji-static-only": true,
     "media-ffmpeg-pool-size": 8,
+    "media-image-max-megapixels": 42,
+    "media-image-max-size": "420B",
     "media-image-size-hint": "5.00MiB",
     "media-local-max-size": "420B",
+    "media-local-quota": "1.00GiB",
+    "media-preserve-color-profile": true,
     "media-remote-cache-days": 30,
     "media-remote-max-size": "420B",
+    "media-remote-sensitive-blurhash-only": true,
     "media-thumb-max-pixels": 42069,
+    "media-variant-cache-size": "32.0MiB",
+    "media-variant-formats": [
+        "webp"
+    ],
+    "media-video-max-bitrate": 8000000,
+    "media-video-max-duration": 300000000000,
+    "media-video-max-size": "420B",
     "media-video-size-hint": "40.0MiB",
     "metrics-enabled": false,
     "oidc-admin-groups": [
@@ -180,8 +251,10 @@ EXPECT=$(cat << "EOF"
     "path": "",
     "port": 6969,
     "protocol": "http",
+    "query": "",
     "remote-only": false,
     "request-id-header": "X-Trace-Id",
+    "role": "",
     "scheduled-statuses-max-daily": 25,
     "scheduled-statuses-max-total": 300,
     "skip-db-setup": false,
@@ -193,10 +266,21 @@ EXPECT=$(cat << "EOF"
     "smtp-port": 4269,
     "smtp-username": "sex-haver",
     "software-version": "",
+    "statuses-backfill-replies": false,
+    "statuses-backfill-replies-cooldown": 1800000000000,
+    "statuses-backfill-replies-max-depth": 5,
+    "statuses-backfill-replies-max-statuses": 100,
+    "statuses-cleanup-interval": 2000000000,
+    "statuses-expiry-max-duration": 31536000000000000,
+    "statuses-expiry-min-duration": 300000000000,
+    "statuses-link-previews": true,
+    "statuses-link-previews-remote": false,
+    "statuses-link-previews-ttl": 604800000000000,
     "statuses-max-chars": 69,
     "statuses-media-max-files": 1,
     "statuses-poll-max-options": 1,
     "statuses-poll-option-max-chars": 50,
+    "statuses-syntax-highlighting": true,
     "storage-backend": "local",
     "storage-local-base-path": "/root/store",
     "storage-s3-access-key": "minio",
@@ -204,8 +288,12 @@ EXPECT=$(cat << "EOF"
     "storage-s3-bucket-lookup": "auto",
     "storage-s3-endpoint": "localhost:9000",
     "storage-s3-key-prefix": "",
+    "storage-s3-multipart-part-size": "16.0MiB",
+    "storage-s3-multipart-retries": 3,
+    "storage-s3-multipart-threshold": "32.0MiB",
     "storage-s3-proxy": true,
     "storage-s3-redirect-url": "",
+    "storage-s3-remote-cache-tag": "",
     "storage-s3-secret-key": "miniostorage",
     "storage-s3-use-ssl": false,
     "syslog-address": "127.0.0.1:6969",
@@ -214,6 +302,10 @@ EXPECT=$(cat << "EOF"
     "tls-certificate-chain": "",
     "tls-certificate-key": "",
     "tracing-enabled": false,
+    "translation-api-key": "",
+    "translation-endpoint": "",
+    "translation-provider": "",
+    "translation-rate-limit": 30,
     "trusted-proxies": [
         "127.0.0.1/32",
         "docker.host.local"
@@ -236,6 +328,7 @@ GTS_APPLICATION_NAME=gts \
 GTS_LANDING_PAGE_USER=admin \
 GTS_HOST=example.com \
 GTS_ACCOUNT_DOMAIN='peepee' \
+GTS_ACCOUNT_DOMAIN_ALIASES='brand-a.com,brand-b.com' \
 GTS_PROTOCOL=http \
 GTS_BIND_ADDRESS='127.0.0.1' \
 GTS_PORT=6969 \
@@ -259,34 +352,66 @@ GTS_WEB_ASSET_BASE_DIR='/root' \
 GTS_INSTANCE_EXPOSE_PEERS=true \
 GTS_INSTANCE_EXPOSE_BLOCKLIST=true \
 GTS_INSTANCE_EXPOSE_BLOCKLIST_WEB=true \
+GTS_INSTANCE_EXPOSE_BLOCKLIST_COMMENTS=false \
 GTS_INSTANCE_EXPOSE_ALLOWLIST=true \
 GTS_INSTANCE_EXPOSE_ALLOWLIST_WEB=true \
 GTS_INSTANCE_EXPOSE_CUSTOM_EMOJIS=true \
 GTS_INSTANCE_EXPOSE_PUBLIC_TIMELINE=true \
+GTS_INSTANCE_EXPOSE_TAG_FEEDS=true \
+GTS_INSTANCE_EXPOSE_LOCAL_FEED=true \
 GTS_INSTANCE_FEDERATION_MODE='allowlist' \
 GTS_INSTANCE_FEDERATION_SPAM_FILTER=true \
+GTS_INSTANCE_FEDERATION_SEEN_ACTIVITY_TTL=24h \
+GTS_INSTANCE_FEDERATION_FAILED_ACTIVITY_TTL=12h \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
+GTS_INSTANCE_EXPERIMENTAL_C2S=true \
 GTS_INSTANCE_INJECT_MASTODON_VERSION=true \
+GTS_INSTANCE_INTEGRITY_PROOFS_SIGN=true \
+GTS_INSTANCE_INTEGRITY_PROOFS_VERIFY=true \
 GTS_INSTANCE_LANGUAGES="nl,en-gb" \
 GTS_INSTANCE_STATS_MODE="baffle" \
+GTS_INSTANCE_PEERS_MODE="anonymize" \
+GTS_INSTANCE_ACTIVITY_MODE="serve" \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
+GTS_ACCOUNTS_ALLOW_CUSTOM_DOMAINS=true \
 GTS_ACCOUNTS_CUSTOM_CSS_LENGTH=5000 \
+GTS_ACCOUNTS_FOLLOW_REQUEST_EXPIRY=720h \
+GTS_ACCOUNTS_INVITES_ALLOWED="moderators" \
+GTS_ACCOUNTS_LOGIN_HISTORY_RETENTION=720h \
+GTS_ACCOUNTS_LOGIN_NOTIFICATION_EMAILS=true \
 GTS_ACCOUNTS_MAX_PROFILE_FIELDS=8 \
+GTS_ACCOUNTS_PROFILE_MEDIA_HISTORY=5 \
 GTS_ACCOUNTS_REGISTRATION_BACKLOG_LIMIT=100 \
 GTS_ACCOUNTS_REGISTRATION_DAILY_LIMIT=50 \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
 GTS_ACCOUNTS_REASON_REQUIRED=false \
+GTS_ACCOUNTS_STATS_RECOUNT_EVERY=24h \
+GTS_ACCOUNTS_SUSPENSION_GRACE_PERIOD=168h \
 GTS_MEDIA_DESCRIPTION_MIN_CHARS=69 \
 GTS_MEDIA_DESCRIPTION_MAX_CHARS=5000 \
 GTS_MEDIA_IMAGE_SIZE_HINT='5MiB' \
 GTS_MEDIA_LOCAL_MAX_SIZE=420 \
+GTS_MEDIA_LOCAL_QUOTA='1GiB' \
+GTS_MEDIA_PRESERVE_COLOR_PROFILE=true \
 GTS_MEDIA_REMOTE_MAX_SIZE=420 \
+GTS_MEDIA_REMOTE_SENSITIVE_BLURHASH_ONLY=true \
 GTS_MEDIA_REMOTE_CACHE_DAYS=30 \
 GTS_MEDIA_EMOJI_LOCAL_MAX_SIZE=420 \
 GTS_MEDIA_EMOJI_REMOTE_MAX_SIZE=420 \
+GTS_MEDIA_EMOJI_STATIC_ONLY=true \
 GTS_MEDIA_FFMPEG_POOL_SIZE=8 \
 GTS_MEDIA_VIDEO_SIZE_HINT='40MiB' \
 GTS_MEDIA_THUMB_MAX_PIXELS=42069 \
+GTS_MEDIA_VARIANT_CACHE_SIZE=32MiB \
+GTS_MEDIA_VARIANT_FORMATS="webp" \
+GTS_MEDIA_IMAGE_MAX_SIZE=420 \
+GTS_MEDIA_IMAGE_MAX_MEGAPIXELS=42 \
+GTS_MEDIA_VIDEO_MAX_SIZE=420 \
+GTS_MEDIA_VIDEO_MAX_DURATION=5m \
+GTS_MEDIA_VIDEO_MAX_BITRATE=8000000 \
+GTS_MEDIA_AUDIO_MAX_SIZE=420 \
+GTS_MEDIA_AUDIO_MAX_DURATION=10m \
+GTS_MEDIA_AUDIO_MAX_BITRATE=320000 \
 GTS_METRICS_ENABLED=false \
 GTS_STORAGE_BACKEND='local' \
 GTS_STORAGE_LOCAL_BASE_PATH='/root/store' \
@@ -323,6 +448,8 @@ GTS_SMTP_USERNAME='sex-haver' \
 GTS_SMTP_PASSWORD='hunter2' \
 GTS_SMTP_FROM='queen.rip.in.piss@terfisland.org' \
 GTS_SMTP_DISCLOSE_RECIPIENTS=true \
+GTS_EVENT_BUS_PROVIDER='nats' \
+GTS_EVENT_BUS_URL='nats://localhost:4222' \
 GTS_SYSLOG_ENABLED=true \
 GTS_SYSLOG_PROTOCOL='udp' \
 GTS_SYSLOG_ADDRESS='127.0.0.1:6969' \
@@ -0,0 +1,510 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package testrig
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"code.superseriousbusiness.org/gopkg/log"
+	"code.superseriousbusiness.org/gotosocial/internal/ap"
+	"code.superseriousbusiness.org/gotosocial/internal/db"
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	gtsstorage "code.superseriousbusiness.org/gotosocial/internal/storage"
+	"code.superseriousbusiness.org/gotosocial/internal/util"
+)
+
+// Client fixtures are an additional, documented set of models that
+// can be seeded on top of the standard test models when running the
+// standalone testrig, for third-party client developers to write
+// integration tests against. Everything here is deterministic, so
+// IDs, URIs and timestamps are the same across every run and reset.
+//
+// They only reference the standard test accounts, so they can be
+// removed again just by dropping and recreating the standard tables.
+//
+// See docs/api/testrig.md for the documented list; if you
+// change anything here, please update that document accordingly.
+
+// NewClientFixtureStatuses returns a map of client fixture
+// statuses, keyed by the name used in the fixtures document.
+func NewClientFixtureStatuses() map[string]*gtsmodel.Status {
+	return map[string]*gtsmodel.Status{
+		"fixture_public": {
+			ID:                       "01JGXGX680XYGZ6XEQC6AFMMD3",
+			URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01JGXGX680XYGZ6XEQC6AFMMD3",
+			URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01JGXGX680XYGZ6XEQC6AFMMD3",
+			Content:                  "<p>fixture: a plain public post.</p>",
+			Text:                     "fixture: a plain public post.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T10:00:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/the_mighty_zork",
+			AccountID:                "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ThreadID:                 "01JGXGX680M6XF681HHBTJWY35",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_unlisted": {
+			ID:                       "01JGXH6B70TG0GSB5TZESXCQQQ",
+			URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01JGXH6B70TG0GSB5TZESXCQQQ",
+			URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01JGXH6B70TG0GSB5TZESXCQQQ",
+			Content:                  "<p>fixture: an unlisted post.</p>",
+			Text:                     "fixture: an unlisted post.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T10:05:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/the_mighty_zork",
+			AccountID:                "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ThreadID:                 "01JGXH6B707HW5KMCQSWQM0ETV",
+			Visibility:               gtsmodel.VisibilityUnlocked,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_followers_only": {
+			ID:                       "01JGXHFG60VSRV5BW734E5CQ37",
+			URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01JGXHFG60VSRV5BW734E5CQ37",
+			URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01JGXHFG60VSRV5BW734E5CQ37",
+			Content:                  "<p>fixture: a followers-only post.</p>",
+			Text:                     "fixture: a followers-only post.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T10:10:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/the_mighty_zork",
+			AccountID:                "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ThreadID:                 "01JGXHFG60FWDSMMCYQS33QZ14",
+			Visibility:               gtsmodel.VisibilityFollowersOnly,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_direct": {
+			ID:                       "01JGXHRN50T5B91W4AAX828S8B",
+			URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01JGXHRN50T5B91W4AAX828S8B",
+			URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01JGXHRN50T5B91W4AAX828S8B",
+			Content:                  "<p><span class=\"h-card\"><a href=\"http://localhost:8080/@1happyturtle\" class=\"u-url mention\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">@<span>1happyturtle</span></a></span> fixture: a direct message.</p>",
+			Text:                     "@1happyturtle fixture: a direct message.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T10:15:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/the_mighty_zork",
+			MentionIDs:               []string{"01JGXHRN50R2HWCEK5R6AQVRWE"},
+			AccountID:                "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ThreadID:                 "01JGXHRN50RDMQQQYPYTSK7XZJ",
+			Visibility:               gtsmodel.VisibilityDirect,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_local_only": {
+			ID:                       "01JGXJ1T40KMR7N67431AZAFTK",
+			URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01JGXJ1T40KMR7N67431AZAFTK",
+			URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01JGXJ1T40KMR7N67431AZAFTK",
+			Content:                  "<p>fixture: a local-only post, this one doesn't federate.</p>",
+			Text:                     "fixture: a local-only post, this one doesn't federate.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T10:20:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/the_mighty_zork",
+			AccountID:                "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ThreadID:                 "01JGXJ1T401ZHV4KFBYKBP5J7N",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+			Federated:                util.Ptr(false),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_content_warning": {
+			ID:                       "01JGXJAZ30HDTKWTNK6CP83G0M",
+			URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01JGXJAZ30HDTKWTNK6CP83G0M",
+			URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01JGXJAZ30HDTKWTNK6CP83G0M",
+			Content:                  "<p>fixture: the body of a post behind a content warning.</p>",
+			Text:                     "fixture: the body of a post behind a content warning.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T10:25:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/the_mighty_zork",
+			AccountID:                "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ThreadID:                 "01JGXJAZ30TP6NQBGWEFY3CSX8",
+			ContentWarning:           "fixture: content warning",
+			ContentWarningText:       "fixture: content warning",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(true),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_poll": {
+			ID:                       "01JGXJM420E1RPBTCR9D8XSQRW",
+			URI:                      "http://localhost:8080/users/1happyturtle/statuses/01JGXJM420E1RPBTCR9D8XSQRW",
+			URL:                      "http://localhost:8080/@1happyturtle/statuses/01JGXJM420E1RPBTCR9D8XSQRW",
+			Content:                  "<p>fixture: an open multiple-choice poll.</p>",
+			Text:                     "fixture: an open multiple-choice poll.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T10:30:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/1happyturtle",
+			AccountID:                "01F8MH5NBDF2MV7CTC4Q5128HF",
+			ThreadID:                 "01JGXJM4203E1WZZV270YETVG6",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ActivityQuestion,
+			PollID:                   "01JGXJM420MTBK2JBAYC4D4SZ9",
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_media": {
+			ID:                       "01JGXJX910E8E9A9DDWBN8J0YK",
+			URI:                      "http://localhost:8080/users/1happyturtle/statuses/01JGXJX910E8E9A9DDWBN8J0YK",
+			URL:                      "http://localhost:8080/@1happyturtle/statuses/01JGXJX910E8E9A9DDWBN8J0YK",
+			Content:                  "<p>fixture: a post with an image attached.</p>",
+			Text:                     "fixture: a post with an image attached.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			AttachmentIDs:            []string{"01JGXJVEE0FCXJ5EQW2T2HV4EE"},
+			CreatedAt:                TimeMustParse("2025-01-06T10:35:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/1happyturtle",
+			AccountID:                "01F8MH5NBDF2MV7CTC4Q5128HF",
+			ThreadID:                 "01JGXJX910VQSQYTG4JAFMNTT8",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_thread_1": {
+			ID:                       "01JGXMB1W02B1N3ZZ1A03KZFJ4",
+			URI:                      "http://localhost:8080/users/admin/statuses/01JGXMB1W02B1N3ZZ1A03KZFJ4",
+			URL:                      "http://localhost:8080/@admin/statuses/01JGXMB1W02B1N3ZZ1A03KZFJ4",
+			Content:                  "<p>fixture: the start of a thread.</p>",
+			Text:                     "fixture: the start of a thread.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T11:00:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/admin",
+			AccountID:                "01F8MH17FWEB39HZJ76B6VXSKF",
+			ThreadID:                 "01JGXMB1W0PKGJGKHAPAD5TZW9",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_thread_2": {
+			ID:                       "01JGXMM6V0G42B55CMFYNQDKJM",
+			URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01JGXMM6V0G42B55CMFYNQDKJM",
+			URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01JGXMM6V0G42B55CMFYNQDKJM",
+			Content:                  "<p>fixture: a reply to the start of the thread.</p>",
+			Text:                     "fixture: a reply to the start of the thread.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T11:05:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/the_mighty_zork",
+			AccountID:                "01F8MH1H7YV1Z7D2C8K2730QBF",
+			InReplyToID:              "01JGXMB1W02B1N3ZZ1A03KZFJ4",
+			InReplyToAccountID:       "01F8MH17FWEB39HZJ76B6VXSKF",
+			InReplyToURI:             "http://localhost:8080/users/admin/statuses/01JGXMB1W02B1N3ZZ1A03KZFJ4",
+			ThreadID:                 "01JGXMB1W0PKGJGKHAPAD5TZW9",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_thread_3": {
+			ID:                       "01JGXMXBT0CSVKV4VRE483FDHY",
+			URI:                      "http://localhost:8080/users/1happyturtle/statuses/01JGXMXBT0CSVKV4VRE483FDHY",
+			URL:                      "http://localhost:8080/@1happyturtle/statuses/01JGXMXBT0CSVKV4VRE483FDHY",
+			Content:                  "<p>fixture: a reply to the reply.</p>",
+			Text:                     "fixture: a reply to the reply.",
+			ContentType:              gtsmodel.StatusContentTypePlain,
+			CreatedAt:                TimeMustParse("2025-01-06T11:10:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/1happyturtle",
+			AccountID:                "01F8MH5NBDF2MV7CTC4Q5128HF",
+			InReplyToID:              "01JGXMM6V0G42B55CMFYNQDKJM",
+			InReplyToAccountID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
+			InReplyToURI:             "http://localhost:8080/users/the_mighty_zork/statuses/01JGXMM6V0G42B55CMFYNQDKJM",
+			ThreadID:                 "01JGXMB1W0PKGJGKHAPAD5TZW9",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			Language:                 "en",
+			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+		"fixture_boost": {
+			ID:                       "01JGXN6GS0S9169YQNJW7WC4YR",
+			URI:                      "http://localhost:8080/users/1happyturtle/statuses/01JGXN6GS0S9169YQNJW7WC4YR",
+			URL:                      "http://localhost:8080/@1happyturtle/statuses/01JGXN6GS0S9169YQNJW7WC4YR",
+			CreatedAt:                TimeMustParse("2025-01-06T11:15:00Z"),
+			Local:                    util.Ptr(true),
+			AccountURI:               "http://localhost:8080/users/1happyturtle",
+			AccountID:                "01F8MH5NBDF2MV7CTC4Q5128HF",
+			BoostOfID:                "01JGXGX680XYGZ6XEQC6AFMMD3",
+			BoostOfAccountID:         "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Visibility:               gtsmodel.VisibilityPublic,
+			Sensitive:                util.Ptr(false),
+			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
+			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(false),
+			ActivityStreamsType:      ap.ObjectNote,
+			PendingApproval:          util.Ptr(false),
+		},
+	}
+}
+
+// NewClientFixtureThreads returns a map of client fixture
+// threads, keyed by the name of the status that started them.
+func NewClientFixtureThreads() map[string]*gtsmodel.Thread {
+	return map[string]*gtsmodel.Thread{
+		"fixture_public":          {ID: "01JGXGX680M6XF681HHBTJWY35"},
+		"fixture_unlisted":        {ID: "01JGXH6B707HW5KMCQSWQM0ETV"},
+		"fixture_followers_only":  {ID: "01JGXHFG60FWDSMMCYQS33QZ14"},
+		"fixture_direct":          {ID: "01JGXHRN50RDMQQQYPYTSK7XZJ"},
+		"fixture_local_only":      {ID: "01JGXJ1T401ZHV4KFBYKBP5J7N"},
+		"fixture_content_warning": {ID: "01JGXJAZ30TP6NQBGWEFY3CSX8"},
+		"fixture_poll":            {ID: "01JGXJM4203E1WZZV270YETVG6"},
+		"fixture_media":           {ID: "01JGXJX910VQSQYTG4JAFMNTT8"},
+		"fixture_thread_1":        {ID: "01JGXMB1W0PKGJGKHAPAD5TZW9"},
+	}
+}
+
+// NewClientFixtureMentions returns a map of client fixture mentions.
+func NewClientFixtureMentions() map[string]*gtsmodel.Mention {
+	return map[string]*gtsmodel.Mention{
+		"fixture_direct_mention_turtle": {
+			ID:               "01JGXHRN50R2HWCEK5R6AQVRWE",
+			StatusID:         "01JGXHRN50T5B91W4AAX828S8B",
+			CreatedAt:        TimeMustParse("2025-01-06T10:15:00Z"),
+			OriginAccountID:  "01F8MH1H7YV1Z7D2C8K2730QBF",
+			OriginAccountURI: "http://localhost:8080/users/the_mighty_zork",
+			TargetAccountID:  "01F8MH5NBDF2MV7CTC4Q5128HF",
+			NameString:       "@1happyturtle",
+			TargetAccountURI: "http://localhost:8080/users/1happyturtle",
+			TargetAccountURL: "http://localhost:8080/@1happyturtle",
+		},
+	}
+}
+
+// NewClientFixturePolls returns a map of client fixture polls.
+func NewClientFixturePolls() map[string]*gtsmodel.Poll {
+	return map[string]*gtsmodel.Poll{
+		"fixture_poll_poll": {
+			ID:         "01JGXJM420MTBK2JBAYC4D4SZ9",
+			Multiple:   util.Ptr(true),
+			HideCounts: util.Ptr(false),
+			Options:    []string{"red", "green", "blue"},
+			Votes:      []int{0, 0, 0},
+			Voters:     util.Ptr(0),
+			StatusID:   "01JGXJM420E1RPBTCR9D8XSQRW",
+			// far enough in the future to
+			// stay open for client tests.
+			ExpiresAt: TimeMustParse("2099-01-06T10:30:00Z"),
+			ClosedAt:  time.Time{},
+			Closing:   false,
+		},
+	}
+}
+
+// NewClientFixtureAttachments returns a map of client fixture media attachments.
+func NewClientFixtureAttachments() map[string]*gtsmodel.MediaAttachment {
+	return map[string]*gtsmodel.MediaAttachment{
+		"fixture_media_attachment": {
+			ID:        "01JGXJVEE0FCXJ5EQW2T2HV4EE",
+			StatusID:  "01JGXJX910E8E9A9DDWBN8J0YK",
+			URL:       "http://localhost:8080/fileserver/01F8MH5NBDF2MV7CTC4Q5128HF/attachment/original/01JGXJVEE0FCXJ5EQW2T2HV4EE.jpg",
+			RemoteURL: "",
+			CreatedAt: TimeMustParse("2025-01-06T10:34:00Z"),
+			Type:      gtsmodel.FileTypeImage,
+			FileMeta: gtsmodel.FileMeta{
+				Original: gtsmodel.Original{
+					Width:  800,
+					Height: 450,
+					Size:   360000,
+					Aspect: 1.7777778,
+				},
+				Small: gtsmodel.Small{
+					Width:  512,
+					Height: 288,
+					Size:   147456,
+					Aspect: 1.7777778,
+				},
+				Focus: gtsmodel.Focus{
+					X: 0,
+					Y: 0,
+				},
+			},
+			AccountID:   "01F8MH5NBDF2MV7CTC4Q5128HF",
+			Description: "fixture: the oh you meme",
+			Blurhash:    "LNABP8o#Dge,S6M}axxVEQjYxWbH",
+			File: gtsmodel.File{
+				Path:        "01F8MH5NBDF2MV7CTC4Q5128HF/attachment/original/01JGXJVEE0FCXJ5EQW2T2HV4EE.jpg",
+				ContentType: "image/jpeg",
+				FileSize:    27759,
+			},
+			Thumbnail: gtsmodel.Thumbnail{
+				Path:        "01F8MH5NBDF2MV7CTC4Q5128HF/attachment/small/01JGXJVEE0FCXJ5EQW2T2HV4EE.jpeg",
+				ContentType: "image/jpeg",
+				FileSize:    14665,
+				URL:         "http://localhost:8080/fileserver/01F8MH5NBDF2MV7CTC4Q5128HF/attachment/small/01JGXJVEE0FCXJ5EQW2T2HV4EE.jpeg",
+				RemoteURL:   "",
+			},
+			Avatar: util.Ptr(false),
+			Header: util.Ptr(false),
+		},
+	}
+}
+
+// NewClientFixtureFaves returns a map of client fixture faves.
+func NewClientFixtureFaves() map[string]*gtsmodel.StatusFave {
+	return map[string]*gtsmodel.StatusFave{
+		"fixture_admin_fave_public": {
+			ID:              "01JGXNFNR0XSAXDERYPAC0JRKK",
+			CreatedAt:       TimeMustParse("2025-01-06T11:20:00Z"),
+			AccountID:       "01F8MH17FWEB39HZJ76B6VXSKF", // admin account
+			TargetAccountID: "01F8MH1H7YV1Z7D2C8K2730QBF", // local account 1
+			StatusID:        "01JGXGX680XYGZ6XEQC6AFMMD3", // fixture_public
+			URI:             "http://localhost:8080/users/admin/liked/01JGXNFNR0XSAXDERYPAC0JRKK",
+		},
+	}
+}
+
+func newClientFixtureStoredAttachments() map[string]filenames {
+	return map[string]filenames{
+		"fixture_media_attachment": {
+			Original: "ohyou-original.jpg",
+			Small:    "ohyou-small.jpeg",
+		},
+	}
+}
+
+// ClientFixturesDBSetup populates the given db with the client
+// fixtures. It expects StandardDBSetup to have been called first.
+func ClientFixturesDBSetup(db db.DB) {
+	if db == nil {
+		log.Panic(nil, "client fixtures setup: db was nil")
+	}
+
+	ctx := context.Background()
+
+	for _, v := range NewClientFixtureAttachments() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(ctx, err)
+		}
+	}
+
+	for _, v := range NewClientFixtureThreads() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(ctx, err)
+		}
+	}
+
+	for _, v := range NewClientFixtureStatuses() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(ctx, err)
+		}
+	}
+
+	for _, v := range NewClientFixtureMentions() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(ctx, err)
+		}
+	}
+
+	for _, v := range NewClientFixturePolls() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(ctx, err)
+		}
+	}
+
+	for _, v := range NewClientFixtureFaves() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(ctx, err)
+		}
+	}
+
+	log.Debug(ctx, "client fixtures db setup complete")
+}
+
+// ClientFixturesStorageSetup populates the storage with
+// the client fixture media files from the given directory.
+func ClientFixturesStorageSetup(storage *gtsstorage.Driver, relativePath string) {
+	a := NewClientFixtureAttachments()
+	for k, paths := range newClientFixtureStoredAttachments() {
+		attachmentInfo, ok := a[k]
+		if !ok {
+			panic(fmt.Errorf("key %s not found in client fixture attachments", k))
+		}
+		bOriginal, err := os.ReadFile(fmt.Sprintf("%s/%s", relativePath, paths.Original))
+		if err != nil {
+			panic(err)
+		}
+		if _, err := storage.Put(context.TODO(), attachmentInfo.File.Path, bOriginal); err != nil {
+			panic(err)
+		}
+		bSmall, err := os.ReadFile(fmt.Sprintf("%s/%s", relativePath, paths.Small))
+		if err != nil {
+			panic(err)
+		}
+		if _, err := storage.Put(context.TODO(), attachmentInfo.Thumbnail.Path, bSmall); err != nil {
+			panic(err)
+		}
+	}
+}
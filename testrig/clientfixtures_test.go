@@ -0,0 +1,144 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package testrig_test
+
+import (
+	"context"
+	"testing"
+
+	"code.superseriousbusiness.org/gotosocial/internal/gtsmodel"
+	"code.superseriousbusiness.org/gotosocial/internal/state"
+	"code.superseriousbusiness.org/gotosocial/testrig"
+	"github.com/stretchr/testify/suite"
+)
+
+type ClientFixturesTestSuite struct {
+	suite.Suite
+	state state.State
+}
+
+func (suite *ClientFixturesTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+	suite.state.Caches.Init()
+	suite.state.DB = testrig.NewTestDB(&suite.state)
+	suite.state.Storage = testrig.NewInMemoryStorage()
+}
+
+func (suite *ClientFixturesTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.state.DB)
+	testrig.StandardStorageTeardown(suite.state.Storage)
+}
+
+// seed drops and repopulates the db and storage
+// the same way the testrig reset endpoint does.
+func (suite *ClientFixturesTestSuite) seed() {
+	testrig.DropTestTables(suite.state.DB)
+	testrig.StandardDBSetup(suite.state.DB, nil)
+	testrig.ClientFixturesDBSetup(suite.state.DB)
+
+	testrig.StandardStorageTeardown(suite.state.Storage)
+	testrig.StandardStorageSetup(suite.state.Storage, "./media")
+	testrig.ClientFixturesStorageSetup(suite.state.Storage, "./media")
+}
+
+// fixtureRows returns the db rows of every
+// client fixture, keyed by fixture name.
+func (suite *ClientFixturesTestSuite) fixtureRows() map[string]any {
+	ctx := context.Background()
+	rows := make(map[string]any)
+
+	get := func(name string, id string, i any) {
+		if err := suite.state.DB.GetByID(ctx, id, i); err != nil {
+			suite.FailNow("error getting fixture row", "%s: %v", name, err)
+		}
+		rows[name] = i
+	}
+
+	for k, v := range testrig.NewClientFixtureAttachments() {
+		get(k, v.ID, new(gtsmodel.MediaAttachment))
+	}
+	for k, v := range testrig.NewClientFixtureThreads() {
+		get(k, v.ID, new(gtsmodel.Thread))
+	}
+	for k, v := range testrig.NewClientFixtureStatuses() {
+		get(k, v.ID, new(gtsmodel.Status))
+	}
+	for k, v := range testrig.NewClientFixtureMentions() {
+		get(k, v.ID, new(gtsmodel.Mention))
+	}
+	for k, v := range testrig.NewClientFixturePolls() {
+		get(k, v.ID, new(gtsmodel.Poll))
+	}
+	for k, v := range testrig.NewClientFixtureFaves() {
+		get(k, v.ID, new(gtsmodel.StatusFave))
+	}
+
+	return rows
+}
+
+// fixtureFiles returns the stored media
+// of every client fixture, keyed by path.
+func (suite *ClientFixturesTestSuite) fixtureFiles() map[string][]byte {
+	ctx := context.Background()
+	files := make(map[string][]byte)
+
+	for _, v := range testrig.NewClientFixtureAttachments() {
+		for _, path := range []string{v.File.Path, v.Thumbnail.Path} {
+			b, err := suite.state.Storage.Get(ctx, path)
+			if err != nil {
+				suite.FailNow("error getting fixture file", "%s: %v", path, err)
+			}
+			files[path] = b
+		}
+	}
+
+	return files
+}
+
+func (suite *ClientFixturesTestSuite) TestSeedTwice() {
+	suite.seed()
+	rows1 := suite.fixtureRows()
+	files1 := suite.fixtureFiles()
+
+	// Clear caches between seeds
+	// so nothing is served stale.
+	for _, name := range suite.state.Caches.ClearNames() {
+		if err := suite.state.Caches.ClearNamed(name); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	suite.seed()
+	rows2 := suite.fixtureRows()
+	files2 := suite.fixtureFiles()
+
+	suite.NotEmpty(rows1)
+	suite.Equal(rows1, rows2)
+
+	suite.NotEmpty(files1)
+	suite.Equal(files1, files2)
+
+	// Standard fixtures should still be there too.
+	_, err := suite.state.DB.GetAccountByID(context.Background(), "01F8MH1H7YV1Z7D2C8K2730QBF")
+	suite.NoError(err)
+}
+
+func TestClientFixturesTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientFixturesTestSuite))
+}
@@ -86,15 +86,18 @@ func testDefaults() config.Configuration {
 		WebTemplateBaseDir: "./web/template/",
 		WebAssetBaseDir:    "./web/assets/",
 
-		InstanceFederationMode:         config.InstanceFederationModeDefault,
-		InstanceFederationSpamFilter:   true,
-		InstanceExposePeers:            true,
-		InstanceExposeBlocklist:        true,
-		InstanceExposeBlocklistWeb:     true,
-		InstanceExposeAllowlist:        true,
-		InstanceExposeAllowlistWeb:     true,
-		InstanceExposeCustomEmojis:     true,
-		InstanceDeliverToSharedInboxes: true,
+		InstanceFederationMode:              config.InstanceFederationModeDefault,
+		InstanceFederationSpamFilter:        true,
+		InstanceFederationSeenActivityTTL:   72 * time.Hour,
+		InstanceFederationFailedActivityTTL: 168 * time.Hour,
+		InstanceExposePeers:                 true,
+		InstanceExposeBlocklist:             true,
+		InstanceExposeBlocklistWeb:          true,
+		InstanceExposeBlocklistComments:     true,
+		InstanceExposeAllowlist:             true,
+		InstanceExposeAllowlistWeb:          true,
+		InstanceExposeCustomEmojis:          true,
+		InstanceDeliverToSharedInboxes:      true,
 		InstanceLanguages: language.Languages{
 			{
 				TagStr: "nl",
@@ -105,19 +108,32 @@ func testDefaults() config.Configuration {
 		},
 		InstanceSubscriptionsProcessFrom:  "23:00",        // 11pm,
 		InstanceSubscriptionsProcessEvery: 24 * time.Hour, // 1/day.
+		InstanceSitemapGenerateEvery:      6 * time.Hour,  // 4/day.
+		InstancePeersMode:                 config.InstancePeersModeServe,
+		InstanceActivityMode:              config.InstanceActivityModeServe,
 		InstanceAllowBackdatingStatuses:   true,
 
 		AccountsRegistrationOpen:         true,
 		AccountsReasonRequired:           true,
 		AccountsRegistrationDailyLimit:   10,
 		AccountsRegistrationBacklogLimit: 20,
+		AccountsInvitesAllowed:           "none",
 		AccountsAllowCustomCSS:           true,
 		AccountsCustomCSSLength:          10000,
+		AccountsAllowCustomDomains:       true,
 		AccountsMaxProfileFields:         8,
+		AccountsProfileMediaHistory:      3,
+		AccountsLoginHistoryRetention:    90 * 24 * time.Hour,
+		AccountsLoginNotificationEmails:  true,
+		AccountsStatsRecountEvery:        7 * 24 * time.Hour,
 
 		Media: config.MediaConfiguration{
 			DescriptionMinChars: 0,
 			DescriptionMaxChars: 500,
+			DescriptionPolicy:   config.MediaDescriptionPolicyOff,
+			DescribeCommand:     "tesseract",
+			DescribeConcurrency: 1,
+			DescribeTimeout:     30 * time.Second,
 			RemoteCacheDays:     7,
 			LocalMaxSize:        40 * bytesize.MiB,
 			RemoteMaxSize:       40 * bytesize.MiB,
@@ -126,6 +142,7 @@ func testDefaults() config.Configuration {
 			CleanupFrom:         "00:00",        // midnight.
 			CleanupEvery:        24 * time.Hour, // 1/day.
 			ThumbMaxPixels:      512,
+			VariantCacheSize:    64 * bytesize.MiB,
 		},
 
 		// the testrig uses in-memory storage by default, so we can
@@ -139,6 +156,16 @@ func testDefaults() config.Configuration {
 		StatusesPollOptionMaxChars: 50,
 		StatusesMediaMaxFiles:      6,
 
+		StatusesSyntaxHighlighting: true,
+
+		StatusesExpiryMinDuration: 5 * time.Minute,
+		StatusesExpiryMaxDuration: 365 * 24 * time.Hour,
+		StatusesCleanupInterval:   2 * time.Second,
+
+		StatusesLinkPreviews:       true,
+		StatusesLinkPreviewsTTL:    7 * 24 * time.Hour,
+		StatusesLinkPreviewsRemote: false,
+
 		ScheduledStatusesMaxTotal: 300,
 		ScheduledStatusesMaxDaily: 25,
 
@@ -185,6 +212,10 @@ func testDefaults() config.Configuration {
 			},
 		},
 
+		HTTPClient: config.HTTPClientConfiguration{
+			IPFamilyPreference: config.HTTPClientIPFamilyAuto,
+		},
+
 		SoftwareVersion: "0.0.0-testrig",
 
 		// simply use cache defaults.
@@ -30,12 +30,20 @@
 
 var testModels = []interface{}{
 	&gtsmodel.Account{},
+	&gtsmodel.AccountCustomDomain{},
 	&gtsmodel.AccountNote{},
 	&gtsmodel.AccountSettings{},
 	&gtsmodel.AccountToEmoji{},
+	&gtsmodel.AccountWarning{},
+	&gtsmodel.Announcement{},
+	&gtsmodel.AnnouncementRead{},
+	&gtsmodel.AnnouncementReaction{},
+	&gtsmodel.Appeal{},
 	&gtsmodel.Application{},
 	&gtsmodel.Block{},
+	&gtsmodel.Card{},
 	&gtsmodel.DomainBlock{},
+	&gtsmodel.DomainLimit{},
 	&gtsmodel.EmailDomainBlock{},
 	&gtsmodel.Filter{},
 	&gtsmodel.FilterKeyword{},
@@ -56,23 +64,35 @@
 	&gtsmodel.StatusEdit{},
 	&gtsmodel.StatusFave{},
 	&gtsmodel.StatusBookmark{},
+	&gtsmodel.StatusCleanup{},
+	&gtsmodel.ScheduledStatus{},
+	&gtsmodel.Invite{},
+	&gtsmodel.BookmarkFolder{},
+	&gtsmodel.BlocklistSubscription{},
 	&gtsmodel.Tag{},
 	&gtsmodel.Thread{},
 	&gtsmodel.ThreadMute{},
+	&gtsmodel.ThreadSubscription{},
 	&gtsmodel.User{},
 	&gtsmodel.UserMute{},
+	&gtsmodel.UserLogin{},
 	&gtsmodel.VAPIDKeyPair{},
 	&gtsmodel.WebPushSubscription{},
 	&gtsmodel.Emoji{},
+	&gtsmodel.FailedActivity{},
+	&gtsmodel.FederationStat{},
+	&gtsmodel.SeenActivity{},
 	&gtsmodel.Instance{},
 	&gtsmodel.Notification{},
 	&gtsmodel.RouterSession{},
 	&gtsmodel.Token{},
 	&gtsmodel.EmojiCategory{},
 	&gtsmodel.Tombstone{},
+	&gtsmodel.Relay{},
 	&gtsmodel.Report{},
 	&gtsmodel.Rule{},
 	&gtsmodel.WorkerTask{},
+	&gtsmodel.PausedDelivery{},
 }
 
 // NewTestDB returns a new initialized, empty database for testing.
@@ -388,11 +408,16 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 
 // StandardDBTeardown drops all the standard testing tables/models from the database to ensure it's clean for the next test.
 func StandardDBTeardown(db db.DB) {
-	ctx := context.Background()
 	if db == nil {
 		return
 	}
 	defer db.Close()
+	DropTestTables(db)
+}
+
+// DropTestTables drops all the standard testing tables from the database, but doesn't close it.
+func DropTestTables(db db.DB) {
+	ctx := context.Background()
 	for _, m := range testModels {
 		if err := db.DropTable(ctx, m); err != nil {
 			log.Error(ctx, err)
@@ -0,0 +1,56 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package testrig
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"code.superseriousbusiness.org/gotosocial/internal/describe"
+)
+
+// mockDescriber "describes" images
+// by their content-type and size, so
+// suggestions can be recognised in
+// test expectations.
+type mockDescriber struct{}
+
+// NewTestDescriber creates a mock describer which
+// suggests descriptions like "image/jpeg,\n  1234 bytes",
+// with messy whitespace the way OCR output tends to have.
+func NewTestDescriber() describe.Describer {
+	return &mockDescriber{}
+}
+
+func (m *mockDescriber) Describe(
+	ctx context.Context,
+	r io.Reader,
+	contentType string,
+) (string, error) {
+	n, err := io.Copy(io.Discard, r)
+	if err != nil {
+		return "", err
+	}
+
+	return contentType + ",\n  " + strconv.FormatInt(n, 10) + " bytes", nil
+}
+
+func (m *mockDescriber) Provider() string {
+	return "Mock"
+}
@@ -57,6 +57,8 @@ func NewTestProcessor(
 		state,
 		emailSender,
 		webPushSender,
+		NewTestTranslator(),
+		NewTestDescriber(),
 		visibility.NewFilter(state),
 		mutes.NewFilter(state),
 		interaction.NewFilter(state),
@@ -641,64 +641,79 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 func NewTestAccountSettings() map[string]*gtsmodel.AccountSettings {
 	return map[string]*gtsmodel.AccountSettings{
 		"unconfirmed_account": {
-			AccountID:        "01F8MH0BBE4FHXPH513MBVFHB0",
-			CreatedAt:        TimeMustParse("2022-06-04T13:12:00Z"),
-			UpdatedAt:        TimeMustParse("2022-06-04T13:12:00Z"),
-			Privacy:          gtsmodel.VisibilityPublic,
-			Sensitive:        util.Ptr(false),
-			Language:         "en",
-			EnableRSS:        util.Ptr(false),
-			HideCollections:  util.Ptr(false),
-			WebLayout:        gtsmodel.WebLayoutMicroblog,
-			WebIncludeBoosts: util.Ptr(false),
+			AccountID:          "01F8MH0BBE4FHXPH513MBVFHB0",
+			CreatedAt:          TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:          TimeMustParse("2022-06-04T13:12:00Z"),
+			Privacy:            gtsmodel.VisibilityPublic,
+			Sensitive:          util.Ptr(false),
+			Language:           "en",
+			EnableRSS:          util.Ptr(false),
+			RSSFullContent:     util.Ptr(false),
+			HideCollections:    util.Ptr(false),
+			PublishBlocks:      util.Ptr(false),
+			WebLayout:          gtsmodel.WebLayoutMicroblog,
+			WebIncludeBoosts:   util.Ptr(false),
+			WebActivityHeatmap: util.Ptr(false),
 		},
 		"admin_account": {
-			AccountID:        "01F8MH17FWEB39HZJ76B6VXSKF",
-			CreatedAt:        TimeMustParse("2022-05-17T13:10:59Z"),
-			UpdatedAt:        TimeMustParse("2022-05-17T13:10:59Z"),
-			Privacy:          gtsmodel.VisibilityPublic,
-			Sensitive:        util.Ptr(false),
-			Language:         "en",
-			EnableRSS:        util.Ptr(true),
-			HideCollections:  util.Ptr(false),
-			WebLayout:        gtsmodel.WebLayoutMicroblog,
-			WebIncludeBoosts: util.Ptr(true),
+			AccountID:          "01F8MH17FWEB39HZJ76B6VXSKF",
+			CreatedAt:          TimeMustParse("2022-05-17T13:10:59Z"),
+			UpdatedAt:          TimeMustParse("2022-05-17T13:10:59Z"),
+			Privacy:            gtsmodel.VisibilityPublic,
+			Sensitive:          util.Ptr(false),
+			Language:           "en",
+			EnableRSS:          util.Ptr(true),
+			RSSFullContent:     util.Ptr(false),
+			HideCollections:    util.Ptr(false),
+			PublishBlocks:      util.Ptr(false),
+			WebLayout:          gtsmodel.WebLayoutMicroblog,
+			WebIncludeBoosts:   util.Ptr(true),
+			WebActivityHeatmap: util.Ptr(false),
 		},
 		"local_account_1": {
-			AccountID:        "01F8MH1H7YV1Z7D2C8K2730QBF",
-			CreatedAt:        TimeMustParse("2022-05-20T11:09:18Z"),
-			UpdatedAt:        TimeMustParse("2022-05-20T11:09:18Z"),
-			Privacy:          gtsmodel.VisibilityPublic,
-			Sensitive:        util.Ptr(false),
-			Language:         "en",
-			EnableRSS:        util.Ptr(true),
-			HideCollections:  util.Ptr(false),
-			WebLayout:        gtsmodel.WebLayoutMicroblog,
-			WebIncludeBoosts: util.Ptr(true),
+			AccountID:          "01F8MH1H7YV1Z7D2C8K2730QBF",
+			CreatedAt:          TimeMustParse("2022-05-20T11:09:18Z"),
+			UpdatedAt:          TimeMustParse("2022-05-20T11:09:18Z"),
+			Privacy:            gtsmodel.VisibilityPublic,
+			Sensitive:          util.Ptr(false),
+			Language:           "en",
+			EnableRSS:          util.Ptr(true),
+			RSSFullContent:     util.Ptr(false),
+			HideCollections:    util.Ptr(false),
+			PublishBlocks:      util.Ptr(false),
+			WebLayout:          gtsmodel.WebLayoutMicroblog,
+			WebIncludeBoosts:   util.Ptr(true),
+			WebActivityHeatmap: util.Ptr(true),
 		},
 		"local_account_2": {
-			AccountID:        "01F8MH5NBDF2MV7CTC4Q5128HF",
-			CreatedAt:        TimeMustParse("2022-06-04T13:12:00Z"),
-			UpdatedAt:        TimeMustParse("2022-06-04T13:12:00Z"),
-			Privacy:          gtsmodel.VisibilityFollowersOnly,
-			Sensitive:        util.Ptr(true),
-			Language:         "fr",
-			EnableRSS:        util.Ptr(false),
-			HideCollections:  util.Ptr(true),
-			WebLayout:        gtsmodel.WebLayoutMicroblog,
-			WebIncludeBoosts: util.Ptr(false),
+			AccountID:          "01F8MH5NBDF2MV7CTC4Q5128HF",
+			CreatedAt:          TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:          TimeMustParse("2022-06-04T13:12:00Z"),
+			Privacy:            gtsmodel.VisibilityFollowersOnly,
+			Sensitive:          util.Ptr(true),
+			Language:           "fr",
+			EnableRSS:          util.Ptr(false),
+			RSSFullContent:     util.Ptr(false),
+			HideCollections:    util.Ptr(true),
+			PublishBlocks:      util.Ptr(false),
+			WebLayout:          gtsmodel.WebLayoutMicroblog,
+			WebIncludeBoosts:   util.Ptr(false),
+			WebActivityHeatmap: util.Ptr(false),
 		},
 		"local_account_3": {
-			AccountID:        "01JPCMD83Y4WR901094YES3QC5",
-			CreatedAt:        TimeMustParse("2025-03-15T11:08:00Z"),
-			UpdatedAt:        TimeMustParse("2025-03-15T11:08:00Z"),
-			Privacy:          gtsmodel.VisibilityPublic,
-			Sensitive:        util.Ptr(true),
-			Language:         "en",
-			EnableRSS:        util.Ptr(true),
-			HideCollections:  util.Ptr(false),
-			WebLayout:        gtsmodel.WebLayoutGallery,
-			WebIncludeBoosts: util.Ptr(false),
+			AccountID:          "01JPCMD83Y4WR901094YES3QC5",
+			CreatedAt:          TimeMustParse("2025-03-15T11:08:00Z"),
+			UpdatedAt:          TimeMustParse("2025-03-15T11:08:00Z"),
+			Privacy:            gtsmodel.VisibilityPublic,
+			Sensitive:          util.Ptr(true),
+			Language:           "en",
+			EnableRSS:          util.Ptr(true),
+			RSSFullContent:     util.Ptr(false),
+			HideCollections:    util.Ptr(false),
+			PublishBlocks:      util.Ptr(false),
+			WebLayout:          gtsmodel.WebLayoutGallery,
+			WebIncludeBoosts:   util.Ptr(false),
+			WebActivityHeatmap: util.Ptr(false),
 		},
 	}
 }
@@ -1945,6 +1960,7 @@ func NewTestDomainLimits() map[string]*gtsmodel.DomainLimit {
 			FollowsPolicy:      gtsmodel.FollowsPolicyRejectNonMutual,
 			StatusesPolicy:     gtsmodel.StatusesPolicyFilterWarn,
 			AccountsPolicy:     gtsmodel.AccountsPolicyNoAction,
+			FederationPolicy:   gtsmodel.FederationPolicyNoAction,
 			ContentWarning:     "potentially annoying post ahead",
 		},
 	}
@@ -2090,6 +2106,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2113,6 +2130,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2137,6 +2155,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2155,6 +2174,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Sensitive:                util.Ptr(false),
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(false),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2178,6 +2198,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Sensitive:                util.Ptr(false),
 			CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(true),
 		},
@@ -2199,6 +2220,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2219,6 +2241,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(false),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2240,6 +2263,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			InteractionPolicy: &gtsmodel.InteractionPolicy{
 				CanLike: &gtsmodel.PolicyRules{
 					AutomaticApproval: gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
@@ -2274,6 +2298,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2295,6 +2320,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2315,6 +2341,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ActivityQuestion,
 			PollID:                   "01HEN2RKT1YTEZ80SA8HGP105F",
 			PendingApproval:          util.Ptr(false),
@@ -2337,6 +2364,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2361,6 +2389,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_1_status_9": {
@@ -2383,6 +2412,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_2_status_1": {
@@ -2403,6 +2433,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2423,6 +2454,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			InteractionPolicy: &gtsmodel.InteractionPolicy{
 				CanLike: &gtsmodel.PolicyRules{
 					AutomaticApproval: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
@@ -2455,6 +2487,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			InteractionPolicy: &gtsmodel.InteractionPolicy{
 				CanLike: &gtsmodel.PolicyRules{
 					AutomaticApproval: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
@@ -2487,6 +2520,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(false),
+			PreviewLinks:             util.Ptr(true),
 			InteractionPolicy: &gtsmodel.InteractionPolicy{
 				CanLike: &gtsmodel.PolicyRules{
 					AutomaticApproval: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
@@ -2522,6 +2556,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2543,6 +2578,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2565,6 +2601,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PendingApproval:          util.Ptr(false),
 		},
@@ -2586,6 +2623,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ActivityQuestion,
 			PollID:                   "01HEN2QB5NR4NCEHGYC3HN84K6",
 			PendingApproval:          util.Ptr(false),
@@ -2611,6 +2649,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"local_account_3_status_1": {
@@ -2636,6 +2675,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 			PinnedAt:                 TimeMustParse("2025-03-15T11:27:00Z"),
 		},
@@ -2661,6 +2701,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"remote_account_1_status_1": {
@@ -2678,6 +2719,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Sensitive:           util.Ptr(false),
 			Language:            "en",
 			Federated:           util.Ptr(true),
+			PreviewLinks:        util.Ptr(true),
 			ActivityStreamsType: ap.ObjectNote,
 			PendingApproval:     util.Ptr(false),
 		},
@@ -2696,6 +2738,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Sensitive:           util.Ptr(false),
 			Language:            "en",
 			Federated:           util.Ptr(true),
+			PreviewLinks:        util.Ptr(true),
 			ActivityStreamsType: ap.ActivityQuestion,
 			PollID:              "01HEN2R65468ZG657C4ZPHJ4EX",
 			PendingApproval:     util.Ptr(false),
@@ -2715,6 +2758,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Sensitive:           util.Ptr(false),
 			Language:            "en",
 			Federated:           util.Ptr(true),
+			PreviewLinks:        util.Ptr(true),
 			ActivityStreamsType: ap.ActivityQuestion,
 			PollID:              "01HEWV1GW2D49R919NPEDXPTZ5",
 			PendingApproval:     util.Ptr(false),
@@ -2739,6 +2783,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Language:                 "en",
 			CreatedWithApplicationID: "01F8MGYG9E893WRHW0TAEXR8GJ",
 			Federated:                util.Ptr(true),
+			PreviewLinks:             util.Ptr(true),
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"remote_account_2_status_1": {
@@ -2761,6 +2806,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Sensitive:           util.Ptr(true),
 			Language:            "en",
 			Federated:           util.Ptr(true),
+			PreviewLinks:        util.Ptr(true),
 			ActivityStreamsType: ap.ObjectNote,
 			PendingApproval:     util.Ptr(false),
 		},
@@ -4049,6 +4095,30 @@ func NewTestDereferenceRequests(accounts map[string]*gtsmodel.Account) map[strin
 		DateHeader:      date,
 	}
 
+	target = URLMustParse(accounts["admin_account"].FeaturedCollectionURI)
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceAdminFeatured := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
+	target = URLMustParse(accounts["admin_account"].FeaturedCollectionURI + "?limit=1")
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceAdminFeaturedFirst := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
+	target = URLMustParse(accounts["admin_account"].FeaturedCollectionURI + "?limit=1&max_id=01F8MHAAY43M6RJ473VQFCVH37")
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceAdminFeaturedNext := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
 	target = URLMustParse(emojis["rainbow"].URI)
 	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
 	fossSatanDereferenceEmoji := ActivityWithSignature{
@@ -4068,6 +4138,9 @@ func NewTestDereferenceRequests(accounts map[string]*gtsmodel.Account) map[strin
 		"foss_satan_dereference_zork_outbox":                           fossSatanDereferenceZorkOutbox,
 		"foss_satan_dereference_zork_outbox_first":                     fossSatanDereferenceZorkOutboxFirst,
 		"foss_satan_dereference_zork_outbox_next":                      fossSatanDereferenceZorkOutboxNext,
+		"foss_satan_dereference_admin_featured":                        fossSatanDereferenceAdminFeatured,
+		"foss_satan_dereference_admin_featured_first":                  fossSatanDereferenceAdminFeaturedFirst,
+		"foss_satan_dereference_admin_featured_next":                   fossSatanDereferenceAdminFeaturedNext,
 		"foss_satan_dereference_emoji":                                 fossSatanDereferenceEmoji,
 	}
 }
@@ -108,6 +108,8 @@ func SetupTestStructs(
 		&state,
 		emailSender,
 		webPushSender,
+		NewTestTranslator(),
+		NewTestDescriber(),
 		visFilter,
 		muteFilter,
 		intFilter,
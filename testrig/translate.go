@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package testrig
+
+import (
+	"context"
+
+	"code.superseriousbusiness.org/gotosocial/internal/translate"
+)
+
+// mockTranslator "translates" texts
+// by prefixing them with the target
+// language, so translations can be
+// recognised in test expectations.
+type mockTranslator struct{}
+
+// NewTestTranslator creates a mock translator that
+// prefixes texts with "[target language] ", and
+// always detects the source language as "en".
+func NewTestTranslator() translate.Translator {
+	return &mockTranslator{}
+}
+
+func (m *mockTranslator) Translate(
+	ctx context.Context,
+	texts []string,
+	source string,
+	target string,
+) ([]string, string, error) {
+	translated := make([]string, len(texts))
+	for i, text := range texts {
+		translated[i] = "[" + target + "] " + text
+	}
+
+	if source == "" {
+		source = "en"
+	}
+
+	return translated, source, nil
+}
+
+func (m *mockTranslator) Provider() string {
+	return "Mock"
+}
@@ -19,9 +19,11 @@
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"io"
+	"net"
 	"net/http"
 	"strings"
 	"sync"
@@ -68,6 +70,7 @@ type MockHTTPClient struct {
 	TestRemoteAttachments map[string]RemoteAttachmentFile
 	TestRemoteEmojis      map[string]vocab.TootEmoji
 	TestTombstones        map[string]*gtsmodel.Tombstone
+	TestTXTRecords        map[string][]string
 
 	SentMessages sync.Map
 }
@@ -281,6 +284,20 @@ func (m *MockHTTPClient) DoSigned(req *http.Request, sign httpclient.SignFunc) (
 	return m.do(req)
 }
 
+// LookupTXT returns the mocked DNS TXT records of name
+// from TestTXTRecords, or a not found error if none set.
+func (m *MockHTTPClient) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	txts, ok := m.TestTXTRecords[name]
+	if !ok {
+		return nil, &net.DNSError{
+			Err:        "no such host",
+			Name:       name,
+			IsNotFound: true,
+		}
+	}
+	return txts, nil
+}
+
 func HostMetaResponse(req *http.Request) (
 	responseCode int,
 	responseBytes []byte,
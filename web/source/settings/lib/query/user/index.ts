@@ -56,6 +56,24 @@ const extended = gtsApi.injectEndpoints({
 			}),
 			...replaceCacheOnMutation("verifyCredentials")
 		}),
+
+		rollbackHeader: build.mutation<Account, string>({
+			query: (id) => ({
+				method: "POST",
+				url: `/api/v1/profile/header/rollback`,
+				body: { id: id },
+			}),
+			...replaceCacheOnMutation("verifyCredentials")
+		}),
+
+		rollbackAvatar: build.mutation<Account, string>({
+			query: (id) => ({
+				method: "POST",
+				url: `/api/v1/profile/avatar/rollback`,
+				body: { id: id },
+			}),
+			...replaceCacheOnMutation("verifyCredentials")
+		}),
 		
 		user: build.query<User, void>({
 			query: () => ({url: `/api/v1/user`}),
@@ -135,6 +153,14 @@ const extended = gtsApi.injectEndpoints({
 			}),
 			invalidatesTags: ["DefaultInteractionPolicies"]
 		}),
+		applyBotInteractionPolicies: build.mutation<DefaultInteractionPolicies, void>({
+			query: () => ({
+				method: "PATCH",
+				url: `/api/v1/interaction_policies/defaults`,
+				body: { preset: "bot" },
+			}),
+			invalidatesTags: ["DefaultInteractionPolicies"]
+		}),
 	})
 });
 
@@ -142,6 +168,8 @@ export const {
 	useUpdateCredentialsMutation,
 	useDeleteHeaderMutation,
 	useDeleteAvatarMutation,
+	useRollbackHeaderMutation,
+	useRollbackAvatarMutation,
 	useUserQuery,
 	usePasswordChangeMutation,
 	useEmailChangeMutation,
@@ -151,4 +179,5 @@ export const {
 	useDefaultInteractionPoliciesQuery,
 	useUpdateDefaultInteractionPoliciesMutation,
 	useResetDefaultInteractionPoliciesMutation,
+	useApplyBotInteractionPoliciesMutation,
 } = extended;
@@ -64,10 +64,18 @@ const extended = gtsApi.injectEndpoints({
 					? [{ type: "TokenInfo", id: "TRANSFORMED" }, { type: "InteractionRequest", id: res.id }]
 					: [{ type: "TokenInfo", id: "TRANSFORMED" }]
 		}),
+		invalidateOtherTokens: build.mutation<TokenInfo[], void>({
+			query: () => ({
+				method: "POST",
+				url: `/api/v1/tokens/invalidate_others`,
+			}),
+			invalidatesTags: [{ type: "TokenInfo", id: "TRANSFORMED" }]
+		}),
 	})
 });
 
 export const {
 	useLazySearchTokenInfoQuery,
 	useInvalidateTokenMutation,
+	useInvalidateOtherTokensMutation,
 } = extended;
@@ -18,6 +18,7 @@
 */
 
 import { Links } from "parse-link-header";
+import { MediaAttachment } from "./status";
 import { CustomEmoji } from "./custom-emoji";
 
 export interface AdminAccount {
@@ -65,9 +66,12 @@ export interface Account {
 	last_status_at: string,
 	emojis: CustomEmoji[],
 	fields: [],
+	pronouns?: string,
+	location?: string,
 	enable_rss: boolean,
 	role: any,
 	suspended?: boolean,
+	operator?: Account,
 	source?: AccountSource;
 }
 
@@ -82,6 +86,10 @@ export interface AccountSource {
 	web_visibility: string;
 	web_layout: string;
 	web_include_boosts: boolean;
+	web_activity_heatmap: boolean;
+	rss_full_content: boolean;
+	avatar_history?: MediaAttachment[];
+	header_history?: MediaAttachment[];
 }
 
 export interface SearchAccountParams {
@@ -56,7 +56,7 @@ export interface MappedDomainActions {
 /**
  * Policy to apply to media files originating from the limited domain.
  */
-export type DomainLimitMediaPolicy = "no_action" | "mark_sensitive" | "reject";
+export type DomainLimitMediaPolicy = "no_action" | "mark_sensitive" | "reject" | "sensitive_blurhash_only";
 
 /**
  * Policy to apply to follow (requests) originating from the limited domain.
@@ -73,6 +73,11 @@ export type DomainLimitStatusesPolicy = "no_action" | "filter_warn" | "filter_hi
  */
 export type DomainLimitAccountsPolicy = "no_action" | "mute";
 
+/**
+ * Policy to apply to federation with the limited domain.
+ */
+export type DomainLimitFederationPolicy = "no_action" | "pause";
+
 /**
  * DomainLimit is a domain action that enforces specific policies
  * for media, follows, statuses, accounts, and content warning.
@@ -98,6 +103,11 @@ export interface DomainLimit extends DomainAction {
 	 */
 	accounts_policy: DomainLimitAccountsPolicy;
 	
+	/**
+	 * Policy to apply to federation with the limited domain.
+	 */
+	federation_policy: DomainLimitFederationPolicy;
+	
 	/**
 	 * Content warning to prepend to statuses originating from the limited domain.
 	 */
@@ -133,6 +143,11 @@ export interface DomainLimitUpdateParams {
 	 */
 	accounts_policy?: DomainLimitAccountsPolicy;
 	
+	/**
+	 * Policy to apply to federation with the limited domain.
+	 */
+	federation_policy?: DomainLimitFederationPolicy;
+	
 	/**
 	 * Content warning to prepend to statuses originating from the limited domain.
 	 */
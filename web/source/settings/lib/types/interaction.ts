@@ -39,6 +39,10 @@ export interface InteractionPolicy {
 	can_favourite: InteractionPolicyEntry;
 	can_reply: InteractionPolicyEntry;
 	can_reblog: InteractionPolicyEntry;
+	// Not editable here since quote posts aren't
+	// supported yet, but passed back unchanged so
+	// that it isn't dropped when saving.
+	can_quote?: InteractionPolicyEntry;
 }
 
 export interface InteractionPolicyEntry {
@@ -19,24 +19,33 @@
 
 /* Sub-scopes / scope components */
 
-const scopeAccounts      = "accounts";
-const scopeApplications  = "applications";
-const scopeBlocks        = "blocks";
-const scopeBookmarks     = "bookmarks";
-const scopeConversations = "conversations";
-const scopeCustomEmojis  = "custom_emojis";
-const scopeDomainAllows  = "domain_allows";
-const scopeDomainBlocks  = "domain_blocks";
-const scopeFavourites    = "favourites";
-const scopeFilters       = "filters";
-const scopeFollows       = "follows";
-const scopeLists         = "lists";
-const scopeMedia         = "media";
-const scopeMutes         = "mutes";
-const scopeNotifications = "notifications";
-const scopeReports       = "reports";
-const scopeSearch        = "search";
-const scopeStatuses      = "statuses";
+const scopeAccounts          = "accounts";
+const scopeAnnouncements     = "announcements";
+const scopeApplications      = "applications";
+const scopeBlocks            = "blocks";
+const scopeBookmarks         = "bookmarks";
+const scopeConversations     = "conversations";
+const scopeCustomEmojis      = "custom_emojis";
+const scopeDebug             = "debug";
+const scopeDomainAllows      = "domain_allows";
+const scopeDomainBlocks      = "domain_blocks";
+const scopeDomainLimits      = "domain_limits";
+const scopeDomainPermissions = "domain_permissions";
+const scopeFavourites        = "favourites";
+const scopeFederation        = "federation";
+const scopeFilters           = "filters";
+const scopeFollows           = "follows";
+const scopeHeaderFilters     = "header_filters";
+const scopeInstance          = "instance";
+const scopeInvites           = "invites";
+const scopeLists             = "lists";
+const scopeMedia             = "media";
+const scopeMutes             = "mutes";
+const scopeNotifications     = "notifications";
+const scopeReports           = "reports";
+const scopeSearch            = "search";
+const scopeStatuses          = "statuses";
+const scopeTags              = "tags";
 
 /* Top-level scopes */
 
@@ -50,43 +59,62 @@ export const ScopeAdminWrite = ScopeAdmin + ":" + ScopeWrite;
 
 /* Granular scopes */
 
-export const ScopeReadAccounts           = ScopeRead + ":" + scopeAccounts;
-export const ScopeWriteAccounts          = ScopeWrite + ":" + scopeAccounts;
-export const ScopeReadApplications       = ScopeRead + ":" + scopeApplications;
-export const ScopeWriteApplications      = ScopeWrite + ":" + scopeApplications;
-export const ScopeReadBlocks             = ScopeRead + ":" + scopeBlocks;
-export const ScopeWriteBlocks            = ScopeWrite + ":" + scopeBlocks;
-export const ScopeReadBookmarks          = ScopeRead + ":" + scopeBookmarks;
-export const ScopeWriteBookmarks         = ScopeWrite + ":" + scopeBookmarks;
-export const ScopeWriteConversations     = ScopeWrite + ":" + scopeConversations;
-export const ScopeReadCustomEmojis       = ScopeRead + ":" + scopeCustomEmojis;
-export const ScopeReadFavourites         = ScopeRead + ":" + scopeFavourites;
-export const ScopeWriteFavourites        = ScopeWrite + ":" + scopeFavourites;
-export const ScopeReadFilters            = ScopeRead + ":" + scopeFilters;
-export const ScopeWriteFilters           = ScopeWrite + ":" + scopeFilters;
-export const ScopeReadFollows            = ScopeRead + ":" + scopeFollows;
-export const ScopeWriteFollows           = ScopeWrite + ":" + scopeFollows;
-export const ScopeReadLists              = ScopeRead + ":" + scopeLists;
-export const ScopeWriteLists             = ScopeWrite + ":" + scopeLists;
-export const ScopeWriteMedia             = ScopeWrite + ":" + scopeMedia;
-export const ScopeReadMutes              = ScopeRead + ":" + scopeMutes;
-export const ScopeWriteMutes             = ScopeWrite + ":" + scopeMutes;
-export const ScopeReadNotifications      = ScopeRead + ":" + scopeNotifications;
-export const ScopeWriteNotifications     = ScopeWrite + ":" + scopeNotifications;
-export const ScopeWriteReports           = ScopeWrite + ":" + scopeReports;
-export const ScopeReadSearch             = ScopeRead + ":" + scopeSearch;
-export const ScopeReadStatuses           = ScopeRead + ":" + scopeStatuses;
-export const ScopeWriteStatuses          = ScopeWrite + ":" + scopeStatuses;
-export const ScopeAdminReadAccounts      = ScopeAdminRead + ":" + scopeAccounts;
-export const ScopeAdminWriteAccounts     = ScopeAdminWrite + ":" + scopeAccounts;
-export const ScopeAdminReadCustomEmojis  = ScopeAdminRead + ":" + scopeCustomEmojis;
-export const ScopeAdminWriteCustomEmojis = ScopeAdminWrite + ":" + scopeCustomEmojis;
-export const ScopeAdminReadDomainAllows  = ScopeAdminRead + ":" + scopeDomainAllows;
-export const ScopeAdminWriteDomainAllows = ScopeAdminWrite + ":" + scopeDomainAllows;
-export const ScopeAdminReadDomainBlocks  = ScopeAdminRead + ":" + scopeDomainBlocks;
-export const ScopeAdminWriteDomainBlocks = ScopeAdminWrite + ":" + scopeDomainBlocks;
-export const ScopeAdminReadReports       = ScopeAdminRead + ":" + scopeReports;
-export const ScopeAdminWriteReports      = ScopeAdminWrite + ":" + scopeReports;
+export const ScopeReadAccounts                = ScopeRead + ":" + scopeAccounts;
+export const ScopeWriteAccounts               = ScopeWrite + ":" + scopeAccounts;
+export const ScopeReadApplications            = ScopeRead + ":" + scopeApplications;
+export const ScopeWriteApplications           = ScopeWrite + ":" + scopeApplications;
+export const ScopeReadBlocks                  = ScopeRead + ":" + scopeBlocks;
+export const ScopeWriteBlocks                 = ScopeWrite + ":" + scopeBlocks;
+export const ScopeReadBookmarks               = ScopeRead + ":" + scopeBookmarks;
+export const ScopeWriteBookmarks              = ScopeWrite + ":" + scopeBookmarks;
+export const ScopeWriteConversations          = ScopeWrite + ":" + scopeConversations;
+export const ScopeReadCustomEmojis            = ScopeRead + ":" + scopeCustomEmojis;
+export const ScopeReadFavourites              = ScopeRead + ":" + scopeFavourites;
+export const ScopeWriteFavourites             = ScopeWrite + ":" + scopeFavourites;
+export const ScopeReadFilters                 = ScopeRead + ":" + scopeFilters;
+export const ScopeWriteFilters                = ScopeWrite + ":" + scopeFilters;
+export const ScopeReadFollows                 = ScopeRead + ":" + scopeFollows;
+export const ScopeWriteFollows                = ScopeWrite + ":" + scopeFollows;
+export const ScopeReadLists                   = ScopeRead + ":" + scopeLists;
+export const ScopeWriteLists                  = ScopeWrite + ":" + scopeLists;
+export const ScopeWriteMedia                  = ScopeWrite + ":" + scopeMedia;
+export const ScopeReadMutes                   = ScopeRead + ":" + scopeMutes;
+export const ScopeWriteMutes                  = ScopeWrite + ":" + scopeMutes;
+export const ScopeReadNotifications           = ScopeRead + ":" + scopeNotifications;
+export const ScopeWriteNotifications          = ScopeWrite + ":" + scopeNotifications;
+export const ScopeWriteReports                = ScopeWrite + ":" + scopeReports;
+export const ScopeReadSearch                  = ScopeRead + ":" + scopeSearch;
+export const ScopeReadStatuses                = ScopeRead + ":" + scopeStatuses;
+export const ScopeWriteStatuses               = ScopeWrite + ":" + scopeStatuses;
+export const ScopeAdminReadAccounts           = ScopeAdminRead + ":" + scopeAccounts;
+export const ScopeAdminWriteAccounts          = ScopeAdminWrite + ":" + scopeAccounts;
+export const ScopeAdminReadAnnouncements      = ScopeAdminRead + ":" + scopeAnnouncements;
+export const ScopeAdminWriteAnnouncements     = ScopeAdminWrite + ":" + scopeAnnouncements;
+export const ScopeAdminReadCustomEmojis       = ScopeAdminRead + ":" + scopeCustomEmojis;
+export const ScopeAdminWriteCustomEmojis      = ScopeAdminWrite + ":" + scopeCustomEmojis;
+export const ScopeAdminReadDebug              = ScopeAdminRead + ":" + scopeDebug;
+export const ScopeAdminWriteDebug             = ScopeAdminWrite + ":" + scopeDebug;
+export const ScopeAdminReadDomainAllows       = ScopeAdminRead + ":" + scopeDomainAllows;
+export const ScopeAdminWriteDomainAllows      = ScopeAdminWrite + ":" + scopeDomainAllows;
+export const ScopeAdminReadDomainBlocks       = ScopeAdminRead + ":" + scopeDomainBlocks;
+export const ScopeAdminWriteDomainBlocks      = ScopeAdminWrite + ":" + scopeDomainBlocks;
+export const ScopeAdminReadDomainLimits       = ScopeAdminRead + ":" + scopeDomainLimits;
+export const ScopeAdminWriteDomainLimits      = ScopeAdminWrite + ":" + scopeDomainLimits;
+export const ScopeAdminReadDomainPermissions  = ScopeAdminRead + ":" + scopeDomainPermissions;
+export const ScopeAdminWriteDomainPermissions = ScopeAdminWrite + ":" + scopeDomainPermissions;
+export const ScopeAdminReadFederation         = ScopeAdminRead + ":" + scopeFederation;
+export const ScopeAdminWriteFederation        = ScopeAdminWrite + ":" + scopeFederation;
+export const ScopeAdminReadHeaderFilters      = ScopeAdminRead + ":" + scopeHeaderFilters;
+export const ScopeAdminWriteHeaderFilters     = ScopeAdminWrite + ":" + scopeHeaderFilters;
+export const ScopeAdminReadInstance           = ScopeAdminRead + ":" + scopeInstance;
+export const ScopeAdminWriteInstance          = ScopeAdminWrite + ":" + scopeInstance;
+export const ScopeAdminReadInvites            = ScopeAdminRead + ":" + scopeInvites;
+export const ScopeAdminWriteInvites           = ScopeAdminWrite + ":" + scopeInvites;
+export const ScopeAdminWriteMedia             = ScopeAdminWrite + ":" + scopeMedia;
+export const ScopeAdminReadReports            = ScopeAdminRead + ":" + scopeReports;
+export const ScopeAdminWriteReports           = ScopeAdminWrite + ":" + scopeReports;
+export const ScopeAdminReadTags               = ScopeAdminRead + ":" + scopeTags;
+export const ScopeAdminWriteTags              = ScopeAdminWrite + ":" + scopeTags;
 
 export const ValidScopes = [
 	ScopeProfile,
@@ -125,14 +153,33 @@ export const ValidScopes = [
 	ScopeWriteStatuses,
 	ScopeAdminReadAccounts,
 	ScopeAdminWriteAccounts,
+	ScopeAdminReadAnnouncements,
+	ScopeAdminWriteAnnouncements,
 	ScopeAdminReadCustomEmojis,
 	ScopeAdminWriteCustomEmojis,
+	ScopeAdminReadDebug,
+	ScopeAdminWriteDebug,
 	ScopeAdminReadDomainAllows,
 	ScopeAdminWriteDomainAllows,
 	ScopeAdminReadDomainBlocks,
 	ScopeAdminWriteDomainBlocks,
+	ScopeAdminReadDomainLimits,
+	ScopeAdminWriteDomainLimits,
+	ScopeAdminReadDomainPermissions,
+	ScopeAdminWriteDomainPermissions,
+	ScopeAdminReadFederation,
+	ScopeAdminWriteFederation,
+	ScopeAdminReadHeaderFilters,
+	ScopeAdminWriteHeaderFilters,
+	ScopeAdminReadInstance,
+	ScopeAdminWriteInstance,
+	ScopeAdminReadInvites,
+	ScopeAdminWriteInvites,
+	ScopeAdminWriteMedia,
 	ScopeAdminReadReports,
 	ScopeAdminWriteReports,
+	ScopeAdminReadTags,
+	ScopeAdminWriteTags,
 ];
 
 export const ValidTopLevelScopes = [
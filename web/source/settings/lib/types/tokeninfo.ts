@@ -23,6 +23,9 @@ export interface TokenInfo {
 	id: string;
 	created_at: string;
 	last_used?: string;
+	last_used_ip?: string;
+	last_used_user_agent?: string;
+	current: boolean;
 	scope: string;
 	application: {
 		name: string;
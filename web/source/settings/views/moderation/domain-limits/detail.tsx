@@ -153,6 +153,7 @@ function CreateOrUpdateDomainLimit({ defaultDomain, limit }: CreateOrUpdateDomai
 				no_action: "No limit",
 				mark_sensitive: "Mark sensitive",
 				reject: "Reject",
+				sensitive_blurhash_only: "Blurhash only for sensitive media",
 			}
 		}),
 		followsPolicy: useRadioInput("follows_policy", {
@@ -182,6 +183,14 @@ function CreateOrUpdateDomainLimit({ defaultDomain, limit }: CreateOrUpdateDomai
 				mute: "Mute/silence",
 			},
 		}),
+		federationPolicy: useRadioInput("federation_policy", {
+			source: limit,
+			defaultValue: "no_action",
+			options: {
+				no_action: "No limit",
+				pause: "Pause federation",
+			},
+		}),
 	};
 
 	// Derive appropriate submit action depending on whether this limit
@@ -369,6 +378,16 @@ function CreateOrUpdateDomainLimit({ defaultDomain, limit }: CreateOrUpdateDomai
 			</div>
 			<RadioGroup	field={form.accountsPolicy} />
 
+			<div className="form-section-docs">
+				<h3>Federation Policy</h3>
+				<p>
+					You can temporarily pause federation with the limited domain, for example to ride out a compromise of the remote instance, without severing any relationships between accounts.
+					<br/>While paused, posts and other activities from this instance to the limited domain are held back, and will be delivered once federation is resumed.
+					<br/>Activities sent to this instance from the limited domain are rejected, with a request to retry them later.
+				</p>
+			</div>
+			<RadioGroup	field={form.federationPolicy} />
+
 			<div className="action-buttons row">
 				<MutationButton
 					label={isExistingLimit ? "Update Limit" : "Limit"}
@@ -381,6 +400,7 @@ function CreateOrUpdateDomainLimit({ defaultDomain, limit }: CreateOrUpdateDomai
 						!form.followsPolicy.hasChanged() &&
 						!form.statusesPolicy.hasChanged() &&
 						!form.accountsPolicy.hasChanged() &&
+						!form.federationPolicy.hasChanged() &&
 						!form.contentWarning.hasChanged()
 					}
 				/>
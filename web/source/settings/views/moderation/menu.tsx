@@ -101,6 +101,7 @@ function ModerationDomainLimitsMenu() {
 		<MenuItem
 			name="Domain Limits"
 			itemUrl="domain-limits"
+			permissions={["admin"]}
 			icon="fa-filter"
 		/>
 	);
@@ -111,6 +112,7 @@ function ModerationDomainPermsMenu() {
 		<MenuItem
 			name="Domain Permissions"
 			itemUrl="domain-permissions"
+			permissions={["admin"]}
 			defaultChild="blocks"
 			icon="fa-hubzilla"
 		>
@@ -143,6 +143,12 @@ function ModerationDomainLimitsRouter() {
 	const thisBase = "/domain-limits";
 	const absBase = parentUrl + thisBase;
 
+	// Only admins may manage federation.
+	const admin = useHasPermission(["admin"]);
+	if (!admin) {
+		return null;
+	}
+
 	return (
 		<BaseUrlContext.Provider value={absBase}>
 			<Router base={thisBase}>
@@ -169,6 +175,12 @@ function ModerationDomainPermsRouter() {
 	const thisBase = "/domain-permissions";
 	const absBase = parentUrl + thisBase;
 
+	// Only admins may manage federation.
+	const admin = useHasPermission(["admin"]);
+	if (!admin) {
+		return null;
+	}
+
 	return (
 		<BaseUrlContext.Provider value={absBase}>
 			<Router base={thisBase}>
@@ -82,8 +82,9 @@ function InteractionPoliciesForm({ defaultPolicies }: InteractionPoliciesFormPro
 			can_favourite: assemblePolicyEntry("public", "favourite", formPublic),
 			can_reply: assemblePolicyEntry("public", "reply", formPublic),
 			can_reblog: assemblePolicyEntry("public", "reblog", formPublic),
+			can_quote: defaultPolicies.public.can_quote,
 		};
-	}, [formPublic]);
+	}, [formPublic, defaultPolicies.public.can_quote]);
 	
 	// Sub-form for visibility "unlisted".
 	const formUnlisted = useFormForVis(defaultPolicies.unlisted, "unlisted");
@@ -92,8 +93,9 @@ function InteractionPoliciesForm({ defaultPolicies }: InteractionPoliciesFormPro
 			can_favourite: assemblePolicyEntry("unlisted", "favourite", formUnlisted),
 			can_reply: assemblePolicyEntry("unlisted", "reply", formUnlisted),
 			can_reblog: assemblePolicyEntry("unlisted", "reblog", formUnlisted),
+			can_quote: defaultPolicies.unlisted.can_quote,
 		};
-	}, [formUnlisted]);
+	}, [formUnlisted, defaultPolicies.unlisted.can_quote]);
 	
 	// Sub-form for visibility "private".
 	const formPrivate = useFormForVis(defaultPolicies.private, "private");
@@ -102,13 +104,15 @@ function InteractionPoliciesForm({ defaultPolicies }: InteractionPoliciesFormPro
 			can_favourite: assemblePolicyEntry("private", "favourite", formPrivate),
 			can_reply: assemblePolicyEntry("private", "reply", formPrivate),
 			can_reblog: assemblePolicyEntry("private", "reblog", formPrivate),
+			can_quote: defaultPolicies.private.can_quote,
 		};
-	}, [formPrivate]);
+	}, [formPrivate, defaultPolicies.private.can_quote]);
 
 	const selectedVis = useTextInput("selectedVis", { defaultValue: "public" });
 	
 	const [updatePolicies, updateResult] = useUpdateDefaultInteractionPoliciesMutation();
 	const [resetPolicies, resetResult] = useResetDefaultInteractionPoliciesMutation();
+	const [applyBotPolicies, applyBotResult] = useApplyBotInteractionPoliciesMutation();
 
 	const onSubmit = (e) => {
 		e.preventDefault();
@@ -183,6 +187,16 @@ function InteractionPoliciesForm({ defaultPolicies }: InteractionPoliciesFormPro
 					className="button danger"
 					showError={false}
 				/>
+
+				<MutationButton
+					disabled={false}
+					type="button"
+					onClick={() => applyBotPolicies()}
+					label="Use bot preset"
+					result={applyBotResult}
+					className="button"
+					showError={false}
+				/>
 			</div>
 
 		</form>
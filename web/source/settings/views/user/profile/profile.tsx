@@ -45,11 +45,14 @@ import {
 	useAccountThemesQuery,
 	useDeleteAvatarMutation,
 	useDeleteHeaderMutation,
+	useRollbackAvatarMutation,
+	useRollbackHeaderMutation,
 } from "../../../lib/query/user";
 import { useUpdateCredentialsMutation } from "../../../lib/query/user";
 import { useVerifyCredentialsQuery } from "../../../lib/query/login";
 import { useInstanceV1Query } from "../../../lib/query/gts-api";
 import { Account } from "../../../lib/types/account";
+import { MediaAttachment } from "../../../lib/types/status";
 
 export default function Profile() {
 	return (
@@ -105,15 +108,21 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 		headerDescription: useTextInput("header_description", { source: profile }),
 		displayName: useTextInput("display_name", { source: profile }),
 		note: useTextInput("note", { source: profile, valueSelector: (p) => p.source?.note }),
+		pronouns: useTextInput("pronouns", { source: profile, valueSelector: (p: Account) => p.pronouns ?? "" }),
+		location: useTextInput("location", { source: profile, valueSelector: (p: Account) => p.location ?? "" }),
 		bot: useBoolInput("bot", { source: profile }),
+		operatorURI: useTextInput("operator_uri", { source: profile, valueSelector: (p: Account) => p.operator?.url ?? "" }),
 		locked: useBoolInput("locked", { source: profile }),
 		discoverable: useBoolInput("discoverable", { source: profile}),
 		indexable: useBoolInput("indexable", { source: profile}),
 		enableRSS: useBoolInput("enable_rss", { source: profile }),
+		rssFullContent: useBoolInput("rss_full_content", { source: profile, valueSelector: (p: Account) => p.source?.rss_full_content }),
 		hideCollections: useBoolInput("hide_collections", { source: profile }),
+		publishBlocks: useBoolInput("publish_blocks", { source: profile }),
 		webVisibility: useTextInput("web_visibility", { source: profile, valueSelector: (p: Account) => p.source?.web_visibility }),
 		webLayout: useTextInput("web_layout", { source: profile, valueSelector: (p: Account) => p.source?.web_layout }),
 		webIncludeBoosts: useBoolInput("web_include_boosts", { source: profile, valueSelector: (p: Account) => p.source?.web_include_boosts }),
+		webActivityHeatmap: useBoolInput("web_activity_heatmap", { source: profile, valueSelector: (p: Account) => p.source?.web_activity_heatmap }),
 		fields: useFieldArrayInput("fields_attributes", {
 			defaultValue: profile?.source?.fields,
 			length: instanceConfig.maxPinnedFields
@@ -126,6 +135,8 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 	const [ deleteHeader, deleteHeaderRes ] = useDeleteHeaderMutation();
 	const [ noAvatar, setNoAvatar ] = useState(!profile.avatar_media_id);
 	const [ deleteAvatar, deleteAvatarRes ] = useDeleteAvatarMutation();
+	const [ rollbackHeader, rollbackHeaderRes ] = useRollbackHeaderMutation();
+	const [ rollbackAvatar, rollbackAvatarRes ] = useRollbackAvatarMutation();
 
 	const [submitForm, result] = useFormSubmit(form, useUpdateCredentialsMutation(), {
 		changedOnly: true,
@@ -196,6 +207,18 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 							});
 						}}
 					/>
+					<ProfileMediaHistory
+						label="Previous headers; click one to restore it"
+						history={profile.source?.header_history}
+						disabled={rollbackHeaderRes.isLoading}
+						onRestore={(id) => {
+							rollbackHeader(id).then(res => {
+								if ('data' in res) {
+									setNoHeader(false);
+								}
+							});
+						}}
+					/>
 				</fieldset>
 
 				<fieldset className="file-input-with-image-description">
@@ -228,6 +251,18 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 							});
 						}}
 					/>
+					<ProfileMediaHistory
+						label="Previous avatars; click one to restore it"
+						history={profile.source?.avatar_history}
+						disabled={rollbackAvatarRes.isLoading}
+						onRestore={(id) => {
+							rollbackAvatar(id).then(res => {
+								if ('data' in res) {
+									setNoAvatar(false);
+								}
+							});
+						}}
+					/>
 				</fieldset>
 
 				<span>After choosing theme or layout and saving, <a href={profile.url} target="_blank">open your profile</a> and refresh to see changes.</span>
@@ -265,6 +300,15 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 				field={form.bot}
 				label="Mark as bot account; this indicates to other users that this is an automated account"
 			/>
+			{form.bot.value &&
+				<TextInput
+					field={form.operatorURI}
+					label="Bot operator; profile URL of the account that runs this bot (optional)"
+					placeholder="https://example.org/@someone"
+					autoCapitalize="none"
+					spellCheck="false"
+				/>
+			}
 			<TextInput
 				field={form.displayName}
 				label="Display name"
@@ -279,6 +323,21 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 				autoCapitalize="sentences"
 				rows={8}
 			/>
+			<TextInput
+				field={form.pronouns}
+				label="Pronouns (optional)"
+				placeholder="they/them"
+				autoCapitalize="none"
+				spellCheck="false"
+				maxLength={40}
+			/>
+			<TextInput
+				field={form.location}
+				label="Location (optional)"
+				placeholder="Somewhere over the rainbow"
+				autoCapitalize="words"
+				maxLength={100}
+			/>
 			<fieldset>
 				<legend>Profile fields</legend>
 				<ProfileFields
@@ -312,6 +371,10 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 				field={form.webIncludeBoosts}
 				label="Include boosts on the web view of your profile."
 			/>
+			<Checkbox
+				field={form.webActivityHeatmap}
+				label="Show a heatmap of your public posting activity over the last year on the web view of your profile."
+			/>
 			<Checkbox
 				field={form.locked}
 				label="Manually approve follow requests."
@@ -328,10 +391,18 @@ function ProfileForm({ data: profile }: ProfileFormProps) {
 				field={form.enableRSS}
 				label="Enable RSS feed of posts."
 			/>
+			<Checkbox
+				field={form.rssFullContent}
+				label="Include all media attachments in the content of RSS feed posts."
+			/>
 			<Checkbox
 				field={form.hideCollections}
 				label="Hide who you follow / are followed by."
 			/>
+			<Checkbox
+				field={form.publishBlocks}
+				label="Publish accounts you block, so others can import them."
+			/>
 
 			<div className="form-section-docs">
 				<h3>Advanced</h3>
@@ -401,3 +472,38 @@ function Field({ index, data }) {
 		</div>
 	);
 }
+
+interface ProfileMediaHistoryProps {
+	label: string;
+	history?: MediaAttachment[];
+	disabled: boolean;
+	onRestore: (_id: string) => void;
+}
+
+function ProfileMediaHistory({ label, history, disabled, onRestore }: ProfileMediaHistoryProps) {
+	if (!history || history.length === 0) {
+		return null;
+	}
+
+	return (
+		<div className="profile-media-history">
+			<span>{label}</span>
+			<div className="entries">
+				{history.map((media) => (
+					<button
+						key={media.id}
+						className="entry"
+						title={media.description ? `Restore: ${media.description}` : "Restore this image"}
+						disabled={disabled}
+						onClick={(e) => {
+							e.preventDefault();
+							onRestore(media.id);
+						}}
+					>
+						<img src={media.preview_url} alt={media.description} />
+					</button>
+				))}
+			</div>
+		</div>
+	);
+}
@@ -28,7 +28,8 @@ export default function Tokens() {
 				<p>
 					On this page you can search through access tokens owned by applications that you have authorized to
 					access your account and/or perform actions on your behalf. You can invalidate a token by clicking on
-					the invalidate button under a token. This will remove the token from the database.
+					the invalidate button under a token. This will remove the token from the database. To log out
+					everywhere except here, use the "Invalidate all other tokens" button.
 					<br/><br/>
 					<strong>
 						If you see any tokens from applications that you do not recognize, or do not remember authorizing to access
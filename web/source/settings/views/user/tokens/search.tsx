@@ -24,7 +24,11 @@ import { PageableList } from "../../../components/pageable-list";
 import MutationButton from "../../../components/form/mutation-button";
 import { useLocation, useSearch } from "wouter";
 import { Select } from "../../../components/form/inputs";
-import { useInvalidateTokenMutation, useLazySearchTokenInfoQuery } from "../../../lib/query/user/tokens";
+import {
+	useInvalidateOtherTokensMutation,
+	useInvalidateTokenMutation,
+	useLazySearchTokenInfoQuery,
+} from "../../../lib/query/user/tokens";
 import { TokenInfo } from "../../../lib/types/tokeninfo";
 
 export default function TokensSearchForm() {
@@ -32,6 +36,7 @@ export default function TokensSearchForm() {
 	const search = useSearch();
 	const urlQueryParams = useMemo(() => new URLSearchParams(search), [search]);
 	const [ searchTokenInfo, searchRes ] = useLazySearchTokenInfoQuery();
+	const [ invalidateOthers, invalidateOthersRes ] = useInvalidateOtherTokensMutation();
 
 	// Populate search form using values from
 	// urlQueryParams, to allow paging.
@@ -106,6 +111,21 @@ export default function TokensSearchForm() {
 					result={searchRes}
 				/>
 			</form>
+			<MutationButton
+				label={"Invalidate all other tokens"}
+				title={"Invalidate all tokens except the one used by this settings panel"}
+				type="button"
+				className="button danger"
+				onClick={(e) => {
+					e.preventDefault();
+					if (window.confirm("Invalidate all other tokens? This will log you out everywhere else.")) {
+						invalidateOthers();
+					}
+				}}
+				disabled={false}
+				showError={true}
+				result={invalidateOthersRes}
+			/>
 			<PageableList
 				isLoading={searchRes.isLoading}
 				isFetching={searchRes.isFetching}
@@ -173,7 +193,10 @@ function TokenInfoListEntry({ tokenInfo }: TokenInfoListEntryProps) {
 			<dl className="info-list">
 				<div className="info-list-entry">
 					<dt>App name:</dt>
-					<dd className="text-cutoff">{tokenInfo.application.name}</dd>
+					<dd className="text-cutoff">
+						{tokenInfo.application.name}
+						{ tokenInfo.current && <b> (current)</b> }
+					</dd>
 				</div>
 				{ appWebsite && 
 					<div className="info-list-entry">
@@ -193,6 +216,18 @@ function TokenInfoListEntry({ tokenInfo }: TokenInfoListEntryProps) {
 					<dt>Last used:</dt>
 					<dd className="text-cutoff">{lastUsed}</dd>
 				</div>
+				{ tokenInfo.last_used_ip &&
+					<div className="info-list-entry">
+						<dt>Last used from:</dt>
+						<dd className="text-cutoff monospace">{tokenInfo.last_used_ip}</dd>
+					</div>
+				}
+				{ tokenInfo.last_used_user_agent &&
+					<div className="info-list-entry">
+						<dt>Last user-agent:</dt>
+						<dd className="text-cutoff">{tokenInfo.last_used_user_agent}</dd>
+					</div>
+				}
 			</dl>
 			<div className="action-buttons">
 				<MutationButton